@@ -493,6 +493,7 @@ type DescribeClusterResponse struct {
 	SupportedClientVersions *shared.SupportedClientVersions `json:"supportedClientVersions,omitempty"`
 	MembershipInfo          *MembershipInfo                 `json:"membershipInfo,omitempty"`
 	PersistenceInfo         map[string]*PersistenceInfo     `json:"persistenceInfo,omitempty"`
+	PersistenceHealth       *PersistenceHealth              `json:"persistenceHealth,omitempty"`
 }
 
 type _Map_String_PersistenceInfo_MapItemList map[string]*PersistenceInfo
@@ -550,7 +551,7 @@ func (_Map_String_PersistenceInfo_MapItemList) Close() {}
 //   }
 func (v *DescribeClusterResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -580,6 +581,14 @@ func (v *DescribeClusterResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.PersistenceHealth != nil {
+		w, err = v.PersistenceHealth.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -630,6 +639,12 @@ func _Map_String_PersistenceInfo_Read(m wire.MapItemList) (map[string]*Persisten
 	return o, err
 }
 
+func _PersistenceHealth_Read(w wire.Value) (*PersistenceHealth, error) {
+	var v PersistenceHealth
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DescribeClusterResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -675,6 +690,14 @@ func (v *DescribeClusterResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.PersistenceHealth, err = _PersistenceHealth_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -753,6 +776,18 @@ func (v *DescribeClusterResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.PersistenceHealth != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.PersistenceHealth.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -814,6 +849,12 @@ func _Map_String_PersistenceInfo_Decode(sr stream.Reader) (map[string]*Persisten
 	return o, err
 }
 
+func _PersistenceHealth_Decode(sr stream.Reader) (*PersistenceHealth, error) {
+	var v PersistenceHealth
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a DescribeClusterResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -850,6 +891,12 @@ func (v *DescribeClusterResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.PersistenceHealth, err = _PersistenceHealth_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -879,7 +926,7 @@ func (v *DescribeClusterResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.SupportedClientVersions != nil {
 		fields[i] = fmt.Sprintf("SupportedClientVersions: %v", v.SupportedClientVersions)
@@ -893,6 +940,10 @@ func (v *DescribeClusterResponse) String() string {
 		fields[i] = fmt.Sprintf("PersistenceInfo: %v", v.PersistenceInfo)
 		i++
 	}
+	if v.PersistenceHealth != nil {
+		fields[i] = fmt.Sprintf("PersistenceHealth: %v", v.PersistenceHealth)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeClusterResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -933,6 +984,9 @@ func (v *DescribeClusterResponse) Equals(rhs *DescribeClusterResponse) bool {
 	if !((v.PersistenceInfo == nil && rhs.PersistenceInfo == nil) || (v.PersistenceInfo != nil && rhs.PersistenceInfo != nil && _Map_String_PersistenceInfo_Equals(v.PersistenceInfo, rhs.PersistenceInfo))) {
 		return false
 	}
+	if !((v.PersistenceHealth == nil && rhs.PersistenceHealth == nil) || (v.PersistenceHealth != nil && rhs.PersistenceHealth != nil && v.PersistenceHealth.Equals(rhs.PersistenceHealth))) {
+		return false
+	}
 
 	return true
 }
@@ -963,6 +1017,9 @@ func (v *DescribeClusterResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.PersistenceInfo != nil {
 		err = multierr.Append(err, enc.AddObject("persistenceInfo", (_Map_String_PersistenceInfo_Zapper)(v.PersistenceInfo)))
 	}
+	if v.PersistenceHealth != nil {
+		err = multierr.Append(err, enc.AddObject("persistenceHealth", v.PersistenceHealth))
+	}
 	return err
 }
 
@@ -1011,6 +1068,21 @@ func (v *DescribeClusterResponse) IsSetPersistenceInfo() bool {
 	return v != nil && v.PersistenceInfo != nil
 }
 
+// GetPersistenceHealth returns the value of PersistenceHealth if it is set or its
+// zero value if it is unset.
+func (v *DescribeClusterResponse) GetPersistenceHealth() (o *PersistenceHealth) {
+	if v != nil && v.PersistenceHealth != nil {
+		return v.PersistenceHealth
+	}
+
+	return
+}
+
+// IsSetPersistenceHealth returns true if PersistenceHealth is not nil.
+func (v *DescribeClusterResponse) IsSetPersistenceHealth() bool {
+	return v != nil && v.PersistenceHealth != nil
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string                   `json:"domain,omitempty"`
 	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
@@ -1695,41 +1767,13 @@ func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
 	return v != nil && v.MutableStateInDatabase != nil
 }
 
-type GetDynamicConfigRequest struct {
-	ConfigName *string                       `json:"configName,omitempty"`
-	Filters    []*config.DynamicConfigFilter `json:"filters,omitempty"`
-}
-
-type _List_DynamicConfigFilter_ValueList []*config.DynamicConfigFilter
-
-func (v _List_DynamicConfigFilter_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigFilter', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_DynamicConfigFilter_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_DynamicConfigFilter_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type FailoverReadinessCheck struct {
+	Name    *string `json:"name,omitempty"`
+	Passed  *bool   `json:"passed,omitempty"`
+	Message *string `json:"message,omitempty"`
 }
 
-func (_List_DynamicConfigFilter_ValueList) Close() {}
-
-// ToWire translates a GetDynamicConfigRequest struct into a Thrift-level intermediate
+// ToWire translates a FailoverReadinessCheck struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1741,66 +1785,50 @@ func (_List_DynamicConfigFilter_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *GetDynamicConfigRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *FailoverReadinessCheck) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ConfigName != nil {
-		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Filters != nil {
-		w, err = wire.NewValueList(_List_DynamicConfigFilter_ValueList(v.Filters)), error(nil)
+	if v.Passed != nil {
+		w, err = wire.NewValueBool(*(v.Passed)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _DynamicConfigFilter_Read(w wire.Value) (*config.DynamicConfigFilter, error) {
-	var v config.DynamicConfigFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_DynamicConfigFilter_Read(l wire.ValueList) ([]*config.DynamicConfigFilter, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*config.DynamicConfigFilter, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DynamicConfigFilter_Read(x)
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetDynamicConfigRequest struct from its Thrift-level
+// FromWire deserializes a FailoverReadinessCheck struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetDynamicConfigRequest struct
+// An error is returned if we were unable to build a FailoverReadinessCheck struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1808,12 +1836,12 @@ func _List_DynamicConfigFilter_Read(l wire.ValueList) ([]*config.DynamicConfigFi
 //     return nil, err
 //   }
 //
-//   var v GetDynamicConfigRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *GetDynamicConfigRequest) FromWire(w wire.Value) error {
+//	var v FailoverReadinessCheck
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *FailoverReadinessCheck) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1822,15 +1850,27 @@ func (v *GetDynamicConfigRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ConfigName = &x
+				v.Name = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Filters, err = _List_DynamicConfigFilter_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Passed = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
 				if err != nil {
 					return err
 				}
@@ -1842,41 +1882,20 @@ func (v *GetDynamicConfigRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_DynamicConfigFilter_Encode(val []*config.DynamicConfigFilter, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigFilter', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a GetDynamicConfigRequest struct directly into bytes, without going
+// Encode serializes a FailoverReadinessCheck struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a GetDynamicConfigRequest struct could not be encoded.
-func (v *GetDynamicConfigRequest) Encode(sw stream.Writer) error {
+// An error is returned if a FailoverReadinessCheck struct could not be encoded.
+func (v *FailoverReadinessCheck) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ConfigName != nil {
+	if v.Name != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+		if err := sw.WriteString(*(v.Name)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -1884,11 +1903,11 @@ func (v *GetDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Filters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.Passed != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := _List_DynamicConfigFilter_Encode(v.Filters, sw); err != nil {
+		if err := sw.WriteBool(*(v.Passed)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -1896,51 +1915,27 @@ func (v *GetDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _DynamicConfigFilter_Decode(sr stream.Reader) (*config.DynamicConfigFilter, error) {
-	var v config.DynamicConfigFilter
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_DynamicConfigFilter_Decode(sr stream.Reader) ([]*config.DynamicConfigFilter, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.Message != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*config.DynamicConfigFilter, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _DynamicConfigFilter_Decode(sr)
-		if err != nil {
-			return nil, err
+		if err := sw.WriteString(*(v.Message)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		o = append(o, v)
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a GetDynamicConfigRequest struct directly from its Thrift-level
+// Decode deserializes a FailoverReadinessCheck struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a GetDynamicConfigRequest struct could not be generated from the wire
+// An error is returned if a FailoverReadinessCheck struct could not be generated from the wire
 // representation.
-func (v *GetDynamicConfigRequest) Decode(sr stream.Reader) error {
+func (v *FailoverReadinessCheck) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -1956,13 +1951,23 @@ func (v *GetDynamicConfigRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ConfigName = &x
+			v.Name = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Filters, err = _List_DynamicConfigFilter_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Passed = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Message = &x
 			if err != nil {
 				return err
 			}
@@ -1989,168 +1994,233 @@ func (v *GetDynamicConfigRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetDynamicConfigRequest
+// String returns a readable string representation of a FailoverReadinessCheck
 // struct.
-func (v *GetDynamicConfigRequest) String() string {
+func (v *FailoverReadinessCheck) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.ConfigName != nil {
-		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.Filters != nil {
-		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
+	if v.Passed != nil {
+		fields[i] = fmt.Sprintf("Passed: %v", *(v.Passed))
+		i++
+	}
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
 		i++
 	}
 
-	return fmt.Sprintf("GetDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("FailoverReadinessCheck{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_DynamicConfigFilter_Equals(lhs, rhs []*config.DynamicConfigFilter) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
 	}
+	return lhs == nil && rhs == nil
+}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-// Equals returns true if all the fields of this GetDynamicConfigRequest match the
-// provided GetDynamicConfigRequest.
+// Equals returns true if all the fields of this FailoverReadinessCheck match the
+// provided FailoverReadinessCheck.
 //
 // This function performs a deep comparison.
-func (v *GetDynamicConfigRequest) Equals(rhs *GetDynamicConfigRequest) bool {
+func (v *FailoverReadinessCheck) Equals(rhs *FailoverReadinessCheck) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_DynamicConfigFilter_Equals(v.Filters, rhs.Filters))) {
+	if !_Bool_EqualsPtr(v.Passed, rhs.Passed) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DynamicConfigFilter_Zapper []*config.DynamicConfigFilter
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DynamicConfigFilter_Zapper.
-func (l _List_DynamicConfigFilter_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetDynamicConfigRequest.
-func (v *GetDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of FailoverReadinessCheck.
+func (v *FailoverReadinessCheck) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ConfigName != nil {
-		enc.AddString("configName", *v.ConfigName)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
 	}
-	if v.Filters != nil {
-		err = multierr.Append(err, enc.AddArray("filters", (_List_DynamicConfigFilter_Zapper)(v.Filters)))
+	if v.Passed != nil {
+		enc.AddBool("passed", *v.Passed)
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
 	}
 	return err
 }
 
-// GetConfigName returns the value of ConfigName if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *GetDynamicConfigRequest) GetConfigName() (o string) {
-	if v != nil && v.ConfigName != nil {
-		return *v.ConfigName
+func (v *FailoverReadinessCheck) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-// IsSetConfigName returns true if ConfigName is not nil.
-func (v *GetDynamicConfigRequest) IsSetConfigName() bool {
-	return v != nil && v.ConfigName != nil
+// IsSetName returns true if Name is not nil.
+func (v *FailoverReadinessCheck) IsSetName() bool {
+	return v != nil && v.Name != nil
 }
 
-// GetFilters returns the value of Filters if it is set or its
+// GetPassed returns the value of Passed if it is set or its
 // zero value if it is unset.
-func (v *GetDynamicConfigRequest) GetFilters() (o []*config.DynamicConfigFilter) {
-	if v != nil && v.Filters != nil {
-		return v.Filters
+func (v *FailoverReadinessCheck) GetPassed() (o bool) {
+	if v != nil && v.Passed != nil {
+		return *v.Passed
 	}
 
 	return
 }
 
-// IsSetFilters returns true if Filters is not nil.
-func (v *GetDynamicConfigRequest) IsSetFilters() bool {
-	return v != nil && v.Filters != nil
+// IsSetPassed returns true if Passed is not nil.
+func (v *FailoverReadinessCheck) IsSetPassed() bool {
+	return v != nil && v.Passed != nil
 }
 
-type GetDynamicConfigResponse struct {
-	Value *shared.DataBlob `json:"value,omitempty"`
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *FailoverReadinessCheck) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
 }
 
-// ToWire translates a GetDynamicConfigResponse struct into a Thrift-level intermediate
+// IsSetMessage returns true if Message is not nil.
+func (v *FailoverReadinessCheck) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+type GetDynamicConfigRequest struct {
+	ConfigName *string                       `json:"configName,omitempty"`
+	Filters    []*config.DynamicConfigFilter `json:"filters,omitempty"`
+}
+
+type _List_DynamicConfigFilter_ValueList []*config.DynamicConfigFilter
+
+func (v _List_DynamicConfigFilter_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigFilter', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DynamicConfigFilter_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DynamicConfigFilter_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DynamicConfigFilter_ValueList) Close() {}
+
+// ToWire translates a GetDynamicConfigRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *GetDynamicConfigResponse) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetDynamicConfigRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Value != nil {
-		w, err = v.Value.ToWire()
+	if v.ConfigName != nil {
+		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Filters != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigFilter_ValueList(v.Filters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
+func _DynamicConfigFilter_Read(w wire.Value) (*config.DynamicConfigFilter, error) {
+	var v config.DynamicConfigFilter
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a GetDynamicConfigResponse struct from its Thrift-level
+func _List_DynamicConfigFilter_Read(l wire.ValueList) ([]*config.DynamicConfigFilter, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*config.DynamicConfigFilter, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DynamicConfigFilter_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetDynamicConfigRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetDynamicConfigResponse struct
+// An error is returned if we were unable to build a GetDynamicConfigRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2158,19 +2228,29 @@ func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
 //     return nil, err
 //   }
 //
-//   var v GetDynamicConfigResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *GetDynamicConfigResponse) FromWire(w wire.Value) error {
+//	var v GetDynamicConfigRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetDynamicConfigRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.Value, err = _DataBlob_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ConfigName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Filters, err = _List_DynamicConfigFilter_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -2182,20 +2262,53 @@ func (v *GetDynamicConfigResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a GetDynamicConfigResponse struct directly into bytes, without going
+func _List_DynamicConfigFilter_Encode(val []*config.DynamicConfigFilter, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigFilter', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a GetDynamicConfigRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a GetDynamicConfigResponse struct could not be encoded.
-func (v *GetDynamicConfigResponse) Encode(sw stream.Writer) error {
+// An error is returned if a GetDynamicConfigRequest struct could not be encoded.
+func (v *GetDynamicConfigRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Value != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+	if v.ConfigName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.Value.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Filters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DynamicConfigFilter_Encode(v.Filters, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2206,18 +2319,48 @@ func (v *GetDynamicConfigResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DataBlob_Decode(sr stream.Reader) (*shared.DataBlob, error) {
-	var v shared.DataBlob
+func _DynamicConfigFilter_Decode(sr stream.Reader) (*config.DynamicConfigFilter, error) {
+	var v config.DynamicConfigFilter
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a GetDynamicConfigResponse struct directly from its Thrift-level
+func _List_DynamicConfigFilter_Decode(sr stream.Reader) ([]*config.DynamicConfigFilter, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*config.DynamicConfigFilter, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DynamicConfigFilter_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a GetDynamicConfigRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a GetDynamicConfigResponse struct could not be generated from the wire
+// An error is returned if a GetDynamicConfigRequest struct could not be generated from the wire
 // representation.
-func (v *GetDynamicConfigResponse) Decode(sr stream.Reader) error {
+func (v *GetDynamicConfigRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -2230,14 +2373,22 @@ func (v *GetDynamicConfigResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TStruct:
-			v.Value, err = _DataBlob_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ConfigName = &x
 			if err != nil {
 				return err
 			}
 
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Filters, err = _List_DynamicConfigFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
 				return err
 			}
 		}
@@ -2258,81 +2409,123 @@ func (v *GetDynamicConfigResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetDynamicConfigResponse
+// String returns a readable string representation of a GetDynamicConfigRequest
 // struct.
-func (v *GetDynamicConfigResponse) String() string {
+func (v *GetDynamicConfigRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.Value != nil {
-		fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	if v.ConfigName != nil {
+		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
+		i++
+	}
+	if v.Filters != nil {
+		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
 		i++
 	}
 
-	return fmt.Sprintf("GetDynamicConfigResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetDynamicConfigResponse match the
-// provided GetDynamicConfigResponse.
+func _List_DynamicConfigFilter_Equals(lhs, rhs []*config.DynamicConfigFilter) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetDynamicConfigRequest match the
+// provided GetDynamicConfigRequest.
 //
 // This function performs a deep comparison.
-func (v *GetDynamicConfigResponse) Equals(rhs *GetDynamicConfigResponse) bool {
+func (v *GetDynamicConfigRequest) Equals(rhs *GetDynamicConfigRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && v.Value.Equals(rhs.Value))) {
+	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
+		return false
+	}
+	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_DynamicConfigFilter_Equals(v.Filters, rhs.Filters))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_DynamicConfigFilter_Zapper []*config.DynamicConfigFilter
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DynamicConfigFilter_Zapper.
+func (l _List_DynamicConfigFilter_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetDynamicConfigResponse.
-func (v *GetDynamicConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetDynamicConfigRequest.
+func (v *GetDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Value != nil {
-		err = multierr.Append(err, enc.AddObject("value", v.Value))
+	if v.ConfigName != nil {
+		enc.AddString("configName", *v.ConfigName)
+	}
+	if v.Filters != nil {
+		err = multierr.Append(err, enc.AddArray("filters", (_List_DynamicConfigFilter_Zapper)(v.Filters)))
 	}
 	return err
 }
 
-// GetValue returns the value of Value if it is set or its
+// GetConfigName returns the value of ConfigName if it is set or its
 // zero value if it is unset.
-func (v *GetDynamicConfigResponse) GetValue() (o *shared.DataBlob) {
-	if v != nil && v.Value != nil {
-		return v.Value
+func (v *GetDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil && v.ConfigName != nil {
+		return *v.ConfigName
 	}
 
 	return
 }
 
-// IsSetValue returns true if Value is not nil.
-func (v *GetDynamicConfigResponse) IsSetValue() bool {
-	return v != nil && v.Value != nil
+// IsSetConfigName returns true if ConfigName is not nil.
+func (v *GetDynamicConfigRequest) IsSetConfigName() bool {
+	return v != nil && v.ConfigName != nil
 }
 
-// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
-// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
-type GetWorkflowExecutionRawHistoryV2Request struct {
-	Domain            *string                   `json:"domain,omitempty"`
-	Execution         *shared.WorkflowExecution `json:"execution,omitempty"`
-	StartEventId      *int64                    `json:"startEventId,omitempty"`
-	StartEventVersion *int64                    `json:"startEventVersion,omitempty"`
-	EndEventId        *int64                    `json:"endEventId,omitempty"`
-	EndEventVersion   *int64                    `json:"endEventVersion,omitempty"`
-	MaximumPageSize   *int32                    `json:"maximumPageSize,omitempty"`
-	NextPageToken     []byte                    `json:"nextPageToken,omitempty"`
+// GetFilters returns the value of Filters if it is set or its
+// zero value if it is unset.
+func (v *GetDynamicConfigRequest) GetFilters() (o []*config.DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+
+	return
 }
 
-// ToWire translates a GetWorkflowExecutionRawHistoryV2Request struct into a Thrift-level intermediate
+// IsSetFilters returns true if Filters is not nil.
+func (v *GetDynamicConfigRequest) IsSetFilters() bool {
+	return v != nil && v.Filters != nil
+}
+
+type GetDynamicConfigResponse struct {
+	Value *shared.DataBlob `json:"value,omitempty"`
+}
+
+// ToWire translates a GetDynamicConfigResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2344,90 +2537,40 @@ type GetWorkflowExecutionRawHistoryV2Request struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *GetWorkflowExecutionRawHistoryV2Request) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetDynamicConfigResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Value != nil {
+		w, err = v.Value.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.StartEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.StartEventVersion != nil {
-		w, err = wire.NewValueI64(*(v.StartEventVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.EndEventId != nil {
-		w, err = wire.NewValueI64(*(v.EndEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.EndEventVersion != nil {
-		w, err = wire.NewValueI64(*(v.EndEventVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryV2Request struct from its Thrift-level
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetDynamicConfigResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryV2Request struct
+// An error is returned if we were unable to build a GetDynamicConfigResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2435,196 +2578,44 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryV2Request
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryV2Request) FromWire(w wire.Value) error {
+//	var v GetDynamicConfigResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetDynamicConfigResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartEventId = &x
+				v.Value, err = _DataBlob_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartEventVersion = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EndEventId = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EndEventVersion = &x
-				if err != nil {
-					return err
-				}
+// Encode serializes a GetDynamicConfigResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetDynamicConfigResponse struct could not be encoded.
+func (v *GetDynamicConfigResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
 
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// Encode serializes a GetWorkflowExecutionRawHistoryV2Request struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a GetWorkflowExecutionRawHistoryV2Request struct could not be encoded.
-func (v *GetWorkflowExecutionRawHistoryV2Request) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Execution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Execution.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StartEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StartEventVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartEventVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.EndEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.EndEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.EndEventVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.EndEventVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.MaximumPageSize != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.NextPageToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+		if err := v.Value.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2635,12 +2626,18 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) Encode(sw stream.Writer) error
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a GetWorkflowExecutionRawHistoryV2Request struct directly from its Thrift-level
+func _DataBlob_Decode(sr stream.Reader) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a GetDynamicConfigResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a GetWorkflowExecutionRawHistoryV2Request struct could not be generated from the wire
+// An error is returned if a GetDynamicConfigResponse struct could not be generated from the wire
 // representation.
-func (v *GetWorkflowExecutionRawHistoryV2Request) Decode(sr stream.Reader) error {
+func (v *GetDynamicConfigResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -2653,62 +2650,8 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) Decode(sr stream.Reader) error
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.Execution, err = _WorkflowExecution_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartEventVersion = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EndEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EndEventVersion = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MaximumPageSize = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Value, err = _DataBlob_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -2735,62 +2678,320 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryV2Request
+// String returns a readable string representation of a GetDynamicConfigResponse
 // struct.
-func (v *GetWorkflowExecutionRawHistoryV2Request) String() string {
+func (v *GetDynamicConfigResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.StartEventId != nil {
-		fields[i] = fmt.Sprintf("StartEventId: %v", *(v.StartEventId))
-		i++
-	}
-	if v.StartEventVersion != nil {
-		fields[i] = fmt.Sprintf("StartEventVersion: %v", *(v.StartEventVersion))
-		i++
-	}
-	if v.EndEventId != nil {
-		fields[i] = fmt.Sprintf("EndEventId: %v", *(v.EndEventId))
-		i++
-	}
-	if v.EndEventVersion != nil {
-		fields[i] = fmt.Sprintf("EndEventVersion: %v", *(v.EndEventVersion))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", v.Value)
 		i++
 	}
 
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryV2Request{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetDynamicConfigResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+// Equals returns true if all the fields of this GetDynamicConfigResponse match the
+// provided GetDynamicConfigResponse.
+//
+// This function performs a deep comparison.
+func (v *GetDynamicConfigResponse) Equals(rhs *GetDynamicConfigResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	return lhs == nil && rhs == nil
+	if !((v.Value == nil && rhs.Value == nil) || (v.Value != nil && rhs.Value != nil && v.Value.Equals(rhs.Value))) {
+		return false
+	}
+
+	return true
 }
 
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetDynamicConfigResponse.
+func (v *GetDynamicConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		err = multierr.Append(err, enc.AddObject("value", v.Value))
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *GetDynamicConfigResponse) GetValue() (o *shared.DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *GetDynamicConfigResponse) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+type GetFailoverReadinessRequest struct {
+	Domain                          *string `json:"domain,omitempty"`
+	MaxReplicationLagInSeconds      *int32  `json:"maxReplicationLagInSeconds,omitempty"`
+	ReplicationErrorWindowInSeconds *int32  `json:"replicationErrorWindowInSeconds,omitempty"`
+}
+
+// ToWire translates a GetFailoverReadinessRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetFailoverReadinessRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.MaxReplicationLagInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MaxReplicationLagInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ReplicationErrorWindowInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ReplicationErrorWindowInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetFailoverReadinessRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetFailoverReadinessRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v GetFailoverReadinessRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetFailoverReadinessRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxReplicationLagInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ReplicationErrorWindowInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a GetFailoverReadinessRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetFailoverReadinessRequest struct could not be encoded.
+func (v *GetFailoverReadinessRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaxReplicationLagInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaxReplicationLagInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicationErrorWindowInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ReplicationErrorWindowInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GetFailoverReadinessRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetFailoverReadinessRequest struct could not be generated from the wire
+// representation.
+func (v *GetFailoverReadinessRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaxReplicationLagInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ReplicationErrorWindowInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetFailoverReadinessRequest
+// struct.
+func (v *GetFailoverReadinessRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.MaxReplicationLagInSeconds != nil {
+		fields[i] = fmt.Sprintf("MaxReplicationLagInSeconds: %v", *(v.MaxReplicationLagInSeconds))
+		i++
+	}
+	if v.ReplicationErrorWindowInSeconds != nil {
+		fields[i] = fmt.Sprintf("ReplicationErrorWindowInSeconds: %v", *(v.ReplicationErrorWindowInSeconds))
+		i++
+	}
+
+	return fmt.Sprintf("GetFailoverReadinessRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -2800,11 +3001,11 @@ func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryV2Request match the
-// provided GetWorkflowExecutionRawHistoryV2Request.
+// Equals returns true if all the fields of this GetFailoverReadinessRequest match the
+// provided GetFailoverReadinessRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryV2Request) Equals(rhs *GetWorkflowExecutionRawHistoryV2Request) bool {
+func (v *GetFailoverReadinessRequest) Equals(rhs *GetFailoverReadinessRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2813,25 +3014,10 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) Equals(rhs *GetWorkflowExecuti
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartEventId, rhs.StartEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartEventVersion, rhs.StartEventVersion) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.EndEventId, rhs.EndEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.EndEventVersion, rhs.EndEventVersion) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+	if !_I32_EqualsPtr(v.MaxReplicationLagInSeconds, rhs.MaxReplicationLagInSeconds) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_I32_EqualsPtr(v.ReplicationErrorWindowInSeconds, rhs.ReplicationErrorWindowInSeconds) {
 		return false
 	}
 
@@ -2839,194 +3025,5096 @@ func (v *GetWorkflowExecutionRawHistoryV2Request) Equals(rhs *GetWorkflowExecuti
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryV2Request.
-func (v *GetWorkflowExecutionRawHistoryV2Request) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetFailoverReadinessRequest.
+func (v *GetFailoverReadinessRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.MaxReplicationLagInSeconds != nil {
+		enc.AddInt32("maxReplicationLagInSeconds", *v.MaxReplicationLagInSeconds)
+	}
+	if v.ReplicationErrorWindowInSeconds != nil {
+		enc.AddInt32("replicationErrorWindowInSeconds", *v.ReplicationErrorWindowInSeconds)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *GetFailoverReadinessRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetMaxReplicationLagInSeconds returns the value of MaxReplicationLagInSeconds if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessRequest) GetMaxReplicationLagInSeconds() (o int32) {
+	if v != nil && v.MaxReplicationLagInSeconds != nil {
+		return *v.MaxReplicationLagInSeconds
+	}
+
+	return
+}
+
+// IsSetMaxReplicationLagInSeconds returns true if MaxReplicationLagInSeconds is not nil.
+func (v *GetFailoverReadinessRequest) IsSetMaxReplicationLagInSeconds() bool {
+	return v != nil && v.MaxReplicationLagInSeconds != nil
+}
+
+// GetReplicationErrorWindowInSeconds returns the value of ReplicationErrorWindowInSeconds if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessRequest) GetReplicationErrorWindowInSeconds() (o int32) {
+	if v != nil && v.ReplicationErrorWindowInSeconds != nil {
+		return *v.ReplicationErrorWindowInSeconds
+	}
+
+	return
+}
+
+// IsSetReplicationErrorWindowInSeconds returns true if ReplicationErrorWindowInSeconds is not nil.
+func (v *GetFailoverReadinessRequest) IsSetReplicationErrorWindowInSeconds() bool {
+	return v != nil && v.ReplicationErrorWindowInSeconds != nil
+}
+
+type GetFailoverReadinessResponse struct {
+	DomainID      *string                   `json:"domainID,omitempty"`
+	Cluster       *string                   `json:"cluster,omitempty"`
+	SourceCluster *string                   `json:"sourceCluster,omitempty"`
+	Ready         *bool                     `json:"ready,omitempty"`
+	Checks        []*FailoverReadinessCheck `json:"checks,omitempty"`
+}
+
+type _List_FailoverReadinessCheck_ValueList []*FailoverReadinessCheck
+
+func (v _List_FailoverReadinessCheck_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*FailoverReadinessCheck', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_FailoverReadinessCheck_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_FailoverReadinessCheck_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_FailoverReadinessCheck_ValueList) Close() {}
+
+// ToWire translates a GetFailoverReadinessResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetFailoverReadinessResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Cluster != nil {
+		w, err = wire.NewValueString(*(v.Cluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Ready != nil {
+		w, err = wire.NewValueBool(*(v.Ready)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Checks != nil {
+		w, err = wire.NewValueList(_List_FailoverReadinessCheck_ValueList(v.Checks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _FailoverReadinessCheck_Read(w wire.Value) (*FailoverReadinessCheck, error) {
+	var v FailoverReadinessCheck
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_FailoverReadinessCheck_Read(l wire.ValueList) ([]*FailoverReadinessCheck, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*FailoverReadinessCheck, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _FailoverReadinessCheck_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetFailoverReadinessResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetFailoverReadinessResponse struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v GetFailoverReadinessResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetFailoverReadinessResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Ready = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.Checks, err = _List_FailoverReadinessCheck_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_FailoverReadinessCheck_Encode(val []*FailoverReadinessCheck, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*FailoverReadinessCheck', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a GetFailoverReadinessResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetFailoverReadinessResponse struct could not be encoded.
+func (v *GetFailoverReadinessResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Cluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Cluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SourceCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Ready != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Ready)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Checks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_FailoverReadinessCheck_Encode(v.Checks, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _FailoverReadinessCheck_Decode(sr stream.Reader) (*FailoverReadinessCheck, error) {
+	var v FailoverReadinessCheck
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_FailoverReadinessCheck_Decode(sr stream.Reader) ([]*FailoverReadinessCheck, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*FailoverReadinessCheck, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _FailoverReadinessCheck_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a GetFailoverReadinessResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetFailoverReadinessResponse struct could not be generated from the wire
+// representation.
+func (v *GetFailoverReadinessResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Cluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SourceCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Ready = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TList:
+			v.Checks, err = _List_FailoverReadinessCheck_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetFailoverReadinessResponse
+// struct.
+func (v *GetFailoverReadinessResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.Cluster != nil {
+		fields[i] = fmt.Sprintf("Cluster: %v", *(v.Cluster))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.Ready != nil {
+		fields[i] = fmt.Sprintf("Ready: %v", *(v.Ready))
+		i++
+	}
+	if v.Checks != nil {
+		fields[i] = fmt.Sprintf("Checks: %v", v.Checks)
+		i++
+	}
+
+	return fmt.Sprintf("GetFailoverReadinessResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_FailoverReadinessCheck_Equals(lhs, rhs []*FailoverReadinessCheck) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetFailoverReadinessResponse match the
+// provided GetFailoverReadinessResponse.
+//
+// This function performs a deep comparison.
+func (v *GetFailoverReadinessResponse) Equals(rhs *GetFailoverReadinessResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Cluster, rhs.Cluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Ready, rhs.Ready) {
+		return false
+	}
+	if !((v.Checks == nil && rhs.Checks == nil) || (v.Checks != nil && rhs.Checks != nil && _List_FailoverReadinessCheck_Equals(v.Checks, rhs.Checks))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_FailoverReadinessCheck_Zapper []*FailoverReadinessCheck
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_FailoverReadinessCheck_Zapper.
+func (l _List_FailoverReadinessCheck_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetFailoverReadinessResponse.
+func (v *GetFailoverReadinessResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.Cluster != nil {
+		enc.AddString("cluster", *v.Cluster)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.Ready != nil {
+		enc.AddBool("ready", *v.Ready)
+	}
+	if v.Checks != nil {
+		err = multierr.Append(err, enc.AddArray("checks", (_List_FailoverReadinessCheck_Zapper)(v.Checks)))
+	}
+	return err
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessResponse) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *GetFailoverReadinessResponse) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetCluster returns the value of Cluster if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessResponse) GetCluster() (o string) {
+	if v != nil && v.Cluster != nil {
+		return *v.Cluster
+	}
+
+	return
+}
+
+// IsSetCluster returns true if Cluster is not nil.
+func (v *GetFailoverReadinessResponse) IsSetCluster() bool {
+	return v != nil && v.Cluster != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessResponse) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *GetFailoverReadinessResponse) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetReady returns the value of Ready if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessResponse) GetReady() (o bool) {
+	if v != nil && v.Ready != nil {
+		return *v.Ready
+	}
+
+	return
+}
+
+// IsSetReady returns true if Ready is not nil.
+func (v *GetFailoverReadinessResponse) IsSetReady() bool {
+	return v != nil && v.Ready != nil
+}
+
+// GetChecks returns the value of Checks if it is set or its
+// zero value if it is unset.
+func (v *GetFailoverReadinessResponse) GetChecks() (o []*FailoverReadinessCheck) {
+	if v != nil && v.Checks != nil {
+		return v.Checks
+	}
+
+	return
+}
+
+// IsSetChecks returns true if Checks is not nil.
+func (v *GetFailoverReadinessResponse) IsSetChecks() bool {
+	return v != nil && v.Checks != nil
+}
+
+type GetReplicationStatusRequest struct {
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+	TargetCluster *string `json:"targetCluster,omitempty"`
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+// ToWire translates a GetReplicationStatusRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetReplicationStatusRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationStatusRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationStatusRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v GetReplicationStatusRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetReplicationStatusRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_I32_Encode(val []int32, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := sw.WriteInt32(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a GetReplicationStatusRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetReplicationStatusRequest struct could not be encoded.
+func (v *GetReplicationStatusRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.SourceCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TargetCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TargetCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShardIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(v.ShardIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_I32_Decode(sr stream.Reader) ([]int32, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]int32, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a GetReplicationStatusRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetReplicationStatusRequest struct could not be generated from the wire
+// representation.
+func (v *GetReplicationStatusRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SourceCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TargetCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.ShardIDs, err = _List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationStatusRequest
+// struct.
+func (v *GetReplicationStatusRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
+		i++
+	}
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationStatusRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationStatusRequest match the
+// provided GetReplicationStatusRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationStatusRequest) Equals(rhs *GetReplicationStatusRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
+		return false
+	}
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationStatusRequest.
+func (v *GetReplicationStatusRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
+	}
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationStatusRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *GetReplicationStatusRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetTargetCluster returns the value of TargetCluster if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationStatusRequest) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
+	}
+
+	return
+}
+
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *GetReplicationStatusRequest) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
+}
+
+// GetShardIDs returns the value of ShardIDs if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+
+	return
+}
+
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *GetReplicationStatusRequest) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
+}
+
+// StartEventId defines the beginning of the event to fetch. The first event is exclusive.
+// EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
+type GetWorkflowExecutionRawHistoryV2Request struct {
+	Domain            *string                   `json:"domain,omitempty"`
+	Execution         *shared.WorkflowExecution `json:"execution,omitempty"`
+	StartEventId      *int64                    `json:"startEventId,omitempty"`
+	StartEventVersion *int64                    `json:"startEventVersion,omitempty"`
+	EndEventId        *int64                    `json:"endEventId,omitempty"`
+	EndEventVersion   *int64                    `json:"endEventVersion,omitempty"`
+	MaximumPageSize   *int32                    `json:"maximumPageSize,omitempty"`
+	NextPageToken     []byte                    `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryV2Request struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetWorkflowExecutionRawHistoryV2Request) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.StartEventVersion != nil {
+		w, err = wire.NewValueI64(*(v.StartEventVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.EndEventId != nil {
+		w, err = wire.NewValueI64(*(v.EndEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.EndEventVersion != nil {
+		w, err = wire.NewValueI64(*(v.EndEventVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryV2Request struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryV2Request struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v GetWorkflowExecutionRawHistoryV2Request
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetWorkflowExecutionRawHistoryV2Request) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartEventVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndEventVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a GetWorkflowExecutionRawHistoryV2Request struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetWorkflowExecutionRawHistoryV2Request struct could not be encoded.
+func (v *GetWorkflowExecutionRawHistoryV2Request) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Execution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Execution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartEventVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartEventVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EndEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EndEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EndEventVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EndEventVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MaximumPageSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GetWorkflowExecutionRawHistoryV2Request struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetWorkflowExecutionRawHistoryV2Request struct could not be generated from the wire
+// representation.
+func (v *GetWorkflowExecutionRawHistoryV2Request) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Execution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartEventVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EndEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EndEventVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaximumPageSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryV2Request
+// struct.
+func (v *GetWorkflowExecutionRawHistoryV2Request) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.StartEventId != nil {
+		fields[i] = fmt.Sprintf("StartEventId: %v", *(v.StartEventId))
+		i++
+	}
+	if v.StartEventVersion != nil {
+		fields[i] = fmt.Sprintf("StartEventVersion: %v", *(v.StartEventVersion))
+		i++
+	}
+	if v.EndEventId != nil {
+		fields[i] = fmt.Sprintf("EndEventId: %v", *(v.EndEventId))
+		i++
+	}
+	if v.EndEventVersion != nil {
+		fields[i] = fmt.Sprintf("EndEventVersion: %v", *(v.EndEventVersion))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryV2Request{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryV2Request match the
+// provided GetWorkflowExecutionRawHistoryV2Request.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryV2Request) Equals(rhs *GetWorkflowExecutionRawHistoryV2Request) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartEventId, rhs.StartEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartEventVersion, rhs.StartEventVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndEventId, rhs.EndEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndEventVersion, rhs.EndEventVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryV2Request.
+func (v *GetWorkflowExecutionRawHistoryV2Request) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
 	if v.StartEventId != nil {
 		enc.AddInt64("startEventId", *v.StartEventId)
 	}
-	if v.StartEventVersion != nil {
-		enc.AddInt64("startEventVersion", *v.StartEventVersion)
+	if v.StartEventVersion != nil {
+		enc.AddInt64("startEventVersion", *v.StartEventVersion)
+	}
+	if v.EndEventId != nil {
+		enc.AddInt64("endEventId", *v.EndEventId)
+	}
+	if v.EndEventVersion != nil {
+		enc.AddInt64("endEventVersion", *v.EndEventVersion)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetStartEventId returns the value of StartEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() (o int64) {
+	if v != nil && v.StartEventId != nil {
+		return *v.StartEventId
+	}
+
+	return
+}
+
+// IsSetStartEventId returns true if StartEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetStartEventId() bool {
+	return v != nil && v.StartEventId != nil
+}
+
+// GetStartEventVersion returns the value of StartEventVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() (o int64) {
+	if v != nil && v.StartEventVersion != nil {
+		return *v.StartEventVersion
+	}
+
+	return
+}
+
+// IsSetStartEventVersion returns true if StartEventVersion is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetStartEventVersion() bool {
+	return v != nil && v.StartEventVersion != nil
+}
+
+// GetEndEventId returns the value of EndEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() (o int64) {
+	if v != nil && v.EndEventId != nil {
+		return *v.EndEventId
+	}
+
+	return
+}
+
+// IsSetEndEventId returns true if EndEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetEndEventId() bool {
+	return v != nil && v.EndEventId != nil
+}
+
+// GetEndEventVersion returns the value of EndEventVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() (o int64) {
+	if v != nil && v.EndEventVersion != nil {
+		return *v.EndEventVersion
+	}
+
+	return
+}
+
+// IsSetEndEventVersion returns true if EndEventVersion is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetEndEventVersion() bool {
+	return v != nil && v.EndEventVersion != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type GetWorkflowExecutionRawHistoryV2Response struct {
+	NextPageToken  []byte                 `json:"nextPageToken,omitempty"`
+	HistoryBatches []*shared.DataBlob     `json:"historyBatches,omitempty"`
+	VersionHistory *shared.VersionHistory `json:"versionHistory,omitempty"`
+}
+
+type _List_DataBlob_ValueList []*shared.DataBlob
+
+func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*shared.DataBlob', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DataBlob_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DataBlob_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DataBlob_ValueList) Close() {}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryV2Response struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetWorkflowExecutionRawHistoryV2Response) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryBatches != nil {
+		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.VersionHistory != nil {
+		w, err = v.VersionHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.DataBlob, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DataBlob_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
+	var v shared.VersionHistory
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryV2Response struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryV2Response struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v GetWorkflowExecutionRawHistoryV2Response
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetWorkflowExecutionRawHistoryV2Response) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.VersionHistory, err = _VersionHistory_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_DataBlob_Encode(val []*shared.DataBlob, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*shared.DataBlob', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a GetWorkflowExecutionRawHistoryV2Response struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetWorkflowExecutionRawHistoryV2Response struct could not be encoded.
+func (v *GetWorkflowExecutionRawHistoryV2Response) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryBatches != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DataBlob_Encode(v.HistoryBatches, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VersionHistory != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.VersionHistory.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_DataBlob_Decode(sr stream.Reader) ([]*shared.DataBlob, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*shared.DataBlob, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DataBlob_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _VersionHistory_Decode(sr stream.Reader) (*shared.VersionHistory, error) {
+	var v shared.VersionHistory
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a GetWorkflowExecutionRawHistoryV2Response struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetWorkflowExecutionRawHistoryV2Response struct could not be generated from the wire
+// representation.
+func (v *GetWorkflowExecutionRawHistoryV2Response) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.HistoryBatches, err = _List_DataBlob_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.VersionHistory, err = _VersionHistory_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryV2Response
+// struct.
+func (v *GetWorkflowExecutionRawHistoryV2Response) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.HistoryBatches != nil {
+		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+		i++
+	}
+	if v.VersionHistory != nil {
+		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryV2Response{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryV2Response match the
+// provided GetWorkflowExecutionRawHistoryV2Response.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryV2Response) Equals(rhs *GetWorkflowExecutionRawHistoryV2Response) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+		return false
+	}
+	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DataBlob_Zapper []*shared.DataBlob
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DataBlob_Zapper.
+func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryV2Response.
+func (v *GetWorkflowExecutionRawHistoryV2Response) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	}
+	if v.VersionHistory != nil {
+		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v != nil && v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// IsSetHistoryBatches returns true if HistoryBatches is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetHistoryBatches() bool {
+	return v != nil && v.HistoryBatches != nil
+}
+
+// GetVersionHistory returns the value of VersionHistory if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() (o *shared.VersionHistory) {
+	if v != nil && v.VersionHistory != nil {
+		return v.VersionHistory
+	}
+
+	return
+}
+
+// IsSetVersionHistory returns true if VersionHistory is not nil.
+func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetVersionHistory() bool {
+	return v != nil && v.VersionHistory != nil
+}
+
+type HostInfo struct {
+	Identity *string `json:"Identity,omitempty"`
+}
+
+// ToWire translates a HostInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *HostInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a HostInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HostInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v HostInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *HostInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a HostInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a HostInfo struct could not be encoded.
+func (v *HostInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a HostInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a HostInfo struct could not be generated from the wire
+// representation.
+func (v *HostInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HostInfo
+// struct.
+func (v *HostInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("HostInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this HostInfo match the
+// provided HostInfo.
+//
+// This function performs a deep comparison.
+func (v *HostInfo) Equals(rhs *HostInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HostInfo.
+func (v *HostInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Identity != nil {
+		enc.AddString("Identity", *v.Identity)
+	}
+	return err
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *HostInfo) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *HostInfo) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type ListDynamicConfigRequest struct {
+	ConfigName *string `json:"configName,omitempty"`
+}
+
+// ToWire translates a ListDynamicConfigRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ConfigName != nil {
+		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListDynamicConfigRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListDynamicConfigRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ListDynamicConfigRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ConfigName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ListDynamicConfigRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ListDynamicConfigRequest struct could not be encoded.
+func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ConfigName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ListDynamicConfigRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ListDynamicConfigRequest struct could not be generated from the wire
+// representation.
+func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ConfigName = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListDynamicConfigRequest
+// struct.
+func (v *ListDynamicConfigRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ConfigName != nil {
+		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
+		i++
+	}
+
+	return fmt.Sprintf("ListDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListDynamicConfigRequest match the
+// provided ListDynamicConfigRequest.
+//
+// This function performs a deep comparison.
+func (v *ListDynamicConfigRequest) Equals(rhs *ListDynamicConfigRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListDynamicConfigRequest.
+func (v *ListDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ConfigName != nil {
+		enc.AddString("configName", *v.ConfigName)
+	}
+	return err
+}
+
+// GetConfigName returns the value of ConfigName if it is set or its
+// zero value if it is unset.
+func (v *ListDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil && v.ConfigName != nil {
+		return *v.ConfigName
+	}
+
+	return
+}
+
+// IsSetConfigName returns true if ConfigName is not nil.
+func (v *ListDynamicConfigRequest) IsSetConfigName() bool {
+	return v != nil && v.ConfigName != nil
+}
+
+type ListDynamicConfigResponse struct {
+	Entries []*config.DynamicConfigEntry `json:"entries,omitempty"`
+}
+
+type _List_DynamicConfigEntry_ValueList []*config.DynamicConfigEntry
+
+func (v _List_DynamicConfigEntry_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigEntry', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DynamicConfigEntry_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DynamicConfigEntry_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DynamicConfigEntry_ValueList) Close() {}
+
+// ToWire translates a ListDynamicConfigResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ListDynamicConfigResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Entries != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigEntry_ValueList(v.Entries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DynamicConfigEntry_Read(w wire.Value) (*config.DynamicConfigEntry, error) {
+	var v config.DynamicConfigEntry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DynamicConfigEntry_Read(l wire.ValueList) ([]*config.DynamicConfigEntry, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*config.DynamicConfigEntry, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DynamicConfigEntry_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListDynamicConfigResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListDynamicConfigResponse struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ListDynamicConfigResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ListDynamicConfigResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Entries, err = _List_DynamicConfigEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_DynamicConfigEntry_Encode(val []*config.DynamicConfigEntry, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigEntry', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ListDynamicConfigResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ListDynamicConfigResponse struct could not be encoded.
+func (v *ListDynamicConfigResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Entries != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DynamicConfigEntry_Encode(v.Entries, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _DynamicConfigEntry_Decode(sr stream.Reader) (*config.DynamicConfigEntry, error) {
+	var v config.DynamicConfigEntry
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_DynamicConfigEntry_Decode(sr stream.Reader) ([]*config.DynamicConfigEntry, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*config.DynamicConfigEntry, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DynamicConfigEntry_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ListDynamicConfigResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ListDynamicConfigResponse struct could not be generated from the wire
+// representation.
+func (v *ListDynamicConfigResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Entries, err = _List_DynamicConfigEntry_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListDynamicConfigResponse
+// struct.
+func (v *ListDynamicConfigResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Entries != nil {
+		fields[i] = fmt.Sprintf("Entries: %v", v.Entries)
+		i++
+	}
+
+	return fmt.Sprintf("ListDynamicConfigResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DynamicConfigEntry_Equals(lhs, rhs []*config.DynamicConfigEntry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListDynamicConfigResponse match the
+// provided ListDynamicConfigResponse.
+//
+// This function performs a deep comparison.
+func (v *ListDynamicConfigResponse) Equals(rhs *ListDynamicConfigResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Entries == nil && rhs.Entries == nil) || (v.Entries != nil && rhs.Entries != nil && _List_DynamicConfigEntry_Equals(v.Entries, rhs.Entries))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DynamicConfigEntry_Zapper []*config.DynamicConfigEntry
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DynamicConfigEntry_Zapper.
+func (l _List_DynamicConfigEntry_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListDynamicConfigResponse.
+func (v *ListDynamicConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Entries != nil {
+		err = multierr.Append(err, enc.AddArray("entries", (_List_DynamicConfigEntry_Zapper)(v.Entries)))
+	}
+	return err
+}
+
+// GetEntries returns the value of Entries if it is set or its
+// zero value if it is unset.
+func (v *ListDynamicConfigResponse) GetEntries() (o []*config.DynamicConfigEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+
+	return
+}
+
+// IsSetEntries returns true if Entries is not nil.
+func (v *ListDynamicConfigResponse) IsSetEntries() bool {
+	return v != nil && v.Entries != nil
+}
+
+type MembershipInfo struct {
+	CurrentHost      *HostInfo   `json:"currentHost,omitempty"`
+	ReachableMembers []string    `json:"reachableMembers,omitempty"`
+	Rings            []*RingInfo `json:"rings,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_RingInfo_ValueList []*RingInfo
+
+func (v _List_RingInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*RingInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_RingInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_RingInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_RingInfo_ValueList) Close() {}
+
+// ToWire translates a MembershipInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *MembershipInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.CurrentHost != nil {
+		w, err = v.CurrentHost.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ReachableMembers != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.ReachableMembers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Rings != nil {
+		w, err = wire.NewValueList(_List_RingInfo_ValueList(v.Rings)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HostInfo_Read(w wire.Value) (*HostInfo, error) {
+	var v HostInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _RingInfo_Read(w wire.Value) (*RingInfo, error) {
+	var v RingInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_RingInfo_Read(l wire.ValueList) ([]*RingInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*RingInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _RingInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a MembershipInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MembershipInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v MembershipInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *MembershipInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.CurrentHost, err = _HostInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.ReachableMembers, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.Rings, err = _List_RingInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_String_Encode(val []string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TBinary,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := sw.WriteString(v); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_RingInfo_Encode(val []*RingInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*RingInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a MembershipInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a MembershipInfo struct could not be encoded.
+func (v *MembershipInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.CurrentHost != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.CurrentHost.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReachableMembers != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.ReachableMembers, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Rings != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_RingInfo_Encode(v.Rings, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _HostInfo_Decode(sr stream.Reader) (*HostInfo, error) {
+	var v HostInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_String_Decode(sr stream.Reader) ([]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TBinary {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _RingInfo_Decode(sr stream.Reader) (*RingInfo, error) {
+	var v RingInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_RingInfo_Decode(sr stream.Reader) ([]*RingInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*RingInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _RingInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a MembershipInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a MembershipInfo struct could not be generated from the wire
+// representation.
+func (v *MembershipInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.CurrentHost, err = _HostInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.ReachableMembers, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.Rings, err = _List_RingInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MembershipInfo
+// struct.
+func (v *MembershipInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.CurrentHost != nil {
+		fields[i] = fmt.Sprintf("CurrentHost: %v", v.CurrentHost)
+		i++
+	}
+	if v.ReachableMembers != nil {
+		fields[i] = fmt.Sprintf("ReachableMembers: %v", v.ReachableMembers)
+		i++
+	}
+	if v.Rings != nil {
+		fields[i] = fmt.Sprintf("Rings: %v", v.Rings)
+		i++
+	}
+
+	return fmt.Sprintf("MembershipInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_RingInfo_Equals(lhs, rhs []*RingInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this MembershipInfo match the
+// provided MembershipInfo.
+//
+// This function performs a deep comparison.
+func (v *MembershipInfo) Equals(rhs *MembershipInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.CurrentHost == nil && rhs.CurrentHost == nil) || (v.CurrentHost != nil && rhs.CurrentHost != nil && v.CurrentHost.Equals(rhs.CurrentHost))) {
+		return false
+	}
+	if !((v.ReachableMembers == nil && rhs.ReachableMembers == nil) || (v.ReachableMembers != nil && rhs.ReachableMembers != nil && _List_String_Equals(v.ReachableMembers, rhs.ReachableMembers))) {
+		return false
+	}
+	if !((v.Rings == nil && rhs.Rings == nil) || (v.Rings != nil && rhs.Rings != nil && _List_RingInfo_Equals(v.Rings, rhs.Rings))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_RingInfo_Zapper []*RingInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RingInfo_Zapper.
+func (l _List_RingInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MembershipInfo.
+func (v *MembershipInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.CurrentHost != nil {
+		err = multierr.Append(err, enc.AddObject("currentHost", v.CurrentHost))
+	}
+	if v.ReachableMembers != nil {
+		err = multierr.Append(err, enc.AddArray("reachableMembers", (_List_String_Zapper)(v.ReachableMembers)))
+	}
+	if v.Rings != nil {
+		err = multierr.Append(err, enc.AddArray("rings", (_List_RingInfo_Zapper)(v.Rings)))
+	}
+	return err
+}
+
+// GetCurrentHost returns the value of CurrentHost if it is set or its
+// zero value if it is unset.
+func (v *MembershipInfo) GetCurrentHost() (o *HostInfo) {
+	if v != nil && v.CurrentHost != nil {
+		return v.CurrentHost
+	}
+
+	return
+}
+
+// IsSetCurrentHost returns true if CurrentHost is not nil.
+func (v *MembershipInfo) IsSetCurrentHost() bool {
+	return v != nil && v.CurrentHost != nil
+}
+
+// GetReachableMembers returns the value of ReachableMembers if it is set or its
+// zero value if it is unset.
+func (v *MembershipInfo) GetReachableMembers() (o []string) {
+	if v != nil && v.ReachableMembers != nil {
+		return v.ReachableMembers
+	}
+
+	return
+}
+
+// IsSetReachableMembers returns true if ReachableMembers is not nil.
+func (v *MembershipInfo) IsSetReachableMembers() bool {
+	return v != nil && v.ReachableMembers != nil
+}
+
+// GetRings returns the value of Rings if it is set or its
+// zero value if it is unset.
+func (v *MembershipInfo) GetRings() (o []*RingInfo) {
+	if v != nil && v.Rings != nil {
+		return v.Rings
+	}
+
+	return
+}
+
+// IsSetRings returns true if Rings is not nil.
+func (v *MembershipInfo) IsSetRings() bool {
+	return v != nil && v.Rings != nil
+}
+
+type PersistenceFeature struct {
+	Key     *string `json:"key,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+// ToWire translates a PersistenceFeature struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PersistenceFeature) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Enabled != nil {
+		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PersistenceFeature struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PersistenceFeature struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v PersistenceFeature
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PersistenceFeature) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Enabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a PersistenceFeature struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PersistenceFeature struct could not be encoded.
+func (v *PersistenceFeature) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Enabled != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Enabled)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a PersistenceFeature struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PersistenceFeature struct could not be generated from the wire
+// representation.
+func (v *PersistenceFeature) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Enabled = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PersistenceFeature
+// struct.
+func (v *PersistenceFeature) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Enabled != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
+		i++
+	}
+
+	return fmt.Sprintf("PersistenceFeature{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PersistenceFeature match the
+// provided PersistenceFeature.
+//
+// This function performs a deep comparison.
+func (v *PersistenceFeature) Equals(rhs *PersistenceFeature) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersistenceFeature.
+func (v *PersistenceFeature) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Enabled != nil {
+		enc.AddBool("enabled", *v.Enabled)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *PersistenceFeature) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *PersistenceFeature) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// zero value if it is unset.
+func (v *PersistenceFeature) GetEnabled() (o bool) {
+	if v != nil && v.Enabled != nil {
+		return *v.Enabled
+	}
+
+	return
+}
+
+// IsSetEnabled returns true if Enabled is not nil.
+func (v *PersistenceFeature) IsSetEnabled() bool {
+	return v != nil && v.Enabled != nil
+}
+
+type PersistenceHealth struct {
+	Requests        *int64 `json:"requests,omitempty"`
+	Failures        *int64 `json:"failures,omitempty"`
+	WindowInSeconds *int32 `json:"windowInSeconds,omitempty"`
+}
+
+// ToWire translates a PersistenceHealth struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PersistenceHealth) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Requests != nil {
+		w, err = wire.NewValueI64(*(v.Requests)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Failures != nil {
+		w, err = wire.NewValueI64(*(v.Failures)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WindowInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.WindowInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PersistenceHealth struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PersistenceHealth struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v PersistenceHealth
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PersistenceHealth) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Requests = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Failures = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WindowInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a PersistenceHealth struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PersistenceHealth struct could not be encoded.
+func (v *PersistenceHealth) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Requests != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Requests)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Failures != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Failures)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WindowInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.WindowInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a PersistenceHealth struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PersistenceHealth struct could not be generated from the wire
+// representation.
+func (v *PersistenceHealth) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Requests = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Failures = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.WindowInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PersistenceHealth
+// struct.
+func (v *PersistenceHealth) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Requests != nil {
+		fields[i] = fmt.Sprintf("Requests: %v", *(v.Requests))
+		i++
+	}
+	if v.Failures != nil {
+		fields[i] = fmt.Sprintf("Failures: %v", *(v.Failures))
+		i++
+	}
+	if v.WindowInSeconds != nil {
+		fields[i] = fmt.Sprintf("WindowInSeconds: %v", *(v.WindowInSeconds))
+		i++
+	}
+
+	return fmt.Sprintf("PersistenceHealth{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PersistenceHealth match the
+// provided PersistenceHealth.
+//
+// This function performs a deep comparison.
+func (v *PersistenceHealth) Equals(rhs *PersistenceHealth) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Requests, rhs.Requests) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Failures, rhs.Failures) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WindowInSeconds, rhs.WindowInSeconds) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersistenceHealth.
+func (v *PersistenceHealth) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Requests != nil {
+		enc.AddInt64("requests", *v.Requests)
+	}
+	if v.Failures != nil {
+		enc.AddInt64("failures", *v.Failures)
+	}
+	if v.WindowInSeconds != nil {
+		enc.AddInt32("windowInSeconds", *v.WindowInSeconds)
+	}
+	return err
+}
+
+// GetRequests returns the value of Requests if it is set or its
+// zero value if it is unset.
+func (v *PersistenceHealth) GetRequests() (o int64) {
+	if v != nil && v.Requests != nil {
+		return *v.Requests
+	}
+
+	return
+}
+
+// IsSetRequests returns true if Requests is not nil.
+func (v *PersistenceHealth) IsSetRequests() bool {
+	return v != nil && v.Requests != nil
+}
+
+// GetFailures returns the value of Failures if it is set or its
+// zero value if it is unset.
+func (v *PersistenceHealth) GetFailures() (o int64) {
+	if v != nil && v.Failures != nil {
+		return *v.Failures
+	}
+
+	return
+}
+
+// IsSetFailures returns true if Failures is not nil.
+func (v *PersistenceHealth) IsSetFailures() bool {
+	return v != nil && v.Failures != nil
+}
+
+// GetWindowInSeconds returns the value of WindowInSeconds if it is set or its
+// zero value if it is unset.
+func (v *PersistenceHealth) GetWindowInSeconds() (o int32) {
+	if v != nil && v.WindowInSeconds != nil {
+		return *v.WindowInSeconds
+	}
+
+	return
+}
+
+// IsSetWindowInSeconds returns true if WindowInSeconds is not nil.
+func (v *PersistenceHealth) IsSetWindowInSeconds() bool {
+	return v != nil && v.WindowInSeconds != nil
+}
+
+type PersistenceInfo struct {
+	Backend  *string               `json:"backend,omitempty"`
+	Settings []*PersistenceSetting `json:"settings,omitempty"`
+	Features []*PersistenceFeature `json:"features,omitempty"`
+}
+
+type _List_PersistenceSetting_ValueList []*PersistenceSetting
+
+func (v _List_PersistenceSetting_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*PersistenceSetting', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PersistenceSetting_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PersistenceSetting_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PersistenceSetting_ValueList) Close() {}
+
+type _List_PersistenceFeature_ValueList []*PersistenceFeature
+
+func (v _List_PersistenceFeature_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*PersistenceFeature', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PersistenceFeature_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PersistenceFeature_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PersistenceFeature_ValueList) Close() {}
+
+// ToWire translates a PersistenceInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PersistenceInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Backend != nil {
+		w, err = wire.NewValueString(*(v.Backend)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Settings != nil {
+		w, err = wire.NewValueList(_List_PersistenceSetting_ValueList(v.Settings)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Features != nil {
+		w, err = wire.NewValueList(_List_PersistenceFeature_ValueList(v.Features)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PersistenceSetting_Read(w wire.Value) (*PersistenceSetting, error) {
+	var v PersistenceSetting
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PersistenceSetting_Read(l wire.ValueList) ([]*PersistenceSetting, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PersistenceSetting, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PersistenceSetting_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _PersistenceFeature_Read(w wire.Value) (*PersistenceFeature, error) {
+	var v PersistenceFeature
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PersistenceFeature_Read(l wire.ValueList) ([]*PersistenceFeature, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PersistenceFeature, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PersistenceFeature_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a PersistenceInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PersistenceInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v PersistenceInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PersistenceInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Backend = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Settings, err = _List_PersistenceSetting_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.Features, err = _List_PersistenceFeature_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_PersistenceSetting_Encode(val []*PersistenceSetting, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*PersistenceSetting', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+func _List_PersistenceFeature_Encode(val []*PersistenceFeature, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*PersistenceFeature', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a PersistenceInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PersistenceInfo struct could not be encoded.
+func (v *PersistenceInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Backend != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Backend)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Settings != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_PersistenceSetting_Encode(v.Settings, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Features != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_PersistenceFeature_Encode(v.Features, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _PersistenceSetting_Decode(sr stream.Reader) (*PersistenceSetting, error) {
+	var v PersistenceSetting
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_PersistenceSetting_Decode(sr stream.Reader) ([]*PersistenceSetting, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
 	}
-	if v.EndEventId != nil {
-		enc.AddInt64("endEventId", *v.EndEventId)
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
 	}
-	if v.EndEventVersion != nil {
-		enc.AddInt64("endEventVersion", *v.EndEventVersion)
+
+	o := make([]*PersistenceSetting, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _PersistenceSetting_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _PersistenceFeature_Decode(sr stream.Reader) (*PersistenceFeature, error) {
+	var v PersistenceFeature
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_PersistenceFeature_Decode(sr stream.Reader) ([]*PersistenceFeature, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*PersistenceFeature, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _PersistenceFeature_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a PersistenceInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PersistenceInfo struct could not be generated from the wire
+// representation.
+func (v *PersistenceInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Backend = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Settings, err = _List_PersistenceSetting_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.Features, err = _List_PersistenceFeature_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PersistenceInfo
+// struct.
+func (v *PersistenceInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Backend != nil {
+		fields[i] = fmt.Sprintf("Backend: %v", *(v.Backend))
+		i++
+	}
+	if v.Settings != nil {
+		fields[i] = fmt.Sprintf("Settings: %v", v.Settings)
+		i++
+	}
+	if v.Features != nil {
+		fields[i] = fmt.Sprintf("Features: %v", v.Features)
+		i++
+	}
+
+	return fmt.Sprintf("PersistenceInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_PersistenceSetting_Equals(lhs, rhs []*PersistenceSetting) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_PersistenceFeature_Equals(lhs, rhs []*PersistenceFeature) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this PersistenceInfo match the
+// provided PersistenceInfo.
+//
+// This function performs a deep comparison.
+func (v *PersistenceInfo) Equals(rhs *PersistenceInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Backend, rhs.Backend) {
+		return false
+	}
+	if !((v.Settings == nil && rhs.Settings == nil) || (v.Settings != nil && rhs.Settings != nil && _List_PersistenceSetting_Equals(v.Settings, rhs.Settings))) {
+		return false
+	}
+	if !((v.Features == nil && rhs.Features == nil) || (v.Features != nil && rhs.Features != nil && _List_PersistenceFeature_Equals(v.Features, rhs.Features))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_PersistenceSetting_Zapper []*PersistenceSetting
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PersistenceSetting_Zapper.
+func (l _List_PersistenceSetting_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_PersistenceFeature_Zapper []*PersistenceFeature
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PersistenceFeature_Zapper.
+func (l _List_PersistenceFeature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersistenceInfo.
+func (v *PersistenceInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	if v.Backend != nil {
+		enc.AddString("backend", *v.Backend)
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.Settings != nil {
+		err = multierr.Append(err, enc.AddArray("settings", (_List_PersistenceSetting_Zapper)(v.Settings)))
+	}
+	if v.Features != nil {
+		err = multierr.Append(err, enc.AddArray("features", (_List_PersistenceFeature_Zapper)(v.Features)))
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetBackend returns the value of Backend if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *PersistenceInfo) GetBackend() (o string) {
+	if v != nil && v.Backend != nil {
+		return *v.Backend
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetBackend returns true if Backend is not nil.
+func (v *PersistenceInfo) IsSetBackend() bool {
+	return v != nil && v.Backend != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetSettings returns the value of Settings if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *PersistenceInfo) GetSettings() (o []*PersistenceSetting) {
+	if v != nil && v.Settings != nil {
+		return v.Settings
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetSettings returns true if Settings is not nil.
+func (v *PersistenceInfo) IsSetSettings() bool {
+	return v != nil && v.Settings != nil
 }
 
-// GetStartEventId returns the value of StartEventId if it is set or its
+// GetFeatures returns the value of Features if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetStartEventId() (o int64) {
-	if v != nil && v.StartEventId != nil {
-		return *v.StartEventId
+func (v *PersistenceInfo) GetFeatures() (o []*PersistenceFeature) {
+	if v != nil && v.Features != nil {
+		return v.Features
 	}
 
 	return
 }
 
-// IsSetStartEventId returns true if StartEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetStartEventId() bool {
-	return v != nil && v.StartEventId != nil
+// IsSetFeatures returns true if Features is not nil.
+func (v *PersistenceInfo) IsSetFeatures() bool {
+	return v != nil && v.Features != nil
 }
 
-// GetStartEventVersion returns the value of StartEventVersion if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetStartEventVersion() (o int64) {
-	if v != nil && v.StartEventVersion != nil {
-		return *v.StartEventVersion
+type PersistenceSetting struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// ToWire translates a PersistenceSetting struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PersistenceSetting) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetStartEventVersion returns true if StartEventVersion is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetStartEventVersion() bool {
-	return v != nil && v.StartEventVersion != nil
+// FromWire deserializes a PersistenceSetting struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PersistenceSetting struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v PersistenceSetting
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PersistenceSetting) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
 }
 
-// GetEndEventId returns the value of EndEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetEndEventId() (o int64) {
-	if v != nil && v.EndEventId != nil {
-		return *v.EndEventId
+// Encode serializes a PersistenceSetting struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PersistenceSetting struct could not be encoded.
+func (v *PersistenceSetting) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Key != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Key)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Value)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a PersistenceSetting struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PersistenceSetting struct could not be generated from the wire
+// representation.
+func (v *PersistenceSetting) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Key = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Value = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PersistenceSetting
+// struct.
+func (v *PersistenceSetting) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
 	}
 
-	return
-}
-
-// IsSetEndEventId returns true if EndEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetEndEventId() bool {
-	return v != nil && v.EndEventId != nil
+	return fmt.Sprintf("PersistenceSetting{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetEndEventVersion returns the value of EndEventVersion if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetEndEventVersion() (o int64) {
-	if v != nil && v.EndEventVersion != nil {
-		return *v.EndEventVersion
+// Equals returns true if all the fields of this PersistenceSetting match the
+// provided PersistenceSetting.
+//
+// This function performs a deep comparison.
+func (v *PersistenceSetting) Equals(rhs *PersistenceSetting) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetEndEventVersion returns true if EndEventVersion is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetEndEventVersion() bool {
-	return v != nil && v.EndEventVersion != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersistenceSetting.
+func (v *PersistenceSetting) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	return err
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// GetKey returns the value of Key if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v *PersistenceSetting) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
 	}
 
 	return
 }
 
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
+// IsSetKey returns true if Key is not nil.
+func (v *PersistenceSetting) IsSetKey() bool {
+	return v != nil && v.Key != nil
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetValue returns the value of Value if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Request) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *PersistenceSetting) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Request) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
-}
-
-type GetWorkflowExecutionRawHistoryV2Response struct {
-	NextPageToken  []byte                 `json:"nextPageToken,omitempty"`
-	HistoryBatches []*shared.DataBlob     `json:"historyBatches,omitempty"`
-	VersionHistory *shared.VersionHistory `json:"versionHistory,omitempty"`
-}
-
-type _List_DataBlob_ValueList []*shared.DataBlob
-
-func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*shared.DataBlob', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_DataBlob_ValueList) Size() int {
-	return len(v)
+// IsSetValue returns true if Value is not nil.
+func (v *PersistenceSetting) IsSetValue() bool {
+	return v != nil && v.Value != nil
 }
 
-func (_List_DataBlob_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type ResendReplicationTasksRequest struct {
+	DomainID      *string `json:"domainID,omitempty"`
+	WorkflowID    *string `json:"workflowID,omitempty"`
+	RunID         *string `json:"runID,omitempty"`
+	RemoteCluster *string `json:"remoteCluster,omitempty"`
+	StartEventID  *int64  `json:"startEventID,omitempty"`
+	StartVersion  *int64  `json:"startVersion,omitempty"`
+	EndEventID    *int64  `json:"endEventID,omitempty"`
+	EndVersion    *int64  `json:"endVersion,omitempty"`
 }
 
-func (_List_DataBlob_ValueList) Close() {}
-
-// ToWire translates a GetWorkflowExecutionRawHistoryV2Response struct into a Thrift-level intermediate
+// ToWire translates a ResendReplicationTasksRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3038,74 +8126,90 @@ func (_List_DataBlob_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *GetWorkflowExecutionRawHistoryV2Response) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ResendReplicationTasksRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryBatches != nil {
-		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.VersionHistory != nil {
-		w, err = v.VersionHistory.ToWire()
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if v.RemoteCluster != nil {
+		w, err = wire.NewValueString(*(v.RemoteCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-
-	o := make([]*shared.DataBlob, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DataBlob_Read(x)
+	if v.StartEventID != nil {
+		w, err = wire.NewValueI64(*(v.StartEventID)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.StartVersion != nil {
+		w, err = wire.NewValueI64(*(v.StartVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.EndEventID != nil {
+		w, err = wire.NewValueI64(*(v.EndEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.EndVersion != nil {
+		w, err = wire.NewValueI64(*(v.EndVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
-func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
-	var v shared.VersionHistory
-	err := v.FromWire(w)
-	return &v, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryV2Response struct from its Thrift-level
+// FromWire deserializes a ResendReplicationTasksRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryV2Response struct
+// An error is returned if we were unable to build a ResendReplicationTasksRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3113,35 +8217,91 @@ func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryV2Response
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryV2Response) FromWire(w wire.Value) error {
+//	var v ResendReplicationTasksRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ResendReplicationTasksRequest) FromWire(w wire.Value) error {
 	var err error
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RemoteCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartVersion = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndEventID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.VersionHistory, err = _VersionHistory_Read(field.Value)
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndVersion = &x
 				if err != nil {
 					return err
 				}
@@ -3153,41 +8313,20 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) FromWire(w wire.Value) error
 	return nil
 }
 
-func _List_DataBlob_Encode(val []*shared.DataBlob, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*shared.DataBlob', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a GetWorkflowExecutionRawHistoryV2Response struct directly into bytes, without going
+// Encode serializes a ResendReplicationTasksRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a GetWorkflowExecutionRawHistoryV2Response struct could not be encoded.
-func (v *GetWorkflowExecutionRawHistoryV2Response) Encode(sw stream.Writer) error {
+// An error is returned if a ResendReplicationTasksRequest struct could not be encoded.
+func (v *ResendReplicationTasksRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.NextPageToken != nil {
+	if v.DomainID != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3195,11 +8334,11 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) Encode(sw stream.Writer) erro
 		}
 	}
 
-	if v.HistoryBatches != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.WorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := _List_DataBlob_Encode(v.HistoryBatches, sw); err != nil {
+		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3207,11 +8346,11 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) Encode(sw stream.Writer) erro
 		}
 	}
 
-	if v.VersionHistory != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.RunID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.VersionHistory.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.RunID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3219,51 +8358,75 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) Encode(sw stream.Writer) erro
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.RemoteCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RemoteCluster)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _List_DataBlob_Decode(sr stream.Reader) ([]*shared.DataBlob, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+	if v.StartEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.StartVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*shared.DataBlob, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _DataBlob_Decode(sr)
-		if err != nil {
-			return nil, err
+	if v.EndEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EndEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		o = append(o, v)
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+	if v.EndVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EndVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return o, err
-}
 
-func _VersionHistory_Decode(sr stream.Reader) (*shared.VersionHistory, error) {
-	var v shared.VersionHistory
-	err := v.Decode(sr)
-	return &v, err
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a GetWorkflowExecutionRawHistoryV2Response struct directly from its Thrift-level
+// Decode deserializes a ResendReplicationTasksRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a GetWorkflowExecutionRawHistoryV2Response struct could not be generated from the wire
+// An error is returned if a ResendReplicationTasksRequest struct could not be generated from the wire
 // representation.
-func (v *GetWorkflowExecutionRawHistoryV2Response) Decode(sr stream.Reader) error {
+func (v *ResendReplicationTasksRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -3277,19 +8440,65 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.HistoryBatches, err = _List_DataBlob_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.VersionHistory, err = _VersionHistory_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RemoteCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EndEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EndVersion = &x
 			if err != nil {
 				return err
 			}
@@ -3316,358 +8525,248 @@ func (v *GetWorkflowExecutionRawHistoryV2Response) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryV2Response
+// String returns a readable string representation of a ResendReplicationTasksRequest
 // struct.
-func (v *GetWorkflowExecutionRawHistoryV2Response) String() string {
+func (v *ResendReplicationTasksRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [8]string
 	i := 0
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
 		i++
 	}
-	if v.HistoryBatches != nil {
-		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
 		i++
 	}
-	if v.VersionHistory != nil {
-		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
 		i++
 	}
-
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryV2Response{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.RemoteCluster != nil {
+		fields[i] = fmt.Sprintf("RemoteCluster: %v", *(v.RemoteCluster))
+		i++
 	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if v.StartEventID != nil {
+		fields[i] = fmt.Sprintf("StartEventID: %v", *(v.StartEventID))
+		i++
+	}
+	if v.StartVersion != nil {
+		fields[i] = fmt.Sprintf("StartVersion: %v", *(v.StartVersion))
+		i++
+	}
+	if v.EndEventID != nil {
+		fields[i] = fmt.Sprintf("EndEventID: %v", *(v.EndEventID))
+		i++
+	}
+	if v.EndVersion != nil {
+		fields[i] = fmt.Sprintf("EndVersion: %v", *(v.EndVersion))
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("ResendReplicationTasksRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryV2Response match the
-// provided GetWorkflowExecutionRawHistoryV2Response.
+// Equals returns true if all the fields of this ResendReplicationTasksRequest match the
+// provided ResendReplicationTasksRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryV2Response) Equals(rhs *GetWorkflowExecutionRawHistoryV2Response) bool {
+func (v *ResendReplicationTasksRequest) Equals(rhs *ResendReplicationTasksRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
 		return false
 	}
-	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
 		return false
 	}
-	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RemoteCluster, rhs.RemoteCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartEventID, rhs.StartEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartVersion, rhs.StartVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndEventID, rhs.EndEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndVersion, rhs.EndVersion) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DataBlob_Zapper []*shared.DataBlob
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DataBlob_Zapper.
-func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryV2Response.
-func (v *GetWorkflowExecutionRawHistoryV2Response) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResendReplicationTasksRequest.
+func (v *ResendReplicationTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
 	}
-	if v.HistoryBatches != nil {
-		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
 	}
-	if v.VersionHistory != nil {
-		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.RemoteCluster != nil {
+		enc.AddString("remoteCluster", *v.RemoteCluster)
+	}
+	if v.StartEventID != nil {
+		enc.AddInt64("startEventID", *v.StartEventID)
+	}
+	if v.StartVersion != nil {
+		enc.AddInt64("startVersion", *v.StartVersion)
+	}
+	if v.EndEventID != nil {
+		enc.AddInt64("endEventID", *v.EndEventID)
+	}
+	if v.EndVersion != nil {
+		enc.AddInt64("endVersion", *v.EndVersion)
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetDomainID returns the value of DomainID if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Response) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *ResendReplicationTasksRequest) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ResendReplicationTasksRequest) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
 }
 
-// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// GetWorkflowID returns the value of WorkflowID if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Response) GetHistoryBatches() (o []*shared.DataBlob) {
-	if v != nil && v.HistoryBatches != nil {
-		return v.HistoryBatches
+func (v *ResendReplicationTasksRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
 	}
 
 	return
 }
 
-// IsSetHistoryBatches returns true if HistoryBatches is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetHistoryBatches() bool {
-	return v != nil && v.HistoryBatches != nil
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ResendReplicationTasksRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
 }
 
-// GetVersionHistory returns the value of VersionHistory if it is set or its
+// GetRunID returns the value of RunID if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() (o *shared.VersionHistory) {
-	if v != nil && v.VersionHistory != nil {
-		return v.VersionHistory
+func (v *ResendReplicationTasksRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
 	}
 
 	return
 }
 
-// IsSetVersionHistory returns true if VersionHistory is not nil.
-func (v *GetWorkflowExecutionRawHistoryV2Response) IsSetVersionHistory() bool {
-	return v != nil && v.VersionHistory != nil
-}
-
-type HostInfo struct {
-	Identity *string `json:"Identity,omitempty"`
-}
-
-// ToWire translates a HostInfo struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *HostInfo) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a HostInfo struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a HostInfo struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v HostInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *HostInfo) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
+// IsSetRunID returns true if RunID is not nil.
+func (v *ResendReplicationTasksRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
 }
 
-// Encode serializes a HostInfo struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a HostInfo struct could not be encoded.
-func (v *HostInfo) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.Identity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Identity)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// GetRemoteCluster returns the value of RemoteCluster if it is set or its
+// zero value if it is unset.
+func (v *ResendReplicationTasksRequest) GetRemoteCluster() (o string) {
+	if v != nil && v.RemoteCluster != nil {
+		return *v.RemoteCluster
 	}
 
-	return sw.WriteStructEnd()
+	return
 }
 
-// Decode deserializes a HostInfo struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a HostInfo struct could not be generated from the wire
-// representation.
-func (v *HostInfo) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Identity = &x
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
+// IsSetRemoteCluster returns true if RemoteCluster is not nil.
+func (v *ResendReplicationTasksRequest) IsSetRemoteCluster() bool {
+	return v != nil && v.RemoteCluster != nil
+}
 
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
+// GetStartEventID returns the value of StartEventID if it is set or its
+// zero value if it is unset.
+func (v *ResendReplicationTasksRequest) GetStartEventID() (o int64) {
+	if v != nil && v.StartEventID != nil {
+		return *v.StartEventID
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
+	return
+}
 
-	return nil
+// IsSetStartEventID returns true if StartEventID is not nil.
+func (v *ResendReplicationTasksRequest) IsSetStartEventID() bool {
+	return v != nil && v.StartEventID != nil
 }
 
-// String returns a readable string representation of a HostInfo
-// struct.
-func (v *HostInfo) String() string {
-	if v == nil {
-		return "<nil>"
+// GetStartVersion returns the value of StartVersion if it is set or its
+// zero value if it is unset.
+func (v *ResendReplicationTasksRequest) GetStartVersion() (o int64) {
+	if v != nil && v.StartVersion != nil {
+		return *v.StartVersion
 	}
 
-	var fields [1]string
-	i := 0
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
+	return
+}
 
-	return fmt.Sprintf("HostInfo{%v}", strings.Join(fields[:i], ", "))
+// IsSetStartVersion returns true if StartVersion is not nil.
+func (v *ResendReplicationTasksRequest) IsSetStartVersion() bool {
+	return v != nil && v.StartVersion != nil
 }
 
-// Equals returns true if all the fields of this HostInfo match the
-// provided HostInfo.
-//
-// This function performs a deep comparison.
-func (v *HostInfo) Equals(rhs *HostInfo) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
+// GetEndEventID returns the value of EndEventID if it is set or its
+// zero value if it is unset.
+func (v *ResendReplicationTasksRequest) GetEndEventID() (o int64) {
+	if v != nil && v.EndEventID != nil {
+		return *v.EndEventID
 	}
 
-	return true
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HostInfo.
-func (v *HostInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.Identity != nil {
-		enc.AddString("Identity", *v.Identity)
-	}
-	return err
+// IsSetEndEventID returns true if EndEventID is not nil.
+func (v *ResendReplicationTasksRequest) IsSetEndEventID() bool {
+	return v != nil && v.EndEventID != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetEndVersion returns the value of EndVersion if it is set or its
 // zero value if it is unset.
-func (v *HostInfo) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *ResendReplicationTasksRequest) GetEndVersion() (o int64) {
+	if v != nil && v.EndVersion != nil {
+		return *v.EndVersion
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *HostInfo) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetEndVersion returns true if EndVersion is not nil.
+func (v *ResendReplicationTasksRequest) IsSetEndVersion() bool {
+	return v != nil && v.EndVersion != nil
 }
 
-type ListDynamicConfigRequest struct {
-	ConfigName *string `json:"configName,omitempty"`
+type RestoreDynamicConfigRequest struct {
+	ConfigName *string                       `json:"configName,omitempty"`
+	Filters    []*config.DynamicConfigFilter `json:"filters,omitempty"`
 }
 
-// ToWire translates a ListDynamicConfigRequest struct into a Thrift-level intermediate
+// ToWire translates a RestoreDynamicConfigRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3679,12 +8778,12 @@ type ListDynamicConfigRequest struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *RestoreDynamicConfigRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3698,15 +8797,23 @@ func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Filters != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigFilter_ValueList(v.Filters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListDynamicConfigRequest struct from its Thrift-level
+// FromWire deserializes a RestoreDynamicConfigRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListDynamicConfigRequest struct
+// An error is returned if we were unable to build a RestoreDynamicConfigRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3714,12 +8821,12 @@ func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ListDynamicConfigRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
+//	var v RestoreDynamicConfigRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *RestoreDynamicConfigRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3733,6 +8840,14 @@ func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Filters, err = _List_DynamicConfigFilter_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -3740,11 +8855,11 @@ func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ListDynamicConfigRequest struct directly into bytes, without going
+// Encode serializes a RestoreDynamicConfigRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListDynamicConfigRequest struct could not be encoded.
-func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RestoreDynamicConfigRequest struct could not be encoded.
+func (v *RestoreDynamicConfigRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -3761,15 +8876,27 @@ func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Filters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DynamicConfigFilter_Encode(v.Filters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ListDynamicConfigRequest struct directly from its Thrift-level
+// Decode deserializes a RestoreDynamicConfigRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListDynamicConfigRequest struct could not be generated from the wire
+// An error is returned if a RestoreDynamicConfigRequest struct could not be generated from the wire
 // representation.
-func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
+func (v *RestoreDynamicConfigRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -3790,6 +8917,12 @@ func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Filters, err = _List_DynamicConfigFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -3812,28 +8945,32 @@ func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListDynamicConfigRequest
+// String returns a readable string representation of a RestoreDynamicConfigRequest
 // struct.
-func (v *ListDynamicConfigRequest) String() string {
+func (v *RestoreDynamicConfigRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.ConfigName != nil {
 		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
 		i++
 	}
+	if v.Filters != nil {
+		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
+		i++
+	}
 
-	return fmt.Sprintf("ListDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RestoreDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListDynamicConfigRequest match the
-// provided ListDynamicConfigRequest.
+// Equals returns true if all the fields of this RestoreDynamicConfigRequest match the
+// provided RestoreDynamicConfigRequest.
 //
 // This function performs a deep comparison.
-func (v *ListDynamicConfigRequest) Equals(rhs *ListDynamicConfigRequest) bool {
+func (v *RestoreDynamicConfigRequest) Equals(rhs *RestoreDynamicConfigRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3842,25 +8979,31 @@ func (v *ListDynamicConfigRequest) Equals(rhs *ListDynamicConfigRequest) bool {
 	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
 		return false
 	}
+	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_DynamicConfigFilter_Equals(v.Filters, rhs.Filters))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListDynamicConfigRequest.
-func (v *ListDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RestoreDynamicConfigRequest.
+func (v *RestoreDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.ConfigName != nil {
 		enc.AddString("configName", *v.ConfigName)
 	}
+	if v.Filters != nil {
+		err = multierr.Append(err, enc.AddArray("filters", (_List_DynamicConfigFilter_Zapper)(v.Filters)))
+	}
 	return err
 }
 
 // GetConfigName returns the value of ConfigName if it is set or its
 // zero value if it is unset.
-func (v *ListDynamicConfigRequest) GetConfigName() (o string) {
+func (v *RestoreDynamicConfigRequest) GetConfigName() (o string) {
 	if v != nil && v.ConfigName != nil {
 		return *v.ConfigName
 	}
@@ -3869,20 +9012,37 @@ func (v *ListDynamicConfigRequest) GetConfigName() (o string) {
 }
 
 // IsSetConfigName returns true if ConfigName is not nil.
-func (v *ListDynamicConfigRequest) IsSetConfigName() bool {
+func (v *RestoreDynamicConfigRequest) IsSetConfigName() bool {
 	return v != nil && v.ConfigName != nil
 }
 
-type ListDynamicConfigResponse struct {
-	Entries []*config.DynamicConfigEntry `json:"entries,omitempty"`
+// GetFilters returns the value of Filters if it is set or its
+// zero value if it is unset.
+func (v *RestoreDynamicConfigRequest) GetFilters() (o []*config.DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+
+	return
 }
 
-type _List_DynamicConfigEntry_ValueList []*config.DynamicConfigEntry
+// IsSetFilters returns true if Filters is not nil.
+func (v *RestoreDynamicConfigRequest) IsSetFilters() bool {
+	return v != nil && v.Filters != nil
+}
 
-func (v _List_DynamicConfigEntry_ValueList) ForEach(f func(wire.Value) error) error {
+type RingInfo struct {
+	Role        *string     `json:"role,omitempty"`
+	MemberCount *int32      `json:"memberCount,omitempty"`
+	Members     []*HostInfo `json:"members,omitempty"`
+}
+
+type _List_HostInfo_ValueList []*HostInfo
+
+func (v _List_HostInfo_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigEntry', index [%v]: value is nil", i)
+			return fmt.Errorf("invalid list '[]*HostInfo', index [%v]: value is nil", i)
 		}
 		w, err := x.ToWire()
 		if err != nil {
@@ -3896,17 +9056,17 @@ func (v _List_DynamicConfigEntry_ValueList) ForEach(f func(wire.Value) error) er
 	return nil
 }
 
-func (v _List_DynamicConfigEntry_ValueList) Size() int {
+func (v _List_HostInfo_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_DynamicConfigEntry_ValueList) ValueType() wire.Type {
+func (_List_HostInfo_ValueList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_DynamicConfigEntry_ValueList) Close() {}
+func (_List_HostInfo_ValueList) Close() {}
 
-// ToWire translates a ListDynamicConfigResponse struct into a Thrift-level intermediate
+// ToWire translates a RingInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3918,43 +9078,53 @@ func (_List_DynamicConfigEntry_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ListDynamicConfigResponse) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *RingInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Entries != nil {
-		w, err = wire.NewValueList(_List_DynamicConfigEntry_ValueList(v.Entries)), error(nil)
+	if v.Role != nil {
+		w, err = wire.NewValueString(*(v.Role)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _DynamicConfigEntry_Read(w wire.Value) (*config.DynamicConfigEntry, error) {
-	var v config.DynamicConfigEntry
-	err := v.FromWire(w)
-	return &v, err
+	if v.MemberCount != nil {
+		w, err = wire.NewValueI32(*(v.MemberCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Members != nil {
+		w, err = wire.NewValueList(_List_HostInfo_ValueList(v.Members)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_DynamicConfigEntry_Read(l wire.ValueList) ([]*config.DynamicConfigEntry, error) {
+func _List_HostInfo_Read(l wire.ValueList) ([]*HostInfo, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]*config.DynamicConfigEntry, 0, l.Size())
+	o := make([]*HostInfo, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DynamicConfigEntry_Read(x)
+		i, err := _HostInfo_Read(x)
 		if err != nil {
 			return err
 		}
@@ -3965,11 +9135,11 @@ func _List_DynamicConfigEntry_Read(l wire.ValueList) ([]*config.DynamicConfigEnt
 	return o, err
 }
 
-// FromWire deserializes a ListDynamicConfigResponse struct from its Thrift-level
+// FromWire deserializes a RingInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListDynamicConfigResponse struct
+// An error is returned if we were unable to build a RingInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3977,19 +9147,39 @@ func _List_DynamicConfigEntry_Read(l wire.ValueList) ([]*config.DynamicConfigEnt
 //     return nil, err
 //   }
 //
-//   var v ListDynamicConfigResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ListDynamicConfigResponse) FromWire(w wire.Value) error {
+//	var v RingInfo
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *RingInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Role = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MemberCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TList {
-				v.Entries, err = _List_DynamicConfigEntry_Read(field.Value.GetList())
+				v.Members, err = _List_HostInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -4001,7 +9191,7 @@ func (v *ListDynamicConfigResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_DynamicConfigEntry_Encode(val []*config.DynamicConfigEntry, sw stream.Writer) error {
+func _List_HostInfo_Encode(val []*HostInfo, sw stream.Writer) error {
 
 	lh := stream.ListHeader{
 		Type:   wire.TStruct,
@@ -4013,7 +9203,7 @@ func _List_DynamicConfigEntry_Encode(val []*config.DynamicConfigEntry, sw stream
 
 	for i, v := range val {
 		if v == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigEntry', index [%v]: value is nil", i)
+			return fmt.Errorf("invalid list '[]*HostInfo', index [%v]: value is nil", i)
 		}
 		if err := v.Encode(sw); err != nil {
 			return err
@@ -4022,20 +9212,20 @@ func _List_DynamicConfigEntry_Encode(val []*config.DynamicConfigEntry, sw stream
 	return sw.WriteListEnd()
 }
 
-// Encode serializes a ListDynamicConfigResponse struct directly into bytes, without going
+// Encode serializes a RingInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListDynamicConfigResponse struct could not be encoded.
-func (v *ListDynamicConfigResponse) Encode(sw stream.Writer) error {
+// An error is returned if a RingInfo struct could not be encoded.
+func (v *RingInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Entries != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+	if v.Role != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := _List_DynamicConfigEntry_Encode(v.Entries, sw); err != nil {
+		if err := sw.WriteString(*(v.Role)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4043,16 +9233,34 @@ func (v *ListDynamicConfigResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.MemberCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MemberCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _DynamicConfigEntry_Decode(sr stream.Reader) (*config.DynamicConfigEntry, error) {
-	var v config.DynamicConfigEntry
-	err := v.Decode(sr)
-	return &v, err
+	if v.Members != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_HostInfo_Encode(v.Members, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-func _List_DynamicConfigEntry_Decode(sr stream.Reader) ([]*config.DynamicConfigEntry, error) {
+func _List_HostInfo_Decode(sr stream.Reader) ([]*HostInfo, error) {
 	lh, err := sr.ReadListBegin()
 	if err != nil {
 		return nil, err
@@ -4067,9 +9275,9 @@ func _List_DynamicConfigEntry_Decode(sr stream.Reader) ([]*config.DynamicConfigE
 		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*config.DynamicConfigEntry, 0, lh.Length)
+	o := make([]*HostInfo, 0, lh.Length)
 	for i := 0; i < lh.Length; i++ {
-		v, err := _DynamicConfigEntry_Decode(sr)
+		v, err := _HostInfo_Decode(sr)
 		if err != nil {
 			return nil, err
 		}
@@ -4082,12 +9290,12 @@ func _List_DynamicConfigEntry_Decode(sr stream.Reader) ([]*config.DynamicConfigE
 	return o, err
 }
 
-// Decode deserializes a ListDynamicConfigResponse struct directly from its Thrift-level
+// Decode deserializes a RingInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListDynamicConfigResponse struct could not be generated from the wire
+// An error is returned if a RingInfo struct could not be generated from the wire
 // representation.
-func (v *ListDynamicConfigResponse) Decode(sr stream.Reader) error {
+func (v *RingInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4100,8 +9308,24 @@ func (v *ListDynamicConfigResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.Entries, err = _List_DynamicConfigEntry_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Role = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MemberCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.Members, err = _List_HostInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4128,24 +9352,32 @@ func (v *ListDynamicConfigResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListDynamicConfigResponse
+// String returns a readable string representation of a RingInfo
 // struct.
-func (v *ListDynamicConfigResponse) String() string {
+func (v *RingInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	if v.Entries != nil {
-		fields[i] = fmt.Sprintf("Entries: %v", v.Entries)
+	if v.Role != nil {
+		fields[i] = fmt.Sprintf("Role: %v", *(v.Role))
+		i++
+	}
+	if v.MemberCount != nil {
+		fields[i] = fmt.Sprintf("MemberCount: %v", *(v.MemberCount))
+		i++
+	}
+	if v.Members != nil {
+		fields[i] = fmt.Sprintf("Members: %v", v.Members)
 		i++
 	}
 
-	return fmt.Sprintf("ListDynamicConfigResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RingInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_DynamicConfigEntry_Equals(lhs, rhs []*config.DynamicConfigEntry) bool {
+func _List_HostInfo_Equals(lhs, rhs []*HostInfo) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
@@ -4160,28 +9392,34 @@ func _List_DynamicConfigEntry_Equals(lhs, rhs []*config.DynamicConfigEntry) bool
 	return true
 }
 
-// Equals returns true if all the fields of this ListDynamicConfigResponse match the
-// provided ListDynamicConfigResponse.
+// Equals returns true if all the fields of this RingInfo match the
+// provided RingInfo.
 //
 // This function performs a deep comparison.
-func (v *ListDynamicConfigResponse) Equals(rhs *ListDynamicConfigResponse) bool {
+func (v *RingInfo) Equals(rhs *RingInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Entries == nil && rhs.Entries == nil) || (v.Entries != nil && rhs.Entries != nil && _List_DynamicConfigEntry_Equals(v.Entries, rhs.Entries))) {
+	if !_String_EqualsPtr(v.Role, rhs.Role) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MemberCount, rhs.MemberCount) {
+		return false
+	}
+	if !((v.Members == nil && rhs.Members == nil) || (v.Members != nil && rhs.Members != nil && _List_HostInfo_Equals(v.Members, rhs.Members))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DynamicConfigEntry_Zapper []*config.DynamicConfigEntry
+type _List_HostInfo_Zapper []*HostInfo
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DynamicConfigEntry_Zapper.
-func (l _List_DynamicConfigEntry_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_HostInfo_Zapper.
+func (l _List_HostInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
 		err = multierr.Append(err, enc.AppendObject(v))
 	}
@@ -4189,70 +9427,79 @@ func (l _List_DynamicConfigEntry_Zapper) MarshalLogArray(enc zapcore.ArrayEncode
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListDynamicConfigResponse.
-func (v *ListDynamicConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RingInfo.
+func (v *RingInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Entries != nil {
-		err = multierr.Append(err, enc.AddArray("entries", (_List_DynamicConfigEntry_Zapper)(v.Entries)))
+	if v.Role != nil {
+		enc.AddString("role", *v.Role)
+	}
+	if v.MemberCount != nil {
+		enc.AddInt32("memberCount", *v.MemberCount)
+	}
+	if v.Members != nil {
+		err = multierr.Append(err, enc.AddArray("members", (_List_HostInfo_Zapper)(v.Members)))
 	}
 	return err
 }
 
-// GetEntries returns the value of Entries if it is set or its
+// GetRole returns the value of Role if it is set or its
 // zero value if it is unset.
-func (v *ListDynamicConfigResponse) GetEntries() (o []*config.DynamicConfigEntry) {
-	if v != nil && v.Entries != nil {
-		return v.Entries
+func (v *RingInfo) GetRole() (o string) {
+	if v != nil && v.Role != nil {
+		return *v.Role
 	}
 
 	return
 }
 
-// IsSetEntries returns true if Entries is not nil.
-func (v *ListDynamicConfigResponse) IsSetEntries() bool {
-	return v != nil && v.Entries != nil
+// IsSetRole returns true if Role is not nil.
+func (v *RingInfo) IsSetRole() bool {
+	return v != nil && v.Role != nil
 }
 
-type MembershipInfo struct {
-	CurrentHost      *HostInfo   `json:"currentHost,omitempty"`
-	ReachableMembers []string    `json:"reachableMembers,omitempty"`
-	Rings            []*RingInfo `json:"rings,omitempty"`
+// GetMemberCount returns the value of MemberCount if it is set or its
+// zero value if it is unset.
+func (v *RingInfo) GetMemberCount() (o int32) {
+	if v != nil && v.MemberCount != nil {
+		return *v.MemberCount
+	}
+
+	return
 }
 
-type _List_String_ValueList []string
+// IsSetMemberCount returns true if MemberCount is not nil.
+func (v *RingInfo) IsSetMemberCount() bool {
+	return v != nil && v.MemberCount != nil
+}
 
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetMembers returns the value of Members if it is set or its
+// zero value if it is unset.
+func (v *RingInfo) GetMembers() (o []*HostInfo) {
+	if v != nil && v.Members != nil {
+		return v.Members
 	}
-	return nil
-}
 
-func (v _List_String_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+// IsSetMembers returns true if Members is not nil.
+func (v *RingInfo) IsSetMembers() bool {
+	return v != nil && v.Members != nil
 }
 
-func (_List_String_ValueList) Close() {}
+type UpdateDynamicConfigRequest struct {
+	ConfigName   *string                      `json:"configName,omitempty"`
+	ConfigValues []*config.DynamicConfigValue `json:"configValues,omitempty"`
+}
 
-type _List_RingInfo_ValueList []*RingInfo
+type _List_DynamicConfigValue_ValueList []*config.DynamicConfigValue
 
-func (v _List_RingInfo_ValueList) ForEach(f func(wire.Value) error) error {
+func (v _List_DynamicConfigValue_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
-			return fmt.Errorf("invalid list '[]*RingInfo', index [%v]: value is nil", i)
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigValue', index [%v]: value is nil", i)
 		}
 		w, err := x.ToWire()
 		if err != nil {
@@ -4266,17 +9513,17 @@ func (v _List_RingInfo_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_RingInfo_ValueList) Size() int {
+func (v _List_DynamicConfigValue_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_RingInfo_ValueList) ValueType() wire.Type {
+func (_List_DynamicConfigValue_ValueList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_RingInfo_ValueList) Close() {}
+func (_List_DynamicConfigValue_ValueList) Close() {}
 
-// ToWire translates a MembershipInfo struct into a Thrift-level intermediate
+// ToWire translates a UpdateDynamicConfigRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4288,83 +9535,51 @@ func (_List_RingInfo_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *MembershipInfo) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *UpdateDynamicConfigRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.CurrentHost != nil {
-		w, err = v.CurrentHost.ToWire()
+	if v.ConfigName != nil {
+		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ReachableMembers != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.ReachableMembers)), error(nil)
+	if v.ConfigValues != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigValue_ValueList(v.ConfigValues)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Rings != nil {
-		w, err = wire.NewValueList(_List_RingInfo_ValueList(v.Rings)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HostInfo_Read(w wire.Value) (*HostInfo, error) {
-	var v HostInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _RingInfo_Read(w wire.Value) (*RingInfo, error) {
-	var v RingInfo
+func _DynamicConfigValue_Read(w wire.Value) (*config.DynamicConfigValue, error) {
+	var v config.DynamicConfigValue
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_RingInfo_Read(l wire.ValueList) ([]*RingInfo, error) {
+func _List_DynamicConfigValue_Read(l wire.ValueList) ([]*config.DynamicConfigValue, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]*RingInfo, 0, l.Size())
+	o := make([]*config.DynamicConfigValue, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _RingInfo_Read(x)
+		i, err := _DynamicConfigValue_Read(x)
 		if err != nil {
 			return err
 		}
@@ -4375,11 +9590,11 @@ func _List_RingInfo_Read(l wire.ValueList) ([]*RingInfo, error) {
 	return o, err
 }
 
-// FromWire deserializes a MembershipInfo struct from its Thrift-level
+// FromWire deserializes a UpdateDynamicConfigRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MembershipInfo struct
+// An error is returned if we were unable to build a UpdateDynamicConfigRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4387,19 +9602,21 @@ func _List_RingInfo_Read(l wire.ValueList) ([]*RingInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v MembershipInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *MembershipInfo) FromWire(w wire.Value) error {
+//	var v UpdateDynamicConfigRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *UpdateDynamicConfigRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.CurrentHost, err = _HostInfo_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ConfigName = &x
 				if err != nil {
 					return err
 				}
@@ -4407,15 +9624,7 @@ func (v *MembershipInfo) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TList {
-				v.ReachableMembers, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TList {
-				v.Rings, err = _List_RingInfo_Read(field.Value.GetList())
+				v.ConfigValues, err = _List_DynamicConfigValue_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -4427,25 +9636,7 @@ func (v *MembershipInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_String_Encode(val []string, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TBinary,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for _, v := range val {
-		if err := sw.WriteString(v); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-func _List_RingInfo_Encode(val []*RingInfo, sw stream.Writer) error {
+func _List_DynamicConfigValue_Encode(val []*config.DynamicConfigValue, sw stream.Writer) error {
 
 	lh := stream.ListHeader{
 		Type:   wire.TStruct,
@@ -4457,7 +9648,7 @@ func _List_RingInfo_Encode(val []*RingInfo, sw stream.Writer) error {
 
 	for i, v := range val {
 		if v == nil {
-			return fmt.Errorf("invalid list '[]*RingInfo', index [%v]: value is nil", i)
+			return fmt.Errorf("invalid list '[]*config.DynamicConfigValue', index [%v]: value is nil", i)
 		}
 		if err := v.Encode(sw); err != nil {
 			return err
@@ -4466,20 +9657,20 @@ func _List_RingInfo_Encode(val []*RingInfo, sw stream.Writer) error {
 	return sw.WriteListEnd()
 }
 
-// Encode serializes a MembershipInfo struct directly into bytes, without going
+// Encode serializes a UpdateDynamicConfigRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MembershipInfo struct could not be encoded.
-func (v *MembershipInfo) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateDynamicConfigRequest struct could not be encoded.
+func (v *UpdateDynamicConfigRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.CurrentHost != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+	if v.ConfigName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.CurrentHost.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.ConfigName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4487,23 +9678,11 @@ func (v *MembershipInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ReachableMembers != nil {
+	if v.ConfigValues != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := _List_String_Encode(v.ReachableMembers, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Rings != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_RingInfo_Encode(v.Rings, sw); err != nil {
+		if err := _List_DynamicConfigValue_Encode(v.ConfigValues, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4514,49 +9693,13 @@ func (v *MembershipInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _HostInfo_Decode(sr stream.Reader) (*HostInfo, error) {
-	var v HostInfo
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_String_Decode(sr stream.Reader) ([]string, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TBinary {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]string, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-func _RingInfo_Decode(sr stream.Reader) (*RingInfo, error) {
-	var v RingInfo
+func _DynamicConfigValue_Decode(sr stream.Reader) (*config.DynamicConfigValue, error) {
+	var v config.DynamicConfigValue
 	err := v.Decode(sr)
 	return &v, err
 }
 
-func _List_RingInfo_Decode(sr stream.Reader) ([]*RingInfo, error) {
+func _List_DynamicConfigValue_Decode(sr stream.Reader) ([]*config.DynamicConfigValue, error) {
 	lh, err := sr.ReadListBegin()
 	if err != nil {
 		return nil, err
@@ -4571,9 +9714,9 @@ func _List_RingInfo_Decode(sr stream.Reader) ([]*RingInfo, error) {
 		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*RingInfo, 0, lh.Length)
+	o := make([]*config.DynamicConfigValue, 0, lh.Length)
 	for i := 0; i < lh.Length; i++ {
-		v, err := _RingInfo_Decode(sr)
+		v, err := _DynamicConfigValue_Decode(sr)
 		if err != nil {
 			return nil, err
 		}
@@ -4586,12 +9729,12 @@ func _List_RingInfo_Decode(sr stream.Reader) ([]*RingInfo, error) {
 	return o, err
 }
 
-// Decode deserializes a MembershipInfo struct directly from its Thrift-level
+// Decode deserializes a UpdateDynamicConfigRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MembershipInfo struct could not be generated from the wire
+// An error is returned if a UpdateDynamicConfigRequest struct could not be generated from the wire
 // representation.
-func (v *MembershipInfo) Decode(sr stream.Reader) error {
+func (v *UpdateDynamicConfigRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4604,20 +9747,16 @@ func (v *MembershipInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TStruct:
-			v.CurrentHost, err = _HostInfo_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ConfigName = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TList:
-			v.ReachableMembers, err = _List_String_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TList:
-			v.Rings, err = _List_RingInfo_Decode(sr)
+			v.ConfigValues, err = _List_DynamicConfigValue_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4644,47 +9783,28 @@ func (v *MembershipInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MembershipInfo
+// String returns a readable string representation of a UpdateDynamicConfigRequest
 // struct.
-func (v *MembershipInfo) String() string {
+func (v *UpdateDynamicConfigRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.CurrentHost != nil {
-		fields[i] = fmt.Sprintf("CurrentHost: %v", v.CurrentHost)
-		i++
-	}
-	if v.ReachableMembers != nil {
-		fields[i] = fmt.Sprintf("ReachableMembers: %v", v.ReachableMembers)
+	if v.ConfigName != nil {
+		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
 		i++
 	}
-	if v.Rings != nil {
-		fields[i] = fmt.Sprintf("Rings: %v", v.Rings)
+	if v.ConfigValues != nil {
+		fields[i] = fmt.Sprintf("ConfigValues: %v", v.ConfigValues)
 		i++
 	}
 
-	return fmt.Sprintf("MembershipInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("UpdateDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_RingInfo_Equals(lhs, rhs []*RingInfo) bool {
+func _List_DynamicConfigValue_Equals(lhs, rhs []*config.DynamicConfigValue) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
@@ -4699,45 +9819,31 @@ func _List_RingInfo_Equals(lhs, rhs []*RingInfo) bool {
 	return true
 }
 
-// Equals returns true if all the fields of this MembershipInfo match the
-// provided MembershipInfo.
+// Equals returns true if all the fields of this UpdateDynamicConfigRequest match the
+// provided UpdateDynamicConfigRequest.
 //
 // This function performs a deep comparison.
-func (v *MembershipInfo) Equals(rhs *MembershipInfo) bool {
+func (v *UpdateDynamicConfigRequest) Equals(rhs *UpdateDynamicConfigRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.CurrentHost == nil && rhs.CurrentHost == nil) || (v.CurrentHost != nil && rhs.CurrentHost != nil && v.CurrentHost.Equals(rhs.CurrentHost))) {
-		return false
-	}
-	if !((v.ReachableMembers == nil && rhs.ReachableMembers == nil) || (v.ReachableMembers != nil && rhs.ReachableMembers != nil && _List_String_Equals(v.ReachableMembers, rhs.ReachableMembers))) {
-		return false
-	}
-	if !((v.Rings == nil && rhs.Rings == nil) || (v.Rings != nil && rhs.Rings != nil && _List_RingInfo_Equals(v.Rings, rhs.Rings))) {
+	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
 		return false
 	}
-
-	return true
-}
-
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
+	if !((v.ConfigValues == nil && rhs.ConfigValues == nil) || (v.ConfigValues != nil && rhs.ConfigValues != nil && _List_DynamicConfigValue_Equals(v.ConfigValues, rhs.ConfigValues))) {
+		return false
 	}
-	return err
+
+	return true
 }
 
-type _List_RingInfo_Zapper []*RingInfo
+type _List_DynamicConfigValue_Zapper []*config.DynamicConfigValue
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_RingInfo_Zapper.
-func (l _List_RingInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_DynamicConfigValue_Zapper.
+func (l _List_DynamicConfigValue_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
 		err = multierr.Append(err, enc.AppendObject(v))
 	}
@@ -4745,74 +9851,74 @@ func (l _List_RingInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err er
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MembershipInfo.
-func (v *MembershipInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateDynamicConfigRequest.
+func (v *UpdateDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.CurrentHost != nil {
-		err = multierr.Append(err, enc.AddObject("currentHost", v.CurrentHost))
-	}
-	if v.ReachableMembers != nil {
-		err = multierr.Append(err, enc.AddArray("reachableMembers", (_List_String_Zapper)(v.ReachableMembers)))
+	if v.ConfigName != nil {
+		enc.AddString("configName", *v.ConfigName)
 	}
-	if v.Rings != nil {
-		err = multierr.Append(err, enc.AddArray("rings", (_List_RingInfo_Zapper)(v.Rings)))
+	if v.ConfigValues != nil {
+		err = multierr.Append(err, enc.AddArray("configValues", (_List_DynamicConfigValue_Zapper)(v.ConfigValues)))
 	}
 	return err
 }
 
-// GetCurrentHost returns the value of CurrentHost if it is set or its
+// GetConfigName returns the value of ConfigName if it is set or its
 // zero value if it is unset.
-func (v *MembershipInfo) GetCurrentHost() (o *HostInfo) {
-	if v != nil && v.CurrentHost != nil {
-		return v.CurrentHost
+func (v *UpdateDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil && v.ConfigName != nil {
+		return *v.ConfigName
 	}
 
 	return
 }
 
-// IsSetCurrentHost returns true if CurrentHost is not nil.
-func (v *MembershipInfo) IsSetCurrentHost() bool {
-	return v != nil && v.CurrentHost != nil
+// IsSetConfigName returns true if ConfigName is not nil.
+func (v *UpdateDynamicConfigRequest) IsSetConfigName() bool {
+	return v != nil && v.ConfigName != nil
 }
 
-// GetReachableMembers returns the value of ReachableMembers if it is set or its
+// GetConfigValues returns the value of ConfigValues if it is set or its
 // zero value if it is unset.
-func (v *MembershipInfo) GetReachableMembers() (o []string) {
-	if v != nil && v.ReachableMembers != nil {
-		return v.ReachableMembers
+func (v *UpdateDynamicConfigRequest) GetConfigValues() (o []*config.DynamicConfigValue) {
+	if v != nil && v.ConfigValues != nil {
+		return v.ConfigValues
 	}
 
 	return
 }
 
-// IsSetReachableMembers returns true if ReachableMembers is not nil.
-func (v *MembershipInfo) IsSetReachableMembers() bool {
-	return v != nil && v.ReachableMembers != nil
+// IsSetConfigValues returns true if ConfigValues is not nil.
+func (v *UpdateDynamicConfigRequest) IsSetConfigValues() bool {
+	return v != nil && v.ConfigValues != nil
 }
 
-// GetRings returns the value of Rings if it is set or its
-// zero value if it is unset.
-func (v *MembershipInfo) GetRings() (o []*RingInfo) {
-	if v != nil && v.Rings != nil {
-		return v.Rings
-	}
-
-	return
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "admin",
+	Package:  "github.com/uber/cadence/.gen/go/admin",
+	FilePath: "admin.thrift",
+	SHA1:     "c55a54edb5f6bb536c0a4f0cb8dc25d3f082f5e2",
+	Includes: []*thriftreflect.ThriftModule{
+		config.ThriftModule,
+		replicator.ThriftModule,
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
 }
 
-// IsSetRings returns true if Rings is not nil.
-func (v *MembershipInfo) IsSetRings() bool {
-	return v != nil && v.Rings != nil
-}
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\ninclude \"config.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privilege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeShardDistribution returns information about history shards within the cluster\n  **/\n  shared.DescribeShardDistributionResponse DescribeShardDistribution(1: shared.DescribeShardDistributionRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void ResetQueue(1: shared.ResetQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  * StartEventId defines the beginning of the event to fetch. The first event is inclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\n  GetWorkflowExecutionRawHistoryV2Response GetWorkflowExecutionRawHistoryV2(1: GetWorkflowExecutionRawHistoryV2Request getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReapplyEvents applies stale events to the current workflow and current run\n  **/\n  void ReapplyEvents(1: shared.ReapplyEventsRequest reapplyEventsRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeCluster returns information about cadence cluster\n  **/\n  DescribeClusterResponse DescribeCluster()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReadDLQMessages returns messages from DLQ\n  **/\n  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PurgeDLQMessages purges messages from DLQ\n  **/\n  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * MergeDLQMessages merges messages from DLQ\n  **/\n  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * OperatePendingActivity updates the options of, retries, fails or skips a pending activity of a workflow,\n  * the operation is recorded in the workflow history\n  **/\n  void OperatePendingActivity(1: shared.OperatePendingActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n      5: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster\n  **/\n  void ResendReplicationTasks(1: ResendReplicationTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * GetCrossClusterTasks fetches cross cluster tasks\n  **/\n  shared.GetCrossClusterTasksResponse GetCrossClusterTasks(1: shared.GetCrossClusterTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetReplicationStatus returns the replication status of shards from the source cluster to the target\n  * cluster. Sent to the source cluster, it combines the replication queue of the source cluster with the\n  * replication DLQ and task processor of the target cluster, sent to the target cluster it only returns the\n  * status of the target cluster.\n  **/\n  replicator.GetReplicationStatusResponse GetReplicationStatus(1: GetReplicationStatusRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetFailoverReadiness checks whether the current cluster caught up with the replication of a domain from\n  * its active cluster, so that failing the domain over to the current cluster does not force conflict resolution\n  **/\n  GetFailoverReadinessResponse GetFailoverReadiness(1: GetFailoverReadinessRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondCrossClusterTasksCompleted responds the result of processing cross cluster tasks\n  **/\n  shared.RespondCrossClusterTasksCompletedResponse RespondCrossClusterTasksCompleted(1: shared.RespondCrossClusterTasksCompletedRequest request) \n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetDynamicConfig returns values associated with a specified dynamic config parameter.\n  **/\n  GetDynamicConfigResponse GetDynamicConfig(1: GetDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void UpdateDynamicConfig(1: UpdateDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void RestoreDynamicConfig(1: RestoreDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  ListDynamicConfigResponse ListDynamicConfig(1: ListDynamicConfigRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  /**\n  * MoveTaskList moves a batch of backlog tasks of all partitions of a task list to another task list.\n  * Tasks are moved by the matching hosts owning the source partitions, preserving schedule to start deadlines.\n  **/\n  shared.MoveTaskListResponse MoveTaskList(1: shared.MoveTaskListRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\n/**\n  * StartEventId defines the beginning of the event to fetch. The first event is exclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\nstruct GetWorkflowExecutionRawHistoryV2Request {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") startEventId\n  40: optional i64 (js.type = \"Long\") startEventVersion\n  50: optional i64 (js.type = \"Long\") endEventId\n  60: optional i64 (js.type = \"Long\") endEventVersion\n  70: optional i32 maximumPageSize\n  80: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryV2Response {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional shared.VersionHistory versionHistory\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n  20: optional string securityToken\n}\n\nstruct HostInfo {\n  10: optional string Identity\n}\n\nstruct RingInfo {\n  10: optional string role\n  20: optional i32 memberCount\n  30: optional list<HostInfo> members\n}\n\nstruct MembershipInfo {\n  10: optional HostInfo currentHost\n  20: optional list<string> reachableMembers\n  30: optional list<RingInfo> rings\n}\n\nstruct PersistenceSetting {\n  10: optional string key\n  20: optional string value\n}\n\nstruct PersistenceFeature {\n  10: optional string key\n  20: optional bool enabled\n}\n\nstruct PersistenceInfo {\n  10: optional string backend\n  20: optional list<PersistenceSetting> settings\n  30: optional list<PersistenceFeature> features\n}\n\n// persistence requests and failures served by the host in the recent window\nstruct PersistenceHealth {\n  10: optional i64 requests\n  20: optional i64 failures\n  30: optional i32 windowInSeconds\n}\n\nstruct DescribeClusterResponse {\n  10: optional shared.SupportedClientVersions supportedClientVersions\n  20: optional MembershipInfo membershipInfo\n  30: optional map<string,PersistenceInfo> persistenceInfo\n  40: optional PersistenceHealth persistenceHealth\n}\n\nstruct GetReplicationStatusRequest {\n  // the current cluster if not set\n  10: optional string sourceCluster\n  20: optional string targetCluster\n  // all the shards if not set\n  30: optional list<i32> shardIDs\n}\n\nstruct GetFailoverReadinessRequest {\n  10: optional string domain\n  // maximum replication lag of any shard\n  20: optional i32 maxReplicationLagInSeconds\n  // period during which replication failures of the domain are considered recent\n  30: optional i32 replicationErrorWindowInSeconds\n}\n\nstruct FailoverReadinessCheck {\n  10: optional string name\n  20: optional bool passed\n  30: optional string message\n}\n\nstruct GetFailoverReadinessResponse {\n  10: optional string domainID\n  20: optional string cluster\n  30: optional string sourceCluster\n  40: optional bool ready\n  50: optional list<FailoverReadinessCheck> checks\n}\n\nstruct ResendReplicationTasksRequest {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string remoteCluster\n  50: optional i64 (js.type = \"Long\") startEventID\n  60: optional i64 (js.type = \"Long\") startVersion\n  70: optional i64 (js.type = \"Long\") endEventID\n  80: optional i64 (js.type = \"Long\") endVersion\n}\n\nstruct GetDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct GetDynamicConfigResponse {\n  10: optional shared.DataBlob value\n}\n\nstruct UpdateDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigValue> configValues\n}\n\nstruct RestoreDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\n//Eventually remove configName and integrate this functionality into Get.\n//GetDynamicConfigResponse would need to change as well.\nstruct ListDynamicConfigRequest {\n  10: optional string configName\n}\n\nstruct ListDynamicConfigResponse {\n  10: optional list<config.DynamicConfigEntry> entries\n}\n\n"
 
-type PersistenceFeature struct {
-	Key     *string `json:"key,omitempty"`
-	Enabled *bool   `json:"enabled,omitempty"`
+// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+//
+// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Args struct {
+	Request *AddSearchAttributeRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a PersistenceFeature struct into a Thrift-level intermediate
+// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4824,42 +9930,40 @@ type PersistenceFeature struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PersistenceFeature) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Key != nil {
-		w, err = wire.NewValueString(*(v.Key)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Enabled != nil {
-		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PersistenceFeature struct from its Thrift-level
+func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PersistenceFeature struct
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4867,31 +9971,19 @@ func (v *PersistenceFeature) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PersistenceFeature
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PersistenceFeature) FromWire(w wire.Value) error {
+//	var v AdminService_AddSearchAttribute_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Key = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.Enabled = &x
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4903,32 +9995,20 @@ func (v *PersistenceFeature) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PersistenceFeature struct directly into bytes, without going
+// Encode serializes a AdminService_AddSearchAttribute_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PersistenceFeature struct could not be encoded.
-func (v *PersistenceFeature) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_AddSearchAttribute_Args struct could not be encoded.
+func (v *AdminService_AddSearchAttribute_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Key != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Key)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Enabled != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.Enabled)); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4939,12 +10019,18 @@ func (v *PersistenceFeature) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a PersistenceFeature struct directly from its Thrift-level
+func _AddSearchAttributeRequest_Decode(sr stream.Reader) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_AddSearchAttribute_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PersistenceFeature struct could not be generated from the wire
+// An error is returned if a AdminService_AddSearchAttribute_Args struct could not be generated from the wire
 // representation.
-func (v *PersistenceFeature) Decode(sr stream.Reader) error {
+func (v *AdminService_AddSearchAttribute_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4957,18 +10043,8 @@ func (v *PersistenceFeature) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Key = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.Enabled = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _AddSearchAttributeRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4995,51 +10071,34 @@ func (v *PersistenceFeature) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PersistenceFeature
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
 // struct.
-func (v *PersistenceFeature) String() string {
+func (v *AdminService_AddSearchAttribute_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Key != nil {
-		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
-		i++
-	}
-	if v.Enabled != nil {
-		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("PersistenceFeature{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PersistenceFeature match the
-// provided PersistenceFeature.
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
+// provided AdminService_AddSearchAttribute_Args.
 //
 // This function performs a deep comparison.
-func (v *PersistenceFeature) Equals(rhs *PersistenceFeature) bool {
+func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Key, rhs.Key) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -5047,115 +10106,168 @@ func (v *PersistenceFeature) Equals(rhs *PersistenceFeature) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PersistenceFeature.
-func (v *PersistenceFeature) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_AddSearchAttribute_Args.
+func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Key != nil {
-		enc.AddString("key", *v.Key)
-	}
-	if v.Enabled != nil {
-		enc.AddBool("enabled", *v.Enabled)
-	}
-	return err
-}
-
-// GetKey returns the value of Key if it is set or its
-// zero value if it is unset.
-func (v *PersistenceFeature) GetKey() (o string) {
-	if v != nil && v.Key != nil {
-		return *v.Key
-	}
-
-	return
-}
-
-// IsSetKey returns true if Key is not nil.
-func (v *PersistenceFeature) IsSetKey() bool {
-	return v != nil && v.Key != nil
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
 }
 
-// GetEnabled returns the value of Enabled if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *PersistenceFeature) GetEnabled() (o bool) {
-	if v != nil && v.Enabled != nil {
-		return *v.Enabled
+func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetEnabled returns true if Enabled is not nil.
-func (v *PersistenceFeature) IsSetEnabled() bool {
-	return v != nil && v.Enabled != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-type PersistenceInfo struct {
-	Backend  *string               `json:"backend,omitempty"`
-	Settings []*PersistenceSetting `json:"settings,omitempty"`
-	Features []*PersistenceFeature `json:"features,omitempty"`
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
+	return "AddSearchAttribute"
 }
 
-type _List_PersistenceSetting_ValueList []*PersistenceSetting
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
 
-func (v _List_PersistenceSetting_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*PersistenceSetting', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
+// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddSearchAttribute
+// function.
+var AdminService_AddSearchAttribute_Helper = struct {
+	// Args accepts the parameters of AddSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddSearchAttribute.
+	//
+	// An error can be thrown by AddSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if AddSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by AddSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddSearchAttribute
+	//
+	//   err := AddSearchAttribute(args)
+	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_AddSearchAttribute_Helper.Args = func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args {
+		return &AdminService_AddSearchAttribute_Args{
+			Request: request,
 		}
 	}
-	return nil
-}
-
-func (v _List_PersistenceSetting_ValueList) Size() int {
-	return len(v)
-}
 
-func (_List_PersistenceSetting_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
+	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
 
-func (_List_PersistenceSetting_ValueList) Close() {}
+	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_AddSearchAttribute_Result{}, nil
+		}
 
-type _List_PersistenceFeature_ValueList []*PersistenceFeature
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+		}
 
-func (v _List_PersistenceFeature_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*PersistenceFeature', index [%v]: value is nil", i)
+		return nil, err
+	}
+	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
 		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
 		}
-		err = f(w)
-		if err != nil {
-			return err
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
 		}
+		return
 	}
-	return nil
-}
 
-func (v _List_PersistenceFeature_ValueList) Size() int {
-	return len(v)
 }
 
-func (_List_PersistenceFeature_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+//
+// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-func (_List_PersistenceFeature_ValueList) Close() {}
-
-// ToWire translates a PersistenceInfo struct into a Thrift-level intermediate
+// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5167,10 +10279,10 @@ func (_List_PersistenceFeature_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PersistenceInfo) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -5178,87 +10290,61 @@ func (v *PersistenceInfo) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Backend != nil {
-		w, err = wire.NewValueString(*(v.Backend)), error(nil)
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.Settings != nil {
-		w, err = wire.NewValueList(_List_PersistenceSetting_ValueList(v.Settings)), error(nil)
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.Features != nil {
-		w, err = wire.NewValueList(_List_PersistenceFeature_ValueList(v.Features)), error(nil)
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PersistenceSetting_Read(w wire.Value) (*PersistenceSetting, error) {
-	var v PersistenceSetting
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_PersistenceSetting_Read(l wire.ValueList) ([]*PersistenceSetting, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*PersistenceSetting, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _PersistenceSetting_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _PersistenceFeature_Read(w wire.Value) (*PersistenceFeature, error) {
-	var v PersistenceFeature
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_PersistenceFeature_Read(l wire.ValueList) ([]*PersistenceFeature, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*PersistenceFeature, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _PersistenceFeature_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// FromWire deserializes a PersistenceInfo struct from its Thrift-level
+// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PersistenceInfo struct
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5266,37 +10352,35 @@ func _List_PersistenceFeature_Read(l wire.ValueList) ([]*PersistenceFeature, err
 //     return nil, err
 //   }
 //
-//   var v PersistenceInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PersistenceInfo) FromWire(w wire.Value) error {
+//	var v AdminService_AddSearchAttribute_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Backend = &x
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Settings, err = _List_PersistenceSetting_Read(field.Value.GetList())
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TList {
-				v.Features, err = _List_PersistenceFeature_Read(field.Value.GetList())
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5305,65 +10389,37 @@ func (v *PersistenceInfo) FromWire(w wire.Value) error {
 		}
 	}
 
-	return nil
-}
-
-func _List_PersistenceSetting_Encode(val []*PersistenceSetting, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+	count := 0
+	if v.BadRequestError != nil {
+		count++
 	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*PersistenceSetting', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
+	if v.InternalServiceError != nil {
+		count++
 	}
-	return sw.WriteListEnd()
-}
-
-func _List_PersistenceFeature_Encode(val []*PersistenceFeature, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
+	if v.ServiceBusyError != nil {
+		count++
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
 	}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*PersistenceFeature', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
+	return nil
 }
 
-// Encode serializes a PersistenceInfo struct directly into bytes, without going
+// Encode serializes a AdminService_AddSearchAttribute_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PersistenceInfo struct could not be encoded.
-func (v *PersistenceInfo) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_AddSearchAttribute_Result struct could not be encoded.
+func (v *AdminService_AddSearchAttribute_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Backend != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Backend)); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5371,11 +10427,11 @@ func (v *PersistenceInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Settings != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_PersistenceSetting_Encode(v.Settings, sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5383,11 +10439,11 @@ func (v *PersistenceInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Features != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_PersistenceFeature_Encode(v.Features, sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5395,87 +10451,48 @@ func (v *PersistenceInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _PersistenceSetting_Decode(sr stream.Reader) (*PersistenceSetting, error) {
-	var v PersistenceSetting
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_PersistenceSetting_Decode(sr stream.Reader) ([]*PersistenceSetting, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+	count := 0
+	if v.BadRequestError != nil {
+		count++
 	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
+	if v.InternalServiceError != nil {
+		count++
 	}
-
-	o := make([]*PersistenceSetting, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _PersistenceSetting_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
+	if v.ServiceBusyError != nil {
+		count++
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
 	}
-	return o, err
+
+	return sw.WriteStructEnd()
 }
 
-func _PersistenceFeature_Decode(sr stream.Reader) (*PersistenceFeature, error) {
-	var v PersistenceFeature
+func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
 	err := v.Decode(sr)
 	return &v, err
 }
 
-func _List_PersistenceFeature_Decode(sr stream.Reader) ([]*PersistenceFeature, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*PersistenceFeature, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _PersistenceFeature_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
+func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.Decode(sr)
+	return &v, err
+}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// Decode deserializes a PersistenceInfo struct directly from its Thrift-level
+// Decode deserializes a AdminService_AddSearchAttribute_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PersistenceInfo struct could not be generated from the wire
+// An error is returned if a AdminService_AddSearchAttribute_Result struct could not be generated from the wire
 // representation.
-func (v *PersistenceInfo) Decode(sr stream.Reader) error {
+func (v *AdminService_AddSearchAttribute_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5488,22 +10505,20 @@ func (v *PersistenceInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Backend = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Settings, err = _List_PersistenceSetting_Decode(sr)
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TList:
-			v.Features, err = _List_PersistenceFeature_Decode(sr)
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5527,178 +10542,157 @@ func (v *PersistenceInfo) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	return nil
-}
-
-// String returns a readable string representation of a PersistenceInfo
-// struct.
-func (v *PersistenceInfo) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [3]string
-	i := 0
-	if v.Backend != nil {
-		fields[i] = fmt.Sprintf("Backend: %v", *(v.Backend))
-		i++
-	}
-	if v.Settings != nil {
-		fields[i] = fmt.Sprintf("Settings: %v", v.Settings)
-		i++
+	count := 0
+	if v.BadRequestError != nil {
+		count++
 	}
-	if v.Features != nil {
-		fields[i] = fmt.Sprintf("Features: %v", v.Features)
-		i++
+	if v.InternalServiceError != nil {
+		count++
 	}
-
-	return fmt.Sprintf("PersistenceInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_PersistenceSetting_Equals(lhs, rhs []*PersistenceSetting) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.ServiceBusyError != nil {
+		count++
 	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
 	}
 
-	return true
+	return nil
 }
 
-func _List_PersistenceFeature_Equals(lhs, rhs []*PersistenceFeature) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// struct.
+func (v *AdminService_AddSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PersistenceInfo match the
-// provided PersistenceInfo.
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
+// provided AdminService_AddSearchAttribute_Result.
 //
 // This function performs a deep comparison.
-func (v *PersistenceInfo) Equals(rhs *PersistenceInfo) bool {
+func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Backend, rhs.Backend) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.Settings == nil && rhs.Settings == nil) || (v.Settings != nil && rhs.Settings != nil && _List_PersistenceSetting_Equals(v.Settings, rhs.Settings))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.Features == nil && rhs.Features == nil) || (v.Features != nil && rhs.Features != nil && _List_PersistenceFeature_Equals(v.Features, rhs.Features))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_PersistenceSetting_Zapper []*PersistenceSetting
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_PersistenceSetting_Zapper.
-func (l _List_PersistenceSetting_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
-type _List_PersistenceFeature_Zapper []*PersistenceFeature
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_PersistenceFeature_Zapper.
-func (l _List_PersistenceFeature_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PersistenceInfo.
-func (v *PersistenceInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_AddSearchAttribute_Result.
+func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Backend != nil {
-		enc.AddString("backend", *v.Backend)
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.Settings != nil {
-		err = multierr.Append(err, enc.AddArray("settings", (_List_PersistenceSetting_Zapper)(v.Settings)))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.Features != nil {
-		err = multierr.Append(err, enc.AddArray("features", (_List_PersistenceFeature_Zapper)(v.Features)))
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
-// GetBackend returns the value of Backend if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *PersistenceInfo) GetBackend() (o string) {
-	if v != nil && v.Backend != nil {
-		return *v.Backend
+func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetBackend returns true if Backend is not nil.
-func (v *PersistenceInfo) IsSetBackend() bool {
-	return v != nil && v.Backend != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetSettings returns the value of Settings if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *PersistenceInfo) GetSettings() (o []*PersistenceSetting) {
-	if v != nil && v.Settings != nil {
-		return v.Settings
+func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetSettings returns true if Settings is not nil.
-func (v *PersistenceInfo) IsSetSettings() bool {
-	return v != nil && v.Settings != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetFeatures returns the value of Features if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *PersistenceInfo) GetFeatures() (o []*PersistenceFeature) {
-	if v != nil && v.Features != nil {
-		return v.Features
+func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetFeatures returns true if Features is not nil.
-func (v *PersistenceInfo) IsSetFeatures() bool {
-	return v != nil && v.Features != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
-type PersistenceSetting struct {
-	Key   *string `json:"key,omitempty"`
-	Value *string `json:"value,omitempty"`
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
+	return "AddSearchAttribute"
 }
 
-// ToWire translates a PersistenceSetting struct into a Thrift-level intermediate
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+//
+// The arguments for CloseShard are sent and received over the wire as this struct.
+type AdminService_CloseShard_Args struct {
+	Request *shared.CloseShardRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5710,42 +10704,40 @@ type PersistenceSetting struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PersistenceSetting) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Key != nil {
-		w, err = wire.NewValueString(*(v.Key)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Value != nil {
-		w, err = wire.NewValueString(*(v.Value)), error(nil)
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PersistenceSetting struct from its Thrift-level
+func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
+	var v shared.CloseShardRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PersistenceSetting struct
+// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5753,31 +10745,19 @@ func (v *PersistenceSetting) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PersistenceSetting
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PersistenceSetting) FromWire(w wire.Value) error {
+//	var v AdminService_CloseShard_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Key = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Value = &x
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CloseShardRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5789,32 +10769,20 @@ func (v *PersistenceSetting) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PersistenceSetting struct directly into bytes, without going
+// Encode serializes a AdminService_CloseShard_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PersistenceSetting struct could not be encoded.
-func (v *PersistenceSetting) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_CloseShard_Args struct could not be encoded.
+func (v *AdminService_CloseShard_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Key != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Key)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Value != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Value)); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5825,12 +10793,18 @@ func (v *PersistenceSetting) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a PersistenceSetting struct directly from its Thrift-level
+func _CloseShardRequest_Decode(sr stream.Reader) (*shared.CloseShardRequest, error) {
+	var v shared.CloseShardRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_CloseShard_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PersistenceSetting struct could not be generated from the wire
+// An error is returned if a AdminService_CloseShard_Args struct could not be generated from the wire
 // representation.
-func (v *PersistenceSetting) Decode(sr stream.Reader) error {
+func (v *AdminService_CloseShard_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5843,18 +10817,8 @@ func (v *PersistenceSetting) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Key = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Value = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _CloseShardRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5881,41 +10845,34 @@ func (v *PersistenceSetting) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PersistenceSetting
+// String returns a readable string representation of a AdminService_CloseShard_Args
 // struct.
-func (v *PersistenceSetting) String() string {
+func (v *AdminService_CloseShard_Args) String() string {
 	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [2]string
-	i := 0
-	if v.Key != nil {
-		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
-		i++
+		return "<nil>"
 	}
-	if v.Value != nil {
-		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("PersistenceSetting{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PersistenceSetting match the
-// provided PersistenceSetting.
+// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
+// provided AdminService_CloseShard_Args.
 //
 // This function performs a deep comparison.
-func (v *PersistenceSetting) Equals(rhs *PersistenceSetting) bool {
+func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Key, rhs.Key) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Value, rhs.Value) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -5923,62 +10880,168 @@ func (v *PersistenceSetting) Equals(rhs *PersistenceSetting) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PersistenceSetting.
-func (v *PersistenceSetting) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_CloseShard_Args.
+func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Key != nil {
-		enc.AddString("key", *v.Key)
-	}
-	if v.Value != nil {
-		enc.AddString("value", *v.Value)
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetKey returns the value of Key if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *PersistenceSetting) GetKey() (o string) {
-	if v != nil && v.Key != nil {
-		return *v.Key
+func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetKey returns true if Key is not nil.
-func (v *PersistenceSetting) IsSetKey() bool {
-	return v != nil && v.Key != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-// GetValue returns the value of Value if it is set or its
-// zero value if it is unset.
-func (v *PersistenceSetting) GetValue() (o string) {
-	if v != nil && v.Value != nil {
-		return *v.Value
-	}
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Args) MethodName() string {
+	return "CloseShard"
+}
 
-	return
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// IsSetValue returns true if Value is not nil.
-func (v *PersistenceSetting) IsSetValue() bool {
-	return v != nil && v.Value != nil
+// AdminService_CloseShard_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.CloseShard
+// function.
+var AdminService_CloseShard_Helper = struct {
+	// Args accepts the parameters of CloseShard in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CloseShard.
+	//
+	// An error can be thrown by CloseShard only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CloseShard
+	// given the error returned by it. The provided error may
+	// be nil if CloseShard did not fail.
+	//
+	// This allows mapping errors returned by CloseShard into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// CloseShard
+	//
+	//   err := CloseShard(args)
+	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+
+	// UnwrapResponse takes the result struct for CloseShard
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if CloseShard threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_CloseShard_Result) error
+}{}
+
+func init() {
+	AdminService_CloseShard_Helper.Args = func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args {
+		return &AdminService_CloseShard_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+		if err == nil {
+			return &AdminService_CloseShard_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+			}
+			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+			}
+			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+			}
+			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
 }
 
-type ResendReplicationTasksRequest struct {
-	DomainID      *string `json:"domainID,omitempty"`
-	WorkflowID    *string `json:"workflowID,omitempty"`
-	RunID         *string `json:"runID,omitempty"`
-	RemoteCluster *string `json:"remoteCluster,omitempty"`
-	StartEventID  *int64  `json:"startEventID,omitempty"`
-	StartVersion  *int64  `json:"startVersion,omitempty"`
-	EndEventID    *int64  `json:"endEventID,omitempty"`
-	EndVersion    *int64  `json:"endVersion,omitempty"`
+// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+//
+// The result of a CloseShard execution is sent and received over the wire as this struct.
+type AdminService_CloseShard_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a ResendReplicationTasksRequest struct into a Thrift-level intermediate
+// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5990,90 +11053,60 @@ type ResendReplicationTasksRequest struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResendReplicationTasksRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainID != nil {
-		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.WorkflowID != nil {
-		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunID != nil {
-		w, err = wire.NewValueString(*(v.RunID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.RemoteCluster != nil {
-		w, err = wire.NewValueString(*(v.RemoteCluster)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.StartEventID != nil {
-		w, err = wire.NewValueI64(*(v.StartEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.StartVersion != nil {
-		w, err = wire.NewValueI64(*(v.StartVersion)), error(nil)
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.EndEventID != nil {
-		w, err = wire.NewValueI64(*(v.EndEventID)), error(nil)
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EndVersion != nil {
-		w, err = wire.NewValueI64(*(v.EndVersion)), error(nil)
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResendReplicationTasksRequest struct from its Thrift-level
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResendReplicationTasksRequest struct
+// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6081,91 +11114,35 @@ func (v *ResendReplicationTasksRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResendReplicationTasksRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ResendReplicationTasksRequest) FromWire(w wire.Value) error {
+//	var v AdminService_CloseShard_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RemoteCluster = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartEventID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartVersion = &x
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EndEventID = &x
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 80:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EndVersion = &x
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6174,35 +11151,37 @@ func (v *ResendReplicationTasksRequest) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// Encode serializes a ResendReplicationTasksRequest struct directly into bytes, without going
+// Encode serializes a AdminService_CloseShard_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResendReplicationTasksRequest struct could not be encoded.
-func (v *ResendReplicationTasksRequest) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_CloseShard_Result struct could not be encoded.
+func (v *AdminService_CloseShard_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DomainID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.DomainID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6210,11 +11189,11 @@ func (v *ResendReplicationTasksRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunID)); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6222,11 +11201,11 @@ func (v *ResendReplicationTasksRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RemoteCluster != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.AccessDeniedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RemoteCluster)); err != nil {
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6234,63 +11213,36 @@ func (v *ResendReplicationTasksRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.StartEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartEventID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	count := 0
+	if v.BadRequestError != nil {
+		count++
 	}
-
-	if v.StartVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.InternalServiceError != nil {
+		count++
 	}
-
-	if v.EndEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.EndEventID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if v.AccessDeniedError != nil {
+		count++
 	}
 
-	if v.EndVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.EndVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResendReplicationTasksRequest struct directly from its Thrift-level
+func _AccessDeniedError_Decode(sr stream.Reader) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_CloseShard_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResendReplicationTasksRequest struct could not be generated from the wire
+// An error is returned if a AdminService_CloseShard_Result struct could not be generated from the wire
 // representation.
-func (v *ResendReplicationTasksRequest) Decode(sr stream.Reader) error {
+func (v *AdminService_CloseShard_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6303,66 +11255,20 @@ func (v *ResendReplicationTasksRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.WorkflowID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RemoteCluster = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartVersion = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EndEventID = &x
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 80 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EndVersion = &x
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -6386,86 +11292,65 @@ func (v *ResendReplicationTasksRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a ResendReplicationTasksRequest
+// String returns a readable string representation of a AdminService_CloseShard_Result
 // struct.
-func (v *ResendReplicationTasksRequest) String() string {
+func (v *AdminService_CloseShard_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [3]string
 	i := 0
-	if v.DomainID != nil {
-		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
-		i++
-	}
-	if v.WorkflowID != nil {
-		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
-		i++
-	}
-	if v.RunID != nil {
-		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
-		i++
-	}
-	if v.RemoteCluster != nil {
-		fields[i] = fmt.Sprintf("RemoteCluster: %v", *(v.RemoteCluster))
-		i++
-	}
-	if v.StartEventID != nil {
-		fields[i] = fmt.Sprintf("StartEventID: %v", *(v.StartEventID))
-		i++
-	}
-	if v.StartVersion != nil {
-		fields[i] = fmt.Sprintf("StartVersion: %v", *(v.StartVersion))
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.EndEventID != nil {
-		fields[i] = fmt.Sprintf("EndEventID: %v", *(v.EndEventID))
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EndVersion != nil {
-		fields[i] = fmt.Sprintf("EndVersion: %v", *(v.EndVersion))
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("ResendReplicationTasksRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResendReplicationTasksRequest match the
-// provided ResendReplicationTasksRequest.
+// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
+// provided AdminService_CloseShard_Result.
 //
 // This function performs a deep comparison.
-func (v *ResendReplicationTasksRequest) Equals(rhs *ResendReplicationTasksRequest) bool {
+func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RemoteCluster, rhs.RemoteCluster) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartEventID, rhs.StartEventID) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartVersion, rhs.StartVersion) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.EndEventID, rhs.EndEventID) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.EndVersion, rhs.EndVersion) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -6473,164 +11358,356 @@ func (v *ResendReplicationTasksRequest) Equals(rhs *ResendReplicationTasksReques
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResendReplicationTasksRequest.
-func (v *ResendReplicationTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_CloseShard_Result.
+func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainID != nil {
-		enc.AddString("domainID", *v.DomainID)
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.WorkflowID != nil {
-		enc.AddString("workflowID", *v.WorkflowID)
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.RunID != nil {
-		enc.AddString("runID", *v.RunID)
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
-	if v.RemoteCluster != nil {
-		enc.AddString("remoteCluster", *v.RemoteCluster)
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
-	if v.StartEventID != nil {
-		enc.AddInt64("startEventID", *v.StartEventID)
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
-	if v.StartVersion != nil {
-		enc.AddInt64("startVersion", *v.StartVersion)
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
-	if v.EndEventID != nil {
-		enc.AddInt64("endEventID", *v.EndEventID)
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Result) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeCluster_Args represents the arguments for the AdminService.DescribeCluster function.
+//
+// The arguments for DescribeCluster are sent and received over the wire as this struct.
+type AdminService_DescribeCluster_Args struct {
+}
+
+// ToWire translates a AdminService_DescribeCluster_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeCluster_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_DescribeCluster_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeCluster_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v AdminService_DescribeCluster_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeCluster_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
 	}
-	if v.EndVersion != nil {
-		enc.AddInt64("endVersion", *v.EndVersion)
+
+	return nil
+}
+
+// Encode serializes a AdminService_DescribeCluster_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_DescribeCluster_Args struct could not be encoded.
+func (v *AdminService_DescribeCluster_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	return err
+
+	return sw.WriteStructEnd()
 }
 
-// GetDomainID returns the value of DomainID if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetDomainID() (o string) {
-	if v != nil && v.DomainID != nil {
-		return *v.DomainID
+// Decode deserializes a AdminService_DescribeCluster_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_DescribeCluster_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_DescribeCluster_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return
-}
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// IsSetDomainID returns true if DomainID is not nil.
-func (v *ResendReplicationTasksRequest) IsSetDomainID() bool {
-	return v != nil && v.DomainID != nil
-}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-// GetWorkflowID returns the value of WorkflowID if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetWorkflowID() (o string) {
-	if v != nil && v.WorkflowID != nil {
-		return *v.WorkflowID
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-// IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *ResendReplicationTasksRequest) IsSetWorkflowID() bool {
-	return v != nil && v.WorkflowID != nil
+	return nil
 }
 
-// GetRunID returns the value of RunID if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetRunID() (o string) {
-	if v != nil && v.RunID != nil {
-		return *v.RunID
+// String returns a readable string representation of a AdminService_DescribeCluster_Args
+// struct.
+func (v *AdminService_DescribeCluster_Args) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [0]string
+	i := 0
 
-// IsSetRunID returns true if RunID is not nil.
-func (v *ResendReplicationTasksRequest) IsSetRunID() bool {
-	return v != nil && v.RunID != nil
+	return fmt.Sprintf("AdminService_DescribeCluster_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetRemoteCluster returns the value of RemoteCluster if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetRemoteCluster() (o string) {
-	if v != nil && v.RemoteCluster != nil {
-		return *v.RemoteCluster
+// Equals returns true if all the fields of this AdminService_DescribeCluster_Args match the
+// provided AdminService_DescribeCluster_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeCluster_Args) Equals(rhs *AdminService_DescribeCluster_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
 
-	return
-}
-
-// IsSetRemoteCluster returns true if RemoteCluster is not nil.
-func (v *ResendReplicationTasksRequest) IsSetRemoteCluster() bool {
-	return v != nil && v.RemoteCluster != nil
+	return true
 }
 
-// GetStartEventID returns the value of StartEventID if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetStartEventID() (o int64) {
-	if v != nil && v.StartEventID != nil {
-		return *v.StartEventID
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeCluster_Args.
+func (v *AdminService_DescribeCluster_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
+	return err
+}
 
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeCluster" for this struct.
+func (v *AdminService_DescribeCluster_Args) MethodName() string {
+	return "DescribeCluster"
 }
 
-// IsSetStartEventID returns true if StartEventID is not nil.
-func (v *ResendReplicationTasksRequest) IsSetStartEventID() bool {
-	return v != nil && v.StartEventID != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeCluster_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// GetStartVersion returns the value of StartVersion if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetStartVersion() (o int64) {
-	if v != nil && v.StartVersion != nil {
-		return *v.StartVersion
-	}
+// AdminService_DescribeCluster_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeCluster
+// function.
+var AdminService_DescribeCluster_Helper = struct {
+	// Args accepts the parameters of DescribeCluster in-order and returns
+	// the arguments struct for the function.
+	Args func() *AdminService_DescribeCluster_Args
 
-	return
-}
+	// IsException returns true if the given error can be thrown
+	// by DescribeCluster.
+	//
+	// An error can be thrown by DescribeCluster only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
 
-// IsSetStartVersion returns true if StartVersion is not nil.
-func (v *ResendReplicationTasksRequest) IsSetStartVersion() bool {
-	return v != nil && v.StartVersion != nil
-}
+	// WrapResponse returns the result struct for DescribeCluster
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeCluster into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeCluster
+	//
+	//   value, err := DescribeCluster(args)
+	//   result, err := AdminService_DescribeCluster_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeCluster: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeClusterResponse, error) (*AdminService_DescribeCluster_Result, error)
 
-// GetEndEventID returns the value of EndEventID if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetEndEventID() (o int64) {
-	if v != nil && v.EndEventID != nil {
-		return *v.EndEventID
+	// UnwrapResponse takes the result struct for DescribeCluster
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeCluster threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeCluster_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeCluster_Result) (*DescribeClusterResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeCluster_Helper.Args = func() *AdminService_DescribeCluster_Args {
+		return &AdminService_DescribeCluster_Args{}
 	}
 
-	return
-}
+	AdminService_DescribeCluster_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
 
-// IsSetEndEventID returns true if EndEventID is not nil.
-func (v *ResendReplicationTasksRequest) IsSetEndEventID() bool {
-	return v != nil && v.EndEventID != nil
-}
+	AdminService_DescribeCluster_Helper.WrapResponse = func(success *DescribeClusterResponse, err error) (*AdminService_DescribeCluster_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeCluster_Result{Success: success}, nil
+		}
 
-// GetEndVersion returns the value of EndVersion if it is set or its
-// zero value if it is unset.
-func (v *ResendReplicationTasksRequest) GetEndVersion() (o int64) {
-	if v != nil && v.EndVersion != nil {
-		return *v.EndVersion
+		switch e := err.(type) {
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeCluster_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.ServiceBusyError")
+			}
+			return &AdminService_DescribeCluster_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
 	}
+	AdminService_DescribeCluster_Helper.UnwrapResponse = func(result *AdminService_DescribeCluster_Result) (success *DescribeClusterResponse, err error) {
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
 
-	return
-}
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
 
-// IsSetEndVersion returns true if EndVersion is not nil.
-func (v *ResendReplicationTasksRequest) IsSetEndVersion() bool {
-	return v != nil && v.EndVersion != nil
 }
 
-type RestoreDynamicConfigRequest struct {
-	ConfigName *string                       `json:"configName,omitempty"`
-	Filters    []*config.DynamicConfigFilter `json:"filters,omitempty"`
+// AdminService_DescribeCluster_Result represents the result of a AdminService.DescribeCluster function call.
+//
+// The result of a DescribeCluster execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeCluster_Result struct {
+	// Value returned by DescribeCluster after a successful execution.
+	Success              *DescribeClusterResponse     `json:"success,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a RestoreDynamicConfigRequest struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeCluster_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6642,42 +11719,60 @@ type RestoreDynamicConfigRequest struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RestoreDynamicConfigRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeCluster_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ConfigName != nil {
-		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.Filters != nil {
-		w, err = wire.NewValueList(_List_DynamicConfigFilter_ValueList(v.Filters)), error(nil)
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RestoreDynamicConfigRequest struct from its Thrift-level
+func _DescribeClusterResponse_Read(w wire.Value) (*DescribeClusterResponse, error) {
+	var v DescribeClusterResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeCluster_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RestoreDynamicConfigRequest struct
+// An error is returned if we were unable to build a AdminService_DescribeCluster_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6685,29 +11780,35 @@ func (v *RestoreDynamicConfigRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RestoreDynamicConfigRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RestoreDynamicConfigRequest) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeCluster_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeCluster_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ConfigName = &x
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeClusterResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Filters, err = _List_DynamicConfigFilter_Read(field.Value.GetList())
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6716,23 +11817,37 @@ func (v *RestoreDynamicConfigRequest) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// Encode serializes a RestoreDynamicConfigRequest struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeCluster_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RestoreDynamicConfigRequest struct could not be encoded.
-func (v *RestoreDynamicConfigRequest) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeCluster_Result struct could not be encoded.
+func (v *AdminService_DescribeCluster_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ConfigName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6740,11 +11855,23 @@ func (v *RestoreDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Filters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_DynamicConfigFilter_Encode(v.Filters, sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6752,15 +11879,36 @@ func (v *RestoreDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RestoreDynamicConfigRequest struct directly from its Thrift-level
+func _DescribeClusterResponse_Decode(sr stream.Reader) (*DescribeClusterResponse, error) {
+	var v DescribeClusterResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_DescribeCluster_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RestoreDynamicConfigRequest struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeCluster_Result struct could not be generated from the wire
 // representation.
-func (v *RestoreDynamicConfigRequest) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeCluster_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6773,16 +11921,20 @@ func (v *RestoreDynamicConfigRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ConfigName = &x
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeClusterResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Filters, err = _List_DynamicConfigFilter_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -6806,44 +11958,65 @@ func (v *RestoreDynamicConfigRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a RestoreDynamicConfigRequest
+// String returns a readable string representation of a AdminService_DescribeCluster_Result
 // struct.
-func (v *RestoreDynamicConfigRequest) String() string {
+func (v *AdminService_DescribeCluster_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.ConfigName != nil {
-		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.Filters != nil {
-		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("RestoreDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeCluster_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RestoreDynamicConfigRequest match the
-// provided RestoreDynamicConfigRequest.
+// Equals returns true if all the fields of this AdminService_DescribeCluster_Result match the
+// provided AdminService_DescribeCluster_Result.
 //
 // This function performs a deep comparison.
-func (v *RestoreDynamicConfigRequest) Equals(rhs *RestoreDynamicConfigRequest) bool {
+func (v *AdminService_DescribeCluster_Result) Equals(rhs *AdminService_DescribeCluster_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_DynamicConfigFilter_Equals(v.Filters, rhs.Filters))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -6851,86 +12024,91 @@ func (v *RestoreDynamicConfigRequest) Equals(rhs *RestoreDynamicConfigRequest) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RestoreDynamicConfigRequest.
-func (v *RestoreDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeCluster_Result.
+func (v *AdminService_DescribeCluster_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ConfigName != nil {
-		enc.AddString("configName", *v.ConfigName)
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.Filters != nil {
-		err = multierr.Append(err, enc.AddArray("filters", (_List_DynamicConfigFilter_Zapper)(v.Filters)))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
-// GetConfigName returns the value of ConfigName if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *RestoreDynamicConfigRequest) GetConfigName() (o string) {
-	if v != nil && v.ConfigName != nil {
-		return *v.ConfigName
+func (v *AdminService_DescribeCluster_Result) GetSuccess() (o *DescribeClusterResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetConfigName returns true if ConfigName is not nil.
-func (v *RestoreDynamicConfigRequest) IsSetConfigName() bool {
-	return v != nil && v.ConfigName != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetFilters returns the value of Filters if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *RestoreDynamicConfigRequest) GetFilters() (o []*config.DynamicConfigFilter) {
-	if v != nil && v.Filters != nil {
-		return v.Filters
+func (v *AdminService_DescribeCluster_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetFilters returns true if Filters is not nil.
-func (v *RestoreDynamicConfigRequest) IsSetFilters() bool {
-	return v != nil && v.Filters != nil
-}
-
-type RingInfo struct {
-	Role        *string     `json:"role,omitempty"`
-	MemberCount *int32      `json:"memberCount,omitempty"`
-	Members     []*HostInfo `json:"members,omitempty"`
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-type _List_HostInfo_ValueList []*HostInfo
-
-func (v _List_HostInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*HostInfo', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeCluster_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
-	return nil
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
-func (v _List_HostInfo_ValueList) Size() int {
-	return len(v)
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeCluster" for this struct.
+func (v *AdminService_DescribeCluster_Result) MethodName() string {
+	return "DescribeCluster"
 }
 
-func (_List_HostInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeCluster_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-func (_List_HostInfo_ValueList) Close() {}
+// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+//
+// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
+type AdminService_DescribeHistoryHost_Args struct {
+	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+}
 
-// ToWire translates a RingInfo struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6942,68 +12120,40 @@ func (_List_HostInfo_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RingInfo) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Role != nil {
-		w, err = wire.NewValueString(*(v.Role)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.MemberCount != nil {
-		w, err = wire.NewValueI32(*(v.MemberCount)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.Members != nil {
-		w, err = wire.NewValueList(_List_HostInfo_ValueList(v.Members)), error(nil)
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_HostInfo_Read(l wire.ValueList) ([]*HostInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*HostInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _HostInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// FromWire deserializes a RingInfo struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RingInfo struct
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7011,39 +12161,19 @@ func _List_HostInfo_Read(l wire.ValueList) ([]*HostInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v RingInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RingInfo) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeHistoryHost_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Role = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MemberCount = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TList {
-				v.Members, err = _List_HostInfo_Read(field.Value.GetList())
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7055,65 +12185,20 @@ func (v *RingInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_HostInfo_Encode(val []*HostInfo, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*HostInfo', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a RingInfo struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeHistoryHost_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RingInfo struct could not be encoded.
-func (v *RingInfo) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeHistoryHost_Args struct could not be encoded.
+func (v *AdminService_DescribeHistoryHost_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Role != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Role)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.MemberCount != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MemberCount)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Members != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_HostInfo_Encode(v.Members, sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7124,42 +12209,18 @@ func (v *RingInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _List_HostInfo_Decode(sr stream.Reader) ([]*HostInfo, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*HostInfo, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _HostInfo_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+func _DescribeHistoryHostRequest_Decode(sr stream.Reader) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// Decode deserializes a RingInfo struct directly from its Thrift-level
+// Decode deserializes a AdminService_DescribeHistoryHost_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RingInfo struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeHistoryHost_Args struct could not be generated from the wire
 // representation.
-func (v *RingInfo) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeHistoryHost_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7172,24 +12233,8 @@ func (v *RingInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Role = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MemberCount = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TList:
-			v.Members, err = _List_HostInfo_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _DescribeHistoryHostRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -7216,178 +12261,213 @@ func (v *RingInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a RingInfo
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
 // struct.
-func (v *RingInfo) String() string {
+func (v *AdminService_DescribeHistoryHost_Args) String() string {
 	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [3]string
-	i := 0
-	if v.Role != nil {
-		fields[i] = fmt.Sprintf("Role: %v", *(v.Role))
-		i++
-	}
-	if v.MemberCount != nil {
-		fields[i] = fmt.Sprintf("MemberCount: %v", *(v.MemberCount))
-		i++
-	}
-	if v.Members != nil {
-		fields[i] = fmt.Sprintf("Members: %v", v.Members)
-		i++
-	}
-
-	return fmt.Sprintf("RingInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_HostInfo_Equals(lhs, rhs []*HostInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
+		return "<nil>"
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RingInfo match the
-// provided RingInfo.
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
+// provided AdminService_DescribeHistoryHost_Args.
 //
 // This function performs a deep comparison.
-func (v *RingInfo) Equals(rhs *RingInfo) bool {
+func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Role, rhs.Role) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MemberCount, rhs.MemberCount) {
-		return false
-	}
-	if !((v.Members == nil && rhs.Members == nil) || (v.Members != nil && rhs.Members != nil && _List_HostInfo_Equals(v.Members, rhs.Members))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_HostInfo_Zapper []*HostInfo
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_HostInfo_Zapper.
-func (l _List_HostInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RingInfo.
-func (v *RingInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeHistoryHost_Args.
+func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Role != nil {
-		enc.AddString("role", *v.Role)
-	}
-	if v.MemberCount != nil {
-		enc.AddInt32("memberCount", *v.MemberCount)
-	}
-	if v.Members != nil {
-		err = multierr.Append(err, enc.AddArray("members", (_List_HostInfo_Zapper)(v.Members)))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetRole returns the value of Role if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *RingInfo) GetRole() (o string) {
-	if v != nil && v.Role != nil {
-		return *v.Role
+func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetRole returns true if Role is not nil.
-func (v *RingInfo) IsSetRole() bool {
-	return v != nil && v.Role != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-// GetMemberCount returns the value of MemberCount if it is set or its
-// zero value if it is unset.
-func (v *RingInfo) GetMemberCount() (o int32) {
-	if v != nil && v.MemberCount != nil {
-		return *v.MemberCount
-	}
-
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
+	return "DescribeHistoryHost"
 }
 
-// IsSetMemberCount returns true if MemberCount is not nil.
-func (v *RingInfo) IsSetMemberCount() bool {
-	return v != nil && v.MemberCount != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// GetMembers returns the value of Members if it is set or its
-// zero value if it is unset.
-func (v *RingInfo) GetMembers() (o []*HostInfo) {
-	if v != nil && v.Members != nil {
-		return v.Members
-	}
+// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeHistoryHost
+// function.
+var AdminService_DescribeHistoryHost_Helper = struct {
+	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args
 
-	return
-}
+	// IsException returns true if the given error can be thrown
+	// by DescribeHistoryHost.
+	//
+	// An error can be thrown by DescribeHistoryHost only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
 
-// IsSetMembers returns true if Members is not nil.
-func (v *RingInfo) IsSetMembers() bool {
-	return v != nil && v.Members != nil
-}
+	// WrapResponse returns the result struct for DescribeHistoryHost
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeHistoryHost into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeHistoryHost
+	//
+	//   value, err := DescribeHistoryHost(args)
+	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
 
-type UpdateDynamicConfigRequest struct {
-	ConfigName   *string                      `json:"configName,omitempty"`
-	ConfigValues []*config.DynamicConfigValue `json:"configValues,omitempty"`
-}
+	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeHistoryHost threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+}{}
 
-type _List_DynamicConfigValue_ValueList []*config.DynamicConfigValue
+func init() {
+	AdminService_DescribeHistoryHost_Helper.Args = func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args {
+		return &AdminService_DescribeHistoryHost_Args{
+			Request: request,
+		}
+	}
 
-func (v _List_DynamicConfigValue_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigValue', index [%v]: value is nil", i)
+	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
 		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
+	}
+
+	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
 		}
-		err = f(w)
-		if err != nil {
-			return err
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
 		}
+
+		return nil, err
 	}
-	return nil
-}
+	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
-func (v _List_DynamicConfigValue_ValueList) Size() int {
-	return len(v)
-}
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
 
-func (_List_DynamicConfigValue_ValueList) ValueType() wire.Type {
-	return wire.TStruct
 }
 
-func (_List_DynamicConfigValue_ValueList) Close() {}
+// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+//
+// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeHistoryHost_Result struct {
+	// Value returned by DescribeHistoryHost after a successful execution.
+	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
 
-// ToWire translates a UpdateDynamicConfigRequest struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7399,66 +12479,68 @@ func (_List_DynamicConfigValue_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *UpdateDynamicConfigRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ConfigName != nil {
-		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.ConfigValues != nil {
-		w, err = wire.NewValueList(_List_DynamicConfigValue_ValueList(v.ConfigValues)), error(nil)
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DynamicConfigValue_Read(w wire.Value) (*config.DynamicConfigValue, error) {
-	var v config.DynamicConfigValue
+func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_DynamicConfigValue_Read(l wire.ValueList) ([]*config.DynamicConfigValue, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*config.DynamicConfigValue, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DynamicConfigValue_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a UpdateDynamicConfigRequest struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDynamicConfigRequest struct
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7466,75 +12548,85 @@ func _List_DynamicConfigValue_Read(l wire.ValueList) ([]*config.DynamicConfigVal
 //     return nil, err
 //   }
 //
-//   var v UpdateDynamicConfigRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *UpdateDynamicConfigRequest) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeHistoryHost_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ConfigName = &x
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.ConfigValues, err = _List_DynamicConfigValue_Read(field.Value.GetList())
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-	return nil
-}
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-func _List_DynamicConfigValue_Encode(val []*config.DynamicConfigValue, sw stream.Writer) error {
+			}
+		}
+	}
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
+	count := 0
+	if v.Success != nil {
+		count++
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
+	if v.BadRequestError != nil {
+		count++
 	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*config.DynamicConfigValue', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
+	if v.InternalServiceError != nil {
+		count++
 	}
-	return sw.WriteListEnd()
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
 }
 
-// Encode serializes a UpdateDynamicConfigRequest struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeHistoryHost_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpdateDynamicConfigRequest struct could not be encoded.
-func (v *UpdateDynamicConfigRequest) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeHistoryHost_Result struct could not be encoded.
+func (v *AdminService_DescribeHistoryHost_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ConfigName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7542,11 +12634,11 @@ func (v *UpdateDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ConfigValues != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _List_DynamicConfigValue_Encode(v.ConfigValues, sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7554,51 +12646,63 @@ func (v *UpdateDynamicConfigRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _DynamicConfigValue_Decode(sr stream.Reader) (*config.DynamicConfigValue, error) {
-	var v config.DynamicConfigValue
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_DynamicConfigValue_Decode(sr stream.Reader) ([]*config.DynamicConfigValue, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.AccessDeniedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*config.DynamicConfigValue, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _DynamicConfigValue_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
 	}
-	return o, err
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a UpdateDynamicConfigRequest struct directly from its Thrift-level
+func _DescribeHistoryHostResponse_Decode(sr stream.Reader) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_DescribeHistoryHost_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpdateDynamicConfigRequest struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeHistoryHost_Result struct could not be generated from the wire
 // representation.
-func (v *UpdateDynamicConfigRequest) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeHistoryHost_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7611,16 +12715,26 @@ func (v *UpdateDynamicConfigRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ConfigName = &x
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeHistoryHostResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.ConfigValues, err = _List_DynamicConfigValue_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -7644,145 +12758,185 @@ func (v *UpdateDynamicConfigRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDynamicConfigRequest
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
 // struct.
-func (v *UpdateDynamicConfigRequest) String() string {
+func (v *AdminService_DescribeHistoryHost_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.ConfigName != nil {
-		fields[i] = fmt.Sprintf("ConfigName: %v", *(v.ConfigName))
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.ConfigValues != nil {
-		fields[i] = fmt.Sprintf("ConfigValues: %v", v.ConfigValues)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-
-	return fmt.Sprintf("UpdateDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_DynamicConfigValue_Equals(lhs, rhs []*config.DynamicConfigValue) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
 	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDynamicConfigRequest match the
-// provided UpdateDynamicConfigRequest.
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
+// provided AdminService_DescribeHistoryHost_Result.
 //
 // This function performs a deep comparison.
-func (v *UpdateDynamicConfigRequest) Equals(rhs *UpdateDynamicConfigRequest) bool {
+func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ConfigName, rhs.ConfigName) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.ConfigValues == nil && rhs.ConfigValues == nil) || (v.ConfigValues != nil && rhs.ConfigValues != nil && _List_DynamicConfigValue_Equals(v.ConfigValues, rhs.ConfigValues))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DynamicConfigValue_Zapper []*config.DynamicConfigValue
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DynamicConfigValue_Zapper.
-func (l _List_DynamicConfigValue_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpdateDynamicConfigRequest.
-func (v *UpdateDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeHistoryHost_Result.
+func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ConfigName != nil {
-		enc.AddString("configName", *v.ConfigName)
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.ConfigValues != nil {
-		err = multierr.Append(err, enc.AddArray("configValues", (_List_DynamicConfigValue_Zapper)(v.ConfigValues)))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetConfigName returns the value of ConfigName if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *UpdateDynamicConfigRequest) GetConfigName() (o string) {
-	if v != nil && v.ConfigName != nil {
-		return *v.ConfigName
+func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetConfigName returns true if ConfigName is not nil.
-func (v *UpdateDynamicConfigRequest) IsSetConfigName() bool {
-	return v != nil && v.ConfigName != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetConfigValues returns the value of ConfigValues if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *UpdateDynamicConfigRequest) GetConfigValues() (o []*config.DynamicConfigValue) {
-	if v != nil && v.ConfigValues != nil {
-		return v.ConfigValues
+func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetConfigValues returns true if ConfigValues is not nil.
-func (v *UpdateDynamicConfigRequest) IsSetConfigValues() bool {
-	return v != nil && v.ConfigValues != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "admin",
-	Package:  "github.com/uber/cadence/.gen/go/admin",
-	FilePath: "admin.thrift",
-	SHA1:     "d60357c2526ae877750859fb07d8fafd7483998f",
-	Includes: []*thriftreflect.ThriftModule{
-		config.ThriftModule,
-		replicator.ThriftModule,
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\ninclude \"config.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privilege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeShardDistribution returns information about history shards within the cluster\n  **/\n  shared.DescribeShardDistributionResponse DescribeShardDistribution(1: shared.DescribeShardDistributionRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void ResetQueue(1: shared.ResetQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  * StartEventId defines the beginning of the event to fetch. The first event is inclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\n  GetWorkflowExecutionRawHistoryV2Response GetWorkflowExecutionRawHistoryV2(1: GetWorkflowExecutionRawHistoryV2Request getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReapplyEvents applies stale events to the current workflow and current run\n  **/\n  void ReapplyEvents(1: shared.ReapplyEventsRequest reapplyEventsRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeCluster returns information about cadence cluster\n  **/\n  DescribeClusterResponse DescribeCluster()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReadDLQMessages returns messages from DLQ\n  **/\n  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PurgeDLQMessages purges messages from DLQ\n  **/\n  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * MergeDLQMessages merges messages from DLQ\n  **/\n  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster\n  **/\n  void ResendReplicationTasks(1: ResendReplicationTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * GetCrossClusterTasks fetches cross cluster tasks\n  **/\n  shared.GetCrossClusterTasksResponse GetCrossClusterTasks(1: shared.GetCrossClusterTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondCrossClusterTasksCompleted responds the result of processing cross cluster tasks\n  **/\n  shared.RespondCrossClusterTasksCompletedResponse RespondCrossClusterTasksCompleted(1: shared.RespondCrossClusterTasksCompletedRequest request) \n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetDynamicConfig returns values associated with a specified dynamic config parameter.\n  **/\n  GetDynamicConfigResponse GetDynamicConfig(1: GetDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void UpdateDynamicConfig(1: UpdateDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void RestoreDynamicConfig(1: RestoreDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  ListDynamicConfigResponse ListDynamicConfig(1: ListDynamicConfigRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\n/**\n  * StartEventId defines the beginning of the event to fetch. The first event is exclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\nstruct GetWorkflowExecutionRawHistoryV2Request {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") startEventId\n  40: optional i64 (js.type = \"Long\") startEventVersion\n  50: optional i64 (js.type = \"Long\") endEventId\n  60: optional i64 (js.type = \"Long\") endEventVersion\n  70: optional i32 maximumPageSize\n  80: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryV2Response {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional shared.VersionHistory versionHistory\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n  20: optional string securityToken\n}\n\nstruct HostInfo {\n  10: optional string Identity\n}\n\nstruct RingInfo {\n  10: optional string role\n  20: optional i32 memberCount\n  30: optional list<HostInfo> members\n}\n\nstruct MembershipInfo {\n  10: optional HostInfo currentHost\n  20: optional list<string> reachableMembers\n  30: optional list<RingInfo> rings\n}\n\nstruct PersistenceSetting {\n  10: optional string key\n  20: optional string value\n}\n\nstruct PersistenceFeature {\n  10: optional string key\n  20: optional bool enabled\n}\n\nstruct PersistenceInfo {\n  10: optional string backend\n  20: optional list<PersistenceSetting> settings\n  30: optional list<PersistenceFeature> features\n}\n\nstruct DescribeClusterResponse {\n  10: optional shared.SupportedClientVersions supportedClientVersions\n  20: optional MembershipInfo membershipInfo\n  30: optional map<string,PersistenceInfo> persistenceInfo\n}\n\nstruct ResendReplicationTasksRequest {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string remoteCluster\n  50: optional i64 (js.type = \"Long\") startEventID\n  60: optional i64 (js.type = \"Long\") startVersion\n  70: optional i64 (js.type = \"Long\") endEventID\n  80: optional i64 (js.type = \"Long\") endVersion\n}\n\nstruct GetDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct GetDynamicConfigResponse {\n  10: optional shared.DataBlob value\n}\n\nstruct UpdateDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigValue> configValues\n}\n\nstruct RestoreDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\n//Eventually remove configName and integrate this functionality into Get.\n//GetDynamicConfigResponse would need to change as well.\nstruct ListDynamicConfigRequest {\n  10: optional string configName\n}\n\nstruct ListDynamicConfigResponse {\n  10: optional list<config.DynamicConfigEntry> entries\n}\n\n"
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
 
-// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
 //
-// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Args struct {
-	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
+	return "DescribeHistoryHost"
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeQueue_Args represents the arguments for the AdminService.DescribeQueue function.
+//
+// The arguments for DescribeQueue are sent and received over the wire as this struct.
+type AdminService_DescribeQueue_Args struct {
+	Request *shared.DescribeQueueRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeQueue_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7794,10 +12948,10 @@ type AdminService_AddSearchAttribute_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeQueue_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7817,17 +12971,17 @@ func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
+func _DescribeQueueRequest_Read(w wire.Value) (*shared.DescribeQueueRequest, error) {
+	var v shared.DescribeQueueRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeQueue_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// An error is returned if we were unable to build a AdminService_DescribeQueue_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7835,19 +12989,19 @@ func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest,
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeQueue_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeQueue_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				v.Request, err = _DescribeQueueRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7859,11 +13013,11 @@ func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_AddSearchAttribute_Args struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeQueue_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_AddSearchAttribute_Args struct could not be encoded.
-func (v *AdminService_AddSearchAttribute_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeQueue_Args struct could not be encoded.
+func (v *AdminService_DescribeQueue_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -7883,18 +13037,18 @@ func (v *AdminService_AddSearchAttribute_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _AddSearchAttributeRequest_Decode(sr stream.Reader) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
+func _DescribeQueueRequest_Decode(sr stream.Reader) (*shared.DescribeQueueRequest, error) {
+	var v shared.DescribeQueueRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_AddSearchAttribute_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_DescribeQueue_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_AddSearchAttribute_Args struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeQueue_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_AddSearchAttribute_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeQueue_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7908,7 +13062,7 @@ func (v *AdminService_AddSearchAttribute_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _AddSearchAttributeRequest_Decode(sr)
+			v.Request, err = _DescribeQueueRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -7935,9 +13089,9 @@ func (v *AdminService_AddSearchAttribute_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// String returns a readable string representation of a AdminService_DescribeQueue_Args
 // struct.
-func (v *AdminService_AddSearchAttribute_Args) String() string {
+func (v *AdminService_DescribeQueue_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7949,14 +13103,14 @@ func (v *AdminService_AddSearchAttribute_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeQueue_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
-// provided AdminService_AddSearchAttribute_Args.
+// Equals returns true if all the fields of this AdminService_DescribeQueue_Args match the
+// provided AdminService_DescribeQueue_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+func (v *AdminService_DescribeQueue_Args) Equals(rhs *AdminService_DescribeQueue_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7970,8 +13124,8 @@ func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Args.
-func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeQueue_Args.
+func (v *AdminService_DescribeQueue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7983,7 +13137,7 @@ func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.Obje
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+func (v *AdminService_DescribeQueue_Args) GetRequest() (o *shared.DescribeQueueRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -7992,119 +13146,118 @@ func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttribu
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+func (v *AdminService_DescribeQueue_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "DescribeQueue" for this struct.
+func (v *AdminService_DescribeQueue_Args) MethodName() string {
+	return "DescribeQueue"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeQueue_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.AddSearchAttribute
+// AdminService_DescribeQueue_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeQueue
 // function.
-var AdminService_AddSearchAttribute_Helper = struct {
-	// Args accepts the parameters of AddSearchAttribute in-order and returns
+var AdminService_DescribeQueue_Helper = struct {
+	// Args accepts the parameters of DescribeQueue in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args
+		request *shared.DescribeQueueRequest,
+	) *AdminService_DescribeQueue_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddSearchAttribute.
+	// by DescribeQueue.
 	//
-	// An error can be thrown by AddSearchAttribute only if the
+	// An error can be thrown by DescribeQueue only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddSearchAttribute
-	// given the error returned by it. The provided error may
-	// be nil if AddSearchAttribute did not fail.
+	// WrapResponse returns the result struct for DescribeQueue
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddSearchAttribute into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddSearchAttribute
+	// This allows mapping values and errors returned by
+	// DescribeQueue into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeQueue
 	//
-	//   err := AddSearchAttribute(args)
-	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   value, err := DescribeQueue(args)
+	//   result, err := AdminService_DescribeQueue_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeQueue: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+	WrapResponse func(*shared.DescribeQueueResponse, error) (*AdminService_DescribeQueue_Result, error)
 
-	// UnwrapResponse takes the result struct for AddSearchAttribute
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeQueue
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddSearchAttribute threw an
+	// The error is non-nil only if DescribeQueue threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+	//   value, err := AdminService_DescribeQueue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeQueue_Result) (*shared.DescribeQueueResponse, error)
 }{}
 
 func init() {
-	AdminService_AddSearchAttribute_Helper.Args = func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args {
-		return &AdminService_AddSearchAttribute_Args{
+	AdminService_DescribeQueue_Helper.Args = func(
+		request *shared.DescribeQueueRequest,
+	) *AdminService_DescribeQueue_Args {
+		return &AdminService_DescribeQueue_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+	AdminService_DescribeQueue_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+	AdminService_DescribeQueue_Helper.WrapResponse = func(success *shared.DescribeQueueResponse, err error) (*AdminService_DescribeQueue_Result, error) {
 		if err == nil {
-			return &AdminService_AddSearchAttribute_Result{}, nil
+			return &AdminService_DescribeQueue_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.BadRequestError")
 			}
-			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+			return &AdminService_DescribeQueue_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.InternalServiceError")
 			}
-			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_DescribeQueue_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.AccessDeniedError")
 			}
-			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+			return &AdminService_DescribeQueue_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+	AdminService_DescribeQueue_Helper.UnwrapResponse = func(result *AdminService_DescribeQueue_Result) (success *shared.DescribeQueueResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -8113,25 +13266,36 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+// AdminService_DescribeQueue_Result represents the result of a AdminService.DescribeQueue function call.
 //
-// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a DescribeQueue execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeQueue_Result struct {
+	// Value returned by DescribeQueue after a successful execution.
+	Success              *shared.DescribeQueueResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError     `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeQueue_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8143,17 +13307,25 @@ type AdminService_AddSearchAttribute_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeQueue_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -8170,8 +13342,8 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -8179,36 +13351,24 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
+func _DescribeQueueResponse_Read(w wire.Value) (*shared.DescribeQueueResponse, error) {
+	var v shared.DescribeQueueResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeQueue_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// An error is returned if we were unable to build a AdminService_DescribeQueue_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8216,16 +13376,24 @@ func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeQueue_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeQueue_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeQueueResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -8244,7 +13412,7 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8254,31 +13422,46 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_AddSearchAttribute_Result struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeQueue_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_AddSearchAttribute_Result struct could not be encoded.
-func (v *AdminService_AddSearchAttribute_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeQueue_Result struct could not be encoded.
+func (v *AdminService_DescribeQueue_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -8303,11 +13486,11 @@ func (v *AdminService_AddSearchAttribute_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8316,47 +13499,38 @@ func (v *AdminService_AddSearchAttribute_Result) Encode(sw stream.Writer) error
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
 
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
+func _DescribeQueueResponse_Decode(sr stream.Reader) (*shared.DescribeQueueResponse, error) {
+	var v shared.DescribeQueueResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_AddSearchAttribute_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_DescribeQueue_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_AddSearchAttribute_Result struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeQueue_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_AddSearchAttribute_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeQueue_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8369,6 +13543,12 @@ func (v *AdminService_AddSearchAttribute_Result) Decode(sr stream.Reader) error
 
 	for ok {
 		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeQueueResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -8382,7 +13562,7 @@ func (v *AdminService_AddSearchAttribute_Result) Decode(sr stream.Reader) error
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -8407,31 +13587,38 @@ func (v *AdminService_AddSearchAttribute_Result) Decode(sr stream.Reader) error
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// String returns a readable string representation of a AdminService_DescribeQueue_Result
 // struct.
-func (v *AdminService_AddSearchAttribute_Result) String() string {
+func (v *AdminService_DescribeQueue_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -8440,31 +13627,34 @@ func (v *AdminService_AddSearchAttribute_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeQueue_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
-// provided AdminService_AddSearchAttribute_Result.
+// Equals returns true if all the fields of this AdminService_DescribeQueue_Result match the
+// provided AdminService_DescribeQueue_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+func (v *AdminService_DescribeQueue_Result) Equals(rhs *AdminService_DescribeQueue_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -8472,26 +13662,44 @@ func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSea
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Result.
-func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeQueue_Result.
+func (v *AdminService_DescribeQueue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Result) GetSuccess() (o *shared.DescribeQueueResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_DescribeQueue_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8500,13 +13708,13 @@ func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+func (v *AdminService_DescribeQueue_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_DescribeQueue_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -8515,48 +13723,48 @@ func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_DescribeQueue_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_DescribeQueue_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "DescribeQueue" for this struct.
+func (v *AdminService_DescribeQueue_Result) MethodName() string {
+	return "DescribeQueue"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeQueue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+// AdminService_DescribeShardDistribution_Args represents the arguments for the AdminService.DescribeShardDistribution function.
 //
-// The arguments for CloseShard are sent and received over the wire as this struct.
-type AdminService_CloseShard_Args struct {
-	Request *shared.CloseShardRequest `json:"request,omitempty"`
+// The arguments for DescribeShardDistribution are sent and received over the wire as this struct.
+type AdminService_DescribeShardDistribution_Args struct {
+	Request *shared.DescribeShardDistributionRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeShardDistribution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8568,10 +13776,10 @@ type AdminService_CloseShard_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeShardDistribution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -8591,17 +13799,17 @@ func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
-	var v shared.CloseShardRequest
+func _DescribeShardDistributionRequest_Read(w wire.Value) (*shared.DescribeShardDistributionRequest, error) {
+	var v shared.DescribeShardDistributionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeShardDistribution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8609,19 +13817,19 @@ func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeShardDistribution_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeShardDistribution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CloseShardRequest_Read(field.Value)
+				v.Request, err = _DescribeShardDistributionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8633,11 +13841,11 @@ func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_CloseShard_Args struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeShardDistribution_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_CloseShard_Args struct could not be encoded.
-func (v *AdminService_CloseShard_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeShardDistribution_Args struct could not be encoded.
+func (v *AdminService_DescribeShardDistribution_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -8657,18 +13865,18 @@ func (v *AdminService_CloseShard_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _CloseShardRequest_Decode(sr stream.Reader) (*shared.CloseShardRequest, error) {
-	var v shared.CloseShardRequest
+func _DescribeShardDistributionRequest_Decode(sr stream.Reader) (*shared.DescribeShardDistributionRequest, error) {
+	var v shared.DescribeShardDistributionRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_CloseShard_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_DescribeShardDistribution_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_CloseShard_Args struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeShardDistribution_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_CloseShard_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeShardDistribution_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8682,7 +13890,7 @@ func (v *AdminService_CloseShard_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _CloseShardRequest_Decode(sr)
+			v.Request, err = _DescribeShardDistributionRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -8709,9 +13917,9 @@ func (v *AdminService_CloseShard_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Args
+// String returns a readable string representation of a AdminService_DescribeShardDistribution_Args
 // struct.
-func (v *AdminService_CloseShard_Args) String() string {
+func (v *AdminService_DescribeShardDistribution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -8723,14 +13931,14 @@ func (v *AdminService_CloseShard_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeShardDistribution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
-// provided AdminService_CloseShard_Args.
+// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Args match the
+// provided AdminService_DescribeShardDistribution_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+func (v *AdminService_DescribeShardDistribution_Args) Equals(rhs *AdminService_DescribeShardDistribution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8744,8 +13952,8 @@ func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Args.
-func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeShardDistribution_Args.
+func (v *AdminService_DescribeShardDistribution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -8757,7 +13965,7 @@ func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+func (v *AdminService_DescribeShardDistribution_Args) GetRequest() (o *shared.DescribeShardDistributionRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -8766,146 +13974,132 @@ func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+func (v *AdminService_DescribeShardDistribution_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Args) MethodName() string {
-	return "CloseShard"
+// This will always be "DescribeShardDistribution" for this struct.
+func (v *AdminService_DescribeShardDistribution_Args) MethodName() string {
+	return "DescribeShardDistribution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeShardDistribution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_CloseShard_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.CloseShard
+// AdminService_DescribeShardDistribution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeShardDistribution
 // function.
-var AdminService_CloseShard_Helper = struct {
-	// Args accepts the parameters of CloseShard in-order and returns
+var AdminService_DescribeShardDistribution_Helper = struct {
+	// Args accepts the parameters of DescribeShardDistribution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args
+		request *shared.DescribeShardDistributionRequest,
+	) *AdminService_DescribeShardDistribution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CloseShard.
+	// by DescribeShardDistribution.
 	//
-	// An error can be thrown by CloseShard only if the
+	// An error can be thrown by DescribeShardDistribution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CloseShard
-	// given the error returned by it. The provided error may
-	// be nil if CloseShard did not fail.
+	// WrapResponse returns the result struct for DescribeShardDistribution
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by CloseShard into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// CloseShard
+	// This allows mapping values and errors returned by
+	// DescribeShardDistribution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeShardDistribution
 	//
-	//   err := CloseShard(args)
-	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   value, err := DescribeShardDistribution(args)
+	//   result, err := AdminService_DescribeShardDistribution_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeShardDistribution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+	WrapResponse func(*shared.DescribeShardDistributionResponse, error) (*AdminService_DescribeShardDistribution_Result, error)
 
-	// UnwrapResponse takes the result struct for CloseShard
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeShardDistribution
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if CloseShard threw an
+	// The error is non-nil only if DescribeShardDistribution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_CloseShard_Result) error
+	//   value, err := AdminService_DescribeShardDistribution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeShardDistribution_Result) (*shared.DescribeShardDistributionResponse, error)
 }{}
 
 func init() {
-	AdminService_CloseShard_Helper.Args = func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args {
-		return &AdminService_CloseShard_Args{
+	AdminService_DescribeShardDistribution_Helper.Args = func(
+		request *shared.DescribeShardDistributionRequest,
+	) *AdminService_DescribeShardDistribution_Args {
+		return &AdminService_DescribeShardDistribution_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+	AdminService_DescribeShardDistribution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
-		case *shared.BadRequestError:
-			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.AccessDeniedError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+	AdminService_DescribeShardDistribution_Helper.WrapResponse = func(success *shared.DescribeShardDistributionResponse, err error) (*AdminService_DescribeShardDistribution_Result, error) {
 		if err == nil {
-			return &AdminService_CloseShard_Result{}, nil
+			return &AdminService_DescribeShardDistribution_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
-		case *shared.BadRequestError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
-			}
-			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
-			}
-			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardDistribution_Result.InternalServiceError")
 			}
-			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+			return &AdminService_DescribeShardDistribution_Result{InternalServiceError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
-		if result.BadRequestError != nil {
-			err = result.BadRequestError
-			return
-		}
+	AdminService_DescribeShardDistribution_Helper.UnwrapResponse = func(result *AdminService_DescribeShardDistribution_Result) (success *shared.DescribeShardDistributionResponse, err error) {
 		if result.InternalServiceError != nil {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+// AdminService_DescribeShardDistribution_Result represents the result of a AdminService.DescribeShardDistribution function call.
 //
-// The result of a CloseShard execution is sent and received over the wire as this struct.
-type AdminService_CloseShard_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+// The result of a DescribeShardDistribution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeShardDistribution_Result struct {
+	// Value returned by DescribeShardDistribution after a successful execution.
+	Success              *shared.DescribeShardDistributionResponse `json:"success,omitempty"`
+	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeShardDistribution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8917,60 +14111,52 @@ type AdminService_CloseShard_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeShardDistribution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.BadRequestError != nil {
-		w, err = v.BadRequestError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 1, Value: w}
-		i++
-	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 2, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
+func _DescribeShardDistributionResponse_Read(w wire.Value) (*shared.DescribeShardDistributionResponse, error) {
+	var v shared.DescribeShardDistributionResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeShardDistribution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8978,74 +14164,63 @@ func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeShardDistribution_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeShardDistribution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.Success, err = _DescribeShardDistributionResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_CloseShard_Result struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeShardDistribution_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_CloseShard_Result struct could not be encoded.
-func (v *AdminService_CloseShard_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeShardDistribution_Result struct could not be encoded.
+func (v *AdminService_DescribeShardDistribution_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9054,7 +14229,7 @@ func (v *AdminService_CloseShard_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.InternalServiceError.Encode(sw); err != nil {
@@ -9065,48 +14240,33 @@ func (v *AdminService_CloseShard_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.AccessDeniedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.AccessDeniedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 
-	if count > 1 {
-		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _AccessDeniedError_Decode(sr stream.Reader) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
+func _DescribeShardDistributionResponse_Decode(sr stream.Reader) (*shared.DescribeShardDistributionResponse, error) {
+	var v shared.DescribeShardDistributionResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_CloseShard_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_DescribeShardDistribution_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_CloseShard_Result struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeShardDistribution_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_CloseShard_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeShardDistribution_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9119,24 +14279,18 @@ func (v *AdminService_CloseShard_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.BadRequestError, err = _BadRequestError_Decode(sr)
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeShardDistributionResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 2 && fh.Type == wire.TStruct:
+		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9157,106 +14311,93 @@ func (v *AdminService_CloseShard_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Result
+// String returns a readable string representation of a AdminService_DescribeShardDistribution_Result
 // struct.
-func (v *AdminService_CloseShard_Result) String() string {
+func (v *AdminService_DescribeShardDistribution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.BadRequestError != nil {
-		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
 	if v.InternalServiceError != nil {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
-		i++
-	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeShardDistribution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
-// provided AdminService_CloseShard_Result.
+// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Result match the
+// provided AdminService_DescribeShardDistribution_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+func (v *AdminService_DescribeShardDistribution_Result) Equals(rhs *AdminService_DescribeShardDistribution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Result.
-func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeShardDistribution_Result.
+func (v *AdminService_DescribeShardDistribution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.BadRequestError != nil {
-		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
-	}
 	return err
 }
 
-// GetBadRequestError returns the value of BadRequestError if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
-	if v != nil && v.BadRequestError != nil {
-		return v.BadRequestError
+func (v *AdminService_DescribeShardDistribution_Result) GetSuccess() (o *shared.DescribeShardDistributionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
-	return v != nil && v.BadRequestError != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeShardDistribution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_DescribeShardDistribution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9265,47 +14406,33 @@ func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_DescribeShardDistribution_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
-	}
-
-	return
-}
-
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Result) MethodName() string {
-	return "CloseShard"
+// This will always be "DescribeShardDistribution" for this struct.
+func (v *AdminService_DescribeShardDistribution_Result) MethodName() string {
+	return "DescribeShardDistribution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeShardDistribution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeCluster_Args represents the arguments for the AdminService.DescribeCluster function.
+// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
 //
-// The arguments for DescribeCluster are sent and received over the wire as this struct.
-type AdminService_DescribeCluster_Args struct {
+// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DescribeWorkflowExecution_Args struct {
+	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeCluster_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9317,23 +14444,40 @@ type AdminService_DescribeCluster_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeCluster_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [1]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_DescribeCluster_Args struct from its Thrift-level
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeCluster_Args struct
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9341,39 +14485,66 @@ func (v *AdminService_DescribeCluster_Args) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeCluster_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeCluster_Args) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeWorkflowExecution_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeCluster_Args struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeWorkflowExecution_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeCluster_Args struct could not be encoded.
-func (v *AdminService_DescribeCluster_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeWorkflowExecution_Args struct could not be encoded.
+func (v *AdminService_DescribeWorkflowExecution_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_DescribeCluster_Args struct directly from its Thrift-level
+func _DescribeWorkflowExecutionRequest_Decode(sr stream.Reader) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_DescribeWorkflowExecution_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeCluster_Args struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeWorkflowExecution_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeCluster_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeWorkflowExecution_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9386,6 +14557,12 @@ func (v *AdminService_DescribeCluster_Args) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _DescribeWorkflowExecutionRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9408,143 +14585,196 @@ func (v *AdminService_DescribeCluster_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeCluster_Args
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
 // struct.
-func (v *AdminService_DescribeCluster_Args) String() string {
+func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [1]string
 	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_DescribeCluster_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeCluster_Args match the
-// provided AdminService_DescribeCluster_Args.
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
+// provided AdminService_DescribeWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeCluster_Args) Equals(rhs *AdminService_DescribeCluster_Args) bool {
+func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeCluster_Args.
-func (v *AdminService_DescribeCluster_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeWorkflowExecution_Args.
+func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
 	return err
 }
 
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeCluster" for this struct.
-func (v *AdminService_DescribeCluster_Args) MethodName() string {
-	return "DescribeCluster"
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
+	return "DescribeWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeCluster_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeCluster_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeCluster
+// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeWorkflowExecution
 // function.
-var AdminService_DescribeCluster_Helper = struct {
-	// Args accepts the parameters of DescribeCluster in-order and returns
+var AdminService_DescribeWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
 	// the arguments struct for the function.
-	Args func() *AdminService_DescribeCluster_Args
+	Args func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeCluster.
+	// by DescribeWorkflowExecution.
 	//
-	// An error can be thrown by DescribeCluster only if the
+	// An error can be thrown by DescribeWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeCluster
+	// WrapResponse returns the result struct for DescribeWorkflowExecution
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeCluster into a serializable result struct.
+	// DescribeWorkflowExecution into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeCluster
+	// error cannot be thrown by DescribeWorkflowExecution
 	//
-	//   value, err := DescribeCluster(args)
-	//   result, err := AdminService_DescribeCluster_Helper.WrapResponse(value, err)
+	//   value, err := DescribeWorkflowExecution(args)
+	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeCluster: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeClusterResponse, error) (*AdminService_DescribeCluster_Result, error)
+	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeCluster
+	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeCluster threw an
+	// The error is non-nil only if DescribeWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeCluster_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeCluster_Result) (*DescribeClusterResponse, error)
+	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeCluster_Helper.Args = func() *AdminService_DescribeCluster_Args {
-		return &AdminService_DescribeCluster_Args{}
+	AdminService_DescribeWorkflowExecution_Helper.Args = func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args {
+		return &AdminService_DescribeWorkflowExecution_Args{
+			Request: request,
+		}
 	}
 
-	AdminService_DescribeCluster_Helper.IsException = func(err error) bool {
+	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeCluster_Helper.WrapResponse = func(success *DescribeClusterResponse, err error) (*AdminService_DescribeCluster_Result, error) {
+	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeCluster_Result{Success: success}, nil
+			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeCluster_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
 			}
-			return &AdminService_DescribeCluster_Result{ServiceBusyError: e}, nil
+			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeCluster_Helper.UnwrapResponse = func(result *AdminService_DescribeCluster_Result) (success *DescribeClusterResponse, err error) {
+	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
 		if result.InternalServiceError != nil {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
 
@@ -9559,19 +14789,21 @@ func init() {
 
 }
 
-// AdminService_DescribeCluster_Result represents the result of a AdminService.DescribeCluster function call.
+// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
 //
-// The result of a DescribeCluster execution is sent and received over the wire as this struct.
+// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_DescribeCluster_Result struct {
-	// Value returned by DescribeCluster after a successful execution.
-	Success              *DescribeClusterResponse     `json:"success,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+type AdminService_DescribeWorkflowExecution_Result struct {
+	// Value returned by DescribeWorkflowExecution after a successful execution.
+	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeCluster_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9583,12 +14815,12 @@ type AdminService_DescribeCluster_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeCluster_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9602,41 +14834,63 @@ func (v *AdminService_DescribeCluster_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeClusterResponse_Read(w wire.Value) (*DescribeClusterResponse, error) {
-	var v DescribeClusterResponse
+func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeCluster_Result struct from its Thrift-level
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeCluster_Result struct
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9644,35 +14898,51 @@ func _DescribeClusterResponse_Read(w wire.Value) (*DescribeClusterResponse, erro
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeCluster_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeCluster_Result) FromWire(w wire.Value) error {
+//	var v AdminService_DescribeWorkflowExecution_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeClusterResponse_Read(field.Value)
+				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9685,24 +14955,30 @@ func (v *AdminService_DescribeCluster_Result) FromWire(w wire.Value) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeCluster_Result struct directly into bytes, without going
+// Encode serializes a AdminService_DescribeWorkflowExecution_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeCluster_Result struct could not be encoded.
-func (v *AdminService_DescribeCluster_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_DescribeWorkflowExecution_Result struct could not be encoded.
+func (v *AdminService_DescribeWorkflowExecution_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -9719,11 +14995,11 @@ func (v *AdminService_DescribeCluster_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9731,11 +15007,35 @@ func (v *AdminService_DescribeCluster_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AccessDeniedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9747,32 +15047,44 @@ func (v *AdminService_DescribeCluster_Result) Encode(sw stream.Writer) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeClusterResponse_Decode(sr stream.Reader) (*DescribeClusterResponse, error) {
-	var v DescribeClusterResponse
+func _DescribeWorkflowExecutionResponse_Decode(sr stream.Reader) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeCluster_Result struct directly from its Thrift-level
+func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_DescribeWorkflowExecution_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeCluster_Result struct could not be generated from the wire
+// An error is returned if a AdminService_DescribeWorkflowExecution_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeCluster_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_DescribeWorkflowExecution_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9786,19 +15098,31 @@ func (v *AdminService_DescribeCluster_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeClusterResponse_Decode(sr)
+			v.Success, err = _DescribeWorkflowExecutionResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -9826,49 +15150,63 @@ func (v *AdminService_DescribeCluster_Result) Decode(sr stream.Reader) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeCluster_Result
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
 // struct.
-func (v *AdminService_DescribeCluster_Result) String() string {
+func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
 	if v.InternalServiceError != nil {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeCluster_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeCluster_Result match the
-// provided AdminService_DescribeCluster_Result.
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
+// provided AdminService_DescribeWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeCluster_Result) Equals(rhs *AdminService_DescribeCluster_Result) bool {
+func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -9877,10 +15215,16 @@ func (v *AdminService_DescribeCluster_Result) Equals(rhs *AdminService_DescribeC
 	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -9888,26 +15232,32 @@ func (v *AdminService_DescribeCluster_Result) Equals(rhs *AdminService_DescribeC
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeCluster_Result.
-func (v *AdminService_DescribeCluster_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeWorkflowExecution_Result.
+func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Success != nil {
 		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeCluster_Result) GetSuccess() (o *DescribeClusterResponse) {
+func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -9916,13 +15266,28 @@ func (v *AdminService_DescribeCluster_Result) GetSuccess() (o *DescribeClusterRe
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeCluster_Result) IsSetSuccess() bool {
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeCluster_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9931,48 +15296,63 @@ func (v *AdminService_DescribeCluster_Result) GetInternalServiceError() (o *shar
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeCluster_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeCluster_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_DescribeCluster_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeCluster" for this struct.
-func (v *AdminService_DescribeCluster_Result) MethodName() string {
-	return "DescribeCluster"
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
+	return "DescribeWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeCluster_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+// AdminService_GetCrossClusterTasks_Args represents the arguments for the AdminService.GetCrossClusterTasks function.
 //
-// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
-type AdminService_DescribeHistoryHost_Args struct {
-	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+// The arguments for GetCrossClusterTasks are sent and received over the wire as this struct.
+type AdminService_GetCrossClusterTasks_Args struct {
+	Request *shared.GetCrossClusterTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetCrossClusterTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9984,10 +15364,10 @@ type AdminService_DescribeHistoryHost_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetCrossClusterTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -10007,17 +15387,17 @@ func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _GetCrossClusterTasksRequest_Read(w wire.Value) (*shared.GetCrossClusterTasksRequest, error) {
+	var v shared.GetCrossClusterTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetCrossClusterTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// An error is returned if we were unable to build a AdminService_GetCrossClusterTasks_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10025,19 +15405,19 @@ func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHost
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetCrossClusterTasks_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetCrossClusterTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				v.Request, err = _GetCrossClusterTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10049,11 +15429,11 @@ func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeHistoryHost_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetCrossClusterTasks_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeHistoryHost_Args struct could not be encoded.
-func (v *AdminService_DescribeHistoryHost_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetCrossClusterTasks_Args struct could not be encoded.
+func (v *AdminService_GetCrossClusterTasks_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -10073,18 +15453,18 @@ func (v *AdminService_DescribeHistoryHost_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DescribeHistoryHostRequest_Decode(sr stream.Reader) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _GetCrossClusterTasksRequest_Decode(sr stream.Reader) (*shared.GetCrossClusterTasksRequest, error) {
+	var v shared.GetCrossClusterTasksRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeHistoryHost_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetCrossClusterTasks_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeHistoryHost_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetCrossClusterTasks_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeHistoryHost_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetCrossClusterTasks_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10098,7 +15478,7 @@ func (v *AdminService_DescribeHistoryHost_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeHistoryHostRequest_Decode(sr)
+			v.Request, err = _GetCrossClusterTasksRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10125,9 +15505,9 @@ func (v *AdminService_DescribeHistoryHost_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// String returns a readable string representation of a AdminService_GetCrossClusterTasks_Args
 // struct.
-func (v *AdminService_DescribeHistoryHost_Args) String() string {
+func (v *AdminService_GetCrossClusterTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -10139,14 +15519,14 @@ func (v *AdminService_DescribeHistoryHost_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetCrossClusterTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
-// provided AdminService_DescribeHistoryHost_Args.
+// Equals returns true if all the fields of this AdminService_GetCrossClusterTasks_Args match the
+// provided AdminService_GetCrossClusterTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+func (v *AdminService_GetCrossClusterTasks_Args) Equals(rhs *AdminService_GetCrossClusterTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10160,8 +15540,8 @@ func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_Describ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Args.
-func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetCrossClusterTasks_Args.
+func (v *AdminService_GetCrossClusterTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -10173,7 +15553,7 @@ func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+func (v *AdminService_GetCrossClusterTasks_Args) GetRequest() (o *shared.GetCrossClusterTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -10182,118 +15562,118 @@ func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.Describe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+func (v *AdminService_GetCrossClusterTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "GetCrossClusterTasks" for this struct.
+func (v *AdminService_GetCrossClusterTasks_Args) MethodName() string {
+	return "GetCrossClusterTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetCrossClusterTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeHistoryHost
+// AdminService_GetCrossClusterTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetCrossClusterTasks
 // function.
-var AdminService_DescribeHistoryHost_Helper = struct {
-	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+var AdminService_GetCrossClusterTasks_Helper = struct {
+	// Args accepts the parameters of GetCrossClusterTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args
+		request *shared.GetCrossClusterTasksRequest,
+	) *AdminService_GetCrossClusterTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeHistoryHost.
+	// by GetCrossClusterTasks.
 	//
-	// An error can be thrown by DescribeHistoryHost only if the
+	// An error can be thrown by GetCrossClusterTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeHistoryHost
+	// WrapResponse returns the result struct for GetCrossClusterTasks
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeHistoryHost into a serializable result struct.
+	// GetCrossClusterTasks into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeHistoryHost
+	// error cannot be thrown by GetCrossClusterTasks
 	//
-	//   value, err := DescribeHistoryHost(args)
-	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   value, err := GetCrossClusterTasks(args)
+	//   result, err := AdminService_GetCrossClusterTasks_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//     return fmt.Errorf("unexpected error from GetCrossClusterTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+	WrapResponse func(*shared.GetCrossClusterTasksResponse, error) (*AdminService_GetCrossClusterTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// UnwrapResponse takes the result struct for GetCrossClusterTasks
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeHistoryHost threw an
+	// The error is non-nil only if GetCrossClusterTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+	//   value, err := AdminService_GetCrossClusterTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetCrossClusterTasks_Result) (*shared.GetCrossClusterTasksResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeHistoryHost_Helper.Args = func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args {
-		return &AdminService_DescribeHistoryHost_Args{
+	AdminService_GetCrossClusterTasks_Helper.Args = func(
+		request *shared.GetCrossClusterTasksRequest,
+	) *AdminService_GetCrossClusterTasks_Args {
+		return &AdminService_GetCrossClusterTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+	AdminService_GetCrossClusterTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.AccessDeniedError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+	AdminService_GetCrossClusterTasks_Helper.WrapResponse = func(success *shared.GetCrossClusterTasksResponse, err error) (*AdminService_GetCrossClusterTasks_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+			return &AdminService_GetCrossClusterTasks_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.BadRequestError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+			return &AdminService_GetCrossClusterTasks_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
+			return &AdminService_GetCrossClusterTasks_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.ServiceBusyError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+			return &AdminService_GetCrossClusterTasks_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+	AdminService_GetCrossClusterTasks_Helper.UnwrapResponse = func(result *AdminService_GetCrossClusterTasks_Result) (success *shared.GetCrossClusterTasksResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -10302,8 +15682,8 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
 
@@ -10318,20 +15698,20 @@ func init() {
 
 }
 
-// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+// AdminService_GetCrossClusterTasks_Result represents the result of a AdminService.GetCrossClusterTasks function call.
 //
-// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+// The result of a GetCrossClusterTasks execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_DescribeHistoryHost_Result struct {
-	// Value returned by DescribeHistoryHost after a successful execution.
-	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+type AdminService_GetCrossClusterTasks_Result struct {
+	// Value returned by GetCrossClusterTasks after a successful execution.
+	Success              *shared.GetCrossClusterTasksResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetCrossClusterTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10343,10 +15723,10 @@ type AdminService_DescribeHistoryHost_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetCrossClusterTasks_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -10378,8 +15758,8 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -10388,23 +15768,23 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
+func _GetCrossClusterTasksResponse_Read(w wire.Value) (*shared.GetCrossClusterTasksResponse, error) {
+	var v shared.GetCrossClusterTasksResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetCrossClusterTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// An error is returned if we were unable to build a AdminService_GetCrossClusterTasks_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10412,19 +15792,19 @@ func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHos
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetCrossClusterTasks_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetCrossClusterTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				v.Success, err = _GetCrossClusterTasksResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10448,7 +15828,7 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10467,21 +15847,21 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeHistoryHost_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetCrossClusterTasks_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeHistoryHost_Result struct could not be encoded.
-func (v *AdminService_DescribeHistoryHost_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetCrossClusterTasks_Result struct could not be encoded.
+func (v *AdminService_GetCrossClusterTasks_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -10522,11 +15902,11 @@ func (v *AdminService_DescribeHistoryHost_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.AccessDeniedError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -10544,29 +15924,29 @@ func (v *AdminService_DescribeHistoryHost_Result) Encode(sw stream.Writer) error
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeHistoryHostResponse_Decode(sr stream.Reader) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
+func _GetCrossClusterTasksResponse_Decode(sr stream.Reader) (*shared.GetCrossClusterTasksResponse, error) {
+	var v shared.GetCrossClusterTasksResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeHistoryHost_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetCrossClusterTasks_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeHistoryHost_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetCrossClusterTasks_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeHistoryHost_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetCrossClusterTasks_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10580,7 +15960,7 @@ func (v *AdminService_DescribeHistoryHost_Result) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeHistoryHostResponse_Decode(sr)
+			v.Success, err = _GetCrossClusterTasksResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10598,7 +15978,7 @@ func (v *AdminService_DescribeHistoryHost_Result) Decode(sr stream.Reader) error
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10632,19 +16012,19 @@ func (v *AdminService_DescribeHistoryHost_Result) Decode(sr stream.Reader) error
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// String returns a readable string representation of a AdminService_GetCrossClusterTasks_Result
 // struct.
-func (v *AdminService_DescribeHistoryHost_Result) String() string {
+func (v *AdminService_GetCrossClusterTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -10663,19 +16043,19 @@ func (v *AdminService_DescribeHistoryHost_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetCrossClusterTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
-// provided AdminService_DescribeHistoryHost_Result.
+// Equals returns true if all the fields of this AdminService_GetCrossClusterTasks_Result match the
+// provided AdminService_GetCrossClusterTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+func (v *AdminService_GetCrossClusterTasks_Result) Equals(rhs *AdminService_GetCrossClusterTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10690,7 +16070,7 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -10698,8 +16078,8 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Result.
-func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetCrossClusterTasks_Result.
+func (v *AdminService_GetCrossClusterTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -10712,15 +16092,15 @@ func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.O
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+func (v *AdminService_GetCrossClusterTasks_Result) GetSuccess() (o *shared.GetCrossClusterTasksResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -10729,13 +16109,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+func (v *AdminService_GetCrossClusterTasks_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetCrossClusterTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -10744,13 +16124,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetCrossClusterTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_GetCrossClusterTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -10759,48 +16139,48 @@ func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_GetCrossClusterTasks_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
+func (v *AdminService_GetCrossClusterTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetCrossClusterTasks_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "GetCrossClusterTasks" for this struct.
+func (v *AdminService_GetCrossClusterTasks_Result) MethodName() string {
+	return "GetCrossClusterTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetCrossClusterTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeQueue_Args represents the arguments for the AdminService.DescribeQueue function.
+// AdminService_GetDLQReplicationMessages_Args represents the arguments for the AdminService.GetDLQReplicationMessages function.
 //
-// The arguments for DescribeQueue are sent and received over the wire as this struct.
-type AdminService_DescribeQueue_Args struct {
-	Request *shared.DescribeQueueRequest `json:"request,omitempty"`
+// The arguments for GetDLQReplicationMessages are sent and received over the wire as this struct.
+type AdminService_GetDLQReplicationMessages_Args struct {
+	Request *replicator.GetDLQReplicationMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeQueue_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDLQReplicationMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10812,10 +16192,10 @@ type AdminService_DescribeQueue_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeQueue_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDLQReplicationMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -10835,17 +16215,17 @@ func (v *AdminService_DescribeQueue_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeQueueRequest_Read(w wire.Value) (*shared.DescribeQueueRequest, error) {
-	var v shared.DescribeQueueRequest
+func _GetDLQReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDLQReplicationMessagesRequest, error) {
+	var v replicator.GetDLQReplicationMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeQueue_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDLQReplicationMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeQueue_Args struct
+// An error is returned if we were unable to build a AdminService_GetDLQReplicationMessages_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10853,19 +16233,19 @@ func _DescribeQueueRequest_Read(w wire.Value) (*shared.DescribeQueueRequest, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeQueue_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeQueue_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetDLQReplicationMessages_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDLQReplicationMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeQueueRequest_Read(field.Value)
+				v.Request, err = _GetDLQReplicationMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10877,11 +16257,11 @@ func (v *AdminService_DescribeQueue_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeQueue_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetDLQReplicationMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeQueue_Args struct could not be encoded.
-func (v *AdminService_DescribeQueue_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDLQReplicationMessages_Args struct could not be encoded.
+func (v *AdminService_GetDLQReplicationMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -10901,18 +16281,18 @@ func (v *AdminService_DescribeQueue_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DescribeQueueRequest_Decode(sr stream.Reader) (*shared.DescribeQueueRequest, error) {
-	var v shared.DescribeQueueRequest
+func _GetDLQReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetDLQReplicationMessagesRequest, error) {
+	var v replicator.GetDLQReplicationMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeQueue_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDLQReplicationMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeQueue_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetDLQReplicationMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeQueue_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDLQReplicationMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -10926,7 +16306,7 @@ func (v *AdminService_DescribeQueue_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeQueueRequest_Decode(sr)
+			v.Request, err = _GetDLQReplicationMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -10953,9 +16333,9 @@ func (v *AdminService_DescribeQueue_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeQueue_Args
+// String returns a readable string representation of a AdminService_GetDLQReplicationMessages_Args
 // struct.
-func (v *AdminService_DescribeQueue_Args) String() string {
+func (v *AdminService_GetDLQReplicationMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -10967,14 +16347,14 @@ func (v *AdminService_DescribeQueue_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeQueue_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDLQReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeQueue_Args match the
-// provided AdminService_DescribeQueue_Args.
+// Equals returns true if all the fields of this AdminService_GetDLQReplicationMessages_Args match the
+// provided AdminService_GetDLQReplicationMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeQueue_Args) Equals(rhs *AdminService_DescribeQueue_Args) bool {
+func (v *AdminService_GetDLQReplicationMessages_Args) Equals(rhs *AdminService_GetDLQReplicationMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -10988,8 +16368,8 @@ func (v *AdminService_DescribeQueue_Args) Equals(rhs *AdminService_DescribeQueue
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeQueue_Args.
-func (v *AdminService_DescribeQueue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDLQReplicationMessages_Args.
+func (v *AdminService_GetDLQReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -11001,7 +16381,7 @@ func (v *AdminService_DescribeQueue_Args) MarshalLogObject(enc zapcore.ObjectEnc
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeQueue_Args) GetRequest() (o *shared.DescribeQueueRequest) {
+func (v *AdminService_GetDLQReplicationMessages_Args) GetRequest() (o *replicator.GetDLQReplicationMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -11010,128 +16390,117 @@ func (v *AdminService_DescribeQueue_Args) GetRequest() (o *shared.DescribeQueueR
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeQueue_Args) IsSetRequest() bool {
+func (v *AdminService_GetDLQReplicationMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeQueue" for this struct.
-func (v *AdminService_DescribeQueue_Args) MethodName() string {
-	return "DescribeQueue"
+// This will always be "GetDLQReplicationMessages" for this struct.
+func (v *AdminService_GetDLQReplicationMessages_Args) MethodName() string {
+	return "GetDLQReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeQueue_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDLQReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeQueue_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeQueue
+// AdminService_GetDLQReplicationMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetDLQReplicationMessages
 // function.
-var AdminService_DescribeQueue_Helper = struct {
-	// Args accepts the parameters of DescribeQueue in-order and returns
+var AdminService_GetDLQReplicationMessages_Helper = struct {
+	// Args accepts the parameters of GetDLQReplicationMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeQueueRequest,
-	) *AdminService_DescribeQueue_Args
+		request *replicator.GetDLQReplicationMessagesRequest,
+	) *AdminService_GetDLQReplicationMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeQueue.
+	// by GetDLQReplicationMessages.
 	//
-	// An error can be thrown by DescribeQueue only if the
+	// An error can be thrown by GetDLQReplicationMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeQueue
+	// WrapResponse returns the result struct for GetDLQReplicationMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeQueue into a serializable result struct.
+	// GetDLQReplicationMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeQueue
+	// error cannot be thrown by GetDLQReplicationMessages
 	//
-	//   value, err := DescribeQueue(args)
-	//   result, err := AdminService_DescribeQueue_Helper.WrapResponse(value, err)
+	//   value, err := GetDLQReplicationMessages(args)
+	//   result, err := AdminService_GetDLQReplicationMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeQueue: %v", err)
+	//     return fmt.Errorf("unexpected error from GetDLQReplicationMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeQueueResponse, error) (*AdminService_DescribeQueue_Result, error)
+	WrapResponse func(*replicator.GetDLQReplicationMessagesResponse, error) (*AdminService_GetDLQReplicationMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeQueue
+	// UnwrapResponse takes the result struct for GetDLQReplicationMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeQueue threw an
+	// The error is non-nil only if GetDLQReplicationMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeQueue_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeQueue_Result) (*shared.DescribeQueueResponse, error)
+	//   value, err := AdminService_GetDLQReplicationMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetDLQReplicationMessages_Result) (*replicator.GetDLQReplicationMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeQueue_Helper.Args = func(
-		request *shared.DescribeQueueRequest,
-	) *AdminService_DescribeQueue_Args {
-		return &AdminService_DescribeQueue_Args{
+	AdminService_GetDLQReplicationMessages_Helper.Args = func(
+		request *replicator.GetDLQReplicationMessagesRequest,
+	) *AdminService_GetDLQReplicationMessages_Args {
+		return &AdminService_GetDLQReplicationMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeQueue_Helper.IsException = func(err error) bool {
+	AdminService_GetDLQReplicationMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
-			return true
-		case *shared.AccessDeniedError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeQueue_Helper.WrapResponse = func(success *shared.DescribeQueueResponse, err error) (*AdminService_DescribeQueue_Result, error) {
+	AdminService_GetDLQReplicationMessages_Helper.WrapResponse = func(success *replicator.GetDLQReplicationMessagesResponse, err error) (*AdminService_GetDLQReplicationMessages_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeQueue_Result{Success: success}, nil
+			return &AdminService_GetDLQReplicationMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.BadRequestError")
-			}
-			return &AdminService_DescribeQueue_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDLQReplicationMessages_Result.BadRequestError")
 			}
-			return &AdminService_DescribeQueue_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
+			return &AdminService_GetDLQReplicationMessages_Result{BadRequestError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDLQReplicationMessages_Result.ServiceBusyError")
 			}
-			return &AdminService_DescribeQueue_Result{AccessDeniedError: e}, nil
+			return &AdminService_GetDLQReplicationMessages_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeQueue_Helper.UnwrapResponse = func(result *AdminService_DescribeQueue_Result) (success *shared.DescribeQueueResponse, err error) {
+	AdminService_GetDLQReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetDLQReplicationMessages_Result) (success *replicator.GetDLQReplicationMessagesResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
-			return
-		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
 
@@ -11146,20 +16515,19 @@ func init() {
 
 }
 
-// AdminService_DescribeQueue_Result represents the result of a AdminService.DescribeQueue function call.
+// AdminService_GetDLQReplicationMessages_Result represents the result of a AdminService.GetDLQReplicationMessages function call.
 //
-// The result of a DescribeQueue execution is sent and received over the wire as this struct.
+// The result of a GetDLQReplicationMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_DescribeQueue_Result struct {
-	// Value returned by DescribeQueue after a successful execution.
-	Success              *shared.DescribeQueueResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError     `json:"accessDeniedError,omitempty"`
+type AdminService_GetDLQReplicationMessages_Result struct {
+	// Value returned by GetDLQReplicationMessages after a successful execution.
+	Success          *replicator.GetDLQReplicationMessagesResponse `json:"success,omitempty"`
+	BadRequestError  *shared.BadRequestError                       `json:"badRequestError,omitempty"`
+	ServiceBusyError *shared.ServiceBusyError                      `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeQueue_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDLQReplicationMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11171,12 +16539,12 @@ type AdminService_DescribeQueue_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeQueue_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDLQReplicationMessages_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11198,41 +16566,33 @@ func (v *AdminService_DescribeQueue_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeQueueResponse_Read(w wire.Value) (*shared.DescribeQueueResponse, error) {
-	var v shared.DescribeQueueResponse
+func _GetDLQReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetDLQReplicationMessagesResponse, error) {
+	var v replicator.GetDLQReplicationMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeQueue_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDLQReplicationMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeQueue_Result struct
+// An error is returned if we were unable to build a AdminService_GetDLQReplicationMessages_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11240,19 +16600,19 @@ func _DescribeQueueResponse_Read(w wire.Value) (*shared.DescribeQueueResponse, e
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeQueue_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeQueue_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetDLQReplicationMessages_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDLQReplicationMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeQueueResponse_Read(field.Value)
+				v.Success, err = _GetDLQReplicationMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11268,15 +16628,7 @@ func (v *AdminService_DescribeQueue_Result) FromWire(w wire.Value) error {
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11292,24 +16644,21 @@ func (v *AdminService_DescribeQueue_Result) FromWire(w wire.Value) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeQueue_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetDLQReplicationMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeQueue_Result struct could not be encoded.
-func (v *AdminService_DescribeQueue_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDLQReplicationMessages_Result struct could not be encoded.
+func (v *AdminService_GetDLQReplicationMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -11338,23 +16687,11 @@ func (v *AdminService_DescribeQueue_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.AccessDeniedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.AccessDeniedError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -11369,32 +16706,29 @@ func (v *AdminService_DescribeQueue_Result) Encode(sw stream.Writer) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeQueueResponse_Decode(sr stream.Reader) (*shared.DescribeQueueResponse, error) {
-	var v shared.DescribeQueueResponse
+func _GetDLQReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetDLQReplicationMessagesResponse, error) {
+	var v replicator.GetDLQReplicationMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeQueue_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDLQReplicationMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeQueue_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetDLQReplicationMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeQueue_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDLQReplicationMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -11408,7 +16742,7 @@ func (v *AdminService_DescribeQueue_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeQueueResponse_Decode(sr)
+			v.Success, err = _GetDLQReplicationMessagesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -11420,13 +16754,7 @@ func (v *AdminService_DescribeQueue_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -11457,27 +16785,24 @@ func (v *AdminService_DescribeQueue_Result) Decode(sr stream.Reader) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeQueue_Result
+// String returns a readable string representation of a AdminService_GetDLQReplicationMessages_Result
 // struct.
-func (v *AdminService_DescribeQueue_Result) String() string {
+func (v *AdminService_GetDLQReplicationMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -11487,23 +16812,19 @@ func (v *AdminService_DescribeQueue_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
-		i++
-	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeQueue_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDLQReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeQueue_Result match the
-// provided AdminService_DescribeQueue_Result.
+// Equals returns true if all the fields of this AdminService_GetDLQReplicationMessages_Result match the
+// provided AdminService_GetDLQReplicationMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeQueue_Result) Equals(rhs *AdminService_DescribeQueue_Result) bool {
+func (v *AdminService_GetDLQReplicationMessages_Result) Equals(rhs *AdminService_GetDLQReplicationMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -11515,10 +16836,7 @@ func (v *AdminService_DescribeQueue_Result) Equals(rhs *AdminService_DescribeQue
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
-		return false
-	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -11526,8 +16844,8 @@ func (v *AdminService_DescribeQueue_Result) Equals(rhs *AdminService_DescribeQue
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeQueue_Result.
-func (v *AdminService_DescribeQueue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDLQReplicationMessages_Result.
+func (v *AdminService_GetDLQReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -11537,18 +16855,15 @@ func (v *AdminService_DescribeQueue_Result) MarshalLogObject(enc zapcore.ObjectE
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
-	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeQueue_Result) GetSuccess() (o *shared.DescribeQueueResponse) {
+func (v *AdminService_GetDLQReplicationMessages_Result) GetSuccess() (o *replicator.GetDLQReplicationMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -11557,13 +16872,13 @@ func (v *AdminService_DescribeQueue_Result) GetSuccess() (o *shared.DescribeQueu
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeQueue_Result) IsSetSuccess() bool {
+func (v *AdminService_GetDLQReplicationMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeQueue_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetDLQReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -11572,63 +16887,48 @@ func (v *AdminService_DescribeQueue_Result) GetBadRequestError() (o *shared.BadR
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeQueue_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetDLQReplicationMessages_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeQueue_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
-	}
-
-	return
-}
-
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeQueue_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
-}
-
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeQueue_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
+func (v *AdminService_GetDLQReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeQueue_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetDLQReplicationMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeQueue" for this struct.
-func (v *AdminService_DescribeQueue_Result) MethodName() string {
-	return "DescribeQueue"
+// This will always be "GetDLQReplicationMessages" for this struct.
+func (v *AdminService_GetDLQReplicationMessages_Result) MethodName() string {
+	return "GetDLQReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeQueue_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDLQReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeShardDistribution_Args represents the arguments for the AdminService.DescribeShardDistribution function.
+// AdminService_GetDomainReplicationMessages_Args represents the arguments for the AdminService.GetDomainReplicationMessages function.
 //
-// The arguments for DescribeShardDistribution are sent and received over the wire as this struct.
-type AdminService_DescribeShardDistribution_Args struct {
-	Request *shared.DescribeShardDistributionRequest `json:"request,omitempty"`
+// The arguments for GetDomainReplicationMessages are sent and received over the wire as this struct.
+type AdminService_GetDomainReplicationMessages_Args struct {
+	Request *replicator.GetDomainReplicationMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeShardDistribution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDomainReplicationMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11640,10 +16940,10 @@ type AdminService_DescribeShardDistribution_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeShardDistribution_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDomainReplicationMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -11663,17 +16963,17 @@ func (v *AdminService_DescribeShardDistribution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeShardDistributionRequest_Read(w wire.Value) (*shared.DescribeShardDistributionRequest, error) {
-	var v shared.DescribeShardDistributionRequest
+func _GetDomainReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDomainReplicationMessagesRequest, error) {
+	var v replicator.GetDomainReplicationMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeShardDistribution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDomainReplicationMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Args struct
+// An error is returned if we were unable to build a AdminService_GetDomainReplicationMessages_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11681,19 +16981,19 @@ func _DescribeShardDistributionRequest_Read(w wire.Value) (*shared.DescribeShard
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeShardDistribution_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeShardDistribution_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetDomainReplicationMessages_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDomainReplicationMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeShardDistributionRequest_Read(field.Value)
+				v.Request, err = _GetDomainReplicationMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -11705,11 +17005,11 @@ func (v *AdminService_DescribeShardDistribution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeShardDistribution_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetDomainReplicationMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeShardDistribution_Args struct could not be encoded.
-func (v *AdminService_DescribeShardDistribution_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDomainReplicationMessages_Args struct could not be encoded.
+func (v *AdminService_GetDomainReplicationMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -11729,18 +17029,18 @@ func (v *AdminService_DescribeShardDistribution_Args) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-func _DescribeShardDistributionRequest_Decode(sr stream.Reader) (*shared.DescribeShardDistributionRequest, error) {
-	var v shared.DescribeShardDistributionRequest
+func _GetDomainReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetDomainReplicationMessagesRequest, error) {
+	var v replicator.GetDomainReplicationMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeShardDistribution_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDomainReplicationMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeShardDistribution_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetDomainReplicationMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeShardDistribution_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDomainReplicationMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -11754,7 +17054,7 @@ func (v *AdminService_DescribeShardDistribution_Args) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeShardDistributionRequest_Decode(sr)
+			v.Request, err = _GetDomainReplicationMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -11781,9 +17081,9 @@ func (v *AdminService_DescribeShardDistribution_Args) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeShardDistribution_Args
+// String returns a readable string representation of a AdminService_GetDomainReplicationMessages_Args
 // struct.
-func (v *AdminService_DescribeShardDistribution_Args) String() string {
+func (v *AdminService_GetDomainReplicationMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -11795,14 +17095,14 @@ func (v *AdminService_DescribeShardDistribution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeShardDistribution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDomainReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Args match the
-// provided AdminService_DescribeShardDistribution_Args.
+// Equals returns true if all the fields of this AdminService_GetDomainReplicationMessages_Args match the
+// provided AdminService_GetDomainReplicationMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeShardDistribution_Args) Equals(rhs *AdminService_DescribeShardDistribution_Args) bool {
+func (v *AdminService_GetDomainReplicationMessages_Args) Equals(rhs *AdminService_GetDomainReplicationMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -11816,8 +17116,8 @@ func (v *AdminService_DescribeShardDistribution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeShardDistribution_Args.
-func (v *AdminService_DescribeShardDistribution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDomainReplicationMessages_Args.
+func (v *AdminService_GetDomainReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -11829,7 +17129,7 @@ func (v *AdminService_DescribeShardDistribution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeShardDistribution_Args) GetRequest() (o *shared.DescribeShardDistributionRequest) {
+func (v *AdminService_GetDomainReplicationMessages_Args) GetRequest() (o *replicator.GetDomainReplicationMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -11838,106 +17138,139 @@ func (v *AdminService_DescribeShardDistribution_Args) GetRequest() (o *shared.De
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeShardDistribution_Args) IsSetRequest() bool {
+func (v *AdminService_GetDomainReplicationMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeShardDistribution" for this struct.
-func (v *AdminService_DescribeShardDistribution_Args) MethodName() string {
-	return "DescribeShardDistribution"
+// This will always be "GetDomainReplicationMessages" for this struct.
+func (v *AdminService_GetDomainReplicationMessages_Args) MethodName() string {
+	return "GetDomainReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeShardDistribution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDomainReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeShardDistribution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeShardDistribution
+// AdminService_GetDomainReplicationMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetDomainReplicationMessages
 // function.
-var AdminService_DescribeShardDistribution_Helper = struct {
-	// Args accepts the parameters of DescribeShardDistribution in-order and returns
+var AdminService_GetDomainReplicationMessages_Helper = struct {
+	// Args accepts the parameters of GetDomainReplicationMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeShardDistributionRequest,
-	) *AdminService_DescribeShardDistribution_Args
+		request *replicator.GetDomainReplicationMessagesRequest,
+	) *AdminService_GetDomainReplicationMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeShardDistribution.
+	// by GetDomainReplicationMessages.
 	//
-	// An error can be thrown by DescribeShardDistribution only if the
+	// An error can be thrown by GetDomainReplicationMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeShardDistribution
+	// WrapResponse returns the result struct for GetDomainReplicationMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeShardDistribution into a serializable result struct.
+	// GetDomainReplicationMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeShardDistribution
+	// error cannot be thrown by GetDomainReplicationMessages
 	//
-	//   value, err := DescribeShardDistribution(args)
-	//   result, err := AdminService_DescribeShardDistribution_Helper.WrapResponse(value, err)
+	//   value, err := GetDomainReplicationMessages(args)
+	//   result, err := AdminService_GetDomainReplicationMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeShardDistribution: %v", err)
+	//     return fmt.Errorf("unexpected error from GetDomainReplicationMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeShardDistributionResponse, error) (*AdminService_DescribeShardDistribution_Result, error)
+	WrapResponse func(*replicator.GetDomainReplicationMessagesResponse, error) (*AdminService_GetDomainReplicationMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeShardDistribution
+	// UnwrapResponse takes the result struct for GetDomainReplicationMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeShardDistribution threw an
+	// The error is non-nil only if GetDomainReplicationMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeShardDistribution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeShardDistribution_Result) (*shared.DescribeShardDistributionResponse, error)
+	//   value, err := AdminService_GetDomainReplicationMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetDomainReplicationMessages_Result) (*replicator.GetDomainReplicationMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeShardDistribution_Helper.Args = func(
-		request *shared.DescribeShardDistributionRequest,
-	) *AdminService_DescribeShardDistribution_Args {
-		return &AdminService_DescribeShardDistribution_Args{
+	AdminService_GetDomainReplicationMessages_Helper.Args = func(
+		request *replicator.GetDomainReplicationMessagesRequest,
+	) *AdminService_GetDomainReplicationMessages_Args {
+		return &AdminService_GetDomainReplicationMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeShardDistribution_Helper.IsException = func(err error) bool {
+	AdminService_GetDomainReplicationMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
-		case *shared.InternalServiceError:
+		case *shared.BadRequestError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.ClientVersionNotSupportedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeShardDistribution_Helper.WrapResponse = func(success *shared.DescribeShardDistributionResponse, err error) (*AdminService_DescribeShardDistribution_Result, error) {
+	AdminService_GetDomainReplicationMessages_Helper.WrapResponse = func(success *replicator.GetDomainReplicationMessagesResponse, err error) (*AdminService_GetDomainReplicationMessages_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeShardDistribution_Result{Success: success}, nil
+			return &AdminService_GetDomainReplicationMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
-		case *shared.InternalServiceError:
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.BadRequestError")
+			}
+			return &AdminService_GetDomainReplicationMessages_Result{BadRequestError: e}, nil
+		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardDistribution_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.LimitExceededError")
 			}
-			return &AdminService_DescribeShardDistribution_Result{InternalServiceError: e}, nil
+			return &AdminService_GetDomainReplicationMessages_Result{LimitExceededError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.ServiceBusyError")
+			}
+			return &AdminService_GetDomainReplicationMessages_Result{ServiceBusyError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.ClientVersionNotSupportedError")
+			}
+			return &AdminService_GetDomainReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeShardDistribution_Helper.UnwrapResponse = func(result *AdminService_DescribeShardDistribution_Result) (success *shared.DescribeShardDistributionResponse, err error) {
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+	AdminService_GetDomainReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetDomainReplicationMessages_Result) (success *replicator.GetDomainReplicationMessagesResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
 			return
 		}
 
@@ -11952,18 +17285,21 @@ func init() {
 
 }
 
-// AdminService_DescribeShardDistribution_Result represents the result of a AdminService.DescribeShardDistribution function call.
+// AdminService_GetDomainReplicationMessages_Result represents the result of a AdminService.GetDomainReplicationMessages function call.
 //
-// The result of a DescribeShardDistribution execution is sent and received over the wire as this struct.
+// The result of a GetDomainReplicationMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_DescribeShardDistribution_Result struct {
-	// Value returned by DescribeShardDistribution after a successful execution.
-	Success              *shared.DescribeShardDistributionResponse `json:"success,omitempty"`
-	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
+type AdminService_GetDomainReplicationMessages_Result struct {
+	// Value returned by GetDomainReplicationMessages after a successful execution.
+	Success                        *replicator.GetDomainReplicationMessagesResponse `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                          `json:"badRequestError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                       `json:"limitExceededError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                         `json:"serviceBusyError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError           `json:"clientVersionNotSupportedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeShardDistribution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDomainReplicationMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11975,12 +17311,12 @@ type AdminService_DescribeShardDistribution_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeShardDistribution_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDomainReplicationMessages_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11994,33 +17330,69 @@ func (v *AdminService_DescribeShardDistribution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeShardDistributionResponse_Read(w wire.Value) (*shared.DescribeShardDistributionResponse, error) {
-	var v shared.DescribeShardDistributionResponse
+func _GetDomainReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetDomainReplicationMessagesResponse, error) {
+	var v replicator.GetDomainReplicationMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeShardDistribution_Result struct from its Thrift-level
+func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ClientVersionNotSupportedError_Read(w wire.Value) (*shared.ClientVersionNotSupportedError, error) {
+	var v shared.ClientVersionNotSupportedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetDomainReplicationMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Result struct
+// An error is returned if we were unable to build a AdminService_GetDomainReplicationMessages_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12028,19 +17400,19 @@ func _DescribeShardDistributionResponse_Read(w wire.Value) (*shared.DescribeShar
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeShardDistribution_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeShardDistribution_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetDomainReplicationMessages_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDomainReplicationMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeShardDistributionResponse_Read(field.Value)
+				v.Success, err = _GetDomainReplicationMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12048,7 +17420,31 @@ func (v *AdminService_DescribeShardDistribution_Result) FromWire(w wire.Value) e
 			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12061,21 +17457,30 @@ func (v *AdminService_DescribeShardDistribution_Result) FromWire(w wire.Value) e
 	if v.Success != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeShardDistribution_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetDomainReplicationMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeShardDistribution_Result struct could not be encoded.
-func (v *AdminService_DescribeShardDistribution_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDomainReplicationMessages_Result struct could not be encoded.
+func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -12092,11 +17497,47 @@ func (v *AdminService_DescribeShardDistribution_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -12108,29 +17549,50 @@ func (v *AdminService_DescribeShardDistribution_Result) Encode(sw stream.Writer)
 	if v.Success != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeShardDistributionResponse_Decode(sr stream.Reader) (*shared.DescribeShardDistributionResponse, error) {
-	var v shared.DescribeShardDistributionResponse
+func _GetDomainReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetDomainReplicationMessagesResponse, error) {
+	var v replicator.GetDomainReplicationMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeShardDistribution_Result struct directly from its Thrift-level
+func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ClientVersionNotSupportedError_Decode(sr stream.Reader) (*shared.ClientVersionNotSupportedError, error) {
+	var v shared.ClientVersionNotSupportedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetDomainReplicationMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeShardDistribution_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetDomainReplicationMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeShardDistribution_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDomainReplicationMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -12144,13 +17606,31 @@ func (v *AdminService_DescribeShardDistribution_Result) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeShardDistributionResponse_Decode(sr)
+			v.Success, err = _GetDomainReplicationMessagesResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12178,42 +17658,63 @@ func (v *AdminService_DescribeShardDistribution_Result) Decode(sr stream.Reader)
 	if v.Success != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeShardDistribution_Result
+// String returns a readable string representation of a AdminService_GetDomainReplicationMessages_Result
 // struct.
-func (v *AdminService_DescribeShardDistribution_Result) String() string {
+func (v *AdminService_GetDomainReplicationMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeShardDistribution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDomainReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Result match the
-// provided AdminService_DescribeShardDistribution_Result.
+// Equals returns true if all the fields of this AdminService_GetDomainReplicationMessages_Result match the
+// provided AdminService_GetDomainReplicationMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeShardDistribution_Result) Equals(rhs *AdminService_DescribeShardDistribution_Result) bool {
+func (v *AdminService_GetDomainReplicationMessages_Result) Equals(rhs *AdminService_GetDomainReplicationMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -12222,7 +17723,16 @@ func (v *AdminService_DescribeShardDistribution_Result) Equals(rhs *AdminService
 	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
 
@@ -12230,23 +17740,32 @@ func (v *AdminService_DescribeShardDistribution_Result) Equals(rhs *AdminService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeShardDistribution_Result.
-func (v *AdminService_DescribeShardDistribution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDomainReplicationMessages_Result.
+func (v *AdminService_GetDomainReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Success != nil {
 		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeShardDistribution_Result) GetSuccess() (o *shared.DescribeShardDistributionResponse) {
+func (v *AdminService_GetDomainReplicationMessages_Result) GetSuccess() (o *replicator.GetDomainReplicationMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -12255,48 +17774,93 @@ func (v *AdminService_DescribeShardDistribution_Result) GetSuccess() (o *shared.
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeShardDistribution_Result) IsSetSuccess() bool {
+func (v *AdminService_GetDomainReplicationMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeShardDistribution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_GetDomainReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeShardDistribution_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetDomainReplicationMessages_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDomainReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *AdminService_GetDomainReplicationMessages_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDomainReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetDomainReplicationMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDomainReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *AdminService_GetDomainReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeShardDistribution" for this struct.
-func (v *AdminService_DescribeShardDistribution_Result) MethodName() string {
-	return "DescribeShardDistribution"
+// This will always be "GetDomainReplicationMessages" for this struct.
+func (v *AdminService_GetDomainReplicationMessages_Result) MethodName() string {
+	return "GetDomainReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeShardDistribution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDomainReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+// AdminService_GetDynamicConfig_Args represents the arguments for the AdminService.GetDynamicConfig function.
 //
-// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
-type AdminService_DescribeWorkflowExecution_Args struct {
-	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+// The arguments for GetDynamicConfig are sent and received over the wire as this struct.
+type AdminService_GetDynamicConfig_Args struct {
+	Request *GetDynamicConfigRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDynamicConfig_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12308,10 +17872,10 @@ type AdminService_DescribeWorkflowExecution_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -12331,17 +17895,17 @@ func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, error) {
+	var v GetDynamicConfigRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDynamicConfig_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12349,19 +17913,19 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetDynamicConfig_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.Request, err = _GetDynamicConfigRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12373,11 +17937,11 @@ func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeWorkflowExecution_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetDynamicConfig_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeWorkflowExecution_Args struct could not be encoded.
-func (v *AdminService_DescribeWorkflowExecution_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be encoded.
+func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -12397,18 +17961,18 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-func _DescribeWorkflowExecutionRequest_Decode(sr stream.Reader) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _GetDynamicConfigRequest_Decode(sr stream.Reader) (*GetDynamicConfigRequest, error) {
+	var v GetDynamicConfigRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeWorkflowExecution_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDynamicConfig_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeWorkflowExecution_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeWorkflowExecution_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -12422,7 +17986,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeWorkflowExecutionRequest_Decode(sr)
+			v.Request, err = _GetDynamicConfigRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12449,9 +18013,9 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// String returns a readable string representation of a AdminService_GetDynamicConfig_Args
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+func (v *AdminService_GetDynamicConfig_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -12463,14 +18027,14 @@ func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
-// provided AdminService_DescribeWorkflowExecution_Args.
+// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Args match the
+// provided AdminService_GetDynamicConfig_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamicConfig_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -12484,8 +18048,8 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Args.
-func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDynamicConfig_Args.
+func (v *AdminService_GetDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -12497,7 +18061,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+func (v *AdminService_GetDynamicConfig_Args) GetRequest() (o *GetDynamicConfigRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -12506,125 +18070,111 @@ func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeW
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+func (v *AdminService_GetDynamicConfig_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "GetDynamicConfig" for this struct.
+func (v *AdminService_GetDynamicConfig_Args) MethodName() string {
+	return "GetDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeWorkflowExecution
+// AdminService_GetDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetDynamicConfig
 // function.
-var AdminService_DescribeWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+var AdminService_GetDynamicConfig_Helper = struct {
+	// Args accepts the parameters of GetDynamicConfig in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args
+		request *GetDynamicConfigRequest,
+	) *AdminService_GetDynamicConfig_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeWorkflowExecution.
+	// by GetDynamicConfig.
 	//
-	// An error can be thrown by DescribeWorkflowExecution only if the
+	// An error can be thrown by GetDynamicConfig only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeWorkflowExecution
+	// WrapResponse returns the result struct for GetDynamicConfig
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeWorkflowExecution into a serializable result struct.
+	// GetDynamicConfig into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeWorkflowExecution
+	// error cannot be thrown by GetDynamicConfig
 	//
-	//   value, err := DescribeWorkflowExecution(args)
-	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   value, err := GetDynamicConfig(args)
+	//   result, err := AdminService_GetDynamicConfig_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from GetDynamicConfig: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+	WrapResponse func(*GetDynamicConfigResponse, error) (*AdminService_GetDynamicConfig_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
+	// UnwrapResponse takes the result struct for GetDynamicConfig
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// The error is non-nil only if GetDynamicConfig threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+	//   value, err := AdminService_GetDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetDynamicConfig_Result) (*GetDynamicConfigResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeWorkflowExecution_Helper.Args = func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args {
-		return &AdminService_DescribeWorkflowExecution_Args{
+	AdminService_GetDynamicConfig_Helper.Args = func(
+		request *GetDynamicConfigRequest,
+	) *AdminService_GetDynamicConfig_Args {
+		return &AdminService_GetDynamicConfig_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+	AdminService_GetDynamicConfig_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
-		case *shared.AccessDeniedError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+	AdminService_GetDynamicConfig_Helper.WrapResponse = func(success *GetDynamicConfigResponse, err error) (*AdminService_GetDynamicConfig_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+			return &AdminService_GetDynamicConfig_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.BadRequestError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+			return &AdminService_GetDynamicConfig_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
-			}
-			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
-			}
-			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
-		case *shared.AccessDeniedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+			return &AdminService_GetDynamicConfig_Result{InternalServiceError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+	AdminService_GetDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_GetDynamicConfig_Result) (success *GetDynamicConfigResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -12633,14 +18183,6 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
-			return
-		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -12653,21 +18195,19 @@ func init() {
 
 }
 
-// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
+// AdminService_GetDynamicConfig_Result represents the result of a AdminService.GetDynamicConfig function call.
 //
-// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+// The result of a GetDynamicConfig execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_DescribeWorkflowExecution_Result struct {
-	// Value returned by DescribeWorkflowExecution after a successful execution.
-	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+type AdminService_GetDynamicConfig_Result struct {
+	// Value returned by GetDynamicConfig after a successful execution.
+	Success              *GetDynamicConfigResponse    `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDynamicConfig_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12679,12 +18219,12 @@ type AdminService_DescribeWorkflowExecution_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12714,47 +18254,25 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, error) {
+	var v GetDynamicConfigResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDynamicConfig_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12762,19 +18280,19 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetDynamicConfig_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
+				v.Success, err = _GetDynamicConfigResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12795,22 +18313,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -12825,24 +18327,18 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_DescribeWorkflowExecution_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetDynamicConfig_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_DescribeWorkflowExecution_Result struct could not be encoded.
-func (v *AdminService_DescribeWorkflowExecution_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be encoded.
+func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -12883,30 +18379,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.AccessDeniedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.AccessDeniedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
 	if v.Success != nil {
 		count++
@@ -12917,38 +18389,26 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Encode(sw stream.Writer)
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeWorkflowExecutionResponse_Decode(sr stream.Reader) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _GetDynamicConfigResponse_Decode(sr stream.Reader) (*GetDynamicConfigResponse, error) {
+	var v GetDynamicConfigResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_DescribeWorkflowExecution_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDynamicConfig_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_DescribeWorkflowExecution_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_DescribeWorkflowExecution_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -12962,7 +18422,7 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeWorkflowExecutionResponse_Decode(sr)
+			v.Success, err = _GetDynamicConfigResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -12979,18 +18439,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Decode(sr stream.Reader)
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -13020,27 +18468,21 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Decode(sr stream.Reader)
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// String returns a readable string representation of a AdminService_GetDynamicConfig_Result
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+func (v *AdminService_GetDynamicConfig_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -13054,23 +18496,15 @@ func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
-		i++
-	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
-// provided AdminService_DescribeWorkflowExecution_Result.
+// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Result match the
+// provided AdminService_GetDynamicConfig_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynamicConfig_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -13085,19 +18519,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Result.
-func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDynamicConfig_Result.
+func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -13110,18 +18538,12 @@ func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zap
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
-	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
+func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfigResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -13130,13 +18552,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *Describ
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
+func (v *AdminService_GetDynamicConfig_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -13145,13 +18567,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetDynamicConfig_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -13160,63 +18582,33 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_GetDynamicConfig_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
-	}
-
-	return
-}
-
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "GetDynamicConfig" for this struct.
+func (v *AdminService_GetDynamicConfig_Result) MethodName() string {
+	return "GetDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetCrossClusterTasks_Args represents the arguments for the AdminService.GetCrossClusterTasks function.
+// AdminService_GetFailoverReadiness_Args represents the arguments for the AdminService.GetFailoverReadiness function.
 //
-// The arguments for GetCrossClusterTasks are sent and received over the wire as this struct.
-type AdminService_GetCrossClusterTasks_Args struct {
-	Request *shared.GetCrossClusterTasksRequest `json:"request,omitempty"`
+// The arguments for GetFailoverReadiness are sent and received over the wire as this struct.
+type AdminService_GetFailoverReadiness_Args struct {
+	Request *GetFailoverReadinessRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetCrossClusterTasks_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetFailoverReadiness_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13228,10 +18620,10 @@ type AdminService_GetCrossClusterTasks_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetCrossClusterTasks_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetFailoverReadiness_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -13251,17 +18643,17 @@ func (v *AdminService_GetCrossClusterTasks_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetCrossClusterTasksRequest_Read(w wire.Value) (*shared.GetCrossClusterTasksRequest, error) {
-	var v shared.GetCrossClusterTasksRequest
+func _GetFailoverReadinessRequest_Read(w wire.Value) (*GetFailoverReadinessRequest, error) {
+	var v GetFailoverReadinessRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetCrossClusterTasks_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetFailoverReadiness_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetCrossClusterTasks_Args struct
+// An error is returned if we were unable to build a AdminService_GetFailoverReadiness_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13269,19 +18661,19 @@ func _GetCrossClusterTasksRequest_Read(w wire.Value) (*shared.GetCrossClusterTas
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetCrossClusterTasks_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetCrossClusterTasks_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetFailoverReadiness_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetFailoverReadiness_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetCrossClusterTasksRequest_Read(field.Value)
+				v.Request, err = _GetFailoverReadinessRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -13293,11 +18685,11 @@ func (v *AdminService_GetCrossClusterTasks_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_GetCrossClusterTasks_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetFailoverReadiness_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetCrossClusterTasks_Args struct could not be encoded.
-func (v *AdminService_GetCrossClusterTasks_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetFailoverReadiness_Args struct could not be encoded.
+func (v *AdminService_GetFailoverReadiness_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -13317,18 +18709,18 @@ func (v *AdminService_GetCrossClusterTasks_Args) Encode(sw stream.Writer) error
 	return sw.WriteStructEnd()
 }
 
-func _GetCrossClusterTasksRequest_Decode(sr stream.Reader) (*shared.GetCrossClusterTasksRequest, error) {
-	var v shared.GetCrossClusterTasksRequest
+func _GetFailoverReadinessRequest_Decode(sr stream.Reader) (*GetFailoverReadinessRequest, error) {
+	var v GetFailoverReadinessRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetCrossClusterTasks_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetFailoverReadiness_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetCrossClusterTasks_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetFailoverReadiness_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetCrossClusterTasks_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetFailoverReadiness_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13342,7 +18734,7 @@ func (v *AdminService_GetCrossClusterTasks_Args) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetCrossClusterTasksRequest_Decode(sr)
+			v.Request, err = _GetFailoverReadinessRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -13369,9 +18761,9 @@ func (v *AdminService_GetCrossClusterTasks_Args) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetCrossClusterTasks_Args
+// String returns a readable string representation of a AdminService_GetFailoverReadiness_Args
 // struct.
-func (v *AdminService_GetCrossClusterTasks_Args) String() string {
+func (v *AdminService_GetFailoverReadiness_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -13383,14 +18775,14 @@ func (v *AdminService_GetCrossClusterTasks_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetCrossClusterTasks_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetFailoverReadiness_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetCrossClusterTasks_Args match the
-// provided AdminService_GetCrossClusterTasks_Args.
+// Equals returns true if all the fields of this AdminService_GetFailoverReadiness_Args match the
+// provided AdminService_GetFailoverReadiness_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetCrossClusterTasks_Args) Equals(rhs *AdminService_GetCrossClusterTasks_Args) bool {
+func (v *AdminService_GetFailoverReadiness_Args) Equals(rhs *AdminService_GetFailoverReadiness_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -13404,8 +18796,8 @@ func (v *AdminService_GetCrossClusterTasks_Args) Equals(rhs *AdminService_GetCro
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetCrossClusterTasks_Args.
-func (v *AdminService_GetCrossClusterTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetFailoverReadiness_Args.
+func (v *AdminService_GetFailoverReadiness_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -13417,7 +18809,7 @@ func (v *AdminService_GetCrossClusterTasks_Args) MarshalLogObject(enc zapcore.Ob
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetCrossClusterTasks_Args) GetRequest() (o *shared.GetCrossClusterTasksRequest) {
+func (v *AdminService_GetFailoverReadiness_Args) GetRequest() (o *GetFailoverReadinessRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -13426,85 +18818,87 @@ func (v *AdminService_GetCrossClusterTasks_Args) GetRequest() (o *shared.GetCros
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetCrossClusterTasks_Args) IsSetRequest() bool {
+func (v *AdminService_GetFailoverReadiness_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetCrossClusterTasks" for this struct.
-func (v *AdminService_GetCrossClusterTasks_Args) MethodName() string {
-	return "GetCrossClusterTasks"
+// This will always be "GetFailoverReadiness" for this struct.
+func (v *AdminService_GetFailoverReadiness_Args) MethodName() string {
+	return "GetFailoverReadiness"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetCrossClusterTasks_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetFailoverReadiness_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetCrossClusterTasks_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetCrossClusterTasks
+// AdminService_GetFailoverReadiness_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetFailoverReadiness
 // function.
-var AdminService_GetCrossClusterTasks_Helper = struct {
-	// Args accepts the parameters of GetCrossClusterTasks in-order and returns
+var AdminService_GetFailoverReadiness_Helper = struct {
+	// Args accepts the parameters of GetFailoverReadiness in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.GetCrossClusterTasksRequest,
-	) *AdminService_GetCrossClusterTasks_Args
+		request *GetFailoverReadinessRequest,
+	) *AdminService_GetFailoverReadiness_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetCrossClusterTasks.
+	// by GetFailoverReadiness.
 	//
-	// An error can be thrown by GetCrossClusterTasks only if the
+	// An error can be thrown by GetFailoverReadiness only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetCrossClusterTasks
+	// WrapResponse returns the result struct for GetFailoverReadiness
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetCrossClusterTasks into a serializable result struct.
+	// GetFailoverReadiness into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetCrossClusterTasks
+	// error cannot be thrown by GetFailoverReadiness
 	//
-	//   value, err := GetCrossClusterTasks(args)
-	//   result, err := AdminService_GetCrossClusterTasks_Helper.WrapResponse(value, err)
+	//   value, err := GetFailoverReadiness(args)
+	//   result, err := AdminService_GetFailoverReadiness_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetCrossClusterTasks: %v", err)
+	//     return fmt.Errorf("unexpected error from GetFailoverReadiness: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.GetCrossClusterTasksResponse, error) (*AdminService_GetCrossClusterTasks_Result, error)
+	WrapResponse func(*GetFailoverReadinessResponse, error) (*AdminService_GetFailoverReadiness_Result, error)
 
-	// UnwrapResponse takes the result struct for GetCrossClusterTasks
+	// UnwrapResponse takes the result struct for GetFailoverReadiness
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetCrossClusterTasks threw an
+	// The error is non-nil only if GetFailoverReadiness threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetCrossClusterTasks_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetCrossClusterTasks_Result) (*shared.GetCrossClusterTasksResponse, error)
+	//   value, err := AdminService_GetFailoverReadiness_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetFailoverReadiness_Result) (*GetFailoverReadinessResponse, error)
 }{}
 
 func init() {
-	AdminService_GetCrossClusterTasks_Helper.Args = func(
-		request *shared.GetCrossClusterTasksRequest,
-	) *AdminService_GetCrossClusterTasks_Args {
-		return &AdminService_GetCrossClusterTasks_Args{
+	AdminService_GetFailoverReadiness_Helper.Args = func(
+		request *GetFailoverReadinessRequest,
+	) *AdminService_GetFailoverReadiness_Args {
+		return &AdminService_GetFailoverReadiness_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetCrossClusterTasks_Helper.IsException = func(err error) bool {
+	AdminService_GetFailoverReadiness_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -13512,32 +18906,37 @@ func init() {
 		}
 	}
 
-	AdminService_GetCrossClusterTasks_Helper.WrapResponse = func(success *shared.GetCrossClusterTasksResponse, err error) (*AdminService_GetCrossClusterTasks_Result, error) {
+	AdminService_GetFailoverReadiness_Helper.WrapResponse = func(success *GetFailoverReadinessResponse, err error) (*AdminService_GetFailoverReadiness_Result, error) {
 		if err == nil {
-			return &AdminService_GetCrossClusterTasks_Result{Success: success}, nil
+			return &AdminService_GetFailoverReadiness_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetFailoverReadiness_Result.BadRequestError")
 			}
-			return &AdminService_GetCrossClusterTasks_Result{BadRequestError: e}, nil
+			return &AdminService_GetFailoverReadiness_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetFailoverReadiness_Result.InternalServiceError")
 			}
-			return &AdminService_GetCrossClusterTasks_Result{InternalServiceError: e}, nil
+			return &AdminService_GetFailoverReadiness_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetFailoverReadiness_Result.EntityNotExistError")
+			}
+			return &AdminService_GetFailoverReadiness_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetCrossClusterTasks_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetFailoverReadiness_Result.ServiceBusyError")
 			}
-			return &AdminService_GetCrossClusterTasks_Result{ServiceBusyError: e}, nil
+			return &AdminService_GetFailoverReadiness_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetCrossClusterTasks_Helper.UnwrapResponse = func(result *AdminService_GetCrossClusterTasks_Result) (success *shared.GetCrossClusterTasksResponse, err error) {
+	AdminService_GetFailoverReadiness_Helper.UnwrapResponse = func(result *AdminService_GetFailoverReadiness_Result) (success *GetFailoverReadinessResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -13546,6 +18945,10 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -13562,20 +18965,21 @@ func init() {
 
 }
 
-// AdminService_GetCrossClusterTasks_Result represents the result of a AdminService.GetCrossClusterTasks function call.
+// AdminService_GetFailoverReadiness_Result represents the result of a AdminService.GetFailoverReadiness function call.
 //
-// The result of a GetCrossClusterTasks execution is sent and received over the wire as this struct.
+// The result of a GetFailoverReadiness execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetCrossClusterTasks_Result struct {
-	// Value returned by GetCrossClusterTasks after a successful execution.
-	Success              *shared.GetCrossClusterTasksResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+type AdminService_GetFailoverReadiness_Result struct {
+	// Value returned by GetFailoverReadiness after a successful execution.
+	Success              *GetFailoverReadinessResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError  `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError      `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetCrossClusterTasks_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetFailoverReadiness_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13587,12 +18991,12 @@ type AdminService_GetCrossClusterTasks_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetCrossClusterTasks_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetFailoverReadiness_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -13622,33 +19026,41 @@ func (v *AdminService_GetCrossClusterTasks_Result) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetFailoverReadiness_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetCrossClusterTasksResponse_Read(w wire.Value) (*shared.GetCrossClusterTasksResponse, error) {
-	var v shared.GetCrossClusterTasksResponse
+func _GetFailoverReadinessResponse_Read(w wire.Value) (*GetFailoverReadinessResponse, error) {
+	var v GetFailoverReadinessResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetCrossClusterTasks_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetFailoverReadiness_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetCrossClusterTasks_Result struct
+// An error is returned if we were unable to build a AdminService_GetFailoverReadiness_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13656,19 +19068,19 @@ func _GetCrossClusterTasksResponse_Read(w wire.Value) (*shared.GetCrossClusterTa
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetCrossClusterTasks_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetCrossClusterTasks_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetFailoverReadiness_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetFailoverReadiness_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetCrossClusterTasksResponse_Read(field.Value)
+				v.Success, err = _GetFailoverReadinessResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -13691,6 +19103,14 @@ func (v *AdminService_GetCrossClusterTasks_Result) FromWire(w wire.Value) error
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -13711,21 +19131,24 @@ func (v *AdminService_GetCrossClusterTasks_Result) FromWire(w wire.Value) error
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetFailoverReadiness_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetCrossClusterTasks_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetFailoverReadiness_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetCrossClusterTasks_Result struct could not be encoded.
-func (v *AdminService_GetCrossClusterTasks_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetFailoverReadiness_Result struct could not be encoded.
+func (v *AdminService_GetFailoverReadiness_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -13766,10 +19189,22 @@ func (v *AdminService_GetCrossClusterTasks_Result) Encode(sw stream.Writer) erro
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
@@ -13788,29 +19223,32 @@ func (v *AdminService_GetCrossClusterTasks_Result) Encode(sw stream.Writer) erro
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetFailoverReadiness_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetCrossClusterTasksResponse_Decode(sr stream.Reader) (*shared.GetCrossClusterTasksResponse, error) {
-	var v shared.GetCrossClusterTasksResponse
+func _GetFailoverReadinessResponse_Decode(sr stream.Reader) (*GetFailoverReadinessResponse, error) {
+	var v GetFailoverReadinessResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetCrossClusterTasks_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetFailoverReadiness_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetCrossClusterTasks_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetFailoverReadiness_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetCrossClusterTasks_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetFailoverReadiness_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -13824,7 +19262,7 @@ func (v *AdminService_GetCrossClusterTasks_Result) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetCrossClusterTasksResponse_Decode(sr)
+			v.Success, err = _GetFailoverReadinessResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -13842,6 +19280,12 @@ func (v *AdminService_GetCrossClusterTasks_Result) Decode(sr stream.Reader) erro
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
@@ -13876,24 +19320,27 @@ func (v *AdminService_GetCrossClusterTasks_Result) Decode(sr stream.Reader) erro
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetCrossClusterTasks_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetFailoverReadiness_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetCrossClusterTasks_Result
+// String returns a readable string representation of a AdminService_GetFailoverReadiness_Result
 // struct.
-func (v *AdminService_GetCrossClusterTasks_Result) String() string {
+func (v *AdminService_GetFailoverReadiness_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -13907,19 +19354,23 @@ func (v *AdminService_GetCrossClusterTasks_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetCrossClusterTasks_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetFailoverReadiness_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetCrossClusterTasks_Result match the
-// provided AdminService_GetCrossClusterTasks_Result.
+// Equals returns true if all the fields of this AdminService_GetFailoverReadiness_Result match the
+// provided AdminService_GetFailoverReadiness_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetCrossClusterTasks_Result) Equals(rhs *AdminService_GetCrossClusterTasks_Result) bool {
+func (v *AdminService_GetFailoverReadiness_Result) Equals(rhs *AdminService_GetFailoverReadiness_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -13934,6 +19385,9 @@ func (v *AdminService_GetCrossClusterTasks_Result) Equals(rhs *AdminService_GetC
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -13942,8 +19396,8 @@ func (v *AdminService_GetCrossClusterTasks_Result) Equals(rhs *AdminService_GetC
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetCrossClusterTasks_Result.
-func (v *AdminService_GetCrossClusterTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetFailoverReadiness_Result.
+func (v *AdminService_GetFailoverReadiness_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -13956,6 +19410,9 @@ func (v *AdminService_GetCrossClusterTasks_Result) MarshalLogObject(enc zapcore.
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -13964,7 +19421,7 @@ func (v *AdminService_GetCrossClusterTasks_Result) MarshalLogObject(enc zapcore.
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetCrossClusterTasks_Result) GetSuccess() (o *shared.GetCrossClusterTasksResponse) {
+func (v *AdminService_GetFailoverReadiness_Result) GetSuccess() (o *GetFailoverReadinessResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -13973,13 +19430,13 @@ func (v *AdminService_GetCrossClusterTasks_Result) GetSuccess() (o *shared.GetCr
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetCrossClusterTasks_Result) IsSetSuccess() bool {
+func (v *AdminService_GetFailoverReadiness_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetCrossClusterTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetFailoverReadiness_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -13988,28 +19445,43 @@ func (v *AdminService_GetCrossClusterTasks_Result) GetBadRequestError() (o *shar
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetCrossClusterTasks_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetFailoverReadiness_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetFailoverReadiness_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetFailoverReadiness_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetCrossClusterTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_GetFailoverReadiness_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetCrossClusterTasks_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetFailoverReadiness_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetCrossClusterTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_GetFailoverReadiness_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -14018,33 +19490,33 @@ func (v *AdminService_GetCrossClusterTasks_Result) GetServiceBusyError() (o *sha
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetCrossClusterTasks_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_GetFailoverReadiness_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetCrossClusterTasks" for this struct.
-func (v *AdminService_GetCrossClusterTasks_Result) MethodName() string {
-	return "GetCrossClusterTasks"
+// This will always be "GetFailoverReadiness" for this struct.
+func (v *AdminService_GetFailoverReadiness_Result) MethodName() string {
+	return "GetFailoverReadiness"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetCrossClusterTasks_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetFailoverReadiness_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetDLQReplicationMessages_Args represents the arguments for the AdminService.GetDLQReplicationMessages function.
+// AdminService_GetReplicationMessages_Args represents the arguments for the AdminService.GetReplicationMessages function.
 //
-// The arguments for GetDLQReplicationMessages are sent and received over the wire as this struct.
-type AdminService_GetDLQReplicationMessages_Args struct {
-	Request *replicator.GetDLQReplicationMessagesRequest `json:"request,omitempty"`
+// The arguments for GetReplicationMessages are sent and received over the wire as this struct.
+type AdminService_GetReplicationMessages_Args struct {
+	Request *replicator.GetReplicationMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDLQReplicationMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetReplicationMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -14056,10 +19528,10 @@ type AdminService_GetDLQReplicationMessages_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetDLQReplicationMessages_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -14079,17 +19551,17 @@ func (v *AdminService_GetDLQReplicationMessages_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDLQReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDLQReplicationMessagesRequest, error) {
-	var v replicator.GetDLQReplicationMessagesRequest
+func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicationMessagesRequest, error) {
+	var v replicator.GetReplicationMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDLQReplicationMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetReplicationMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDLQReplicationMessages_Args struct
+// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -14097,19 +19569,19 @@ func _GetDLQReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDLQRep
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDLQReplicationMessages_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetDLQReplicationMessages_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetReplicationMessages_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetDLQReplicationMessagesRequest_Read(field.Value)
+				v.Request, err = _GetReplicationMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14121,11 +19593,11 @@ func (v *AdminService_GetDLQReplicationMessages_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// Encode serializes a AdminService_GetDLQReplicationMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetReplicationMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDLQReplicationMessages_Args struct could not be encoded.
-func (v *AdminService_GetDLQReplicationMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be encoded.
+func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -14145,18 +19617,18 @@ func (v *AdminService_GetDLQReplicationMessages_Args) Encode(sw stream.Writer) e
 	return sw.WriteStructEnd()
 }
 
-func _GetDLQReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetDLQReplicationMessagesRequest, error) {
-	var v replicator.GetDLQReplicationMessagesRequest
+func _GetReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesRequest, error) {
+	var v replicator.GetReplicationMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDLQReplicationMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetReplicationMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDLQReplicationMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDLQReplicationMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -14170,7 +19642,7 @@ func (v *AdminService_GetDLQReplicationMessages_Args) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetDLQReplicationMessagesRequest_Decode(sr)
+			v.Request, err = _GetReplicationMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -14197,9 +19669,9 @@ func (v *AdminService_GetDLQReplicationMessages_Args) Decode(sr stream.Reader) e
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDLQReplicationMessages_Args
+// String returns a readable string representation of a AdminService_GetReplicationMessages_Args
 // struct.
-func (v *AdminService_GetDLQReplicationMessages_Args) String() string {
+func (v *AdminService_GetReplicationMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -14211,14 +19683,14 @@ func (v *AdminService_GetDLQReplicationMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetDLQReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDLQReplicationMessages_Args match the
-// provided AdminService_GetDLQReplicationMessages_Args.
+// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Args match the
+// provided AdminService_GetReplicationMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDLQReplicationMessages_Args) Equals(rhs *AdminService_GetDLQReplicationMessages_Args) bool {
+func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetReplicationMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -14232,8 +19704,8 @@ func (v *AdminService_GetDLQReplicationMessages_Args) Equals(rhs *AdminService_G
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDLQReplicationMessages_Args.
-func (v *AdminService_GetDLQReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetReplicationMessages_Args.
+func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -14245,7 +19717,7 @@ func (v *AdminService_GetDLQReplicationMessages_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDLQReplicationMessages_Args) GetRequest() (o *replicator.GetDLQReplicationMessagesRequest) {
+func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.GetReplicationMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -14254,119 +19726,141 @@ func (v *AdminService_GetDLQReplicationMessages_Args) GetRequest() (o *replicato
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetDLQReplicationMessages_Args) IsSetRequest() bool {
+func (v *AdminService_GetReplicationMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetDLQReplicationMessages" for this struct.
-func (v *AdminService_GetDLQReplicationMessages_Args) MethodName() string {
-	return "GetDLQReplicationMessages"
+// This will always be "GetReplicationMessages" for this struct.
+func (v *AdminService_GetReplicationMessages_Args) MethodName() string {
+	return "GetReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetDLQReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetDLQReplicationMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetDLQReplicationMessages
+// AdminService_GetReplicationMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetReplicationMessages
 // function.
-var AdminService_GetDLQReplicationMessages_Helper = struct {
-	// Args accepts the parameters of GetDLQReplicationMessages in-order and returns
+var AdminService_GetReplicationMessages_Helper = struct {
+	// Args accepts the parameters of GetReplicationMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.GetDLQReplicationMessagesRequest,
-	) *AdminService_GetDLQReplicationMessages_Args
+		request *replicator.GetReplicationMessagesRequest,
+	) *AdminService_GetReplicationMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetDLQReplicationMessages.
+	// by GetReplicationMessages.
 	//
-	// An error can be thrown by GetDLQReplicationMessages only if the
+	// An error can be thrown by GetReplicationMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetDLQReplicationMessages
+	// WrapResponse returns the result struct for GetReplicationMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetDLQReplicationMessages into a serializable result struct.
+	// GetReplicationMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetDLQReplicationMessages
+	// error cannot be thrown by GetReplicationMessages
 	//
-	//   value, err := GetDLQReplicationMessages(args)
-	//   result, err := AdminService_GetDLQReplicationMessages_Helper.WrapResponse(value, err)
+	//   value, err := GetReplicationMessages(args)
+	//   result, err := AdminService_GetReplicationMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetDLQReplicationMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from GetReplicationMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.GetDLQReplicationMessagesResponse, error) (*AdminService_GetDLQReplicationMessages_Result, error)
+	WrapResponse func(*replicator.GetReplicationMessagesResponse, error) (*AdminService_GetReplicationMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for GetDLQReplicationMessages
+	// UnwrapResponse takes the result struct for GetReplicationMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetDLQReplicationMessages threw an
+	// The error is non-nil only if GetReplicationMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetDLQReplicationMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetDLQReplicationMessages_Result) (*replicator.GetDLQReplicationMessagesResponse, error)
+	//   value, err := AdminService_GetReplicationMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetReplicationMessages_Result) (*replicator.GetReplicationMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_GetDLQReplicationMessages_Helper.Args = func(
-		request *replicator.GetDLQReplicationMessagesRequest,
-	) *AdminService_GetDLQReplicationMessages_Args {
-		return &AdminService_GetDLQReplicationMessages_Args{
+	AdminService_GetReplicationMessages_Helper.Args = func(
+		request *replicator.GetReplicationMessagesRequest,
+	) *AdminService_GetReplicationMessages_Args {
+		return &AdminService_GetReplicationMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetDLQReplicationMessages_Helper.IsException = func(err error) bool {
+	AdminService_GetReplicationMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
+		case *shared.LimitExceededError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.ClientVersionNotSupportedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetDLQReplicationMessages_Helper.WrapResponse = func(success *replicator.GetDLQReplicationMessagesResponse, err error) (*AdminService_GetDLQReplicationMessages_Result, error) {
+	AdminService_GetReplicationMessages_Helper.WrapResponse = func(success *replicator.GetReplicationMessagesResponse, err error) (*AdminService_GetReplicationMessages_Result, error) {
 		if err == nil {
-			return &AdminService_GetDLQReplicationMessages_Result{Success: success}, nil
+			return &AdminService_GetReplicationMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDLQReplicationMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.BadRequestError")
 			}
-			return &AdminService_GetDLQReplicationMessages_Result{BadRequestError: e}, nil
+			return &AdminService_GetReplicationMessages_Result{BadRequestError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.LimitExceededError")
+			}
+			return &AdminService_GetReplicationMessages_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDLQReplicationMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ServiceBusyError")
 			}
-			return &AdminService_GetDLQReplicationMessages_Result{ServiceBusyError: e}, nil
+			return &AdminService_GetReplicationMessages_Result{ServiceBusyError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ClientVersionNotSupportedError")
+			}
+			return &AdminService_GetReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetDLQReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetDLQReplicationMessages_Result) (success *replicator.GetDLQReplicationMessagesResponse, err error) {
+	AdminService_GetReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetReplicationMessages_Result) (success *replicator.GetReplicationMessagesResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -14379,19 +19873,21 @@ func init() {
 
 }
 
-// AdminService_GetDLQReplicationMessages_Result represents the result of a AdminService.GetDLQReplicationMessages function call.
+// AdminService_GetReplicationMessages_Result represents the result of a AdminService.GetReplicationMessages function call.
 //
-// The result of a GetDLQReplicationMessages execution is sent and received over the wire as this struct.
+// The result of a GetReplicationMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetDLQReplicationMessages_Result struct {
-	// Value returned by GetDLQReplicationMessages after a successful execution.
-	Success          *replicator.GetDLQReplicationMessagesResponse `json:"success,omitempty"`
-	BadRequestError  *shared.BadRequestError                       `json:"badRequestError,omitempty"`
-	ServiceBusyError *shared.ServiceBusyError                      `json:"serviceBusyError,omitempty"`
+type AdminService_GetReplicationMessages_Result struct {
+	// Value returned by GetReplicationMessages after a successful execution.
+	Success                        *replicator.GetReplicationMessagesResponse `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                    `json:"badRequestError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                 `json:"limitExceededError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                   `json:"serviceBusyError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError     `json:"clientVersionNotSupportedError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDLQReplicationMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetReplicationMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -14403,12 +19899,12 @@ type AdminService_GetDLQReplicationMessages_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetDLQReplicationMessages_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14430,33 +19926,49 @@ func (v *AdminService_GetDLQReplicationMessages_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 2, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDLQReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetDLQReplicationMessagesResponse, error) {
-	var v replicator.GetDLQReplicationMessagesResponse
+func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicationMessagesResponse, error) {
+	var v replicator.GetReplicationMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDLQReplicationMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetReplicationMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDLQReplicationMessages_Result struct
+// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -14464,19 +19976,19 @@ func _GetDLQReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetDLQRe
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDLQReplicationMessages_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetDLQReplicationMessages_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetReplicationMessages_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetDLQReplicationMessagesResponse_Read(field.Value)
+				v.Success, err = _GetReplicationMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14490,9 +20002,25 @@ func (v *AdminService_GetDLQReplicationMessages_Result) FromWire(w wire.Value) e
 				}
 
 			}
-		case 2:
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14508,21 +20036,27 @@ func (v *AdminService_GetDLQReplicationMessages_Result) FromWire(w wire.Value) e
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetDLQReplicationMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetReplicationMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDLQReplicationMessages_Result struct could not be encoded.
-func (v *AdminService_GetDLQReplicationMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be encoded.
+func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -14551,8 +20085,20 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Encode(sw stream.Writer)
 		}
 	}
 
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -14563,6 +20109,18 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Encode(sw stream.Writer)
 		}
 	}
 
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.Success != nil {
 		count++
@@ -14570,29 +20128,35 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Encode(sw stream.Writer)
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetDLQReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetDLQReplicationMessagesResponse, error) {
-	var v replicator.GetDLQReplicationMessagesResponse
+func _GetReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesResponse, error) {
+	var v replicator.GetReplicationMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDLQReplicationMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetReplicationMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDLQReplicationMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDLQReplicationMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -14606,7 +20170,7 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetDLQReplicationMessagesResponse_Decode(sr)
+			v.Success, err = _GetReplicationMessagesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -14617,12 +20181,24 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Decode(sr stream.Reader)
 				return err
 			}
 
-		case fh.ID == 2 && fh.Type == wire.TStruct:
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -14649,24 +20225,30 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Decode(sr stream.Reader)
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDLQReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDLQReplicationMessages_Result
+// String returns a readable string representation of a AdminService_GetReplicationMessages_Result
 // struct.
-func (v *AdminService_GetDLQReplicationMessages_Result) String() string {
+func (v *AdminService_GetReplicationMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -14676,19 +20258,27 @@ func (v *AdminService_GetDLQReplicationMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetDLQReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDLQReplicationMessages_Result match the
-// provided AdminService_GetDLQReplicationMessages_Result.
+// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Result match the
+// provided AdminService_GetReplicationMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDLQReplicationMessages_Result) Equals(rhs *AdminService_GetDLQReplicationMessages_Result) bool {
+func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_GetReplicationMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -14700,16 +20290,22 @@ func (v *AdminService_GetDLQReplicationMessages_Result) Equals(rhs *AdminService
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDLQReplicationMessages_Result.
-func (v *AdminService_GetDLQReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetReplicationMessages_Result.
+func (v *AdminService_GetReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -14719,15 +20315,21 @@ func (v *AdminService_GetDLQReplicationMessages_Result) MarshalLogObject(enc zap
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDLQReplicationMessages_Result) GetSuccess() (o *replicator.GetDLQReplicationMessagesResponse) {
+func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator.GetReplicationMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -14736,13 +20338,13 @@ func (v *AdminService_GetDLQReplicationMessages_Result) GetSuccess() (o *replica
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetDLQReplicationMessages_Result) IsSetSuccess() bool {
+func (v *AdminService_GetReplicationMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDLQReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -14751,13 +20353,28 @@ func (v *AdminService_GetDLQReplicationMessages_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetDLQReplicationMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetReplicationMessages_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDLQReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_GetReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -14766,33 +20383,48 @@ func (v *AdminService_GetDLQReplicationMessages_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetDLQReplicationMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_GetReplicationMessages_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetDLQReplicationMessages" for this struct.
-func (v *AdminService_GetDLQReplicationMessages_Result) MethodName() string {
-	return "GetDLQReplicationMessages"
+// This will always be "GetReplicationMessages" for this struct.
+func (v *AdminService_GetReplicationMessages_Result) MethodName() string {
+	return "GetReplicationMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetDLQReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetDomainReplicationMessages_Args represents the arguments for the AdminService.GetDomainReplicationMessages function.
+// AdminService_GetReplicationStatus_Args represents the arguments for the AdminService.GetReplicationStatus function.
 //
-// The arguments for GetDomainReplicationMessages are sent and received over the wire as this struct.
-type AdminService_GetDomainReplicationMessages_Args struct {
-	Request *replicator.GetDomainReplicationMessagesRequest `json:"request,omitempty"`
+// The arguments for GetReplicationStatus are sent and received over the wire as this struct.
+type AdminService_GetReplicationStatus_Args struct {
+	Request *GetReplicationStatusRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDomainReplicationMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetReplicationStatus_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -14804,10 +20436,10 @@ type AdminService_GetDomainReplicationMessages_Args struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetDomainReplicationMessages_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationStatus_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -14827,17 +20459,17 @@ func (v *AdminService_GetDomainReplicationMessages_Args) ToWire() (wire.Value, e
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDomainReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDomainReplicationMessagesRequest, error) {
-	var v replicator.GetDomainReplicationMessagesRequest
+func _GetReplicationStatusRequest_Read(w wire.Value) (*GetReplicationStatusRequest, error) {
+	var v GetReplicationStatusRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDomainReplicationMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetReplicationStatus_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDomainReplicationMessages_Args struct
+// An error is returned if we were unable to build a AdminService_GetReplicationStatus_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -14845,19 +20477,19 @@ func _GetDomainReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetDom
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDomainReplicationMessages_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetDomainReplicationMessages_Args) FromWire(w wire.Value) error {
+//	var v AdminService_GetReplicationStatus_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationStatus_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetDomainReplicationMessagesRequest_Read(field.Value)
+				v.Request, err = _GetReplicationStatusRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -14869,11 +20501,11 @@ func (v *AdminService_GetDomainReplicationMessages_Args) FromWire(w wire.Value)
 	return nil
 }
 
-// Encode serializes a AdminService_GetDomainReplicationMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetReplicationStatus_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDomainReplicationMessages_Args struct could not be encoded.
-func (v *AdminService_GetDomainReplicationMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetReplicationStatus_Args struct could not be encoded.
+func (v *AdminService_GetReplicationStatus_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -14893,18 +20525,18 @@ func (v *AdminService_GetDomainReplicationMessages_Args) Encode(sw stream.Writer
 	return sw.WriteStructEnd()
 }
 
-func _GetDomainReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetDomainReplicationMessagesRequest, error) {
-	var v replicator.GetDomainReplicationMessagesRequest
+func _GetReplicationStatusRequest_Decode(sr stream.Reader) (*GetReplicationStatusRequest, error) {
+	var v GetReplicationStatusRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDomainReplicationMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetReplicationStatus_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDomainReplicationMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetReplicationStatus_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDomainReplicationMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetReplicationStatus_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -14918,7 +20550,7 @@ func (v *AdminService_GetDomainReplicationMessages_Args) Decode(sr stream.Reader
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetDomainReplicationMessagesRequest_Decode(sr)
+			v.Request, err = _GetReplicationStatusRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -14945,9 +20577,9 @@ func (v *AdminService_GetDomainReplicationMessages_Args) Decode(sr stream.Reader
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDomainReplicationMessages_Args
+// String returns a readable string representation of a AdminService_GetReplicationStatus_Args
 // struct.
-func (v *AdminService_GetDomainReplicationMessages_Args) String() string {
+func (v *AdminService_GetReplicationStatus_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -14959,14 +20591,14 @@ func (v *AdminService_GetDomainReplicationMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetDomainReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetReplicationStatus_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDomainReplicationMessages_Args match the
-// provided AdminService_GetDomainReplicationMessages_Args.
+// Equals returns true if all the fields of this AdminService_GetReplicationStatus_Args match the
+// provided AdminService_GetReplicationStatus_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDomainReplicationMessages_Args) Equals(rhs *AdminService_GetDomainReplicationMessages_Args) bool {
+func (v *AdminService_GetReplicationStatus_Args) Equals(rhs *AdminService_GetReplicationStatus_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -14980,8 +20612,8 @@ func (v *AdminService_GetDomainReplicationMessages_Args) Equals(rhs *AdminServic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDomainReplicationMessages_Args.
-func (v *AdminService_GetDomainReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetReplicationStatus_Args.
+func (v *AdminService_GetReplicationStatus_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -14993,7 +20625,7 @@ func (v *AdminService_GetDomainReplicationMessages_Args) MarshalLogObject(enc za
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Args) GetRequest() (o *replicator.GetDomainReplicationMessagesRequest) {
+func (v *AdminService_GetReplicationStatus_Args) GetRequest() (o *GetReplicationStatusRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -15002,141 +20634,130 @@ func (v *AdminService_GetDomainReplicationMessages_Args) GetRequest() (o *replic
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Args) IsSetRequest() bool {
+func (v *AdminService_GetReplicationStatus_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetDomainReplicationMessages" for this struct.
-func (v *AdminService_GetDomainReplicationMessages_Args) MethodName() string {
-	return "GetDomainReplicationMessages"
+// This will always be "GetReplicationStatus" for this struct.
+func (v *AdminService_GetReplicationStatus_Args) MethodName() string {
+	return "GetReplicationStatus"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetDomainReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetReplicationStatus_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetDomainReplicationMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetDomainReplicationMessages
+// AdminService_GetReplicationStatus_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetReplicationStatus
 // function.
-var AdminService_GetDomainReplicationMessages_Helper = struct {
-	// Args accepts the parameters of GetDomainReplicationMessages in-order and returns
+var AdminService_GetReplicationStatus_Helper = struct {
+	// Args accepts the parameters of GetReplicationStatus in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.GetDomainReplicationMessagesRequest,
-	) *AdminService_GetDomainReplicationMessages_Args
+		request *GetReplicationStatusRequest,
+	) *AdminService_GetReplicationStatus_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetDomainReplicationMessages.
+	// by GetReplicationStatus.
 	//
-	// An error can be thrown by GetDomainReplicationMessages only if the
+	// An error can be thrown by GetReplicationStatus only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetDomainReplicationMessages
+	// WrapResponse returns the result struct for GetReplicationStatus
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetDomainReplicationMessages into a serializable result struct.
+	// GetReplicationStatus into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetDomainReplicationMessages
+	// error cannot be thrown by GetReplicationStatus
 	//
-	//   value, err := GetDomainReplicationMessages(args)
-	//   result, err := AdminService_GetDomainReplicationMessages_Helper.WrapResponse(value, err)
+	//   value, err := GetReplicationStatus(args)
+	//   result, err := AdminService_GetReplicationStatus_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetDomainReplicationMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from GetReplicationStatus: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.GetDomainReplicationMessagesResponse, error) (*AdminService_GetDomainReplicationMessages_Result, error)
+	WrapResponse func(*replicator.GetReplicationStatusResponse, error) (*AdminService_GetReplicationStatus_Result, error)
 
-	// UnwrapResponse takes the result struct for GetDomainReplicationMessages
+	// UnwrapResponse takes the result struct for GetReplicationStatus
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetDomainReplicationMessages threw an
+	// The error is non-nil only if GetReplicationStatus threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetDomainReplicationMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetDomainReplicationMessages_Result) (*replicator.GetDomainReplicationMessagesResponse, error)
+	//   value, err := AdminService_GetReplicationStatus_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetReplicationStatus_Result) (*replicator.GetReplicationStatusResponse, error)
 }{}
 
 func init() {
-	AdminService_GetDomainReplicationMessages_Helper.Args = func(
-		request *replicator.GetDomainReplicationMessagesRequest,
-	) *AdminService_GetDomainReplicationMessages_Args {
-		return &AdminService_GetDomainReplicationMessages_Args{
+	AdminService_GetReplicationStatus_Helper.Args = func(
+		request *GetReplicationStatusRequest,
+	) *AdminService_GetReplicationStatus_Args {
+		return &AdminService_GetReplicationStatus_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetDomainReplicationMessages_Helper.IsException = func(err error) bool {
+	AdminService_GetReplicationStatus_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.LimitExceededError:
+		case *shared.InternalServiceError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.ClientVersionNotSupportedError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetDomainReplicationMessages_Helper.WrapResponse = func(success *replicator.GetDomainReplicationMessagesResponse, err error) (*AdminService_GetDomainReplicationMessages_Result, error) {
+	AdminService_GetReplicationStatus_Helper.WrapResponse = func(success *replicator.GetReplicationStatusResponse, err error) (*AdminService_GetReplicationStatus_Result, error) {
 		if err == nil {
-			return &AdminService_GetDomainReplicationMessages_Result{Success: success}, nil
+			return &AdminService_GetReplicationStatus_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.BadRequestError")
-			}
-			return &AdminService_GetDomainReplicationMessages_Result{BadRequestError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationStatus_Result.BadRequestError")
 			}
-			return &AdminService_GetDomainReplicationMessages_Result{LimitExceededError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_GetReplicationStatus_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationStatus_Result.InternalServiceError")
 			}
-			return &AdminService_GetDomainReplicationMessages_Result{ServiceBusyError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+			return &AdminService_GetReplicationStatus_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainReplicationMessages_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationStatus_Result.ServiceBusyError")
 			}
-			return &AdminService_GetDomainReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
+			return &AdminService_GetReplicationStatus_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetDomainReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetDomainReplicationMessages_Result) (success *replicator.GetDomainReplicationMessagesResponse, err error) {
+	AdminService_GetReplicationStatus_Helper.UnwrapResponse = func(result *AdminService_GetReplicationStatus_Result) (success *replicator.GetReplicationStatusResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
 			return
 		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.ClientVersionNotSupportedError != nil {
-			err = result.ClientVersionNotSupportedError
-			return
-		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -15149,21 +20770,20 @@ func init() {
 
 }
 
-// AdminService_GetDomainReplicationMessages_Result represents the result of a AdminService.GetDomainReplicationMessages function call.
+// AdminService_GetReplicationStatus_Result represents the result of a AdminService.GetReplicationStatus function call.
 //
-// The result of a GetDomainReplicationMessages execution is sent and received over the wire as this struct.
+// The result of a GetReplicationStatus execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetDomainReplicationMessages_Result struct {
-	// Value returned by GetDomainReplicationMessages after a successful execution.
-	Success                        *replicator.GetDomainReplicationMessagesResponse `json:"success,omitempty"`
-	BadRequestError                *shared.BadRequestError                          `json:"badRequestError,omitempty"`
-	LimitExceededError             *shared.LimitExceededError                       `json:"limitExceededError,omitempty"`
-	ServiceBusyError               *shared.ServiceBusyError                         `json:"serviceBusyError,omitempty"`
-	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError           `json:"clientVersionNotSupportedError,omitempty"`
+type AdminService_GetReplicationStatus_Result struct {
+	// Value returned by GetReplicationStatus after a successful execution.
+	Success              *replicator.GetReplicationStatusResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                  `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError             `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                 `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDomainReplicationMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetReplicationStatus_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -15175,12 +20795,12 @@ type AdminService_GetDomainReplicationMessages_Result struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_GetDomainReplicationMessages_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationStatus_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -15202,12 +20822,12 @@ func (v *AdminService_GetDomainReplicationMessages_Result) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -15215,48 +20835,28 @@ func (v *AdminService_GetDomainReplicationMessages_Result) ToWire() (wire.Value,
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetReplicationStatus_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDomainReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetDomainReplicationMessagesResponse, error) {
-	var v replicator.GetDomainReplicationMessagesResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ClientVersionNotSupportedError_Read(w wire.Value) (*shared.ClientVersionNotSupportedError, error) {
-	var v shared.ClientVersionNotSupportedError
+func _GetReplicationStatusResponse_Read(w wire.Value) (*replicator.GetReplicationStatusResponse, error) {
+	var v replicator.GetReplicationStatusResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDomainReplicationMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetReplicationStatus_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDomainReplicationMessages_Result struct
+// An error is returned if we were unable to build a AdminService_GetReplicationStatus_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -15264,19 +20864,19 @@ func _ClientVersionNotSupportedError_Read(w wire.Value) (*shared.ClientVersionNo
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDomainReplicationMessages_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_GetDomainReplicationMessages_Result) FromWire(w wire.Value) error {
+//	var v AdminService_GetReplicationStatus_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationStatus_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetDomainReplicationMessagesResponse_Read(field.Value)
+				v.Success, err = _GetReplicationStatusResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -15290,29 +20890,21 @@ func (v *AdminService_GetDomainReplicationMessages_Result) FromWire(w wire.Value
 				}
 
 			}
-		case 3:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -15324,27 +20916,24 @@ func (v *AdminService_GetDomainReplicationMessages_Result) FromWire(w wire.Value
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationStatus_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetDomainReplicationMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetReplicationStatus_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDomainReplicationMessages_Result struct could not be encoded.
-func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetReplicationStatus_Result struct could not be encoded.
+func (v *AdminService_GetReplicationStatus_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -15373,11 +20962,11 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writ
 		}
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -15386,7 +20975,7 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writ
 	}
 
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -15397,18 +20986,6 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writ
 		}
 	}
 
-	if v.ClientVersionNotSupportedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
 	if v.Success != nil {
 		count++
@@ -15416,47 +20993,32 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Encode(sw stream.Writ
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		count++
-	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationStatus_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetDomainReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetDomainReplicationMessagesResponse, error) {
-	var v replicator.GetDomainReplicationMessagesResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _ClientVersionNotSupportedError_Decode(sr stream.Reader) (*shared.ClientVersionNotSupportedError, error) {
-	var v shared.ClientVersionNotSupportedError
+func _GetReplicationStatusResponse_Decode(sr stream.Reader) (*replicator.GetReplicationStatusResponse, error) {
+	var v replicator.GetReplicationStatusResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDomainReplicationMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetReplicationStatus_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDomainReplicationMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetReplicationStatus_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDomainReplicationMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetReplicationStatus_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -15470,7 +21032,7 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Decode(sr stream.Read
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetDomainReplicationMessagesResponse_Decode(sr)
+			v.Success, err = _GetReplicationStatusResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -15481,24 +21043,18 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Decode(sr stream.Read
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 4 && fh.Type == wire.TStruct:
+		case fh.ID == 3 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -15525,30 +21081,27 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Decode(sr stream.Read
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		count++
-	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDomainReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetReplicationStatus_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDomainReplicationMessages_Result
+// String returns a readable string representation of a AdminService_GetReplicationStatus_Result
 // struct.
-func (v *AdminService_GetDomainReplicationMessages_Result) String() string {
+func (v *AdminService_GetReplicationStatus_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -15558,27 +21111,23 @@ func (v *AdminService_GetDomainReplicationMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
-		i++
-	}
 
-	return fmt.Sprintf("AdminService_GetDomainReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetReplicationStatus_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDomainReplicationMessages_Result match the
-// provided AdminService_GetDomainReplicationMessages_Result.
+// Equals returns true if all the fields of this AdminService_GetReplicationStatus_Result match the
+// provided AdminService_GetReplicationStatus_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDomainReplicationMessages_Result) Equals(rhs *AdminService_GetDomainReplicationMessages_Result) bool {
+func (v *AdminService_GetReplicationStatus_Result) Equals(rhs *AdminService_GetReplicationStatus_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -15590,22 +21139,19 @@ func (v *AdminService_GetDomainReplicationMessages_Result) Equals(rhs *AdminServ
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDomainReplicationMessages_Result.
-func (v *AdminService_GetDomainReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetReplicationStatus_Result.
+func (v *AdminService_GetReplicationStatus_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -15615,21 +21161,18 @@ func (v *AdminService_GetDomainReplicationMessages_Result) MarshalLogObject(enc
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
-	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Result) GetSuccess() (o *replicator.GetDomainReplicationMessagesResponse) {
+func (v *AdminService_GetReplicationStatus_Result) GetSuccess() (o *replicator.GetReplicationStatusResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -15638,13 +21181,13 @@ func (v *AdminService_GetDomainReplicationMessages_Result) GetSuccess() (o *repl
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Result) IsSetSuccess() bool {
+func (v *AdminService_GetReplicationStatus_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetReplicationStatus_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -15653,28 +21196,28 @@ func (v *AdminService_GetDomainReplicationMessages_Result) GetBadRequestError()
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetReplicationStatus_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
+func (v *AdminService_GetReplicationStatus_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetReplicationStatus_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_GetReplicationStatus_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -15683,48 +21226,33 @@ func (v *AdminService_GetDomainReplicationMessages_Result) GetServiceBusyError()
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_GetReplicationStatus_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetDomainReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
-	if v != nil && v.ClientVersionNotSupportedError != nil {
-		return v.ClientVersionNotSupportedError
-	}
-
-	return
-}
-
-// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *AdminService_GetDomainReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
-	return v != nil && v.ClientVersionNotSupportedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetDomainReplicationMessages" for this struct.
-func (v *AdminService_GetDomainReplicationMessages_Result) MethodName() string {
-	return "GetDomainReplicationMessages"
+// This will always be "GetReplicationStatus" for this struct.
+func (v *AdminService_GetReplicationStatus_Result) MethodName() string {
+	return "GetReplicationStatus"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetDomainReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetReplicationStatus_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetDynamicConfig_Args represents the arguments for the AdminService.GetDynamicConfig function.
+// AdminService_GetWorkflowExecutionRawHistoryV2_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistoryV2 function.
 //
-// The arguments for GetDynamicConfig are sent and received over the wire as this struct.
-type AdminService_GetDynamicConfig_Args struct {
-	Request *GetDynamicConfigRequest `json:"request,omitempty"`
+// The arguments for GetWorkflowExecutionRawHistoryV2 are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryV2Request `json:"getRequest,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDynamicConfig_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -15739,7 +21267,7 @@ type AdminService_GetDynamicConfig_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -15747,8 +21275,8 @@ func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -15759,17 +21287,17 @@ func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, error) {
-	var v GetDynamicConfigRequest
+func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Request, error) {
+	var v GetWorkflowExecutionRawHistoryV2Request
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDynamicConfig_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Args struct
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -15777,19 +21305,19 @@ func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, erro
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDynamicConfig_Args
+//   var v AdminService_GetWorkflowExecutionRawHistoryV2_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetDynamicConfigRequest_Read(field.Value)
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -15801,20 +21329,20 @@ func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_GetDynamicConfig_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be encoded.
-func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be encoded.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Request != nil {
+	if v.GetRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Request.Encode(sw); err != nil {
+		if err := v.GetRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -15825,18 +21353,18 @@ func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _GetDynamicConfigRequest_Decode(sr stream.Reader) (*GetDynamicConfigRequest, error) {
-	var v GetDynamicConfigRequest
+func _GetWorkflowExecutionRawHistoryV2Request_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Request, error) {
+	var v GetWorkflowExecutionRawHistoryV2Request
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDynamicConfig_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -15850,7 +21378,7 @@ func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetDynamicConfigRequest_Decode(sr)
+			v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -15877,34 +21405,34 @@ func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDynamicConfig_Args
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Args
 // struct.
-func (v *AdminService_GetDynamicConfig_Args) String() string {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Args match the
-// provided AdminService_GetDynamicConfig_Args.
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistoryV2_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamicConfig_Args) bool {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
 		return false
 	}
 
@@ -15912,133 +21440,147 @@ func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDynamicConfig_Args.
-func (v *AdminService_GetDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetGetRequest returns the value of GetRequest if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Args) GetRequest() (o *GetDynamicConfigRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryV2Request) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetDynamicConfig_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetDynamicConfig" for this struct.
-func (v *AdminService_GetDynamicConfig_Args) MethodName() string {
-	return "GetDynamicConfig"
+// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistoryV2"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetDynamicConfig_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetDynamicConfig
+// AdminService_GetWorkflowExecutionRawHistoryV2_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistoryV2
 // function.
-var AdminService_GetDynamicConfig_Helper = struct {
-	// Args accepts the parameters of GetDynamicConfig in-order and returns
+var AdminService_GetWorkflowExecutionRawHistoryV2_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistoryV2 in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *GetDynamicConfigRequest,
-	) *AdminService_GetDynamicConfig_Args
+		getRequest *GetWorkflowExecutionRawHistoryV2Request,
+	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetDynamicConfig.
+	// by GetWorkflowExecutionRawHistoryV2.
 	//
-	// An error can be thrown by GetDynamicConfig only if the
+	// An error can be thrown by GetWorkflowExecutionRawHistoryV2 only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetDynamicConfig
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistoryV2
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetDynamicConfig into a serializable result struct.
+	// GetWorkflowExecutionRawHistoryV2 into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetDynamicConfig
+	// error cannot be thrown by GetWorkflowExecutionRawHistoryV2
 	//
-	//   value, err := GetDynamicConfig(args)
-	//   result, err := AdminService_GetDynamicConfig_Helper.WrapResponse(value, err)
+	//   value, err := GetWorkflowExecutionRawHistoryV2(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetDynamicConfig: %v", err)
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistoryV2: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetDynamicConfigResponse, error) (*AdminService_GetDynamicConfig_Result, error)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryV2Response, error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error)
 
-	// UnwrapResponse takes the result struct for GetDynamicConfig
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistoryV2
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetDynamicConfig threw an
+	// The error is non-nil only if GetWorkflowExecutionRawHistoryV2 threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetDynamicConfig_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetDynamicConfig_Result) (*GetDynamicConfigResponse, error)
+	//   value, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistoryV2_Result) (*GetWorkflowExecutionRawHistoryV2Response, error)
 }{}
 
 func init() {
-	AdminService_GetDynamicConfig_Helper.Args = func(
-		request *GetDynamicConfigRequest,
-	) *AdminService_GetDynamicConfig_Args {
-		return &AdminService_GetDynamicConfig_Args{
-			Request: request,
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryV2Request,
+	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args {
+		return &AdminService_GetWorkflowExecutionRawHistoryV2_Args{
+			GetRequest: getRequest,
 		}
 	}
 
-	AdminService_GetDynamicConfig_Helper.IsException = func(err error) bool {
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetDynamicConfig_Helper.WrapResponse = func(success *GetDynamicConfigResponse, err error) (*AdminService_GetDynamicConfig_Result, error) {
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryV2Response, err error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error) {
 		if err == nil {
-			return &AdminService_GetDynamicConfig_Result{Success: success}, nil
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.BadRequestError")
 			}
-			return &AdminService_GetDynamicConfig_Result{BadRequestError: e}, nil
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.InternalServiceError")
 			}
-			return &AdminService_GetDynamicConfig_Result{InternalServiceError: e}, nil
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.ServiceBusyError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_GetDynamicConfig_Result) (success *GetDynamicConfigResponse, err error) {
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistoryV2_Result) (success *GetWorkflowExecutionRawHistoryV2Response, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -16047,6 +21589,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -16059,19 +21609,21 @@ func init() {
 
 }
 
-// AdminService_GetDynamicConfig_Result represents the result of a AdminService.GetDynamicConfig function call.
+// AdminService_GetWorkflowExecutionRawHistoryV2_Result represents the result of a AdminService.GetWorkflowExecutionRawHistoryV2 function call.
 //
-// The result of a GetDynamicConfig execution is sent and received over the wire as this struct.
+// The result of a GetWorkflowExecutionRawHistoryV2 execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetDynamicConfig_Result struct {
-	// Value returned by GetDynamicConfig after a successful execution.
-	Success              *GetDynamicConfigResponse    `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistoryV2 after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryV2Response `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                   `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError              `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                  `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDynamicConfig_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -16086,9 +21638,9 @@ type AdminService_GetDynamicConfig_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -16118,25 +21670,41 @@ func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, error) {
-	var v GetDynamicConfigResponse
+func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	var v GetWorkflowExecutionRawHistoryV2Response
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDynamicConfig_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Result struct
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -16144,19 +21712,19 @@ func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, er
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetDynamicConfig_Result
+//   var v AdminService_GetWorkflowExecutionRawHistoryV2_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetDynamicConfigResponse_Read(field.Value)
+				v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -16177,6 +21745,22 @@ func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -16191,18 +21775,24 @@ func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetDynamicConfig_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be encoded.
-func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be encoded.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -16211,7 +21801,31 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -16219,11 +21833,11 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -16231,11 +21845,11 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -16253,26 +21867,32 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetDynamicConfigResponse_Decode(sr stream.Reader) (*GetDynamicConfigResponse, error) {
-	var v GetDynamicConfigResponse
+func _GetWorkflowExecutionRawHistoryV2Response_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	var v GetWorkflowExecutionRawHistoryV2Response
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDynamicConfig_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -16286,7 +21906,7 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetDynamicConfigResponse_Decode(sr)
+			v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -16303,6 +21923,18 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -16332,21 +21964,27 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDynamicConfig_Result
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Result
 // struct.
-func (v *AdminService_GetDynamicConfig_Result) String() string {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -16360,15 +21998,23 @@ func (v *AdminService_GetDynamicConfig_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Result match the
-// provided AdminService_GetDynamicConfig_Result.
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistoryV2_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynamicConfig_Result) bool {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -16383,13 +22029,19 @@ func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynam
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDynamicConfig_Result.
-func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -16402,12 +22054,18 @@ func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.Obje
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfigResponse) {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryV2Response) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -16416,13 +22074,13 @@ func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfig
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetSuccess() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -16431,13 +22089,13 @@ func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -16446,33 +22104,63 @@ func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *sha
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetDynamicConfig" for this struct.
-func (v *AdminService_GetDynamicConfig_Result) MethodName() string {
-	return "GetDynamicConfig"
+// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistoryV2"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetReplicationMessages_Args represents the arguments for the AdminService.GetReplicationMessages function.
+// AdminService_ListDynamicConfig_Args represents the arguments for the AdminService.ListDynamicConfig function.
 //
-// The arguments for GetReplicationMessages are sent and received over the wire as this struct.
-type AdminService_GetReplicationMessages_Args struct {
-	Request *replicator.GetReplicationMessagesRequest `json:"request,omitempty"`
+// The arguments for ListDynamicConfig are sent and received over the wire as this struct.
+type AdminService_ListDynamicConfig_Args struct {
+	Request *ListDynamicConfigRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetReplicationMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListDynamicConfig_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -16487,7 +22175,7 @@ type AdminService_GetReplicationMessages_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -16507,17 +22195,17 @@ func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicationMessagesRequest, error) {
-	var v replicator.GetReplicationMessagesRequest
+func _ListDynamicConfigRequest_Read(w wire.Value) (*ListDynamicConfigRequest, error) {
+	var v ListDynamicConfigRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetReplicationMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ListDynamicConfig_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Args struct
+// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -16525,19 +22213,19 @@ func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicati
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetReplicationMessages_Args
+//   var v AdminService_ListDynamicConfig_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetReplicationMessagesRequest_Read(field.Value)
+				v.Request, err = _ListDynamicConfigRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -16549,11 +22237,11 @@ func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a AdminService_GetReplicationMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ListDynamicConfig_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be encoded.
-func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be encoded.
+func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -16573,18 +22261,18 @@ func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-func _GetReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesRequest, error) {
-	var v replicator.GetReplicationMessagesRequest
+func _ListDynamicConfigRequest_Decode(sr stream.Reader) (*ListDynamicConfigRequest, error) {
+	var v ListDynamicConfigRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetReplicationMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ListDynamicConfig_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -16598,7 +22286,7 @@ func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetReplicationMessagesRequest_Decode(sr)
+			v.Request, err = _ListDynamicConfigRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -16625,9 +22313,9 @@ func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetReplicationMessages_Args
+// String returns a readable string representation of a AdminService_ListDynamicConfig_Args
 // struct.
-func (v *AdminService_GetReplicationMessages_Args) String() string {
+func (v *AdminService_ListDynamicConfig_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -16639,14 +22327,14 @@ func (v *AdminService_GetReplicationMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Args match the
-// provided AdminService_GetReplicationMessages_Args.
+// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Args match the
+// provided AdminService_ListDynamicConfig_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetReplicationMessages_Args) bool {
+func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynamicConfig_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -16660,8 +22348,8 @@ func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetR
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetReplicationMessages_Args.
-func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListDynamicConfig_Args.
+func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -16673,7 +22361,7 @@ func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.GetReplicationMessagesRequest) {
+func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfigRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -16682,139 +22370,106 @@ func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.G
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetReplicationMessages_Args) IsSetRequest() bool {
+func (v *AdminService_ListDynamicConfig_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetReplicationMessages" for this struct.
-func (v *AdminService_GetReplicationMessages_Args) MethodName() string {
-	return "GetReplicationMessages"
+// This will always be "ListDynamicConfig" for this struct.
+func (v *AdminService_ListDynamicConfig_Args) MethodName() string {
+	return "ListDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetReplicationMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetReplicationMessages
+// AdminService_ListDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListDynamicConfig
 // function.
-var AdminService_GetReplicationMessages_Helper = struct {
-	// Args accepts the parameters of GetReplicationMessages in-order and returns
+var AdminService_ListDynamicConfig_Helper = struct {
+	// Args accepts the parameters of ListDynamicConfig in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.GetReplicationMessagesRequest,
-	) *AdminService_GetReplicationMessages_Args
+		request *ListDynamicConfigRequest,
+	) *AdminService_ListDynamicConfig_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetReplicationMessages.
+	// by ListDynamicConfig.
 	//
-	// An error can be thrown by GetReplicationMessages only if the
+	// An error can be thrown by ListDynamicConfig only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetReplicationMessages
+	// WrapResponse returns the result struct for ListDynamicConfig
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetReplicationMessages into a serializable result struct.
+	// ListDynamicConfig into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetReplicationMessages
+	// error cannot be thrown by ListDynamicConfig
 	//
-	//   value, err := GetReplicationMessages(args)
-	//   result, err := AdminService_GetReplicationMessages_Helper.WrapResponse(value, err)
+	//   value, err := ListDynamicConfig(args)
+	//   result, err := AdminService_ListDynamicConfig_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetReplicationMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from ListDynamicConfig: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.GetReplicationMessagesResponse, error) (*AdminService_GetReplicationMessages_Result, error)
+	WrapResponse func(*ListDynamicConfigResponse, error) (*AdminService_ListDynamicConfig_Result, error)
 
-	// UnwrapResponse takes the result struct for GetReplicationMessages
+	// UnwrapResponse takes the result struct for ListDynamicConfig
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetReplicationMessages threw an
+	// The error is non-nil only if ListDynamicConfig threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetReplicationMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetReplicationMessages_Result) (*replicator.GetReplicationMessagesResponse, error)
+	//   value, err := AdminService_ListDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListDynamicConfig_Result) (*ListDynamicConfigResponse, error)
 }{}
 
 func init() {
-	AdminService_GetReplicationMessages_Helper.Args = func(
-		request *replicator.GetReplicationMessagesRequest,
-	) *AdminService_GetReplicationMessages_Args {
-		return &AdminService_GetReplicationMessages_Args{
+	AdminService_ListDynamicConfig_Helper.Args = func(
+		request *ListDynamicConfigRequest,
+	) *AdminService_ListDynamicConfig_Args {
+		return &AdminService_ListDynamicConfig_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetReplicationMessages_Helper.IsException = func(err error) bool {
+	AdminService_ListDynamicConfig_Helper.IsException = func(err error) bool {
 		switch err.(type) {
-		case *shared.BadRequestError:
-			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.ServiceBusyError:
-			return true
-		case *shared.ClientVersionNotSupportedError:
+		case *shared.InternalServiceError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetReplicationMessages_Helper.WrapResponse = func(success *replicator.GetReplicationMessagesResponse, err error) (*AdminService_GetReplicationMessages_Result, error) {
+	AdminService_ListDynamicConfig_Helper.WrapResponse = func(success *ListDynamicConfigResponse, err error) (*AdminService_ListDynamicConfig_Result, error) {
 		if err == nil {
-			return &AdminService_GetReplicationMessages_Result{Success: success}, nil
+			return &AdminService_ListDynamicConfig_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
-		case *shared.BadRequestError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.BadRequestError")
-			}
-			return &AdminService_GetReplicationMessages_Result{BadRequestError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.LimitExceededError")
-			}
-			return &AdminService_GetReplicationMessages_Result{LimitExceededError: e}, nil
-		case *shared.ServiceBusyError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ServiceBusyError")
-			}
-			return &AdminService_GetReplicationMessages_Result{ServiceBusyError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDynamicConfig_Result.InternalServiceError")
 			}
-			return &AdminService_GetReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
+			return &AdminService_ListDynamicConfig_Result{InternalServiceError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetReplicationMessages_Result) (success *replicator.GetReplicationMessagesResponse, err error) {
-		if result.BadRequestError != nil {
-			err = result.BadRequestError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
-			return
-		}
-		if result.ClientVersionNotSupportedError != nil {
-			err = result.ClientVersionNotSupportedError
+	AdminService_ListDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_ListDynamicConfig_Result) (success *ListDynamicConfigResponse, err error) {
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
 			return
 		}
 
@@ -16829,21 +22484,18 @@ func init() {
 
 }
 
-// AdminService_GetReplicationMessages_Result represents the result of a AdminService.GetReplicationMessages function call.
+// AdminService_ListDynamicConfig_Result represents the result of a AdminService.ListDynamicConfig function call.
 //
-// The result of a GetReplicationMessages execution is sent and received over the wire as this struct.
+// The result of a ListDynamicConfig execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetReplicationMessages_Result struct {
-	// Value returned by GetReplicationMessages after a successful execution.
-	Success                        *replicator.GetReplicationMessagesResponse `json:"success,omitempty"`
-	BadRequestError                *shared.BadRequestError                    `json:"badRequestError,omitempty"`
-	LimitExceededError             *shared.LimitExceededError                 `json:"limitExceededError,omitempty"`
-	ServiceBusyError               *shared.ServiceBusyError                   `json:"serviceBusyError,omitempty"`
-	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError     `json:"clientVersionNotSupportedError,omitempty"`
+type AdminService_ListDynamicConfig_Result struct {
+	// Value returned by ListDynamicConfig after a successful execution.
+	Success              *ListDynamicConfigResponse   `json:"success,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetReplicationMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListDynamicConfig_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -16858,9 +22510,9 @@ type AdminService_GetReplicationMessages_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -16874,57 +22526,33 @@ func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.BadRequestError != nil {
-		w, err = v.BadRequestError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicationMessagesResponse, error) {
-	var v replicator.GetReplicationMessagesResponse
+func _ListDynamicConfigResponse_Read(w wire.Value) (*ListDynamicConfigResponse, error) {
+	var v ListDynamicConfigResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetReplicationMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ListDynamicConfig_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Result struct
+// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -16932,19 +22560,19 @@ func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicat
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetReplicationMessages_Result
+//   var v AdminService_ListDynamicConfig_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ListDynamicConfig_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetReplicationMessagesResponse_Read(field.Value)
+				v.Success, err = _ListDynamicConfigResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -16952,31 +22580,7 @@ func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) erro
 			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -16989,30 +22593,21 @@ func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) erro
 	if v.Success != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetReplicationMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ListDynamicConfig_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be encoded.
-func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be encoded.
+func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -17029,47 +22624,11 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.BadRequestError != nil {
+	if v.InternalServiceError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ClientVersionNotSupportedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -17081,76 +22640,49 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 	if v.Success != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesResponse, error) {
-	var v replicator.GetReplicationMessagesResponse
+func _ListDynamicConfigResponse_Decode(sr stream.Reader) (*ListDynamicConfigResponse, error) {
+	var v ListDynamicConfigResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetReplicationMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ListDynamicConfig_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetReplicationMessagesResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.BadRequestError, err = _BadRequestError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
+		return err
+	}
 
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ListDynamicConfigResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -17178,63 +22710,42 @@ func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) er
 	if v.Success != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetReplicationMessages_Result
+// String returns a readable string representation of a AdminService_ListDynamicConfig_Result
 // struct.
-func (v *AdminService_GetReplicationMessages_Result) String() string {
+func (v *AdminService_ListDynamicConfig_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.BadRequestError != nil {
-		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
-		i++
-	}
-	if v.ClientVersionNotSupportedError != nil {
-		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Result match the
-// provided AdminService_GetReplicationMessages_Result.
+// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Result match the
+// provided AdminService_ListDynamicConfig_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_GetReplicationMessages_Result) bool {
+func (v *AdminService_ListDynamicConfig_Result) Equals(rhs *AdminService_ListDynamicConfig_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -17243,16 +22754,7 @@ func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_Ge
 	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
-		return false
-	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
-		return false
-	}
-	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
 
@@ -17260,32 +22762,23 @@ func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_Ge
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetReplicationMessages_Result.
-func (v *AdminService_GetReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListDynamicConfig_Result.
+func (v *AdminService_ListDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Success != nil {
 		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.BadRequestError != nil {
-		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	if v.ClientVersionNotSupportedError != nil {
-		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator.GetReplicationMessagesResponse) {
+func (v *AdminService_ListDynamicConfig_Result) GetSuccess() (o *ListDynamicConfigResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -17294,93 +22787,48 @@ func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetSuccess() bool {
+func (v *AdminService_ListDynamicConfig_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
-// GetBadRequestError returns the value of BadRequestError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
-	if v != nil && v.BadRequestError != nil {
-		return v.BadRequestError
-	}
-
-	return
-}
-
-// IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetBadRequestError() bool {
-	return v != nil && v.BadRequestError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
-	}
-
-	return
-}
-
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
-}
-
-// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
-	if v != nil && v.ClientVersionNotSupportedError != nil {
-		return v.ClientVersionNotSupportedError
+func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
-	return v != nil && v.ClientVersionNotSupportedError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListDynamicConfig_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetReplicationMessages" for this struct.
-func (v *AdminService_GetReplicationMessages_Result) MethodName() string {
-	return "GetReplicationMessages"
+// This will always be "ListDynamicConfig" for this struct.
+func (v *AdminService_ListDynamicConfig_Result) MethodName() string {
+	return "ListDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistoryV2 function.
+// AdminService_MergeDLQMessages_Args represents the arguments for the AdminService.MergeDLQMessages function.
 //
-// The arguments for GetWorkflowExecutionRawHistoryV2 are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryV2Request `json:"getRequest,omitempty"`
+// The arguments for MergeDLQMessages are sent and received over the wire as this struct.
+type AdminService_MergeDLQMessages_Args struct {
+	Request *replicator.MergeDLQMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MergeDLQMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -17395,7 +22843,7 @@ type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -17403,8 +22851,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Valu
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -17415,17 +22863,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Valu
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Request, error) {
-	var v GetWorkflowExecutionRawHistoryV2Request
+func _MergeDLQMessagesRequest_Read(w wire.Value) (*replicator.MergeDLQMessagesRequest, error) {
+	var v replicator.MergeDLQMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_MergeDLQMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct
+// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -17433,19 +22881,19 @@ func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowEx
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistoryV2_Args
+//   var v AdminService_MergeDLQMessages_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Value) error {
+func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Read(field.Value)
+				v.Request, err = _MergeDLQMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -17457,20 +22905,20 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Val
 	return nil
 }
 
-// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly into bytes, without going
+// Encode serializes a AdminService_MergeDLQMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be encoded.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be encoded.
+func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.GetRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.GetRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -17481,18 +22929,18 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Wr
 	return sw.WriteStructEnd()
 }
 
-func _GetWorkflowExecutionRawHistoryV2Request_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Request, error) {
-	var v GetWorkflowExecutionRawHistoryV2Request
+func _MergeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesRequest, error) {
+	var v replicator.MergeDLQMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_MergeDLQMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be generated from the wire
+// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -17506,7 +22954,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Re
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Decode(sr)
+			v.Request, err = _MergeDLQMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -17533,34 +22981,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Re
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Args
+// String returns a readable string representation of a AdminService_MergeDLQMessages_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) String() string {
+func (v *AdminService_MergeDLQMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MergeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistoryV2_Args.
+// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Args match the
+// provided AdminService_MergeDLQMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Args) bool {
+func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -17568,147 +23016,147 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MergeDLQMessages_Args.
+func (v *AdminService_MergeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryV2Request) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_MergeDLQMessages_Args) GetRequest() (o *replicator.MergeDLQMessagesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_MergeDLQMessages_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistoryV2"
+// This will always be "MergeDLQMessages" for this struct.
+func (v *AdminService_MergeDLQMessages_Args) MethodName() string {
+	return "MergeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MergeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistoryV2
+// AdminService_MergeDLQMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.MergeDLQMessages
 // function.
-var AdminService_GetWorkflowExecutionRawHistoryV2_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistoryV2 in-order and returns
+var AdminService_MergeDLQMessages_Helper = struct {
+	// Args accepts the parameters of MergeDLQMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryV2Request,
-	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args
+		request *replicator.MergeDLQMessagesRequest,
+	) *AdminService_MergeDLQMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistoryV2.
+	// by MergeDLQMessages.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistoryV2 only if the
+	// An error can be thrown by MergeDLQMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistoryV2
+	// WrapResponse returns the result struct for MergeDLQMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistoryV2 into a serializable result struct.
+	// MergeDLQMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistoryV2
+	// error cannot be thrown by MergeDLQMessages
 	//
-	//   value, err := GetWorkflowExecutionRawHistoryV2(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(value, err)
+	//   value, err := MergeDLQMessages(args)
+	//   result, err := AdminService_MergeDLQMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistoryV2: %v", err)
+	//     return fmt.Errorf("unexpected error from MergeDLQMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryV2Response, error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error)
+	WrapResponse func(*replicator.MergeDLQMessagesResponse, error) (*AdminService_MergeDLQMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistoryV2
+	// UnwrapResponse takes the result struct for MergeDLQMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistoryV2 threw an
+	// The error is non-nil only if MergeDLQMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistoryV2_Result) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	//   value, err := AdminService_MergeDLQMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_MergeDLQMessages_Result) (*replicator.MergeDLQMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryV2Request,
-	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args {
-		return &AdminService_GetWorkflowExecutionRawHistoryV2_Args{
-			GetRequest: getRequest,
+	AdminService_MergeDLQMessages_Helper.Args = func(
+		request *replicator.MergeDLQMessagesRequest,
+	) *AdminService_MergeDLQMessages_Args {
+		return &AdminService_MergeDLQMessages_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.IsException = func(err error) bool {
+	AdminService_MergeDLQMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryV2Response, err error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error) {
+	AdminService_MergeDLQMessages_Helper.WrapResponse = func(success *replicator.MergeDLQMessagesResponse, err error) (*AdminService_MergeDLQMessages_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{Success: success}, nil
+			return &AdminService_MergeDLQMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{BadRequestError: e}, nil
+			return &AdminService_MergeDLQMessages_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.InternalServiceError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_MergeDLQMessages_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.ServiceBusyError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{EntityNotExistError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_MergeDLQMessages_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.EntityNotExistError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{ServiceBusyError: e}, nil
+			return &AdminService_MergeDLQMessages_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistoryV2_Result) (success *GetWorkflowExecutionRawHistoryV2Response, err error) {
+	AdminService_MergeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_MergeDLQMessages_Result) (success *replicator.MergeDLQMessagesResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -17717,14 +23165,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -17737,21 +23185,21 @@ func init() {
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Result represents the result of a AdminService.GetWorkflowExecutionRawHistoryV2 function call.
+// AdminService_MergeDLQMessages_Result represents the result of a AdminService.MergeDLQMessages function call.
 //
-// The result of a GetWorkflowExecutionRawHistoryV2 execution is sent and received over the wire as this struct.
+// The result of a MergeDLQMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistoryV2 after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryV2Response `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                   `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError              `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                  `json:"serviceBusyError,omitempty"`
+type AdminService_MergeDLQMessages_Result struct {
+	// Value returned by MergeDLQMessages after a successful execution.
+	Success              *replicator.MergeDLQMessagesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MergeDLQMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -17766,7 +23214,7 @@ type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -17798,16 +23246,16 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Va
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -17816,23 +23264,23 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Va
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Response, error) {
-	var v GetWorkflowExecutionRawHistoryV2Response
+func _MergeDLQMessagesResponse_Read(w wire.Value) (*replicator.MergeDLQMessagesResponse, error) {
+	var v replicator.MergeDLQMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_MergeDLQMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct
+// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -17840,19 +23288,19 @@ func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowE
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistoryV2_Result
+//   var v AdminService_MergeDLQMessages_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.Value) error {
+func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Read(field.Value)
+				v.Success, err = _MergeDLQMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -17876,7 +23324,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.V
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -17884,7 +23332,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.V
 			}
 		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -17903,24 +23351,24 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.V
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly into bytes, without going
+// Encode serializes a AdminService_MergeDLQMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be encoded.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be encoded.
+func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -17961,11 +23409,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 		}
 	}
 
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -17973,11 +23421,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -17995,32 +23443,32 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetWorkflowExecutionRawHistoryV2Response_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Response, error) {
-	var v GetWorkflowExecutionRawHistoryV2Response
+func _MergeDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesResponse, error) {
+	var v replicator.MergeDLQMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_MergeDLQMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be generated from the wire
+// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -18034,7 +23482,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Decode(sr)
+			v.Success, err = _MergeDLQMessagesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -18052,13 +23500,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -18092,22 +23540,22 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Result
+// String returns a readable string representation of a AdminService_MergeDLQMessages_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) String() string {
+func (v *AdminService_MergeDLQMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -18126,23 +23574,23 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MergeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistoryV2_Result.
+// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Result match the
+// provided AdminService_MergeDLQMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Result) bool {
+func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -18157,10 +23605,10 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *Admin
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 
@@ -18168,8 +23616,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *Admin
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MergeDLQMessages_Result.
+func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -18182,18 +23630,18 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MarshalLogObject(
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryV2Response) {
+func (v *AdminService_MergeDLQMessages_Result) GetSuccess() (o *replicator.MergeDLQMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -18202,13 +23650,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetSuccess() (o *
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetSuccess() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_MergeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -18217,13 +23665,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetBadRequestErro
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetBadRequestError() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_MergeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -18232,78 +23680,78 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetInternalServic
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_MergeDLQMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_MergeDLQMessages_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistoryV2"
+// This will always be "MergeDLQMessages" for this struct.
+func (v *AdminService_MergeDLQMessages_Result) MethodName() string {
+	return "MergeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MergeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ListDynamicConfig_Args represents the arguments for the AdminService.ListDynamicConfig function.
+// AdminService_MoveTaskList_Args represents the arguments for the AdminService.MoveTaskList function.
 //
-// The arguments for ListDynamicConfig are sent and received over the wire as this struct.
-type AdminService_ListDynamicConfig_Args struct {
-	Request *ListDynamicConfigRequest `json:"request,omitempty"`
+// The arguments for MoveTaskList are sent and received over the wire as this struct.
+type AdminService_MoveTaskList_Args struct {
+	Request *shared.MoveTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ListDynamicConfig_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MoveTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_MoveTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -18323,37 +23771,37 @@ func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListDynamicConfigRequest_Read(w wire.Value) (*ListDynamicConfigRequest, error) {
-	var v ListDynamicConfigRequest
+func _MoveTaskListRequest_Read(w wire.Value) (*shared.MoveTaskListRequest, error) {
+	var v shared.MoveTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ListDynamicConfig_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_MoveTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Args struct
+// An error is returned if we were unable to build a AdminService_MoveTaskList_Args struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v AdminService_ListDynamicConfig_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_MoveTaskList_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_MoveTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ListDynamicConfigRequest_Read(field.Value)
+				v.Request, err = _MoveTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -18365,11 +23813,11 @@ func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_ListDynamicConfig_Args struct directly into bytes, without going
+// Encode serializes a AdminService_MoveTaskList_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be encoded.
-func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_MoveTaskList_Args struct could not be encoded.
+func (v *AdminService_MoveTaskList_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -18389,18 +23837,18 @@ func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ListDynamicConfigRequest_Decode(sr stream.Reader) (*ListDynamicConfigRequest, error) {
-	var v ListDynamicConfigRequest
+func _MoveTaskListRequest_Decode(sr stream.Reader) (*shared.MoveTaskListRequest, error) {
+	var v shared.MoveTaskListRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ListDynamicConfig_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_MoveTaskList_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be generated from the wire
+// An error is returned if a AdminService_MoveTaskList_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_MoveTaskList_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -18414,7 +23862,7 @@ func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ListDynamicConfigRequest_Decode(sr)
+			v.Request, err = _MoveTaskListRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -18441,9 +23889,9 @@ func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ListDynamicConfig_Args
+// String returns a readable string representation of a AdminService_MoveTaskList_Args
 // struct.
-func (v *AdminService_ListDynamicConfig_Args) String() string {
+func (v *AdminService_MoveTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -18455,14 +23903,14 @@ func (v *AdminService_ListDynamicConfig_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ListDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MoveTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Args match the
-// provided AdminService_ListDynamicConfig_Args.
+// Equals returns true if all the fields of this AdminService_MoveTaskList_Args match the
+// provided AdminService_MoveTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynamicConfig_Args) bool {
+func (v *AdminService_MoveTaskList_Args) Equals(rhs *AdminService_MoveTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -18476,8 +23924,8 @@ func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynam
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ListDynamicConfig_Args.
-func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MoveTaskList_Args.
+func (v *AdminService_MoveTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -18489,7 +23937,7 @@ func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.Objec
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfigRequest) {
+func (v *AdminService_MoveTaskList_Args) GetRequest() (o *shared.MoveTaskListRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -18498,108 +23946,141 @@ func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfig
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_ListDynamicConfig_Args) IsSetRequest() bool {
+func (v *AdminService_MoveTaskList_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ListDynamicConfig" for this struct.
-func (v *AdminService_ListDynamicConfig_Args) MethodName() string {
-	return "ListDynamicConfig"
+// This will always be "MoveTaskList" for this struct.
+func (v *AdminService_MoveTaskList_Args) MethodName() string {
+	return "MoveTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ListDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MoveTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ListDynamicConfig_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ListDynamicConfig
+// AdminService_MoveTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.MoveTaskList
 // function.
-var AdminService_ListDynamicConfig_Helper = struct {
-	// Args accepts the parameters of ListDynamicConfig in-order and returns
+var AdminService_MoveTaskList_Helper = struct {
+	// Args accepts the parameters of MoveTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *ListDynamicConfigRequest,
-	) *AdminService_ListDynamicConfig_Args
+		request *shared.MoveTaskListRequest,
+	) *AdminService_MoveTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ListDynamicConfig.
+	// by MoveTaskList.
 	//
-	// An error can be thrown by ListDynamicConfig only if the
+	// An error can be thrown by MoveTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ListDynamicConfig
+	// WrapResponse returns the result struct for MoveTaskList
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// ListDynamicConfig into a serializable result struct.
+	// MoveTaskList into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by ListDynamicConfig
+	// error cannot be thrown by MoveTaskList
 	//
-	//   value, err := ListDynamicConfig(args)
-	//   result, err := AdminService_ListDynamicConfig_Helper.WrapResponse(value, err)
+	//   value, err := MoveTaskList(args)
+	//   result, err := AdminService_MoveTaskList_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ListDynamicConfig: %v", err)
+	//     return fmt.Errorf("unexpected error from MoveTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*ListDynamicConfigResponse, error) (*AdminService_ListDynamicConfig_Result, error)
+	WrapResponse func(*shared.MoveTaskListResponse, error) (*AdminService_MoveTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for ListDynamicConfig
+	// UnwrapResponse takes the result struct for MoveTaskList
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if ListDynamicConfig threw an
+	// The error is non-nil only if MoveTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_ListDynamicConfig_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ListDynamicConfig_Result) (*ListDynamicConfigResponse, error)
+	//   value, err := AdminService_MoveTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_MoveTaskList_Result) (*shared.MoveTaskListResponse, error)
 }{}
 
 func init() {
-	AdminService_ListDynamicConfig_Helper.Args = func(
-		request *ListDynamicConfigRequest,
-	) *AdminService_ListDynamicConfig_Args {
-		return &AdminService_ListDynamicConfig_Args{
+	AdminService_MoveTaskList_Helper.Args = func(
+		request *shared.MoveTaskListRequest,
+	) *AdminService_MoveTaskList_Args {
+		return &AdminService_MoveTaskList_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_ListDynamicConfig_Helper.IsException = func(err error) bool {
+	AdminService_MoveTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_ListDynamicConfig_Helper.WrapResponse = func(success *ListDynamicConfigResponse, err error) (*AdminService_ListDynamicConfig_Result, error) {
+	AdminService_MoveTaskList_Helper.WrapResponse = func(success *shared.MoveTaskListResponse, err error) (*AdminService_MoveTaskList_Result, error) {
 		if err == nil {
-			return &AdminService_ListDynamicConfig_Result{Success: success}, nil
+			return &AdminService_MoveTaskList_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MoveTaskList_Result.BadRequestError")
+			}
+			return &AdminService_MoveTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDynamicConfig_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MoveTaskList_Result.InternalServiceError")
 			}
-			return &AdminService_ListDynamicConfig_Result{InternalServiceError: e}, nil
+			return &AdminService_MoveTaskList_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MoveTaskList_Result.EntityNotExistError")
+			}
+			return &AdminService_MoveTaskList_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MoveTaskList_Result.ServiceBusyError")
+			}
+			return &AdminService_MoveTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ListDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_ListDynamicConfig_Result) (success *ListDynamicConfigResponse, err error) {
+	AdminService_MoveTaskList_Helper.UnwrapResponse = func(result *AdminService_MoveTaskList_Result) (success *shared.MoveTaskListResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
 		if result.InternalServiceError != nil {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -18612,35 +24093,38 @@ func init() {
 
 }
 
-// AdminService_ListDynamicConfig_Result represents the result of a AdminService.ListDynamicConfig function call.
+// AdminService_MoveTaskList_Result represents the result of a AdminService.MoveTaskList function call.
 //
-// The result of a ListDynamicConfig execution is sent and received over the wire as this struct.
+// The result of a MoveTaskList execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_ListDynamicConfig_Result struct {
-	// Value returned by ListDynamicConfig after a successful execution.
-	Success              *ListDynamicConfigResponse   `json:"success,omitempty"`
+type AdminService_MoveTaskList_Result struct {
+	// Value returned by MoveTaskList after a successful execution.
+	Success              *shared.MoveTaskListResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_ListDynamicConfig_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MoveTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_MoveTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -18654,65 +24138,113 @@ func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
 	if v.InternalServiceError != nil {
 		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_MoveTaskList_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListDynamicConfigResponse_Read(w wire.Value) (*ListDynamicConfigResponse, error) {
-	var v ListDynamicConfigResponse
+func _MoveTaskListResponse_Read(w wire.Value) (*shared.MoveTaskListResponse, error) {
+	var v shared.MoveTaskListResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ListDynamicConfig_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_MoveTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Result struct
+// An error is returned if we were unable to build a AdminService_MoveTaskList_Result struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v AdminService_ListDynamicConfig_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_ListDynamicConfig_Result) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_MoveTaskList_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_MoveTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _ListDynamicConfigResponse_Read(field.Value)
+				v.Success, err = _MoveTaskListResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -18721,30 +24253,75 @@ func (v *AdminService_ListDynamicConfig_Result) FromWire(w wire.Value) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MoveTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ListDynamicConfig_Result struct directly into bytes, without going
+// Encode serializes a AdminService_MoveTaskList_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be encoded.
-func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_MoveTaskList_Result struct could not be encoded.
+func (v *AdminService_MoveTaskList_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -18752,11 +24329,11 @@ func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -18768,29 +24345,38 @@ func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MoveTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _ListDynamicConfigResponse_Decode(sr stream.Reader) (*ListDynamicConfigResponse, error) {
-	var v ListDynamicConfigResponse
+func _MoveTaskListResponse_Decode(sr stream.Reader) (*shared.MoveTaskListResponse, error) {
+	var v shared.MoveTaskListResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ListDynamicConfig_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_MoveTaskList_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be generated from the wire
+// An error is returned if a AdminService_MoveTaskList_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_MoveTaskList_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -18804,17 +24390,35 @@ func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _ListDynamicConfigResponse_Decode(sr)
+			v.Success, err = _MoveTaskListResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
 			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -18838,42 +24442,63 @@ func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
 	if v.Success != nil {
 		count++
 	}
+	if v.BadRequestError != nil {
+		count++
+	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MoveTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ListDynamicConfig_Result
+// String returns a readable string representation of a AdminService_MoveTaskList_Result
 // struct.
-func (v *AdminService_ListDynamicConfig_Result) String() string {
+func (v *AdminService_MoveTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
 	if v.InternalServiceError != nil {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_ListDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MoveTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Result match the
-// provided AdminService_ListDynamicConfig_Result.
+// Equals returns true if all the fields of this AdminService_MoveTaskList_Result match the
+// provided AdminService_MoveTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ListDynamicConfig_Result) Equals(rhs *AdminService_ListDynamicConfig_Result) bool {
+func (v *AdminService_MoveTaskList_Result) Equals(rhs *AdminService_MoveTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -18882,31 +24507,49 @@ func (v *AdminService_ListDynamicConfig_Result) Equals(rhs *AdminService_ListDyn
 	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ListDynamicConfig_Result.
-func (v *AdminService_ListDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MoveTaskList_Result.
+func (v *AdminService_MoveTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Success != nil {
 		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Result) GetSuccess() (o *ListDynamicConfigResponse) {
+func (v *AdminService_MoveTaskList_Result) GetSuccess() (o *shared.MoveTaskListResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -18915,13 +24558,28 @@ func (v *AdminService_ListDynamicConfig_Result) GetSuccess() (o *ListDynamicConf
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_ListDynamicConfig_Result) IsSetSuccess() bool {
+func (v *AdminService_MoveTaskList_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MoveTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_MoveTaskList_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_MoveTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -18930,48 +24588,78 @@ func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *sh
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_ListDynamicConfig_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_MoveTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MoveTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_MoveTaskList_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MoveTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_MoveTaskList_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ListDynamicConfig" for this struct.
-func (v *AdminService_ListDynamicConfig_Result) MethodName() string {
-	return "ListDynamicConfig"
+// This will always be "MoveTaskList" for this struct.
+func (v *AdminService_MoveTaskList_Result) MethodName() string {
+	return "MoveTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ListDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MoveTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_MergeDLQMessages_Args represents the arguments for the AdminService.MergeDLQMessages function.
+// AdminService_OperatePendingActivity_Args represents the arguments for the AdminService.OperatePendingActivity function.
 //
-// The arguments for MergeDLQMessages are sent and received over the wire as this struct.
-type AdminService_MergeDLQMessages_Args struct {
-	Request *replicator.MergeDLQMessagesRequest `json:"request,omitempty"`
+// The arguments for OperatePendingActivity are sent and received over the wire as this struct.
+type AdminService_OperatePendingActivity_Args struct {
+	Request *shared.OperatePendingActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_MergeDLQMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_OperatePendingActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_OperatePendingActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -18991,37 +24679,37 @@ func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _MergeDLQMessagesRequest_Read(w wire.Value) (*replicator.MergeDLQMessagesRequest, error) {
-	var v replicator.MergeDLQMessagesRequest
+func _OperatePendingActivityRequest_Read(w wire.Value) (*shared.OperatePendingActivityRequest, error) {
+	var v shared.OperatePendingActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_MergeDLQMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_OperatePendingActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Args struct
+// An error is returned if we were unable to build a AdminService_OperatePendingActivity_Args struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v AdminService_MergeDLQMessages_Args
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_OperatePendingActivity_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_OperatePendingActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _MergeDLQMessagesRequest_Read(field.Value)
+				v.Request, err = _OperatePendingActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -19033,11 +24721,11 @@ func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_MergeDLQMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_OperatePendingActivity_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be encoded.
-func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_OperatePendingActivity_Args struct could not be encoded.
+func (v *AdminService_OperatePendingActivity_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -19057,18 +24745,18 @@ func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _MergeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesRequest, error) {
-	var v replicator.MergeDLQMessagesRequest
+func _OperatePendingActivityRequest_Decode(sr stream.Reader) (*shared.OperatePendingActivityRequest, error) {
+	var v shared.OperatePendingActivityRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_MergeDLQMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_OperatePendingActivity_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_OperatePendingActivity_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_OperatePendingActivity_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -19082,7 +24770,7 @@ func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _MergeDLQMessagesRequest_Decode(sr)
+			v.Request, err = _OperatePendingActivityRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -19109,9 +24797,9 @@ func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MergeDLQMessages_Args
+// String returns a readable string representation of a AdminService_OperatePendingActivity_Args
 // struct.
-func (v *AdminService_MergeDLQMessages_Args) String() string {
+func (v *AdminService_OperatePendingActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -19123,14 +24811,14 @@ func (v *AdminService_MergeDLQMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_MergeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_OperatePendingActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Args match the
-// provided AdminService_MergeDLQMessages_Args.
+// Equals returns true if all the fields of this AdminService_OperatePendingActivity_Args match the
+// provided AdminService_OperatePendingActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMessages_Args) bool {
+func (v *AdminService_OperatePendingActivity_Args) Equals(rhs *AdminService_OperatePendingActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -19144,8 +24832,8 @@ func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MergeDLQMessages_Args.
-func (v *AdminService_MergeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_OperatePendingActivity_Args.
+func (v *AdminService_OperatePendingActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -19157,7 +24845,7 @@ func (v *AdminService_MergeDLQMessages_Args) MarshalLogObject(enc zapcore.Object
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Args) GetRequest() (o *replicator.MergeDLQMessagesRequest) {
+func (v *AdminService_OperatePendingActivity_Args) GetRequest() (o *shared.OperatePendingActivityRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -19166,131 +24854,139 @@ func (v *AdminService_MergeDLQMessages_Args) GetRequest() (o *replicator.MergeDL
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_MergeDLQMessages_Args) IsSetRequest() bool {
+func (v *AdminService_OperatePendingActivity_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "MergeDLQMessages" for this struct.
-func (v *AdminService_MergeDLQMessages_Args) MethodName() string {
-	return "MergeDLQMessages"
+// This will always be "OperatePendingActivity" for this struct.
+func (v *AdminService_OperatePendingActivity_Args) MethodName() string {
+	return "OperatePendingActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_MergeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_OperatePendingActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_MergeDLQMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.MergeDLQMessages
+// AdminService_OperatePendingActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.OperatePendingActivity
 // function.
-var AdminService_MergeDLQMessages_Helper = struct {
-	// Args accepts the parameters of MergeDLQMessages in-order and returns
+var AdminService_OperatePendingActivity_Helper = struct {
+	// Args accepts the parameters of OperatePendingActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.MergeDLQMessagesRequest,
-	) *AdminService_MergeDLQMessages_Args
+		request *shared.OperatePendingActivityRequest,
+	) *AdminService_OperatePendingActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by MergeDLQMessages.
+	// by OperatePendingActivity.
 	//
-	// An error can be thrown by MergeDLQMessages only if the
+	// An error can be thrown by OperatePendingActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for MergeDLQMessages
-	// given its return value and error.
+	// WrapResponse returns the result struct for OperatePendingActivity
+	// given the error returned by it. The provided error may
+	// be nil if OperatePendingActivity did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// MergeDLQMessages into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by MergeDLQMessages
+	// This allows mapping errors returned by OperatePendingActivity into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// OperatePendingActivity
 	//
-	//   value, err := MergeDLQMessages(args)
-	//   result, err := AdminService_MergeDLQMessages_Helper.WrapResponse(value, err)
+	//   err := OperatePendingActivity(args)
+	//   result, err := AdminService_OperatePendingActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from MergeDLQMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from OperatePendingActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.MergeDLQMessagesResponse, error) (*AdminService_MergeDLQMessages_Result, error)
+	WrapResponse func(error) (*AdminService_OperatePendingActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for MergeDLQMessages
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for OperatePendingActivity
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if MergeDLQMessages threw an
+	// The error is non-nil only if OperatePendingActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_MergeDLQMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_MergeDLQMessages_Result) (*replicator.MergeDLQMessagesResponse, error)
+	//   err := AdminService_OperatePendingActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_OperatePendingActivity_Result) error
 }{}
 
 func init() {
-	AdminService_MergeDLQMessages_Helper.Args = func(
-		request *replicator.MergeDLQMessagesRequest,
-	) *AdminService_MergeDLQMessages_Args {
-		return &AdminService_MergeDLQMessages_Args{
+	AdminService_OperatePendingActivity_Helper.Args = func(
+		request *shared.OperatePendingActivityRequest,
+	) *AdminService_OperatePendingActivity_Args {
+		return &AdminService_OperatePendingActivity_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_MergeDLQMessages_Helper.IsException = func(err error) bool {
+	AdminService_OperatePendingActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
 		case *shared.EntityNotExistsError:
 			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_MergeDLQMessages_Helper.WrapResponse = func(success *replicator.MergeDLQMessagesResponse, err error) (*AdminService_MergeDLQMessages_Result, error) {
+	AdminService_OperatePendingActivity_Helper.WrapResponse = func(err error) (*AdminService_OperatePendingActivity_Result, error) {
 		if err == nil {
-			return &AdminService_MergeDLQMessages_Result{Success: success}, nil
+			return &AdminService_OperatePendingActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_OperatePendingActivity_Result.BadRequestError")
 			}
-			return &AdminService_MergeDLQMessages_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_OperatePendingActivity_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_OperatePendingActivity_Result.DomainNotActiveError")
 			}
-			return &AdminService_MergeDLQMessages_Result{InternalServiceError: e}, nil
+			return &AdminService_OperatePendingActivity_Result{DomainNotActiveError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_OperatePendingActivity_Result.ServiceBusyError")
 			}
-			return &AdminService_MergeDLQMessages_Result{ServiceBusyError: e}, nil
+			return &AdminService_OperatePendingActivity_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_OperatePendingActivity_Result.EntityNotExistError")
 			}
-			return &AdminService_MergeDLQMessages_Result{EntityNotExistError: e}, nil
+			return &AdminService_OperatePendingActivity_Result{EntityNotExistError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_OperatePendingActivity_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &AdminService_OperatePendingActivity_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_MergeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_MergeDLQMessages_Result) (success *replicator.MergeDLQMessagesResponse, err error) {
+	AdminService_OperatePendingActivity_Helper.UnwrapResponse = func(result *AdminService_OperatePendingActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -19301,48 +24997,42 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
+			return
+		}
 		return
 	}
 
 }
 
-// AdminService_MergeDLQMessages_Result represents the result of a AdminService.MergeDLQMessages function call.
-//
-// The result of a MergeDLQMessages execution is sent and received over the wire as this struct.
+// AdminService_OperatePendingActivity_Result represents the result of a AdminService.OperatePendingActivity function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_MergeDLQMessages_Result struct {
-	// Value returned by MergeDLQMessages after a successful execution.
-	Success              *replicator.MergeDLQMessagesResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
+// The result of a OperatePendingActivity execution is sent and received over the wire as this struct.
+type AdminService_OperatePendingActivity_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a AdminService_MergeDLQMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_OperatePendingActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_OperatePendingActivity_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -19350,14 +25040,6 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -19366,8 +25048,8 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -19390,50 +25072,56 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_OperatePendingActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _MergeDLQMessagesResponse_Read(w wire.Value) (*replicator.MergeDLQMessagesResponse, error) {
-	var v replicator.MergeDLQMessagesResponse
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_MergeDLQMessages_Result struct from its Thrift-level
+func _WorkflowExecutionAlreadyCompletedError_Read(w wire.Value) (*shared.WorkflowExecutionAlreadyCompletedError, error) {
+	var v shared.WorkflowExecutionAlreadyCompletedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_OperatePendingActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Result struct
+// An error is returned if we were unable to build a AdminService_OperatePendingActivity_Result struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v AdminService_MergeDLQMessages_Result
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_OperatePendingActivity_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_OperatePendingActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _MergeDLQMessagesResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -19444,7 +25132,7 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -19465,18 +25153,23 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -19485,34 +25178,25 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_OperatePendingActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_MergeDLQMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_OperatePendingActivity_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be encoded.
-func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_OperatePendingActivity_Result struct could not be encoded.
+func (v *AdminService_OperatePendingActivity_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Success.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -19525,11 +25209,11 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -19561,14 +25245,23 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	count := 0
-	if v.Success != nil {
-		count++
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
+
+	count := 0
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -19577,26 +25270,35 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_OperatePendingActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _MergeDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesResponse, error) {
-	var v replicator.MergeDLQMessagesResponse
+func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_MergeDLQMessages_Result struct directly from its Thrift-level
+func _WorkflowExecutionAlreadyCompletedError_Decode(sr stream.Reader) (*shared.WorkflowExecutionAlreadyCompletedError, error) {
+	var v shared.WorkflowExecutionAlreadyCompletedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_OperatePendingActivity_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_OperatePendingActivity_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_OperatePendingActivity_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -19609,12 +25311,6 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _MergeDLQMessagesResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -19622,7 +25318,7 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -19639,6 +25335,12 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -19659,13 +25361,10 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -19674,32 +25373,31 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_OperatePendingActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MergeDLQMessages_Result
+// String returns a readable string representation of a AdminService_OperatePendingActivity_Result
 // struct.
-func (v *AdminService_MergeDLQMessages_Result) String() string {
+func (v *AdminService_OperatePendingActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -19710,27 +25408,28 @@ func (v *AdminService_MergeDLQMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_MergeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_OperatePendingActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Result match the
-// provided AdminService_MergeDLQMessages_Result.
+// Equals returns true if all the fields of this AdminService_OperatePendingActivity_Result match the
+// provided AdminService_OperatePendingActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQMessages_Result) bool {
+func (v *AdminService_OperatePendingActivity_Result) Equals(rhs *AdminService_OperatePendingActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -19739,24 +25438,24 @@ func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQ
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MergeDLQMessages_Result.
-func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_OperatePendingActivity_Result.
+func (v *AdminService_OperatePendingActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
@@ -19764,27 +25463,15 @@ func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.Obje
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetSuccess() (o *replicator.MergeDLQMessagesResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
 	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_OperatePendingActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -19793,28 +25480,28 @@ func (v *AdminService_MergeDLQMessages_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_OperatePendingActivity_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_OperatePendingActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_OperatePendingActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_OperatePendingActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -19823,13 +25510,13 @@ func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_OperatePendingActivity_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_OperatePendingActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -19838,22 +25525,37 @@ func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shar
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_OperatePendingActivity_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_OperatePendingActivity_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *AdminService_OperatePendingActivity_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "MergeDLQMessages" for this struct.
-func (v *AdminService_MergeDLQMessages_Result) MethodName() string {
-	return "MergeDLQMessages"
+// This will always be "OperatePendingActivity" for this struct.
+func (v *AdminService_OperatePendingActivity_Result) MethodName() string {
+	return "OperatePendingActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_MergeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_OperatePendingActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
@@ -22034,12 +27736,6 @@ func (v *AdminService_ReapplyEvents_Result) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.FromWire(w)
-	return &v, err
-}
-
 // FromWire deserializes a AdminService_ReapplyEvents_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -22221,12 +27917,6 @@ func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.Decode(sr)
-	return &v, err
-}
-
 // Decode deserializes a AdminService_ReapplyEvents_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -106,12 +106,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetDynamicConfigResponse, error)
 
+	GetFailoverReadiness(
+		ctx context.Context,
+		Request *admin.GetFailoverReadinessRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetFailoverReadinessResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *admin.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationStatusResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
@@ -130,6 +142,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MoveTaskList(
+		ctx context.Context,
+		Request *shared.MoveTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MoveTaskListResponse, error)
+
+	OperatePendingActivity(
+		ctx context.Context,
+		Request *shared.OperatePendingActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -527,6 +551,34 @@ func (c client) GetDynamicConfig(
 	return
 }
 
+func (c client) GetFailoverReadiness(
+	ctx context.Context,
+	_Request *admin.GetFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetFailoverReadinessResponse, err error) {
+
+	var result admin.AdminService_GetFailoverReadiness_Result
+	args := admin.AdminService_GetFailoverReadiness_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetFailoverReadiness_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -555,6 +607,34 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *admin.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationStatusResponse, err error) {
+
+	var result admin.AdminService_GetReplicationStatus_Result
+	args := admin.AdminService_GetReplicationStatus_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
@@ -639,6 +719,62 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MoveTaskList(
+	ctx context.Context,
+	_Request *shared.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListResponse, err error) {
+
+	var result admin.AdminService_MoveTaskList_Result
+	args := admin.AdminService_MoveTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_MoveTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) OperatePendingActivity(
+	ctx context.Context,
+	_Request *shared.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_OperatePendingActivity_Result
+	args := admin.AdminService_OperatePendingActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_OperatePendingActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
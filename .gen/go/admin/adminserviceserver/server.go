@@ -95,11 +95,21 @@ type Interface interface {
 		Request *admin.GetDynamicConfigRequest,
 	) (*admin.GetDynamicConfigResponse, error)
 
+	GetFailoverReadiness(
+		ctx context.Context,
+		Request *admin.GetFailoverReadinessRequest,
+	) (*admin.GetFailoverReadinessResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *admin.GetReplicationStatusRequest,
+	) (*replicator.GetReplicationStatusResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
@@ -115,6 +125,16 @@ type Interface interface {
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MoveTaskList(
+		ctx context.Context,
+		Request *shared.MoveTaskListRequest,
+	) (*shared.MoveTaskListResponse, error)
+
+	OperatePendingActivity(
+		ctx context.Context,
+		Request *shared.OperatePendingActivityRequest,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -309,6 +329,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetFailoverReadiness",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetFailoverReadiness),
+					NoWire: getfailoverreadiness_NoWireHandler{impl},
+				},
+				Signature:    "GetFailoverReadiness(Request *admin.GetFailoverReadinessRequest) (*admin.GetFailoverReadinessResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetReplicationMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -321,6 +353,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetReplicationStatus",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetReplicationStatus),
+					NoWire: getreplicationstatus_NoWireHandler{impl},
+				},
+				Signature:    "GetReplicationStatus(Request *admin.GetReplicationStatusRequest) (*replicator.GetReplicationStatusResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionRawHistoryV2",
 				HandlerSpec: thrift.HandlerSpec{
@@ -357,6 +401,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MoveTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.MoveTaskList),
+					NoWire: movetasklist_NoWireHandler{impl},
+				},
+				Signature:    "MoveTaskList(Request *shared.MoveTaskListRequest) (*shared.MoveTaskListResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "OperatePendingActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.OperatePendingActivity),
+					NoWire: operatependingactivity_NoWireHandler{impl},
+				},
+				Signature:    "OperatePendingActivity(Request *shared.OperatePendingActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -479,7 +547,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 25)
+	procedures := make([]transport.Procedure, 0, 29)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -820,6 +888,36 @@ func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) GetFailoverReadiness(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetFailoverReadiness_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'GetFailoverReadiness': %w", err)
+	}
+
+	success, appErr := h.impl.GetFailoverReadiness(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetFailoverReadiness_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -850,6 +948,36 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) GetReplicationStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationStatus': %w", err)
+	}
+
+	success, appErr := h.impl.GetReplicationStatus(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetReplicationStatus_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
 	if err := args.FromWire(body); err != nil {
@@ -940,6 +1068,66 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) MoveTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MoveTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MoveTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MoveTaskList_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) OperatePendingActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_OperatePendingActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'OperatePendingActivity': %w", err)
+	}
+
+	appErr := h.impl.OperatePendingActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_OperatePendingActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1647,6 +1835,43 @@ func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type getfailoverreadiness_NoWireHandler struct{ impl Interface }
+
+func (h getfailoverreadiness_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_GetFailoverReadiness_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetFailoverReadiness': %w", err)
+	}
+
+	success, appErr := h.impl.GetFailoverReadiness(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetFailoverReadiness_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getreplicationmessages_NoWireHandler struct{ impl Interface }
 
 func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1684,6 +1909,43 @@ func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type getreplicationstatus_NoWireHandler struct{ impl Interface }
+
+func (h getreplicationstatus_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_GetReplicationStatus_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationStatus': %w", err)
+	}
+
+	success, appErr := h.impl.GetReplicationStatus(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetReplicationStatus_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getworkflowexecutionrawhistoryv2_NoWireHandler struct{ impl Interface }
 
 func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1795,6 +2057,80 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type movetasklist_NoWireHandler struct{ impl Interface }
+
+func (h movetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_MoveTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MoveTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MoveTaskList_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type operatependingactivity_NoWireHandler struct{ impl Interface }
+
+func (h operatependingactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_OperatePendingActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'OperatePendingActivity': %w", err)
+	}
+
+	appErr := h.impl.OperatePendingActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_OperatePendingActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type purgedlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -425,6 +425,39 @@ func (mr *_MockClientRecorder) GetDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDynamicConfig", args...)
 }
 
+// GetFailoverReadiness responds to a GetFailoverReadiness call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetFailoverReadiness(gomock.Any(), ...).Return(...)
+//	... := client.GetFailoverReadiness(...)
+func (m *MockClient) GetFailoverReadiness(
+	ctx context.Context,
+	_Request *admin.GetFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetFailoverReadinessResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetFailoverReadiness", args...)
+	success, _ = ret[i].(*admin.GetFailoverReadinessResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetFailoverReadiness(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetFailoverReadiness", args...)
+}
+
 // GetReplicationMessages responds to a GetReplicationMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -458,6 +491,39 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
 }
 
+// GetReplicationStatus responds to a GetReplicationStatus call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetReplicationStatus(gomock.Any(), ...).Return(...)
+//	... := client.GetReplicationStatus(...)
+func (m *MockClient) GetReplicationStatus(
+	ctx context.Context,
+	_Request *admin.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationStatusResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetReplicationStatus", args...)
+	success, _ = ret[i].(*replicator.GetReplicationStatusResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetReplicationStatus(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationStatus", args...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 responds to a GetWorkflowExecutionRawHistoryV2 call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -557,6 +623,70 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// MoveTaskList responds to a MoveTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MoveTaskList(gomock.Any(), ...).Return(...)
+//	... := client.MoveTaskList(...)
+func (m *MockClient) MoveTaskList(
+	ctx context.Context,
+	_Request *shared.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MoveTaskList", args...)
+	success, _ = ret[i].(*shared.MoveTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MoveTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MoveTaskList", args...)
+}
+
+// OperatePendingActivity responds to a OperatePendingActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().OperatePendingActivity(gomock.Any(), ...).Return(...)
+//	... := client.OperatePendingActivity(...)
+func (m *MockClient) OperatePendingActivity(
+	ctx context.Context,
+	_Request *shared.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "OperatePendingActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) OperatePendingActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "OperatePendingActivity", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
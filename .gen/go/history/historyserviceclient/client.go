@@ -101,6 +101,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetReplicationReadiness(
+		ctx context.Context,
+		Request *history.GetReplicationReadinessRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationReadinessResponse, error)
+
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *history.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationStatusResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
@@ -113,6 +125,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	OperatePendingActivity(
+		ctx context.Context,
+		Request *history.OperatePendingActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -292,6 +316,12 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -603,6 +633,62 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) GetReplicationReadiness(
+	ctx context.Context,
+	_Request *history.GetReplicationReadinessRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationReadinessResponse, err error) {
+
+	var result history.HistoryService_GetReplicationReadiness_Result
+	args := history.HistoryService_GetReplicationReadiness_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetReplicationReadiness_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *history.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationStatusResponse, err error) {
+
+	var result history.HistoryService_GetReplicationStatus_Result
+	args := history.HistoryService_GetReplicationStatus_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MergeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.MergeDLQMessagesRequest,
@@ -659,6 +745,62 @@ func (c client) NotifyFailoverMarkers(
 	return
 }
 
+func (c client) OperatePendingActivity(
+	ctx context.Context,
+	_Request *history.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_OperatePendingActivity_Result
+	args := history.HistoryService_OperatePendingActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_OperatePendingActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -1498,3 +1640,31 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UnpauseWorkflowExecution_Result
+	args := history.HistoryService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
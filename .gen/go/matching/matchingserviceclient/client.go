@@ -76,6 +76,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	MoveTaskList(
+		ctx context.Context,
+		Request *matching.MoveTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MoveTaskListResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -94,6 +100,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.QueryWorkflowResponse, error)
 
+	ReleaseOutstandingTask(
+		ctx context.Context,
+		Request *matching.ReleaseOutstandingTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -298,6 +310,34 @@ func (c client) ListTaskListPartitions(
 	return
 }
 
+func (c client) MoveTaskList(
+	ctx context.Context,
+	_Request *matching.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListResponse, err error) {
+
+	var result matching.MatchingService_MoveTaskList_Result
+	args := matching.MatchingService_MoveTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_MoveTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -382,6 +422,34 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) ReleaseOutstandingTask(
+	ctx context.Context,
+	_Request *matching.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result matching.MatchingService_ReleaseOutstandingTask_Result
+	args := matching.MatchingService_ReleaseOutstandingTask_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = matching.MatchingService_ReleaseOutstandingTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_Request *matching.RespondQueryTaskCompletedRequest,
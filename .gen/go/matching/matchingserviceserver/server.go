@@ -70,6 +70,11 @@ type Interface interface {
 		Request *matching.ListTaskListPartitionsRequest,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	MoveTaskList(
+		ctx context.Context,
+		Request *matching.MoveTaskListRequest,
+	) (*shared.MoveTaskListResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -85,6 +90,11 @@ type Interface interface {
 		QueryRequest *matching.QueryWorkflowRequest,
 	) (*shared.QueryWorkflowResponse, error)
 
+	ReleaseOutstandingTask(
+		ctx context.Context,
+		Request *matching.ReleaseOutstandingTaskRequest,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -174,6 +184,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MoveTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.MoveTaskList),
+					NoWire: movetasklist_NoWireHandler{impl},
+				},
+				Signature:    "MoveTaskList(Request *matching.MoveTaskListRequest) (*shared.MoveTaskListResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -210,6 +232,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReleaseOutstandingTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReleaseOutstandingTask),
+					NoWire: releaseoutstandingtask_NoWireHandler{impl},
+				},
+				Signature:    "ReleaseOutstandingTask(Request *matching.ReleaseOutstandingTaskRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondQueryTaskCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -224,7 +258,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 12)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -415,6 +449,36 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) MoveTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_MoveTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'MoveTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_MoveTaskList_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -505,6 +569,36 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) ReleaseOutstandingTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ReleaseOutstandingTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'ReleaseOutstandingTask': %w", err)
+	}
+
+	appErr := h.impl.ReleaseOutstandingTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_ReleaseOutstandingTask_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_RespondQueryTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
@@ -757,6 +851,43 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type movetasklist_NoWireHandler struct{ impl Interface }
+
+func (h movetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_MoveTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'MoveTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MoveTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_MoveTaskList_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type pollforactivitytask_NoWireHandler struct{ impl Interface }
 
 func (h pollforactivitytask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -868,6 +999,43 @@ func (h queryworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type releaseoutstandingtask_NoWireHandler struct{ impl Interface }
+
+func (h releaseoutstandingtask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args matching.MatchingService_ReleaseOutstandingTask_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'MatchingService' procedure 'ReleaseOutstandingTask': %w", err)
+	}
+
+	appErr := h.impl.ReleaseOutstandingTask(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_ReleaseOutstandingTask_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type respondquerytaskcompleted_NoWireHandler struct{ impl Interface }
 
 func (h respondquerytaskcompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -259,6 +259,39 @@ func (mr *_MockClientRecorder) ListTaskListPartitions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListPartitions", args...)
 }
 
+// MoveTaskList responds to a MoveTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MoveTaskList(gomock.Any(), ...).Return(...)
+//	... := client.MoveTaskList(...)
+func (m *MockClient) MoveTaskList(
+	ctx context.Context,
+	_Request *matching.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MoveTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MoveTaskList", args...)
+	success, _ = ret[i].(*shared.MoveTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MoveTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MoveTaskList", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -358,6 +391,37 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "QueryWorkflow", args...)
 }
 
+// ReleaseOutstandingTask responds to a ReleaseOutstandingTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReleaseOutstandingTask(gomock.Any(), ...).Return(...)
+//	... := client.ReleaseOutstandingTask(...)
+func (m *MockClient) ReleaseOutstandingTask(
+	ctx context.Context,
+	_Request *matching.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReleaseOutstandingTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReleaseOutstandingTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReleaseOutstandingTask", args...)
+}
+
 // RespondQueryTaskCompleted responds to a RespondQueryTaskCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
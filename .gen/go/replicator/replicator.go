@@ -3870,28 +3870,28 @@ func (v *GetReplicationMessagesResponse) IsSetMessagesByShard() bool {
 	return v != nil && v.MessagesByShard != nil
 }
 
-type HistoryTaskV2Attributes struct {
-	TaskId              *int64                       `json:"taskId,omitempty"`
-	DomainId            *string                      `json:"domainId,omitempty"`
-	WorkflowId          *string                      `json:"workflowId,omitempty"`
-	RunId               *string                      `json:"runId,omitempty"`
-	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
-	Events              *shared.DataBlob             `json:"events,omitempty"`
-	NewRunEvents        *shared.DataBlob             `json:"newRunEvents,omitempty"`
+type GetReplicationReadinessResponse struct {
+	ReadinessByShard   map[int32]*ReplicationShardReadiness `json:"readinessByShard,omitempty"`
+	FailedCauseByShard map[int32]shared.GetTaskFailedCause  `json:"failedCauseByShard,omitempty"`
 }
 
-type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
+type _Map_I32_ReplicationShardReadiness_MapItemList map[int32]*ReplicationShardReadiness
 
-func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+func (m _Map_I32_ReplicationShardReadiness_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[int32]*ReplicationShardReadiness', key [%v]: value is nil", k)
 		}
-		w, err := x.ToWire()
+		kw, err := wire.NewValueI32(k), error(nil)
 		if err != nil {
 			return err
 		}
-		err = f(w)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
 		if err != nil {
 			return err
 		}
@@ -3899,17 +3899,56 @@ func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) er
 	return nil
 }
 
-func (v _List_VersionHistoryItem_ValueList) Size() int {
-	return len(v)
+func (m _Map_I32_ReplicationShardReadiness_MapItemList) Size() int {
+	return len(m)
 }
 
-func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
+func (_Map_I32_ReplicationShardReadiness_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_ReplicationShardReadiness_MapItemList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_VersionHistoryItem_ValueList) Close() {}
+func (_Map_I32_ReplicationShardReadiness_MapItemList) Close() {}
 
-// ToWire translates a HistoryTaskV2Attributes struct into a Thrift-level intermediate
+type _Map_I32_GetTaskFailedCause_MapItemList map[int32]shared.GetTaskFailedCause
+
+func (m _Map_I32_GetTaskFailedCause_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_GetTaskFailedCause_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_GetTaskFailedCause_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_GetTaskFailedCause_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_GetTaskFailedCause_MapItemList) Close() {}
+
+// ToWire translates a GetReplicationReadinessResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3921,112 +3960,110 @@ func (_List_VersionHistoryItem_ValueList) Close() {}
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetReplicationReadinessResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.ReadinessByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_ReplicationShardReadiness_MapItemList(v.ReadinessByShard)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.FailedCauseByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_GetTaskFailedCause_MapItemList(v.FailedCauseByShard)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationShardReadiness_Read(w wire.Value) (*ReplicationShardReadiness, error) {
+	var v ReplicationShardReadiness
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I32_ReplicationShardReadiness_Read(m wire.MapItemList) (map[int32]*ReplicationShardReadiness, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
 	}
-	if v.VersionHistoryItems != nil {
-		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.VersionHistoryItems)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.Events != nil {
-		w, err = v.Events.ToWire()
+
+	o := make(map[int32]*ReplicationShardReadiness, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.NewRunEvents != nil {
-		w, err = v.NewRunEvents.ToWire()
+
+		v, err := _ReplicationShardReadiness_Read(x.Value)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-func _VersionHistoryItem_Read(w wire.Value) (*shared.VersionHistoryItem, error) {
-	var v shared.VersionHistoryItem
+func _GetTaskFailedCause_Read(w wire.Value) (shared.GetTaskFailedCause, error) {
+	var v shared.GetTaskFailedCause
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryItem, error) {
-	if l.ValueType() != wire.TStruct {
+func _Map_I32_GetTaskFailedCause_Read(m wire.MapItemList) (map[int32]shared.GetTaskFailedCause, error) {
+	if m.KeyType() != wire.TI32 {
 		return nil, nil
 	}
 
-	o := make([]*shared.VersionHistoryItem, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _VersionHistoryItem_Read(x)
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[int32]shared.GetTaskFailedCause, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
 		if err != nil {
 			return err
 		}
-		o = append(o, i)
+
+		v, err := _GetTaskFailedCause_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
 		return nil
 	})
-	l.Close()
+	m.Close()
 	return o, err
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a HistoryTaskV2Attributes struct from its Thrift-level
+// FromWire deserializes a GetReplicationReadinessResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryTaskV2Attributes struct
+// An error is returned if we were unable to build a GetReplicationReadinessResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4034,75 +4071,27 @@ func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryTaskV2Attributes
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
+//	var v GetReplicationReadinessResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetReplicationReadinessResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+			if field.Value.Type() == wire.TMap {
+				v.ReadinessByShard, err = _Map_I32_ReplicationShardReadiness_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TList {
-				v.VersionHistoryItems, err = _List_VersionHistoryItem_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.Events, err = _DataBlob_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunEvents, err = _DataBlob_Read(field.Value)
+			if field.Value.Type() == wire.TMap {
+				v.FailedCauseByShard, err = _Map_I32_GetTaskFailedCause_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -4114,65 +4103,69 @@ func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_VersionHistoryItem_Encode(val []*shared.VersionHistoryItem, sw stream.Writer) error {
+func _Map_I32_ReplicationShardReadiness_Encode(val map[int32]*ReplicationShardReadiness, sw stream.Writer) error {
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TStruct,
+		Length:    len(val),
 	}
-	if err := sw.WriteListBegin(lh); err != nil {
+	if err := sw.WriteMapBegin(mh); err != nil {
 		return err
 	}
 
-	for i, v := range val {
+	for k, v := range val {
 		if v == nil {
-			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+			return fmt.Errorf("invalid map 'map[int32]*ReplicationShardReadiness', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteInt32(k); err != nil {
+			return err
 		}
 		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
-	return sw.WriteListEnd()
+
+	return sw.WriteMapEnd()
 }
 
-// Encode serializes a HistoryTaskV2Attributes struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a HistoryTaskV2Attributes struct could not be encoded.
-func (v *HistoryTaskV2Attributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+func _Map_I32_GetTaskFailedCause_Encode(val map[int32]shared.GetTaskFailedCause, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TI32,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
 		return err
 	}
 
-	if v.TaskId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.TaskId)); err != nil {
+	for k, v := range val {
+		if err := sw.WriteInt32(k); err != nil {
 			return err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
 
-	if v.DomainId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.DomainId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a GetReplicationReadinessResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetReplicationReadinessResponse struct could not be encoded.
+func (v *GetReplicationReadinessResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.WorkflowId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.ReadinessByShard != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+		if err := _Map_I32_ReplicationShardReadiness_Encode(v.ReadinessByShard, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4180,11 +4173,11 @@ func (v *HistoryTaskV2Attributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.FailedCauseByShard != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
+		if err := _Map_I32_GetTaskFailedCause_Encode(v.FailedCauseByShard, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4192,93 +4185,107 @@ func (v *HistoryTaskV2Attributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VersionHistoryItems != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_VersionHistoryItem_Encode(v.VersionHistoryItems, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationShardReadiness_Decode(sr stream.Reader) (*ReplicationShardReadiness, error) {
+	var v ReplicationShardReadiness
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_I32_ReplicationShardReadiness_Decode(sr stream.Reader) (map[int32]*ReplicationShardReadiness, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	if v.Events != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Events.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadMapEnd()
 	}
 
-	if v.NewRunEvents != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.NewRunEvents.Encode(sw); err != nil {
-			return err
+	o := make(map[int32]*ReplicationShardReadiness, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+
+		v, err := _ReplicationShardReadiness_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+
+		o[k] = v
 	}
 
-	return sw.WriteStructEnd()
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-func _VersionHistoryItem_Decode(sr stream.Reader) (*shared.VersionHistoryItem, error) {
-	var v shared.VersionHistoryItem
+func _GetTaskFailedCause_Decode(sr stream.Reader) (shared.GetTaskFailedCause, error) {
+	var v shared.GetTaskFailedCause
 	err := v.Decode(sr)
-	return &v, err
+	return v, err
 }
 
-func _List_VersionHistoryItem_Decode(sr stream.Reader) ([]*shared.VersionHistoryItem, error) {
-	lh, err := sr.ReadListBegin()
+func _Map_I32_GetTaskFailedCause_Decode(sr stream.Reader) (map[int32]shared.GetTaskFailedCause, error) {
+	mh, err := sr.ReadMapBegin()
 	if err != nil {
 		return nil, err
 	}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI32 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
 				return nil, err
 			}
 		}
-		return nil, sr.ReadListEnd()
+		return nil, sr.ReadMapEnd()
 	}
 
-	o := make([]*shared.VersionHistoryItem, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _VersionHistoryItem_Decode(sr)
+	o := make(map[int32]shared.GetTaskFailedCause, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
 		if err != nil {
 			return nil, err
 		}
-		o = append(o, v)
+
+		v, err := _GetTaskFailedCause_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
+	if err = sr.ReadMapEnd(); err != nil {
 		return nil, err
 	}
 	return o, err
 }
 
-func _DataBlob_Decode(sr stream.Reader) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a HistoryTaskV2Attributes struct directly from its Thrift-level
+// Decode deserializes a GetReplicationReadinessResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a HistoryTaskV2Attributes struct could not be generated from the wire
+// An error is returned if a GetReplicationReadinessResponse struct could not be generated from the wire
 // representation.
-func (v *HistoryTaskV2Attributes) Decode(sr stream.Reader) error {
+func (v *GetReplicationReadinessResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4291,52 +4298,14 @@ func (v *HistoryTaskV2Attributes) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 5 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.TaskId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.WorkflowId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TList:
-			v.VersionHistoryItems, err = _List_VersionHistoryItem_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TStruct:
-			v.Events, err = _DataBlob_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TMap:
+			v.ReadinessByShard, err = _Map_I32_ReplicationShardReadiness_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TStruct:
-			v.NewRunEvents, err = _DataBlob_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TMap:
+			v.FailedCauseByShard, err = _Map_I32_GetTaskFailedCause_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4363,433 +4332,378 @@ func (v *HistoryTaskV2Attributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a HistoryTaskV2Attributes
+// String returns a readable string representation of a GetReplicationReadinessResponse
 // struct.
-func (v *HistoryTaskV2Attributes) String() string {
+func (v *GetReplicationReadinessResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [2]string
 	i := 0
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
-		i++
-	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.VersionHistoryItems != nil {
-		fields[i] = fmt.Sprintf("VersionHistoryItems: %v", v.VersionHistoryItems)
-		i++
-	}
-	if v.Events != nil {
-		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+	if v.ReadinessByShard != nil {
+		fields[i] = fmt.Sprintf("ReadinessByShard: %v", v.ReadinessByShard)
 		i++
 	}
-	if v.NewRunEvents != nil {
-		fields[i] = fmt.Sprintf("NewRunEvents: %v", v.NewRunEvents)
+	if v.FailedCauseByShard != nil {
+		fields[i] = fmt.Sprintf("FailedCauseByShard: %v", v.FailedCauseByShard)
 		i++
 	}
 
-	return fmt.Sprintf("HistoryTaskV2Attributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetReplicationReadinessResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool {
+func _Map_I32_ReplicationShardReadiness_Equals(lhs, rhs map[int32]*ReplicationShardReadiness) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
 		if !lv.Equals(rv) {
 			return false
 		}
 	}
+	return true
+}
+
+func _Map_I32_GetTaskFailedCause_Equals(lhs, rhs map[int32]shared.GetTaskFailedCause) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
 	return true
 }
 
-// Equals returns true if all the fields of this HistoryTaskV2Attributes match the
-// provided HistoryTaskV2Attributes.
+// Equals returns true if all the fields of this GetReplicationReadinessResponse match the
+// provided GetReplicationReadinessResponse.
 //
 // This function performs a deep comparison.
-func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
+func (v *GetReplicationReadinessResponse) Equals(rhs *GetReplicationReadinessResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !((v.VersionHistoryItems == nil && rhs.VersionHistoryItems == nil) || (v.VersionHistoryItems != nil && rhs.VersionHistoryItems != nil && _List_VersionHistoryItem_Equals(v.VersionHistoryItems, rhs.VersionHistoryItems))) {
-		return false
-	}
-	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && v.Events.Equals(rhs.Events))) {
+	if !((v.ReadinessByShard == nil && rhs.ReadinessByShard == nil) || (v.ReadinessByShard != nil && rhs.ReadinessByShard != nil && _Map_I32_ReplicationShardReadiness_Equals(v.ReadinessByShard, rhs.ReadinessByShard))) {
 		return false
 	}
-	if !((v.NewRunEvents == nil && rhs.NewRunEvents == nil) || (v.NewRunEvents != nil && rhs.NewRunEvents != nil && v.NewRunEvents.Equals(rhs.NewRunEvents))) {
+	if !((v.FailedCauseByShard == nil && rhs.FailedCauseByShard == nil) || (v.FailedCauseByShard != nil && rhs.FailedCauseByShard != nil && _Map_I32_GetTaskFailedCause_Equals(v.FailedCauseByShard, rhs.FailedCauseByShard))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_VersionHistoryItem_Zapper []*shared.VersionHistoryItem
+type _Map_I32_ReplicationShardReadiness_Item_Zapper struct {
+	Key   int32
+	Value *ReplicationShardReadiness
+}
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_VersionHistoryItem_Zapper.
-func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
+// fast logging of _Map_I32_ReplicationShardReadiness_Item_Zapper.
+func (v _Map_I32_ReplicationShardReadiness_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryTaskV2Attributes.
-func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.TaskId != nil {
-		enc.AddInt64("taskId", *v.TaskId)
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.VersionHistoryItems != nil {
-		err = multierr.Append(err, enc.AddArray("versionHistoryItems", (_List_VersionHistoryItem_Zapper)(v.VersionHistoryItems)))
-	}
-	if v.Events != nil {
-		err = multierr.Append(err, enc.AddObject("events", v.Events))
-	}
-	if v.NewRunEvents != nil {
-		err = multierr.Append(err, enc.AddObject("newRunEvents", v.NewRunEvents))
+type _Map_I32_ReplicationShardReadiness_Zapper map[int32]*ReplicationShardReadiness
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationShardReadiness_Zapper.
+func (m _Map_I32_ReplicationShardReadiness_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_ReplicationShardReadiness_Item_Zapper{Key: k, Value: v}))
 	}
 	return err
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetTaskId() (o int64) {
-	if v != nil && v.TaskId != nil {
-		return *v.TaskId
-	}
-
-	return
+type _Map_I32_GetTaskFailedCause_Item_Zapper struct {
+	Key   int32
+	Value shared.GetTaskFailedCause
 }
 
-// IsSetTaskId returns true if TaskId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetTaskId() bool {
-	return v != nil && v.TaskId != nil
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_GetTaskFailedCause_Item_Zapper.
+func (v _Map_I32_GetTaskFailedCause_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
-	}
+type _Map_I32_GetTaskFailedCause_Zapper map[int32]shared.GetTaskFailedCause
 
-	return
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_GetTaskFailedCause_Zapper.
+func (m _Map_I32_GetTaskFailedCause_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_GetTaskFailedCause_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationReadinessResponse.
+func (v *GetReplicationReadinessResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReadinessByShard != nil {
+		err = multierr.Append(err, enc.AddArray("readinessByShard", (_Map_I32_ReplicationShardReadiness_Zapper)(v.ReadinessByShard)))
+	}
+	if v.FailedCauseByShard != nil {
+		err = multierr.Append(err, enc.AddArray("failedCauseByShard", (_Map_I32_GetTaskFailedCause_Zapper)(v.FailedCauseByShard)))
+	}
+	return err
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
+// GetReadinessByShard returns the value of ReadinessByShard if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+func (v *GetReplicationReadinessResponse) GetReadinessByShard() (o map[int32]*ReplicationShardReadiness) {
+	if v != nil && v.ReadinessByShard != nil {
+		return v.ReadinessByShard
 	}
 
 	return
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
+// IsSetReadinessByShard returns true if ReadinessByShard is not nil.
+func (v *GetReplicationReadinessResponse) IsSetReadinessByShard() bool {
+	return v != nil && v.ReadinessByShard != nil
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetFailedCauseByShard returns the value of FailedCauseByShard if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *GetReplicationReadinessResponse) GetFailedCauseByShard() (o map[int32]shared.GetTaskFailedCause) {
+	if v != nil && v.FailedCauseByShard != nil {
+		return v.FailedCauseByShard
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetFailedCauseByShard returns true if FailedCauseByShard is not nil.
+func (v *GetReplicationReadinessResponse) IsSetFailedCauseByShard() bool {
+	return v != nil && v.FailedCauseByShard != nil
 }
 
-// GetVersionHistoryItems returns the value of VersionHistoryItems if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetVersionHistoryItems() (o []*shared.VersionHistoryItem) {
-	if v != nil && v.VersionHistoryItems != nil {
-		return v.VersionHistoryItems
-	}
-
-	return
+type GetReplicationStatusResponse struct {
+	StatusByShard      map[int32]*ReplicationShardStatus   `json:"statusByShard,omitempty"`
+	FailedCauseByShard map[int32]shared.GetTaskFailedCause `json:"failedCauseByShard,omitempty"`
 }
 
-// IsSetVersionHistoryItems returns true if VersionHistoryItems is not nil.
-func (v *HistoryTaskV2Attributes) IsSetVersionHistoryItems() bool {
-	return v != nil && v.VersionHistoryItems != nil
-}
+type _Map_I32_ReplicationShardStatus_MapItemList map[int32]*ReplicationShardStatus
 
-// GetEvents returns the value of Events if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetEvents() (o *shared.DataBlob) {
-	if v != nil && v.Events != nil {
-		return v.Events
-	}
+func (m _Map_I32_ReplicationShardStatus_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[int32]*ReplicationShardStatus', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
 
-	return
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// IsSetEvents returns true if Events is not nil.
-func (v *HistoryTaskV2Attributes) IsSetEvents() bool {
-	return v != nil && v.Events != nil
+func (m _Map_I32_ReplicationShardStatus_MapItemList) Size() int {
+	return len(m)
 }
 
-// GetNewRunEvents returns the value of NewRunEvents if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetNewRunEvents() (o *shared.DataBlob) {
-	if v != nil && v.NewRunEvents != nil {
-		return v.NewRunEvents
-	}
-
-	return
+func (_Map_I32_ReplicationShardStatus_MapItemList) KeyType() wire.Type {
+	return wire.TI32
 }
 
-// IsSetNewRunEvents returns true if NewRunEvents is not nil.
-func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
-	return v != nil && v.NewRunEvents != nil
+func (_Map_I32_ReplicationShardStatus_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type MergeDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               *int32   `json:"shardID,omitempty"`
-	SourceCluster         *string  `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
-}
+func (_Map_I32_ReplicationShardStatus_MapItemList) Close() {}
 
-// ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
+// ToWire translates a GetReplicationStatusResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *GetReplicationStatusResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Type != nil {
-		w, err = v.Type.ToWire()
+	if v.StatusByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_ReplicationShardStatus_MapItemList(v.StatusByShard)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+	if v.FailedCauseByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_GetTaskFailedCause_MapItemList(v.FailedCauseByShard)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationShardStatus_Read(w wire.Value) (*ReplicationShardStatus, error) {
+	var v ReplicationShardStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I32_ReplicationShardStatus_Read(m wire.MapItemList) (map[int32]*ReplicationShardStatus, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
 	}
-	if v.InclusiveEndMessageID != nil {
-		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+
+	o := make(map[int32]*ReplicationShardStatus, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+
+		v, err := _ReplicationShardStatus_Read(x.Value)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _DLQType_Read(w wire.Value) (DLQType, error) {
-	var v DLQType
-	err := v.FromWire(w)
-	return v, err
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// FromWire deserializes a MergeDLQMessagesRequest struct from its Thrift-level
+// FromWire deserializes a GetReplicationStatusResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MergeDLQMessagesRequest struct
+// An error is returned if we were unable to build a GetReplicationStatusResponse struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v MergeDLQMessagesRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v GetReplicationStatusResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *GetReplicationStatusResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x DLQType
-				x, err = _DLQType_Read(field.Value)
-				v.Type = &x
+			if field.Value.Type() == wire.TMap {
+				v.StatusByShard, err = _Map_I32_ReplicationShardStatus_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
+			if field.Value.Type() == wire.TMap {
+				v.FailedCauseByShard, err = _Map_I32_GetTaskFailedCause_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InclusiveEndMessageID = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
+func _Map_I32_ReplicationShardStatus_Encode(val map[int32]*ReplicationShardStatus, sw stream.Writer) error {
 
-			}
-		case 60:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
 
-			}
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[int32]*ReplicationShardStatus', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return sw.WriteMapEnd()
 }
 
-// Encode serializes a MergeDLQMessagesRequest struct directly into bytes, without going
+// Encode serializes a GetReplicationStatusResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MergeDLQMessagesRequest struct could not be encoded.
-func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
+// An error is returned if a GetReplicationStatusResponse struct could not be encoded.
+func (v *GetReplicationStatusResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Type != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+	if v.StatusByShard != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := v.Type.Encode(sw); err != nil {
+		if err := _Map_I32_ReplicationShardStatus_Encode(v.StatusByShard, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4797,11 +4711,11 @@ func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+	if v.FailedCauseByShard != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TMap}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+		if err := _Map_I32_GetTaskFailedCause_Encode(v.FailedCauseByShard, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4809,69 +4723,61 @@ func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SourceCluster != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+	return sw.WriteStructEnd()
+}
 
-	if v.InclusiveEndMessageID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.InclusiveEndMessageID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+func _ReplicationShardStatus_Decode(sr stream.Reader) (*ReplicationShardStatus, error) {
+	var v ReplicationShardStatus
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_I32_ReplicationShardStatus_Decode(sr stream.Reader) (map[int32]*ReplicationShardStatus, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
 	}
 
-	if v.MaximumPageSize != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
 		}
+		return nil, sr.ReadMapEnd()
 	}
 
-	if v.NextPageToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
-			return err
+	o := make(map[int32]*ReplicationShardStatus, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+
+		v, err := _ReplicationShardStatus_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	return sw.WriteStructEnd()
-}
+		o[k] = v
+	}
 
-func _DLQType_Decode(sr stream.Reader) (DLQType, error) {
-	var v DLQType
-	err := v.Decode(sr)
-	return v, err
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a MergeDLQMessagesRequest struct directly from its Thrift-level
+// Decode deserializes a GetReplicationStatusResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MergeDLQMessagesRequest struct could not be generated from the wire
+// An error is returned if a GetReplicationStatusResponse struct could not be generated from the wire
 // representation.
-func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
+func (v *GetReplicationStatusResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4884,48 +4790,14 @@ func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x DLQType
-			x, err = _DLQType_Decode(sr)
-			v.Type = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.SourceCluster = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.InclusiveEndMessageID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MaximumPageSize = &x
+		case fh.ID == 10 && fh.Type == wire.TMap:
+			v.StatusByShard, err = _Map_I32_ReplicationShardStatus_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
+		case fh.ID == 20 && fh.Type == wire.TMap:
+			v.FailedCauseByShard, err = _Map_I32_GetTaskFailedCause_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4952,301 +4824,484 @@ func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MergeDLQMessagesRequest
+// String returns a readable string representation of a GetReplicationStatusResponse
 // struct.
-func (v *MergeDLQMessagesRequest) String() string {
+func (v *GetReplicationStatusResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [2]string
 	i := 0
-	if v.Type != nil {
-		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
-		i++
-	}
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
-		i++
-	}
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
-		i++
-	}
-	if v.InclusiveEndMessageID != nil {
-		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+	if v.StatusByShard != nil {
+		fields[i] = fmt.Sprintf("StatusByShard: %v", v.StatusByShard)
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.FailedCauseByShard != nil {
+		fields[i] = fmt.Sprintf("FailedCauseByShard: %v", v.FailedCauseByShard)
 		i++
 	}
 
-	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetReplicationStatusResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _DLQType_EqualsPtr(lhs, rhs *DLQType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+func _Map_I32_ReplicationShardStatus_Equals(lhs, rhs map[int32]*ReplicationShardStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
-// Equals returns true if all the fields of this MergeDLQMessagesRequest match the
-// provided MergeDLQMessagesRequest.
+// Equals returns true if all the fields of this GetReplicationStatusResponse match the
+// provided GetReplicationStatusResponse.
 //
 // This function performs a deep comparison.
-func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
+func (v *GetReplicationStatusResponse) Equals(rhs *GetReplicationStatusResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+	if !((v.StatusByShard == nil && rhs.StatusByShard == nil) || (v.StatusByShard != nil && rhs.StatusByShard != nil && _Map_I32_ReplicationShardStatus_Equals(v.StatusByShard, rhs.StatusByShard))) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !((v.FailedCauseByShard == nil && rhs.FailedCauseByShard == nil) || (v.FailedCauseByShard != nil && rhs.FailedCauseByShard != nil && _Map_I32_GetTaskFailedCause_Equals(v.FailedCauseByShard, rhs.FailedCauseByShard))) {
 		return false
 	}
 
 	return true
 }
 
+type _Map_I32_ReplicationShardStatus_Item_Zapper struct {
+	Key   int32
+	Value *ReplicationShardStatus
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationShardStatus_Item_Zapper.
+func (v _Map_I32_ReplicationShardStatus_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_I32_ReplicationShardStatus_Zapper map[int32]*ReplicationShardStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationShardStatus_Zapper.
+func (m _Map_I32_ReplicationShardStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_ReplicationShardStatus_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MergeDLQMessagesRequest.
-func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetReplicationStatusResponse.
+func (v *GetReplicationStatusResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Type != nil {
-		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+	if v.StatusByShard != nil {
+		err = multierr.Append(err, enc.AddArray("statusByShard", (_Map_I32_ReplicationShardStatus_Zapper)(v.StatusByShard)))
 	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
-	}
-	if v.SourceCluster != nil {
-		enc.AddString("sourceCluster", *v.SourceCluster)
-	}
-	if v.InclusiveEndMessageID != nil {
-		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
-	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
-	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.FailedCauseByShard != nil {
+		err = multierr.Append(err, enc.AddArray("failedCauseByShard", (_Map_I32_GetTaskFailedCause_Zapper)(v.FailedCauseByShard)))
 	}
 	return err
 }
 
-// GetType returns the value of Type if it is set or its
-// zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetType() (o DLQType) {
-	if v != nil && v.Type != nil {
-		return *v.Type
-	}
-
-	return
-}
-
-// IsSetType returns true if Type is not nil.
-func (v *MergeDLQMessagesRequest) IsSetType() bool {
-	return v != nil && v.Type != nil
-}
-
-// GetShardID returns the value of ShardID if it is set or its
+// GetStatusByShard returns the value of StatusByShard if it is set or its
 // zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
+func (v *GetReplicationStatusResponse) GetStatusByShard() (o map[int32]*ReplicationShardStatus) {
+	if v != nil && v.StatusByShard != nil {
+		return v.StatusByShard
 	}
 
 	return
 }
 
-// IsSetShardID returns true if ShardID is not nil.
-func (v *MergeDLQMessagesRequest) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
+// IsSetStatusByShard returns true if StatusByShard is not nil.
+func (v *GetReplicationStatusResponse) IsSetStatusByShard() bool {
+	return v != nil && v.StatusByShard != nil
 }
 
-// GetSourceCluster returns the value of SourceCluster if it is set or its
+// GetFailedCauseByShard returns the value of FailedCauseByShard if it is set or its
 // zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetSourceCluster() (o string) {
-	if v != nil && v.SourceCluster != nil {
-		return *v.SourceCluster
+func (v *GetReplicationStatusResponse) GetFailedCauseByShard() (o map[int32]shared.GetTaskFailedCause) {
+	if v != nil && v.FailedCauseByShard != nil {
+		return v.FailedCauseByShard
 	}
 
 	return
 }
 
-// IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *MergeDLQMessagesRequest) IsSetSourceCluster() bool {
-	return v != nil && v.SourceCluster != nil
+// IsSetFailedCauseByShard returns true if FailedCauseByShard is not nil.
+func (v *GetReplicationStatusResponse) IsSetFailedCauseByShard() bool {
+	return v != nil && v.FailedCauseByShard != nil
 }
 
-// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
-// zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
-	if v != nil && v.InclusiveEndMessageID != nil {
-		return *v.InclusiveEndMessageID
-	}
-
-	return
+type HistoryTaskV2Attributes struct {
+	TaskId              *int64                       `json:"taskId,omitempty"`
+	DomainId            *string                      `json:"domainId,omitempty"`
+	WorkflowId          *string                      `json:"workflowId,omitempty"`
+	RunId               *string                      `json:"runId,omitempty"`
+	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
+	Events              *shared.DataBlob             `json:"events,omitempty"`
+	NewRunEvents        *shared.DataBlob             `json:"newRunEvents,omitempty"`
 }
 
-// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
-func (v *MergeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
-	return v != nil && v.InclusiveEndMessageID != nil
-}
+type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
-// zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
-}
-
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *MergeDLQMessagesRequest) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
+	return nil
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
-// zero value if it is unset.
-func (v *MergeDLQMessagesRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
-	}
-
-	return
+func (v _List_VersionHistoryItem_ValueList) Size() int {
+	return len(v)
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type MergeDLQMessagesResponse struct {
-	NextPageToken []byte `json:"nextPageToken,omitempty"`
-}
+func (_List_VersionHistoryItem_ValueList) Close() {}
 
-// ToWire translates a MergeDLQMessagesResponse struct into a Thrift-level intermediate
+// ToWire translates a HistoryTaskV2Attributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *MergeDLQMessagesResponse) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.VersionHistoryItems)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Events != nil {
+		w, err = v.Events.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NewRunEvents != nil {
+		w, err = v.NewRunEvents.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MergeDLQMessagesResponse struct from its Thrift-level
+func _VersionHistoryItem_Read(w wire.Value) (*shared.VersionHistoryItem, error) {
+	var v shared.VersionHistoryItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.VersionHistoryItem, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _VersionHistoryItem_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a HistoryTaskV2Attributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MergeDLQMessagesResponse struct
+// An error is returned if we were unable to build a HistoryTaskV2Attributes struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
 //
-//   var v MergeDLQMessagesResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *MergeDLQMessagesResponse) FromWire(w wire.Value) error {
+//	var v HistoryTaskV2Attributes
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
 
-	return nil
-}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
 
-// Encode serializes a MergeDLQMessagesResponse struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a MergeDLQMessagesResponse struct could not be encoded.
-func (v *MergeDLQMessagesResponse) Encode(sw stream.Writer) error {
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.VersionHistoryItems, err = _List_VersionHistoryItem_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.Events, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunEvents, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_VersionHistoryItem_Encode(val []*shared.VersionHistoryItem, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a HistoryTaskV2Attributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a HistoryTaskV2Attributes struct could not be encoded.
+func (v *HistoryTaskV2Attributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.NextPageToken != nil {
+	if v.TaskId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TaskId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+		if err := sw.WriteString(*(v.DomainId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VersionHistoryItems != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_VersionHistoryItem_Encode(v.VersionHistoryItems, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Events != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Events.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NewRunEvents != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NewRunEvents.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5257,12 +5312,54 @@ func (v *MergeDLQMessagesResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a MergeDLQMessagesResponse struct directly from its Thrift-level
+func _VersionHistoryItem_Decode(sr stream.Reader) (*shared.VersionHistoryItem, error) {
+	var v shared.VersionHistoryItem
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Decode(sr stream.Reader) ([]*shared.VersionHistoryItem, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*shared.VersionHistoryItem, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _VersionHistoryItem_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _DataBlob_Decode(sr stream.Reader) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a HistoryTaskV2Attributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MergeDLQMessagesResponse struct could not be generated from the wire
+// An error is returned if a HistoryTaskV2Attributes struct could not be generated from the wire
 // representation.
-func (v *MergeDLQMessagesResponse) Decode(sr stream.Reader) error {
+func (v *HistoryTaskV2Attributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5275,8 +5372,52 @@ func (v *MergeDLQMessagesResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
+		case fh.ID == 5 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskId = &x
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.DomainId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TList:
+			v.VersionHistoryItems, err = _List_VersionHistoryItem_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.Events, err = _DataBlob_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.NewRunEvents, err = _DataBlob_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5303,92 +5444,270 @@ func (v *MergeDLQMessagesResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MergeDLQMessagesResponse
+// String returns a readable string representation of a HistoryTaskV2Attributes
 // struct.
-func (v *MergeDLQMessagesResponse) String() string {
+func (v *HistoryTaskV2Attributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [7]string
 	i := 0
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
 		i++
 	}
-
-	return fmt.Sprintf("MergeDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this MergeDLQMessagesResponse match the
-// provided MergeDLQMessagesResponse.
-//
-// This function performs a deep comparison.
-func (v *MergeDLQMessagesResponse) Equals(rhs *MergeDLQMessagesResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
-		return false
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		fields[i] = fmt.Sprintf("VersionHistoryItems: %v", v.VersionHistoryItems)
+		i++
+	}
+	if v.Events != nil {
+		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+		i++
+	}
+	if v.NewRunEvents != nil {
+		fields[i] = fmt.Sprintf("NewRunEvents: %v", v.NewRunEvents)
+		i++
+	}
+
+	return fmt.Sprintf("HistoryTaskV2Attributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HistoryTaskV2Attributes match the
+// provided HistoryTaskV2Attributes.
+//
+// This function performs a deep comparison.
+func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !((v.VersionHistoryItems == nil && rhs.VersionHistoryItems == nil) || (v.VersionHistoryItems != nil && rhs.VersionHistoryItems != nil && _List_VersionHistoryItem_Equals(v.VersionHistoryItems, rhs.VersionHistoryItems))) {
+		return false
+	}
+	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && v.Events.Equals(rhs.Events))) {
+		return false
+	}
+	if !((v.NewRunEvents == nil && rhs.NewRunEvents == nil) || (v.NewRunEvents != nil && rhs.NewRunEvents != nil && v.NewRunEvents.Equals(rhs.NewRunEvents))) {
+		return false
 	}
 
 	return true
 }
 
+type _List_VersionHistoryItem_Zapper []*shared.VersionHistoryItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_VersionHistoryItem_Zapper.
+func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MergeDLQMessagesResponse.
-func (v *MergeDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of HistoryTaskV2Attributes.
+func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.VersionHistoryItems != nil {
+		err = multierr.Append(err, enc.AddArray("versionHistoryItems", (_List_VersionHistoryItem_Zapper)(v.VersionHistoryItems)))
+	}
+	if v.Events != nil {
+		err = multierr.Append(err, enc.AddObject("events", v.Events))
+	}
+	if v.NewRunEvents != nil {
+		err = multierr.Append(err, enc.AddObject("newRunEvents", v.NewRunEvents))
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetTaskId returns the value of TaskId if it is set or its
 // zero value if it is unset.
-func (v *MergeDLQMessagesResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *HistoryTaskV2Attributes) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *MergeDLQMessagesResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
 }
 
-type PurgeDLQMessagesRequest struct {
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetVersionHistoryItems returns the value of VersionHistoryItems if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetVersionHistoryItems() (o []*shared.VersionHistoryItem) {
+	if v != nil && v.VersionHistoryItems != nil {
+		return v.VersionHistoryItems
+	}
+
+	return
+}
+
+// IsSetVersionHistoryItems returns true if VersionHistoryItems is not nil.
+func (v *HistoryTaskV2Attributes) IsSetVersionHistoryItems() bool {
+	return v != nil && v.VersionHistoryItems != nil
+}
+
+// GetEvents returns the value of Events if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetEvents() (o *shared.DataBlob) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+
+	return
+}
+
+// IsSetEvents returns true if Events is not nil.
+func (v *HistoryTaskV2Attributes) IsSetEvents() bool {
+	return v != nil && v.Events != nil
+}
+
+// GetNewRunEvents returns the value of NewRunEvents if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetNewRunEvents() (o *shared.DataBlob) {
+	if v != nil && v.NewRunEvents != nil {
+		return v.NewRunEvents
+	}
+
+	return
+}
+
+// IsSetNewRunEvents returns true if NewRunEvents is not nil.
+func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
+	return v != nil && v.NewRunEvents != nil
+}
+
+type MergeDLQMessagesRequest struct {
 	Type                  *DLQType `json:"type,omitempty"`
 	ShardID               *int32   `json:"shardID,omitempty"`
 	SourceCluster         *string  `json:"sourceCluster,omitempty"`
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a PurgeDLQMessagesRequest struct into a Thrift-level intermediate
+// ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5426,28 +5745,50 @@ func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PurgeDLQMessagesRequest struct from its Thrift-level
+func _DLQType_Read(w wire.Value) (DLQType, error) {
+	var v DLQType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a MergeDLQMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PurgeDLQMessagesRequest struct
+// An error is returned if we were unable to build a MergeDLQMessagesRequest struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
 //
-//   var v PurgeDLQMessagesRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
+//	var v MergeDLQMessagesRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -5491,6 +5832,24 @@ func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5498,11 +5857,11 @@ func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PurgeDLQMessagesRequest struct directly into bytes, without going
+// Encode serializes a MergeDLQMessagesRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PurgeDLQMessagesRequest struct could not be encoded.
-func (v *PurgeDLQMessagesRequest) Encode(sw stream.Writer) error {
+// An error is returned if a MergeDLQMessagesRequest struct could not be encoded.
+func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -5555,15 +5914,45 @@ func (v *PurgeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.MaximumPageSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a PurgeDLQMessagesRequest struct directly from its Thrift-level
+func _DLQType_Decode(sr stream.Reader) (DLQType, error) {
+	var v DLQType
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a MergeDLQMessagesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PurgeDLQMessagesRequest struct could not be generated from the wire
+// An error is returned if a MergeDLQMessagesRequest struct could not be generated from the wire
 // representation.
-func (v *PurgeDLQMessagesRequest) Decode(sr stream.Reader) error {
+func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5608,6 +5997,20 @@ func (v *PurgeDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaximumPageSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -5630,14 +6033,14 @@ func (v *PurgeDLQMessagesRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PurgeDLQMessagesRequest
+// String returns a readable string representation of a MergeDLQMessagesRequest
 // struct.
-func (v *PurgeDLQMessagesRequest) String() string {
+func (v *MergeDLQMessagesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -5655,15 +6058,43 @@ func (v *PurgeDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
 		i++
 	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
 
-	return fmt.Sprintf("PurgeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PurgeDLQMessagesRequest match the
-// provided PurgeDLQMessagesRequest.
+func _DLQType_EqualsPtr(lhs, rhs *DLQType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this MergeDLQMessagesRequest match the
+// provided MergeDLQMessagesRequest.
 //
 // This function performs a deep comparison.
-func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
+func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5681,13 +6112,19 @@ func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
 	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PurgeDLQMessagesRequest.
-func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MergeDLQMessagesRequest.
+func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5703,12 +6140,18 @@ func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.InclusiveEndMessageID != nil {
 		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
 	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
 	return err
 }
 
 // GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *PurgeDLQMessagesRequest) GetType() (o DLQType) {
+func (v *MergeDLQMessagesRequest) GetType() (o DLQType) {
 	if v != nil && v.Type != nil {
 		return *v.Type
 	}
@@ -5717,13 +6160,13 @@ func (v *PurgeDLQMessagesRequest) GetType() (o DLQType) {
 }
 
 // IsSetType returns true if Type is not nil.
-func (v *PurgeDLQMessagesRequest) IsSetType() bool {
+func (v *MergeDLQMessagesRequest) IsSetType() bool {
 	return v != nil && v.Type != nil
 }
 
 // GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
+func (v *MergeDLQMessagesRequest) GetShardID() (o int32) {
 	if v != nil && v.ShardID != nil {
 		return *v.ShardID
 	}
@@ -5732,13 +6175,13 @@ func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
 }
 
 // IsSetShardID returns true if ShardID is not nil.
-func (v *PurgeDLQMessagesRequest) IsSetShardID() bool {
+func (v *MergeDLQMessagesRequest) IsSetShardID() bool {
 	return v != nil && v.ShardID != nil
 }
 
 // GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
+func (v *MergeDLQMessagesRequest) GetSourceCluster() (o string) {
 	if v != nil && v.SourceCluster != nil {
 		return *v.SourceCluster
 	}
@@ -5747,13 +6190,13 @@ func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
 }
 
 // IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *PurgeDLQMessagesRequest) IsSetSourceCluster() bool {
+func (v *MergeDLQMessagesRequest) IsSetSourceCluster() bool {
 	return v != nil && v.SourceCluster != nil
 }
 
 // GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
 // zero value if it is unset.
-func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+func (v *MergeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
 	if v != nil && v.InclusiveEndMessageID != nil {
 		return *v.InclusiveEndMessageID
 	}
@@ -5762,20 +6205,45 @@ func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
 }
 
 // IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
-func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+func (v *MergeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
 	return v != nil && v.InclusiveEndMessageID != nil
 }
 
-type ReadDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               *int32   `json:"shardID,omitempty"`
-	SourceCluster         *string  `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
 }
 
-// ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *MergeDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type MergeDLQMessagesResponse struct {
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a MergeDLQMessagesResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5787,74 +6255,34 @@ type ReadDLQMessagesRequest struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *MergeDLQMessagesResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Type != nil {
-		w, err = v.Type.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.SourceCluster != nil {
-		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.InclusiveEndMessageID != nil {
-		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 	if v.NextPageToken != nil {
 		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReadDLQMessagesRequest struct from its Thrift-level
+// FromWire deserializes a MergeDLQMessagesResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReadDLQMessagesRequest struct
+// An error is returned if we were unable to build a MergeDLQMessagesResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5862,67 +6290,17 @@ func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ReadDLQMessagesRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
+//	var v MergeDLQMessagesResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *MergeDLQMessagesResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x DLQType
-				x, err = _DLQType_Read(field.Value)
-				v.Type = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SourceCluster = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InclusiveEndMessageID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
 			if field.Value.Type() == wire.TBinary {
 				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
@@ -5936,77 +6314,17 @@ func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReadDLQMessagesRequest struct directly into bytes, without going
+// Encode serializes a MergeDLQMessagesResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ReadDLQMessagesRequest struct could not be encoded.
-func (v *ReadDLQMessagesRequest) Encode(sw stream.Writer) error {
+// An error is returned if a MergeDLQMessagesResponse struct could not be encoded.
+func (v *MergeDLQMessagesResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Type != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.Type.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.SourceCluster != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.InclusiveEndMessageID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.InclusiveEndMessageID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.MaximumPageSize != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.NextPageToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
 		if err := sw.WriteBinary(v.NextPageToken); err != nil {
@@ -6020,12 +6338,12 @@ func (v *ReadDLQMessagesRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ReadDLQMessagesRequest struct directly from its Thrift-level
+// Decode deserializes a MergeDLQMessagesResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReadDLQMessagesRequest struct could not be generated from the wire
+// An error is returned if a MergeDLQMessagesResponse struct could not be generated from the wire
 // representation.
-func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
+func (v *MergeDLQMessagesResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6038,47 +6356,7 @@ func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x DLQType
-			x, err = _DLQType_Decode(sr)
-			v.Type = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.SourceCluster = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.InclusiveEndMessageID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MaximumPageSize = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TBinary:
+		case fh.ID == 10 && fh.Type == wire.TBinary:
 			v.NextPageToken, err = sr.ReadBinary()
 			if err != nil {
 				return err
@@ -6106,68 +6384,33 @@ func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReadDLQMessagesRequest
+// String returns a readable string representation of a MergeDLQMessagesResponse
 // struct.
-func (v *ReadDLQMessagesRequest) String() string {
+func (v *MergeDLQMessagesResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [1]string
 	i := 0
-	if v.Type != nil {
-		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
-		i++
-	}
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
-		i++
-	}
-	if v.SourceCluster != nil {
-		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
-		i++
-	}
-	if v.InclusiveEndMessageID != nil {
-		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
 	if v.NextPageToken != nil {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
 
-	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MergeDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReadDLQMessagesRequest match the
-// provided ReadDLQMessagesRequest.
+// Equals returns true if all the fields of this MergeDLQMessagesResponse match the
+// provided MergeDLQMessagesResponse.
 //
 // This function performs a deep comparison.
-func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
+func (v *MergeDLQMessagesResponse) Equals(rhs *MergeDLQMessagesResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
-		return false
-	}
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
@@ -6176,145 +6419,55 @@ func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReadDLQMessagesRequest.
-func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MergeDLQMessagesResponse.
+func (v *MergeDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Type != nil {
-		err = multierr.Append(err, enc.AddObject("type", *v.Type))
-	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
-	}
-	if v.SourceCluster != nil {
-		enc.AddString("sourceCluster", *v.SourceCluster)
-	}
-	if v.InclusiveEndMessageID != nil {
-		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
-	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
-	}
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetType returns the value of Type if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetType() (o DLQType) {
-	if v != nil && v.Type != nil {
-		return *v.Type
+func (v *MergeDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetType returns true if Type is not nil.
-func (v *ReadDLQMessagesRequest) IsSetType() bool {
-	return v != nil && v.Type != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-// GetShardID returns the value of ShardID if it is set or its
-// zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
-	}
-
-	return
-}
-
-// IsSetShardID returns true if ShardID is not nil.
-func (v *ReadDLQMessagesRequest) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
-}
-
-// GetSourceCluster returns the value of SourceCluster if it is set or its
-// zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetSourceCluster() (o string) {
-	if v != nil && v.SourceCluster != nil {
-		return *v.SourceCluster
-	}
-
-	return
-}
-
-// IsSetSourceCluster returns true if SourceCluster is not nil.
-func (v *ReadDLQMessagesRequest) IsSetSourceCluster() bool {
-	return v != nil && v.SourceCluster != nil
-}
-
-// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
-// zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
-	if v != nil && v.InclusiveEndMessageID != nil {
-		return *v.InclusiveEndMessageID
-	}
-
-	return
-}
-
-// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
-func (v *ReadDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
-	return v != nil && v.InclusiveEndMessageID != nil
-}
-
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
-// zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
-	}
-
-	return
-}
-
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *ReadDLQMessagesRequest) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
-}
-
-// GetNextPageToken returns the value of NextPageToken if it is set or its
-// zero value if it is unset.
-func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
-	}
-
-	return
-}
-
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
-}
-
-type ReadDLQMessagesResponse struct {
-	Type                 *DLQType               `json:"type,omitempty"`
-	ReplicationTasks     []*ReplicationTask     `json:"replicationTasks,omitempty"`
-	NextPageToken        []byte                 `json:"nextPageToken,omitempty"`
-	ReplicationTasksInfo []*ReplicationTaskInfo `json:"replicationTasksInfo,omitempty"`
+type PurgeDLQMessagesRequest struct {
+	Type                  *DLQType `json:"type,omitempty"`
+	ShardID               *int32   `json:"shardID,omitempty"`
+	SourceCluster         *string  `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
 }
 
-// ToWire translates a ReadDLQMessagesResponse struct into a Thrift-level intermediate
+// ToWire translates a PurgeDLQMessagesRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -6330,24 +6483,24 @@ func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ReplicationTasks != nil {
-		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ReplicationTasksInfo != nil {
-		w, err = wire.NewValueList(_List_ReplicationTaskInfo_ValueList(v.ReplicationTasksInfo)), error(nil)
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -6358,11 +6511,11 @@ func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReadDLQMessagesResponse struct from its Thrift-level
+// FromWire deserializes a PurgeDLQMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReadDLQMessagesResponse struct
+// An error is returned if we were unable to build a PurgeDLQMessagesRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6370,12 +6523,12 @@ func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ReadDLQMessagesResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
+//	var v PurgeDLQMessagesRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6391,8 +6544,10 @@ func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
@@ -6400,15 +6555,19 @@ func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TList {
-				v.ReplicationTasksInfo, err = _List_ReplicationTaskInfo_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
 				if err != nil {
 					return err
 				}
@@ -6420,11 +6579,11 @@ func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReadDLQMessagesResponse struct directly into bytes, without going
+// Encode serializes a PurgeDLQMessagesRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ReadDLQMessagesResponse struct could not be encoded.
-func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
+// An error is returned if a PurgeDLQMessagesRequest struct could not be encoded.
+func (v *PurgeDLQMessagesRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -6441,11 +6600,11 @@ func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ReplicationTasks != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := _List_ReplicationTask_Encode(v.ReplicationTasks, sw); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6453,11 +6612,11 @@ func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.NextPageToken != nil {
+	if v.SourceCluster != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6465,11 +6624,11 @@ func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ReplicationTasksInfo != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
+	if v.InclusiveEndMessageID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := _List_ReplicationTaskInfo_Encode(v.ReplicationTasksInfo, sw); err != nil {
+		if err := sw.WriteInt64(*(v.InclusiveEndMessageID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6480,12 +6639,12 @@ func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ReadDLQMessagesResponse struct directly from its Thrift-level
+// Decode deserializes a PurgeDLQMessagesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReadDLQMessagesResponse struct could not be generated from the wire
+// An error is returned if a PurgeDLQMessagesRequest struct could not be generated from the wire
 // representation.
-func (v *ReadDLQMessagesResponse) Decode(sr stream.Reader) error {
+func (v *PurgeDLQMessagesRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6506,20 +6665,26 @@ func (v *ReadDLQMessagesResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.ReplicationTasks, err = _List_ReplicationTask_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 30 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.SourceCluster = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TList:
-			v.ReplicationTasksInfo, err = _List_ReplicationTaskInfo_Decode(sr)
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.InclusiveEndMessageID = &x
 			if err != nil {
 				return err
 			}
@@ -6546,9 +6711,9 @@ func (v *ReadDLQMessagesResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReadDLQMessagesResponse
+// String returns a readable string representation of a PurgeDLQMessagesRequest
 // struct.
-func (v *ReadDLQMessagesResponse) String() string {
+func (v *PurgeDLQMessagesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -6559,27 +6724,27 @@ func (v *ReadDLQMessagesResponse) String() string {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.ReplicationTasks != nil {
-		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.ReplicationTasksInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationTasksInfo: %v", v.ReplicationTasksInfo)
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
 		i++
 	}
 
-	return fmt.Sprintf("ReadDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PurgeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReadDLQMessagesResponse match the
-// provided ReadDLQMessagesResponse.
+// Equals returns true if all the fields of this PurgeDLQMessagesRequest match the
+// provided PurgeDLQMessagesRequest.
 //
 // This function performs a deep comparison.
-func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
+func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6588,13 +6753,13 @@ func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
 	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
 		return false
 	}
-	if !((v.ReplicationTasksInfo == nil && rhs.ReplicationTasksInfo == nil) || (v.ReplicationTasksInfo != nil && rhs.ReplicationTasksInfo != nil && _List_ReplicationTaskInfo_Equals(v.ReplicationTasksInfo, rhs.ReplicationTasksInfo))) {
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
 		return false
 	}
 
@@ -6602,29 +6767,29 @@ func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReadDLQMessagesResponse.
-func (v *ReadDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PurgeDLQMessagesRequest.
+func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Type != nil {
 		err = multierr.Append(err, enc.AddObject("type", *v.Type))
 	}
-	if v.ReplicationTasks != nil {
-		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
 	}
-	if v.ReplicationTasksInfo != nil {
-		err = multierr.Append(err, enc.AddArray("replicationTasksInfo", (_List_ReplicationTaskInfo_Zapper)(v.ReplicationTasksInfo)))
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
 	}
 	return err
 }
 
 // GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *ReadDLQMessagesResponse) GetType() (o DLQType) {
+func (v *PurgeDLQMessagesRequest) GetType() (o DLQType) {
 	if v != nil && v.Type != nil {
 		return *v.Type
 	}
@@ -6633,63 +6798,65 @@ func (v *ReadDLQMessagesResponse) GetType() (o DLQType) {
 }
 
 // IsSetType returns true if Type is not nil.
-func (v *ReadDLQMessagesResponse) IsSetType() bool {
+func (v *PurgeDLQMessagesRequest) IsSetType() bool {
 	return v != nil && v.Type != nil
 }
 
-// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *ReadDLQMessagesResponse) GetReplicationTasks() (o []*ReplicationTask) {
-	if v != nil && v.ReplicationTasks != nil {
-		return v.ReplicationTasks
+func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
-func (v *ReadDLQMessagesResponse) IsSetReplicationTasks() bool {
-	return v != nil && v.ReplicationTasks != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *ReadDLQMessagesResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ReadDLQMessagesResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
 }
 
-// GetReplicationTasksInfo returns the value of ReplicationTasksInfo if it is set or its
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
 // zero value if it is unset.
-func (v *ReadDLQMessagesResponse) GetReplicationTasksInfo() (o []*ReplicationTaskInfo) {
-	if v != nil && v.ReplicationTasksInfo != nil {
-		return v.ReplicationTasksInfo
+func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
 	}
 
 	return
 }
 
-// IsSetReplicationTasksInfo returns true if ReplicationTasksInfo is not nil.
-func (v *ReadDLQMessagesResponse) IsSetReplicationTasksInfo() bool {
-	return v != nil && v.ReplicationTasksInfo != nil
-}
-
-type ReplicationMessages struct {
-	ReplicationTasks       []*ReplicationTask `json:"replicationTasks,omitempty"`
-	LastRetrievedMessageId *int64             `json:"lastRetrievedMessageId,omitempty"`
-	HasMore                *bool              `json:"hasMore,omitempty"`
-	SyncShardStatus        *SyncShardStatus   `json:"syncShardStatus,omitempty"`
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
 }
 
-// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
+type ReadDLQMessagesRequest struct {
+	Type                  *DLQType `json:"type,omitempty"`
+	ShardID               *int32   `json:"shardID,omitempty"`
+	SourceCluster         *string  `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6701,64 +6868,74 @@ type ReplicationMessages struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReplicationMessages) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ReplicationTasks != nil {
-		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.LastRetrievedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.HasMore != nil {
-		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.SyncShardStatus != nil {
-		w, err = v.SyncShardStatus.ToWire()
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _SyncShardStatus_Read(w wire.Value) (*SyncShardStatus, error) {
-	var v SyncShardStatus
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a ReplicationMessages struct from its Thrift-level
+// FromWire deserializes a ReadDLQMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationMessages struct
+// An error is returned if we were unable to build a ReadDLQMessagesRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6766,47 +6943,69 @@ func _SyncShardStatus_Read(w wire.Value) (*SyncShardStatus, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicationMessages
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReplicationMessages) FromWire(w wire.Value) error {
+//	var v ReadDLQMessagesRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI32 {
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastRetrievedMessageId = &x
+				v.InclusiveEndMessageID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.HasMore = &x
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncShardStatus, err = _SyncShardStatus_Read(field.Value)
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -6818,20 +7017,20 @@ func (v *ReplicationMessages) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReplicationMessages struct directly into bytes, without going
+// Encode serializes a ReadDLQMessagesRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ReplicationMessages struct could not be encoded.
-func (v *ReplicationMessages) Encode(sw stream.Writer) error {
+// An error is returned if a ReadDLQMessagesRequest struct could not be encoded.
+func (v *ReadDLQMessagesRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ReplicationTasks != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := _List_ReplicationTask_Encode(v.ReplicationTasks, sw); err != nil {
+		if err := v.Type.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6839,11 +7038,11 @@ func (v *ReplicationMessages) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastRetrievedMessageId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastRetrievedMessageId)); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6851,11 +7050,11 @@ func (v *ReplicationMessages) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HasMore != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBool}); err != nil {
+	if v.SourceCluster != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.HasMore)); err != nil {
+		if err := sw.WriteString(*(v.SourceCluster)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6863,11 +7062,11 @@ func (v *ReplicationMessages) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SyncShardStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.InclusiveEndMessageID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.SyncShardStatus.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.InclusiveEndMessageID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6875,21 +7074,39 @@ func (v *ReplicationMessages) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.MaximumPageSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _SyncShardStatus_Decode(sr stream.Reader) (*SyncShardStatus, error) {
-	var v SyncShardStatus
-	err := v.Decode(sr)
-	return &v, err
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ReplicationMessages struct directly from its Thrift-level
+// Decode deserializes a ReadDLQMessagesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReplicationMessages struct could not be generated from the wire
+// An error is returned if a ReadDLQMessagesRequest struct could not be generated from the wire
 // representation.
-func (v *ReplicationMessages) Decode(sr stream.Reader) error {
+func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6902,30 +7119,48 @@ func (v *ReplicationMessages) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.ReplicationTasks, err = _List_ReplicationTask_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x DLQType
+			x, err = _DLQType_Decode(sr)
+			v.Type = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SourceCluster = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.LastRetrievedMessageId = &x
+			v.InclusiveEndMessageID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.HasMore = &x
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaximumPageSize = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.SyncShardStatus, err = _SyncShardStatus_Decode(sr)
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -6952,65 +7187,69 @@ func (v *ReplicationMessages) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationMessages
+// String returns a readable string representation of a ReadDLQMessagesRequest
 // struct.
-func (v *ReplicationMessages) String() string {
+func (v *ReadDLQMessagesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.ReplicationTasks != nil {
-		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.LastRetrievedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.HasMore != nil {
-		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.SyncShardStatus != nil {
-		fields[i] = fmt.Sprintf("SyncShardStatus: %v", v.SyncShardStatus)
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-// Equals returns true if all the fields of this ReplicationMessages match the
-// provided ReplicationMessages.
+// Equals returns true if all the fields of this ReadDLQMessagesRequest match the
+// provided ReadDLQMessagesRequest.
 //
 // This function performs a deep comparison.
-func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
+func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
 		return false
 	}
-	if !((v.SyncShardStatus == nil && rhs.SyncShardStatus == nil) || (v.SyncShardStatus != nil && rhs.SyncShardStatus != nil && v.SyncShardStatus.Equals(rhs.SyncShardStatus))) {
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
@@ -7018,98 +7257,160 @@ func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationMessages.
-func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReadDLQMessagesRequest.
+func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ReplicationTasks != nil {
-		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
 	}
-	if v.LastRetrievedMessageId != nil {
-		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.HasMore != nil {
-		enc.AddBool("hasMore", *v.HasMore)
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
 	}
-	if v.SyncShardStatus != nil {
-		err = multierr.Append(err, enc.AddObject("syncShardStatus", v.SyncShardStatus))
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
-	if v != nil && v.ReplicationTasks != nil {
-		return v.ReplicationTasks
+func (v *ReadDLQMessagesRequest) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
-func (v *ReplicationMessages) IsSetReplicationTasks() bool {
-	return v != nil && v.ReplicationTasks != nil
+// IsSetType returns true if Type is not nil.
+func (v *ReadDLQMessagesRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationMessages) GetLastRetrievedMessageId() (o int64) {
-	if v != nil && v.LastRetrievedMessageId != nil {
-		return *v.LastRetrievedMessageId
+func (v *ReadDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
-func (v *ReplicationMessages) IsSetLastRetrievedMessageId() bool {
-	return v != nil && v.LastRetrievedMessageId != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetHasMore returns the value of HasMore if it is set or its
+// GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *ReplicationMessages) GetHasMore() (o bool) {
-	if v != nil && v.HasMore != nil {
-		return *v.HasMore
+func (v *ReadDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
 	}
 
 	return
 }
 
-// IsSetHasMore returns true if HasMore is not nil.
-func (v *ReplicationMessages) IsSetHasMore() bool {
-	return v != nil && v.HasMore != nil
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *ReadDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
 }
 
-// GetSyncShardStatus returns the value of SyncShardStatus if it is set or its
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationMessages) GetSyncShardStatus() (o *SyncShardStatus) {
-	if v != nil && v.SyncShardStatus != nil {
-		return v.SyncShardStatus
+func (v *ReadDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
 	}
 
 	return
 }
 
-// IsSetSyncShardStatus returns true if SyncShardStatus is not nil.
-func (v *ReplicationMessages) IsSetSyncShardStatus() bool {
-	return v != nil && v.SyncShardStatus != nil
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
 }
 
-type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActivityTaskAttributes    *SyncActivityTaskAttributes    `json:"syncActivityTaskAttributes,omitempty"`
-	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
-	FailoverMarkerAttributes      *FailoverMarkerAttributes      `json:"failoverMarkerAttributes,omitempty"`
-	CreationTime                  *int64                         `json:"creationTime,omitempty"`
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
 }
 
-// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ReadDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReadDLQMessagesResponse struct {
+	Type                 *DLQType                   `json:"type,omitempty"`
+	ReplicationTasks     []*ReplicationTask         `json:"replicationTasks,omitempty"`
+	NextPageToken        []byte                     `json:"nextPageToken,omitempty"`
+	ReplicationTasksInfo []*ReplicationTaskInfo     `json:"replicationTasksInfo,omitempty"`
+	RetryStates          []*ReplicationDLQTaskState `json:"retryStates,omitempty"`
+}
+
+type _List_ReplicationDLQTaskState_ValueList []*ReplicationDLQTaskState
+
+func (v _List_ReplicationDLQTaskState_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationDLQTaskState', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationDLQTaskState_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationDLQTaskState_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationDLQTaskState_ValueList) Close() {}
+
+// ToWire translates a ReadDLQMessagesResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7121,120 +7422,90 @@ type ReplicationTask struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReplicationTask) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskType != nil {
-		w, err = v.TaskType.ToWire()
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.SourceTaskId != nil {
-		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 11, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		w, err = v.DomainTaskAttributes.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+	if v.ReplicationTasksInfo != nil {
+		w, err = wire.NewValueList(_List_ReplicationTaskInfo_ValueList(v.ReplicationTasksInfo)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.SyncActivityTaskAttributes != nil {
-		w, err = v.SyncActivityTaskAttributes.ToWire()
+	if v.RetryStates != nil {
+		w, err = wire.NewValueList(_List_ReplicationDLQTaskState_ValueList(v.RetryStates)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.HistoryTaskV2Attributes != nil {
-		w, err = v.HistoryTaskV2Attributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.FailoverMarkerAttributes != nil {
-		w, err = v.FailoverMarkerAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.CreationTime != nil {
-		w, err = wire.NewValueI64(*(v.CreationTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
-	var v DomainTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
-	var v SyncShardStatusTaskAttributes
+func _ReplicationDLQTaskState_Read(w wire.Value) (*ReplicationDLQTaskState, error) {
+	var v ReplicationDLQTaskState
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _SyncActivityTaskAttributes_Read(w wire.Value) (*SyncActivityTaskAttributes, error) {
-	var v SyncActivityTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+func _List_ReplicationDLQTaskState_Read(l wire.ValueList) ([]*ReplicationDLQTaskState, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
 
-func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, error) {
-	var v HistoryTaskV2Attributes
-	err := v.FromWire(w)
-	return &v, err
+	o := make([]*ReplicationDLQTaskState, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationDLQTaskState_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// FromWire deserializes a ReadDLQMessagesResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationTask struct
+// An error is returned if we were unable to build a ReadDLQMessagesResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7242,81 +7513,53 @@ func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, erro
 //     return nil, err
 //   }
 //
-//   var v ReplicationTask
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReplicationTask) FromWire(w wire.Value) error {
+//	var v ReadDLQMessagesResponse
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TI32 {
-				var x ReplicationTaskType
-				x, err = _ReplicationTaskType_Read(field.Value)
-				v.TaskType = &x
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 11:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.SourceTaskId = &x
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasksInfo, err = _List_ReplicationTaskInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncActivityTaskAttributes, err = _SyncActivityTaskAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.FailoverMarkerAttributes, err = _FailoverMarkerAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.CreationTime = &x
+			if field.Value.Type() == wire.TList {
+				v.RetryStates, err = _List_ReplicationDLQTaskState_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -7328,56 +7571,41 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReplicationTask struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a ReplicationTask struct could not be encoded.
-func (v *ReplicationTask) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
+func _List_ReplicationDLQTaskState_Encode(val []*ReplicationDLQTaskState, sw stream.Writer) error {
 
-	if v.TaskType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.TaskType.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
 	}
 
-	if v.SourceTaskId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.SourceTaskId)); err != nil {
-			return err
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationDLQTaskState', index [%v]: value is nil", i)
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
+	return sw.WriteListEnd()
+}
 
-	if v.DomainTaskAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.DomainTaskAttributes.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// Encode serializes a ReadDLQMessagesResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReadDLQMessagesResponse struct could not be encoded.
+func (v *ReadDLQMessagesResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.SyncShardStatusTaskAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.SyncShardStatusTaskAttributes.Encode(sw); err != nil {
+		if err := v.Type.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7385,11 +7613,11 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SyncActivityTaskAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+	if v.ReplicationTasks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := v.SyncActivityTaskAttributes.Encode(sw); err != nil {
+		if err := _List_ReplicationTask_Encode(v.ReplicationTasks, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7397,11 +7625,11 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HistoryTaskV2Attributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.HistoryTaskV2Attributes.Encode(sw); err != nil {
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7409,11 +7637,11 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.FailoverMarkerAttributes != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TStruct}); err != nil {
+	if v.ReplicationTasksInfo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := v.FailoverMarkerAttributes.Encode(sw); err != nil {
+		if err := _List_ReplicationTaskInfo_Encode(v.ReplicationTasksInfo, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7421,11 +7649,11 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.CreationTime != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+	if v.RetryStates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.CreationTime)); err != nil {
+		if err := _List_ReplicationDLQTaskState_Encode(v.RetryStates, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -7436,42 +7664,48 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ReplicationTaskType_Decode(sr stream.Reader) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.Decode(sr)
-	return v, err
-}
-
-func _DomainTaskAttributes_Decode(sr stream.Reader) (*DomainTaskAttributes, error) {
-	var v DomainTaskAttributes
+func _ReplicationDLQTaskState_Decode(sr stream.Reader) (*ReplicationDLQTaskState, error) {
+	var v ReplicationDLQTaskState
 	err := v.Decode(sr)
 	return &v, err
 }
 
-func _SyncShardStatusTaskAttributes_Decode(sr stream.Reader) (*SyncShardStatusTaskAttributes, error) {
-	var v SyncShardStatusTaskAttributes
-	err := v.Decode(sr)
-	return &v, err
-}
+func _List_ReplicationDLQTaskState_Decode(sr stream.Reader) ([]*ReplicationDLQTaskState, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
 
-func _SyncActivityTaskAttributes_Decode(sr stream.Reader) (*SyncActivityTaskAttributes, error) {
-	var v SyncActivityTaskAttributes
-	err := v.Decode(sr)
-	return &v, err
-}
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
 
-func _HistoryTaskV2Attributes_Decode(sr stream.Reader) (*HistoryTaskV2Attributes, error) {
-	var v HistoryTaskV2Attributes
-	err := v.Decode(sr)
-	return &v, err
+	o := make([]*ReplicationDLQTaskState, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ReplicationDLQTaskState_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a ReplicationTask struct directly from its Thrift-level
+// Decode deserializes a ReadDLQMessagesResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReplicationTask struct could not be generated from the wire
+// An error is returned if a ReadDLQMessagesResponse struct could not be generated from the wire
 // representation.
-func (v *ReplicationTask) Decode(sr stream.Reader) error {
+func (v *ReadDLQMessagesResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7485,55 +7719,33 @@ func (v *ReplicationTask) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x ReplicationTaskType
-			x, err = _ReplicationTaskType_Decode(sr)
-			v.TaskType = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 11 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.SourceTaskId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.DomainTaskAttributes, err = _DomainTaskAttributes_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Decode(sr)
+			var x DLQType
+			x, err = _DLQType_Decode(sr)
+			v.Type = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TStruct:
-			v.SyncActivityTaskAttributes, err = _SyncActivityTaskAttributes_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.ReplicationTasks, err = _List_ReplicationTask_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TStruct:
-			v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 80 && fh.Type == wire.TStruct:
-			v.FailoverMarkerAttributes, err = _FailoverMarkerAttributes_Decode(sr)
+		case fh.ID == 40 && fh.Type == wire.TList:
+			v.ReplicationTasksInfo, err = _List_ReplicationTaskInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 90 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.CreationTime = &x
+		case fh.ID == 50 && fh.Type == wire.TList:
+			v.RetryStates, err = _List_ReplicationDLQTaskState_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -7560,390 +7772,313 @@ func (v *ReplicationTask) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationTask
+// String returns a readable string representation of a ReadDLQMessagesResponse
 // struct.
-func (v *ReplicationTask) String() string {
+func (v *ReadDLQMessagesResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [5]string
 	i := 0
-	if v.TaskType != nil {
-		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.SourceTaskId != nil {
-		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+	if v.ReplicationTasksInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasksInfo: %v", v.ReplicationTasksInfo)
 		i++
 	}
-	if v.SyncActivityTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncActivityTaskAttributes: %v", v.SyncActivityTaskAttributes)
-		i++
-	}
-	if v.HistoryTaskV2Attributes != nil {
-		fields[i] = fmt.Sprintf("HistoryTaskV2Attributes: %v", v.HistoryTaskV2Attributes)
-		i++
-	}
-	if v.FailoverMarkerAttributes != nil {
-		fields[i] = fmt.Sprintf("FailoverMarkerAttributes: %v", v.FailoverMarkerAttributes)
-		i++
-	}
-	if v.CreationTime != nil {
-		fields[i] = fmt.Sprintf("CreationTime: %v", *(v.CreationTime))
+	if v.RetryStates != nil {
+		fields[i] = fmt.Sprintf("RetryStates: %v", v.RetryStates)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReadDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
-	if lhs != nil && rhs != nil {
+func _List_ReplicationDLQTaskState_Equals(lhs, rhs []*ReplicationDLQTaskState) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
-// Equals returns true if all the fields of this ReplicationTask match the
-// provided ReplicationTask.
+// Equals returns true if all the fields of this ReadDLQMessagesResponse match the
+// provided ReadDLQMessagesResponse.
 //
 // This function performs a deep comparison.
-func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
-		return false
-	}
-	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
-		return false
-	}
-	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !((v.SyncActivityTaskAttributes == nil && rhs.SyncActivityTaskAttributes == nil) || (v.SyncActivityTaskAttributes != nil && rhs.SyncActivityTaskAttributes != nil && v.SyncActivityTaskAttributes.Equals(rhs.SyncActivityTaskAttributes))) {
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
 		return false
 	}
-	if !((v.HistoryTaskV2Attributes == nil && rhs.HistoryTaskV2Attributes == nil) || (v.HistoryTaskV2Attributes != nil && rhs.HistoryTaskV2Attributes != nil && v.HistoryTaskV2Attributes.Equals(rhs.HistoryTaskV2Attributes))) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
-	if !((v.FailoverMarkerAttributes == nil && rhs.FailoverMarkerAttributes == nil) || (v.FailoverMarkerAttributes != nil && rhs.FailoverMarkerAttributes != nil && v.FailoverMarkerAttributes.Equals(rhs.FailoverMarkerAttributes))) {
+	if !((v.ReplicationTasksInfo == nil && rhs.ReplicationTasksInfo == nil) || (v.ReplicationTasksInfo != nil && rhs.ReplicationTasksInfo != nil && _List_ReplicationTaskInfo_Equals(v.ReplicationTasksInfo, rhs.ReplicationTasksInfo))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.CreationTime, rhs.CreationTime) {
+	if !((v.RetryStates == nil && rhs.RetryStates == nil) || (v.RetryStates != nil && rhs.RetryStates != nil && _List_ReplicationDLQTaskState_Equals(v.RetryStates, rhs.RetryStates))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_ReplicationDLQTaskState_Zapper []*ReplicationDLQTaskState
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationDLQTaskState_Zapper.
+func (l _List_ReplicationDLQTaskState_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTask.
-func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReadDLQMessagesResponse.
+func (v *ReadDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskType != nil {
-		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
-	}
-	if v.SourceTaskId != nil {
-		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
-	}
-	if v.DomainTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
-	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
 	}
-	if v.SyncActivityTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncActivityTaskAttributes", v.SyncActivityTaskAttributes))
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
 	}
-	if v.HistoryTaskV2Attributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyTaskV2Attributes", v.HistoryTaskV2Attributes))
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
-	if v.FailoverMarkerAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("failoverMarkerAttributes", v.FailoverMarkerAttributes))
+	if v.ReplicationTasksInfo != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasksInfo", (_List_ReplicationTaskInfo_Zapper)(v.ReplicationTasksInfo)))
 	}
-	if v.CreationTime != nil {
-		enc.AddInt64("creationTime", *v.CreationTime)
+	if v.RetryStates != nil {
+		err = multierr.Append(err, enc.AddArray("retryStates", (_List_ReplicationDLQTaskState_Zapper)(v.RetryStates)))
 	}
 	return err
 }
 
-// GetTaskType returns the value of TaskType if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
-	}
-
-	return
-}
-
-// IsSetTaskType returns true if TaskType is not nil.
-func (v *ReplicationTask) IsSetTaskType() bool {
-	return v != nil && v.TaskType != nil
-}
-
-// GetSourceTaskId returns the value of SourceTaskId if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetSourceTaskId() (o int64) {
-	if v != nil && v.SourceTaskId != nil {
-		return *v.SourceTaskId
-	}
-
-	return
-}
-
-// IsSetSourceTaskId returns true if SourceTaskId is not nil.
-func (v *ReplicationTask) IsSetSourceTaskId() bool {
-	return v != nil && v.SourceTaskId != nil
-}
-
-// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
-	if v != nil && v.DomainTaskAttributes != nil {
-		return v.DomainTaskAttributes
-	}
-
-	return
-}
-
-// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
-	return v != nil && v.DomainTaskAttributes != nil
-}
-
-// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
-	if v != nil && v.SyncShardStatusTaskAttributes != nil {
-		return v.SyncShardStatusTaskAttributes
+func (v *ReadDLQMessagesResponse) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
-	return v != nil && v.SyncShardStatusTaskAttributes != nil
+// IsSetType returns true if Type is not nil.
+func (v *ReadDLQMessagesResponse) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetSyncActivityTaskAttributes returns the value of SyncActivityTaskAttributes if it is set or its
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncActivityTaskAttributes() (o *SyncActivityTaskAttributes) {
-	if v != nil && v.SyncActivityTaskAttributes != nil {
-		return v.SyncActivityTaskAttributes
+func (v *ReadDLQMessagesResponse) GetReplicationTasks() (o []*ReplicationTask) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
 	}
 
 	return
 }
 
-// IsSetSyncActivityTaskAttributes returns true if SyncActivityTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncActivityTaskAttributes() bool {
-	return v != nil && v.SyncActivityTaskAttributes != nil
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReadDLQMessagesResponse) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
 }
 
-// GetHistoryTaskV2Attributes returns the value of HistoryTaskV2Attributes if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetHistoryTaskV2Attributes() (o *HistoryTaskV2Attributes) {
-	if v != nil && v.HistoryTaskV2Attributes != nil {
-		return v.HistoryTaskV2Attributes
+func (v *ReadDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetHistoryTaskV2Attributes returns true if HistoryTaskV2Attributes is not nil.
-func (v *ReplicationTask) IsSetHistoryTaskV2Attributes() bool {
-	return v != nil && v.HistoryTaskV2Attributes != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-// GetFailoverMarkerAttributes returns the value of FailoverMarkerAttributes if it is set or its
+// GetReplicationTasksInfo returns the value of ReplicationTasksInfo if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetFailoverMarkerAttributes() (o *FailoverMarkerAttributes) {
-	if v != nil && v.FailoverMarkerAttributes != nil {
-		return v.FailoverMarkerAttributes
+func (v *ReadDLQMessagesResponse) GetReplicationTasksInfo() (o []*ReplicationTaskInfo) {
+	if v != nil && v.ReplicationTasksInfo != nil {
+		return v.ReplicationTasksInfo
 	}
 
 	return
 }
 
-// IsSetFailoverMarkerAttributes returns true if FailoverMarkerAttributes is not nil.
-func (v *ReplicationTask) IsSetFailoverMarkerAttributes() bool {
-	return v != nil && v.FailoverMarkerAttributes != nil
+// IsSetReplicationTasksInfo returns true if ReplicationTasksInfo is not nil.
+func (v *ReadDLQMessagesResponse) IsSetReplicationTasksInfo() bool {
+	return v != nil && v.ReplicationTasksInfo != nil
 }
 
-// GetCreationTime returns the value of CreationTime if it is set or its
+// GetRetryStates returns the value of RetryStates if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetCreationTime() (o int64) {
-	if v != nil && v.CreationTime != nil {
-		return *v.CreationTime
+func (v *ReadDLQMessagesResponse) GetRetryStates() (o []*ReplicationDLQTaskState) {
+	if v != nil && v.RetryStates != nil {
+		return v.RetryStates
 	}
 
 	return
 }
 
-// IsSetCreationTime returns true if CreationTime is not nil.
-func (v *ReplicationTask) IsSetCreationTime() bool {
-	return v != nil && v.CreationTime != nil
+// IsSetRetryStates returns true if RetryStates is not nil.
+func (v *ReadDLQMessagesResponse) IsSetRetryStates() bool {
+	return v != nil && v.RetryStates != nil
 }
 
-type ReplicationTaskInfo struct {
-	DomainID     *string `json:"domainID,omitempty"`
-	WorkflowID   *string `json:"workflowID,omitempty"`
-	RunID        *string `json:"runID,omitempty"`
-	TaskType     *int16  `json:"taskType,omitempty"`
-	TaskID       *int64  `json:"taskID,omitempty"`
-	Version      *int64  `json:"version,omitempty"`
-	FirstEventID *int64  `json:"firstEventID,omitempty"`
-	NextEventID  *int64  `json:"nextEventID,omitempty"`
-	ScheduledID  *int64  `json:"scheduledID,omitempty"`
+type ReplicationDLQTaskState struct {
+	TaskID               *int64  `json:"taskID,omitempty"`
+	ErrorClass           *string `json:"errorClass,omitempty"`
+	Attempts             *int32  `json:"attempts,omitempty"`
+	LastError            *string `json:"lastError,omitempty"`
+	LastAttemptTimestamp *int64  `json:"lastAttemptTimestamp,omitempty"`
+	NextAttemptTimestamp *int64  `json:"nextAttemptTimestamp,omitempty"`
+	Poison               *bool   `json:"poison,omitempty"`
 }
 
-// ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
+// ToWire translates a ReplicationDLQTaskState struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationDLQTaskState) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainID != nil {
-		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowID != nil {
-		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+	if v.ErrorClass != nil {
+		w, err = wire.NewValueString(*(v.ErrorClass)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunID != nil {
-		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+	if v.Attempts != nil {
+		w, err = wire.NewValueI32(*(v.Attempts)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.TaskType != nil {
-		w, err = wire.NewValueI16(*(v.TaskType)), error(nil)
+	if v.LastError != nil {
+		w, err = wire.NewValueString(*(v.LastError)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+	if v.LastAttemptTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastAttemptTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.NextAttemptTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.NextAttemptTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.FirstEventID != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventID)), error(nil)
+	if v.Poison != nil {
+		w, err = wire.NewValueBool(*(v.Poison)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.NextEventID != nil {
-		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ScheduledID != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReplicationTaskInfo struct from its Thrift-level
+// FromWire deserializes a ReplicationDLQTaskState struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationTaskInfo struct
+// An error is returned if we were unable to build a ReplicationDLQTaskState struct
 // from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ReplicationTaskInfo
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationDLQTaskState
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationDLQTaskState) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainID = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
 				if err != nil {
 					return err
 				}
@@ -7953,27 +8088,27 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowID = &x
+				v.ErrorClass = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempts = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI16 {
-				var x int16
-				x, err = field.Value.GetI16(), error(nil)
-				v.TaskType = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastError = &x
 				if err != nil {
 					return err
 				}
@@ -7983,7 +8118,7 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.TaskID = &x
+				v.LastAttemptTimestamp = &x
 				if err != nil {
 					return err
 				}
@@ -7993,37 +8128,17 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+				v.NextAttemptTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledID = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Poison = &x
 				if err != nil {
 					return err
 				}
@@ -8035,20 +8150,20 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReplicationTaskInfo struct directly into bytes, without going
+// Encode serializes a ReplicationDLQTaskState struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ReplicationTaskInfo struct could not be encoded.
-func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
+// An error is returned if a ReplicationDLQTaskState struct could not be encoded.
+func (v *ReplicationDLQTaskState) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DomainID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.DomainID)); err != nil {
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8056,11 +8171,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.WorkflowID != nil {
+	if v.ErrorClass != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+		if err := sw.WriteString(*(v.ErrorClass)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8068,11 +8183,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.Attempts != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunID)); err != nil {
+		if err := sw.WriteInt32(*(v.Attempts)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8080,11 +8195,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI16}); err != nil {
+	if v.LastError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt16(*(v.TaskType)); err != nil {
+		if err := sw.WriteString(*(v.LastError)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8092,11 +8207,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskID != nil {
+	if v.LastAttemptTimestamp != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
+		if err := sw.WriteInt64(*(v.LastAttemptTimestamp)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8104,35 +8219,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Version != nil {
+	if v.NextAttemptTimestamp != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.Version)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.FirstEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.FirstEventID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.NextEventID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.NextEventID)); err != nil {
+		if err := sw.WriteInt64(*(v.NextAttemptTimestamp)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8140,11 +8231,11 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ScheduledID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+	if v.Poison != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.ScheduledID)); err != nil {
+		if err := sw.WriteBool(*(v.Poison)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8155,12 +8246,12 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ReplicationTaskInfo struct directly from its Thrift-level
+// Decode deserializes a ReplicationDLQTaskState struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReplicationTaskInfo struct could not be generated from the wire
+// An error is returned if a ReplicationDLQTaskState struct could not be generated from the wire
 // representation.
-func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
+func (v *ReplicationDLQTaskState) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8173,10 +8264,10 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainID = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
 			if err != nil {
 				return err
 			}
@@ -8184,23 +8275,23 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.WorkflowID = &x
+			v.ErrorClass = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunID = &x
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Attempts = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TI16:
-			var x int16
-			x, err = sr.ReadInt16()
-			v.TaskType = &x
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastError = &x
 			if err != nil {
 				return err
 			}
@@ -8208,7 +8299,7 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 50 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.TaskID = &x
+			v.LastAttemptTimestamp = &x
 			if err != nil {
 				return err
 			}
@@ -8216,31 +8307,15 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 60 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.Version = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.FirstEventID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.NextEventID = &x
+			v.NextAttemptTimestamp = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 90 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledID = &x
+		case fh.ID == 70 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Poison = &x
 			if err != nil {
 				return err
 			}
@@ -8267,56 +8342,48 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationTaskInfo
+// String returns a readable string representation of a ReplicationDLQTaskState
 // struct.
-func (v *ReplicationTaskInfo) String() string {
+func (v *ReplicationDLQTaskState) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [7]string
 	i := 0
-	if v.DomainID != nil {
-		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
-		i++
-	}
-	if v.WorkflowID != nil {
-		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
-		i++
-	}
-	if v.RunID != nil {
-		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
 		i++
 	}
-	if v.TaskType != nil {
-		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+	if v.ErrorClass != nil {
+		fields[i] = fmt.Sprintf("ErrorClass: %v", *(v.ErrorClass))
 		i++
 	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+	if v.Attempts != nil {
+		fields[i] = fmt.Sprintf("Attempts: %v", *(v.Attempts))
 		i++
 	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+	if v.LastError != nil {
+		fields[i] = fmt.Sprintf("LastError: %v", *(v.LastError))
 		i++
 	}
-	if v.FirstEventID != nil {
-		fields[i] = fmt.Sprintf("FirstEventID: %v", *(v.FirstEventID))
+	if v.LastAttemptTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastAttemptTimestamp: %v", *(v.LastAttemptTimestamp))
 		i++
 	}
-	if v.NextEventID != nil {
-		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
+	if v.NextAttemptTimestamp != nil {
+		fields[i] = fmt.Sprintf("NextAttemptTimestamp: %v", *(v.NextAttemptTimestamp))
 		i++
 	}
-	if v.ScheduledID != nil {
-		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
+	if v.Poison != nil {
+		fields[i] = fmt.Sprintf("Poison: %v", *(v.Poison))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicationDLQTaskState{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _I16_EqualsPtr(lhs, rhs *int16) bool {
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -8326,41 +8393,35 @@ func _I16_EqualsPtr(lhs, rhs *int16) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this ReplicationTaskInfo match the
-// provided ReplicationTaskInfo.
+// Equals returns true if all the fields of this ReplicationDLQTaskState match the
+// provided ReplicationDLQTaskState.
 //
 // This function performs a deep comparison.
-func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
+func (v *ReplicationDLQTaskState) Equals(rhs *ReplicationDLQTaskState) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
 		return false
 	}
-	if !_I16_EqualsPtr(v.TaskType, rhs.TaskType) {
+	if !_String_EqualsPtr(v.ErrorClass, rhs.ErrorClass) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+	if !_I32_EqualsPtr(v.Attempts, rhs.Attempts) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+	if !_String_EqualsPtr(v.LastError, rhs.LastError) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventID, rhs.FirstEventID) {
+	if !_I64_EqualsPtr(v.LastAttemptTimestamp, rhs.LastAttemptTimestamp) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
+	if !_I64_EqualsPtr(v.NextAttemptTimestamp, rhs.NextAttemptTimestamp) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
+	if !_Bool_EqualsPtr(v.Poison, rhs.Poison) {
 		return false
 	}
 
@@ -8368,544 +8429,282 @@ func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTaskInfo.
-func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicationDLQTaskState.
+func (v *ReplicationDLQTaskState) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainID != nil {
-		enc.AddString("domainID", *v.DomainID)
-	}
-	if v.WorkflowID != nil {
-		enc.AddString("workflowID", *v.WorkflowID)
-	}
-	if v.RunID != nil {
-		enc.AddString("runID", *v.RunID)
-	}
-	if v.TaskType != nil {
-		enc.AddInt16("taskType", *v.TaskType)
-	}
 	if v.TaskID != nil {
 		enc.AddInt64("taskID", *v.TaskID)
 	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
+	if v.ErrorClass != nil {
+		enc.AddString("errorClass", *v.ErrorClass)
 	}
-	if v.FirstEventID != nil {
-		enc.AddInt64("firstEventID", *v.FirstEventID)
+	if v.Attempts != nil {
+		enc.AddInt32("attempts", *v.Attempts)
 	}
-	if v.NextEventID != nil {
-		enc.AddInt64("nextEventID", *v.NextEventID)
+	if v.LastError != nil {
+		enc.AddString("lastError", *v.LastError)
 	}
-	if v.ScheduledID != nil {
-		enc.AddInt64("scheduledID", *v.ScheduledID)
+	if v.LastAttemptTimestamp != nil {
+		enc.AddInt64("lastAttemptTimestamp", *v.LastAttemptTimestamp)
+	}
+	if v.NextAttemptTimestamp != nil {
+		enc.AddInt64("nextAttemptTimestamp", *v.NextAttemptTimestamp)
+	}
+	if v.Poison != nil {
+		enc.AddBool("poison", *v.Poison)
 	}
 	return err
 }
 
-// GetDomainID returns the value of DomainID if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetDomainID() (o string) {
-	if v != nil && v.DomainID != nil {
-		return *v.DomainID
+func (v *ReplicationDLQTaskState) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// IsSetDomainID returns true if DomainID is not nil.
-func (v *ReplicationTaskInfo) IsSetDomainID() bool {
-	return v != nil && v.DomainID != nil
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReplicationDLQTaskState) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
 }
 
-// GetWorkflowID returns the value of WorkflowID if it is set or its
+// GetErrorClass returns the value of ErrorClass if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetWorkflowID() (o string) {
-	if v != nil && v.WorkflowID != nil {
-		return *v.WorkflowID
+func (v *ReplicationDLQTaskState) GetErrorClass() (o string) {
+	if v != nil && v.ErrorClass != nil {
+		return *v.ErrorClass
 	}
 
 	return
 }
 
-// IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *ReplicationTaskInfo) IsSetWorkflowID() bool {
-	return v != nil && v.WorkflowID != nil
+// IsSetErrorClass returns true if ErrorClass is not nil.
+func (v *ReplicationDLQTaskState) IsSetErrorClass() bool {
+	return v != nil && v.ErrorClass != nil
 }
 
-// GetRunID returns the value of RunID if it is set or its
+// GetAttempts returns the value of Attempts if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetRunID() (o string) {
-	if v != nil && v.RunID != nil {
-		return *v.RunID
+func (v *ReplicationDLQTaskState) GetAttempts() (o int32) {
+	if v != nil && v.Attempts != nil {
+		return *v.Attempts
 	}
 
 	return
 }
 
-// IsSetRunID returns true if RunID is not nil.
-func (v *ReplicationTaskInfo) IsSetRunID() bool {
-	return v != nil && v.RunID != nil
+// IsSetAttempts returns true if Attempts is not nil.
+func (v *ReplicationDLQTaskState) IsSetAttempts() bool {
+	return v != nil && v.Attempts != nil
 }
 
-// GetTaskType returns the value of TaskType if it is set or its
+// GetLastError returns the value of LastError if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetTaskType() (o int16) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
+func (v *ReplicationDLQTaskState) GetLastError() (o string) {
+	if v != nil && v.LastError != nil {
+		return *v.LastError
 	}
 
 	return
 }
 
-// IsSetTaskType returns true if TaskType is not nil.
-func (v *ReplicationTaskInfo) IsSetTaskType() bool {
-	return v != nil && v.TaskType != nil
+// IsSetLastError returns true if LastError is not nil.
+func (v *ReplicationDLQTaskState) IsSetLastError() bool {
+	return v != nil && v.LastError != nil
 }
 
-// GetTaskID returns the value of TaskID if it is set or its
+// GetLastAttemptTimestamp returns the value of LastAttemptTimestamp if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetTaskID() (o int64) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+func (v *ReplicationDLQTaskState) GetLastAttemptTimestamp() (o int64) {
+	if v != nil && v.LastAttemptTimestamp != nil {
+		return *v.LastAttemptTimestamp
 	}
 
 	return
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *ReplicationTaskInfo) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
+// IsSetLastAttemptTimestamp returns true if LastAttemptTimestamp is not nil.
+func (v *ReplicationDLQTaskState) IsSetLastAttemptTimestamp() bool {
+	return v != nil && v.LastAttemptTimestamp != nil
 }
 
-// GetVersion returns the value of Version if it is set or its
+// GetNextAttemptTimestamp returns the value of NextAttemptTimestamp if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+func (v *ReplicationDLQTaskState) GetNextAttemptTimestamp() (o int64) {
+	if v != nil && v.NextAttemptTimestamp != nil {
+		return *v.NextAttemptTimestamp
 	}
 
 	return
 }
 
-// IsSetVersion returns true if Version is not nil.
-func (v *ReplicationTaskInfo) IsSetVersion() bool {
-	return v != nil && v.Version != nil
+// IsSetNextAttemptTimestamp returns true if NextAttemptTimestamp is not nil.
+func (v *ReplicationDLQTaskState) IsSetNextAttemptTimestamp() bool {
+	return v != nil && v.NextAttemptTimestamp != nil
 }
 
-// GetFirstEventID returns the value of FirstEventID if it is set or its
+// GetPoison returns the value of Poison if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTaskInfo) GetFirstEventID() (o int64) {
-	if v != nil && v.FirstEventID != nil {
-		return *v.FirstEventID
+func (v *ReplicationDLQTaskState) GetPoison() (o bool) {
+	if v != nil && v.Poison != nil {
+		return *v.Poison
 	}
 
 	return
 }
 
-// IsSetFirstEventID returns true if FirstEventID is not nil.
-func (v *ReplicationTaskInfo) IsSetFirstEventID() bool {
-	return v != nil && v.FirstEventID != nil
+// IsSetPoison returns true if Poison is not nil.
+func (v *ReplicationDLQTaskState) IsSetPoison() bool {
+	return v != nil && v.Poison != nil
 }
 
-// GetNextEventID returns the value of NextEventID if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTaskInfo) GetNextEventID() (o int64) {
-	if v != nil && v.NextEventID != nil {
-		return *v.NextEventID
-	}
-
-	return
+type ReplicationDomainStatus struct {
+	DomainID            *string `json:"domainID,omitempty"`
+	Domain              *string `json:"domain,omitempty"`
+	Backlog             *int64  `json:"backlog,omitempty"`
+	OldestTaskTimestamp *int64  `json:"oldestTaskTimestamp,omitempty"`
+	DlqSize             *int64  `json:"dlqSize,omitempty"`
 }
 
-// IsSetNextEventID returns true if NextEventID is not nil.
-func (v *ReplicationTaskInfo) IsSetNextEventID() bool {
-	return v != nil && v.NextEventID != nil
-}
+// ToWire translates a ReplicationDomainStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationDomainStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetScheduledID returns the value of ScheduledID if it is set or its
-// zero value if it is unset.
-func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
-	if v != nil && v.ScheduledID != nil {
-		return *v.ScheduledID
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetScheduledID returns true if ScheduledID is not nil.
-func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
-	return v != nil && v.ScheduledID != nil
-}
-
-type ReplicationTaskType int32
-
-const (
-	ReplicationTaskTypeDomain          ReplicationTaskType = 0
-	ReplicationTaskTypeHistory         ReplicationTaskType = 1
-	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
-	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
-	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
-	ReplicationTaskTypeHistoryV2       ReplicationTaskType = 5
-	ReplicationTaskTypeFailoverMarker  ReplicationTaskType = 6
-)
-
-// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
-func ReplicationTaskType_Values() []ReplicationTaskType {
-	return []ReplicationTaskType{
-		ReplicationTaskTypeDomain,
-		ReplicationTaskTypeHistory,
-		ReplicationTaskTypeSyncShardStatus,
-		ReplicationTaskTypeSyncActivity,
-		ReplicationTaskTypeHistoryMetadata,
-		ReplicationTaskTypeHistoryV2,
-		ReplicationTaskTypeFailoverMarker,
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-}
-
-// UnmarshalText tries to decode ReplicationTaskType from a byte slice
-// containing its name.
-//
-//   var v ReplicationTaskType
-//   err := v.UnmarshalText([]byte("Domain"))
-func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Domain":
-		*v = ReplicationTaskTypeDomain
-		return nil
-	case "History":
-		*v = ReplicationTaskTypeHistory
-		return nil
-	case "SyncShardStatus":
-		*v = ReplicationTaskTypeSyncShardStatus
-		return nil
-	case "SyncActivity":
-		*v = ReplicationTaskTypeSyncActivity
-		return nil
-	case "HistoryMetadata":
-		*v = ReplicationTaskTypeHistoryMetadata
-		return nil
-	case "HistoryV2":
-		*v = ReplicationTaskTypeHistoryV2
-		return nil
-	case "FailoverMarker":
-		*v = ReplicationTaskTypeFailoverMarker
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+	if v.Backlog != nil {
+		w, err = wire.NewValueI64(*(v.Backlog)), error(nil)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+			return w, err
 		}
-		*v = ReplicationTaskType(val)
-		return nil
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-}
-
-// MarshalText encodes ReplicationTaskType to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v ReplicationTaskType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Domain"), nil
-	case 1:
-		return []byte("History"), nil
-	case 2:
-		return []byte("SyncShardStatus"), nil
-	case 3:
-		return []byte("SyncActivity"), nil
-	case 4:
-		return []byte("HistoryMetadata"), nil
-	case 5:
-		return []byte("HistoryV2"), nil
-	case 6:
-		return []byte("FailoverMarker"), nil
+	if v.OldestTaskTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.OldestTaskTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTaskType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Domain")
-	case 1:
-		enc.AddString("name", "History")
-	case 2:
-		enc.AddString("name", "SyncShardStatus")
-	case 3:
-		enc.AddString("name", "SyncActivity")
-	case 4:
-		enc.AddString("name", "HistoryMetadata")
-	case 5:
-		enc.AddString("name", "HistoryV2")
-	case 6:
-		enc.AddString("name", "FailoverMarker")
+	if v.DlqSize != nil {
+		w, err = wire.NewValueI64(*(v.DlqSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
 	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
-	return &v
-}
 
-// Encode encodes ReplicationTaskType directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v ReplicationTaskType
-//   return v.Encode(sWriter)
-func (v ReplicationTaskType) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// ToWire translates ReplicationTaskType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// FromWire deserializes a ReplicationDomainStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v ReplicationTaskType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes ReplicationTaskType from its Thrift-level
-// representation.
+// An error is returned if we were unable to build a ReplicationDomainStatus struct
+// from the provided intermediate representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
 //   if err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//
-//   var v ReplicationTaskType
-//   if err := v.FromWire(x); err != nil {
-//     return ReplicationTaskType(0), err
+//     return nil, err
 //   }
-//   return v, nil
-func (v *ReplicationTaskType) FromWire(w wire.Value) error {
-	*v = (ReplicationTaskType)(w.GetI32())
-	return nil
-}
-
-// Decode reads off the encoded ReplicationTaskType directly off of the wire.
 //
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v ReplicationTaskType
-//   if err := v.Decode(sReader); err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//   return v, nil
-func (v *ReplicationTaskType) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
-	}
-	*v = (ReplicationTaskType)(i)
-	return nil
-}
-
-// String returns a readable string representation of ReplicationTaskType.
-func (v ReplicationTaskType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Domain"
-	case 1:
-		return "History"
-	case 2:
-		return "SyncShardStatus"
-	case 3:
-		return "SyncActivity"
-	case 4:
-		return "HistoryMetadata"
-	case 5:
-		return "HistoryV2"
-	case 6:
-		return "FailoverMarker"
-	}
-	return fmt.Sprintf("ReplicationTaskType(%d)", w)
-}
-
-// Equals returns true if this ReplicationTaskType value matches the provided
-// value.
-func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes ReplicationTaskType into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Domain\""), nil
-	case 1:
-		return ([]byte)("\"History\""), nil
-	case 2:
-		return ([]byte)("\"SyncShardStatus\""), nil
-	case 3:
-		return ([]byte)("\"SyncActivity\""), nil
-	case 4:
-		return ([]byte)("\"HistoryMetadata\""), nil
-	case 5:
-		return ([]byte)("\"HistoryV2\""), nil
-	case 6:
-		return ([]byte)("\"FailoverMarker\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		*v = (ReplicationTaskType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
-	}
-}
-
-type ReplicationToken struct {
-	ShardID                *int32 `json:"shardID,omitempty"`
-	LastRetrievedMessageId *int64 `json:"lastRetrievedMessageId,omitempty"`
-	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
-}
-
-// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReplicationToken) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.LastRetrievedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.LastProcessedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a ReplicationToken struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ReplicationToken struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ReplicationToken
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReplicationToken) FromWire(w wire.Value) error {
-	var err error
+//	var v ReplicationDomainStatus
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationDomainStatus) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastRetrievedMessageId = &x
+				v.Backlog = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastProcessedMessageId = &x
+				v.OldestTaskTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DlqSize = &x
 				if err != nil {
 					return err
 				}
@@ -8917,20 +8716,20 @@ func (v *ReplicationToken) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ReplicationToken struct directly into bytes, without going
+// Encode serializes a ReplicationDomainStatus struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ReplicationToken struct could not be encoded.
-func (v *ReplicationToken) Encode(sw stream.Writer) error {
+// An error is returned if a ReplicationDomainStatus struct could not be encoded.
+func (v *ReplicationDomainStatus) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8938,11 +8737,11 @@ func (v *ReplicationToken) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastRetrievedMessageId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastRetrievedMessageId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8950,11 +8749,35 @@ func (v *ReplicationToken) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastProcessedMessageId != nil {
+	if v.Backlog != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.LastProcessedMessageId)); err != nil {
+		if err := sw.WriteInt64(*(v.Backlog)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OldestTaskTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.OldestTaskTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DlqSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DlqSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -8965,12 +8788,12 @@ func (v *ReplicationToken) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ReplicationToken struct directly from its Thrift-level
+// Decode deserializes a ReplicationDomainStatus struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ReplicationToken struct could not be generated from the wire
+// An error is returned if a ReplicationDomainStatus struct could not be generated from the wire
 // representation.
-func (v *ReplicationToken) Decode(sr stream.Reader) error {
+func (v *ReplicationDomainStatus) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8983,26 +8806,42 @@ func (v *ReplicationToken) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.LastRetrievedMessageId = &x
+			v.Backlog = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI64:
+		case fh.ID == 40 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.LastProcessedMessageId = &x
+			v.OldestTaskTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DlqSize = &x
 			if err != nil {
 				return err
 			}
@@ -9029,48 +8868,62 @@ func (v *ReplicationToken) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationToken
+// String returns a readable string representation of a ReplicationDomainStatus
 // struct.
-func (v *ReplicationToken) String() string {
+func (v *ReplicationDomainStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
 		i++
 	}
-	if v.LastRetrievedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.LastProcessedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+	if v.Backlog != nil {
+		fields[i] = fmt.Sprintf("Backlog: %v", *(v.Backlog))
+		i++
+	}
+	if v.OldestTaskTimestamp != nil {
+		fields[i] = fmt.Sprintf("OldestTaskTimestamp: %v", *(v.OldestTaskTimestamp))
+		i++
+	}
+	if v.DlqSize != nil {
+		fields[i] = fmt.Sprintf("DlqSize: %v", *(v.DlqSize))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicationDomainStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicationToken match the
-// provided ReplicationToken.
+// Equals returns true if all the fields of this ReplicationDomainStatus match the
+// provided ReplicationDomainStatus.
 //
 // This function performs a deep comparison.
-func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+func (v *ReplicationDomainStatus) Equals(rhs *ReplicationDomainStatus) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+	if !_I64_EqualsPtr(v.Backlog, rhs.Backlog) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.OldestTaskTimestamp, rhs.OldestTaskTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DlqSize, rhs.DlqSize) {
 		return false
 	}
 
@@ -9078,87 +8931,112 @@ func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationToken.
-func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicationDomainStatus.
+func (v *ReplicationDomainStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
 	}
-	if v.LastRetrievedMessageId != nil {
-		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.LastProcessedMessageId != nil {
-		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	if v.Backlog != nil {
+		enc.AddInt64("backlog", *v.Backlog)
+	}
+	if v.OldestTaskTimestamp != nil {
+		enc.AddInt64("oldestTaskTimestamp", *v.OldestTaskTimestamp)
+	}
+	if v.DlqSize != nil {
+		enc.AddInt64("dlqSize", *v.DlqSize)
 	}
 	return err
 }
 
-// GetShardID returns the value of ShardID if it is set or its
+// GetDomainID returns the value of DomainID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
+func (v *ReplicationDomainStatus) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
 	}
 
 	return
 }
 
-// IsSetShardID returns true if ShardID is not nil.
-func (v *ReplicationToken) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ReplicationDomainStatus) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
 }
 
-// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetLastRetrievedMessageId() (o int64) {
-	if v != nil && v.LastRetrievedMessageId != nil {
-		return *v.LastRetrievedMessageId
+func (v *ReplicationDomainStatus) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
-func (v *ReplicationToken) IsSetLastRetrievedMessageId() bool {
-	return v != nil && v.LastRetrievedMessageId != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ReplicationDomainStatus) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// GetBacklog returns the value of Backlog if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
-	if v != nil && v.LastProcessedMessageId != nil {
-		return *v.LastProcessedMessageId
+func (v *ReplicationDomainStatus) GetBacklog() (o int64) {
+	if v != nil && v.Backlog != nil {
+		return *v.Backlog
 	}
 
 	return
 }
 
-// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
-func (v *ReplicationToken) IsSetLastProcessedMessageId() bool {
-	return v != nil && v.LastProcessedMessageId != nil
+// IsSetBacklog returns true if Backlog is not nil.
+func (v *ReplicationDomainStatus) IsSetBacklog() bool {
+	return v != nil && v.Backlog != nil
 }
 
-type SyncActivityTaskAttributes struct {
-	DomainId           *string                `json:"domainId,omitempty"`
-	WorkflowId         *string                `json:"workflowId,omitempty"`
-	RunId              *string                `json:"runId,omitempty"`
-	Version            *int64                 `json:"version,omitempty"`
-	ScheduledId        *int64                 `json:"scheduledId,omitempty"`
-	ScheduledTime      *int64                 `json:"scheduledTime,omitempty"`
-	StartedId          *int64                 `json:"startedId,omitempty"`
-	StartedTime        *int64                 `json:"startedTime,omitempty"`
-	LastHeartbeatTime  *int64                 `json:"lastHeartbeatTime,omitempty"`
-	Details            []byte                 `json:"details,omitempty"`
-	Attempt            *int32                 `json:"attempt,omitempty"`
-	LastFailureReason  *string                `json:"lastFailureReason,omitempty"`
-	LastWorkerIdentity *string                `json:"lastWorkerIdentity,omitempty"`
-	LastFailureDetails []byte                 `json:"lastFailureDetails,omitempty"`
-	VersionHistory     *shared.VersionHistory `json:"versionHistory,omitempty"`
+// GetOldestTaskTimestamp returns the value of OldestTaskTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ReplicationDomainStatus) GetOldestTaskTimestamp() (o int64) {
+	if v != nil && v.OldestTaskTimestamp != nil {
+		return *v.OldestTaskTimestamp
+	}
+
+	return
 }
 
-// ToWire translates a SyncActivityTaskAttributes struct into a Thrift-level intermediate
+// IsSetOldestTaskTimestamp returns true if OldestTaskTimestamp is not nil.
+func (v *ReplicationDomainStatus) IsSetOldestTaskTimestamp() bool {
+	return v != nil && v.OldestTaskTimestamp != nil
+}
+
+// GetDlqSize returns the value of DlqSize if it is set or its
+// zero value if it is unset.
+func (v *ReplicationDomainStatus) GetDlqSize() (o int64) {
+	if v != nil && v.DlqSize != nil {
+		return *v.DlqSize
+	}
+
+	return
+}
+
+// IsSetDlqSize returns true if DlqSize is not nil.
+func (v *ReplicationDomainStatus) IsSetDlqSize() bool {
+	return v != nil && v.DlqSize != nil
+}
+
+type ReplicationMessages struct {
+	ReplicationTasks       []*ReplicationTask `json:"replicationTasks,omitempty"`
+	LastRetrievedMessageId *int64             `json:"lastRetrievedMessageId,omitempty"`
+	HasMore                *bool              `json:"hasMore,omitempty"`
+	SyncShardStatus        *SyncShardStatus   `json:"syncShardStatus,omitempty"`
+}
+
+// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9170,152 +9048,5988 @@ type SyncActivityTaskAttributes struct {
 //     return err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationMessages) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+	if v.SyncShardStatus != nil {
+		w, err = v.SyncShardStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ScheduledId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledId)), error(nil)
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SyncShardStatus_Read(w wire.Value) (*SyncShardStatus, error) {
+	var v SyncShardStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationMessages struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationMessages struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//	var v ReplicationMessages
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationMessages) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatus, err = _SyncShardStatus_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
 	}
-	if v.ScheduledTime != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTime)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
+
+	return nil
+}
+
+// Encode serializes a ReplicationMessages struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationMessages struct could not be encoded.
+func (v *ReplicationMessages) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	if v.StartedId != nil {
-		w, err = wire.NewValueI64(*(v.StartedId)), error(nil)
-		if err != nil {
-			return w, err
+
+	if v.ReplicationTasks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.StartedTime != nil {
-		w, err = wire.NewValueI64(*(v.StartedTime)), error(nil)
-		if err != nil {
-			return w, err
+		if err := _List_ReplicationTask_Encode(v.ReplicationTasks, sw); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.LastHeartbeatTime != nil {
-		w, err = wire.NewValueI64(*(v.LastHeartbeatTime)), error(nil)
-		if err != nil {
-			return w, err
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
+
+	if v.LastRetrievedMessageId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastRetrievedMessageId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HasMore != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.HasMore)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SyncShardStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SyncShardStatus.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _SyncShardStatus_Decode(sr stream.Reader) (*SyncShardStatus, error) {
+	var v SyncShardStatus
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a ReplicationMessages struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationMessages struct could not be generated from the wire
+// representation.
+func (v *ReplicationMessages) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.ReplicationTasks, err = _List_ReplicationTask_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastRetrievedMessageId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.HasMore = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.SyncShardStatus, err = _SyncShardStatus_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationMessages
+// struct.
+func (v *ReplicationMessages) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+	if v.SyncShardStatus != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatus: %v", v.SyncShardStatus)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationMessages match the
+// provided ReplicationMessages.
+//
+// This function performs a deep comparison.
+func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+	if !((v.SyncShardStatus == nil && rhs.SyncShardStatus == nil) || (v.SyncShardStatus != nil && rhs.SyncShardStatus != nil && v.SyncShardStatus.Equals(rhs.SyncShardStatus))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationMessages.
+func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	}
+	if v.LastRetrievedMessageId != nil {
+		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
+	}
+	if v.SyncShardStatus != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatus", v.SyncShardStatus))
+	}
+	return err
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReplicationMessages) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetLastRetrievedMessageId() (o int64) {
+	if v != nil && v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
+func (v *ReplicationMessages) IsSetLastRetrievedMessageId() bool {
+	return v != nil && v.LastRetrievedMessageId != nil
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetHasMore() (o bool) {
+	if v != nil && v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// IsSetHasMore returns true if HasMore is not nil.
+func (v *ReplicationMessages) IsSetHasMore() bool {
+	return v != nil && v.HasMore != nil
+}
+
+// GetSyncShardStatus returns the value of SyncShardStatus if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetSyncShardStatus() (o *SyncShardStatus) {
+	if v != nil && v.SyncShardStatus != nil {
+		return v.SyncShardStatus
+	}
+
+	return
+}
+
+// IsSetSyncShardStatus returns true if SyncShardStatus is not nil.
+func (v *ReplicationMessages) IsSetSyncShardStatus() bool {
+	return v != nil && v.SyncShardStatus != nil
+}
+
+type ReplicationProcessorState struct {
+	ReplicatedUpToTimestamp *int64                             `json:"replicatedUpToTimestamp,omitempty"`
+	FailuresByDomain        map[string]*ReplicationTaskFailure `json:"failuresByDomain,omitempty"`
+}
+
+type _Map_String_ReplicationTaskFailure_MapItemList map[string]*ReplicationTaskFailure
+
+func (m _Map_String_ReplicationTaskFailure_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*ReplicationTaskFailure', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationTaskFailure_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationTaskFailure_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationTaskFailure_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationTaskFailure_MapItemList) Close() {}
+
+// ToWire translates a ReplicationProcessorState struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationProcessorState) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicatedUpToTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ReplicatedUpToTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.FailuresByDomain != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationTaskFailure_MapItemList(v.FailuresByDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskFailure_Read(w wire.Value) (*ReplicationTaskFailure, error) {
+	var v ReplicationTaskFailure
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationTaskFailure_Read(m wire.MapItemList) (map[string]*ReplicationTaskFailure, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*ReplicationTaskFailure, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationTaskFailure_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReplicationProcessorState struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationProcessorState struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationProcessorState
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationProcessorState) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicatedUpToTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TMap {
+				v.FailuresByDomain, err = _Map_String_ReplicationTaskFailure_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_String_ReplicationTaskFailure_Encode(val map[string]*ReplicationTaskFailure, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*ReplicationTaskFailure', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a ReplicationProcessorState struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationProcessorState struct could not be encoded.
+func (v *ReplicationProcessorState) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ReplicatedUpToTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ReplicatedUpToTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailuresByDomain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_ReplicationTaskFailure_Encode(v.FailuresByDomain, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationTaskFailure_Decode(sr stream.Reader) (*ReplicationTaskFailure, error) {
+	var v ReplicationTaskFailure
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_ReplicationTaskFailure_Decode(sr stream.Reader) (map[string]*ReplicationTaskFailure, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*ReplicationTaskFailure, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _ReplicationTaskFailure_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ReplicationProcessorState struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationProcessorState struct could not be generated from the wire
+// representation.
+func (v *ReplicationProcessorState) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ReplicatedUpToTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TMap:
+			v.FailuresByDomain, err = _Map_String_ReplicationTaskFailure_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationProcessorState
+// struct.
+func (v *ReplicationProcessorState) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ReplicatedUpToTimestamp != nil {
+		fields[i] = fmt.Sprintf("ReplicatedUpToTimestamp: %v", *(v.ReplicatedUpToTimestamp))
+		i++
+	}
+	if v.FailuresByDomain != nil {
+		fields[i] = fmt.Sprintf("FailuresByDomain: %v", v.FailuresByDomain)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationProcessorState{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_ReplicationTaskFailure_Equals(lhs, rhs map[string]*ReplicationTaskFailure) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationProcessorState match the
+// provided ReplicationProcessorState.
+//
+// This function performs a deep comparison.
+func (v *ReplicationProcessorState) Equals(rhs *ReplicationProcessorState) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReplicatedUpToTimestamp, rhs.ReplicatedUpToTimestamp) {
+		return false
+	}
+	if !((v.FailuresByDomain == nil && rhs.FailuresByDomain == nil) || (v.FailuresByDomain != nil && rhs.FailuresByDomain != nil && _Map_String_ReplicationTaskFailure_Equals(v.FailuresByDomain, rhs.FailuresByDomain))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_ReplicationTaskFailure_Zapper map[string]*ReplicationTaskFailure
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationTaskFailure_Zapper.
+func (m _Map_String_ReplicationTaskFailure_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationProcessorState.
+func (v *ReplicationProcessorState) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicatedUpToTimestamp != nil {
+		enc.AddInt64("replicatedUpToTimestamp", *v.ReplicatedUpToTimestamp)
+	}
+	if v.FailuresByDomain != nil {
+		err = multierr.Append(err, enc.AddObject("failuresByDomain", (_Map_String_ReplicationTaskFailure_Zapper)(v.FailuresByDomain)))
+	}
+	return err
+}
+
+// GetReplicatedUpToTimestamp returns the value of ReplicatedUpToTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ReplicationProcessorState) GetReplicatedUpToTimestamp() (o int64) {
+	if v != nil && v.ReplicatedUpToTimestamp != nil {
+		return *v.ReplicatedUpToTimestamp
+	}
+
+	return
+}
+
+// IsSetReplicatedUpToTimestamp returns true if ReplicatedUpToTimestamp is not nil.
+func (v *ReplicationProcessorState) IsSetReplicatedUpToTimestamp() bool {
+	return v != nil && v.ReplicatedUpToTimestamp != nil
+}
+
+// GetFailuresByDomain returns the value of FailuresByDomain if it is set or its
+// zero value if it is unset.
+func (v *ReplicationProcessorState) GetFailuresByDomain() (o map[string]*ReplicationTaskFailure) {
+	if v != nil && v.FailuresByDomain != nil {
+		return v.FailuresByDomain
+	}
+
+	return
+}
+
+// IsSetFailuresByDomain returns true if FailuresByDomain is not nil.
+func (v *ReplicationProcessorState) IsSetFailuresByDomain() bool {
+	return v != nil && v.FailuresByDomain != nil
+}
+
+type ReplicationShardReadiness struct {
+	ShardID         *int32                     `json:"shardID,omitempty"`
+	ProcessorState  *ReplicationProcessorState `json:"processorState,omitempty"`
+	DlqSizeByDomain map[string]int64           `json:"dlqSizeByDomain,omitempty"`
+}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+// ToWire translates a ReplicationShardReadiness struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationShardReadiness) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ProcessorState != nil {
+		w, err = v.ProcessorState.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DlqSizeByDomain != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.DlqSizeByDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationProcessorState_Read(w wire.Value) (*ReplicationProcessorState, error) {
+	var v ReplicationProcessorState
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReplicationShardReadiness struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationShardReadiness struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationShardReadiness
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationShardReadiness) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ProcessorState, err = _ReplicationProcessorState_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.DlqSizeByDomain, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_String_I64_Encode(val map[string]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a ReplicationShardReadiness struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationShardReadiness struct could not be encoded.
+func (v *ReplicationShardReadiness) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ProcessorState != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ProcessorState.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DlqSizeByDomain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.DlqSizeByDomain, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationProcessorState_Decode(sr stream.Reader) (*ReplicationProcessorState, error) {
+	var v ReplicationProcessorState
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_I64_Decode(sr stream.Reader) (map[string]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ReplicationShardReadiness struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationShardReadiness struct could not be generated from the wire
+// representation.
+func (v *ReplicationShardReadiness) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.ProcessorState, err = _ReplicationProcessorState_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TMap:
+			v.DlqSizeByDomain, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationShardReadiness
+// struct.
+func (v *ReplicationShardReadiness) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.ProcessorState != nil {
+		fields[i] = fmt.Sprintf("ProcessorState: %v", v.ProcessorState)
+		i++
+	}
+	if v.DlqSizeByDomain != nil {
+		fields[i] = fmt.Sprintf("DlqSizeByDomain: %v", v.DlqSizeByDomain)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationShardReadiness{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationShardReadiness match the
+// provided ReplicationShardReadiness.
+//
+// This function performs a deep comparison.
+func (v *ReplicationShardReadiness) Equals(rhs *ReplicationShardReadiness) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !((v.ProcessorState == nil && rhs.ProcessorState == nil) || (v.ProcessorState != nil && rhs.ProcessorState != nil && v.ProcessorState.Equals(rhs.ProcessorState))) {
+		return false
+	}
+	if !((v.DlqSizeByDomain == nil && rhs.DlqSizeByDomain == nil) || (v.DlqSizeByDomain != nil && rhs.DlqSizeByDomain != nil && _Map_String_I64_Equals(v.DlqSizeByDomain, rhs.DlqSizeByDomain))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_I64_Zapper map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationShardReadiness.
+func (v *ReplicationShardReadiness) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.ProcessorState != nil {
+		err = multierr.Append(err, enc.AddObject("processorState", v.ProcessorState))
+	}
+	if v.DlqSizeByDomain != nil {
+		err = multierr.Append(err, enc.AddObject("dlqSizeByDomain", (_Map_String_I64_Zapper)(v.DlqSizeByDomain)))
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardReadiness) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReplicationShardReadiness) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetProcessorState returns the value of ProcessorState if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardReadiness) GetProcessorState() (o *ReplicationProcessorState) {
+	if v != nil && v.ProcessorState != nil {
+		return v.ProcessorState
+	}
+
+	return
+}
+
+// IsSetProcessorState returns true if ProcessorState is not nil.
+func (v *ReplicationShardReadiness) IsSetProcessorState() bool {
+	return v != nil && v.ProcessorState != nil
+}
+
+// GetDlqSizeByDomain returns the value of DlqSizeByDomain if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardReadiness) GetDlqSizeByDomain() (o map[string]int64) {
+	if v != nil && v.DlqSizeByDomain != nil {
+		return v.DlqSizeByDomain
+	}
+
+	return
+}
+
+// IsSetDlqSizeByDomain returns true if DlqSizeByDomain is not nil.
+func (v *ReplicationShardReadiness) IsSetDlqSizeByDomain() bool {
+	return v != nil && v.DlqSizeByDomain != nil
+}
+
+type ReplicationShardStatus struct {
+	ShardID                 *int32                     `json:"shardID,omitempty"`
+	AckLevel                *int64                     `json:"ackLevel,omitempty"`
+	LastTaskID              *int64                     `json:"lastTaskID,omitempty"`
+	ReplicatedUpToTimestamp *int64                     `json:"replicatedUpToTimestamp,omitempty"`
+	Domains                 []*ReplicationDomainStatus `json:"domains,omitempty"`
+	Truncated               *bool                      `json:"truncated,omitempty"`
+}
+
+type _List_ReplicationDomainStatus_ValueList []*ReplicationDomainStatus
+
+func (v _List_ReplicationDomainStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationDomainStatus', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationDomainStatus_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationDomainStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationDomainStatus_ValueList) Close() {}
+
+// ToWire translates a ReplicationShardStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationShardStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.AckLevel != nil {
+		w, err = wire.NewValueI64(*(v.AckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastTaskID != nil {
+		w, err = wire.NewValueI64(*(v.LastTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ReplicatedUpToTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ReplicatedUpToTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Domains != nil {
+		w, err = wire.NewValueList(_List_ReplicationDomainStatus_ValueList(v.Domains)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Truncated != nil {
+		w, err = wire.NewValueBool(*(v.Truncated)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationDomainStatus_Read(w wire.Value) (*ReplicationDomainStatus, error) {
+	var v ReplicationDomainStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationDomainStatus_Read(l wire.ValueList) ([]*ReplicationDomainStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationDomainStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationDomainStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReplicationShardStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationShardStatus struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationShardStatus
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationShardStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicatedUpToTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.Domains, err = _List_ReplicationDomainStatus_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Truncated = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_ReplicationDomainStatus_Encode(val []*ReplicationDomainStatus, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationDomainStatus', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ReplicationShardStatus struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationShardStatus struct could not be encoded.
+func (v *ReplicationShardStatus) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AckLevel != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.AckLevel)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastTaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastTaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicatedUpToTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ReplicatedUpToTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Domains != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ReplicationDomainStatus_Encode(v.Domains, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Truncated != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Truncated)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationDomainStatus_Decode(sr stream.Reader) (*ReplicationDomainStatus, error) {
+	var v ReplicationDomainStatus
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ReplicationDomainStatus_Decode(sr stream.Reader) ([]*ReplicationDomainStatus, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ReplicationDomainStatus, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ReplicationDomainStatus_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ReplicationShardStatus struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationShardStatus struct could not be generated from the wire
+// representation.
+func (v *ReplicationShardStatus) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.AckLevel = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastTaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ReplicatedUpToTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TList:
+			v.Domains, err = _List_ReplicationDomainStatus_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Truncated = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationShardStatus
+// struct.
+func (v *ReplicationShardStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.AckLevel != nil {
+		fields[i] = fmt.Sprintf("AckLevel: %v", *(v.AckLevel))
+		i++
+	}
+	if v.LastTaskID != nil {
+		fields[i] = fmt.Sprintf("LastTaskID: %v", *(v.LastTaskID))
+		i++
+	}
+	if v.ReplicatedUpToTimestamp != nil {
+		fields[i] = fmt.Sprintf("ReplicatedUpToTimestamp: %v", *(v.ReplicatedUpToTimestamp))
+		i++
+	}
+	if v.Domains != nil {
+		fields[i] = fmt.Sprintf("Domains: %v", v.Domains)
+		i++
+	}
+	if v.Truncated != nil {
+		fields[i] = fmt.Sprintf("Truncated: %v", *(v.Truncated))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationShardStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationDomainStatus_Equals(lhs, rhs []*ReplicationDomainStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationShardStatus match the
+// provided ReplicationShardStatus.
+//
+// This function performs a deep comparison.
+func (v *ReplicationShardStatus) Equals(rhs *ReplicationShardStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AckLevel, rhs.AckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastTaskID, rhs.LastTaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReplicatedUpToTimestamp, rhs.ReplicatedUpToTimestamp) {
+		return false
+	}
+	if !((v.Domains == nil && rhs.Domains == nil) || (v.Domains != nil && rhs.Domains != nil && _List_ReplicationDomainStatus_Equals(v.Domains, rhs.Domains))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Truncated, rhs.Truncated) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationDomainStatus_Zapper []*ReplicationDomainStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationDomainStatus_Zapper.
+func (l _List_ReplicationDomainStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationShardStatus.
+func (v *ReplicationShardStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.AckLevel != nil {
+		enc.AddInt64("ackLevel", *v.AckLevel)
+	}
+	if v.LastTaskID != nil {
+		enc.AddInt64("lastTaskID", *v.LastTaskID)
+	}
+	if v.ReplicatedUpToTimestamp != nil {
+		enc.AddInt64("replicatedUpToTimestamp", *v.ReplicatedUpToTimestamp)
+	}
+	if v.Domains != nil {
+		err = multierr.Append(err, enc.AddArray("domains", (_List_ReplicationDomainStatus_Zapper)(v.Domains)))
+	}
+	if v.Truncated != nil {
+		enc.AddBool("truncated", *v.Truncated)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReplicationShardStatus) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetAckLevel returns the value of AckLevel if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetAckLevel() (o int64) {
+	if v != nil && v.AckLevel != nil {
+		return *v.AckLevel
+	}
+
+	return
+}
+
+// IsSetAckLevel returns true if AckLevel is not nil.
+func (v *ReplicationShardStatus) IsSetAckLevel() bool {
+	return v != nil && v.AckLevel != nil
+}
+
+// GetLastTaskID returns the value of LastTaskID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetLastTaskID() (o int64) {
+	if v != nil && v.LastTaskID != nil {
+		return *v.LastTaskID
+	}
+
+	return
+}
+
+// IsSetLastTaskID returns true if LastTaskID is not nil.
+func (v *ReplicationShardStatus) IsSetLastTaskID() bool {
+	return v != nil && v.LastTaskID != nil
+}
+
+// GetReplicatedUpToTimestamp returns the value of ReplicatedUpToTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetReplicatedUpToTimestamp() (o int64) {
+	if v != nil && v.ReplicatedUpToTimestamp != nil {
+		return *v.ReplicatedUpToTimestamp
+	}
+
+	return
+}
+
+// IsSetReplicatedUpToTimestamp returns true if ReplicatedUpToTimestamp is not nil.
+func (v *ReplicationShardStatus) IsSetReplicatedUpToTimestamp() bool {
+	return v != nil && v.ReplicatedUpToTimestamp != nil
+}
+
+// GetDomains returns the value of Domains if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetDomains() (o []*ReplicationDomainStatus) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
+	}
+
+	return
+}
+
+// IsSetDomains returns true if Domains is not nil.
+func (v *ReplicationShardStatus) IsSetDomains() bool {
+	return v != nil && v.Domains != nil
+}
+
+// GetTruncated returns the value of Truncated if it is set or its
+// zero value if it is unset.
+func (v *ReplicationShardStatus) GetTruncated() (o bool) {
+	if v != nil && v.Truncated != nil {
+		return *v.Truncated
+	}
+
+	return
+}
+
+// IsSetTruncated returns true if Truncated is not nil.
+func (v *ReplicationShardStatus) IsSetTruncated() bool {
+	return v != nil && v.Truncated != nil
+}
+
+type ReplicationTask struct {
+	TaskType                              *ReplicationTaskType                   `json:"taskType,omitempty"`
+	SourceTaskId                          *int64                                 `json:"sourceTaskId,omitempty"`
+	DomainTaskAttributes                  *DomainTaskAttributes                  `json:"domainTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes         *SyncShardStatusTaskAttributes         `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActivityTaskAttributes            *SyncActivityTaskAttributes            `json:"syncActivityTaskAttributes,omitempty"`
+	HistoryTaskV2Attributes               *HistoryTaskV2Attributes               `json:"historyTaskV2Attributes,omitempty"`
+	FailoverMarkerAttributes              *FailoverMarkerAttributes              `json:"failoverMarkerAttributes,omitempty"`
+	CreationTime                          *int64                                 `json:"creationTime,omitempty"`
+	FrameAttributes                       *ReplicationTaskFrameAttributes        `json:"frameAttributes,omitempty"`
+	SyncCompletionCallbacksTaskAttributes *SyncCompletionCallbacksTaskAttributes `json:"syncCompletionCallbacksTaskAttributes,omitempty"`
+}
+
+// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationTask) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceTaskId != nil {
+		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		w, err = v.DomainTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SyncActivityTaskAttributes != nil {
+		w, err = v.SyncActivityTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		w, err = v.HistoryTaskV2Attributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.FailoverMarkerAttributes != nil {
+		w, err = v.FailoverMarkerAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.CreationTime != nil {
+		w, err = wire.NewValueI64(*(v.CreationTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FrameAttributes != nil {
+		w, err = v.FrameAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.SyncCompletionCallbacksTaskAttributes != nil {
+		w, err = v.SyncCompletionCallbacksTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
+	var v DomainTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
+	var v SyncShardStatusTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncActivityTaskAttributes_Read(w wire.Value) (*SyncActivityTaskAttributes, error) {
+	var v SyncActivityTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, error) {
+	var v HistoryTaskV2Attributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ReplicationTaskFrameAttributes_Read(w wire.Value) (*ReplicationTaskFrameAttributes, error) {
+	var v ReplicationTaskFrameAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncCompletionCallbacksTaskAttributes_Read(w wire.Value) (*SyncCompletionCallbacksTaskAttributes, error) {
+	var v SyncCompletionCallbacksTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTask struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationTask
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationTask) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SourceTaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncActivityTaskAttributes, err = _SyncActivityTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.FailoverMarkerAttributes, err = _FailoverMarkerAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreationTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.FrameAttributes, err = _ReplicationTaskFrameAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncCompletionCallbacksTaskAttributes, err = _SyncCompletionCallbacksTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReplicationTask struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationTask struct could not be encoded.
+func (v *ReplicationTask) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TaskType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.TaskType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SourceTaskId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 11, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.SourceTaskId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SyncShardStatusTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SyncShardStatusTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SyncActivityTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SyncActivityTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryTaskV2Attributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.HistoryTaskV2Attributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailoverMarkerAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.FailoverMarkerAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CreationTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CreationTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FrameAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.FrameAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SyncCompletionCallbacksTaskAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.SyncCompletionCallbacksTaskAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationTaskType_Decode(sr stream.Reader) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _DomainTaskAttributes_Decode(sr stream.Reader) (*DomainTaskAttributes, error) {
+	var v DomainTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _SyncShardStatusTaskAttributes_Decode(sr stream.Reader) (*SyncShardStatusTaskAttributes, error) {
+	var v SyncShardStatusTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _SyncActivityTaskAttributes_Decode(sr stream.Reader) (*SyncActivityTaskAttributes, error) {
+	var v SyncActivityTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _HistoryTaskV2Attributes_Decode(sr stream.Reader) (*HistoryTaskV2Attributes, error) {
+	var v HistoryTaskV2Attributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ReplicationTaskFrameAttributes_Decode(sr stream.Reader) (*ReplicationTaskFrameAttributes, error) {
+	var v ReplicationTaskFrameAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _SyncCompletionCallbacksTaskAttributes_Decode(sr stream.Reader) (*SyncCompletionCallbacksTaskAttributes, error) {
+	var v SyncCompletionCallbacksTaskAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a ReplicationTask struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationTask struct could not be generated from the wire
+// representation.
+func (v *ReplicationTask) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x ReplicationTaskType
+			x, err = _ReplicationTaskType_Decode(sr)
+			v.TaskType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 11 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.SourceTaskId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.DomainTaskAttributes, err = _DomainTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.SyncActivityTaskAttributes, err = _SyncActivityTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TStruct:
+			v.FailoverMarkerAttributes, err = _FailoverMarkerAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CreationTime = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TStruct:
+			v.FrameAttributes, err = _ReplicationTaskFrameAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TStruct:
+			v.SyncCompletionCallbacksTaskAttributes, err = _SyncCompletionCallbacksTaskAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTask
+// struct.
+func (v *ReplicationTask) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.SourceTaskId != nil {
+		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+		i++
+	}
+	if v.SyncActivityTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncActivityTaskAttributes: %v", v.SyncActivityTaskAttributes)
+		i++
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		fields[i] = fmt.Sprintf("HistoryTaskV2Attributes: %v", v.HistoryTaskV2Attributes)
+		i++
+	}
+	if v.FailoverMarkerAttributes != nil {
+		fields[i] = fmt.Sprintf("FailoverMarkerAttributes: %v", v.FailoverMarkerAttributes)
+		i++
+	}
+	if v.CreationTime != nil {
+		fields[i] = fmt.Sprintf("CreationTime: %v", *(v.CreationTime))
+		i++
+	}
+	if v.FrameAttributes != nil {
+		fields[i] = fmt.Sprintf("FrameAttributes: %v", v.FrameAttributes)
+		i++
+	}
+	if v.SyncCompletionCallbacksTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncCompletionCallbacksTaskAttributes: %v", v.SyncCompletionCallbacksTaskAttributes)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTask match the
+// provided ReplicationTask.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
+		return false
+	}
+	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncActivityTaskAttributes == nil && rhs.SyncActivityTaskAttributes == nil) || (v.SyncActivityTaskAttributes != nil && rhs.SyncActivityTaskAttributes != nil && v.SyncActivityTaskAttributes.Equals(rhs.SyncActivityTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryTaskV2Attributes == nil && rhs.HistoryTaskV2Attributes == nil) || (v.HistoryTaskV2Attributes != nil && rhs.HistoryTaskV2Attributes != nil && v.HistoryTaskV2Attributes.Equals(rhs.HistoryTaskV2Attributes))) {
+		return false
+	}
+	if !((v.FailoverMarkerAttributes == nil && rhs.FailoverMarkerAttributes == nil) || (v.FailoverMarkerAttributes != nil && rhs.FailoverMarkerAttributes != nil && v.FailoverMarkerAttributes.Equals(rhs.FailoverMarkerAttributes))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CreationTime, rhs.CreationTime) {
+		return false
+	}
+	if !((v.FrameAttributes == nil && rhs.FrameAttributes == nil) || (v.FrameAttributes != nil && rhs.FrameAttributes != nil && v.FrameAttributes.Equals(rhs.FrameAttributes))) {
+		return false
+	}
+	if !((v.SyncCompletionCallbacksTaskAttributes == nil && rhs.SyncCompletionCallbacksTaskAttributes == nil) || (v.SyncCompletionCallbacksTaskAttributes != nil && rhs.SyncCompletionCallbacksTaskAttributes != nil && v.SyncCompletionCallbacksTaskAttributes.Equals(rhs.SyncCompletionCallbacksTaskAttributes))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTask.
+func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.SourceTaskId != nil {
+		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
+	}
+	if v.DomainTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	}
+	if v.SyncActivityTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncActivityTaskAttributes", v.SyncActivityTaskAttributes))
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyTaskV2Attributes", v.HistoryTaskV2Attributes))
+	}
+	if v.FailoverMarkerAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("failoverMarkerAttributes", v.FailoverMarkerAttributes))
+	}
+	if v.CreationTime != nil {
+		enc.AddInt64("creationTime", *v.CreationTime)
+	}
+	if v.FrameAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("frameAttributes", v.FrameAttributes))
+	}
+	if v.SyncCompletionCallbacksTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncCompletionCallbacksTaskAttributes", v.SyncCompletionCallbacksTaskAttributes))
+	}
+	return err
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTask) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetSourceTaskId returns the value of SourceTaskId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSourceTaskId() (o int64) {
+	if v != nil && v.SourceTaskId != nil {
+		return *v.SourceTaskId
+	}
+
+	return
+}
+
+// IsSetSourceTaskId returns true if SourceTaskId is not nil.
+func (v *ReplicationTask) IsSetSourceTaskId() bool {
+	return v != nil && v.SourceTaskId != nil
+}
+
+// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
+	if v != nil && v.DomainTaskAttributes != nil {
+		return v.DomainTaskAttributes
+	}
+
+	return
+}
+
+// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
+	return v != nil && v.DomainTaskAttributes != nil
+}
+
+// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
+	if v != nil && v.SyncShardStatusTaskAttributes != nil {
+		return v.SyncShardStatusTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
+	return v != nil && v.SyncShardStatusTaskAttributes != nil
+}
+
+// GetSyncActivityTaskAttributes returns the value of SyncActivityTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncActivityTaskAttributes() (o *SyncActivityTaskAttributes) {
+	if v != nil && v.SyncActivityTaskAttributes != nil {
+		return v.SyncActivityTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncActivityTaskAttributes returns true if SyncActivityTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncActivityTaskAttributes() bool {
+	return v != nil && v.SyncActivityTaskAttributes != nil
+}
+
+// GetHistoryTaskV2Attributes returns the value of HistoryTaskV2Attributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryTaskV2Attributes() (o *HistoryTaskV2Attributes) {
+	if v != nil && v.HistoryTaskV2Attributes != nil {
+		return v.HistoryTaskV2Attributes
+	}
+
+	return
+}
+
+// IsSetHistoryTaskV2Attributes returns true if HistoryTaskV2Attributes is not nil.
+func (v *ReplicationTask) IsSetHistoryTaskV2Attributes() bool {
+	return v != nil && v.HistoryTaskV2Attributes != nil
+}
+
+// GetFailoverMarkerAttributes returns the value of FailoverMarkerAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetFailoverMarkerAttributes() (o *FailoverMarkerAttributes) {
+	if v != nil && v.FailoverMarkerAttributes != nil {
+		return v.FailoverMarkerAttributes
+	}
+
+	return
+}
+
+// IsSetFailoverMarkerAttributes returns true if FailoverMarkerAttributes is not nil.
+func (v *ReplicationTask) IsSetFailoverMarkerAttributes() bool {
+	return v != nil && v.FailoverMarkerAttributes != nil
+}
+
+// GetCreationTime returns the value of CreationTime if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetCreationTime() (o int64) {
+	if v != nil && v.CreationTime != nil {
+		return *v.CreationTime
+	}
+
+	return
+}
+
+// IsSetCreationTime returns true if CreationTime is not nil.
+func (v *ReplicationTask) IsSetCreationTime() bool {
+	return v != nil && v.CreationTime != nil
+}
+
+// GetFrameAttributes returns the value of FrameAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetFrameAttributes() (o *ReplicationTaskFrameAttributes) {
+	if v != nil && v.FrameAttributes != nil {
+		return v.FrameAttributes
+	}
+
+	return
+}
+
+// IsSetFrameAttributes returns true if FrameAttributes is not nil.
+func (v *ReplicationTask) IsSetFrameAttributes() bool {
+	return v != nil && v.FrameAttributes != nil
+}
+
+// GetSyncCompletionCallbacksTaskAttributes returns the value of SyncCompletionCallbacksTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncCompletionCallbacksTaskAttributes() (o *SyncCompletionCallbacksTaskAttributes) {
+	if v != nil && v.SyncCompletionCallbacksTaskAttributes != nil {
+		return v.SyncCompletionCallbacksTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncCompletionCallbacksTaskAttributes returns true if SyncCompletionCallbacksTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncCompletionCallbacksTaskAttributes() bool {
+	return v != nil && v.SyncCompletionCallbacksTaskAttributes != nil
+}
+
+type ReplicationTaskFailure struct {
+	Count         *int64  `json:"count,omitempty"`
+	LastTimestamp *int64  `json:"lastTimestamp,omitempty"`
+	LastError     *string `json:"lastError,omitempty"`
+}
+
+// ToWire translates a ReplicationTaskFailure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ReplicationTaskFailure) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastError != nil {
+		w, err = wire.NewValueString(*(v.LastError)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationTaskFailure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskFailure struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ReplicationTaskFailure
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ReplicationTaskFailure) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastError = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReplicationTaskFailure struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationTaskFailure struct could not be encoded.
+func (v *ReplicationTaskFailure) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastError)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ReplicationTaskFailure struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationTaskFailure struct could not be generated from the wire
+// representation.
+func (v *ReplicationTaskFailure) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastError = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskFailure
+// struct.
+func (v *ReplicationTaskFailure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.LastTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastTimestamp: %v", *(v.LastTimestamp))
+		i++
+	}
+	if v.LastError != nil {
+		fields[i] = fmt.Sprintf("LastError: %v", *(v.LastError))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskFailure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationTaskFailure match the
+// provided ReplicationTaskFailure.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskFailure) Equals(rhs *ReplicationTaskFailure) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastTimestamp, rhs.LastTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastError, rhs.LastError) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskFailure.
+func (v *ReplicationTaskFailure) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
+	}
+	if v.LastTimestamp != nil {
+		enc.AddInt64("lastTimestamp", *v.LastTimestamp)
+	}
+	if v.LastError != nil {
+		enc.AddString("lastError", *v.LastError)
+	}
+	return err
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskFailure) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *ReplicationTaskFailure) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetLastTimestamp returns the value of LastTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskFailure) GetLastTimestamp() (o int64) {
+	if v != nil && v.LastTimestamp != nil {
+		return *v.LastTimestamp
+	}
+
+	return
+}
+
+// IsSetLastTimestamp returns true if LastTimestamp is not nil.
+func (v *ReplicationTaskFailure) IsSetLastTimestamp() bool {
+	return v != nil && v.LastTimestamp != nil
+}
+
+// GetLastError returns the value of LastError if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskFailure) GetLastError() (o string) {
+	if v != nil && v.LastError != nil {
+		return *v.LastError
+	}
+
+	return
+}
+
+// IsSetLastError returns true if LastError is not nil.
+func (v *ReplicationTaskFailure) IsSetLastError() bool {
+	return v != nil && v.LastError != nil
+}
+
+type ReplicationTaskFrameAttributes struct {
+	Data []byte `json:"data,omitempty"`
+}
+
+// ToWire translates a ReplicationTaskFrameAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTaskFrameAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Data != nil {
+		w, err = wire.NewValueBinary(v.Data), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationTaskFrameAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskFrameAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTaskFrameAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTaskFrameAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.Data, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReplicationTaskFrameAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationTaskFrameAttributes struct could not be encoded.
+func (v *ReplicationTaskFrameAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Data); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ReplicationTaskFrameAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationTaskFrameAttributes struct could not be generated from the wire
+// representation.
+func (v *ReplicationTaskFrameAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.Data, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskFrameAttributes
+// struct.
+func (v *ReplicationTaskFrameAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskFrameAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationTaskFrameAttributes match the
+// provided ReplicationTaskFrameAttributes.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskFrameAttributes) Equals(rhs *ReplicationTaskFrameAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskFrameAttributes.
+func (v *ReplicationTaskFrameAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Data != nil {
+		enc.AddString("data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	return err
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskFrameAttributes) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+func (v *ReplicationTaskFrameAttributes) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+type ReplicationTaskInfo struct {
+	DomainID     *string `json:"domainID,omitempty"`
+	WorkflowID   *string `json:"workflowID,omitempty"`
+	RunID        *string `json:"runID,omitempty"`
+	TaskType     *int16  `json:"taskType,omitempty"`
+	TaskID       *int64  `json:"taskID,omitempty"`
+	Version      *int64  `json:"version,omitempty"`
+	FirstEventID *int64  `json:"firstEventID,omitempty"`
+	NextEventID  *int64  `json:"nextEventID,omitempty"`
+	ScheduledID  *int64  `json:"scheduledID,omitempty"`
+}
+
+// ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskType != nil {
+		w, err = wire.NewValueI16(*(v.TaskType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.FirstEventID != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.NextEventID != nil {
+		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ScheduledID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationTaskInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTaskInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReplicationTaskInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationTaskInfo struct could not be encoded.
+func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI16}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt16(*(v.TaskType)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FirstEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.FirstEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextEventID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.NextEventID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ReplicationTaskInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationTaskInfo struct could not be generated from the wire
+// representation.
+func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI16:
+			var x int16
+			x, err = sr.ReadInt16()
+			v.TaskType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.FirstEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.NextEventID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskInfo
+// struct.
+func (v *ReplicationTaskInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.FirstEventID != nil {
+		fields[i] = fmt.Sprintf("FirstEventID: %v", *(v.FirstEventID))
+		i++
+	}
+	if v.NextEventID != nil {
+		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
+		i++
+	}
+	if v.ScheduledID != nil {
+		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTaskInfo match the
+// provided ReplicationTaskInfo.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventID, rhs.FirstEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskInfo.
+func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.TaskType != nil {
+		enc.AddInt16("taskType", *v.TaskType)
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.FirstEventID != nil {
+		enc.AddInt64("firstEventID", *v.FirstEventID)
+	}
+	if v.NextEventID != nil {
+		enc.AddInt64("nextEventID", *v.NextEventID)
+	}
+	if v.ScheduledID != nil {
+		enc.AddInt64("scheduledID", *v.ScheduledID)
+	}
+	return err
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ReplicationTaskInfo) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ReplicationTaskInfo) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *ReplicationTaskInfo) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskType() (o int16) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *ReplicationTaskInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetFirstEventID returns the value of FirstEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetFirstEventID() (o int64) {
+	if v != nil && v.FirstEventID != nil {
+		return *v.FirstEventID
+	}
+
+	return
+}
+
+// IsSetFirstEventID returns true if FirstEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetFirstEventID() bool {
+	return v != nil && v.FirstEventID != nil
+}
+
+// GetNextEventID returns the value of NextEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetNextEventID() (o int64) {
+	if v != nil && v.NextEventID != nil {
+		return *v.NextEventID
+	}
+
+	return
+}
+
+// IsSetNextEventID returns true if NextEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetNextEventID() bool {
+	return v != nil && v.NextEventID != nil
+}
+
+// GetScheduledID returns the value of ScheduledID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
+	if v != nil && v.ScheduledID != nil {
+		return *v.ScheduledID
+	}
+
+	return
+}
+
+// IsSetScheduledID returns true if ScheduledID is not nil.
+func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
+	return v != nil && v.ScheduledID != nil
+}
+
+type ReplicationTaskType int32
+
+const (
+	ReplicationTaskTypeDomain                  ReplicationTaskType = 0
+	ReplicationTaskTypeHistory                 ReplicationTaskType = 1
+	ReplicationTaskTypeSyncShardStatus         ReplicationTaskType = 2
+	ReplicationTaskTypeSyncActivity            ReplicationTaskType = 3
+	ReplicationTaskTypeHistoryMetadata         ReplicationTaskType = 4
+	ReplicationTaskTypeHistoryV2               ReplicationTaskType = 5
+	ReplicationTaskTypeFailoverMarker          ReplicationTaskType = 6
+	ReplicationTaskTypeFrame                   ReplicationTaskType = 7
+	ReplicationTaskTypeSyncCompletionCallbacks ReplicationTaskType = 8
+)
+
+// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
+func ReplicationTaskType_Values() []ReplicationTaskType {
+	return []ReplicationTaskType{
+		ReplicationTaskTypeDomain,
+		ReplicationTaskTypeHistory,
+		ReplicationTaskTypeSyncShardStatus,
+		ReplicationTaskTypeSyncActivity,
+		ReplicationTaskTypeHistoryMetadata,
+		ReplicationTaskTypeHistoryV2,
+		ReplicationTaskTypeFailoverMarker,
+		ReplicationTaskTypeFrame,
+		ReplicationTaskTypeSyncCompletionCallbacks,
+	}
+}
+
+// UnmarshalText tries to decode ReplicationTaskType from a byte slice
+// containing its name.
+//
+//   var v ReplicationTaskType
+//   err := v.UnmarshalText([]byte("Domain"))
+func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Domain":
+		*v = ReplicationTaskTypeDomain
+		return nil
+	case "History":
+		*v = ReplicationTaskTypeHistory
+		return nil
+	case "SyncShardStatus":
+		*v = ReplicationTaskTypeSyncShardStatus
+		return nil
+	case "SyncActivity":
+		*v = ReplicationTaskTypeSyncActivity
+		return nil
+	case "HistoryMetadata":
+		*v = ReplicationTaskTypeHistoryMetadata
+		return nil
+	case "HistoryV2":
+		*v = ReplicationTaskTypeHistoryV2
+		return nil
+	case "FailoverMarker":
+		*v = ReplicationTaskTypeFailoverMarker
+		return nil
+	case "Frame":
+		*v = ReplicationTaskTypeFrame
+		return nil
+	case "SyncCompletionCallbacks":
+		*v = ReplicationTaskTypeSyncCompletionCallbacks
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+		}
+		*v = ReplicationTaskType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ReplicationTaskType to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v ReplicationTaskType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Domain"), nil
+	case 1:
+		return []byte("History"), nil
+	case 2:
+		return []byte("SyncShardStatus"), nil
+	case 3:
+		return []byte("SyncActivity"), nil
+	case 4:
+		return []byte("HistoryMetadata"), nil
+	case 5:
+		return []byte("HistoryV2"), nil
+	case 6:
+		return []byte("FailoverMarker"), nil
+	case 7:
+		return []byte("Frame"), nil
+	case 8:
+		return []byte("SyncCompletionCallbacks"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Domain")
+	case 1:
+		enc.AddString("name", "History")
+	case 2:
+		enc.AddString("name", "SyncShardStatus")
+	case 3:
+		enc.AddString("name", "SyncActivity")
+	case 4:
+		enc.AddString("name", "HistoryMetadata")
+	case 5:
+		enc.AddString("name", "HistoryV2")
+	case 6:
+		enc.AddString("name", "FailoverMarker")
+	case 7:
+		enc.AddString("name", "Frame")
+	case 8:
+		enc.AddString("name", "SyncCompletionCallbacks")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
+	return &v
+}
+
+// Encode encodes ReplicationTaskType directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v ReplicationTaskType
+//   return v.Encode(sWriter)
+func (v ReplicationTaskType) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates ReplicationTaskType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ReplicationTaskType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ReplicationTaskType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//
+//   var v ReplicationTaskType
+//   if err := v.FromWire(x); err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//   return v, nil
+func (v *ReplicationTaskType) FromWire(w wire.Value) error {
+	*v = (ReplicationTaskType)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded ReplicationTaskType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v ReplicationTaskType
+//   if err := v.Decode(sReader); err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//   return v, nil
+func (v *ReplicationTaskType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (ReplicationTaskType)(i)
+	return nil
+}
+
+// String returns a readable string representation of ReplicationTaskType.
+func (v ReplicationTaskType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Domain"
+	case 1:
+		return "History"
+	case 2:
+		return "SyncShardStatus"
+	case 3:
+		return "SyncActivity"
+	case 4:
+		return "HistoryMetadata"
+	case 5:
+		return "HistoryV2"
+	case 6:
+		return "FailoverMarker"
+	case 7:
+		return "Frame"
+	case 8:
+		return "SyncCompletionCallbacks"
+	}
+	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+}
+
+// Equals returns true if this ReplicationTaskType value matches the provided
+// value.
+func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ReplicationTaskType into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Domain\""), nil
+	case 1:
+		return ([]byte)("\"History\""), nil
+	case 2:
+		return ([]byte)("\"SyncShardStatus\""), nil
+	case 3:
+		return ([]byte)("\"SyncActivity\""), nil
+	case 4:
+		return ([]byte)("\"HistoryMetadata\""), nil
+	case 5:
+		return ([]byte)("\"HistoryV2\""), nil
+	case 6:
+		return ([]byte)("\"FailoverMarker\""), nil
+	case 7:
+		return ([]byte)("\"Frame\""), nil
+	case 8:
+		return ([]byte)("\"SyncCompletionCallbacks\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		*v = (ReplicationTaskType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
+	}
+}
+
+type ReplicationToken struct {
+	ShardID                *int32 `json:"shardID,omitempty"`
+	LastRetrievedMessageId *int64 `json:"lastRetrievedMessageId,omitempty"`
+	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
+}
+
+// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationToken) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationToken struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationToken struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationToken
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationToken) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ReplicationToken struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ReplicationToken struct could not be encoded.
+func (v *ReplicationToken) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastRetrievedMessageId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastRetrievedMessageId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastProcessedMessageId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastProcessedMessageId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ReplicationToken struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ReplicationToken struct could not be generated from the wire
+// representation.
+func (v *ReplicationToken) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastRetrievedMessageId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastProcessedMessageId = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationToken
+// struct.
+func (v *ReplicationToken) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationToken match the
+// provided ReplicationToken.
+//
+// This function performs a deep comparison.
+func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationToken.
+func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.LastRetrievedMessageId != nil {
+		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	}
+	if v.LastProcessedMessageId != nil {
+		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReplicationToken) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetLastRetrievedMessageId() (o int64) {
+	if v != nil && v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// IsSetLastRetrievedMessageId returns true if LastRetrievedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastRetrievedMessageId() bool {
+	return v != nil && v.LastRetrievedMessageId != nil
+}
+
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
+	if v != nil && v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
+	}
+
+	return
+}
+
+// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastProcessedMessageId() bool {
+	return v != nil && v.LastProcessedMessageId != nil
+}
+
+type SyncActivityTaskAttributes struct {
+	DomainId           *string                `json:"domainId,omitempty"`
+	WorkflowId         *string                `json:"workflowId,omitempty"`
+	RunId              *string                `json:"runId,omitempty"`
+	Version            *int64                 `json:"version,omitempty"`
+	ScheduledId        *int64                 `json:"scheduledId,omitempty"`
+	ScheduledTime      *int64                 `json:"scheduledTime,omitempty"`
+	StartedId          *int64                 `json:"startedId,omitempty"`
+	StartedTime        *int64                 `json:"startedTime,omitempty"`
+	LastHeartbeatTime  *int64                 `json:"lastHeartbeatTime,omitempty"`
+	Details            []byte                 `json:"details,omitempty"`
+	Attempt            *int32                 `json:"attempt,omitempty"`
+	LastFailureReason  *string                `json:"lastFailureReason,omitempty"`
+	LastWorkerIdentity *string                `json:"lastWorkerIdentity,omitempty"`
+	LastFailureDetails []byte                 `json:"lastFailureDetails,omitempty"`
+	VersionHistory     *shared.VersionHistory `json:"versionHistory,omitempty"`
+}
+
+// ToWire translates a SyncActivityTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [15]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduledId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ScheduledTime != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.StartedId != nil {
+		w, err = wire.NewValueI64(*(v.StartedId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.StartedTime != nil {
+		w, err = wire.NewValueI64(*(v.StartedTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.LastHeartbeatTime != nil {
+		w, err = wire.NewValueI64(*(v.LastHeartbeatTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.LastFailureReason != nil {
+		w, err = wire.NewValueString(*(v.LastFailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.LastWorkerIdentity != nil {
+		w, err = wire.NewValueString(*(v.LastWorkerIdentity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.LastFailureDetails != nil {
+		w, err = wire.NewValueBinary(v.LastFailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.VersionHistory != nil {
+		w, err = v.VersionHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
+	var v shared.VersionHistory
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a SyncActivityTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SyncActivityTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SyncActivityTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SyncActivityTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastHeartbeatTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastFailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastWorkerIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				v.LastFailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TStruct {
+				v.VersionHistory, err = _VersionHistory_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SyncActivityTaskAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SyncActivityTaskAttributes struct could not be encoded.
+func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RunId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastHeartbeatTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LastHeartbeatTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Details != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Details); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastFailureReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastFailureReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastWorkerIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.LastWorkerIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LastFailureDetails != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.LastFailureDetails); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VersionHistory != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.VersionHistory.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _VersionHistory_Decode(sr stream.Reader) (*shared.VersionHistory, error) {
+	var v shared.VersionHistory
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a SyncActivityTaskAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SyncActivityTaskAttributes struct could not be generated from the wire
+// representation.
+func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledTime = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedTime = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LastHeartbeatTime = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TBinary:
+			v.Details, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastFailureReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.LastWorkerIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TBinary:
+			v.LastFailureDetails, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 150 && fh.Type == wire.TStruct:
+			v.VersionHistory, err = _VersionHistory_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SyncActivityTaskAttributes
+// struct.
+func (v *SyncActivityTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [15]string
+	i := 0
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ScheduledId != nil {
+		fields[i] = fmt.Sprintf("ScheduledId: %v", *(v.ScheduledId))
+		i++
+	}
+	if v.ScheduledTime != nil {
+		fields[i] = fmt.Sprintf("ScheduledTime: %v", *(v.ScheduledTime))
+		i++
+	}
+	if v.StartedId != nil {
+		fields[i] = fmt.Sprintf("StartedId: %v", *(v.StartedId))
+		i++
+	}
+	if v.StartedTime != nil {
+		fields[i] = fmt.Sprintf("StartedTime: %v", *(v.StartedTime))
+		i++
+	}
+	if v.LastHeartbeatTime != nil {
+		fields[i] = fmt.Sprintf("LastHeartbeatTime: %v", *(v.LastHeartbeatTime))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.LastFailureReason != nil {
+		fields[i] = fmt.Sprintf("LastFailureReason: %v", *(v.LastFailureReason))
+		i++
+	}
+	if v.LastWorkerIdentity != nil {
+		fields[i] = fmt.Sprintf("LastWorkerIdentity: %v", *(v.LastWorkerIdentity))
+		i++
+	}
+	if v.LastFailureDetails != nil {
+		fields[i] = fmt.Sprintf("LastFailureDetails: %v", v.LastFailureDetails)
+		i++
+	}
+	if v.VersionHistory != nil {
+		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
+		i++
+	}
+
+	return fmt.Sprintf("SyncActivityTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SyncActivityTaskAttributes match the
+// provided SyncActivityTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *SyncActivityTaskAttributes) Equals(rhs *SyncActivityTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledId, rhs.ScheduledId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTime, rhs.ScheduledTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedId, rhs.StartedId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTime, rhs.StartedTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastHeartbeatTime, rhs.LastHeartbeatTime) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastFailureReason, rhs.LastFailureReason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastWorkerIdentity, rhs.LastWorkerIdentity) {
+		return false
+	}
+	if !((v.LastFailureDetails == nil && rhs.LastFailureDetails == nil) || (v.LastFailureDetails != nil && rhs.LastFailureDetails != nil && bytes.Equal(v.LastFailureDetails, rhs.LastFailureDetails))) {
+		return false
+	}
+	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SyncActivityTaskAttributes.
+func (v *SyncActivityTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ScheduledId != nil {
+		enc.AddInt64("scheduledId", *v.ScheduledId)
+	}
+	if v.ScheduledTime != nil {
+		enc.AddInt64("scheduledTime", *v.ScheduledTime)
+	}
+	if v.StartedId != nil {
+		enc.AddInt64("startedId", *v.StartedId)
+	}
+	if v.StartedTime != nil {
+		enc.AddInt64("startedTime", *v.StartedTime)
+	}
+	if v.LastHeartbeatTime != nil {
+		enc.AddInt64("lastHeartbeatTime", *v.LastHeartbeatTime)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
+	}
+	if v.LastFailureReason != nil {
+		enc.AddString("lastFailureReason", *v.LastFailureReason)
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+	if v.LastWorkerIdentity != nil {
+		enc.AddString("lastWorkerIdentity", *v.LastWorkerIdentity)
+	}
+	if v.LastFailureDetails != nil {
+		enc.AddString("lastFailureDetails", base64.StdEncoding.EncodeToString(v.LastFailureDetails))
+	}
+	if v.VersionHistory != nil {
+		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
+	}
+	return err
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *SyncActivityTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *SyncActivityTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *SyncActivityTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *SyncActivityTaskAttributes) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetScheduledId returns the value of ScheduledId if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetScheduledId() (o int64) {
+	if v != nil && v.ScheduledId != nil {
+		return *v.ScheduledId
+	}
+
+	return
+}
+
+// IsSetScheduledId returns true if ScheduledId is not nil.
+func (v *SyncActivityTaskAttributes) IsSetScheduledId() bool {
+	return v != nil && v.ScheduledId != nil
+}
+
+// GetScheduledTime returns the value of ScheduledTime if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetScheduledTime() (o int64) {
+	if v != nil && v.ScheduledTime != nil {
+		return *v.ScheduledTime
+	}
+
+	return
+}
+
+// IsSetScheduledTime returns true if ScheduledTime is not nil.
+func (v *SyncActivityTaskAttributes) IsSetScheduledTime() bool {
+	return v != nil && v.ScheduledTime != nil
+}
+
+// GetStartedId returns the value of StartedId if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetStartedId() (o int64) {
+	if v != nil && v.StartedId != nil {
+		return *v.StartedId
+	}
+
+	return
+}
+
+// IsSetStartedId returns true if StartedId is not nil.
+func (v *SyncActivityTaskAttributes) IsSetStartedId() bool {
+	return v != nil && v.StartedId != nil
+}
+
+// GetStartedTime returns the value of StartedTime if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetStartedTime() (o int64) {
+	if v != nil && v.StartedTime != nil {
+		return *v.StartedTime
+	}
+
+	return
+}
+
+// IsSetStartedTime returns true if StartedTime is not nil.
+func (v *SyncActivityTaskAttributes) IsSetStartedTime() bool {
+	return v != nil && v.StartedTime != nil
+}
+
+// GetLastHeartbeatTime returns the value of LastHeartbeatTime if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetLastHeartbeatTime() (o int64) {
+	if v != nil && v.LastHeartbeatTime != nil {
+		return *v.LastHeartbeatTime
+	}
+
+	return
+}
+
+// IsSetLastHeartbeatTime returns true if LastHeartbeatTime is not nil.
+func (v *SyncActivityTaskAttributes) IsSetLastHeartbeatTime() bool {
+	return v != nil && v.LastHeartbeatTime != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *SyncActivityTaskAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *SyncActivityTaskAttributes) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetLastFailureReason returns the value of LastFailureReason if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetLastFailureReason() (o string) {
+	if v != nil && v.LastFailureReason != nil {
+		return *v.LastFailureReason
+	}
+
+	return
+}
+
+// IsSetLastFailureReason returns true if LastFailureReason is not nil.
+func (v *SyncActivityTaskAttributes) IsSetLastFailureReason() bool {
+	return v != nil && v.LastFailureReason != nil
+}
+
+// GetLastWorkerIdentity returns the value of LastWorkerIdentity if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetLastWorkerIdentity() (o string) {
+	if v != nil && v.LastWorkerIdentity != nil {
+		return *v.LastWorkerIdentity
+	}
+
+	return
+}
+
+// IsSetLastWorkerIdentity returns true if LastWorkerIdentity is not nil.
+func (v *SyncActivityTaskAttributes) IsSetLastWorkerIdentity() bool {
+	return v != nil && v.LastWorkerIdentity != nil
+}
+
+// GetLastFailureDetails returns the value of LastFailureDetails if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetLastFailureDetails() (o []byte) {
+	if v != nil && v.LastFailureDetails != nil {
+		return v.LastFailureDetails
+	}
+
+	return
+}
+
+// IsSetLastFailureDetails returns true if LastFailureDetails is not nil.
+func (v *SyncActivityTaskAttributes) IsSetLastFailureDetails() bool {
+	return v != nil && v.LastFailureDetails != nil
+}
+
+// GetVersionHistory returns the value of VersionHistory if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *shared.VersionHistory) {
+	if v != nil && v.VersionHistory != nil {
+		return v.VersionHistory
+	}
+
+	return
+}
+
+// IsSetVersionHistory returns true if VersionHistory is not nil.
+func (v *SyncActivityTaskAttributes) IsSetVersionHistory() bool {
+	return v != nil && v.VersionHistory != nil
+}
+
+type SyncCompletionCallbacksTaskAttributes struct {
+	DomainId            *string                          `json:"domainId,omitempty"`
+	WorkflowId          *string                          `json:"workflowId,omitempty"`
+	RunId               *string                          `json:"runId,omitempty"`
+	Version             *int64                           `json:"version,omitempty"`
+	CompletionCallbacks []*shared.CompletionCallbackInfo `json:"completionCallbacks,omitempty"`
+}
+
+type _List_CompletionCallbackInfo_ValueList []*shared.CompletionCallbackInfo
+
+func (v _List_CompletionCallbackInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*shared.CompletionCallbackInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_CompletionCallbackInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CompletionCallbackInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CompletionCallbackInfo_ValueList) Close() {}
+
+// ToWire translates a SyncCompletionCallbacksTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SyncCompletionCallbacksTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.LastFailureReason != nil {
-		w, err = wire.NewValueString(*(v.LastFailureReason)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.LastWorkerIdentity != nil {
-		w, err = wire.NewValueString(*(v.LastWorkerIdentity)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.LastFailureDetails != nil {
-		w, err = wire.NewValueBinary(v.LastFailureDetails), error(nil)
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 140, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.VersionHistory != nil {
-		w, err = v.VersionHistory.ToWire()
+	if v.CompletionCallbacks != nil {
+		w, err = wire.NewValueList(_List_CompletionCallbackInfo_ValueList(v.CompletionCallbacks)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
-	var v shared.VersionHistory
+func _CompletionCallbackInfo_Read(w wire.Value) (*shared.CompletionCallbackInfo, error) {
+	var v shared.CompletionCallbackInfo
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a SyncActivityTaskAttributes struct from its Thrift-level
+func _List_CompletionCallbackInfo_Read(l wire.ValueList) ([]*shared.CompletionCallbackInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.CompletionCallbackInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CompletionCallbackInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a SyncCompletionCallbacksTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a SyncActivityTaskAttributes struct
+// An error is returned if we were unable to build a SyncCompletionCallbacksTaskAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9323,12 +15037,12 @@ func _VersionHistory_Read(w wire.Value) (*shared.VersionHistory, error) {
 //     return nil, err
 //   }
 //
-//   var v SyncActivityTaskAttributes
+//   var v SyncCompletionCallbacksTaskAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *SyncActivityTaskAttributes) FromWire(w wire.Value) error {
+func (v *SyncCompletionCallbacksTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -9370,253 +15084,58 @@ func (v *SyncActivityTaskAttributes) FromWire(w wire.Value) error {
 				v.Version = &x
 				if err != nil {
 					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastHeartbeatTime = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.LastFailureReason = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.LastWorkerIdentity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 140:
-			if field.Value.Type() == wire.TBinary {
-				v.LastFailureDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 150:
-			if field.Value.Type() == wire.TStruct {
-				v.VersionHistory, err = _VersionHistory_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// Encode serializes a SyncActivityTaskAttributes struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a SyncActivityTaskAttributes struct could not be encoded.
-func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.DomainId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.DomainId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Version != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.Version)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ScheduledId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ScheduledId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+				}
 
-	if v.ScheduledTime != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ScheduledTime)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.CompletionCallbacks, err = _List_CompletionCallbackInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
 
-	if v.StartedId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+			}
 		}
 	}
 
-	if v.StartedTime != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedTime)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	return nil
+}
+
+func _List_CompletionCallbackInfo_Encode(val []*shared.CompletionCallbackInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
 	}
 
-	if v.LastHeartbeatTime != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.LastHeartbeatTime)); err != nil {
-			return err
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*shared.CompletionCallbackInfo', index [%v]: value is nil", i)
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
+	return sw.WriteListEnd()
+}
 
-	if v.Details != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Details); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// Encode serializes a SyncCompletionCallbacksTaskAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SyncCompletionCallbacksTaskAttributes struct could not be encoded.
+func (v *SyncCompletionCallbacksTaskAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.Attempt != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TI32}); err != nil {
+	if v.DomainId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.Attempt)); err != nil {
+		if err := sw.WriteString(*(v.DomainId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9624,11 +15143,11 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastFailureReason != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
+	if v.WorkflowId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.LastFailureReason)); err != nil {
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9636,11 +15155,11 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastWorkerIdentity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TBinary}); err != nil {
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.LastWorkerIdentity)); err != nil {
+		if err := sw.WriteString(*(v.RunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9648,11 +15167,11 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.LastFailureDetails != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.LastFailureDetails); err != nil {
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9660,11 +15179,11 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VersionHistory != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TStruct}); err != nil {
+	if v.CompletionCallbacks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := v.VersionHistory.Encode(sw); err != nil {
+		if err := _List_CompletionCallbackInfo_Encode(v.CompletionCallbacks, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -9675,18 +15194,48 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _VersionHistory_Decode(sr stream.Reader) (*shared.VersionHistory, error) {
-	var v shared.VersionHistory
+func _CompletionCallbackInfo_Decode(sr stream.Reader) (*shared.CompletionCallbackInfo, error) {
+	var v shared.CompletionCallbackInfo
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a SyncActivityTaskAttributes struct directly from its Thrift-level
+func _List_CompletionCallbackInfo_Decode(sr stream.Reader) ([]*shared.CompletionCallbackInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*shared.CompletionCallbackInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _CompletionCallbackInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a SyncCompletionCallbacksTaskAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a SyncActivityTaskAttributes struct could not be generated from the wire
+// An error is returned if a SyncCompletionCallbacksTaskAttributes struct could not be generated from the wire
 // representation.
-func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
+func (v *SyncCompletionCallbacksTaskAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -9731,84 +15280,8 @@ func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledTime = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedTime = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 90 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.LastHeartbeatTime = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 100 && fh.Type == wire.TBinary:
-			v.Details, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 110 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Attempt = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 120 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.LastFailureReason = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 130 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.LastWorkerIdentity = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 140 && fh.Type == wire.TBinary:
-			v.LastFailureDetails, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 150 && fh.Type == wire.TStruct:
-			v.VersionHistory, err = _VersionHistory_Decode(sr)
+		case fh.ID == 50 && fh.Type == wire.TList:
+			v.CompletionCallbacks, err = _List_CompletionCallbackInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -9835,14 +15308,14 @@ func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a SyncActivityTaskAttributes
+// String returns a readable string representation of a SyncCompletionCallbacksTaskAttributes
 // struct.
-func (v *SyncActivityTaskAttributes) String() string {
+func (v *SyncCompletionCallbacksTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [5]string
 	i := 0
 	if v.DomainId != nil {
 		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
@@ -9860,59 +15333,34 @@ func (v *SyncActivityTaskAttributes) String() string {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
-	if v.ScheduledId != nil {
-		fields[i] = fmt.Sprintf("ScheduledId: %v", *(v.ScheduledId))
-		i++
-	}
-	if v.ScheduledTime != nil {
-		fields[i] = fmt.Sprintf("ScheduledTime: %v", *(v.ScheduledTime))
-		i++
-	}
-	if v.StartedId != nil {
-		fields[i] = fmt.Sprintf("StartedId: %v", *(v.StartedId))
-		i++
-	}
-	if v.StartedTime != nil {
-		fields[i] = fmt.Sprintf("StartedTime: %v", *(v.StartedTime))
-		i++
-	}
-	if v.LastHeartbeatTime != nil {
-		fields[i] = fmt.Sprintf("LastHeartbeatTime: %v", *(v.LastHeartbeatTime))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.LastFailureReason != nil {
-		fields[i] = fmt.Sprintf("LastFailureReason: %v", *(v.LastFailureReason))
-		i++
-	}
-	if v.LastWorkerIdentity != nil {
-		fields[i] = fmt.Sprintf("LastWorkerIdentity: %v", *(v.LastWorkerIdentity))
-		i++
-	}
-	if v.LastFailureDetails != nil {
-		fields[i] = fmt.Sprintf("LastFailureDetails: %v", v.LastFailureDetails)
-		i++
-	}
-	if v.VersionHistory != nil {
-		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
+	if v.CompletionCallbacks != nil {
+		fields[i] = fmt.Sprintf("CompletionCallbacks: %v", v.CompletionCallbacks)
 		i++
 	}
 
-	return fmt.Sprintf("SyncActivityTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SyncCompletionCallbacksTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this SyncActivityTaskAttributes match the
-// provided SyncActivityTaskAttributes.
+func _List_CompletionCallbackInfo_Equals(lhs, rhs []*shared.CompletionCallbackInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this SyncCompletionCallbacksTaskAttributes match the
+// provided SyncCompletionCallbacksTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *SyncActivityTaskAttributes) Equals(rhs *SyncActivityTaskAttributes) bool {
+func (v *SyncCompletionCallbacksTaskAttributes) Equals(rhs *SyncCompletionCallbacksTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -9930,46 +15378,27 @@ func (v *SyncActivityTaskAttributes) Equals(rhs *SyncActivityTaskAttributes) boo
 	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduledId, rhs.ScheduledId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTime, rhs.ScheduledTime) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedId, rhs.StartedId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTime, rhs.StartedTime) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastHeartbeatTime, rhs.LastHeartbeatTime) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_String_EqualsPtr(v.LastFailureReason, rhs.LastFailureReason) {
-		return false
-	}
-	if !_String_EqualsPtr(v.LastWorkerIdentity, rhs.LastWorkerIdentity) {
-		return false
-	}
-	if !((v.LastFailureDetails == nil && rhs.LastFailureDetails == nil) || (v.LastFailureDetails != nil && rhs.LastFailureDetails != nil && bytes.Equal(v.LastFailureDetails, rhs.LastFailureDetails))) {
-		return false
-	}
-	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
+	if !((v.CompletionCallbacks == nil && rhs.CompletionCallbacks == nil) || (v.CompletionCallbacks != nil && rhs.CompletionCallbacks != nil && _List_CompletionCallbackInfo_Equals(v.CompletionCallbacks, rhs.CompletionCallbacks))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_CompletionCallbackInfo_Zapper []*shared.CompletionCallbackInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CompletionCallbackInfo_Zapper.
+func (l _List_CompletionCallbackInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of SyncActivityTaskAttributes.
-func (v *SyncActivityTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of SyncCompletionCallbacksTaskAttributes.
+func (v *SyncCompletionCallbacksTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -9985,45 +15414,15 @@ func (v *SyncActivityTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.Version != nil {
 		enc.AddInt64("version", *v.Version)
 	}
-	if v.ScheduledId != nil {
-		enc.AddInt64("scheduledId", *v.ScheduledId)
-	}
-	if v.ScheduledTime != nil {
-		enc.AddInt64("scheduledTime", *v.ScheduledTime)
-	}
-	if v.StartedId != nil {
-		enc.AddInt64("startedId", *v.StartedId)
-	}
-	if v.StartedTime != nil {
-		enc.AddInt64("startedTime", *v.StartedTime)
-	}
-	if v.LastHeartbeatTime != nil {
-		enc.AddInt64("lastHeartbeatTime", *v.LastHeartbeatTime)
-	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
-	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
-	}
-	if v.LastFailureReason != nil {
-		enc.AddString("lastFailureReason", *v.LastFailureReason)
-	}
-	if v.LastWorkerIdentity != nil {
-		enc.AddString("lastWorkerIdentity", *v.LastWorkerIdentity)
-	}
-	if v.LastFailureDetails != nil {
-		enc.AddString("lastFailureDetails", base64.StdEncoding.EncodeToString(v.LastFailureDetails))
-	}
-	if v.VersionHistory != nil {
-		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
+	if v.CompletionCallbacks != nil {
+		err = multierr.Append(err, enc.AddArray("completionCallbacks", (_List_CompletionCallbackInfo_Zapper)(v.CompletionCallbacks)))
 	}
 	return err
 }
 
 // GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetDomainId() (o string) {
+func (v *SyncCompletionCallbacksTaskAttributes) GetDomainId() (o string) {
 	if v != nil && v.DomainId != nil {
 		return *v.DomainId
 	}
@@ -10032,13 +15431,13 @@ func (v *SyncActivityTaskAttributes) GetDomainId() (o string) {
 }
 
 // IsSetDomainId returns true if DomainId is not nil.
-func (v *SyncActivityTaskAttributes) IsSetDomainId() bool {
+func (v *SyncCompletionCallbacksTaskAttributes) IsSetDomainId() bool {
 	return v != nil && v.DomainId != nil
 }
 
 // GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetWorkflowId() (o string) {
+func (v *SyncCompletionCallbacksTaskAttributes) GetWorkflowId() (o string) {
 	if v != nil && v.WorkflowId != nil {
 		return *v.WorkflowId
 	}
@@ -10047,13 +15446,13 @@ func (v *SyncActivityTaskAttributes) GetWorkflowId() (o string) {
 }
 
 // IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *SyncActivityTaskAttributes) IsSetWorkflowId() bool {
+func (v *SyncCompletionCallbacksTaskAttributes) IsSetWorkflowId() bool {
 	return v != nil && v.WorkflowId != nil
 }
 
 // GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetRunId() (o string) {
+func (v *SyncCompletionCallbacksTaskAttributes) GetRunId() (o string) {
 	if v != nil && v.RunId != nil {
 		return *v.RunId
 	}
@@ -10062,13 +15461,13 @@ func (v *SyncActivityTaskAttributes) GetRunId() (o string) {
 }
 
 // IsSetRunId returns true if RunId is not nil.
-func (v *SyncActivityTaskAttributes) IsSetRunId() bool {
+func (v *SyncCompletionCallbacksTaskAttributes) IsSetRunId() bool {
 	return v != nil && v.RunId != nil
 }
 
 // GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetVersion() (o int64) {
+func (v *SyncCompletionCallbacksTaskAttributes) GetVersion() (o int64) {
 	if v != nil && v.Version != nil {
 		return *v.Version
 	}
@@ -10077,173 +15476,23 @@ func (v *SyncActivityTaskAttributes) GetVersion() (o int64) {
 }
 
 // IsSetVersion returns true if Version is not nil.
-func (v *SyncActivityTaskAttributes) IsSetVersion() bool {
+func (v *SyncCompletionCallbacksTaskAttributes) IsSetVersion() bool {
 	return v != nil && v.Version != nil
 }
 
-// GetScheduledId returns the value of ScheduledId if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetScheduledId() (o int64) {
-	if v != nil && v.ScheduledId != nil {
-		return *v.ScheduledId
-	}
-
-	return
-}
-
-// IsSetScheduledId returns true if ScheduledId is not nil.
-func (v *SyncActivityTaskAttributes) IsSetScheduledId() bool {
-	return v != nil && v.ScheduledId != nil
-}
-
-// GetScheduledTime returns the value of ScheduledTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetScheduledTime() (o int64) {
-	if v != nil && v.ScheduledTime != nil {
-		return *v.ScheduledTime
-	}
-
-	return
-}
-
-// IsSetScheduledTime returns true if ScheduledTime is not nil.
-func (v *SyncActivityTaskAttributes) IsSetScheduledTime() bool {
-	return v != nil && v.ScheduledTime != nil
-}
-
-// GetStartedId returns the value of StartedId if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetStartedId() (o int64) {
-	if v != nil && v.StartedId != nil {
-		return *v.StartedId
-	}
-
-	return
-}
-
-// IsSetStartedId returns true if StartedId is not nil.
-func (v *SyncActivityTaskAttributes) IsSetStartedId() bool {
-	return v != nil && v.StartedId != nil
-}
-
-// GetStartedTime returns the value of StartedTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetStartedTime() (o int64) {
-	if v != nil && v.StartedTime != nil {
-		return *v.StartedTime
-	}
-
-	return
-}
-
-// IsSetStartedTime returns true if StartedTime is not nil.
-func (v *SyncActivityTaskAttributes) IsSetStartedTime() bool {
-	return v != nil && v.StartedTime != nil
-}
-
-// GetLastHeartbeatTime returns the value of LastHeartbeatTime if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetLastHeartbeatTime() (o int64) {
-	if v != nil && v.LastHeartbeatTime != nil {
-		return *v.LastHeartbeatTime
-	}
-
-	return
-}
-
-// IsSetLastHeartbeatTime returns true if LastHeartbeatTime is not nil.
-func (v *SyncActivityTaskAttributes) IsSetLastHeartbeatTime() bool {
-	return v != nil && v.LastHeartbeatTime != nil
-}
-
-// GetDetails returns the value of Details if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
-	}
-
-	return
-}
-
-// IsSetDetails returns true if Details is not nil.
-func (v *SyncActivityTaskAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
-	}
-
-	return
-}
-
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *SyncActivityTaskAttributes) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
-}
-
-// GetLastFailureReason returns the value of LastFailureReason if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetLastFailureReason() (o string) {
-	if v != nil && v.LastFailureReason != nil {
-		return *v.LastFailureReason
-	}
-
-	return
-}
-
-// IsSetLastFailureReason returns true if LastFailureReason is not nil.
-func (v *SyncActivityTaskAttributes) IsSetLastFailureReason() bool {
-	return v != nil && v.LastFailureReason != nil
-}
-
-// GetLastWorkerIdentity returns the value of LastWorkerIdentity if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetLastWorkerIdentity() (o string) {
-	if v != nil && v.LastWorkerIdentity != nil {
-		return *v.LastWorkerIdentity
-	}
-
-	return
-}
-
-// IsSetLastWorkerIdentity returns true if LastWorkerIdentity is not nil.
-func (v *SyncActivityTaskAttributes) IsSetLastWorkerIdentity() bool {
-	return v != nil && v.LastWorkerIdentity != nil
-}
-
-// GetLastFailureDetails returns the value of LastFailureDetails if it is set or its
-// zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetLastFailureDetails() (o []byte) {
-	if v != nil && v.LastFailureDetails != nil {
-		return v.LastFailureDetails
-	}
-
-	return
-}
-
-// IsSetLastFailureDetails returns true if LastFailureDetails is not nil.
-func (v *SyncActivityTaskAttributes) IsSetLastFailureDetails() bool {
-	return v != nil && v.LastFailureDetails != nil
-}
-
-// GetVersionHistory returns the value of VersionHistory if it is set or its
+// GetCompletionCallbacks returns the value of CompletionCallbacks if it is set or its
 // zero value if it is unset.
-func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *shared.VersionHistory) {
-	if v != nil && v.VersionHistory != nil {
-		return v.VersionHistory
+func (v *SyncCompletionCallbacksTaskAttributes) GetCompletionCallbacks() (o []*shared.CompletionCallbackInfo) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
 	}
 
 	return
 }
 
-// IsSetVersionHistory returns true if VersionHistory is not nil.
-func (v *SyncActivityTaskAttributes) IsSetVersionHistory() bool {
-	return v != nil && v.VersionHistory != nil
+// IsSetCompletionCallbacks returns true if CompletionCallbacks is not nil.
+func (v *SyncCompletionCallbacksTaskAttributes) IsSetCompletionCallbacks() bool {
+	return v != nil && v.CompletionCallbacks != nil
 }
 
 type SyncShardStatus struct {
@@ -10799,11 +16048,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "replicator",
 	Package:  "github.com/uber/cadence/.gen/go/replicator",
 	FilePath: "replicator.thrift",
-	SHA1:     "85475c6529845fd2ee1b039bfa9eda1128146139",
+	SHA1:     "d5e994a5f087e253b90bfd2c8ece54dd1dc3bda9",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n  // Frame carries a batch of replication tasks compressed together, only sent to clusters which ask for it\n  Frame\n  SyncCompletionCallbacks\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\n// SyncCompletionCallbacksTaskAttributes carries the delivery state of the completion callbacks of a closed workflow\nstruct SyncCompletionCallbacksTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional list<shared.CompletionCallbackInfo> completionCallbacks\n}\n\nstruct ReplicationTaskFrameAttributes {\n  // data is the zstd compressed concatenation of the length prefixed thriftrw encoded replication tasks\n  10: optional binary data\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n  100: optional ReplicationTaskFrameAttributes frameAttributes\n  110: optional SyncCompletionCallbacksTaskAttributes syncCompletionCallbacksTaskAttributes\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n  // retry state of the returned tasks which were retried automatically\n  50: optional list<ReplicationDLQTaskState> retryStates\n}\n\nstruct ReplicationDLQTaskState {\n  10: optional i64 (js.type = \"Long\") taskID\n  // class of the error of the last retry\n  20: optional string errorClass\n  30: optional i32 attempts\n  40: optional string lastError\n  50: optional i64 (js.type = \"Long\") lastAttemptTimestamp\n  // the task is not retried before this time\n  60: optional i64 (js.type = \"Long\") nextAttemptTimestamp\n  // the task is no longer retried\n  70: optional bool poison\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n\nstruct ReplicationDomainStatus {\n  10: optional string domainID\n  20: optional string domain\n  // number of replication tasks of the domain not acknowledged by the target cluster\n  30: optional i64 (js.type = \"Long\") backlog\n  // creation time of the oldest replication task of the domain not acknowledged by the target cluster\n  40: optional i64 (js.type = \"Long\") oldestTaskTimestamp\n  // number of replication tasks of the domain in the DLQ of the target cluster\n  50: optional i64 (js.type = \"Long\") dlqSize\n}\n\nstruct ReplicationShardStatus {\n  10: optional i32 shardID\n  // ID of the last replication task acknowledged by the target cluster\n  20: optional i64 (js.type = \"Long\") ackLevel\n  // ID of the last replication task created for the target cluster\n  30: optional i64 (js.type = \"Long\") lastTaskID\n  // time of the source cluster up to which the task processor of the target cluster applied the tasks\n  40: optional i64 (js.type = \"Long\") replicatedUpToTimestamp\n  50: optional list<ReplicationDomainStatus> domains\n  // the backlog or the DLQ of the shard has more tasks than were read, the backlog and DLQ sizes of the domains are lower bounds\n  60: optional bool truncated\n}\n\nstruct GetReplicationStatusResponse {\n  10: optional map<i32, ReplicationShardStatus> statusByShard\n  20: optional map<i32, shared.GetTaskFailedCause> failedCauseByShard\n}\n\nstruct ReplicationTaskFailure {\n  // number of failed attempts to apply the replication tasks of the domain\n  10: optional i64 (js.type = \"Long\") count\n  20: optional i64 (js.type = \"Long\") lastTimestamp\n  30: optional string lastError\n}\n\nstruct ReplicationProcessorState {\n  // time of the source cluster up to which the task processor applied the replication tasks\n  10: optional i64 (js.type = \"Long\") replicatedUpToTimestamp\n  20: optional map<string, ReplicationTaskFailure> failuresByDomain\n}\n\nstruct ReplicationShardReadiness {\n  10: optional i32 shardID\n  20: optional ReplicationProcessorState processorState\n  // number of replication tasks from the source cluster in the DLQ by domain ID\n  30: optional map<string, i64> dlqSizeByDomain\n}\n\nstruct GetReplicationReadinessResponse {\n  10: optional map<i32, ReplicationShardReadiness> readinessByShard\n  20: optional map<i32, shared.GetTaskFailedCause> failedCauseByShard\n}\n"
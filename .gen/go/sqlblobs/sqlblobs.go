@@ -6976,6 +6976,10 @@ type ShardInfo struct {
 	TimerProcessingQueueStatesEncoding        *string          `json:"timerProcessingQueueStatesEncoding,omitempty"`
 	CrossClusterProcessingQueueStates         []byte           `json:"crossClusterProcessingQueueStates,omitempty"`
 	CrossClusterProcessingQueueStatesEncoding *string          `json:"crossClusterProcessingQueueStatesEncoding,omitempty"`
+	ReplicationProcessorStates                []byte           `json:"replicationProcessorStates,omitempty"`
+	ReplicationProcessorStatesEncoding        *string          `json:"replicationProcessorStatesEncoding,omitempty"`
+	ReplicationDLQRetryStates                 []byte           `json:"replicationDLQRetryStates,omitempty"`
+	ReplicationDLQRetryStatesEncoding         *string          `json:"replicationDLQRetryStatesEncoding,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -7030,7 +7034,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [19]wire.Field
+		fields [23]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7188,6 +7192,38 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 61, Value: w}
 		i++
 	}
+	if v.ReplicationProcessorStates != nil {
+		w, err = wire.NewValueBinary(v.ReplicationProcessorStates), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+	if v.ReplicationProcessorStatesEncoding != nil {
+		w, err = wire.NewValueString(*(v.ReplicationProcessorStatesEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 66, Value: w}
+		i++
+	}
+	if v.ReplicationDLQRetryStates != nil {
+		w, err = wire.NewValueBinary(v.ReplicationDLQRetryStates), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 67, Value: w}
+		i++
+	}
+	if v.ReplicationDLQRetryStatesEncoding != nil {
+		w, err = wire.NewValueString(*(v.ReplicationDLQRetryStatesEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 68, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -7415,6 +7451,42 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 65:
+			if field.Value.Type() == wire.TBinary {
+				v.ReplicationProcessorStates, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 66:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ReplicationProcessorStatesEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 67:
+			if field.Value.Type() == wire.TBinary {
+				v.ReplicationDLQRetryStates, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 68:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ReplicationDLQRetryStatesEncoding = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -7682,6 +7754,54 @@ func (v *ShardInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ReplicationProcessorStates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 65, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ReplicationProcessorStates); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicationProcessorStatesEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 66, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ReplicationProcessorStatesEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicationDLQRetryStates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 67, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ReplicationDLQRetryStates); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicationDLQRetryStatesEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 68, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ReplicationDLQRetryStatesEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -7879,6 +7999,34 @@ func (v *ShardInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 65 && fh.Type == wire.TBinary:
+			v.ReplicationProcessorStates, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 66 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ReplicationProcessorStatesEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 67 && fh.Type == wire.TBinary:
+			v.ReplicationDLQRetryStates, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 68 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ReplicationDLQRetryStatesEncoding = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -7908,7 +8056,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [19]string
+	var fields [23]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -7986,6 +8134,22 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("CrossClusterProcessingQueueStatesEncoding: %v", *(v.CrossClusterProcessingQueueStatesEncoding))
 		i++
 	}
+	if v.ReplicationProcessorStates != nil {
+		fields[i] = fmt.Sprintf("ReplicationProcessorStates: %v", v.ReplicationProcessorStates)
+		i++
+	}
+	if v.ReplicationProcessorStatesEncoding != nil {
+		fields[i] = fmt.Sprintf("ReplicationProcessorStatesEncoding: %v", *(v.ReplicationProcessorStatesEncoding))
+		i++
+	}
+	if v.ReplicationDLQRetryStates != nil {
+		fields[i] = fmt.Sprintf("ReplicationDLQRetryStates: %v", v.ReplicationDLQRetryStates)
+		i++
+	}
+	if v.ReplicationDLQRetryStatesEncoding != nil {
+		fields[i] = fmt.Sprintf("ReplicationDLQRetryStatesEncoding: %v", *(v.ReplicationDLQRetryStatesEncoding))
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8074,6 +8238,18 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !_String_EqualsPtr(v.CrossClusterProcessingQueueStatesEncoding, rhs.CrossClusterProcessingQueueStatesEncoding) {
 		return false
 	}
+	if !((v.ReplicationProcessorStates == nil && rhs.ReplicationProcessorStates == nil) || (v.ReplicationProcessorStates != nil && rhs.ReplicationProcessorStates != nil && bytes.Equal(v.ReplicationProcessorStates, rhs.ReplicationProcessorStates))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ReplicationProcessorStatesEncoding, rhs.ReplicationProcessorStatesEncoding) {
+		return false
+	}
+	if !((v.ReplicationDLQRetryStates == nil && rhs.ReplicationDLQRetryStates == nil) || (v.ReplicationDLQRetryStates != nil && rhs.ReplicationDLQRetryStates != nil && bytes.Equal(v.ReplicationDLQRetryStates, rhs.ReplicationDLQRetryStates))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ReplicationDLQRetryStatesEncoding, rhs.ReplicationDLQRetryStatesEncoding) {
+		return false
+	}
 
 	return true
 }
@@ -8152,6 +8328,18 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.CrossClusterProcessingQueueStatesEncoding != nil {
 		enc.AddString("crossClusterProcessingQueueStatesEncoding", *v.CrossClusterProcessingQueueStatesEncoding)
 	}
+	if v.ReplicationProcessorStates != nil {
+		enc.AddString("replicationProcessorStates", base64.StdEncoding.EncodeToString(v.ReplicationProcessorStates))
+	}
+	if v.ReplicationProcessorStatesEncoding != nil {
+		enc.AddString("replicationProcessorStatesEncoding", *v.ReplicationProcessorStatesEncoding)
+	}
+	if v.ReplicationDLQRetryStates != nil {
+		enc.AddString("replicationDLQRetryStates", base64.StdEncoding.EncodeToString(v.ReplicationDLQRetryStates))
+	}
+	if v.ReplicationDLQRetryStatesEncoding != nil {
+		enc.AddString("replicationDLQRetryStatesEncoding", *v.ReplicationDLQRetryStatesEncoding)
+	}
 	return err
 }
 
@@ -8440,6 +8628,66 @@ func (v *ShardInfo) IsSetCrossClusterProcessingQueueStatesEncoding() bool {
 	return v != nil && v.CrossClusterProcessingQueueStatesEncoding != nil
 }
 
+// GetReplicationProcessorStates returns the value of ReplicationProcessorStates if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetReplicationProcessorStates() (o []byte) {
+	if v != nil && v.ReplicationProcessorStates != nil {
+		return v.ReplicationProcessorStates
+	}
+
+	return
+}
+
+// IsSetReplicationProcessorStates returns true if ReplicationProcessorStates is not nil.
+func (v *ShardInfo) IsSetReplicationProcessorStates() bool {
+	return v != nil && v.ReplicationProcessorStates != nil
+}
+
+// GetReplicationProcessorStatesEncoding returns the value of ReplicationProcessorStatesEncoding if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetReplicationProcessorStatesEncoding() (o string) {
+	if v != nil && v.ReplicationProcessorStatesEncoding != nil {
+		return *v.ReplicationProcessorStatesEncoding
+	}
+
+	return
+}
+
+// IsSetReplicationProcessorStatesEncoding returns true if ReplicationProcessorStatesEncoding is not nil.
+func (v *ShardInfo) IsSetReplicationProcessorStatesEncoding() bool {
+	return v != nil && v.ReplicationProcessorStatesEncoding != nil
+}
+
+// GetReplicationDLQRetryStates returns the value of ReplicationDLQRetryStates if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetReplicationDLQRetryStates() (o []byte) {
+	if v != nil && v.ReplicationDLQRetryStates != nil {
+		return v.ReplicationDLQRetryStates
+	}
+
+	return
+}
+
+// IsSetReplicationDLQRetryStates returns true if ReplicationDLQRetryStates is not nil.
+func (v *ShardInfo) IsSetReplicationDLQRetryStates() bool {
+	return v != nil && v.ReplicationDLQRetryStates != nil
+}
+
+// GetReplicationDLQRetryStatesEncoding returns the value of ReplicationDLQRetryStatesEncoding if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetReplicationDLQRetryStatesEncoding() (o string) {
+	if v != nil && v.ReplicationDLQRetryStatesEncoding != nil {
+		return *v.ReplicationDLQRetryStatesEncoding
+	}
+
+	return
+}
+
+// IsSetReplicationDLQRetryStatesEncoding returns true if ReplicationDLQRetryStatesEncoding is not nil.
+func (v *ShardInfo) IsSetReplicationDLQRetryStatesEncoding() bool {
+	return v != nil && v.ReplicationDLQRetryStatesEncoding != nil
+}
+
 type SignalInfo struct {
 	Version               *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -12029,6 +12277,13 @@ type WorkflowExecutionInfo struct {
 	Memo                                    map[string][]byte `json:"memo,omitempty"`
 	VersionHistories                        []byte            `json:"versionHistories,omitempty"`
 	VersionHistoriesEncoding                *string           `json:"versionHistoriesEncoding,omitempty"`
+	OffloadedPayloads                       map[string]int64  `json:"offloadedPayloads,omitempty"`
+	PauseReason                             *string           `json:"pauseReason,omitempty"`
+	PauseIdentity                           *string           `json:"pauseIdentity,omitempty"`
+	PausedTimeNanos                         *int64            `json:"pausedTimeNanos,omitempty"`
+	PauseAllowActivities                    *bool             `json:"pauseAllowActivities,omitempty"`
+	CompletionCallbacks                     []byte            `json:"completionCallbacks,omitempty"`
+	CompletionCallbacksEncoding             *string           `json:"completionCallbacksEncoding,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -12086,7 +12341,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [58]wire.Field
+		fields [65]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12556,6 +12811,62 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 124, Value: w}
 		i++
 	}
+	if v.OffloadedPayloads != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.OffloadedPayloads)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 126, Value: w}
+		i++
+	}
+	if v.PauseReason != nil {
+		w, err = wire.NewValueString(*(v.PauseReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 128, Value: w}
+		i++
+	}
+	if v.PauseIdentity != nil {
+		w, err = wire.NewValueString(*(v.PauseIdentity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.PausedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.PausedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 132, Value: w}
+		i++
+	}
+	if v.PauseAllowActivities != nil {
+		w, err = wire.NewValueBool(*(v.PauseAllowActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 134, Value: w}
+		i++
+	}
+	if v.CompletionCallbacks != nil {
+		w, err = wire.NewValueBinary(v.CompletionCallbacks), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 136, Value: w}
+		i++
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		w, err = wire.NewValueString(*(v.CompletionCallbacksEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 138, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -13169,6 +13480,72 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 126:
+			if field.Value.Type() == wire.TMap {
+				v.OffloadedPayloads, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PauseReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PauseIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 132:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PausedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 134:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.PauseAllowActivities = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TBinary {
+				v.CompletionCallbacks, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 138:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CompletionCallbacksEncoding = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -13907,6 +14284,90 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.OffloadedPayloads != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 126, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.OffloadedPayloads, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PauseReason != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 128, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.PauseReason)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PauseIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.PauseIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PausedTimeNanos != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 132, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.PausedTimeNanos)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PauseAllowActivities != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 134, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.PauseAllowActivities)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompletionCallbacks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 136, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.CompletionCallbacks); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompletionCallbacksEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 138, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.CompletionCallbacksEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -14412,6 +14873,58 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 126 && fh.Type == wire.TMap:
+			v.OffloadedPayloads, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 128 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PauseReason = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PauseIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 132 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.PausedTimeNanos = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 134 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.PauseAllowActivities = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 136 && fh.Type == wire.TBinary:
+			v.CompletionCallbacks, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 138 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.CompletionCallbacksEncoding = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -14441,7 +14954,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [58]string
+	var fields [65]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -14675,6 +15188,34 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("VersionHistoriesEncoding: %v", *(v.VersionHistoriesEncoding))
 		i++
 	}
+	if v.OffloadedPayloads != nil {
+		fields[i] = fmt.Sprintf("OffloadedPayloads: %v", v.OffloadedPayloads)
+		i++
+	}
+	if v.PauseReason != nil {
+		fields[i] = fmt.Sprintf("PauseReason: %v", *(v.PauseReason))
+		i++
+	}
+	if v.PauseIdentity != nil {
+		fields[i] = fmt.Sprintf("PauseIdentity: %v", *(v.PauseIdentity))
+		i++
+	}
+	if v.PausedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("PausedTimeNanos: %v", *(v.PausedTimeNanos))
+		i++
+	}
+	if v.PauseAllowActivities != nil {
+		fields[i] = fmt.Sprintf("PauseAllowActivities: %v", *(v.PauseAllowActivities))
+		i++
+	}
+	if v.CompletionCallbacks != nil {
+		fields[i] = fmt.Sprintf("CompletionCallbacks: %v", v.CompletionCallbacks)
+		i++
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		fields[i] = fmt.Sprintf("CompletionCallbacksEncoding: %v", *(v.CompletionCallbacksEncoding))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -14880,6 +15421,27 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.VersionHistoriesEncoding, rhs.VersionHistoriesEncoding) {
 		return false
 	}
+	if !((v.OffloadedPayloads == nil && rhs.OffloadedPayloads == nil) || (v.OffloadedPayloads != nil && rhs.OffloadedPayloads != nil && _Map_String_I64_Equals(v.OffloadedPayloads, rhs.OffloadedPayloads))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PauseReason, rhs.PauseReason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PauseIdentity, rhs.PauseIdentity) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PausedTimeNanos, rhs.PausedTimeNanos) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.PauseAllowActivities, rhs.PauseAllowActivities) {
+		return false
+	}
+	if !((v.CompletionCallbacks == nil && rhs.CompletionCallbacks == nil) || (v.CompletionCallbacks != nil && rhs.CompletionCallbacks != nil && bytes.Equal(v.CompletionCallbacks, rhs.CompletionCallbacks))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CompletionCallbacksEncoding, rhs.CompletionCallbacksEncoding) {
+		return false
+	}
 
 	return true
 }
@@ -15075,6 +15637,27 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.VersionHistoriesEncoding != nil {
 		enc.AddString("versionHistoriesEncoding", *v.VersionHistoriesEncoding)
 	}
+	if v.OffloadedPayloads != nil {
+		err = multierr.Append(err, enc.AddObject("offloadedPayloads", (_Map_String_I64_Zapper)(v.OffloadedPayloads)))
+	}
+	if v.PauseReason != nil {
+		enc.AddString("pauseReason", *v.PauseReason)
+	}
+	if v.PauseIdentity != nil {
+		enc.AddString("pauseIdentity", *v.PauseIdentity)
+	}
+	if v.PausedTimeNanos != nil {
+		enc.AddInt64("pausedTimeNanos", *v.PausedTimeNanos)
+	}
+	if v.PauseAllowActivities != nil {
+		enc.AddBool("pauseAllowActivities", *v.PauseAllowActivities)
+	}
+	if v.CompletionCallbacks != nil {
+		enc.AddString("completionCallbacks", base64.StdEncoding.EncodeToString(v.CompletionCallbacks))
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		enc.AddString("completionCallbacksEncoding", *v.CompletionCallbacksEncoding)
+	}
 	return err
 }
 
@@ -15948,16 +16531,121 @@ func (v *WorkflowExecutionInfo) IsSetVersionHistoriesEncoding() bool {
 	return v != nil && v.VersionHistoriesEncoding != nil
 }
 
+// GetOffloadedPayloads returns the value of OffloadedPayloads if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetOffloadedPayloads() (o map[string]int64) {
+	if v != nil && v.OffloadedPayloads != nil {
+		return v.OffloadedPayloads
+	}
+
+	return
+}
+
+// IsSetOffloadedPayloads returns true if OffloadedPayloads is not nil.
+func (v *WorkflowExecutionInfo) IsSetOffloadedPayloads() bool {
+	return v != nil && v.OffloadedPayloads != nil
+}
+
+// GetPauseReason returns the value of PauseReason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPauseReason() (o string) {
+	if v != nil && v.PauseReason != nil {
+		return *v.PauseReason
+	}
+
+	return
+}
+
+// IsSetPauseReason returns true if PauseReason is not nil.
+func (v *WorkflowExecutionInfo) IsSetPauseReason() bool {
+	return v != nil && v.PauseReason != nil
+}
+
+// GetPauseIdentity returns the value of PauseIdentity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPauseIdentity() (o string) {
+	if v != nil && v.PauseIdentity != nil {
+		return *v.PauseIdentity
+	}
+
+	return
+}
+
+// IsSetPauseIdentity returns true if PauseIdentity is not nil.
+func (v *WorkflowExecutionInfo) IsSetPauseIdentity() bool {
+	return v != nil && v.PauseIdentity != nil
+}
+
+// GetPausedTimeNanos returns the value of PausedTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPausedTimeNanos() (o int64) {
+	if v != nil && v.PausedTimeNanos != nil {
+		return *v.PausedTimeNanos
+	}
+
+	return
+}
+
+// IsSetPausedTimeNanos returns true if PausedTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetPausedTimeNanos() bool {
+	return v != nil && v.PausedTimeNanos != nil
+}
+
+// GetPauseAllowActivities returns the value of PauseAllowActivities if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPauseAllowActivities() (o bool) {
+	if v != nil && v.PauseAllowActivities != nil {
+		return *v.PauseAllowActivities
+	}
+
+	return
+}
+
+// IsSetPauseAllowActivities returns true if PauseAllowActivities is not nil.
+func (v *WorkflowExecutionInfo) IsSetPauseAllowActivities() bool {
+	return v != nil && v.PauseAllowActivities != nil
+}
+
+// GetCompletionCallbacks returns the value of CompletionCallbacks if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCompletionCallbacks() (o []byte) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+
+	return
+}
+
+// IsSetCompletionCallbacks returns true if CompletionCallbacks is not nil.
+func (v *WorkflowExecutionInfo) IsSetCompletionCallbacks() bool {
+	return v != nil && v.CompletionCallbacks != nil
+}
+
+// GetCompletionCallbacksEncoding returns the value of CompletionCallbacksEncoding if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCompletionCallbacksEncoding() (o string) {
+	if v != nil && v.CompletionCallbacksEncoding != nil {
+		return *v.CompletionCallbacksEncoding
+	}
+
+	return
+}
+
+// IsSetCompletionCallbacksEncoding returns true if CompletionCallbacksEncoding is not nil.
+func (v *WorkflowExecutionInfo) IsSetCompletionCallbacksEncoding() bool {
+	return v != nil && v.CompletionCallbacksEncoding != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "215db027f8e11111bbb3bc537dcd7dc0e4a09213",
+	SHA1:     "720ef37e5e671555473e902110d9606d738f6c95",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  65: optional binary replicationProcessorStates\n  66: optional string replicationProcessorStatesEncoding\n  67: optional binary replicationDLQRetryStates\n  68: optional string replicationDLQRetryStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional map<string, i64> offloadedPayloads\n  128: optional string pauseReason\n  130: optional string pauseIdentity\n  132: optional i64 (js.type = \"Long\") pausedTimeNanos\n  134: optional bool pauseAllowActivities\n  136: optional binary completionCallbacks\n  138: optional string completionCallbacksEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
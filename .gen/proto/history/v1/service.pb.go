@@ -641,6 +641,194 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
+type PauseWorkflowExecutionRequest struct {
+	Request              *v1.PauseWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()         { *m = PauseWorkflowExecutionRequest{} }
+func (m *PauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{10}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetRequest() *v1.PauseWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *PauseWorkflowExecutionRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type PauseWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()         { *m = PauseWorkflowExecutionResponse{} }
+func (m *PauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{11}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	Request              *v1.UnpauseWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                              `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()         { *m = UnpauseWorkflowExecutionRequest{} }
+func (m *UnpauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetRequest() *v1.UnpauseWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()         { *m = UnpauseWorkflowExecutionResponse{} }
+func (m *UnpauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
 type DescribeWorkflowExecutionRequest struct {
 	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -653,7 +841,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{10}
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,6 +890,8 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
 	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
+	PauseInfo              *v1.WorkflowPauseInfo              `protobuf:"bytes,6,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	CompletionCallbacks    []*v1.CompletionCallbackInfo       `protobuf:"bytes,7,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
 	XXX_unrecognized       []byte                             `json:"-"`
 	XXX_sizecache          int32                              `json:"-"`
@@ -711,7 +901,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{11}
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,6 +965,20 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDeci
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetPauseInfo() *v1.WorkflowPauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() []*v1.CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
 type QueryWorkflowRequest struct {
 	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -787,7 +991,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +1046,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1101,7 @@ func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListR
 func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListRequest) ProtoMessage()    {}
 func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
 func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1154,7 @@ func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskList
 func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListResponse) ProtoMessage()    {}
 func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
 }
 func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1197,7 @@ func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{}
 func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateRequest) ProtoMessage()    {}
 func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
 func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1281,7 @@ func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse
 func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateResponse) ProtoMessage()    {}
 func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
 func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1443,7 @@ func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest
 func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateRequest) ProtoMessage()    {}
 func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
 func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1525,7 @@ func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateRespon
 func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateResponse) ProtoMessage()    {}
 func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
 func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1676,7 @@ func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecision
 func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
 }
 func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1771,7 @@ func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisio
 func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
 func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1711,7 +1915,7 @@ func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivity
 func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
 func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
 func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1799,7 +2003,7 @@ func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivit
 func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
 func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
 }
 func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1889,7 +2093,7 @@ func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDeci
 func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
 }
 func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2148,7 @@ func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDec
 func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
 }
 func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2203,7 @@ func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisio
 func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
 func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,7 +2256,7 @@ func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisi
 func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
 func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2297,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivi
 func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
 func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2147,7 +2351,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActiv
 func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
 func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2399,7 @@ func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
 }
 func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,7 +2452,7 @@ func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondAct
 func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
 }
 func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2493,7 @@ func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivit
 func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
 }
 func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2546,7 @@ func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivi
 func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
 func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,7 +2587,7 @@ func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActiv
 func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
 }
 func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2640,7 @@ func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
 func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2478,7 +2682,7 @@ func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMut
 func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
 func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
 func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2538,7 +2742,7 @@ func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMu
 func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
 func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
 func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2584,7 +2788,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2653,7 +2857,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2695,7 +2899,7 @@ func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTas
 func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
 func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
 func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +2959,7 @@ func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTa
 func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
 func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
 func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2804,7 +3008,7 @@ func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChil
 func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2878,7 +3082,7 @@ func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChi
 func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3127,7 @@ func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Reque
 func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Request) ProtoMessage()    {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2997,7 +3201,7 @@ func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Resp
 func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Response) ProtoMessage()    {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3039,7 +3243,7 @@ func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{}
 func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusRequest) ProtoMessage()    {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3303,7 @@ func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse
 func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusResponse) ProtoMessage()    {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3151,7 +3355,7 @@ func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
 func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityRequest) ProtoMessage()    {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3281,7 +3485,7 @@ func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
 func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityResponse) ProtoMessage()    {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3526,7 @@ func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStat
 func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateRequest) ProtoMessage()    {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3377,7 +3581,7 @@ func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableSta
 func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateResponse) ProtoMessage()    {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3430,7 +3634,7 @@ func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostR
 func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostRequest) ProtoMessage()    {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3474,7 +3678,7 @@ func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHost
 func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostResponse) ProtoMessage()    {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
+	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3549,7 +3753,7 @@ func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
 func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseShardRequest) ProtoMessage()    {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
+	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3799,7 @@ func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
 func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseShardResponse) ProtoMessage()    {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{55}
+	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3639,7 +3843,7 @@ func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
 func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskRequest) ProtoMessage()    {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{56}
+	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3713,7 +3917,7 @@ func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
 func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskResponse) ProtoMessage()    {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{57}
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3755,7 +3959,7 @@ func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
 func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueRequest) ProtoMessage()    {}
 func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{58}
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
 func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3815,7 +4019,7 @@ func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
 func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueResponse) ProtoMessage()    {}
 func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{59}
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
 }
 func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3857,7 +4061,7 @@ func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
 func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueRequest) ProtoMessage()    {}
 func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{60}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3918,7 +4122,7 @@ func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
 func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueResponse) ProtoMessage()    {}
 func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4170,7 @@ func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMes
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4224,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4067,7 +4271,7 @@ func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicat
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4114,7 +4318,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4164,7 +4368,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4231,7 +4435,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4273,7 +4477,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4333,7 +4537,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4362,10 +4566,204 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-type ReadDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+type OperatePendingActivityRequest struct {
+	Domain            string                       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId          string                       `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution        `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId        string                       `protobuf:"bytes,4,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Operation         v11.PendingActivityOperation `protobuf:"varint,5,opt,name=operation,proto3,enum=uber.cadence.shared.v1.PendingActivityOperation" json:"operation,omitempty"`
+	// for UPDATE_OPTIONS, only the options which are set are updated
+	ScheduleToStartTimeout *types.Duration `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	ScheduleToCloseTimeout *types.Duration `protobuf:"bytes,7,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	StartToCloseTimeout    *types.Duration `protobuf:"bytes,8,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout       *types.Duration `protobuf:"bytes,9,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy            *v1.RetryPolicy `protobuf:"bytes,10,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// for RETRY
+	ResetAttempts bool `protobuf:"varint,11,opt,name=reset_attempts,json=resetAttempts,proto3" json:"reset_attempts,omitempty"`
+	// for FAIL
+	Failure *v1.Failure `protobuf:"bytes,12,opt,name=failure,proto3" json:"failure,omitempty"`
+	// for SKIP
+	Result               *v1.Payload `protobuf:"bytes,13,opt,name=result,proto3" json:"result,omitempty"`
+	Identity             string      `protobuf:"bytes,14,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *OperatePendingActivityRequest) Reset()         { *m = OperatePendingActivityRequest{} }
+func (m *OperatePendingActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePendingActivityRequest) ProtoMessage()    {}
+func (*OperatePendingActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
+}
+func (m *OperatePendingActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatePendingActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatePendingActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatePendingActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePendingActivityRequest.Merge(m, src)
+}
+func (m *OperatePendingActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatePendingActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePendingActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePendingActivityRequest proto.InternalMessageInfo
+
+func (m *OperatePendingActivityRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *OperatePendingActivityRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *OperatePendingActivityRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *OperatePendingActivityRequest) GetOperation() v11.PendingActivityOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return v11.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_INVALID
+}
+
+func (m *OperatePendingActivityRequest) GetScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetScheduleToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetHeartbeatTimeout() *types.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetRetryPolicy() *v1.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetResetAttempts() bool {
+	if m != nil {
+		return m.ResetAttempts
+	}
+	return false
+}
+
+func (m *OperatePendingActivityRequest) GetFailure() *v1.Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetResult() *v1.Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *OperatePendingActivityRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type OperatePendingActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperatePendingActivityResponse) Reset()         { *m = OperatePendingActivityResponse{} }
+func (m *OperatePendingActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*OperatePendingActivityResponse) ProtoMessage()    {}
+func (*OperatePendingActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
+}
+func (m *OperatePendingActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatePendingActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatePendingActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatePendingActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePendingActivityResponse.Merge(m, src)
+}
+func (m *OperatePendingActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatePendingActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePendingActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePendingActivityResponse proto.InternalMessageInfo
+
+type ReadDLQMessagesRequest struct {
+	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
 	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
@@ -4378,7 +4776,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4450,20 +4848,21 @@ func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type ReadDLQMessagesResponse struct {
-	Type                 v11.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
-	ReplicationTasks     []*v11.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	ReplicationTasksInfo []*v11.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Type                 v11.DLQType                    `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
+	ReplicationTasks     []*v11.ReplicationTask         `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasksInfo []*v11.ReplicationTaskInfo     `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	NextPageToken        []byte                         `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	RetryStates          []*v11.ReplicationDLQTaskState `protobuf:"bytes,5,rep,name=retry_states,json=retryStates,proto3" json:"retry_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse{} }
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4520,6 +4919,13 @@ func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *ReadDLQMessagesResponse) GetRetryStates() []*v11.ReplicationDLQTaskState {
+	if m != nil {
+		return m.RetryStates
+	}
+	return nil
+}
+
 type PurgeDLQMessagesRequest struct {
 	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
 	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -4534,7 +4940,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4601,7 +5007,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4646,7 +5052,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4728,7 +5134,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4775,7 +5181,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4821,7 +5227,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4862,7 +5268,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4917,7 +5323,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4960,30 +5366,26 @@ func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v11.Get
 	return nil
 }
 
-type RespondCrossClusterTasksCompletedRequest struct {
-	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	TaskResponses        []*v11.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
-	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type GetReplicationStatusRequest struct {
+	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	RemoteCluster        string   `protobuf:"bytes,2,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
-	*m = RespondCrossClusterTasksCompletedRequest{}
-}
-func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
-func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+func (m *GetReplicationStatusRequest) Reset()         { *m = GetReplicationStatusRequest{} }
+func (m *GetReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusRequest) ProtoMessage()    {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4993,69 +5395,52 @@ func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+func (m *GetReplicationStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
-
-func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
-	if m != nil {
-		return m.ShardId
-	}
-	return 0
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
-	if m != nil {
-		return m.TargetCluster
-	}
-	return ""
-}
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v11.CrossClusterTaskResponse {
+func (m *GetReplicationStatusRequest) GetShardIds() []int32 {
 	if m != nil {
-		return m.TaskResponses
+		return m.ShardIds
 	}
 	return nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
+func (m *GetReplicationStatusRequest) GetRemoteCluster() string {
 	if m != nil {
-		return m.FetchNewTasks
+		return m.RemoteCluster
 	}
-	return false
+	return ""
 }
 
-type RespondCrossClusterTasksCompletedResponse struct {
-	Tasks                *v11.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type GetReplicationStatusResponse struct {
+	StatusByShard        map[int32]*v11.ReplicationShardStatus `protobuf:"bytes,1,rep,name=status_by_shard,json=statusByShard,proto3" json:"status_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedCauseByShard   map[int32]v11.GetTaskFailedCause      `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.shared.v1.GetTaskFailedCause"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
-	*m = RespondCrossClusterTasksCompletedResponse{}
-}
-func (m *RespondCrossClusterTasksCompletedResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
-func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+func (m *GetReplicationStatusResponse) Reset()         { *m = GetReplicationStatusResponse{} }
+func (m *GetReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusResponse) ProtoMessage()    {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5065,44 +5450,52 @@ func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+func (m *GetReplicationStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v11.CrossClusterTaskRequests {
+func (m *GetReplicationStatusResponse) GetStatusByShard() map[int32]*v11.ReplicationShardStatus {
 	if m != nil {
-		return m.Tasks
+		return m.StatusByShard
 	}
 	return nil
 }
 
-type GetFailoverInfoRequest struct {
-	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+func (m *GetReplicationStatusResponse) GetFailedCauseByShard() map[int32]v11.GetTaskFailedCause {
+	if m != nil {
+		return m.FailedCauseByShard
+	}
+	return nil
+}
+
+type GetReplicationReadinessRequest struct {
+	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	SourceCluster        string   `protobuf:"bytes,2,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
-func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFailoverInfoRequest) ProtoMessage()    {}
-func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+func (m *GetReplicationReadinessRequest) Reset()         { *m = GetReplicationReadinessRequest{} }
+func (m *GetReplicationReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationReadinessRequest) ProtoMessage()    {}
+func (*GetReplicationReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
 }
-func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationReadinessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationReadinessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5112,21 +5505,254 @@ func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+func (m *GetReplicationReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationReadinessRequest.Merge(m, src)
 }
-func (m *GetFailoverInfoRequest) XXX_Size() int {
+func (m *GetReplicationReadinessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
+func (m *GetReplicationReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationReadinessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicationReadinessRequest proto.InternalMessageInfo
 
-func (m *GetFailoverInfoRequest) GetDomainId() string {
+func (m *GetReplicationReadinessRequest) GetShardIds() []int32 {
 	if m != nil {
-		return m.DomainId
+		return m.ShardIds
+	}
+	return nil
+}
+
+func (m *GetReplicationReadinessRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+type GetReplicationReadinessResponse struct {
+	ReadinessByShard     map[int32]*v11.ReplicationShardReadiness `protobuf:"bytes,1,rep,name=readiness_by_shard,json=readinessByShard,proto3" json:"readiness_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedCauseByShard   map[int32]v11.GetTaskFailedCause         `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.shared.v1.GetTaskFailedCause"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *GetReplicationReadinessResponse) Reset()         { *m = GetReplicationReadinessResponse{} }
+func (m *GetReplicationReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationReadinessResponse) ProtoMessage()    {}
+func (*GetReplicationReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
+}
+func (m *GetReplicationReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationReadinessResponse.Merge(m, src)
+}
+func (m *GetReplicationReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationReadinessResponse proto.InternalMessageInfo
+
+func (m *GetReplicationReadinessResponse) GetReadinessByShard() map[int32]*v11.ReplicationShardReadiness {
+	if m != nil {
+		return m.ReadinessByShard
+	}
+	return nil
+}
+
+func (m *GetReplicationReadinessResponse) GetFailedCauseByShard() map[int32]v11.GetTaskFailedCause {
+	if m != nil {
+		return m.FailedCauseByShard
+	}
+	return nil
+}
+
+type RespondCrossClusterTasksCompletedRequest struct {
+	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	TaskResponses        []*v11.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
+	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
+	*m = RespondCrossClusterTasksCompletedRequest{}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
+func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v11.CrossClusterTaskResponse {
+	if m != nil {
+		return m.TaskResponses
+	}
+	return nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
+	if m != nil {
+		return m.FetchNewTasks
+	}
+	return false
+}
+
+type RespondCrossClusterTasksCompletedResponse struct {
+	Tasks                *v11.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
+	*m = RespondCrossClusterTasksCompletedResponse{}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
+func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v11.CrossClusterTaskRequests {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type GetFailoverInfoRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
+func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFailoverInfoRequest) ProtoMessage()    {}
+func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
+}
+func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+}
+func (m *GetFailoverInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+
+func (m *GetFailoverInfoRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
 	return ""
 }
@@ -5143,7 +5769,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{93}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5197,6 +5823,10 @@ func init() {
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.UnpauseWorkflowExecutionResponse")
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
 	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
@@ -5260,6 +5890,8 @@ func init() {
 	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*OperatePendingActivityRequest)(nil), "uber.cadence.history.v1.OperatePendingActivityRequest")
+	proto.RegisterType((*OperatePendingActivityResponse)(nil), "uber.cadence.history.v1.OperatePendingActivityResponse")
 	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
 	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
 	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
@@ -5272,6 +5904,14 @@ func init() {
 	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
 	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
 	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "uber.cadence.history.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "uber.cadence.history.v1.GetReplicationStatusResponse")
+	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetReplicationStatusResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v11.ReplicationShardStatus)(nil), "uber.cadence.history.v1.GetReplicationStatusResponse.StatusByShardEntry")
+	proto.RegisterType((*GetReplicationReadinessRequest)(nil), "uber.cadence.history.v1.GetReplicationReadinessRequest")
+	proto.RegisterType((*GetReplicationReadinessResponse)(nil), "uber.cadence.history.v1.GetReplicationReadinessResponse")
+	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetReplicationReadinessResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v11.ReplicationShardReadiness)(nil), "uber.cadence.history.v1.GetReplicationReadinessResponse.ReadinessByShardEntry")
 	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
 	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
 	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
@@ -5283,303 +5923,336 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc9,
-	0x56, 0x56, 0x7b, 0xe2, 0xbf, 0x63, 0x7b, 0x6c, 0x57, 0xfc, 0x33, 0x1e, 0x27, 0x8e, 0xdd, 0x77,
-	0x93, 0x78, 0xb3, 0xbb, 0x93, 0x8d, 0xb3, 0x9b, 0x64, 0xb3, 0xbb, 0x77, 0x37, 0xb1, 0x93, 0xec,
-	0x44, 0xf9, 0x6d, 0x9b, 0x04, 0x10, 0x4a, 0xab, 0x3d, 0x5d, 0x63, 0x37, 0x9e, 0xe9, 0x9e, 0x74,
-	0xf7, 0xd8, 0x99, 0x7d, 0x40, 0x17, 0xb8, 0x42, 0xba, 0x57, 0x08, 0x2e, 0x70, 0x41, 0x48, 0x48,
-	0x57, 0x42, 0x17, 0xe9, 0xc2, 0xf2, 0xc0, 0x03, 0xbc, 0x21, 0x9e, 0x78, 0x81, 0x07, 0x24, 0x5e,
-	0x79, 0x02, 0xad, 0xae, 0x78, 0x00, 0x89, 0x17, 0x10, 0x8f, 0x08, 0xd5, 0x4f, 0xff, 0x4d, 0x57,
-	0x57, 0xf7, 0xd8, 0x48, 0xd9, 0xbb, 0xec, 0x9b, 0xa7, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0x7d,
-	0x75, 0xea, 0x9c, 0xd3, 0x86, 0xf3, 0xdd, 0x5d, 0xec, 0x5e, 0x6e, 0x18, 0x26, 0xb6, 0x1b, 0xf8,
-	0xf2, 0xbe, 0xe5, 0xf9, 0x8e, 0xdb, 0xbb, 0x7c, 0x78, 0xe5, 0xb2, 0x87, 0xdd, 0x43, 0xab, 0x81,
-	0x6b, 0x1d, 0xd7, 0xf1, 0x1d, 0xb4, 0x48, 0x86, 0xd5, 0xf8, 0xb0, 0x1a, 0x1f, 0x56, 0x3b, 0xbc,
-	0x52, 0x5d, 0xd9, 0x73, 0x9c, 0xbd, 0x16, 0xbe, 0x4c, 0x87, 0xed, 0x76, 0x9b, 0x97, 0xcd, 0xae,
-	0x6b, 0xf8, 0x96, 0x63, 0x33, 0xc2, 0xea, 0xb9, 0xfe, 0x7e, 0xdf, 0x6a, 0x63, 0xcf, 0x37, 0xda,
-	0x1d, 0x3e, 0x20, 0xc5, 0xe0, 0xc8, 0x35, 0x3a, 0x1d, 0xec, 0x7a, 0xbc, 0x7f, 0x35, 0x21, 0xa0,
-	0xd1, 0xb1, 0x88, 0x70, 0x0d, 0xa7, 0xdd, 0x0e, 0xa7, 0x58, 0x13, 0x8d, 0x08, 0x44, 0xe4, 0x52,
-	0x88, 0x86, 0xbc, 0xec, 0xe2, 0x70, 0x80, 0x2a, 0x1a, 0xe0, 0x1b, 0xde, 0x41, 0xcb, 0xf2, 0x7c,
-	0xd9, 0x98, 0x23, 0xc7, 0x3d, 0x68, 0xb6, 0x9c, 0x23, 0x3e, 0xe6, 0x92, 0x68, 0x0c, 0x57, 0xa5,
-	0xde, 0x37, 0x76, 0x3d, 0x6f, 0x2c, 0x76, 0xf9, 0xc8, 0x37, 0x12, 0x23, 0xbd, 0x7d, 0xc3, 0xc5,
-	0x26, 0x55, 0x43, 0xab, 0xeb, 0xf9, 0xb9, 0xa3, 0x92, 0xaa, 0x50, 0x33, 0x46, 0xbd, 0xec, 0xe2,
-	0x2e, 0x16, 0x4a, 0x16, 0x8d, 0x71, 0x71, 0xa7, 0x65, 0x35, 0xe2, 0xdb, 0x7b, 0x3e, 0x63, 0x64,
-	0x72, 0xa9, 0xea, 0x9f, 0x0e, 0xc3, 0xd9, 0x6d, 0xdf, 0x70, 0xfd, 0xe7, 0xbc, 0xfd, 0xce, 0x2b,
-	0xdc, 0xe8, 0x12, 0x3e, 0x1a, 0x7e, 0xd9, 0xc5, 0x9e, 0x8f, 0x1e, 0xc0, 0xa8, 0xcb, 0xfe, 0xac,
-	0x28, 0xab, 0xca, 0xfa, 0xc4, 0xc6, 0x46, 0x2d, 0x61, 0x72, 0x46, 0xc7, 0xaa, 0x1d, 0x5e, 0xa9,
-	0x49, 0x99, 0x68, 0x01, 0x0b, 0xb4, 0x0c, 0xe3, 0xa6, 0xd3, 0x36, 0x2c, 0x5b, 0xb7, 0xcc, 0xca,
-	0xd0, 0xaa, 0xb2, 0x3e, 0xae, 0x8d, 0xb1, 0x86, 0xba, 0x89, 0x7e, 0x09, 0xe6, 0x3b, 0x86, 0x8b,
-	0x6d, 0x5f, 0xc7, 0x01, 0x03, 0xdd, 0xb2, 0x9b, 0x4e, 0xa5, 0x44, 0x27, 0x5e, 0x17, 0x4e, 0xfc,
-	0x84, 0x52, 0x84, 0x33, 0xd6, 0xed, 0xa6, 0xa3, 0x9d, 0xee, 0xa4, 0x1b, 0x51, 0x05, 0x46, 0x0d,
-	0xdf, 0xc7, 0xed, 0x8e, 0x5f, 0x39, 0xb5, 0xaa, 0xac, 0x0f, 0x6b, 0xc1, 0x4f, 0xb4, 0x09, 0xd3,
-	0xf8, 0x55, 0xc7, 0x62, 0xc7, 0x43, 0x27, 0xe7, 0xa0, 0x32, 0x4c, 0x67, 0xac, 0xd6, 0xd8, 0x19,
-	0xa8, 0x05, 0x67, 0xa0, 0xb6, 0x13, 0x1c, 0x12, 0xad, 0x1c, 0x91, 0x90, 0x46, 0xd4, 0x84, 0xa5,
-	0x86, 0x63, 0xfb, 0x96, 0xdd, 0xc5, 0xba, 0xe1, 0xe9, 0x36, 0x3e, 0xd2, 0x2d, 0xdb, 0xf2, 0x2d,
-	0xc3, 0x77, 0xdc, 0xca, 0xc8, 0xaa, 0xb2, 0x5e, 0xde, 0x78, 0x4b, 0xb8, 0x80, 0x4d, 0x4e, 0x75,
-	0xcb, 0x7b, 0x84, 0x8f, 0xea, 0x01, 0x89, 0xb6, 0xd0, 0x10, 0xb6, 0xa3, 0x3a, 0xcc, 0x06, 0x3d,
-	0xa6, 0xde, 0x34, 0xac, 0x56, 0xd7, 0xc5, 0x95, 0x51, 0x2a, 0xee, 0x19, 0x21, 0xff, 0xbb, 0x6c,
-	0x8c, 0x36, 0x13, 0x92, 0xf1, 0x16, 0xa4, 0xc1, 0x42, 0xcb, 0xf0, 0x7c, 0xbd, 0xe1, 0xb4, 0x3b,
-	0x2d, 0x4c, 0x17, 0xef, 0x62, 0xaf, 0xdb, 0xf2, 0x2b, 0x63, 0x12, 0x7e, 0x4f, 0x8c, 0x5e, 0xcb,
-	0x31, 0x4c, 0x6d, 0x8e, 0xd0, 0x6e, 0x86, 0xa4, 0x1a, 0xa5, 0x44, 0x3f, 0x0f, 0xcb, 0x4d, 0xcb,
-	0xf5, 0x7c, 0xdd, 0xc4, 0x0d, 0xcb, 0xa3, 0xfa, 0x34, 0xbc, 0x03, 0x7d, 0xd7, 0x68, 0x1c, 0x38,
-	0xcd, 0x66, 0x65, 0x9c, 0x32, 0x5e, 0x4a, 0xe9, 0x75, 0x8b, 0x83, 0x93, 0x56, 0xa1, 0xd4, 0x5b,
-	0x9c, 0x78, 0xc7, 0xf0, 0x0e, 0x6e, 0x33, 0x52, 0xf5, 0x3a, 0xac, 0x64, 0x19, 0x99, 0xd7, 0x71,
-	0x6c, 0x0f, 0xa3, 0x79, 0x18, 0x71, 0xbb, 0xd4, 0xb2, 0x14, 0x6a, 0x59, 0xc3, 0x6e, 0xd7, 0xae,
-	0x9b, 0xea, 0x9f, 0x0c, 0xc1, 0xca, 0xb6, 0xb5, 0x67, 0x1b, 0xad, 0x4c, 0x23, 0x7f, 0xd8, 0x6f,
-	0xe4, 0x57, 0xc5, 0x46, 0x2e, 0xe5, 0x52, 0xd0, 0xca, 0x9b, 0xb0, 0x8c, 0x5f, 0xf9, 0xd8, 0xb5,
-	0x8d, 0x56, 0x08, 0x3c, 0x91, 0xc1, 0x73, 0x5b, 0xbf, 0x20, 0x9c, 0x3f, 0x3d, 0xf3, 0x52, 0xc0,
-	0x2a, 0xd5, 0x85, 0x6a, 0x70, 0xba, 0xb1, 0x6f, 0xb5, 0xcc, 0x68, 0x12, 0xc7, 0x6e, 0xf5, 0xa8,
-	0xed, 0x8f, 0x69, 0xb3, 0xb4, 0x2b, 0x20, 0x7a, 0x6c, 0xb7, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x5c,
-	0x1f, 0x53, 0xb0, 0xfa, 0x23, 0x05, 0x2e, 0xf2, 0x31, 0x96, 0xbf, 0x2f, 0xc7, 0x8d, 0x67, 0xfd,
-	0x2a, 0xfd, 0x48, 0xa6, 0xd2, 0x3c, 0x76, 0xc5, 0x74, 0xab, 0xde, 0x82, 0xf5, 0x7c, 0x86, 0x72,
-	0x6b, 0xf9, 0xbe, 0x02, 0x67, 0x35, 0xec, 0xe1, 0x13, 0x23, 0xa2, 0x94, 0x49, 0xc1, 0xf5, 0x5c,
-	0x87, 0x95, 0x2c, 0x36, 0xf2, 0x55, 0x7c, 0x31, 0x04, 0x6b, 0x3b, 0xd8, 0x6d, 0x5b, 0xb6, 0xe1,
-	0xe3, 0xcc, 0x95, 0x3c, 0xe9, 0x5f, 0xc9, 0x35, 0xe1, 0x4a, 0x72, 0x19, 0xfd, 0x8c, 0x5b, 0xfe,
-	0x1b, 0xa0, 0xca, 0x96, 0xc8, 0x8d, 0xff, 0x07, 0x0a, 0xac, 0x6e, 0x61, 0xaf, 0xe1, 0x5a, 0xbb,
-	0xd9, 0x1a, 0x7d, 0xdc, 0xaf, 0xd1, 0xf7, 0x85, 0xcb, 0xc9, 0xe3, 0x53, 0xd0, 0x3c, 0xfe, 0xa7,
-	0x04, 0x6b, 0x12, 0x56, 0xdc, 0x44, 0x5a, 0xb0, 0x18, 0xdd, 0xa7, 0x0d, 0xc7, 0x6e, 0x5a, 0x7b,
-	0x1c, 0x6d, 0xa5, 0x60, 0x97, 0x62, 0xb8, 0x19, 0x27, 0xd5, 0x16, 0xb0, 0xb0, 0x1d, 0xed, 0xc2,
-	0x62, 0x7a, 0x6f, 0xd9, 0x35, 0x3e, 0x44, 0x67, 0xbb, 0x54, 0x6c, 0x36, 0x7a, 0x91, 0xcf, 0x1f,
-	0x89, 0x9a, 0xd1, 0x73, 0x40, 0x1d, 0x6c, 0x9b, 0x96, 0xbd, 0xa7, 0x1b, 0x0d, 0xdf, 0x3a, 0xb4,
-	0x7c, 0x0b, 0x7b, 0x95, 0xd2, 0x6a, 0x29, 0xdb, 0x4b, 0x60, 0xc3, 0x6f, 0xb1, 0xd1, 0x3d, 0xca,
-	0x7c, 0xb6, 0x93, 0x68, 0xb4, 0xb0, 0x87, 0x7e, 0x01, 0x66, 0x02, 0xc6, 0xd4, 0x4c, 0x5c, 0x6c,
-	0x57, 0x4e, 0x51, 0xb6, 0x35, 0x19, 0xdb, 0x4d, 0x32, 0x36, 0x29, 0xf9, 0x74, 0x27, 0xd6, 0xe5,
-	0x62, 0x1b, 0x6d, 0x47, 0xac, 0x83, 0xab, 0x91, 0x7b, 0x19, 0x52, 0x89, 0x83, 0x9b, 0x30, 0xc1,
-	0x34, 0x68, 0x54, 0x5f, 0xc1, 0xdc, 0x53, 0xe2, 0x2c, 0x07, 0xda, 0x0b, 0xcc, 0x70, 0xb3, 0xdf,
-	0x0c, 0xdf, 0x14, 0xce, 0x21, 0xa2, 0x2d, 0x68, 0x7a, 0x3f, 0x56, 0x60, 0xbe, 0x8f, 0x9c, 0x9b,
-	0xdb, 0x27, 0x30, 0x49, 0x1d, 0xf8, 0xc0, 0x97, 0x50, 0x0a, 0xf8, 0x12, 0x13, 0x94, 0x82, 0xbb,
-	0x10, 0x75, 0x28, 0x07, 0x0c, 0x7e, 0x19, 0x37, 0x7c, 0x6c, 0x72, 0xc3, 0x51, 0xb3, 0xd7, 0xa0,
-	0xf1, 0x91, 0xda, 0xd4, 0xcb, 0xf8, 0x4f, 0xf5, 0xbb, 0x0a, 0x54, 0x29, 0x80, 0x6e, 0xfb, 0x56,
-	0xe3, 0xa0, 0x47, 0xdc, 0x89, 0x07, 0x96, 0xe7, 0x07, 0x6a, 0xaa, 0xf7, 0xab, 0xe9, 0x72, 0x36,
-	0x92, 0x0b, 0x39, 0x14, 0x54, 0xd6, 0x59, 0x58, 0x16, 0xf2, 0xe0, 0xc8, 0xf2, 0x9f, 0x0a, 0x2c,
-	0xdc, 0xc3, 0xfe, 0xc3, 0xae, 0x6f, 0xec, 0xb6, 0xf0, 0xb6, 0x6f, 0xf8, 0x58, 0x13, 0xb1, 0x55,
-	0xfa, 0xf0, 0xf4, 0xe7, 0x00, 0x09, 0x60, 0x74, 0x68, 0x20, 0x18, 0x9d, 0x4d, 0x9d, 0x30, 0x74,
-	0x15, 0x16, 0xf0, 0xab, 0x0e, 0x55, 0xa0, 0x6e, 0xe3, 0x57, 0xbe, 0x8e, 0x0f, 0x89, 0x4f, 0x6e,
-	0x99, 0x14, 0xa1, 0x4b, 0xda, 0xe9, 0xa0, 0xf7, 0x11, 0x7e, 0xe5, 0xdf, 0x21, 0x7d, 0x75, 0x13,
-	0xbd, 0x0b, 0x73, 0x8d, 0xae, 0x4b, 0x9d, 0xf7, 0x5d, 0xd7, 0xb0, 0x1b, 0xfb, 0xba, 0xef, 0x1c,
-	0xd0, 0xd3, 0xa3, 0xac, 0x4f, 0x6a, 0x88, 0xf7, 0xdd, 0xa6, 0x5d, 0x3b, 0xa4, 0x47, 0xfd, 0xe1,
-	0x38, 0x2c, 0xa6, 0x56, 0xcd, 0x6d, 0x48, 0xbc, 0x32, 0xe5, 0xa4, 0x2b, 0xbb, 0x0b, 0x53, 0x21,
-	0x5b, 0xbf, 0xd7, 0xc1, 0x5c, 0x57, 0x6b, 0x52, 0x8e, 0x3b, 0xbd, 0x0e, 0xd6, 0x26, 0x8f, 0x62,
-	0xbf, 0x90, 0x0a, 0x53, 0x22, 0xc5, 0x4c, 0xd8, 0x31, 0x85, 0x3c, 0x83, 0xa5, 0x8e, 0x8b, 0x0f,
-	0x2d, 0xa7, 0xeb, 0xe9, 0x1e, 0xf1, 0x44, 0xb0, 0x19, 0x8d, 0x3f, 0x45, 0xe7, 0x5d, 0x4e, 0xb9,
-	0xc1, 0x75, 0xdb, 0xbf, 0xf6, 0xde, 0x33, 0xa3, 0xd5, 0xc5, 0xda, 0x42, 0x40, 0xbd, 0xcd, 0x88,
-	0x03, 0xbe, 0xef, 0xc0, 0x69, 0xea, 0xb4, 0x33, 0x2f, 0x3b, 0xe4, 0x38, 0x4c, 0x25, 0x98, 0x21,
-	0x5d, 0x77, 0x49, 0x4f, 0x30, 0xfc, 0x26, 0x8c, 0x53, 0x07, 0x9c, 0x3c, 0x97, 0xe9, 0x33, 0x64,
-	0x62, 0xe3, 0xac, 0xf8, 0x92, 0x0f, 0xac, 0x72, 0xcc, 0xe7, 0x7f, 0xa1, 0x7b, 0x30, 0xe3, 0x51,
-	0x8b, 0xd5, 0x23, 0x16, 0xa3, 0x45, 0x58, 0x94, 0xbd, 0x84, 0xa1, 0xa3, 0xf7, 0x60, 0xa1, 0xd1,
-	0xb2, 0x88, 0xa4, 0x2d, 0x6b, 0xd7, 0x35, 0xdc, 0x9e, 0x7e, 0x88, 0x5d, 0x8a, 0x80, 0x63, 0xd4,
-	0xa4, 0xe7, 0x58, 0xef, 0x03, 0xd6, 0xf9, 0x8c, 0xf5, 0xc5, 0xa8, 0x9a, 0xd8, 0xf0, 0xbb, 0x2e,
-	0x0e, 0xa9, 0xc6, 0xe3, 0x54, 0x77, 0x59, 0x67, 0x40, 0x75, 0x0e, 0x26, 0x38, 0x95, 0xd5, 0xee,
-	0xb4, 0x2a, 0x40, 0x87, 0x02, 0x6b, 0xaa, 0xb7, 0x3b, 0x2d, 0xe4, 0xc1, 0xa5, 0xfe, 0x55, 0xe9,
-	0x5e, 0x63, 0x1f, 0x9b, 0xdd, 0x16, 0xd6, 0x7d, 0x87, 0x6d, 0x16, 0x7d, 0x05, 0x3a, 0x5d, 0xbf,
-	0x32, 0x91, 0xf7, 0x60, 0x79, 0x23, 0xb9, 0xd6, 0x6d, 0xce, 0x69, 0xc7, 0xa1, 0xfb, 0xb6, 0xc3,
-	0xd8, 0x10, 0x97, 0x84, 0x6d, 0x15, 0x79, 0xf1, 0x47, 0x0b, 0x99, 0xa4, 0x0f, 0xd1, 0x59, 0xda,
-	0xb5, 0x4d, 0x7a, 0x82, 0x55, 0x64, 0x1d, 0xa7, 0xa9, 0xac, 0xe3, 0x84, 0x1e, 0x40, 0x39, 0xb4,
-	0x6d, 0x8f, 0x1c, 0xa6, 0x4a, 0x99, 0x3e, 0x3a, 0xcf, 0x27, 0xb7, 0x8a, 0x45, 0x02, 0xe2, 0xf6,
-	0xcd, 0x4e, 0x5e, 0x78, 0x30, 0xe8, 0x4f, 0xd4, 0x80, 0xb9, 0x90, 0x5b, 0xa3, 0xe5, 0x78, 0x98,
-	0xf3, 0x9c, 0xa6, 0x3c, 0xaf, 0x14, 0x74, 0x18, 0x08, 0x21, 0xe1, 0xd7, 0xf5, 0xb4, 0xf0, 0x3c,
-	0x87, 0x8d, 0xe4, 0x94, 0xcf, 0x72, 0x45, 0xe8, 0x2c, 0x14, 0x42, 0x6e, 0xf1, 0x19, 0xd1, 0x9d,
-	0x18, 0x49, 0xcd, 0x15, 0xf4, 0x59, 0x30, 0x5e, 0x9b, 0x39, 0xec, 0x6b, 0x41, 0x1f, 0xc1, 0xb2,
-	0x45, 0xce, 0x5c, 0xdf, 0x1e, 0x63, 0x9b, 0xe0, 0x8c, 0x59, 0x99, 0xa5, 0x6e, 0xe0, 0xa2, 0xe5,
-	0x25, 0xd1, 0xf8, 0x0e, 0xeb, 0x56, 0xff, 0x4b, 0x81, 0xc5, 0x27, 0x4e, 0xab, 0xf5, 0xff, 0x0c,
-	0x8d, 0x7f, 0x32, 0x06, 0x95, 0xf4, 0xb2, 0xbf, 0x81, 0xe3, 0x6f, 0xe0, 0xf8, 0xeb, 0x08, 0xc7,
-	0x59, 0xe7, 0x63, 0x32, 0x13, 0x5e, 0x85, 0x58, 0x35, 0x75, 0x62, 0xac, 0xfa, 0xd9, 0x43, 0x6d,
-	0xf5, 0x6f, 0x87, 0x60, 0x55, 0xc3, 0x0d, 0xc7, 0x35, 0xe3, 0x51, 0x3a, 0x7e, 0x2c, 0x5e, 0x27,
-	0x52, 0x9e, 0x83, 0x89, 0xd0, 0x70, 0x42, 0x10, 0x80, 0xa0, 0xa9, 0x6e, 0xa2, 0x45, 0x18, 0xa5,
-	0x36, 0xc6, 0x4f, 0x7c, 0x49, 0x1b, 0x21, 0x3f, 0xeb, 0x26, 0x3a, 0x0b, 0xc0, 0xfd, 0xf8, 0xe0,
-	0xec, 0x8e, 0x6b, 0xe3, 0xbc, 0xa5, 0x6e, 0x22, 0x0d, 0x26, 0x3b, 0x4e, 0xab, 0xa5, 0x07, 0x6f,
-	0x85, 0x11, 0xc9, 0x5b, 0x81, 0x60, 0xe8, 0x5d, 0xc7, 0x8d, 0xab, 0x26, 0x78, 0x2b, 0x4c, 0x10,
-	0x26, 0xfc, 0x87, 0xfa, 0xcf, 0xa3, 0xb0, 0x26, 0xd1, 0x22, 0x07, 0xde, 0x14, 0x42, 0x2a, 0xc7,
-	0x43, 0x48, 0x29, 0xfa, 0x0d, 0x1d, 0x1f, 0xfd, 0xde, 0x06, 0x14, 0xe8, 0xd7, 0xec, 0x87, 0xdf,
-	0x99, 0xb0, 0x27, 0x18, 0xbd, 0x4e, 0x00, 0x4c, 0x00, 0xbd, 0x25, 0x82, 0x50, 0x09, 0xbe, 0x29,
-	0x44, 0x1f, 0x4e, 0x23, 0x7a, 0x2c, 0x9e, 0x3f, 0x92, 0x8c, 0xe7, 0xdf, 0x80, 0x0a, 0x87, 0x94,
-	0x28, 0x00, 0x11, 0xdc, 0xfe, 0xa3, 0xf4, 0xf6, 0x5f, 0x60, 0xfd, 0xa1, 0xed, 0xf0, 0xcb, 0x1f,
-	0x69, 0x30, 0x15, 0xc6, 0xad, 0x69, 0xc8, 0x82, 0x05, 0xc2, 0xdf, 0xc9, 0x3a, 0x8d, 0x3b, 0xae,
-	0x61, 0x7b, 0x04, 0xca, 0x12, 0xcf, 0xf4, 0x49, 0x33, 0xf6, 0x0b, 0xbd, 0x80, 0x33, 0x82, 0x80,
-	0x48, 0x04, 0xe1, 0xe3, 0x45, 0x20, 0x7c, 0x29, 0x65, 0xee, 0x21, 0x9a, 0x67, 0xb8, 0x96, 0x90,
-	0xe5, 0x5a, 0xae, 0xc1, 0x64, 0x02, 0xf3, 0x26, 0x28, 0xe6, 0x4d, 0xec, 0xc6, 0xc0, 0xee, 0x16,
-	0x94, 0xa3, 0x6d, 0xa5, 0xf9, 0x90, 0xc9, 0xdc, 0x7c, 0xc8, 0x54, 0x48, 0x41, 0xd3, 0x21, 0x1f,
-	0xc3, 0x64, 0xb0, 0xd7, 0x94, 0xc1, 0x54, 0x2e, 0x83, 0x09, 0x3e, 0x9e, 0x92, 0x1b, 0x30, 0x4a,
-	0x5e, 0xf2, 0x04, 0x64, 0xcb, 0x34, 0xfe, 0x72, 0xaf, 0x96, 0x91, 0xe8, 0xac, 0xe5, 0x9e, 0x22,
-	0x1a, 0x22, 0xb0, 0xb0, 0x77, 0xc7, 0xf6, 0xdd, 0x9e, 0x16, 0xf0, 0xad, 0xbe, 0x80, 0xc9, 0x78,
-	0x07, 0x9a, 0x81, 0xd2, 0x01, 0xee, 0x71, 0xb0, 0x22, 0x7f, 0xa2, 0x1b, 0x30, 0x7c, 0x48, 0xcc,
-	0x5f, 0x1a, 0x7f, 0x08, 0x4e, 0x1d, 0x8b, 0x43, 0x30, 0x82, 0x9b, 0x43, 0x37, 0x94, 0x18, 0x4e,
-	0x06, 0x51, 0xa7, 0x6f, 0x70, 0x32, 0x85, 0x93, 0x71, 0xd5, 0x08, 0x71, 0xf2, 0xa7, 0xa5, 0x00,
-	0x27, 0x85, 0x5a, 0xe4, 0x38, 0x79, 0x1f, 0xa6, 0xfb, 0x70, 0x48, 0x8a, 0x94, 0xec, 0xfe, 0xed,
-	0x51, 0x24, 0xd1, 0xca, 0x49, 0x9c, 0x4a, 0x59, 0xee, 0xd0, 0x60, 0x96, 0x1b, 0x83, 0xa5, 0x52,
-	0x12, 0x96, 0x5e, 0xc0, 0x4a, 0xf2, 0x54, 0xe9, 0x4e, 0x53, 0xf7, 0xf7, 0x2d, 0x4f, 0x8f, 0xe7,
-	0x25, 0xe5, 0x53, 0x55, 0x13, 0xa7, 0xec, 0x71, 0x73, 0x67, 0xdf, 0xf2, 0x6e, 0x71, 0xfe, 0x75,
-	0x98, 0xdd, 0xc7, 0x86, 0xeb, 0xef, 0x62, 0xc3, 0xd7, 0x4d, 0xec, 0x1b, 0x56, 0xcb, 0xe3, 0x21,
-	0x46, 0x79, 0xf4, 0x6d, 0x26, 0x24, 0xdb, 0x62, 0x54, 0xe9, 0x7b, 0x67, 0xe4, 0x78, 0xf7, 0xce,
-	0x45, 0x98, 0x0e, 0xf9, 0x30, 0xb3, 0xa6, 0x00, 0x3c, 0xae, 0x85, 0x5e, 0xcf, 0x16, 0x6d, 0x55,
-	0xff, 0x40, 0x81, 0x6f, 0xb1, 0xdd, 0x4c, 0x9c, 0x64, 0x9e, 0x5e, 0x8c, 0xce, 0x8b, 0xd6, 0x1f,
-	0xb1, 0xbb, 0x91, 0x15, 0xb1, 0xcb, 0x63, 0x55, 0x30, 0x74, 0xf7, 0x97, 0x25, 0x78, 0x43, 0xce,
-	0x8d, 0x9b, 0x20, 0x8e, 0x2e, 0x37, 0x97, 0xb7, 0x71, 0x11, 0x6f, 0x1e, 0x1f, 0xba, 0xb4, 0x69,
-	0xaf, 0xcf, 0xd2, 0x7f, 0xac, 0xc0, 0x4a, 0x14, 0xf3, 0x26, 0x0e, 0xb2, 0x69, 0x79, 0x1d, 0xc3,
-	0x6f, 0xec, 0xeb, 0x2d, 0xa7, 0x61, 0xb4, 0x5a, 0xbd, 0xca, 0x10, 0x05, 0xcc, 0x17, 0x92, 0x59,
-	0xf3, 0x97, 0x53, 0x8b, 0x82, 0xe2, 0x3b, 0xce, 0x16, 0x9f, 0xe1, 0x01, 0x9b, 0x80, 0xe1, 0xe8,
-	0xb2, 0x91, 0x3d, 0xa2, 0xfa, 0x2b, 0xb0, 0x9a, 0xc7, 0x40, 0x80, 0xb7, 0x5b, 0x49, 0xbc, 0x15,
-	0x87, 0xdc, 0x03, 0x18, 0xa0, 0xbc, 0x02, 0xc6, 0xf4, 0xda, 0x8d, 0x61, 0xef, 0x0f, 0x14, 0x82,
-	0xbd, 0xa9, 0x65, 0xde, 0x35, 0xac, 0x56, 0x64, 0x4b, 0x05, 0x73, 0x35, 0x79, 0x7c, 0x0a, 0x1a,
-	0xd2, 0xb7, 0x08, 0x8e, 0x65, 0x72, 0xe2, 0x91, 0xe0, 0x1f, 0x2a, 0xa0, 0xa6, 0xd1, 0xee, 0xb3,
-	0xe0, 0x78, 0x06, 0x92, 0x3f, 0xed, 0x97, 0xfc, 0x7a, 0x86, 0xe4, 0x79, 0x9c, 0x0a, 0xca, 0xfe,
-	0x84, 0x1c, 0x4e, 0x09, 0x2f, 0x6e, 0x9b, 0x6f, 0xc2, 0x4c, 0xc3, 0xb0, 0x1b, 0x38, 0xbc, 0x01,
-	0x30, 0xbb, 0xd3, 0xc6, 0xb4, 0x69, 0xd6, 0xae, 0x05, 0xcd, 0xf1, 0xf3, 0x1e, 0xe7, 0x79, 0xc2,
-	0xf3, 0x2e, 0x63, 0x55, 0x70, 0xa9, 0x17, 0xc2, 0xe3, 0x9e, 0xc1, 0x2c, 0x96, 0x0d, 0x14, 0x0c,
-	0x3c, 0x89, 0x85, 0x65, 0xf2, 0x19, 0xd8, 0xc2, 0x44, 0x9c, 0x12, 0x16, 0x96, 0x5e, 0x20, 0xdd,
-	0x9f, 0x48, 0xf2, 0xc2, 0x16, 0x96, 0xc7, 0xa9, 0xa0, 0xec, 0xe7, 0xc5, 0xe6, 0x10, 0xf2, 0xe2,
-	0xd2, 0xff, 0x95, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0e, 0x31, 0x4b, 0xf3, 0x7f, 0x55, 0x82, 0x74,
-	0x49, 0xc7, 0xa8, 0xd4, 0xe7, 0x18, 0xa9, 0x2a, 0xb1, 0x95, 0x2c, 0xa9, 0xf9, 0xd2, 0xfe, 0x7a,
-	0x08, 0xce, 0xf3, 0x25, 0xb0, 0x65, 0x67, 0xe6, 0x98, 0xa5, 0x0b, 0x34, 0xa0, 0x9c, 0x3c, 0x83,
-	0x7c, 0x71, 0x37, 0x33, 0xf6, 0xaf, 0xc0, 0x84, 0xda, 0x54, 0xe2, 0xf4, 0xa2, 0x5d, 0x58, 0x0c,
-	0xd3, 0xf8, 0xc2, 0x42, 0x2d, 0x71, 0x86, 0xf7, 0x0e, 0xa7, 0xe9, 0xcb, 0xf0, 0x62, 0x51, 0xf3,
-	0xc0, 0x29, 0xfc, 0x75, 0xb8, 0x90, 0xb7, 0x16, 0xae, 0xe7, 0xbf, 0x51, 0x60, 0x39, 0x88, 0x0a,
-	0x09, 0x5e, 0xe9, 0xaf, 0xc5, 0x7c, 0x2e, 0xc1, 0xac, 0xe5, 0xe9, 0xc9, 0xba, 0x29, 0xaa, 0xcb,
-	0x31, 0x6d, 0xda, 0xf2, 0xee, 0xc6, 0x2b, 0xa2, 0xd4, 0x15, 0x38, 0x23, 0x16, 0x9f, 0xaf, 0xef,
-	0xa7, 0x43, 0x04, 0xc1, 0x08, 0x58, 0x27, 0xb3, 0xd2, 0x29, 0x68, 0x7d, 0x1d, 0x0b, 0x5d, 0x83,
-	0x49, 0x5e, 0x14, 0x87, 0xcd, 0x58, 0xa0, 0x36, 0x6c, 0xab, 0x9b, 0xe8, 0x39, 0x9c, 0x6e, 0x04,
-	0xa2, 0xc6, 0xa6, 0x3e, 0x35, 0xd0, 0xd4, 0x28, 0x64, 0x11, 0xcd, 0xfd, 0x00, 0x66, 0x62, 0x85,
-	0x6e, 0xec, 0x91, 0x30, 0x5c, 0xf4, 0x91, 0x30, 0x1d, 0x91, 0xd2, 0x06, 0xf5, 0x22, 0x39, 0xad,
-	0x52, 0x2d, 0xf3, 0xfd, 0xf8, 0xb7, 0x21, 0xa8, 0x68, 0xbc, 0x3c, 0x13, 0x53, 0x5a, 0xef, 0xd9,
-	0xc6, 0xeb, 0xdc, 0x83, 0x17, 0x30, 0x9f, 0x8c, 0x64, 0xf6, 0x74, 0xcb, 0xc7, 0xed, 0xa0, 0x7e,
-	0xe2, 0x52, 0xa1, 0x68, 0x66, 0xaf, 0xee, 0xe3, 0xb6, 0x76, 0xfa, 0x30, 0xd5, 0xe6, 0xa1, 0xf7,
-	0x61, 0x84, 0x2a, 0xd7, 0xe3, 0x7b, 0x26, 0x8e, 0x6c, 0x6c, 0x19, 0xbe, 0x71, 0xbb, 0xe5, 0xec,
-	0x6a, 0x7c, 0x30, 0xda, 0x84, 0xb2, 0x8d, 0x8f, 0x74, 0xb7, 0xcb, 0xf7, 0x26, 0x78, 0xba, 0xe4,
-	0x90, 0x4f, 0xda, 0xf8, 0x48, 0xeb, 0xb2, 0x4d, 0xf1, 0xd4, 0x65, 0x58, 0x12, 0xe8, 0x9a, 0xef,
-	0xc4, 0xf7, 0x15, 0x58, 0xd8, 0xee, 0xd9, 0x8d, 0xed, 0x7d, 0xc3, 0x35, 0x79, 0x80, 0x93, 0xef,
-	0xc3, 0x79, 0x28, 0x7b, 0x4e, 0xd7, 0x6d, 0x60, 0x9d, 0x57, 0xee, 0xf2, 0xcd, 0x98, 0x62, 0xad,
-	0x9b, 0xac, 0x11, 0x2d, 0xc1, 0x18, 0xd1, 0x87, 0x19, 0xdc, 0x60, 0xc3, 0xda, 0x28, 0xfd, 0x5d,
-	0x37, 0x51, 0x0d, 0x4e, 0xd1, 0xd7, 0x62, 0x29, 0xf7, 0x09, 0x47, 0xc7, 0xa9, 0x4b, 0xb0, 0x98,
-	0x92, 0x85, 0xcb, 0xf9, 0xf7, 0xc3, 0x70, 0x9a, 0xf4, 0x05, 0x37, 0xe1, 0xeb, 0x34, 0x96, 0x0a,
-	0x8c, 0x06, 0x01, 0x25, 0x76, 0x56, 0x83, 0x9f, 0xe4, 0x28, 0x47, 0xaf, 0xd9, 0x30, 0x52, 0x10,
-	0x46, 0x16, 0x88, 0x4e, 0xd2, 0x61, 0xa4, 0xe1, 0x41, 0xc3, 0x48, 0x67, 0x01, 0x82, 0x57, 0x95,
-	0x65, 0xd2, 0x57, 0x68, 0x49, 0x1b, 0xe7, 0x2d, 0x75, 0x33, 0xf5, 0x56, 0x1f, 0x1d, 0xec, 0xad,
-	0x7e, 0x9f, 0x27, 0x6f, 0xa2, 0x67, 0x33, 0xe5, 0x32, 0x96, 0xcb, 0x65, 0x96, 0x90, 0x85, 0x0e,
-	0x30, 0xe5, 0x75, 0x0d, 0x46, 0x83, 0x37, 0xf7, 0x78, 0x81, 0x37, 0x77, 0x30, 0x38, 0x1e, 0x2f,
-	0x80, 0x64, 0xbc, 0xe0, 0x13, 0x98, 0x64, 0xa9, 0x25, 0x5e, 0xe4, 0x3b, 0x51, 0xa0, 0xc8, 0x77,
-	0x82, 0x66, 0x9c, 0x78, 0x7d, 0xef, 0xbb, 0x40, 0x6b, 0x74, 0x79, 0xc9, 0xba, 0x6e, 0x99, 0xd8,
-	0xf6, 0x2d, 0xbf, 0x47, 0x83, 0x79, 0xe3, 0x1a, 0x22, 0x7d, 0xcf, 0x69, 0x57, 0x9d, 0xf7, 0xa0,
-	0xc7, 0x30, 0xdd, 0x87, 0x0d, 0x3c, 0x70, 0x77, 0xa1, 0x18, 0x2a, 0x68, 0xe5, 0x24, 0x22, 0xa8,
-	0x0b, 0x30, 0x97, 0x34, 0x65, 0x6e, 0xe3, 0xbf, 0xa3, 0xc0, 0x72, 0x50, 0xb9, 0xf6, 0x15, 0x71,
-	0xe2, 0xd4, 0xdf, 0x52, 0xe0, 0x8c, 0x58, 0x26, 0xfe, 0xbe, 0xb9, 0x0a, 0x0b, 0x6d, 0xd6, 0xce,
-	0xf2, 0x2a, 0xba, 0x65, 0xeb, 0x0d, 0xa3, 0xb1, 0x8f, 0xb9, 0x84, 0xa7, 0xdb, 0x31, 0xaa, 0xba,
-	0xbd, 0x49, 0xba, 0xd0, 0x07, 0xb0, 0x94, 0x22, 0x32, 0x0d, 0xdf, 0xd8, 0x35, 0x3c, 0xcc, 0xdd,
-	0xe0, 0x85, 0x24, 0xdd, 0x16, 0xef, 0x55, 0xcf, 0x40, 0x35, 0x90, 0x87, 0xeb, 0xf3, 0x33, 0x27,
-	0x2c, 0x3d, 0x52, 0x7f, 0x6d, 0x28, 0x52, 0x61, 0xa2, 0x9b, 0x4b, 0xbb, 0x0e, 0x33, 0x76, 0xb7,
-	0xbd, 0x8b, 0x5d, 0xdd, 0x69, 0xea, 0x14, 0xa6, 0x3c, 0x2a, 0xe7, 0xb0, 0x56, 0x66, 0xed, 0x8f,
-	0x9b, 0x14, 0x7d, 0x3c, 0xa2, 0xec, 0x00, 0xd6, 0x3c, 0x1a, 0x3d, 0x18, 0xd6, 0xc6, 0x38, 0xae,
-	0x79, 0xe8, 0x3e, 0x4c, 0xf2, 0x9d, 0x60, 0x4b, 0x65, 0x00, 0x77, 0x31, 0xcb, 0x1e, 0x58, 0x3c,
-	0x87, 0x2e, 0x9d, 0xfa, 0x77, 0x13, 0x66, 0xd4, 0x80, 0xae, 0xc1, 0x22, 0x9b, 0xa8, 0xe1, 0xd8,
-	0xbe, 0xeb, 0xb4, 0x5a, 0xd8, 0xa5, 0x4a, 0xe9, 0xb2, 0xbb, 0x62, 0x5c, 0x9b, 0xa7, 0xdd, 0x9b,
-	0x61, 0x2f, 0x43, 0x46, 0x7a, 0x46, 0x4c, 0xd3, 0xc5, 0x9e, 0xc7, 0x83, 0x8e, 0xc1, 0x4f, 0xb5,
-	0x06, 0xb3, 0x2c, 0x35, 0x45, 0xe8, 0x02, 0xe3, 0x89, 0xc3, 0xb4, 0x92, 0x80, 0x69, 0x75, 0x0e,
-	0x50, 0x7c, 0x3c, 0xb7, 0xc6, 0xff, 0x50, 0x60, 0x96, 0x39, 0xe8, 0x71, 0x4f, 0x30, 0x9b, 0x0d,
-	0xfa, 0x98, 0xa7, 0x71, 0xc3, 0xac, 0x75, 0x79, 0x63, 0x35, 0x33, 0x47, 0x60, 0x78, 0x07, 0x34,
-	0x34, 0x46, 0x33, 0xb9, 0x34, 0x2c, 0x16, 0x0b, 0xb0, 0x96, 0x12, 0x01, 0xd6, 0x4d, 0x98, 0x3e,
-	0xb4, 0x3c, 0x6b, 0xd7, 0x6a, 0x59, 0x7e, 0x8f, 0x81, 0x51, 0x7e, 0x4c, 0xb0, 0x1c, 0x91, 0x50,
-	0x24, 0x5a, 0x83, 0x49, 0x7e, 0x8b, 0xe9, 0xb6, 0xc1, 0x41, 0x77, 0x5c, 0x9b, 0xe0, 0x6d, 0x8f,
-	0x8c, 0x36, 0x26, 0x6a, 0x88, 0xaf, 0x37, 0x7a, 0xd3, 0xce, 0xd2, 0x3a, 0xb5, 0xa7, 0x5d, 0xdc,
-	0xc5, 0x05, 0xd4, 0xd0, 0x3f, 0xd3, 0x50, 0x6a, 0xa6, 0xa4, 0xa6, 0x4a, 0x83, 0x6a, 0x8a, 0x09,
-	0x1a, 0x49, 0xc4, 0x05, 0xfd, 0x3d, 0x05, 0xe6, 0x02, 0xd3, 0xff, 0xea, 0xc8, 0xfa, 0x18, 0xe6,
-	0xfb, 0x84, 0xe2, 0x27, 0xf1, 0x1a, 0x2c, 0x76, 0x5c, 0xa7, 0x81, 0x3d, 0xcf, 0xb2, 0xf7, 0x74,
-	0xfa, 0x3d, 0x0f, 0xc3, 0x02, 0x72, 0x20, 0x4b, 0xc4, 0xec, 0xa3, 0x6e, 0x4a, 0x49, 0x81, 0xc0,
-	0x53, 0xbf, 0xab, 0xc0, 0xd9, 0x7b, 0xd8, 0xd7, 0xa2, 0x8f, 0x7b, 0x1e, 0x62, 0xcf, 0x33, 0xf6,
-	0x70, 0xe8, 0xb7, 0x7c, 0x0a, 0x23, 0x34, 0x89, 0xc3, 0x18, 0x49, 0x52, 0xd1, 0x31, 0x1e, 0x34,
-	0xc5, 0xa3, 0x71, 0xba, 0x02, 0x6a, 0x21, 0x40, 0xb3, 0x92, 0x25, 0x06, 0x5f, 0xe1, 0x4b, 0x28,
-	0x33, 0xbd, 0xb7, 0x79, 0x0f, 0x97, 0xe7, 0x7e, 0x66, 0x10, 0x52, 0xce, 0xb0, 0x46, 0xcf, 0x67,
-	0xd0, 0xca, 0x02, 0x8e, 0x53, 0x5e, 0xbc, 0xad, 0xda, 0x06, 0x94, 0x1e, 0x14, 0x0f, 0x2a, 0x0e,
-	0xb3, 0xa0, 0xe2, 0xad, 0x64, 0x50, 0xf1, 0xad, 0x02, 0x1a, 0x0a, 0xa5, 0x89, 0x45, 0x14, 0x6d,
-	0x58, 0xbd, 0x87, 0xfd, 0xad, 0x07, 0x4f, 0x25, 0xbb, 0x71, 0x1f, 0x80, 0x1d, 0x6b, 0xbb, 0xe9,
-	0x04, 0x1a, 0x28, 0x32, 0x1f, 0xb1, 0x25, 0x0a, 0x96, 0xd4, 0xfc, 0xc8, 0x5f, 0x9e, 0xda, 0x83,
-	0x35, 0xc9, 0x7c, 0x5c, 0xed, 0x3b, 0x30, 0x1b, 0xfb, 0xf2, 0x8b, 0xe6, 0x14, 0x83, 0x79, 0x2f,
-	0x16, 0x9c, 0x57, 0x9b, 0x71, 0x93, 0x0d, 0x9e, 0xfa, 0x4f, 0x0a, 0xcc, 0x69, 0xd8, 0xe8, 0x74,
-	0x5a, 0xec, 0xf1, 0x13, 0xae, 0x6f, 0x01, 0x46, 0x78, 0x10, 0x9f, 0xdd, 0x77, 0xfc, 0x97, 0xbc,
-	0xe8, 0x5f, 0x7c, 0x59, 0x97, 0x4e, 0xea, 0x98, 0x1e, 0xef, 0x95, 0xa1, 0x2e, 0xc2, 0x7c, 0xdf,
-	0xd2, 0x38, 0xa4, 0xfc, 0x99, 0x02, 0xcb, 0x1a, 0x6e, 0xba, 0xd8, 0xdb, 0x0f, 0xf3, 0x19, 0x44,
-	0x1b, 0x5f, 0xc1, 0xb5, 0xab, 0x2b, 0x70, 0x46, 0x2c, 0x2a, 0x5f, 0xcb, 0x17, 0x43, 0xb0, 0xa0,
-	0x61, 0xc3, 0xdc, 0x7a, 0xf0, 0xb4, 0xdf, 0x44, 0xaf, 0xc2, 0xa9, 0xb0, 0x8e, 0xa0, 0xbc, 0x71,
-	0x2e, 0xf3, 0x16, 0x7f, 0xf0, 0x94, 0x82, 0x1b, 0x1d, 0x2c, 0x7b, 0xf6, 0xa4, 0x1f, 0x4e, 0x25,
-	0xd1, 0xc3, 0x69, 0x07, 0x2a, 0x96, 0x4d, 0x46, 0x58, 0x87, 0x58, 0xc7, 0x76, 0x88, 0x13, 0x05,
-	0x8b, 0xaf, 0xe6, 0x43, 0xe2, 0x3b, 0x76, 0x70, 0xe0, 0xeb, 0x26, 0xd1, 0x7d, 0x87, 0x30, 0xf1,
-	0xac, 0xcf, 0xd9, 0x2d, 0x37, 0xac, 0x8d, 0x91, 0x86, 0x6d, 0xeb, 0x73, 0x8c, 0x2e, 0xc0, 0x34,
-	0x2d, 0x21, 0xa0, 0x23, 0x58, 0xa6, 0x7b, 0x84, 0x66, 0xba, 0x69, 0x65, 0xc1, 0x13, 0x63, 0x0f,
-	0xb3, 0xc2, 0xb7, 0xbf, 0x18, 0x82, 0xc5, 0x94, 0xb2, 0x42, 0x87, 0xef, 0x18, 0xda, 0x12, 0x1e,
-	0xca, 0xa1, 0x13, 0x1e, 0x4a, 0x64, 0xc0, 0x42, 0x8a, 0x6b, 0x10, 0x73, 0x1b, 0x18, 0x67, 0xe6,
-	0xfa, 0xd9, 0xd3, 0x98, 0x9b, 0x40, 0x63, 0xa7, 0x44, 0x1a, 0xfb, 0x57, 0x05, 0x16, 0x9f, 0x74,
-	0xdd, 0x3d, 0xfc, 0x35, 0xb7, 0x2f, 0xb5, 0x0a, 0x95, 0xf4, 0x3a, 0xf9, 0x19, 0xfb, 0xf3, 0x21,
-	0x58, 0x7c, 0x88, 0xbf, 0xfe, 0x4a, 0xf8, 0xbf, 0x39, 0x64, 0xb7, 0xa1, 0x92, 0x56, 0x16, 0x3f,
-	0x64, 0x02, 0x1e, 0x8a, 0x88, 0xc7, 0xaf, 0x2a, 0x70, 0xe6, 0x91, 0xe3, 0x5b, 0xcd, 0x1e, 0x79,
-	0xdf, 0x3a, 0x87, 0xd8, 0x7d, 0x68, 0x90, 0xc7, 0x6b, 0xa8, 0x76, 0x03, 0x16, 0x9a, 0xbc, 0x47,
-	0x6f, 0xd3, 0x2e, 0x3d, 0xe1, 0x1c, 0x65, 0x1e, 0x91, 0x24, 0x3f, 0xe6, 0x1f, 0xcd, 0x35, 0xd3,
-	0x8d, 0x9e, 0x7a, 0x0e, 0xce, 0x66, 0x88, 0xc0, 0xcd, 0xc2, 0x80, 0xe5, 0x7b, 0xd8, 0xdf, 0x74,
-	0x1d, 0xcf, 0xe3, 0xdb, 0x92, 0xb8, 0x45, 0x12, 0x2f, 0x2d, 0xa5, 0xef, 0xa5, 0x75, 0x1e, 0xca,
-	0xbe, 0xe1, 0xee, 0x61, 0x3f, 0xdc, 0x66, 0x76, 0x9f, 0x4c, 0xb1, 0x56, 0xce, 0x4f, 0xfd, 0xef,
-	0x12, 0x9c, 0x11, 0xcf, 0xc1, 0x15, 0xda, 0x26, 0x7c, 0x08, 0x3c, 0xec, 0xf6, 0xd8, 0xbb, 0x8f,
-	0xaf, 0xff, 0x9e, 0xcc, 0x19, 0xcb, 0x64, 0x47, 0x3d, 0x5d, 0xef, 0x76, 0x8f, 0x3a, 0x5b, 0xcc,
-	0x13, 0x9b, 0xf4, 0x63, 0x4d, 0xe8, 0x3b, 0x0a, 0xcc, 0x37, 0x69, 0x92, 0x49, 0x6f, 0x18, 0x5d,
-	0x0f, 0x47, 0xd3, 0x32, 0xd0, 0x7b, 0x78, 0xbc, 0x69, 0x59, 0xde, 0x6a, 0x93, 0x70, 0x4c, 0x4c,
-	0x8e, 0x9a, 0xa9, 0x8e, 0xea, 0x4b, 0x98, 0x4d, 0x49, 0x29, 0x70, 0x05, 0xef, 0x26, 0x5d, 0xc1,
-	0x77, 0xb3, 0xec, 0xa1, 0x5f, 0x28, 0xbe, 0x7b, 0x71, 0x7f, 0xb0, 0xfa, 0x12, 0x16, 0x33, 0x24,
-	0x14, 0x4c, 0xfc, 0x69, 0x7c, 0xe2, 0x72, 0x76, 0x88, 0xf5, 0x1e, 0xf6, 0xa3, 0x94, 0x1d, 0x65,
-	0x1c, 0x77, 0x41, 0xff, 0x5d, 0x81, 0x75, 0x9e, 0x24, 0x4b, 0xa9, 0x2d, 0x15, 0xdd, 0x97, 0xbc,
-	0x84, 0x8a, 0xd9, 0x19, 0x7a, 0xce, 0xcc, 0x28, 0xac, 0x66, 0x08, 0x02, 0xc4, 0x03, 0xa8, 0x8d,
-	0x17, 0x31, 0x4c, 0xf9, 0xb1, 0x5f, 0x1e, 0x7a, 0x03, 0xa6, 0x9a, 0xd8, 0x6f, 0xec, 0x3f, 0xc2,
-	0xcc, 0x6f, 0xe1, 0x59, 0x9d, 0x64, 0xa3, 0xea, 0xc1, 0x9b, 0x05, 0x16, 0x1b, 0xd6, 0x49, 0x0e,
-	0x07, 0xce, 0xef, 0x31, 0x77, 0x96, 0x92, 0xab, 0xef, 0xd3, 0x0f, 0xb1, 0x82, 0xc3, 0x4d, 0xef,
-	0xca, 0x02, 0x01, 0x29, 0xd5, 0xa7, 0x5f, 0x32, 0x25, 0xc9, 0xb8, 0x64, 0x1b, 0x30, 0x1f, 0xa5,
-	0x33, 0x82, 0xe0, 0x47, 0x97, 0xd7, 0x27, 0x0d, 0x6b, 0x51, 0xae, 0x63, 0x9b, 0x45, 0x3e, 0xba,
-	0x36, 0x8d, 0x46, 0x07, 0x9f, 0x0a, 0xf2, 0xb8, 0x0d, 0x0b, 0xca, 0x4c, 0xf1, 0x56, 0x16, 0xb6,
-	0xd9, 0xf8, 0x87, 0xb7, 0x01, 0x78, 0xe0, 0xe7, 0xd6, 0x93, 0x3a, 0xfa, 0x9e, 0x02, 0x0b, 0xe2,
-	0x4f, 0x9e, 0xd1, 0xb5, 0xcc, 0x23, 0x28, 0xfd, 0xe8, 0xba, 0x7a, 0x7d, 0x60, 0x3a, 0xbe, 0xea,
-	0xdf, 0x54, 0x60, 0x31, 0xe3, 0x63, 0x72, 0x24, 0x61, 0x2a, 0xfd, 0xbc, 0xbe, 0x7a, 0x63, 0x70,
-	0x42, 0x2e, 0xce, 0x4f, 0x14, 0x58, 0xcd, 0xfb, 0x2e, 0x1c, 0x7d, 0x9a, 0xc7, 0x3e, 0xef, 0x1b,
-	0xf5, 0xea, 0xad, 0x13, 0x70, 0xe0, 0x92, 0x92, 0x4d, 0x14, 0x7f, 0xf1, 0x2d, 0xd9, 0x44, 0xe9,
-	0x97, 0xe6, 0x92, 0x4d, 0xcc, 0xf9, 0xb4, 0xfc, 0xf7, 0x15, 0xa8, 0x66, 0x7f, 0x17, 0x8d, 0xb2,
-	0xcb, 0x9a, 0x72, 0xbf, 0x17, 0xaf, 0x7e, 0x78, 0x2c, 0x5a, 0x2e, 0xd7, 0xef, 0x2a, 0xb0, 0x94,
-	0xf9, 0xd5, 0x33, 0xfa, 0x20, 0x93, 0x75, 0xde, 0x47, 0xd7, 0xd5, 0x9b, 0xc7, 0x21, 0xe5, 0x42,
-	0xd9, 0x30, 0x95, 0xf8, 0x1c, 0x16, 0xbd, 0x93, 0xc9, 0x4c, 0xf4, 0xd5, 0x6d, 0xb5, 0x56, 0x74,
-	0x38, 0x9f, 0xef, 0x3b, 0x0a, 0x9c, 0x16, 0x7c, 0x53, 0x8a, 0xae, 0xca, 0x77, 0x5b, 0xf8, 0x15,
-	0x6b, 0xf5, 0xbd, 0xc1, 0x88, 0xb8, 0x08, 0x3e, 0x4c, 0xf7, 0x7d, 0xbf, 0x89, 0x2e, 0xcb, 0xee,
-	0x7a, 0x41, 0x9c, 0xbf, 0xfa, 0x6e, 0x71, 0x02, 0x3e, 0xeb, 0x11, 0xcc, 0xf4, 0x7f, 0xa7, 0x84,
-	0xb2, 0xb9, 0x64, 0x7c, 0xc9, 0x55, 0xbd, 0x32, 0x00, 0x45, 0xcc, 0xec, 0x32, 0x0b, 0xf6, 0x24,
-	0x66, 0x97, 0xf7, 0xad, 0x44, 0xf5, 0x04, 0xf5, 0x81, 0xe8, 0x8f, 0x14, 0x38, 0x23, 0xab, 0xe7,
-	0x43, 0x1f, 0x1d, 0xb3, 0x0c, 0x90, 0x89, 0xf6, 0xf1, 0x89, 0x8a, 0x08, 0xb9, 0xca, 0x32, 0x8a,
-	0xde, 0xa4, 0x2a, 0x93, 0x97, 0xdc, 0x49, 0x55, 0x96, 0x53, 0x63, 0x17, 0xdb, 0x47, 0x41, 0x45,
-	0x71, 0xee, 0x3e, 0x66, 0xd7, 0x72, 0xe7, 0xee, 0xa3, 0xac, 0x80, 0x39, 0xb6, 0x8f, 0xc2, 0xba,
-	0xb3, 0xfc, 0x7d, 0x94, 0xd5, 0xbe, 0xe5, 0xef, 0xa3, 0xb4, 0xd8, 0x2d, 0xbe, 0x8f, 0xe9, 0xd2,
-	0xb2, 0xfc, 0x7d, 0xcc, 0x2c, 0x6c, 0xcb, 0xdf, 0xc7, 0xec, 0x4a, 0x36, 0xf4, 0x87, 0x34, 0x62,
-	0x97, 0x59, 0x33, 0x86, 0x3e, 0x1c, 0x68, 0xcd, 0xc9, 0xaa, 0xb5, 0xea, 0x47, 0xc7, 0x23, 0x4e,
-	0x88, 0x96, 0x59, 0x30, 0x29, 0x15, 0x2d, 0xaf, 0x64, 0x53, 0x2a, 0x5a, 0x7e, 0x8d, 0xe6, 0x1f,
-	0x2b, 0xb0, 0x22, 0xaf, 0x94, 0x42, 0xdf, 0x96, 0x4c, 0x50, 0xa0, 0x5c, 0xac, 0xfa, 0xc9, 0xb1,
-	0xe9, 0xb9, 0x8c, 0xbf, 0xad, 0x40, 0x25, 0xab, 0x5e, 0x0e, 0xdd, 0x90, 0x70, 0x97, 0x16, 0x06,
-	0x56, 0x3f, 0x38, 0x06, 0x25, 0x97, 0xe8, 0xd7, 0x15, 0x98, 0x13, 0x55, 0x5d, 0xa1, 0xec, 0x9b,
-	0x53, 0x52, 0x63, 0x56, 0x7d, 0x7f, 0x40, 0x2a, 0x2e, 0xc5, 0x8f, 0xe8, 0xbf, 0x26, 0x92, 0x14,
-	0x1d, 0xa1, 0x8f, 0x73, 0x6c, 0x43, 0x5e, 0x12, 0x56, 0xfd, 0xf6, 0x71, 0xc9, 0xb9, 0x80, 0x9f,
-	0xc3, 0x6c, 0xaa, 0xfc, 0x06, 0x5d, 0x91, 0x30, 0x15, 0x97, 0x45, 0x55, 0x37, 0x06, 0x21, 0x89,
-	0xbc, 0x91, 0xbe, 0x82, 0x1a, 0x89, 0x37, 0x22, 0x2e, 0x03, 0x92, 0x78, 0x23, 0x19, 0xb5, 0x3a,
-	0xe8, 0x00, 0x26, 0xe3, 0xf5, 0x0d, 0xe8, 0x6d, 0x29, 0x87, 0xbe, 0x8a, 0x9e, 0xea, 0x3b, 0x05,
-	0x47, 0xc7, 0xac, 0x50, 0x54, 0xa0, 0x20, 0xb1, 0x42, 0x49, 0x8d, 0x85, 0xc4, 0x0a, 0xa5, 0x55,
-	0x10, 0xc4, 0xf3, 0x14, 0xd4, 0x1d, 0x48, 0x3c, 0xcf, 0xec, 0x22, 0x86, 0xea, 0x7b, 0x83, 0x11,
-	0x85, 0xdf, 0x5a, 0x40, 0x94, 0xc5, 0x47, 0x97, 0x32, 0x79, 0xa4, 0x4a, 0x03, 0xaa, 0x6f, 0x15,
-	0x1a, 0x1b, 0x4d, 0x13, 0x65, 0xc9, 0x25, 0xd3, 0xa4, 0x4a, 0x07, 0x24, 0xd3, 0xa4, 0xd3, 0xee,
-	0x6c, 0x9a, 0x20, 0xc7, 0x2d, 0x9d, 0xa6, 0x2f, 0x35, 0x2f, 0x9d, 0xa6, 0x3f, 0x69, 0x4e, 0x5e,
-	0x28, 0x89, 0xf4, 0xb4, 0xe4, 0x85, 0x22, 0xca, 0xad, 0x4b, 0x5e, 0x28, 0xe2, 0xac, 0xf7, 0xf7,
-	0xd8, 0x7f, 0xb5, 0x11, 0xe4, 0x2f, 0x25, 0x4f, 0x59, 0x69, 0xba, 0x5b, 0xf2, 0x94, 0xcd, 0xc9,
-	0x4f, 0x13, 0x07, 0x26, 0x33, 0x9d, 0x2a, 0x71, 0x60, 0xf2, 0x52, 0xbe, 0x12, 0x07, 0x26, 0x3f,
-	0x7b, 0x6b, 0xc3, 0x54, 0x22, 0x17, 0x29, 0xd9, 0x10, 0x51, 0x3a, 0x56, 0xb2, 0x21, 0xc2, 0x14,
-	0x27, 0x85, 0x0f, 0x51, 0xde, 0x10, 0xc9, 0x9e, 0x7f, 0x99, 0x19, 0x51, 0x09, 0x7c, 0xc8, 0x92,
-	0x93, 0x04, 0xa7, 0xfb, 0xd2, 0x6d, 0x12, 0x9c, 0x16, 0x67, 0x31, 0x25, 0x38, 0x9d, 0x95, 0xc9,
-	0x23, 0xaf, 0xc6, 0xbe, 0x54, 0x8e, 0xec, 0xd5, 0x28, 0xce, 0x6e, 0xc9, 0x5e, 0x8d, 0x19, 0x79,
-	0x22, 0x32, 0x71, 0x7f, 0xe6, 0x43, 0x32, 0x71, 0x46, 0x46, 0x49, 0x32, 0x71, 0x66, 0x5a, 0xe5,
-	0x37, 0x14, 0x98, 0x17, 0xe6, 0x2a, 0x50, 0xf6, 0xc6, 0xc9, 0xd2, 0x2b, 0xd5, 0x6b, 0x83, 0x92,
-	0xc5, 0xcc, 0x4e, 0x14, 0xe9, 0x97, 0x98, 0x9d, 0x24, 0x85, 0x22, 0x31, 0x3b, 0x69, 0x52, 0xe4,
-	0x0b, 0x25, 0xfc, 0x3a, 0x26, 0x3b, 0x9e, 0x8c, 0x6e, 0xe5, 0xb9, 0xfd, 0xb9, 0x81, 0xf7, 0xea,
-	0xed, 0x93, 0xb0, 0x48, 0x44, 0x56, 0xe2, 0xf1, 0x64, 0x79, 0x64, 0x45, 0x10, 0xb0, 0x96, 0x47,
-	0x56, 0x44, 0xa1, 0xea, 0xdb, 0x77, 0xfe, 0xee, 0xcb, 0x15, 0xe5, 0x1f, 0xbf, 0x5c, 0x51, 0xfe,
-	0xe5, 0xcb, 0x15, 0xe5, 0x17, 0xaf, 0xef, 0x59, 0xfe, 0x7e, 0x77, 0xb7, 0xd6, 0x70, 0xda, 0x97,
-	0x13, 0xff, 0x4b, 0xb8, 0xb6, 0x87, 0x6d, 0xf6, 0x4f, 0xa1, 0x63, 0xff, 0x95, 0xfa, 0x43, 0xfe,
-	0xe7, 0xe1, 0x95, 0xdd, 0x11, 0xda, 0x77, 0xf5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xed,
-	0x19, 0x44, 0xc1, 0x5a, 0x00, 0x00,
+	// 5251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x1c, 0xc9,
+	0x56, 0xb0, 0xda, 0x8e, 0xff, 0x8e, 0xed, 0xb1, 0x5d, 0xf1, 0xcf, 0xb8, 0x9d, 0x38, 0xce, 0x6c,
+	0xb2, 0xeb, 0x9b, 0xbd, 0x3b, 0x49, 0x9c, 0xbf, 0xdd, 0xec, 0xee, 0xdd, 0x4d, 0xec, 0x24, 0x3b,
+	0xab, 0xfc, 0xb6, 0x7d, 0x77, 0xbf, 0x0f, 0xa1, 0x8c, 0xda, 0xd3, 0x35, 0x76, 0x93, 0x99, 0xee,
+	0x49, 0x57, 0x8f, 0x93, 0x59, 0x21, 0x74, 0x61, 0x11, 0x12, 0xe8, 0x0a, 0x2e, 0x70, 0x41, 0x48,
+	0x48, 0x57, 0x42, 0x17, 0x74, 0x61, 0x79, 0x84, 0x37, 0xc4, 0x13, 0x2f, 0xf0, 0xc6, 0x13, 0x12,
+	0x4f, 0xa0, 0xd5, 0x15, 0x0f, 0x20, 0xf1, 0x02, 0x42, 0x3c, 0xa2, 0xfa, 0xeb, 0xe9, 0x9f, 0xea,
+	0x9f, 0x19, 0x23, 0x65, 0xef, 0xb2, 0x6f, 0x9e, 0xaa, 0x73, 0x4e, 0x9d, 0x3a, 0x75, 0xea, 0x54,
+	0x9d, 0x9f, 0x6a, 0xc3, 0xf9, 0xee, 0x3e, 0xf6, 0x2e, 0x36, 0x4c, 0x0b, 0x3b, 0x0d, 0x7c, 0xf1,
+	0xd0, 0x26, 0xbe, 0xeb, 0xf5, 0x2e, 0x1e, 0x5d, 0xbe, 0x48, 0xb0, 0x77, 0x64, 0x37, 0x70, 0xb5,
+	0xe3, 0xb9, 0xbe, 0x8b, 0x56, 0x28, 0x58, 0x55, 0x80, 0x55, 0x05, 0x58, 0xf5, 0xe8, 0xb2, 0xbe,
+	0x7e, 0xe0, 0xba, 0x07, 0x2d, 0x7c, 0x91, 0x81, 0xed, 0x77, 0x9b, 0x17, 0xad, 0xae, 0x67, 0xfa,
+	0xb6, 0xeb, 0x70, 0x44, 0xfd, 0x4c, 0xbc, 0xdf, 0xb7, 0xdb, 0x98, 0xf8, 0x66, 0xbb, 0x23, 0x00,
+	0x12, 0x04, 0x5e, 0x78, 0x66, 0xa7, 0x83, 0x3d, 0x22, 0xfa, 0x37, 0x22, 0x0c, 0x9a, 0x1d, 0x9b,
+	0x32, 0xd7, 0x70, 0xdb, 0xed, 0x60, 0x88, 0xb3, 0x2a, 0x08, 0xc9, 0xa2, 0xe0, 0x42, 0x05, 0xf2,
+	0xbc, 0x8b, 0x03, 0x80, 0x8a, 0x0a, 0xc0, 0x37, 0xc9, 0xb3, 0x96, 0x4d, 0xfc, 0x2c, 0x98, 0x17,
+	0xae, 0xf7, 0xac, 0xd9, 0x72, 0x5f, 0x08, 0x98, 0x0b, 0x2a, 0x18, 0x21, 0xca, 0x7a, 0x0c, 0x76,
+	0x33, 0x0f, 0x16, 0x7b, 0x02, 0xf2, 0x5c, 0x04, 0x92, 0x1c, 0x9a, 0x1e, 0xb6, 0x98, 0x18, 0x5a,
+	0x5d, 0xe2, 0xe7, 0x42, 0x45, 0x45, 0x51, 0x49, 0x81, 0x7a, 0xde, 0xc5, 0x5d, 0xac, 0xe4, 0xac,
+	0x0f, 0xe3, 0xe1, 0x4e, 0xcb, 0x6e, 0x84, 0x97, 0xf7, 0x7c, 0x0a, 0x64, 0x74, 0xaa, 0x95, 0x3f,
+	0x1d, 0x83, 0xd3, 0xbb, 0xbe, 0xe9, 0xf9, 0x9f, 0x8a, 0xf6, 0x3b, 0x2f, 0x71, 0xa3, 0x4b, 0xe9,
+	0x18, 0xf8, 0x79, 0x17, 0x13, 0x1f, 0xdd, 0x87, 0x09, 0x8f, 0xff, 0x59, 0xd6, 0x36, 0xb4, 0xcd,
+	0xe9, 0xad, 0xad, 0x6a, 0x44, 0xe5, 0xcc, 0x8e, 0x5d, 0x3d, 0xba, 0x5c, 0xcd, 0x24, 0x62, 0x48,
+	0x12, 0x68, 0x0d, 0xa6, 0x2c, 0xb7, 0x6d, 0xda, 0x4e, 0xdd, 0xb6, 0xca, 0x23, 0x1b, 0xda, 0xe6,
+	0x94, 0x31, 0xc9, 0x1b, 0x6a, 0x16, 0xfa, 0x79, 0x58, 0xea, 0x98, 0x1e, 0x76, 0xfc, 0x3a, 0x96,
+	0x04, 0xea, 0xb6, 0xd3, 0x74, 0xcb, 0xa3, 0x6c, 0xe0, 0x4d, 0xe5, 0xc0, 0x8f, 0x19, 0x46, 0x30,
+	0x62, 0xcd, 0x69, 0xba, 0xc6, 0xc9, 0x4e, 0xb2, 0x11, 0x95, 0x61, 0xc2, 0xf4, 0x7d, 0xdc, 0xee,
+	0xf8, 0xe5, 0x13, 0x1b, 0xda, 0xe6, 0x98, 0x21, 0x7f, 0xa2, 0x6d, 0x98, 0xc3, 0x2f, 0x3b, 0x36,
+	0xdf, 0x1e, 0x75, 0xba, 0x0f, 0xca, 0x63, 0x6c, 0x44, 0xbd, 0xca, 0xf7, 0x40, 0x55, 0xee, 0x81,
+	0xea, 0x9e, 0xdc, 0x24, 0x46, 0xa9, 0x8f, 0x42, 0x1b, 0x51, 0x13, 0x56, 0x1b, 0xae, 0xe3, 0xdb,
+	0x4e, 0x17, 0xd7, 0x4d, 0x52, 0x77, 0xf0, 0x8b, 0xba, 0xed, 0xd8, 0xbe, 0x6d, 0xfa, 0xae, 0x57,
+	0x1e, 0xdf, 0xd0, 0x36, 0x4b, 0x5b, 0x6f, 0x2a, 0x27, 0xb0, 0x2d, 0xb0, 0x6e, 0x91, 0x87, 0xf8,
+	0x45, 0x4d, 0xa2, 0x18, 0xcb, 0x0d, 0x65, 0x3b, 0xaa, 0xc1, 0x82, 0xec, 0xb1, 0xea, 0x4d, 0xd3,
+	0x6e, 0x75, 0x3d, 0x5c, 0x9e, 0x60, 0xec, 0x9e, 0x52, 0xd2, 0xbf, 0xcb, 0x61, 0x8c, 0xf9, 0x00,
+	0x4d, 0xb4, 0x20, 0x03, 0x96, 0x5b, 0x26, 0xf1, 0xeb, 0x0d, 0xb7, 0xdd, 0x69, 0x61, 0x36, 0x79,
+	0x0f, 0x93, 0x6e, 0xcb, 0x2f, 0x4f, 0x66, 0xd0, 0x7b, 0x6c, 0xf6, 0x5a, 0xae, 0x69, 0x19, 0x8b,
+	0x14, 0x77, 0x3b, 0x40, 0x35, 0x18, 0x26, 0xfa, 0x7f, 0xb0, 0xd6, 0xb4, 0x3d, 0xe2, 0xd7, 0x2d,
+	0xdc, 0xb0, 0x09, 0x93, 0xa7, 0x49, 0x9e, 0xd5, 0xf7, 0xcd, 0xc6, 0x33, 0xb7, 0xd9, 0x2c, 0x4f,
+	0x31, 0xc2, 0xab, 0x09, 0xb9, 0xee, 0x08, 0xe3, 0x64, 0x94, 0x19, 0xf6, 0x8e, 0x40, 0xde, 0x33,
+	0xc9, 0xb3, 0xdb, 0x1c, 0xb5, 0x72, 0x03, 0xd6, 0xd3, 0x94, 0x8c, 0x74, 0x5c, 0x87, 0x60, 0xb4,
+	0x04, 0xe3, 0x5e, 0x97, 0x69, 0x96, 0xc6, 0x34, 0x6b, 0xcc, 0xeb, 0x3a, 0x35, 0xab, 0xf2, 0xc7,
+	0x23, 0xb0, 0xbe, 0x6b, 0x1f, 0x38, 0x66, 0x2b, 0x55, 0xc9, 0x1f, 0xc4, 0x95, 0xfc, 0x8a, 0x5a,
+	0xc9, 0x33, 0xa9, 0x14, 0xd4, 0xf2, 0x26, 0xac, 0xe1, 0x97, 0x3e, 0xf6, 0x1c, 0xb3, 0x15, 0x18,
+	0x9e, 0xbe, 0xc2, 0x0b, 0x5d, 0x7f, 0x5d, 0x39, 0x7e, 0x72, 0xe4, 0x55, 0x49, 0x2a, 0xd1, 0x85,
+	0xaa, 0x70, 0xb2, 0x71, 0x68, 0xb7, 0xac, 0xfe, 0x20, 0xae, 0xd3, 0xea, 0x31, 0xdd, 0x9f, 0x34,
+	0x16, 0x58, 0x97, 0x44, 0x7a, 0xe4, 0xb4, 0x7a, 0x95, 0xb3, 0x70, 0x26, 0x75, 0x7e, 0x5c, 0xc0,
+	0x95, 0x1f, 0x69, 0xf0, 0x86, 0x80, 0xb1, 0xfd, 0xc3, 0x6c, 0xbb, 0xf1, 0x49, 0x5c, 0xa4, 0xef,
+	0x65, 0x89, 0x34, 0x8f, 0x5c, 0x31, 0xd9, 0x56, 0x6e, 0xc1, 0x66, 0x3e, 0xc1, 0x6c, 0x6d, 0xf9,
+	0x0d, 0x0d, 0x4e, 0x1b, 0x98, 0xe0, 0x63, 0x5b, 0xc4, 0x4c, 0x22, 0x05, 0xe7, 0x73, 0x03, 0xd6,
+	0xd3, 0xc8, 0x64, 0xcf, 0xe2, 0x8b, 0x11, 0x38, 0xbb, 0x87, 0xbd, 0xb6, 0xed, 0x98, 0x3e, 0x4e,
+	0x9d, 0xc9, 0xe3, 0xf8, 0x4c, 0xae, 0x2b, 0x67, 0x92, 0x4b, 0xe8, 0x67, 0x5c, 0xf3, 0xcf, 0x41,
+	0x25, 0x6b, 0x8a, 0x42, 0xf9, 0xa9, 0x62, 0x3c, 0x36, 0xbb, 0x04, 0x1f, 0x57, 0x31, 0x32, 0x89,
+	0x14, 0x54, 0x8c, 0x0d, 0x58, 0x4f, 0x23, 0x23, 0xd8, 0xfd, 0x4d, 0x0d, 0xce, 0x7c, 0xd7, 0xe9,
+	0x64, 0x32, 0xfc, 0x30, 0xce, 0xf0, 0x55, 0x25, 0xc3, 0x39, 0x64, 0x0a, 0xb2, 0x5c, 0x81, 0x8d,
+	0x74, 0x42, 0x82, 0xe9, 0x1f, 0x68, 0xb0, 0xb1, 0x83, 0x49, 0xc3, 0xb3, 0xf7, 0xd3, 0xb9, 0x7e,
+	0x14, 0xe7, 0xfa, 0x9a, 0x92, 0xeb, 0x3c, 0x3a, 0x05, 0xd9, 0xfe, 0x93, 0x31, 0x38, 0x9b, 0x41,
+	0x4a, 0x6c, 0xc3, 0x16, 0xac, 0xf4, 0xef, 0x2c, 0x0d, 0xd7, 0x69, 0xda, 0x07, 0xe2, 0x44, 0xcb,
+	0x3c, 0x50, 0x12, 0x04, 0xb7, 0xc3, 0xa8, 0xc6, 0x32, 0x56, 0xb6, 0xa3, 0x7d, 0x58, 0x49, 0xee,
+	0x1f, 0x7e, 0x55, 0x1a, 0x61, 0xa3, 0x5d, 0x28, 0x36, 0x1a, 0xbb, 0x2c, 0x2d, 0xbd, 0x50, 0x35,
+	0xa3, 0x4f, 0x01, 0x75, 0xb0, 0x63, 0xd9, 0xce, 0x41, 0xdd, 0x6c, 0xf8, 0xf6, 0x91, 0xed, 0xdb,
+	0x98, 0x94, 0x47, 0x37, 0x46, 0xd3, 0x6f, 0x62, 0x1c, 0xfc, 0x16, 0x87, 0xee, 0x31, 0xe2, 0x0b,
+	0x9d, 0x48, 0xa3, 0x8d, 0x09, 0xfa, 0xff, 0x30, 0x2f, 0x09, 0xb3, 0xad, 0xe8, 0x61, 0xa7, 0x7c,
+	0x82, 0x91, 0xad, 0x66, 0x91, 0xdd, 0xa6, 0xb0, 0x51, 0xce, 0xe7, 0x3a, 0xa1, 0x2e, 0x0f, 0x3b,
+	0x68, 0xb7, 0x4f, 0x5a, 0x5e, 0x3f, 0xc4, 0x4d, 0x2e, 0x93, 0x63, 0x79, 0xdb, 0x88, 0x10, 0x95,
+	0x8d, 0xe8, 0x0e, 0x00, 0xd3, 0x5a, 0x2e, 0xdf, 0xf1, 0x02, 0x46, 0x8a, 0xed, 0x4c, 0x46, 0x6c,
+	0xaa, 0x23, 0xff, 0x44, 0x4f, 0x61, 0x31, 0x74, 0xcf, 0x6a, 0x98, 0xad, 0x16, 0xbd, 0x13, 0x91,
+	0xf2, 0x04, 0x9b, 0x7a, 0xda, 0xd5, 0x50, 0x22, 0x6c, 0x0b, 0x78, 0x7e, 0xbd, 0x6d, 0x24, 0xda,
+	0x49, 0xe5, 0x25, 0x2c, 0x3e, 0xa1, 0x7e, 0x93, 0x64, 0x42, 0xee, 0x96, 0xed, 0xf8, 0x6e, 0xf9,
+	0x96, 0x72, 0x28, 0x15, 0x6e, 0xc1, 0x1d, 0xf2, 0x63, 0x0d, 0x96, 0x62, 0xe8, 0x62, 0x57, 0x7c,
+	0x00, 0x33, 0xcc, 0x97, 0x93, 0xd7, 0x4a, 0xad, 0xc0, 0xb5, 0x72, 0x9a, 0x61, 0x88, 0xdb, 0x64,
+	0x0d, 0x4a, 0x92, 0xc0, 0x2f, 0xe0, 0x86, 0x8f, 0x2d, 0xa1, 0xdf, 0x95, 0xf4, 0x39, 0x18, 0x02,
+	0xd2, 0x98, 0x7d, 0x1e, 0xfe, 0x59, 0xf9, 0x55, 0x0d, 0x74, 0x76, 0x96, 0xee, 0xfa, 0x76, 0xe3,
+	0x59, 0x8f, 0xde, 0x2c, 0xef, 0xdb, 0xc4, 0x97, 0x62, 0xaa, 0xc5, 0xc5, 0x74, 0x31, 0xfd, 0x50,
+	0x57, 0x52, 0x28, 0x28, 0xac, 0xd3, 0xb0, 0xa6, 0xa4, 0x21, 0x0c, 0xe0, 0x7f, 0x68, 0xb0, 0x7c,
+	0x0f, 0xfb, 0x0f, 0xba, 0xbe, 0xb9, 0xdf, 0xc2, 0xbb, 0xbe, 0xe9, 0x63, 0x43, 0x45, 0x56, 0x8b,
+	0x1d, 0xad, 0xdf, 0x05, 0xa4, 0x38, 0x51, 0x47, 0x06, 0x3a, 0x51, 0x17, 0x12, 0x86, 0x00, 0x5d,
+	0x81, 0x65, 0xfc, 0xb2, 0xc3, 0x04, 0x58, 0x77, 0xf0, 0x4b, 0xbf, 0x8e, 0x8f, 0xa8, 0x7b, 0x66,
+	0x5b, 0xec, 0xb0, 0x1e, 0x35, 0x4e, 0xca, 0xde, 0x87, 0xf8, 0xa5, 0x7f, 0x87, 0xf6, 0xd5, 0x2c,
+	0x74, 0x09, 0x16, 0x1b, 0x5d, 0x8f, 0xf9, 0x71, 0xfb, 0x9e, 0xe9, 0x34, 0x0e, 0xeb, 0xbe, 0xfb,
+	0x8c, 0x6d, 0x72, 0x6d, 0x73, 0xc6, 0x40, 0xa2, 0xef, 0x36, 0xeb, 0xda, 0xa3, 0x3d, 0x95, 0x1f,
+	0x4e, 0xc1, 0x4a, 0x62, 0xd6, 0x42, 0x87, 0xd4, 0x33, 0xd3, 0x8e, 0x3b, 0xb3, 0xbb, 0x30, 0x1b,
+	0x90, 0xf5, 0x7b, 0x1d, 0x2c, 0x64, 0x75, 0x36, 0x93, 0xe2, 0x5e, 0xaf, 0x83, 0x8d, 0x99, 0x17,
+	0xa1, 0x5f, 0xa8, 0x02, 0xb3, 0x2a, 0xc1, 0x4c, 0x3b, 0x21, 0x81, 0x7c, 0x02, 0xab, 0x1d, 0x0f,
+	0x1f, 0xd9, 0x6e, 0x97, 0xd4, 0x89, 0x6f, 0x7a, 0x54, 0x9a, 0x01, 0xfc, 0x09, 0x36, 0xee, 0x5a,
+	0xc2, 0x23, 0xaa, 0x39, 0xfe, 0xf5, 0xab, 0x9f, 0x98, 0xad, 0x2e, 0x36, 0x96, 0x25, 0xf6, 0x2e,
+	0x47, 0x96, 0x74, 0xdf, 0x82, 0x93, 0xcc, 0x7f, 0xe3, 0x0e, 0x57, 0x40, 0x71, 0x8c, 0x71, 0x30,
+	0x4f, 0xbb, 0xee, 0xd2, 0x1e, 0x09, 0x7e, 0x13, 0xa6, 0x98, 0x2f, 0x46, 0x23, 0x27, 0xc2, 0x8e,
+	0x9d, 0x56, 0xdf, 0xf7, 0xa4, 0x56, 0x4e, 0xfa, 0xe2, 0x2f, 0x74, 0x0f, 0xe6, 0x09, 0xd3, 0xd8,
+	0x7a, 0x9f, 0xc4, 0x44, 0x11, 0x12, 0x25, 0x12, 0x51, 0x74, 0x74, 0x15, 0x96, 0x1b, 0x2d, 0x9b,
+	0x72, 0xda, 0xb2, 0xf7, 0x3d, 0xd3, 0xeb, 0xd5, 0x8f, 0xb0, 0xc7, 0x0c, 0xf5, 0x24, 0x53, 0xe9,
+	0x45, 0xde, 0x7b, 0x9f, 0x77, 0x7e, 0xc2, 0xfb, 0x42, 0x58, 0x4d, 0x6c, 0xfa, 0x5d, 0x0f, 0x07,
+	0x58, 0x53, 0x61, 0xac, 0xbb, 0xbc, 0x53, 0x62, 0x9d, 0x81, 0x69, 0x81, 0x65, 0xb7, 0x3b, 0xad,
+	0x32, 0x30, 0x50, 0xe0, 0x4d, 0xb5, 0x76, 0xa7, 0x85, 0x08, 0x5c, 0x88, 0xcf, 0xaa, 0x4e, 0x1a,
+	0x87, 0xd8, 0xea, 0xb6, 0x70, 0xdd, 0x77, 0xf9, 0x62, 0xb1, 0x80, 0x80, 0xdb, 0xf5, 0xcb, 0xd3,
+	0x79, 0xbe, 0xeb, 0xb9, 0xe8, 0x5c, 0x77, 0x05, 0xa5, 0x3d, 0x97, 0xad, 0xdb, 0x1e, 0x27, 0x43,
+	0x6f, 0xa7, 0x7c, 0xa9, 0x88, 0xef, 0x86, 0x26, 0x32, 0xc3, 0x62, 0x12, 0x0b, 0xac, 0x6b, 0xd7,
+	0x77, 0xfb, 0xb3, 0x48, 0xdb, 0x4e, 0xb3, 0x69, 0xdb, 0x09, 0xdd, 0x87, 0x52, 0xa0, 0xdb, 0xc4,
+	0x37, 0x7d, 0x5c, 0x2e, 0xb1, 0xf8, 0xc3, 0xf9, 0xe8, 0x52, 0xf1, 0xa0, 0x50, 0x58, 0xbf, 0xf9,
+	0xce, 0x9b, 0x7d, 0x11, 0xfe, 0x89, 0x1a, 0xb0, 0x18, 0x50, 0x6b, 0xb4, 0x5c, 0x82, 0x05, 0xcd,
+	0x39, 0x46, 0xf3, 0x72, 0xc1, 0x7b, 0x0d, 0x45, 0xa4, 0xf4, 0xba, 0xc4, 0x08, 0xf6, 0x73, 0xd0,
+	0x48, 0x77, 0xf9, 0x82, 0x10, 0x44, 0x9d, 0x47, 0xc5, 0xe8, 0x65, 0x63, 0x5e, 0x75, 0x74, 0xf7,
+	0xb9, 0x16, 0x02, 0xfa, 0x48, 0xc2, 0x1b, 0xf3, 0x47, 0xb1, 0x16, 0xf4, 0x1e, 0xac, 0xd9, 0xa4,
+	0xce, 0x97, 0x25, 0xb4, 0xc6, 0xd8, 0xa1, 0x76, 0xc6, 0x2a, 0x2f, 0x30, 0x8f, 0x60, 0xc5, 0x26,
+	0x51, 0x6b, 0x7c, 0x87, 0x77, 0x57, 0xfe, 0x53, 0x83, 0x95, 0xc7, 0x6e, 0xab, 0xf5, 0x7f, 0xcc,
+	0x1a, 0xff, 0x64, 0x12, 0xca, 0xc9, 0x69, 0x7f, 0x63, 0x8e, 0xbf, 0x31, 0xc7, 0x5f, 0x47, 0x73,
+	0x9c, 0xb6, 0x3f, 0x66, 0x52, 0xcd, 0xab, 0xd2, 0x56, 0xcd, 0x1e, 0xdb, 0x56, 0xfd, 0xec, 0x59,
+	0xed, 0xca, 0xdf, 0x8c, 0xc0, 0x86, 0x81, 0x1b, 0xae, 0x67, 0x85, 0x03, 0xb6, 0x62, 0x5b, 0xbc,
+	0x4a, 0x4b, 0x79, 0x06, 0xa6, 0x03, 0xc5, 0x09, 0x8c, 0x00, 0xc8, 0xa6, 0x9a, 0x85, 0x56, 0x60,
+	0x82, 0xe9, 0x98, 0xd8, 0xf1, 0xa3, 0xc6, 0x38, 0xfd, 0x59, 0xb3, 0xd0, 0x69, 0x00, 0x71, 0x8f,
+	0x97, 0x7b, 0x77, 0xca, 0x98, 0x12, 0x2d, 0x35, 0x0b, 0x19, 0x30, 0xd3, 0x71, 0x5b, 0xad, 0xba,
+	0x68, 0x29, 0x8f, 0x67, 0xf8, 0x0a, 0xd4, 0x86, 0xde, 0x75, 0xbd, 0xb0, 0x68, 0xa4, 0xaf, 0x30,
+	0x4d, 0x89, 0x88, 0x1f, 0x95, 0x7f, 0x9a, 0x80, 0xb3, 0x19, 0x52, 0x14, 0x86, 0x37, 0x61, 0x21,
+	0xb5, 0xe1, 0x2c, 0x64, 0xa6, 0xf5, 0x1b, 0x19, 0xde, 0xfa, 0x7d, 0x1b, 0x90, 0x94, 0xaf, 0x15,
+	0x37, 0xbf, 0xf3, 0x41, 0x8f, 0x84, 0xde, 0xa4, 0x06, 0x4c, 0x61, 0x7a, 0x47, 0x8d, 0x92, 0x68,
+	0x97, 0x90, 0x09, 0x8b, 0x3e, 0x96, 0xb4, 0xe8, 0xa1, 0xd4, 0xce, 0x78, 0x34, 0xb5, 0xf3, 0x36,
+	0x94, 0x85, 0x49, 0xe9, 0xc7, 0x49, 0xe4, 0xe9, 0x3f, 0xc1, 0x4e, 0xff, 0x65, 0xde, 0x1f, 0xe8,
+	0x8e, 0x38, 0xfc, 0x91, 0x01, 0xb3, 0x41, 0x0a, 0x83, 0x79, 0xfe, 0x3c, 0x27, 0xf2, 0x56, 0xda,
+	0x6e, 0xdc, 0xf3, 0x4c, 0x87, 0x50, 0x53, 0x16, 0x89, 0x26, 0xcc, 0x58, 0xa1, 0x5f, 0xe8, 0x29,
+	0x9c, 0x52, 0xc4, 0x6d, 0xfa, 0x26, 0x7c, 0xaa, 0x88, 0x09, 0x5f, 0x4d, 0xa8, 0xbb, 0xec, 0x4a,
+	0xbb, 0x5a, 0x42, 0xda, 0xd5, 0xf2, 0x2c, 0xcc, 0x44, 0x6c, 0xde, 0x34, 0xb3, 0x79, 0xd3, 0xfb,
+	0x21, 0x63, 0x77, 0x0b, 0x4a, 0xfd, 0x65, 0x65, 0xa9, 0xb1, 0x99, 0xdc, 0xd4, 0xd8, 0x6c, 0x80,
+	0x41, 0xdb, 0xd0, 0xfb, 0x30, 0x23, 0xd7, 0x9a, 0x11, 0x98, 0xcd, 0x25, 0x30, 0x2d, 0xe0, 0x19,
+	0xba, 0x09, 0x13, 0xd4, 0x93, 0xa7, 0x46, 0xb6, 0xc4, 0x62, 0x25, 0xf7, 0xaa, 0x29, 0x39, 0xef,
+	0x6a, 0xee, 0x2e, 0x62, 0x21, 0x02, 0x1b, 0x93, 0x3b, 0x8e, 0xef, 0xf5, 0x0c, 0x49, 0x57, 0x7f,
+	0x0a, 0x33, 0xe1, 0x0e, 0x34, 0x0f, 0xa3, 0xcf, 0x70, 0x4f, 0x18, 0x2b, 0xfa, 0x27, 0x7a, 0x1b,
+	0xc6, 0x8e, 0xa8, 0xfa, 0x67, 0xc6, 0x1f, 0xe4, 0xae, 0xe3, 0x71, 0x08, 0x8e, 0x70, 0x73, 0xe4,
+	0x6d, 0x2d, 0x64, 0x27, 0x65, 0x70, 0xec, 0x1b, 0x3b, 0x99, 0xb0, 0x93, 0x61, 0xd1, 0x28, 0xed,
+	0xe4, 0x4f, 0x47, 0xa5, 0x9d, 0x54, 0x4a, 0x51, 0xd8, 0xc9, 0x8f, 0x61, 0x2e, 0x66, 0x87, 0x32,
+	0x2d, 0x25, 0x3f, 0x7f, 0x7b, 0xcc, 0x92, 0x18, 0xa5, 0xa8, 0x9d, 0x4a, 0x68, 0xee, 0xc8, 0x60,
+	0x9a, 0x1b, 0x32, 0x4b, 0xa3, 0x51, 0xb3, 0xf4, 0x14, 0xd6, 0xa3, 0xbb, 0xaa, 0xee, 0x36, 0xeb,
+	0xfe, 0xa1, 0x4d, 0xea, 0xe1, 0x14, 0x75, 0xf6, 0x50, 0x7a, 0x64, 0x97, 0x3d, 0x6a, 0xee, 0x1d,
+	0xda, 0xe4, 0x96, 0xa0, 0x5f, 0x83, 0x85, 0x43, 0x6c, 0x7a, 0xfe, 0x3e, 0x36, 0xfd, 0xba, 0x85,
+	0x7d, 0xd3, 0x6e, 0x91, 0xf2, 0x58, 0x81, 0xe8, 0xdb, 0x7c, 0x80, 0xb6, 0xc3, 0xb1, 0x92, 0xe7,
+	0xce, 0xf8, 0x70, 0xe7, 0xce, 0x1b, 0x30, 0x17, 0xd0, 0xe1, 0x6a, 0xcd, 0x0c, 0xf0, 0x94, 0x11,
+	0xdc, 0x7a, 0x76, 0x58, 0x6b, 0xe5, 0xf7, 0x35, 0x78, 0x8d, 0xaf, 0x66, 0x64, 0x27, 0x8b, 0x58,
+	0x68, 0x7f, 0xbf, 0x18, 0xf1, 0x88, 0xdd, 0xdb, 0x69, 0x11, 0xbb, 0x3c, 0x52, 0x05, 0x43, 0x77,
+	0x7f, 0x31, 0x0a, 0xe7, 0xb2, 0xa9, 0x09, 0x15, 0xc4, 0xfd, 0xc3, 0xcd, 0x13, 0x6d, 0x82, 0xc5,
+	0x9b, 0xc3, 0x9b, 0x2e, 0x63, 0x8e, 0xc4, 0x34, 0xfd, 0xc7, 0x1a, 0xac, 0xf7, 0x43, 0xf3, 0xf4,
+	0x82, 0x6c, 0xd9, 0xa4, 0x63, 0xfa, 0x8d, 0xc3, 0x7a, 0xcb, 0xa5, 0x01, 0xe6, 0x5e, 0x79, 0x84,
+	0x19, 0xcc, 0xa7, 0x19, 0xa3, 0xe6, 0x4f, 0xa7, 0xda, 0x8f, 0xdd, 0xef, 0xb9, 0x3b, 0x62, 0x84,
+	0xfb, 0x7c, 0x00, 0x6e, 0x47, 0xd7, 0xcc, 0x74, 0x08, 0xfd, 0x97, 0x60, 0x23, 0x8f, 0x80, 0xc2,
+	0xde, 0xee, 0x44, 0xed, 0xad, 0x3a, 0x33, 0x20, 0xcd, 0x00, 0xa3, 0x25, 0x09, 0xb3, 0x63, 0x37,
+	0x64, 0x7b, 0x69, 0x4a, 0x49, 0x31, 0x4d, 0x5a, 0x03, 0x81, 0xad, 0x01, 0x53, 0x4a, 0x79, 0x74,
+	0x0a, 0x2a, 0xd2, 0x6b, 0x70, 0x36, 0x83, 0x92, 0x88, 0x04, 0xff, 0x50, 0x83, 0x4a, 0xd2, 0xda,
+	0x7d, 0x24, 0xb7, 0xa7, 0xe4, 0xfc, 0x49, 0x9c, 0xf3, 0x1b, 0x29, 0x9c, 0xe7, 0x51, 0x2a, 0xc8,
+	0xfb, 0x63, 0x78, 0x2d, 0x93, 0x96, 0xd0, 0xcd, 0x6f, 0xc1, 0x7c, 0xc3, 0x74, 0x1a, 0x38, 0x38,
+	0x01, 0x30, 0x3f, 0xd3, 0x26, 0x8d, 0x39, 0xde, 0x6e, 0xc8, 0xe6, 0xf0, 0x7e, 0x0f, 0xd3, 0x3c,
+	0xe6, 0x7e, 0xcf, 0x22, 0x55, 0x70, 0xaa, 0xaf, 0xc3, 0xb9, 0x6c, 0x62, 0xa1, 0xa4, 0xa5, 0x02,
+	0xf0, 0x38, 0x1a, 0x96, 0x4a, 0x67, 0x60, 0x0d, 0x53, 0x51, 0x8a, 0x68, 0x58, 0x72, 0x82, 0x6c,
+	0x7d, 0xb0, 0x35, 0xb0, 0x86, 0xe5, 0x51, 0x2a, 0xc8, 0xfb, 0x79, 0x78, 0x2d, 0x93, 0x96, 0xe0,
+	0xfe, 0x2f, 0x35, 0x38, 0x63, 0xe0, 0xb6, 0x7b, 0x84, 0x79, 0xc5, 0xc7, 0x57, 0x25, 0x48, 0x17,
+	0xbd, 0x18, 0x8d, 0xc6, 0x2e, 0x46, 0x34, 0x0b, 0x9e, 0xce, 0xb5, 0x98, 0xda, 0x5f, 0x8d, 0xc0,
+	0x79, 0x31, 0x05, 0x3e, 0xed, 0xd4, 0x54, 0x78, 0xe6, 0x04, 0x4d, 0x28, 0x45, 0xf7, 0x60, 0x79,
+	0x44, 0x75, 0x08, 0x05, 0xeb, 0x57, 0x60, 0x40, 0x63, 0x36, 0xb2, 0x7b, 0x69, 0x22, 0x3a, 0xa8,
+	0xe8, 0x50, 0xd6, 0xec, 0xa9, 0x13, 0xd1, 0x77, 0x04, 0x4e, 0x2c, 0x11, 0x8d, 0x55, 0xcd, 0x03,
+	0x57, 0x73, 0x6c, 0xc2, 0xeb, 0x79, 0x73, 0x11, 0x72, 0xfe, 0x6b, 0x0d, 0xd6, 0x64, 0x54, 0x48,
+	0xe1, 0xa5, 0xbf, 0x12, 0xf5, 0xb9, 0x00, 0x0b, 0x36, 0xa9, 0x47, 0x4b, 0xe8, 0x98, 0x2c, 0x27,
+	0x8d, 0x39, 0x9b, 0xdc, 0x0d, 0x17, 0xc7, 0x55, 0xd6, 0xe1, 0x94, 0x9a, 0x7d, 0x31, 0xbf, 0x9f,
+	0x8e, 0xc0, 0x39, 0x6e, 0xac, 0xa3, 0xc9, 0xf3, 0x84, 0x69, 0x7d, 0x15, 0x13, 0x3d, 0x0b, 0x33,
+	0xa2, 0x3e, 0x12, 0x5b, 0xa1, 0x40, 0x6d, 0xd0, 0x56, 0xb3, 0xd0, 0xa7, 0x20, 0x33, 0xdd, 0xd8,
+	0x0a, 0x0d, 0x7d, 0x62, 0xa0, 0xa1, 0x51, 0x40, 0xa2, 0x3f, 0xf6, 0x7d, 0x98, 0x0f, 0xe5, 0xe2,
+	0xb9, 0x93, 0x30, 0x56, 0xd4, 0x49, 0x98, 0xeb, 0xa3, 0xb2, 0x86, 0xca, 0x1b, 0x70, 0x3e, 0x47,
+	0xca, 0x62, 0x3d, 0xfe, 0x75, 0x04, 0xca, 0x86, 0xa8, 0xd4, 0xc5, 0x0c, 0x97, 0x7c, 0xb2, 0xf5,
+	0x2a, 0xd7, 0xe0, 0x29, 0x2c, 0x45, 0x23, 0x99, 0xbd, 0xba, 0xed, 0xe3, 0xb6, 0x2c, 0xf3, 0xb8,
+	0x50, 0x28, 0x9a, 0xd9, 0xab, 0xf9, 0xb8, 0x6d, 0x9c, 0x3c, 0x4a, 0xb4, 0x11, 0x74, 0x0d, 0xc6,
+	0x99, 0x70, 0x49, 0xf9, 0x44, 0x46, 0x64, 0x63, 0xc7, 0xf4, 0xcd, 0xdb, 0x2d, 0x77, 0xdf, 0x10,
+	0xc0, 0x68, 0x1b, 0x4a, 0xb4, 0x7c, 0x96, 0xd6, 0xb5, 0x09, 0xf4, 0xb1, 0x22, 0xe8, 0x33, 0x0e,
+	0x7e, 0x61, 0x74, 0xf9, 0xa2, 0x90, 0xca, 0x1a, 0xac, 0x2a, 0x64, 0xdd, 0xaf, 0xe5, 0x5a, 0xde,
+	0xed, 0x39, 0x8d, 0xdd, 0x43, 0xd3, 0xb3, 0x44, 0x80, 0x53, 0xac, 0xc3, 0x79, 0x28, 0x11, 0xb7,
+	0xeb, 0x35, 0x70, 0x5d, 0x14, 0x71, 0x8b, 0xc5, 0x98, 0xe5, 0xad, 0xdb, 0xbc, 0x11, 0xad, 0xc2,
+	0x24, 0x95, 0x87, 0x25, 0x4f, 0xb0, 0x31, 0x63, 0x82, 0xfd, 0xae, 0x59, 0xa8, 0x0a, 0x27, 0x98,
+	0xb7, 0x38, 0x9a, 0xeb, 0xc2, 0x31, 0xb8, 0xca, 0x2a, 0xac, 0x24, 0x78, 0x11, 0x7c, 0xfe, 0xdd,
+	0x18, 0x9c, 0xa4, 0x7d, 0xf2, 0x24, 0x7c, 0x95, 0xca, 0x52, 0x86, 0x09, 0x19, 0x50, 0xe2, 0x7b,
+	0x55, 0xfe, 0xa4, 0x5b, 0xb9, 0xef, 0xcd, 0x06, 0x91, 0x82, 0x20, 0xb2, 0x40, 0x65, 0x92, 0x0c,
+	0x23, 0x8d, 0x0d, 0x1a, 0x46, 0x3a, 0x0d, 0x20, 0xbd, 0x2a, 0xdb, 0x62, 0x5e, 0xe8, 0xa8, 0x31,
+	0x25, 0x5a, 0x6a, 0x56, 0xc2, 0x57, 0x9f, 0x18, 0xcc, 0x57, 0xff, 0x58, 0x24, 0x6f, 0xfa, 0x6e,
+	0x33, 0xa3, 0x32, 0x99, 0x4b, 0x65, 0x81, 0xa2, 0x05, 0x17, 0x60, 0x46, 0xeb, 0x3a, 0x4c, 0x48,
+	0x9f, 0x7b, 0xaa, 0x80, 0xcf, 0x2d, 0x81, 0xc3, 0xf1, 0x02, 0x88, 0xc6, 0x0b, 0x3e, 0x80, 0x19,
+	0x9e, 0x5a, 0x12, 0xf5, 0xde, 0xd3, 0x05, 0xea, 0xbd, 0xa7, 0x59, 0xc6, 0x89, 0xff, 0xa0, 0x59,
+	0x0e, 0x46, 0x80, 0xbf, 0x5e, 0xa8, 0xdb, 0x16, 0x76, 0x7c, 0xdb, 0xef, 0xb1, 0x60, 0xde, 0x94,
+	0x81, 0x68, 0xdf, 0xa7, 0xac, 0xab, 0x26, 0x7a, 0xd0, 0x23, 0x98, 0x8b, 0xd9, 0x86, 0xf2, 0xac,
+	0x4a, 0x85, 0xd2, 0xac, 0x82, 0x51, 0x8a, 0x5a, 0x84, 0xca, 0x32, 0x2c, 0x46, 0x55, 0x59, 0xe8,
+	0xf8, 0x6f, 0x6b, 0xb0, 0x26, 0x0b, 0xec, 0xbe, 0x22, 0x97, 0x38, 0x5a, 0x3c, 0x79, 0x4a, 0xcd,
+	0x93, 0xf0, 0x6f, 0xae, 0xc0, 0x72, 0x9b, 0xb7, 0xf3, 0xbc, 0x4a, 0xdd, 0xa6, 0x35, 0x5d, 0x8d,
+	0x43, 0x2c, 0x38, 0x3c, 0xd9, 0x0e, 0x61, 0xd5, 0x9c, 0x6d, 0xda, 0x85, 0xde, 0x81, 0xd5, 0x04,
+	0x92, 0x65, 0xfa, 0xe6, 0xbe, 0x49, 0xb0, 0xb8, 0x06, 0x2f, 0x47, 0xf1, 0x76, 0x44, 0x6f, 0xe5,
+	0x14, 0xe8, 0x92, 0x1f, 0x21, 0xcf, 0x8f, 0xdc, 0xa0, 0xf4, 0xa8, 0xf2, 0x2b, 0x23, 0xb0, 0xa6,
+	0xec, 0x16, 0xdc, 0x6e, 0xc2, 0xbc, 0xd3, 0x6d, 0xef, 0x63, 0x8f, 0x86, 0x99, 0x98, 0x99, 0x22,
+	0x8c, 0xcf, 0x31, 0xa3, 0xc4, 0xdb, 0x1f, 0x35, 0x99, 0xf5, 0x21, 0x54, 0xd8, 0xd2, 0xac, 0x11,
+	0x16, 0x3d, 0x18, 0x33, 0x26, 0x85, 0x5d, 0x23, 0xe8, 0x63, 0x98, 0x11, 0x2b, 0xc1, 0xa7, 0xca,
+	0x0d, 0xdc, 0x1b, 0x69, 0xfa, 0xc0, 0xe3, 0x39, 0x6c, 0xea, 0xec, 0x7e, 0x37, 0x6d, 0xf5, 0x1b,
+	0xd0, 0x75, 0x58, 0xe1, 0x03, 0x35, 0x5c, 0xc7, 0xf7, 0xdc, 0x56, 0x0b, 0x7b, 0x4c, 0x28, 0x5d,
+	0x7e, 0x56, 0x4c, 0x19, 0x4b, 0xac, 0x7b, 0x3b, 0xe8, 0xe5, 0x96, 0x91, 0xed, 0x11, 0xcb, 0xf2,
+	0x30, 0x21, 0x22, 0xe8, 0x28, 0x7f, 0x56, 0xaa, 0xb0, 0xc0, 0x53, 0x53, 0x14, 0x4f, 0x2a, 0x4f,
+	0xd8, 0x4c, 0x6b, 0x11, 0x33, 0x5d, 0x59, 0x04, 0x14, 0x86, 0x17, 0xda, 0xf8, 0xef, 0x1a, 0x2c,
+	0xf0, 0x0b, 0x7a, 0xf8, 0x26, 0x98, 0x4e, 0x06, 0xbd, 0x2f, 0xd2, 0xb8, 0x41, 0xd6, 0xba, 0xb4,
+	0xb5, 0x91, 0x9a, 0x23, 0x30, 0xc9, 0x33, 0x16, 0x1a, 0x9b, 0xf4, 0xc5, 0x5f, 0xe1, 0x00, 0xeb,
+	0x68, 0x24, 0xc0, 0xba, 0x0d, 0x73, 0x47, 0x36, 0xb1, 0xf7, 0xed, 0x96, 0xed, 0xf7, 0xb8, 0x31,
+	0xca, 0x8f, 0x09, 0x96, 0xfa, 0x28, 0xb4, 0x91, 0x5a, 0x66, 0x71, 0x8a, 0xd5, 0x1d, 0x53, 0x18,
+	0xdd, 0x29, 0x63, 0x5a, 0xb4, 0x3d, 0x34, 0xdb, 0x98, 0x8a, 0x21, 0x3c, 0xdf, 0xbe, 0x4f, 0xbb,
+	0x60, 0x60, 0x82, 0xfd, 0x27, 0x5d, 0xdc, 0xc5, 0x05, 0xc4, 0x10, 0x1f, 0x69, 0x24, 0x31, 0x52,
+	0x54, 0x52, 0xa3, 0x83, 0x4a, 0x8a, 0x33, 0xda, 0xe7, 0x48, 0x30, 0xfa, 0xbb, 0x1a, 0x2c, 0x4a,
+	0xd5, 0xff, 0xea, 0xf0, 0xfa, 0x08, 0x96, 0x62, 0x4c, 0x89, 0x9d, 0x78, 0x1d, 0x56, 0x3a, 0x9e,
+	0xdb, 0xc0, 0x84, 0xd0, 0x22, 0x55, 0xf6, 0xb4, 0x8b, 0xdb, 0x02, 0xba, 0x21, 0x47, 0xa9, 0xda,
+	0xf7, 0xbb, 0x19, 0x26, 0x33, 0x04, 0x84, 0x56, 0x2f, 0x9e, 0xbe, 0x87, 0x7d, 0xa3, 0xff, 0xce,
+	0xeb, 0x01, 0x26, 0xc4, 0x3c, 0xc0, 0xc1, 0xbd, 0xe5, 0x43, 0x18, 0x67, 0x49, 0x1c, 0x4e, 0x28,
+	0x23, 0x15, 0x1d, 0xa2, 0xc1, 0x52, 0x3c, 0x86, 0xc0, 0x2b, 0x20, 0x16, 0x6a, 0x68, 0xd6, 0xd3,
+	0xd8, 0x10, 0x33, 0x7c, 0x0e, 0x25, 0x2e, 0xf7, 0xb6, 0xe8, 0x11, 0xfc, 0x7c, 0x9c, 0x1a, 0x84,
+	0xcc, 0x26, 0x58, 0x65, 0xfb, 0x53, 0xb6, 0xf2, 0x80, 0xe3, 0x2c, 0x09, 0xb7, 0xe9, 0x6d, 0x40,
+	0x49, 0xa0, 0x70, 0x50, 0x71, 0x8c, 0x07, 0x15, 0x6f, 0x45, 0x83, 0x8a, 0x6f, 0x16, 0x90, 0x50,
+	0xc0, 0x4d, 0x28, 0xa2, 0xe8, 0xc0, 0xc6, 0x3d, 0xec, 0xef, 0xdc, 0x7f, 0x92, 0xb1, 0x1a, 0x1f,
+	0x03, 0xf0, 0x6d, 0xed, 0x34, 0x5d, 0x29, 0x81, 0x22, 0xe3, 0x51, 0x5d, 0xe2, 0x95, 0xc3, 0xbe,
+	0xf8, 0x8b, 0x54, 0x7a, 0x70, 0x36, 0x63, 0x3c, 0x21, 0xf6, 0x3d, 0x58, 0x08, 0x3d, 0x02, 0x64,
+	0x39, 0x45, 0x39, 0xee, 0x1b, 0x05, 0xc7, 0x35, 0xe6, 0xbd, 0x68, 0x03, 0xa9, 0xfc, 0xa3, 0x06,
+	0x8b, 0x06, 0x36, 0x3b, 0x9d, 0x16, 0x77, 0x7e, 0x82, 0xf9, 0x2d, 0xc3, 0xb8, 0x08, 0xe2, 0xf3,
+	0xf3, 0x4e, 0xfc, 0xca, 0x7e, 0xff, 0xa1, 0x3e, 0xac, 0x47, 0x8f, 0x7b, 0x31, 0x1d, 0xce, 0xcb,
+	0xa8, 0xac, 0xc0, 0x52, 0x6c, 0x6a, 0xc2, 0xa4, 0xfc, 0x99, 0x46, 0x6b, 0x74, 0x9b, 0x1e, 0x26,
+	0x87, 0x41, 0x3e, 0x83, 0x4a, 0xe3, 0x2b, 0x38, 0x77, 0x1a, 0x02, 0x50, 0xb3, 0x2a, 0xe6, 0xf2,
+	0x0f, 0xe3, 0x70, 0xfa, 0x51, 0x07, 0x7b, 0xa6, 0x8f, 0x63, 0xe5, 0xf9, 0x5f, 0xc5, 0x95, 0x3c,
+	0x03, 0xd3, 0x22, 0x95, 0xd0, 0x93, 0x7e, 0xc4, 0x94, 0x01, 0xb2, 0xa9, 0x66, 0xa1, 0x87, 0x30,
+	0xe5, 0xb2, 0xd9, 0xc8, 0xca, 0xfe, 0xd2, 0xd6, 0xa5, 0x34, 0xed, 0x8e, 0xcd, 0xf7, 0x91, 0xc4,
+	0x33, 0xfa, 0x24, 0xd0, 0x1e, 0xac, 0xa6, 0x17, 0x18, 0x8d, 0xe7, 0x15, 0x18, 0x2d, 0x13, 0x75,
+	0x49, 0x51, 0x8c, 0x2a, 0x2f, 0xbf, 0x91, 0x54, 0x27, 0x06, 0xa0, 0xca, 0xee, 0x27, 0x92, 0xea,
+	0x43, 0x58, 0x16, 0xfc, 0xc5, 0x49, 0x4e, 0xe6, 0x91, 0x3c, 0xc9, 0x10, 0x63, 0xf4, 0xee, 0x86,
+	0x73, 0x84, 0x92, 0x54, 0xee, 0xfb, 0xcc, 0x7e, 0x82, 0x50, 0xd2, 0xd9, 0x86, 0x19, 0x0f, 0xfb,
+	0x5e, 0xaf, 0xde, 0x71, 0x5b, 0x76, 0xa3, 0xc7, 0x5c, 0x97, 0xe9, 0xad, 0x0d, 0xa5, 0x16, 0x18,
+	0x14, 0xf0, 0x31, 0x83, 0x33, 0xa6, 0xbd, 0xfe, 0x0f, 0xea, 0x75, 0x7b, 0x98, 0x60, 0x5f, 0xe6,
+	0x3f, 0x09, 0x73, 0x71, 0x26, 0x8d, 0x59, 0xd6, 0x2a, 0xd2, 0x9a, 0x84, 0x7a, 0x56, 0xd2, 0x05,
+	0x9a, 0x29, 0xe0, 0x02, 0x49, 0x60, 0x74, 0x15, 0xc6, 0xc5, 0x13, 0x84, 0xd9, 0x02, 0x0e, 0x99,
+	0x80, 0x45, 0x3a, 0x4c, 0x06, 0x8e, 0x52, 0x89, 0xef, 0x00, 0xf9, 0x9b, 0x3e, 0xc0, 0x4a, 0xdb,
+	0x57, 0x62, 0xeb, 0x7d, 0x31, 0x02, 0xcb, 0x06, 0x36, 0xad, 0x9d, 0xfb, 0x4f, 0xe2, 0xa7, 0xc3,
+	0x15, 0x38, 0x11, 0x94, 0xf0, 0x94, 0xb6, 0xce, 0xa4, 0x5e, 0xa0, 0xef, 0x3f, 0x61, 0xf7, 0x0a,
+	0x06, 0x9c, 0x15, 0x71, 0x48, 0xc6, 0x2c, 0x46, 0x55, 0x31, 0x8b, 0x3d, 0x28, 0xdb, 0x0e, 0x85,
+	0xb0, 0x8f, 0x70, 0x1d, 0x3b, 0xc1, 0x11, 0x5d, 0xb0, 0xee, 0x71, 0x29, 0x40, 0xbe, 0xe3, 0xc8,
+	0xb3, 0xb6, 0x66, 0x51, 0x43, 0xd1, 0xa1, 0x44, 0x88, 0xfd, 0x19, 0xbf, 0x60, 0x8e, 0x19, 0x93,
+	0xb4, 0x61, 0xd7, 0xfe, 0x0c, 0xa3, 0xd7, 0x61, 0x8e, 0x55, 0xef, 0x30, 0x08, 0x5e, 0x64, 0x32,
+	0xce, 0x8a, 0x4c, 0x58, 0x51, 0xcf, 0x63, 0xf3, 0x00, 0xf3, 0x9a, 0xd3, 0xcf, 0x47, 0x61, 0x25,
+	0x21, 0xac, 0xc0, 0xd7, 0x1a, 0x42, 0x5a, 0xca, 0xf3, 0x70, 0xe4, 0x98, 0xe7, 0x21, 0x32, 0x61,
+	0x39, 0x41, 0x55, 0x86, 0xbb, 0x07, 0x3e, 0xe2, 0x17, 0xe3, 0xe4, 0x69, 0xab, 0x4a, 0x62, 0x27,
+	0x14, 0x12, 0xa3, 0x15, 0x16, 0x7c, 0xdb, 0x89, 0xeb, 0xe3, 0xd8, 0xc6, 0x68, 0xb2, 0xc2, 0x42,
+	0xc9, 0x00, 0x15, 0x14, 0xcf, 0x2f, 0xfb, 0x58, 0xec, 0x42, 0x71, 0xcb, 0xfc, 0x17, 0x5a, 0xf0,
+	0xdc, 0xf5, 0x0e, 0xf0, 0xd7, 0x5c, 0x67, 0x2b, 0x3a, 0x94, 0x93, 0xf3, 0x14, 0xfb, 0xf6, 0xcf,
+	0x47, 0x60, 0xe5, 0x01, 0xfe, 0xfa, 0x0b, 0xe1, 0x7f, 0x67, 0xe3, 0xde, 0x86, 0xf2, 0x03, 0xac,
+	0x96, 0xa4, 0x8a, 0x86, 0xa6, 0xa2, 0xf1, 0xcb, 0x1a, 0x9c, 0x7a, 0xe8, 0xfa, 0x76, 0xb3, 0x47,
+	0x0d, 0xb7, 0x7b, 0x84, 0xbd, 0x07, 0x26, 0x8d, 0x45, 0x05, 0x62, 0x37, 0x61, 0xb9, 0x29, 0x7a,
+	0xea, 0x6d, 0xd6, 0x55, 0x8f, 0xf8, 0x3a, 0xa9, 0xdb, 0x2e, 0x4a, 0x8f, 0x8d, 0x66, 0x2c, 0x36,
+	0x93, 0x8d, 0xa4, 0x72, 0x06, 0x4e, 0xa7, 0xb0, 0x20, 0xd4, 0xc2, 0x84, 0xb5, 0x7b, 0xd8, 0xdf,
+	0xf6, 0x5c, 0x42, 0xc4, 0xb2, 0x44, 0x2e, 0x85, 0x91, 0xc0, 0x89, 0x16, 0x0b, 0x9c, 0x9c, 0x87,
+	0x92, 0x6f, 0x7a, 0x07, 0xd8, 0x0f, 0x96, 0x99, 0x5f, 0xa8, 0x66, 0x79, 0xab, 0xa0, 0x57, 0xf9,
+	0xaf, 0x51, 0x38, 0xa5, 0x1e, 0x43, 0x08, 0xb4, 0x0d, 0x25, 0x6e, 0x72, 0xf6, 0x7b, 0x3c, 0x8c,
+	0x53, 0xd6, 0x72, 0x2a, 0xe2, 0xb2, 0xc8, 0x31, 0xc7, 0x95, 0xdc, 0xee, 0x31, 0xdf, 0x89, 0x3b,
+	0x56, 0x33, 0x7e, 0xa8, 0x09, 0x7d, 0x4f, 0x83, 0xa5, 0x26, 0xcb, 0x19, 0xd7, 0x1b, 0xec, 0x05,
+	0x64, 0x30, 0x2c, 0x37, 0xa4, 0x0f, 0x86, 0x1b, 0x96, 0xa7, 0xa1, 0xb7, 0x29, 0xc5, 0xc8, 0xe0,
+	0xa8, 0x99, 0xe8, 0xd0, 0x9f, 0xc3, 0x42, 0x82, 0x4b, 0x85, 0x67, 0x77, 0x37, 0xea, 0xd9, 0xa5,
+	0xde, 0x09, 0xe3, 0x4c, 0x89, 0xd5, 0x0b, 0xbb, 0x77, 0xfa, 0x73, 0x58, 0x49, 0xe1, 0x50, 0x31,
+	0xf0, 0x87, 0xe1, 0x81, 0x4b, 0xe9, 0x19, 0x93, 0x7b, 0xd8, 0xef, 0x67, 0xe0, 0x19, 0xe1, 0xb0,
+	0x47, 0xc9, 0x75, 0x2b, 0x64, 0xa2, 0xa3, 0x29, 0x89, 0x3c, 0xdd, 0xf2, 0x70, 0xdb, 0xf5, 0x71,
+	0x5c, 0xb7, 0x78, 0xab, 0xd4, 0xad, 0xff, 0xe6, 0xba, 0xa5, 0x18, 0x43, 0xe8, 0x56, 0x07, 0xe6,
+	0x78, 0xfc, 0x2d, 0xae, 0x5c, 0x1f, 0x15, 0x74, 0xdc, 0xa3, 0xf4, 0xaa, 0xfc, 0x67, 0x64, 0x81,
+	0x67, 0x49, 0xb8, 0xed, 0x78, 0xea, 0x95, 0x3e, 0xf0, 0x20, 0xea, 0xd5, 0x01, 0x94, 0xe4, 0x53,
+	0xb1, 0xcc, 0xd9, 0xe5, 0x48, 0xca, 0x53, 0x36, 0x9c, 0xce, 0x79, 0xb5, 0xda, 0x65, 0xc5, 0x63,
+	0x36, 0x06, 0x36, 0x2d, 0xdb, 0xc1, 0xa4, 0xb0, 0x82, 0xc5, 0xce, 0xa8, 0x11, 0xc5, 0x19, 0x55,
+	0xf9, 0xc1, 0x09, 0x38, 0x93, 0x3a, 0x8c, 0xd0, 0xb1, 0x5f, 0x04, 0xe4, 0xc9, 0xc6, 0xb8, 0x9a,
+	0x3d, 0x2c, 0xb8, 0xda, 0x09, 0xaa, 0xd5, 0xa0, 0x25, 0xb2, 0xdc, 0xf3, 0x5e, 0xac, 0x19, 0x7d,
+	0x9e, 0xa3, 0x6f, 0x8f, 0x87, 0xe6, 0x60, 0x10, 0x95, 0x3b, 0x82, 0xa5, 0x80, 0x40, 0xce, 0xf2,
+	0xdf, 0x8b, 0x6a, 0xdd, 0xe5, 0xa2, 0x5a, 0xd7, 0x67, 0xf0, 0xd5, 0x2a, 0xde, 0xbf, 0x69, 0xb0,
+	0xc9, 0x65, 0x64, 0x25, 0x4e, 0x83, 0x44, 0x0d, 0x42, 0x46, 0xbc, 0xb6, 0xd8, 0xf1, 0x89, 0x3e,
+	0xe5, 0xa7, 0x63, 0x50, 0x73, 0x29, 0xd3, 0xd8, 0x03, 0x9c, 0x06, 0x1c, 0x91, 0x12, 0xee, 0xff,
+	0x22, 0xe8, 0x1c, 0xcc, 0x36, 0xb1, 0xdf, 0x38, 0x7c, 0x88, 0x79, 0x74, 0x45, 0xd4, 0x9e, 0x44,
+	0x1b, 0x2b, 0x04, 0xbe, 0x55, 0x60, 0xb2, 0xc1, 0x6b, 0x8e, 0x31, 0x19, 0xa2, 0x1b, 0xf2, 0xc0,
+	0x62, 0xe8, 0x95, 0x6b, 0xec, 0xb9, 0xb8, 0xbc, 0xb3, 0x30, 0xb7, 0xa2, 0x40, 0xda, 0xac, 0xe2,
+	0xc3, 0x4a, 0x02, 0x4d, 0x70, 0xb6, 0x05, 0x4b, 0xfd, 0xa2, 0x0b, 0x99, 0xa2, 0xe9, 0x8a, 0x2a,
+	0xea, 0x31, 0xa3, 0x5f, 0x91, 0xb1, 0xcb, 0xf3, 0x33, 0x5d, 0x87, 0xe5, 0xcc, 0xe5, 0x77, 0x17,
+	0x44, 0x76, 0x89, 0xa7, 0x8e, 0x66, 0x45, 0x2b, 0x03, 0x25, 0x5b, 0xdf, 0xdf, 0x02, 0x10, 0xe9,
+	0xa9, 0x5b, 0x8f, 0x6b, 0xe8, 0xd7, 0x69, 0x12, 0x5e, 0xf9, 0x8d, 0x1e, 0x74, 0x3d, 0x75, 0x2b,
+	0x66, 0x7e, 0x25, 0x48, 0xbf, 0x31, 0x30, 0x9e, 0x98, 0xf5, 0xf7, 0x35, 0x58, 0x49, 0xf9, 0xfa,
+	0x11, 0xca, 0x20, 0x9a, 0xf9, 0x3d, 0x28, 0xfd, 0xed, 0xc1, 0x11, 0x05, 0x3b, 0x3f, 0xd1, 0x60,
+	0x23, 0xef, 0x43, 0x46, 0xe8, 0xc3, 0x3c, 0xf2, 0x79, 0x1f, 0x55, 0xd2, 0x6f, 0x1d, 0x83, 0x82,
+	0xe0, 0x94, 0x2e, 0xa2, 0xfa, 0x13, 0x45, 0x19, 0x8b, 0x98, 0xf9, 0x69, 0x24, 0xfd, 0xc6, 0xc0,
+	0x78, 0x82, 0x97, 0xdf, 0xd3, 0x40, 0x4f, 0xff, 0x90, 0x0f, 0x4a, 0x2f, 0xbe, 0xce, 0xfd, 0xc0,
+	0x91, 0xfe, 0xee, 0x50, 0xb8, 0x21, 0x19, 0xa9, 0xbf, 0xd6, 0x93, 0x21, 0xa3, 0xcc, 0xaf, 0x04,
+	0xe9, 0x37, 0x06, 0xc6, 0x13, 0xbc, 0xfc, 0x96, 0x06, 0xe5, 0xb4, 0xcf, 0xf0, 0xa0, 0x74, 0x85,
+	0xcd, 0xf9, 0x04, 0x90, 0xfe, 0xce, 0x10, 0x98, 0x82, 0xa3, 0xdf, 0xd1, 0x60, 0x35, 0xf5, 0x03,
+	0x3b, 0x28, 0x9d, 0x70, 0xde, 0xf7, 0x7d, 0xf4, 0x9b, 0xc3, 0xa0, 0x0a, 0xa6, 0x1c, 0x98, 0x8d,
+	0x7c, 0xd2, 0x04, 0xbd, 0x95, 0x4a, 0x4c, 0xf5, 0xe5, 0x14, 0xbd, 0x5a, 0x14, 0x5c, 0x8c, 0xf7,
+	0x3d, 0x0d, 0x4e, 0x2a, 0xbe, 0x0b, 0x82, 0xae, 0x64, 0xef, 0x05, 0xe5, 0x97, 0x48, 0xf4, 0xab,
+	0x83, 0x21, 0x09, 0x16, 0x7c, 0x98, 0x8b, 0x7d, 0x83, 0x03, 0x5d, 0xcc, 0xba, 0x11, 0x29, 0x6a,
+	0x35, 0xf4, 0x4b, 0xc5, 0x11, 0xc4, 0xa8, 0x2f, 0x60, 0x3e, 0xfe, 0xd6, 0x1c, 0xa5, 0x53, 0x49,
+	0x79, 0x8d, 0xaf, 0x5f, 0x1e, 0x00, 0x23, 0xa4, 0x76, 0xa9, 0x8f, 0x2e, 0x32, 0xd4, 0x2e, 0xef,
+	0xbd, 0xab, 0x7e, 0x8c, 0x37, 0x1e, 0xe8, 0x0f, 0x35, 0x38, 0xc5, 0x7f, 0xa8, 0xdf, 0x64, 0xa0,
+	0xf7, 0x86, 0x7c, 0xca, 0xc1, 0x59, 0x7b, 0xff, 0x58, 0x0f, 0x41, 0x84, 0xc8, 0x52, 0x1e, 0x2e,
+	0x64, 0x8a, 0x2c, 0xfb, 0xd9, 0x84, 0x7e, 0x73, 0x18, 0xd4, 0xc4, 0x3a, 0x2a, 0x5e, 0x85, 0xe5,
+	0xae, 0x63, 0xfa, 0x7b, 0x3c, 0xfd, 0xe6, 0x30, 0xa8, 0xc9, 0x75, 0x54, 0xbe, 0x1d, 0xc8, 0x5f,
+	0xc7, 0xac, 0xf7, 0x0b, 0xfa, 0xfb, 0x43, 0x62, 0x27, 0xd7, 0x31, 0xf9, 0x3c, 0x20, 0x7f, 0x1d,
+	0x53, 0x1f, 0x27, 0xe8, 0x37, 0x87, 0x41, 0x15, 0x4c, 0xfd, 0x01, 0xcb, 0xba, 0xa6, 0xd6, 0xfd,
+	0xa3, 0x77, 0x07, 0x9a, 0x73, 0xf4, 0xe5, 0x81, 0xfe, 0xde, 0x70, 0xc8, 0x11, 0xd6, 0x52, 0x1f,
+	0xbd, 0x64, 0xb2, 0x96, 0xf7, 0xec, 0x46, 0x7f, 0x6f, 0x38, 0x64, 0xc1, 0xda, 0x1f, 0x69, 0xb0,
+	0x2e, 0x28, 0xa5, 0x54, 0xbb, 0xa3, 0xef, 0x64, 0x0c, 0x50, 0xa0, 0xe4, 0x5f, 0xff, 0x60, 0x68,
+	0xfc, 0xd0, 0x95, 0x23, 0xed, 0xcd, 0x43, 0xc6, 0x95, 0x23, 0xe7, 0x71, 0x87, 0xfe, 0xce, 0x10,
+	0x98, 0x82, 0xa3, 0xcf, 0x35, 0x58, 0x54, 0x55, 0xce, 0xa3, 0xf4, 0x93, 0x33, 0xe3, 0x9d, 0x80,
+	0x7e, 0x6d, 0x40, 0x2c, 0xc1, 0xc5, 0x8f, 0xd8, 0x97, 0x46, 0x33, 0x0a, 0xc7, 0xd1, 0xfb, 0x39,
+	0xba, 0x91, 0x5d, 0xd6, 0xaf, 0x7f, 0x67, 0x58, 0x74, 0xc1, 0xe0, 0x67, 0xb0, 0x20, 0x43, 0x0b,
+	0x41, 0x09, 0x35, 0xba, 0x9c, 0x41, 0x54, 0x5d, 0xda, 0xae, 0x6f, 0x0d, 0x82, 0xd2, 0xbf, 0x8d,
+	0xc4, 0x8a, 0xa2, 0x33, 0x6e, 0x23, 0xea, 0x52, 0x6e, 0xfd, 0x52, 0x71, 0x04, 0x31, 0xea, 0x33,
+	0x98, 0x09, 0xd7, 0xa8, 0xa2, 0x6f, 0x67, 0x52, 0x88, 0x95, 0x52, 0xe8, 0x6f, 0x15, 0x84, 0x0e,
+	0x69, 0xa1, 0xaa, 0xc8, 0x34, 0x43, 0x0b, 0x33, 0xea, 0x64, 0xf5, 0x6b, 0x03, 0x62, 0x85, 0x6e,
+	0x9e, 0x8a, 0xda, 0xd1, 0x8c, 0x9b, 0x67, 0x7a, 0x21, 0xaa, 0x7e, 0x75, 0x30, 0xa4, 0xe0, 0xbd,
+	0x2c, 0xf4, 0x2b, 0x31, 0xd1, 0x85, 0x54, 0x1a, 0x89, 0xf2, 0x4e, 0xfd, 0xcd, 0x42, 0xb0, 0xfd,
+	0x61, 0xfa, 0x95, 0x8e, 0x19, 0xc3, 0x24, 0xca, 0x3f, 0xf5, 0x37, 0x0b, 0xc1, 0x86, 0x87, 0x91,
+	0x75, 0x8a, 0x99, 0xc3, 0xc4, 0xca, 0x2b, 0xf5, 0x37, 0x0b, 0xc1, 0xf6, 0x3d, 0x94, 0x48, 0x89,
+	0x61, 0x86, 0x87, 0xa2, 0xaa, 0x8f, 0xd4, 0xab, 0x45, 0xc1, 0x43, 0x4e, 0xac, 0xba, 0x52, 0x2f,
+	0xc3, 0x89, 0xcd, 0x2c, 0x59, 0xd4, 0x6f, 0x0c, 0x8c, 0x17, 0xba, 0xc0, 0xa4, 0x96, 0xc4, 0x65,
+	0x5c, 0x60, 0xf2, 0xca, 0xf6, 0xf4, 0x9b, 0xc3, 0xa0, 0xf6, 0x17, 0x24, 0x52, 0x4f, 0x96, 0xb1,
+	0x20, 0xaa, 0x92, 0x3a, 0xbd, 0x5a, 0x14, 0x3c, 0x64, 0x3e, 0x54, 0xb5, 0x5f, 0x28, 0xcb, 0xfd,
+	0x4b, 0xad, 0x6a, 0xd3, 0xaf, 0x0d, 0x88, 0x15, 0x52, 0x0b, 0x75, 0x21, 0x4c, 0x86, 0x5a, 0x64,
+	0x56, 0xa4, 0xe9, 0x37, 0x06, 0xc6, 0xeb, 0x9f, 0x19, 0xb1, 0x1a, 0x92, 0x8c, 0x33, 0x43, 0x5d,
+	0x9a, 0xa3, 0x5f, 0x2a, 0x8e, 0x10, 0xf2, 0x60, 0x63, 0xb5, 0x04, 0x59, 0x1e, 0xac, 0xba, 0xbc,
+	0x42, 0xbf, 0x3c, 0x00, 0x46, 0x7f, 0xe0, 0x07, 0xb8, 0xf0, 0xc0, 0x0f, 0xf0, 0xa0, 0x03, 0xa7,
+	0xe6, 0xf5, 0x7f, 0x4d, 0x83, 0x25, 0x65, 0xb2, 0x1c, 0xa5, 0x2b, 0x51, 0x56, 0x7e, 0x5f, 0xbf,
+	0x3e, 0x28, 0x5a, 0x68, 0x0b, 0xa8, 0x52, 0xcd, 0x19, 0x5b, 0x20, 0x23, 0x87, 0xaf, 0x5f, 0x1b,
+	0x10, 0x2b, 0xca, 0x45, 0x22, 0x23, 0x99, 0xcd, 0x45, 0x5a, 0xb6, 0x57, 0xbf, 0x36, 0x20, 0x56,
+	0x28, 0x82, 0x9d, 0x92, 0xa7, 0x42, 0x37, 0x06, 0xcf, 0x6c, 0xe5, 0x45, 0xb0, 0xf3, 0x52, 0x7d,
+	0x5f, 0x68, 0xc1, 0x13, 0xf4, 0xf4, 0x74, 0x08, 0xba, 0x95, 0xe7, 0x97, 0xe5, 0xe6, 0x8d, 0xf4,
+	0xdb, 0xc7, 0x21, 0x11, 0x09, 0x7d, 0x85, 0xd3, 0x21, 0xd9, 0xa1, 0x2f, 0x45, 0xbe, 0x45, 0xbf,
+	0x54, 0x1c, 0x81, 0x8f, 0x7a, 0xfb, 0xce, 0xdf, 0x7e, 0xb9, 0xae, 0xfd, 0xfd, 0x97, 0xeb, 0xda,
+	0x3f, 0x7f, 0xb9, 0xae, 0xfd, 0xdc, 0x8d, 0x03, 0xdb, 0x3f, 0xec, 0xee, 0x57, 0x1b, 0x6e, 0xfb,
+	0x62, 0xe4, 0x7f, 0xb7, 0x54, 0x0f, 0xb0, 0xc3, 0xff, 0x09, 0x4f, 0xe8, 0xbf, 0x00, 0xbd, 0x2b,
+	0xfe, 0x3c, 0xba, 0xbc, 0x3f, 0xce, 0xfa, 0xae, 0xfc, 0xcf, 0x00, 0x2c, 0x04, 0x47, 0x35, 0x31,
+	0x68, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -6082,7 +6755,7 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6092,12 +6765,12 @@ func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6128,7 +6801,7 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6138,12 +6811,12 @@ func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6152,9 +6825,43 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PendingDecision != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
 		{
-			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6162,20 +6869,158 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PauseInfo != nil {
+		{
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PendingDecision != nil {
+		{
+			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
 	if len(m.PendingActivities) > 0 {
@@ -8630,21 +9475,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA82 := make([]byte, len(m.ShardIds)*10)
-		var j81 int
+		dAtA85 := make([]byte, len(m.ShardIds)*10)
+		var j84 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA85[j84] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j84++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA85[j84] = uint8(num)
+			j84++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintService(dAtA, i, uint64(j81))
+		i -= j84
+		copy(dAtA[i:], dAtA85[:j84])
+		i = encodeVarintService(dAtA, i, uint64(j84))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9307,7 +10152,7 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *OperatePendingActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9317,12 +10162,12 @@ func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperatePendingActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperatePendingActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9331,21 +10176,98 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x72
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.Failure != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ResetAttempts {
+		i--
+		if m.ResetAttempts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9353,29 +10275,50 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x32
+	}
+	if m.Operation != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ActivityId)))
+		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *OperatePendingActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9385,7 +10328,102 @@ func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperatePendingActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatePendingActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -9399,6 +10437,20 @@ func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RetryStates) > 0 {
+		for iNdEx := len(m.RetryStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NextPageToken) > 0 {
 		i -= len(m.NextPageToken)
 		copy(dAtA[i:], m.NextPageToken)
@@ -9727,21 +10779,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA92 := make([]byte, len(m.ShardIds)*10)
-		var j91 int
+		dAtA103 := make([]byte, len(m.ShardIds)*10)
+		var j102 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j102++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA103[j102] = uint8(num)
+			j102++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintService(dAtA, i, uint64(j91))
+		i -= j102
+		copy(dAtA[i:], dAtA103[:j102])
+		i = encodeVarintService(dAtA, i, uint64(j102))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9814,7 +10866,7 @@ func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9824,12 +10876,12 @@ func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9838,46 +10890,36 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FetchNewTasks {
-		i--
-		if m.FetchNewTasks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if len(m.TaskResponses) > 0 {
-		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.ShardIds) > 0 {
+		dAtA106 := make([]byte, len(m.ShardIds)*10)
+		var j105 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA106[j105] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j105++
 			}
-			i--
-			dAtA[i] = 0x1a
+			dAtA106[j105] = uint8(num)
+			j105++
 		}
-	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i -= j105
+		copy(dAtA[i:], dAtA106[:j105])
+		i = encodeVarintService(dAtA, i, uint64(j105))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9887,12 +10929,12 @@ func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9901,22 +10943,49 @@ func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Tasks != nil {
-		{
-			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.FailedCauseByShard) > 0 {
+		for k := range m.FailedCauseByShard {
+			v := m.FailedCauseByShard[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StatusByShard) > 0 {
+		for k := range m.StatusByShard {
+			v := m.StatusByShard[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationReadinessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9926,12 +10995,12 @@ func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9940,17 +11009,36 @@ func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ShardIds) > 0 {
+		dAtA109 := make([]byte, len(m.ShardIds)*10)
+		var j108 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA109[j108] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j108++
+			}
+			dAtA109[j108] = uint8(num)
+			j108++
+		}
+		i -= j108
+		copy(dAtA[i:], dAtA109[:j108])
+		i = encodeVarintService(dAtA, i, uint64(j108))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationReadinessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9960,12 +11048,12 @@ func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9974,28 +11062,230 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingShards) > 0 {
-		dAtA96 := make([]byte, len(m.PendingShards)*10)
-		var j95 int
-		for _, num1 := range m.PendingShards {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j95++
+	if len(m.FailedCauseByShard) > 0 {
+		for k := range m.FailedCauseByShard {
+			v := m.FailedCauseByShard[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReadinessByShard) > 0 {
+		for k := range m.ReadinessByShard {
+			v := m.ReadinessByShard[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			dAtA96[j95] = uint8(num)
-			j95++
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= j95
-		copy(dAtA[i:], dAtA96[:j95])
-		i = encodeVarintService(dAtA, i, uint64(j95))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.CompletedShardCount != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
-		i--
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FetchNewTasks {
+		i--
+		if m.FetchNewTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskResponses) > 0 {
+		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tasks != nil {
+		{
+			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PendingShards) > 0 {
+		dAtA113 := make([]byte, len(m.PendingShards)*10)
+		var j112 int
+		for _, num1 := range m.PendingShards {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j112++
+			}
+			dAtA113[j112] = uint8(num)
+			j112++
+		}
+		i -= j112
+		copy(dAtA[i:], dAtA113[:j112])
+		i = encodeVarintService(dAtA, i, uint64(j112))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CompletedShardCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
@@ -10224,6 +11514,70 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10274,6 +11628,16 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 		l = m.PendingDecision.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11631,27 +12995,105 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func (m *ReadDLQMessagesRequest) Size() (n int) {
+func (m *OperatePendingActivityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
+	l = len(m.Domain)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.PageSize != 0 {
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovService(uint64(m.Operation))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ResetAttempts {
+		n += 2
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperatePendingActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
 		n += 1 + sovService(uint64(m.PageSize))
 	}
 	l = len(m.NextPageToken)
@@ -11689,6 +13131,12 @@ func (m *ReadDLQMessagesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.RetryStates) > 0 {
+		for _, e := range m.RetryStates {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11868,6 +13316,118 @@ func (m *GetCrossClusterTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StatusByShard) > 0 {
+		for k, v := range m.StatusByShard {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FailedCauseByShard) > 0 {
+		for k, v := range m.FailedCauseByShard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReadinessByShard) > 0 {
+		for k, v := range m.ReadinessByShard {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FailedCauseByShard) > 0 {
+		for k, v := range m.FailedCauseByShard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RespondCrossClusterTasksCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13232,7 +14792,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13255,10 +14815,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13291,7 +14851,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+				m.Request = &v1.PauseWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13351,7 +14911,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13374,15 +14934,66 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13409,18 +15020,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfiguration == nil {
-				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
+			if m.Request == nil {
+				m.Request = &v1.UnpauseWorkflowExecutionRequest{}
 			}
-			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13430,132 +15041,75 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
-			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingDecision == nil {
-				m.PendingDecision = &v1.PendingDecisionInfo{}
-			}
-			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13578,7 +15132,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13601,10 +15155,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13637,7 +15191,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13697,7 +15251,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13720,15 +15274,15 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13755,16 +15309,16 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
+			if m.ExecutionConfiguration == nil {
+				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
 			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13791,67 +15345,50 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
 			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13878,18 +15415,16 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.ResetStickyTaskListRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13899,24 +15434,98 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if m.PendingDecision == nil {
+				m.PendingDecision = &v1.PendingDecisionInfo{}
+			}
+			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &v1.WorkflowPauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v1.CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13939,7 +15548,7 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13962,12 +15571,80 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13990,7 +15667,7 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14013,47 +15690,15 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14080,37 +15725,18 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14120,24 +15746,26 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
+			}
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14162,7 +15790,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14185,15 +15813,15 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14220,18 +15848,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.ResetStickyTaskListRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14241,33 +15869,131 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.NextEventId = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14277,14 +16003,27 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14311,18 +16050,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
 			}
-			m.LastFirstEventId = 0
+			m.ExpectedNextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14332,16 +16071,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14351,31 +16090,80 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14402,18 +16190,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14423,29 +16211,33 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			var stringLen uint64
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14455,14 +16247,192 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
+			}
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -21859,7 +23829,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *OperatePendingActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21882,17 +23852,17 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatePendingActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatePendingActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21902,33 +23872,27 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21956,11 +23920,11 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21987,18 +23951,18 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
-			m.PageSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22008,101 +23972,29 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Type = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22112,14 +24004,14 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.Operation |= v11.PendingActivityOperation(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22146,14 +24038,16 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22180,16 +24074,18 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
-			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22199,82 +24095,69 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = 0
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22284,16 +24167,33 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v1.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAttempts", wireType)
 			}
-			m.ShardId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22303,16 +24203,17 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.ResetAttempts = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22322,27 +24223,31 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			if m.Failure == nil {
+				m.Failure = &v1.Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22369,13 +24274,45 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
+			if m.Result == nil {
+				m.Result = &v1.Payload{}
 			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22398,7 +24335,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *OperatePendingActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22421,10 +24358,10 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatePendingActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatePendingActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22449,7 +24386,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22472,10 +24409,10 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22659,7 +24596,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22682,17 +24619,1362 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
+			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryStates = append(m.RetryStates, &v11.ReplicationDLQTaskState{})
+			if err := m.RetryStates[len(m.RetryStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v11.FailoverMarkerToken{})
+			if err := m.FailoverMarkerTokens[len(m.FailoverMarkerTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TasksByShard == nil {
+				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			}
+			var mapkey int32
+			var mapvalue *v11.CrossClusterTaskRequests
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.CrossClusterTaskRequests{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TasksByShard[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailedCauseByShard == nil {
+				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
+			}
+			var mapkey int32
+			var mapvalue v11.GetTaskFailedCause
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FailedCauseByShard[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22702,25 +25984,23 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22744,7 +26024,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22767,15 +26047,15 @@ func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusByShard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22802,62 +26082,191 @@ func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v11.FailoverMarkerToken{})
-			if err := m.FailoverMarkerTokens[len(m.FailoverMarkerTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StatusByShard == nil {
+				m.StatusByShard = make(map[int32]*v11.ReplicationShardStatus)
+			}
+			var mapkey int32
+			var mapvalue *v11.ReplicationShardStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ReplicationShardStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.StatusByShard[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FailedCauseByShard == nil {
+				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey int32
+			var mapvalue v11.GetTaskFailedCause
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FailedCauseByShard[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22880,7 +26289,7 @@ func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationReadinessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22903,10 +26312,10 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationReadinessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22987,7 +26396,7 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23015,7 +26424,7 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23039,7 +26448,7 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationReadinessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23062,15 +26471,15 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationReadinessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessByShard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23097,11 +26506,11 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TasksByShard == nil {
-				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			if m.ReadinessByShard == nil {
+				m.ReadinessByShard = make(map[int32]*v11.ReplicationShardReadiness)
 			}
 			var mapkey int32
-			var mapvalue *v11.CrossClusterTaskRequests
+			var mapvalue *v11.ReplicationShardReadiness
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -23161,7 +26570,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v11.CrossClusterTaskRequests{}
+					mapvalue = &v11.ReplicationShardReadiness{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -23181,7 +26590,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TasksByShard[mapkey] = mapvalue
+			m.ReadinessByShard[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1155,6 +1155,116 @@ func (m *CancelOutstandingPollResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 
+type ReleaseOutstandingTaskRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList          `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ScheduleId           int64                 `protobuf:"varint,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReleaseOutstandingTaskRequest) Reset()         { *m = ReleaseOutstandingTaskRequest{} }
+func (m *ReleaseOutstandingTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutstandingTaskRequest) ProtoMessage()    {}
+func (*ReleaseOutstandingTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{14}
+}
+func (m *ReleaseOutstandingTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseOutstandingTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseOutstandingTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseOutstandingTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutstandingTaskRequest.Merge(m, src)
+}
+func (m *ReleaseOutstandingTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseOutstandingTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutstandingTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutstandingTaskRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutstandingTaskRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *ReleaseOutstandingTaskRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *ReleaseOutstandingTaskRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ReleaseOutstandingTaskRequest) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
+
+type ReleaseOutstandingTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutstandingTaskResponse) Reset()         { *m = ReleaseOutstandingTaskResponse{} }
+func (m *ReleaseOutstandingTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutstandingTaskResponse) ProtoMessage()    {}
+func (*ReleaseOutstandingTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{15}
+}
+func (m *ReleaseOutstandingTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseOutstandingTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseOutstandingTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseOutstandingTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutstandingTaskResponse.Merge(m, src)
+}
+func (m *ReleaseOutstandingTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseOutstandingTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutstandingTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutstandingTaskResponse proto.InternalMessageInfo
+
 type DescribeTaskListRequest struct {
 	Request              *v1.DescribeTaskListRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1167,7 +1277,7 @@ func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest
 func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListRequest) ProtoMessage()    {}
 func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{14}
+	return fileDescriptor_826e827d3aabf7fc, []int{16}
 }
 func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1332,7 @@ func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListRespon
 func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListResponse) ProtoMessage()    {}
 func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{15}
+	return fileDescriptor_826e827d3aabf7fc, []int{17}
 }
 func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1387,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{16}
+	return fileDescriptor_826e827d3aabf7fc, []int{18}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1442,7 @@ func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPart
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{17}
+	return fileDescriptor_826e827d3aabf7fc, []int{19}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1496,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{18}
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1544,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{19}
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,6 +1587,172 @@ func (m *GetTaskListsByDomainResponse) GetActivityTaskListMap() map[string]*Desc
 	return nil
 }
 
+type MoveTaskListRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain               string          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	SourceTaskList       *v1.TaskList    `protobuf:"bytes,3,opt,name=source_task_list,json=sourceTaskList,proto3" json:"source_task_list,omitempty"`
+	TargetTaskList       *v1.TaskList    `protobuf:"bytes,4,opt,name=target_task_list,json=targetTaskList,proto3" json:"target_task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,5,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	BatchSize            int32           `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Rps                  int32           `protobuf:"varint,7,opt,name=rps,proto3" json:"rps,omitempty"`
+	DryRun               bool            `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MoveTaskListRequest) Reset()         { *m = MoveTaskListRequest{} }
+func (m *MoveTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListRequest) ProtoMessage()    {}
+func (*MoveTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
+}
+func (m *MoveTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListRequest.Merge(m, src)
+}
+func (m *MoveTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListRequest proto.InternalMessageInfo
+
+func (m *MoveTaskListRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *MoveTaskListRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MoveTaskListRequest) GetSourceTaskList() *v1.TaskList {
+	if m != nil {
+		return m.SourceTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListRequest) GetTargetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TargetTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *MoveTaskListRequest) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *MoveTaskListRequest) GetRps() int32 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func (m *MoveTaskListRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type MoveTaskListResponse struct {
+	TasksMoved           int64    `protobuf:"varint,1,opt,name=tasks_moved,json=tasksMoved,proto3" json:"tasks_moved,omitempty"`
+	TasksExpired         int64    `protobuf:"varint,2,opt,name=tasks_expired,json=tasksExpired,proto3" json:"tasks_expired,omitempty"`
+	Failure              string   `protobuf:"bytes,3,opt,name=failure,proto3" json:"failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTaskListResponse) Reset()         { *m = MoveTaskListResponse{} }
+func (m *MoveTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListResponse) ProtoMessage()    {}
+func (*MoveTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{23}
+}
+func (m *MoveTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListResponse.Merge(m, src)
+}
+func (m *MoveTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListResponse proto.InternalMessageInfo
+
+func (m *MoveTaskListResponse) GetTasksMoved() int64 {
+	if m != nil {
+		return m.TasksMoved
+	}
+	return 0
+}
+
+func (m *MoveTaskListResponse) GetTasksExpired() int64 {
+	if m != nil {
+		return m.TasksExpired
+	}
+	return 0
+}
+
+func (m *MoveTaskListResponse) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
 	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
@@ -1493,6 +1769,8 @@ func init() {
 	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
 	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
 	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*ReleaseOutstandingTaskRequest)(nil), "uber.cadence.matching.v1.ReleaseOutstandingTaskRequest")
+	proto.RegisterType((*ReleaseOutstandingTaskResponse)(nil), "uber.cadence.matching.v1.ReleaseOutstandingTaskResponse")
 	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
 	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
 	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
@@ -1501,6 +1779,8 @@ func init() {
 	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
 	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
+	proto.RegisterType((*MoveTaskListRequest)(nil), "uber.cadence.matching.v1.MoveTaskListRequest")
+	proto.RegisterType((*MoveTaskListResponse)(nil), "uber.cadence.matching.v1.MoveTaskListResponse")
 }
 
 func init() {
@@ -1508,127 +1788,140 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 1909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x6f, 0xdb, 0xd6,
-	0x15, 0x07, 0xfd, 0xad, 0x23, 0x59, 0x71, 0x6e, 0x5a, 0x47, 0x96, 0xe3, 0x8f, 0xa8, 0x6b, 0xe7,
-	0x0d, 0x1d, 0x35, 0xab, 0x4d, 0x96, 0xa6, 0x18, 0x06, 0xc7, 0x8e, 0x13, 0x01, 0xcb, 0x92, 0x32,
-	0x5a, 0x06, 0x0c, 0x43, 0x88, 0x2b, 0xf2, 0xda, 0xe2, 0x2c, 0x91, 0x0c, 0xef, 0x95, 0x5c, 0xed,
-	0x61, 0x0f, 0x43, 0x37, 0x0c, 0xe8, 0xeb, 0xfe, 0x82, 0xad, 0x8f, 0xfb, 0x43, 0xfa, 0xb0, 0x87,
-	0xbd, 0x0f, 0x03, 0x86, 0x00, 0xfb, 0x3f, 0x86, 0xfb, 0x41, 0x4a, 0x94, 0x2e, 0xf5, 0x61, 0xaf,
-	0xed, 0x9b, 0x78, 0xcf, 0x39, 0xbf, 0xf3, 0x7d, 0xee, 0x21, 0x05, 0x1f, 0x74, 0x9b, 0x24, 0xaa,
-	0x3a, 0xd8, 0x25, 0xbe, 0x43, 0xaa, 0x1d, 0xcc, 0x9c, 0x96, 0xe7, 0x9f, 0x57, 0x7b, 0x87, 0x55,
-	0x4a, 0xa2, 0x9e, 0xe7, 0x10, 0x33, 0x8c, 0x02, 0x16, 0xa0, 0x12, 0xe7, 0x33, 0x15, 0x9f, 0x19,
-	0xf3, 0x99, 0xbd, 0xc3, 0xf2, 0xee, 0x79, 0x10, 0x9c, 0xb7, 0x49, 0x55, 0xf0, 0x35, 0xbb, 0x67,
-	0x55, 0xb7, 0x1b, 0x61, 0xe6, 0x05, 0xbe, 0x94, 0x2c, 0xef, 0x8d, 0xd2, 0x99, 0xd7, 0x21, 0x94,
-	0xe1, 0x4e, 0xa8, 0x18, 0xc6, 0x00, 0x2e, 0x23, 0x1c, 0x86, 0x24, 0xa2, 0x8a, 0xbe, 0x9f, 0x32,
-	0x11, 0x87, 0x1e, 0xb7, 0xce, 0x09, 0x3a, 0x9d, 0x81, 0x0a, 0x1d, 0xc7, 0x9b, 0x2e, 0x89, 0xfa,
-	0x8a, 0xa1, 0xa2, 0x63, 0x60, 0x98, 0x5e, 0xb4, 0x3d, 0xca, 0x14, 0xcf, 0x81, 0x8e, 0x47, 0x05,
-	0xc1, 0xbe, 0x0c, 0xa2, 0x0b, 0x12, 0x29, 0xce, 0x1f, 0x4e, 0xe3, 0x3c, 0x6b, 0x07, 0x97, 0x8a,
-	0xf7, 0x7b, 0x29, 0x5e, 0xda, 0xc2, 0x11, 0x71, 0x39, 0x7b, 0xcb, 0xa3, 0x2c, 0x48, 0xec, 0x7b,
-	0x3f, 0x83, 0x2b, 0x6d, 0x62, 0xe5, 0x6b, 0x03, 0xca, 0x2f, 0x82, 0x76, 0xfb, 0x34, 0x88, 0x4e,
-	0x88, 0xe3, 0x51, 0x2f, 0xf0, 0x1b, 0x98, 0x5e, 0x58, 0xe4, 0x4d, 0x97, 0x50, 0x86, 0xea, 0xb0,
-	0x1a, 0xc9, 0x9f, 0x25, 0x63, 0xdf, 0x38, 0xc8, 0xd7, 0xaa, 0x66, 0x2a, 0x6b, 0x38, 0xf4, 0xcc,
-	0xde, 0xa1, 0x99, 0x8d, 0x60, 0xc5, 0xf2, 0x68, 0x1b, 0x72, 0x6e, 0xd0, 0xc1, 0x9e, 0x6f, 0x7b,
-	0x6e, 0x69, 0x61, 0xdf, 0x38, 0xc8, 0x59, 0x6b, 0xf2, 0xa0, 0xee, 0x72, 0x62, 0x18, 0xb4, 0xdb,
-	0x24, 0xe2, 0xc4, 0x45, 0x49, 0x94, 0x07, 0x75, 0x17, 0xbd, 0x0f, 0xc5, 0xb3, 0x20, 0xba, 0xc4,
-	0x91, 0x4b, 0x5c, 0xfb, 0x2c, 0x0a, 0x3a, 0xa5, 0x25, 0xc1, 0xb1, 0x9e, 0x9c, 0x9e, 0x46, 0x41,
-	0xa7, 0xf2, 0x45, 0x0e, 0xb6, 0xb5, 0x86, 0xd0, 0x30, 0xf0, 0x29, 0x41, 0x3b, 0x00, 0xdc, 0x79,
-	0x9b, 0x05, 0x17, 0xc4, 0x17, 0xee, 0x14, 0xac, 0x1c, 0x3f, 0x69, 0xf0, 0x03, 0xf4, 0x4b, 0x40,
-	0x71, 0xa0, 0x6d, 0xf2, 0x39, 0x71, 0xba, 0xbc, 0xe0, 0x84, 0xa1, 0xf9, 0xda, 0x07, 0x5a, 0xaf,
-	0x7f, 0xa5, 0xd8, 0x1f, 0xc7, 0xdc, 0xd6, 0xcd, 0xcb, 0xd1, 0x23, 0x74, 0x0a, 0xeb, 0x09, 0x2c,
-	0xeb, 0x87, 0x44, 0x78, 0x97, 0xaf, 0xdd, 0x9d, 0x88, 0xd8, 0xe8, 0x87, 0xc4, 0x2a, 0x5c, 0x0e,
-	0x3d, 0xa1, 0x57, 0xb0, 0x15, 0x46, 0xa4, 0xe7, 0x05, 0x5d, 0x6a, 0x53, 0x86, 0x23, 0x46, 0x5c,
-	0x9b, 0xf4, 0x88, 0xcf, 0x78, 0xc4, 0x96, 0x04, 0xe6, 0xb6, 0x29, 0xcb, 0xde, 0x8c, 0xcb, 0xde,
-	0xac, 0xfb, 0xec, 0xfe, 0xc7, 0xaf, 0x70, 0xbb, 0x4b, 0xac, 0xcd, 0x58, 0xfa, 0xa5, 0x14, 0x7e,
-	0xcc, 0x65, 0xeb, 0x2e, 0x3a, 0x80, 0x8d, 0x31, 0xb8, 0xe5, 0x7d, 0xe3, 0x60, 0xd1, 0x2a, 0xd2,
-	0x34, 0x67, 0x09, 0x56, 0x31, 0x63, 0xa4, 0x13, 0xb2, 0xd2, 0xca, 0xbe, 0x71, 0xb0, 0x6c, 0xc5,
-	0x8f, 0xa8, 0x02, 0xeb, 0x3e, 0xf9, 0x9c, 0x0d, 0x00, 0x56, 0x05, 0x40, 0x9e, 0x1f, 0xc6, 0xd2,
-	0x1f, 0x02, 0x6a, 0x62, 0xe7, 0xa2, 0x1d, 0x9c, 0xdb, 0x4e, 0xd0, 0xf5, 0x99, 0xdd, 0xf2, 0x7c,
-	0x56, 0x5a, 0x13, 0x8c, 0x1b, 0x8a, 0x72, 0xcc, 0x09, 0x4f, 0x3d, 0x9f, 0xa1, 0x07, 0x50, 0xa2,
-	0xcc, 0x73, 0x2e, 0xfa, 0x83, 0x54, 0xd8, 0xc4, 0xc7, 0xcd, 0x36, 0x71, 0x4b, 0xb9, 0x7d, 0xe3,
-	0x60, 0xcd, 0xda, 0x94, 0xf4, 0x24, 0xd0, 0x8f, 0x25, 0x15, 0x3d, 0x80, 0x65, 0xd1, 0xa6, 0x25,
-	0x10, 0x31, 0xa9, 0x4c, 0x8c, 0xf3, 0x67, 0x9c, 0xd3, 0x92, 0x02, 0xc8, 0x82, 0x75, 0x57, 0xd5,
-	0x8d, 0xed, 0xf9, 0x67, 0x41, 0x29, 0x2f, 0x10, 0x7e, 0x94, 0x46, 0x90, 0x9d, 0xc4, 0x41, 0x1a,
-	0x11, 0xf6, 0xa9, 0x47, 0x7c, 0x16, 0x57, 0x5b, 0xdd, 0x3f, 0x0b, 0xac, 0x82, 0x3b, 0xf4, 0x84,
-	0x5e, 0xc3, 0x9d, 0xf1, 0xa2, 0xb2, 0x45, 0x19, 0xf2, 0x26, 0x2c, 0x15, 0x84, 0x8a, 0x1d, 0xad,
-	0x91, 0xbc, 0x78, 0x7f, 0xee, 0x51, 0x66, 0x6d, 0x8d, 0x55, 0x55, 0x4c, 0x42, 0x26, 0xdc, 0x92,
-	0x41, 0xe7, 0xad, 0x4f, 0xec, 0x1e, 0x89, 0xb8, 0xea, 0xd2, 0xba, 0xc8, 0xcf, 0x4d, 0x41, 0x7a,
-	0xc9, 0x29, 0xaf, 0x24, 0x01, 0xdd, 0x85, 0x42, 0x33, 0xc2, 0xbe, 0xd3, 0x52, 0x5d, 0x50, 0x14,
-	0x5d, 0x90, 0x97, 0x67, 0xb2, 0x0f, 0x8e, 0xa0, 0x48, 0x9d, 0x16, 0x71, 0xbb, 0x6d, 0xe2, 0xda,
-	0x7c, 0xb0, 0x96, 0x6e, 0x08, 0x23, 0xcb, 0x63, 0xd5, 0xd5, 0x88, 0xa7, 0xae, 0xb5, 0x9e, 0x48,
-	0xf0, 0x33, 0xf4, 0x53, 0x28, 0xc4, 0x35, 0x25, 0x00, 0x36, 0xa6, 0x02, 0xe4, 0x15, 0xbf, 0x10,
-	0xff, 0x0d, 0xac, 0xf2, 0x8c, 0x78, 0x84, 0x96, 0x6e, 0xee, 0x2f, 0x1e, 0xe4, 0x6b, 0x8f, 0xcc,
-	0xac, 0xab, 0xc2, 0x9c, 0xd0, 0xf0, 0xe6, 0x67, 0x12, 0xe4, 0xb1, 0xcf, 0xa2, 0xbe, 0x15, 0x43,
-	0x96, 0x5f, 0x43, 0x61, 0x98, 0x80, 0x36, 0x60, 0xf1, 0x82, 0xf4, 0xc5, 0x3c, 0xc8, 0x59, 0xfc,
-	0x27, 0x2f, 0xa1, 0x1e, 0xef, 0x19, 0xd5, 0xfc, 0x33, 0x95, 0x90, 0x10, 0x78, 0xb8, 0xf0, 0xc0,
-	0x18, 0x9e, 0xa8, 0x47, 0x0e, 0xf3, 0x7a, 0x1e, 0xeb, 0x5f, 0x7d, 0xa2, 0x6a, 0x10, 0xbe, 0xc5,
-	0x89, 0xfa, 0xe5, 0x5a, 0x32, 0x51, 0xd3, 0x86, 0x7c, 0xa7, 0x13, 0x75, 0x0f, 0xf2, 0x58, 0x59,
-	0x33, 0xf0, 0x0d, 0xe2, 0xa3, 0xba, 0xcb, 0x47, 0x6e, 0xc2, 0x20, 0x46, 0xee, 0xd2, 0x84, 0x91,
-	0x9b, 0x38, 0x26, 0x46, 0x2e, 0x1e, 0x7a, 0x42, 0x35, 0x58, 0xf6, 0xfc, 0xb0, 0xcb, 0xc4, 0x3c,
-	0xcc, 0xd7, 0xee, 0xe8, 0x13, 0x85, 0xfb, 0xed, 0x00, 0xbb, 0x96, 0x64, 0xd5, 0x74, 0xcf, 0xca,
-	0x75, 0xbb, 0x67, 0x75, 0xbe, 0xee, 0x69, 0xc0, 0x56, 0x8c, 0x67, 0xb3, 0xc0, 0x76, 0xda, 0x01,
-	0x25, 0x02, 0x28, 0xe8, 0xca, 0x79, 0x9b, 0xaf, 0x6d, 0x8d, 0x61, 0x9d, 0xa8, 0x05, 0xcb, 0xda,
-	0x8c, 0x65, 0x1b, 0xc1, 0x31, 0x97, 0x6c, 0x48, 0x41, 0xf4, 0x0b, 0xd8, 0x14, 0x4a, 0xc6, 0x21,
-	0x73, 0xd3, 0x20, 0x6f, 0x09, 0xc1, 0x11, 0xbc, 0x53, 0xb8, 0xd9, 0x22, 0x38, 0x62, 0x4d, 0x82,
-	0x59, 0x02, 0x05, 0xd3, 0xa0, 0x36, 0x12, 0x99, 0x18, 0x67, 0xe8, 0x52, 0xca, 0xa7, 0x2f, 0xa5,
-	0xd7, 0xb0, 0x9b, 0xce, 0x84, 0x1d, 0x9c, 0xd9, 0xac, 0xe5, 0x51, 0x3b, 0x16, 0x28, 0x4c, 0x0d,
-	0x6c, 0x39, 0x95, 0x99, 0xe7, 0x67, 0x8d, 0x96, 0x47, 0x8f, 0x14, 0x7e, 0x7d, 0xd8, 0x03, 0x97,
-	0x30, 0xec, 0xb5, 0xa9, 0x18, 0xbc, 0xd3, 0x2a, 0x65, 0xe0, 0xc4, 0x89, 0x94, 0x1a, 0xdf, 0x11,
-	0x8a, 0x57, 0xdb, 0x11, 0xbe, 0x0f, 0x37, 0x12, 0x1c, 0x39, 0x08, 0xc4, 0xec, 0xce, 0x59, 0xc5,
-	0xf8, 0xf8, 0x44, 0x9c, 0xa2, 0x8f, 0x60, 0xa5, 0x45, 0xb0, 0x4b, 0x22, 0x35, 0x9a, 0xb7, 0xb5,
-	0x9a, 0x9e, 0x0a, 0x16, 0x4b, 0xb1, 0x56, 0xfe, 0xba, 0x08, 0x9b, 0x47, 0xae, 0xab, 0x5b, 0x13,
-	0x53, 0x93, 0xc8, 0x18, 0x99, 0x44, 0xdf, 0xd0, 0x18, 0x78, 0x08, 0xb9, 0xc1, 0x3d, 0xba, 0x38,
-	0xcb, 0x3d, 0xba, 0xc6, 0xe2, 0x6b, 0x73, 0x0f, 0xf2, 0x49, 0x8f, 0xa8, 0xf5, 0x69, 0xd1, 0x82,
-	0xf8, 0xa8, 0xee, 0x8e, 0x36, 0x91, 0x2a, 0x7d, 0x55, 0xa6, 0xcb, 0x73, 0x34, 0x91, 0xd8, 0xb6,
-	0xe2, 0x62, 0x7d, 0x08, 0x2b, 0x34, 0xe8, 0x46, 0x8e, 0x1c, 0x0a, 0xc5, 0xd1, 0x9b, 0x65, 0x68,
-	0xb5, 0xc0, 0xf4, 0xe2, 0xa5, 0xe0, 0xb4, 0x94, 0x84, 0x66, 0x64, 0xaf, 0xea, 0x46, 0xf6, 0x16,
-	0xdc, 0x1e, 0xcb, 0x91, 0x9c, 0xd6, 0x95, 0x7f, 0xc8, 0xfc, 0xe9, 0x2e, 0xa5, 0xef, 0x22, 0x7f,
-	0x7c, 0xf1, 0x14, 0xae, 0xd9, 0x03, 0xd5, 0x72, 0x96, 0x17, 0xe5, 0xf9, 0x49, 0x6c, 0x40, 0x2a,
-	0xd3, 0x4b, 0xd7, 0xca, 0xf4, 0xf2, 0x7c, 0x99, 0x5e, 0xb9, 0x7e, 0xa6, 0x57, 0xff, 0x0f, 0x99,
-	0x5e, 0xcb, 0xce, 0xb4, 0xee, 0x5e, 0xae, 0xfc, 0xcb, 0x80, 0x77, 0xc4, 0x5e, 0x12, 0x27, 0x22,
-	0xce, 0xf3, 0xf1, 0xe8, 0xf2, 0xf1, 0x03, 0x6d, 0x1c, 0x75, 0xb2, 0x33, 0xae, 0x1d, 0xd7, 0xe9,
-	0xca, 0x19, 0xb7, 0x92, 0xbf, 0x19, 0xf0, 0xee, 0x88, 0x85, 0x6a, 0x1f, 0xf9, 0x19, 0x14, 0xc4,
-	0x2a, 0x6f, 0x47, 0x84, 0x76, 0xdb, 0xb1, 0x8f, 0x93, 0xa7, 0x71, 0x5e, 0x48, 0x58, 0x42, 0x00,
-	0xd5, 0xa1, 0x18, 0x03, 0xfc, 0x96, 0x38, 0x8c, 0xb8, 0x13, 0x57, 0x40, 0xb9, 0xfa, 0x29, 0x4e,
-	0x6b, 0xfd, 0xcd, 0xf0, 0x63, 0xe5, 0xbf, 0x06, 0xec, 0x4b, 0xc3, 0x5c, 0xc1, 0xc7, 0xfd, 0x3d,
-	0x0e, 0x3a, 0x61, 0x9b, 0x70, 0x66, 0x15, 0xca, 0xe7, 0xa3, 0xf9, 0xb8, 0xa7, 0x55, 0x34, 0x0d,
-	0xe7, 0x5b, 0xc8, 0xcd, 0x6d, 0x58, 0x15, 0xb2, 0x6a, 0x5a, 0xe6, 0xac, 0x15, 0xfe, 0x58, 0x77,
-	0x2b, 0xef, 0xc1, 0xdd, 0x09, 0xe6, 0xa9, 0x82, 0xfc, 0xb7, 0x01, 0x77, 0x8e, 0xb1, 0xef, 0x90,
-	0xf6, 0xf3, 0x2e, 0xa3, 0x0c, 0xfb, 0xae, 0xe7, 0x9f, 0xf3, 0xcd, 0x72, 0xa6, 0x01, 0x94, 0x5a,
-	0x65, 0x17, 0x46, 0x56, 0xd9, 0x27, 0x50, 0x4c, 0x9c, 0x1a, 0xbc, 0x60, 0x17, 0x33, 0x2e, 0xcf,
-	0xd8, 0x33, 0x79, 0x79, 0xb2, 0xa1, 0xa7, 0xeb, 0x4c, 0x99, 0xca, 0x1e, 0xec, 0x64, 0xb8, 0xa7,
-	0x02, 0xf0, 0x7b, 0xb8, 0x7d, 0x42, 0xa8, 0x13, 0x79, 0x4d, 0x92, 0x88, 0x2b, 0xd7, 0x4f, 0x47,
-	0x6b, 0xe0, 0x43, 0xad, 0xd6, 0x0c, 0xf1, 0xd9, 0x52, 0x5f, 0xf9, 0xca, 0x80, 0xd2, 0x38, 0x82,
-	0x6a, 0x9b, 0x4f, 0x60, 0x55, 0x86, 0x93, 0x96, 0x0c, 0xf1, 0xbe, 0xb5, 0x97, 0xf9, 0x4a, 0x42,
-	0x22, 0xf1, 0x92, 0x1b, 0xf3, 0xa3, 0x67, 0xb0, 0x31, 0x88, 0x3e, 0x65, 0x98, 0x75, 0xa9, 0x6a,
-	0x99, 0xf7, 0x26, 0xc6, 0xee, 0xa5, 0x60, 0xb5, 0x8a, 0x2c, 0xf5, 0x5c, 0xa1, 0xb0, 0x23, 0xf2,
-	0xa1, 0x4e, 0x5f, 0xe0, 0x88, 0x79, 0x7c, 0xce, 0xd2, 0x38, 0x58, 0x9b, 0xb0, 0xa2, 0x16, 0x1b,
-	0x59, 0x24, 0xea, 0x29, 0x9d, 0xbc, 0x85, 0xf9, 0x92, 0xf7, 0xa7, 0x05, 0xd8, 0xcd, 0xd2, 0xaa,
-	0x22, 0xf4, 0x06, 0x76, 0x06, 0x6f, 0x14, 0x89, 0xbf, 0x61, 0xc2, 0xa8, 0xe2, 0x66, 0x4e, 0x54,
-	0x99, 0xe0, 0x3e, 0x23, 0x0c, 0xbb, 0x98, 0x61, 0xab, 0x8c, 0x87, 0xa6, 0x77, 0x5a, 0x35, 0x57,
-	0x99, 0x7c, 0x8d, 0xd0, 0xaa, 0x5c, 0xb8, 0x9a, 0x4a, 0x77, 0x68, 0x35, 0x48, 0xab, 0xac, 0xdc,
-	0x83, 0xed, 0x27, 0x24, 0x09, 0x03, 0x7d, 0xd4, 0x97, 0x37, 0xf0, 0x94, 0xd8, 0x57, 0xbe, 0x5a,
-	0x82, 0x3b, 0x7a, 0x39, 0x15, 0xbd, 0x2f, 0x0c, 0xd8, 0xd4, 0xf8, 0xd2, 0xc1, 0xa1, 0x8a, 0xdb,
-	0xf3, 0xec, 0xf7, 0xfb, 0x49, 0xc0, 0xe6, 0xc9, 0x88, 0x2f, 0xcf, 0x70, 0x28, 0x5f, 0xf6, 0x6f,
-	0xb9, 0xe3, 0x14, 0x61, 0x86, 0x26, 0x8b, 0xdc, 0x8c, 0x85, 0x6b, 0x99, 0x71, 0x34, 0x92, 0xc5,
-	0x81, 0x19, 0x78, 0x9c, 0x52, 0xfe, 0x1d, 0xef, 0x44, 0xbd, 0xdd, 0x9a, 0x6f, 0x11, 0x4f, 0xd3,
-	0xdf, 0x22, 0x6a, 0xd9, 0x26, 0x66, 0xb5, 0xf7, 0xd0, 0xb7, 0x09, 0xae, 0x3b, 0xcb, 0xd8, 0x6f,
-	0x5a, 0x77, 0xed, 0xef, 0x00, 0xf9, 0x67, 0x4a, 0xe6, 0xe8, 0x45, 0x1d, 0xfd, 0xc1, 0x80, 0x5b,
-	0x9a, 0xaf, 0x37, 0xe8, 0xe3, 0x39, 0x3f, 0xf6, 0x88, 0xe2, 0x2c, 0xdf, 0xbb, 0xd2, 0x27, 0xa2,
-	0x61, 0x23, 0x86, 0x03, 0x33, 0x83, 0x11, 0x9a, 0x35, 0x7a, 0x06, 0x23, 0xb4, 0x9f, 0x51, 0x7a,
-	0x70, 0x63, 0x64, 0x67, 0x47, 0x3f, 0xce, 0x46, 0xd2, 0xbf, 0x82, 0x95, 0x0f, 0xe7, 0x90, 0x48,
-	0xe9, 0x4d, 0xf9, 0x3d, 0x59, 0xaf, 0xce, 0xe7, 0xc3, 0x39, 0x24, 0x94, 0xde, 0x10, 0xd6, 0x53,
-	0xfb, 0x1b, 0x32, 0xb3, 0x31, 0x74, 0xab, 0x68, 0xb9, 0x3a, 0x33, 0xbf, 0xd2, 0xf8, 0x17, 0x03,
-	0xb6, 0x32, 0xb7, 0x14, 0xf4, 0x30, 0x1b, 0x6e, 0xda, 0xe6, 0x55, 0xfe, 0xf4, 0x4a, 0xb2, 0xca,
-	0xac, 0x3f, 0x1b, 0xf0, 0xae, 0x76, 0x6f, 0x40, 0xf7, 0xb3, 0x61, 0x27, 0xed, 0x51, 0xe5, 0x9f,
-	0xcc, 0x2d, 0xa7, 0x4c, 0xe9, 0xc3, 0xc6, 0x68, 0x13, 0xa3, 0xc3, 0x79, 0x1a, 0x5e, 0xea, 0xbf,
-	0xc2, 0x8c, 0x40, 0x5f, 0x1a, 0xb0, 0xa9, 0xbf, 0x7f, 0xd1, 0x04, 0x77, 0x26, 0xee, 0x09, 0xe5,
-	0x07, 0xf3, 0x0b, 0x2a, 0x6b, 0xfe, 0x68, 0xc0, 0x3b, 0xba, 0x69, 0x8f, 0xee, 0xcd, 0x7b, 0x3b,
-	0x48, 0x4b, 0xee, 0x5f, 0xed, 0x52, 0x79, 0xf4, 0xe4, 0xeb, 0xb7, 0xbb, 0xc6, 0x3f, 0xdf, 0xee,
-	0x1a, 0xff, 0x79, 0xbb, 0x6b, 0xfc, 0xfa, 0x93, 0x73, 0x8f, 0xb5, 0xba, 0x4d, 0xd3, 0x09, 0x3a,
-	0xd5, 0xd4, 0x1f, 0x7b, 0xe6, 0x39, 0xf1, 0xe5, 0xdf, 0x9c, 0xc3, 0xff, 0xb4, 0x7e, 0x1a, 0xff,
-	0xee, 0x1d, 0x36, 0x57, 0x04, 0xf5, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x4f, 0x1f,
-	0x16, 0x97, 0x1d, 0x00, 0x00,
+	// 2124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x4b, 0x6f, 0xdb, 0xc8,
+	0x19, 0x94, 0xfc, 0xd2, 0xa7, 0xc7, 0x3a, 0x74, 0xd6, 0x91, 0xe5, 0xf8, 0x11, 0xa6, 0xbb, 0x75,
+	0x8b, 0x5d, 0xba, 0xd6, 0xae, 0xb3, 0xd9, 0x2c, 0x8a, 0xc2, 0xf1, 0x23, 0x11, 0x50, 0x37, 0x59,
+	0xda, 0x4d, 0x81, 0xa2, 0x08, 0x31, 0x12, 0xc7, 0x16, 0x6b, 0x89, 0x64, 0x38, 0x43, 0x39, 0xca,
+	0xa1, 0x87, 0x62, 0x5b, 0x14, 0xd8, 0x6b, 0x7f, 0x41, 0xbb, 0x97, 0xfe, 0x93, 0x3d, 0xf4, 0xd0,
+	0x7b, 0x51, 0xa0, 0x08, 0xd0, 0x73, 0xfb, 0x13, 0x8a, 0x79, 0x90, 0x12, 0x25, 0x92, 0x96, 0xec,
+	0x7d, 0xdc, 0x38, 0x33, 0xdf, 0xfb, 0x3d, 0x23, 0xc1, 0xfb, 0x41, 0x13, 0xfb, 0xdb, 0x2d, 0x64,
+	0x61, 0xa7, 0x85, 0xb7, 0xbb, 0x88, 0xb6, 0xda, 0xb6, 0x73, 0xbe, 0xdd, 0xdb, 0xd9, 0x26, 0xd8,
+	0xef, 0xd9, 0x2d, 0xac, 0x7b, 0xbe, 0x4b, 0x5d, 0xb5, 0xca, 0xe0, 0x74, 0x09, 0xa7, 0x87, 0x70,
+	0x7a, 0x6f, 0xa7, 0xb6, 0x7e, 0xee, 0xba, 0xe7, 0x1d, 0xbc, 0xcd, 0xe1, 0x9a, 0xc1, 0xd9, 0xb6,
+	0x15, 0xf8, 0x88, 0xda, 0xae, 0x23, 0x30, 0x6b, 0x1b, 0xa3, 0xe7, 0xd4, 0xee, 0x62, 0x42, 0x51,
+	0xd7, 0x93, 0x00, 0x63, 0x04, 0x2e, 0x7d, 0xe4, 0x79, 0xd8, 0x27, 0xf2, 0x7c, 0x33, 0x26, 0x22,
+	0xf2, 0x6c, 0x26, 0x5d, 0xcb, 0xed, 0x76, 0x07, 0x2c, 0x92, 0x20, 0x5e, 0x05, 0xd8, 0xef, 0x4b,
+	0x00, 0x2d, 0x09, 0x80, 0x22, 0x72, 0xd1, 0xb1, 0x09, 0x95, 0x30, 0x5b, 0x49, 0x30, 0xd2, 0x08,
+	0xe6, 0xa5, 0xeb, 0x5f, 0x60, 0x5f, 0x42, 0xfe, 0xf8, 0x2a, 0xc8, 0xb3, 0x8e, 0x7b, 0x29, 0x61,
+	0x7f, 0x10, 0x83, 0x25, 0x6d, 0xe4, 0x63, 0x8b, 0x81, 0xb7, 0x6d, 0x42, 0xdd, 0x48, 0xbe, 0xf7,
+	0x52, 0xa0, 0xe2, 0x22, 0x6a, 0x5f, 0x2b, 0x50, 0x7b, 0xee, 0x76, 0x3a, 0x47, 0xae, 0x7f, 0x80,
+	0x5b, 0x36, 0xb1, 0x5d, 0xe7, 0x14, 0x91, 0x0b, 0x03, 0xbf, 0x0a, 0x30, 0xa1, 0x6a, 0x03, 0xe6,
+	0x7d, 0xf1, 0x59, 0x55, 0x36, 0x95, 0xad, 0x62, 0x7d, 0x5b, 0x8f, 0x79, 0x0d, 0x79, 0xb6, 0xde,
+	0xdb, 0xd1, 0xd3, 0x29, 0x18, 0x21, 0xbe, 0xba, 0x0a, 0x05, 0xcb, 0xed, 0x22, 0xdb, 0x31, 0x6d,
+	0xab, 0x9a, 0xdb, 0x54, 0xb6, 0x0a, 0xc6, 0x82, 0xd8, 0x68, 0x58, 0xec, 0xd0, 0x73, 0x3b, 0x1d,
+	0xec, 0xb3, 0xc3, 0xbc, 0x38, 0x14, 0x1b, 0x0d, 0x4b, 0x7d, 0x0f, 0x2a, 0x67, 0xae, 0x7f, 0x89,
+	0x7c, 0x0b, 0x5b, 0xe6, 0x99, 0xef, 0x76, 0xab, 0x33, 0x1c, 0xa2, 0x1c, 0xed, 0x1e, 0xf9, 0x6e,
+	0x57, 0xfb, 0xa2, 0x00, 0xab, 0x89, 0x82, 0x10, 0xcf, 0x75, 0x08, 0x56, 0xd7, 0x00, 0x98, 0xf2,
+	0x26, 0x75, 0x2f, 0xb0, 0xc3, 0xd5, 0x29, 0x19, 0x05, 0xb6, 0x73, 0xca, 0x36, 0xd4, 0x5f, 0x82,
+	0x1a, 0x1a, 0xda, 0xc4, 0xaf, 0x71, 0x2b, 0x60, 0x01, 0xc7, 0x05, 0x2d, 0xd6, 0xdf, 0x4f, 0xd4,
+	0xfa, 0x57, 0x12, 0xfc, 0x30, 0x84, 0x36, 0x6e, 0x5d, 0x8e, 0x6e, 0xa9, 0x47, 0x50, 0x8e, 0xc8,
+	0xd2, 0xbe, 0x87, 0xb9, 0x76, 0xc5, 0xfa, 0xbd, 0x4c, 0x8a, 0xa7, 0x7d, 0x0f, 0x1b, 0xa5, 0xcb,
+	0xa1, 0x95, 0xfa, 0x02, 0x56, 0x3c, 0x1f, 0xf7, 0x6c, 0x37, 0x20, 0x26, 0xa1, 0xc8, 0xa7, 0xd8,
+	0x32, 0x71, 0x0f, 0x3b, 0x94, 0x59, 0x6c, 0x86, 0xd3, 0x5c, 0xd5, 0x45, 0xd8, 0xeb, 0x61, 0xd8,
+	0xeb, 0x0d, 0x87, 0x3e, 0xf8, 0xf8, 0x05, 0xea, 0x04, 0xd8, 0x58, 0x0e, 0xb1, 0x4f, 0x04, 0xf2,
+	0x21, 0xc3, 0x6d, 0x58, 0xea, 0x16, 0x2c, 0x8e, 0x91, 0x9b, 0xdd, 0x54, 0xb6, 0xf2, 0x46, 0x85,
+	0xc4, 0x21, 0xab, 0x30, 0x8f, 0x28, 0xc5, 0x5d, 0x8f, 0x56, 0xe7, 0x36, 0x95, 0xad, 0x59, 0x23,
+	0x5c, 0xaa, 0x1a, 0x94, 0x1d, 0xfc, 0x9a, 0x0e, 0x08, 0xcc, 0x73, 0x02, 0x45, 0xb6, 0x19, 0x62,
+	0x7f, 0x00, 0x6a, 0x13, 0xb5, 0x2e, 0x3a, 0xee, 0xb9, 0xd9, 0x72, 0x03, 0x87, 0x9a, 0x6d, 0xdb,
+	0xa1, 0xd5, 0x05, 0x0e, 0xb8, 0x28, 0x4f, 0xf6, 0xd9, 0xc1, 0x53, 0xdb, 0xa1, 0xea, 0x43, 0xa8,
+	0x12, 0x6a, 0xb7, 0x2e, 0xfa, 0x03, 0x57, 0x98, 0xd8, 0x41, 0xcd, 0x0e, 0xb6, 0xaa, 0x85, 0x4d,
+	0x65, 0x6b, 0xc1, 0x58, 0x16, 0xe7, 0x91, 0xa1, 0x0f, 0xc5, 0xa9, 0xfa, 0x10, 0x66, 0x79, 0x9a,
+	0x56, 0x81, 0xdb, 0x44, 0xcb, 0xb4, 0xf3, 0xe7, 0x0c, 0xd2, 0x10, 0x08, 0xaa, 0x01, 0x65, 0x4b,
+	0xc6, 0x8d, 0x69, 0x3b, 0x67, 0x6e, 0xb5, 0xc8, 0x29, 0x7c, 0x18, 0xa7, 0x20, 0x32, 0x89, 0x11,
+	0x39, 0xf5, 0x91, 0x43, 0x6c, 0xec, 0xd0, 0x30, 0xda, 0x1a, 0xce, 0x99, 0x6b, 0x94, 0xac, 0xa1,
+	0x95, 0xfa, 0x12, 0xee, 0x8e, 0x07, 0x95, 0xc9, 0xc3, 0x90, 0x25, 0x61, 0xb5, 0xc4, 0x59, 0xac,
+	0x25, 0x0a, 0xc9, 0x82, 0xf7, 0xe7, 0x36, 0xa1, 0xc6, 0xca, 0x58, 0x54, 0x85, 0x47, 0xaa, 0x0e,
+	0x4b, 0xc2, 0xe8, 0x2c, 0xf5, 0xb1, 0xd9, 0xc3, 0x3e, 0x63, 0x5d, 0x2d, 0x73, 0xff, 0xdc, 0xe2,
+	0x47, 0x27, 0xec, 0xe4, 0x85, 0x38, 0x50, 0xef, 0x41, 0xa9, 0xe9, 0x23, 0xa7, 0xd5, 0x96, 0x59,
+	0x50, 0xe1, 0x59, 0x50, 0x14, 0x7b, 0x22, 0x0f, 0xf6, 0xa0, 0x42, 0x5a, 0x6d, 0x6c, 0x05, 0x1d,
+	0x6c, 0x99, 0xac, 0xb0, 0x56, 0xdf, 0xe1, 0x42, 0xd6, 0xc6, 0xa2, 0xeb, 0x34, 0xac, 0xba, 0x46,
+	0x39, 0xc2, 0x60, 0x7b, 0xea, 0x4f, 0xa1, 0x14, 0xc6, 0x14, 0x27, 0xb0, 0x78, 0x25, 0x81, 0xa2,
+	0x84, 0xe7, 0xe8, 0xbf, 0x81, 0x79, 0xe6, 0x11, 0x1b, 0x93, 0xea, 0xad, 0xcd, 0xfc, 0x56, 0xb1,
+	0xfe, 0x58, 0x4f, 0x6b, 0x15, 0x7a, 0x46, 0xc2, 0xeb, 0x9f, 0x0b, 0x22, 0x87, 0x0e, 0xf5, 0xfb,
+	0x46, 0x48, 0xb2, 0xf6, 0x12, 0x4a, 0xc3, 0x07, 0xea, 0x22, 0xe4, 0x2f, 0x70, 0x9f, 0xd7, 0x83,
+	0x82, 0xc1, 0x3e, 0x59, 0x08, 0xf5, 0x58, 0xce, 0x54, 0x73, 0x93, 0x87, 0x10, 0x47, 0x78, 0x94,
+	0x7b, 0xa8, 0x0c, 0x57, 0xd4, 0xbd, 0x16, 0xb5, 0x7b, 0x36, 0xed, 0x5f, 0xbf, 0xa2, 0x26, 0x50,
+	0xf8, 0x0e, 0x2b, 0xea, 0x97, 0x0b, 0xb0, 0x9a, 0x28, 0xc8, 0xf7, 0x5a, 0x51, 0x37, 0xa0, 0x88,
+	0xa4, 0x34, 0x03, 0xdd, 0x20, 0xdc, 0x6a, 0x58, 0xac, 0xe4, 0x46, 0x00, 0xbc, 0xe4, 0xce, 0x64,
+	0x94, 0xdc, 0x48, 0x31, 0x5e, 0x72, 0xd1, 0xd0, 0x4a, 0xad, 0xc3, 0xac, 0xed, 0x78, 0x01, 0xe5,
+	0xf5, 0xb0, 0x58, 0xbf, 0x9b, 0xec, 0x28, 0xd4, 0xef, 0xb8, 0xc8, 0x32, 0x04, 0x68, 0x42, 0xf6,
+	0xcc, 0xdd, 0x34, 0x7b, 0xe6, 0xa7, 0xcb, 0x9e, 0x53, 0x58, 0x09, 0xe9, 0x99, 0xd4, 0x35, 0x5b,
+	0x1d, 0x97, 0x60, 0x4e, 0xc8, 0x0d, 0x44, 0xbd, 0x2d, 0xd6, 0x57, 0xc6, 0x68, 0x1d, 0xc8, 0x01,
+	0xcb, 0x58, 0x0e, 0x71, 0x4f, 0xdd, 0x7d, 0x86, 0x79, 0x2a, 0x10, 0xd5, 0x5f, 0xc0, 0x32, 0x67,
+	0x32, 0x4e, 0xb2, 0x70, 0x15, 0xc9, 0x25, 0x8e, 0x38, 0x42, 0xef, 0x08, 0x6e, 0xb5, 0x31, 0xf2,
+	0x69, 0x13, 0x23, 0x1a, 0x91, 0x82, 0xab, 0x48, 0x2d, 0x46, 0x38, 0x21, 0x9d, 0xa1, 0xa6, 0x54,
+	0x8c, 0x37, 0xa5, 0x97, 0xb0, 0x1e, 0xf7, 0x84, 0xe9, 0x9e, 0x99, 0xb4, 0x6d, 0x13, 0x33, 0x44,
+	0x28, 0x5d, 0x69, 0xd8, 0x5a, 0xcc, 0x33, 0xcf, 0xce, 0x4e, 0xdb, 0x36, 0xd9, 0x93, 0xf4, 0x1b,
+	0xc3, 0x1a, 0x58, 0x98, 0x22, 0xbb, 0x43, 0xaa, 0xe5, 0x09, 0x22, 0x65, 0xa0, 0xc4, 0x81, 0xc0,
+	0x1a, 0x9f, 0x11, 0x2a, 0xd7, 0x9b, 0x11, 0x7e, 0x08, 0xef, 0x44, 0x74, 0x44, 0x21, 0xe0, 0xb5,
+	0xbb, 0x60, 0x54, 0xc2, 0xed, 0x03, 0xbe, 0xab, 0x7e, 0x04, 0x73, 0x6d, 0x8c, 0x2c, 0xec, 0xcb,
+	0xd2, 0xbc, 0x9a, 0xc8, 0xe9, 0x29, 0x07, 0x31, 0x24, 0xa8, 0xf6, 0x97, 0x3c, 0x2c, 0xef, 0x59,
+	0x56, 0xd2, 0x98, 0x18, 0xab, 0x44, 0xca, 0x48, 0x25, 0xfa, 0x96, 0xca, 0xc0, 0x23, 0x28, 0x0c,
+	0xfa, 0x68, 0x7e, 0x92, 0x3e, 0xba, 0x40, 0xe5, 0x17, 0x2b, 0x21, 0x51, 0x8e, 0xc8, 0xf1, 0x29,
+	0x6f, 0x40, 0xb8, 0xd5, 0xb0, 0x46, 0x93, 0x48, 0x86, 0xbe, 0x0c, 0xd3, 0xd9, 0x29, 0x92, 0x88,
+	0x4f, 0x5b, 0x61, 0xb0, 0x3e, 0x82, 0x39, 0xe2, 0x06, 0x7e, 0x4b, 0x14, 0x85, 0x4a, 0x5d, 0x4b,
+	0x1d, 0x2d, 0x10, 0xb9, 0x38, 0xe1, 0x90, 0x86, 0xc4, 0x48, 0x28, 0xd9, 0xf3, 0x49, 0x25, 0x7b,
+	0x05, 0xee, 0x8c, 0xf9, 0x48, 0x54, 0x6b, 0xed, 0xef, 0xc2, 0x7f, 0x49, 0x4d, 0xe9, 0xfb, 0xf0,
+	0x1f, 0x1b, 0x3c, 0xb9, 0x6a, 0xe6, 0x80, 0xb5, 0xa8, 0xe5, 0x15, 0xb1, 0x7f, 0x10, 0x0a, 0x10,
+	0xf3, 0xf4, 0xcc, 0x8d, 0x3c, 0x3d, 0x3b, 0x9d, 0xa7, 0xe7, 0x6e, 0xee, 0xe9, 0xf9, 0x6f, 0xc0,
+	0xd3, 0x0b, 0xe9, 0x9e, 0x4e, 0xea, 0xcb, 0xda, 0x3f, 0x15, 0xb8, 0xcd, 0xe7, 0x92, 0xd0, 0x11,
+	0xa1, 0x9f, 0xf7, 0x47, 0x87, 0x8f, 0x1f, 0x25, 0xda, 0x31, 0x09, 0x77, 0xc2, 0xb1, 0xe3, 0x26,
+	0x59, 0x39, 0xe1, 0x54, 0xf2, 0x57, 0x05, 0xde, 0x1d, 0x91, 0x50, 0xce, 0x23, 0x3f, 0x83, 0x12,
+	0x1f, 0xe5, 0x4d, 0x1f, 0x93, 0xa0, 0x13, 0xea, 0x98, 0x5d, 0x8d, 0x8b, 0x1c, 0xc3, 0xe0, 0x08,
+	0x6a, 0x03, 0x2a, 0x21, 0x81, 0xdf, 0xe2, 0x16, 0xc5, 0x56, 0xe6, 0x08, 0x28, 0x46, 0x3f, 0x09,
+	0x69, 0x94, 0x5f, 0x0d, 0x2f, 0xb5, 0xff, 0x28, 0xb0, 0x29, 0x04, 0xb3, 0x38, 0x1c, 0xd3, 0x77,
+	0xdf, 0xed, 0x7a, 0x1d, 0xcc, 0x80, 0xa5, 0x29, 0x9f, 0x8d, 0xfa, 0x63, 0x37, 0x91, 0xd1, 0x55,
+	0x74, 0xbe, 0x03, 0xdf, 0xdc, 0x81, 0x79, 0x8e, 0x2b, 0xab, 0x65, 0xc1, 0x98, 0x63, 0xcb, 0x86,
+	0xa5, 0xdd, 0x87, 0x7b, 0x19, 0xe2, 0xc9, 0x80, 0xfc, 0x97, 0x02, 0x77, 0xf7, 0x91, 0xd3, 0xc2,
+	0x9d, 0x67, 0x01, 0x25, 0x14, 0x39, 0x96, 0xed, 0x9c, 0xb3, 0xc9, 0x72, 0xa2, 0x02, 0x14, 0x1b,
+	0x65, 0x73, 0x23, 0xa3, 0xec, 0x13, 0xa8, 0x44, 0x4a, 0x0d, 0x2e, 0xd8, 0x95, 0x94, 0xe6, 0x19,
+	0x6a, 0x26, 0x9a, 0x27, 0x1d, 0x5a, 0xdd, 0xa4, 0xca, 0x68, 0x1b, 0xb0, 0x96, 0xa2, 0x9e, 0x34,
+	0xc0, 0xff, 0x14, 0x58, 0x33, 0x70, 0x07, 0x23, 0x82, 0x87, 0x40, 0x26, 0x2e, 0xc1, 0x31, 0xd9,
+	0x72, 0xd3, 0x79, 0x2e, 0xb9, 0x7c, 0xe7, 0xbf, 0x81, 0x29, 0x3c, 0xb3, 0x85, 0x6a, 0x9b, 0xb0,
+	0x9e, 0xa6, 0xb1, 0x34, 0xca, 0xef, 0xe0, 0xce, 0x01, 0x26, 0x2d, 0xdf, 0x6e, 0xe2, 0x48, 0x6e,
+	0x69, 0x8d, 0xa3, 0xd1, 0xc4, 0xf8, 0x20, 0x51, 0xd2, 0x14, 0xf4, 0xc9, 0xf2, 0x41, 0xfb, 0x4a,
+	0x81, 0xea, 0x38, 0x05, 0x59, 0x4b, 0x3e, 0x85, 0x79, 0x11, 0x63, 0xa4, 0xaa, 0xf0, 0x4b, 0xe8,
+	0x46, 0xea, 0x3d, 0x0d, 0xfb, 0xfc, 0xe6, 0x1f, 0xc2, 0xab, 0xc7, 0xb0, 0x38, 0x08, 0x49, 0x42,
+	0x11, 0x0d, 0x88, 0x74, 0xda, 0xfd, 0x4c, 0xa7, 0x9d, 0x70, 0x50, 0xa3, 0x42, 0x63, 0x6b, 0x8d,
+	0xc0, 0x1a, 0x5b, 0x85, 0x50, 0xcf, 0x91, 0x4f, 0x6d, 0xe6, 0x02, 0x12, 0x1a, 0x6b, 0x19, 0xe6,
+	0xe4, 0xb4, 0x27, 0xe2, 0x46, 0xae, 0x6e, 0x12, 0x35, 0xda, 0x1f, 0x73, 0xb0, 0x9e, 0xc6, 0x55,
+	0x5a, 0xe8, 0x15, 0xac, 0x0d, 0xae, 0x59, 0x91, 0xbe, 0x5e, 0x04, 0x28, 0xed, 0xa6, 0x67, 0xb2,
+	0x8c, 0xe8, 0x1e, 0x63, 0x8a, 0x2c, 0x44, 0x91, 0x51, 0x43, 0x43, 0x2d, 0x2d, 0xce, 0x9a, 0xb1,
+	0x8c, 0x9e, 0x68, 0x12, 0x59, 0xe6, 0xae, 0xc7, 0xd2, 0x1a, 0x9a, 0x97, 0xe2, 0x2c, 0xb5, 0x5d,
+	0x58, 0x7d, 0x82, 0x23, 0x33, 0x90, 0xc7, 0x7d, 0x31, 0x96, 0x5c, 0x61, 0x7b, 0xed, 0xab, 0x19,
+	0xb8, 0x9b, 0x8c, 0x27, 0xad, 0xf7, 0x85, 0x02, 0xcb, 0x09, 0xba, 0x74, 0x91, 0x27, 0xed, 0xf6,
+	0x2c, 0xfd, 0xd1, 0x23, 0x8b, 0xb0, 0x7e, 0x30, 0xa2, 0xcb, 0x31, 0xf2, 0xc4, 0x0b, 0xc8, 0x92,
+	0x35, 0x7e, 0xc2, 0xc5, 0x48, 0xf0, 0x22, 0x13, 0x23, 0x77, 0x23, 0x31, 0xf6, 0x46, 0xbc, 0x38,
+	0x10, 0x03, 0x8d, 0x9f, 0xd4, 0xde, 0xb0, 0x4c, 0x4c, 0x96, 0x3b, 0xe1, 0x81, 0xe6, 0x69, 0xfc,
+	0x81, 0xa6, 0x9e, 0x2e, 0x62, 0x5a, 0x7a, 0x0f, 0x3d, 0xd8, 0x30, 0xde, 0x69, 0xc2, 0x7e, 0xdb,
+	0xbc, 0xb5, 0xff, 0xe6, 0x60, 0xe9, 0xd8, 0xed, 0x8d, 0xd5, 0xbf, 0xcc, 0x6e, 0x30, 0x88, 0xb9,
+	0x5c, 0x2c, 0xdf, 0x9f, 0x44, 0x13, 0xf5, 0x94, 0x6d, 0x5e, 0x0e, 0xdc, 0xe1, 0x9a, 0x11, 0xa2,
+	0xc8, 0x3f, 0xc7, 0xd4, 0x9c, 0xb2, 0x23, 0x56, 0x04, 0xda, 0x10, 0xa1, 0xd1, 0xe6, 0x3c, 0x7b,
+	0xbd, 0xe6, 0xbc, 0x06, 0xd0, 0x64, 0xa6, 0x35, 0x89, 0xfd, 0x06, 0xcb, 0xe7, 0xe7, 0x02, 0xdf,
+	0x39, 0xb1, 0xdf, 0x60, 0xe6, 0x26, 0xdf, 0x23, 0x7c, 0xd6, 0x9e, 0x35, 0xd8, 0x27, 0x9b, 0x57,
+	0x2c, 0x36, 0xc7, 0x05, 0x0e, 0x9f, 0x9e, 0x17, 0x8c, 0x39, 0xcb, 0xef, 0x1b, 0x81, 0xa3, 0xf5,
+	0xe0, 0x76, 0xdc, 0xe0, 0x32, 0x1f, 0x37, 0xa0, 0xc8, 0x38, 0x12, 0xb3, 0xeb, 0xf6, 0xb0, 0xb0,
+	0x79, 0xde, 0xe0, 0xcf, 0x5b, 0x84, 0xc1, 0x5b, 0xea, 0x7d, 0x28, 0x0b, 0x00, 0xfc, 0xda, 0xb3,
+	0x7d, 0x39, 0x1a, 0xe6, 0x85, 0x9c, 0xe4, 0x50, 0xec, 0xb1, 0xe7, 0x88, 0x33, 0x64, 0x77, 0x02,
+	0x1f, 0xcb, 0xbb, 0x4c, 0xb8, 0xac, 0xff, 0xad, 0x04, 0xc5, 0x63, 0x19, 0x1d, 0x7b, 0xcf, 0x1b,
+	0xea, 0xef, 0x15, 0x58, 0x4a, 0x78, 0xbc, 0x54, 0x3f, 0x9e, 0xf2, 0xad, 0x93, 0xc7, 0x4b, 0x6d,
+	0xf7, 0x5a, 0x2f, 0xa4, 0xc3, 0x42, 0x0c, 0xa7, 0xc0, 0x04, 0x42, 0x24, 0xdc, 0x22, 0x6b, 0xbb,
+	0x53, 0x62, 0x49, 0x21, 0x7a, 0xf0, 0xce, 0xc8, 0x95, 0x55, 0xfd, 0x49, 0x3a, 0xa5, 0xe4, 0x17,
+	0x88, 0xda, 0xce, 0x14, 0x18, 0x31, 0xbe, 0x31, 0xbd, 0xb3, 0xf9, 0x26, 0xe9, 0xbc, 0x33, 0x05,
+	0x86, 0xe4, 0xeb, 0x41, 0x39, 0x76, 0x7d, 0x51, 0xf5, 0x74, 0x1a, 0x49, 0x37, 0xb1, 0xda, 0xf6,
+	0xc4, 0xf0, 0x92, 0xe3, 0x9f, 0x15, 0x58, 0x49, 0x1d, 0xd2, 0xd5, 0x47, 0xe9, 0xe4, 0xae, 0xba,
+	0x78, 0xd4, 0x3e, 0xbb, 0x16, 0xae, 0x14, 0xeb, 0x4f, 0x0a, 0xbc, 0x9b, 0x38, 0x36, 0xab, 0x0f,
+	0xd2, 0xc9, 0x66, 0x5d, 0x23, 0x6a, 0x9f, 0x4c, 0x8d, 0x27, 0x45, 0xf9, 0x52, 0x81, 0xe5, 0xe4,
+	0x69, 0x55, 0xfd, 0x24, 0x4b, 0xc5, 0x8c, 0x89, 0xbe, 0xf6, 0x70, 0x7a, 0x44, 0x29, 0x4d, 0x1f,
+	0x16, 0x47, 0x9b, 0x87, 0xba, 0x33, 0x4d, 0xa3, 0x11, 0x02, 0x5c, 0xa3, 0x37, 0x71, 0x43, 0x24,
+	0xcf, 0x7d, 0x59, 0x86, 0xc8, 0x9c, 0x4f, 0xb3, 0x0c, 0x71, 0xc5, 0x88, 0xf9, 0x07, 0x05, 0x6e,
+	0x27, 0x4d, 0x19, 0xea, 0xee, 0xb4, 0x53, 0x89, 0x90, 0xe4, 0xc1, 0xf5, 0x86, 0x19, 0xb5, 0x0b,
+	0xa5, 0xe1, 0xa6, 0xa1, 0x7e, 0x98, 0x4e, 0x27, 0xa1, 0x9b, 0xd7, 0xf4, 0x49, 0xc1, 0x05, 0xbb,
+	0xc7, 0x4f, 0xbe, 0x7e, 0xbb, 0xae, 0xfc, 0xe3, 0xed, 0xba, 0xf2, 0xef, 0xb7, 0xeb, 0xca, 0xaf,
+	0x3f, 0x3d, 0xb7, 0x69, 0x3b, 0x68, 0xea, 0x2d, 0xb7, 0xbb, 0x1d, 0xfb, 0x51, 0x5f, 0x3f, 0xc7,
+	0x8e, 0xf8, 0x8b, 0xc3, 0xf0, 0xbf, 0x2c, 0x3e, 0x0b, 0xbf, 0x7b, 0x3b, 0xcd, 0x39, 0x7e, 0xfa,
+	0xd1, 0xff, 0x07, 0x00, 0x13, 0xb1, 0x2f, 0xa3, 0x93, 0x21, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -2680,7 +2973,7 @@ func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseOutstandingTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2690,12 +2983,12 @@ func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseOutstandingTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseOutstandingTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2704,16 +2997,26 @@ func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if m.Request != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2721,12 +3024,19 @@ func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseOutstandingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2736,12 +3046,12 @@ func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseOutstandingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseOutstandingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2750,36 +3060,10 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskListStatus != nil {
-		{
-			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pollers) > 0 {
-		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2789,12 +3073,12 @@ func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2803,7 +3087,106 @@ func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskList != nil {
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskListStatus != nil {
+		{
+			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pollers) > 0 {
+		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskList != nil {
 		{
 			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -2993,6 +3376,140 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MoveTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rps != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetTaskList != nil {
+		{
+			size, err := m.TargetTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SourceTaskList != nil {
+		{
+			size, err := m.SourceTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Failure) > 0 {
+		i -= len(m.Failure)
+		copy(dAtA[i:], m.Failure)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Failure)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TasksExpired != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TasksExpired))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TasksMoved != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TasksMoved))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -3449,6 +3966,45 @@ func (m *CancelOutstandingPollResponse) Size() (n int) {
 	return n
 }
 
+func (m *ReleaseOutstandingTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseOutstandingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeTaskListRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3589,17 +4145,79 @@ func (m *GetTaskListsByDomainResponse) Size() (n int) {
 	return n
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *MoveTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.SourceTaskList != nil {
+		l = m.SourceTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TargetTaskList != nil {
+		l = m.TargetTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovService(uint64(m.BatchSize))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovService(uint64(m.Rps))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TasksMoved != 0 {
+		n += 1 + sovService(uint64(m.TasksMoved))
+	}
+	if m.TasksExpired != 0 {
+		n += 1 + sovService(uint64(m.TasksExpired))
+	}
+	l = len(m.Failure)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -6637,7 +7255,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleaseOutstandingTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6660,15 +7278,47 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseOutstandingTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseOutstandingTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6695,18 +7345,18 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeTaskListRequest{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6716,24 +7366,47 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6756,7 +7429,7 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *ReleaseOutstandingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6779,15 +7452,66 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseOutstandingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseOutstandingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6814,16 +7538,18 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &v1.DescribeTaskListRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6833,27 +7559,23 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskListStatus == nil {
-				m.TaskListStatus = &v1.TaskListStatus{}
-			}
-			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6877,7 +7599,7 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6900,17 +7622,17 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6920,27 +7642,29 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6967,10 +7691,10 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.TaskListStatus == nil {
+				m.TaskListStatus = &v1.TaskListStatus{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6996,7 +7720,7 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7019,15 +7743,134 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7507,6 +8350,391 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MoveTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceTaskList == nil {
+				m.SourceTaskList = &v1.TaskList{}
+			}
+			if err := m.SourceTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetTaskList == nil {
+				m.TargetTaskList = &v1.TaskList{}
+			}
+			if err := m.TargetTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksMoved", wireType)
+			}
+			m.TasksMoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TasksMoved |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksExpired", wireType)
+			}
+			m.TasksExpired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TasksExpired |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failure = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,231 +54,247 @@ var yarpcFileDescriptorClosure0370c4177fcc3ee8 = [][]byte{
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x9f, 0xe3, 0xd8, 0xea, 0x4a,
-		0xfe, 0x92, 0x68, 0xe2, 0xa4, 0x05, 0x21, 0x2d, 0xe3, 0x99, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-		0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-		0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e,
-		0x78, 0x80, 0x07, 0x1e, 0xe8, 0x1b, 0x0f, 0x94, 0x37, 0x24, 0x34, 0x77, 0xee, 0xec, 0xce, 0x9f,
-		0x7b, 0x67, 0xee, 0xac, 0x37, 0x2d, 0xa8, 0x79, 0xf3, 0xdc, 0x39, 0xf7, 0xcc, 0xef, 0x9e, 0x7b,
-		0xce, 0xb9, 0xe7, 0x9e, 0x73, 0xd6, 0x70, 0xaa, 0xbd, 0x81, 0xad, 0x05, 0x4d, 0xd5, 0x71, 0x53,
-		0xc3, 0x0b, 0x6a, 0xcb, 0x58, 0xd8, 0xb9, 0xb4, 0xb0, 0x65, 0xd8, 0x8e, 0x69, 0xed, 0x56, 0x5a,
-		0x96, 0xe9, 0x98, 0xe8, 0x80, 0x4b, 0x52, 0xa1, 0x24, 0x15, 0xb5, 0x65, 0x54, 0x76, 0x2e, 0x1d,
-		0x39, 0xf1, 0xd0, 0x34, 0x1f, 0xd6, 0xf1, 0x02, 0x21, 0xd9, 0x68, 0x6f, 0x2e, 0xe8, 0x6d, 0x4b,
-		0x75, 0x0c, 0xb3, 0xe9, 0x4d, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x31, 0x1a, 0xd8, 0x76, 0xd4, 0x46,
-		0x8b, 0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x99, 0x8d, 0x46, 0x97, 0x85, 0xcc, 0xa2, 0x70, 0x54, 0x7b,
-		0xbb, 0x6e, 0xd8, 0x4e, 0x12, 0x4d, 0xc7, 0xb4, 0xb6, 0x37, 0xeb, 0x66, 0xc7, 0xa3, 0x91, 0x6f,
-		0x41, 0xf1, 0x25, 0x6f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x6e, 0x3a, 0xf6, 0x94, 0x34, 0x93,
-		0x9f, 0x2d, 0x2f, 0x9e, 0xaa, 0x30, 0xd6, 0x56, 0xa1, 0xd4, 0x2b, 0x2e, 0xa5, 0x42, 0x27, 0xc8,
-		0xef, 0x5f, 0x83, 0xd1, 0xe0, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19,
-		0x69, 0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xbc, 0x57, 0xee, 0xa2, 0xa7, 0x72,
-		0x33, 0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x93, 0x48, 0xc5, 0x97, 0x48, 0x65, 0xdd, 0x97, 0x88,
-		0x52, 0x22, 0xd4, 0xee, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x6c, 0xc3, 0x6c, 0x4e, 0xe5, 0x3d,
-		0xa6, 0xf4, 0x11, 0x1d, 0x86, 0xa2, 0xbb, 0x78, 0xf7, 0x73, 0x43, 0xe4, 0x4d, 0xc1, 0x7d, 0xac,
-		0xea, 0xe8, 0x6b, 0x12, 0x9c, 0xf7, 0x97, 0x5c, 0xc3, 0x8f, 0xb0, 0xd6, 0x76, 0xf7, 0xa1, 0x66,
-		0x3b, 0xaa, 0xe5, 0x60, 0xbd, 0xe6, 0x21, 0x51, 0x1d, 0xc7, 0x32, 0x36, 0xda, 0x0e, 0xb6, 0xa7,
-		0x86, 0x09, 0x9e, 0xff, 0x65, 0x2e, 0xfd, 0x65, 0xca, 0x67, 0xc5, 0x67, 0x73, 0xcf, 0xe3, 0x42,
-		0x96, 0xbc, 0xd4, 0xe5, 0xf1, 0xd2, 0x53, 0xca, 0xb9, 0x8e, 0x18, 0x29, 0xfa, 0x96, 0x04, 0x17,
-		0x18, 0xf0, 0x34, 0xb3, 0xd1, 0xaa, 0x63, 0x26, 0xc0, 0x02, 0x01, 0xf8, 0x82, 0x18, 0xc0, 0x65,
-		0x9f, 0x4f, 0x1c, 0xe2, 0x33, 0x1d, 0x51, 0x62, 0xf4, 0x96, 0x04, 0x73, 0x0c, 0x90, 0x9b, 0xaa,
-		0x51, 0x67, 0x21, 0x2c, 0x12, 0x84, 0x37, 0xc4, 0x10, 0xae, 0x12, 0x26, 0x71, 0x78, 0x67, 0x3b,
-		0x42, 0x94, 0xe8, 0x9b, 0x6c, 0x01, 0xba, 0xba, 0xa5, 0xd7, 0xcc, 0xb6, 0x13, 0x87, 0x37, 0x42,
-		0xe0, 0x3d, 0x2f, 0x06, 0xcf, 0x55, 0x3b, 0xfd, 0x4e, 0xdb, 0x89, 0x03, 0x9c, 0xed, 0x08, 0xd2,
-		0xa2, 0x37, 0x25, 0x98, 0xd5, 0xb1, 0x66, 0xd8, 0x04, 0x98, 0xab, 0xa5, 0xb6, 0xb6, 0x85, 0xf5,
-		0x36, 0x53, 0x78, 0x25, 0x82, 0xee, 0x3a, 0x13, 0xdd, 0x2d, 0xca, 0x64, 0x5d, 0xb5, 0xb7, 0xef,
-		0xf9, 0x2c, 0xe2, 0xc8, 0xce, 0xe8, 0x02, 0x74, 0xe8, 0x75, 0x09, 0xce, 0x46, 0x50, 0xf1, 0x6c,
-		0x02, 0x08, 0xa6, 0x6b, 0xe9, 0x98, 0x78, 0xe6, 0x20, 0xeb, 0xa9, 0x54, 0x0c, 0x29, 0x25, 0x18,
-		0x41, 0x59, 0x50, 0x4a, 0x09, 0xfa, 0x1f, 0x92, 0x12, 0x57, 0xf5, 0xdf, 0x88, 0xa1, 0x4a, 0xd0,
-		0xac, 0x51, 0x82, 0xea, 0x7f, 0x52, 0x51, 0xf1, 0x95, 0xea, 0xb4, 0x9e, 0x4e, 0x86, 0xbe, 0x20,
-		0xc1, 0xd3, 0x61, 0x4c, 0x3c, 0x4b, 0xdc, 0x47, 0x00, 0x5d, 0x4d, 0x05, 0xc4, 0x33, 0xc2, 0x53,
-		0x7a, 0x1a, 0x11, 0xd9, 0x36, 0x55, 0x73, 0x8c, 0x1d, 0xc3, 0xd9, 0x4d, 0x55, 0xee, 0xb1, 0x84,
-		0x6d, 0x5b, 0xa2, 0x4c, 0xd2, 0x94, 0x5b, 0x15, 0xa0, 0x23, 0xca, 0x1d, 0x41, 0xc5, 0x53, 0xee,
-		0xfd, 0x09, 0xca, 0x1d, 0xc2, 0xc4, 0x55, 0x6e, 0x35, 0x95, 0x8a, 0x21, 0xa5, 0x04, 0xe5, 0x1e,
-		0x17, 0x94, 0x52, 0x92, 0x72, 0xab, 0x02, 0x74, 0x44, 0x91, 0xc2, 0xa8, 0x78, 0x8a, 0x34, 0x91,
-		0xa0, 0x48, 0x41, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a,
-		0x4a, 0xb0, 0xb4, 0x20, 0x9e, 0x04, 0x4b, 0x53, 0xd3, 0xc9, 0x50, 0x07, 0x4e, 0xb8, 0x20, 0x2c,
-		0xbe, 0xf6, 0x1c, 0x20, 0x40, 0x2e, 0x32, 0x81, 0xb8, 0x5c, 0x2d, 0xae, 0xda, 0x1c, 0x75, 0xf8,
-		0xaf, 0xd1, 0xab, 0x70, 0xcc, 0xfb, 0xf0, 0xa6, 0x61, 0xb1, 0x3e, 0x7b, 0x90, 0x7c, 0xb6, 0xc2,
-		0xff, 0xec, 0xaa, 0x3b, 0x2f, 0xfe, 0xd1, 0x69, 0x87, 0xf7, 0x12, 0x7d, 0x47, 0x82, 0x85, 0x88,
-		0x8a, 0xaa, 0x4d, 0x0d, 0xd7, 0x6b, 0x16, 0x7e, 0xb5, 0x8d, 0x6d, 0xe6, 0xea, 0x0f, 0x11, 0x18,
-		0x2f, 0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0xf8, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x43,
-		0x09, 0xae, 0x50, 0x4c, 0x3e, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7,
-		0xbc, 0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xca, 0x34, 0x03, 0x7d, 0x49, 0x82, 0x73, 0x2c, 0xf1, 0xb2,
-		0x80, 0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0x33, 0x70, 0xd2,
-		0x53, 0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x3c, 0x05, 0xe6, 0x7d,
-		0xfb, 0xf3, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c,
-		0x04, 0x1e, 0x73, 0x4f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc2, 0x4c, 0x43,
-		0xb5, 0xb6, 0xb1, 0x55, 0xb3, 0xb0, 0x66, 0x5a, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09,
-		0xe2, 0xff, 0xc9, 0x64, 0x85, 0xce, 0x8d, 0x23, 0x38, 0xde, 0x48, 0x22, 0x40, 0xdf, 0x90, 0x60,
-		0x9e, 0x75, 0x3f, 0x31, 0x1e, 0x36, 0x55, 0xa6, 0x40, 0x8e, 0x66, 0x09, 0x5f, 0xef, 0x51, 0x36,
-		0x22, 0xe1, 0x2b, 0x87, 0x16, 0x7d, 0x5b, 0x82, 0x0a, 0x2b, 0xc2, 0xc6, 0x56, 0xc3, 0x68, 0xaa,
-		0x4c, 0xbf, 0x70, 0x2c, 0xc1, 0x2f, 0xc4, 0x43, 0xec, 0x2e, 0x23, 0x86, 0x5f, 0xe8, 0x08, 0x53,
-		0xa3, 0x1f, 0x49, 0x70, 0x85, 0x75, 0x95, 0x4a, 0xf5, 0x62, 0xc7, 0x09, 0xda, 0x5b, 0x82, 0x37,
-		0xaa, 0x34, 0x57, 0xb6, 0xd0, 0xc9, 0x36, 0x85, 0xa7, 0x01, 0x7c, 0xa3, 0x3c, 0x91, 0x45, 0x03,
-		0xf8, 0x06, 0x3a, 0xdb, 0x11, 0xa4, 0x45, 0x7f, 0x95, 0x60, 0x25, 0xe2, 0x71, 0xf1, 0x23, 0x07,
-		0x5b, 0x4d, 0xb5, 0x5e, 0x63, 0x20, 0x37, 0x9a, 0x86, 0x63, 0xb0, 0x15, 0xe3, 0x24, 0x81, 0x7e,
-		0x2f, 0xdd, 0x05, 0xaf, 0x50, 0xfe, 0xb1, 0xf5, 0x54, 0x7d, 0xe6, 0xf1, 0x05, 0xbd, 0x60, 0xed,
-		0x89, 0x03, 0xfa, 0x93, 0x04, 0x37, 0x33, 0x2c, 0x93, 0xe7, 0xb1, 0x66, 0xc8, 0x1a, 0xef, 0xee,
-		0x61, 0x8d, 0x3c, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7a, 0x4f, 0x82, 0xe7, 0x93, 0x96, 0x93,
-		0x6e, 0x27, 0xa7, 0xc8, 0xc2, 0xd6, 0x98, 0x0b, 0xe3, 0x82, 0x49, 0xb5, 0x97, 0x6b, 0xb8, 0xbf,
-		0xa9, 0x24, 0x0e, 0x60, 0xa6, 0x4e, 0x9a, 0x8e, 0xd1, 0x6c, 0x63, 0xbd, 0xa6, 0xda, 0xb5, 0x26,
-		0xee, 0xc4, 0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0x64, 0xdf, 0xc6, 0x1d,
-		0x46, 0x1c, 0xd0, 0xc9, 0x34, 0x03, 0xfd, 0x52, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46,
-		0x5d, 0xcf, 0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x32, 0xcd,
-		0x62, 0x34, 0x97, 0xed, 0xec, 0xd3, 0xd0, 0x4f, 0x25, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c,
-		0x21, 0x2b, 0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0xb4, 0x33, 0xce, 0x41, 0xdf, 0x93, 0xe0,
-		0x12, 0x17, 0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03,
-		0xff, 0x79, 0x2d, 0x03, 0x3d, 0xfa, 0x81, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13,
-		0x94, 0x9c, 0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0xef, 0x48, 0x70, 0x31, 0xb3,
-		0x66, 0x9c, 0x23, 0x88, 0xff, 0x2f, 0x03, 0x62, 0x9e, 0x52, 0x9c, 0xd7, 0x32, 0xe8, 0xc3, 0xbb,
-		0x12, 0x2c, 0xf2, 0x05, 0xcc, 0x3d, 0x84, 0x67, 0x09, 0xda, 0x9b, 0x59, 0xe4, 0xcb, 0x3d, 0x89,
-		0x2f, 0x68, 0x59, 0x26, 0xa0, 0xef, 0x27, 0xa9, 0x44, 0xc2, 0xa5, 0xf9, 0x99, 0xcc, 0x90, 0xf9,
-		0xd7, 0x67, 0x0e, 0x64, 0xde, 0x45, 0xda, 0x8d, 0xcd, 0xf8, 0x90, 0x13, 0x22, 0xc9, 0xb9, 0x84,
-		0xd8, 0x8c, 0x83, 0x39, 0x21, 0x9c, 0x5c, 0xd0, 0xb2, 0x4d, 0x21, 0x87, 0xa6, 0x17, 0x8a, 0xf7,
-		0x1b, 0xf1, 0x9c, 0x4f, 0x38, 0x34, 0xbd, 0x88, 0xbb, 0x9f, 0x50, 0xe7, 0x9a, 0xdd, 0xdf, 0x54,
-		0xf4, 0x2b, 0x09, 0x9e, 0x13, 0x58, 0x10, 0xcf, 0x46, 0xe7, 0xc9, 0x6a, 0xaa, 0xfd, 0xac, 0x86,
-		0x67, 0xac, 0x57, 0xec, 0x3e, 0xe6, 0xa1, 0x9f, 0x48, 0xf0, 0x6c, 0xd2, 0x02, 0xf8, 0xf7, 0xa7,
-		0x0b, 0x09, 0x07, 0x10, 0x17, 0x04, 0xff, 0x1e, 0x75, 0x11, 0x67, 0x9c, 0x43, 0x1c, 0x4e, 0xbb,
-		0x65, 0x63, 0xcb, 0xe9, 0x01, 0xb7, 0xb1, 0x6a, 0x69, 0x5b, 0x01, 0x98, 0x71, 0xdc, 0x95, 0x04,
-		0xeb, 0xbd, 0x4f, 0xd8, 0xf9, 0x08, 0xee, 0x11, 0x66, 0xbd, 0x2f, 0x32, 0xac, 0xb7, 0x9d, 0x65,
-		0xc2, 0xcd, 0x51, 0x80, 0x1e, 0x10, 0xf9, 0x2f, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe,
-		0xee, 0x1a, 0x9d, 0xdd, 0x16, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0xd1, 0x67, 0xba, 0xbe, 0xdb, 0xc2,
-		0xca, 0x68, 0x27, 0xf0, 0x84, 0x3e, 0x05, 0x87, 0x5a, 0xaa, 0xe5, 0x4a, 0x24, 0x68, 0x74, 0x9b,
-		0x26, 0x2d, 0x1f, 0xce, 0x32, 0xf9, 0xdd, 0x25, 0x33, 0x02, 0x36, 0xb1, 0x69, 0x2a, 0x07, 0x5a,
-		0xf1, 0x41, 0xf4, 0x1c, 0x94, 0x48, 0x46, 0xa6, 0x6e, 0xd8, 0x0e, 0x29, 0x2c, 0x96, 0x17, 0x8f,
-		0xb3, 0x53, 0x1e, 0xaa, 0xbd, 0xbd, 0x66, 0xd8, 0x8e, 0x32, 0xe2, 0xd0, 0xbf, 0xd0, 0x22, 0x0c,
-		0x1b, 0xcd, 0x56, 0xdb, 0x21, 0x65, 0xc7, 0xf2, 0xe2, 0x31, 0x0e, 0x92, 0xdd, 0xba, 0xa9, 0xea,
-		0x8a, 0x47, 0x8a, 0x54, 0x98, 0x89, 0x84, 0x1c, 0x35, 0xc7, 0xac, 0x69, 0x75, 0xd3, 0xc6, 0xc4,
-		0x7f, 0x9b, 0x6d, 0x87, 0xd6, 0x21, 0xa7, 0x63, 0x75, 0xd1, 0x5b, 0xb4, 0x92, 0xac, 0x1c, 0xc3,
-		0x21, 0xd9, 0xaf, 0x9b, 0xcb, 0xee, 0xfc, 0x75, 0x6f, 0x3a, 0x7a, 0x19, 0x8e, 0xf6, 0xd2, 0xde,
-		0x71, 0xee, 0x85, 0x34, 0xee, 0x87, 0x1d, 0x3f, 0x99, 0x1d, 0x61, 0x7c, 0x03, 0x8e, 0xf4, 0x22,
-		0xec, 0xde, 0x2a, 0xac, 0x76, 0xb3, 0x66, 0xe8, 0xa4, 0xf4, 0x57, 0x52, 0x0e, 0x77, 0x29, 0xba,
-		0x72, 0x56, 0xda, 0xcd, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb4, 0x48, 0x1d, 0x6e, 0x6c,
-		0xf1, 0x3c, 0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0xfa, 0x53, 0x94, 0xde, 0x6c, 0x54, 0x85,
-		0x89, 0x1e, 0x0e, 0xd7, 0x5d, 0xb5, 0x2d, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x68, 0x94,
-		0xf1, 0xee, 0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xee, 0x9d, 0xcf, 0x0b, 0x67, 0xc8, 0x72,
-		0xb0, 0xdd, 0xae, 0x3b, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xdd, 0xb9, 0xcb, 0xdd, 0xa9, 0x0a,
-		0x99, 0x89, 0xae, 0xc3, 0xb4, 0x69, 0x19, 0x0f, 0x0d, 0xcf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52,
-		0x9a, 0xf4, 0x09, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x9b, 0x8e, 0xe1, 0xec, 0x92, 0x8a,
-		0x52, 0x49, 0xe9, 0x3e, 0xa3, 0xcb, 0x30, 0xb9, 0x69, 0x58, 0xb6, 0x13, 0xe7, 0xb9, 0x8f, 0x50,
-		0x1e, 0x20, 0x6f, 0x23, 0x0c, 0x97, 0x61, 0xd4, 0xc2, 0x8e, 0xb5, 0x5b, 0x6b, 0x99, 0x75, 0x43,
-		0xdb, 0xa5, 0x55, 0x98, 0x19, 0xce, 0x05, 0xd5, 0xb1, 0x76, 0xef, 0x12, 0x3a, 0xa5, 0x6c, 0xf5,
-		0x1e, 0xd0, 0x14, 0x14, 0x55, 0xc7, 0xc1, 0x8d, 0x96, 0x43, 0x2a, 0x26, 0xc3, 0x8a, 0xff, 0x88,
-		0x96, 0x61, 0x3f, 0x7e, 0xd4, 0x32, 0x3c, 0xc5, 0xf1, 0x8a, 0xfa, 0xe3, 0xa9, 0x45, 0xfd, 0xb1,
-		0xde, 0x14, 0x52, 0xd9, 0x3f, 0x0d, 0xfb, 0x34, 0xcb, 0xb5, 0x06, 0x5a, 0xd1, 0x21, 0x15, 0x87,
-		0x92, 0x32, 0xea, 0x0e, 0xfa, 0x55, 0x1e, 0xf4, 0x0a, 0x1c, 0xf5, 0x56, 0x1f, 0xae, 0x7e, 0x6d,
-		0xa8, 0xda, 0xb6, 0xb9, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0x07, 0x0b, 0x5f,
-		0x37, 0xbd, 0xa9, 0xe8, 0x02, 0x0c, 0x35, 0x70, 0xc3, 0xa4, 0xe9, 0xfc, 0x69, 0x76, 0xa2, 0x0f,
-		0x37, 0x4c, 0x85, 0x90, 0x21, 0x05, 0x26, 0x62, 0x1e, 0x9b, 0xe6, 0xe4, 0x9f, 0x66, 0x9f, 0x8d,
-		0x11, 0x0f, 0xab, 0x8c, 0xdb, 0x91, 0x11, 0x74, 0x1f, 0x26, 0x5b, 0x16, 0xde, 0xa9, 0xa9, 0x6d,
-		0xc7, 0x74, 0xf5, 0x0f, 0x3b, 0xb5, 0x96, 0x69, 0x34, 0x1d, 0x3f, 0xcb, 0xce, 0xdb, 0x2f, 0x1b,
-		0x3b, 0x77, 0x09, 0x9d, 0x72, 0xc0, 0x9d, 0xbf, 0xd4, 0x76, 0xcc, 0xc0, 0x20, 0xba, 0x0c, 0x85,
-		0x2d, 0xac, 0xea, 0xd8, 0xa2, 0xe9, 0xef, 0xa3, 0xec, 0xa6, 0x0e, 0x42, 0xa2, 0x50, 0x52, 0xf9,
-		0x1d, 0x09, 0x9e, 0x11, 0x8f, 0xf6, 0xaf, 0x40, 0x81, 0xda, 0x8b, 0x24, 0x60, 0x2f, 0x94, 0x16,
-		0xad, 0xc2, 0x4c, 0x72, 0xb9, 0xd7, 0xd0, 0x89, 0x77, 0xcf, 0x2b, 0xc7, 0xf8, 0x95, 0xda, 0xaa,
-		0x2e, 0xbf, 0x2d, 0xc1, 0x59, 0xc1, 0xa0, 0xe1, 0x2a, 0x14, 0x7d, 0x4f, 0x21, 0x09, 0x78, 0x0a,
-		0x9f, 0x78, 0x60, 0x50, 0x4d, 0x98, 0x15, 0x8e, 0x98, 0x97, 0x61, 0x94, 0x3a, 0xeb, 0xde, 0xc1,
-		0x39, 0xc6, 0x51, 0x02, 0xea, 0x9b, 0xc9, 0xb9, 0x59, 0x76, 0x7a, 0x0f, 0xf2, 0xef, 0x24, 0x38,
-		0x23, 0xd2, 0x34, 0x10, 0x3e, 0x01, 0xa5, 0x6c, 0x27, 0xe0, 0x6d, 0x98, 0xe4, 0x9c, 0x32, 0xb9,
-		0x34, 0x83, 0x3c, 0x60, 0x33, 0x4e, 0x98, 0x80, 0xa7, 0xc9, 0x87, 0x3c, 0x8d, 0xfc, 0xba, 0x04,
-		0x72, 0x7a, 0xbf, 0x01, 0x9a, 0x07, 0x14, 0xad, 0x41, 0x77, 0xbb, 0x90, 0xc6, 0xed, 0x90, 0x08,
-		0x22, 0xee, 0x36, 0x17, 0x71, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x13, 0x34, 0x25, 0xa5,
-		0x44, 0x47, 0xaa, 0xba, 0xfc, 0xf7, 0x88, 0x78, 0xb9, 0x16, 0x92, 0x0d, 0xd1, 0x2c, 0x8c, 0x87,
-		0xf3, 0x10, 0x5d, 0xf5, 0x1a, 0xb3, 0x03, 0x2b, 0x8e, 0x60, 0xcf, 0x47, 0xb0, 0x9f, 0x83, 0xfd,
-		0x1b, 0x46, 0x53, 0xb5, 0x76, 0x6b, 0xda, 0x16, 0xd6, 0xb6, 0xed, 0x76, 0x83, 0x84, 0x28, 0x25,
-		0x65, 0xcc, 0x1b, 0x5e, 0xa6, 0xa3, 0xe8, 0x3c, 0x4c, 0x84, 0xb3, 0x67, 0xf8, 0x91, 0x17, 0x7e,
-		0x8c, 0x2a, 0xe3, 0x38, 0x98, 0xd4, 0xc2, 0x8f, 0x1c, 0xf9, 0xb5, 0x3c, 0x9c, 0x16, 0x68, 0x65,
-		0x78, 0x6c, 0x2b, 0x8e, 0x9a, 0x45, 0xbe, 0x0f, 0xb3, 0x40, 0x27, 0xa0, 0xbc, 0xa1, 0xda, 0xd8,
-		0x3f, 0x3a, 0x3d, 0xb1, 0x94, 0xdc, 0x21, 0xef, 0xc0, 0x3c, 0x06, 0xd0, 0xc4, 0x1d, 0xff, 0xf5,
-		0xb0, 0x27, 0xd8, 0x26, 0xee, 0x78, 0x6f, 0xe7, 0x01, 0x6d, 0x9a, 0xd6, 0x36, 0x45, 0xea, 0xf7,
-		0xa3, 0x15, 0xbc, 0xa5, 0xb9, 0x6f, 0x08, 0xd6, 0x07, 0xb4, 0x31, 0x6d, 0xd2, 0x75, 0x8e, 0xaa,
-		0x6d, 0x36, 0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0xb6, 0x8d, 0x69, 0x18, 0x54,
-		0x11, 0x6e, 0x1a, 0x59, 0x76, 0x67, 0x29, 0xde, 0x64, 0xf9, 0xed, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4,
-		0x78, 0x6c, 0x9b, 0x71, 0xd3, 0x5f, 0x83, 0xb7, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xc1, 0x15, 0x04,
-		0x7d, 0xf2, 0x50, 0x16, 0x9f, 0x1c, 0x54, 0xfd, 0xe1, 0x88, 0xea, 0x47, 0xf6, 0xb7, 0x90, 0xbc,
-		0xbf, 0x45, 0xa1, 0xfd, 0x1d, 0xe1, 0xec, 0x2f, 0xc3, 0xcc, 0x4a, 0x2c, 0x33, 0x93, 0xbf, 0x5e,
-		0x80, 0x33, 0x22, 0x3d, 0x2e, 0xe8, 0x24, 0x94, 0xbb, 0x85, 0x62, 0xba, 0x4d, 0x25, 0x05, 0xfc,
-		0xa1, 0xaa, 0xee, 0x5e, 0xaa, 0x7a, 0x95, 0x64, 0xd7, 0x08, 0x72, 0x09, 0x97, 0xaa, 0xee, 0x27,
-		0xc9, 0xa5, 0x4a, 0x0d, 0x3c, 0xb9, 0xaa, 0xa9, 0x9b, 0x0d, 0xd5, 0x68, 0x52, 0xdf, 0x41, 0x9f,
-		0xc2, 0x87, 0xc1, 0x50, 0x9f, 0xd7, 0xa1, 0x82, 0xf8, 0x75, 0x68, 0x1d, 0xa6, 0x7d, 0x25, 0x8c,
-		0x9f, 0x21, 0xc5, 0xb4, 0x33, 0x64, 0xd2, 0x9f, 0x1b, 0x39, 0x46, 0x22, 0x5c, 0xe9, 0x11, 0x45,
-		0xb9, 0x8e, 0x64, 0xe0, 0xea, 0xdd, 0x82, 0x28, 0x57, 0xfe, 0x61, 0x57, 0xea, 0xeb, 0xb0, 0x5b,
-		0x85, 0x89, 0x2d, 0xac, 0x5a, 0xce, 0x06, 0x56, 0x7b, 0xe8, 0x20, 0x8d, 0xd5, 0x78, 0x77, 0x4e,
-		0x8f, 0x4f, 0x7a, 0x88, 0x52, 0x4e, 0x0f, 0x51, 0x62, 0x77, 0x85, 0xd1, 0x7e, 0xee, 0x0a, 0xbd,
-		0x98, 0x73, 0x9f, 0x78, 0xcc, 0xf9, 0x37, 0x09, 0xe4, 0xf4, 0x7e, 0xab, 0x0f, 0xed, 0x70, 0x0f,
-		0x86, 0x21, 0x43, 0xe1, 0x0b, 0xcf, 0x8b, 0x30, 0x4a, 0xee, 0x8b, 0xbe, 0xdf, 0x1a, 0x16, 0xf0,
-		0x5b, 0x65, 0x77, 0x06, 0x7d, 0x90, 0xff, 0x20, 0x85, 0x5d, 0xc1, 0x80, 0x23, 0x6b, 0xb6, 0x88,
-		0x72, 0x19, 0xdc, 0x7d, 0x3e, 0x35, 0xda, 0x18, 0x0a, 0x0b, 0x53, 0xfe, 0xbd, 0x04, 0xa7, 0xd2,
-		0x9b, 0x60, 0xfa, 0x0d, 0xc0, 0x3f, 0x8a, 0x15, 0xfd, 0x2c, 0x07, 0xa7, 0x05, 0x5a, 0xc9, 0xdc,
-		0x35, 0xe9, 0xd8, 0x51, 0x8d, 0xba, 0x2d, 0xb4, 0x49, 0x3e, 0xf1, 0x63, 0x5b, 0x53, 0x34, 0x42,
-		0x1a, 0xea, 0x27, 0x42, 0xda, 0xb3, 0x8a, 0x7f, 0x59, 0x82, 0x39, 0xf1, 0x0e, 0x30, 0x91, 0x33,
-		0x6f, 0x30, 0x57, 0xb0, 0x77, 0x25, 0xc8, 0xd8, 0xeb, 0x95, 0x8e, 0xed, 0xa0, 0x1f, 0x06, 0x79,
-		0x1e, 0x86, 0x06, 0x36, 0x22, 0x88, 0xf3, 0x02, 0x88, 0xdf, 0x8a, 0xe8, 0x21, 0xaf, 0x2a, 0xd4,
-		0xaf, 0x1e, 0xae, 0xc2, 0x4c, 0x5d, 0x75, 0x02, 0x3d, 0x0f, 0xd1, 0x0e, 0x80, 0x9e, 0x64, 0x3d,
-		0x3a, 0xd6, 0x56, 0x7a, 0x61, 0x13, 0x43, 0x9f, 0xf3, 0x19, 0xf4, 0x79, 0x28, 0xd5, 0x46, 0x23,
-		0x81, 0x9e, 0xfc, 0x9e, 0x04, 0x47, 0x13, 0xba, 0x2c, 0xd1, 0x34, 0x8c, 0x78, 0xdd, 0x65, 0xdd,
-		0x7d, 0x2b, 0x92, 0xe7, 0xaa, 0x8e, 0xd6, 0xe0, 0x50, 0xf7, 0x20, 0xdf, 0x34, 0xac, 0x0c, 0x97,
-		0x56, 0x44, 0xcf, 0xf1, 0x55, 0xc3, 0xc2, 0x59, 0x8e, 0x5f, 0x91, 0xcd, 0xfe, 0x34, 0x4c, 0x73,
-		0xdb, 0x37, 0x93, 0x56, 0x23, 0x1c, 0xb3, 0xcb, 0xbf, 0x96, 0xe0, 0x58, 0x52, 0xe7, 0xde, 0x40,
-		0xbe, 0x32, 0x28, 0x79, 0x24, 0x3a, 0xe8, 0x1f, 0x4b, 0x30, 0x93, 0xd6, 0x01, 0x98, 0xb4, 0x9a,
-		0xc7, 0x6a, 0xb6, 0x89, 0xc8, 0xff, 0x55, 0x84, 0x8c, 0x8d, 0x26, 0x68, 0x01, 0x0e, 0x92, 0x5e,
-		0x96, 0x68, 0xda, 0xd7, 0x5b, 0xd3, 0x44, 0x13, 0x77, 0x22, 0x49, 0xdf, 0x58, 0xe5, 0x25, 0xd7,
-		0x5f, 0xe5, 0xe5, 0x49, 0x6d, 0x44, 0xbc, 0x36, 0x22, 0xa2, 0x3b, 0x45, 0x01, 0xdd, 0xb9, 0x03,
-		0x93, 0x34, 0xa7, 0x4d, 0x31, 0x1a, 0x4d, 0x07, 0x5b, 0x3b, 0x6a, 0x3d, 0xfd, 0xde, 0x72, 0x90,
-		0x4e, 0x24, 0xf0, 0xaa, 0x74, 0x5a, 0xb8, 0xee, 0x52, 0xda, 0x53, 0xdd, 0x25, 0x10, 0xc2, 0x41,
-		0x96, 0x10, 0x8e, 0x5f, 0x64, 0x29, 0xf7, 0x5d, 0x64, 0xe9, 0xdd, 0x33, 0x46, 0x85, 0xef, 0x19,
-		0xdd, 0x54, 0xff, 0xbe, 0x3d, 0xa4, 0xfa, 0xc7, 0xf6, 0x94, 0xea, 0x77, 0x7d, 0xf0, 0x42, 0xd6,
-		0x6e, 0xb7, 0xae, 0xb7, 0x92, 0x82, 0xde, 0x2a, 0xe9, 0x7e, 0xb3, 0x01, 0x87, 0xbb, 0x15, 0xf2,
-		0x48, 0xd5, 0xd4, 0xb3, 0xe3, 0xb9, 0xc4, 0x1a, 0x78, 0xb8, 0x6e, 0x7a, 0x08, 0xb3, 0x86, 0xe5,
-		0xef, 0x4a, 0x8c, 0x94, 0x36, 0xef, 0x64, 0x11, 0x31, 0x0f, 0x49, 0xc0, 0x3c, 0x02, 0x91, 0x4e,
-		0x2e, 0x43, 0xa4, 0x23, 0x7f, 0x20, 0xc1, 0xf1, 0xc4, 0x6e, 0x6d, 0x37, 0xd4, 0xa3, 0xbd, 0xe0,
-		0x4d, 0xb5, 0xe1, 0x8b, 0x1a, 0xbc, 0xa1, 0xdb, 0x6a, 0x03, 0xf7, 0xfb, 0xe9, 0x81, 0x9d, 0x2a,
-		0x3d, 0x8d, 0x1f, 0x12, 0xbf, 0x59, 0x7f, 0x95, 0xb5, 0x49, 0xbc, 0xee, 0x84, 0x93, 0x50, 0xa6,
-		0xfd, 0x21, 0x41, 0x11, 0x78, 0x43, 0x44, 0x04, 0x5d, 0xa7, 0x9e, 0x13, 0x77, 0xea, 0x09, 0x79,
-		0x6a, 0xf9, 0x2b, 0x12, 0xcc, 0x65, 0xe8, 0xc8, 0xe9, 0xe5, 0x53, 0xa5, 0x50, 0x3e, 0xb5, 0xdf,
-		0x9d, 0x49, 0x82, 0xf6, 0x8b, 0x1c, 0xbc, 0xb0, 0xb7, 0xae, 0xe4, 0x81, 0xe9, 0x7c, 0x2f, 0x57,
-		0x97, 0x0b, 0xe5, 0xea, 0xee, 0x03, 0x8a, 0x77, 0xbf, 0x50, 0xfb, 0x3e, 0x2b, 0xd6, 0xe1, 0xaa,
-		0x4c, 0xc4, 0x5a, 0x58, 0xd1, 0x14, 0x14, 0x35, 0xb3, 0xe9, 0x58, 0x66, 0x9d, 0x28, 0xda, 0xa8,
-		0xe2, 0x3f, 0xa2, 0x0a, 0x1c, 0x88, 0x34, 0x72, 0x99, 0xcd, 0xba, 0x17, 0x99, 0x8f, 0x28, 0x13,
-		0xa1, 0xfe, 0xaa, 0x3b, 0xcd, 0xfa, 0xae, 0xfc, 0x66, 0x1e, 0x6e, 0xec, 0xa1, 0xeb, 0x19, 0xdd,
-		0x0f, 0xfa, 0xbd, 0x31, 0xce, 0x6f, 0x0a, 0x84, 0x38, 0x87, 0xd2, 0xce, 0x03, 0xba, 0x4f, 0x72,
-		0x73, 0xa8, 0xec, 0x7d, 0x19, 0xda, 0xeb, 0xbe, 0xcc, 0x03, 0x8a, 0xf6, 0x9a, 0xd1, 0x0a, 0x45,
-		0x5e, 0x19, 0x37, 0x42, 0x4a, 0xe8, 0xa5, 0xb0, 0xfc, 0x5d, 0x2c, 0x84, 0x76, 0x51, 0xfe, 0xa3,
-		0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0xe6, 0x60, 0x90, 0x38, 0x18, 0x3e, 0x5c, 0xc5, 0x95, 0xbf, 0x98,
-		0x87, 0x6b, 0x7d, 0xb6, 0xd5, 0xfd, 0xb7, 0xda, 0x6a, 0xc4, 0x63, 0x0f, 0xf1, 0x3d, 0xf6, 0xb0,
-		0xb8, 0xc7, 0xe6, 0xaa, 0x0e, 0xcf, 0x01, 0x14, 0x79, 0x0e, 0xe0, 0xb5, 0x3c, 0x5c, 0xe9, 0xa7,
-		0x35, 0x50, 0xcc, 0xf2, 0x85, 0x38, 0x3f, 0xb1, 0xfc, 0x9e, 0xe5, 0xbf, 0x2f, 0xc1, 0xc5, 0xac,
-		0x6d, 0x8e, 0xff, 0xd1, 0x26, 0xcf, 0x3f, 0xab, 0xe4, 0xdf, 0x4a, 0x70, 0x21, 0x53, 0x6b, 0xe4,
-		0xc0, 0x5c, 0x00, 0xf3, 0xd6, 0x90, 0xdb, 0xdb, 0xad, 0xe1, 0xcf, 0x23, 0x70, 0xb9, 0x8f, 0xdf,
-		0x78, 0x04, 0xb6, 0x43, 0x0a, 0x6d, 0xc7, 0x49, 0x28, 0x77, 0xb7, 0x83, 0xea, 0x7c, 0x49, 0x01,
-		0x7f, 0x88, 0x95, 0x42, 0xc8, 0x0f, 0x20, 0x85, 0xd0, 0x6f, 0x3d, 0x71, 0x78, 0xb0, 0x29, 0x84,
-		0xc2, 0x63, 0x4d, 0x21, 0x14, 0xfb, 0x4e, 0x21, 0x3c, 0x00, 0xda, 0xa1, 0x4a, 0x39, 0xd2, 0x32,
-		0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa1, 0xcd, 0x95, 0x70, 0xa1, 0xc5, 0xb8, 0x89, 0x56, 0x74, 0x28,
-		0x68, 0x24, 0xa5, 0xb0, 0x3f, 0x17, 0x51, 0x79, 0x10, 0x50, 0x79, 0x0d, 0xa6, 0x02, 0xea, 0x54,
-		0xb3, 0x70, 0xbb, 0x07, 0xbf, 0x4c, 0xe0, 0xcf, 0x25, 0x2a, 0x4e, 0x55, 0x57, 0xdc, 0x29, 0x74,
-		0x09, 0x87, 0x3a, 0xac, 0xe1, 0x58, 0x79, 0x72, 0x5f, 0x3f, 0xe5, 0xc9, 0x58, 0xaf, 0xe1, 0x18,
-		0xa3, 0xd7, 0xb0, 0x77, 0xd3, 0xda, 0x9f, 0x3d, 0xb7, 0x30, 0xbe, 0x87, 0xdc, 0xc2, 0xc4, 0xde,
-		0xda, 0x08, 0x9f, 0x83, 0xb2, 0x8e, 0xeb, 0xea, 0xae, 0xa7, 0x9a, 0xe9, 0x3d, 0x91, 0x40, 0xa8,
-		0x89, 0x2a, 0xca, 0x6f, 0xe4, 0xe1, 0x62, 0xd6, 0xdf, 0x60, 0x7d, 0xf4, 0xee, 0x65, 0xcd, 0x8f,
-		0x13, 0xbc, 0x4a, 0xd7, 0xd5, 0xcc, 0x3f, 0x20, 0x0a, 0x85, 0x07, 0x01, 0x43, 0x19, 0x0e, 0x1b,
-		0x0a, 0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe4, 0x60, 0x3e, 0xcb,
-		0x0f, 0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9,
-		0x0e, 0x71, 0xa4, 0xdb, 0xb3, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0x07, 0x39, 0xc8, 0xf8, 0xd3, 0xb7,
-		0x8f, 0x87, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xd7, 0x8f, 0x50, 0x10, 0xef, 0x47,
-		0x90, 0xff, 0x91, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x53, 0xa1, 0x07, 0x32, 0xee, 0x85, 0x0c,
-		0x19, 0x77, 0xf9, 0x9f, 0x39, 0xb8, 0x90, 0xe9, 0x97, 0x88, 0x4f, 0x04, 0x1f, 0x13, 0xbc, 0x9f,
-		0x52, 0x2c, 0x64, 0xc9, 0x33, 0x7f, 0x2e, 0xcf, 0x13, 0x3c, 0xaf, 0x87, 0xe4, 0x89, 0xe0, 0x13,
-		0x5b, 0x58, 0x0a, 0xfd, 0xf4, 0xbe, 0xff, 0x3c, 0x07, 0x0b, 0x19, 0x7f, 0x21, 0xfa, 0x64, 0x1f,
-		0x42, 0xfb, 0x30, 0xe7, 0xc0, 0x7e, 0xf2, 0xe7, 0xaa, 0x51, 0x77, 0xb0, 0x45, 0x3e, 0x75, 0x1c,
-		0xa6, 0x57, 0x1e, 0xac, 0xdc, 0x5e, 0xaf, 0xad, 0x56, 0xd7, 0xd6, 0x57, 0x94, 0xda, 0xfa, 0x27,
-		0xee, 0xae, 0xd4, 0xaa, 0xb7, 0x1f, 0x2c, 0xad, 0x55, 0x6f, 0x8d, 0x3f, 0x85, 0x4e, 0xc2, 0xd1,
-		0xf8, 0xeb, 0xa5, 0xb5, 0xb5, 0x1a, 0x19, 0x1d, 0x97, 0xd0, 0x29, 0x38, 0x1e, 0x27, 0x58, 0x5e,
-		0xbb, 0x73, 0x6f, 0x85, 0x92, 0xe4, 0x6e, 0xbe, 0x02, 0x87, 0x35, 0xb3, 0xc1, 0x92, 0xc1, 0xcd,
-		0x91, 0xa5, 0x96, 0x71, 0xd7, 0x0d, 0x61, 0xef, 0x4a, 0x9f, 0xbc, 0xf4, 0xd0, 0x70, 0xb6, 0xda,
-		0x1b, 0x15, 0xcd, 0x6c, 0x2c, 0x04, 0xff, 0xcf, 0xe9, 0x05, 0x43, 0xaf, 0x2f, 0x3c, 0x34, 0xbd,
-		0xff, 0xad, 0x4a, 0xff, 0xe9, 0xe9, 0x0d, 0xb5, 0x65, 0xec, 0x5c, 0xda, 0x28, 0x90, 0xb1, 0xcb,
-		0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x63, 0xa5, 0x41, 0x73, 0xd7, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4f, 0x6c, 0x1c, 0x49,
+		0xd5, 0xdf, 0x9e, 0xb1, 0xc7, 0x9e, 0x37, 0xb6, 0x63, 0x97, 0x13, 0xc7, 0x4e, 0x9c, 0xc4, 0xe9,
+		0xfc, 0x73, 0x1c, 0x7b, 0x9c, 0x38, 0xd9, 0xe4, 0xcb, 0x66, 0xff, 0x7c, 0x8e, 0x63, 0x2b, 0x23,
+		0xf9, 0x4b, 0xac, 0x8e, 0x93, 0xdd, 0x6f, 0xf5, 0xe9, 0x1b, 0xda, 0xdd, 0xe5, 0xb8, 0xe5, 0x99,
+		0xe9, 0xd9, 0xee, 0x1e, 0x4f, 0x8c, 0xc4, 0x89, 0xc3, 0x4a, 0x68, 0x57, 0xb0, 0x5a, 0x21, 0xb1,
+		0x62, 0x11, 0x08, 0x04, 0xda, 0x45, 0x48, 0x8b, 0x40, 0x08, 0x10, 0x1c, 0x00, 0x09, 0x81, 0x04,
+		0x5a, 0x38, 0x71, 0xe1, 0xc0, 0x85, 0x03, 0xdc, 0x90, 0x60, 0xb9, 0x81, 0x50, 0x57, 0x57, 0xcf,
+		0xf4, 0x74, 0x57, 0x75, 0x57, 0x8f, 0x9d, 0x5d, 0xd0, 0xe6, 0xe6, 0xae, 0x79, 0xf5, 0xfa, 0x57,
+		0xaf, 0xde, 0x7b, 0xf5, 0x5e, 0xbd, 0xd7, 0x86, 0x93, 0x8d, 0x0d, 0x6c, 0xcd, 0x6b, 0xaa, 0x8e,
+		0x6b, 0x1a, 0x9e, 0x57, 0xeb, 0xc6, 0xfc, 0xce, 0xa5, 0xf9, 0x2d, 0xc3, 0x76, 0x4c, 0x6b, 0xb7,
+		0x58, 0xb7, 0x4c, 0xc7, 0x44, 0xa3, 0x2e, 0x49, 0x91, 0x92, 0x14, 0xd5, 0xba, 0x51, 0xdc, 0xb9,
+		0x74, 0xe4, 0xf8, 0x43, 0xd3, 0x7c, 0x58, 0xc1, 0xf3, 0x84, 0x64, 0xa3, 0xb1, 0x39, 0xaf, 0x37,
+		0x2c, 0xd5, 0x31, 0xcc, 0x9a, 0x37, 0xe9, 0xc8, 0x89, 0xf0, 0xef, 0x8e, 0x51, 0xc5, 0xb6, 0xa3,
+		0x56, 0xeb, 0x94, 0x60, 0x8a, 0xf5, 0x62, 0xcd, 0xac, 0x56, 0x5b, 0x2c, 0x64, 0x16, 0x85, 0xa3,
+		0xda, 0xdb, 0x15, 0xc3, 0x76, 0xe2, 0x68, 0x9a, 0xa6, 0xb5, 0xbd, 0x59, 0x31, 0x9b, 0x1e, 0x8d,
+		0x7c, 0x0b, 0xfa, 0x6e, 0x7b, 0x0b, 0x42, 0xd7, 0x21, 0x87, 0x77, 0x70, 0xcd, 0xb1, 0xc7, 0xa5,
+		0xa9, 0xec, 0x74, 0x61, 0xe1, 0x64, 0x91, 0xb1, 0xb6, 0x22, 0xa5, 0x5e, 0x76, 0x29, 0x15, 0x3a,
+		0x41, 0xfe, 0xeb, 0xb3, 0x30, 0x10, 0xfc, 0x01, 0x4d, 0x40, 0x3f, 0xf9, 0xa9, 0x6c, 0xe8, 0xe3,
+		0xd2, 0x94, 0x34, 0x9d, 0x55, 0xfa, 0xc8, 0x73, 0x49, 0x47, 0xd7, 0x01, 0xbc, 0x9f, 0xdc, 0x45,
+		0x8f, 0x67, 0xa6, 0xa4, 0xe9, 0xc2, 0xc2, 0x91, 0xa2, 0x27, 0x91, 0xa2, 0x2f, 0x91, 0xe2, 0xba,
+		0x2f, 0x11, 0x25, 0x4f, 0xa8, 0xdd, 0x67, 0x34, 0x0e, 0x7d, 0x3b, 0xd8, 0xb2, 0x0d, 0xb3, 0x36,
+		0x9e, 0xf5, 0x98, 0xd2, 0x47, 0x74, 0x18, 0xfa, 0xdc, 0xc5, 0xbb, 0xaf, 0xeb, 0x21, 0xbf, 0xe4,
+		0xdc, 0xc7, 0x92, 0x8e, 0xde, 0x96, 0xe0, 0x82, 0xbf, 0xe4, 0x32, 0x7e, 0x84, 0xb5, 0x86, 0xbb,
+		0x0f, 0x65, 0xdb, 0x51, 0x2d, 0x07, 0xeb, 0x65, 0x0f, 0x89, 0xea, 0x38, 0x96, 0xb1, 0xd1, 0x70,
+		0xb0, 0x3d, 0xde, 0x4b, 0xf0, 0x3c, 0xcb, 0x5c, 0xfa, 0x8b, 0x94, 0xcf, 0xb2, 0xcf, 0xe6, 0x9e,
+		0xc7, 0x85, 0x2c, 0x79, 0xb1, 0xc5, 0xe3, 0xf6, 0x53, 0xca, 0xb9, 0xa6, 0x18, 0x29, 0xfa, 0xaa,
+		0x04, 0x73, 0x0c, 0x78, 0x9a, 0x59, 0xad, 0x57, 0x30, 0x13, 0x60, 0x8e, 0x00, 0x7c, 0x5e, 0x0c,
+		0xe0, 0x92, 0xcf, 0x27, 0x0a, 0xf1, 0x7c, 0x53, 0x94, 0x18, 0xbd, 0x25, 0xc1, 0x0c, 0x03, 0xe4,
+		0xa6, 0x6a, 0x54, 0x58, 0x08, 0xfb, 0x08, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x08, 0x93, 0x28, 0xbc,
+		0xb3, 0x4d, 0x21, 0x4a, 0xf4, 0x15, 0xb6, 0x00, 0x5d, 0xdd, 0xd2, 0xcb, 0x66, 0xc3, 0x89, 0xc2,
+		0xeb, 0x27, 0xf0, 0x9e, 0x13, 0x83, 0xe7, 0xaa, 0x9d, 0x7e, 0xb7, 0xe1, 0x44, 0x01, 0x4e, 0x37,
+		0x05, 0x69, 0xd1, 0x9b, 0x12, 0x4c, 0xeb, 0x58, 0x33, 0x6c, 0x02, 0xcc, 0xd5, 0x52, 0x5b, 0xdb,
+		0xc2, 0x7a, 0x83, 0x29, 0xbc, 0x3c, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0xda,
+		0xdb, 0xf7, 0x7c, 0x16, 0x51, 0x64, 0xa7, 0x75, 0x01, 0x3a, 0xf4, 0xba, 0x04, 0x67, 0x43, 0xa8,
+		0x78, 0x36, 0x01, 0x04, 0xd3, 0xb5, 0x64, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x44, 0x2a, 0x86, 0x94,
+		0x62, 0x8c, 0xa0, 0x20, 0x28, 0xa5, 0x18, 0xfd, 0x3f, 0xad, 0x0b, 0xd0, 0xa1, 0x37, 0x22, 0xa8,
+		0x62, 0x34, 0x6b, 0x80, 0xa0, 0xfa, 0xaf, 0x44, 0x54, 0x7c, 0xa5, 0x3a, 0xa5, 0x27, 0x93, 0xa1,
+		0xcf, 0x48, 0x70, 0xa6, 0x13, 0x13, 0xcf, 0x12, 0x07, 0x09, 0xa0, 0xab, 0x89, 0x80, 0x78, 0x46,
+		0x78, 0x52, 0x4f, 0x22, 0x22, 0xdb, 0xa6, 0x6a, 0x8e, 0xb1, 0x63, 0x38, 0xbb, 0x89, 0xca, 0x3d,
+		0x14, 0xb3, 0x6d, 0x8b, 0x94, 0x49, 0x92, 0x72, 0xab, 0x02, 0x74, 0x44, 0xb9, 0x43, 0xa8, 0x78,
+		0xca, 0x7d, 0x20, 0x46, 0xb9, 0x3b, 0x30, 0x71, 0x95, 0x5b, 0x4d, 0xa4, 0x62, 0x48, 0x29, 0x46,
+		0xb9, 0x87, 0x05, 0xa5, 0x14, 0xa7, 0xdc, 0xaa, 0x00, 0x1d, 0x51, 0xa4, 0x4e, 0x54, 0x3c, 0x45,
+		0x1a, 0x89, 0x51, 0xa4, 0x20, 0x24, 0xae, 0x22, 0xa9, 0x49, 0x44, 0xc4, 0xd2, 0x3a, 0xc1, 0xc4,
+		0x58, 0x1a, 0x8a, 0xb1, 0xb4, 0x20, 0x9e, 0x18, 0x4b, 0x53, 0x93, 0xc9, 0x50, 0x13, 0x8e, 0xbb,
+		0x20, 0x2c, 0xbe, 0xf6, 0x8c, 0x12, 0x20, 0x17, 0x99, 0x40, 0x5c, 0xae, 0x16, 0x57, 0x6d, 0x8e,
+		0x3a, 0xfc, 0x9f, 0xd1, 0x2b, 0x30, 0xe9, 0xbd, 0x78, 0xd3, 0xb0, 0x58, 0xaf, 0x3d, 0x48, 0x5e,
+		0x5b, 0xe4, 0xbf, 0x76, 0xc5, 0xb0, 0x22, 0x5c, 0x6f, 0x3f, 0xa5, 0x4c, 0x38, 0xbc, 0x1f, 0xd1,
+		0x37, 0x24, 0x98, 0x0f, 0xa9, 0xa8, 0x5a, 0xd3, 0x70, 0xa5, 0x6c, 0xe1, 0x57, 0x1a, 0xd8, 0x66,
+		0xae, 0xfe, 0x10, 0x81, 0xf1, 0x42, 0xb2, 0xa6, 0x12, 0x4e, 0x8a, 0xcf, 0x28, 0x8a, 0x6b, 0x46,
+		0x15, 0xa6, 0x46, 0xdf, 0x95, 0xe0, 0x0a, 0xc5, 0xe4, 0x43, 0x14, 0x53, 0xe2, 0x31, 0x82, 0x76,
+		0x89, 0x89, 0x96, 0xbe, 0xcd, 0x7b, 0xb5, 0x88, 0x46, 0x17, 0xad, 0x54, 0x33, 0xd0, 0xe7, 0x24,
+		0x38, 0xc7, 0x12, 0x2f, 0x0b, 0xe8, 0x61, 0x41, 0xed, 0x5e, 0xa2, 0x1c, 0x12, 0xb4, 0x9b, 0x43,
+		0x86, 0x3e, 0x09, 0x27, 0x3c, 0x25, 0xe3, 0x23, 0x19, 0x27, 0x48, 0x2e, 0xf1, 0xf5, 0x8c, 0x0f,
+		0x61, 0xd2, 0x89, 0xf9, 0x1d, 0xbd, 0x2a, 0xc1, 0x69, 0xba, 0x79, 0x54, 0xd1, 0x39, 0x9b, 0x36,
+		0x41, 0x10, 0x3c, 0xcd, 0x44, 0xe0, 0x31, 0xf7, 0xf4, 0x9d, 0xb3, 0x4d, 0x53, 0x5a, 0x02, 0x0d,
+		0xfa, 0x14, 0x4c, 0x55, 0x55, 0x6b, 0x1b, 0x5b, 0x65, 0x0b, 0x6b, 0xa6, 0xa5, 0xb3, 0x40, 0x1c,
+		0x21, 0x20, 0x16, 0x98, 0x20, 0xfe, 0x87, 0x4c, 0x56, 0xe8, 0xdc, 0x28, 0x82, 0x63, 0xd5, 0x38,
+		0x02, 0xf4, 0x65, 0x09, 0x66, 0x59, 0xf9, 0x89, 0xf1, 0xb0, 0xa6, 0x32, 0x05, 0x72, 0x34, 0x4d,
+		0xf8, 0x7a, 0x8f, 0xb2, 0x11, 0x09, 0x5f, 0x39, 0xb4, 0xe8, 0xeb, 0x12, 0x14, 0x19, 0x08, 0x1d,
+		0x6c, 0x55, 0x8d, 0x9a, 0xca, 0xf4, 0x0b, 0x93, 0x31, 0x7e, 0x21, 0x1a, 0x62, 0xb7, 0x18, 0x31,
+		0xfc, 0x42, 0x53, 0x98, 0x1a, 0x7d, 0x4f, 0x82, 0x2b, 0xac, 0x54, 0x2a, 0xd1, 0x8b, 0x1d, 0x23,
+		0x68, 0x6f, 0x09, 0x66, 0x54, 0x49, 0xae, 0x6c, 0xbe, 0x99, 0x6e, 0x0a, 0x4f, 0x03, 0xf8, 0x46,
+		0x79, 0x3c, 0x8d, 0x06, 0xf0, 0x0d, 0x74, 0xba, 0x29, 0x48, 0x8b, 0xfe, 0x28, 0xc1, 0x72, 0xc8,
+		0xe3, 0xe2, 0x47, 0x0e, 0xb6, 0x6a, 0x6a, 0xa5, 0xcc, 0x40, 0x6e, 0xd4, 0x0c, 0xc7, 0x60, 0x2b,
+		0xc6, 0x09, 0x02, 0xfd, 0x5e, 0xb2, 0x0b, 0x5e, 0xa6, 0xfc, 0x23, 0xeb, 0x29, 0xf9, 0xcc, 0xa3,
+		0x0b, 0x7a, 0xde, 0xda, 0x13, 0x07, 0xf4, 0x7b, 0x09, 0x6e, 0xa6, 0x58, 0x26, 0xcf, 0x63, 0x4d,
+		0x91, 0x35, 0xae, 0xed, 0x61, 0x8d, 0x3c, 0x67, 0x76, 0xc3, 0xea, 0x7e, 0x3a, 0x7a, 0x5f, 0x82,
+		0xe7, 0xe2, 0x96, 0x93, 0x6c, 0x27, 0x27, 0xc9, 0xc2, 0x56, 0x99, 0x0b, 0xe3, 0x82, 0x49, 0xb4,
+		0x97, 0x6b, 0xb8, 0xbb, 0xa9, 0x24, 0x0e, 0x60, 0xad, 0xc3, 0xac, 0x39, 0x46, 0xad, 0x81, 0xf5,
+		0xb2, 0x6a, 0x97, 0x6b, 0xb8, 0x19, 0x5d, 0x87, 0x1c, 0x13, 0x07, 0x44, 0x41, 0xf8, 0xec, 0x16,
+		0xed, 0x3b, 0xb8, 0x19, 0x85, 0x5f, 0x6c, 0xa6, 0x9a, 0x81, 0x7e, 0x26, 0xc1, 0x75, 0x12, 0x4d,
+		0x96, 0xb5, 0x2d, 0xa3, 0xa2, 0xa7, 0xb4, 0x9f, 0x53, 0x04, 0xfa, 0x6d, 0x26, 0x74, 0x12, 0x4a,
+		0x2e, 0xb9, 0x4c, 0xd3, 0x18, 0xcd, 0x65, 0x3b, 0xfd, 0x34, 0xf4, 0x43, 0x09, 0xae, 0x26, 0x2c,
+		0x82, 0x67, 0x1d, 0xa7, 0xc9, 0x0a, 0x96, 0xd3, 0xae, 0x80, 0x67, 0x12, 0x17, 0xed, 0x94, 0x73,
+		0xd0, 0xb7, 0x24, 0xb8, 0xc4, 0x45, 0xcd, 0x8d, 0xf3, 0xcf, 0x10, 0xd8, 0x8b, 0xec, 0x30, 0x84,
+		0xf9, 0x76, 0x6e, 0xe0, 0x3f, 0xab, 0xa5, 0xa0, 0x47, 0xdf, 0x91, 0xe0, 0x32, 0x17, 0x6e, 0x4c,
+		0x12, 0x79, 0x36, 0x46, 0xc9, 0xd9, 0x80, 0x63, 0xd2, 0xc9, 0xa2, 0x96, 0x6a, 0x06, 0x7a, 0x57,
+		0x82, 0x8b, 0xa9, 0x35, 0xe3, 0x1c, 0x41, 0xfc, 0xdf, 0x29, 0x10, 0xf3, 0x94, 0xe2, 0x82, 0x96,
+		0x42, 0x1f, 0xde, 0x93, 0x60, 0x81, 0x2f, 0x60, 0xee, 0x21, 0x3c, 0x4d, 0xd0, 0xde, 0x4c, 0x23,
+		0x5f, 0xee, 0x49, 0x3c, 0xa7, 0xa5, 0x99, 0x80, 0xbe, 0x1d, 0xa7, 0x12, 0x31, 0x49, 0xf3, 0xf9,
+		0xd4, 0x90, 0xf9, 0xe9, 0xf3, 0x9c, 0x96, 0x66, 0x02, 0x89, 0xcd, 0xf8, 0x90, 0x63, 0x22, 0xc9,
+		0x99, 0x98, 0xd8, 0x8c, 0x83, 0x39, 0x26, 0x9c, 0x9c, 0xd7, 0xd2, 0x4d, 0x21, 0x87, 0xa6, 0x17,
+		0x8a, 0x77, 0x1b, 0xf1, 0x5c, 0x88, 0x39, 0x34, 0xbd, 0x88, 0xbb, 0x9b, 0x50, 0xe7, 0x9a, 0xdd,
+		0xdd, 0x54, 0xf4, 0x73, 0x09, 0x9e, 0x11, 0x58, 0x10, 0xcf, 0x46, 0x67, 0xc9, 0x6a, 0x4a, 0xdd,
+		0xac, 0x86, 0x67, 0xac, 0x57, 0xec, 0x2e, 0xe6, 0xa1, 0x1f, 0x48, 0xf0, 0x74, 0xdc, 0x02, 0xf8,
+		0xf9, 0xd3, 0x5c, 0xcc, 0x01, 0xc4, 0x05, 0xc1, 0xcf, 0xa3, 0x2e, 0xe2, 0x94, 0x73, 0x88, 0xc3,
+		0x69, 0xd4, 0x6d, 0x6c, 0x39, 0x6d, 0xe0, 0x36, 0x56, 0x2d, 0x6d, 0x2b, 0x00, 0x33, 0x8a, 0xbb,
+		0x18, 0x63, 0xbd, 0xf7, 0x09, 0x3b, 0x1f, 0xc1, 0x3d, 0xc2, 0xac, 0xfd, 0x46, 0x86, 0xf5, 0x36,
+		0xd2, 0x4c, 0xe0, 0xd5, 0x7f, 0xea, 0x6a, 0xc3, 0x66, 0x49, 0x78, 0x3e, 0x4d, 0xfd, 0x67, 0x8d,
+		0x30, 0x11, 0xa9, 0xff, 0x30, 0x29, 0x79, 0xd9, 0x53, 0xa3, 0xc6, 0x43, 0x77, 0x31, 0x4d, 0xf6,
+		0x74, 0xbf, 0x56, 0x67, 0xbd, 0x95, 0x99, 0x3d, 0x71, 0x68, 0xd1, 0xd7, 0x24, 0x28, 0x76, 0xde,
+		0xfc, 0x98, 0x75, 0x97, 0xde, 0x2e, 0x37, 0xea, 0x3a, 0xdb, 0x69, 0x5c, 0x8a, 0xa9, 0xf1, 0x05,
+		0x2f, 0x80, 0xee, 0x7a, 0x8c, 0xee, 0x7b, 0x7c, 0x18, 0x35, 0x3e, 0x55, 0x94, 0x98, 0x81, 0xd2,
+		0xc2, 0x8e, 0xb5, 0x1b, 0x97, 0x0f, 0x2c, 0x08, 0xa2, 0x54, 0x5c, 0x46, 0x31, 0x19, 0xc0, 0x79,
+		0x55, 0x94, 0xf8, 0xe6, 0x00, 0x40, 0x1b, 0x80, 0xfc, 0xe3, 0x01, 0x38, 0x27, 0x1a, 0x47, 0xad,
+		0xc0, 0x60, 0x4b, 0x4d, 0x9c, 0xdd, 0x3a, 0x26, 0x55, 0x69, 0x5e, 0x8d, 0xdb, 0x67, 0xba, 0xbe,
+		0x5b, 0xc7, 0xca, 0x40, 0x33, 0xf0, 0x84, 0xfe, 0x0f, 0x0e, 0xd5, 0x55, 0xcb, 0x95, 0x44, 0xd0,
+		0xfd, 0x6f, 0x9a, 0xb4, 0x90, 0x3d, 0xcd, 0xe4, 0xb7, 0x46, 0x66, 0x04, 0xbc, 0xf3, 0xa6, 0xa9,
+		0x8c, 0xd6, 0xa3, 0x83, 0xe8, 0x19, 0xc8, 0x13, 0xd9, 0x57, 0x0c, 0xdb, 0x21, 0x25, 0xee, 0xc2,
+		0xc2, 0x31, 0xf6, 0xe5, 0x9b, 0x6a, 0x6f, 0xaf, 0x1a, 0xb6, 0xa3, 0xf4, 0x3b, 0xf4, 0x2f, 0xb4,
+		0x00, 0xbd, 0x46, 0xad, 0xde, 0x70, 0x48, 0x01, 0xbc, 0xb0, 0x30, 0xc9, 0x41, 0xb2, 0x5b, 0x31,
+		0x55, 0x5d, 0xf1, 0x48, 0x91, 0x0a, 0x53, 0xa1, 0xe0, 0xb7, 0xec, 0x98, 0x65, 0xad, 0x62, 0xda,
+		0x98, 0x44, 0x12, 0x66, 0xc3, 0xa1, 0x15, 0xf1, 0x89, 0x48, 0x85, 0xfe, 0x16, 0xed, 0x69, 0x50,
+		0x26, 0x71, 0x87, 0xec, 0xd7, 0xcd, 0x25, 0x77, 0xfe, 0xba, 0x37, 0x1d, 0xbd, 0x08, 0x47, 0xdb,
+		0x05, 0x98, 0x28, 0xf7, 0x5c, 0x12, 0xf7, 0xc3, 0x8e, 0x5f, 0x56, 0x09, 0x31, 0xbe, 0x01, 0x47,
+		0xda, 0xb9, 0x5e, 0x7b, 0x15, 0x56, 0xa3, 0xe6, 0x76, 0x01, 0xb8, 0x45, 0xe8, 0xbc, 0x72, 0xb8,
+		0x45, 0xd1, 0x92, 0xb3, 0xd2, 0xa8, 0x95, 0x74, 0x54, 0x82, 0x3c, 0x3d, 0xb4, 0x4d, 0x8b, 0x54,
+		0x84, 0x87, 0x16, 0x2e, 0xb0, 0x83, 0x0c, 0xca, 0x80, 0x24, 0x73, 0x25, 0x7f, 0x8a, 0xd2, 0x9e,
+		0x8d, 0x4a, 0x30, 0xd2, 0xc6, 0xe1, 0x1e, 0x9c, 0x0d, 0x0b, 0x8f, 0xe7, 0x63, 0xf6, 0x60, 0xc5,
+		0xa3, 0x51, 0x86, 0x5b, 0xd3, 0xe8, 0x08, 0x52, 0x60, 0xac, 0xa2, 0xba, 0xb7, 0x0f, 0x5e, 0x60,
+		0x4d, 0x96, 0x83, 0xed, 0x46, 0xc5, 0x19, 0x87, 0x18, 0x7e, 0xfe, 0x9e, 0x1e, 0x74, 0xe7, 0x2e,
+		0xb5, 0xa6, 0x2a, 0x64, 0x26, 0xba, 0x0e, 0x13, 0xa6, 0x65, 0x3c, 0x34, 0xbc, 0x23, 0x3f, 0x24,
+		0xa5, 0x02, 0x91, 0xd2, 0x98, 0x4f, 0x10, 0x12, 0xd2, 0x11, 0xe8, 0x37, 0x74, 0x5c, 0x73, 0x0c,
+		0x67, 0x97, 0xd4, 0x36, 0xf3, 0x4a, 0xeb, 0x19, 0x5d, 0x86, 0xb1, 0x4d, 0xc3, 0xb2, 0x9d, 0x28,
+		0xcf, 0x41, 0x42, 0x39, 0x4a, 0x7e, 0x0d, 0x31, 0x5c, 0x82, 0x01, 0xcf, 0xab, 0xd4, 0xcd, 0x8a,
+		0xa1, 0xed, 0xd2, 0x7a, 0xe0, 0x14, 0xe7, 0xaa, 0xc4, 0xb1, 0x76, 0xd7, 0x08, 0x9d, 0x52, 0xb0,
+		0xda, 0x0f, 0x6e, 0x13, 0x88, 0xea, 0x38, 0xb8, 0x5a, 0x77, 0x48, 0xed, 0xae, 0x57, 0xf1, 0x1f,
+		0xd1, 0x12, 0x1c, 0xc0, 0x8f, 0xea, 0x86, 0xa7, 0x38, 0x5e, 0x7b, 0xc9, 0x70, 0x62, 0x7b, 0xc9,
+		0x50, 0x7b, 0x8a, 0x3b, 0x88, 0x4e, 0xc1, 0xa0, 0x66, 0xb9, 0xd6, 0x40, 0x6b, 0x8b, 0xa4, 0xf6,
+		0x95, 0x57, 0x06, 0xdc, 0x41, 0xbf, 0xde, 0x88, 0x5e, 0x82, 0xa3, 0xde, 0xea, 0x3b, 0xeb, 0xb0,
+		0x1b, 0xaa, 0xb6, 0x6d, 0x6e, 0x6e, 0x8e, 0xa3, 0x24, 0xa5, 0x1e, 0x27, 0xb3, 0x83, 0x25, 0xd8,
+		0x9b, 0xde, 0x54, 0x34, 0x07, 0x3d, 0x55, 0x5c, 0x35, 0x69, 0x61, 0x69, 0x82, 0x7d, 0xe5, 0x8c,
+		0xab, 0xa6, 0x42, 0xc8, 0x90, 0x02, 0x23, 0x91, 0xd8, 0x81, 0x56, 0x87, 0xce, 0xb0, 0xa3, 0xb4,
+		0xd0, 0x59, 0xaf, 0x0c, 0xdb, 0xa1, 0x11, 0x74, 0x1f, 0xc6, 0xea, 0x16, 0xde, 0x29, 0xab, 0x0d,
+		0xc7, 0x74, 0xf5, 0x0f, 0x3b, 0xe5, 0xba, 0x69, 0xd4, 0x1c, 0xbf, 0xde, 0xc3, 0xdb, 0x2f, 0x1b,
+		0x3b, 0x6b, 0x84, 0x4e, 0x19, 0x75, 0xe7, 0x2f, 0x36, 0x1c, 0x33, 0x30, 0x88, 0x2e, 0x43, 0x6e,
+		0x0b, 0xab, 0x3a, 0xb6, 0x68, 0x21, 0xe6, 0x28, 0xbb, 0xbd, 0x88, 0x90, 0x28, 0x94, 0x14, 0xbd,
+		0x0c, 0x07, 0x03, 0xc6, 0xa0, 0xa9, 0x95, 0x8a, 0x2b, 0x63, 0xb7, 0x44, 0xe2, 0x76, 0x28, 0x9d,
+		0xe3, 0x98, 0xac, 0x3f, 0x61, 0x89, 0xd2, 0x2b, 0xa3, 0x5a, 0x64, 0xcc, 0x96, 0xdf, 0x95, 0xe0,
+		0xbc, 0x78, 0x4e, 0x7b, 0x05, 0x72, 0xd4, 0x16, 0x25, 0x01, 0x5b, 0xa4, 0xb4, 0x68, 0x05, 0xa6,
+		0xe2, 0x9b, 0x1a, 0x0c, 0x9d, 0x9c, 0x1c, 0x59, 0x65, 0x92, 0xdf, 0x8f, 0x50, 0xd2, 0xe5, 0x77,
+		0x24, 0x38, 0x2b, 0x18, 0x1a, 0x5f, 0x85, 0x3e, 0xdf, 0x0b, 0x49, 0x02, 0x5e, 0xc8, 0x27, 0xde,
+		0x37, 0xa8, 0x26, 0x4c, 0x0b, 0xe7, 0x85, 0x4b, 0x30, 0x40, 0x0f, 0x82, 0xf6, 0xa1, 0x3c, 0xc4,
+		0x51, 0x30, 0xea, 0xf7, 0xc9, 0x99, 0x5c, 0x70, 0xda, 0x0f, 0xf2, 0xaf, 0x25, 0x38, 0x2d, 0xd2,
+		0x1a, 0xd3, 0x79, 0xba, 0x4a, 0xe9, 0x4e, 0xd7, 0x3b, 0x30, 0xc6, 0x39, 0xc1, 0x32, 0x49, 0xc6,
+		0x3e, 0x6a, 0x33, 0x4e, 0xaf, 0x80, 0x17, 0xcb, 0x76, 0x78, 0x31, 0xf9, 0x75, 0x09, 0xe4, 0xe4,
+		0xae, 0x1a, 0x34, 0x0b, 0x28, 0xdc, 0x69, 0xd1, 0xea, 0xb5, 0x1b, 0xb6, 0x3b, 0x44, 0x10, 0x72,
+		0xe5, 0x99, 0x90, 0x2b, 0x3f, 0x06, 0xe0, 0x5f, 0x7b, 0x1b, 0x3a, 0x41, 0x93, 0x57, 0xf2, 0x74,
+		0xa4, 0xa4, 0xcb, 0x7f, 0x09, 0x89, 0x97, 0x6b, 0x21, 0xe9, 0x10, 0x4d, 0xc3, 0x70, 0xe7, 0x6d,
+		0x5b, 0x4b, 0xbd, 0x86, 0xec, 0xc0, 0x8a, 0x43, 0xd8, 0xb3, 0x21, 0xec, 0xe7, 0xe0, 0xc0, 0x86,
+		0x51, 0x53, 0xad, 0xdd, 0xb2, 0xb6, 0x85, 0xb5, 0x6d, 0xbb, 0x51, 0x25, 0xe1, 0x4f, 0x5e, 0x19,
+		0xf2, 0x86, 0x97, 0xe8, 0x28, 0xba, 0x00, 0x23, 0x9d, 0x77, 0xc4, 0xf8, 0x91, 0x17, 0xda, 0x0c,
+		0x28, 0xc3, 0x38, 0x78, 0x75, 0x8b, 0x1f, 0x39, 0xf2, 0x6b, 0x59, 0x38, 0x25, 0xd0, 0xb0, 0xf3,
+		0xd8, 0x56, 0x1c, 0x36, 0x8b, 0x6c, 0x17, 0x66, 0x81, 0x8e, 0x43, 0x61, 0x43, 0xb5, 0xb1, 0x7f,
+		0x2c, 0x7b, 0x62, 0xc9, 0xbb, 0x43, 0xde, 0x61, 0x3c, 0x09, 0xe0, 0x5e, 0x8f, 0xd3, 0x9f, 0x7b,
+		0x3d, 0xc1, 0xd6, 0x70, 0xd3, 0xfb, 0x75, 0x16, 0xd0, 0xa6, 0x69, 0x6d, 0x53, 0xa4, 0x7e, 0xd7,
+		0x65, 0xce, 0x5b, 0x9a, 0xfb, 0x0b, 0xc1, 0xfa, 0xc0, 0x1b, 0x47, 0x63, 0xae, 0x73, 0x54, 0x6d,
+		0xb3, 0x46, 0xe3, 0x2e, 0xfa, 0x84, 0x6e, 0x41, 0xaf, 0xe6, 0x26, 0x45, 0x34, 0xc4, 0x2a, 0x0a,
+		0xb7, 0x46, 0x2d, 0xb9, 0xb3, 0x14, 0x6f, 0xb2, 0xfc, 0x4e, 0x16, 0x4e, 0x26, 0xb6, 0x2b, 0x3d,
+		0xb6, 0xcd, 0xb8, 0xe9, 0xaf, 0xc1, 0xdb, 0x85, 0x59, 0xc1, 0x6e, 0xaa, 0xe0, 0x0a, 0x82, 0x3e,
+		0xb9, 0x27, 0x8d, 0x4f, 0x0e, 0xaa, 0x7e, 0x6f, 0x48, 0xf5, 0x43, 0xfb, 0x9b, 0x8b, 0xdf, 0xdf,
+		0x3e, 0xa1, 0xfd, 0xed, 0xe7, 0xec, 0x2f, 0xc3, 0xcc, 0xf2, 0x2c, 0x33, 0x93, 0xbf, 0x94, 0x83,
+		0xd3, 0x22, 0x9d, 0x5c, 0xe8, 0x04, 0x14, 0x5a, 0xe9, 0x26, 0xdd, 0xa6, 0xbc, 0x02, 0xfe, 0x50,
+		0x49, 0x77, 0x13, 0xb6, 0x16, 0x01, 0x31, 0x82, 0x4c, 0x4c, 0xc2, 0xd6, 0x7a, 0x25, 0x49, 0xd8,
+		0xd4, 0xc0, 0x93, 0xab, 0x9a, 0xba, 0x59, 0x55, 0x8d, 0x1a, 0xf5, 0x1d, 0xf4, 0xa9, 0xf3, 0x30,
+		0xe8, 0xe9, 0x32, 0xd5, 0xca, 0x89, 0xa7, 0x5a, 0xeb, 0x30, 0xe1, 0x2b, 0x61, 0xf4, 0x0c, 0xe9,
+		0x4b, 0x3a, 0x43, 0xc6, 0xfc, 0xb9, 0xa1, 0x63, 0x24, 0xc4, 0x95, 0x1e, 0x51, 0x94, 0x6b, 0x7f,
+		0x0a, 0xae, 0x5e, 0x86, 0x45, 0xb9, 0xf2, 0x0f, 0xbb, 0x7c, 0x57, 0x87, 0xdd, 0x0a, 0x8c, 0x6c,
+		0x61, 0xd5, 0x72, 0x36, 0xb0, 0xda, 0x46, 0x07, 0x49, 0xac, 0x86, 0x5b, 0x73, 0xda, 0x7c, 0x92,
+		0x43, 0x94, 0x42, 0x72, 0x88, 0x12, 0xc9, 0x43, 0x06, 0xba, 0xc9, 0x43, 0xda, 0xf1, 0xec, 0xa0,
+		0x70, 0x3c, 0x2b, 0xff, 0x49, 0x02, 0x39, 0xb9, 0xab, 0xf0, 0x43, 0x3b, 0xdc, 0x83, 0x61, 0x48,
+		0x4f, 0x67, 0x32, 0xf5, 0x02, 0x0c, 0x90, 0x5c, 0xd4, 0xf7, 0x5b, 0xbd, 0x02, 0x7e, 0xab, 0xe0,
+		0xce, 0xa0, 0x0f, 0xf2, 0x6f, 0xa5, 0x4e, 0x57, 0xb0, 0xcf, 0x91, 0x35, 0x5b, 0x44, 0x99, 0x14,
+		0xee, 0x3e, 0x9b, 0x18, 0x6d, 0xf4, 0x74, 0x0a, 0x53, 0xfe, 0x8d, 0x04, 0x27, 0x93, 0x5b, 0xbd,
+		0xba, 0x0d, 0xc0, 0x3f, 0x8a, 0x15, 0xfd, 0x28, 0x03, 0xa7, 0x04, 0x1a, 0x26, 0xdd, 0x35, 0xe9,
+		0xd8, 0x51, 0x8d, 0x8a, 0x2d, 0xb4, 0x49, 0x3e, 0xf1, 0x63, 0x5b, 0x53, 0x38, 0x42, 0xea, 0xe9,
+		0x26, 0x42, 0xda, 0xb3, 0x8a, 0x7f, 0x5e, 0x82, 0x19, 0xf1, 0x3e, 0x47, 0x91, 0x33, 0x6f, 0x7f,
+		0x52, 0xb0, 0xf7, 0x24, 0x48, 0xd9, 0xd1, 0x98, 0x8c, 0xed, 0xa0, 0x1f, 0x06, 0x79, 0x1e, 0xc6,
+		0x7b, 0x10, 0x42, 0x9c, 0x15, 0x40, 0xfc, 0x56, 0x48, 0x0f, 0x79, 0xb5, 0xcf, 0x6e, 0xf5, 0x70,
+		0x05, 0xa6, 0x2a, 0xaa, 0x13, 0xe8, 0xec, 0x09, 0xdf, 0x6b, 0xb7, 0x25, 0xeb, 0xd1, 0xb1, 0xb6,
+		0xd2, 0x0b, 0x9b, 0x18, 0xfa, 0x9c, 0x4d, 0xa1, 0xcf, 0x3d, 0x89, 0x36, 0x1a, 0x0a, 0xf4, 0xe4,
+		0x7f, 0x66, 0xe1, 0xbc, 0xf0, 0xad, 0x7f, 0xca, 0xa3, 0x23, 0x36, 0x7e, 0xc8, 0x74, 0x1b, 0x3f,
+		0xc4, 0xc6, 0x3a, 0xd9, 0x6e, 0x63, 0x1d, 0x7e, 0x54, 0xd2, 0xb3, 0x7f, 0x51, 0x49, 0x6f, 0xfa,
+		0xa8, 0x24, 0x1c, 0x4d, 0xe4, 0xba, 0x89, 0x26, 0x82, 0x0a, 0xd0, 0x17, 0x52, 0x80, 0xb7, 0xa5,
+		0x4e, 0x05, 0x88, 0xad, 0x91, 0xa4, 0x54, 0x80, 0x33, 0x30, 0xe4, 0x5d, 0xf1, 0xd1, 0x73, 0xdf,
+		0x26, 0xbb, 0xde, 0xaf, 0x0c, 0x92, 0xd1, 0x45, 0x3a, 0x18, 0x97, 0x83, 0xcb, 0xef, 0x4b, 0x70,
+		0x34, 0xa6, 0xd7, 0xdd, 0xfd, 0x16, 0xd0, 0xeb, 0xf1, 0x6d, 0xf9, 0x95, 0x3e, 0xf2, 0x5c, 0xd2,
+		0xd1, 0x2a, 0x1c, 0x6a, 0x6d, 0xe9, 0xa6, 0x61, 0xa5, 0xb8, 0x54, 0x41, 0x74, 0x47, 0xdd, 0x5e,
+		0xf6, 0x34, 0xe1, 0xa1, 0x88, 0x33, 0xfa, 0x04, 0x4c, 0x70, 0x9b, 0xe8, 0xe3, 0x56, 0x23, 0x9c,
+		0x53, 0xca, 0xbf, 0x90, 0x60, 0x32, 0xae, 0x7f, 0x7a, 0x5f, 0xde, 0xb2, 0x5f, 0xf2, 0x88, 0x0d,
+		0x20, 0xbe, 0x2f, 0xc1, 0x54, 0x52, 0x1f, 0x76, 0xdc, 0x6a, 0x1e, 0xeb, 0xb1, 0x12, 0x8b, 0xfc,
+		0x1f, 0x7d, 0x90, 0xb2, 0xdd, 0x0f, 0xcd, 0xc3, 0x41, 0xd2, 0x51, 0x18, 0x2e, 0x79, 0x78, 0x6b,
+		0x1a, 0xa9, 0xe1, 0x66, 0xa8, 0xe0, 0x11, 0xa9, 0x3a, 0x66, 0xba, 0xab, 0x3a, 0x3e, 0xa9, 0x0b,
+		0x8a, 0xd7, 0x05, 0x45, 0x74, 0xa7, 0x4f, 0x40, 0x77, 0xee, 0xc2, 0x18, 0xad, 0xe7, 0x50, 0x8c,
+		0x46, 0xcd, 0xc1, 0xd6, 0x8e, 0x5a, 0x49, 0xce, 0xab, 0x0f, 0xd2, 0x89, 0x04, 0x5e, 0x89, 0x4e,
+		0xeb, 0xac, 0x39, 0xe6, 0xf7, 0x54, 0x73, 0x0c, 0xa4, 0x18, 0x90, 0x26, 0xc5, 0xe0, 0x17, 0x18,
+		0x0b, 0x5d, 0x17, 0x18, 0xdb, 0x79, 0xf0, 0x80, 0x78, 0x5d, 0xc7, 0x2f, 0x73, 0x0d, 0xee, 0xa1,
+		0xcc, 0x35, 0xb4, 0xa7, 0x32, 0x97, 0xeb, 0x83, 0xe7, 0xd3, 0xf6, 0x1c, 0xb7, 0xbc, 0x95, 0x14,
+		0xf4, 0x56, 0x71, 0xf9, 0xf7, 0x06, 0x1c, 0x6e, 0xf5, 0x29, 0x85, 0x3a, 0x06, 0x3c, 0x3b, 0x9e,
+		0x89, 0xed, 0x44, 0xea, 0xec, 0x19, 0x38, 0x84, 0x59, 0xc3, 0xf2, 0x37, 0x25, 0x98, 0xe6, 0xac,
+		0x84, 0xd5, 0x08, 0x91, 0x6c, 0x1e, 0x92, 0x80, 0x79, 0x04, 0x22, 0xf1, 0x4c, 0x8a, 0x48, 0x5c,
+		0xfe, 0x40, 0x82, 0x63, 0xb1, 0xdf, 0xcc, 0xb8, 0xa9, 0x08, 0xfd, 0x22, 0xa7, 0xa6, 0x56, 0x7d,
+		0x51, 0x83, 0x37, 0x74, 0x47, 0xad, 0xe2, 0x6e, 0x5f, 0xbd, 0x6f, 0xa7, 0x4a, 0x5b, 0xe3, 0x7b,
+		0xc4, 0x6f, 0x7e, 0xbe, 0xc8, 0xda, 0x24, 0x5e, 0x8f, 0xd8, 0x09, 0x28, 0xd0, 0x2e, 0xbd, 0xa0,
+		0x08, 0xbc, 0x21, 0x22, 0x82, 0x96, 0x53, 0xcf, 0x88, 0x3b, 0xf5, 0xb8, 0x18, 0xee, 0x0b, 0x12,
+		0xcc, 0xa4, 0xe8, 0x8b, 0x6c, 0xdf, 0xf7, 0x4b, 0x1d, 0xf7, 0xfd, 0xdd, 0xee, 0x4c, 0x1c, 0xb4,
+		0x57, 0x59, 0xa5, 0x4f, 0x76, 0x33, 0x18, 0x0f, 0x56, 0x9c, 0x81, 0x9e, 0x87, 0x61, 0xb5, 0xe2,
+		0x9e, 0xcf, 0x34, 0xd7, 0x35, 0xb0, 0x4d, 0x20, 0xf4, 0x2b, 0x07, 0xc8, 0xf8, 0x62, 0x6b, 0x58,
+		0xfe, 0x7f, 0xc6, 0x0e, 0xf2, 0xba, 0xbe, 0xba, 0x80, 0x22, 0xff, 0x34, 0x03, 0xcf, 0xef, 0xed,
+		0x2b, 0x98, 0x7d, 0xb3, 0xee, 0xf6, 0xad, 0x79, 0xa6, 0xe3, 0xd6, 0xfc, 0x3e, 0xa0, 0x68, 0xb7,
+		0x1d, 0xf5, 0x64, 0x67, 0xc5, 0x7a, 0xea, 0x94, 0x91, 0x48, 0xeb, 0x9c, 0x7b, 0x0d, 0xa9, 0x99,
+		0x35, 0xc7, 0x32, 0x2b, 0xc4, 0xa4, 0x06, 0x14, 0xff, 0x11, 0x15, 0x61, 0x34, 0xd4, 0x38, 0x6c,
+		0xd6, 0x2a, 0x5e, 0x8e, 0xdc, 0xaf, 0x8c, 0x74, 0xf4, 0xf3, 0xde, 0xad, 0x55, 0x76, 0xe5, 0x37,
+		0xb3, 0x70, 0x63, 0x0f, 0x5f, 0xd9, 0xa0, 0xfb, 0x41, 0x0f, 0x3f, 0xc4, 0xf9, 0x86, 0x4d, 0x88,
+		0x73, 0x47, 0x01, 0x68, 0x9f, 0x6e, 0x76, 0xb8, 0xd5, 0x0c, 0xf6, 0xbe, 0xf4, 0xec, 0x75, 0x5f,
+		0x66, 0x01, 0x85, 0x7b, 0x9b, 0x69, 0xad, 0x30, 0xab, 0x0c, 0x1b, 0x1d, 0x4a, 0xe8, 0x5d, 0x26,
+		0xfb, 0xbb, 0x98, 0xeb, 0xd8, 0x45, 0xf9, 0x77, 0x12, 0x5c, 0xeb, 0xf2, 0x13, 0x21, 0x0e, 0x06,
+		0x89, 0x83, 0xe1, 0xc3, 0x55, 0x5c, 0xf9, 0xb3, 0x59, 0xb8, 0xd6, 0x65, 0x1b, 0xf7, 0x7f, 0xaa,
+		0xad, 0x86, 0xce, 0xa6, 0x1e, 0xfe, 0xd9, 0xd4, 0x2b, 0x7e, 0x36, 0x71, 0x55, 0x87, 0xe7, 0x00,
+		0xfa, 0x78, 0x0e, 0xe0, 0xb5, 0x2c, 0x5c, 0xe9, 0xa6, 0x15, 0x5d, 0xcc, 0xf2, 0x85, 0x38, 0x3f,
+		0xb1, 0xfc, 0xb6, 0xe5, 0xff, 0x59, 0x82, 0x8b, 0x69, 0xdb, 0xea, 0xff, 0xad, 0x4d, 0x9e, 0x7f,
+		0x56, 0xc9, 0xbf, 0x92, 0x60, 0x2e, 0x55, 0x2b, 0xfe, 0xbe, 0xb9, 0x00, 0x66, 0x7e, 0x94, 0xd9,
+		0x5b, 0x7e, 0xf4, 0x87, 0x7e, 0xb8, 0xdc, 0xc5, 0x37, 0x85, 0x81, 0xed, 0x90, 0x3a, 0xb6, 0xe3,
+		0x04, 0x14, 0x5a, 0xdb, 0x41, 0x75, 0x3e, 0xaf, 0x80, 0x3f, 0xc4, 0xba, 0x2c, 0xc9, 0xee, 0xc3,
+		0x65, 0x49, 0xb7, 0x95, 0xfd, 0xde, 0xfd, 0xbd, 0x2c, 0xc9, 0x3d, 0xd6, 0xcb, 0x92, 0xbe, 0xae,
+		0x2f, 0x4b, 0x1e, 0x00, 0xed, 0x43, 0xa7, 0x1c, 0xe9, 0x15, 0xb6, 0xd7, 0xae, 0x73, 0x36, 0xa6,
+		0x99, 0x9d, 0x70, 0xa1, 0x17, 0xd9, 0x23, 0xf5, 0xf0, 0x50, 0xd0, 0x48, 0xf2, 0x9d, 0xfe, 0x5c,
+		0x44, 0xe5, 0x41, 0x40, 0xe5, 0x35, 0x18, 0x0f, 0xa8, 0x53, 0xd9, 0xc2, 0x8d, 0x36, 0xfc, 0x02,
+		0x81, 0x3f, 0x13, 0xab, 0x38, 0x25, 0x5d, 0xc1, 0x0d, 0x1f, 0xaf, 0x72, 0xa8, 0xc9, 0x1a, 0x8e,
+		0x5c, 0xed, 0x0f, 0x76, 0x73, 0xb5, 0x1f, 0xe9, 0x28, 0x1e, 0x62, 0x74, 0x14, 0xb7, 0x73, 0xca,
+		0x03, 0xe9, 0x6f, 0x51, 0x86, 0xf7, 0x70, 0x8b, 0x32, 0xb2, 0xb7, 0x66, 0xe1, 0x67, 0xa0, 0xa0,
+		0xe3, 0x8a, 0xba, 0xeb, 0xa9, 0x66, 0x72, 0xe7, 0x33, 0x10, 0x6a, 0xa2, 0x8a, 0xf2, 0x1b, 0x59,
+		0xb8, 0x98, 0xf6, 0x9b, 0xdf, 0x8f, 0xde, 0xbd, 0xac, 0xfa, 0x71, 0x82, 0x57, 0x73, 0xbe, 0x9a,
+		0xfa, 0x83, 0xd5, 0x8e, 0xf0, 0x20, 0x60, 0x28, 0xbd, 0x9d, 0x86, 0xc2, 0x3e, 0x04, 0x73, 0x9c,
+		0x43, 0x70, 0x9f, 0x6e, 0x3d, 0xe5, 0x5f, 0x66, 0x60, 0x36, 0xcd, 0x07, 0xcd, 0xdc, 0xfd, 0x60,
+		0x9f, 0xbe, 0x99, 0xbd, 0x9e, 0xbe, 0xfb, 0xb5, 0x8b, 0x6c, 0xe9, 0xf6, 0x70, 0xa4, 0xdb, 0xb6,
+		0xce, 0x5e, 0xf1, 0x1b, 0x9f, 0x0f, 0x32, 0x90, 0xf2, 0x53, 0xeb, 0x8f, 0x87, 0x30, 0x59, 0x05,
+		0xac, 0x5e, 0x66, 0x01, 0xab, 0xdd, 0x19, 0x94, 0x13, 0xef, 0x0c, 0x92, 0xff, 0x96, 0x81, 0x0b,
+		0xfb, 0xe1, 0x51, 0x3e, 0xa6, 0x42, 0x0f, 0xd4, 0x16, 0x72, 0x29, 0x6a, 0x0b, 0xf2, 0xdf, 0x33,
+		0x30, 0x97, 0xea, 0xcb, 0xf7, 0x27, 0x82, 0x8f, 0x08, 0xde, 0xbf, 0x3c, 0xcd, 0xa5, 0xb9, 0x51,
+		0xff, 0x74, 0x96, 0x27, 0x78, 0x5e, 0x37, 0xd7, 0x13, 0xc1, 0xc7, 0x36, 0x93, 0xe5, 0xba, 0xf9,
+		0x0a, 0xe5, 0x27, 0x19, 0x98, 0x4f, 0xf9, 0x1f, 0x09, 0x9e, 0xec, 0x43, 0xc7, 0x3e, 0xcc, 0x38,
+		0x70, 0x80, 0xfc, 0xb9, 0x62, 0x54, 0x1c, 0x6c, 0x91, 0x57, 0x1d, 0x83, 0x89, 0xe5, 0x07, 0xcb,
+		0x77, 0xd6, 0xcb, 0x2b, 0xa5, 0xd5, 0xf5, 0x65, 0xa5, 0xbc, 0xfe, 0xbf, 0x6b, 0xcb, 0xe5, 0xd2,
+		0x9d, 0x07, 0x8b, 0xab, 0xa5, 0x5b, 0xc3, 0x4f, 0xa1, 0x13, 0x70, 0x34, 0xfa, 0xf3, 0xe2, 0xea,
+		0x6a, 0x99, 0x8c, 0x0e, 0x4b, 0xe8, 0x24, 0x1c, 0x8b, 0x12, 0x2c, 0xad, 0xde, 0xbd, 0xb7, 0x4c,
+		0x49, 0x32, 0x37, 0x5f, 0x82, 0xc3, 0x9a, 0x59, 0x65, 0xc9, 0xe0, 0x66, 0xff, 0x62, 0xdd, 0x58,
+		0xb3, 0x4c, 0xc7, 0x5c, 0x93, 0x5e, 0xbe, 0xf4, 0xd0, 0x70, 0xb6, 0x1a, 0x1b, 0x45, 0xcd, 0xac,
+		0xce, 0x07, 0xff, 0xaf, 0xf6, 0x9c, 0xa1, 0x57, 0xe6, 0x1f, 0x9a, 0xde, 0xff, 0xf2, 0xa6, 0xff,
+		0x64, 0xfb, 0x86, 0x5a, 0x37, 0x76, 0x2e, 0x6d, 0xe4, 0xc8, 0xd8, 0xe5, 0x7f, 0x0d, 0x00, 0xf5,
+		0xb5, 0x4f, 0x83, 0x47, 0x5c, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -366,53 +382,58 @@ var yarpcFileDescriptorClosure0370c4177fcc3ee8 = [][]byte{
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x72, 0xe3, 0x34,
-		0x14, 0xc6, 0x4d, 0x77, 0x49, 0x15, 0x9a, 0x35, 0x82, 0xdd, 0x6d, 0xb2, 0x2c, 0x04, 0x5f, 0xec,
-		0x74, 0x76, 0xc0, 0x9e, 0x94, 0xe1, 0x8a, 0x0b, 0x26, 0x4d, 0x3a, 0xac, 0x27, 0x69, 0x36, 0x63,
-		0x7b, 0x3b, 0x94, 0x1b, 0x21, 0x5b, 0xda, 0x44, 0xe3, 0x1f, 0x79, 0x24, 0x39, 0x6d, 0x5e, 0x84,
-		0x87, 0xe1, 0x89, 0x78, 0x0c, 0x46, 0xb2, 0x13, 0x42, 0x1b, 0xb8, 0x93, 0xce, 0x77, 0xbe, 0xf3,
-		0xf3, 0xe9, 0x1c, 0x01, 0xa7, 0x8a, 0xa9, 0xf0, 0x12, 0x4c, 0x68, 0x91, 0x50, 0x0f, 0x97, 0xcc,
-		0x5b, 0x0f, 0x3d, 0x85, 0x65, 0x9a, 0x31, 0xa9, 0xdc, 0x52, 0x70, 0xc5, 0xe1, 0x17, 0xda, 0xc7,
-		0x6d, 0x7c, 0x5c, 0x5c, 0x32, 0x77, 0x3d, 0xec, 0x7f, 0xbd, 0xe4, 0x7c, 0x99, 0x51, 0xcf, 0xb8,
-		0xc4, 0xd5, 0x47, 0x8f, 0x54, 0x02, 0x2b, 0xc6, 0x8b, 0x9a, 0xd4, 0xff, 0xe6, 0x21, 0xae, 0x58,
-		0x4e, 0xa5, 0xc2, 0x79, 0xd9, 0x38, 0x3c, 0x0a, 0x70, 0x27, 0x70, 0x59, 0x52, 0x21, 0x6b, 0xdc,
-		0xf9, 0x00, 0xda, 0x11, 0x96, 0xe9, 0x8c, 0x49, 0x05, 0x21, 0x38, 0x2e, 0x70, 0x4e, 0xcf, 0xac,
-		0x81, 0x75, 0x7e, 0x12, 0x98, 0x33, 0xfc, 0x11, 0x1c, 0xa7, 0xac, 0x20, 0x67, 0x47, 0x03, 0xeb,
-		0xbc, 0x7b, 0xf1, 0xad, 0x7b, 0xa0, 0x48, 0x77, 0x1b, 0x60, 0xca, 0x0a, 0x12, 0x18, 0x77, 0x07,
-		0x03, 0x7b, 0x6b, 0xbd, 0xa6, 0x0a, 0x13, 0xac, 0x30, 0xbc, 0x06, 0x5f, 0xe6, 0xf8, 0x1e, 0xe9,
-		0xb6, 0x25, 0x2a, 0xa9, 0x40, 0x92, 0x26, 0xbc, 0x20, 0x26, 0x5d, 0xe7, 0xe2, 0x2b, 0xb7, 0xae,
-		0xd4, 0xdd, 0x56, 0xea, 0x4e, 0x78, 0x15, 0x67, 0xf4, 0x06, 0x67, 0x15, 0x0d, 0x3e, 0xcf, 0xf1,
-		0xbd, 0x0e, 0x28, 0x17, 0x54, 0x84, 0x86, 0xe6, 0x7c, 0x00, 0xbd, 0x6d, 0x8a, 0x05, 0x16, 0x8a,
-		0x69, 0x55, 0x76, 0xb9, 0x6c, 0xd0, 0x4a, 0xe9, 0xa6, 0xe9, 0x44, 0x1f, 0xe1, 0x1b, 0xf0, 0x8c,
-		0xdf, 0x15, 0x54, 0xa0, 0x15, 0x97, 0x0a, 0x99, 0x3e, 0x8f, 0x0c, 0x7a, 0x6a, 0xcc, 0xef, 0xb8,
-		0x54, 0x73, 0x9c, 0x53, 0xe7, 0x2f, 0x0b, 0x74, 0xb7, 0x71, 0x43, 0x85, 0x55, 0x25, 0xe1, 0x77,
-		0x00, 0xc6, 0x38, 0x49, 0x33, 0xbe, 0x44, 0x09, 0xaf, 0x0a, 0x85, 0x56, 0xac, 0x50, 0x26, 0x76,
-		0x2b, 0xb0, 0x1b, 0x64, 0xac, 0x81, 0x77, 0xac, 0x50, 0xf0, 0x35, 0x00, 0x82, 0x62, 0x82, 0x32,
-		0xba, 0xa6, 0x99, 0xc9, 0xd1, 0x0a, 0x4e, 0xb4, 0x65, 0xa6, 0x0d, 0xf0, 0x15, 0x38, 0xc1, 0x49,
-		0xda, 0xa0, 0x2d, 0x83, 0xb6, 0x71, 0x92, 0xd6, 0xe0, 0x1b, 0xf0, 0x4c, 0x60, 0x45, 0xf7, 0xd5,
-		0x39, 0x1e, 0x58, 0xe7, 0x56, 0x70, 0xaa, 0xcd, 0xbb, 0xde, 0xe1, 0x04, 0x9c, 0x6a, 0x19, 0x11,
-		0x23, 0x28, 0xce, 0x78, 0x92, 0x9e, 0x3d, 0x31, 0x1a, 0x0e, 0xfe, 0xf3, 0x79, 0xfc, 0xc9, 0xa5,
-		0xf6, 0x0b, 0x3a, 0x9a, 0xe6, 0x13, 0x73, 0x71, 0x7e, 0x06, 0x9d, 0x3d, 0x0c, 0xf6, 0x40, 0x5b,
-		0x2a, 0x2c, 0x14, 0x62, 0xa4, 0x69, 0xee, 0x53, 0x73, 0xf7, 0x09, 0x7c, 0x0e, 0x9e, 0xd2, 0x82,
-		0x68, 0xa0, 0xee, 0xe7, 0x09, 0x2d, 0x88, 0x4f, 0x9c, 0x3f, 0x2c, 0x00, 0x16, 0x3c, 0xcb, 0xa8,
-		0xf0, 0x8b, 0x8f, 0x1c, 0x4e, 0x80, 0x9d, 0x61, 0xa9, 0x10, 0x4e, 0x12, 0x2a, 0x25, 0xd2, 0xa3,
-		0xd8, 0x3c, 0x6e, 0xff, 0xd1, 0xe3, 0x46, 0xdb, 0x39, 0x0d, 0xba, 0x9a, 0x33, 0x32, 0x14, 0x6d,
-		0x84, 0x7d, 0xd0, 0x66, 0x84, 0x16, 0x8a, 0xa9, 0x4d, 0xf3, 0x42, 0xbb, 0xfb, 0x21, 0x7d, 0x5a,
-		0x07, 0xf4, 0x71, 0xfe, 0xb4, 0x40, 0x2f, 0x54, 0x2c, 0x49, 0x37, 0x57, 0xf7, 0x34, 0xa9, 0xf4,
-		0x68, 0x8c, 0x94, 0x12, 0x2c, 0xae, 0x14, 0x95, 0xf0, 0x17, 0x60, 0xdf, 0x71, 0x91, 0x52, 0x61,
-		0x66, 0x11, 0xe9, 0x1d, 0x6c, 0xea, 0x7c, 0xfd, 0xbf, 0xf3, 0x1d, 0x74, 0x6b, 0xda, 0x6e, 0x61,
-		0x22, 0xd0, 0x93, 0xc9, 0x8a, 0x92, 0x2a, 0xa3, 0x48, 0x71, 0x54, 0xab, 0xa7, 0xdb, 0xe6, 0x95,
-		0x32, 0xb5, 0x77, 0x2e, 0x7a, 0x8f, 0xc7, 0xba, 0xd9, 0xe0, 0xe0, 0xc5, 0x96, 0x1b, 0xf1, 0x50,
-		0x33, 0xa3, 0x9a, 0xf8, 0xf6, 0x77, 0xf0, 0xd9, 0xfe, 0x46, 0xc1, 0x3e, 0x78, 0x11, 0x8d, 0xc2,
-		0x29, 0x9a, 0xf9, 0x61, 0x84, 0xa6, 0xfe, 0x7c, 0x82, 0xfc, 0xf9, 0xcd, 0x68, 0xe6, 0x4f, 0xec,
-		0x4f, 0x60, 0x0f, 0x3c, 0x7f, 0x80, 0xcd, 0xdf, 0x07, 0xd7, 0xa3, 0x99, 0x6d, 0x1d, 0x80, 0xc2,
-		0xc8, 0x1f, 0x4f, 0x6f, 0xed, 0xa3, 0xb7, 0xe4, 0x9f, 0x0c, 0xd1, 0xa6, 0xa4, 0xff, 0xce, 0x10,
-		0xdd, 0x2e, 0xae, 0xf6, 0x32, 0xbc, 0x02, 0x2f, 0x1f, 0x60, 0x93, 0xab, 0xb1, 0x1f, 0xfa, 0xef,
-		0xe7, 0xb6, 0x75, 0x00, 0x1c, 0x8d, 0x23, 0xff, 0xc6, 0x8f, 0x6e, 0xed, 0xa3, 0xcb, 0x5f, 0xc1,
-		0xcb, 0x84, 0xe7, 0x87, 0x14, 0xbd, 0x6c, 0x8f, 0x4a, 0xb6, 0xd0, 0x82, 0x2c, 0xac, 0xdf, 0x86,
-		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xbf, 0xc9, 0xef, 0x19, 0xc9, 0xbc, 0x25,
-		0xaf, 0x7f, 0xae, 0xe6, 0xcf, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x99, 0x3b, 0x06, 0xfc, 0x57, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5f, 0x73, 0xdb, 0x44,
+		0x10, 0x47, 0x76, 0x9a, 0x3a, 0x1b, 0xe2, 0xaa, 0x07, 0x69, 0x6d, 0x97, 0x42, 0xf0, 0x43, 0x27,
+		0x53, 0x40, 0x9e, 0x94, 0x61, 0x98, 0x81, 0x07, 0xc6, 0x89, 0x33, 0x54, 0x13, 0xc7, 0xf5, 0xc8,
+		0x6a, 0x86, 0xf0, 0x72, 0x9c, 0x75, 0x57, 0xfb, 0x46, 0xd2, 0x9d, 0x46, 0x77, 0x4a, 0xe2, 0x4f,
+		0xc0, 0x77, 0xe2, 0x95, 0x2f, 0xc6, 0xdc, 0x49, 0x0a, 0x4e, 0x62, 0xe8, 0x9b, 0xb4, 0xbf, 0xfd,
+		0xed, 0x9f, 0xdf, 0xde, 0x2e, 0xf4, 0x8b, 0x39, 0xcb, 0x07, 0x11, 0xa1, 0x4c, 0x44, 0x6c, 0x40,
+		0x32, 0x3e, 0xb8, 0x3a, 0x1a, 0x68, 0xa2, 0xe2, 0x84, 0x2b, 0xed, 0x65, 0xb9, 0xd4, 0x12, 0x7d,
+		0x66, 0x7c, 0xbc, 0xca, 0xc7, 0x23, 0x19, 0xf7, 0xae, 0x8e, 0x7a, 0x5f, 0x2e, 0xa4, 0x5c, 0x24,
+		0x6c, 0x60, 0x5d, 0xe6, 0xc5, 0x87, 0x01, 0x2d, 0x72, 0xa2, 0xb9, 0x14, 0x25, 0xa9, 0xf7, 0xd5,
+		0x7d, 0x5c, 0xf3, 0x94, 0x29, 0x4d, 0xd2, 0xac, 0x72, 0x78, 0x10, 0xe0, 0x3a, 0x27, 0x59, 0xc6,
+		0x72, 0x55, 0xe2, 0xfd, 0xf7, 0xd0, 0x0a, 0x89, 0x8a, 0xc7, 0x5c, 0x69, 0x84, 0x60, 0x4b, 0x90,
+		0x94, 0x75, 0x9c, 0x03, 0xe7, 0x70, 0x27, 0xb0, 0xdf, 0xe8, 0x07, 0xd8, 0x8a, 0xb9, 0xa0, 0x9d,
+		0xc6, 0x81, 0x73, 0xd8, 0x7e, 0xf3, 0xb5, 0xb7, 0xa1, 0x48, 0xaf, 0x0e, 0x70, 0xc6, 0x05, 0x0d,
+		0xac, 0x7b, 0x9f, 0x80, 0x5b, 0x5b, 0xcf, 0x99, 0x26, 0x94, 0x68, 0x82, 0xce, 0xe1, 0xf3, 0x94,
+		0xdc, 0x60, 0xd3, 0xb6, 0xc2, 0x19, 0xcb, 0xb1, 0x62, 0x91, 0x14, 0xd4, 0xa6, 0xdb, 0x7d, 0xf3,
+		0x85, 0x57, 0x56, 0xea, 0xd5, 0x95, 0x7a, 0x23, 0x59, 0xcc, 0x13, 0x76, 0x41, 0x92, 0x82, 0x05,
+		0x4f, 0x53, 0x72, 0x63, 0x02, 0xaa, 0x29, 0xcb, 0x67, 0x96, 0xd6, 0x7f, 0x0f, 0xdd, 0x3a, 0xc5,
+		0x94, 0xe4, 0x9a, 0x1b, 0x55, 0x6e, 0x73, 0xb9, 0xd0, 0x8c, 0xd9, 0xaa, 0xea, 0xc4, 0x7c, 0xa2,
+		0x57, 0xf0, 0x44, 0x5e, 0x0b, 0x96, 0xe3, 0xa5, 0x54, 0x1a, 0xdb, 0x3e, 0x1b, 0x16, 0xdd, 0xb3,
+		0xe6, 0xb7, 0x52, 0xe9, 0x09, 0x49, 0x59, 0xff, 0xcf, 0x26, 0xb4, 0xeb, 0xb8, 0x33, 0x4d, 0x74,
+		0xa1, 0xd0, 0xb7, 0x80, 0xe6, 0x24, 0x8a, 0x13, 0xb9, 0xc0, 0x91, 0x2c, 0x84, 0xc6, 0x4b, 0x2e,
+		0xb4, 0x8d, 0xdd, 0x0c, 0xdc, 0x0a, 0x39, 0x31, 0xc0, 0x5b, 0x2e, 0x34, 0x7a, 0x09, 0x90, 0x33,
+		0x42, 0x71, 0xc2, 0xae, 0x58, 0x62, 0x73, 0x34, 0x83, 0x1d, 0x63, 0x19, 0x1b, 0x03, 0x7a, 0x01,
+		0x3b, 0x24, 0x8a, 0x2b, 0xb4, 0x69, 0xd1, 0x16, 0x89, 0xe2, 0x12, 0x7c, 0x05, 0x4f, 0x72, 0xa2,
+		0xd9, 0xba, 0x3a, 0x5b, 0x07, 0xce, 0xa1, 0x13, 0xec, 0x19, 0xf3, 0x6d, 0xef, 0x68, 0x04, 0x7b,
+		0x46, 0x46, 0xcc, 0x29, 0x9e, 0x27, 0x32, 0x8a, 0x3b, 0x8f, 0xac, 0x86, 0x07, 0xff, 0x39, 0x1e,
+		0x7f, 0x74, 0x6c, 0xfc, 0x82, 0x5d, 0x43, 0xf3, 0xa9, 0xfd, 0x41, 0x3f, 0xc1, 0x6e, 0xdd, 0x17,
+		0x59, 0xb0, 0xce, 0xb6, 0x8d, 0xd1, 0x7d, 0x38, 0x87, 0xea, 0xc9, 0x05, 0x50, 0x79, 0x0f, 0x17,
+		0x0c, 0xfd, 0x08, 0x1d, 0xca, 0x55, 0x46, 0x74, 0xb4, 0xc4, 0xf7, 0x4b, 0x7e, 0x6c, 0x4b, 0xde,
+		0xaf, 0xf1, 0xe0, 0x4e, 0xe9, 0x87, 0xe0, 0xaa, 0x95, 0x88, 0x70, 0x5a, 0x53, 0xb9, 0xec, 0xb4,
+		0x2c, 0xa1, 0x6d, 0xec, 0xe7, 0x15, 0x83, 0xcb, 0xfe, 0x2f, 0xb0, 0xbb, 0x56, 0x3a, 0xea, 0x42,
+		0x4b, 0x69, 0x92, 0x6b, 0xcc, 0x69, 0xa5, 0xfd, 0x63, 0xfb, 0xef, 0x53, 0xb4, 0x0f, 0xdb, 0x4c,
+		0x50, 0x03, 0x94, 0x72, 0x3f, 0x62, 0x82, 0xfa, 0xb4, 0xff, 0xb7, 0x03, 0x30, 0x95, 0x49, 0xc2,
+		0x72, 0x5f, 0x7c, 0x90, 0x68, 0x04, 0x6e, 0x42, 0x94, 0xc6, 0x24, 0x8a, 0x98, 0x52, 0xd8, 0x6c,
+		0x4a, 0xf5, 0xf6, 0x7a, 0x0f, 0x7a, 0x0e, 0xeb, 0x35, 0x0a, 0xda, 0x86, 0x33, 0xb4, 0x14, 0x63,
+		0x44, 0x3d, 0x68, 0x71, 0xca, 0x84, 0xe6, 0x7a, 0x55, 0x3d, 0xa0, 0xdb, 0xff, 0x4d, 0xe3, 0x6b,
+		0x6e, 0x1a, 0xdf, 0x37, 0xf0, 0x54, 0x16, 0x5a, 0x69, 0x22, 0x28, 0x17, 0x8b, 0x72, 0x23, 0xec,
+		0xa0, 0x9b, 0x81, 0xbb, 0x06, 0xd8, 0x07, 0xdf, 0xff, 0xcb, 0x81, 0xee, 0x4c, 0xf3, 0x28, 0x5e,
+		0x9d, 0xde, 0xb0, 0xa8, 0x30, 0x93, 0x18, 0x6a, 0x9d, 0xf3, 0x79, 0xa1, 0x99, 0x42, 0xbf, 0x82,
+		0x7b, 0x2d, 0xf3, 0x98, 0xe5, 0x36, 0x0a, 0x36, 0xf7, 0xa4, 0x6a, 0xea, 0xe5, 0xff, 0xee, 0x6a,
+		0xd0, 0x2e, 0x69, 0xb7, 0xcb, 0x1f, 0x42, 0x57, 0x45, 0x4b, 0x46, 0x8b, 0x84, 0x61, 0x2d, 0x71,
+		0x29, 0xb5, 0xd1, 0x48, 0x16, 0xba, 0xd3, 0xf8, 0xd8, 0xd3, 0x78, 0x56, 0x73, 0x43, 0x39, 0x33,
+		0xcc, 0xb0, 0x24, 0xbe, 0xfe, 0x03, 0x3e, 0x5d, 0xbf, 0x0e, 0xa8, 0x07, 0xcf, 0xc2, 0xe1, 0xec,
+		0x0c, 0x8f, 0xfd, 0x59, 0x88, 0xcf, 0xfc, 0xc9, 0x08, 0xfb, 0x93, 0x8b, 0xe1, 0xd8, 0x1f, 0xb9,
+		0x9f, 0xa0, 0x2e, 0xec, 0xdf, 0xc3, 0x26, 0xef, 0x82, 0xf3, 0xe1, 0xd8, 0x75, 0x36, 0x40, 0xb3,
+		0xd0, 0x3f, 0x39, 0xbb, 0x74, 0x1b, 0xaf, 0xe9, 0xbf, 0x19, 0xc2, 0x55, 0xc6, 0xee, 0x66, 0x08,
+		0x2f, 0xa7, 0xa7, 0x6b, 0x19, 0x5e, 0xc0, 0xf3, 0x7b, 0xd8, 0xe8, 0xf4, 0xc4, 0x9f, 0xf9, 0xef,
+		0x26, 0xae, 0xb3, 0x01, 0x1c, 0x9e, 0x84, 0xfe, 0x85, 0x1f, 0x5e, 0xba, 0x8d, 0xe3, 0xdf, 0xe0,
+		0x79, 0x24, 0xd3, 0x4d, 0x8a, 0x1e, 0xb7, 0x86, 0x19, 0x9f, 0x1a, 0x41, 0xa6, 0xce, 0xef, 0x47,
+		0x0b, 0xae, 0x97, 0xc5, 0xdc, 0x8b, 0x64, 0x3a, 0x58, 0x3f, 0xf9, 0xdf, 0x71, 0x9a, 0x0c, 0x16,
+		0xb2, 0xbc, 0xc2, 0xd5, 0xfd, 0xff, 0x99, 0x64, 0xfc, 0xea, 0x68, 0xbe, 0x6d, 0x6d, 0xdf, 0xff,
+		0x33, 0x00, 0xda, 0xd4, 0x7a, 0xc6, 0x23, 0x06, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -436,149 +457,166 @@ var yarpcFileDescriptorClosure0370c4177fcc3ee8 = [][]byte{
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0xb1, 0x92, 0xec, 0x26, 0x8e, 0x76,
-		0x93, 0x75, 0xd4, 0xb5, 0xbd, 0x4e, 0x36, 0x9b, 0x66, 0xd3, 0x34, 0xa5, 0x49, 0x3a, 0x66, 0x22,
-		0x53, 0xea, 0x88, 0x8a, 0xe3, 0x45, 0x51, 0x82, 0x96, 0xc6, 0xf6, 0x20, 0x12, 0x29, 0x90, 0x54,
-		0x12, 0xdf, 0x0b, 0xf4, 0xdc, 0x5b, 0xd1, 0x53, 0xff, 0x80, 0x02, 0x45, 0xd1, 0x73, 0xd1, 0xa2,
-		0x87, 0xde, 0x7a, 0xed, 0xb1, 0xf7, 0xfe, 0x17, 0xc5, 0x0c, 0x87, 0x12, 0xf5, 0x93, 0x4a, 0x0b,
-		0x6c, 0x6f, 0xe6, 0xe3, 0xf7, 0x7d, 0x7c, 0xf3, 0xe6, 0xbd, 0x8f, 0x43, 0x0b, 0x0a, 0x9d, 0x53,
-		0xe2, 0xef, 0xd6, 0x9d, 0x06, 0x71, 0xeb, 0x64, 0xd7, 0x69, 0xd3, 0xdd, 0x77, 0x7b, 0xbb, 0xef,
-		0x3d, 0xff, 0xed, 0x59, 0xd3, 0x7b, 0xbf, 0xd3, 0xf6, 0xbd, 0xd0, 0x43, 0x6b, 0x0c, 0xb3, 0x23,
-		0x30, 0x3b, 0x4e, 0x9b, 0xee, 0xbc, 0xdb, 0xbb, 0x71, 0xeb, 0xdc, 0xf3, 0xce, 0x9b, 0x64, 0x97,
-		0x43, 0x4e, 0x3b, 0x67, 0xbb, 0x8d, 0x8e, 0xef, 0x84, 0xd4, 0x73, 0x23, 0xd2, 0x8d, 0xdb, 0x83,
-		0xf7, 0x43, 0xda, 0x22, 0x41, 0xe8, 0xb4, 0xda, 0x02, 0xb0, 0x39, 0xea, 0xc9, 0x75, 0xaf, 0xd5,
-		0xea, 0x4a, 0x8c, 0xcc, 0x2d, 0x74, 0x82, 0xb7, 0x4d, 0x1a, 0x84, 0x11, 0xa6, 0xf0, 0xd7, 0x39,
-		0x58, 0x3f, 0x16, 0xe9, 0xea, 0x1f, 0x48, 0xbd, 0xc3, 0x52, 0x30, 0xdc, 0x33, 0x0f, 0xd5, 0x00,
-		0xc5, 0xeb, 0xb0, 0x49, 0x7c, 0x27, 0x2f, 0x6d, 0x4a, 0x5b, 0xb9, 0x07, 0xf7, 0x76, 0x46, 0x2c,
-		0x69, 0x67, 0x48, 0x07, 0xaf, 0xbe, 0x1f, 0x0c, 0xa1, 0x47, 0x30, 0x13, 0x5e, 0xb6, 0x49, 0x3e,
-		0xc3, 0x85, 0xee, 0x4c, 0x14, 0xb2, 0x2e, 0xdb, 0x04, 0x73, 0x38, 0x7a, 0x02, 0x10, 0x84, 0x8e,
-		0x1f, 0xda, 0xac, 0x0c, 0xf9, 0x2c, 0x27, 0xdf, 0xd8, 0x89, 0x6a, 0xb4, 0x13, 0xd7, 0x68, 0xc7,
-		0x8a, 0x6b, 0x84, 0x17, 0x38, 0x9a, 0x5d, 0x33, 0x6a, 0xbd, 0xe9, 0x05, 0x24, 0xa2, 0xce, 0xa4,
-		0x53, 0x39, 0x9a, 0x53, 0x2d, 0x58, 0x8c, 0xa8, 0x41, 0xe8, 0x84, 0x9d, 0x20, 0x3f, 0xbb, 0x29,
-		0x6d, 0x2d, 0x3f, 0xd8, 0x9b, 0x6e, 0xf5, 0x2a, 0x63, 0x56, 0x39, 0x11, 0xe7, 0xea, 0xbd, 0x0b,
-		0x74, 0x17, 0x96, 0x2f, 0x68, 0x10, 0x7a, 0xfe, 0xa5, 0xdd, 0x24, 0xee, 0x79, 0x78, 0x91, 0x9f,
-		0xdb, 0x94, 0xb6, 0xb2, 0x78, 0x49, 0x44, 0x4b, 0x3c, 0x88, 0x7e, 0x0e, 0xeb, 0x6d, 0xc7, 0x27,
-		0x6e, 0xd8, 0x2b, 0xbf, 0x4d, 0xdd, 0x33, 0x2f, 0x7f, 0x85, 0x2f, 0x61, 0x6b, 0x64, 0x16, 0x15,
-		0xce, 0xe8, 0xdb, 0x49, 0xbc, 0xd6, 0x1e, 0x0e, 0x22, 0x05, 0x96, 0x7b, 0xb2, 0xbc, 0x32, 0xf3,
-		0xa9, 0x95, 0x59, 0xea, 0x32, 0x78, 0x75, 0xb6, 0x61, 0xa6, 0x45, 0x5a, 0x5e, 0x7e, 0x81, 0x13,
-		0xaf, 0x8f, 0xcc, 0xe7, 0x88, 0xb4, 0x3c, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x40, 0x1c, 0xbf, 0x7e,
-		0x61, 0x3b, 0x61, 0xe8, 0xd3, 0xd3, 0x4e, 0x48, 0x82, 0x3c, 0x70, 0xee, 0xdd, 0x91, 0xdc, 0x2a,
-		0x47, 0x2b, 0x5d, 0x30, 0x96, 0x83, 0x81, 0x08, 0x2a, 0xc1, 0xaa, 0xd3, 0x09, 0x3d, 0xdb, 0x27,
-		0x01, 0x09, 0xed, 0xb6, 0x47, 0xdd, 0x30, 0xc8, 0xe7, 0xb8, 0xe6, 0xe6, 0x48, 0x4d, 0xcc, 0x80,
-		0x15, 0x8e, 0xc3, 0x2b, 0x8c, 0x9a, 0x08, 0xa0, 0x9b, 0xb0, 0xc0, 0xc6, 0xc3, 0x66, 0xf3, 0x91,
-		0x5f, 0xdc, 0x94, 0xb6, 0x16, 0xf0, 0x3c, 0x0b, 0x94, 0x68, 0x10, 0xa2, 0x0d, 0xb8, 0x42, 0x03,
-		0xbb, 0xee, 0x7b, 0x6e, 0x7e, 0x69, 0x53, 0xda, 0x9a, 0xc7, 0x73, 0x34, 0x50, 0x7d, 0xcf, 0x2d,
-		0xfc, 0x26, 0x03, 0xb7, 0x86, 0x37, 0xdf, 0x73, 0xcf, 0xe8, 0xb9, 0x18, 0x69, 0xf4, 0x6d, 0x52,
-		0x38, 0x1a, 0xa1, 0x4f, 0x47, 0xa6, 0x67, 0x89, 0xa7, 0x25, 0x9e, 0xeb, 0xc0, 0x66, 0x6f, 0xa3,
-		0xc4, 0x0c, 0x78, 0x76, 0xaf, 0xa3, 0xbd, 0x4e, 0x28, 0x86, 0xe9, 0xfa, 0xd0, 0xd6, 0x69, 0x22,
-		0x01, 0xfc, 0x49, 0x57, 0xa2, 0xca, 0xe7, 0xc2, 0x53, 0xe3, 0x1e, 0xf7, 0x3a, 0x21, 0x3a, 0x86,
-		0x9b, 0x3c, 0xbd, 0x31, 0xea, 0xd9, 0x34, 0xf5, 0x0d, 0xc6, 0x1e, 0x21, 0x5c, 0xf8, 0x87, 0x04,
-		0x6b, 0x23, 0x3a, 0x92, 0x15, 0xba, 0xe1, 0xb5, 0x1c, 0xea, 0xda, 0xb4, 0xc1, 0xeb, 0xb1, 0x80,
-		0xe7, 0xa3, 0x80, 0xd1, 0x40, 0xb7, 0x21, 0x27, 0x6e, 0xba, 0x4e, 0x2b, 0x32, 0x8a, 0x05, 0x0c,
-		0x51, 0xc8, 0x74, 0x5a, 0x64, 0x8c, 0x33, 0x65, 0xff, 0x57, 0x67, 0xba, 0x03, 0x8b, 0xd4, 0xa5,
-		0x21, 0x75, 0x42, 0xd2, 0x60, 0x79, 0xcd, 0xf0, 0xa1, 0xcc, 0x75, 0x63, 0x46, 0xa3, 0xf0, 0x6b,
-		0x09, 0xd6, 0xf5, 0x0f, 0x21, 0xf1, 0x5d, 0xa7, 0xf9, 0xbd, 0xb8, 0xe5, 0x60, 0x4e, 0x99, 0xe1,
-		0x9c, 0xfe, 0x35, 0x0b, 0x6b, 0x15, 0xe2, 0x36, 0xa8, 0x7b, 0xae, 0xd4, 0x43, 0xfa, 0x8e, 0x86,
-		0x97, 0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xaf, 0xca, 0x10, 0x87, 0x8c, 0x06, 0x3a, 0x80,
-		0xa5, 0x2e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0x3d, 0x87, 0x59,
-		0x66, 0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0xb8, 0x3f, 0xda, 0x97, 0xfa, 0x33, 0x64, 0x4e, 0x48, 0x70,
-		0xc4, 0x43, 0x06, 0xac, 0x5e, 0x10, 0xc7, 0x0f, 0x4f, 0x89, 0x13, 0xda, 0x0d, 0x12, 0x3a, 0xb4,
-		0x19, 0x08, 0x9f, 0xfe, 0x64, 0x8c, 0xc9, 0x5d, 0x36, 0x3d, 0xa7, 0x81, 0xe5, 0x2e, 0x4d, 0x8b,
-		0x58, 0xe8, 0x25, 0xac, 0x35, 0x9d, 0x20, 0xb4, 0x7b, 0x7a, 0xdc, 0xda, 0x66, 0x53, 0xad, 0x6d,
-		0x95, 0xd1, 0x0e, 0x63, 0x16, 0xb7, 0xb7, 0x03, 0xe0, 0xc1, 0x68, 0x2a, 0x48, 0x23, 0x52, 0x9a,
-		0x4b, 0x55, 0x5a, 0x61, 0xa4, 0x6a, 0xc4, 0xe1, 0x3a, 0x79, 0xb8, 0xe2, 0x84, 0x21, 0x69, 0xb5,
-		0x43, 0xee, 0xdc, 0xb3, 0x38, 0xbe, 0x44, 0xf7, 0x41, 0x6e, 0x39, 0x1f, 0x68, 0xab, 0xd3, 0xb2,
-		0x45, 0x28, 0xe0, 0x2e, 0x3c, 0x8b, 0x57, 0x44, 0x5c, 0x11, 0x61, 0x66, 0xd7, 0x41, 0xfd, 0x82,
-		0x34, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e, 0x2a, 0xac, 0x90, 0x0f,
-		0x6d, 0x1a, 0xcd, 0x6c, 0xa4, 0x01, 0xa9, 0x1a, 0xcb, 0x3d, 0x0a, 0x17, 0x79, 0x0e, 0x8b, 0xbc,
-		0x28, 0x67, 0x0e, 0x6d, 0x76, 0x7c, 0x22, 0xbc, 0x76, 0xf4, 0x36, 0x1d, 0x44, 0x18, 0x9c, 0x63,
-		0x0c, 0x71, 0x81, 0xbe, 0x82, 0xab, 0x5c, 0x80, 0xf5, 0x3a, 0xf1, 0x6d, 0xda, 0x20, 0x6e, 0x48,
-		0xc3, 0x4b, 0x61, 0xb7, 0x88, 0xdd, 0x3b, 0xe6, 0xb7, 0x0c, 0x71, 0xa7, 0xf0, 0xa7, 0x0c, 0x5c,
-		0x17, 0xed, 0xa3, 0x5e, 0xd0, 0x66, 0xe3, 0x7b, 0x19, 0xbc, 0x2f, 0x13, 0xb2, 0x6c, 0x38, 0x92,
-		0x5e, 0x24, 0xbf, 0x4f, 0x9c, 0x4f, 0xb8, 0x23, 0x0d, 0x8e, 0x69, 0x76, 0x68, 0x4c, 0xd1, 0x6b,
-		0x10, 0xaf, 0x61, 0x61, 0xae, 0x6d, 0xaf, 0x49, 0xeb, 0x97, 0xbc, 0xcd, 0x97, 0xc7, 0x24, 0x1a,
-		0x39, 0x27, 0x37, 0xd4, 0x0a, 0x47, 0xe3, 0xd5, 0xf6, 0x60, 0x08, 0x5d, 0x83, 0xb9, 0xc8, 0x1a,
-		0x79, 0x93, 0x2f, 0x60, 0x71, 0x55, 0xf8, 0x7b, 0xa6, 0x6b, 0x0b, 0x1a, 0xa9, 0xd3, 0x20, 0xae,
-		0x57, 0x77, 0x5a, 0xa5, 0xf4, 0x69, 0x8d, 0x89, 0x7d, 0xd3, 0x3a, 0xdc, 0x89, 0x99, 0x8f, 0xed,
-		0xc4, 0x67, 0xb0, 0xd8, 0x37, 0x54, 0xe9, 0xc7, 0xb9, 0x5c, 0x30, 0x7a, 0xa0, 0x66, 0xfa, 0x07,
-		0x0a, 0xc3, 0x86, 0xe7, 0xd3, 0x73, 0xea, 0x3a, 0x4d, 0x7b, 0x20, 0xc9, 0x74, 0x0b, 0x58, 0x8f,
-		0xa9, 0xd5, 0x64, 0xb2, 0x85, 0x3f, 0x67, 0xe0, 0x7a, 0x6c, 0x5b, 0x25, 0xaf, 0xee, 0x34, 0x35,
-		0x1a, 0xb4, 0x9d, 0xb0, 0x7e, 0x31, 0x9d, 0xcb, 0xfe, 0xff, 0xcb, 0xf5, 0x0b, 0xb8, 0xd5, 0x9f,
-		0x81, 0xed, 0x9d, 0xd9, 0xe1, 0x05, 0x0d, 0xec, 0x64, 0x15, 0x27, 0x0b, 0xde, 0xe8, 0xcb, 0xa8,
-		0x7c, 0x66, 0x5d, 0xd0, 0x40, 0x78, 0x13, 0xfa, 0x14, 0x80, 0x9f, 0x1e, 0x42, 0xef, 0x2d, 0x89,
-		0xba, 0x70, 0x11, 0xf3, 0xe3, 0x8e, 0xc5, 0x02, 0x85, 0x97, 0x90, 0x4b, 0x9e, 0xb1, 0x9e, 0xc2,
-		0x9c, 0x38, 0xa6, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0x83, 0xcf, 0x52, 0x8e, 0x69, 0xfc, 0x04, 0x2b,
-		0x28, 0x85, 0x3f, 0x64, 0x60, 0xb9, 0xff, 0x16, 0xfa, 0x02, 0x56, 0x4e, 0xa9, 0xeb, 0xf8, 0x97,
-		0x76, 0xfd, 0x82, 0xd4, 0xdf, 0x06, 0x9d, 0x96, 0xd8, 0x84, 0xe5, 0x28, 0xac, 0x8a, 0x28, 0x5a,
-		0x87, 0x39, 0xbf, 0xe3, 0xc6, 0x2f, 0xd1, 0x05, 0x3c, 0xeb, 0x77, 0xd8, 0x69, 0xe3, 0x19, 0xdc,
-		0x3c, 0xa3, 0x7e, 0xc0, 0x5e, 0x3c, 0x51, 0xb3, 0xdb, 0x75, 0xaf, 0xd5, 0x6e, 0x92, 0xbe, 0x49,
-		0xce, 0x73, 0x48, 0x3c, 0x0e, 0x6a, 0x0c, 0xe0, 0xf4, 0xc5, 0xba, 0x4f, 0x9c, 0xee, 0xde, 0xa4,
-		0x97, 0x32, 0x27, 0xf0, 0xc2, 0x4e, 0x97, 0xb8, 0xc1, 0x52, 0xf7, 0x7c, 0xda, 0x36, 0x5d, 0x8c,
-		0x09, 0x5c, 0xe0, 0x16, 0x00, 0x3f, 0xfb, 0x86, 0xce, 0x69, 0x33, 0x7a, 0x3b, 0xcd, 0xe3, 0x44,
-		0xa4, 0xf8, 0x47, 0x09, 0xae, 0x8e, 0x7a, 0xf7, 0xa2, 0x02, 0xdc, 0xaa, 0xe8, 0xa6, 0x66, 0x98,
-		0x2f, 0x6c, 0x45, 0xb5, 0x8c, 0xd7, 0x86, 0x75, 0x62, 0x57, 0x2d, 0xc5, 0xd2, 0x6d, 0xc3, 0x7c,
-		0xad, 0x94, 0x0c, 0x4d, 0xfe, 0x01, 0xfa, 0x1c, 0x36, 0xc7, 0x60, 0xaa, 0xea, 0xa1, 0xae, 0xd5,
-		0x4a, 0xba, 0x26, 0x4b, 0x13, 0x94, 0xaa, 0x96, 0x82, 0x2d, 0x5d, 0x93, 0x33, 0xe8, 0x87, 0xf0,
-		0xc5, 0x18, 0x8c, 0xaa, 0x98, 0xaa, 0x5e, 0xb2, 0xb1, 0xfe, 0xb3, 0x9a, 0x5e, 0x65, 0xe0, 0x6c,
-		0xf1, 0x97, 0xbd, 0x9c, 0xfb, 0x1c, 0x28, 0xf9, 0x24, 0x4d, 0x57, 0x8d, 0xaa, 0x51, 0x36, 0x27,
-		0xe5, 0x3c, 0x80, 0x19, 0x93, 0xf3, 0x20, 0x2a, 0xce, 0xb9, 0xf8, 0xab, 0x4c, 0xef, 0xd3, 0xd8,
-		0x68, 0x60, 0xd2, 0xe9, 0x7a, 0xee, 0xe7, 0xb0, 0x79, 0x5c, 0xc6, 0xaf, 0x0e, 0x4a, 0xe5, 0x63,
-		0xdb, 0xd0, 0x6c, 0xac, 0xd7, 0xaa, 0xba, 0x5d, 0x29, 0x97, 0x0c, 0xf5, 0x24, 0x91, 0xc9, 0x8f,
-		0xe0, 0xeb, 0xb1, 0x28, 0xa5, 0xc4, 0xa2, 0x5a, 0xad, 0x52, 0x32, 0x54, 0xf6, 0xd4, 0x03, 0xc5,
-		0x28, 0xe9, 0x9a, 0x5d, 0x36, 0x4b, 0x27, 0xb2, 0x84, 0xbe, 0x84, 0xad, 0x69, 0x99, 0x72, 0x06,
-		0x6d, 0xc3, 0xfd, 0xb1, 0x68, 0xac, 0xbf, 0xd4, 0x55, 0x2b, 0x01, 0xcf, 0xa2, 0x3d, 0xd8, 0x1e,
-		0x0b, 0xb7, 0x74, 0x7c, 0x64, 0x98, 0xbc, 0xa0, 0x07, 0x36, 0xae, 0x99, 0xa6, 0x61, 0xbe, 0x90,
-		0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0x65, 0x84, 0x6e, 0xc3, 0xcd, 0x8a, 0x82, 0x75, 0xd3,
-		0xb2, 0xd5, 0x52, 0x79, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x7d, 0xc5, 0xd4, 0xca, 0xa6, 0x2c, 0xa1,
-		0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0, 0x3b, 0xf0, 0xe9, 0x28, 0x5c,
-		0x37, 0x5b, 0x39, 0x5b, 0xfc, 0x77, 0x06, 0x3e, 0x99, 0xf4, 0x05, 0xce, 0x3a, 0xb0, 0xbb, 0x6c,
-		0xfd, 0x8d, 0xae, 0xd6, 0x2c, 0xb6, 0xe7, 0x91, 0x1e, 0xdb, 0xf9, 0x5a, 0x35, 0x91, 0x79, 0xb2,
-		0xa4, 0x63, 0xc0, 0x6a, 0xf9, 0xa8, 0x52, 0xd2, 0x2d, 0xde, 0x4d, 0x45, 0xb8, 0x97, 0x06, 0x8f,
-		0x36, 0x58, 0xce, 0xf4, 0xed, 0xed, 0x38, 0x69, 0xbe, 0x6e, 0x36, 0x0a, 0x68, 0x07, 0x8a, 0x69,
-		0xe8, 0x6e, 0x15, 0x34, 0x79, 0x06, 0x7d, 0x0d, 0x5f, 0xa5, 0x27, 0x6e, 0x5a, 0x86, 0x59, 0xd3,
-		0x35, 0x5b, 0xa9, 0xda, 0xa6, 0x7e, 0x2c, 0xcf, 0x4e, 0xb3, 0x5c, 0xcb, 0x38, 0x62, 0xfd, 0x59,
-		0xb3, 0xe4, 0xb9, 0xe2, 0x5f, 0x24, 0xb8, 0xa6, 0x7a, 0x6e, 0x48, 0xdd, 0x0e, 0x51, 0x02, 0x93,
-		0xbc, 0x37, 0xa2, 0x73, 0x8e, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xd6, 0x17, 0xf2, 0xb6, 0x61, 0x1a,
-		0x96, 0xa1, 0x58, 0x65, 0x9c, 0xa8, 0xef, 0x44, 0x18, 0x1b, 0x48, 0x4d, 0xc7, 0x51, 0x5d, 0xc7,
-		0xc3, 0xb0, 0x6e, 0xe1, 0x13, 0xd1, 0x0a, 0x91, 0xc3, 0x8c, 0xc7, 0xaa, 0x98, 0xcd, 0xb7, 0x98,
-		0x7f, 0x39, 0x5b, 0xfc, 0xbd, 0x04, 0x39, 0xf1, 0x8d, 0xca, 0x3f, 0x61, 0xf2, 0x70, 0x95, 0x2d,
-		0xb0, 0x5c, 0xb3, 0x6c, 0xeb, 0xa4, 0xa2, 0xf7, 0xf7, 0x70, 0xdf, 0x1d, 0x6e, 0x0f, 0xb6, 0x55,
-		0x8e, 0xaa, 0x13, 0x39, 0x49, 0x3f, 0x40, 0x3c, 0x85, 0x61, 0x38, 0x58, 0xce, 0x4c, 0xc4, 0x44,
-		0x3a, 0x59, 0x74, 0x03, 0xae, 0xf5, 0x61, 0x0e, 0x75, 0x05, 0x5b, 0xfb, 0xba, 0x62, 0xc9, 0x33,
-		0xc5, 0xdf, 0x4a, 0x70, 0x3d, 0x76, 0x42, 0x8b, 0xbd, 0x58, 0x69, 0x8b, 0x34, 0xca, 0x9d, 0x50,
-		0x75, 0x3a, 0x01, 0x41, 0xf7, 0xe1, 0x6e, 0xd7, 0xc3, 0x2c, 0xa5, 0xfa, 0xaa, 0xb7, 0x57, 0xb6,
-		0xaa, 0xb0, 0xe1, 0xee, 0xad, 0x26, 0x15, 0x2a, 0x52, 0x90, 0x25, 0xf4, 0x05, 0x7c, 0x36, 0x19,
-		0x8a, 0xf5, 0xaa, 0x6e, 0xc9, 0x99, 0xe2, 0x3f, 0x73, 0xb0, 0x91, 0x4c, 0x8e, 0x1d, 0xf4, 0x49,
-		0x23, 0x4a, 0xed, 0x1e, 0x14, 0xfa, 0x45, 0x84, 0xcf, 0x0d, 0xe6, 0xb5, 0x07, 0xdb, 0x13, 0x70,
-		0x35, 0xf3, 0x50, 0x31, 0x35, 0x76, 0x1d, 0x83, 0x64, 0x09, 0x3d, 0x87, 0xa7, 0x13, 0x28, 0xfb,
-		0x8a, 0xd6, 0xab, 0x72, 0xf7, 0x8d, 0xa3, 0x58, 0x16, 0x36, 0xf6, 0x6b, 0x96, 0x5e, 0x95, 0x33,
-		0x48, 0x07, 0x25, 0x45, 0xa0, 0xdf, 0x87, 0x46, 0xca, 0x64, 0xd1, 0x13, 0x78, 0x94, 0x96, 0x47,
-		0xd4, 0x32, 0xc6, 0x91, 0x8e, 0x93, 0xd4, 0x19, 0xf4, 0x2d, 0x7c, 0x93, 0x42, 0x15, 0x4f, 0x1e,
-		0xe2, 0xce, 0xa2, 0xa7, 0xf0, 0x38, 0x35, 0x7b, 0xb5, 0x8c, 0x35, 0xfb, 0x48, 0xc1, 0xaf, 0xfa,
-		0xc9, 0x73, 0xc8, 0x00, 0x3d, 0xed, 0xc1, 0xc2, 0xdd, 0xec, 0x11, 0xbe, 0x90, 0x90, 0xba, 0x32,
-		0x45, 0x15, 0x59, 0x20, 0x45, 0x66, 0x1e, 0xbd, 0x00, 0x75, 0xba, 0x52, 0x4c, 0x16, 0x5a, 0x40,
-		0x6f, 0xc0, 0xfa, 0xb8, 0x5d, 0xd5, 0xdf, 0x58, 0x3a, 0x36, 0x95, 0x34, 0x65, 0x40, 0xcf, 0xe0,
-		0x49, 0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0xc7, 0xf0, 0x70, 0x02, 0x3d, 0xd9, 0x23,
-		0xbd, 0x53, 0x81, 0xa1, 0xc9, 0x8b, 0xe8, 0x11, 0xec, 0x4d, 0x20, 0xf2, 0x29, 0xb4, 0xab, 0x96,
-		0xa1, 0xbe, 0x3a, 0x89, 0x6e, 0x97, 0x8c, 0xaa, 0x25, 0x2f, 0xa1, 0x9f, 0xc2, 0x8f, 0x27, 0xd0,
-		0xba, 0x8b, 0x65, 0x7f, 0xe8, 0x38, 0x31, 0x62, 0x0c, 0x56, 0xc3, 0xba, 0xbc, 0x3c, 0xc5, 0x9e,
-		0x54, 0x8d, 0x17, 0xe9, 0x95, 0x5b, 0x41, 0x2a, 0x3c, 0x9f, 0x6a, 0x44, 0xd4, 0x43, 0xa3, 0xa4,
-		0x8d, 0x16, 0x91, 0xd1, 0x43, 0xd8, 0x9d, 0x20, 0x72, 0x50, 0xc6, 0xaa, 0x2e, 0xde, 0x58, 0x5d,
-		0x93, 0x58, 0x45, 0xdf, 0xc0, 0x83, 0x49, 0x24, 0xc5, 0x28, 0x95, 0x5f, 0xeb, 0x78, 0x90, 0x87,
-		0xd8, 0x6b, 0x74, 0xba, 0xa5, 0x1b, 0x66, 0xa5, 0x66, 0xd9, 0x55, 0xe3, 0x3b, 0x5d, 0x5e, 0x63,
-		0xaf, 0xd1, 0xd4, 0x9d, 0x8a, 0x6b, 0x25, 0x5f, 0x1d, 0x36, 0xe3, 0xa1, 0x87, 0xec, 0x1b, 0xa6,
-		0x82, 0x4f, 0xe4, 0xf5, 0x94, 0xde, 0x1b, 0x36, 0xba, 0xbe, 0x16, 0xba, 0x36, 0xcd, 0x72, 0x74,
-		0x05, 0xab, 0x87, 0xc9, 0x8a, 0x6f, 0xb0, 0xb7, 0xce, 0x1d, 0xfe, 0x0f, 0x97, 0xa1, 0x73, 0x55,
-		0xd2, 0xe2, 0xf7, 0x60, 0x3b, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6, 0xed, 0xf7, 0xe1, 0x27, 0xd3,
-		0x51, 0xba, 0xf7, 0x95, 0x12, 0xd6, 0x15, 0xed, 0xa4, 0x7b, 0x24, 0x95, 0x8a, 0x7f, 0x93, 0xa0,
-		0xa8, 0x3a, 0x6e, 0x9d, 0x34, 0xe3, 0xff, 0xc7, 0x4e, 0xcc, 0xf2, 0x29, 0x3c, 0x9e, 0x62, 0xde,
-		0xc7, 0xe4, 0x7b, 0x0c, 0xd5, 0x8f, 0x25, 0xd7, 0xcc, 0x57, 0x66, 0xf9, 0xd8, 0x9c, 0x44, 0x10,
-		0x8b, 0xa8, 0xd2, 0x73, 0xfe, 0xcf, 0xe4, 0xe9, 0x16, 0x21, 0xda, 0xee, 0xbf, 0x5b, 0xc4, 0xc7,
-		0x92, 0xa7, 0x5a, 0xc4, 0xfe, 0x1b, 0xd8, 0xa8, 0x7b, 0xad, 0x51, 0x5f, 0xf1, 0xfb, 0xf3, 0x4a,
-		0x9b, 0x56, 0xd8, 0x17, 0x6c, 0x45, 0xfa, 0x6e, 0xef, 0x9c, 0x86, 0x17, 0x9d, 0xd3, 0x9d, 0xba,
-		0xd7, 0xda, 0x4d, 0xfe, 0x2e, 0xb9, 0x4d, 0x1b, 0xcd, 0xdd, 0x73, 0x2f, 0xfa, 0x9d, 0x53, 0xfc,
-		0x48, 0xf9, 0xd4, 0x69, 0xd3, 0x77, 0x7b, 0xa7, 0x73, 0x3c, 0xf6, 0xf0, 0x3f, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0x4a, 0xf8, 0xd6, 0xfd, 0x64, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0xd8, 0xf4, 0xe4, 0xc3, 0x8a, 0xb3, 0x9b, 0x38, 0xda,
+		0x4d, 0xd6, 0xd1, 0xbb, 0xb1, 0xd7, 0xd9, 0xef, 0xa4, 0xdb, 0x94, 0xa6, 0xe8, 0x98, 0xb1, 0x4c,
+		0xa9, 0x43, 0x2a, 0x8e, 0x17, 0x45, 0x09, 0x5a, 0x1a, 0x5b, 0x84, 0x29, 0x52, 0x20, 0xa9, 0x24,
+		0xbe, 0x2f, 0xd0, 0xab, 0x5e, 0xf4, 0xae, 0xe8, 0x55, 0x7f, 0x40, 0x81, 0x45, 0x5b, 0xa0, 0x37,
+		0x45, 0xd1, 0xa2, 0x17, 0xbd, 0xeb, 0x5f, 0xe8, 0x7d, 0xff, 0x45, 0x31, 0xc3, 0x21, 0x45, 0x7d,
+		0x52, 0x69, 0x81, 0xed, 0x9d, 0xe7, 0xf0, 0x79, 0xce, 0x9c, 0x39, 0x73, 0xce, 0x33, 0x43, 0x5a,
+		0x50, 0xea, 0x9d, 0x12, 0x7f, 0xa7, 0x69, 0xb5, 0x88, 0xdb, 0x24, 0x3b, 0x56, 0xd7, 0xde, 0x79,
+		0xbd, 0xbb, 0xf3, 0xc6, 0xf3, 0x2f, 0xce, 0x1c, 0xef, 0xcd, 0x76, 0xd7, 0xf7, 0x42, 0x0f, 0x5d,
+		0xa3, 0x98, 0x6d, 0x8e, 0xd9, 0xb6, 0xba, 0xf6, 0xf6, 0xeb, 0xdd, 0x8d, 0x3b, 0xe7, 0x9e, 0x77,
+		0xee, 0x90, 0x1d, 0x06, 0x39, 0xed, 0x9d, 0xed, 0xb4, 0x7a, 0xbe, 0x15, 0xda, 0x9e, 0x1b, 0x91,
+		0x36, 0xee, 0x0e, 0x3f, 0x0f, 0xed, 0x0e, 0x09, 0x42, 0xab, 0xd3, 0xe5, 0x80, 0xcd, 0x71, 0x33,
+		0x37, 0xbd, 0x4e, 0x27, 0x71, 0x31, 0x36, 0xb6, 0xd0, 0x0a, 0x2e, 0x1c, 0x3b, 0x08, 0x23, 0x4c,
+		0xe9, 0xaf, 0x0b, 0x70, 0xe3, 0x98, 0x87, 0xab, 0xbc, 0x25, 0xcd, 0x1e, 0x0d, 0x41, 0x75, 0xcf,
+		0x3c, 0xd4, 0x00, 0x14, 0xaf, 0xc3, 0x24, 0xf1, 0x93, 0xa2, 0xb0, 0x29, 0x6c, 0x15, 0x1e, 0x3f,
+		0xd8, 0x1e, 0xb3, 0xa4, 0xed, 0x11, 0x3f, 0x78, 0xed, 0xcd, 0xb0, 0x09, 0x7d, 0x0e, 0x73, 0xe1,
+		0x65, 0x97, 0x14, 0x73, 0xcc, 0xd1, 0xbd, 0xa9, 0x8e, 0x8c, 0xcb, 0x2e, 0xc1, 0x0c, 0x8e, 0xbe,
+		0x06, 0x08, 0x42, 0xcb, 0x0f, 0x4d, 0x9a, 0x86, 0x62, 0x9e, 0x91, 0x37, 0xb6, 0xa3, 0x1c, 0x6d,
+		0xc7, 0x39, 0xda, 0x36, 0xe2, 0x1c, 0xe1, 0x25, 0x86, 0xa6, 0x63, 0x4a, 0x6d, 0x3a, 0x5e, 0x40,
+		0x22, 0xea, 0x5c, 0x36, 0x95, 0xa1, 0x19, 0xd5, 0x80, 0xe5, 0x88, 0x1a, 0x84, 0x56, 0xd8, 0x0b,
+		0x8a, 0xf3, 0x9b, 0xc2, 0xd6, 0xca, 0xe3, 0xdd, 0xd9, 0x56, 0x2f, 0x53, 0xa6, 0xce, 0x88, 0xb8,
+		0xd0, 0xec, 0x0f, 0xd0, 0x7d, 0x58, 0x69, 0xdb, 0x41, 0xe8, 0xf9, 0x97, 0xa6, 0x43, 0xdc, 0xf3,
+		0xb0, 0x5d, 0x5c, 0xd8, 0x14, 0xb6, 0xf2, 0xf8, 0x2a, 0xb7, 0x56, 0x99, 0x11, 0xfd, 0x04, 0x6e,
+		0x74, 0x2d, 0x9f, 0xb8, 0x61, 0x3f, 0xfd, 0xa6, 0xed, 0x9e, 0x79, 0xc5, 0x2b, 0x6c, 0x09, 0x5b,
+		0x63, 0xa3, 0xa8, 0x33, 0xc6, 0xc0, 0x4e, 0xe2, 0x6b, 0xdd, 0x51, 0x23, 0x92, 0x60, 0xa5, 0xef,
+		0x96, 0x65, 0x66, 0x31, 0x33, 0x33, 0x57, 0x13, 0x06, 0xcb, 0xce, 0x23, 0x98, 0xeb, 0x90, 0x8e,
+		0x57, 0x5c, 0x62, 0xc4, 0x5b, 0x63, 0xe3, 0x39, 0x22, 0x1d, 0x0f, 0x33, 0x18, 0xc2, 0xb0, 0x16,
+		0x10, 0xcb, 0x6f, 0xb6, 0x4d, 0x2b, 0x0c, 0x7d, 0xfb, 0xb4, 0x17, 0x92, 0xa0, 0x08, 0x8c, 0x7b,
+		0x7f, 0x2c, 0x57, 0x67, 0x68, 0x29, 0x01, 0x63, 0x31, 0x18, 0xb2, 0xa0, 0x2a, 0xac, 0x59, 0xbd,
+		0xd0, 0x33, 0x7d, 0x12, 0x90, 0xd0, 0xec, 0x7a, 0xb6, 0x1b, 0x06, 0xc5, 0x02, 0xf3, 0xb9, 0x39,
+		0xd6, 0x27, 0xa6, 0xc0, 0x3a, 0xc3, 0xe1, 0x55, 0x4a, 0x4d, 0x19, 0xd0, 0x6d, 0x58, 0xa2, 0xed,
+		0x61, 0xd2, 0xfe, 0x28, 0x2e, 0x6f, 0x0a, 0x5b, 0x4b, 0x78, 0x91, 0x1a, 0xaa, 0x76, 0x10, 0xa2,
+		0x75, 0xb8, 0x62, 0x07, 0x66, 0xd3, 0xf7, 0xdc, 0xe2, 0xd5, 0x4d, 0x61, 0x6b, 0x11, 0x2f, 0xd8,
+		0x81, 0xec, 0x7b, 0x6e, 0xe9, 0x57, 0x39, 0xb8, 0x33, 0xba, 0xf9, 0x9e, 0x7b, 0x66, 0x9f, 0xf3,
+		0x96, 0x46, 0x4f, 0xd2, 0x8e, 0xa3, 0x16, 0x7a, 0x7f, 0x6c, 0x78, 0x06, 0x9f, 0x2d, 0x35, 0xaf,
+		0x05, 0x9b, 0xfd, 0x8d, 0xe2, 0x3d, 0xe0, 0x99, 0xfd, 0x8a, 0xf6, 0x7a, 0x21, 0x6f, 0xa6, 0x5b,
+		0x23, 0x5b, 0x57, 0xe1, 0x01, 0xe0, 0xf7, 0x12, 0x17, 0x3a, 0xeb, 0x0b, 0x4f, 0x8e, 0x6b, 0xdc,
+		0xeb, 0x85, 0xe8, 0x18, 0x6e, 0xb3, 0xf0, 0x26, 0x78, 0xcf, 0x67, 0x79, 0x5f, 0xa7, 0xec, 0x31,
+		0x8e, 0x4b, 0xff, 0x10, 0xe0, 0xda, 0x98, 0x8a, 0xa4, 0x89, 0x6e, 0x79, 0x1d, 0xcb, 0x76, 0x4d,
+		0xbb, 0xc5, 0xf2, 0xb1, 0x84, 0x17, 0x23, 0x83, 0xda, 0x42, 0x77, 0xa1, 0xc0, 0x1f, 0xba, 0x56,
+		0x27, 0x12, 0x8a, 0x25, 0x0c, 0x91, 0x49, 0xb3, 0x3a, 0x64, 0x82, 0x32, 0xe5, 0xff, 0x5b, 0x65,
+		0xba, 0x07, 0xcb, 0xb6, 0x6b, 0x87, 0xb6, 0x15, 0x92, 0x16, 0x8d, 0x6b, 0x8e, 0x35, 0x65, 0x21,
+		0xb1, 0xa9, 0xad, 0xd2, 0x2f, 0x05, 0xb8, 0xa1, 0xbc, 0x0d, 0x89, 0xef, 0x5a, 0xce, 0xf7, 0xa2,
+		0x96, 0xc3, 0x31, 0xe5, 0x46, 0x63, 0xfa, 0xe7, 0x3c, 0x5c, 0xab, 0x13, 0xb7, 0x65, 0xbb, 0xe7,
+		0x52, 0x33, 0xb4, 0x5f, 0xdb, 0xe1, 0x25, 0x8b, 0xe8, 0x2e, 0x14, 0x2c, 0x3e, 0xee, 0x67, 0x19,
+		0x62, 0x93, 0xda, 0x42, 0xfb, 0x70, 0x35, 0x01, 0x64, 0x4a, 0x72, 0xec, 0x9a, 0x49, 0xf2, 0xb2,
+		0x95, 0x1a, 0xa1, 0x67, 0x30, 0x4f, 0xe5, 0x31, 0x52, 0xe5, 0x95, 0xc7, 0x0f, 0xc7, 0xeb, 0xd2,
+		0x60, 0x84, 0x54, 0x09, 0x09, 0x8e, 0x78, 0x48, 0x85, 0xb5, 0x36, 0xb1, 0xfc, 0xf0, 0x94, 0x58,
+		0xa1, 0xd9, 0x22, 0xa1, 0x65, 0x3b, 0x01, 0xd7, 0xe9, 0xf7, 0x26, 0x88, 0xdc, 0xa5, 0xe3, 0x59,
+		0x2d, 0x2c, 0x26, 0xb4, 0x4a, 0xc4, 0x42, 0x2f, 0xe0, 0x9a, 0x63, 0x05, 0xa1, 0xd9, 0xf7, 0xc7,
+		0xa4, 0x6d, 0x3e, 0x53, 0xda, 0xd6, 0x28, 0xed, 0x20, 0x66, 0x51, 0x3b, 0xda, 0x07, 0x66, 0x8c,
+		0xba, 0x82, 0xb4, 0x22, 0x4f, 0x0b, 0x99, 0x9e, 0x56, 0x29, 0x49, 0x8f, 0x38, 0xcc, 0x4f, 0x11,
+		0xae, 0x58, 0x61, 0x48, 0x3a, 0xdd, 0x90, 0x29, 0xf7, 0x3c, 0x8e, 0x87, 0xe8, 0x21, 0x88, 0x1d,
+		0xeb, 0xad, 0xdd, 0xe9, 0x75, 0x4c, 0x6e, 0x0a, 0x98, 0x0a, 0xcf, 0xe3, 0x55, 0x6e, 0x97, 0xb8,
+		0x99, 0xca, 0x75, 0xd0, 0x6c, 0x93, 0x56, 0xcf, 0x89, 0x23, 0x59, 0xca, 0x96, 0xeb, 0x84, 0xc1,
+		0xe2, 0x90, 0x61, 0x95, 0xbc, 0xed, 0xda, 0x51, 0xcf, 0x46, 0x3e, 0x20, 0xd3, 0xc7, 0x4a, 0x9f,
+		0xc2, 0x9c, 0x3c, 0x83, 0x65, 0x96, 0x94, 0x33, 0xcb, 0x76, 0x7a, 0x3e, 0x29, 0x16, 0xa6, 0x6c,
+		0xd3, 0x7e, 0x84, 0xc1, 0x05, 0xca, 0xe0, 0x03, 0xf4, 0x09, 0x5c, 0x67, 0x0e, 0x68, 0xad, 0x13,
+		0xdf, 0xb4, 0x5b, 0xc4, 0x0d, 0xed, 0xf0, 0x92, 0xcb, 0x2d, 0xa2, 0xcf, 0x8e, 0xd9, 0x23, 0x95,
+		0x3f, 0x29, 0xfd, 0x21, 0x07, 0xb7, 0x78, 0xf9, 0xc8, 0x6d, 0xdb, 0x69, 0x7d, 0x2f, 0x8d, 0xf7,
+		0x71, 0xca, 0x2d, 0x6d, 0x8e, 0xb4, 0x16, 0x89, 0x6f, 0x52, 0xf7, 0x13, 0xa6, 0x48, 0xc3, 0x6d,
+		0x9a, 0x1f, 0x69, 0x53, 0xf4, 0x12, 0xf8, 0x31, 0xcc, 0xc5, 0xb5, 0xeb, 0x39, 0x76, 0xf3, 0x92,
+		0x95, 0xf9, 0xca, 0x84, 0x40, 0x23, 0xe5, 0x64, 0x82, 0x5a, 0x67, 0x68, 0xbc, 0xd6, 0x1d, 0x36,
+		0xa1, 0x9b, 0xb0, 0x10, 0x49, 0x23, 0x2b, 0xf2, 0x25, 0xcc, 0x47, 0xa5, 0xbf, 0xe7, 0x12, 0x59,
+		0xa8, 0x90, 0xa6, 0x1d, 0xc4, 0xf9, 0x4a, 0xba, 0x55, 0xc8, 0xee, 0xd6, 0x98, 0x38, 0xd0, 0xad,
+		0xa3, 0x95, 0x98, 0x7b, 0xd7, 0x4a, 0xfc, 0x06, 0x96, 0x07, 0x9a, 0x2a, 0xfb, 0x3a, 0x57, 0x08,
+		0xc6, 0x37, 0xd4, 0xdc, 0x60, 0x43, 0x61, 0x58, 0xf7, 0x7c, 0xfb, 0xdc, 0x76, 0x2d, 0xc7, 0x1c,
+		0x0a, 0x32, 0x5b, 0x02, 0x6e, 0xc4, 0x54, 0x3d, 0x1d, 0x6c, 0xe9, 0x3b, 0x01, 0xd6, 0xe2, 0x92,
+		0xa9, 0x5b, 0xbd, 0x80, 0xb0, 0x34, 0xde, 0x84, 0x05, 0x9f, 0x58, 0x01, 0x2f, 0xb5, 0x25, 0xcc,
+		0x47, 0x68, 0x03, 0x16, 0x93, 0x92, 0x8e, 0xaa, 0x25, 0x19, 0xa3, 0xa7, 0x50, 0xe8, 0x52, 0x07,
+		0x33, 0xaf, 0x1a, 0x22, 0x38, 0x5b, 0xf4, 0x43, 0x10, 0x2d, 0x87, 0x56, 0x23, 0xd7, 0x5e, 0x9b,
+		0x44, 0x1a, 0xb9, 0x88, 0x57, 0x99, 0x5d, 0x4a, 0xcc, 0xa5, 0xdf, 0x0b, 0x80, 0x64, 0xaf, 0xd3,
+		0x75, 0x08, 0xbb, 0x89, 0x58, 0x8e, 0x73, 0x6a, 0x35, 0x2f, 0x90, 0x08, 0xf9, 0x9e, 0xef, 0xf0,
+		0x78, 0xe9, 0x9f, 0x48, 0x83, 0x2b, 0x6d, 0x62, 0xb5, 0x88, 0x1f, 0x14, 0x73, 0x9b, 0xf9, 0xad,
+		0xc2, 0xe3, 0xcf, 0xc6, 0x56, 0xc3, 0xa8, 0xaf, 0xed, 0x83, 0x88, 0xa6, 0xb8, 0xa1, 0x7f, 0x89,
+		0x63, 0x27, 0x1b, 0x4f, 0x60, 0x39, 0xfd, 0x80, 0xce, 0x78, 0x41, 0x2e, 0xe3, 0x19, 0x2f, 0xc8,
+		0x25, 0xba, 0x0e, 0xf3, 0xaf, 0x2d, 0xa7, 0x17, 0x77, 0x52, 0x34, 0x78, 0x92, 0xfb, 0x4a, 0x28,
+		0xfd, 0x29, 0x07, 0x37, 0x47, 0x27, 0x62, 0xb9, 0x1e, 0x0d, 0x7c, 0x2f, 0x2e, 0xe2, 0x1c, 0x2b,
+		0xe2, 0x8f, 0x67, 0x0c, 0x7b, 0xa0, 0x8e, 0x53, 0x55, 0x94, 0x1f, 0xac, 0xa2, 0x58, 0xf8, 0xf9,
+		0x78, 0xd6, 0xf7, 0x06, 0x26, 0xfc, 0x5c, 0xb0, 0xe3, 0x03, 0xc4, 0x25, 0x6f, 0x87, 0xfc, 0x64,
+		0xd7, 0xe1, 0x2a, 0x25, 0xa5, 0xfd, 0xdc, 0x1b, 0xd2, 0xdc, 0x05, 0x96, 0x88, 0xb4, 0xaa, 0x96,
+		0xfe, 0x9c, 0x83, 0x5b, 0xf1, 0xd9, 0x5a, 0xf5, 0x9a, 0x96, 0x53, 0xb1, 0x83, 0xae, 0x15, 0x36,
+		0xdb, 0xb3, 0x5d, 0x05, 0xfe, 0xf7, 0x3d, 0xfd, 0x53, 0xb8, 0x33, 0x18, 0x81, 0xe9, 0x9d, 0x99,
+		0x61, 0xdb, 0x0e, 0xcc, 0x74, 0xab, 0x4f, 0x77, 0xb8, 0x31, 0x10, 0x51, 0xed, 0xcc, 0x68, 0xdb,
+		0x01, 0xcf, 0x23, 0x7a, 0x1f, 0x80, 0x5d, 0x71, 0x43, 0xef, 0x82, 0x44, 0x52, 0xb9, 0x8c, 0xd9,
+		0x9d, 0xdc, 0xa0, 0x86, 0xd2, 0x0b, 0x28, 0xa4, 0x5f, 0x04, 0x9e, 0xc2, 0x02, 0x7f, 0x97, 0x10,
+		0x58, 0x5f, 0x7c, 0x90, 0xf1, 0x2e, 0xc1, 0x5e, 0xb3, 0x38, 0xa5, 0xf4, 0x5d, 0x0e, 0x56, 0x06,
+		0x1f, 0xa1, 0x8f, 0x60, 0xf5, 0xd4, 0x76, 0x2d, 0xff, 0xd2, 0x6c, 0xb6, 0x49, 0xf3, 0x22, 0xe8,
+		0x75, 0xf8, 0x26, 0xac, 0x44, 0x66, 0x99, 0x5b, 0xd1, 0x0d, 0x58, 0xf0, 0x7b, 0x6e, 0x7c, 0xd3,
+		0x5b, 0xc2, 0xf3, 0x7e, 0x8f, 0x5e, 0x89, 0xbf, 0x81, 0xdb, 0x67, 0xb6, 0x1f, 0xd0, 0xdb, 0x51,
+		0xa4, 0xc8, 0x66, 0x33, 0x2a, 0xee, 0xf4, 0x71, 0x53, 0x64, 0x90, 0x58, 0xb3, 0xe5, 0x18, 0xc0,
+		0xe8, 0xcb, 0x4d, 0x9f, 0x58, 0xc9, 0xde, 0x64, 0xa7, 0xb2, 0xc0, 0xf1, 0xfc, 0xcc, 0xbf, 0xca,
+		0x6e, 0x01, 0xb6, 0x7b, 0x3e, 0x6b, 0x0d, 0x2f, 0xc7, 0x04, 0xe6, 0xe0, 0x0e, 0x00, 0x7b, 0x41,
+		0x0b, 0xad, 0x53, 0x27, 0x2a, 0xdf, 0x45, 0x9c, 0xb2, 0x94, 0x7f, 0x27, 0xc0, 0xf5, 0x71, 0x17,
+		0x44, 0x54, 0x82, 0x3b, 0x75, 0x45, 0xab, 0xa8, 0xda, 0x73, 0x53, 0x92, 0x0d, 0xf5, 0xa5, 0x6a,
+		0x9c, 0x98, 0xba, 0x21, 0x19, 0x8a, 0xa9, 0x6a, 0x2f, 0xa5, 0xaa, 0x5a, 0x11, 0xff, 0x0f, 0x7d,
+		0x08, 0x9b, 0x13, 0x30, 0xba, 0x7c, 0xa0, 0x54, 0x1a, 0x55, 0xa5, 0x22, 0x0a, 0x53, 0x3c, 0xe9,
+		0x86, 0x84, 0x0d, 0xa5, 0x22, 0xe6, 0xd0, 0xff, 0xc3, 0x47, 0x13, 0x30, 0xb2, 0xa4, 0xc9, 0x4a,
+		0xd5, 0xc4, 0xca, 0x8f, 0x1b, 0x8a, 0x4e, 0xc1, 0xf9, 0xf2, 0xcf, 0xfa, 0x31, 0x0f, 0x1c, 0x93,
+		0xe9, 0x99, 0x2a, 0x8a, 0xac, 0xea, 0x6a, 0x4d, 0x9b, 0x16, 0xf3, 0x10, 0x66, 0x42, 0xcc, 0xc3,
+		0xa8, 0x38, 0xe6, 0xf2, 0xcf, 0x73, 0xfd, 0xef, 0x37, 0x6a, 0x0b, 0x93, 0x5e, 0x72, 0x31, 0xf8,
+		0x10, 0x36, 0x8f, 0x6b, 0xf8, 0x70, 0xbf, 0x5a, 0x3b, 0x36, 0xd5, 0x8a, 0x89, 0x95, 0x86, 0xae,
+		0x98, 0xf5, 0x5a, 0x55, 0x95, 0x4f, 0x52, 0x91, 0x7c, 0x05, 0x9f, 0x4d, 0x44, 0x49, 0x55, 0x6a,
+		0xad, 0x34, 0xea, 0x55, 0x55, 0xa6, 0xb3, 0xee, 0x4b, 0x6a, 0x55, 0xa9, 0x98, 0x35, 0xad, 0x7a,
+		0x22, 0x0a, 0xe8, 0x63, 0xd8, 0x9a, 0x95, 0x29, 0xe6, 0xd0, 0x23, 0x78, 0x38, 0x11, 0x8d, 0x95,
+		0x17, 0x8a, 0x6c, 0xa4, 0xe0, 0x79, 0xb4, 0x0b, 0x8f, 0x26, 0xc2, 0x0d, 0x05, 0x1f, 0xa9, 0x1a,
+		0x4b, 0xe8, 0xbe, 0x89, 0x1b, 0x9a, 0xa6, 0x6a, 0xcf, 0xc5, 0xb9, 0xf2, 0x6f, 0x04, 0x58, 0x1b,
+		0xb9, 0x31, 0xa1, 0xbb, 0x70, 0xbb, 0x2e, 0x61, 0x45, 0x33, 0x4c, 0xb9, 0x5a, 0x1b, 0x97, 0x80,
+		0x09, 0x00, 0x69, 0x4f, 0xd2, 0x2a, 0x35, 0x4d, 0x14, 0xd0, 0x03, 0x28, 0x8d, 0x03, 0xf0, 0x5a,
+		0xe0, 0xa5, 0x21, 0xe6, 0xd0, 0x3d, 0x78, 0x7f, 0x1c, 0x2e, 0x89, 0x56, 0xcc, 0x97, 0xff, 0x28,
+		0xc0, 0xfa, 0x84, 0x53, 0x09, 0xdd, 0x87, 0x7b, 0x72, 0xed, 0xa8, 0x5e, 0x55, 0x0c, 0xba, 0xcd,
+		0xb2, 0x54, 0xad, 0xee, 0x49, 0xf2, 0xe1, 0x48, 0xe5, 0x4c, 0x85, 0xf1, 0x6a, 0x11, 0x05, 0xf4,
+		0x11, 0x7c, 0x30, 0x19, 0xa6, 0x37, 0x64, 0x59, 0x51, 0x2a, 0xac, 0xe6, 0x3f, 0x84, 0xcd, 0xc9,
+		0xc0, 0x68, 0xc3, 0xc5, 0x7c, 0xf9, 0x5f, 0x39, 0x78, 0x6f, 0xda, 0xf7, 0x2d, 0xda, 0x3a, 0xc9,
+		0x7e, 0x29, 0xaf, 0x14, 0xb9, 0x11, 0xb9, 0x63, 0x89, 0xa0, 0xbe, 0x1a, 0x7a, 0x6a, 0x0d, 0xe9,
+		0x5a, 0x98, 0x00, 0xe6, 0x31, 0xb1, 0x36, 0x28, 0xc3, 0x83, 0x2c, 0x38, 0x0f, 0x34, 0x37, 0x50,
+		0x94, 0x93, 0x5c, 0xb3, 0x0d, 0xa3, 0xcb, 0x42, 0xdb, 0x50, 0xce, 0x42, 0x27, 0xdb, 0x57, 0x11,
+		0xe7, 0xd0, 0x67, 0xf0, 0x49, 0x76, 0xe0, 0x9a, 0xa1, 0x6a, 0x0d, 0xa5, 0x62, 0x4a, 0xba, 0xa9,
+		0x29, 0xc7, 0xe2, 0xfc, 0x2c, 0xcb, 0x35, 0xd4, 0x23, 0xda, 0x58, 0x0d, 0x43, 0x5c, 0x28, 0xff,
+		0x45, 0xa0, 0x17, 0x21, 0x37, 0xb4, 0xdd, 0x1e, 0x91, 0x02, 0x8d, 0xbc, 0x51, 0xa3, 0xb7, 0x08,
+		0xcf, 0x8f, 0x36, 0x3f, 0xf2, 0xcf, 0xdd, 0x9b, 0xaa, 0xa6, 0x1a, 0xaa, 0x64, 0xd4, 0xf0, 0x70,
+		0x8d, 0x4c, 0x82, 0x51, 0x25, 0xa9, 0x28, 0x38, 0xca, 0xeb, 0x64, 0x18, 0x56, 0x0c, 0x7c, 0xc2,
+		0x6b, 0x38, 0x92, 0xc6, 0xc9, 0x58, 0x19, 0xd7, 0xb4, 0x44, 0xb8, 0xc4, 0x7c, 0xf9, 0xb7, 0x02,
+		0x14, 0xf8, 0x17, 0x20, 0xf6, 0x81, 0xa0, 0x08, 0xd7, 0xe9, 0x02, 0x6b, 0x0d, 0xc3, 0x34, 0x4e,
+		0xea, 0xca, 0x60, 0xf3, 0x0d, 0x3c, 0x61, 0xba, 0x66, 0x1a, 0xb5, 0x28, 0x3b, 0x91, 0x04, 0x0e,
+		0x02, 0xf8, 0x2c, 0x14, 0xc3, 0xc0, 0x62, 0x6e, 0x2a, 0x26, 0xf2, 0x93, 0x47, 0x1b, 0x70, 0x73,
+		0x00, 0x73, 0xa0, 0x48, 0xd8, 0xd8, 0x53, 0x24, 0x43, 0x9c, 0x2b, 0xff, 0x5a, 0x80, 0x5b, 0xb1,
+		0x84, 0xd3, 0xef, 0x6f, 0x34, 0xf4, 0x56, 0xad, 0x17, 0xca, 0xf4, 0xf2, 0x8d, 0x1e, 0xc2, 0xfd,
+		0x44, 0x7c, 0x0d, 0x49, 0x3f, 0xec, 0xef, 0x95, 0x29, 0x4b, 0x0d, 0x3d, 0xbd, 0x9a, 0x4c, 0x28,
+		0x0f, 0x21, 0xea, 0xcf, 0xe9, 0x50, 0xac, 0xe8, 0x8a, 0x21, 0xe6, 0xca, 0xbf, 0x58, 0x86, 0xf5,
+		0x74, 0x70, 0xf4, 0xc2, 0x47, 0x5a, 0x51, 0x68, 0x0f, 0xa0, 0x34, 0xe8, 0x84, 0x0b, 0xf4, 0x70,
+		0x5c, 0xbb, 0xf0, 0x68, 0x0a, 0xae, 0xa1, 0x1d, 0x48, 0x5a, 0x85, 0x8e, 0x63, 0x90, 0x28, 0xa0,
+		0x67, 0xf0, 0x74, 0x0a, 0x65, 0x4f, 0xaa, 0xf4, 0xb3, 0x9c, 0x1c, 0x95, 0x92, 0x61, 0x60, 0x75,
+		0xaf, 0x61, 0x28, 0xba, 0x98, 0x43, 0x0a, 0x48, 0x19, 0x0e, 0x06, 0x05, 0x74, 0xac, 0x9b, 0x3c,
+		0xfa, 0x1a, 0x3e, 0xcf, 0x8a, 0x23, 0x2a, 0x19, 0xf5, 0x48, 0xc1, 0x69, 0xea, 0x1c, 0x7a, 0x02,
+		0x5f, 0x64, 0x50, 0xf9, 0xcc, 0x23, 0xdc, 0x79, 0xf4, 0x14, 0xbe, 0xcc, 0x8c, 0x5e, 0xae, 0xe1,
+		0x8a, 0x79, 0x24, 0xe1, 0xc3, 0x41, 0xf2, 0x02, 0x52, 0x41, 0xc9, 0x9a, 0x98, 0xab, 0x9b, 0x39,
+		0x46, 0x17, 0x52, 0xae, 0xae, 0xcc, 0x90, 0x45, 0x6a, 0xc8, 0x70, 0xb3, 0x88, 0x9e, 0x83, 0x3c,
+		0x5b, 0x2a, 0xa6, 0x3b, 0x5a, 0x42, 0xaf, 0xc0, 0x78, 0xb7, 0x5d, 0x55, 0x5e, 0x19, 0x0a, 0xd6,
+		0xa4, 0x2c, 0xcf, 0x80, 0xbe, 0x81, 0xaf, 0x33, 0x93, 0x36, 0xa8, 0x3f, 0x29, 0x7a, 0x01, 0x7d,
+		0x09, 0x9f, 0x4e, 0xa1, 0xa7, 0x6b, 0xa4, 0x7f, 0x9d, 0x51, 0x2b, 0xe2, 0x32, 0xfa, 0x1c, 0x76,
+		0xa7, 0x10, 0x59, 0x17, 0x9a, 0xba, 0xa1, 0xca, 0x87, 0x27, 0xd1, 0xe3, 0xaa, 0xaa, 0x1b, 0xe2,
+		0x55, 0xf4, 0x23, 0xf8, 0xc1, 0x14, 0x5a, 0xb2, 0x58, 0xfa, 0x87, 0x82, 0x53, 0x2d, 0x46, 0x61,
+		0x0d, 0xac, 0x88, 0x2b, 0x33, 0xec, 0x89, 0xae, 0x3e, 0xcf, 0xce, 0xdc, 0x2a, 0x92, 0xe1, 0xd9,
+		0x4c, 0x2d, 0x22, 0x1f, 0xa8, 0xd5, 0xca, 0x78, 0x27, 0x22, 0xfa, 0x14, 0x76, 0xa6, 0x38, 0xd9,
+		0xaf, 0x61, 0x59, 0xe1, 0x27, 0x56, 0x22, 0x12, 0x6b, 0xe8, 0x0b, 0x78, 0x3c, 0x8d, 0x24, 0xa9,
+		0xd5, 0xda, 0x4b, 0x05, 0x0f, 0xf3, 0x10, 0x3d, 0x46, 0x67, 0x5b, 0xba, 0xaa, 0xd5, 0x1b, 0x86,
+		0xa9, 0xab, 0xdf, 0x2a, 0xe2, 0x35, 0x7a, 0x8c, 0x66, 0xee, 0x54, 0x9c, 0x2b, 0xf1, 0xfa, 0xa8,
+		0x18, 0x8f, 0x4c, 0xb2, 0xa7, 0x6a, 0x12, 0x3e, 0x11, 0x6f, 0x64, 0xd4, 0xde, 0xa8, 0xd0, 0x0d,
+		0x94, 0xd0, 0xcd, 0x59, 0x96, 0xa3, 0x48, 0x58, 0x3e, 0x48, 0x67, 0x7c, 0x3d, 0xa3, 0xf0, 0xe2,
+		0x7b, 0x7f, 0x55, 0x3d, 0x52, 0x0d, 0x53, 0x79, 0xc5, 0xef, 0x6b, 0x45, 0x7a, 0x58, 0xdd, 0x63,
+		0x5f, 0x41, 0x47, 0xae, 0x63, 0xe9, 0x93, 0x61, 0x17, 0x1e, 0x45, 0xdb, 0x3d, 0xa6, 0x78, 0x26,
+		0x1c, 0x12, 0x7b, 0xf0, 0xc3, 0xd9, 0x28, 0xc9, 0x73, 0xa9, 0x8a, 0x15, 0xa9, 0x72, 0x92, 0x5c,
+		0xc1, 0x85, 0xf2, 0xdf, 0x04, 0x28, 0xcb, 0x96, 0xdb, 0x24, 0x4e, 0xfc, 0x4f, 0x92, 0xa9, 0x51,
+		0x3e, 0x85, 0x2f, 0x67, 0x90, 0x89, 0x09, 0xf1, 0x1e, 0x83, 0xfe, 0xae, 0xe4, 0x86, 0x76, 0xa8,
+		0xd5, 0x8e, 0xb5, 0x69, 0x04, 0xbe, 0x08, 0xdd, 0x3e, 0x77, 0xad, 0x99, 0x17, 0xc1, 0xab, 0xf5,
+		0x3f, 0x5b, 0xc4, 0xbb, 0x92, 0x67, 0x5a, 0xc4, 0xde, 0x2b, 0x58, 0x6f, 0x7a, 0x9d, 0x71, 0x5f,
+		0x2d, 0xf6, 0x16, 0xa5, 0xae, 0x5d, 0xa7, 0x6f, 0xec, 0x75, 0xe1, 0xdb, 0xdd, 0x73, 0x3b, 0x6c,
+		0xf7, 0x4e, 0xb7, 0x9b, 0x5e, 0x67, 0x27, 0xfd, 0x63, 0x81, 0x47, 0x76, 0xcb, 0xd9, 0x39, 0xf7,
+		0xa2, 0x1f, 0x1f, 0xf0, 0x5f, 0x0e, 0x3c, 0xb5, 0xba, 0xf6, 0xeb, 0xdd, 0xd3, 0x05, 0x66, 0xfb,
+		0xf4, 0xdf, 0x03, 0x00, 0x3a, 0x4a, 0x64, 0xf9, 0xf9, 0x20, 0x00, 0x00,
 	},
 }
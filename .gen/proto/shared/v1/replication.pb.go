@@ -51,14 +51,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ReplicationTaskType int32
 
 const (
-	ReplicationTaskType_REPLICATION_TASK_TYPE_INVALID           ReplicationTaskType = 0
-	ReplicationTaskType_REPLICATION_TASK_TYPE_DOMAIN            ReplicationTaskType = 1
-	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY           ReplicationTaskType = 2
-	ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS ReplicationTaskType = 3
-	ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_ACTIVITY     ReplicationTaskType = 4
-	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY_METADATA  ReplicationTaskType = 5
-	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY_V2        ReplicationTaskType = 6
-	ReplicationTaskType_REPLICATION_TASK_TYPE_FAILOVER_MARKER   ReplicationTaskType = 7
+	ReplicationTaskType_REPLICATION_TASK_TYPE_INVALID                   ReplicationTaskType = 0
+	ReplicationTaskType_REPLICATION_TASK_TYPE_DOMAIN                    ReplicationTaskType = 1
+	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY                   ReplicationTaskType = 2
+	ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS         ReplicationTaskType = 3
+	ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_ACTIVITY             ReplicationTaskType = 4
+	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY_METADATA          ReplicationTaskType = 5
+	ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY_V2                ReplicationTaskType = 6
+	ReplicationTaskType_REPLICATION_TASK_TYPE_FAILOVER_MARKER           ReplicationTaskType = 7
+	ReplicationTaskType_REPLICATION_TASK_TYPE_FRAME                     ReplicationTaskType = 8
+	ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_COMPLETION_CALLBACKS ReplicationTaskType = 9
 )
 
 var ReplicationTaskType_name = map[int32]string{
@@ -70,17 +72,21 @@ var ReplicationTaskType_name = map[int32]string{
 	5: "REPLICATION_TASK_TYPE_HISTORY_METADATA",
 	6: "REPLICATION_TASK_TYPE_HISTORY_V2",
 	7: "REPLICATION_TASK_TYPE_FAILOVER_MARKER",
+	8: "REPLICATION_TASK_TYPE_FRAME",
+	9: "REPLICATION_TASK_TYPE_SYNC_COMPLETION_CALLBACKS",
 }
 
 var ReplicationTaskType_value = map[string]int32{
-	"REPLICATION_TASK_TYPE_INVALID":           0,
-	"REPLICATION_TASK_TYPE_DOMAIN":            1,
-	"REPLICATION_TASK_TYPE_HISTORY":           2,
-	"REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS": 3,
-	"REPLICATION_TASK_TYPE_SYNC_ACTIVITY":     4,
-	"REPLICATION_TASK_TYPE_HISTORY_METADATA":  5,
-	"REPLICATION_TASK_TYPE_HISTORY_V2":        6,
-	"REPLICATION_TASK_TYPE_FAILOVER_MARKER":   7,
+	"REPLICATION_TASK_TYPE_INVALID":                   0,
+	"REPLICATION_TASK_TYPE_DOMAIN":                    1,
+	"REPLICATION_TASK_TYPE_HISTORY":                   2,
+	"REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS":         3,
+	"REPLICATION_TASK_TYPE_SYNC_ACTIVITY":             4,
+	"REPLICATION_TASK_TYPE_HISTORY_METADATA":          5,
+	"REPLICATION_TASK_TYPE_HISTORY_V2":                6,
+	"REPLICATION_TASK_TYPE_FAILOVER_MARKER":           7,
+	"REPLICATION_TASK_TYPE_FRAME":                     8,
+	"REPLICATION_TASK_TYPE_SYNC_COMPLETION_CALLBACKS": 9,
 }
 
 func (x ReplicationTaskType) String() string {
@@ -231,6 +237,8 @@ type ReplicationTask struct {
 	//	*ReplicationTask_SyncActivityTaskAttributes
 	//	*ReplicationTask_HistoryTaskV2Attributes
 	//	*ReplicationTask_FailoverMarkerAttributes
+	//	*ReplicationTask_FrameAttributes
+	//	*ReplicationTask_SyncCompletionCallbacksTaskAttributes
 	Attributes           isReplicationTask_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
@@ -291,12 +299,20 @@ type ReplicationTask_HistoryTaskV2Attributes struct {
 type ReplicationTask_FailoverMarkerAttributes struct {
 	FailoverMarkerAttributes *FailoverMarkerAttributes `protobuf:"bytes,8,opt,name=failover_marker_attributes,json=failoverMarkerAttributes,proto3,oneof" json:"failover_marker_attributes,omitempty"`
 }
+type ReplicationTask_FrameAttributes struct {
+	FrameAttributes *ReplicationTaskFrameAttributes `protobuf:"bytes,9,opt,name=frame_attributes,json=frameAttributes,proto3,oneof" json:"frame_attributes,omitempty"`
+}
+type ReplicationTask_SyncCompletionCallbacksTaskAttributes struct {
+	SyncCompletionCallbacksTaskAttributes *SyncCompletionCallbacksTaskAttributes `protobuf:"bytes,10,opt,name=sync_completion_callbacks_task_attributes,json=syncCompletionCallbacksTaskAttributes,proto3,oneof" json:"sync_completion_callbacks_task_attributes,omitempty"`
+}
 
-func (*ReplicationTask_DomainTaskAttributes) isReplicationTask_Attributes()          {}
-func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes() {}
-func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()    {}
-func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()       {}
-func (*ReplicationTask_FailoverMarkerAttributes) isReplicationTask_Attributes()      {}
+func (*ReplicationTask_DomainTaskAttributes) isReplicationTask_Attributes()                  {}
+func (*ReplicationTask_SyncShardStatusTaskAttributes) isReplicationTask_Attributes()         {}
+func (*ReplicationTask_SyncActivityTaskAttributes) isReplicationTask_Attributes()            {}
+func (*ReplicationTask_HistoryTaskV2Attributes) isReplicationTask_Attributes()               {}
+func (*ReplicationTask_FailoverMarkerAttributes) isReplicationTask_Attributes()              {}
+func (*ReplicationTask_FrameAttributes) isReplicationTask_Attributes()                       {}
+func (*ReplicationTask_SyncCompletionCallbacksTaskAttributes) isReplicationTask_Attributes() {}
 
 func (m *ReplicationTask) GetAttributes() isReplicationTask_Attributes {
 	if m != nil {
@@ -361,6 +377,20 @@ func (m *ReplicationTask) GetFailoverMarkerAttributes() *FailoverMarkerAttribute
 	return nil
 }
 
+func (m *ReplicationTask) GetFrameAttributes() *ReplicationTaskFrameAttributes {
+	if x, ok := m.GetAttributes().(*ReplicationTask_FrameAttributes); ok {
+		return x.FrameAttributes
+	}
+	return nil
+}
+
+func (m *ReplicationTask) GetSyncCompletionCallbacksTaskAttributes() *SyncCompletionCallbacksTaskAttributes {
+	if x, ok := m.GetAttributes().(*ReplicationTask_SyncCompletionCallbacksTaskAttributes); ok {
+		return x.SyncCompletionCallbacksTaskAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -369,6 +399,8 @@ func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 		(*ReplicationTask_SyncActivityTaskAttributes)(nil),
 		(*ReplicationTask_HistoryTaskV2Attributes)(nil),
 		(*ReplicationTask_FailoverMarkerAttributes)(nil),
+		(*ReplicationTask_FrameAttributes)(nil),
+		(*ReplicationTask_SyncCompletionCallbacksTaskAttributes)(nil),
 	}
 }
 
@@ -1087,578 +1119,774 @@ func (m *SyncShardStatus) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("uber.cadence.shared.v1.ReplicationTaskType", ReplicationTaskType_name, ReplicationTaskType_value)
-	proto.RegisterEnum("uber.cadence.shared.v1.DomainOperation", DomainOperation_name, DomainOperation_value)
-	proto.RegisterEnum("uber.cadence.shared.v1.DLQType", DLQType_name, DLQType_value)
-	proto.RegisterType((*ReplicationMessages)(nil), "uber.cadence.shared.v1.ReplicationMessages")
-	proto.RegisterType((*ReplicationTask)(nil), "uber.cadence.shared.v1.ReplicationTask")
-	proto.RegisterType((*DomainTaskAttributes)(nil), "uber.cadence.shared.v1.DomainTaskAttributes")
-	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "uber.cadence.shared.v1.SyncShardStatusTaskAttributes")
-	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "uber.cadence.shared.v1.SyncActivityTaskAttributes")
-	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "uber.cadence.shared.v1.HistoryTaskV2Attributes")
-	proto.RegisterType((*FailoverMarkerAttributes)(nil), "uber.cadence.shared.v1.FailoverMarkerAttributes")
-	proto.RegisterType((*FailoverMarkerToken)(nil), "uber.cadence.shared.v1.FailoverMarkerToken")
-	proto.RegisterType((*ReplicationTaskInfo)(nil), "uber.cadence.shared.v1.ReplicationTaskInfo")
-	proto.RegisterType((*ReplicationToken)(nil), "uber.cadence.shared.v1.ReplicationToken")
-	proto.RegisterType((*SyncShardStatus)(nil), "uber.cadence.shared.v1.SyncShardStatus")
+type ReplicationDomainStatus struct {
+	DomainId string `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain   string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// number of replication tasks of the domain not acknowledged by the target cluster
+	Backlog int64 `protobuf:"varint,3,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	// creation time of the oldest replication task of the domain not acknowledged by the target cluster
+	OldestTaskTime *types.Timestamp `protobuf:"bytes,4,opt,name=oldest_task_time,json=oldestTaskTime,proto3" json:"oldest_task_time,omitempty"`
+	// number of replication tasks of the domain in the DLQ of the target cluster
+	DlqSize              int64    `protobuf:"varint,5,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/shared/v1/replication.proto", fileDescriptor_00df2ec6c2eaefe5)
+func (m *ReplicationDomainStatus) Reset()         { *m = ReplicationDomainStatus{} }
+func (m *ReplicationDomainStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationDomainStatus) ProtoMessage()    {}
+func (*ReplicationDomainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{11}
 }
-
-var fileDescriptor_00df2ec6c2eaefe5 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1a, 0x49,
-	0x12, 0x76, 0x83, 0xf8, 0x51, 0x0a, 0x01, 0x2a, 0x69, 0x2d, 0x2c, 0x59, 0x5e, 0xcc, 0xca, 0x96,
-	0x2c, 0x6f, 0x80, 0x2d, 0x87, 0x37, 0xf6, 0x27, 0x36, 0x1c, 0x6d, 0x81, 0x42, 0xbd, 0xd6, 0x9f,
-	0x8b, 0xb6, 0x1c, 0xda, 0xc3, 0x74, 0xb4, 0xe8, 0x92, 0xe8, 0x10, 0x74, 0x13, 0x5d, 0x05, 0x32,
-	0xc7, 0x99, 0xfb, 0x1c, 0x67, 0x2e, 0x73, 0x9c, 0xe7, 0x98, 0xeb, 0xc4, 0x1c, 0xfd, 0x08, 0x13,
-	0x7e, 0x92, 0x89, 0xae, 0xaa, 0x06, 0x1a, 0x1a, 0x2c, 0x87, 0x0f, 0x73, 0x53, 0x67, 0x7e, 0x5f,
-	0x66, 0x56, 0x66, 0x56, 0x66, 0x09, 0xd8, 0xee, 0x5e, 0x10, 0xaf, 0xd2, 0x30, 0x2d, 0xe2, 0x34,
-	0x48, 0x85, 0x36, 0x4d, 0x8f, 0x58, 0x95, 0xde, 0xf3, 0x8a, 0x47, 0x3a, 0x2d, 0xbb, 0x61, 0x32,
-	0xdb, 0x75, 0xca, 0x1d, 0xcf, 0x65, 0x2e, 0xba, 0xeb, 0x23, 0xcb, 0x12, 0x59, 0x16, 0xc8, 0x72,
-	0xef, 0xf9, 0xda, 0x5f, 0xaf, 0x5c, 0xf7, 0xaa, 0x45, 0x2a, 0x1c, 0x75, 0xd1, 0xbd, 0xac, 0x30,
-	0xbb, 0x4d, 0x28, 0x33, 0xdb, 0x1d, 0x41, 0x5c, 0x2b, 0x86, 0x5c, 0x98, 0x1d, 0xdb, 0xb7, 0xdf,
-	0x70, 0xdb, 0xed, 0xc0, 0x74, 0x34, 0xc2, 0x72, 0xdb, 0xa6, 0x1d, 0x20, 0x36, 0xa7, 0x84, 0xd9,
-	0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x40, 0x95, 0x7e, 0x8c, 0xc1, 0x32, 0x1e, 0x06, 0x7e, 0x44, 0x28,
-	0x35, 0xaf, 0x08, 0x45, 0x3a, 0x2c, 0x8d, 0x9c, 0xc7, 0x60, 0x26, 0xbd, 0xa6, 0x05, 0xa5, 0x18,
-	0xdf, 0x5e, 0xd8, 0xdd, 0x2a, 0x47, 0x1f, 0xab, 0x3c, 0x62, 0x47, 0x37, 0xe9, 0x35, 0xce, 0x7b,
-	0x61, 0x01, 0x45, 0xff, 0x82, 0x7b, 0x2d, 0x93, 0x32, 0xc3, 0x23, 0xcc, 0xb3, 0x49, 0x8f, 0x58,
-	0x46, 0x5b, 0x38, 0x34, 0x6c, 0xab, 0x10, 0x2b, 0x2a, 0xdb, 0x71, 0x7c, 0xd7, 0x07, 0xe0, 0x40,
-	0x2f, 0xe3, 0xd1, 0x2c, 0x74, 0x0f, 0xd2, 0x4d, 0x93, 0x1a, 0x6d, 0xd7, 0x23, 0x85, 0x78, 0x51,
-	0xd9, 0x4e, 0xe3, 0x54, 0xd3, 0xa4, 0x47, 0xae, 0x47, 0x50, 0x1d, 0x96, 0x68, 0xdf, 0x69, 0x18,
-	0x7e, 0x24, 0x96, 0x41, 0x99, 0xc9, 0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xc5, 0x5a, 0xef, 0x3b,
-	0x8d, 0xba, 0x8f, 0xaf, 0x73, 0x38, 0xce, 0xd1, 0xb0, 0xa0, 0xf4, 0x43, 0x12, 0x72, 0x63, 0x07,
-	0x42, 0x07, 0x30, 0xef, 0x27, 0xc2, 0x60, 0xfd, 0x0e, 0x29, 0x28, 0x45, 0x65, 0x3b, 0xbb, 0xfb,
-	0xf4, 0x96, 0xc9, 0xd0, 0xfb, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0x36, 0x21, 0x4b, 0xdd, 0xae,
-	0xd7, 0x20, 0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x84, 0xd4, 0x67, 0x68, 0x16, 0x7a, 0x05, 0x8b, 0x0d,
-	0x8f, 0xc8, 0x0a, 0xd8, 0x6d, 0x71, 0xf0, 0x85, 0xdd, 0xb5, 0xb2, 0xe8, 0x9f, 0x72, 0xd0, 0x3f,
-	0x65, 0x3d, 0xe8, 0x1f, 0x9c, 0x09, 0x08, 0xbe, 0x08, 0x59, 0x70, 0x57, 0xf4, 0x84, 0x70, 0x63,
-	0x32, 0xe6, 0xd9, 0x17, 0x5d, 0x46, 0x82, 0xf4, 0xfc, 0x7d, 0x5a, 0xf4, 0x55, 0xce, 0xf2, 0xc3,
-	0x50, 0x07, 0x9c, 0x83, 0x3b, 0x78, 0xc5, 0x8a, 0x90, 0xa3, 0x6f, 0x15, 0x78, 0x38, 0x51, 0x80,
-	0x09, 0x8f, 0x09, 0xee, 0xf1, 0xe5, 0x2d, 0x0b, 0x32, 0xe1, 0x7a, 0x83, 0xce, 0x02, 0xa0, 0x1b,
-	0xe0, 0x00, 0xc3, 0x6c, 0x30, 0xbb, 0x67, 0xb3, 0xfe, 0x84, 0xfb, 0x24, 0x77, 0xbf, 0x3b, 0xcb,
-	0xbd, 0x2a, 0xb9, 0x13, 0xbe, 0xd7, 0xe8, 0x54, 0x2d, 0x72, 0x60, 0x4d, 0xde, 0x28, 0xe1, 0xb2,
-	0xb7, 0x3b, 0xea, 0x35, 0xc5, 0xbd, 0x56, 0xa6, 0x79, 0x3d, 0x10, 0x4c, 0xdf, 0xe4, 0xd9, 0x6e,
-	0xc8, 0xe5, 0x6a, 0x33, 0x5a, 0x85, 0x3a, 0xb0, 0x76, 0x69, 0xda, 0x2d, 0xb7, 0x47, 0x3c, 0xa3,
-	0x6d, 0x7a, 0xd7, 0xc4, 0x1b, 0xf5, 0x97, 0xe6, 0xfe, 0x9e, 0x4d, 0xf3, 0xb7, 0x2f, 0x99, 0x47,
-	0x9c, 0x18, 0x72, 0x58, 0xb8, 0x9c, 0xa2, 0x7b, 0x9d, 0x01, 0x18, 0x7a, 0x28, 0xfd, 0x12, 0x83,
-	0x95, 0xa8, 0xee, 0x40, 0x18, 0xf2, 0xb2, 0xd7, 0xdc, 0x0e, 0xf1, 0x78, 0x0f, 0xca, 0x3b, 0xb2,
-	0x35, 0xbb, 0xcb, 0x4e, 0x02, 0x38, 0xce, 0x59, 0x61, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8d, 0x79,
-	0x1c, 0xb3, 0x2d, 0xf4, 0x02, 0x92, 0x02, 0x22, 0x6f, 0xc2, 0x7a, 0xd8, 0xb2, 0xd9, 0xb1, 0x87,
-	0x66, 0xb1, 0x84, 0xa2, 0x47, 0x90, 0x6d, 0xb8, 0xce, 0xa5, 0x7d, 0x65, 0xf4, 0x88, 0x47, 0xfd,
-	0xb0, 0xe6, 0xf8, 0x5d, 0x5b, 0x14, 0xd2, 0x33, 0x21, 0x44, 0x4f, 0x20, 0x3f, 0x48, 0x6c, 0x00,
-	0x4c, 0x70, 0x60, 0x2e, 0x90, 0x07, 0xd0, 0x7f, 0xc3, 0xbd, 0x8e, 0x47, 0x7a, 0xb6, 0xdb, 0xa5,
-	0xc6, 0x04, 0x27, 0xc9, 0x39, 0xab, 0x01, 0x60, 0x3f, 0xcc, 0x2d, 0xfd, 0xa4, 0xc0, 0xc6, 0xcc,
-	0x5e, 0xf7, 0xe3, 0x95, 0xb3, 0xa1, 0xd1, 0xea, 0x52, 0x46, 0x3c, 0x9e, 0xc6, 0x79, 0xbc, 0x28,
-	0xa4, 0x7b, 0x42, 0xe8, 0x0f, 0x44, 0x71, 0xdf, 0x64, 0x86, 0x12, 0x38, 0xc5, 0xbf, 0x35, 0x0b,
-	0xfd, 0x13, 0xe6, 0x07, 0x1b, 0xe5, 0x16, 0x33, 0x63, 0x08, 0x2e, 0x7d, 0x4c, 0xc0, 0xda, 0xf4,
-	0xab, 0x80, 0xd6, 0x61, 0x5e, 0xd6, 0xd8, 0xb6, 0x64, 0x54, 0x69, 0x21, 0xd0, 0x2c, 0xf4, 0x0e,
-	0xd0, 0x8d, 0xeb, 0x5d, 0x5f, 0xb6, 0xdc, 0x1b, 0x83, 0x7c, 0x20, 0x8d, 0x2e, 0x6f, 0x81, 0x18,
-	0x77, 0xff, 0x38, 0xb2, 0x50, 0xef, 0x25, 0xbc, 0x16, 0xa0, 0xf1, 0xd2, 0xcd, 0xb8, 0x08, 0x15,
-	0x20, 0x15, 0xa4, 0x36, 0xce, 0x53, 0x1b, 0x7c, 0xa2, 0x87, 0x90, 0xa1, 0x8d, 0x26, 0xb1, 0xba,
-	0x2d, 0xc2, 0xb3, 0x20, 0xca, 0xba, 0x30, 0x90, 0x69, 0x16, 0x52, 0x21, 0x3b, 0x84, 0xf0, 0x11,
-	0x9a, 0xf8, 0x6c, 0x3a, 0x16, 0x07, 0x0c, 0x3e, 0x43, 0x37, 0x00, 0x28, 0x33, 0x3d, 0x26, 0x7c,
-	0x88, 0xea, 0xce, 0x4b, 0x89, 0x66, 0xa1, 0xff, 0x42, 0x26, 0x50, 0x73, 0xfb, 0xa9, 0xcf, 0xda,
-	0x5f, 0x90, 0x78, 0x6e, 0xfd, 0x7f, 0xb0, 0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26,
-	0x13, 0x56, 0xd2, 0x9f, 0xb5, 0xb2, 0xe4, 0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x0f, 0x48, 0x59,
-	0x84, 0x99, 0x76, 0x8b, 0x16, 0xe6, 0x39, 0xff, 0x7e, 0x64, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x35,
-	0x2d, 0x1c, 0x80, 0xfd, 0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0x15, 0x40, 0x34, 0x92, 0xfc, 0x44,
-	0xaf, 0x20, 0xc3, 0xa3, 0xf3, 0x9b, 0xbc, 0xeb, 0x91, 0xc2, 0xc2, 0x0c, 0xb3, 0xfb, 0x02, 0x83,
-	0x17, 0x7c, 0x86, 0xfc, 0x40, 0xcf, 0x60, 0x85, 0x1b, 0xf0, 0xcb, 0x4a, 0x3c, 0xc3, 0xb6, 0x88,
-	0xc3, 0x6c, 0xd6, 0x2f, 0x64, 0x78, 0xef, 0x20, 0x5f, 0xf7, 0x9e, 0xab, 0x34, 0xa9, 0x41, 0x27,
-	0x90, 0x93, 0xf5, 0x35, 0xe4, 0x08, 0x2c, 0x2c, 0x46, 0xb5, 0xd0, 0x70, 0x8a, 0xc8, 0x9b, 0x25,
-	0x67, 0x29, 0xce, 0xf6, 0x42, 0xdf, 0xa5, 0xef, 0xe2, 0xb0, 0x3a, 0x65, 0xce, 0xa2, 0x55, 0x48,
-	0x05, 0xfb, 0x57, 0xe1, 0x85, 0x4d, 0x32, 0xb1, 0x79, 0x43, 0x8d, 0x1e, 0xbb, 0x55, 0xa3, 0xc7,
-	0xbf, 0xb6, 0xd1, 0xbf, 0x81, 0xbf, 0x8c, 0x9d, 0xdc, 0xb0, 0x19, 0x69, 0xfb, 0xbb, 0xda, 0x7f,
-	0x76, 0xed, 0xdc, 0xee, 0xfc, 0x1a, 0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x12, 0x92,
-	0xa4, 0x47, 0x1c, 0x16, 0xac, 0xe2, 0x8d, 0xe8, 0xe1, 0x69, 0x32, 0xf3, 0x75, 0xcb, 0xbd, 0xc0,
-	0x12, 0x8c, 0xf6, 0x20, 0xeb, 0x90, 0x1b, 0xc3, 0xeb, 0x3a, 0x86, 0xa4, 0x27, 0x6f, 0x43, 0xcf,
-	0x38, 0xe4, 0x06, 0x77, 0x9d, 0x1a, 0xa7, 0x94, 0x7e, 0x56, 0xa0, 0x30, 0x6d, 0xf9, 0xcc, 0x9e,
-	0x2a, 0x51, 0x63, 0x39, 0x16, 0x3d, 0x96, 0xbf, 0xf6, 0xb9, 0x54, 0xfa, 0x5e, 0x81, 0xe5, 0x70,
-	0x94, 0xba, 0x7b, 0x4d, 0x1c, 0x3f, 0xc0, 0x60, 0xd4, 0x8a, 0x47, 0x70, 0x02, 0xa7, 0xe5, 0xac,
-	0xa5, 0xe8, 0x1c, 0x72, 0x63, 0x0b, 0x59, 0xce, 0xbc, 0x2f, 0xde, 0xc2, 0x38, 0x1b, 0xde, 0xc1,
-	0xa5, 0x5f, 0xc3, 0x8f, 0x73, 0xfe, 0x2a, 0x74, 0x2e, 0xdd, 0x3f, 0x65, 0x0c, 0xaf, 0x8f, 0xbe,
-	0x7d, 0xe3, 0x7c, 0x4c, 0x0c, 0x9f, 0xb3, 0x23, 0xf7, 0x68, 0x2e, 0x74, 0x8f, 0x46, 0x86, 0x77,
-	0x22, 0x3c, 0xbc, 0x37, 0x21, 0x7b, 0x69, 0x7b, 0x94, 0x89, 0xa6, 0x1a, 0x8e, 0xd6, 0x0c, 0x97,
-	0xf2, 0xb6, 0xd1, 0x2c, 0x54, 0x82, 0x45, 0x87, 0x7c, 0x18, 0x01, 0xa5, 0xc4, 0x8c, 0xf7, 0x85,
-	0x01, 0x66, 0x7c, 0x0d, 0xa4, 0x27, 0xd6, 0x80, 0xdf, 0x7e, 0xf9, 0xd1, 0x44, 0xf2, 0xaa, 0x8e,
-	0x2e, 0x50, 0x25, 0xbc, 0x40, 0xbf, 0xe2, 0xff, 0x94, 0x80, 0xda, 0xf1, 0xdc, 0x06, 0xa1, 0x34,
-	0x4c, 0x8d, 0x0f, 0xa9, 0xa7, 0x81, 0x7e, 0x40, 0x2d, 0xbd, 0x81, 0xdc, 0xd8, 0xcb, 0x20, 0xbc,
-	0xc9, 0x95, 0x2f, 0xd8, 0xe4, 0x3b, 0x1f, 0x27, 0x7b, 0x87, 0x97, 0xea, 0x21, 0x6c, 0xe0, 0xda,
-	0xe9, 0xa1, 0xb6, 0xa7, 0xea, 0xda, 0xc9, 0xb1, 0xa1, 0xab, 0xf5, 0x37, 0x86, 0x7e, 0x7e, 0x5a,
-	0x33, 0xb4, 0xe3, 0x33, 0xf5, 0x50, 0xab, 0xe6, 0xef, 0xa0, 0x22, 0xdc, 0x8f, 0x86, 0x54, 0x4f,
-	0x8e, 0x54, 0xed, 0x38, 0xaf, 0x4c, 0x37, 0x72, 0xa0, 0xd5, 0xf5, 0x13, 0x7c, 0x9e, 0x8f, 0xa1,
-	0xa7, 0xb0, 0x15, 0x0d, 0xa9, 0x9f, 0x1f, 0xef, 0x19, 0xf5, 0x03, 0x15, 0x57, 0x8d, 0xba, 0xae,
-	0xea, 0xef, 0xea, 0xf9, 0x38, 0xda, 0x82, 0xbf, 0xcd, 0x00, 0xab, 0x7b, 0xba, 0x76, 0xa6, 0xe9,
-	0xe7, 0xf9, 0x39, 0xb4, 0x03, 0x8f, 0x67, 0x3a, 0x36, 0x8e, 0x6a, 0xba, 0x5a, 0x55, 0x75, 0x35,
-	0x9f, 0x40, 0x9b, 0x50, 0x9c, 0x8d, 0x3d, 0xdb, 0xcd, 0x27, 0xd1, 0x13, 0x78, 0x14, 0x8d, 0xda,
-	0x57, 0xb5, 0xc3, 0x93, 0xb3, 0x1a, 0x36, 0x8e, 0x54, 0xfc, 0xa6, 0x86, 0xf3, 0xa9, 0x1d, 0x1b,
-	0x72, 0x63, 0x2f, 0x56, 0x74, 0x1f, 0x0a, 0x22, 0x29, 0xc6, 0xc9, 0x69, 0x0d, 0x0b, 0x13, 0xc3,
-	0x44, 0xae, 0xc3, 0xea, 0x84, 0x76, 0x0f, 0xd7, 0x54, 0xbd, 0x96, 0x57, 0x22, 0x95, 0xef, 0x4e,
-	0xab, 0xbe, 0x32, 0xb6, 0x73, 0x0c, 0xa9, 0xea, 0xe1, 0x5b, 0x5e, 0xb0, 0x15, 0xc8, 0x57, 0x0f,
-	0xdf, 0x8e, 0xd7, 0xa8, 0x00, 0x2b, 0x03, 0xe9, 0x48, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0x6e, 0xa0,
-	0x91, 0x05, 0x8b, 0xbd, 0xde, 0xfb, 0xed, 0xd3, 0x03, 0xe5, 0xe3, 0xa7, 0x07, 0xca, 0xef, 0x9f,
-	0x1e, 0x28, 0xff, 0x7f, 0x79, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0xdc, 0x70, 0xdb, 0x95, 0xd0, 0xaf,
-	0x04, 0xe5, 0x2b, 0xe2, 0x88, 0x5f, 0x25, 0x86, 0x3f, 0x18, 0xfc, 0x47, 0xfc, 0xd5, 0x7b, 0x7e,
-	0x91, 0xe4, 0x9a, 0x17, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0xd1, 0xe8, 0x01, 0x11,
-	0x00, 0x00,
+func (m *ReplicationDomainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationDomainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationDomainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ReplicationDomainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationDomainStatus.Merge(m, src)
+}
+func (m *ReplicationDomainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationDomainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationDomainStatus.DiscardUnknown(m)
 }
 
-func (m *ReplicationMessages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReplicationDomainStatus proto.InternalMessageInfo
+
+func (m *ReplicationDomainStatus) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
 }
 
-func (m *ReplicationMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ReplicationDomainStatus) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if m.SyncShardStatus != nil {
-		{
-			size, err := m.SyncShardStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *ReplicationDomainStatus) GetBacklog() int64 {
+	if m != nil {
+		return m.Backlog
 	}
-	if m.HasMore {
-		i--
-		if m.HasMore {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ReplicationDomainStatus) GetOldestTaskTime() *types.Timestamp {
+	if m != nil {
+		return m.OldestTaskTime
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *ReplicationDomainStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+type ReplicationShardStatus struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// ID of the last replication task acknowledged by the target cluster
+	AckLevel int64 `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	// ID of the last replication task created for the target cluster
+	LastTaskId int64 `protobuf:"varint,3,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"`
+	// time of the source cluster up to which the task processor of the target cluster applied the tasks
+	ReplicatedUpTo       *types.Timestamp           `protobuf:"bytes,4,opt,name=replicated_up_to,json=replicatedUpTo,proto3" json:"replicated_up_to,omitempty"`
+	Domains              []*ReplicationDomainStatus `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
+	Truncated            bool                       `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ReplicationShardStatus) Reset()         { *m = ReplicationShardStatus{} }
+func (m *ReplicationShardStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationShardStatus) ProtoMessage()    {}
+func (*ReplicationShardStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{12}
+}
+func (m *ReplicationShardStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationShardStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationShardStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReplicationShardStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationShardStatus.Merge(m, src)
+}
+func (m *ReplicationShardStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationShardStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationShardStatus.DiscardUnknown(m)
 }
 
-func (m *ReplicationTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplicationShardStatus proto.InternalMessageInfo
+
+func (m *ReplicationShardStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ReplicationTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationShardStatus) GetAckLevel() int64 {
+	if m != nil {
+		return m.AckLevel
+	}
+	return 0
 }
 
-func (m *ReplicationTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Attributes != nil {
-		{
-			size := m.Attributes.Size()
-			i -= size
-			if _, err := m.Attributes.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.CreationTime != nil {
-		{
-			size, err := m.CreationTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SourceTaskId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.SourceTaskId))
-		i--
-		dAtA[i] = 0x10
+func (m *ReplicationShardStatus) GetLastTaskId() int64 {
+	if m != nil {
+		return m.LastTaskId
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ReplicationShardStatus) GetReplicatedUpTo() *types.Timestamp {
+	if m != nil {
+		return m.ReplicatedUpTo
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ReplicationTask_DomainTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationShardStatus) GetDomains() []*ReplicationDomainStatus {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
 }
 
-func (m *ReplicationTask_DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DomainTaskAttributes != nil {
-		{
-			size, err := m.DomainTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *ReplicationShardStatus) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return len(dAtA) - i, nil
+	return false
 }
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type ReplicationTaskFailure struct {
+	// number of failed attempts to apply the replication tasks of the domain
+	Count                int64            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	LastTime             *types.Timestamp `protobuf:"bytes,2,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	LastError            string           `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SyncShardStatusTaskAttributes != nil {
-		{
-			size, err := m.SyncShardStatusTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
+func (m *ReplicationTaskFailure) Reset()         { *m = ReplicationTaskFailure{} }
+func (m *ReplicationTaskFailure) String() string { return proto.CompactTextString(m) }
+func (*ReplicationTaskFailure) ProtoMessage()    {}
+func (*ReplicationTaskFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{13}
+}
+func (m *ReplicationTaskFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationTaskFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationTaskFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationTaskFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationTaskFailure.Merge(m, src)
+}
+func (m *ReplicationTaskFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationTaskFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationTaskFailure.DiscardUnknown(m)
 }
 
-func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SyncActivityTaskAttributes != nil {
-		{
-			size, err := m.SyncActivityTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+var xxx_messageInfo_ReplicationTaskFailure proto.InternalMessageInfo
+
+func (m *ReplicationTaskFailure) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *ReplicationTaskFailure) GetLastTime() *types.Timestamp {
+	if m != nil {
+		return m.LastTime
+	}
+	return nil
 }
 
-func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HistoryTaskV2Attributes != nil {
-		{
-			size, err := m.HistoryTaskV2Attributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (m *ReplicationTaskFailure) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *ReplicationTask_FailoverMarkerAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type ReplicationProcessorState struct {
+	// time of the source cluster up to which the task processor applied the replication tasks
+	ReplicatedUpTo       *types.Timestamp                   `protobuf:"bytes,1,opt,name=replicated_up_to,json=replicatedUpTo,proto3" json:"replicated_up_to,omitempty"`
+	FailuresByDomain     map[string]*ReplicationTaskFailure `protobuf:"bytes,2,rep,name=failures_by_domain,json=failuresByDomain,proto3" json:"failures_by_domain,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func (m *ReplicationTask_FailoverMarkerAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FailoverMarkerAttributes != nil {
-		{
-			size, err := m.FailoverMarkerAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
+func (m *ReplicationProcessorState) Reset()         { *m = ReplicationProcessorState{} }
+func (m *ReplicationProcessorState) String() string { return proto.CompactTextString(m) }
+func (*ReplicationProcessorState) ProtoMessage()    {}
+func (*ReplicationProcessorState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{14}
+}
+func (m *ReplicationProcessorState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationProcessorState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationProcessorState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *DomainTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationProcessorState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationProcessorState.Merge(m, src)
+}
+func (m *ReplicationProcessorState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationProcessorState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationProcessorState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationProcessorState proto.InternalMessageInfo
+
+func (m *ReplicationProcessorState) GetReplicatedUpTo() *types.Timestamp {
+	if m != nil {
+		return m.ReplicatedUpTo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DomainTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationProcessorState) GetFailuresByDomain() map[string]*ReplicationTaskFailure {
+	if m != nil {
+		return m.FailuresByDomain
+	}
+	return nil
 }
 
-func (m *DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type ReplicationDLQTaskState struct {
+	TaskId int64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Class of the error of the last retry.
+	ErrorClass      string           `protobuf:"bytes,2,opt,name=error_class,json=errorClass,proto3" json:"error_class,omitempty"`
+	Attempts        int32            `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError       string           `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastAttemptTime *types.Timestamp `protobuf:"bytes,5,opt,name=last_attempt_time,json=lastAttemptTime,proto3" json:"last_attempt_time,omitempty"`
+	// The task is not retried before this time.
+	NextAttemptTime *types.Timestamp `protobuf:"bytes,6,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	// The task is no longer retried.
+	Poison               bool     `protobuf:"varint,7,opt,name=poison,proto3" json:"poison,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicationDLQTaskState) Reset()         { *m = ReplicationDLQTaskState{} }
+func (m *ReplicationDLQTaskState) String() string { return proto.CompactTextString(m) }
+func (*ReplicationDLQTaskState) ProtoMessage()    {}
+func (*ReplicationDLQTaskState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{15}
+}
+func (m *ReplicationDLQTaskState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationDLQTaskState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationDLQTaskState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PreviousFailoverVersion != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.PreviousFailoverVersion))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *ReplicationDLQTaskState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationDLQTaskState.Merge(m, src)
+}
+func (m *ReplicationDLQTaskState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationDLQTaskState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationDLQTaskState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationDLQTaskState proto.InternalMessageInfo
+
+func (m *ReplicationDLQTaskState) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
 	}
-	if m.FailoverVersion != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.FailoverVersion))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *ReplicationDLQTaskState) GetErrorClass() string {
+	if m != nil {
+		return m.ErrorClass
 	}
-	if m.ConfigVersion != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.ConfigVersion))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *ReplicationDLQTaskState) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
 	}
-	if m.Domain != nil {
-		{
-			size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ReplicationDLQTaskState) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ReplicationDLQTaskState) GetLastAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.LastAttemptTime
 	}
-	if m.DomainOperation != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.DomainOperation))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ReplicationDLQTaskState) GetNextAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.NextAttemptTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SyncShardStatusTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationDLQTaskState) GetPoison() bool {
+	if m != nil {
+		return m.Poison
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReplicationShardReadiness struct {
+	ShardId        int32                      `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	ProcessorState *ReplicationProcessorState `protobuf:"bytes,2,opt,name=processor_state,json=processorState,proto3" json:"processor_state,omitempty"`
+	// number of replication tasks from the source cluster in the DLQ by domain ID
+	DlqSizeByDomain      map[string]int64 `protobuf:"bytes,3,rep,name=dlq_size_by_domain,json=dlqSizeByDomain,proto3" json:"dlq_size_by_domain,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Timestamp != nil {
-		{
-			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
+func (m *ReplicationShardReadiness) Reset()         { *m = ReplicationShardReadiness{} }
+func (m *ReplicationShardReadiness) String() string { return proto.CompactTextString(m) }
+func (*ReplicationShardReadiness) ProtoMessage()    {}
+func (*ReplicationShardReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{16}
+}
+func (m *ReplicationShardReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationShardReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationShardReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ReplicationShardReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationShardReadiness.Merge(m, src)
+}
+func (m *ReplicationShardReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationShardReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationShardReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationShardReadiness proto.InternalMessageInfo
+
+func (m *ReplicationShardReadiness) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ReplicationShardReadiness) GetProcessorState() *ReplicationProcessorState {
+	if m != nil {
+		return m.ProcessorState
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SyncActivityTaskAttributes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationShardReadiness) GetDlqSizeByDomain() map[string]int64 {
+	if m != nil {
+		return m.DlqSizeByDomain
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReplicationTaskFrameAttributes struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
+func (m *ReplicationTaskFrameAttributes) Reset()         { *m = ReplicationTaskFrameAttributes{} }
+func (m *ReplicationTaskFrameAttributes) String() string { return proto.CompactTextString(m) }
+func (*ReplicationTaskFrameAttributes) ProtoMessage()    {}
+func (*ReplicationTaskFrameAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{17}
+}
+func (m *ReplicationTaskFrameAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationTaskFrameAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationTaskFrameAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6a
+		return b[:n], nil
 	}
-	if len(m.LastWorkerIdentity) > 0 {
-		i -= len(m.LastWorkerIdentity)
-		copy(dAtA[i:], m.LastWorkerIdentity)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.LastWorkerIdentity)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *ReplicationTaskFrameAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationTaskFrameAttributes.Merge(m, src)
+}
+func (m *ReplicationTaskFrameAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationTaskFrameAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationTaskFrameAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationTaskFrameAttributes proto.InternalMessageInfo
+
+func (m *ReplicationTaskFrameAttributes) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if m.LastFailure != nil {
-		{
-			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
+	return nil
+}
+
+type SyncCompletionCallbacksTaskAttributes struct {
+	DomainId             string                       `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution        `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Version              int64                        `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	CompletionCallbacks  []*v1.CompletionCallbackInfo `protobuf:"bytes,4,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) Reset()         { *m = SyncCompletionCallbacksTaskAttributes{} }
+func (m *SyncCompletionCallbacksTaskAttributes) String() string { return proto.CompactTextString(m) }
+func (*SyncCompletionCallbacksTaskAttributes) ProtoMessage()    {}
+func (*SyncCompletionCallbacksTaskAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{18}
+}
+func (m *SyncCompletionCallbacksTaskAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncCompletionCallbacksTaskAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncCompletionCallbacksTaskAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		return b[:n], nil
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x50
+}
+func (m *SyncCompletionCallbacksTaskAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCompletionCallbacksTaskAttributes.Merge(m, src)
+}
+func (m *SyncCompletionCallbacksTaskAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncCompletionCallbacksTaskAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCompletionCallbacksTaskAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCompletionCallbacksTaskAttributes proto.InternalMessageInfo
+
+func (m *SyncCompletionCallbacksTaskAttributes) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	if m.LastHeartbeatTime != nil {
-		{
-			size, err := m.LastHeartbeatTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return nil
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if m.StartedTime != nil {
-		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) GetCompletionCallbacks() []*v1.CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
 	}
-	if m.StartedId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.StartedId))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.shared.v1.ReplicationTaskType", ReplicationTaskType_name, ReplicationTaskType_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.DomainOperation", DomainOperation_name, DomainOperation_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.DLQType", DLQType_name, DLQType_value)
+	proto.RegisterType((*ReplicationMessages)(nil), "uber.cadence.shared.v1.ReplicationMessages")
+	proto.RegisterType((*ReplicationTask)(nil), "uber.cadence.shared.v1.ReplicationTask")
+	proto.RegisterType((*DomainTaskAttributes)(nil), "uber.cadence.shared.v1.DomainTaskAttributes")
+	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "uber.cadence.shared.v1.SyncShardStatusTaskAttributes")
+	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "uber.cadence.shared.v1.SyncActivityTaskAttributes")
+	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "uber.cadence.shared.v1.HistoryTaskV2Attributes")
+	proto.RegisterType((*FailoverMarkerAttributes)(nil), "uber.cadence.shared.v1.FailoverMarkerAttributes")
+	proto.RegisterType((*FailoverMarkerToken)(nil), "uber.cadence.shared.v1.FailoverMarkerToken")
+	proto.RegisterType((*ReplicationTaskInfo)(nil), "uber.cadence.shared.v1.ReplicationTaskInfo")
+	proto.RegisterType((*ReplicationToken)(nil), "uber.cadence.shared.v1.ReplicationToken")
+	proto.RegisterType((*SyncShardStatus)(nil), "uber.cadence.shared.v1.SyncShardStatus")
+	proto.RegisterType((*ReplicationDomainStatus)(nil), "uber.cadence.shared.v1.ReplicationDomainStatus")
+	proto.RegisterType((*ReplicationShardStatus)(nil), "uber.cadence.shared.v1.ReplicationShardStatus")
+	proto.RegisterType((*ReplicationTaskFailure)(nil), "uber.cadence.shared.v1.ReplicationTaskFailure")
+	proto.RegisterType((*ReplicationProcessorState)(nil), "uber.cadence.shared.v1.ReplicationProcessorState")
+	proto.RegisterMapType((map[string]*ReplicationTaskFailure)(nil), "uber.cadence.shared.v1.ReplicationProcessorState.FailuresByDomainEntry")
+	proto.RegisterType((*ReplicationDLQTaskState)(nil), "uber.cadence.shared.v1.ReplicationDLQTaskState")
+	proto.RegisterType((*ReplicationShardReadiness)(nil), "uber.cadence.shared.v1.ReplicationShardReadiness")
+	proto.RegisterMapType((map[string]int64)(nil), "uber.cadence.shared.v1.ReplicationShardReadiness.DlqSizeByDomainEntry")
+	proto.RegisterType((*ReplicationTaskFrameAttributes)(nil), "uber.cadence.shared.v1.ReplicationTaskFrameAttributes")
+	proto.RegisterType((*SyncCompletionCallbacksTaskAttributes)(nil), "uber.cadence.shared.v1.SyncCompletionCallbacksTaskAttributes")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/shared/v1/replication.proto", fileDescriptor_00df2ec6c2eaefe5)
+}
+
+var fileDescriptor_00df2ec6c2eaefe5 = []byte{
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xcf, 0x92, 0xa2, 0x48, 0x3e, 0x49, 0xe4, 0x7a, 0xa4, 0x48, 0xb4, 0x64, 0x3b, 0x32, 0x6b,
+	0xc7, 0xff, 0x0a, 0x2a, 0x96, 0xeb, 0x34, 0x4d, 0x11, 0x04, 0x14, 0x45, 0x55, 0xac, 0x25, 0x4b,
+	0x19, 0xd2, 0x0a, 0x9c, 0x43, 0x16, 0xab, 0xdd, 0x91, 0xb4, 0xd0, 0x72, 0x97, 0xd9, 0x19, 0x52,
+	0x61, 0x6e, 0xed, 0xa1, 0xb7, 0x1e, 0x1b, 0xa0, 0xe8, 0xa9, 0x28, 0xd0, 0x8f, 0xd0, 0x5b, 0xaf,
+	0x45, 0x8b, 0xa2, 0x40, 0x3e, 0x42, 0xe1, 0x4f, 0x52, 0xcc, 0x9f, 0x25, 0x77, 0xc9, 0x25, 0x45,
+	0xd7, 0x87, 0xf6, 0xc6, 0x79, 0xf3, 0x7b, 0xef, 0xcd, 0xfb, 0x33, 0xef, 0xbd, 0x59, 0xc2, 0xc3,
+	0xee, 0x29, 0x09, 0xb6, 0x2c, 0xd3, 0x26, 0x9e, 0x45, 0xb6, 0xe8, 0x85, 0x19, 0x10, 0x7b, 0xab,
+	0xf7, 0x74, 0x2b, 0x20, 0x1d, 0xd7, 0xb1, 0x4c, 0xe6, 0xf8, 0x5e, 0xa5, 0x13, 0xf8, 0xcc, 0x47,
+	0xab, 0x1c, 0x59, 0x51, 0xc8, 0x8a, 0x44, 0x56, 0x7a, 0x4f, 0xd7, 0x3f, 0x38, 0xf7, 0xfd, 0x73,
+	0x97, 0x6c, 0x09, 0xd4, 0x69, 0xf7, 0x6c, 0x8b, 0x39, 0x6d, 0x42, 0x99, 0xd9, 0xee, 0x48, 0xc6,
+	0xf5, 0xcd, 0x98, 0x0a, 0xb3, 0xe3, 0x70, 0xf9, 0x96, 0xdf, 0x6e, 0xfb, 0xde, 0x34, 0x84, 0xed,
+	0xb7, 0x4d, 0x27, 0x44, 0x94, 0x93, 0x10, 0x57, 0x7e, 0x70, 0x79, 0xe6, 0xfa, 0x57, 0x0a, 0x73,
+	0x6f, 0x82, 0x29, 0x17, 0x0e, 0x65, 0x7e, 0xd0, 0x97, 0xa8, 0xf2, 0xf7, 0x29, 0x58, 0xc6, 0x43,
+	0xe3, 0x0e, 0x09, 0xa5, 0xe6, 0x39, 0xa1, 0xa8, 0x05, 0x37, 0x22, 0x36, 0x1b, 0xcc, 0xa4, 0x97,
+	0xb4, 0xa4, 0x6d, 0xa6, 0x1f, 0x2e, 0x6c, 0x3f, 0xa8, 0x24, 0x9b, 0x5e, 0x89, 0xc8, 0x69, 0x99,
+	0xf4, 0x12, 0xeb, 0x41, 0x9c, 0x40, 0xd1, 0xcf, 0xe0, 0xa6, 0x6b, 0x52, 0x66, 0x04, 0x84, 0x05,
+	0x0e, 0xe9, 0x11, 0xdb, 0x68, 0x4b, 0x85, 0x86, 0x63, 0x97, 0x52, 0x9b, 0xda, 0xc3, 0x34, 0x5e,
+	0xe5, 0x00, 0x1c, 0xee, 0xab, 0xf3, 0x34, 0x6c, 0x74, 0x13, 0x72, 0x17, 0x26, 0x35, 0xda, 0x7e,
+	0x40, 0x4a, 0xe9, 0x4d, 0xed, 0x61, 0x0e, 0x67, 0x2f, 0x4c, 0x7a, 0xe8, 0x07, 0x04, 0x35, 0xe1,
+	0x06, 0xed, 0x7b, 0x96, 0xc1, 0x4f, 0x62, 0x1b, 0x94, 0x99, 0xac, 0x4b, 0x4b, 0x73, 0x9b, 0xda,
+	0xb4, 0xb3, 0x36, 0xfb, 0x9e, 0xd5, 0xe4, 0xf8, 0xa6, 0x80, 0xe3, 0x22, 0x8d, 0x13, 0xca, 0xdf,
+	0xe7, 0xa0, 0x38, 0x62, 0x10, 0xda, 0x87, 0x3c, 0x77, 0x84, 0xc1, 0xfa, 0x1d, 0x52, 0xd2, 0x36,
+	0xb5, 0x87, 0x85, 0xed, 0x27, 0x33, 0x3a, 0xa3, 0xd5, 0xef, 0x10, 0x9c, 0x63, 0xea, 0x17, 0xba,
+	0x07, 0x05, 0xea, 0x77, 0x03, 0x8b, 0x08, 0xcf, 0x0e, 0xad, 0x5f, 0x94, 0x54, 0xce, 0xd1, 0xb0,
+	0xd1, 0xe7, 0xb0, 0x64, 0x05, 0x44, 0x45, 0xc0, 0x69, 0x4b, 0xc3, 0x17, 0xb6, 0xd7, 0x2b, 0x32,
+	0xc7, 0x2a, 0x61, 0x8e, 0x55, 0x5a, 0x61, 0x8e, 0xe1, 0xc5, 0x90, 0x81, 0x93, 0x90, 0x0d, 0xab,
+	0x32, 0x6f, 0xa4, 0x1a, 0x93, 0xb1, 0xc0, 0x39, 0xed, 0x32, 0x12, 0xba, 0xe7, 0xc7, 0x93, 0x4e,
+	0xbf, 0x2b, 0xb8, 0xf8, 0x31, 0xaa, 0x03, 0x9e, 0xfd, 0xf7, 0xf0, 0x8a, 0x9d, 0x40, 0x47, 0xbf,
+	0xd2, 0xe0, 0xee, 0x58, 0x00, 0xc6, 0x34, 0x66, 0x84, 0xc6, 0xe7, 0x33, 0x06, 0x64, 0x4c, 0xf5,
+	0x6d, 0x3a, 0x0d, 0x80, 0xae, 0x40, 0x00, 0x0c, 0xd3, 0x62, 0x4e, 0xcf, 0x61, 0xfd, 0x31, 0xf5,
+	0xf3, 0x42, 0xfd, 0xf6, 0x34, 0xf5, 0x55, 0xc5, 0x3b, 0xa6, 0x7b, 0x9d, 0x4e, 0xdc, 0x45, 0x1e,
+	0xac, 0xab, 0x1b, 0x25, 0x55, 0xf6, 0xb6, 0xa3, 0x5a, 0xb3, 0x42, 0xeb, 0xd6, 0x24, 0xad, 0xfb,
+	0x92, 0x93, 0x8b, 0x3c, 0xd9, 0x8e, 0xa9, 0x5c, 0xbb, 0x48, 0xde, 0x42, 0x1d, 0x58, 0x3f, 0x33,
+	0x1d, 0xd7, 0xef, 0x91, 0xc0, 0x68, 0x9b, 0xc1, 0x25, 0x09, 0xa2, 0xfa, 0x72, 0x42, 0xdf, 0x47,
+	0x93, 0xf4, 0xed, 0x29, 0xce, 0x43, 0xc1, 0x18, 0x53, 0x58, 0x3a, 0x9b, 0xb0, 0x87, 0x2c, 0xd0,
+	0xcf, 0x02, 0xb3, 0x4d, 0xa2, 0x7a, 0xf2, 0x42, 0xcf, 0xc7, 0x33, 0x26, 0xff, 0x1e, 0x67, 0x8f,
+	0x69, 0x2b, 0x9e, 0xc5, 0x49, 0xe8, 0xf7, 0x1a, 0x3c, 0x12, 0x01, 0xb4, 0xfc, 0x76, 0xc7, 0x25,
+	0x22, 0xe5, 0x2d, 0xd3, 0x75, 0x4f, 0x4d, 0xeb, 0x72, 0x3c, 0x97, 0x40, 0xa8, 0xff, 0x6c, 0x5a,
+	0x30, 0x6b, 0x03, 0x39, 0xb5, 0x50, 0xcc, 0x58, 0x5c, 0xef, 0xd3, 0x59, 0x80, 0x3b, 0x8b, 0x00,
+	0x43, 0xdd, 0xe5, 0xbf, 0xa6, 0x60, 0x25, 0xe9, 0x7a, 0x20, 0x0c, 0xba, 0xba, 0x6c, 0x7e, 0x87,
+	0x04, 0xc2, 0x78, 0x55, 0x24, 0x1e, 0x4c, 0xbf, 0x66, 0x47, 0x21, 0x1c, 0x17, 0xed, 0x38, 0x01,
+	0x15, 0x20, 0xa5, 0x6a, 0x43, 0x1e, 0xa7, 0x1c, 0x1b, 0x3d, 0x83, 0x79, 0x09, 0x51, 0xa5, 0x60,
+	0x23, 0x2e, 0xd9, 0xec, 0x38, 0x43, 0xb1, 0x58, 0x41, 0xd1, 0x7d, 0x28, 0x58, 0xbe, 0x77, 0xe6,
+	0x9c, 0x1b, 0x3d, 0x12, 0x50, 0x7e, 0xac, 0x39, 0x51, 0x6c, 0x96, 0x24, 0xf5, 0x44, 0x12, 0xd1,
+	0x23, 0xd0, 0x07, 0x99, 0x15, 0x02, 0x33, 0x02, 0x58, 0x0c, 0xe9, 0x21, 0xf4, 0x53, 0xb8, 0xd9,
+	0x09, 0x48, 0xcf, 0xf1, 0xbb, 0xd4, 0x18, 0xe3, 0x99, 0x17, 0x3c, 0x6b, 0x21, 0x60, 0x2f, 0xce,
+	0x5b, 0xfe, 0x83, 0x06, 0xb7, 0xa7, 0x5e, 0x76, 0x7e, 0x5e, 0x55, 0x1c, 0x2d, 0xb7, 0x4b, 0x19,
+	0x09, 0x84, 0x1b, 0xf3, 0x78, 0x49, 0x52, 0x6b, 0x92, 0xc8, 0x3b, 0x82, 0x2c, 0x38, 0xca, 0x43,
+	0x19, 0x9c, 0x15, 0xeb, 0x86, 0x8d, 0x3e, 0x81, 0xfc, 0xa0, 0xed, 0xce, 0x50, 0x34, 0x87, 0xe0,
+	0xf2, 0x0f, 0x19, 0x58, 0x9f, 0x5c, 0x0b, 0xd0, 0x06, 0xe4, 0x55, 0x8c, 0x1d, 0x5b, 0x9d, 0x2a,
+	0x27, 0x09, 0x0d, 0x1b, 0xbd, 0x02, 0x14, 0xf6, 0x60, 0x83, 0x7c, 0x4b, 0xac, 0xae, 0x48, 0x81,
+	0x94, 0x50, 0xff, 0x61, 0x62, 0xa0, 0xbe, 0x54, 0xf0, 0x7a, 0x88, 0xc6, 0x37, 0xae, 0x46, 0x49,
+	0xa8, 0x04, 0xd9, 0xd0, 0xb5, 0x69, 0xe1, 0xda, 0x70, 0x89, 0xee, 0xc2, 0x22, 0xb5, 0x2e, 0x88,
+	0xdd, 0x75, 0x89, 0xf0, 0x82, 0x0c, 0xeb, 0xc2, 0x80, 0xd6, 0xb0, 0x51, 0x15, 0x0a, 0x43, 0x88,
+	0xe8, 0x21, 0x99, 0x6b, 0xdd, 0xb1, 0x34, 0xe0, 0xe0, 0x34, 0x74, 0x1b, 0x80, 0x32, 0x33, 0x60,
+	0x52, 0x87, 0x8c, 0x6e, 0x5e, 0x51, 0x1a, 0x36, 0xfa, 0x0c, 0x16, 0xc3, 0x6d, 0x21, 0x3f, 0x7b,
+	0xad, 0xfc, 0x05, 0x85, 0x17, 0xd2, 0x7f, 0x09, 0xcb, 0x62, 0x24, 0xb8, 0x20, 0x66, 0xc0, 0x4e,
+	0x89, 0xc9, 0xa4, 0x94, 0xdc, 0xb5, 0x52, 0x6e, 0x70, 0xb6, 0xfd, 0x90, 0x4b, 0xc8, 0xfa, 0x18,
+	0xb2, 0x36, 0x61, 0xa6, 0xe3, 0x86, 0x05, 0xea, 0x56, 0xa2, 0xd7, 0x8f, 0xcd, 0xbe, 0xeb, 0x9b,
+	0x36, 0x0e, 0xc1, 0xdc, 0xc3, 0x26, 0x63, 0xa4, 0xdd, 0x61, 0xa2, 0xb2, 0x64, 0x70, 0xb8, 0x44,
+	0x9f, 0xc3, 0xa2, 0x38, 0x1d, 0x4f, 0xf2, 0x6e, 0x40, 0x4a, 0x0b, 0x53, 0xc4, 0xee, 0x49, 0x0c,
+	0x5e, 0xe0, 0x1c, 0x6a, 0x81, 0x3e, 0x82, 0x15, 0x21, 0x80, 0x87, 0x95, 0x04, 0x86, 0x63, 0x13,
+	0x8f, 0x39, 0xac, 0x5f, 0x5a, 0x14, 0xb9, 0x83, 0xf8, 0xde, 0x97, 0x62, 0xab, 0xa1, 0x76, 0xd0,
+	0x11, 0x14, 0x55, 0x7c, 0x0d, 0xd5, 0x03, 0x4a, 0x4b, 0x49, 0x29, 0x34, 0xac, 0x22, 0xea, 0x66,
+	0xa9, 0x66, 0x82, 0x0b, 0xbd, 0xd8, 0xba, 0xfc, 0xeb, 0x34, 0xac, 0x4d, 0x68, 0x34, 0x68, 0x0d,
+	0xb2, 0xe1, 0x00, 0xa2, 0x89, 0xc0, 0xce, 0x33, 0x39, 0x7a, 0xc4, 0x12, 0x3d, 0x35, 0x53, 0xa2,
+	0xa7, 0xdf, 0x35, 0xd1, 0xbf, 0x86, 0xf7, 0x47, 0x2c, 0x37, 0x1c, 0x46, 0xda, 0x7c, 0x58, 0xe1,
+	0x73, 0xe7, 0xe3, 0xd9, 0xec, 0x6f, 0x30, 0xd2, 0xc6, 0xcb, 0xbd, 0x31, 0x1a, 0x45, 0xcf, 0x61,
+	0x9e, 0xf4, 0x88, 0xc7, 0xc2, 0x59, 0xe4, 0x76, 0x72, 0xf1, 0x34, 0x99, 0xb9, 0xe3, 0xfa, 0xa7,
+	0x58, 0x81, 0x51, 0x0d, 0x0a, 0x1e, 0xb9, 0x32, 0x82, 0xae, 0x67, 0x28, 0xf6, 0xf9, 0x59, 0xd8,
+	0x17, 0x3d, 0x72, 0x85, 0xbb, 0x5e, 0x5d, 0xb0, 0x94, 0xff, 0xa4, 0x41, 0x69, 0x52, 0xf7, 0x9d,
+	0x5e, 0x55, 0x92, 0xca, 0x72, 0x2a, 0xb9, 0x2c, 0xbf, 0xeb, 0xbc, 0x58, 0xfe, 0xad, 0x06, 0xcb,
+	0xf1, 0x53, 0xb6, 0xfc, 0x4b, 0xe2, 0xf1, 0x03, 0x86, 0xa5, 0x56, 0xbe, 0x02, 0x32, 0x38, 0xa7,
+	0x6a, 0x2d, 0x45, 0xaf, 0xa1, 0x38, 0x32, 0x91, 0x94, 0x52, 0xff, 0xdd, 0x18, 0x82, 0x0b, 0xf1,
+	0x21, 0xa4, 0xfc, 0xb7, 0xf8, 0xeb, 0x44, 0x8c, 0xc5, 0xde, 0x99, 0xff, 0x3f, 0x29, 0xc3, 0x1b,
+	0xd1, 0xe1, 0x3f, 0x2d, 0xca, 0xc4, 0x70, 0x9e, 0x8f, 0xdc, 0xa3, 0xb9, 0xd8, 0x3d, 0x8a, 0x14,
+	0xef, 0x4c, 0xbc, 0x78, 0xdf, 0x83, 0xc2, 0x99, 0x13, 0x50, 0x26, 0x93, 0x6a, 0x58, 0x5a, 0x17,
+	0x05, 0x55, 0xa4, 0x4d, 0xc3, 0x46, 0x65, 0x58, 0xf2, 0xc8, 0xb7, 0x11, 0x50, 0x56, 0xd6, 0x78,
+	0x4e, 0x0c, 0x31, 0xa3, 0x6d, 0x20, 0x37, 0xd6, 0x06, 0x78, 0xfa, 0xe9, 0x51, 0x47, 0x8a, 0xa8,
+	0x46, 0x1b, 0xa8, 0x16, 0x6f, 0xa0, 0xef, 0xf0, 0x50, 0x0b, 0x59, 0x3b, 0x81, 0x6f, 0x11, 0x4a,
+	0xe3, 0xac, 0xe9, 0x21, 0xeb, 0x71, 0xb8, 0x3f, 0x60, 0x2d, 0xbf, 0x80, 0xe2, 0xc8, 0x64, 0x10,
+	0xef, 0xe4, 0xda, 0xdb, 0x74, 0xf2, 0x7f, 0x68, 0xb0, 0x16, 0x31, 0x59, 0xce, 0x44, 0x4a, 0xea,
+	0xd4, 0xfc, 0x59, 0x1d, 0xcc, 0x58, 0xb2, 0xee, 0xa9, 0x15, 0x0f, 0x25, 0x9f, 0x0e, 0x5d, 0xff,
+	0x3c, 0xec, 0xc3, 0x6a, 0x89, 0x76, 0x41, 0xf7, 0x5d, 0x9b, 0x50, 0x26, 0x07, 0x55, 0x71, 0xf5,
+	0xe6, 0xae, 0x3d, 0x6b, 0x41, 0xf2, 0x88, 0xd7, 0x21, 0xef, 0x5e, 0x37, 0x21, 0x67, 0xbb, 0xdf,
+	0x18, 0xd4, 0xf9, 0x8e, 0x84, 0xb9, 0x62, 0xbb, 0xdf, 0x34, 0x9d, 0xef, 0x48, 0xf9, 0x8f, 0x29,
+	0x58, 0x8d, 0xd8, 0x12, 0x75, 0xd0, 0x94, 0x20, 0x6e, 0x40, 0xde, 0xb4, 0x2e, 0x0d, 0x97, 0xf4,
+	0x88, 0xab, 0x82, 0x96, 0x33, 0xad, 0xcb, 0x03, 0xbe, 0x46, 0x9b, 0xaa, 0xb3, 0x85, 0x69, 0x2b,
+	0x4d, 0x02, 0xd7, 0x94, 0x27, 0x6a, 0xd8, 0xdc, 0xaa, 0xf0, 0x01, 0x4f, 0x6c, 0xa3, 0xdb, 0x31,
+	0x98, 0x3f, 0x8b, 0x55, 0x43, 0x9e, 0x57, 0x9d, 0x96, 0x8f, 0x1a, 0x90, 0x95, 0xfe, 0xe3, 0x55,
+	0x37, 0x3d, 0xed, 0x31, 0x34, 0x21, 0x58, 0x38, 0xe4, 0x47, 0xb7, 0x20, 0xcf, 0x82, 0xae, 0x27,
+	0x64, 0x8b, 0xcb, 0x92, 0xc3, 0x43, 0x42, 0xf9, 0x37, 0x5a, 0xcc, 0x47, 0xe2, 0xdd, 0xa1, 0x9a,
+	0xf0, 0x0a, 0x64, 0x2c, 0xbf, 0xeb, 0x31, 0xd5, 0xe3, 0xe4, 0x02, 0xfd, 0x14, 0xf2, 0xd2, 0x03,
+	0x3c, 0x5c, 0xa9, 0x6b, 0x0d, 0xcb, 0x09, 0xd7, 0xa8, 0x81, 0x48, 0x30, 0x92, 0x20, 0xf0, 0x03,
+	0xe1, 0xb8, 0x3c, 0x16, 0xa2, 0xea, 0x9c, 0x50, 0xfe, 0x57, 0x0a, 0x6e, 0x46, 0x0e, 0xa2, 0xf2,
+	0xdc, 0x0f, 0xb8, 0x39, 0x24, 0xd1, 0xab, 0xda, 0x5b, 0x7b, 0xb5, 0x0b, 0x48, 0x8d, 0x24, 0xd4,
+	0x38, 0xed, 0x1b, 0x83, 0x7c, 0xe5, 0x0e, 0xfe, 0xc5, 0x0c, 0x0e, 0x8e, 0x1f, 0x2a, 0x1c, 0x5d,
+	0xe8, 0x4e, 0x5f, 0x7a, 0xbe, 0xee, 0xb1, 0xa0, 0x8f, 0xf5, 0xb3, 0x11, 0xf2, 0x3a, 0x85, 0xf7,
+	0x13, 0xa1, 0x48, 0x87, 0xf4, 0x25, 0xe9, 0xab, 0xab, 0xc4, 0x7f, 0xa2, 0x5d, 0xc8, 0xf4, 0x4c,
+	0xb7, 0x1b, 0x7a, 0xb6, 0x32, 0xeb, 0x53, 0x51, 0x0d, 0x51, 0x92, 0xf9, 0xd3, 0xd4, 0x27, 0x5a,
+	0xf9, 0x2f, 0xa9, 0xf8, 0x45, 0x3e, 0xf8, 0x82, 0x03, 0xa5, 0x37, 0x27, 0xce, 0x2f, 0x1f, 0xc0,
+	0x82, 0x08, 0x8f, 0x61, 0xb9, 0x26, 0xa5, 0xea, 0x26, 0x83, 0x20, 0xd5, 0x38, 0x05, 0xad, 0x43,
+	0x4e, 0x0d, 0x79, 0x34, 0xac, 0xe6, 0xe1, 0x7a, 0x24, 0xc0, 0x73, 0x23, 0x01, 0x46, 0x7b, 0x20,
+	0x66, 0x4f, 0x43, 0xe1, 0x67, 0x1d, 0xab, 0x8b, 0x9c, 0xa9, 0x2a, 0x79, 0x44, 0x1e, 0xed, 0xc1,
+	0x0d, 0x51, 0xdb, 0x63, 0x72, 0xe6, 0xaf, 0x97, 0xc3, 0x99, 0xa2, 0x72, 0x56, 0x61, 0xbe, 0xe3,
+	0x3b, 0xd4, 0xf7, 0x44, 0x73, 0xc8, 0x61, 0xb5, 0x2a, 0xff, 0x33, 0x9e, 0x88, 0xa2, 0x6a, 0x60,
+	0x62, 0xda, 0x8e, 0x47, 0xe8, 0xd4, 0xc2, 0xf1, 0x15, 0x14, 0x3b, 0x61, 0x82, 0x88, 0xcf, 0x39,
+	0x61, 0x14, 0x9f, 0xbe, 0x75, 0x6a, 0xe1, 0x42, 0x27, 0x9e, 0xff, 0x14, 0x50, 0x58, 0xe5, 0x22,
+	0x99, 0x9b, 0x16, 0x99, 0xbb, 0x37, 0x83, 0xf8, 0xb8, 0x15, 0x95, 0x5d, 0x59, 0x21, 0xe3, 0x89,
+	0x5b, 0xb4, 0xe3, 0xd4, 0xf5, 0x1d, 0x58, 0x49, 0x02, 0x26, 0xa4, 0xed, 0x4a, 0x34, 0x6d, 0xd3,
+	0xd1, 0x34, 0xfc, 0x09, 0xdc, 0x99, 0xfe, 0x59, 0x03, 0x21, 0x98, 0xb3, 0x4d, 0x66, 0x0a, 0x71,
+	0x8b, 0x58, 0xfc, 0x2e, 0xff, 0x2e, 0x05, 0xf7, 0x67, 0xfa, 0x1c, 0xf1, 0x7f, 0xf6, 0xb4, 0xfc,
+	0x1a, 0x56, 0x92, 0xbe, 0xc4, 0xa8, 0x51, 0xfc, 0x49, 0xa2, 0xca, 0x71, 0x1b, 0xf9, 0xb0, 0x86,
+	0x97, 0xad, 0x71, 0xdb, 0x1f, 0xff, 0x39, 0x3d, 0x36, 0xd9, 0x89, 0x41, 0xea, 0x2e, 0xdc, 0xc6,
+	0xf5, 0xe3, 0x83, 0x46, 0xad, 0xda, 0x6a, 0x1c, 0xbd, 0x34, 0x5a, 0xd5, 0xe6, 0x0b, 0xa3, 0xf5,
+	0xfa, 0xb8, 0x6e, 0x34, 0x5e, 0x9e, 0x54, 0x0f, 0x1a, 0xbb, 0xfa, 0x7b, 0x68, 0x13, 0x6e, 0x25,
+	0x43, 0x76, 0x8f, 0x0e, 0xab, 0x8d, 0x97, 0xba, 0x36, 0x59, 0xc8, 0x7e, 0xa3, 0xd9, 0x3a, 0xc2,
+	0xaf, 0xf5, 0x14, 0x7a, 0x02, 0x0f, 0x92, 0x21, 0xcd, 0xd7, 0x2f, 0x6b, 0x46, 0x73, 0xbf, 0x8a,
+	0x77, 0x8d, 0x66, 0xab, 0xda, 0x7a, 0xd5, 0xd4, 0xd3, 0xe8, 0x01, 0xfc, 0x68, 0x0a, 0xb8, 0x5a,
+	0x6b, 0x35, 0x4e, 0x1a, 0xad, 0xd7, 0xfa, 0x1c, 0x7a, 0x0c, 0x1f, 0x4e, 0x55, 0x6c, 0x1c, 0xd6,
+	0x5b, 0xd5, 0xdd, 0x6a, 0xab, 0xaa, 0x67, 0xd0, 0x3d, 0xd8, 0x9c, 0x8e, 0x3d, 0xd9, 0xd6, 0xe7,
+	0xd1, 0x23, 0xb8, 0x9f, 0x8c, 0xda, 0xab, 0x36, 0x0e, 0x8e, 0x4e, 0xea, 0xd8, 0x38, 0xac, 0xe2,
+	0x17, 0x75, 0xac, 0x67, 0xd1, 0x07, 0xb0, 0x31, 0x01, 0x8a, 0xab, 0x87, 0x75, 0x3d, 0x87, 0x9e,
+	0xc1, 0xd6, 0x14, 0x33, 0x6a, 0x47, 0x87, 0xc7, 0x07, 0x75, 0xb1, 0x53, 0xab, 0x1e, 0x1c, 0xec,
+	0x54, 0x6b, 0x2f, 0x9a, 0x7a, 0xfe, 0xb1, 0x03, 0xc5, 0x91, 0xaf, 0x54, 0xe8, 0x16, 0x94, 0xa4,
+	0xab, 0x8d, 0xa3, 0xe3, 0x3a, 0x96, 0xc2, 0x86, 0xe1, 0xd9, 0x80, 0xb5, 0xb1, 0xdd, 0x1a, 0xae,
+	0x57, 0x5b, 0x75, 0x5d, 0x4b, 0xdc, 0x7c, 0x75, 0xbc, 0xcb, 0x37, 0x53, 0x8f, 0x5f, 0x42, 0x96,
+	0x17, 0x77, 0x9e, 0x06, 0x2b, 0xa0, 0xef, 0x1e, 0x7c, 0x31, 0x1a, 0xf9, 0x12, 0xac, 0x0c, 0xa8,
+	0x11, 0x4b, 0x74, 0x0d, 0x2d, 0x43, 0x71, 0xb0, 0xa3, 0xd2, 0x20, 0xb5, 0x53, 0xfb, 0xfb, 0x9b,
+	0x3b, 0xda, 0x0f, 0x6f, 0xee, 0x68, 0xff, 0x7e, 0x73, 0x47, 0xfb, 0xea, 0xf9, 0xb9, 0xc3, 0x2e,
+	0xba, 0xa7, 0x15, 0xcb, 0x6f, 0x6f, 0xc5, 0xfe, 0x1a, 0xa9, 0x9c, 0x13, 0x4f, 0xfe, 0x5d, 0x33,
+	0xfc, 0x97, 0xe4, 0xe7, 0xf2, 0x57, 0xef, 0xe9, 0xe9, 0xbc, 0xd8, 0x79, 0xf6, 0x9f, 0x01, 0x00,
+	0xed, 0x9f, 0x22, 0x72, 0x1a, 0x1a, 0x00, 0x00,
+}
+
+func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScheduledTime != nil {
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SyncShardStatus != nil {
 		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SyncShardStatus.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1666,41 +1894,41 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if m.ScheduledId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.ScheduledId))
+	if m.HasMore {
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.Version != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.Version))
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
-		}
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.LastRetrievedMessageId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1710,12 +1938,12 @@ func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1724,21 +1952,18 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NewRunEvents != nil {
+	if m.Attributes != nil {
 		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Attributes.Size()
+			i -= size
+			if _, err := m.Attributes.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.Events != nil {
+	if m.CreationTime != nil {
 		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreationTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1746,25 +1971,31 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.SourceTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.SourceTaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.WorkflowExecution != nil {
+	if m.TaskType != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationTask_DomainTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DomainTaskAttributes != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DomainTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1772,50 +2003,41 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *FailoverMarkerAttributes) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FailoverMarkerAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SyncShardStatusTaskAttributes != nil {
+		{
+			size, err := m.SyncShardStatusTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FailoverMarkerAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationTask_SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CreationTime != nil {
+	if m.SyncActivityTaskAttributes != nil {
 		{
-			size, err := m.CreationTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SyncActivityTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1823,50 +2045,62 @@ func (m *FailoverMarkerAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FailoverVersion != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.FailoverVersion))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x32
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HistoryTaskV2Attributes != nil {
+		{
+			size, err := m.HistoryTaskV2Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *FailoverMarkerToken) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationTask_FailoverMarkerAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FailoverMarkerToken) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationTask_FailoverMarkerAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FailoverMarkerAttributes != nil {
+		{
+			size, err := m.FailoverMarkerAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_FrameAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FailoverMarkerToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicationTask_FrameAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.FailoverMarker != nil {
+	if m.FrameAttributes != nil {
 		{
-			size, err := m.FailoverMarker.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FrameAttributes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1874,31 +2108,32 @@ func (m *FailoverMarkerToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA23 := make([]byte, len(m.ShardIds)*10)
-		var j22 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j22++
+	return len(dAtA) - i, nil
+}
+func (m *ReplicationTask_SyncCompletionCallbacksTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTask_SyncCompletionCallbacksTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SyncCompletionCallbacksTaskAttributes != nil {
+		{
+			size, err := m.SyncCompletionCallbacksTaskAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintReplication(dAtA, i, uint64(j22))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
+func (m *DomainTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1908,12 +2143,12 @@ func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicationTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DomainTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1922,39 +2157,24 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ScheduledId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.ScheduledId))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.FirstEventId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.FirstEventId))
+	if m.PreviousFailoverVersion != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.PreviousFailoverVersion))
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Version != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.Version))
+	if m.FailoverVersion != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.FailoverVersion))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.TaskId))
+	if m.ConfigVersion != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ConfigVersion))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
+	if m.Domain != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1962,19 +2182,24 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+	if m.DomainOperation != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.DomainOperation))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
+func (m *SyncShardStatusTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1984,12 +2209,12 @@ func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicationToken) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncShardStatusTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicationToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncShardStatusTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1998,25 +2223,34 @@ func (m *ReplicationToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LastProcessedMessageId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.LastProcessedMessageId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintReplication(dAtA, i, uint64(m.LastRetrievedMessageId))
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if m.ShardId != 0 {
 		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SyncShardStatus) Marshal() (dAtA []byte, err error) {
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncActivityTaskAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2026,12 +2260,12 @@ func (m *SyncShardStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncActivityTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2040,9 +2274,9 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Timestamp != nil {
+	if m.VersionHistory != nil {
 		{
-			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2050,446 +2284,2809 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReplication(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x6a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ReplicationMessages) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovReplication(uint64(l))
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	if m.Attempt != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.HasMore {
-		n += 2
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.SyncShardStatus != nil {
-		l = m.SyncShardStatus.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.LastHeartbeatTime != nil {
+		{
+			size, err := m.LastHeartbeatTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *ReplicationTask) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartedId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.TaskType != 0 {
-		n += 1 + sovReplication(uint64(m.TaskType))
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.SourceTaskId != 0 {
-		n += 1 + sovReplication(uint64(m.SourceTaskId))
+	if m.ScheduledId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CreationTime != nil {
-		l = m.CreationTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Attributes != nil {
-		n += m.Attributes.Size()
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationTask_DomainTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DomainTaskAttributes != nil {
-		l = m.DomainTaskAttributes.Size()
-		n += 1 + l + sovReplication(uint64(l))
+func (m *HistoryTaskV2Attributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReplicationTask_SyncShardStatusTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SyncShardStatusTaskAttributes != nil {
-		l = m.SyncShardStatusTaskAttributes.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	return n
+
+func (m *HistoryTaskV2Attributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ReplicationTask_SyncActivityTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SyncActivityTaskAttributes != nil {
-		l = m.SyncActivityTaskAttributes.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	return n
-}
-func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	if m.HistoryTaskV2Attributes != nil {
-		l = m.HistoryTaskV2Attributes.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *ReplicationTask_FailoverMarkerAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.FailoverMarkerAttributes != nil {
-		l = m.FailoverMarkerAttributes.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (m *DomainTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FailoverMarkerAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FailoverMarkerAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailoverMarkerAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DomainOperation != 0 {
-		n += 1 + sovReplication(uint64(m.DomainOperation))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.Domain != nil {
-		l = m.Domain.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ConfigVersion != 0 {
-		n += 1 + sovReplication(uint64(m.ConfigVersion))
+	if m.CreationTime != nil {
+		{
+			size, err := m.CreationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.FailoverVersion != 0 {
-		n += 1 + sovReplication(uint64(m.FailoverVersion))
-	}
-	if m.PreviousFailoverVersion != 0 {
-		n += 1 + sovReplication(uint64(m.PreviousFailoverVersion))
+		i = encodeVarintReplication(dAtA, i, uint64(m.FailoverVersion))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FailoverMarkerToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FailoverMarkerToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailoverMarkerToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovReplication(uint64(m.ShardId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.FailoverMarker != nil {
+		{
+			size, err := m.FailoverMarker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ShardIds) > 0 {
+		dAtA25 := make([]byte, len(m.ShardIds)*10)
+		var j24 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintReplication(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityTaskAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovReplication(uint64(m.Version))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.ScheduledId != 0 {
-		n += 1 + sovReplication(uint64(m.ScheduledId))
+		i = encodeVarintReplication(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.NextEventId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.StartedId != 0 {
-		n += 1 + sovReplication(uint64(m.StartedId))
+	if m.FirstEventId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LastHeartbeatTime != nil {
-		l = m.LastHeartbeatTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.TaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.TaskType != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovReplication(uint64(m.Attempt))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LastFailure != nil {
-		l = m.LastFailure.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.LastWorkerIdentity)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoryTaskV2Attributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovReplication(uint64(m.TaskId))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if len(m.VersionHistoryItems) > 0 {
-		for _, e := range m.VersionHistoryItems {
-			l = e.Size()
-			n += 1 + l + sovReplication(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.LastProcessedMessageId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.LastProcessedMessageId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.NewRunEvents != nil {
-		l = m.NewRunEvents.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FailoverMarkerAttributes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SyncShardStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncShardStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.FailoverVersion != 0 {
-		n += 1 + sovReplication(uint64(m.FailoverVersion))
-	}
-	if m.CreationTime != nil {
-		l = m.CreationTime.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FailoverMarkerToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationDomainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationDomainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationDomainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovReplication(uint64(e))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OldestTaskTime != nil {
+		{
+			size, err := m.OldestTaskTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
 		}
-		n += 1 + sovReplication(uint64(l)) + l
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.FailoverMarker != nil {
-		l = m.FailoverMarker.Size()
-		n += 1 + l + sovReplication(uint64(l))
+	if m.Backlog != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Backlog))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationShardStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationShardStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
-	if m.TaskType != 0 {
-		n += 1 + sovReplication(uint64(m.TaskType))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovReplication(uint64(m.TaskId))
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Version != 0 {
-		n += 1 + sovReplication(uint64(m.Version))
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.FirstEventId != 0 {
-		n += 1 + sovReplication(uint64(m.FirstEventId))
+	if m.ReplicatedUpTo != nil {
+		{
+			size, err := m.ReplicatedUpTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovReplication(uint64(m.NextEventId))
+	if m.LastTaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.LastTaskId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovReplication(uint64(m.ScheduledId))
+	if m.AckLevel != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.AckLevel))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *ReplicationTaskFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationTaskFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTaskFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovReplication(uint64(m.ShardId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.LastProcessedMessageId != 0 {
-		n += 1 + sovReplication(uint64(m.LastProcessedMessageId))
+	if m.LastTime != nil {
+		{
+			size, err := m.LastTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Count != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationProcessorState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationProcessorState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationProcessorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovReplication(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.FailuresByDomain) > 0 {
+		for k := range m.FailuresByDomain {
+			v := m.FailuresByDomain[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintReplication(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReplication(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReplication(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ReplicatedUpTo != nil {
+		{
+			size, err := m.ReplicatedUpTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovReplication(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ReplicationDLQTaskState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozReplication(x uint64) (n int) {
-	return sovReplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ReplicationDLQTaskState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowReplication
+
+func (m *ReplicationDLQTaskState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Poison {
+		i--
+		if m.Poison {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextAttemptTime != nil {
+		{
+			size, err := m.NextAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastAttemptTime != nil {
+		{
+			size, err := m.LastAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ErrorClass) > 0 {
+		i -= len(m.ErrorClass)
+		copy(dAtA[i:], m.ErrorClass)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ErrorClass)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationShardReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationShardReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationShardReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DlqSizeByDomain) > 0 {
+		for k := range m.DlqSizeByDomain {
+			v := m.DlqSizeByDomain[k]
+			baseI := i
+			i = encodeVarintReplication(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReplication(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReplication(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProcessorState != nil {
+		{
+			size, err := m.ProcessorState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationTaskFrameAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationTaskFrameAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationTaskFrameAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReplicationMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovReplication(uint64(l))
+		}
+	}
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	if m.SyncShardStatus != nil {
+		l = m.SyncShardStatus.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		n += 1 + sovReplication(uint64(m.TaskType))
+	}
+	if m.SourceTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.SourceTaskId))
+	}
+	if m.CreationTime != nil {
+		l = m.CreationTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Attributes != nil {
+		n += m.Attributes.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTask_DomainTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainTaskAttributes != nil {
+		l = m.DomainTaskAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SyncShardStatusTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncShardStatusTaskAttributes != nil {
+		l = m.SyncShardStatusTaskAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SyncActivityTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncActivityTaskAttributes != nil {
+		l = m.SyncActivityTaskAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_HistoryTaskV2Attributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryTaskV2Attributes != nil {
+		l = m.HistoryTaskV2Attributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_FailoverMarkerAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailoverMarkerAttributes != nil {
+		l = m.FailoverMarkerAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_FrameAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrameAttributes != nil {
+		l = m.FrameAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *ReplicationTask_SyncCompletionCallbacksTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncCompletionCallbacksTaskAttributes != nil {
+		l = m.SyncCompletionCallbacksTaskAttributes.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	return n
+}
+func (m *DomainTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainOperation != 0 {
+		n += 1 + sovReplication(uint64(m.DomainOperation))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Domain != nil {
+		l = m.Domain.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.ConfigVersion != 0 {
+		n += 1 + sovReplication(uint64(m.ConfigVersion))
+	}
+	if m.FailoverVersion != 0 {
+		n += 1 + sovReplication(uint64(m.FailoverVersion))
+	}
+	if m.PreviousFailoverVersion != 0 {
+		n += 1 + sovReplication(uint64(m.PreviousFailoverVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncShardStatusTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovReplication(uint64(m.ShardId))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncActivityTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovReplication(uint64(m.Version))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovReplication(uint64(m.ScheduledId))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovReplication(uint64(m.StartedId))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.LastHeartbeatTime != nil {
+		l = m.LastHeartbeatTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovReplication(uint64(m.Attempt))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryTaskV2Attributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovReplication(uint64(m.TaskId))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for _, e := range m.VersionHistoryItems {
+			l = e.Size()
+			n += 1 + l + sovReplication(uint64(l))
+		}
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.NewRunEvents != nil {
+		l = m.NewRunEvents.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailoverMarkerAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.FailoverVersion != 0 {
+		n += 1 + sovReplication(uint64(m.FailoverVersion))
+	}
+	if m.CreationTime != nil {
+		l = m.CreationTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FailoverMarkerToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovReplication(uint64(e))
+		}
+		n += 1 + sovReplication(uint64(l)) + l
+	}
+	if m.FailoverMarker != nil {
+		l = m.FailoverMarker.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovReplication(uint64(m.TaskType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovReplication(uint64(m.TaskId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovReplication(uint64(m.Version))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovReplication(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovReplication(uint64(m.NextEventId))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovReplication(uint64(m.ScheduledId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovReplication(uint64(m.ShardId))
+	}
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovReplication(uint64(m.LastRetrievedMessageId))
+	}
+	if m.LastProcessedMessageId != 0 {
+		n += 1 + sovReplication(uint64(m.LastProcessedMessageId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationDomainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Backlog != 0 {
+		n += 1 + sovReplication(uint64(m.Backlog))
+	}
+	if m.OldestTaskTime != nil {
+		l = m.OldestTaskTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovReplication(uint64(m.DlqSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationShardStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovReplication(uint64(m.ShardId))
+	}
+	if m.AckLevel != 0 {
+		n += 1 + sovReplication(uint64(m.AckLevel))
+	}
+	if m.LastTaskId != 0 {
+		n += 1 + sovReplication(uint64(m.LastTaskId))
+	}
+	if m.ReplicatedUpTo != nil {
+		l = m.ReplicatedUpTo.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovReplication(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTaskFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovReplication(uint64(m.Count))
+	}
+	if m.LastTime != nil {
+		l = m.LastTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationProcessorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicatedUpTo != nil {
+		l = m.ReplicatedUpTo.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if len(m.FailuresByDomain) > 0 {
+		for k, v := range m.FailuresByDomain {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovReplication(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovReplication(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovReplication(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationDLQTaskState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovReplication(uint64(m.TaskId))
+	}
+	l = len(m.ErrorClass)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovReplication(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.LastAttemptTime != nil {
+		l = m.LastAttemptTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.NextAttemptTime != nil {
+		l = m.NextAttemptTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Poison {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationShardReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovReplication(uint64(m.ShardId))
+	}
+	if m.ProcessorState != nil {
+		l = m.ProcessorState.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if len(m.DlqSizeByDomain) > 0 {
+		for k, v := range m.DlqSizeByDomain {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReplication(uint64(len(k))) + 1 + sovReplication(uint64(v))
+			n += mapEntrySize + 1 + sovReplication(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationTaskFrameAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncCompletionCallbacksTaskAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovReplication(uint64(m.Version))
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovReplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovReplication(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReplication(x uint64) (n int) {
+	return sovReplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncShardStatus == nil {
+				m.SyncShardStatus = &SyncShardStatus{}
+			}
+			if err := m.SyncShardStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= ReplicationTaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskId", wireType)
+			}
+			m.SourceTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTime == nil {
+				m.CreationTime = &types.Timestamp{}
+			}
+			if err := m.CreationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DomainTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_DomainTaskAttributes{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatusTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncShardStatusTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncShardStatusTaskAttributes{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncActivityTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncActivityTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncActivityTaskAttributes{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskV2Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HistoryTaskV2Attributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FailoverMarkerAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_FailoverMarkerAttributes{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReplicationTaskFrameAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_FrameAttributes{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCompletionCallbacksTaskAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SyncCompletionCallbacksTaskAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &ReplicationTask_SyncCompletionCallbacksTaskAttributes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainOperation", wireType)
+			}
+			m.DomainOperation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DomainOperation |= DomainOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Domain == nil {
+				m.Domain = &v1.Domain{}
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+			}
+			m.ConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			}
+			m.FailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFailoverVersion", wireType)
+			}
+			m.PreviousFailoverVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousFailoverVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncActivityTaskAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncActivityTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = &types.Timestamp{}
+			}
+			if err := m.LastHeartbeatTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &v1.Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationMessages: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationMessages: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2516,16 +5113,18 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailure == nil {
+				m.LastFailure = &v1.Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -2535,34 +5134,27 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
 			}
-			m.HasMore = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2589,10 +5181,10 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncShardStatus == nil {
-				m.SyncShardStatus = &SyncShardStatus{}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &VersionHistory{}
 			}
-			if err := m.SyncShardStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2618,7 +5210,7 @@ func (m *ReplicationMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
+func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2641,17 +5233,17 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryTaskV2Attributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryTaskV2Attributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.TaskType = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -2661,35 +5253,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= ReplicationTaskType(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskId", wireType)
-			}
-			m.SourceTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -2699,31 +5272,27 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreationTime == nil {
-				m.CreationTime = &types.Timestamp{}
-			}
-			if err := m.CreationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainTaskAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2750,50 +5319,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DomainTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &ReplicationTask_DomainTaskAttributes{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncShardStatusTaskAttributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			v := &SyncShardStatusTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &ReplicationTask_SyncShardStatusTaskAttributes{v}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncActivityTaskAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2820,15 +5355,14 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncActivityTaskAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &ReplicationTask_SyncActivityTaskAttributes{v}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTaskV2Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2855,15 +5389,16 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HistoryTaskV2Attributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2890,11 +5425,12 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &FailoverMarkerAttributes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v1.DataBlob{}
+			}
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &ReplicationTask_FailoverMarkerAttributes{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2918,7 +5454,7 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,68 +5477,17 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailoverMarkerAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainOperation", wireType)
-			}
-			m.DomainOperation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DomainOperation |= DomainOperation(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: FailoverMarkerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3012,48 +5497,25 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Domain == nil {
-				m.Domain = &v1.Domain{}
-			}
-			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
-			}
-			m.ConfigVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfigVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
 			}
@@ -3072,11 +5534,11 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFailoverVersion", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			m.PreviousFailoverVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3086,11 +5548,28 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousFailoverVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTime == nil {
+				m.CreationTime = &types.Timestamp{}
+			}
+			if err := m.CreationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -3113,7 +5592,7 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *FailoverMarkerToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,66 +5615,91 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailoverMarkerToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailoverMarkerToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthReplication
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReplication
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3222,10 +5726,10 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = &types.Timestamp{}
+			if m.FailoverMarker == nil {
+				m.FailoverMarker = &FailoverMarkerAttributes{}
 			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailoverMarker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3251,7 +5755,7 @@ func (m *SyncShardStatusTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
+func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,10 +5778,10 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityTaskAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationTaskInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3347,12 +5851,69 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
 			}
-			m.Version = 0
+			m.FirstEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3362,16 +5923,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.FirstEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.ScheduledId = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3381,16 +5942,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
 			}
-			var msglen int
+			m.ScheduledId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3400,33 +5961,67 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ScheduledId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.StartedId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3436,16 +6031,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
 			}
-			var msglen int
+			m.LastRetrievedMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3455,33 +6050,16 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
 			}
-			var msglen int
+			m.LastProcessedMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3491,31 +6069,65 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastProcessedMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
 			}
-			if err := m.LastHeartbeatTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncShardStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3542,37 +6154,69 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v1.Payload{}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
 			}
-		case 11:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationDomainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationDomainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationDomainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3582,31 +6226,27 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v1.Failure{}
-			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3634,11 +6274,30 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backlog", wireType)
+			}
+			m.Backlog = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backlog |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTaskTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3665,13 +6324,32 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &VersionHistory{}
+			if m.OldestTaskTime == nil {
+				m.OldestTaskTime = &types.Timestamp{}
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldestTaskTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -3694,7 +6372,7 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
+func (m *ReplicationShardStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3717,17 +6395,17 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryTaskV2Attributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationShardStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryTaskV2Attributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.TaskId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3737,16 +6415,16 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
 			}
-			var stringLen uint64
+			m.AckLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3756,29 +6434,16 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AckLevel |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTaskId", wireType)
 			}
-			var msglen int
+			m.LastTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3788,31 +6453,14 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatedUpTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3839,14 +6487,16 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReplicatedUpTo == nil {
+				m.ReplicatedUpTo = &types.Timestamp{}
+			}
+			if err := m.ReplicatedUpTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3873,18 +6523,16 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Domains = append(m.Domains, &ReplicationDomainStatus{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3894,28 +6542,12 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v1.DataBlob{}
-			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -3938,7 +6570,7 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
+func (m *ReplicationTaskFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,17 +6593,17 @@ func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailoverMarkerAttributes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationTaskFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailoverMarkerAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationTaskFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -3981,29 +6613,16 @@ func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTime", wireType)
 			}
-			m.FailoverVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4013,16 +6632,33 @@ func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailoverVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTime == nil {
+				m.LastTime = &types.Timestamp{}
+			}
+			if err := m.LastTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4032,27 +6668,23 @@ func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreationTime == nil {
-				m.CreationTime = &types.Timestamp{}
-			}
-			if err := m.CreationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4076,7 +6708,7 @@ func (m *FailoverMarkerAttributes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailoverMarkerToken) Unmarshal(dAtA []byte) error {
+func (m *ReplicationProcessorState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4099,91 +6731,51 @@ func (m *FailoverMarkerToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailoverMarkerToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationProcessorState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailoverMarkerToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationProcessorState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowReplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowReplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthReplication
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthReplication
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatedUpTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowReplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicatedUpTo == nil {
+				m.ReplicatedUpTo = &types.Timestamp{}
+			}
+			if err := m.ReplicatedUpTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailuresByDomain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4210,12 +6802,105 @@ func (m *FailoverMarkerToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailoverMarker == nil {
-				m.FailoverMarker = &FailoverMarkerAttributes{}
+			if m.FailuresByDomain == nil {
+				m.FailuresByDomain = make(map[string]*ReplicationTaskFailure)
 			}
-			if err := m.FailoverMarker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *ReplicationTaskFailure
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReplication
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReplication
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthReplication
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthReplication
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ReplicationTaskFailure{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReplication(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReplication
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.FailuresByDomain[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4239,7 +6924,7 @@ func (m *FailoverMarkerToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
+func (m *ReplicationDLQTaskState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4262,15 +6947,34 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationTaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationDLQTaskState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationDLQTaskState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4298,11 +7002,62 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.ErrorClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4329,18 +7084,18 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.LastAttemptTime == nil {
+				m.LastAttemptTime = &types.Timestamp{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTime", wireType)
 			}
-			m.TaskType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4350,16 +7105,33 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAttemptTime == nil {
+				m.NextAttemptTime = &types.Timestamp{}
+			}
+			if err := m.NextAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Poison", wireType)
 			}
-			m.TaskId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4369,16 +7141,68 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Poison = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationShardReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationShardReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationShardReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.Version = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4388,16 +7212,16 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessorState", wireType)
 			}
-			m.FirstEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4407,16 +7231,33 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
 			}
-			m.NextEventId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessorState == nil {
+				m.ProcessorState = &ReplicationProcessorState{}
+			}
+			if err := m.ProcessorState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSizeByDomain", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4426,30 +7267,105 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
 			}
-			m.ScheduledId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DlqSizeByDomain == nil {
+				m.DlqSizeByDomain = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReplication
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReplication
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReplication(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReplication
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.DlqSizeByDomain[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -4472,7 +7388,7 @@ func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
+func (m *ReplicationTaskFrameAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4495,17 +7411,17 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationTaskFrameAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationTaskFrameAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.ShardId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplication
@@ -4515,49 +7431,26 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthReplication
 			}
-			m.LastRetrievedMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastProcessedMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastProcessedMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -4580,7 +7473,7 @@ func (m *ReplicationToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncCompletionCallbacksTaskAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4603,15 +7496,47 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncCompletionCallbacksTaskAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncCompletionCallbacksTaskAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4638,10 +7563,63 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = &types.Timestamp{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v1.CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
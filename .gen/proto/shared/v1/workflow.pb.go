@@ -83,8 +83,43 @@ func (WorkflowState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7ca73ea33aecbb95, []int{0}
 }
 
+type PendingActivityOperation int32
+
+const (
+	PendingActivityOperation_PENDING_ACTIVITY_OPERATION_INVALID        PendingActivityOperation = 0
+	PendingActivityOperation_PENDING_ACTIVITY_OPERATION_UPDATE_OPTIONS PendingActivityOperation = 1
+	PendingActivityOperation_PENDING_ACTIVITY_OPERATION_RETRY          PendingActivityOperation = 2
+	PendingActivityOperation_PENDING_ACTIVITY_OPERATION_FAIL           PendingActivityOperation = 3
+	PendingActivityOperation_PENDING_ACTIVITY_OPERATION_SKIP           PendingActivityOperation = 4
+)
+
+var PendingActivityOperation_name = map[int32]string{
+	0: "PENDING_ACTIVITY_OPERATION_INVALID",
+	1: "PENDING_ACTIVITY_OPERATION_UPDATE_OPTIONS",
+	2: "PENDING_ACTIVITY_OPERATION_RETRY",
+	3: "PENDING_ACTIVITY_OPERATION_FAIL",
+	4: "PENDING_ACTIVITY_OPERATION_SKIP",
+}
+
+var PendingActivityOperation_value = map[string]int32{
+	"PENDING_ACTIVITY_OPERATION_INVALID":        0,
+	"PENDING_ACTIVITY_OPERATION_UPDATE_OPTIONS": 1,
+	"PENDING_ACTIVITY_OPERATION_RETRY":          2,
+	"PENDING_ACTIVITY_OPERATION_FAIL":           3,
+	"PENDING_ACTIVITY_OPERATION_SKIP":           4,
+}
+
+func (x PendingActivityOperation) String() string {
+	return proto.EnumName(PendingActivityOperation_name, int32(x))
+}
+
+func (PendingActivityOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{1}
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.WorkflowState", WorkflowState_name, WorkflowState_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.PendingActivityOperation", PendingActivityOperation_name, PendingActivityOperation_value)
 }
 
 func init() {
@@ -92,20 +127,27 @@ func init() {
 }
 
 var fileDescriptor_7ca73ea33aecbb95 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-	0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xba,
-	0xcc, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
-	0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0xef, 0xe9,
-	0x17, 0xe6, 0xe8, 0xe3, 0xe9, 0x22, 0xc0, 0x80, 0x45, 0xce, 0x39, 0xc8, 0xd5, 0x31, 0xc4, 0xd5,
-	0x45, 0x80, 0x11, 0x8b, 0x5c, 0x50, 0xa8, 0x9f, 0x9f, 0xa7, 0x9f, 0xbb, 0x00, 0x93, 0x90, 0x0c,
-	0x97, 0x04, 0xba, 0x3e, 0x7f, 0xdf, 0x00, 0x1f, 0x57, 0x90, 0x4e, 0x66, 0x21, 0x49, 0x2e, 0x51,
-	0x34, 0xd9, 0x28, 0x7f, 0x5f, 0x27, 0x4f, 0x57, 0x01, 0x16, 0x21, 0x71, 0x2e, 0x61, 0x34, 0xa9,
-	0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
-	0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xa3, 0x4c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x42, 0x4b, 0x2f, 0x3d,
-	0x35, 0x4f, 0x1f, 0x1c, 0x3e, 0x88, 0x80, 0xb3, 0x86, 0xb0, 0xca, 0x0c, 0x93, 0xd8, 0xc0, 0x32,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x33, 0x81, 0xb3, 0x62, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x6f, 0x4b, 0xfa, 0x40,
+	0x00, 0xc7, 0x7f, 0x53, 0x7f, 0x3e, 0x38, 0x08, 0x8e, 0x8b, 0xcc, 0x22, 0x2c, 0xfa, 0x07, 0x09,
+	0x6d, 0x48, 0xf4, 0xa8, 0x47, 0xd3, 0x9d, 0x72, 0x38, 0x77, 0xc7, 0x6d, 0x2a, 0xfa, 0x64, 0xcc,
+	0x79, 0xe9, 0xa8, 0x36, 0x59, 0xe7, 0xa4, 0x77, 0xd8, 0xc3, 0xa0, 0x37, 0x50, 0xbe, 0x92, 0x98,
+	0x0a, 0x95, 0x88, 0x3d, 0xbb, 0xe3, 0xf3, 0xf9, 0x7e, 0x8f, 0x83, 0x2f, 0xb8, 0x98, 0x0e, 0x44,
+	0xac, 0xf9, 0xde, 0x50, 0x84, 0xbe, 0xd0, 0x9e, 0xc7, 0x5e, 0x2c, 0x86, 0x5a, 0x52, 0xd1, 0x66,
+	0x51, 0xfc, 0x70, 0xff, 0x18, 0xcd, 0xd4, 0x49, 0x1c, 0xc9, 0x08, 0x15, 0x52, 0x4d, 0x5d, 0x69,
+	0xea, 0x52, 0x53, 0x93, 0x4a, 0xf9, 0x5d, 0x01, 0x3b, 0xdd, 0x95, 0x6a, 0x4b, 0x4f, 0x0a, 0x74,
+	0x08, 0x0a, 0x5d, 0xca, 0x9b, 0x75, 0x93, 0x76, 0x5d, 0xdb, 0xd1, 0x1d, 0xec, 0x12, 0xab, 0xa3,
+	0x9b, 0xc4, 0x80, 0xff, 0x36, 0xb0, 0x1a, 0xc7, 0xba, 0x83, 0x0d, 0xa8, 0x6c, 0x60, 0xbc, 0x6d,
+	0x59, 0xc4, 0x6a, 0xc0, 0x0c, 0x3a, 0x02, 0xc5, 0xf5, 0x1c, 0x6d, 0x31, 0x13, 0xa7, 0xc9, 0x2c,
+	0x3a, 0x00, 0x7b, 0x6b, 0xb4, 0x4f, 0x5b, 0x55, 0x82, 0x61, 0x0e, 0xed, 0x83, 0xdd, 0x35, 0xd4,
+	0xa1, 0xc4, 0x80, 0xff, 0x37, 0x36, 0x72, 0xde, 0x66, 0x69, 0x63, 0xbe, 0xfc, 0xa9, 0x80, 0x22,
+	0x13, 0xe1, 0x30, 0x08, 0x47, 0xba, 0x2f, 0x83, 0x24, 0x90, 0x2f, 0x74, 0x22, 0x62, 0x4f, 0x06,
+	0x51, 0x88, 0x2e, 0xc1, 0x29, 0xc3, 0x96, 0x41, 0xac, 0x86, 0xab, 0xd7, 0x1c, 0xd2, 0x21, 0x4e,
+	0xcf, 0xa5, 0x0c, 0x73, 0xdd, 0x21, 0xd4, 0xfa, 0xf1, 0xd9, 0x6b, 0x70, 0xb5, 0xc5, 0x6b, 0x33,
+	0x23, 0x7d, 0x95, 0xb2, 0xf4, 0x66, 0x43, 0x05, 0x9d, 0x83, 0x93, 0x2d, 0x3a, 0xc7, 0x0e, 0xef,
+	0xc1, 0x0c, 0x3a, 0x03, 0xc7, 0x5b, 0xac, 0xba, 0x4e, 0x4c, 0x98, 0xfd, 0x43, 0xb2, 0x9b, 0x84,
+	0xc1, 0x5c, 0xb5, 0xf6, 0x3a, 0x2f, 0x29, 0x6f, 0xf3, 0x92, 0xf2, 0x31, 0x2f, 0x29, 0xfd, 0xdb,
+	0x51, 0x20, 0xc7, 0xd3, 0x81, 0xea, 0x47, 0x4f, 0xda, 0xaf, 0x45, 0xa8, 0x23, 0x11, 0x6a, 0x8b,
+	0x0d, 0x7c, 0x8f, 0xe3, 0x6e, 0x79, 0x4a, 0x2a, 0x83, 0xfc, 0x82, 0xdc, 0x7c, 0x0d, 0x00, 0x9c,
+	0xa8, 0xd0, 0x25, 0x46, 0x02, 0x00, 0x00,
 }
@@ -28,20 +28,26 @@ package sharedv1
 var yarpcFileDescriptorClosure7ca73ea33aecbb95 = [][]byte{
 	// uber/cadence/shared/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-		0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f,
-		0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xba,
-		0xcc, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
-		0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0xef, 0xe9,
-		0x17, 0xe6, 0xe8, 0xe3, 0xe9, 0x22, 0xc0, 0x80, 0x45, 0xce, 0x39, 0xc8, 0xd5, 0x31, 0xc4, 0xd5,
-		0x45, 0x80, 0x11, 0x8b, 0x5c, 0x50, 0xa8, 0x9f, 0x9f, 0xa7, 0x9f, 0xbb, 0x00, 0x93, 0x90, 0x0c,
-		0x97, 0x04, 0xba, 0x3e, 0x7f, 0xdf, 0x00, 0x1f, 0x57, 0x90, 0x4e, 0x66, 0x21, 0x49, 0x2e, 0x51,
-		0x34, 0xd9, 0x28, 0x7f, 0x5f, 0x27, 0x4f, 0x57, 0x01, 0x16, 0x21, 0x71, 0x2e, 0x61, 0x34, 0xa9,
-		0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
-		0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x09,
-		0x21, 0xbd, 0xf4, 0xd4, 0x3c, 0x7d, 0x70, 0x98, 0x20, 0x02, 0xcb, 0x1a, 0xc2, 0x2a, 0x33, 0x4c,
-		0x62, 0x03, 0xcb, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x5f, 0x6b, 0xea, 0x30,
+		0x18, 0xc6, 0x4f, 0xd5, 0xe3, 0x45, 0xe0, 0x40, 0xc8, 0xe1, 0x78, 0xdc, 0x18, 0x6c, 0xec, 0x1f,
+		0x4c, 0x58, 0x8b, 0x8c, 0xb1, 0x8b, 0x5d, 0x55, 0x1b, 0x25, 0x58, 0x93, 0x90, 0x46, 0x45, 0x6f,
+		0x4a, 0xad, 0x99, 0x96, 0x6d, 0xad, 0x74, 0xb1, 0xb2, 0xcf, 0xb9, 0x4f, 0xb0, 0x6f, 0x32, 0xaa,
+		0xc2, 0x36, 0x11, 0x77, 0x97, 0xf0, 0xfb, 0xbd, 0x4f, 0x08, 0xef, 0x03, 0x2e, 0x16, 0x63, 0x95,
+		0x5a, 0x61, 0x30, 0x51, 0x71, 0xa8, 0xac, 0x97, 0x59, 0x90, 0xaa, 0x89, 0x95, 0xd5, 0xad, 0x65,
+		0x92, 0x3e, 0x3e, 0x3c, 0x25, 0x4b, 0x73, 0x9e, 0x26, 0x3a, 0x41, 0x95, 0x5c, 0x33, 0x37, 0x9a,
+		0xb9, 0xd6, 0xcc, 0xac, 0x5e, 0x7b, 0x33, 0xc0, 0x9f, 0xc1, 0x46, 0xf5, 0x74, 0xa0, 0x15, 0x3a,
+		0x04, 0x95, 0x01, 0x13, 0x9d, 0x96, 0xcb, 0x06, 0xbe, 0x27, 0x6d, 0x89, 0x7d, 0x42, 0xfb, 0xb6,
+		0x4b, 0x1c, 0xf8, 0x6b, 0x07, 0x6b, 0x0a, 0x6c, 0x4b, 0xec, 0x40, 0x63, 0x07, 0x13, 0x3d, 0x4a,
+		0x09, 0x6d, 0xc3, 0x02, 0x3a, 0x02, 0xd5, 0xed, 0x39, 0xd6, 0xe5, 0x2e, 0xce, 0x27, 0x8b, 0xe8,
+		0x00, 0xfc, 0xdb, 0xa2, 0x23, 0xd6, 0x6d, 0x10, 0x0c, 0x4b, 0xe8, 0x3f, 0xf8, 0xbb, 0x85, 0xfa,
+		0x8c, 0x38, 0xf0, 0xf7, 0xce, 0x44, 0x21, 0x7a, 0x3c, 0x4f, 0x2c, 0xd7, 0xde, 0x0d, 0x50, 0xe5,
+		0x2a, 0x9e, 0x44, 0xf1, 0xd4, 0x0e, 0x75, 0x94, 0x45, 0xfa, 0x95, 0xcd, 0x55, 0x1a, 0xe8, 0x28,
+		0x89, 0xd1, 0x25, 0x38, 0xe5, 0x98, 0x3a, 0x84, 0xb6, 0x7d, 0xbb, 0x29, 0x49, 0x9f, 0xc8, 0xa1,
+		0xcf, 0x38, 0x16, 0xb6, 0x24, 0x8c, 0x7e, 0xf9, 0xec, 0x35, 0xb8, 0xda, 0xe3, 0xf5, 0xb8, 0x93,
+		0xbf, 0xca, 0x78, 0x7e, 0xf3, 0xa0, 0x81, 0xce, 0xc1, 0xc9, 0x1e, 0x5d, 0x60, 0x29, 0x86, 0xb0,
+		0x80, 0xce, 0xc0, 0xf1, 0x1e, 0xab, 0x65, 0x13, 0x17, 0x16, 0x7f, 0x90, 0xbc, 0x0e, 0xe1, 0xb0,
+		0xd4, 0xb8, 0x1b, 0xdd, 0x4e, 0x23, 0x3d, 0x5b, 0x8c, 0xcd, 0x30, 0x79, 0xb6, 0xbe, 0xb5, 0xc0,
+		0x9c, 0xaa, 0xd8, 0x5a, 0xed, 0xfd, 0xb3, 0x10, 0xf7, 0xeb, 0x53, 0x56, 0x1f, 0x97, 0x57, 0xe4,
+		0xe6, 0x63, 0x00, 0x52, 0x4a, 0x2a, 0x1e, 0x3a, 0x02, 0x00, 0x00,
 	},
 }
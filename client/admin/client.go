@@ -251,6 +251,17 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) OperatePendingActivity(
+	ctx context.Context,
+	request *types.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.OperatePendingActivity(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -272,6 +283,26 @@ func (c *clientImpl) GetCrossClusterTasks(
 	return c.client.GetCrossClusterTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationStatusResponse, error) {
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetFailoverReadiness(
+	ctx context.Context,
+	request *types.GetFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetFailoverReadinessResponse, error) {
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return c.client.GetFailoverReadiness(ctx, request, opts...)
+}
+
 func (c *clientImpl) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -322,6 +353,16 @@ func (c *clientImpl) ListDynamicConfig(
 	return c.client.ListDynamicConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskList(
+	ctx context.Context,
+	request *types.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return c.client.MoveTaskList(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -118,6 +118,11 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.Ref
 	return proto.ToError(err)
 }
 
+func (g grpcClient) OperatePendingActivity(ctx context.Context, request *types.OperatePendingActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.OperatePendingActivity(ctx, proto.FromAdminOperatePendingActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveTask(ctx, proto.FromAdminRemoveTaskRequest(request), opts...)
 	return proto.ToError(err)
@@ -138,6 +143,16 @@ func (g grpcClient) GetCrossClusterTasks(ctx context.Context, request *types.Get
 	return proto.ToAdminGetCrossClusterTasksResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetReplicationStatus(ctx context.Context, request *types.GetReplicationStatusRequest, opts ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	response, err := g.c.GetReplicationStatus(ctx, proto.FromAdminGetReplicationStatusRequest(request), opts...)
+	return proto.ToAdminGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) GetFailoverReadiness(ctx context.Context, request *types.GetFailoverReadinessRequest, opts ...yarpc.CallOption) (*types.GetFailoverReadinessResponse, error) {
+	response, err := g.c.GetFailoverReadiness(ctx, proto.FromAdminGetFailoverReadinessRequest(request), opts...)
+	return proto.ToAdminGetFailoverReadinessResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) RespondCrossClusterTasksCompleted(ctx context.Context, request *types.RespondCrossClusterTasksCompletedRequest, opts ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := g.c.RespondCrossClusterTasksCompleted(ctx, proto.FromAdminRespondCrossClusterTasksCompletedRequest(request), opts...)
 	return proto.ToAdminRespondCrossClusterTasksCompletedResponse(response), proto.ToError(err)
@@ -162,3 +177,8 @@ func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDy
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) MoveTaskList(ctx context.Context, request *types.MoveTaskListRequest, opts ...yarpc.CallOption) (*types.MoveTaskListResponse, error) {
+	response, err := g.c.MoveTaskList(ctx, proto.FromMoveTaskListRequest(request), opts...)
+	return proto.ToMoveTaskListResponse(response), proto.ToError(err)
+}
@@ -374,6 +374,25 @@ func (mr *MockClientMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// OperatePendingActivity mocks base method
+func (m *MockClient) OperatePendingActivity(arg0 context.Context, arg1 *types.OperatePendingActivityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperatePendingActivity", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OperatePendingActivity indicates an expected call of OperatePendingActivity
+func (mr *MockClientMockRecorder) OperatePendingActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatePendingActivity", reflect.TypeOf((*MockClient)(nil).OperatePendingActivity), varargs...)
+}
+
 // RemoveTask mocks base method
 func (m *MockClient) RemoveTask(arg0 context.Context, arg1 *types.RemoveTaskRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -451,6 +470,46 @@ func (mr *MockClientMockRecorder) GetCrossClusterTasks(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockClient)(nil).GetCrossClusterTasks), varargs...)
 }
 
+// GetReplicationStatus mocks base method
+func (m *MockClient) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus
+func (mr *MockClientMockRecorder) GetReplicationStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockClient)(nil).GetReplicationStatus), varargs...)
+}
+
+// GetFailoverReadiness mocks base method
+func (m *MockClient) GetFailoverReadiness(arg0 context.Context, arg1 *types.GetFailoverReadinessRequest, arg2 ...yarpc.CallOption) (*types.GetFailoverReadinessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFailoverReadiness", varargs...)
+	ret0, _ := ret[0].(*types.GetFailoverReadinessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailoverReadiness indicates an expected call of GetFailoverReadiness
+func (mr *MockClientMockRecorder) GetFailoverReadiness(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailoverReadiness", reflect.TypeOf((*MockClient)(nil).GetFailoverReadiness), varargs...)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method
 func (m *MockClient) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest, arg2 ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -548,3 +607,23 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
+
+// MoveTaskList mocks base method
+func (m *MockClient) MoveTaskList(arg0 context.Context, arg1 *types.MoveTaskListRequest, arg2 ...yarpc.CallOption) (*types.MoveTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MoveTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskList indicates an expected call of MoveTaskList
+func (mr *MockClientMockRecorder) MoveTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskList", reflect.TypeOf((*MockClient)(nil).MoveTaskList), varargs...)
+}
@@ -359,6 +359,23 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *metricClient) OperatePendingActivity(
+	ctx context.Context,
+	request *types.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientOperatePendingActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientOperatePendingActivityScope, metrics.CadenceClientLatency)
+	err := c.client.OperatePendingActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientOperatePendingActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -393,6 +410,40 @@ func (c *metricClient) GetCrossClusterTasks(
 	return resp, err
 }
 
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationStatus(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetFailoverReadiness(
+	ctx context.Context,
+	request *types.GetFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetFailoverReadinessResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetFailoverReadinessScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetFailoverReadinessScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetFailoverReadiness(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetFailoverReadinessScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -478,3 +529,20 @@ func (c *metricClient) ListDynamicConfig(
 	}
 	return resp, err
 }
+
+func (c *metricClient) MoveTaskList(
+	ctx context.Context,
+	request *types.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientMoveTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMoveTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MoveTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMoveTaskListScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -303,6 +303,18 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) OperatePendingActivity(
+	ctx context.Context,
+	request *types.OperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.OperatePendingActivity(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *types.ResendReplicationTasksRequest,
@@ -330,6 +342,36 @@ func (c *retryableClient) GetCrossClusterTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationStatusResponse, error) {
+	var resp *types.GetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) GetFailoverReadiness(
+	ctx context.Context,
+	request *types.GetFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetFailoverReadinessResponse, error) {
+	var resp *types.GetFailoverReadinessResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetFailoverReadiness(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -397,3 +439,12 @@ func (c *retryableClient) ListDynamicConfig(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+// MoveTaskList is not retried, as a retry would move another batch of tasks
+func (c *retryableClient) MoveTaskList(
+	ctx context.Context,
+	request *types.MoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	return c.client.MoveTaskList(ctx, request, opts...)
+}
@@ -119,6 +119,11 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.R
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) OperatePendingActivity(ctx context.Context, request *types.OperatePendingActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.OperatePendingActivity(ctx, thrift.FromOperatePendingActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveTask(ctx, thrift.FromRemoveTaskRequest(request), opts...)
 	return thrift.ToError(err)
@@ -139,6 +144,16 @@ func (t thriftClient) GetCrossClusterTasks(ctx context.Context, request *types.G
 	return thrift.ToGetCrossClusterTasksResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetReplicationStatus(ctx context.Context, request *types.GetReplicationStatusRequest, opts ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	response, err := t.c.GetReplicationStatus(ctx, thrift.FromGetReplicationStatusRequest(request), opts...)
+	return thrift.ToGetReplicationStatusResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) GetFailoverReadiness(ctx context.Context, request *types.GetFailoverReadinessRequest, opts ...yarpc.CallOption) (*types.GetFailoverReadinessResponse, error) {
+	response, err := t.c.GetFailoverReadiness(ctx, thrift.FromGetFailoverReadinessRequest(request), opts...)
+	return thrift.ToGetFailoverReadinessResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) RespondCrossClusterTasksCompleted(ctx context.Context, request *types.RespondCrossClusterTasksCompletedRequest, opts ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := t.c.RespondCrossClusterTasksCompleted(ctx, thrift.FromRespondCrossClusterTasksCompletedRequest(request), opts...)
 	return thrift.ToRespondCrossClusterTasksCompletedResponse(response), thrift.ToError(err)
@@ -163,3 +178,8 @@ func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.List
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) MoveTaskList(ctx context.Context, request *types.MoveTaskListRequest, opts ...yarpc.CallOption) (*types.MoveTaskListResponse, error) {
+	response, err := t.c.MoveTaskList(ctx, thrift.FromMoveTaskListRequest(request), opts...)
+	return thrift.ToMoveTaskListResponse(response), thrift.ToError(err)
+}
@@ -236,6 +236,16 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.PauseWorkflowExecution(ctx, proto.FromPauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UnpauseWorkflowExecution(ctx, proto.FromUnpauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := g.domain.UpdateDomain(ctx, proto.FromUpdateDomainRequest(request), opts...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
@@ -558,6 +558,30 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
@@ -229,6 +229,16 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := t.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(request), opts...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
@@ -640,6 +640,42 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.PauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.PauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.UnpauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.UnpauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
@@ -966,6 +1002,24 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *clientImpl) OperatePendingActivity(
+	ctx context.Context,
+	request *types.HistoryOperatePendingActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.OperatePendingActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
@@ -1078,6 +1132,126 @@ func (c *clientImpl) GetCrossClusterTasks(
 	return response, nil
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.HistoryGetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationStatusResponse, error) {
+	requestByPeer := make(map[string]*types.HistoryGetReplicationStatusRequest)
+	for _, shardID := range request.GetShardIDs() {
+		peer, err := c.peerResolver.FromShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestByPeer[peer]; !ok {
+			requestByPeer[peer] = &types.HistoryGetReplicationStatusRequest{
+				RemoteCluster: request.RemoteCluster,
+			}
+		}
+		requestByPeer[peer].ShardIDs = append(requestByPeer[peer].ShardIDs, shardID)
+	}
+
+	// preserve 5% timeout to return partial of the result if context is timing out
+	ctx, cancel := common.CreateChildContext(ctx, 0.05)
+	defer cancel()
+
+	futureByPeer := make(map[string]future.Future, len(requestByPeer))
+	for peer, req := range requestByPeer {
+		future, settable := future.NewFuture()
+		go func(ctx context.Context, peer string, req *types.HistoryGetReplicationStatusRequest) {
+			settable.Set(c.client.GetReplicationStatus(ctx, req, append(opts, yarpc.WithShardKey(peer))...))
+		}(ctx, peer, req)
+
+		futureByPeer[peer] = future
+	}
+
+	response := &types.GetReplicationStatusResponse{
+		StatusByShard:      make(map[int32]*types.ReplicationShardStatus),
+		FailedCauseByShard: make(map[int32]types.GetTaskFailedCause),
+	}
+	for peer, future := range futureByPeer {
+		var resp *types.GetReplicationStatusResponse
+		if futureErr := future.Get(ctx, &resp); futureErr != nil {
+			c.logger.Error("Failed to get replication status", tag.Error(futureErr))
+			for _, failedShardID := range requestByPeer[peer].ShardIDs {
+				response.FailedCauseByShard[failedShardID] = common.ConvertErrToGetTaskFailedCause(futureErr)
+			}
+		} else {
+			for shardID, status := range resp.StatusByShard {
+				response.StatusByShard[shardID] = status
+			}
+			for shardID, failedCause := range resp.FailedCauseByShard {
+				response.FailedCauseByShard[shardID] = failedCause
+			}
+		}
+	}
+	// not using a waitGroup for created goroutines as once all futures are unblocked,
+	// those goroutines will eventually be completed
+
+	return response, nil
+}
+
+func (c *clientImpl) GetReplicationReadiness(
+	ctx context.Context,
+	request *types.HistoryGetReplicationReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationReadinessResponse, error) {
+	requestByPeer := make(map[string]*types.HistoryGetReplicationReadinessRequest)
+	for _, shardID := range request.GetShardIDs() {
+		peer, err := c.peerResolver.FromShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestByPeer[peer]; !ok {
+			requestByPeer[peer] = &types.HistoryGetReplicationReadinessRequest{
+				SourceCluster: request.SourceCluster,
+			}
+		}
+		requestByPeer[peer].ShardIDs = append(requestByPeer[peer].ShardIDs, shardID)
+	}
+
+	// preserve 5% timeout to return partial of the result if context is timing out
+	ctx, cancel := common.CreateChildContext(ctx, 0.05)
+	defer cancel()
+
+	futureByPeer := make(map[string]future.Future, len(requestByPeer))
+	for peer, req := range requestByPeer {
+		future, settable := future.NewFuture()
+		go func(ctx context.Context, peer string, req *types.HistoryGetReplicationReadinessRequest) {
+			settable.Set(c.client.GetReplicationReadiness(ctx, req, append(opts, yarpc.WithShardKey(peer))...))
+		}(ctx, peer, req)
+
+		futureByPeer[peer] = future
+	}
+
+	response := &types.GetReplicationReadinessResponse{
+		ReadinessByShard:   make(map[int32]*types.ReplicationShardReadiness),
+		FailedCauseByShard: make(map[int32]types.GetTaskFailedCause),
+	}
+	for peer, future := range futureByPeer {
+		var resp *types.GetReplicationReadinessResponse
+		if futureErr := future.Get(ctx, &resp); futureErr != nil {
+			c.logger.Error("Failed to get replication readiness", tag.Error(futureErr))
+			for _, failedShardID := range requestByPeer[peer].ShardIDs {
+				response.FailedCauseByShard[failedShardID] = common.ConvertErrToGetTaskFailedCause(futureErr)
+			}
+		} else {
+			for shardID, status := range resp.ReadinessByShard {
+				response.ReadinessByShard[shardID] = status
+			}
+			for shardID, failedCause := range resp.FailedCauseByShard {
+				response.FailedCauseByShard[shardID] = failedCause
+			}
+		}
+	}
+	// not using a waitGroup for created goroutines as once all futures are unblocked,
+	// those goroutines will eventually be completed
+
+	return response, nil
+}
+
 func (c *clientImpl) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -68,6 +68,16 @@ func (g grpcClient) GetCrossClusterTasks(ctx context.Context, request *types.Get
 	return proto.ToHistoryGetCrossClusterTasksResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetReplicationStatus(ctx context.Context, request *types.HistoryGetReplicationStatusRequest, opts ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	response, err := g.c.GetReplicationStatus(ctx, proto.FromHistoryGetReplicationStatusRequest(request), opts...)
+	return proto.ToHistoryGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) GetReplicationReadiness(ctx context.Context, request *types.HistoryGetReplicationReadinessRequest, opts ...yarpc.CallOption) (*types.GetReplicationReadinessResponse, error) {
+	response, err := g.c.GetReplicationReadiness(ctx, proto.FromHistoryGetReplicationReadinessRequest(request), opts...)
+	return proto.ToHistoryGetReplicationReadinessResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) GetDLQReplicationMessages(ctx context.Context, request *types.GetDLQReplicationMessagesRequest, opts ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error) {
 	response, err := g.c.GetDLQReplicationMessages(ctx, proto.FromHistoryGetDLQReplicationMessagesRequest(request), opts...)
 	return proto.ToHistoryGetDLQReplicationMessagesResponse(response), proto.ToError(err)
@@ -143,6 +153,11 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.His
 	return proto.ToError(err)
 }
 
+func (g grpcClient) OperatePendingActivity(ctx context.Context, request *types.HistoryOperatePendingActivityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.OperatePendingActivity(ctx, proto.FromHistoryOperatePendingActivityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveSignalMutableState(ctx, proto.FromHistoryRemoveSignalMutableStateRequest(request), opts...)
 	return proto.ToError(err)
@@ -243,6 +258,16 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UnpauseWorkflowExecution(ctx, proto.FromHistoryUnpauseWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(request), opts...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
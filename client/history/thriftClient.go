@@ -69,6 +69,16 @@ func (t thriftClient) GetCrossClusterTasks(ctx context.Context, request *types.G
 	return thrift.ToGetCrossClusterTasksResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetReplicationStatus(ctx context.Context, request *types.HistoryGetReplicationStatusRequest, opts ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	response, err := t.c.GetReplicationStatus(ctx, thrift.FromHistoryGetReplicationStatusRequest(request), opts...)
+	return thrift.ToGetReplicationStatusResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) GetReplicationReadiness(ctx context.Context, request *types.HistoryGetReplicationReadinessRequest, opts ...yarpc.CallOption) (*types.GetReplicationReadinessResponse, error) {
+	response, err := t.c.GetReplicationReadiness(ctx, thrift.FromHistoryGetReplicationReadinessRequest(request), opts...)
+	return thrift.ToGetReplicationReadinessResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) GetDLQReplicationMessages(ctx context.Context, request *types.GetDLQReplicationMessagesRequest, opts ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error) {
 	response, err := t.c.GetDLQReplicationMessages(ctx, thrift.FromGetDLQReplicationMessagesRequest(request), opts...)
 	return thrift.ToGetDLQReplicationMessagesResponse(response), thrift.ToError(err)
@@ -144,6 +154,11 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.H
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) OperatePendingActivity(ctx context.Context, request *types.HistoryOperatePendingActivityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.OperatePendingActivity(ctx, thrift.FromHistoryOperatePendingActivityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveSignalMutableState(ctx, thrift.FromRemoveSignalMutableStateRequest(request), opts...)
 	return thrift.ToError(err)
@@ -244,6 +259,16 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseWorkflowExecution(ctx, thrift.FromHistoryPauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpauseWorkflowExecution(ctx, thrift.FromHistoryUnpauseWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) GetFailoverInfo(ctx context.Context, request *types.GetFailoverInfoRequest, opts ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error) {
 	response, err := t.c.GetFailoverInfo(ctx, thrift.FromGetFailoverInfoRequest(request), opts...)
 	return thrift.ToGetFailoverInfoResponse(response), thrift.ToError(err)
@@ -198,6 +198,20 @@ func (c *clientImpl) CancelOutstandingPoll(
 	return c.client.CancelOutstandingPoll(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) ReleaseOutstandingTask(
+	ctx context.Context,
+	request *types.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReleaseOutstandingTask(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -226,6 +240,20 @@ func (c *clientImpl) ListTaskListPartitions(
 	return c.client.ListTaskListPartitions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) MoveTaskList(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.MoveRequest.GetSourceTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
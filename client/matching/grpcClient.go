@@ -53,6 +53,11 @@ func (g grpcClient) CancelOutstandingPoll(ctx context.Context, request *types.Ca
 	return proto.ToError(err)
 }
 
+func (g grpcClient) ReleaseOutstandingTask(ctx context.Context, request *types.ReleaseOutstandingTaskRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReleaseOutstandingTask(ctx, proto.FromMatchingReleaseOutstandingTaskRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.MatchingDescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromMatchingDescribeTaskListRequest(request), opts...)
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
@@ -63,6 +68,11 @@ func (g grpcClient) ListTaskListPartitions(ctx context.Context, request *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) MoveTaskList(ctx context.Context, request *types.MatchingMoveTaskListRequest, opts ...yarpc.CallOption) (*types.MoveTaskListResponse, error) {
+	response, err := g.c.MoveTaskList(ctx, proto.FromMatchingMoveTaskListRequest(request), opts...)
+	return proto.ToMatchingMoveTaskListResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) GetTaskListsByDomain(ctx context.Context, request *types.GetTaskListsByDomainRequest, opts ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromMatchingGetTaskListsByDomainRequest(request), opts...)
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
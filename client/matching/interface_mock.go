@@ -116,6 +116,25 @@ func (mr *MockClientMockRecorder) CancelOutstandingPoll(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// ReleaseOutstandingTask mocks base method
+func (m *MockClient) ReleaseOutstandingTask(arg0 context.Context, arg1 *types.ReleaseOutstandingTaskRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseOutstandingTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseOutstandingTask indicates an expected call of ReleaseOutstandingTask
+func (mr *MockClientMockRecorder) ReleaseOutstandingTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutstandingTask", reflect.TypeOf((*MockClient)(nil).ReleaseOutstandingTask), varargs...)
+}
+
 // DescribeTaskList mocks base method
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +175,26 @@ func (mr *MockClientMockRecorder) ListTaskListPartitions(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockClient)(nil).ListTaskListPartitions), varargs...)
 }
 
+// MoveTaskList mocks base method
+func (m *MockClient) MoveTaskList(arg0 context.Context, arg1 *types.MatchingMoveTaskListRequest, arg2 ...yarpc.CallOption) (*types.MoveTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MoveTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskList indicates an expected call of MoveTaskList
+func (mr *MockClientMockRecorder) MoveTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskList", reflect.TypeOf((*MockClient)(nil).MoveTaskList), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
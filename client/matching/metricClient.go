@@ -206,6 +206,24 @@ func (c *metricClient) CancelOutstandingPoll(
 	return err
 }
 
+func (c *metricClient) ReleaseOutstandingTask(
+	ctx context.Context,
+	request *types.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientReleaseOutstandingTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientReleaseOutstandingTaskScope, metrics.CadenceClientLatency)
+	err := c.client.ReleaseOutstandingTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientReleaseOutstandingTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -242,6 +260,24 @@ func (c *metricClient) ListTaskListPartitions(
 	return resp, err
 }
 
+func (c *metricClient) MoveTaskList(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientMoveTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientMoveTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MoveTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientMoveTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
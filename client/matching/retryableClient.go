@@ -153,6 +153,19 @@ func (c *retryableClient) CancelOutstandingPoll(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) ReleaseOutstandingTask(
+	ctx context.Context,
+	request *types.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReleaseOutstandingTask(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -187,6 +200,16 @@ func (c *retryableClient) ListTaskListPartitions(
 	return resp, err
 }
 
+// MoveTaskList is not retried, as a retry would move another batch of tasks
+func (c *retryableClient) MoveTaskList(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+
+	return c.client.MoveTaskList(ctx, request, opts...)
+}
+
 func (c *retryableClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
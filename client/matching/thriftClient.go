@@ -66,6 +66,15 @@ func (t thriftClient) CancelOutstandingPoll(
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) ReleaseOutstandingTask(
+	ctx context.Context,
+	request *types.ReleaseOutstandingTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.ReleaseOutstandingTask(ctx, thrift.FromReleaseOutstandingTaskRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeTaskList(
 	ctx context.Context,
 	request *types.MatchingDescribeTaskListRequest,
@@ -84,6 +93,15 @@ func (t thriftClient) ListTaskListPartitions(
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) MoveTaskList(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListResponse, error) {
+	response, err := t.c.MoveTaskList(ctx, thrift.FromMatchingMoveTaskListRequest(request), opts...)
+	return thrift.ToMoveTaskListResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) GetTaskListsByDomain(
 	ctx context.Context,
 	request *types.GetTaskListsByDomainRequest,
@@ -228,6 +228,26 @@ func (s *server) startService() common.Daemon {
 		}
 	}
 
+	if targetStoreKey := s.cfg.Persistence.AdvancedVisibilityMigrationTargetStore; targetStoreKey != "" {
+		targetStore, ok := s.cfg.Persistence.DataStores[targetStoreKey]
+		if !ok || targetStore.ElasticSearch == nil {
+			log.Fatalf("not able to find advanced visibility migration target store in config: %v", targetStoreKey)
+		}
+
+		params.MigrationTargetESConfig = targetStore.ElasticSearch
+		params.MigrationTargetESConfig.SetUsernamePassword()
+		esClient, err := elasticsearch.NewGenericClient(params.MigrationTargetESConfig, params.Logger)
+		if err != nil {
+			log.Fatalf("error creating elastic search client of migration target store: %v", err)
+		}
+		params.MigrationTargetESClient = esClient
+
+		indexName, ok := params.MigrationTargetESConfig.Indices[common.VisibilityAppName]
+		if !ok || len(indexName) == 0 {
+			log.Fatalf("elastic search config of migration target store missing visibility index")
+		}
+	}
+
 	publicClientConfig := params.RPCFactory.GetDispatcher().ClientConfig(rpc.OutboundPublicClient)
 	if rpc.IsGRPCOutbound(publicClientConfig) {
 		params.PublicClient = compatibility.NewThrift2ProtoAdapter(
@@ -238,8 +238,16 @@ func (i *historyIterator) readHistory(ctx context.Context, firstEventID int64) (
 		ShardID:     common.IntPtr(i.request.ShardID),
 	}
 	historyBatches, _, _, err := persistenceutils.ReadFullPageV2EventsByBatch(ctx, i.historyV2Manager, req)
-	return historyBatches, err
-
+	if err != nil {
+		return nil, err
+	}
+	// headers of completion callbacks may carry credentials of the callback targets, they are not archived
+	for _, batch := range historyBatches {
+		for idx, event := range batch.Events {
+			batch.Events[idx] = common.RedactCompletionCallbacks(event)
+		}
+	}
+	return historyBatches, nil
 }
 
 // reset resets iterator to a certain state given its encoded representation
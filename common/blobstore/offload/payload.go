@@ -0,0 +1,254 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package offload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// referencePrefix marks a payload which was replaced by a reference to a blob
+	referencePrefix = "cadence-payload-ref:"
+
+	tagDomainID   = "domain_id"
+	tagWorkflowID = "workflow_id"
+)
+
+type (
+	// Reference points to an offloaded payload in the blob store
+	Reference struct {
+		Key  string `json:"key"`
+		Size int    `json:"size"`
+	}
+
+	// PayloadStore offloads payloads to a blob store and keeps references to them in their place, so that
+	// history only stores the (small) reference. Payloads of workflow start, signal and activity completion
+	// are offloaded, which are the ones read back by history consumers.
+	PayloadStore struct {
+		client blobstore.Client
+	}
+)
+
+// NewPayloadStore creates a new PayloadStore
+func NewPayloadStore(client blobstore.Client) *PayloadStore {
+	return &PayloadStore{
+		client: client,
+	}
+}
+
+// IsReference returns true if the payload is a reference to an offloaded payload
+func IsReference(payload []byte) bool {
+	return bytes.HasPrefix(payload, []byte(referencePrefix))
+}
+
+// DecodeReference decodes the reference stored in place of an offloaded payload
+func DecodeReference(payload []byte) (*Reference, error) {
+	if !IsReference(payload) {
+		return nil, fmt.Errorf("payload is not a reference")
+	}
+	var ref Reference
+	if err := json.Unmarshal(payload[len(referencePrefix):], &ref); err != nil {
+		return nil, fmt.Errorf("invalid payload reference: %v", err)
+	}
+	return &ref, nil
+}
+
+func encodeReference(ref *Reference) ([]byte, error) {
+	data, err := json.Marshal(ref)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(referencePrefix), data...), nil
+}
+
+// Offload writes the payload to the blob store and returns the reference to store instead of it. The key of the
+// blob is derived from the idempotency key of the request, e.g. its request ID, and from the payload, so that a
+// retried request writes the payload to the same blob instead of leaving one behind per attempt. A random key is
+// used if the idempotency key is empty.
+func (s *PayloadStore) Offload(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	idempotencyKey string,
+	payload []byte,
+) ([]byte, error) {
+	ref := &Reference{
+		// workflow IDs may contain any character, so they are only kept in the tags of the blob
+		Key:  keyPrefix(domainID) + blobID(workflowID, idempotencyKey, payload),
+		Size: len(payload),
+	}
+	if _, err := s.client.Put(ctx, &blobstore.PutRequest{
+		Key: ref.Key,
+		Blob: blobstore.Blob{
+			Tags: map[string]string{tagDomainID: domainID, tagWorkflowID: workflowID},
+			Body: payload,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return encodeReference(ref)
+}
+
+// RehydrateEvents replaces references in the payloads of the given events of a workflow in the given domain
+// with the offloaded payloads
+func (s *PayloadStore) RehydrateEvents(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	events []*types.HistoryEvent,
+) error {
+	for _, event := range events {
+		for _, payload := range eventPayloads(event) {
+			if !IsReference(*payload) {
+				continue
+			}
+			ref, err := DecodeReference(*payload)
+			if err != nil {
+				return err
+			}
+			// payloads are set by clients, which must not be able to read blobs of other domains this way
+			if !strings.HasPrefix(ref.Key, keyPrefix(domainID)) {
+				return fmt.Errorf("payload reference %v of event %v does not belong to the domain", ref.Key, event.EventID)
+			}
+			resp, err := s.client.Get(ctx, &blobstore.GetRequest{Key: ref.Key})
+			if err != nil {
+				return fmt.Errorf("failed to read offloaded payload %v of event %v: %v", ref.Key, event.EventID, err)
+			}
+			if !isOffloadedBy(&resp.Blob, domainID, workflowID) {
+				return fmt.Errorf("payload reference %v of event %v does not belong to the workflow", ref.Key, event.EventID)
+			}
+			*payload = resp.Blob.Body
+		}
+	}
+	return nil
+}
+
+// Delete deletes the offloaded payloads with the given keys, which were offloaded by the given workflow,
+// and returns the number of deleted payloads. Keys of payloads offloaded by other workflows are skipped.
+func (s *PayloadStore) Delete(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	keys []string,
+) (int, error) {
+	deleted := 0
+	for _, key := range keys {
+		// keys are taken from references set by clients, which must not be able to delete blobs of other domains
+		if !strings.HasPrefix(key, keyPrefix(domainID)) {
+			continue
+		}
+		// deletion is retried with the task, so blobs already deleted by a previous attempt are skipped
+		resp, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+		if err != nil {
+			return deleted, err
+		}
+		if !resp.Exists {
+			continue
+		}
+		blob, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key})
+		if err != nil {
+			return deleted, err
+		}
+		if !isOffloadedBy(&blob.Blob, domainID, workflowID) {
+			continue
+		}
+		if _, err := s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key}); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// ReferencedKeys returns the keys of the offloaded payloads referenced by the event. The input of a continued
+// as new event is passed on to the new run, which references it from its started event, so it is not included.
+func ReferencedKeys(event *types.HistoryEvent) []string {
+	var keys []string
+	for _, payload := range eventPayloads(event) {
+		if ref, err := DecodeReference(*payload); err == nil {
+			keys = append(keys, ref.Key)
+		}
+	}
+	return keys
+}
+
+// HasReference returns true if any payload of the event is a reference to an offloaded payload
+func HasReference(event *types.HistoryEvent) bool {
+	if attr := event.WorkflowExecutionContinuedAsNewEventAttributes; attr != nil && IsReference(attr.Input) {
+		return true
+	}
+	for _, payload := range eventPayloads(event) {
+		if IsReference(*payload) {
+			return true
+		}
+	}
+	return false
+}
+
+// isOffloadedBy returns true if the blob holds a payload offloaded by the given workflow
+func isOffloadedBy(blob *blobstore.Blob, domainID string, workflowID string) bool {
+	return blob.Tags[tagDomainID] == domainID && blob.Tags[tagWorkflowID] == workflowID
+}
+
+// blobID returns the ID of the blob holding the payload, a hash of the workflow ID, the idempotency key and the
+// payload: the same payload sent by a retried request gets the same ID, but a different payload never does
+func blobID(workflowID string, idempotencyKey string, payload []byte) string {
+	if idempotencyKey == "" {
+		return uuid.New()
+	}
+	hash := sha256.New()
+	hash.Write([]byte(workflowID))
+	hash.Write([]byte{0})
+	hash.Write([]byte(idempotencyKey))
+	hash.Write([]byte{0})
+	hash.Write(payload)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// keyPrefix returns the prefix of the keys of payloads offloaded by workflows of the domain
+func keyPrefix(domainID string) string {
+	return "payload_" + domainID + "_"
+}
+
+// eventPayloads returns the payloads of an event which may be offloaded
+func eventPayloads(event *types.HistoryEvent) []*[]byte {
+	switch {
+	case event.WorkflowExecutionStartedEventAttributes != nil:
+		return []*[]byte{&event.WorkflowExecutionStartedEventAttributes.Input}
+	case event.WorkflowExecutionSignaledEventAttributes != nil:
+		return []*[]byte{&event.WorkflowExecutionSignaledEventAttributes.Input}
+	case event.ActivityTaskCompletedEventAttributes != nil:
+		return []*[]byte{&event.ActivityTaskCompletedEventAttributes.Result}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package offload
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID        = "test-domain-id"
+	testOtherDomainID   = "test-other-domain-id"
+	testWorkflowID      = "test-workflow-id"
+	testOtherWorkflowID = "test-other-workflow-id"
+)
+
+type payloadStoreSuite struct {
+	suite.Suite
+	dir    string
+	client blobstore.Client
+	store  *PayloadStore
+}
+
+func TestPayloadStoreSuite(t *testing.T) {
+	suite.Run(t, new(payloadStoreSuite))
+}
+
+func (s *payloadStoreSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "payloadStoreSuite")
+	s.Require().NoError(err)
+	s.dir = dir
+	s.client, err = filestore.NewFilestoreClient(&config.FileBlobstore{OutputDirectory: dir})
+	s.Require().NoError(err)
+	s.store = NewPayloadStore(s.client)
+}
+
+func (s *payloadStoreSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *payloadStoreSuite) TestOffloadAndRehydrate() {
+	input := []byte("large workflow input")
+	result := []byte("large activity result")
+	inputRef := s.offload(testDomainID, input)
+	resultRef := s.offload(testDomainID, result)
+	s.True(IsReference(inputRef))
+	ref, err := DecodeReference(inputRef)
+	s.NoError(err)
+	s.Equal(len(input), ref.Size)
+
+	events := []*types.HistoryEvent{
+		{
+			EventID:                                 1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: inputRef},
+		},
+		{
+			EventID:                                  2,
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: []byte("small signal input")},
+		},
+		{
+			EventID:                              3,
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{Result: resultRef},
+		},
+	}
+	s.True(HasReference(events[0]))
+	s.False(HasReference(events[1]))
+
+	s.NoError(s.store.RehydrateEvents(context.Background(), testDomainID, testWorkflowID, events))
+	s.Equal(input, events[0].WorkflowExecutionStartedEventAttributes.Input)
+	s.Equal([]byte("small signal input"), events[1].WorkflowExecutionSignaledEventAttributes.Input)
+	s.Equal(result, events[2].ActivityTaskCompletedEventAttributes.Result)
+}
+
+func (s *payloadStoreSuite) TestRehydrate_ReferenceOfOtherDomain() {
+	events := []*types.HistoryEvent{
+		{
+			EventID:                                  1,
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: s.offload(testOtherDomainID, []byte("input"))},
+		},
+	}
+	s.Error(s.store.RehydrateEvents(context.Background(), testDomainID, testWorkflowID, events))
+}
+
+func (s *payloadStoreSuite) TestRehydrate_ReferenceOfOtherWorkflow() {
+	ref, err := s.store.Offload(context.Background(), testDomainID, testOtherWorkflowID, "", []byte("input"))
+	s.Require().NoError(err)
+	events := []*types.HistoryEvent{
+		{
+			EventID:                                  1,
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: ref},
+		},
+	}
+	s.Error(s.store.RehydrateEvents(context.Background(), testDomainID, testWorkflowID, events))
+}
+
+func (s *payloadStoreSuite) TestReferencedKeys() {
+	inputRef := s.offload(testDomainID, []byte("input"))
+	s.Equal([]string{s.key(inputRef)}, ReferencedKeys(&types.HistoryEvent{
+		EventID:                                 1,
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: inputRef},
+	}))
+	s.Empty(ReferencedKeys(&types.HistoryEvent{
+		EventID:                                  2,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: []byte("small signal input")},
+	}))
+	// the input of a cron run is passed on to the next run
+	s.Empty(ReferencedKeys(&types.HistoryEvent{
+		EventID: 3,
+		WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{Input: inputRef},
+	}))
+}
+
+func (s *payloadStoreSuite) TestOffload_IdempotencyKey() {
+	ref, err := s.store.Offload(context.Background(), testDomainID, testWorkflowID, "request-id", []byte("input"))
+	s.Require().NoError(err)
+	// a retried request writes the same blob
+	retriedRef, err := s.store.Offload(context.Background(), testDomainID, testWorkflowID, "request-id", []byte("input"))
+	s.Require().NoError(err)
+	s.Equal(ref, retriedRef)
+
+	otherPayloadRef, err := s.store.Offload(context.Background(), testDomainID, testWorkflowID, "request-id", []byte("other input"))
+	s.Require().NoError(err)
+	s.NotEqual(s.key(ref), s.key(otherPayloadRef))
+	otherWorkflowRef, err := s.store.Offload(context.Background(), testDomainID, testOtherWorkflowID, "request-id", []byte("input"))
+	s.Require().NoError(err)
+	s.NotEqual(s.key(ref), s.key(otherWorkflowRef))
+	randomRef, err := s.store.Offload(context.Background(), testDomainID, testWorkflowID, "", []byte("input"))
+	s.Require().NoError(err)
+	s.NotEqual(s.key(ref), s.key(randomRef))
+}
+
+func (s *payloadStoreSuite) TestDelete() {
+	inputRef := s.offload(testDomainID, []byte("input"))
+	signalRef := s.offload(testDomainID, []byte("signal"))
+	otherDomainRef := s.offload(testOtherDomainID, []byte("signal"))
+	otherWorkflowRef, err := s.store.Offload(context.Background(), testDomainID, testOtherWorkflowID, "", []byte("signal"))
+	s.Require().NoError(err)
+	keys := []string{s.key(inputRef), s.key(signalRef), s.key(otherDomainRef), s.key(otherWorkflowRef)}
+
+	deleted, err := s.store.Delete(context.Background(), testDomainID, testWorkflowID, keys)
+	s.NoError(err)
+	s.Equal(2, deleted)
+	s.False(s.exists(inputRef))
+	s.False(s.exists(signalRef))
+	s.True(s.exists(otherDomainRef))
+	s.True(s.exists(otherWorkflowRef))
+
+	// deleting again is a no-op
+	deleted, err = s.store.Delete(context.Background(), testDomainID, testWorkflowID, keys)
+	s.NoError(err)
+	s.Equal(0, deleted)
+}
+
+func (s *payloadStoreSuite) offload(domainID string, payload []byte) []byte {
+	ref, err := s.store.Offload(context.Background(), domainID, testWorkflowID, "", payload)
+	s.Require().NoError(err)
+	return ref
+}
+
+func (s *payloadStoreSuite) exists(payload []byte) bool {
+	ref, err := DecodeReference(payload)
+	s.Require().NoError(err)
+	resp, err := s.client.Exists(context.Background(), &blobstore.ExistsRequest{Key: ref.Key})
+	s.Require().NoError(err)
+	return resp.Exists
+}
+
+func (s *payloadStoreSuite) key(payload []byte) string {
+	ref, err := DecodeReference(payload)
+	s.Require().NoError(err)
+	return ref.Key
+}
@@ -24,9 +24,11 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"hash/fnv"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -862,6 +864,203 @@ func (entry *DomainCacheEntry) GetDomainNotActiveErr() error {
 	)
 }
 
+// GetActiveClusterForWorkflow returns the cluster a workflow of the domain is active in, which is the
+// active cluster of the domain unless the workflow is assigned to another cluster by WorkflowActiveClustersKey
+func (entry *DomainCacheEntry) GetActiveClusterForWorkflow(
+	workflowID string,
+) string {
+
+	return entry.getActiveClusterForWorkflow(entry.info.Data[WorkflowActiveClustersKey], workflowID)
+}
+
+func (entry *DomainCacheEntry) getActiveClusterForWorkflow(
+	value string,
+	workflowID string,
+) string {
+
+	activeCluster := entry.replicationConfig.ActiveClusterName
+	if !entry.isGlobalDomain {
+		return activeCluster
+	}
+	workflowActiveClusters, err := ParseWorkflowActiveClusters(value)
+	if err != nil {
+		return activeCluster
+	}
+
+	h := fnv.New32a()
+	if _, err := h.Write([]byte(workflowID)); err != nil {
+		return activeCluster
+	}
+	bucket := int(h.Sum32() % 100)
+	for _, workflowActiveCluster := range workflowActiveClusters {
+		if bucket < workflowActiveCluster.Percentage {
+			return workflowActiveCluster.ClusterName
+		}
+		bucket -= workflowActiveCluster.Percentage
+	}
+	return activeCluster
+}
+
+// GetWorkflowHandoffEndTime returns the time in unix nanoseconds the handoff of workflows moving to another
+// active cluster ends at, nil if no handoff is in progress
+func (entry *DomainCacheEntry) GetWorkflowHandoffEndTime() *int64 {
+
+	if !entry.isGlobalDomain {
+		return nil
+	}
+	value, ok := entry.info.Data[WorkflowActiveClustersHandoffEndTimeKey]
+	if !ok {
+		return nil
+	}
+	endTime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		// an unreadable end time is treated as an expired handoff
+		return common.Int64Ptr(0)
+	}
+	return &endTime
+}
+
+// IsWorkflowPendingActive returns whether a workflow of the domain is handed off to another active cluster,
+// i.e. its active cluster changed with the last change of WorkflowActiveClustersKey and the handoff is not over.
+// Such a workflow is active in neither cluster until the handoff ends.
+func (entry *DomainCacheEntry) IsWorkflowPendingActive(
+	workflowID string,
+) bool {
+
+	if entry.GetWorkflowHandoffEndTime() == nil {
+		return false
+	}
+	previousActiveCluster := entry.getActiveClusterForWorkflow(entry.info.Data[WorkflowActiveClustersPreviousKey], workflowID)
+	return previousActiveCluster != entry.GetActiveClusterForWorkflow(workflowID)
+}
+
+// IsActiveIn returns whether any workflow of the domain is active in the cluster
+func (entry *DomainCacheEntry) IsActiveIn(
+	clusterName string,
+) bool {
+
+	if !entry.isGlobalDomain || entry.replicationConfig.ActiveClusterName == clusterName {
+		return true
+	}
+	workflowActiveClusters, err := ParseWorkflowActiveClusters(entry.info.Data[WorkflowActiveClustersKey])
+	if err != nil {
+		return false
+	}
+	for _, workflowActiveCluster := range workflowActiveClusters {
+		if workflowActiveCluster.ClusterName == clusterName && workflowActiveCluster.Percentage > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWorkflowActive returns whether a workflow of the domain is active in the current cluster
+func (entry *DomainCacheEntry) IsWorkflowActive(
+	workflowID string,
+) bool {
+
+	if !entry.isGlobalDomain {
+		return true
+	}
+	return entry.clusterMetadata.GetCurrentClusterName() == entry.GetActiveClusterForWorkflow(workflowID) &&
+		!entry.IsDomainPendingActive() &&
+		!entry.IsWorkflowPendingActive(workflowID)
+}
+
+// GetWorkflowNotActiveErr return err if a workflow of the domain is not active, nil otherwise
+func (entry *DomainCacheEntry) GetWorkflowNotActiveErr(
+	workflowID string,
+) error {
+
+	if entry.IsWorkflowActive(workflowID) {
+		return nil
+	}
+	activeCluster := entry.GetActiveClusterForWorkflow(workflowID)
+	if entry.IsDomainPendingActive() ||
+		(entry.IsWorkflowPendingActive(workflowID) && activeCluster == entry.clusterMetadata.GetCurrentClusterName()) {
+		return errors.NewDomainPendingActiveError(
+			entry.info.Name,
+			entry.clusterMetadata.GetCurrentClusterName(),
+		)
+	}
+	return errors.NewDomainNotActiveError(
+		entry.info.Name,
+		entry.clusterMetadata.GetCurrentClusterName(),
+		activeCluster,
+	)
+}
+
+// GetFailoverVersionForWorkflow returns the failover version of a workflow of the domain. Workflows active
+// in another cluster than the domain use the version of that cluster derived from the domain failover version,
+// so it increases whenever the domain failover version increases.
+func (entry *DomainCacheEntry) GetFailoverVersionForWorkflow(
+	workflowID string,
+) int64 {
+
+	if !entry.isGlobalDomain {
+		return entry.failoverVersion
+	}
+	activeCluster := entry.replicationConfig.ActiveClusterName
+	workflowActiveCluster := entry.GetActiveClusterForWorkflow(workflowID)
+	if workflowActiveCluster == activeCluster {
+		return entry.failoverVersion
+	}
+	clusterInfo := entry.clusterMetadata.GetAllClusterInfo()
+	return entry.failoverVersion -
+		clusterInfo[activeCluster].InitialFailoverVersion +
+		clusterInfo[workflowActiveCluster].InitialFailoverVersion
+}
+
+// GetActiveDomainForWorkflow returns the domain cache entry by ID, and an error if the workflow is not
+// active in the current cluster
+func GetActiveDomainForWorkflow(
+	domainCache DomainCache,
+	domainID string,
+	workflowID string,
+) (*DomainCacheEntry, error) {
+
+	domainEntry, err := domainCache.GetActiveDomainByID(domainID)
+	if _, ok := err.(*types.DomainNotActiveError); err != nil && !ok {
+		return domainEntry, err
+	}
+	if err := domainEntry.GetWorkflowNotActiveErr(workflowID); err != nil {
+		return domainEntry, err
+	}
+	return domainEntry, nil
+}
+
+// ParseWorkflowActiveClusters parses the value of WorkflowActiveClustersKey
+func ParseWorkflowActiveClusters(
+	value string,
+) ([]WorkflowActiveCluster, error) {
+
+	if value == "" {
+		return nil, nil
+	}
+
+	var workflowActiveClusters []WorkflowActiveCluster
+	total := 0
+	for _, rule := range strings.Split(value, ";") {
+		parts := strings.Split(strings.TrimSpace(rule), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid workflow active cluster %q, expected <cluster>:<percentage>", rule)
+		}
+		percentage, err := strconv.Atoi(parts[1])
+		if err != nil || percentage < 0 {
+			return nil, fmt.Errorf("invalid percentage of workflow active cluster %q", rule)
+		}
+		total += percentage
+		workflowActiveClusters = append(workflowActiveClusters, WorkflowActiveCluster{
+			ClusterName: parts[0],
+			Percentage:  percentage,
+		})
+	}
+	if total > 100 {
+		return nil, fmt.Errorf("percentages of workflow active clusters add up to %v, more than 100", total)
+	}
+	return workflowActiveClusters, nil
+}
+
 // Len return length
 func (t DomainCacheEntries) Len() int {
 	return len(t)
@@ -891,6 +1090,26 @@ var SampleRetentionKey = "sample_retention_days"
 // SampleRateKey is key to specify sample rate
 var SampleRateKey = "sample_retention_rate"
 
+// WorkflowActiveClustersKey is key to specify the clusters workflows of a global domain are active in by
+// workflow ID, e.g. "cluster1:20;cluster2:10". Each cluster owns the given percentage of workflow ID hash
+// buckets, in the listed order, and the remaining workflows are active in the active cluster of the domain.
+var WorkflowActiveClustersKey = "workflow_active_clusters"
+
+// WorkflowActiveClustersPreviousKey is key of the value of WorkflowActiveClustersKey before its last change.
+// It is set by the domain handler together with WorkflowActiveClustersHandoffEndTimeKey and both are removed
+// by the domain failover watcher once the handoff ends.
+var WorkflowActiveClustersPreviousKey = "workflow_active_clusters_previous"
+
+// WorkflowActiveClustersHandoffEndTimeKey is key of the time in unix nanoseconds the handoff of workflows moving
+// to another active cluster after a change of WorkflowActiveClustersKey ends at
+var WorkflowActiveClustersHandoffEndTimeKey = "workflow_active_clusters_handoff_end_time"
+
+// WorkflowActiveCluster is the percentage of workflows of a domain active in a cluster
+type WorkflowActiveCluster struct {
+	ClusterName string
+	Percentage  int
+}
+
 // GetRetentionDays returns retention in days for given workflow
 func (entry *DomainCacheEntry) GetRetentionDays(
 	workflowID string,
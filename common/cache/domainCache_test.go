@@ -21,6 +21,7 @@
 package cache
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -794,3 +795,158 @@ func Test_DomainCacheEntry_GetDomainNotActiveErr(t *testing.T) {
 	_, ok := err.(*types.DomainNotActiveError)
 	require.True(t, ok)
 }
+
+func Test_ParseWorkflowActiveClusters(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected []WorkflowActiveCluster
+		err      bool
+	}{
+		"empty": {
+			value: "",
+		},
+		"single cluster": {
+			value:    "cluster1:20",
+			expected: []WorkflowActiveCluster{{ClusterName: "cluster1", Percentage: 20}},
+		},
+		"multiple clusters": {
+			value: "cluster1:20; cluster2:80",
+			expected: []WorkflowActiveCluster{
+				{ClusterName: "cluster1", Percentage: 20},
+				{ClusterName: "cluster2", Percentage: 80},
+			},
+		},
+		"missing percentage": {
+			value: "cluster1",
+			err:   true,
+		},
+		"invalid percentage": {
+			value: "cluster1:abc",
+			err:   true,
+		},
+		"negative percentage": {
+			value: "cluster1:-1",
+			err:   true,
+		},
+		"more than 100 percent": {
+			value: "cluster1:60;cluster2:50",
+			err:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			workflowActiveClusters, err := ParseWorkflowActiveClusters(tc.value)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, workflowActiveClusters)
+		})
+	}
+}
+
+func Test_DomainCacheEntry_WorkflowActiveClusters(t *testing.T) {
+	clusterMetadata := cluster.NewMetadata(
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetBoolPropertyFn(true),
+		int64(10),
+		cluster.TestCurrentClusterName,
+		cluster.TestCurrentClusterName,
+		cluster.TestAllClusterInfo,
+	)
+	domainEntry := NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "test-domain", Data: map[string]string{}},
+		nil,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1230,
+		clusterMetadata,
+	)
+	wid := "test-workflow-id"
+
+	require.Equal(t, cluster.TestCurrentClusterName, domainEntry.GetActiveClusterForWorkflow(wid))
+	require.True(t, domainEntry.IsWorkflowActive(wid))
+	require.False(t, domainEntry.IsActiveIn(cluster.TestAlternativeClusterName))
+	require.Equal(t, int64(1230), domainEntry.GetFailoverVersionForWorkflow(wid))
+
+	// move all workflows to the alternative cluster
+	domainEntry.info.Data[WorkflowActiveClustersKey] = cluster.TestAlternativeClusterName + ":100"
+	require.Equal(t, cluster.TestAlternativeClusterName, domainEntry.GetActiveClusterForWorkflow(wid))
+	require.False(t, domainEntry.IsWorkflowActive(wid))
+	require.True(t, domainEntry.IsActiveIn(cluster.TestAlternativeClusterName))
+	require.True(t, domainEntry.IsActiveIn(cluster.TestCurrentClusterName))
+	require.Equal(t, int64(1231), domainEntry.GetFailoverVersionForWorkflow(wid))
+	err := domainEntry.GetWorkflowNotActiveErr(wid)
+	require.IsType(t, &types.DomainNotActiveError{}, err)
+	require.Equal(t, cluster.TestAlternativeClusterName, err.(*types.DomainNotActiveError).ActiveCluster)
+
+	// split workflows between the clusters
+	domainEntry.info.Data[WorkflowActiveClustersKey] = cluster.TestAlternativeClusterName + ":50"
+	activeClusters := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		activeClusters[domainEntry.GetActiveClusterForWorkflow(fmt.Sprintf("workflow-%v", i))]++
+	}
+	require.Len(t, activeClusters, 2)
+
+	// invalid value falls back to the domain active cluster
+	domainEntry.info.Data[WorkflowActiveClustersKey] = "invalid"
+	require.Equal(t, cluster.TestCurrentClusterName, domainEntry.GetActiveClusterForWorkflow(wid))
+}
+
+func Test_DomainCacheEntry_WorkflowHandoff(t *testing.T) {
+	clusterMetadata := cluster.NewMetadata(
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetBoolPropertyFn(true),
+		int64(10),
+		cluster.TestCurrentClusterName,
+		cluster.TestCurrentClusterName,
+		cluster.TestAllClusterInfo,
+	)
+	// all workflows move from the alternative cluster to the current cluster
+	domainEntry := NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "test-domain", Data: map[string]string{
+			WorkflowActiveClustersKey:               cluster.TestCurrentClusterName + ":100",
+			WorkflowActiveClustersPreviousKey:       cluster.TestAlternativeClusterName + ":100",
+			WorkflowActiveClustersHandoffEndTimeKey: "1234",
+		}},
+		nil,
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1231,
+		clusterMetadata,
+	)
+	wid := "test-workflow-id"
+
+	require.Equal(t, common.Int64Ptr(1234), domainEntry.GetWorkflowHandoffEndTime())
+	require.Equal(t, cluster.TestCurrentClusterName, domainEntry.GetActiveClusterForWorkflow(wid))
+	require.True(t, domainEntry.IsWorkflowPendingActive(wid))
+	require.False(t, domainEntry.IsWorkflowActive(wid))
+	err := domainEntry.GetWorkflowNotActiveErr(wid)
+	require.IsType(t, &types.DomainNotActiveError{}, err)
+	require.Contains(t, err.Error(), "pending active")
+
+	// workflows which keep their active cluster are not handed off
+	domainEntry.info.Data[WorkflowActiveClustersPreviousKey] = cluster.TestCurrentClusterName + ":100"
+	require.False(t, domainEntry.IsWorkflowPendingActive(wid))
+	require.True(t, domainEntry.IsWorkflowActive(wid))
+	require.NoError(t, domainEntry.GetWorkflowNotActiveErr(wid))
+
+	// the handoff ends once the handoff state is removed
+	domainEntry.info.Data[WorkflowActiveClustersPreviousKey] = cluster.TestAlternativeClusterName + ":100"
+	delete(domainEntry.info.Data, WorkflowActiveClustersHandoffEndTimeKey)
+	require.Nil(t, domainEntry.GetWorkflowHandoffEndTime())
+	require.False(t, domainEntry.IsWorkflowPendingActive(wid))
+	require.True(t, domainEntry.IsWorkflowActive(wid))
+}
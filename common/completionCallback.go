@@ -0,0 +1,115 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// MaxCompletionCallbacks is the max number of completion callback targets of a workflow execution
+	MaxCompletionCallbacks = 10
+
+	allowAllCompletionCallbackHosts = "*"
+)
+
+type (
+	// CompletionCallbackRequest is the JSON body posted to completion callback targets
+	CompletionCallbackRequest struct {
+		Domain         string `json:"domain"`
+		WorkflowID     string `json:"workflowID"`
+		RunID          string `json:"runID"`
+		WorkflowType   string `json:"workflowType"`
+		CloseStatus    string `json:"closeStatus"`
+		CloseTimestamp int64  `json:"closeTimestamp"`
+		Result         []byte `json:"result,omitempty"`
+		FailureReason  string `json:"failureReason,omitempty"`
+		FailureDetails []byte `json:"failureDetails,omitempty"`
+	}
+)
+
+// ValidateCompletionCallbacks validates the completion callback targets of a start workflow request
+// against the comma separated list of allowed host names
+func ValidateCompletionCallbacks(callbacks []*types.CompletionCallback, allowedHosts string) error {
+	if len(callbacks) > MaxCompletionCallbacks {
+		return &types.BadRequestError{Message: fmt.Sprintf("Too many completion callbacks, at most %v are allowed.", MaxCompletionCallbacks)}
+	}
+	for _, callback := range callbacks {
+		if err := ValidateCompletionCallbackURL(callback.GetURL(), allowedHosts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCompletionCallbackURL checks that the callback URL is a HTTP(S) URL of an allowed host
+func ValidateCompletionCallbackURL(rawURL string, allowedHosts string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid completion callback URL: %v.", rawURL)}
+	}
+	for _, host := range strings.Split(allowedHosts, ",") {
+		host = strings.TrimSpace(host)
+		if host == allowAllCompletionCallbackHosts || (host != "" && strings.EqualFold(host, u.Hostname())) {
+			return nil
+		}
+	}
+	return &types.BadRequestError{Message: fmt.Sprintf("Completion callback host %v is not allowed.", u.Hostname())}
+}
+
+// NewCompletionCallbackInfos creates the pending delivery state of the completion callbacks
+func NewCompletionCallbackInfos(callbacks []*types.CompletionCallback) []*types.CompletionCallbackInfo {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	infos := make([]*types.CompletionCallbackInfo, 0, len(callbacks))
+	for _, callback := range callbacks {
+		infos = append(infos, &types.CompletionCallbackInfo{
+			URL:   callback.GetURL(),
+			State: types.CompletionCallbackStatePending.Ptr(),
+		})
+	}
+	return infos
+}
+
+// RedactCompletionCallbacks returns the history event with the headers of its completion callbacks removed.
+// Headers may carry credentials of the callback targets, so they are kept in the persisted history for
+// delivery and replication but never returned to callers. The given event is not modified.
+func RedactCompletionCallbacks(event *types.HistoryEvent) *types.HistoryEvent {
+	attributes := event.GetWorkflowExecutionStartedEventAttributes()
+	if attributes == nil || len(attributes.CompletionCallbacks) == 0 {
+		return event
+	}
+
+	redactedAttributes := *attributes
+	redactedAttributes.CompletionCallbacks = make([]*types.CompletionCallback, 0, len(attributes.CompletionCallbacks))
+	for _, callback := range attributes.CompletionCallbacks {
+		redactedAttributes.CompletionCallbacks = append(redactedAttributes.CompletionCallbacks, &types.CompletionCallback{
+			URL: callback.GetURL(),
+		})
+	}
+	redactedEvent := *event
+	redactedEvent.WorkflowExecutionStartedEventAttributes = &redactedAttributes
+	return &redactedEvent
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateCompletionCallbacks(t *testing.T) {
+	require.NoError(t, ValidateCompletionCallbacks(nil, ""))
+	require.NoError(t, ValidateCompletionCallbacks([]*types.CompletionCallback{{URL: "https://example.com/done"}}, "other.com, example.com"))
+	require.NoError(t, ValidateCompletionCallbacks([]*types.CompletionCallback{{URL: "http://example.com:8080/done"}}, "*"))
+
+	invalidCases := map[string]struct {
+		callbacks    []*types.CompletionCallback
+		allowedHosts string
+	}{
+		"callbacks disabled": {
+			callbacks: []*types.CompletionCallback{{URL: "https://example.com/done"}},
+		},
+		"host not allowed": {
+			callbacks:    []*types.CompletionCallback{{URL: "https://example.com/done"}},
+			allowedHosts: "other.com",
+		},
+		"invalid scheme": {
+			callbacks:    []*types.CompletionCallback{{URL: "ftp://example.com/done"}},
+			allowedHosts: "*",
+		},
+		"missing host": {
+			callbacks:    []*types.CompletionCallback{{URL: "/done"}},
+			allowedHosts: "*",
+		},
+		"too many callbacks": {
+			callbacks: func() []*types.CompletionCallback {
+				var callbacks []*types.CompletionCallback
+				for i := 0; i <= MaxCompletionCallbacks; i++ {
+					callbacks = append(callbacks, &types.CompletionCallback{URL: "https://example.com/done"})
+				}
+				return callbacks
+			}(),
+			allowedHosts: "*",
+		},
+	}
+	for name, tc := range invalidCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateCompletionCallbacks(tc.callbacks, tc.allowedHosts)
+			require.IsType(t, &types.BadRequestError{}, err)
+		})
+	}
+}
+
+func TestNewCompletionCallbackInfos(t *testing.T) {
+	require.Nil(t, NewCompletionCallbackInfos(nil))
+	require.Equal(t, []*types.CompletionCallbackInfo{
+		{URL: "https://example.com/done", State: types.CompletionCallbackStatePending.Ptr()},
+	}, NewCompletionCallbackInfos([]*types.CompletionCallback{
+		{URL: "https://example.com/done", Headers: map[string]string{"Authorization": "secret"}},
+	}))
+}
+
+func TestRedactCompletionCallbacks(t *testing.T) {
+	event := &types.HistoryEvent{
+		EventID:   1,
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			Identity: "identity",
+			CompletionCallbacks: []*types.CompletionCallback{
+				{URL: "https://example.com/done", Headers: map[string]string{"Authorization": "secret"}},
+			},
+		},
+	}
+
+	redacted := RedactCompletionCallbacks(event)
+	require.Equal(t, int64(1), redacted.EventID)
+	require.Equal(t, "identity", redacted.WorkflowExecutionStartedEventAttributes.Identity)
+	require.Equal(t, []*types.CompletionCallback{{URL: "https://example.com/done"}}, redacted.WorkflowExecutionStartedEventAttributes.CompletionCallbacks)
+	// the persisted event keeps the headers for delivery
+	require.Equal(t, "secret", event.WorkflowExecutionStartedEventAttributes.CompletionCallbacks[0].Headers["Authorization"])
+
+	other := &types.HistoryEvent{EventID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()}
+	require.True(t, other == RedactCompletionCallbacks(other))
+}
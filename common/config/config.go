@@ -162,6 +162,10 @@ type (
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
 		// Must provide one of VisibilityStore and AdvancedVisibilityStore
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
+		// AdvancedVisibilityMigrationTargetStore is the name of the ElasticSearch datastore that advanced visibility
+		// records are migrated to, optional. Records are written to it through the kafka topic of the
+		// visibility-migration-target application.
+		AdvancedVisibilityMigrationTargetStore string `yaml:"advancedVisibilityMigrationTargetStore"`
 		// HistoryMaxConns is the desired number of conns to history store. Value specified
 		// here overrides the MaxConns config specified as part of datastore
 		HistoryMaxConns int `yaml:"historyMaxConns"`
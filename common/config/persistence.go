@@ -85,6 +85,18 @@ func (c *Persistence) Validate() error {
 		useAdvancedVisibilityOnly = true
 	}
 
+	if c.AdvancedVisibilityMigrationTargetStore != "" {
+		if _, ok := c.DataStores[c.AdvancedVisibilityStore]; !ok {
+			return fmt.Errorf("persistence config: AdvancedVisibilityMigrationTargetStore requires AdvancedVisibilityStore")
+		}
+		if c.AdvancedVisibilityMigrationTargetStore == c.AdvancedVisibilityStore {
+			return fmt.Errorf("persistence config: AdvancedVisibilityMigrationTargetStore must differ from AdvancedVisibilityStore")
+		}
+		if ds, ok := c.DataStores[c.AdvancedVisibilityMigrationTargetStore]; !ok || ds.ElasticSearch == nil {
+			return fmt.Errorf("persistence config: datastore %v: must provide config for ElasticSearch", c.AdvancedVisibilityMigrationTargetStore)
+		}
+	}
+
 	for _, st := range dbStoreKeys {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -86,6 +86,10 @@ const (
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// VisibilityMigrationTargetAppName is used to find kafka topics for the target store of advanced visibility migration
+	VisibilityMigrationTargetAppName = "visibility-migration-target"
+	// HistoryExportAppName is used to find the kafka topic history events are exported to
+	HistoryExportAppName = "history-export"
 )
 
 // This was flagged by salus as potentially hardcoded credentials. This is a false positive by the scanner and should be
@@ -111,6 +115,11 @@ const (
 	ShadowerDomainID = "59c51119-1b41-4a28-986d-d6e377716f82"
 	// ShadowerLocalDomainName
 	ShadowerLocalDomainName = shadower.LocalDomainName
+	// ClusterSettingsDomainID is domain id for the local domain which stores the versions of the replicated cluster settings
+	ClusterSettingsDomainID = "df8aeec0-89b6-4991-b322-4b5defff38d6"
+	// ClusterSettingsLocalDomainName is domain name for the local domain which stores the versions of the replicated
+	// cluster settings in its data, no workflow runs in it
+	ClusterSettingsLocalDomainName = "cadence-cluster-settings-versions"
 )
 
 const (
@@ -158,6 +167,8 @@ const (
 	DomainDataKeyForManagedFailover = "IsManagedByCadence"
 	// DomainDataKeyForPreferredCluster is the key of DomainData for domain rebalance
 	DomainDataKeyForPreferredCluster = "PreferredCluster"
+	// DomainDataKeyForAutoFailover is the key of DomainData for failover by the auto failover controller
+	DomainDataKeyForAutoFailover = "IsAutoFailover"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
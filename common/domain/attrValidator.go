@@ -23,6 +23,7 @@ package domain
 import (
 	"fmt"
 
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -123,6 +124,44 @@ func (d *AttrValidatorImpl) validateDomainReplicationConfigForGlobalDomain(
 	return nil
 }
 
+func (d *AttrValidatorImpl) validateDomainData(
+	data map[string]string,
+) error {
+
+	if _, ok := data[cache.WorkflowActiveClustersPreviousKey]; ok {
+		return errReservedDomainDataKey
+	}
+	if _, ok := data[cache.WorkflowActiveClustersHandoffEndTimeKey]; ok {
+		return errReservedDomainDataKey
+	}
+	return nil
+}
+
+func (d *AttrValidatorImpl) validateWorkflowActiveClusters(
+	value string,
+	replicationConfig *persistence.DomainReplicationConfig,
+) error {
+
+	workflowActiveClusters, err := cache.ParseWorkflowActiveClusters(value)
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+
+	clusters := make(map[string]struct{})
+	for _, clusterConfig := range replicationConfig.Clusters {
+		clusters[clusterConfig.ClusterName] = struct{}{}
+	}
+	for _, workflowActiveCluster := range workflowActiveClusters {
+		if _, ok := clusters[workflowActiveCluster.ClusterName]; !ok {
+			return &types.BadRequestError{Message: fmt.Sprintf(
+				"Workflow active cluster %v is not in domain clusters",
+				workflowActiveCluster.ClusterName,
+			)}
+		}
+	}
+	return nil
+}
+
 func (d *AttrValidatorImpl) validateDomainReplicationConfigClustersDoesNotRemove(
 	clustersOld []*persistence.ClusterReplicationConfig,
 	clustersNew []*persistence.ClusterReplicationConfig,
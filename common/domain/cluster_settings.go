@@ -0,0 +1,545 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ClusterSettingsTaskID is the reserved domain ID of the domain replication tasks which replicate a
+	// cluster setting instead of a domain. The domain status of these tasks is not set, so that clusters
+	// which do not know about cluster settings fail to apply them instead of creating a domain.
+	ClusterSettingsTaskID = "cadence-cluster-settings"
+
+	clusterSettingsDataName          = "name"
+	clusterSettingsDataValue         = "value"
+	clusterSettingsDataSourceCluster = "sourceCluster"
+	clusterSettingsDataRestore       = "restore"
+
+	clusterSettingsContextTimeout = 10 * time.Second
+
+	// clusterSettingsVersionUpdateAttempts is the number of attempts to update the version of a setting
+	// before giving up, an attempt fails when another host updates a domain at the same time
+	clusterSettingsVersionUpdateAttempts = 5
+)
+
+var (
+	// ErrInvalidClusterSettingsTask is the error to indicate a cluster settings replication task without setting
+	ErrInvalidClusterSettingsTask = &types.BadRequestError{Message: "invalid cluster settings replication task"}
+)
+
+type (
+	// ClusterSetting is the value of a dynamic config key replicated to the other clusters. When Restore is
+	// set, the value is the filters of the restored values instead.
+	ClusterSetting struct {
+		Name          string
+		Value         []byte
+		Version       int64
+		SourceCluster string
+		Restore       bool
+	}
+
+	// ClusterSettingsHandler replicates the search attribute whitelist and the selected dynamic config
+	// to the other clusters through the domain replication queue, and applies the settings replicated
+	// from the other clusters.
+	//
+	// Every change of a setting gets a version from the failover versions of the cluster where it is
+	// made, so versions from different clusters never collide. Dynamic config values are last writer wins:
+	// a replicated value with a version not greater than the local version of the key is a conflict and
+	// is dropped. Search attributes are merged: new attributes are added, and attributes already
+	// whitelisted with a different type are conflicts which are kept as they are.
+	//
+	// The versions are stored in the data of the local domain common.ClusterSettingsLocalDomainName and
+	// updated with the compare and swap of the domain metadata notification version, so that the hosts
+	// of a cluster never hand out the same version twice or move a version back.
+	ClusterSettingsHandler interface {
+		IsReplicated(key dynamicconfig.Key) bool
+		Replicate(ctx context.Context, key dynamicconfig.Key, value interface{}) error
+		ReplicateRestore(ctx context.Context, key dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}) error
+		Apply(ctx context.Context, setting *ClusterSetting) error
+	}
+
+	clusterSettingsHandlerImpl struct {
+		clusterMetadata   cluster.Metadata
+		dynamicConfig     dynamicconfig.Client
+		domainManager     persistence.DomainManager
+		replicationQueue  ReplicationQueue
+		esClient          es.GenericClient
+		esIndex           string
+		enableReplication dynamicconfig.BoolPropertyFn
+		replicatedKeys    dynamicconfig.StringPropertyFn
+		logger            log.Logger
+
+		// versionLock serializes the settings changes of the host, so that a replicated value and its
+		// version are written together, the versions themselves are protected by their compare and swap
+		versionLock sync.Mutex
+	}
+
+	clusterSettingsTaskExecutor struct {
+		ReplicationTaskExecutor
+		handler ClusterSettingsHandler
+	}
+)
+
+var _ ClusterSettingsHandler = (*clusterSettingsHandlerImpl)(nil)
+
+// NewClusterSettingsHandler creates a new cluster settings handler, the ElasticSearch client is only
+// needed to apply replicated search attributes and can be nil when advanced visibility is not configured
+func NewClusterSettingsHandler(
+	clusterMetadata cluster.Metadata,
+	dynamicConfig dynamicconfig.Client,
+	domainManager persistence.DomainManager,
+	replicationQueue ReplicationQueue,
+	esClient es.GenericClient,
+	esIndex string,
+	enableReplication dynamicconfig.BoolPropertyFn,
+	replicatedKeys dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) ClusterSettingsHandler {
+
+	return &clusterSettingsHandlerImpl{
+		clusterMetadata:   clusterMetadata,
+		dynamicConfig:     dynamicConfig,
+		domainManager:     domainManager,
+		replicationQueue:  replicationQueue,
+		esClient:          esClient,
+		esIndex:           esIndex,
+		enableReplication: enableReplication,
+		replicatedKeys:    replicatedKeys,
+		logger:            logger,
+	}
+}
+
+// NewClusterSettingsReplicationTaskExecutor creates a domain replication task executor which applies the
+// cluster settings replication tasks with the handler and passes the other tasks to the executor
+func NewClusterSettingsReplicationTaskExecutor(
+	executor ReplicationTaskExecutor,
+	handler ClusterSettingsHandler,
+) ReplicationTaskExecutor {
+
+	return &clusterSettingsTaskExecutor{
+		ReplicationTaskExecutor: executor,
+		handler:                 handler,
+	}
+}
+
+// Execute applies the cluster settings replication task or executes the domain replication task
+func (e *clusterSettingsTaskExecutor) Execute(task *types.DomainTaskAttributes) error {
+	if task.GetID() != ClusterSettingsTaskID {
+		return e.ReplicationTaskExecutor.Execute(task)
+	}
+
+	setting, err := ClusterSettingFromTask(task)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterSettingsContextTimeout)
+	defer cancel()
+	return e.handler.Apply(ctx, setting)
+}
+
+// NewClusterSettingsReplicationTask creates the domain replication task of the cluster setting
+func NewClusterSettingsReplicationTask(
+	setting *ClusterSetting,
+) *types.ReplicationTask {
+
+	return &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeDomain.Ptr(),
+		DomainTaskAttributes: &types.DomainTaskAttributes{
+			DomainOperation: types.DomainOperationUpdate.Ptr(),
+			ID:              ClusterSettingsTaskID,
+			Info: &types.DomainInfo{
+				Name: ClusterSettingsTaskID,
+				Data: map[string]string{
+					clusterSettingsDataName:          setting.Name,
+					clusterSettingsDataValue:         string(setting.Value),
+					clusterSettingsDataSourceCluster: setting.SourceCluster,
+					clusterSettingsDataRestore:       strconv.FormatBool(setting.Restore),
+				},
+			},
+			Config:            &types.DomainConfiguration{},
+			ReplicationConfig: &types.DomainReplicationConfiguration{},
+			ConfigVersion:     setting.Version,
+		},
+	}
+}
+
+// ClusterSettingFromTask returns the cluster setting of the cluster settings replication task
+func ClusterSettingFromTask(
+	task *types.DomainTaskAttributes,
+) (*ClusterSetting, error) {
+
+	if task.GetID() != ClusterSettingsTaskID || task.Info == nil || task.Info.Data[clusterSettingsDataName] == "" {
+		return nil, ErrInvalidClusterSettingsTask
+	}
+	return &ClusterSetting{
+		Name:          task.Info.Data[clusterSettingsDataName],
+		Value:         []byte(task.Info.Data[clusterSettingsDataValue]),
+		Version:       task.GetConfigVersion(),
+		SourceCluster: task.Info.Data[clusterSettingsDataSourceCluster],
+		Restore:       task.Info.Data[clusterSettingsDataRestore] == "true",
+	}, nil
+}
+
+// IsReplicated returns whether the changes of the dynamic config key are replicated to the other clusters
+func (h *clusterSettingsHandlerImpl) IsReplicated(
+	key dynamicconfig.Key,
+) bool {
+
+	if !h.enableReplication() || !h.clusterMetadata.IsGlobalDomainEnabled() {
+		return false
+	}
+	if key == dynamicconfig.ValidSearchAttributes {
+		return true
+	}
+	for _, name := range strings.Split(h.replicatedKeys(), ",") {
+		if strings.TrimSpace(name) == dynamicconfig.Keys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// Replicate publishes the new value of the dynamic config key to the other clusters. For search attributes
+// the value is the attributes added to the whitelist.
+func (h *clusterSettingsHandlerImpl) Replicate(
+	ctx context.Context,
+	key dynamicconfig.Key,
+	value interface{},
+) error {
+
+	return h.publish(ctx, key, value, false)
+}
+
+// ReplicateRestore publishes the restore of the dynamic config values matching the filters to the other clusters
+func (h *clusterSettingsHandlerImpl) ReplicateRestore(
+	ctx context.Context,
+	key dynamicconfig.Key,
+	filters map[dynamicconfig.Filter]interface{},
+) error {
+
+	return h.publish(ctx, key, filters, true)
+}
+
+func (h *clusterSettingsHandlerImpl) publish(
+	ctx context.Context,
+	key dynamicconfig.Key,
+	value interface{},
+	restore bool,
+) error {
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	h.versionLock.Lock()
+	defer h.versionLock.Unlock()
+
+	currentCluster := h.clusterMetadata.GetCurrentClusterName()
+	name := dynamicconfig.Keys[key]
+	version, _, err := h.updateVersion(ctx, name, func(current int64) (int64, bool) {
+		return h.clusterMetadata.GetNextFailoverVersion(currentCluster, current), true
+	})
+	if err != nil {
+		return err
+	}
+	setting := &ClusterSetting{
+		Name:          name,
+		Value:         data,
+		Version:       version,
+		SourceCluster: currentCluster,
+		Restore:       restore,
+	}
+	if err := h.replicationQueue.Publish(ctx, NewClusterSettingsReplicationTask(setting)); err != nil {
+		return err
+	}
+	h.logger.Info("Replicated cluster setting.", tag.Key(name), tag.Number(setting.Version))
+	return nil
+}
+
+// Apply applies the cluster setting replicated from another cluster
+func (h *clusterSettingsHandlerImpl) Apply(
+	ctx context.Context,
+	setting *ClusterSetting,
+) (retError error) {
+
+	// dynamic config clients accept different types of values for updates and may panic on the others
+	defer log.CapturePanic(h.logger, &retError)
+
+	if setting.SourceCluster == h.clusterMetadata.GetCurrentClusterName() {
+		return nil
+	}
+	key, ok := dynamicconfig.KeyNames[setting.Name]
+	if !ok || key == dynamicconfig.UnknownKey {
+		return &types.BadRequestError{Message: fmt.Sprintf("Unknown replicated dynamic config key %v.", setting.Name)}
+	}
+
+	h.versionLock.Lock()
+	defer h.versionLock.Unlock()
+
+	if key == dynamicconfig.ValidSearchAttributes {
+		if err := h.applySearchAttributes(ctx, setting); err != nil {
+			return err
+		}
+	} else {
+		versions, _, err := h.getVersions(ctx)
+		if err != nil {
+			return err
+		}
+		if version := parseClusterSettingVersion(versions.Info.Data[setting.Name]); setting.Version <= version {
+			h.logger.Warn("Dropped replicated dynamic config value which conflicts with a newer value.",
+				tag.Key(setting.Name),
+				tag.SourceCluster(setting.SourceCluster),
+				tag.Number(setting.Version),
+				tag.Value(version),
+			)
+			return nil
+		}
+		if err := h.applyDynamicConfig(key, setting); err != nil {
+			return err
+		}
+	}
+
+	// the task is retried when the version is not updated, applying the value again is harmless
+	if _, _, err := h.updateVersion(ctx, setting.Name, func(current int64) (int64, bool) {
+		return setting.Version, setting.Version > current
+	}); err != nil {
+		return err
+	}
+	h.logger.Info("Applied replicated cluster setting.",
+		tag.Key(setting.Name),
+		tag.SourceCluster(setting.SourceCluster),
+		tag.Number(setting.Version),
+	)
+	return nil
+}
+
+func (h *clusterSettingsHandlerImpl) applyDynamicConfig(
+	key dynamicconfig.Key,
+	setting *ClusterSetting,
+) error {
+
+	if setting.Restore {
+		var filters map[dynamicconfig.Filter]interface{}
+		if err := json.Unmarshal(setting.Value, &filters); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid replicated dynamic config filters of %v: %v.", setting.Name, err)}
+		}
+		err := h.dynamicConfig.RestoreValue(key, filters)
+		if err == dynamicconfig.NotFoundError {
+			// there is no value to restore in this cluster
+			return nil
+		}
+		return err
+	}
+
+	var values []*types.DynamicConfigValue
+	if err := json.Unmarshal(setting.Value, &values); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid replicated dynamic config value of %v: %v.", setting.Name, err)}
+	}
+	return h.dynamicConfig.UpdateValue(key, values)
+}
+
+func (h *clusterSettingsHandlerImpl) applySearchAttributes(
+	ctx context.Context,
+	setting *ClusterSetting,
+) error {
+
+	var searchAttributes map[string]types.IndexedValueType
+	if err := json.Unmarshal(setting.Value, &searchAttributes); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid replicated search attributes: %v.", err)}
+	}
+	currentValidAttr, err := h.dynamicConfig.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
+	if err != nil {
+		return err
+	}
+
+	added := make(map[string]types.IndexedValueType)
+	for key, valueType := range searchAttributes {
+		if definition.IsSystemIndexedKey(key) {
+			continue
+		}
+		if currentValueType, exist := currentValidAttr[key]; exist {
+			if currentType, ok := common.ConvertIndexedValueTypeToInternalType(currentValueType); !ok || currentType != valueType {
+				h.logger.Error("Replicated search attribute conflicts with the whitelisted search attribute of a different type.",
+					tag.Key(key),
+					tag.SourceCluster(setting.SourceCluster),
+					tag.Value(currentValueType),
+				)
+			}
+			continue
+		}
+		currentValidAttr[key] = int(valueType)
+		added[key] = valueType
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	// the mapping is put before the whitelist is updated, so that the task is retried until the attributes
+	// added to the whitelist can be indexed
+	if h.esClient != nil {
+		for key, valueType := range added {
+			esType := es.ToESDataType(valueType)
+			if len(esType) == 0 {
+				return &types.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", valueType)}
+			}
+			err := h.esClient.PutMapping(ctx, h.esIndex, definition.Attr, key, esType)
+			if h.esClient.IsNotFoundError(err) {
+				if err := h.esClient.CreateIndex(ctx, h.esIndex); err != nil {
+					return err
+				}
+				err = h.esClient.PutMapping(ctx, h.esIndex, definition.Attr, key, esType)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// same as AddSearchAttribute, the dynamic config may not support updates and must be updated separately
+	if err := h.dynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr); err != nil {
+		h.logger.Warn("Failed to update the search attribute whitelist, the dynamic config must be updated separately.", tag.Error(err))
+	}
+	return nil
+}
+
+// getVersions returns the domain which stores the versions of the settings in its data, creating it when
+// it does not exist yet, and the domain metadata notification version read before it
+func (h *clusterSettingsHandlerImpl) getVersions(
+	ctx context.Context,
+) (*persistence.GetDomainResponse, int64, error) {
+
+	for created := false; ; created = true {
+		// the notification version is read first, so that the update fails if the domain changed in between
+		metadata, err := h.domainManager.GetMetadata(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		versions, err := h.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: common.ClusterSettingsLocalDomainName})
+		if err == nil {
+			return versions, metadata.NotificationVersion, nil
+		}
+		if _, ok := err.(*types.EntityNotExistsError); !ok || created {
+			return nil, 0, err
+		}
+
+		currentCluster := h.clusterMetadata.GetCurrentClusterName()
+		_, err = h.domainManager.CreateDomain(ctx, &persistence.CreateDomainRequest{
+			Info: &persistence.DomainInfo{
+				ID:          common.ClusterSettingsDomainID,
+				Name:        common.ClusterSettingsLocalDomainName,
+				Status:      persistence.DomainStatusRegistered,
+				Description: "Cadence internal system domain",
+				Data:        map[string]string{},
+			},
+			Config: &persistence.DomainConfig{
+				Retention: common.SystemDomainRetentionDays,
+			},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: currentCluster,
+				Clusters:          cluster.GetOrUseDefaultClusters(currentCluster, nil),
+			},
+			IsGlobalDomain:  false,
+			FailoverVersion: common.EmptyVersion,
+		})
+		if _, ok := err.(*types.DomainAlreadyExistsError); err != nil && !ok {
+			return nil, 0, err
+		}
+	}
+}
+
+// updateVersion updates the version of the setting to the version returned by update for the current
+// version, unless update returns false. It returns the version of the setting and whether it was updated.
+func (h *clusterSettingsHandlerImpl) updateVersion(
+	ctx context.Context,
+	name string,
+	update func(current int64) (int64, bool),
+) (int64, bool, error) {
+
+	var err error
+	for attempt := 0; attempt < clusterSettingsVersionUpdateAttempts; attempt++ {
+		var versions *persistence.GetDomainResponse
+		var notificationVersion int64
+		versions, notificationVersion, err = h.getVersions(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		current := parseClusterSettingVersion(versions.Info.Data[name])
+		version, ok := update(current)
+		if !ok {
+			return current, false, nil
+		}
+
+		info := *versions.Info
+		info.Data = make(map[string]string, len(versions.Info.Data)+1)
+		for key, value := range versions.Info.Data {
+			info.Data[key] = value
+		}
+		info.Data[name] = strconv.FormatInt(version, 10)
+		// the stores fail the update unless the notification version is still the one read before the domain
+		err = h.domainManager.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+			Info:                        &info,
+			Config:                      versions.Config,
+			ReplicationConfig:           versions.ReplicationConfig,
+			ConfigVersion:               versions.ConfigVersion + 1,
+			FailoverVersion:             versions.FailoverVersion,
+			FailoverNotificationVersion: versions.FailoverNotificationVersion,
+			PreviousFailoverVersion:     versions.PreviousFailoverVersion,
+			FailoverEndTime:             versions.FailoverEndTime,
+			LastUpdatedTime:             time.Now().UnixNano(),
+			NotificationVersion:         notificationVersion,
+		})
+		if err == nil {
+			return version, true, nil
+		}
+		h.logger.Warn("Failed to update the version of a cluster setting.", tag.Key(name), tag.Error(err))
+	}
+	return 0, false, err
+}
+
+func parseClusterSettingVersion(
+	value string,
+) int64 {
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return version
+}
@@ -0,0 +1,373 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	clusterSettingsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		clusterMetadata  *cluster.MockMetadata
+		dynamicConfig    *dynamicconfig.MockClient
+		domainManager    *persistence.MockDomainManager
+		replicationQueue *MockReplicationQueue
+
+		enableReplication bool
+		handler           *clusterSettingsHandlerImpl
+	}
+)
+
+func TestClusterSettingsSuite(t *testing.T) {
+	s := new(clusterSettingsSuite)
+	suite.Run(t, s)
+}
+
+func (s *clusterSettingsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.clusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.dynamicConfig = dynamicconfig.NewMockClient(s.controller)
+	s.domainManager = persistence.NewMockDomainManager(s.controller)
+	s.replicationQueue = NewMockReplicationQueue(s.controller)
+
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	s.clusterMetadata.EXPECT().IsGlobalDomainEnabled().Return(true).AnyTimes()
+	s.enableReplication = true
+	s.handler = NewClusterSettingsHandler(
+		s.clusterMetadata,
+		s.dynamicConfig,
+		s.domainManager,
+		s.replicationQueue,
+		nil,
+		"",
+		func(...dynamicconfig.FilterOption) bool { return s.enableReplication },
+		dynamicconfig.GetStringPropertyFn("frontend.enableClientVersionCheck, history.EnableConsistentQuery"),
+		loggerimpl.NewNopLogger(),
+	).(*clusterSettingsHandlerImpl)
+}
+
+func (s *clusterSettingsSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *clusterSettingsSuite) TestIsReplicated() {
+	s.True(s.handler.IsReplicated(dynamicconfig.ValidSearchAttributes))
+	s.True(s.handler.IsReplicated(dynamicconfig.EnableClientVersionCheck))
+	s.True(s.handler.IsReplicated(dynamicconfig.EnableConsistentQuery))
+	s.False(s.handler.IsReplicated(dynamicconfig.EnableGracefulFailover))
+
+	s.enableReplication = false
+	s.False(s.handler.IsReplicated(dynamicconfig.ValidSearchAttributes))
+}
+
+func (s *clusterSettingsSuite) TestReplicate() {
+	values := []*types.DynamicConfigValue{
+		{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("true")}},
+	}
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "1"})
+	s.clusterMetadata.EXPECT().GetNextFailoverVersion("active", int64(1)).Return(int64(11)).Times(1)
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "11"}, nil)
+	s.replicationQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message interface{}) error {
+			task := message.(*types.ReplicationTask)
+			s.Equal(types.ReplicationTaskTypeDomain, task.GetTaskType())
+			s.Nil(task.DomainTaskAttributes.Info.Status)
+
+			setting, err := ClusterSettingFromTask(task.DomainTaskAttributes)
+			s.NoError(err)
+			s.Equal("frontend.enableClientVersionCheck", setting.Name)
+			s.Equal(int64(11), setting.Version)
+			s.Equal("active", setting.SourceCluster)
+			var replicated []*types.DynamicConfigValue
+			s.NoError(json.Unmarshal(setting.Value, &replicated))
+			s.Equal(values, replicated)
+			return nil
+		},
+	).Times(1)
+
+	s.NoError(s.handler.Replicate(context.Background(), dynamicconfig.EnableClientVersionCheck, values))
+}
+
+func (s *clusterSettingsSuite) TestApply_DynamicConfig() {
+	values := []*types.DynamicConfigValue{
+		{Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("true")}},
+	}
+	data, err := json.Marshal(values)
+	s.NoError(err)
+
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "2"})
+	s.dynamicConfig.EXPECT().UpdateValue(dynamicconfig.EnableClientVersionCheck, values).Return(nil).Times(1)
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "2"})
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "12"}, nil)
+
+	s.NoError(s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "frontend.enableClientVersionCheck",
+		Value:         data,
+		Version:       12,
+		SourceCluster: "standby",
+	}))
+}
+
+func (s *clusterSettingsSuite) TestApply_DynamicConfig_Restore() {
+	filters := map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "test-domain"}
+
+	s.expectVersions(map[string]string{})
+	s.clusterMetadata.EXPECT().GetNextFailoverVersion("active", int64(0)).Return(int64(1)).Times(1)
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "1"}, nil)
+	var setting *ClusterSetting
+	s.replicationQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message interface{}) error {
+			var err error
+			setting, err = ClusterSettingFromTask(message.(*types.ReplicationTask).DomainTaskAttributes)
+			return err
+		},
+	).Times(1)
+	s.NoError(s.handler.ReplicateRestore(context.Background(), dynamicconfig.EnableClientVersionCheck, filters))
+	s.True(setting.Restore)
+
+	setting.Version = 12
+	setting.SourceCluster = "standby"
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "1"})
+	s.dynamicConfig.EXPECT().RestoreValue(dynamicconfig.EnableClientVersionCheck, filters).Return(nil).Times(1)
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "1"})
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "12"}, nil)
+	s.NoError(s.handler.Apply(context.Background(), setting))
+}
+
+func (s *clusterSettingsSuite) TestApply_DynamicConfig_Conflict() {
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "21"})
+
+	s.NoError(s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "frontend.enableClientVersionCheck",
+		Value:         []byte("[]"),
+		Version:       12,
+		SourceCluster: "standby",
+	}))
+}
+
+func (s *clusterSettingsSuite) TestApply_SearchAttributes() {
+	data, err := json.Marshal(map[string]types.IndexedValueType{
+		"NewKey":      types.IndexedValueTypeKeyword,
+		"ExistingKey": types.IndexedValueTypeInt,
+		"WorkflowID":  types.IndexedValueTypeInt,
+	})
+	s.NoError(err)
+
+	s.dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).
+		Return(map[string]interface{}{"ExistingKey": 0}, nil).Times(1)
+	s.dynamicConfig.EXPECT().UpdateValue(dynamicconfig.ValidSearchAttributes, map[string]interface{}{
+		"ExistingKey": 0,
+		"NewKey":      int(types.IndexedValueTypeKeyword),
+	}).Return(nil).Times(1)
+	s.expectVersions(map[string]string{})
+	s.expectUpdateVersions(map[string]string{"frontend.validSearchAttributes": "12"}, nil)
+
+	s.NoError(s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "frontend.validSearchAttributes",
+		Value:         data,
+		Version:       12,
+		SourceCluster: "standby",
+	}))
+}
+
+func (s *clusterSettingsSuite) TestApply_SearchAttributes_MappingFailed() {
+	esClient := &esMocks.GenericClient{}
+	defer esClient.AssertExpectations(s.T())
+	s.handler.esClient = esClient
+	s.handler.esIndex = "test-index"
+
+	data, err := json.Marshal(map[string]types.IndexedValueType{
+		"NewKey": types.IndexedValueTypeKeyword,
+	})
+	s.NoError(err)
+
+	mappingErr := &types.InternalServiceError{Message: "mapping failed"}
+	s.dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).
+		Return(map[string]interface{}{}, nil).Times(1)
+	esClient.On("PutMapping", mock.Anything, "test-index", definition.Attr, "NewKey", "keyword").Return(mappingErr).Once()
+	esClient.On("IsNotFoundError", mappingErr).Return(false).Once()
+
+	// the whitelist is not updated, so that the retried task puts the mapping again
+	err = s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "frontend.validSearchAttributes",
+		Value:         data,
+		Version:       12,
+		SourceCluster: "standby",
+	})
+	s.Equal(mappingErr, err)
+}
+
+func (s *clusterSettingsSuite) TestApply_UnknownKey() {
+	err := s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "unknown.key",
+		Version:       12,
+		SourceCluster: "standby",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *clusterSettingsSuite) TestTaskExecutor() {
+	executor := NewMockReplicationTaskExecutor(s.controller)
+	taskExecutor := NewClusterSettingsReplicationTaskExecutor(executor, s.handler)
+
+	domainTask := &types.DomainTaskAttributes{ID: "some-domain-id"}
+	executor.EXPECT().Execute(domainTask).Return(nil).Times(1)
+	s.NoError(taskExecutor.Execute(domainTask))
+
+	// settings from the current cluster are not applied again
+	task := NewClusterSettingsReplicationTask(&ClusterSetting{
+		Name:          "frontend.enableClientVersionCheck",
+		Value:         []byte("[]"),
+		Version:       1,
+		SourceCluster: "active",
+	})
+	s.NoError(taskExecutor.Execute(task.DomainTaskAttributes))
+
+	task.DomainTaskAttributes.Info.Data = nil
+	s.Equal(ErrInvalidClusterSettingsTask, taskExecutor.Execute(task.DomainTaskAttributes))
+}
+
+func (s *clusterSettingsSuite) TestReplicate_VersionUpdateConflict() {
+	// another host updated the version in between, the version is computed again from its version
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "1"})
+	s.clusterMetadata.EXPECT().GetNextFailoverVersion("active", int64(1)).Return(int64(11)).Times(1)
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "11"}, &types.InternalServiceError{Message: "condition failed"})
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "11"})
+	s.clusterMetadata.EXPECT().GetNextFailoverVersion("active", int64(11)).Return(int64(21)).Times(1)
+	s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "21"}, nil)
+	s.replicationQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message interface{}) error {
+			setting, err := ClusterSettingFromTask(message.(*types.ReplicationTask).DomainTaskAttributes)
+			s.NoError(err)
+			s.Equal(int64(21), setting.Version)
+			return nil
+		},
+	).Times(1)
+
+	s.NoError(s.handler.Replicate(context.Background(), dynamicconfig.EnableClientVersionCheck, []*types.DynamicConfigValue{}))
+}
+
+func (s *clusterSettingsSuite) TestReplicate_VersionUpdateFailed() {
+	updateErr := &types.InternalServiceError{Message: "condition failed"}
+	for attempt := 0; attempt < clusterSettingsVersionUpdateAttempts; attempt++ {
+		s.expectVersions(map[string]string{})
+		s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "1"}, updateErr)
+	}
+	s.clusterMetadata.EXPECT().GetNextFailoverVersion("active", int64(0)).Return(int64(1)).Times(clusterSettingsVersionUpdateAttempts)
+
+	// the value is not published without a version
+	err := s.handler.Replicate(context.Background(), dynamicconfig.EnableClientVersionCheck, []*types.DynamicConfigValue{})
+	s.Equal(updateErr, err)
+}
+
+func (s *clusterSettingsSuite) TestApply_DynamicConfig_VersionUpdateFailed() {
+	updateErr := &types.InternalServiceError{Message: "condition failed"}
+	s.expectVersions(map[string]string{})
+	s.dynamicConfig.EXPECT().UpdateValue(dynamicconfig.EnableClientVersionCheck, []*types.DynamicConfigValue{}).Return(nil).Times(1)
+	for attempt := 0; attempt < clusterSettingsVersionUpdateAttempts; attempt++ {
+		s.expectVersions(map[string]string{})
+		s.expectUpdateVersions(map[string]string{"frontend.enableClientVersionCheck": "12"}, updateErr)
+	}
+
+	// the task is retried
+	err := s.handler.Apply(context.Background(), &ClusterSetting{
+		Name:          "frontend.enableClientVersionCheck",
+		Value:         []byte("[]"),
+		Version:       12,
+		SourceCluster: "standby",
+	})
+	s.Equal(updateErr, err)
+}
+
+func (s *clusterSettingsSuite) TestGetVersions_CreateDomain() {
+	s.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(1)
+	s.domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: common.ClusterSettingsLocalDomainName}).
+		Return(nil, &types.EntityNotExistsError{}).Times(1)
+	s.domainManager.EXPECT().CreateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateDomainRequest) (*persistence.CreateDomainResponse, error) {
+			s.Equal(common.ClusterSettingsDomainID, request.Info.ID)
+			s.Equal(common.ClusterSettingsLocalDomainName, request.Info.Name)
+			s.False(request.IsGlobalDomain)
+			// created by another host at the same time
+			return nil, &types.DomainAlreadyExistsError{}
+		},
+	).Times(1)
+	s.expectVersions(map[string]string{"frontend.enableClientVersionCheck": "1"})
+
+	versions, notificationVersion, err := s.handler.getVersions(context.Background())
+	s.NoError(err)
+	s.Equal(int64(7), notificationVersion)
+	s.Equal(map[string]string{"frontend.enableClientVersionCheck": "1"}, versions.Info.Data)
+}
+
+func (s *clusterSettingsSuite) expectVersions(
+	versions map[string]string,
+) {
+
+	s.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(1)
+	s.domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: common.ClusterSettingsLocalDomainName}).
+		Return(&persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{
+				ID:   common.ClusterSettingsDomainID,
+				Name: common.ClusterSettingsLocalDomainName,
+				Data: versions,
+			},
+			Config:            &persistence.DomainConfig{},
+			ReplicationConfig: &persistence.DomainReplicationConfig{},
+			ConfigVersion:     3,
+		}, nil).Times(1)
+}
+
+func (s *clusterSettingsSuite) expectUpdateVersions(
+	versions map[string]string,
+	err error,
+) {
+
+	s.domainManager.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateDomainRequest) error {
+			s.Equal(versions, request.Info.Data)
+			s.Equal(int64(4), request.ConfigVersion)
+			s.Equal(int64(7), request.NotificationVersion)
+			return err
+		},
+	).Times(1)
+}
@@ -34,6 +34,8 @@ var (
 	errGracefulFailoverInActiveCluster     = &types.BadRequestError{Message: "Cannot start the graceful failover from an active cluster to an active cluster."}
 	errOngoingGracefulFailover             = &types.BadRequestError{Message: "Cannot start concurrent graceful failover."}
 	errInvalidGracefulFailover             = &types.BadRequestError{Message: "Cannot start graceful failover without updating active cluster or in local domain."}
+	errOngoingWorkflowHandoff              = &types.BadRequestError{Message: "Cannot fail over or change workflow active clusters while workflows are handed off to other clusters."}
+	errReservedDomainDataKey               = &types.BadRequestError{Message: "Domain data keys of the workflow handoff are reserved."}
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
@@ -0,0 +1,144 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// FailoverReadinessOptions are the thresholds of the failover readiness check
+	FailoverReadinessOptions struct {
+		// MaxLag is the maximum replication lag of any shard
+		MaxLag time.Duration
+		// ErrorWindow is the period during which replication failures of the domain are considered recent
+		ErrorWindow time.Duration
+	}
+)
+
+const (
+	// FailoverReadinessCheckLag is the name of the replication lag check
+	FailoverReadinessCheckLag = "replication lag"
+	// FailoverReadinessCheckDLQ is the name of the replication DLQ check
+	FailoverReadinessCheckDLQ = "replication DLQ"
+	// FailoverReadinessCheckErrors is the name of the recent replication errors check
+	FailoverReadinessCheckErrors = "replication errors"
+)
+
+// CheckFailoverReadiness checks the replication state of all the shards of the cluster a domain
+// fails over to, so that failing the domain over does not force conflict resolution. The replication
+// lag is only tracked per shard, so the lag of all the domains in a shard is the lag of the shard.
+// Shards which failed to report their state are not ready.
+func CheckFailoverReadiness(
+	domainID string,
+	shards *types.GetReplicationReadinessResponse,
+	now time.Time,
+	options FailoverReadinessOptions,
+) *types.GetFailoverReadinessResponse {
+
+	var maxLag time.Duration
+	var laggingShards, unknownShards, failedShards []int
+	var dlqSize, failures int64
+	var lastError string
+	var lastErrorTime time.Time
+	for shardID, shard := range shards.GetReadinessByShard() {
+		state := shard.GetProcessorState()
+		if state.GetReplicatedUpToTimestamp() == 0 {
+			unknownShards = append(unknownShards, int(shardID))
+		} else if lag := now.Sub(time.Unix(0, state.GetReplicatedUpToTimestamp())); lag > options.MaxLag {
+			laggingShards = append(laggingShards, int(shardID))
+			if lag > maxLag {
+				maxLag = lag
+			}
+		}
+
+		dlqSize += shard.GetDLQSizeByDomain()[domainID]
+
+		failure, ok := state.GetFailuresByDomain()[domainID]
+		if !ok {
+			continue
+		}
+		if failureTime := time.Unix(0, failure.GetLastTimestamp()); now.Sub(failureTime) <= options.ErrorWindow {
+			failures += failure.GetCount()
+			if failureTime.After(lastErrorTime) {
+				lastErrorTime = failureTime
+				lastError = failure.GetLastError()
+			}
+		}
+	}
+	for shardID := range shards.GetFailedCauseByShard() {
+		failedShards = append(failedShards, int(shardID))
+	}
+	sort.Ints(laggingShards)
+	sort.Ints(unknownShards)
+	sort.Ints(failedShards)
+
+	lagCheck := &types.FailoverReadinessCheck{
+		Name:    FailoverReadinessCheckLag,
+		Passed:  len(laggingShards) == 0 && len(unknownShards) == 0 && len(failedShards) == 0,
+		Message: fmt.Sprintf("all %v shards replicated within %v", len(shards.GetReadinessByShard()), options.MaxLag),
+	}
+	switch {
+	case len(failedShards) != 0:
+		lagCheck.Message = fmt.Sprintf("%v shards failed to report their replication state, shards: %v", len(failedShards), failedShards)
+	case len(laggingShards) != 0:
+		lagCheck.Message = fmt.Sprintf("%v shards lag behind by up to %v, shards: %v", len(laggingShards), maxLag, laggingShards)
+	case len(unknownShards) != 0:
+		lagCheck.Message = fmt.Sprintf("%v shards have not applied any replication task yet, shards: %v", len(unknownShards), unknownShards)
+	}
+
+	dlqCheck := &types.FailoverReadinessCheck{
+		Name:    FailoverReadinessCheckDLQ,
+		Passed:  dlqSize == 0 && len(failedShards) == 0,
+		Message: "DLQ is empty",
+	}
+	switch {
+	case dlqSize != 0:
+		dlqCheck.Message = fmt.Sprintf("%v replication tasks in DLQ", dlqSize)
+	case len(failedShards) != 0:
+		dlqCheck.Message = fmt.Sprintf("DLQ of %v shards is unknown", len(failedShards))
+	}
+
+	errorsCheck := &types.FailoverReadinessCheck{
+		Name:    FailoverReadinessCheckErrors,
+		Passed:  failures == 0,
+		Message: fmt.Sprintf("no failure in the last %v", options.ErrorWindow),
+	}
+	if failures != 0 {
+		errorsCheck.Message = fmt.Sprintf(
+			"%v failures in the last %v, last at %v: %v",
+			failures,
+			options.ErrorWindow,
+			lastErrorTime.Format(time.RFC3339),
+			lastError,
+		)
+	}
+
+	return &types.GetFailoverReadinessResponse{
+		DomainID: domainID,
+		Ready:    lagCheck.Passed && dlqCheck.Passed && errorsCheck.Passed,
+		Checks:   []*types.FailoverReadinessCheck{lagCheck, dlqCheck, errorsCheck},
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCheckFailoverReadiness_Ready(t *testing.T) {
+	now := time.Now()
+	replicatedUpTo := common.Int64Ptr(now.Add(-time.Second).UnixNano())
+	shards := &types.GetReplicationReadinessResponse{
+		ReadinessByShard: map[int32]*types.ReplicationShardReadiness{
+			0: {
+				ShardID: 0,
+				ProcessorState: &types.ReplicationProcessorState{
+					ReplicatedUpToTimestamp: replicatedUpTo,
+					FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+						"domain-id":       {Count: 2, LastTimestamp: now.Add(-time.Hour).UnixNano(), LastError: "old error"},
+						"other-domain-id": {Count: 1, LastTimestamp: now.UnixNano(), LastError: "other domain error"},
+					},
+				},
+				DLQSizeByDomain: map[string]int64{"other-domain-id": 3},
+			},
+			1: {
+				ShardID:        1,
+				ProcessorState: &types.ReplicationProcessorState{ReplicatedUpToTimestamp: replicatedUpTo},
+			},
+		},
+	}
+
+	readiness := CheckFailoverReadiness("domain-id", shards, now, FailoverReadinessOptions{
+		MaxLag:      time.Minute,
+		ErrorWindow: 10 * time.Minute,
+	})
+	require.True(t, readiness.Ready)
+	require.Equal(t, "domain-id", readiness.DomainID)
+	require.Len(t, readiness.Checks, 3)
+	for _, check := range readiness.Checks {
+		require.True(t, check.Passed, check.Name)
+	}
+}
+
+func TestCheckFailoverReadiness_NotReady(t *testing.T) {
+	now := time.Now()
+	shards := &types.GetReplicationReadinessResponse{
+		ReadinessByShard: map[int32]*types.ReplicationShardReadiness{
+			1: {
+				ShardID: 1,
+				ProcessorState: &types.ReplicationProcessorState{
+					ReplicatedUpToTimestamp: common.Int64Ptr(now.Add(-5 * time.Minute).UnixNano()),
+					FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+						"domain-id": {Count: 2, LastTimestamp: now.Add(-time.Minute).UnixNano(), LastError: "some error"},
+					},
+				},
+				DLQSizeByDomain: map[string]int64{"domain-id": 3},
+			},
+			0: {
+				ShardID:        0,
+				ProcessorState: &types.ReplicationProcessorState{},
+			},
+		},
+	}
+
+	readiness := CheckFailoverReadiness("domain-id", shards, now, FailoverReadinessOptions{
+		MaxLag:      time.Minute,
+		ErrorWindow: 10 * time.Minute,
+	})
+	require.False(t, readiness.Ready)
+	require.Equal(t, []*types.FailoverReadinessCheck{
+		{Name: FailoverReadinessCheckLag, Passed: false, Message: "1 shards lag behind by up to 5m0s, shards: [1]"},
+		{Name: FailoverReadinessCheckDLQ, Passed: false, Message: "3 replication tasks in DLQ"},
+		{Name: FailoverReadinessCheckErrors, Passed: false, Message: "2 failures in the last 10m0s, last at " + time.Unix(0, now.Add(-time.Minute).UnixNano()).Format(time.RFC3339) + ": some error"},
+	}, readiness.Checks)
+
+	delete(shards.ReadinessByShard, 1)
+	readiness = CheckFailoverReadiness("domain-id", shards, now, FailoverReadinessOptions{MaxLag: time.Minute})
+	require.False(t, readiness.Ready)
+	require.Equal(t, "1 shards have not applied any replication task yet, shards: [0]", readiness.Checks[0].Message)
+}
+
+func TestCheckFailoverReadiness_FailedShards(t *testing.T) {
+	now := time.Now()
+	shards := &types.GetReplicationReadinessResponse{
+		ReadinessByShard: map[int32]*types.ReplicationShardReadiness{
+			0: {
+				ShardID:        0,
+				ProcessorState: &types.ReplicationProcessorState{ReplicatedUpToTimestamp: common.Int64Ptr(now.UnixNano())},
+			},
+		},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{
+			2: types.GetTaskFailedCauseShardOwnershipLost,
+			1: types.GetTaskFailedCauseTimeout,
+		},
+	}
+
+	readiness := CheckFailoverReadiness("domain-id", shards, now, FailoverReadinessOptions{MaxLag: time.Minute})
+	require.False(t, readiness.Ready)
+	require.Equal(t, []*types.FailoverReadinessCheck{
+		{Name: FailoverReadinessCheckLag, Passed: false, Message: "2 shards failed to report their replication state, shards: [1 2]"},
+		{Name: FailoverReadinessCheckDLQ, Passed: false, Message: "DLQ of 2 shards is unknown"},
+		{Name: FailoverReadinessCheckErrors, Passed: true, Message: "no failure in the last 0s"},
+	}, readiness.Checks)
+}
@@ -132,6 +132,7 @@ func (p *failoverWatcherImpl) refreshDomainLoop() {
 			domains := p.domainCache.GetAllDomain()
 			for _, domain := range domains {
 				p.handleFailoverTimeout(domain)
+				p.handleWorkflowHandoffTimeout(domain)
 				select {
 				case <-p.shutdownChan:
 					p.logger.Debug("Stop refresh domain as the processing is stopping.")
@@ -169,6 +170,82 @@ func (p *failoverWatcherImpl) handleFailoverTimeout(
 	}
 }
 
+func (p *failoverWatcherImpl) handleWorkflowHandoffTimeout(
+	domain *cache.DomainCacheEntry,
+) {
+
+	handoffEndTime := domain.GetWorkflowHandoffEndTime()
+	if handoffEndTime != nil && p.timeSource.Now().After(time.Unix(0, *handoffEndTime)) {
+		domainID := domain.GetInfo().ID
+		if err := CleanWorkflowHandoffState(
+			p.domainManager,
+			domainID,
+			domain.GetInfo().Data[cache.WorkflowActiveClustersKey],
+			p.retryPolicy,
+		); err != nil {
+			p.logger.Error("Failed to end the handoff of workflows to other clusters", tag.WorkflowDomainID(domainID), tag.Error(err))
+		}
+	}
+}
+
+// CleanWorkflowHandoffState removes the state of the handoff of workflows moving to other active clusters from
+// the domain, which makes them active in their new cluster. The domain is updated in this cluster only, like
+// the pending active state is removed in each cluster by CleanPendingActiveState.
+func CleanWorkflowHandoffState(
+	domainManager persistence.DomainManager,
+	domainID string,
+	workflowActiveClusters string,
+	policy backoff.RetryPolicy,
+) error {
+
+	metadata, err := domainManager.GetMetadata(context.Background())
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+
+	getResponse, err := domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{ID: domainID})
+	if err != nil {
+		return err
+	}
+	info := getResponse.Info
+	if _, ok := info.Data[cache.WorkflowActiveClustersHandoffEndTimeKey]; !ok ||
+		info.Data[cache.WorkflowActiveClustersKey] != workflowActiveClusters {
+		// the handoff already ended, or the workflow active clusters changed again since
+		return nil
+	}
+
+	data := make(map[string]string, len(info.Data))
+	for key, value := range info.Data {
+		data[key] = value
+	}
+	delete(data, cache.WorkflowActiveClustersPreviousKey)
+	delete(data, cache.WorkflowActiveClustersHandoffEndTimeKey)
+	newInfo := *info
+	newInfo.Data = data
+
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        &newInfo,
+		Config:                      getResponse.Config,
+		ReplicationConfig:           getResponse.ReplicationConfig,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             getResponse.FailoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		FailoverEndTime:             getResponse.FailoverEndTime,
+		PreviousFailoverVersion:     getResponse.PreviousFailoverVersion,
+		LastUpdatedTime:             getResponse.LastUpdatedTime,
+		NotificationVersion:         notificationVersion,
+	}
+	op := func() error {
+		return domainManager.UpdateDomain(context.Background(), updateReq)
+	}
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(policy),
+		backoff.WithRetryableError(isUpdateDomainRetryable),
+	)
+	return throttleRetry.Do(context.Background(), op)
+}
+
 // CleanPendingActiveState removes the pending active state from the domain
 func CleanPendingActiveState(
 	domainManager persistence.DomainManager,
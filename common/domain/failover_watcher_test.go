@@ -23,6 +23,7 @@ package domain
 import (
 	"log"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -244,3 +245,93 @@ func (s *failoverWatcherSuite) TestHandleFailoverTimeout() {
 	)
 	s.watcher.handleFailoverTimeout(domainEntry)
 }
+
+func (s *failoverWatcherSuite) TestHandleWorkflowHandoffTimeout() {
+	domainName := uuid.New()
+	info := &persistence.DomainInfo{
+		ID:     domainName,
+		Name:   domainName,
+		Status: persistence.DomainStatusRegistered,
+		Data: map[string]string{
+			"some random key":                             "some random value",
+			cache.WorkflowActiveClustersKey:               "standby:50",
+			cache.WorkflowActiveClustersPreviousKey:       "",
+			cache.WorkflowActiveClustersHandoffEndTimeKey: strconv.FormatInt(s.timeSource.Now().UnixNano()-1, 10),
+		},
+	}
+	domainConfig := &persistence.DomainConfig{
+		Retention:  1,
+		EmitMetric: true,
+	}
+	replicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: "active",
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: "active"},
+			{ClusterName: "standby"},
+		},
+	}
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{
+		ID: domainName,
+	}).Return(&persistence.GetDomainResponse{
+		Info:                        info,
+		Config:                      domainConfig,
+		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
+		ConfigVersion:               1,
+		FailoverVersion:             1,
+		FailoverNotificationVersion: 1,
+		LastUpdatedTime:             1,
+		NotificationVersion:         1,
+	}, nil).Times(1)
+	s.mockMetadataMgr.On("UpdateDomain", mock.Anything, &persistence.UpdateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:     domainName,
+			Name:   domainName,
+			Status: persistence.DomainStatusRegistered,
+			Data: map[string]string{
+				"some random key":               "some random value",
+				cache.WorkflowActiveClustersKey: "standby:50",
+			},
+		},
+		Config:                      domainConfig,
+		ReplicationConfig:           replicationConfig,
+		ConfigVersion:               1,
+		FailoverVersion:             1,
+		FailoverNotificationVersion: 1,
+		LastUpdatedTime:             1,
+		NotificationVersion:         1,
+	}).Return(nil).Times(1)
+
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		info,
+		domainConfig,
+		replicationConfig,
+		1,
+		nil,
+	)
+	s.watcher.handleWorkflowHandoffTimeout(domainEntry)
+}
+
+func (s *failoverWatcherSuite) TestCleanWorkflowHandoffState_WorkflowActiveClustersChanged() {
+	domainName := uuid.New()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{
+		ID: domainName,
+	}).Return(&persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{
+			ID:   domainName,
+			Name: domainName,
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey:               "standby:100",
+				cache.WorkflowActiveClustersPreviousKey:       "standby:50",
+				cache.WorkflowActiveClustersHandoffEndTimeKey: "1",
+			},
+		},
+		IsGlobalDomain: true,
+	}, nil).Times(1)
+
+	// the handoff of a later change is not ended
+	err := CleanWorkflowHandoffState(s.mockMetadataMgr, domainName, "standby:50", s.watcher.retryPolicy)
+	s.NoError(err)
+	s.mockMetadataMgr.AssertNotCalled(s.T(), "UpdateDomain", mock.Anything, mock.Anything)
+}
@@ -25,6 +25,7 @@ package domain
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -46,6 +48,12 @@ var (
 	errFailoverTooFrequent = &types.ServiceBusyError{Message: "The domain failovers too frequent."}
 )
 
+const (
+	// defaultWorkflowHandoffTimeout is how long workflows moving to another active cluster are active in no
+	// cluster when workflow active clusters change without a failover timeout
+	defaultWorkflowHandoffTimeout = time.Minute
+)
+
 type (
 	// Handler is the domain operation handler
 	Handler interface {
@@ -151,6 +159,10 @@ func (d *handlerImpl) RegisterDomain(
 		return err
 	}
 
+	if err := d.domainAttrValidator.validateDomainData(registerRequest.Data); err != nil {
+		return err
+	}
+
 	activeClusterName := d.clusterMetadata.GetCurrentClusterName()
 	// input validation on cluster names
 	if registerRequest.ActiveClusterName != "" {
@@ -426,10 +438,17 @@ func (d *handlerImpl) UpdateDomain(
 	}
 
 	// Update domain info
+	if err := d.domainAttrValidator.validateDomainData(updateRequest.Data); err != nil {
+		return nil, err
+	}
+	workflowActiveClusters := info.Data[cache.WorkflowActiveClustersKey]
+	_, workflowHandoffInProgress := info.Data[cache.WorkflowActiveClustersHandoffEndTimeKey]
 	info, domainInfoChanged := d.updateDomainInfo(
 		updateRequest,
 		info,
 	)
+	// whether the clusters workflows are active in by workflow ID changed
+	workflowActiveClustersChanged := info.Data[cache.WorkflowActiveClustersKey] != workflowActiveClusters
 	// Update domain config
 	config, domainConfigChanged, err := d.updateDomainConfiguration(
 		updateRequest.GetName(),
@@ -458,8 +477,8 @@ func (d *handlerImpl) UpdateDomain(
 		return nil, err
 	}
 
-	// Handle graceful failover request
-	if updateRequest.FailoverTimeoutInSeconds != nil {
+	// Handle graceful failover request, the timeout of a change of workflow active clusters is its handoff timeout
+	if updateRequest.FailoverTimeoutInSeconds != nil && !workflowActiveClustersChanged {
 		// must update active cluster on a global domain
 		if !activeClusterChanged || !isGlobalDomain {
 			return nil, errInvalidGracefulFailover
@@ -496,6 +515,19 @@ func (d *handlerImpl) UpdateDomain(
 			return nil, errCannotDoDomainFailoverAndUpdate
 		}
 
+		if (workflowActiveClustersChanged || activeClusterChanged) && workflowHandoffInProgress {
+			return nil, errOngoingWorkflowHandoff
+		}
+
+		if workflowActiveClustersChanged {
+			if err := d.domainAttrValidator.validateWorkflowActiveClusters(
+				info.Data[cache.WorkflowActiveClustersKey],
+				replicationConfig,
+			); err != nil {
+				return nil, err
+			}
+		}
+
 		if !activeClusterChanged && !d.clusterMetadata.IsPrimaryCluster() {
 			return nil, errNotPrimaryCluster
 		}
@@ -531,6 +563,22 @@ func (d *handlerImpl) UpdateDomain(
 			)
 			failoverNotificationVersion = notificationVersion
 		}
+		if workflowActiveClustersChanged && isGlobalDomain {
+			// workflows moving to another cluster are active in neither cluster until the handoff ends,
+			// which gives the new cluster time to receive their history replicated from the old cluster
+			handoffTimeout := defaultWorkflowHandoffTimeout
+			if updateRequest.FailoverTimeoutInSeconds != nil {
+				handoffTimeout = time.Duration(updateRequest.GetFailoverTimeoutInSeconds()) * time.Second
+			}
+			info.Data[cache.WorkflowActiveClustersPreviousKey] = workflowActiveClusters
+			info.Data[cache.WorkflowActiveClustersHandoffEndTimeKey] = strconv.FormatInt(now.Add(handoffTimeout).UnixNano(), 10)
+			// workflows moving to another cluster need a higher failover version there
+			failoverVersion = d.clusterMetadata.GetNextFailoverVersion(
+				replicationConfig.ActiveClusterName,
+				failoverVersion,
+			)
+			failoverNotificationVersion = notificationVersion
+		}
 		lastUpdatedTime = now
 		updateReq := &persistence.UpdateDomainRequest{
 			Info:                        info,
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
@@ -607,6 +608,64 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_ForceFailover_SameActiveClus
 	s.NoError(err)
 }
 
+func (s *domainHandlerCommonSuite) TestUpdateDomain_WorkflowActiveClusters_Handoff() {
+	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Twice()
+	domain := uuid.New()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         true,
+		ActiveClusterName:                      s.ClusterMetadata.GetCurrentClusterName(),
+		Clusters: []*types.ClusterReplicationConfiguration{
+			{ClusterName: s.ClusterMetadata.GetCurrentClusterName()},
+			{ClusterName: "standby"},
+		},
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	updateRequest := &types.UpdateDomainRequest{
+		Name:                     domain,
+		Data:                     map[string]string{cache.WorkflowActiveClustersKey: "standby:50"},
+		FailoverTimeoutInSeconds: common.Int32Ptr(100),
+	}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.NoError(err)
+	resp, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{
+		Name: domain,
+	})
+	s.NoError(err)
+	s.Equal("standby:50", resp.Info.Data[cache.WorkflowActiveClustersKey])
+	s.Equal("", resp.Info.Data[cache.WorkflowActiveClustersPreviousKey])
+	s.NotEmpty(resp.Info.Data[cache.WorkflowActiveClustersHandoffEndTimeKey])
+	s.Nil(resp.FailoverEndTime)
+
+	// workflows cannot move again before the handoff ends
+	updateRequest.Data = map[string]string{cache.WorkflowActiveClustersKey: "standby:100"}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.Equal(errOngoingWorkflowHandoff, err)
+}
+
+func (s *domainHandlerCommonSuite) TestUpdateDomain_ReservedDataKey() {
+	domain := s.getRandomDomainName()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         false,
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	updateRequest := &types.UpdateDomainRequest{
+		Name: domain,
+		Data: map[string]string{cache.WorkflowActiveClustersHandoffEndTimeKey: "0"},
+	}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.Equal(errReservedDomainDataKey, err)
+}
+
 func (s *domainHandlerCommonSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
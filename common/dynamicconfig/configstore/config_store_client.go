@@ -240,6 +240,21 @@ func (csc *configStoreClient) GetDurationValue(
 }
 
 func (csc *configStoreClient) UpdateValue(name dc.Key, value interface{}) error {
+	if _, ok := value.([]*types.DynamicConfigValue); !ok && value != nil {
+		// a plain value, as the other clients take, replaces the values with a fallback value without filters
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		value = []*types.DynamicConfigValue{
+			{
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         data,
+				},
+			},
+		}
+	}
 	return csc.updateValue(name, value, csc.config.UpdateRetryAttempts)
 }
 
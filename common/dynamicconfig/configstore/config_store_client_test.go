@@ -780,6 +780,40 @@ func (s *configStoreClientSuite) TestUpdateValue_SuccessNewKey() {
 	s.NoError(err)
 }
 
+func (s *configStoreClientSuite) TestUpdateValue_PlainValue() {
+	s.mockManager.EXPECT().
+		FetchDynamicConfig(gomock.Any()).
+		Return(&p.FetchDynamicConfigResponse{
+			Snapshot: &p.DynamicConfigSnapshot{
+				Version: 1,
+				Values: &types.DynamicConfigBlob{
+					SchemaVersion: 1,
+					Entries:       nil,
+				},
+			},
+		}, nil).
+		AnyTimes()
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest) error {
+			s.Equal(1, len(request.Snapshot.Values.Entries))
+			s.Equal([]*types.DynamicConfigValue{
+				{
+					Value: &types.DataBlob{
+						EncodingType: types.EncodingTypeJSON.Ptr(),
+						Data:         jsonMarshalHelper(map[string]interface{}{"CustomKeywordField": 1}),
+					},
+				},
+			}, request.Snapshot.Values.Entries[0].Values)
+			return nil
+		}).AnyTimes()
+
+	s.client.update()
+	err := s.client.UpdateValue(dc.TestGetMapPropertyKey, map[string]interface{}{"CustomKeywordField": 1})
+	s.NoError(err)
+}
+
 func (s *configStoreClientSuite) TestUpdateValue_RetrySuccess() {
 	s.mockManager.EXPECT().
 		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2)).
@@ -196,6 +196,13 @@ const (
 	// Default value: 4194304 (4*1024*1024)
 	// Allowed filters: N/A
 	GRPCMaxSizeInByte
+	// CompletionCallbackAllowedHosts is the comma separated list of host names that workflow completion callbacks
+	// of a domain may be sent to, "*" allows any host and an empty value disables completion callbacks
+	// KeyName: system.completionCallbackAllowedHosts
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName
+	CompletionCallbackAllowedHosts
 	// BlobSizeLimitError is the per event blob size limit
 	// KeyName: limit.blobSize.error
 	// Value type: Int
@@ -232,6 +239,34 @@ const (
 	// Default value: 51200 (50*1024)
 	// Allowed filters: DomainName
 	HistoryCountLimitWarn
+	// PendingActivitiesCountLimit is the max number of pending activities of a workflow execution,
+	// decisions scheduling more activities are failed. 0 means no limit
+	// KeyName: limit.pendingActivityCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingActivitiesCountLimit
+	// PendingChildExecutionsCountLimit is the max number of pending child workflow executions of a workflow execution,
+	// decisions starting more child workflows are failed. 0 means no limit
+	// KeyName: limit.pendingChildExecutionCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingChildExecutionsCountLimit
+	// PendingTimersCountLimit is the max number of pending user timers of a workflow execution,
+	// decisions starting more timers are failed. 0 means no limit
+	// KeyName: limit.pendingTimerCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingTimersCountLimit
+	// PendingExternalRequestsCountLimit is the max number of outstanding signal and cancel requests to external
+	// workflows of a workflow execution, decisions initiating more requests are failed. 0 means no limit
+	// KeyName: limit.pendingExternalRequestCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	PendingExternalRequestsCountLimit
 	// DomainNameMaxLength is the length limit for domain name
 	// KeyName: limit.domainNameLength
 	// Value type: Int
@@ -331,6 +366,27 @@ const (
 	// Default value: 1000
 	// Allowed filters: DomainName
 	FrontendVisibilityMaxPageSize
+	// FrontendCountGroupByMaxGroups is the max number of groups returned by CountWorkflowExecutions with group by,
+	// only the largest groups are returned when there are more
+	// KeyName: frontend.countGroupByMaxGroups
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	FrontendCountGroupByMaxGroups
+	// FrontendPayloadOffloadThreshold is the size in bytes above which payloads of workflow start, signal and
+	// activity completion requests are offloaded to the blob store, zero disables offloading
+	// KeyName: frontend.payloadOffloadThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendPayloadOffloadThreshold
+	// FrontendPayloadOffloadReturnReferences is whether history reads return references to offloaded payloads
+	// instead of the payloads
+	// KeyName: frontend.payloadOffloadReturnReferences
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendPayloadOffloadReturnReferences
 	// FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows
 	// KeyName: frontend.visibilityListMaxQPS
 	// Value type: Int
@@ -421,6 +477,21 @@ const (
 	// Default value: the default attributes of this release version, see definition.GetDefaultIndexedKeys()
 	// Allowed filters: N/A
 	ValidSearchAttributes
+	// EnableClusterSettingsReplication is whether the search attributes added by AddSearchAttribute and the dynamic
+	// config values in ReplicatedDynamicConfigKeys updated by UpdateDynamicConfig are replicated to the other clusters
+	// through the domain replication queue
+	// KeyName: frontend.enableClusterSettingsReplication
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableClusterSettingsReplication
+	// ReplicatedDynamicConfigKeys is the comma separated names of the dynamic config keys whose updates are replicated
+	// to the other clusters when EnableClusterSettingsReplication is set
+	// KeyName: frontend.replicatedDynamicConfigKeys
+	// Value type: String
+	// Default value: "" (no dynamic config key is replicated)
+	// Allowed filters: N/A
+	ReplicatedDynamicConfigKeys
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	// KeyName: frontend.sendRawWorkflowHistory
 	// Value type: Bool
@@ -604,6 +675,13 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingMaxOutstandingTasksPerPoller is the max number of started activity tasks a single poller identity
+	// can hold on a task list partition, zero means no limit
+	// KeyName: matching.maxOutstandingTasksPerPoller
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxOutstandingTasksPerPoller
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: matching.shutdownDrainDuration
 	// Value type: Duration
@@ -739,6 +817,22 @@ const (
 	// Default value: 1
 	// Allowed filters: N/A
 	AcquireShardConcurrency
+	// EnableGracefulShardHandoff is key for enable handing off shards to their new owner on membership change and when the host
+	// is shutting down: the shard is closed after persisting its shard info and the new owner is notified to acquire it, while the
+	// new owner waits up to ShardHandoffTimeout for the previous owner before acquiring the shard, unless the previous owner has
+	// left the membership ring and doesn't answer. A host shutting down hands off its shards after leaving the ring, within
+	// HistoryShutdownDrainDuration
+	// KeyName: history.enableGracefulShardHandoff
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableGracefulShardHandoff
+	// ShardHandoffTimeout is the max time a host waits for the previous owner of a shard to hand it off before acquiring it
+	// KeyName: history.shardHandoffTimeout
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: N/A
+	ShardHandoffTimeout
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	// KeyName: history.standbyClusterDelay
 	// Value type: Duration
@@ -1035,6 +1129,13 @@ const (
 	// Default value: 1s (1*time.Second)
 	// Allowed filters: N/A
 	TimerProcessorArchivalTimeLimit
+	// TimerProcessorEnablePayloadOffloadCleanup is whether offloaded payloads of a workflow are deleted from the
+	// blob store when the workflow is deleted after retention
+	// KeyName: history.timerProcessorEnablePayloadOffloadCleanup
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	TimerProcessorEnablePayloadOffloadCleanup
 
 	// TransferTaskBatchSize is batch size for transferQueueProcessor
 	// KeyName: history.transferTaskBatchSize
@@ -1489,6 +1590,26 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	EnableDropStuckTaskByDomainID
+	// CompletionCallbackMaxAttempts is the max number of attempts to deliver a workflow completion callback
+	// KeyName: history.completionCallbackMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	CompletionCallbackMaxAttempts
+	// CompletionCallbackTimeout is the timeout of a single workflow completion callback request
+	// KeyName: history.completionCallbackTimeout
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: DomainName
+	CompletionCallbackTimeout
+	// HistoryExportMode is the mode of exporting persisted history events of a domain to the history export topic.
+	// Empty string disables the export, "batch" exports every persisted history batch and "lifecycle" exports
+	// a compact projection of workflow started, workflow closed and activity failed events
+	// KeyName: history.historyExportMode
+	// Value type: String
+	// Default value: "" (disabled)
+	// Allowed filters: DomainName
+	HistoryExportMode
 	// EnableConsistentQuery indicates if consistent query is enabled for the cluster
 	// KeyName: history.EnableConsistentQuery
 	// Value type: Bool
@@ -1690,6 +1811,67 @@ const (
 	// Default value: true
 	// Allowed filters: DomainID, WorkflowID
 	EnableReplicationTaskGeneration
+	// EnableReplicationDLQAutoRetry is the flag to periodically retry the replication tasks in the DLQ
+	// KeyName: history.enableReplicationDLQAutoRetry
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationDLQAutoRetry
+	// ReplicationDLQRetryInterval is the interval between scans of the replication DLQ, it is also the initial
+	// backoff of a task which failed to be retried
+	// KeyName: history.replicationDLQRetryInterval
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: ShardID
+	ReplicationDLQRetryInterval
+	// ReplicationDLQRetryMaxInterval is the maximum backoff between retries of a replication task in the DLQ
+	// KeyName: history.replicationDLQRetryMaxInterval
+	// Value type: Duration
+	// Default value: 6h (6*time.Hour)
+	// Allowed filters: ShardID
+	ReplicationDLQRetryMaxInterval
+	// ReplicationDLQRetryMaxAttempts is the number of failed retries after which a replication task in the DLQ
+	// is considered poison and no longer retried
+	// KeyName: history.replicationDLQRetryMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: ShardID
+	ReplicationDLQRetryMaxAttempts
+	// ReplicationDLQRetryBatchSize is the page size of reading the replication DLQ for retries, a retry pass
+	// ends once about this many tasks are retried and the next pass continues after the last task read
+	// KeyName: history.replicationDLQRetryBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	ReplicationDLQRetryBatchSize
+	// ReplicationDLQMaxTaskStates is the max number of replication DLQ tasks per source cluster whose retry state is
+	// kept in the shard info, once it is reached the other tasks are not retried until the poison tasks are merged or purged
+	// KeyName: history.replicationDLQMaxTaskStates
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	ReplicationDLQMaxTaskStates
+	// EnableReplicationTaskCompression is the flag to ask remote clusters for replication tasks batched into
+	// zstd compressed frames, remote clusters which don't support it keep sending the tasks uncompressed
+	// KeyName: history.enableReplicationTaskCompression
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationTaskCompression
+	// ReplicationTaskFrameMaxSize is the max size in bytes of the replication tasks batched into one compressed frame
+	// before compression, a task larger than it is sent in a frame of its own
+	// KeyName: history.replicationTaskFrameMaxSize
+	// Value type: Int
+	// Default value: 1048576 (1MB)
+	// Allowed filters: N/A
+	ReplicationTaskFrameMaxSize
+	// ReplicationStatusMaxTasks is the max number of replication tasks read from the backlog of a shard, and from its DLQ,
+	// to report the replication status, the status of a shard with more tasks is reported as truncated
+	// KeyName: history.replicationStatusMaxTasks
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: N/A
+	ReplicationStatusMaxTasks
 
 	// key for worker
 
@@ -2053,6 +2235,129 @@ const (
 	// Default value: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerBlobIntegrityCheckProbability
+	// EnableVisibilityMigrationDualWrite is key for enable writing visibility records of a domain to both db and advanced visibility,
+	// in addition to the store(s) selected by AdvancedVisibilityWritingMode. Failures of the additional write are only logged and counted
+	// KeyName: system.enableVisibilityMigrationDualWrite
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableVisibilityMigrationDualWrite
+	// VisibilityMigrationShadowReadPercentage is the percentage of visibility reads of a domain that are also sent to the visibility store
+	// not selected by EnableReadVisibilityFromES, or to the advanced visibility migration target store if configured. Results of both stores
+	// are compared and mismatches are logged and counted
+	// KeyName: system.visibilityMigrationShadowReadPercentage
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	VisibilityMigrationShadowReadPercentage
+	// VisibilityMigrationMaxConcurrentShadowReads is the max number of visibility migration shadow reads in flight per host,
+	// reads sampled while the limit is reached are not shadowed
+	// KeyName: system.visibilityMigrationMaxConcurrentShadowReads
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	VisibilityMigrationMaxConcurrentShadowReads
+	// EnableVisibilityMigrationBackfill is key for enable backfilling visibility records of a domain from the store it reads from
+	// (see EnableReadVisibilityFromES) to the other store
+	// KeyName: worker.enableVisibilityMigrationBackfill
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableVisibilityMigrationBackfill
+	// VisibilityMigrationBackfillRPS is the rate limit of records written by the visibility migration backfill per worker host
+	// KeyName: worker.visibilityMigrationBackfillRPS
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	VisibilityMigrationBackfillRPS
+	// EnableAutoFailover is key for enable the controller workflow which fails over domains tagged for auto failover
+	// to the current cluster when another cluster is unhealthy. Only the controller in the primary cluster makes
+	// decisions for the other clusters and there is no quorum between the clusters. Domains active in the primary
+	// cluster are only failed over when AutoFailoverBackupCluster is set
+	// KeyName: worker.enableAutoFailover
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableAutoFailover
+	// AutoFailoverCheckInterval is the interval between two health checks of the auto failover controller
+	// KeyName: worker.autoFailoverCheckInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: N/A
+	AutoFailoverCheckInterval
+	// AutoFailoverConfirmationWindow is how long a cluster has to stay unhealthy before its domains are failed over
+	// KeyName: worker.autoFailoverConfirmationWindow
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: N/A
+	AutoFailoverConfirmationWindow
+	// AutoFailoverProbeCount is the number of requests sent to the frontend of a cluster by one health check
+	// KeyName: worker.autoFailoverProbeCount
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	AutoFailoverProbeCount
+	// AutoFailoverMinFrontendAvailability is the ratio of probe requests a healthy frontend has to answer, 0 disables the check
+	// KeyName: worker.autoFailoverMinFrontendAvailability
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	AutoFailoverMinFrontendAvailability
+	// AutoFailoverMaxPersistenceErrorRate is the max ratio of persistence requests failed in the last minute, as reported by
+	// the frontend of a cluster, 0 disables the check
+	// KeyName: worker.autoFailoverMaxPersistenceErrorRate
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	AutoFailoverMaxPersistenceErrorRate
+	// AutoFailoverMaxReplicationLag is the max replication lag from a healthy cluster, 0 disables the check
+	// KeyName: worker.autoFailoverMaxReplicationLag
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	AutoFailoverMaxReplicationLag
+	// AutoFailoverBackupCluster is the cluster whose controller checks the health of the primary cluster and fails the
+	// domains active in the primary cluster over to itself. Empty means that the domains of the primary cluster are
+	// never failed over automatically. Without a quorum, a partition between the two clusters makes both of them
+	// active for these domains, the conflicting histories are resolved by the failover version as in a forced failover
+	// KeyName: worker.autoFailoverBackupCluster
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: N/A
+	AutoFailoverBackupCluster
+	// EnableReplicationVerifier is key for enable the cron workflow which samples workflows of global domains active in the
+	// current cluster and compares their history and mutable state with the other clusters of the domain
+	// KeyName: worker.enableReplicationVerifier
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationVerifier
+	// ReplicationVerifierSampleSize is the number of open and the number of closed workflows verified per domain by one run of the replication verifier
+	// KeyName: worker.replicationVerifierSampleSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	ReplicationVerifierSampleSize
+	// ReplicationVerifierMinAge is the minimum time since a workflow was started (or closed) before it is sampled by the replication verifier,
+	// so that replication lag is not reported as divergence
+	// KeyName: worker.replicationVerifierMinAge
+	// Value type: Duration
+	// Default value: 10m
+	// Allowed filters: N/A
+	ReplicationVerifierMinAge
+	// ReplicationVerifierEnableResend is key for enable resending replication tasks to the cluster which is behind for divergences
+	// the replication verifier is able to fix
+	// KeyName: worker.replicationVerifierEnableResend
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	ReplicationVerifierEnableResend
+	// ReplicationVerifierRPS is the rate limit of workflows verified by the replication verifier per worker host
+	// KeyName: worker.replicationVerifierRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	ReplicationVerifierRPS
 
 	// ESAnalyzerPause defines if we want to dynamically pause the analyzer workflow
 	// KeyName: worker.ESAnalyzerPause
@@ -2156,6 +2461,7 @@ var Keys = map[Key]string{
 	RequiredDomainDataKeys:              "system.requiredDomainDataKeys",
 	EnableGRPCOutbound:                  "system.enableGRPCOutbound",
 	GRPCMaxSizeInByte:                   "system.grpcMaxSizeInByte",
+	CompletionCallbackAllowedHosts:      "system.completionCallbackAllowedHosts",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -2165,6 +2471,12 @@ var Keys = map[Key]string{
 	HistoryCountLimitError: "limit.historyCount.error",
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 
+	// pending count limits
+	PendingActivitiesCountLimit:       "limit.pendingActivityCount",
+	PendingChildExecutionsCountLimit:  "limit.pendingChildExecutionCount",
+	PendingTimersCountLimit:           "limit.pendingTimerCount",
+	PendingExternalRequestsCountLimit: "limit.pendingExternalRequestCount",
+
 	// id length limits
 	MaxIDLengthWarnLimit:  "limit.maxIDWarnLength",
 	DomainNameMaxLength:   "limit.domainNameLength",
@@ -2186,6 +2498,9 @@ var Keys = map[Key]string{
 	FrontendPersistenceMaxQPS:                   "frontend.persistenceMaxQPS",
 	FrontendPersistenceGlobalMaxQPS:             "frontend.persistenceGlobalMaxQPS",
 	FrontendVisibilityMaxPageSize:               "frontend.visibilityMaxPageSize",
+	FrontendCountGroupByMaxGroups:               "frontend.countGroupByMaxGroups",
+	FrontendPayloadOffloadThreshold:             "frontend.payloadOffloadThreshold",
+	FrontendPayloadOffloadReturnReferences:      "frontend.payloadOffloadReturnReferences",
 	FrontendVisibilityListMaxQPS:                "frontend.visibilityListMaxQPS",
 	FrontendESVisibilityListMaxQPS:              "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                      "frontend.maxBadBinaries",
@@ -2202,6 +2517,8 @@ var Keys = map[Key]string{
 	FrontendThrottledLogRPS:                     "frontend.throttledLogRPS",
 	EnableClientVersionCheck:                    "frontend.enableClientVersionCheck",
 	ValidSearchAttributes:                       "frontend.validSearchAttributes",
+	EnableClusterSettingsReplication:            "frontend.enableClusterSettingsReplication",
+	ReplicatedDynamicConfigKeys:                 "frontend.replicatedDynamicConfigKeys",
 	SendRawWorkflowHistory:                      "frontend.sendRawWorkflowHistory",
 	SearchAttributesNumberOfKeysLimit:           "frontend.searchAttributesNumberOfKeysLimit",
 	SearchAttributesSizeOfValueLimit:            "frontend.searchAttributesSizeOfValueLimit",
@@ -2233,6 +2550,7 @@ var Keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingMaxOutstandingTasksPerPoller:    "matching.maxOutstandingTasksPerPoller",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
 	MatchingErrorInjectionRate:              "matching.errorInjectionRate",
 	MatchingEnableTaskInfoLogByDomainID:     "matching.enableTaskInfoLogByDomainID",
@@ -2258,6 +2576,8 @@ var Keys = map[Key]string{
 	EventsCacheGlobalMaxCount:                          "history.eventsCacheGlobalMaxSize",
 	AcquireShardInterval:                               "history.acquireShardInterval",
 	AcquireShardConcurrency:                            "history.acquireShardConcurrency",
+	EnableGracefulShardHandoff:                         "history.enableGracefulShardHandoff",
+	ShardHandoffTimeout:                                "history.shardHandoffTimeout",
 	StandbyClusterDelay:                                "history.standbyClusterDelay",
 	StandbyTaskMissingEventsResendDelay:                "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:               "history.standbyTaskMissingEventsDiscardDelay",
@@ -2307,6 +2627,7 @@ var Keys = map[Key]string{
 	TimerProcessorMaxTimeShift:                        "history.timerProcessorMaxTimeShift",
 	TimerProcessorHistoryArchivalSizeLimit:            "history.timerProcessorHistoryArchivalSizeLimit",
 	TimerProcessorArchivalTimeLimit:                   "history.timerProcessorArchivalTimeLimit",
+	TimerProcessorEnablePayloadOffloadCleanup:         "history.timerProcessorEnablePayloadOffloadCleanup",
 
 	TransferTaskBatchSize:                                "history.transferTaskBatchSize",
 	TransferTaskDeleteBatchSize:                          "history.transferTaskDeleteBatchSize",
@@ -2404,6 +2725,15 @@ var Keys = map[Key]string{
 	ReplicationTaskProcessorHostQPS:                    "history.ReplicationTaskProcessorHostQPS",
 	ReplicationTaskProcessorShardQPS:                   "history.ReplicationTaskProcessorShardQPS",
 	EnableReplicationTaskGeneration:                    "history.enableReplicationTaskGeneration",
+	EnableReplicationDLQAutoRetry:                      "history.enableReplicationDLQAutoRetry",
+	ReplicationDLQRetryInterval:                        "history.replicationDLQRetryInterval",
+	ReplicationDLQRetryMaxInterval:                     "history.replicationDLQRetryMaxInterval",
+	ReplicationDLQRetryMaxAttempts:                     "history.replicationDLQRetryMaxAttempts",
+	ReplicationDLQRetryBatchSize:                       "history.replicationDLQRetryBatchSize",
+	ReplicationDLQMaxTaskStates:                        "history.replicationDLQMaxTaskStates",
+	EnableReplicationTaskCompression:                   "history.enableReplicationTaskCompression",
+	ReplicationTaskFrameMaxSize:                        "history.replicationTaskFrameMaxSize",
+	ReplicationStatusMaxTasks:                          "history.replicationStatusMaxTasks",
 	ReplicationTaskGenerationQPS:                       "history.ReplicationTaskGenerationQPS",
 	EnableConsistentQuery:                              "history.EnableConsistentQuery",
 	EnableConsistentQueryByDomain:                      "history.EnableConsistentQueryByDomain",
@@ -2415,6 +2745,9 @@ var Keys = map[Key]string{
 	NotifyFailoverMarkerInterval:                       "history.NotifyFailoverMarkerInterval",
 	NotifyFailoverMarkerTimerJitterCoefficient:         "history.NotifyFailoverMarkerTimerJitterCoefficient",
 	EnableDropStuckTaskByDomainID:                      "history.DropStuckTaskByDomain",
+	CompletionCallbackMaxAttempts:                      "history.completionCallbackMaxAttempts",
+	CompletionCallbackTimeout:                          "history.completionCallbackTimeout",
+	HistoryExportMode:                                  "history.historyExportMode",
 	EnableActivityLocalDispatchByDomain:                "history.enableActivityLocalDispatchByDomain",
 	HistoryErrorInjectionRate:                          "history.errorInjectionRate",
 	HistoryEnableTaskInfoLogByDomainID:                 "history.enableTaskInfoLogByDomainID",
@@ -2478,6 +2811,24 @@ var Keys = map[Key]string{
 	EnableArchivalCompression:                       "worker.EnableArchivalCompression",
 	WorkerDeterministicConstructionCheckProbability: "worker.DeterministicConstructionCheckProbability",
 	WorkerBlobIntegrityCheckProbability:             "worker.BlobIntegrityCheckProbability",
+	EnableVisibilityMigrationDualWrite:              "system.enableVisibilityMigrationDualWrite",
+	VisibilityMigrationShadowReadPercentage:         "system.visibilityMigrationShadowReadPercentage",
+	VisibilityMigrationMaxConcurrentShadowReads:     "system.visibilityMigrationMaxConcurrentShadowReads",
+	EnableVisibilityMigrationBackfill:               "worker.enableVisibilityMigrationBackfill",
+	VisibilityMigrationBackfillRPS:                  "worker.visibilityMigrationBackfillRPS",
+	EnableAutoFailover:                              "worker.enableAutoFailover",
+	AutoFailoverCheckInterval:                       "worker.autoFailoverCheckInterval",
+	AutoFailoverConfirmationWindow:                  "worker.autoFailoverConfirmationWindow",
+	AutoFailoverProbeCount:                          "worker.autoFailoverProbeCount",
+	AutoFailoverMinFrontendAvailability:             "worker.autoFailoverMinFrontendAvailability",
+	AutoFailoverMaxPersistenceErrorRate:             "worker.autoFailoverMaxPersistenceErrorRate",
+	AutoFailoverMaxReplicationLag:                   "worker.autoFailoverMaxReplicationLag",
+	AutoFailoverBackupCluster:                       "worker.autoFailoverBackupCluster",
+	EnableReplicationVerifier:                       "worker.enableReplicationVerifier",
+	ReplicationVerifierSampleSize:                   "worker.replicationVerifierSampleSize",
+	ReplicationVerifierMinAge:                       "worker.replicationVerifierMinAge",
+	ReplicationVerifierEnableResend:                 "worker.replicationVerifierEnableResend",
+	ReplicationVerifierRPS:                          "worker.replicationVerifierRPS",
 
 	ESAnalyzerPause:                          "worker.ESAnalyzerPause",
 	ESAnalyzerTimeWindow:                     "worker.ESAnalyzerTimeWindow",
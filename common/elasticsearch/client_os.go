@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+)
+
+var _ GenericClient = (*openSearchClient)(nil)
+var _ GenericBulkProcessor = (*openSearchBulkProcessor)(nil)
+
+type (
+	// openSearchClient implements Client for OpenSearch 1.x and 2.x.
+	// The REST API of OpenSearch is compatible with ElasticSearch 7, except that mapping types
+	// were removed in OpenSearch 2.0, so it reuses the v7 client and only strips types off bulk requests.
+	// ScanByQuery pages with the scroll API like the ElasticSearch clients, point in time search is not
+	// supported: its OpenSearch API differs from the ElasticSearch one and is missing from OpenSearch 1.x.
+	openSearchClient struct {
+		*elasticV7
+	}
+
+	openSearchBulkProcessor struct {
+		*v7BulkProcessor
+	}
+)
+
+// NewOpenSearchClient returns a new implementation of GenericClient for OpenSearch
+func NewOpenSearchClient(
+	connectConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+) (GenericClient, error) {
+	client, err := NewV7Client(connectConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClient{
+		elasticV7: client.(*elasticV7),
+	}, nil
+}
+
+func (c *openSearchClient) RunBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (GenericBulkProcessor, error) {
+	processor, err := c.elasticV7.RunBulkProcessor(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchBulkProcessor{
+		v7BulkProcessor: processor.(*v7BulkProcessor),
+	}, nil
+}
+
+func (v *openSearchBulkProcessor) Add(request *GenericBulkableAddRequest) {
+	v.processor.Add(newV7BulkableRequest(toOpenSearchBulkableAddRequest(request)))
+}
+
+// toOpenSearchBulkableAddRequest drops the mapping type, which is rejected by OpenSearch 2.x
+func toOpenSearchBulkableAddRequest(request *GenericBulkableAddRequest) *GenericBulkableAddRequest {
+	if request.Type == "" {
+		return request
+	}
+	req := *request
+	req.Type = ""
+	return &req
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OpenSearchBulkableRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *GenericBulkableAddRequest
+	}{
+		{
+			name: "index",
+			request: &GenericBulkableAddRequest{
+				Index:       "test-index",
+				Type:        "_doc",
+				ID:          "wid~rid",
+				VersionType: "external",
+				Version:     1,
+				Doc:         map[string]interface{}{KafkaKey: "1"},
+			},
+		},
+		{
+			name: "delete",
+			request: &GenericBulkableAddRequest{
+				Index:       "test-index",
+				Type:        "_doc",
+				ID:          "wid~rid",
+				VersionType: "external",
+				Version:     1,
+				IsDelete:    true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, err := newV7BulkableRequest(test.request).Source()
+			require.NoError(t, err)
+			require.Contains(t, source[0], `"_type":"_doc"`)
+
+			source, err = newV7BulkableRequest(toOpenSearchBulkableAddRequest(test.request)).Source()
+			require.NoError(t, err)
+			require.NotContains(t, source[0], `"_type":`)
+			require.Contains(t, source[0], `"_id":"wid~rid"`)
+			// the original request is left untouched
+			require.Equal(t, "_doc", test.request.Type)
+		})
+	}
+}
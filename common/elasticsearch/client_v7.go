@@ -521,24 +521,25 @@ func (v *v7BulkProcessor) Close() error {
 }
 
 func (v *v7BulkProcessor) Add(request *GenericBulkableAddRequest) {
-	var req elastic.BulkableRequest
+	v.processor.Add(newV7BulkableRequest(request))
+}
+
+func newV7BulkableRequest(request *GenericBulkableAddRequest) elastic.BulkableRequest {
 	if request.IsDelete {
-		req = elastic.NewBulkDeleteRequest().
+		return elastic.NewBulkDeleteRequest().
 			Index(request.Index).
 			Type(request.Type).
 			Id(request.ID).
 			VersionType(request.VersionType).
 			Version(request.Version)
-	} else {
-		req = elastic.NewBulkIndexRequest().
-			Index(request.Index).
-			Type(request.Type).
-			Id(request.ID).
-			VersionType(request.VersionType).
-			Version(request.Version).
-			Doc(request.Doc)
 	}
-	v.processor.Add(req)
+	return elastic.NewBulkIndexRequest().
+		Index(request.Index).
+		Type(request.Type).
+		Id(request.ID).
+		VersionType(request.VersionType).
+		Version(request.Version).
+		Doc(request.Doc)
 }
 
 func (v *v7BulkProcessor) Flush() error {
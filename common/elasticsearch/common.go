@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
 )
 
 const unknownStatusCode = -1
@@ -45,3 +46,23 @@ func buildTLSHTTPClient(config config.TLS) (*http.Client, error) {
 
 	return tlsClient, nil
 }
+
+// ToESDataType returns the ElasticSearch data type of the search attribute value type
+func ToESDataType(valueType types.IndexedValueType) string {
+	switch valueType {
+	case types.IndexedValueTypeString:
+		return "text"
+	case types.IndexedValueTypeKeyword:
+		return "keyword"
+	case types.IndexedValueTypeInt:
+		return "long"
+	case types.IndexedValueTypeDouble:
+		return "double"
+	case types.IndexedValueTypeBool:
+		return "boolean"
+	case types.IndexedValueTypeDatetime:
+		return "date"
+	default:
+		return ""
+	}
+}
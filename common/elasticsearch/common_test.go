@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestToESDataType(t *testing.T) {
+	tests := []struct {
+		input    types.IndexedValueType
+		expected string
+	}{
+		{
+			input:    types.IndexedValueTypeString,
+			expected: "text",
+		},
+		{
+			input:    types.IndexedValueTypeKeyword,
+			expected: "keyword",
+		},
+		{
+			input:    types.IndexedValueTypeInt,
+			expected: "long",
+		},
+		{
+			input:    types.IndexedValueTypeDouble,
+			expected: "double",
+		},
+		{
+			input:    types.IndexedValueTypeBool,
+			expected: "boolean",
+		},
+		{
+			input:    types.IndexedValueTypeDatetime,
+			expected: "date",
+		},
+		{
+			input:    types.IndexedValueType(-1),
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, ToESDataType(test.input))
+	}
+}
@@ -27,12 +27,29 @@ import (
 
 	"github.com/xwb1989/sqlparser"
 
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/types"
 )
 
+const (
+	maxGroupBySearchAttributes = 3
+)
+
+var (
+	// nonGroupBySearchAttributes are system search attributes which are (nearly) unique to every workflow
+	nonGroupBySearchAttributes = map[string]bool{
+		definition.DomainID:      true,
+		definition.WorkflowID:    true,
+		definition.RunID:         true,
+		definition.StartTime:     true,
+		definition.ExecutionTime: true,
+		definition.CloseTime:     true,
+	}
+)
+
 // VisibilityQueryValidator for sql query validation
 type VisibilityQueryValidator struct {
 	validSearchAttributes dynamicconfig.MapPropertyFn
@@ -191,6 +208,55 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 	return nil
 }
 
+// ValidateGroupBy validates that search attributes used to group a count are legal, i.e. registered
+// attributes which can be aggregated and are not unique to every workflow, like IDs and timestamps.
+// Adds attr prefix for customized fields and returns modified search attributes.
+func (qv *VisibilityQueryValidator) ValidateGroupBy(groupBy []string) ([]string, error) {
+	if len(groupBy) > maxGroupBySearchAttributes {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("at most %v search attributes can be used to group by", maxGroupBySearchAttributes),
+		}
+	}
+	validAttr := qv.validSearchAttributes()
+	result := make([]string, 0, len(groupBy))
+	seen := make(map[string]bool, len(groupBy))
+	for _, key := range groupBy {
+		valueType, ok := validAttr[key]
+		if !ok {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("invalid group by search attribute %q", key)}
+		}
+		if nonGroupBySearchAttributes[key] || !isGroupByValueType(valueType) {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("search attribute %q cannot be used to group by", key)}
+		}
+		if seen[key] {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("duplicated group by search attribute %q", key)}
+		}
+		seen[key] = true
+		if !definition.IsSystemIndexedKey(key) {
+			key = definition.Attr + "." + key
+		}
+		result = append(result, key)
+	}
+	return result, nil
+}
+
+// isGroupByValueType returns false for full text and datetime search attributes, values of the former
+// cannot be aggregated and the latter are practically unique
+func isGroupByValueType(valueType interface{}) bool {
+	var t shared.IndexedValueType
+	switch v := valueType.(type) {
+	case float64:
+		t = shared.IndexedValueType(v)
+	case int:
+		t = shared.IndexedValueType(v)
+	case shared.IndexedValueType:
+		t = v
+	default:
+		return false
+	}
+	return t != shared.IndexedValueTypeString && t != shared.IndexedValueTypeDatetime
+}
+
 // isValidSearchAttributes return true if key is registered
 func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
 	validAttr := qv.validSearchAttributes()
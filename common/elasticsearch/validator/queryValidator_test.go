@@ -141,3 +141,57 @@ func TestValidateQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGroupBy(t *testing.T) {
+	tests := []struct {
+		msg       string
+		groupBy   []string
+		validated []string
+		err       string
+	}{
+		{
+			msg:       "system and custom fields",
+			groupBy:   []string{"WorkflowType", "CloseStatus", "CustomKeywordField"},
+			validated: []string{"WorkflowType", "CloseStatus", "Attr.CustomKeywordField"},
+		},
+		{
+			msg:     "unknown field",
+			groupBy: []string{"UnknownField"},
+			err:     `BadRequestError{Message: invalid group by search attribute "UnknownField"}`,
+		},
+		{
+			msg:     "unique field",
+			groupBy: []string{"RunID"},
+			err:     `BadRequestError{Message: search attribute "RunID" cannot be used to group by}`,
+		},
+		{
+			msg:     "full text field",
+			groupBy: []string{"CustomStringField"},
+			err:     `BadRequestError{Message: search attribute "CustomStringField" cannot be used to group by}`,
+		},
+		{
+			msg:     "duplicated field",
+			groupBy: []string{"WorkflowType", "WorkflowType"},
+			err:     `BadRequestError{Message: duplicated group by search attribute "WorkflowType"}`,
+		},
+		{
+			msg:     "too many fields",
+			groupBy: []string{"WorkflowType", "CloseStatus", "TaskList", "IsCron"},
+			err:     "BadRequestError{Message: at most 3 search attributes can be used to group by}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+			qv := NewQueryValidator(validSearchAttr)
+			validated, err := qv.ValidateGroupBy(tt.groupBy)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.validated, validated)
+			}
+		})
+	}
+}
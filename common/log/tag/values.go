@@ -34,6 +34,8 @@ var (
 	// workflow cancellation / sign
 	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 
@@ -58,6 +60,8 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskOptionsUpdated  = workflowAction("add-activitytask-options-updated-event")
+	WorkflowActionActivityTaskRetryRequested  = workflowAction("add-activitytask-retry-requested-event")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -131,6 +135,8 @@ var (
 	ComponentCrossClusterTaskFetcher    = component("cross-cluster-task-fetcher")
 	ComponentShardScanner               = component("shardscanner-scanner")
 	ComponentShardFixer                 = component("shardscanner-fixer")
+	ComponentVisibilityMigration        = component("visibility-migration")
+	ComponentReplicationVerifier        = component("replication-verifier")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -143,6 +149,7 @@ var (
 	LifeCycleStartFailed      = lifecycle("StartFailed")
 	LifeCycleStopFailed       = lifecycle("StopFailed")
 	LifeCycleProcessingFailed = lifecycle("ProcessingFailed")
+	LifeCycleHandedOff        = lifecycle("HandedOff")
 )
 
 // Pre-defined values for SysErrorType
@@ -291,14 +298,18 @@ var (
 	AdminClientOperationReadDLQMessages                   = clientOperation("admin-read-dlq-messsages")
 	AdminClientOperationPurgeDLQMessages                  = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                  = clientOperation("admin-merge-dlq-messsages")
+	AdminClientOperationOperatePendingActivity            = clientOperation("admin-operate-pending-activity")
 	AdminClientOperationRefreshWorkflowTasks              = clientOperation("admin-refresh-wf-tasks")
 	AdminClientOperationResendReplicationTasks            = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks              = clientOperation("admin-get-cross-cluster-tasks")
+	AdminClientOperationGetReplicationStatus              = clientOperation("admin-get-replication-status")
+	AdminClientOperationGetFailoverReadiness              = clientOperation("admin-get-failover-readiness")
 	AdminClientOperationRespondCrossClusterTasksCompleted = clientOperation("admin-respond-cross-cluster-tasks-completed")
 	AdminClientOperationGetDynamicConfig                  = clientOperation("admin-get-dynamic-config")
 	AdminClientOperationUpdateDynamicConfig               = clientOperation("admin-update-dynamic-config")
 	AdminClientOperationRestoreDynamicConfig              = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
+	AdminClientOperationMoveTaskList                      = clientOperation("admin-move-task-list")
 
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
@@ -335,6 +346,8 @@ var (
 	FrontendClientOperationSignalWorkflowExecution          = clientOperation("frontend-signal-wf-execution")
 	FrontendClientOperationStartWorkflowExecution           = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationTerminateWorkflowExecution       = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution           = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution         = clientOperation("frontend-unpause-wf-execution")
 	FrontendClientOperationUpdateDomain                     = clientOperation("frontend-update-domain")
 	FrontendClientOperationGetClusterInfo                   = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions           = clientOperation("frontend-list-task-list-partitions")
@@ -364,6 +377,8 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
@@ -377,9 +392,12 @@ var (
 	HistoryClientOperationReadDLQMessages                   = clientOperation("history-read-dlq-messages")
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
+	HistoryClientOperationOperatePendingActivity            = clientOperation("history-operate-pending-activity")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
+	HistoryClientOperationGetReplicationStatus              = clientOperation("history-get-replication-status")
+	HistoryClientOperationGetReplicationReadiness           = clientOperation("history-get-replication-readiness")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
 	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
 
@@ -390,9 +408,11 @@ var (
 	MatchingClientOperationQueryWorkflow          = clientOperation("matching-query-wf")
 	MatchingClientOperationQueryTaskCompleted     = clientOperation("matching-query-task-completed")
 	MatchingClientOperationCancelOutstandingPoll  = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationReleaseOutstandingTask = clientOperation("matching-release-outstanding-task")
 	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
 	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationMoveTaskList           = clientOperation("matching-move-task-list")
 )
 
 // Pre-defined values for TagIDType
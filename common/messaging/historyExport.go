@@ -0,0 +1,82 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// HistoryExportModeDisabled disables the history export of a domain
+	HistoryExportModeDisabled = ""
+	// HistoryExportModeBatch exports every persisted history batch of a domain
+	HistoryExportModeBatch = "batch"
+	// HistoryExportModeLifecycle exports a compact projection of the workflow started,
+	// workflow closed and activity failed events of a domain
+	HistoryExportModeLifecycle = "lifecycle"
+)
+
+type (
+	// HistoryExportMessage is published to the history export topic for each exported history batch.
+	// Messages are delivered at least once, consumers can deduplicate them using the run ID and event IDs.
+	HistoryExportMessage struct {
+		DomainID     string `json:"domainID"`
+		Domain       string `json:"domain"`
+		WorkflowID   string `json:"workflowID"`
+		RunID        string `json:"runID"`
+		FirstEventID int64  `json:"firstEventID"`
+		NextEventID  int64  `json:"nextEventID"`
+		// Events is set when the domain exports in batch mode
+		Events []*types.HistoryEvent `json:"events,omitempty"`
+		// LifecycleEvents is set when the domain exports in lifecycle mode
+		LifecycleEvents []*HistoryExportLifecycleEvent `json:"lifecycleEvents,omitempty"`
+	}
+
+	// HistoryExportLifecycleEvent is the compact projection of a workflow lifecycle event
+	HistoryExportLifecycleEvent struct {
+		EventID          int64           `json:"eventID"`
+		EventType        types.EventType `json:"eventType"`
+		Timestamp        int64           `json:"timestamp"`
+		Version          int64           `json:"version"`
+		WorkflowType     string          `json:"workflowType,omitempty"`
+		TaskList         string          `json:"taskList,omitempty"`
+		ScheduledEventID int64           `json:"scheduledEventID,omitempty"`
+		FailureReason    string          `json:"failureReason,omitempty"`
+	}
+)
+
+// IsHistoryExportLifecycleEvent returns true if events of the type are exported in lifecycle mode
+func IsHistoryExportLifecycleEvent(eventType types.EventType) bool {
+	switch eventType {
+	case types.EventTypeWorkflowExecutionStarted,
+		types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+		types.EventTypeWorkflowExecutionCanceled,
+		types.EventTypeWorkflowExecutionTerminated,
+		types.EventTypeWorkflowExecutionTimedOut,
+		types.EventTypeWorkflowExecutionContinuedAsNew,
+		types.EventTypeActivityTaskFailed,
+		types.EventTypeActivityTaskTimedOut:
+		return true
+	default:
+		return false
+	}
+}
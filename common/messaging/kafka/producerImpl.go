@@ -22,6 +22,7 @@ package kafka
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/Shopify/sarama"
@@ -104,6 +105,18 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *messaging.HistoryExportMessage:
+		payload, err := json.Marshal(message)
+		if err != nil {
+			p.logger.Error("Failed to serialize history export message", tag.Error(err))
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.WorkflowID),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	case *sarama.ConsumerMessage:
 		msg := &sarama.ProducerMessage{
 			Topic: p.topic,
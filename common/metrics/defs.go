@@ -345,6 +345,10 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -371,12 +375,18 @@ const (
 	HistoryClientPurgeDLQMessagesScope
 	// HistoryClientMergeDLQMessagesScope tracks RPC calls to history service
 	HistoryClientMergeDLQMessagesScope
+	// HistoryClientOperatePendingActivityScope tracks RPC calls to history service
+	HistoryClientOperatePendingActivityScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
 	HistoryClientGetCrossClusterTasksScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientGetReplicationReadinessScope tracks RPC calls to history service
+	HistoryClientGetReplicationReadinessScope
 	// HistoryClientRespondCrossClusterTasksCompletedScope tracks RPC calls to history service
 	HistoryClientRespondCrossClusterTasksCompletedScope
 	// HistoryClientGetFailoverInfoScope tracks RPC calls to history service
@@ -395,12 +405,16 @@ const (
 	MatchingClientRespondQueryTaskCompletedScope
 	// MatchingClientCancelOutstandingPollScope tracks RPC calls to matching service
 	MatchingClientCancelOutstandingPollScope
+	// MatchingClientReleaseOutstandingTaskScope tracks RPC calls to matching service
+	MatchingClientReleaseOutstandingTaskScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
 	// MatchingClientListTaskListPartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientMoveTaskListScope tracks RPC calls to matching service
+	MatchingClientMoveTaskListScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -467,6 +481,10 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -517,12 +535,18 @@ const (
 	AdminClientPurgeDLQMessagesScope
 	// AdminClientMergeDLQMessagesScope tracks RPC calls to admin service
 	AdminClientMergeDLQMessagesScope
+	// AdminClientOperatePendingActivityScope tracks RPC calls to admin service
+	AdminClientOperatePendingActivityScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
 	AdminClientGetCrossClusterTasksScope
+	// AdminClientGetReplicationStatusScope tracks RPC calls to Admin service
+	AdminClientGetReplicationStatusScope
+	// AdminClientGetFailoverReadinessScope tracks RPC calls to Admin service
+	AdminClientGetFailoverReadinessScope
 	// AdminClientRespondCrossClusterTasksCompletedScope tracks RPC calls to Admin service
 	AdminClientRespondCrossClusterTasksCompletedScope
 	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
@@ -533,6 +557,8 @@ const (
 	AdminClientRestoreDynamicConfigScope
 	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
 	AdminClientListDynamicConfigScope
+	// AdminClientMoveTaskListScope tracks RPC calls to admin service
+	AdminClientMoveTaskListScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -607,6 +633,10 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
@@ -739,6 +769,8 @@ const (
 	AdminGetDLQReplicationMessagesScope
 	// AdminReapplyEventsScope is the metric scope for admin.ReapplyEvents
 	AdminReapplyEventsScope
+	// AdminOperatePendingActivityScope is the metric scope for admin.OperatePendingActivity
+	AdminOperatePendingActivityScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
@@ -761,6 +793,10 @@ const (
 	AdminDescribeShardDistributionScope
 	// AdminGetCrossClusterTasksScope is the metric scope for admin.GetCrossClusterTasks
 	AdminGetCrossClusterTasksScope
+	// AdminGetReplicationStatusScope is the metric scope for admin.GetReplicationStatus
+	AdminGetReplicationStatusScope
+	// AdminGetFailoverReadinessScope is the metric scope for admin.GetFailoverReadiness
+	AdminGetFailoverReadinessScope
 	// AdminRespondCrossClusterTasksCompletedScope is the metric scope for admin.AdminRespondCrossClusterTasksCompleted
 	AdminRespondCrossClusterTasksCompletedScope
 	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
@@ -771,6 +807,8 @@ const (
 	AdminRestoreDynamicConfigScope
 	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
 	AdminListDynamicConfigScope
+	// AdminMoveTaskListScope is the metric scope for admin.MoveTaskList
+	AdminMoveTaskListScope
 
 	NumAdminScopes
 )
@@ -817,6 +855,10 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
@@ -903,6 +945,10 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -935,12 +981,18 @@ const (
 	HistoryShardControllerScope
 	// HistoryReapplyEventsScope tracks ReapplyEvents API calls received by service
 	HistoryReapplyEventsScope
+	// HistoryOperatePendingActivityScope tracks OperatePendingActivity API calls received by service
+	HistoryOperatePendingActivityScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
 	HistoryGetCrossClusterTasksScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
+	// HistoryGetReplicationReadinessScope tracks GetReplicationReadiness API calls received by service
+	HistoryGetReplicationReadinessScope
 	// HistoryRespondCrossClusterTasksCompletedScope tracks RespondCrossClusterTasksCompleted API calls received by service
 	HistoryRespondCrossClusterTasksCompletedScope
 	// HistoryGetFailoverInfoScope tracks HistoryGetFailoverInfo API calls received by service
@@ -977,6 +1029,10 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferActiveTaskCompletionCallbackScope
+	// TransferActiveTaskHistoryExportScope is the scope used for history export task processing by transfer queue processor
+	TransferActiveTaskHistoryExportScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1001,6 +1057,10 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferStandbyTaskCompletionCallbackScope
+	// TransferStandbyTaskHistoryExportScope is the scope used for history export task processing by transfer queue processor
+	TransferStandbyTaskHistoryExportScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -1021,6 +1081,8 @@ const (
 	TimerActiveTaskWorkflowBackoffTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
+	// TimerActiveTaskCompletionCallbackRetryTimerScope is the scope used by metric emitted by timer queue processor for processing completion callback retries
+	TimerActiveTaskCompletionCallbackRetryTimerScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
 	TimerStandbyTaskActivityTimeoutScope
 	// TimerStandbyTaskDecisionTimeoutScope is the scope used by metric emitted by timer queue processor for processing decision timeouts
@@ -1035,6 +1097,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskCompletionCallbackRetryTimerScope is the scope used by metric emitted by timer queue processor for processing completion callback retries
+	TimerStandbyTaskCompletionCallbackRetryTimerScope
 	// CrossClusterQueueProcessorScope is the scope used by all metric emitted by cross cluster queue processor in the source cluster
 	CrossClusterQueueProcessorScope
 	// CrossClusterTaskProcessorScope is the scope used by all metric emitted by cross cluster task processor in the target cluster
@@ -1119,6 +1183,8 @@ const (
 	HistoryReplicationV2TaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// SyncCompletionCallbacksTaskScope is the scope used by sync completion callbacks information processing
+	SyncCompletionCallbacksTaskScope
 
 	NumHistoryScopes
 )
@@ -1141,12 +1207,16 @@ const (
 	MatchingRespondQueryTaskCompletedScope
 	// MatchingCancelOutstandingPollScope tracks CancelOutstandingPoll API calls received by service
 	MatchingCancelOutstandingPollScope
+	// MatchingReleaseOutstandingTaskScope tracks ReleaseOutstandingTask API calls received by service
+	MatchingReleaseOutstandingTaskScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
 	// MatchingListTaskListPartitionsScope tracks ListTaskListPartitions API calls received by service
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingMoveTaskListScope tracks MoveTaskList API calls received by service
+	MatchingMoveTaskListScope
 
 	NumMatchingScopes
 )
@@ -1191,6 +1261,10 @@ const (
 	CheckDataCorruptionWorkflowScope
 	// ESAnalyzerScope is scope used by ElasticSearch Analyzer (esanalyzer) workflow
 	ESAnalyzerScope
+	// VisibilityMigrationBackfillScope is scope used by the visibility migration backfill workflow
+	VisibilityMigrationBackfillScope
+	// ReplicationVerifierScope is scope used by the replication verifier workflow
+	ReplicationVerifierScope
 
 	NumWorkerScopes
 )
@@ -1313,6 +1387,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:              {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:                {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:       {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1326,9 +1402,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientReadDLQMessagesScope:                     {operation: "HistoryClientReadDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientOperatePendingActivityScope:              {operation: "HistoryClientOperatePendingActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:               {operation: "HistoryClientNotifyFailoverMarkersScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:                {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:                {operation: "HistoryClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationReadinessScope:             {operation: "HistoryClientGetReplicationReadiness", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope:   {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetFailoverInfoScope:                     {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1338,9 +1417,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientQueryWorkflowScope:                      {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientRespondQueryTaskCompletedScope:          {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientReleaseOutstandingTaskScope:             {operation: "MatchingClientReleaseOutstandingTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientMoveTaskListScope:                       {operation: "MatchingClientMoveTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1374,6 +1455,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:            {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:             {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:         {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:             {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:           {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                       {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:             {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:             {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1392,6 +1475,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeWorkflowExecutionScope:             {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientOperatePendingActivityScope:                {operation: "AdminClientOperatePendingActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1402,11 +1486,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientMergeDLQMessagesScope:                      {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetCrossClusterTasksScope:                  {operation: "AdminClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetReplicationStatusScope:                  {operation: "AdminClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetFailoverReadinessScope:                  {operation: "AdminClientGetFailoverReadiness", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRespondCrossClusterTasksCompletedScope:     {operation: "AdminClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRestoreDynamicConfigScope:                  {operation: "AdminClientRestoreDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMoveTaskListScope:                          {operation: "AdminClientMoveTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                     {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                      {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                    {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1444,6 +1531,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWorkflowExecutionScope:             {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:              {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:              {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:            {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                        {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:              {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1511,14 +1600,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDomainReplicationMessagesScope:      {operation: "GetDomainReplicationMessages"},
 		AdminGetDLQReplicationMessagesScope:         {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                     {operation: "ReapplyEvents"},
+		AdminOperatePendingActivityScope:            {operation: "OperatePendingActivity"},
 		AdminRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
+		AdminGetReplicationStatusScope:              {operation: "AdminGetReplicationStatus"},
+		AdminGetFailoverReadinessScope:              {operation: "AdminGetFailoverReadiness"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
 		AdminGetDynamicConfigScope:                  {operation: "AdminGetDynamicConfig"},
 		AdminUpdateDynamicConfigScope:               {operation: "AdminUpdateDynamicConfig"},
 		AdminRestoreDynamicConfigScope:              {operation: "AdminRestoreDynamicConfig"},
 		AdminListDynamicConfigScope:                 {operation: "AdminListDynamicConfig"},
+		AdminMoveTaskListScope:                      {operation: "AdminMoveTaskList"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1540,6 +1633,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:            {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope:   {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:         {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:             {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:           {operation: "UnpauseWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:             {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:     {operation: "RequestCancelWorkflowExecution"},
 		FrontendListArchivedWorkflowExecutionsScope:     {operation: "ListArchivedWorkflowExecutions"},
@@ -1583,6 +1678,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                              {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                            {operation: "UnpauseWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
@@ -1602,9 +1699,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryMergeDLQMessagesScope:                                    {operation: "MergeDLQMessages"},
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
+		HistoryOperatePendingActivityScope:                              {operation: "OperatePendingActivity"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
+		HistoryGetReplicationStatusScope:                                {operation: "GetReplicationStatus"},
+		HistoryGetReplicationReadinessScope:                             {operation: "GetReplicationReadiness"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
 		HistoryGetFailoverInfoScope:                                     {operation: "GetFailoverInfo"},
 		TaskPriorityAssignerScope:                                       {operation: "TaskPriorityAssigner"},
@@ -1623,6 +1723,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskCompletionCallbackScope:                       {operation: "TransferActiveTaskCompletionCallback"},
+		TransferActiveTaskHistoryExportScope:                            {operation: "TransferActiveTaskHistoryExport"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -1635,6 +1737,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskCompletionCallbackScope:                      {operation: "TransferStandbyTaskCompletionCallback"},
+		TransferStandbyTaskHistoryExportScope:                           {operation: "TransferStandbyTaskHistoryExport"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:                                 {operation: "TimerStandbyQueueProcessor"},
@@ -1645,6 +1749,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskActivityRetryTimerScope:                          {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:                        {operation: "TimerActiveTaskWorkflowBackoffTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                          {operation: "TimerActiveTaskDeleteHistoryEvent"},
+		TimerActiveTaskCompletionCallbackRetryTimerScope:                {operation: "TimerActiveTaskCompletionCallbackRetryTimer"},
 		TimerStandbyTaskActivityTimeoutScope:                            {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                            {operation: "TimerStandbyTaskDecisionTimeout"},
 		TimerStandbyTaskUserTimerScope:                                  {operation: "TimerStandbyTaskUserTimer"},
@@ -1652,6 +1757,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskActivityRetryTimerScope:                         {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:                       {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                         {operation: "TimerStandbyTaskDeleteHistoryEvent"},
+		TimerStandbyTaskCompletionCallbackRetryTimerScope:               {operation: "TimerStandbyTaskCompletionCallbackRetryTimer"},
 		CrossClusterQueueProcessorScope:                                 {operation: "CrossClusterQueueProcessor"},
 		CrossClusterTaskProcessorScope:                                  {operation: "CrossClusterTaskProcessor"},
 		CrossClusterTaskFetcherScope:                                    {operation: "CrossClusterTaskFetcher"},
@@ -1691,6 +1797,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FailoverMarkerScope:                                             {operation: "FailoverMarker"},
 		HistoryReplicationV2TaskScope:                                   {operation: "HistoryReplicationV2Task"},
 		SyncActivityTaskScope:                                           {operation: "SyncActivityTask"},
+		SyncCompletionCallbacksTaskScope:                                {operation: "SyncCompletionCallbacksTask"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -1702,9 +1809,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
+		MatchingReleaseOutstandingTaskScope:    {operation: "ReleaseOutstandingTask"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingMoveTaskListScope:              {operation: "MoveTaskList"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1727,6 +1836,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
+		VisibilityMigrationBackfillScope:       {operation: "VisibilityMigrationBackfill"},
+		ReplicationVerifierScope:               {operation: "ReplicationVerifier"},
 	},
 }
 
@@ -1781,6 +1892,14 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	VisibilityMigrationDualWriteFailures
+	VisibilityMigrationShadowReadCounter
+	VisibilityMigrationShadowReadFailures
+	VisibilityMigrationShadowReadMismatchCounter
+	VisibilityMigrationShadowReadDroppedCounter
+	PayloadOffloadCounter
+	PayloadOffloadFailures
+	PayloadRehydrateFailures
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -1956,9 +2075,12 @@ const (
 	CrossClusterTaskRespondFailures
 	CrossClusterTaskFetchedTimer
 	CrossClusterTaskPendingTimer
+	CrossClusterTaskLatency
 
 	ActivityE2ELatency
 	ActivityLostCounter
+	ActivityLocalDispatchCounter
+	ActivityLocalDispatchFallbackCounter
 	AckLevelUpdateCounter
 	AckLevelUpdateFailedCounter
 	DecisionTypeScheduleActivityCounter
@@ -1976,7 +2098,13 @@ const (
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
 	EmptyCompletionDecisionsCounter
 	MultipleCompletionDecisionsCounter
+	PendingLimitExceededDecisionsCounter
 	FailedDecisionsCounter
+	CompletionCallbackSucceededCounter
+	CompletionCallbackFailedCounter
+	CompletionCallbackGiveUpCounter
+	CompletionCallbackLatency
+	HistoryExportEventsCounter
 	DecisionAttemptTimer
 	DecisionRetriesExceededCounter
 	StaleMutableStateCounter
@@ -2000,6 +2128,9 @@ const (
 	ShardItemCreatedCounter
 	ShardItemRemovedCounter
 	ShardItemAcquisitionLatency
+	ShardHandoffCounter
+	ShardHandoffLatency
+	ShardHandoffTimeoutCounter
 	ShardInfoReplicationPendingTasksTimer
 	ShardInfoTransferActivePendingTasksTimer
 	ShardInfoTransferStandbyPendingTasksTimer
@@ -2072,6 +2203,7 @@ const (
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
 	WorkflowCleanupDeleteHistoryInlineCount
+	WorkflowCleanupDeletePayloadCount
 	WorkflowSuccessCount
 	WorkflowCancelCount
 	WorkflowFailedCount
@@ -2100,6 +2232,15 @@ const (
 	ReplicationDLQProbeFailed
 	ReplicationDLQSize
 	ReplicationDLQValidationFailed
+	ReplicationDLQRetryCount
+	ReplicationDLQRetrySuccess
+	ReplicationDLQRetryFailed
+	ReplicationDLQPoisonTasks
+	ReplicationDLQRetrySkipped
+	ReplicationFrames
+	ReplicationFrameUncompressedBytes
+	ReplicationFrameCompressedBytes
+	ReplicationFrameCompressionRatio
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
@@ -2141,6 +2282,8 @@ const (
 	PollSuccessWithSyncPerTaskListCounter
 	LeaseRequestPerTaskListCounter
 	LeaseFailurePerTaskListCounter
+	HandoffLatencyPerTaskList
+	HandoffFailurePerTaskListCounter
 	ConditionFailedErrorPerTaskListCounter
 	RespondQueryTaskFailedPerTaskListCounter
 	SyncThrottlePerTaskListCounter
@@ -2163,8 +2306,13 @@ const (
 	RemoteToLocalMatchPerTaskListCounter
 	RemoteToRemoteMatchPerTaskListCounter
 	PollerPerTaskListCounter
+	OutstandingLimitPerTaskListCounter
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
+	TaskBacklogPerTaskListGauge
+	TaskBacklogAgePerTaskListGauge
+	TaskDispatchRatePerTaskListGauge
+	SyncMatchRatioPerTaskListGauge
 
 	NumMatchingMetrics
 )
@@ -2247,6 +2395,12 @@ const (
 	ESAnalyzerNumStuckWorkflowsRefreshed
 	ESAnalyzerNumStuckWorkflowsFailedToRefresh
 	ESAnalyzerNumLongRunningWorkflows
+	VisibilityMigrationBackfillRecords
+	VisibilityMigrationBackfillFailures
+	ReplicationVerifierWorkflowsVerified
+	ReplicationVerifierDivergences
+	ReplicationVerifierResends
+	ReplicationVerifierFailures
 
 	NumWorkerMetrics
 )
@@ -2303,6 +2457,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		VisibilityMigrationDualWriteFailures:                {metricName: "visibility_migration_dual_write_errors", metricType: Counter},
+		VisibilityMigrationShadowReadCounter:                {metricName: "visibility_migration_shadow_reads", metricType: Counter},
+		VisibilityMigrationShadowReadFailures:               {metricName: "visibility_migration_shadow_read_errors", metricType: Counter},
+		VisibilityMigrationShadowReadMismatchCounter:        {metricName: "visibility_migration_shadow_read_mismatches", metricType: Counter},
+		VisibilityMigrationShadowReadDroppedCounter:         {metricName: "visibility_migration_shadow_reads_dropped", metricType: Counter},
+		PayloadOffloadCounter:                               {metricName: "payload_offload", metricType: Counter},
+		PayloadOffloadFailures:                              {metricName: "payload_offload_errors", metricType: Counter},
+		PayloadRehydrateFailures:                            {metricName: "payload_rehydrate_errors", metricType: Counter},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
@@ -2493,8 +2655,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CrossClusterTaskRespondFailures:                   {metricName: "cross_cluster_fetch_errors", metricType: Counter},
 		CrossClusterTaskFetchedTimer:                      {metricName: "cross_cluster_task_fetched", metricType: Timer},
 		CrossClusterTaskPendingTimer:                      {metricName: "cross_cluster_task_pending", metricType: Timer},
+		CrossClusterTaskLatency:                           {metricName: "cross_cluster_task_latency", metricType: Timer},
 		ActivityE2ELatency:                                {metricName: "activity_end_to_end_latency", metricType: Timer},
 		ActivityLostCounter:                               {metricName: "activity_lost", metricType: Counter},
+		ActivityLocalDispatchCounter:                      {metricName: "activity_local_dispatch", metricType: Counter},
+		ActivityLocalDispatchFallbackCounter:              {metricName: "activity_local_dispatch_fallback", metricType: Counter},
 		AckLevelUpdateCounter:                             {metricName: "ack_level_update", metricType: Counter},
 		AckLevelUpdateFailedCounter:                       {metricName: "ack_level_update_failed", metricType: Counter},
 		DecisionTypeScheduleActivityCounter:               {metricName: "schedule_activity_decision", metricType: Counter},
@@ -2512,7 +2677,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
 		EmptyCompletionDecisionsCounter:                   {metricName: "empty_completion_decisions", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
+		PendingLimitExceededDecisionsCounter:              {metricName: "pending_limit_exceeded_decisions", metricType: Counter},
 		FailedDecisionsCounter:                            {metricName: "failed_decisions", metricType: Counter},
+		CompletionCallbackSucceededCounter:                {metricName: "completion_callback_succeeded", metricType: Counter},
+		CompletionCallbackFailedCounter:                   {metricName: "completion_callback_failed", metricType: Counter},
+		CompletionCallbackGiveUpCounter:                   {metricName: "completion_callback_give_up", metricType: Counter},
+		CompletionCallbackLatency:                         {metricName: "completion_callback_latency", metricType: Timer},
+		HistoryExportEventsCounter:                        {metricName: "history_export_events", metricType: Counter},
 		DecisionAttemptTimer:                              {metricName: "decision_attempt", metricType: Timer},
 		DecisionRetriesExceededCounter:                    {metricName: "decision_retries_exceeded", metricType: Counter},
 		StaleMutableStateCounter:                          {metricName: "stale_mutable_state", metricType: Counter},
@@ -2536,6 +2707,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardItemCreatedCounter:                           {metricName: "sharditem_created_count", metricType: Counter},
 		ShardItemRemovedCounter:                           {metricName: "sharditem_removed_count", metricType: Counter},
 		ShardItemAcquisitionLatency:                       {metricName: "sharditem_acquisition_latency", metricType: Timer},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffLatency:                               {metricName: "shard_handoff_latency", metricType: Timer},
+		ShardHandoffTimeoutCounter:                        {metricName: "shard_handoff_timeout_count", metricType: Counter},
 		ShardInfoReplicationPendingTasksTimer:             {metricName: "shardinfo_replication_pending_task", metricType: Timer},
 		ShardInfoTransferActivePendingTasksTimer:          {metricName: "shardinfo_transfer_active_pending_task", metricType: Timer},
 		ShardInfoTransferStandbyPendingTasksTimer:         {metricName: "shardinfo_transfer_standby_pending_task", metricType: Timer},
@@ -2604,6 +2778,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
 		WorkflowCleanupDeleteHistoryInlineCount:           {metricName: "workflow_cleanup_delete_history_inline", metricType: Counter},
+		WorkflowCleanupDeletePayloadCount:                 {metricName: "workflow_cleanup_delete_payload", metricType: Counter},
 		WorkflowSuccessCount:                              {metricName: "workflow_success", metricType: Counter},
 		WorkflowCancelCount:                               {metricName: "workflow_cancel", metricType: Counter},
 		WorkflowFailedCount:                               {metricName: "workflow_failed", metricType: Counter},
@@ -2632,6 +2807,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationDLQProbeFailed:                         {metricName: "replication_dlq_probe_failed", metricType: Counter},
 		ReplicationDLQSize:                                {metricName: "replication_dlq_size", metricType: Gauge},
 		ReplicationDLQValidationFailed:                    {metricName: "replication_dlq_validation_failed", metricType: Counter},
+		ReplicationDLQRetryCount:                          {metricName: "replication_dlq_retry_count", metricType: Counter},
+		ReplicationDLQRetrySuccess:                        {metricName: "replication_dlq_retry_success", metricType: Counter},
+		ReplicationDLQRetryFailed:                         {metricName: "replication_dlq_retry_failed", metricType: Counter},
+		ReplicationDLQPoisonTasks:                         {metricName: "replication_dlq_poison_tasks", metricType: Gauge},
+		ReplicationDLQRetrySkipped:                        {metricName: "replication_dlq_retry_skipped", metricType: Counter},
+		ReplicationFrames:                                 {metricName: "replication_frames", metricType: Counter},
+		ReplicationFrameUncompressedBytes:                 {metricName: "replication_frame_uncompressed_bytes", metricType: Counter},
+		ReplicationFrameCompressedBytes:                   {metricName: "replication_frame_compressed_bytes", metricType: Counter},
+		ReplicationFrameCompressionRatio:                  {metricName: "replication_frame_compression_ratio", metricType: Gauge},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                  {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                          {metricName: "event_reapply_skipped_count", metricType: Counter},
@@ -2673,6 +2857,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PollSuccessWithSyncPerTaskListCounter:    {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
 		LeaseRequestPerTaskListCounter:           {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
 		LeaseFailurePerTaskListCounter:           {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
+		HandoffLatencyPerTaskList:                {metricName: "handoff_latency_per_tl", metricRollupName: "handoff_latency", metricType: Timer},
+		HandoffFailurePerTaskListCounter:         {metricName: "handoff_failures_per_tl", metricRollupName: "handoff_failures"},
 		ConditionFailedErrorPerTaskListCounter:   {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
 		RespondQueryTaskFailedPerTaskListCounter: {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
 		SyncThrottlePerTaskListCounter:           {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
@@ -2695,8 +2881,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		RemoteToLocalMatchPerTaskListCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
 		RemoteToRemoteMatchPerTaskListCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
 		PollerPerTaskListCounter:                 {metricName: "poller_count_per_tl", metricRollupName: "poller_count"},
+		OutstandingLimitPerTaskListCounter:       {metricName: "outstanding_limit_per_tl", metricRollupName: "outstanding_limit"},
 		TaskListManagersGauge:                    {metricName: "tasklist_managers", metricType: Gauge},
 		TaskLagPerTaskListGauge:                  {metricName: "task_lag_per_tl", metricType: Gauge},
+		TaskBacklogPerTaskListGauge:              {metricName: "task_backlog_per_tl", metricType: Gauge},
+		TaskBacklogAgePerTaskListGauge:           {metricName: "task_backlog_age_per_tl", metricType: Gauge},
+		TaskDispatchRatePerTaskListGauge:         {metricName: "task_dispatch_rate_per_tl", metricType: Gauge},
+		SyncMatchRatioPerTaskListGauge:           {metricName: "sync_match_ratio_per_tl", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2775,6 +2966,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESAnalyzerNumStuckWorkflowsRefreshed:          {metricName: "es_analyzer_num_stuck_workflows_refreshed", metricType: Counter},
 		ESAnalyzerNumStuckWorkflowsFailedToRefresh:    {metricName: "es_analyzer_num_stuck_workflows_failed_to_refresh", metricType: Counter},
 		ESAnalyzerNumLongRunningWorkflows:             {metricName: "es_analyzer_num_long_running_workflows", metricType: Counter},
+		VisibilityMigrationBackfillRecords:            {metricName: "visibility_migration_backfill_records", metricType: Counter},
+		VisibilityMigrationBackfillFailures:           {metricName: "visibility_migration_backfill_errors", metricType: Counter},
+		ReplicationVerifierWorkflowsVerified:          {metricName: "replication_verifier_workflows_verified", metricType: Counter},
+		ReplicationVerifierDivergences:                {metricName: "replication_verifier_divergences", metricType: Counter},
+		ReplicationVerifierResends:                    {metricName: "replication_verifier_resends", metricType: Counter},
+		ReplicationVerifierFailures:                   {metricName: "replication_verifier_errors", metricType: Counter},
 	},
 }
 
@@ -47,6 +47,8 @@ const (
 	transport              = "transport"
 	caller                 = "caller"
 	signalName             = "signalName"
+	errorClass             = "errorClass"
+	divergenceType         = "divergence_type"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -174,3 +176,13 @@ func SignalNameTag(value string) Tag {
 func SignalNameAllTag() Tag {
 	return metricWithUnknown(signalName, allValue)
 }
+
+// ErrorClassTag returns a new error class tag
+func ErrorClassTag(value string) Tag {
+	return metricWithUnknown(errorClass, value)
+}
+
+// DivergenceTypeTag returns a new replication divergence type tag
+func DivergenceTypeTag(value string) Tag {
+	return metricWithUnknown(divergenceType, value)
+}
@@ -86,6 +86,10 @@ type (
 		MessagingClient   messaging.Client
 		ESClient          es.GenericClient
 		ESConfig          *config.ElasticSearchConfig
+		// MigrationTargetESClient and MigrationTargetESConfig are set if advanced visibility records
+		// are migrated to another ElasticSearch store
+		MigrationTargetESClient es.GenericClient
+		MigrationTargetESConfig *config.ElasticSearchConfig
 	}
 )
 
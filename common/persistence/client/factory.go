@@ -82,7 +82,9 @@ type (
 		// NewVisibilityStore returns a new visibility store,
 		// TODO We temporarily using sortByCloseTime to determine whether or not ListClosedWorkflowExecutions should
 		// be ordering by CloseTime. This will be removed when implementing https://github.com/uber/cadence/issues/3621
-		NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error)
+		// validSearchAttributes returns the types of custom search attributes, it may be nil if the service
+		// never writes nor queries them
+		NewVisibilityStore(sortByCloseTime bool, validSearchAttributes dynamicconfig.MapPropertyFn) (p.VisibilityStore, error)
 		NewQueue(queueType p.QueueType) (p.Queue, error)
 		// NewConfigStore returns a new config store
 		NewConfigStore() (p.ConfigStore, error)
@@ -263,7 +265,7 @@ func (f *factoryImpl) NewVisibilityManager(
 		// No need to create visibility manager as no read/write needed
 		return nil, nil
 	}
-	var visibilityFromDB, visibilityFromES p.VisibilityManager
+	var visibilityFromDB, visibilityFromES, visibilityFromESTarget p.VisibilityManager
 	var err error
 	if params.PersistenceConfig.VisibilityStore != "" {
 		visibilityFromDB, err = f.newDBVisibilityManager(resourceConfig)
@@ -281,13 +283,47 @@ func (f *factoryImpl) NewVisibilityManager(
 			visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, f.logger,
 		)
 	}
-	return p.NewVisibilityDualManager(
+	if params.PersistenceConfig.AdvancedVisibilityMigrationTargetStore != "" && visibilityFromES != nil {
+		targetIndexName := params.MigrationTargetESConfig.Indices[common.VisibilityAppName]
+		targetProducer, err := params.MessagingClient.NewProducer(common.VisibilityMigrationTargetAppName)
+		if err != nil {
+			f.logger.Fatal("Creating visibility migration target producer failed", tag.Error(err))
+		}
+		visibilityFromESTarget = newESVisibilityManager(
+			targetIndexName, params.MigrationTargetESClient, resourceConfig, targetProducer, params.MetricsClient, f.logger,
+		)
+	}
+	result := p.NewVisibilityDualManager(
 		visibilityFromDB,
 		visibilityFromES,
 		resourceConfig.EnableReadVisibilityFromES,
 		resourceConfig.AdvancedVisibilityWritingMode,
 		f.logger,
-	), nil
+	)
+	if visibilityFromES != nil && (visibilityFromDB != nil || visibilityFromESTarget != nil) {
+		// visibility records can be migrated between db and advanced visibility, or to the advanced
+		// visibility migration target store
+		metricsClient := params.MetricsClient
+		if metricsClient == nil {
+			metricsClient = metrics.NewNoopMetricsClient()
+		}
+		result = p.NewVisibilityMigrationManager(
+			result,
+			visibilityFromDB,
+			visibilityFromES,
+			visibilityFromESTarget,
+			&p.VisibilityMigrationConfig{
+				ReadModeIsFromES:         resourceConfig.EnableReadVisibilityFromES,
+				WriteMode:                resourceConfig.AdvancedVisibilityWritingMode,
+				EnableDualWrite:          resourceConfig.EnableVisibilityMigrationDualWrite,
+				ShadowReadPercentage:     resourceConfig.VisibilityMigrationShadowReadPercentage,
+				MaxConcurrentShadowReads: resourceConfig.VisibilityMigrationMaxConcurrentShadowReads,
+			},
+			metricsClient,
+			f.logger,
+		)
+	}
+	return result, nil
 }
 
 // NewESVisibilityManager create a visibility manager for ElasticSearch
@@ -333,7 +369,7 @@ func (f *factoryImpl) newDBVisibilityManager(
 	}
 
 	ds := f.datastores[storeTypeVisibility]
-	store, err := ds.factory.NewVisibilityStore(enableReadFromClosedExecutionV2)
+	store, err := ds.factory.NewVisibilityStore(enableReadFromClosedExecutionV2, visibilityConfig.ValidSearchAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +155,8 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy
+	TransferTaskTypeCompletionCallback
+	TransferTaskTypeHistoryExport
 )
 
 // Types of cross-cluster tasks
@@ -171,6 +173,7 @@ const (
 	ReplicationTaskTypeHistory = iota
 	ReplicationTaskTypeSyncActivity
 	ReplicationTaskTypeFailoverMarker
+	ReplicationTaskTypeSyncCompletionCallbacks
 )
 
 // Types of timers
@@ -182,6 +185,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeCompletionCallbackRetryTimer
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -285,6 +289,8 @@ type (
 		ClusterReplicationLevel           map[string]int64                  `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                             `json:"domain_notification_version"`
 		PendingFailoverMarkers            []*types.FailoverMarkerAttributes `json:"pending_failover_markers"`
+		ReplicationProcessorStates        *types.ReplicationProcessorStates `json:"replication_processor_states"`
+		ReplicationDLQRetryStates         *types.ReplicationDLQRetryStates  `json:"replication_dlq_retry_states"`
 	}
 
 	// WorkflowExecutionInfo describes a workflow execution
@@ -332,6 +338,13 @@ type (
 		AutoResetPoints                    *types.ResetPoints
 		Memo                               map[string][]byte
 		SearchAttributes                   map[string][]byte
+		// OffloadedPayloads maps the blob keys of the offloaded payloads referenced by the run to the
+		// IDs of the events referencing them
+		OffloadedPayloads map[string]int64
+		// PauseInfo is set while the workflow execution is paused
+		PauseInfo *WorkflowPauseInfo
+		// CompletionCallbacks is the delivery state of the completion callbacks of the run
+		CompletionCallbacks []*types.CompletionCallbackInfo
 		// for retry
 		Attempt            int32
 		HasRetryPolicy     bool
@@ -348,6 +361,14 @@ type (
 		ExpirationSeconds int32 // TODO: is this field useful?
 	}
 
+	// WorkflowPauseInfo describes why and since when a workflow execution is paused
+	WorkflowPauseInfo struct {
+		Reason          string
+		Identity        string
+		PausedTimestamp time.Time
+		AllowActivities bool
+	}
+
 	// ExecutionStats is the statistics about workflow execution
 	ExecutionStats struct {
 		HistorySize int64
@@ -605,6 +626,21 @@ type (
 		Version             int64
 	}
 
+	// CompletionCallbackTask identifies a transfer task for invoking the completion callbacks of a closed workflow
+	CompletionCallbackTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
+	// HistoryExportTask identifies a transfer task for exporting a persisted history batch
+	HistoryExportTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+		FirstEventID        int64
+	}
+
 	// CrossClusterStartChildExecutionTask is the cross-cluster version of StartChildExecutionTask
 	CrossClusterStartChildExecutionTask struct {
 		StartChildExecutionTask
@@ -676,6 +712,13 @@ type (
 		TimeoutType         int // 0 for retry, 1 for cron.
 	}
 
+	// CompletionCallbackRetryTimerTask to schedule the next delivery attempt of the completion callbacks
+	CompletionCallbackRetryTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		VisibilityTimestamp time.Time
@@ -695,6 +738,14 @@ type (
 		ScheduledID         int64
 	}
 
+	// SyncCompletionCallbacksTask is the replication task created for shipping the delivery state of
+	// completion callbacks to other clusters
+	SyncCompletionCallbacksTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// FailoverMarkerTask is the marker for graceful failover
 	FailoverMarkerTask struct {
 		TaskID              int64
@@ -2252,6 +2303,41 @@ func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the completion callback retry timer task
+func (r *CompletionCallbackRetryTimerTask) GetType() int {
+	return TaskTypeCompletionCallbackRetryTimer
+}
+
+// GetVersion returns the version of the completion callback retry timer task
+func (r *CompletionCallbackRetryTimerTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the completion callback retry timer task
+func (r *CompletionCallbackRetryTimerTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (r *CompletionCallbackRetryTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (r *CompletionCallbackRetryTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *CompletionCallbackRetryTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *CompletionCallbackRetryTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() int {
 	return TaskTypeWorkflowTimeout
@@ -2427,6 +2513,76 @@ func (u *ApplyParentClosePolicyTask) SetVisibilityTimestamp(timestamp time.Time)
 	u.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the completion callback task
+func (u *CompletionCallbackTask) GetType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+// GetVersion returns the version of the completion callback task
+func (u *CompletionCallbackTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion returns the version of the completion callback task
+func (u *CompletionCallbackTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the completion callback task
+func (u *CompletionCallbackTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the completion callback task
+func (u *CompletionCallbackTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (u *CompletionCallbackTask) GetVisibilityTimestamp() time.Time {
+	return u.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (u *CompletionCallbackTask) SetVisibilityTimestamp(timestamp time.Time) {
+	u.VisibilityTimestamp = timestamp
+}
+
+// GetType returns the type of the history export task
+func (u *HistoryExportTask) GetType() int {
+	return TransferTaskTypeHistoryExport
+}
+
+// GetVersion returns the version of the history export task
+func (u *HistoryExportTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion returns the version of the history export task
+func (u *HistoryExportTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the history export task
+func (u *HistoryExportTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the history export task
+func (u *HistoryExportTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (u *HistoryExportTask) GetVisibilityTimestamp() time.Time {
+	return u.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (u *HistoryExportTask) SetVisibilityTimestamp(timestamp time.Time) {
+	u.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the upsert search attributes transfer task
 func (u *UpsertWorkflowSearchAttributesTask) GetType() int {
 	return TransferTaskTypeUpsertWorkflowSearchAttributes
@@ -2627,6 +2783,41 @@ func (a *SyncActivityTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the history replication task
+func (a *SyncCompletionCallbacksTask) GetType() int {
+	return ReplicationTaskTypeSyncCompletionCallbacks
+}
+
+// GetVersion returns the version of the history replication task
+func (a *SyncCompletionCallbacksTask) GetVersion() int64 {
+	return a.Version
+}
+
+// SetVersion returns the version of the history replication task
+func (a *SyncCompletionCallbacksTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+// GetTaskID returns the sequence ID of the history replication task
+func (a *SyncCompletionCallbacksTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the history replication task
+func (a *SyncCompletionCallbacksTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (a *SyncCompletionCallbacksTask) GetVisibilityTimestamp() time.Time {
+	return a.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (a *SyncCompletionCallbacksTask) SetVisibilityTimestamp(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the history replication task
 func (a *FailoverMarkerTask) GetType() int {
 	return ReplicationTaskTypeFailoverMarker
@@ -2821,6 +3012,8 @@ func (s *ShardInfo) Copy() *ShardInfo {
 		ClusterReplicationLevel:           clusterReplicationLevel,
 		ReplicationDLQAckLevel:            replicationDLQAckLevel,
 		PendingFailoverMarkers:            s.PendingFailoverMarkers,
+		ReplicationProcessorStates:        s.ReplicationProcessorStates,
+		ReplicationDLQRetryStates:         s.ReplicationDLQRetryStates,
 		UpdatedAt:                         s.UpdatedAt,
 	}
 }
@@ -2904,6 +3097,15 @@ func NewHistoryBranchTokenByBranchID(treeID, branchID string) ([]byte, error) {
 	return token, nil
 }
 
+// DecodeHistoryBranchToken decodes a branchToken into the history branch it refers to
+func DecodeHistoryBranchToken(branchToken []byte) (*workflow.HistoryBranch, error) {
+	var branch workflow.HistoryBranch
+	if err := internalThriftEncoder.Decode(branchToken, &branch); err != nil {
+		return nil, err
+	}
+	return &branch, nil
+}
+
 // NewHistoryBranchTokenFromAnother make up a branchToken
 func NewHistoryBranchTokenFromAnother(branchID string, anotherToken []byte) ([]byte, error) {
 	var branch workflow.HistoryBranch
@@ -324,6 +324,10 @@ type (
 		ExpirationSeconds  time.Duration
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		OffloadedPayloads  map[string]int64
+		PauseInfo          *WorkflowPauseInfo
+		// CompletionCallbacks is the encoded delivery state of the completion callbacks
+		CompletionCallbacks *DataBlob
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -831,6 +835,8 @@ type (
 		ClusterReplicationLevel           map[string]int64     `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                `json:"domain_notification_version"`
 		PendingFailoverMarkers            *DataBlob            `json:"pending_failover_markers"`
+		ReplicationProcessorStates        *DataBlob            `json:"replication_processor_states"`
+		ReplicationDLQRetryStates         *DataBlob            `json:"replication_dlq_retry_states"`
 	}
 
 	// InternalCreateShardRequest is request to CreateShard
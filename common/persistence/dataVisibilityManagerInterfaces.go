@@ -129,11 +129,19 @@ type (
 		DomainUUID string
 		Domain     string // domain name is not persisted, but used as config filter key
 		Query      string
+		// GroupBy are the search attributes (with attr prefix for customized fields) to group the count by
+		GroupBy []string
+		// MaxGroups is the maximum number of groups returned when GroupBy is set
+		MaxGroups int
 	}
 
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups are ordered by count, largest groups first, groups with the same count are ordered by their values
+		Groups []*types.CountWorkflowExecutionsGroup
+		// Truncated is true when there are more than MaxGroups groups, only the largest ones are returned
+		Truncated bool
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
@@ -172,6 +180,7 @@ type (
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		DomainID   string
+		Domain     string // not persisted, used as config filter key
 		RunID      string
 		WorkflowID string
 		TaskID     int64
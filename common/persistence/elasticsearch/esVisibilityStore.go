@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/elasticsearch/esql"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -60,6 +62,14 @@ type (
 		logger   log.Logger
 		config   *service.Config
 	}
+
+	esCompositeAggregation struct {
+		AfterKey json.RawMessage `json:"after_key"`
+		Buckets  []struct {
+			Key      map[string]json.RawMessage `json:"key"`
+			DocCount int64                      `json:"doc_count"`
+		} `json:"buckets"`
+	}
 )
 
 var _ p.VisibilityStore = (*esVisibilityStore)(nil)
@@ -455,21 +465,101 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 	}
 
 	response := &p.CountWorkflowExecutionsResponse{Count: count}
+	if len(request.GroupBy) > 0 {
+		if response.Groups, response.Truncated, err = v.countWorkflowExecutionsGroups(ctx, request); err != nil {
+			return nil, err
+		}
+	}
 	return response, nil
 }
 
+// countWorkflowExecutionsGroups counts workflow executions per group with a composite aggregation.
+// Composite buckets come back in key order, so all of them (up to esMaxCountGroupsScanned) are read
+// and the largest request.MaxGroups groups are returned
+func (v *esVisibilityStore) countWorkflowExecutionsGroups(
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
+) ([]*types.CountWorkflowExecutionsGroup, bool, error) {
+	dsl, err := getESQueryDSLForCountGroups(request, esql.DefaultBucketNumber)
+	if err != nil {
+		return nil, false, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	var groups []*types.CountWorkflowExecutionsGroup
+	truncated := false
+	for {
+		resp, err := v.esClient.SearchRaw(ctx, v.index, dsl.String())
+		if err != nil {
+			return nil, false, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
+			}
+		}
+		var aggregation esCompositeAggregation
+		if err := json.Unmarshal(resp.Aggregations[esGroupByAggregationName], &aggregation); err != nil {
+			return nil, false, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed to decode aggregation. Error: %v", err),
+			}
+		}
+
+		for _, bucket := range aggregation.Buckets {
+			values := make([]string, len(request.GroupBy))
+			for i, key := range request.GroupBy {
+				value, ok := bucket.Key[esGroupByKeyPrefix+key]
+				if !ok || len(value) == 0 {
+					value = json.RawMessage("null")
+				}
+				values[i] = string(value)
+			}
+			groups = append(groups, &types.CountWorkflowExecutionsGroup{GroupValues: values, Count: bucket.DocCount})
+		}
+		if len(aggregation.Buckets) == 0 || len(aggregation.AfterKey) == 0 {
+			break
+		}
+		if len(groups) >= esMaxCountGroupsScanned {
+			truncated = true
+			break
+		}
+		afterKey, err := fastjson.ParseBytes(aggregation.AfterKey)
+		if err != nil {
+			return nil, false, &types.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed to decode aggregation. Error: %v", err),
+			}
+		}
+		dsl.Get(dslFieldAggs, esGroupByAggregationName, "composite").Set("after", afterKey)
+	}
+
+	// stable sort keeps groups with the same count in key order
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Count > groups[j].Count
+	})
+	if request.MaxGroups > 0 && len(groups) > request.MaxGroups {
+		groups = groups[:request.MaxGroups]
+		truncated = true
+	}
+	return groups, truncated, nil
+}
+
 const (
 	jsonMissingCloseTime     = `{"missing":{"field":"CloseTime"}}`
 	jsonRangeOnExecutionTime = `{"range":{"ExecutionTime":`
 	jsonSortForOpen          = `[{"StartTime":"desc"},{"RunID":"desc"}]`
 	jsonSortWithTieBreaker   = `{"RunID":"desc"}`
 
+	dslFieldAggs        = "aggs"
 	dslFieldSort        = "sort"
 	dslFieldSearchAfter = "search_after"
 	dslFieldFrom        = "from"
 	dslFieldSize        = "size"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
+
+	// esql converts GROUP BY to a composite aggregation with this name, and names the source of
+	// each group by field with this prefix
+	esGroupByAggregationName = "groupby"
+	esGroupByKeyPrefix       = "group_"
+	// composite aggregation buckets are ordered by key, not by count, so groups are read up to this
+	// limit to find the largest ones
+	esMaxCountGroupsScanned = 10000
 )
 
 var (
@@ -514,6 +604,37 @@ func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, e
 	return dsl.String(), nil
 }
 
+func getESQueryDSLForCountGroups(request *p.CountWorkflowExecutionsRequest, bucketSize int) (*fastjson.Value, error) {
+	sql := getSQLFromCountRequest(request)
+	dsl, err := getCustomizedDSLFromSQL(sql, request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the aggregation is needed, not the documents
+	dsl.Del(dslFieldFrom)
+	dsl.Del(dslFieldSort)
+	dsl.Set(dslFieldSize, fastjson.MustParse("0"))
+
+	fields := make([]string, len(request.GroupBy))
+	for i, field := range request.GroupBy {
+		fields[i] = "`" + field + "`"
+	}
+	e := esql.NewESql()
+	e.SetCadence(true)
+	e.SetBucketNum(bucketSize)
+	aggDSLStr, _, err := e.ConvertCadence(fmt.Sprintf("select count(*) from dummy group by %s", strings.Join(fields, ", ")), "")
+	if err != nil {
+		return nil, err
+	}
+	aggDSL, err := fastjson.Parse(aggDSLStr)
+	if err != nil {
+		return nil, err
+	}
+	dsl.Set(dslFieldAggs, aggDSL.Get(dslFieldAggs))
+	return dsl, nil
+}
+
 func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsByQueryRequest, token *es.ElasticVisibilityPageToken) (string, error) {
 	sql := getSQLFromListRequest(request)
 	dsl, err := getCustomizedDSLFromSQL(sql, request.DomainUUID)
@@ -834,6 +834,48 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.Contains(err.Error(), "Error when parse query"))
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	s.mockESClient.On("CountByQuery", mock.Anything, testIndex, mock.Anything).Return(int64(6), nil).Once()
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"sources":[{"group_WorkflowType":{"terms":{"field":"WorkflowType","missing_bucket":true}}},{"group_Attr.CustomKeywordField":{"terms":{"field":"Attr.CustomKeywordField","missing_bucket":true}}}]`) &&
+			!strings.Contains(input, `"after"`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{
+			"groupby": json.RawMessage(`{"after_key":{"group_WorkflowType":"type1","group_Attr.CustomKeywordField":null},"buckets":[` +
+				`{"key":{"group_WorkflowType":"type1","group_Attr.CustomKeywordField":"value"},"doc_count":1},` +
+				`{"key":{"group_WorkflowType":"type1","group_Attr.CustomKeywordField":null},"doc_count":3}]}`),
+		},
+	}, nil).Once()
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"after":{"group_WorkflowType":"type1","group_Attr.CustomKeywordField":null}`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{
+			"groupby": json.RawMessage(`{"buckets":[` +
+				`{"key":{"group_WorkflowType":"type2","group_Attr.CustomKeywordField":"value"},"doc_count":2}]}`),
+		},
+	}, nil).Once()
+
+	request := &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      `CloseStatus = 5`,
+		GroupBy:    []string{"WorkflowType", "Attr.CustomKeywordField"},
+		MaxGroups:  2,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal(int64(6), resp.Count)
+	s.Equal([]*types.CountWorkflowExecutionsGroup{
+		{GroupValues: []string{`"type1"`, `null`}, Count: 3},
+		{GroupValues: []string{`"type2"`, `"value"`}, Count: 2},
+	}, resp.Groups)
+	s.True(resp.Truncated)
+}
+
 func (s *ESVisibilitySuite) TestTimeProcessFunc() {
 	cases := []struct {
 		key   string
@@ -129,6 +129,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	completionCallbacks, err := m.serializer.DeserializeCompletionCallbackInfos(info.CompletionCallbacks)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -186,6 +191,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		OffloadedPayloads:                  info.OffloadedPayloads,
+		PauseInfo:                          info.PauseInfo,
+		CompletionCallbacks:                completionCallbacks,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -455,6 +463,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	completionCallbacks, err := m.serializer.SerializeCompletionCallbackInfos(info.CompletionCallbacks, encoding)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -510,6 +523,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationSeconds:                  common.SecondsToDuration(int64(info.ExpirationSeconds)),
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		OffloadedPayloads:                  info.OffloadedPayloads,
+		PauseInfo:                          info.PauseInfo,
+		CompletionCallbacks:                completionCallbacks,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -224,6 +224,9 @@ func (d *nosqlExecutionStore) prepareTimerTasksForWorkflowTxn(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.CompletionCallbackRetryTimerTask:
+			// noop
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -280,7 +283,7 @@ func (d *nosqlExecutionStore) prepareReplicationTasksForWorkflowTxn(
 			version = task.GetVersion()
 			activityScheduleID = task.(*p.SyncActivityTask).ScheduledID
 
-		case p.ReplicationTaskTypeFailoverMarker:
+		case p.ReplicationTaskTypeFailoverMarker, p.ReplicationTaskTypeSyncCompletionCallbacks:
 			version = task.GetVersion()
 
 		default:
@@ -498,11 +501,15 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(
 		case p.TransferTaskTypeApplyParentClosePolicy:
 			targetDomainIDs = task.(*p.ApplyParentClosePolicyTask).TargetDomainIDs
 
+		case p.TransferTaskTypeHistoryExport:
+			scheduleID = task.(*p.HistoryExportTask).FirstEventID
+
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
 			p.TransferTaskTypeUpsertWorkflowSearchAttributes,
-			p.TransferTaskTypeRecordWorkflowClosed:
+			p.TransferTaskTypeRecordWorkflowClosed,
+			p.TransferTaskTypeCompletionCallback:
 			// No explicit property needs to be set
 
 		default:
@@ -624,6 +631,7 @@ func (d *nosqlExecutionStore) prepareUpdateWorkflowExecutionTxn(
 
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.CompletionCallbacks = executionInfo.CompletionCallbacks.ToNilSafeDataBlob()
 	// TODO also need to set the start / current / last write version
 	versionHistories = versionHistories.ToNilSafeDataBlob()
 	return &nosqlplugin.WorkflowExecutionRequest{
@@ -665,6 +673,7 @@ func (d *nosqlExecutionStore) prepareCreateWorkflowExecutionTxn(
 	}
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.CompletionCallbacks = executionInfo.CompletionCallbacks.ToNilSafeDataBlob()
 	if versionHistories == nil {
 		return nil, &types.InternalServiceError{Message: "encounter empty version histories in createExecution"}
 	}
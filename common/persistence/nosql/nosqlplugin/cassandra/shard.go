@@ -53,7 +53,11 @@ const (
 		`cluster_replication_level: ?, ` +
 		`replication_dlq_ack_level: ?, ` +
 		`pending_failover_markers: ?, ` +
-		`pending_failover_markers_encoding: ? ` +
+		`pending_failover_markers_encoding: ?, ` +
+		`replication_processor_states: ?, ` +
+		`replication_processor_states_encoding: ?, ` +
+		`replication_dlq_retry_states: ?, ` +
+		`replication_dlq_retry_states_encoding: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -101,6 +105,8 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 	transferPQS, transferPQSEncoding := persistence.FromDataBlob(row.TransferProcessingQueueStates)
 	crossClusterPQS, crossClusterPQSEncoding := persistence.FromDataBlob(row.CrossClusterProcessingQueueStates)
 	timerPQS, timerPQSEncoding := persistence.FromDataBlob(row.TimerProcessingQueueStates)
+	replicationPS, replicationPSEncoding := persistence.FromDataBlob(row.ReplicationProcessorStates)
+	replicationDLQRS, replicationDLQRSEncoding := persistence.FromDataBlob(row.ReplicationDLQRetryStates)
 	query := db.session.Query(templateCreateShardQuery,
 		row.ShardID,
 		rowTypeShard,
@@ -130,6 +136,10 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		replicationPS,
+		replicationPSEncoding,
+		replicationDLQRS,
+		replicationDLQRSEncoding,
 		row.RangeID,
 	).WithContext(ctx)
 
@@ -195,6 +205,10 @@ func convertToShardInfo(
 	var crossClusterProcessingQueueStatesEncoding string
 	var timerProcessingQueueStatesRawData []byte
 	var timerProcessingQueueStatesEncoding string
+	var replicationProcessorStatesRawData []byte
+	var replicationProcessorStatesEncoding string
+	var replicationDLQRetryStatesRawData []byte
+	var replicationDLQRetryStatesEncoding string
 	info := &persistence.InternalShardInfo{}
 	info.RangeID = rangeID
 	for k, v := range shard {
@@ -239,6 +253,14 @@ func convertToShardInfo(
 			pendingFailoverMarkersRawData = v.([]byte)
 		case "pending_failover_markers_encoding":
 			pendingFailoverMarkersEncoding = v.(string)
+		case "replication_processor_states":
+			replicationProcessorStatesRawData = v.([]byte)
+		case "replication_processor_states_encoding":
+			replicationProcessorStatesEncoding = v.(string)
+		case "replication_dlq_retry_states":
+			replicationDLQRetryStatesRawData = v.([]byte)
+		case "replication_dlq_retry_states_encoding":
+			replicationDLQRetryStatesEncoding = v.(string)
 		}
 	}
 
@@ -274,6 +296,14 @@ func convertToShardInfo(
 		timerProcessingQueueStatesRawData,
 		common.EncodingType(timerProcessingQueueStatesEncoding),
 	)
+	info.ReplicationProcessorStates = persistence.NewDataBlob(
+		replicationProcessorStatesRawData,
+		common.EncodingType(replicationProcessorStatesEncoding),
+	)
+	info.ReplicationDLQRetryStates = persistence.NewDataBlob(
+		replicationDLQRetryStatesRawData,
+		common.EncodingType(replicationDLQRetryStatesEncoding),
+	)
 
 	return info
 }
@@ -314,6 +344,8 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 	transferPQS, transferPQSEncoding := persistence.FromDataBlob(row.TransferProcessingQueueStates)
 	crossClusterPQS, crossClusterPQSEncoding := persistence.FromDataBlob(row.CrossClusterProcessingQueueStates)
 	timerPQS, timerPQSEncoding := persistence.FromDataBlob(row.TimerProcessingQueueStates)
+	replicationPS, replicationPSEncoding := persistence.FromDataBlob(row.ReplicationProcessorStates)
+	replicationDLQRS, replicationDLQRSEncoding := persistence.FromDataBlob(row.ReplicationDLQRetryStates)
 
 	query := db.session.Query(templateUpdateShardQuery,
 		row.ShardID,
@@ -337,6 +369,10 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		replicationPS,
+		replicationPSEncoding,
+		replicationDLQRS,
+		replicationDLQRSEncoding,
 		row.RangeID,
 		row.ShardID,
 		rowTypeShard,
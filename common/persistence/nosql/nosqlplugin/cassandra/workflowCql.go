@@ -80,7 +80,14 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`offloaded_payloads: ?, ` +
+		`pause_reason: ?, ` +
+		`pause_identity: ?, ` +
+		`paused_time: ?, ` +
+		`pause_allow_activities: ?, ` +
+		`completion_callbacks: ?, ` +
+		`completion_callbacks_encoding: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -41,6 +41,9 @@ func parseWorkflowExecutionInfo(
 	var completionEventEncoding common.EncodingType
 	var autoResetPoints []byte
 	var autoResetPointsEncoding common.EncodingType
+	var completionCallbacks []byte
+	var completionCallbacksEncoding common.EncodingType
+	pauseInfo := &persistence.WorkflowPauseInfo{}
 
 	for k, v := range result {
 		switch k {
@@ -164,10 +167,28 @@ func parseWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "offloaded_payloads":
+			info.OffloadedPayloads = v.(map[string]int64)
+		case "pause_reason":
+			pauseInfo.Reason = v.(string)
+		case "pause_identity":
+			pauseInfo.Identity = v.(string)
+		case "paused_time":
+			pauseInfo.PausedTimestamp = v.(time.Time)
+		case "pause_allow_activities":
+			pauseInfo.AllowActivities = v.(bool)
+		case "completion_callbacks":
+			completionCallbacks = v.([]byte)
+		case "completion_callbacks_encoding":
+			completionCallbacksEncoding = common.EncodingType(v.(string))
 		}
 	}
+	if !pauseInfo.PausedTimestamp.IsZero() {
+		info.PauseInfo = pauseInfo
+	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
+	info.CompletionCallbacks = persistence.NewDataBlob(completionCallbacks, completionCallbacksEncoding)
 	return info
 }
 
@@ -1198,6 +1198,7 @@ func (db *cdb) updateWorkflowExecution(
 ) error {
 	execution.StartTimestamp = db.convertToCassandraTimestamp(execution.StartTimestamp)
 	execution.LastUpdatedTimestamp = db.convertToCassandraTimestamp(execution.LastUpdatedTimestamp)
+	pauseReason, pauseIdentity, pausedTime, pauseAllowActivities := toPauseInfoColumns(execution.PauseInfo)
 
 	batch.Query(templateUpdateWorkflowExecutionWithVersionHistoriesQuery,
 		domainID,
@@ -1258,6 +1259,13 @@ func (db *cdb) updateWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.OffloadedPayloads,
+		pauseReason,
+		pauseIdentity,
+		pausedTime,
+		pauseAllowActivities,
+		execution.CompletionCallbacks.Data,
+		execution.CompletionCallbacks.GetEncodingString(),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1287,6 +1295,7 @@ func (db *cdb) createWorkflowExecution(
 ) error {
 	execution.StartTimestamp = db.convertToCassandraTimestamp(execution.StartTimestamp)
 	execution.LastUpdatedTimestamp = db.convertToCassandraTimestamp(execution.LastUpdatedTimestamp)
+	pauseReason, pauseIdentity, pausedTime, pauseAllowActivities := toPauseInfoColumns(execution.PauseInfo)
 
 	batch.Query(templateCreateWorkflowExecutionWithVersionHistoriesQuery,
 		shardID,
@@ -1352,6 +1361,13 @@ func (db *cdb) createWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.OffloadedPayloads,
+		pauseReason,
+		pauseIdentity,
+		pausedTime,
+		pauseAllowActivities,
+		execution.CompletionCallbacks.Data,
+		execution.CompletionCallbacks.GetEncodingString(),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -1366,6 +1382,17 @@ func (db *cdb) createWorkflowExecution(
 	return nil
 }
 
+// toPauseInfoColumns returns the workflow_execution columns of the pause info, paused_time is null unless the
+// workflow execution is paused
+func toPauseInfoColumns(
+	pauseInfo *persistence.WorkflowPauseInfo,
+) (string, string, interface{}, bool) {
+	if pauseInfo == nil {
+		return "", "", nil, false
+	}
+	return pauseInfo.Reason, pauseInfo.Identity, pauseInfo.PausedTimestamp, pauseInfo.AllowActivities
+}
+
 func (db *cdb) createOrUpdateCurrentWorkflow(
 	batch gocql.Batch,
 	shardID int,
@@ -22,7 +22,9 @@ package persistencetests
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/elasticsearch/validator"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/service"
@@ -77,6 +80,7 @@ func (s *DBVisibilityPersistenceSuite) SetupSuite() {
 			AdvancedVisibilityWritingMode:               dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOff),
 			EnableReadDBVisibilityFromClosedExecutionV2: dynamicconfig.GetBoolPropertyFn(false),
 			EnableDBVisibilitySampling:                  dynamicconfig.GetBoolPropertyFn(false),
+			ValidSearchAttributes:                       dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		},
 	)
 	if err != nil {
@@ -838,11 +842,149 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 		},
 	}
 
+	if s.isSQLVisibility() {
+		// upsert is supported by SQL visibility, it is covered by TestListWorkflowExecutionsByQuery
+		tests = tests[:1]
+	}
 	for _, test := range tests {
 		s.Equal(test.expected, s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request))
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if !s.isSQLVisibility() {
+		s.T().Skip("query based visibility is only supported by SQL")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	queryValidator := validator.NewQueryValidator(dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()))
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+
+	for i := 0; i < 3; i++ {
+		execution := types.WorkflowExecution{WorkflowID: fmt.Sprintf("visibility-query-test-%v", i), RunID: uuid.New()}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime + int64(i),
+			SearchAttributes: map[string][]byte{
+				definition.CustomIntField: []byte(strconv.Itoa(i)),
+			},
+		})
+		s.NoError(err)
+		err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime + int64(i),
+			SearchAttributes: map[string][]byte{
+				definition.CustomIntField:      []byte(strconv.Itoa(i)),
+				definition.CustomKeywordField:  []byte(`["keyword", "other"]`),
+				definition.CustomDatetimeField: []byte(strconv.Quote(time.Unix(0, startTime+int64(i)).Format(time.RFC3339Nano))),
+			},
+		})
+		s.NoError(err)
+		if i == 0 {
+			err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+				DomainUUID:       testDomainUUID,
+				Execution:        execution,
+				WorkflowTypeName: "visibility-workflow",
+				StartTimestamp:   startTime,
+				Status:           types.WorkflowExecutionCloseStatusCompleted,
+				CloseTimestamp:   time.Now().UnixNano(),
+				HistoryLength:    3,
+				SearchAttributes: map[string][]byte{
+					definition.CustomIntField: []byte("0"),
+				},
+			})
+			s.NoError(err)
+		}
+	}
+
+	count := func(query string) int64 {
+		validatedQuery, err := queryValidator.ValidateQuery(query)
+		s.NoError(err)
+		resp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+			DomainUUID: testDomainUUID,
+			Query:      validatedQuery,
+		})
+		s.NoError(err)
+		return resp.Count
+	}
+	s.Equal(int64(3), count(""))
+	s.Equal(int64(2), count("CloseTime = missing"))
+	s.Equal(int64(1), count("CloseStatus = 'COMPLETED'"))
+	s.Equal(int64(2), count("CustomKeywordField = 'keyword'"))
+	s.Equal(int64(2), count("CustomIntField >= 1 and CustomIntField < 3"))
+	s.Equal(int64(1), count("CustomIntField in (0, 2) and CloseTime = missing"))
+	s.Equal(int64(2), count(fmt.Sprintf("CustomDatetimeField >= %v", startTime+1)))
+	s.Equal(int64(1), count(fmt.Sprintf("CustomDatetimeField = '%v'", time.Unix(0, startTime+2).UTC().Format(time.RFC3339Nano))))
+
+	validatedQuery, err := queryValidator.ValidateQuery("CloseTime = missing order by CustomIntField asc")
+	s.NoError(err)
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   1,
+		Query:      validatedQuery,
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal("visibility-query-test-1", resp.Executions[0].Execution.WorkflowID)
+	s.Equal([]byte(`["keyword","other"]`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+	s.NotEmpty(resp.NextPageToken)
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    testDomainUUID,
+		PageSize:      1,
+		Query:         validatedQuery,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal("visibility-query-test-2", resp.Executions[0].Execution.WorkflowID)
+
+	// pages are read after the last row of the previous page, open workflows without close time come
+	// last and a workflow started while paging is returned by the following pages
+	validatedQuery, err = queryValidator.ValidateQuery("order by CloseTime desc")
+	s.NoError(err)
+	var workflowIDs []string
+	var nextPageToken []byte
+	for {
+		resp, err = s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      1,
+			Query:         validatedQuery,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		for _, execution := range resp.Executions {
+			workflowIDs = append(workflowIDs, execution.Execution.WorkflowID)
+		}
+		if len(workflowIDs) == 1 {
+			err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+				DomainUUID:       testDomainUUID,
+				Execution:        types.WorkflowExecution{WorkflowID: "visibility-query-test-3", RunID: uuid.New()},
+				WorkflowTypeName: "visibility-workflow",
+				StartTimestamp:   startTime,
+			})
+			s.NoError(err)
+		}
+		if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+			break
+		}
+	}
+	s.Equal("visibility-query-test-0", workflowIDs[0])
+	s.Len(workflowIDs, 4)
+}
+
+func (s *DBVisibilityPersistenceSuite) isSQLVisibility() bool {
+	cfg := s.VisibilityTestCluster.Config()
+	return cfg.DataStores[cfg.VisibilityStore].SQL != nil
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
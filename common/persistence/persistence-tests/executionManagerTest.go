@@ -1348,6 +1348,21 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.ClientLibraryVersion = "random client library version"
 	updatedInfo.ClientFeatureVersion = "random client feature version"
 	updatedInfo.ClientImpl = "random client impl"
+	updatedInfo.OffloadedPayloads = map[string]int64{"payload_key": 5}
+	updatedInfo.PauseInfo = &p.WorkflowPauseInfo{
+		Reason:          "random pause reason",
+		Identity:        "random pause identity",
+		PausedTimestamp: time.Unix(0, 1234567000),
+		AllowActivities: true,
+	}
+	updatedInfo.CompletionCallbacks = []*types.CompletionCallbackInfo{{
+		URL:                  "https://random.callback/url",
+		State:                types.CompletionCallbackStatePending.Ptr(),
+		Attempt:              2,
+		LastAttemptTimestamp: common.Int64Ptr(1234567000),
+		NextAttemptTimestamp: common.Int64Ptr(2234567000),
+		LastFailure:          "random callback failure",
+	}}
 	updatedInfo.SignalCount = 9
 	updatedInfo.InitialInterval = math.MaxInt32
 	updatedInfo.BackoffCoefficient = 4.45
@@ -1406,6 +1421,12 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.ClientLibraryVersion, info1.ClientLibraryVersion)
 	s.Equal(updatedInfo.ClientFeatureVersion, info1.ClientFeatureVersion)
 	s.Equal(updatedInfo.ClientImpl, info1.ClientImpl)
+	s.Equal(updatedInfo.OffloadedPayloads, info1.OffloadedPayloads)
+	s.Equal(updatedInfo.PauseInfo.Reason, info1.PauseInfo.Reason)
+	s.Equal(updatedInfo.PauseInfo.Identity, info1.PauseInfo.Identity)
+	s.EqualTimes(updatedInfo.PauseInfo.PausedTimestamp, info1.PauseInfo.PausedTimestamp)
+	s.Equal(updatedInfo.PauseInfo.AllowActivities, info1.PauseInfo.AllowActivities)
+	s.Equal(updatedInfo.CompletionCallbacks, info1.CompletionCallbacks)
 	s.Equal(updatedInfo.SignalCount, info1.SignalCount)
 	s.EqualValues(updatedStats.HistorySize, state1.ExecutionStats.HistorySize)
 	s.Equal(updatedInfo.InitialInterval, info1.InitialInterval)
@@ -0,0 +1,132 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// HealthTracker counts the persistence requests and failures of the host in a sliding window
+	HealthTracker interface {
+		GetPersistenceHealth() *types.PersistenceHealth
+	}
+
+	healthTrackerImpl struct {
+		sync.Mutex
+
+		timeSource clock.TimeSource
+		buckets    []healthBucket
+	}
+
+	// healthBucket holds the counts of one second of the window
+	healthBucket struct {
+		second   int64
+		requests int64
+		failures int64
+	}
+
+	// healthTrackingMetricsClient feeds the persistence request and failure counters emitted
+	// by the persistence metric clients into the health tracker
+	healthTrackingMetricsClient struct {
+		metrics.Client
+
+		tracker *healthTrackerImpl
+	}
+)
+
+var _ HealthTracker = (*healthTrackerImpl)(nil)
+
+// NewHealthTrackingMetricsClient wraps the metrics client given to the persistence clients, and returns
+// the tracker of the persistence requests and failures reported through it in the window
+func NewHealthTrackingMetricsClient(
+	metricsClient metrics.Client,
+	window time.Duration,
+	timeSource clock.TimeSource,
+) (metrics.Client, HealthTracker) {
+
+	numBuckets := int(window / time.Second)
+	if numBuckets < 1 {
+		numBuckets = 1
+	}
+	tracker := &healthTrackerImpl{
+		timeSource: timeSource,
+		buckets:    make([]healthBucket, numBuckets),
+	}
+	return &healthTrackingMetricsClient{
+		Client:  metricsClient,
+		tracker: tracker,
+	}, tracker
+}
+
+func (c *healthTrackingMetricsClient) IncCounter(scope int, counter int) {
+	c.Client.IncCounter(scope, counter)
+	c.tracker.add(counter, 1)
+}
+
+func (c *healthTrackingMetricsClient) AddCounter(scope int, counter int, delta int64) {
+	c.Client.AddCounter(scope, counter, delta)
+	c.tracker.add(counter, delta)
+}
+
+func (t *healthTrackerImpl) add(counter int, delta int64) {
+	if counter != metrics.PersistenceRequests && counter != metrics.PersistenceFailures {
+		return
+	}
+
+	second := t.timeSource.Now().Unix()
+	t.Lock()
+	defer t.Unlock()
+
+	bucket := &t.buckets[second%int64(len(t.buckets))]
+	if bucket.second != second {
+		*bucket = healthBucket{second: second}
+	}
+	if counter == metrics.PersistenceRequests {
+		bucket.requests += delta
+	} else {
+		bucket.failures += delta
+	}
+}
+
+// GetPersistenceHealth returns the persistence requests and failures in the window
+func (t *healthTrackerImpl) GetPersistenceHealth() *types.PersistenceHealth {
+	now := t.timeSource.Now().Unix()
+	t.Lock()
+	defer t.Unlock()
+
+	health := &types.PersistenceHealth{
+		WindowInSeconds: int32(len(t.buckets)),
+	}
+	for _, bucket := range t.buckets {
+		if now-bucket.second < int64(len(t.buckets)) {
+			health.Requests += bucket.requests
+			health.Failures += bucket.failures
+		}
+	}
+	return health
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHealthTrackingMetricsClient(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	client, tracker := NewHealthTrackingMetricsClient(metrics.NewNoopMetricsClient(), 10*time.Second, timeSource)
+
+	client.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
+	client.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceFailures)
+	client.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceErrBusyCounter)
+	client.AddCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests, 2)
+	assert.Equal(t, &types.PersistenceHealth{Requests: 3, Failures: 1, WindowInSeconds: 10}, tracker.GetPersistenceHealth())
+
+	timeSource.Update(time.Unix(1005, 0))
+	client.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
+	assert.Equal(t, &types.PersistenceHealth{Requests: 4, Failures: 1, WindowInSeconds: 10}, tracker.GetPersistenceHealth())
+
+	// the counts of the first second leave the window
+	timeSource.Update(time.Unix(1010, 0))
+	assert.Equal(t, &types.PersistenceHealth{Requests: 1, Failures: 0, WindowInSeconds: 10}, tracker.GetPersistenceHealth())
+
+	// the bucket of the first second is reused
+	client.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceFailures)
+	assert.Equal(t, &types.PersistenceHealth{Requests: 1, Failures: 1, WindowInSeconds: 10}, tracker.GetPersistenceHealth())
+}
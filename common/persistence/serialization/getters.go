@@ -180,6 +180,38 @@ func (s *ShardInfo) GetTimerProcessingQueueStatesEncoding() (o string) {
 	return
 }
 
+// GetReplicationProcessorStates internal sql blob getter
+func (s *ShardInfo) GetReplicationProcessorStates() (o []byte) {
+	if s != nil {
+		return s.ReplicationProcessorStates
+	}
+	return
+}
+
+// GetReplicationProcessorStatesEncoding internal sql blob getter
+func (s *ShardInfo) GetReplicationProcessorStatesEncoding() (o string) {
+	if s != nil {
+		return s.ReplicationProcessorStatesEncoding
+	}
+	return
+}
+
+// GetReplicationDLQRetryStates internal sql blob getter
+func (s *ShardInfo) GetReplicationDLQRetryStates() (o []byte) {
+	if s != nil {
+		return s.ReplicationDLQRetryStates
+	}
+	return
+}
+
+// GetReplicationDLQRetryStatesEncoding internal sql blob getter
+func (s *ShardInfo) GetReplicationDLQRetryStatesEncoding() (o string) {
+	if s != nil {
+		return s.ReplicationDLQRetryStatesEncoding
+	}
+	return
+}
+
 // GetName internal sql blob getter
 func (d *DomainInfo) GetName() (o string) {
 	if d != nil {
@@ -836,6 +868,62 @@ func (w *WorkflowExecutionInfo) GetMemo() (o map[string][]byte) {
 	return
 }
 
+// GetOffloadedPayloads internal sql blob getter
+func (w *WorkflowExecutionInfo) GetOffloadedPayloads() (o map[string]int64) {
+	if w != nil {
+		return w.OffloadedPayloads
+	}
+	return
+}
+
+// GetPauseReason internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPauseReason() (o string) {
+	if w != nil {
+		return w.PauseReason
+	}
+	return
+}
+
+// GetPauseIdentity internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPauseIdentity() (o string) {
+	if w != nil {
+		return w.PauseIdentity
+	}
+	return
+}
+
+// GetPausedTimestamp internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPausedTimestamp() (o *time.Time) {
+	if w != nil {
+		return w.PausedTimestamp
+	}
+	return
+}
+
+// GetPauseAllowActivities internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPauseAllowActivities() (o bool) {
+	if w != nil {
+		return w.PauseAllowActivities
+	}
+	return
+}
+
+// GetCompletionCallbacks internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCompletionCallbacks() (o []byte) {
+	if w != nil {
+		return w.CompletionCallbacks
+	}
+	return
+}
+
+// GetCompletionCallbacksEncoding internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCompletionCallbacksEncoding() (o string) {
+	if w != nil {
+		return w.CompletionCallbacksEncoding
+	}
+	return
+}
+
 // GetSearchAttributes internal sql blob getter
 func (w *WorkflowExecutionInfo) GetSearchAttributes() (o map[string][]byte) {
 	if w != nil {
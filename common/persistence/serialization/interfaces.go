@@ -56,6 +56,10 @@ type (
 		CrossClusterProcessingQueueStatesEncoding string
 		TimerProcessingQueueStates                []byte
 		TimerProcessingQueueStatesEncoding        string
+		ReplicationProcessorStates                []byte
+		ReplicationProcessorStatesEncoding        string
+		ReplicationDLQRetryStates                 []byte
+		ReplicationDLQRetryStatesEncoding         string
 	}
 
 	// DomainInfo blob in a serialization agnostic format
@@ -161,6 +165,13 @@ type (
 		Memo                               map[string][]byte
 		VersionHistories                   []byte
 		VersionHistoriesEncoding           string
+		OffloadedPayloads                  map[string]int64
+		PauseReason                        string
+		PauseIdentity                      string
+		PausedTimestamp                    *time.Time
+		PauseAllowActivities               bool
+		CompletionCallbacks                []byte
+		CompletionCallbacksEncoding        string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -52,6 +52,10 @@ func shardInfoToThrift(info *ShardInfo) *sqlblobs.ShardInfo {
 		CrossClusterProcessingQueueStatesEncoding: &info.CrossClusterProcessingQueueStatesEncoding,
 		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
 		TimerProcessingQueueStatesEncoding:        &info.TimerProcessingQueueStatesEncoding,
+		ReplicationProcessorStates:                info.ReplicationProcessorStates,
+		ReplicationProcessorStatesEncoding:        &info.ReplicationProcessorStatesEncoding,
+		ReplicationDLQRetryStates:                 info.ReplicationDLQRetryStates,
+		ReplicationDLQRetryStatesEncoding:         &info.ReplicationDLQRetryStatesEncoding,
 		UpdatedAtNanos:                            timeToUnixNanoPtr(info.UpdatedAt),
 		TimerAckLevelNanos:                        timeToUnixNanoPtr(info.TimerAckLevel),
 	}
@@ -86,6 +90,10 @@ func shardInfoFromThrift(info *sqlblobs.ShardInfo) *ShardInfo {
 		CrossClusterProcessingQueueStatesEncoding: info.GetCrossClusterProcessingQueueStatesEncoding(),
 		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
 		TimerProcessingQueueStatesEncoding:        info.GetTimerProcessingQueueStatesEncoding(),
+		ReplicationProcessorStates:                info.ReplicationProcessorStates,
+		ReplicationProcessorStatesEncoding:        info.GetReplicationProcessorStatesEncoding(),
+		ReplicationDLQRetryStates:                 info.ReplicationDLQRetryStates,
+		ReplicationDLQRetryStatesEncoding:         info.GetReplicationDLQRetryStatesEncoding(),
 		UpdatedAt:                                 timeFromUnixNano(info.GetUpdatedAtNanos()),
 		TimerAckLevel:                             timeFromUnixNano(info.GetTimerAckLevelNanos()),
 	}
@@ -267,6 +275,13 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		Memo:                                    info.Memo,
 		VersionHistories:                        info.VersionHistories,
 		VersionHistoriesEncoding:                &info.VersionHistoriesEncoding,
+		OffloadedPayloads:                       info.OffloadedPayloads,
+		PauseReason:                             &info.PauseReason,
+		PauseIdentity:                           &info.PauseIdentity,
+		PausedTimeNanos:                         unixNanoPtr(info.PausedTimestamp),
+		PauseAllowActivities:                    &info.PauseAllowActivities,
+		CompletionCallbacks:                     info.CompletionCallbacks,
+		CompletionCallbacksEncoding:             &info.CompletionCallbacksEncoding,
 	}
 }
 
@@ -333,6 +348,13 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		Memo:                               info.Memo,
 		VersionHistories:                   info.VersionHistories,
 		VersionHistoriesEncoding:           info.GetVersionHistoriesEncoding(),
+		OffloadedPayloads:                  info.OffloadedPayloads,
+		PauseReason:                        info.GetPauseReason(),
+		PauseIdentity:                      info.GetPauseIdentity(),
+		PausedTimestamp:                    timePtr(info.PausedTimeNanos),
+		PauseAllowActivities:               info.GetPauseAllowActivities(),
+		CompletionCallbacks:                info.CompletionCallbacks,
+		CompletionCallbacksEncoding:        info.GetCompletionCallbacksEncoding(),
 	}
 }
 
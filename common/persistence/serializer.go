@@ -66,10 +66,22 @@ type (
 		SerializePendingFailoverMarkers(markers []*types.FailoverMarkerAttributes, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializePendingFailoverMarkers(data *DataBlob) ([]*types.FailoverMarkerAttributes, error)
 
+		// serialize/deserialize the delivery state of completion callbacks
+		SerializeCompletionCallbackInfos(infos []*types.CompletionCallbackInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeCompletionCallbackInfos(data *DataBlob) ([]*types.CompletionCallbackInfo, error)
+
 		// serialize/deserialize processing queue statesss
 		SerializeProcessingQueueStates(states *types.ProcessingQueueStates, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeProcessingQueueStates(data *DataBlob) (*types.ProcessingQueueStates, error)
 
+		// serialize/deserialize replication task processor states
+		SerializeReplicationProcessorStates(states *types.ReplicationProcessorStates, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeReplicationProcessorStates(data *DataBlob) (*types.ReplicationProcessorStates, error)
+
+		// serialize/deserialize replication DLQ retry states
+		SerializeReplicationDLQRetryStates(states *types.ReplicationDLQRetryStates, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeReplicationDLQRetryStates(data *DataBlob) (*types.ReplicationDLQRetryStates, error)
+
 		// serialize/deserialize DynamicConfigBlob
 		SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeDynamicConfigBlob(data *DataBlob) (*types.DynamicConfigBlob, error)
@@ -214,6 +226,29 @@ func (t *serializerImpl) DeserializePendingFailoverMarkers(
 	return markers, err
 }
 
+func (t *serializerImpl) SerializeCompletionCallbackInfos(
+	infos []*types.CompletionCallbackInfo,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if infos == nil {
+		return nil, nil
+	}
+	return t.serialize(infos, encodingType)
+}
+
+func (t *serializerImpl) DeserializeCompletionCallbackInfos(
+	data *DataBlob,
+) ([]*types.CompletionCallbackInfo, error) {
+
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil
+	}
+	var infos []*types.CompletionCallbackInfo
+	err := t.deserialize(data, &infos)
+	return infos, err
+}
+
 func (t *serializerImpl) SerializeProcessingQueueStates(
 	states *types.ProcessingQueueStates,
 	encodingType common.EncodingType,
@@ -239,6 +274,56 @@ func (t *serializerImpl) DeserializeProcessingQueueStates(
 	return &states, err
 }
 
+func (t *serializerImpl) SerializeReplicationProcessorStates(
+	states *types.ReplicationProcessorStates,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+	if states == nil {
+		return nil, nil
+	}
+	return t.serialize(states, encodingType)
+}
+
+func (t *serializerImpl) DeserializeReplicationProcessorStates(
+	data *DataBlob,
+) (*types.ReplicationProcessorStates, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var states types.ReplicationProcessorStates
+	if data != nil && len(data.Data) == 0 {
+		return &states, nil
+	}
+	err := t.deserialize(data, &states)
+	return &states, err
+}
+
+func (t *serializerImpl) SerializeReplicationDLQRetryStates(
+	states *types.ReplicationDLQRetryStates,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+	if states == nil {
+		return nil, nil
+	}
+	return t.serialize(states, encodingType)
+}
+
+func (t *serializerImpl) DeserializeReplicationDLQRetryStates(
+	data *DataBlob,
+) (*types.ReplicationDLQRetryStates, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var states types.ReplicationDLQRetryStates
+	if len(data.Data) == 0 {
+		return &states, nil
+	}
+	err := t.deserialize(data, &states)
+	return &states, err
+}
+
 func (t *serializerImpl) SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType common.EncodingType) (*DataBlob, error) {
 	if blob == nil {
 		return nil, nil
@@ -300,8 +385,14 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromVersionHistories(input))
 	case []*types.FailoverMarkerAttributes:
 		return t.thriftrwEncoder.Encode(&replicator.FailoverMarkers{FailoverMarkers: thrift.FromFailoverMarkerAttributesArray(input)})
+	case []*types.CompletionCallbackInfo:
+		return t.thriftrwEncoder.Encode(&workflow.CompletionCallbackInfos{Callbacks: thrift.FromCompletionCallbackInfoArray(input)})
 	case *types.ProcessingQueueStates:
 		return t.thriftrwEncoder.Encode(thrift.FromProcessingQueueStates(input))
+	case *types.ReplicationProcessorStates:
+		return t.thriftrwEncoder.Encode(thrift.FromReplicationProcessorStates(input))
+	case *types.ReplicationDLQRetryStates:
+		return t.thriftrwEncoder.Encode(thrift.FromReplicationDLQRetryStates(input))
 	case *types.DynamicConfigBlob:
 		return t.thriftrwEncoder.Encode(thrift.FromDynamicConfigBlob(input))
 	default:
@@ -384,6 +475,13 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = thrift.ToFailoverMarkerAttributesArray(thriftTarget.GetFailoverMarkers())
 		return nil
+	case *[]*types.CompletionCallbackInfo:
+		thriftTarget := workflow.CompletionCallbackInfos{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToCompletionCallbackInfoArray(thriftTarget.GetCallbacks())
+		return nil
 	case *types.ProcessingQueueStates:
 		thriftTarget := history.ProcessingQueueStates{}
 		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
@@ -391,6 +489,20 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToProcessingQueueStates(&thriftTarget)
 		return nil
+	case *types.ReplicationProcessorStates:
+		thriftTarget := history.ReplicationProcessorStates{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToReplicationProcessorStates(&thriftTarget)
+		return nil
+	case *types.ReplicationDLQRetryStates:
+		thriftTarget := history.ReplicationDLQRetryStates{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToReplicationDLQRetryStates(&thriftTarget)
+		return nil
 	case *types.DynamicConfigBlob:
 		thriftTarget := config.DynamicConfigBlob{}
 		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
@@ -167,6 +167,35 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	}
 	processingQueueStates := &types.ProcessingQueueStates{StatesByCluster: processingQueueStateMap}
 
+	replicationProcessorStates := &types.ReplicationProcessorStates{
+		StatesByCluster: map[string]*types.ReplicationProcessorState{
+			"cluster": {
+				ReplicatedUpToTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+				FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+					"domain-id": {Count: 2, LastTimestamp: time.Now().UnixNano(), LastError: "some error"},
+				},
+			},
+		},
+	}
+
+	replicationDLQRetryStates := &types.ReplicationDLQRetryStates{
+		StatesByCluster: map[string]*types.ReplicationDLQRetryState{
+			"cluster": {
+				Cursor: 10,
+				TaskStates: []*types.ReplicationDLQTaskState{
+					{
+						TaskID:               5,
+						ErrorClass:           "history-gap",
+						Attempts:             2,
+						LastError:            "some error",
+						LastAttemptTimestamp: time.Now().UnixNano(),
+						NextAttemptTimestamp: time.Now().Add(time.Minute).UnixNano(),
+					},
+				},
+			},
+		},
+	}
+
 	boolFalseEnc, _ := json.Marshal(false)
 	dcBlob := &types.DynamicConfigBlob{
 		SchemaVersion: 1,
@@ -493,6 +522,38 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.Equal(dProcessingQueueStatesEmpty, processingQueueStates)
 
+			// serialize and deserialize replication processor states
+
+			nilReplicationProcessorStates, err := serializer.SerializeReplicationProcessorStates(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilReplicationProcessorStates)
+
+			replicationProcessorStatesThrift, err := serializer.SerializeReplicationProcessorStates(replicationProcessorStates, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(replicationProcessorStatesThrift)
+
+			dNilReplicationProcessorStates, err := serializer.DeserializeReplicationProcessorStates(nil)
+			s.Nil(err)
+			s.Nil(dNilReplicationProcessorStates)
+
+			dReplicationProcessorStatesThrift, err := serializer.DeserializeReplicationProcessorStates(replicationProcessorStatesThrift)
+			s.Nil(err)
+			s.Equal(replicationProcessorStates, dReplicationProcessorStatesThrift)
+
+			// serialize and deserialize replication DLQ retry states
+
+			nilReplicationDLQRetryStates, err := serializer.SerializeReplicationDLQRetryStates(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilReplicationDLQRetryStates)
+
+			replicationDLQRetryStatesThrift, err := serializer.SerializeReplicationDLQRetryStates(replicationDLQRetryStates, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(replicationDLQRetryStatesThrift)
+
+			dReplicationDLQRetryStatesThrift, err := serializer.DeserializeReplicationDLQRetryStates(replicationDLQRetryStatesThrift)
+			s.Nil(err)
+			s.Equal(replicationDLQRetryStates, dReplicationDLQRetryStatesThrift)
+
 			// serialize dynamic config blob
 
 			nilDynamicConfigBlob, err := serializer.SerializeDynamicConfigBlob(nil, common.EncodingTypeJSON)
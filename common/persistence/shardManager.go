@@ -116,6 +116,14 @@ func (m *shardManager) toInternalShardInfo(shardInfo *ShardInfo) (*InternalShard
 	if err != nil {
 		return nil, err
 	}
+	serializedReplicationProcessorStates, err := m.serializer.SerializeReplicationProcessorStates(shardInfo.ReplicationProcessorStates, common.EncodingTypeThriftRW)
+	if err != nil {
+		return nil, err
+	}
+	serializedReplicationDLQRetryStates, err := m.serializer.SerializeReplicationDLQRetryStates(shardInfo.ReplicationDLQRetryStates, common.EncodingTypeThriftRW)
+	if err != nil {
+		return nil, err
+	}
 
 	return &InternalShardInfo{
 		ShardID:                           shardInfo.ShardID,
@@ -135,6 +143,8 @@ func (m *shardManager) toInternalShardInfo(shardInfo *ShardInfo) (*InternalShard
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         shardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		ReplicationProcessorStates:        serializedReplicationProcessorStates,
+		ReplicationDLQRetryStates:         serializedReplicationDLQRetryStates,
 	}, nil
 }
 
@@ -158,6 +168,14 @@ func (m *shardManager) fromInternalShardInfo(internalShardInfo *InternalShardInf
 	if err != nil {
 		return nil, err
 	}
+	replicationProcessorStates, err := m.serializer.DeserializeReplicationProcessorStates(internalShardInfo.ReplicationProcessorStates)
+	if err != nil {
+		return nil, err
+	}
+	replicationDLQRetryStates, err := m.serializer.DeserializeReplicationDLQRetryStates(internalShardInfo.ReplicationDLQRetryStates)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ShardInfo{
 		ShardID:                           internalShardInfo.ShardID,
@@ -177,5 +195,7 @@ func (m *shardManager) fromInternalShardInfo(internalShardInfo *InternalShardInf
 		ClusterReplicationLevel:           internalShardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         internalShardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		ReplicationProcessorStates:        replicationProcessorStates,
+		ReplicationDLQRetryStates:         replicationDLQRetryStates,
 	}, nil
 }
@@ -1374,6 +1374,16 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		OffloadedPayloads:                  info.GetOffloadedPayloads(),
+	}
+
+	if pausedTimestamp := info.GetPausedTimestamp(); pausedTimestamp != nil {
+		state.ExecutionInfo.PauseInfo = &p.WorkflowPauseInfo{
+			Reason:          info.GetPauseReason(),
+			Identity:        info.GetPauseIdentity(),
+			PausedTimestamp: *pausedTimestamp,
+			AllowActivities: info.GetPauseAllowActivities(),
+		}
 	}
 
 	// TODO: remove this after all 2DC workflows complete
@@ -1417,6 +1427,11 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		state.ExecutionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
 			common.EncodingType(info.GetAutoResetPointsEncoding()))
 	}
+
+	if info.CompletionCallbacks != nil {
+		state.ExecutionInfo.CompletionCallbacks = p.NewDataBlob(info.CompletionCallbacks,
+			common.EncodingType(info.GetCompletionCallbacksEncoding()))
+	}
 	return state, nil
 }
 
@@ -937,11 +937,15 @@ func createTransferTasks(
 				info.TargetDomainIDs = append(info.TargetDomainIDs, serialization.MustParseUUID(targetDomainID))
 			}
 
+		case p.TransferTaskTypeHistoryExport:
+			info.ScheduleID = task.(*p.HistoryExportTask).FirstEventID
+
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
 			p.TransferTaskTypeUpsertWorkflowSearchAttributes,
-			p.TransferTaskTypeRecordWorkflowClosed:
+			p.TransferTaskTypeRecordWorkflowClosed,
+			p.TransferTaskTypeCompletionCallback:
 			// No explicit property needs to be set
 
 		default:
@@ -1021,7 +1025,7 @@ func createReplicationTasks(
 			version = task.GetVersion()
 			activityScheduleID = task.(*p.SyncActivityTask).ScheduledID
 
-		case p.ReplicationTaskTypeFailoverMarker:
+		case p.ReplicationTaskTypeFailoverMarker, p.ReplicationTaskTypeSyncCompletionCallbacks:
 			version = task.GetVersion()
 
 		default:
@@ -1128,6 +1132,9 @@ func createTimerTasks(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.CompletionCallbackRetryTimerTask:
+			// noop
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -1402,6 +1409,7 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:            string(executionInfo.AutoResetPoints.GetEncoding()),
 		SearchAttributes:                   executionInfo.SearchAttributes,
 		Memo:                               executionInfo.Memo,
+		OffloadedPayloads:                  executionInfo.OffloadedPayloads,
 		CompletionEventEncoding:            string(common.EncodingTypeEmpty),
 		VersionHistoriesEncoding:           string(common.EncodingTypeEmpty),
 		InitiatedID:                        common.EmptyEventID,
@@ -1434,6 +1442,18 @@ func buildExecutionRow(
 		info.CancelRequestID = executionInfo.CancelRequestID
 	}
 
+	if pauseInfo := executionInfo.PauseInfo; pauseInfo != nil {
+		info.PauseReason = pauseInfo.Reason
+		info.PauseIdentity = pauseInfo.Identity
+		info.PausedTimestamp = common.TimePtr(pauseInfo.PausedTimestamp)
+		info.PauseAllowActivities = pauseInfo.AllowActivities
+	}
+
+	if executionInfo.CompletionCallbacks != nil {
+		info.CompletionCallbacks = executionInfo.CompletionCallbacks.Data
+		info.CompletionCallbacksEncoding = string(executionInfo.CompletionCallbacks.GetEncoding())
+	}
+
 	blob, err := parser.WorkflowExecutionInfoToBlob(info)
 	if err != nil {
 		return nil, err
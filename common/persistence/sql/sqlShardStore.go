@@ -145,6 +145,22 @@ func (m *sqlShardStore) GetShard(
 		}
 	}
 
+	var replicationPS *persistence.DataBlob
+	if shardInfo.GetReplicationProcessorStates() != nil {
+		replicationPS = &persistence.DataBlob{
+			Encoding: common.EncodingType(shardInfo.GetReplicationProcessorStatesEncoding()),
+			Data:     shardInfo.GetReplicationProcessorStates(),
+		}
+	}
+
+	var replicationDLQRS *persistence.DataBlob
+	if shardInfo.GetReplicationDLQRetryStates() != nil {
+		replicationDLQRS = &persistence.DataBlob{
+			Encoding: common.EncodingType(shardInfo.GetReplicationDLQRetryStatesEncoding()),
+			Data:     shardInfo.GetReplicationDLQRetryStates(),
+		}
+	}
+
 	resp := &persistence.InternalGetShardResponse{ShardInfo: &persistence.InternalShardInfo{
 		ShardID:                           int(row.ShardID),
 		RangeID:                           row.RangeID,
@@ -162,6 +178,8 @@ func (m *sqlShardStore) GetShard(
 		DomainNotificationVersion:         shardInfo.GetDomainNotificationVersion(),
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		ReplicationDLQAckLevel:            shardInfo.ReplicationDlqAckLevel,
+		ReplicationProcessorStates:        replicationPS,
+		ReplicationDLQRetryStates:         replicationDLQRS,
 	}}
 
 	return resp, nil
@@ -269,6 +287,20 @@ func shardInfoToShardsRow(s persistence.InternalShardInfo, parser serialization.
 		timerPQSEncoding = string(s.TimerProcessingQueueStates.Encoding)
 	}
 
+	var replicationPSData []byte
+	replicationPSEncoding := string(common.EncodingTypeEmpty)
+	if s.ReplicationProcessorStates != nil {
+		replicationPSData = s.ReplicationProcessorStates.Data
+		replicationPSEncoding = string(s.ReplicationProcessorStates.Encoding)
+	}
+
+	var replicationDLQRSData []byte
+	replicationDLQRSEncoding := string(common.EncodingTypeEmpty)
+	if s.ReplicationDLQRetryStates != nil {
+		replicationDLQRSData = s.ReplicationDLQRetryStates.Data
+		replicationDLQRSEncoding = string(s.ReplicationDLQRetryStates.Encoding)
+	}
+
 	shardInfo := &serialization.ShardInfo{
 		StolenSinceRenew:                          int32(s.StolenSinceRenew),
 		UpdatedAt:                                 s.UpdatedAt,
@@ -289,6 +321,10 @@ func shardInfoToShardsRow(s persistence.InternalShardInfo, parser serialization.
 		ReplicationDlqAckLevel:                    s.ReplicationDLQAckLevel,
 		PendingFailoverMarkers:                    markerData,
 		PendingFailoverMarkersEncoding:            markerEncoding,
+		ReplicationProcessorStates:                replicationPSData,
+		ReplicationProcessorStatesEncoding:        replicationPSEncoding,
+		ReplicationDLQRetryStates:                 replicationDLQRSData,
+		ReplicationDLQRetryStatesEncoding:         replicationDLQRSEncoding,
 	}
 
 	blob, err := parser.ShardInfoToBlob(shardInfo)
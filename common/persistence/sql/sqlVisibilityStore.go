@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -30,7 +31,9 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -40,16 +43,29 @@ import (
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of queries on visibility, it holds the sort value
+	// and run ID of the last row of the page, as rows are sorted by the search attribute of the query
+	// and then by run ID
+	visibilityQueryPageToken struct {
+		SortValue json.RawMessage `json:",omitempty"`
+		RunID     string
+	}
+)
+
+const (
+	defaultVisibilityQueryPageSize = 1000
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, validSearchAttributes dynamicconfig.MapPropertyFn, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -59,6 +75,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		validSearchAttributes: validSearchAttributes,
 	}, nil
 }
 
@@ -66,7 +83,11 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.datetimeSearchAttributes())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -75,8 +96,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
 		IsCron:           request.IsCron,
 		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
 	})
 
 	if err != nil {
@@ -89,6 +112,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest,
 ) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.datetimeSearchAttributes())
+	if err != nil {
+		return err
+	}
 	closeTime := request.CloseTimestamp
 	result, err := s.db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -102,8 +129,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
 		IsCron:           request.IsCron,
 		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -129,7 +158,28 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.NewOperationNotSupportErrorForVis()
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.datetimeSearchAttributes())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        request.StartTimestamp,
+		ExecutionTime:    request.ExecutionTimestamp,
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
+		IsCron:           request.IsCron,
+		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -296,21 +346,134 @@ func (s *sqlVisibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.datetimeSearchAttributes())
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	response := &p.CountWorkflowExecutionsResponse{Count: count}
+	if len(request.GroupBy) > 0 {
+		if response.Groups, response.Truncated, err = s.countWorkflowExecutionsGroups(ctx, request, query); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *sqlVisibilityStore) countWorkflowExecutionsGroups(
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
+	query *sqlplugin.VisibilityQuery,
+) ([]*types.CountWorkflowExecutionsGroup, bool, error) {
+	groupBy, err := sqlplugin.ParseVisibilityGroupBy(request.GroupBy)
+	if err != nil {
+		return nil, false, &types.BadRequestError{Message: fmt.Sprintf("Invalid group by: %v", err)}
+	}
+	// one extra group is read to tell whether the groups are truncated
+	rows, err := s.db.CountGroupsFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		GroupBy:  groupBy,
+		PageSize: request.MaxGroups + 1,
+	})
+	if err != nil {
+		return nil, false, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	truncated := len(rows) > request.MaxGroups
+	if truncated {
+		rows = rows[:request.MaxGroups]
+	}
+
+	groups := make([]*types.CountWorkflowExecutionsGroup, len(rows))
+	for i, row := range rows {
+		var values []json.RawMessage
+		if err := json.Unmarshal(row.GroupValues, &values); err != nil {
+			return nil, false, &types.InternalServiceError{Message: fmt.Sprintf("CountWorkflowExecutions failed to decode group values: %v", err)}
+		}
+		groupValues := make([]string, len(values))
+		for j, value := range values {
+			groupValues[j] = string(value)
+		}
+		groups[i] = &types.CountWorkflowExecutionsGroup{GroupValues: groupValues, Count: row.Count}
+	}
+	return groups, truncated, nil
+}
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.datetimeSearchAttributes())
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	var after *sqlplugin.VisibilityQueryCursor
+	if len(request.NextPageToken) > 0 {
+		var token visibilityQueryPageToken
+		if err := json.Unmarshal(request.NextPageToken, &token); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+		if after, err = query.ParseCursor(token.SortValue, token.RunID); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+	}
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultVisibilityQueryPageSize
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: pageSize,
+		After:    after,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		rows[i].DomainID = request.DomainUUID
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		lastRow := &rows[len(rows)-1]
+		sortValue, err := query.SortValue(lastRow)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: fmt.Sprintf("%v failed to read sort value: %v", opName, err)}
+		}
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{SortValue: sortValue, RunID: lastRow.RunID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -326,6 +489,10 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		IsCron:        row.IsCron,
 		NumClusters:   row.NumClusters,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
+		TaskList:      row.TaskList,
+	}
+	if len(row.SearchAttributes) > 0 {
+		info.SearchAttributes = deserializeSearchAttributes(row.SearchAttributes, s.logger)
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
@@ -387,3 +554,53 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// datetimeSearchAttributes returns the set of custom search attributes of datetime type
+func (s *sqlVisibilityStore) datetimeSearchAttributes() map[string]bool {
+	if s.validSearchAttributes == nil {
+		return nil
+	}
+	result := make(map[string]bool)
+	for name, valueType := range s.validSearchAttributes() {
+		if common.ConvertIndexedValueTypeToThriftType(valueType, s.logger) == workflow.IndexedValueTypeDatetime {
+			result[name] = true
+		}
+	}
+	return result
+}
+
+// serializeSearchAttributes encodes search attributes, whose values are JSON already, as a JSON object.
+// Values of datetime attributes are normalized to unix nanos.
+func serializeSearchAttributes(attributes map[string][]byte, datetimeAttributes map[string]bool) ([]byte, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage, len(attributes))
+	for key, value := range attributes {
+		if datetimeAttributes[key] {
+			normalized, err := sqlplugin.NormalizeVisibilityDatetime(value)
+			if err != nil {
+				return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid value of datetime search attribute %v: %v", key, err)}
+			}
+			value = normalized
+		}
+		fields[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid search attributes: %v", err)}
+	}
+	return data, nil
+}
+
+func deserializeSearchAttributes(data []byte, logger log.Logger) map[string]interface{} {
+	var attributes map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// keep numbers as they were written, int64 values do not fit in a float64
+	decoder.UseNumber()
+	if err := decoder.Decode(&attributes); err != nil {
+		logger.Error("failed to deserialize search attributes", tag.Error(err))
+		return nil
+	}
+	return attributes
+}
@@ -536,8 +536,11 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskList         string
 		IsCron           bool
 		NumClusters      int16
+		// SearchAttributes is a JSON object holding the custom search attributes of the workflow
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -554,6 +557,26 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the visibility query used to select or count rows of
+	// executions_visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		PageSize int
+		// After is the position of the last row of the previous page, nil for the first page
+		After *VisibilityQueryCursor
+		// GroupBy is the list of search attributes to count the rows by, PageSize limits the number of groups
+		GroupBy VisibilityGroupBy
+	}
+
+	// VisibilityGroupRow represents the number of rows in executions_visibility table sharing the same
+	// values of the group by search attributes
+	VisibilityGroupRow struct {
+		// GroupValues is a JSON array holding the values of the group by search attributes
+		GroupValues []byte
+		Count       int64
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -785,6 +808,20 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table, or updates the memo and search attributes
+		// of the row if it already exists
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of rows from visibility table matching the visibility query
+		// Required filter params - {domainID, query, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows in visibility table matching the visibility query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
+		// CountGroupsFromVisibilityByQuery returns the number of rows in visibility table matching the visibility
+		// query for each distinct combination of values of the group by search attributes, largest groups first
+		// and groups with the same count ordered by these values
+		// Required filter params - {domainID, query, groupBy, pageSize}
+		CountGroupsFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityGroupRow, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
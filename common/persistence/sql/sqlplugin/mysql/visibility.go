@@ -25,18 +25,25 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), search_attributes = VALUES(search_attributes)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -72,8 +79,39 @@ const (
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length, task_list, num_clusters, search_attributes
+		 FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountGroupsFromVisibilityByQuery = `SELECT %s AS group_values, COUNT(*) AS count FROM executions_visibility WHERE domain_id = ?`
 )
 
+// visibilityQueryDialect renders visibility queries for MySQL, custom search attributes are read
+// from the search_attributes JSON column
+type visibilityQueryDialect struct{}
+
+func (visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+func (visibilityQueryDialect) SearchAttribute(name string) string {
+	return fmt.Sprintf(`JSON_EXTRACT(search_attributes, '$."%s"')`, name)
+}
+
+func (visibilityQueryDialect) JSONValue(param string) string {
+	return fmt.Sprintf("CAST(%s AS JSON)", param)
+}
+
+func (visibilityQueryDialect) JSONContains(target string, candidate string) string {
+	return fmt.Sprintf("JSON_CONTAINS(%s, %s)", target, candidate)
+}
+
+func (visibilityQueryDialect) JSONArray(exprs []string) string {
+	return "JSON_ARRAY(" + strings.Join(exprs, ", ") + ")"
+}
+
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -92,8 +130,10 @@ func (mdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
+		row.TaskList,
 		row.IsCron,
-		row.NumClusters)
+		row.NumClusters,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -117,13 +157,37 @@ func (mdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskList,
 			row.IsCron,
-			row.NumClusters)
+			row.NumClusters,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table, or updates the memo and search attributes
+// of the row if it already exists
+func (mdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		searchAttributesParam(row.SearchAttributes))
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
@@ -223,3 +287,81 @@ func (mdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads a page of rows matching the visibility query from visibility table
+func (mdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	query := templateGetWorkflowExecutionsByQuery
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+	if filter.After != nil {
+		query += " AND " + filter.Query.RenderAfter(stmt, filter.After)
+	}
+	query += " ORDER BY " + stmt.OrderBy + " LIMIT " + stmt.Bind(filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, mdb.toMySQLArgs(stmt.Args)...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching the visibility query in visibility table
+func (mdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	query := templateCountWorkflowExecutionsByQuery
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, mdb.toMySQLArgs(stmt.Args)...)
+	return count, err
+}
+
+// CountGroupsFromVisibilityByQuery counts the rows matching the visibility query in visibility table by
+// the values of the group by search attributes
+func (mdb *db) CountGroupsFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityGroupRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	groupBy, groupValues := filter.GroupBy.Render(visibilityQueryDialect{})
+	query := fmt.Sprintf(templateCountGroupsFromVisibilityByQuery, groupValues)
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+	fields := strings.Join(groupBy, ", ")
+	query += " GROUP BY " + fields + " ORDER BY COUNT(*) DESC, " + fields + " LIMIT " + stmt.Bind(filter.PageSize)
+
+	var rows []sqlplugin.VisibilityGroupRow
+	err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, mdb.toMySQLArgs(stmt.Args)...)
+	return rows, err
+}
+
+func (mdb *db) toMySQLArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	return args
+}
+
+// searchAttributesParam returns the search_attributes column value, JSON columns do not accept
+// binary strings so the document is passed as a string
+func searchAttributesParam(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -26,19 +26,28 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+         ON CONFLICT (domain_id, run_id) DO UPDATE
+           SET memo = excluded.memo,
+               encoding = excluded.encoding,
+               search_attributes = excluded.search_attributes`
+
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -49,8 +58,10 @@ const (
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
 			  encoding = excluded.encoding,
+			  task_list = excluded.task_list,
 				is_cron = excluded.is_cron,
-				num_clusters = excluded.num_clusters`
+				num_clusters = excluded.num_clusters,
+				search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -93,8 +104,39 @@ const (
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
+
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length, task_list, num_clusters, search_attributes
+		 FROM executions_visibility WHERE domain_id = $1`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = $1`
+
+	templateCountGroupsFromVisibilityByQuery = `SELECT %s AS group_values, COUNT(*) AS count FROM executions_visibility WHERE domain_id = $1`
 )
 
+// visibilityQueryDialect renders visibility queries for PostgreSQL, custom search attributes are read
+// from the search_attributes JSONB column
+type visibilityQueryDialect struct{}
+
+func (visibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (visibilityQueryDialect) SearchAttribute(name string) string {
+	return fmt.Sprintf("(search_attributes->'%s')", name)
+}
+
+func (visibilityQueryDialect) JSONValue(param string) string {
+	return fmt.Sprintf("CAST(%s AS jsonb)", param)
+}
+
+func (visibilityQueryDialect) JSONContains(target string, candidate string) string {
+	return fmt.Sprintf("%s @> %s", target, candidate)
+}
+
+func (visibilityQueryDialect) JSONArray(exprs []string) string {
+	return "jsonb_build_array(" + strings.Join(exprs, ", ") + ")"
+}
+
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -111,8 +153,10 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
+		row.TaskList,
 		row.IsCron,
-		row.NumClusters)
+		row.NumClusters,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -134,13 +178,35 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskList,
 			row.IsCron,
-			row.NumClusters)
+			row.NumClusters,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table, or updates the memo and search attributes
+// of the row if it already exists
+func (pdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		searchAttributesParam(row.SearchAttributes))
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
@@ -236,3 +302,83 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads a page of rows matching the visibility query from visibility table
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	query := templateGetWorkflowExecutionsByQuery
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+	if filter.After != nil {
+		query += " AND " + filter.Query.RenderAfter(stmt, filter.After)
+	}
+	query += " ORDER BY " + stmt.OrderBy + " LIMIT " + stmt.Bind(filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, pdb.toPostgresArgs(stmt.Args)...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching the visibility query in visibility table
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	query := templateCountWorkflowExecutionsByQuery
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+
+	var count int64
+	err := pdb.driver.GetContext(ctx, dbShardID, &count, query, pdb.toPostgresArgs(stmt.Args)...)
+	return count, err
+}
+
+// CountGroupsFromVisibilityByQuery counts the rows matching the visibility query in visibility table by
+// the values of the group by search attributes
+func (pdb *db) CountGroupsFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityGroupRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	stmt := filter.Query.Render(visibilityQueryDialect{}, filter.DomainID)
+	groupBy, groupValues := filter.GroupBy.Render(visibilityQueryDialect{})
+	query := fmt.Sprintf(templateCountGroupsFromVisibilityByQuery, groupValues)
+	if stmt.Where != "" {
+		query += " AND (" + stmt.Where + ")"
+	}
+	fields := strings.Join(groupBy, ", ")
+	query += " GROUP BY " + fields + " ORDER BY COUNT(*) DESC, " + fields + " LIMIT " + stmt.Bind(filter.PageSize)
+
+	var rows []sqlplugin.VisibilityGroupRow
+	err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, pdb.toPostgresArgs(stmt.Args)...)
+	return rows, err
+}
+
+func (pdb *db) toPostgresArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgresDateTime(t)
+		}
+	}
+	return args
+}
+
+// searchAttributesParam returns the search_attributes column value, JSONB columns do not accept
+// bytea values so the document is passed as a string
+func searchAttributesParam(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,710 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// VisibilityQuery is a visibility query (the SQL like query syntax of advanced visibility, already
+	// validated by the frontend) parsed into a form which can be rendered as a parameterized
+	// condition for any of the supported databases
+	VisibilityQuery struct {
+		where   visibilityQueryNode
+		orderBy visibilityQueryOrder
+	}
+
+	// VisibilityQueryDialect provides the database specific syntax used to render a VisibilityQuery
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind parameter for the n-th argument of the statement, starting from 1
+		Placeholder(n int) string
+		// SearchAttribute returns the expression reading a custom search attribute from the
+		// search_attributes JSON column
+		SearchAttribute(name string) string
+		// JSONValue returns the expression converting a bind parameter holding a JSON document to a JSON value
+		JSONValue(param string) string
+		// JSONContains returns the condition which is true if the JSON value target equals candidate, or
+		// contains candidate as one of its elements when target is an array
+		JSONContains(target string, candidate string) string
+		// JSONArray returns the expression building a JSON array from the values of the given expressions
+		JSONArray(exprs []string) string
+	}
+
+	// VisibilityQueryCursor is the position of the last row of a page in the sort order of a
+	// VisibilityQuery, the next page is read from the rows which come after it
+	VisibilityQueryCursor struct {
+		// sortValue is the value of the sort field of the row, nil if the field is not set
+		sortValue interface{}
+		runID     string
+	}
+
+	// VisibilityGroupBy is the list of search attributes the rows matching a visibility query are counted by
+	VisibilityGroupBy []visibilityQueryField
+
+	// VisibilityQueryStatement is the rendered form of a VisibilityQuery
+	VisibilityQueryStatement struct {
+		// Where is the condition of the query, empty if the query has no condition
+		Where string
+		// OrderBy is the sort order of the query, including the tie-breaker on run_id
+		OrderBy string
+		// Args are the bind arguments of the statement, including the ones bound before rendering
+		Args []interface{}
+
+		dialect VisibilityQueryDialect
+	}
+
+	visibilityQueryNode interface {
+		render(s *VisibilityQueryStatement) string
+	}
+
+	visibilityQueryAndOr struct {
+		operator    string
+		left, right visibilityQueryNode
+	}
+
+	visibilityQueryParen struct {
+		expr visibilityQueryNode
+	}
+
+	// visibilityQueryComparison compares a column or a custom search attribute with one or more values
+	visibilityQueryComparison struct {
+		field    visibilityQueryField
+		operator string
+		values   []interface{}
+	}
+
+	// visibilityQueryMissing checks whether a column has no value, e.g. CloseTime = missing for open workflows
+	visibilityQueryMissing struct {
+		column  string
+		missing bool
+	}
+
+	visibilityQueryField struct {
+		// column is set for system search attributes, which are stored in their own column
+		column string
+		// searchAttribute is set for custom search attributes, which are stored in the search_attributes column
+		searchAttribute string
+		// datetime is set for custom search attributes of datetime type, which are stored as unix nanos
+		datetime bool
+	}
+
+	visibilityQueryOrder struct {
+		field visibilityQueryField
+		desc  bool
+	}
+)
+
+const (
+	visibilityQueryMissingValue = "missing"
+)
+
+var (
+	// visibilityColumns maps system search attributes to the columns of executions_visibility table
+	visibilityColumns = map[string]string{
+		definition.DomainID:      "domain_id",
+		definition.WorkflowID:    "workflow_id",
+		definition.RunID:         "run_id",
+		definition.WorkflowType:  "workflow_type_name",
+		definition.StartTime:     "start_time",
+		definition.ExecutionTime: "execution_time",
+		definition.CloseTime:     "close_time",
+		definition.CloseStatus:   "close_status",
+		definition.HistoryLength: "history_length",
+		definition.TaskList:      "task_list",
+		definition.IsCron:        "is_cron",
+		definition.NumClusters:   "num_clusters",
+	}
+
+	visibilityTimeColumns = map[string]bool{
+		"start_time":     true,
+		"execution_time": true,
+		"close_time":     true,
+	}
+
+	// visibilityNullableColumns are the columns which are not set for open workflows
+	visibilityNullableColumns = map[string]bool{
+		"close_time":     true,
+		"close_status":   true,
+		"history_length": true,
+	}
+
+	visibilityQueryOperators = map[string]bool{
+		sqlparser.EqualStr:        true,
+		sqlparser.NotEqualStr:     true,
+		sqlparser.LessThanStr:     true,
+		sqlparser.LessEqualStr:    true,
+		sqlparser.GreaterThanStr:  true,
+		sqlparser.GreaterEqualStr: true,
+		sqlparser.InStr:           true,
+		sqlparser.NotInStr:        true,
+		sqlparser.BetweenStr:      true,
+		sqlparser.NotBetweenStr:   true,
+	}
+
+	searchAttributeNameRegex = regexp.MustCompile(`^\w+$`)
+
+	defaultVisibilityQueryOrder = visibilityQueryOrder{field: visibilityQueryField{column: "start_time"}, desc: true}
+)
+
+// ParseVisibilityQuery parses a visibility query validated by the frontend, i.e. with custom search
+// attributes prefixed with definition.Attr. Values of time columns and datetime search attributes may
+// be given either as unix nanos or RFC3339 strings, and values of CloseStatus either as numbers or
+// names of the close status. datetimeAttributes is the set of custom search attributes of datetime type.
+func ParseVisibilityQuery(query string, datetimeAttributes map[string]bool) (*VisibilityQuery, error) {
+	result := &VisibilityQuery{orderBy: defaultVisibilityQueryOrder}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return result, nil
+	}
+
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+
+	if sel.Where != nil {
+		if result.where, err = parseVisibilityQueryExpr(sel.Where.Expr, datetimeAttributes); err != nil {
+			return nil, err
+		}
+	}
+	switch len(sel.OrderBy) {
+	case 0:
+	case 1:
+		field, err := parseVisibilityQueryField(sel.OrderBy[0].Expr, datetimeAttributes)
+		if err != nil {
+			return nil, err
+		}
+		result.orderBy = visibilityQueryOrder{field: field, desc: sel.OrderBy[0].Direction == sqlparser.DescScr}
+	default:
+		return nil, errors.New("only one field can be used to sort")
+	}
+	return result, nil
+}
+
+// Render renders the query with the given dialect. Args are the bind arguments which precede
+// the condition of the query in the statement, e.g. the domain ID.
+func (q *VisibilityQuery) Render(dialect VisibilityQueryDialect, args ...interface{}) *VisibilityQueryStatement {
+	s := &VisibilityQueryStatement{
+		Args:    args,
+		dialect: dialect,
+	}
+	if q.where != nil {
+		s.Where = q.where.render(s)
+	}
+	direction := "ASC"
+	if q.orderBy.desc {
+		direction = "DESC"
+	}
+	field := q.orderBy.field.render(s)
+	s.OrderBy = fmt.Sprintf("%s %s, run_id", field, direction)
+	if q.orderBy.field.nullable() {
+		// rows without the sort field come last in both directions, as the order of NULL values
+		// differs between databases
+		s.OrderBy = fmt.Sprintf("%s IS NULL, %s", field, s.OrderBy)
+	}
+	return s
+}
+
+// RenderAfter renders the condition selecting the rows which come after the cursor in the sort order
+// of the query, binding its arguments to the statement rendered from the query
+func (q *VisibilityQuery) RenderAfter(s *VisibilityQueryStatement, cursor *VisibilityQueryCursor) string {
+	field := q.orderBy.field.render(s)
+	if cursor.sortValue == nil {
+		return fmt.Sprintf("(%s IS NULL AND run_id > %s)", field, s.Bind(cursor.runID))
+	}
+
+	operator := sqlparser.GreaterThanStr
+	if q.orderBy.desc {
+		operator = sqlparser.LessThanStr
+	}
+	bind := s.Bind
+	if q.orderBy.field.searchAttribute != "" {
+		bind = func(arg interface{}) string {
+			return s.dialect.JSONValue(s.Bind(arg))
+		}
+	}
+	condition := fmt.Sprintf("%s %s %s OR (%s = %s AND run_id > %s)",
+		field, operator, bind(cursor.sortValue), field, bind(cursor.sortValue), s.Bind(cursor.runID))
+	if q.orderBy.field.nullable() {
+		condition += fmt.Sprintf(" OR %s IS NULL", field)
+	}
+	return "(" + condition + ")"
+}
+
+// SortValue returns the JSON encoded value of the sort field of the row, which is empty if the field
+// is not set. Times are encoded as unix nanos.
+func (q *VisibilityQuery) SortValue(row *VisibilityRow) (json.RawMessage, error) {
+	field := q.orderBy.field
+	if field.searchAttribute != "" {
+		if len(row.SearchAttributes) == 0 {
+			return nil, nil
+		}
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(row.SearchAttributes, &attributes); err != nil {
+			return nil, err
+		}
+		return attributes[field.searchAttribute], nil
+	}
+
+	var value interface{}
+	switch field.column {
+	case "domain_id":
+		value = row.DomainID
+	case "workflow_id":
+		value = row.WorkflowID
+	case "run_id":
+		value = row.RunID
+	case "workflow_type_name":
+		value = row.WorkflowTypeName
+	case "start_time":
+		value = row.StartTime.UnixNano()
+	case "execution_time":
+		value = row.ExecutionTime.UnixNano()
+	case "close_time":
+		if row.CloseTime == nil {
+			return nil, nil
+		}
+		value = row.CloseTime.UnixNano()
+	case "close_status":
+		if row.CloseStatus == nil {
+			return nil, nil
+		}
+		value = *row.CloseStatus
+	case "history_length":
+		if row.HistoryLength == nil {
+			return nil, nil
+		}
+		value = *row.HistoryLength
+	case "task_list":
+		value = row.TaskList
+	case "is_cron":
+		value = row.IsCron
+	case "num_clusters":
+		value = row.NumClusters
+	default:
+		return nil, fmt.Errorf("unknown sort column %q", field.column)
+	}
+	return json.Marshal(value)
+}
+
+// ParseCursor parses the cursor of the row with the given sort value, as returned by SortValue, and run ID
+func (q *VisibilityQuery) ParseCursor(sortValue json.RawMessage, runID string) (*VisibilityQueryCursor, error) {
+	cursor := &VisibilityQueryCursor{runID: runID}
+	if len(sortValue) == 0 {
+		return cursor, nil
+	}
+	field := q.orderBy.field
+	if field.searchAttribute != "" {
+		// the value is bound as a JSON document, the same way as the values of comparisons
+		var value interface{}
+		if err := json.Unmarshal(sortValue, &value); err != nil {
+			return nil, err
+		}
+		cursor.sortValue = string(sortValue)
+		return cursor, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(sortValue))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if number, ok := value.(json.Number); ok {
+		i, err := number.Int64()
+		if err != nil {
+			return nil, err
+		}
+		value = i
+	}
+	var err error
+	if cursor.sortValue, err = field.convertValue(value); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Bind adds an argument to the statement and returns its bind parameter
+func (s *VisibilityQueryStatement) Bind(arg interface{}) string {
+	s.Args = append(s.Args, arg)
+	return s.dialect.Placeholder(len(s.Args))
+}
+
+// ParseVisibilityGroupBy parses the search attributes to count by, validated by the frontend, i.e.
+// with custom search attributes prefixed with definition.Attr
+func ParseVisibilityGroupBy(names []string) (VisibilityGroupBy, error) {
+	result := make(VisibilityGroupBy, len(names))
+	for i, name := range names {
+		field, err := parseVisibilityQueryFieldName(name)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = field
+	}
+	return result, nil
+}
+
+// Render returns the expressions reading the values of the group by search attributes, and the
+// expression building the JSON array of these values. Custom search attributes are grouped by their
+// JSON value, so a workflow with a list of keywords is counted in the group of the whole list.
+func (g VisibilityGroupBy) Render(dialect VisibilityQueryDialect) ([]string, string) {
+	s := &VisibilityQueryStatement{dialect: dialect}
+	exprs := make([]string, len(g))
+	for i, field := range g {
+		exprs[i] = field.render(s)
+	}
+	return exprs, dialect.JSONArray(exprs)
+}
+
+func parseVisibilityQueryExpr(expr sqlparser.Expr, datetimeAttributes map[string]bool) (visibilityQueryNode, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return parseVisibilityQueryAndOr("AND", expr.Left, expr.Right, datetimeAttributes)
+	case *sqlparser.OrExpr:
+		return parseVisibilityQueryAndOr("OR", expr.Left, expr.Right, datetimeAttributes)
+	case *sqlparser.ParenExpr:
+		inner, err := parseVisibilityQueryExpr(expr.Expr, datetimeAttributes)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityQueryParen{expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		return parseVisibilityQueryComparison(expr.Operator, expr.Left, expr.Right, datetimeAttributes)
+	case *sqlparser.RangeCond:
+		return parseVisibilityQueryComparison(expr.Operator, expr.Left, sqlparser.ValTuple{expr.From, expr.To}, datetimeAttributes)
+	default:
+		return nil, errors.New("invalid where clause")
+	}
+}
+
+func parseVisibilityQueryAndOr(operator string, left, right sqlparser.Expr, datetimeAttributes map[string]bool) (visibilityQueryNode, error) {
+	leftNode, err := parseVisibilityQueryExpr(left, datetimeAttributes)
+	if err != nil {
+		return nil, err
+	}
+	rightNode, err := parseVisibilityQueryExpr(right, datetimeAttributes)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityQueryAndOr{operator: operator, left: leftNode, right: rightNode}, nil
+}
+
+func parseVisibilityQueryComparison(operator string, left, right sqlparser.Expr, datetimeAttributes map[string]bool) (visibilityQueryNode, error) {
+	if !visibilityQueryOperators[operator] {
+		return nil, fmt.Errorf("operator %q is not supported", operator)
+	}
+	field, err := parseVisibilityQueryField(left, datetimeAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if colName, ok := right.(*sqlparser.ColName); ok {
+		// the only identifier allowed on the right side of a comparison is the missing keyword
+		if colName.Name.String() != visibilityQueryMissingValue || field.column == "" {
+			return nil, errors.New("invalid comparison expression")
+		}
+		if operator != sqlparser.EqualStr && operator != sqlparser.NotEqualStr {
+			return nil, fmt.Errorf("operator %q is not supported for missing", operator)
+		}
+		return &visibilityQueryMissing{column: field.column, missing: operator == sqlparser.EqualStr}, nil
+	}
+
+	var valueExprs sqlparser.ValTuple
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr, sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		tuple, ok := right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid values for operator %q", operator)
+		}
+		valueExprs = tuple
+	default:
+		valueExprs = sqlparser.ValTuple{right}
+	}
+
+	values := make([]interface{}, 0, len(valueExprs))
+	for _, valueExpr := range valueExprs {
+		value, err := parseVisibilityQueryValue(valueExpr)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = field.convertValue(value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return &visibilityQueryComparison{field: field, operator: operator, values: values}, nil
+}
+
+func parseVisibilityQueryField(expr sqlparser.Expr, datetimeAttributes map[string]bool) (visibilityQueryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return visibilityQueryField{}, errors.New("invalid search attribute expression")
+	}
+	field, err := parseVisibilityQueryFieldName(colName.Name.String())
+	if err != nil {
+		return visibilityQueryField{}, err
+	}
+	field.datetime = field.searchAttribute != "" && datetimeAttributes[field.searchAttribute]
+	return field, nil
+}
+
+func parseVisibilityQueryFieldName(name string) (visibilityQueryField, error) {
+	if strings.HasPrefix(name, definition.Attr+".") {
+		name = strings.TrimPrefix(name, definition.Attr+".")
+		// the name ends up in the JSON path of the attribute, so it must not contain any special characters
+		if !searchAttributeNameRegex.MatchString(name) {
+			return visibilityQueryField{}, fmt.Errorf("invalid search attribute %q", name)
+		}
+		return visibilityQueryField{searchAttribute: name}, nil
+	}
+	column, ok := visibilityColumns[name]
+	if !ok {
+		return visibilityQueryField{}, fmt.Errorf("invalid search attribute %q", name)
+	}
+	return visibilityQueryField{column: column}, nil
+}
+
+func parseVisibilityQueryValue(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	}
+	return nil, errors.New("invalid value in comparison expression")
+}
+
+func (f visibilityQueryField) convertValue(value interface{}) (interface{}, error) {
+	if f.searchAttribute != "" {
+		if f.datetime {
+			t, err := convertVisibilityQueryTime(value)
+			if err != nil {
+				return nil, err
+			}
+			value = t.(time.Time).UnixNano()
+		}
+		// custom search attributes are compared as JSON values, which keeps the type of the
+		// value given in the query, e.g. numbers are compared as numbers and strings as strings
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	}
+
+	switch {
+	case visibilityTimeColumns[f.column]:
+		return convertVisibilityQueryTime(value)
+	case f.column == "close_status":
+		return convertVisibilityQueryCloseStatus(value)
+	case f.column == "is_cron":
+		if s, ok := value.(string); ok {
+			return strconv.ParseBool(s)
+		}
+	}
+	return value, nil
+}
+
+// NormalizeVisibilityDatetime converts the JSON encoded value of a datetime search attribute, or the
+// list of its values, given either as unix nanos or RFC3339 strings to unix nanos. Datetime search
+// attributes are stored this way so that they are compared and sorted as numbers.
+func NormalizeVisibilityDatetime(value []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	if list, ok := decoded.([]interface{}); ok {
+		nanos := make([]int64, len(list))
+		for i, item := range list {
+			t, err := convertVisibilityDatetimeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			nanos[i] = t
+		}
+		return json.Marshal(nanos)
+	}
+	nanos, err := convertVisibilityDatetimeValue(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(nanos)
+}
+
+func convertVisibilityDatetimeValue(value interface{}) (int64, error) {
+	if number, ok := value.(json.Number); ok {
+		value = number.String()
+	}
+	t, err := convertVisibilityQueryTime(value)
+	if err != nil {
+		return 0, err
+	}
+	return t.(time.Time).UnixNano(), nil
+}
+
+func convertVisibilityQueryTime(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int64:
+		return time.Unix(0, v).UTC(), nil
+	case string:
+		if nanos, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(0, nanos).UTC(), nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time %q: %v", v, err)
+		}
+		return t.UTC(), nil
+	default:
+		return nil, fmt.Errorf("invalid time %v", value)
+	}
+}
+
+func convertVisibilityQueryCloseStatus(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int64:
+		return int32(v), nil
+	case string:
+		if status, err := strconv.ParseInt(v, 10, 32); err == nil {
+			return int32(status), nil
+		}
+		var status types.WorkflowExecutionCloseStatus
+		if err := status.UnmarshalText([]byte(v)); err != nil {
+			return nil, err
+		}
+		return int32(status), nil
+	default:
+		return nil, fmt.Errorf("invalid close status %v", value)
+	}
+}
+
+func (n *visibilityQueryAndOr) render(s *VisibilityQueryStatement) string {
+	return fmt.Sprintf("%s %s %s", n.left.render(s), n.operator, n.right.render(s))
+}
+
+func (n *visibilityQueryParen) render(s *VisibilityQueryStatement) string {
+	return "(" + n.expr.render(s) + ")"
+}
+
+func (n *visibilityQueryMissing) render(s *VisibilityQueryStatement) string {
+	if n.missing {
+		return n.column + " IS NULL"
+	}
+	return n.column + " IS NOT NULL"
+}
+
+func (n *visibilityQueryComparison) render(s *VisibilityQueryStatement) string {
+	field := n.field.render(s)
+	if n.field.searchAttribute != "" {
+		return n.renderSearchAttribute(s, field)
+	}
+
+	params := make([]string, len(n.values))
+	for i, value := range n.values {
+		params[i] = s.Bind(value)
+	}
+	switch n.operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return fmt.Sprintf("%s %s (%s)", field, strings.ToUpper(n.operator), strings.Join(params, ", "))
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		return fmt.Sprintf("%s %s %s AND %s", field, strings.ToUpper(n.operator), params[0], params[1])
+	default:
+		return fmt.Sprintf("%s %s %s", field, n.operator, params[0])
+	}
+}
+
+// renderSearchAttribute renders the comparison of a custom search attribute. Equality is checked by
+// JSON containment, so that a value matches an attribute holding a list if any element of the list
+// equals the value, the same way as Elasticsearch matches it. Attributes which are not set never
+// match a comparison, except for the negated ones.
+func (n *visibilityQueryComparison) renderSearchAttribute(s *VisibilityQueryStatement, field string) string {
+	params := make([]string, len(n.values))
+	for i, value := range n.values {
+		params[i] = s.dialect.JSONValue(s.Bind(value))
+	}
+	switch n.operator {
+	case sqlparser.EqualStr:
+		return s.dialect.JSONContains(field, params[0])
+	case sqlparser.NotEqualStr:
+		return fmt.Sprintf("(%s IS NULL OR NOT %s)", field, s.dialect.JSONContains(field, params[0]))
+	case sqlparser.InStr, sqlparser.NotInStr:
+		conditions := make([]string, len(params))
+		for i, param := range params {
+			conditions[i] = s.dialect.JSONContains(field, param)
+		}
+		condition := "(" + strings.Join(conditions, " OR ") + ")"
+		if n.operator == sqlparser.NotInStr {
+			return fmt.Sprintf("(%s IS NULL OR NOT %s)", field, condition)
+		}
+		return condition
+	case sqlparser.BetweenStr:
+		return fmt.Sprintf("(%s >= %s AND %s <= %s)", field, params[0], field, params[1])
+	case sqlparser.NotBetweenStr:
+		return fmt.Sprintf("(%s < %s OR %s > %s)", field, params[0], field, params[1])
+	default:
+		return fmt.Sprintf("%s %s %s", field, n.operator, params[0])
+	}
+}
+
+// nullable returns whether the field may not be set for some rows
+func (f visibilityQueryField) nullable() bool {
+	return f.searchAttribute != "" || visibilityNullableColumns[f.column]
+}
+
+func (f visibilityQueryField) render(s *VisibilityQueryStatement) string {
+	if f.searchAttribute != "" {
+		return s.dialect.SearchAttribute(f.searchAttribute)
+	}
+	return f.column
+}
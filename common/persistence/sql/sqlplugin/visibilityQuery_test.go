@@ -0,0 +1,252 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testVisibilityQueryDialect struct{}
+
+func (testVisibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (testVisibilityQueryDialect) SearchAttribute(name string) string {
+	return "attr(" + name + ")"
+}
+
+func (testVisibilityQueryDialect) JSONValue(param string) string {
+	return "json(" + param + ")"
+}
+
+func (testVisibilityQueryDialect) JSONContains(target string, candidate string) string {
+	return "contains(" + target + ", " + candidate + ")"
+}
+
+func (testVisibilityQueryDialect) JSONArray(exprs []string) string {
+	return "array(" + strings.Join(exprs, ", ") + ")"
+}
+
+var testDatetimeAttributes = map[string]bool{"CustomDatetimeField": true}
+
+func TestParseVisibilityQuery(t *testing.T) {
+	startTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		query           string
+		expectedWhere   string
+		expectedOrderBy string
+		expectedArgs    []interface{}
+		expectedErr     bool
+	}{
+		{
+			query:           "",
+			expectedOrderBy: "start_time DESC, run_id",
+			expectedArgs:    []interface{}{"domain"},
+		},
+		{
+			query:           "WorkflowID = 'wid' and CloseTime = missing",
+			expectedWhere:   "workflow_id = $2 AND close_time IS NULL",
+			expectedOrderBy: "start_time DESC, run_id",
+			expectedArgs:    []interface{}{"domain", "wid"},
+		},
+		{
+			query:           "(CloseStatus = 'FAILED' or CloseStatus = 3) and StartTime > '2021-01-02T03:04:05Z'",
+			expectedWhere:   "(close_status = $2 OR close_status = $3) AND start_time > $4",
+			expectedOrderBy: "start_time DESC, run_id",
+			expectedArgs:    []interface{}{"domain", int32(1), int32(3), startTime},
+		},
+		{
+			query:           fmt.Sprintf("StartTime between %v and '%v' order by CloseTime asc", startTime.UnixNano(), startTime.UnixNano()),
+			expectedWhere:   "start_time BETWEEN $2 AND $3",
+			expectedOrderBy: "close_time IS NULL, close_time ASC, run_id",
+			expectedArgs:    []interface{}{"domain", startTime, startTime},
+		},
+		{
+			query:           "`Attr.CustomKeywordField` = 'keyword' and `Attr.CustomIntField` >= 10 order by `Attr.CustomDoubleField` desc",
+			expectedWhere:   "contains(attr(CustomKeywordField), json($2)) AND attr(CustomIntField) >= json($3)",
+			expectedOrderBy: "attr(CustomDoubleField) IS NULL, attr(CustomDoubleField) DESC, run_id",
+			expectedArgs:    []interface{}{"domain", `"keyword"`, "10"},
+		},
+		{
+			query:           "`Attr.CustomIntField` not in (1, 2) or `Attr.CustomBoolField` != true",
+			expectedWhere:   "(attr(CustomIntField) IS NULL OR NOT (contains(attr(CustomIntField), json($2)) OR contains(attr(CustomIntField), json($3)))) OR (attr(CustomBoolField) IS NULL OR NOT contains(attr(CustomBoolField), json($4)))",
+			expectedOrderBy: "start_time DESC, run_id",
+			expectedArgs:    []interface{}{"domain", "1", "2", "true"},
+		},
+		{
+			query:           fmt.Sprintf("`Attr.CustomDatetimeField` between '2021-01-02T03:04:05Z' and %v", startTime.UnixNano()),
+			expectedWhere:   "(attr(CustomDatetimeField) >= json($2) AND attr(CustomDatetimeField) <= json($3))",
+			expectedOrderBy: "start_time DESC, run_id",
+			expectedArgs:    []interface{}{"domain", "1609556645000000000", "1609556645000000000"},
+		},
+		{
+			query:       "`Attr.CustomDatetimeField` > 'yesterday'",
+			expectedErr: true,
+		},
+		{
+			query:       "UnknownField = 1",
+			expectedErr: true,
+		},
+		{
+			query:       "`Attr.Custom') OR 1=1` = 1",
+			expectedErr: true,
+		},
+		{
+			query:       "WorkflowID like 'wid%'",
+			expectedErr: true,
+		},
+		{
+			query:       "WorkflowID = RunID",
+			expectedErr: true,
+		},
+		{
+			query:       "order by StartTime, CloseTime",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, testDatetimeAttributes)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			stmt := query.Render(testVisibilityQueryDialect{}, "domain")
+			require.Equal(t, test.expectedWhere, stmt.Where)
+			require.Equal(t, test.expectedOrderBy, stmt.OrderBy)
+			require.Equal(t, test.expectedArgs, stmt.Args)
+		})
+	}
+}
+
+func TestParseVisibilityGroupBy(t *testing.T) {
+	groupBy, err := ParseVisibilityGroupBy([]string{"WorkflowType", "CloseStatus", "Attr.CustomKeywordField"})
+	require.NoError(t, err)
+	exprs, array := groupBy.Render(testVisibilityQueryDialect{})
+	require.Equal(t, []string{"workflow_type_name", "close_status", "attr(CustomKeywordField)"}, exprs)
+	require.Equal(t, "array(workflow_type_name, close_status, attr(CustomKeywordField))", array)
+
+	_, err = ParseVisibilityGroupBy([]string{"UnknownField"})
+	require.Error(t, err)
+	_, err = ParseVisibilityGroupBy([]string{"Attr.Custom'Field"})
+	require.Error(t, err)
+}
+
+func TestVisibilityQueryCursor(t *testing.T) {
+	closeTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := &VisibilityRow{
+		RunID:            "rid",
+		StartTime:        closeTime.Add(-time.Hour),
+		CloseTime:        &closeTime,
+		SearchAttributes: []byte(`{"CustomIntField":10}`),
+	}
+	openRow := &VisibilityRow{RunID: "rid", StartTime: closeTime}
+	tests := []struct {
+		query             string
+		row               *VisibilityRow
+		expectedSortValue string
+		expectedAfter     string
+		expectedArgs      []interface{}
+	}{
+		{
+			query:             "",
+			row:               row,
+			expectedSortValue: "1609553045000000000",
+			expectedAfter:     "(start_time < $2 OR (start_time = $3 AND run_id > $4))",
+			expectedArgs:      []interface{}{"domain", closeTime.Add(-time.Hour), closeTime.Add(-time.Hour), "rid"},
+		},
+		{
+			query:             "order by CloseTime asc",
+			row:               row,
+			expectedSortValue: "1609556645000000000",
+			expectedAfter:     "(close_time > $2 OR (close_time = $3 AND run_id > $4) OR close_time IS NULL)",
+			expectedArgs:      []interface{}{"domain", closeTime, closeTime, "rid"},
+		},
+		{
+			query:         "order by CloseTime desc",
+			row:           openRow,
+			expectedAfter: "(close_time IS NULL AND run_id > $2)",
+			expectedArgs:  []interface{}{"domain", "rid"},
+		},
+		{
+			query:             "order by `Attr.CustomIntField` desc",
+			row:               row,
+			expectedSortValue: "10",
+			expectedAfter:     "(attr(CustomIntField) < json($2) OR (attr(CustomIntField) = json($3) AND run_id > $4) OR attr(CustomIntField) IS NULL)",
+			expectedArgs:      []interface{}{"domain", "10", "10", "rid"},
+		},
+		{
+			query:         "order by `Attr.CustomIntField` desc",
+			row:           openRow,
+			expectedAfter: "(attr(CustomIntField) IS NULL AND run_id > $2)",
+			expectedArgs:  []interface{}{"domain", "rid"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, testDatetimeAttributes)
+			require.NoError(t, err)
+
+			sortValue, err := query.SortValue(test.row)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedSortValue, string(sortValue))
+
+			cursor, err := query.ParseCursor(sortValue, test.row.RunID)
+			require.NoError(t, err)
+			stmt := query.Render(testVisibilityQueryDialect{}, "domain")
+			require.Equal(t, test.expectedAfter, query.RenderAfter(stmt, cursor))
+			require.Equal(t, test.expectedArgs, stmt.Args)
+		})
+	}
+
+	query, err := ParseVisibilityQuery("", testDatetimeAttributes)
+	require.NoError(t, err)
+	_, err = query.ParseCursor([]byte(`"not a time"`), "rid")
+	require.Error(t, err)
+}
+
+func TestNormalizeVisibilityDatetime(t *testing.T) {
+	tests := map[string]string{
+		`1609556645000000000`:                           "1609556645000000000",
+		`"1609556645000000000"`:                         "1609556645000000000",
+		`"2021-01-02T03:04:05Z"`:                        "1609556645000000000",
+		`"2021-01-02T04:04:05.5+01:00"`:                 "1609556645500000000",
+		`["2021-01-02T03:04:05Z", 1609556645000000001]`: "[1609556645000000000,1609556645000000001]",
+	}
+	for value, expected := range tests {
+		normalized, err := NormalizeVisibilityDatetime([]byte(value))
+		require.NoError(t, err, value)
+		require.Equal(t, expected, string(normalized), value)
+	}
+
+	_, err := NormalizeVisibilityDatetime([]byte(`"yesterday"`))
+	require.Error(t, err)
+}
@@ -0,0 +1,466 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	visibilityMigrationShadowReadTimeout               = 10 * time.Second
+	visibilityMigrationDefaultMaxConcurrentShadowReads = 100
+)
+
+type (
+	// VisibilityMigrationManager is a VisibilityManager which helps migrating visibility records of domains
+	// between db and advanced visibility, or from advanced visibility to another advanced visibility store.
+	// Between db and advanced visibility, the store serving reads of a domain (see EnableReadVisibilityFromES)
+	// is the source of the migration and the other store is the target. If an advanced visibility migration
+	// target store is configured, advanced visibility is the source and that store is the target.
+	VisibilityMigrationManager interface {
+		VisibilityManager
+		// GetMigrationStores returns the source and the target store of a domain
+		GetMigrationStores(domain string) (source VisibilityManager, target VisibilityManager)
+	}
+
+	// VisibilityMigrationConfig is config for migrating visibility records between stores
+	VisibilityMigrationConfig struct {
+		// ReadModeIsFromES decides the source store of a domain
+		ReadModeIsFromES dynamicconfig.BoolPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+		// WriteMode decides the store(s) records are always written to
+		WriteMode dynamicconfig.StringPropertyFn `yaml:"-" json:"-"`
+		// EnableDualWrite makes records of a domain also written to the store not selected by WriteMode
+		EnableDualWrite dynamicconfig.BoolPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+		// ShadowReadPercentage is the percentage of reads of a domain to be compared with the target store
+		ShadowReadPercentage dynamicconfig.IntPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+		// MaxConcurrentShadowReads is the max number of shadow reads in flight, reads sampled above it are not shadowed
+		MaxConcurrentShadowReads dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+	}
+
+	visibilityMigrationManager struct {
+		VisibilityManager
+		dbVisibilityManager VisibilityManager
+		esVisibilityManager VisibilityManager
+		// esTargetVisibilityManager is set if advanced visibility records are migrated to another advanced visibility store
+		esTargetVisibilityManager VisibilityManager
+		config                    *VisibilityMigrationConfig
+		metricsClient             metrics.Client
+		logger                    log.Logger
+
+		shadowReadsInFlight int32
+	}
+)
+
+var _ VisibilityMigrationManager = (*visibilityMigrationManager)(nil)
+
+// NewVisibilityMigrationManager wraps the dual visibility manager with best effort writes to, and shadow reads
+// from the migration target store. Errors of the target store never fail requests, they are only logged and counted.
+// esTargetVisibilityManager is the advanced visibility migration target store, it is nil if records are migrated
+// between db and advanced visibility.
+func NewVisibilityMigrationManager(
+	dualVisibilityManager VisibilityManager,
+	dbVisibilityManager VisibilityManager,
+	esVisibilityManager VisibilityManager,
+	esTargetVisibilityManager VisibilityManager,
+	config *VisibilityMigrationConfig,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) VisibilityMigrationManager {
+	return &visibilityMigrationManager{
+		VisibilityManager:         dualVisibilityManager,
+		dbVisibilityManager:       dbVisibilityManager,
+		esVisibilityManager:       esVisibilityManager,
+		esTargetVisibilityManager: esTargetVisibilityManager,
+		config:                    config,
+		metricsClient:             metricsClient,
+		logger:                    logger,
+	}
+}
+
+func (v *visibilityMigrationManager) GetMigrationStores(domain string) (VisibilityManager, VisibilityManager) {
+	if v.esTargetVisibilityManager != nil {
+		return v.esVisibilityManager, v.esTargetVisibilityManager
+	}
+	if v.config.ReadModeIsFromES != nil && v.config.ReadModeIsFromES(domain) {
+		return v.esVisibilityManager, v.dbVisibilityManager
+	}
+	return v.dbVisibilityManager, v.esVisibilityManager
+}
+
+func (v *visibilityMigrationManager) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *RecordWorkflowExecutionStartedRequest,
+) error {
+	return v.write(request.Domain, metrics.PersistenceRecordWorkflowExecutionStartedScope, func(manager VisibilityManager) error {
+		return manager.RecordWorkflowExecutionStarted(ctx, request)
+	})
+}
+
+func (v *visibilityMigrationManager) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *RecordWorkflowExecutionClosedRequest,
+) error {
+	return v.write(request.Domain, metrics.PersistenceRecordWorkflowExecutionClosedScope, func(manager VisibilityManager) error {
+		return manager.RecordWorkflowExecutionClosed(ctx, request)
+	})
+}
+
+func (v *visibilityMigrationManager) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *UpsertWorkflowExecutionRequest,
+) error {
+	return v.write(request.Domain, metrics.PersistenceUpsertWorkflowExecutionScope, func(manager VisibilityManager) error {
+		return manager.UpsertWorkflowExecution(ctx, request)
+	})
+}
+
+func (v *visibilityMigrationManager) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	return v.write(request.Domain, metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, func(manager VisibilityManager) error {
+		return manager.DeleteWorkflowExecution(ctx, request)
+	})
+}
+
+func (v *visibilityMigrationManager) write(domain string, scope int, op func(VisibilityManager) error) error {
+	if err := op(v.VisibilityManager); err != nil {
+		return err
+	}
+
+	if v.config.EnableDualWrite == nil || v.config.WriteMode == nil || !v.config.EnableDualWrite(domain) {
+		return nil
+	}
+	var target VisibilityManager
+	switch {
+	case v.esTargetVisibilityManager != nil:
+		target = v.esTargetVisibilityManager
+	case v.config.WriteMode() == common.AdvancedVisibilityWritingModeOff:
+		target = v.esVisibilityManager
+	case v.config.WriteMode() == common.AdvancedVisibilityWritingModeOn:
+		target = v.dbVisibilityManager
+	default:
+		// records are already written to both stores
+		return nil
+	}
+	if err := op(target); err != nil {
+		v.metricsClient.IncCounter(scope, metrics.VisibilityMigrationDualWriteFailures)
+		v.logger.Warn("Failed to write visibility record to migration target store.",
+			tag.WorkflowDomainName(domain), tag.Error(err))
+	}
+	return nil
+}
+
+func (v *visibilityMigrationManager) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListOpenWorkflowExecutions(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListOpenWorkflowExecutionsScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListOpenWorkflowExecutions(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListClosedWorkflowExecutions(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListClosedWorkflowExecutionsScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListClosedWorkflowExecutions(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListOpenWorkflowExecutionsByType(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListOpenWorkflowExecutionsByType(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListClosedWorkflowExecutionsByType(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListClosedWorkflowExecutionsByType(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListOpenWorkflowExecutionsByWorkflowID(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListClosedWorkflowExecutionsByWorkflowID(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *ListClosedWorkflowExecutionsByStatusRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListClosedWorkflowExecutionsByStatus(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) ListWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.ListWorkflowExecutions(ctx, request)
+	if err == nil && len(request.NextPageToken) == 0 {
+		shadowRequest := *request
+		v.shadowList(request.Domain, metrics.PersistenceListWorkflowExecutionsScope, response, func(ctx context.Context, manager VisibilityManager) (*ListWorkflowExecutionsResponse, error) {
+			return manager.ListWorkflowExecutions(ctx, &shadowRequest)
+		})
+	}
+	return response, err
+}
+
+func (v *visibilityMigrationManager) CountWorkflowExecutions(
+	ctx context.Context,
+	request *CountWorkflowExecutionsRequest,
+) (*CountWorkflowExecutionsResponse, error) {
+	response, err := v.VisibilityManager.CountWorkflowExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	shadowRequest := *request
+	expected := response.Count
+	v.shadowRead(request.Domain, metrics.PersistenceCountWorkflowExecutionsScope, func(ctx context.Context, manager VisibilityManager) (string, error) {
+		shadowResponse, err := manager.CountWorkflowExecutions(ctx, &shadowRequest)
+		if err != nil {
+			return "", err
+		}
+		if shadowResponse.Count != expected {
+			return fmt.Sprintf("count %v != %v", expected, shadowResponse.Count), nil
+		}
+		return "", nil
+	})
+	return response, nil
+}
+
+func (v *visibilityMigrationManager) GetClosedWorkflowExecution(
+	ctx context.Context,
+	request *GetClosedWorkflowExecutionRequest,
+) (*GetClosedWorkflowExecutionResponse, error) {
+	response, err := v.VisibilityManager.GetClosedWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	shadowRequest := *request
+	expected := visibilityRecordSummary(response.Execution)
+	v.shadowRead(request.Domain, metrics.PersistenceGetClosedWorkflowExecutionScope, func(ctx context.Context, manager VisibilityManager) (string, error) {
+		shadowResponse, err := manager.GetClosedWorkflowExecution(ctx, &shadowRequest)
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return "record not found", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if actual := visibilityRecordSummary(shadowResponse.Execution); actual != expected {
+			return fmt.Sprintf("record %v != %v", expected, actual), nil
+		}
+		return "", nil
+	})
+	return response, nil
+}
+
+// shadowList compares the result of a list request with the target store. Only results which fit in the
+// first page are compared: stores sort records differently and page tokens are specific to each store, so
+// the first pages of a longer result are not expected to hold the same records.
+func (v *visibilityMigrationManager) shadowList(
+	domain string,
+	scope int,
+	response *ListWorkflowExecutionsResponse,
+	list func(context.Context, VisibilityManager) (*ListWorkflowExecutionsResponse, error),
+) {
+	if len(response.NextPageToken) != 0 {
+		return
+	}
+	v.shadowRead(domain, scope, func(ctx context.Context, manager VisibilityManager) (string, error) {
+		shadowResponse, err := list(ctx, manager)
+		if err != nil {
+			return "", err
+		}
+		return compareListResponses(response, shadowResponse), nil
+	})
+}
+
+// shadowRead runs the comparison against the target store of the domain in the background for the configured
+// percentage of requests. The comparison returns a description of the mismatch, or empty string if results match.
+// Reads sampled while MaxConcurrentShadowReads comparisons are in flight are dropped, so that a slow target store
+// does not pile up goroutines.
+func (v *visibilityMigrationManager) shadowRead(
+	domain string,
+	scope int,
+	compare func(context.Context, VisibilityManager) (string, error),
+) {
+	if v.config.ShadowReadPercentage == nil {
+		return
+	}
+	percentage := v.config.ShadowReadPercentage(domain)
+	if percentage <= 0 || rand.Intn(100) >= percentage {
+		return
+	}
+	maxShadowReads := visibilityMigrationDefaultMaxConcurrentShadowReads
+	if v.config.MaxConcurrentShadowReads != nil {
+		maxShadowReads = v.config.MaxConcurrentShadowReads()
+	}
+	if atomic.AddInt32(&v.shadowReadsInFlight, 1) > int32(maxShadowReads) {
+		atomic.AddInt32(&v.shadowReadsInFlight, -1)
+		v.metricsClient.IncCounter(scope, metrics.VisibilityMigrationShadowReadDroppedCounter)
+		return
+	}
+	_, target := v.GetMigrationStores(domain)
+
+	go func() {
+		defer atomic.AddInt32(&v.shadowReadsInFlight, -1)
+		ctx, cancel := context.WithTimeout(context.Background(), visibilityMigrationShadowReadTimeout)
+		defer cancel()
+
+		v.metricsClient.IncCounter(scope, metrics.VisibilityMigrationShadowReadCounter)
+		mismatch, err := compare(ctx, target)
+		if err != nil {
+			v.metricsClient.IncCounter(scope, metrics.VisibilityMigrationShadowReadFailures)
+			v.logger.Warn("Failed to shadow visibility read to migration target store.",
+				tag.WorkflowDomainName(domain), tag.Error(err))
+			return
+		}
+		if mismatch != "" {
+			v.metricsClient.IncCounter(scope, metrics.VisibilityMigrationShadowReadMismatchCounter)
+			v.logger.Warn("Visibility records mismatch between migration source and target store.",
+				tag.WorkflowDomainName(domain), tag.Value(mismatch))
+		}
+	}()
+}
+
+// visibilityRecordSummaries returns the summary of records keyed by workflowID and runID
+func visibilityRecordSummaries(executions []*types.WorkflowExecutionInfo) map[string]string {
+	summaries := make(map[string]string, len(executions))
+	for _, execution := range executions {
+		key := execution.GetExecution().GetWorkflowID() + "/" + execution.GetExecution().GetRunID()
+		summaries[key] = visibilityRecordSummary(execution)
+	}
+	return summaries
+}
+
+// visibilityRecordSummary returns the fields of a record which are expected to be the same in all stores,
+// timestamps are compared in milliseconds as not all stores keep nanoseconds
+func visibilityRecordSummary(execution *types.WorkflowExecutionInfo) string {
+	summary := fmt.Sprintf("type=%v start=%v", execution.GetType().GetName(), execution.GetStartTime()/int64(time.Millisecond))
+	if execution.CloseStatus != nil {
+		summary += fmt.Sprintf(" close=%v status=%v", execution.GetCloseTime()/int64(time.Millisecond), execution.GetCloseStatus())
+	}
+	return summary
+}
+
+// compareListResponses compares a full list result of the source store with the first page of the target store
+func compareListResponses(expected, actual *ListWorkflowExecutionsResponse) string {
+	if len(actual.NextPageToken) != 0 {
+		return fmt.Sprintf("more than %v records", len(expected.Executions))
+	}
+	return compareVisibilityRecordSummaries(
+		visibilityRecordSummaries(expected.Executions),
+		visibilityRecordSummaries(actual.Executions),
+	)
+}
+
+func compareVisibilityRecordSummaries(expected, actual map[string]string) string {
+	var mismatches []string
+	for key, summary := range expected {
+		actualSummary, ok := actual[key]
+		switch {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("%v missing", key))
+		case actualSummary != summary:
+			mismatches = append(mismatches, fmt.Sprintf("%v {%v} != {%v}", key, summary, actualSummary))
+		}
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%v unexpected", key))
+		}
+	}
+	sort.Strings(mismatches)
+	return strings.Join(mismatches, ", ")
+}
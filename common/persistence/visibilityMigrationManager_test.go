@@ -0,0 +1,294 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type recordingVisibilityManager struct {
+	VisibilityManager
+	err     error
+	started []string
+}
+
+func (m *recordingVisibilityManager) RecordWorkflowExecutionStarted(
+	_ context.Context,
+	request *RecordWorkflowExecutionStartedRequest,
+) error {
+	m.started = append(m.started, request.Execution.GetWorkflowID())
+	return m.err
+}
+
+type countingVisibilityManager struct {
+	VisibilityManager
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *countingVisibilityManager) CountWorkflowExecutions(
+	_ context.Context,
+	_ *CountWorkflowExecutionsRequest,
+) (*CountWorkflowExecutionsResponse, error) {
+	if m.started != nil {
+		m.started <- struct{}{}
+		<-m.release
+	}
+	return &CountWorkflowExecutionsResponse{}, nil
+}
+
+func TestVisibilityMigrationManager_DualWrite(t *testing.T) {
+	testCases := []struct {
+		name           string
+		writeMode      string
+		dualWrite      bool
+		primaryErr     error
+		targetErr      error
+		expectErr      bool
+		expectDBWrites int
+		expectESWrites int
+	}{
+		{name: "dual write disabled", writeMode: common.AdvancedVisibilityWritingModeOff, expectDBWrites: 1},
+		{name: "write mode off", writeMode: common.AdvancedVisibilityWritingModeOff, dualWrite: true, expectDBWrites: 1, expectESWrites: 1},
+		{name: "write mode on", writeMode: common.AdvancedVisibilityWritingModeOn, dualWrite: true, expectDBWrites: 1, expectESWrites: 1},
+		{name: "write mode dual", writeMode: common.AdvancedVisibilityWritingModeDual, dualWrite: true, expectDBWrites: 1, expectESWrites: 1},
+		{name: "target error is ignored", writeMode: common.AdvancedVisibilityWritingModeOff, dualWrite: true, targetErr: errors.New("target"), expectDBWrites: 1, expectESWrites: 1},
+		{name: "primary error is returned", writeMode: common.AdvancedVisibilityWritingModeOff, dualWrite: true, primaryErr: errors.New("primary"), expectErr: true, expectDBWrites: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &recordingVisibilityManager{}
+			es := &recordingVisibilityManager{}
+			switch tc.writeMode {
+			case common.AdvancedVisibilityWritingModeOff:
+				db.err, es.err = tc.primaryErr, tc.targetErr
+			case common.AdvancedVisibilityWritingModeOn:
+				es.err, db.err = tc.primaryErr, tc.targetErr
+			}
+			writeMode := dynamicconfig.GetStringPropertyFn(tc.writeMode)
+			readFromES := dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+			manager := NewVisibilityMigrationManager(
+				NewVisibilityDualManager(db, es, readFromES, writeMode, log.NewNoop()),
+				db,
+				es,
+				nil,
+				&VisibilityMigrationConfig{
+					ReadModeIsFromES: readFromES,
+					WriteMode:        writeMode,
+					EnableDualWrite:  dynamicconfig.GetBoolPropertyFnFilteredByDomain(tc.dualWrite),
+				},
+				metrics.NewNoopMetricsClient(),
+				log.NewNoop(),
+			)
+
+			err := manager.RecordWorkflowExecutionStarted(context.Background(), &RecordWorkflowExecutionStartedRequest{
+				Domain:    "test-domain",
+				Execution: types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			})
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, db.started, tc.expectDBWrites)
+			assert.Len(t, es.started, tc.expectESWrites)
+		})
+	}
+}
+
+func TestVisibilityMigrationManager_GetMigrationStores(t *testing.T) {
+	db := &recordingVisibilityManager{}
+	es := &recordingVisibilityManager{}
+	manager := NewVisibilityMigrationManager(nil, db, es, nil, &VisibilityMigrationConfig{
+		ReadModeIsFromES: func(domain string) bool { return domain == "es-domain" },
+	}, metrics.NewNoopMetricsClient(), log.NewNoop())
+
+	source, target := manager.GetMigrationStores("es-domain")
+	assert.Same(t, es, source)
+	assert.Same(t, db, target)
+
+	source, target = manager.GetMigrationStores("db-domain")
+	assert.Same(t, db, source)
+	assert.Same(t, es, target)
+
+	esTarget := &recordingVisibilityManager{}
+	manager = NewVisibilityMigrationManager(nil, db, es, esTarget, &VisibilityMigrationConfig{
+		ReadModeIsFromES: func(domain string) bool { return domain == "es-domain" },
+	}, metrics.NewNoopMetricsClient(), log.NewNoop())
+	for _, domain := range []string{"es-domain", "db-domain"} {
+		source, target = manager.GetMigrationStores(domain)
+		assert.Same(t, es, source)
+		assert.Same(t, esTarget, target)
+	}
+}
+
+func TestVisibilityMigrationManager_DualWriteToAdvancedTarget(t *testing.T) {
+	es := &recordingVisibilityManager{}
+	esTarget := &recordingVisibilityManager{}
+	writeMode := dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	readFromES := dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	manager := NewVisibilityMigrationManager(
+		NewVisibilityDualManager(nil, es, readFromES, writeMode, log.NewNoop()),
+		nil,
+		es,
+		esTarget,
+		&VisibilityMigrationConfig{
+			ReadModeIsFromES: readFromES,
+			WriteMode:        writeMode,
+			EnableDualWrite:  dynamicconfig.GetBoolPropertyFnFilteredByDomain(true),
+		},
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+	)
+
+	err := manager.RecordWorkflowExecutionStarted(context.Background(), &RecordWorkflowExecutionStartedRequest{
+		Domain:    "test-domain",
+		Execution: types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"wid"}, es.started)
+	assert.Equal(t, []string{"wid"}, esTarget.started)
+}
+
+type listingVisibilityManager struct {
+	VisibilityManager
+	response *ListWorkflowExecutionsResponse
+	listed   chan struct{}
+}
+
+func (m *listingVisibilityManager) ListOpenWorkflowExecutions(
+	_ context.Context,
+	_ *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	if m.listed != nil {
+		m.listed <- struct{}{}
+	}
+	return m.response, nil
+}
+
+func TestVisibilityMigrationManager_ShadowListFullResultOnly(t *testing.T) {
+	source := &listingVisibilityManager{response: &ListWorkflowExecutionsResponse{}}
+	target := &listingVisibilityManager{response: &ListWorkflowExecutionsResponse{}, listed: make(chan struct{}, 10)}
+	manager := NewVisibilityMigrationManager(source, source, target, nil, &VisibilityMigrationConfig{
+		ReadModeIsFromES:     dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		ShadowReadPercentage: dynamicconfig.GetIntPropertyFilteredByDomain(100),
+	}, metrics.NewNoopMetricsClient(), log.NewNoop())
+	request := &ListWorkflowExecutionsRequest{Domain: "test-domain"}
+
+	// the result does not fit in the first page, so it is not compared
+	source.response.NextPageToken = []byte("token")
+	_, err := manager.ListOpenWorkflowExecutions(context.Background(), request)
+	assert.NoError(t, err)
+
+	source.response.NextPageToken = nil
+	_, err = manager.ListOpenWorkflowExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	select {
+	case <-target.listed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "full result was not shadowed")
+	}
+	assert.Len(t, target.listed, 0)
+}
+
+func TestVisibilityMigrationManager_MaxConcurrentShadowReads(t *testing.T) {
+	source := &countingVisibilityManager{}
+	target := &countingVisibilityManager{started: make(chan struct{}, 10), release: make(chan struct{})}
+	readFromES := dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	manager := NewVisibilityMigrationManager(source, source, target, nil, &VisibilityMigrationConfig{
+		ReadModeIsFromES:         readFromES,
+		ShadowReadPercentage:     dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		MaxConcurrentShadowReads: dynamicconfig.GetIntPropertyFn(1),
+	}, metrics.NewNoopMetricsClient(), log.NewNoop())
+
+	request := &CountWorkflowExecutionsRequest{Domain: "test-domain"}
+	_, err := manager.CountWorkflowExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	<-target.started
+	// the first shadow read is still in flight, so the second one is dropped
+	_, err = manager.CountWorkflowExecutions(context.Background(), request)
+	assert.NoError(t, err)
+	close(target.release)
+	assert.Len(t, target.started, 0)
+
+	assert.Eventually(t, func() bool {
+		if _, err := manager.CountWorkflowExecutions(context.Background(), request); err != nil {
+			return false
+		}
+		select {
+		case <-target.started:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCompareVisibilityRecordSummaries(t *testing.T) {
+	record := func(wid string, closeStatus *types.WorkflowExecutionCloseStatus) *types.WorkflowExecutionInfo {
+		return &types.WorkflowExecutionInfo{
+			Execution:   &types.WorkflowExecution{WorkflowID: wid, RunID: "rid"},
+			Type:        &types.WorkflowType{Name: "type"},
+			StartTime:   common.Int64Ptr(1000000),
+			CloseStatus: closeStatus,
+		}
+	}
+	source := []*types.WorkflowExecutionInfo{record("wid1", nil), record("wid2", nil)}
+
+	assert.Empty(t, compareVisibilityRecordSummaries(
+		visibilityRecordSummaries(source),
+		visibilityRecordSummaries([]*types.WorkflowExecutionInfo{record("wid2", nil), record("wid1", nil)}),
+	))
+	assert.NotEmpty(t, compareVisibilityRecordSummaries(
+		visibilityRecordSummaries(source),
+		visibilityRecordSummaries([]*types.WorkflowExecutionInfo{record("wid1", nil)}),
+	))
+	assert.NotEmpty(t, compareVisibilityRecordSummaries(
+		visibilityRecordSummaries(source),
+		visibilityRecordSummaries([]*types.WorkflowExecutionInfo{record("wid1", nil), record("wid2", types.WorkflowExecutionCloseStatusCompleted.Ptr())}),
+	))
+}
+
+func TestCompareListResponses(t *testing.T) {
+	record := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Type:      &types.WorkflowType{Name: "type"},
+	}
+	expected := &ListWorkflowExecutionsResponse{Executions: []*types.WorkflowExecutionInfo{record}}
+
+	assert.Empty(t, compareListResponses(expected, &ListWorkflowExecutionsResponse{Executions: []*types.WorkflowExecutionInfo{record}}))
+	assert.Equal(t, "more than 1 records", compareListResponses(expected, &ListWorkflowExecutionsResponse{
+		Executions:    []*types.WorkflowExecutionInfo{record},
+		NextPageToken: []byte("token"),
+	}))
+}
@@ -60,6 +60,8 @@ type (
 		BlobstoreClient          blobstore.Client
 		ESClient                 es.GenericClient
 		ESConfig                 *config.ElasticSearchConfig
+		MigrationTargetESClient  es.GenericClient
+		MigrationTargetESConfig  *config.ElasticSearchConfig
 		DynamicConfig            dynamicconfig.Client
 		ClusterRedirectionPolicy *config.ClusterRedirectionPolicy
 		PublicClient             workflowserviceclient.Interface
@@ -96,6 +96,7 @@ type (
 		GetHistoryManager() persistence.HistoryManager
 		GetExecutionManager(int) (persistence.ExecutionManager, error)
 		GetPersistenceBean() persistenceClient.Bean
+		GetPersistenceHealthTracker() persistence.HealthTracker
 
 		// loggers
 
@@ -106,7 +106,8 @@ type (
 		clientBean        client.Bean
 
 		// persistence clients
-		persistenceBean persistenceClient.Bean
+		persistenceBean          persistenceClient.Bean
+		persistenceHealthTracker persistence.HealthTracker
 
 		// loggers
 		logger          log.Logger
@@ -125,6 +126,9 @@ type (
 
 var _ Resource = (*Impl)(nil)
 
+// persistenceHealthWindow is the window of the persistence requests and failures reported by DescribeCluster
+const persistenceHealthWindow = time.Minute
+
 // New create a new resource containing common dependencies
 func New(
 	params *Params,
@@ -166,6 +170,11 @@ func New(
 		return nil, err
 	}
 
+	persistenceMetricsClient, persistenceHealthTracker := persistence.NewHealthTrackingMetricsClient(
+		params.MetricsClient,
+		persistenceHealthWindow,
+		clock.NewRealTimeSource(),
+	)
 	persistenceBean, err := persistenceClient.NewBeanFromFactory(persistenceClient.NewFactory(
 		&params.PersistenceConfig,
 		func(...dynamicconfig.FilterOption) int {
@@ -179,14 +188,17 @@ func New(
 			return serviceConfig.PersistenceMaxQPS()
 		},
 		params.ClusterMetadata.GetCurrentClusterName(),
-		params.MetricsClient,
+		persistenceMetricsClient,
 		logger,
 	), &persistenceClient.Params{
 		PersistenceConfig: params.PersistenceConfig,
-		MetricsClient:     params.MetricsClient,
+		MetricsClient:     persistenceMetricsClient,
 		MessagingClient:   params.MessagingClient,
 		ESClient:          params.ESClient,
 		ESConfig:          params.ESConfig,
+
+		MigrationTargetESClient: params.MigrationTargetESClient,
+		MigrationTargetESConfig: params.MigrationTargetESConfig,
 	}, serviceConfig)
 	if err != nil {
 		return nil, err
@@ -291,7 +303,8 @@ func New(
 		clientBean:        clientBean,
 
 		// persistence clients
-		persistenceBean: persistenceBean,
+		persistenceBean:          persistenceBean,
+		persistenceHealthTracker: persistenceHealthTracker,
 
 		// loggers
 
@@ -546,6 +559,11 @@ func (h *Impl) GetPersistenceBean() persistenceClient.Bean {
 	return h.persistenceBean
 }
 
+// GetPersistenceHealthTracker return the tracker of the persistence requests and failures of the host
+func (h *Impl) GetPersistenceHealthTracker() persistence.HealthTracker {
+	return h.persistenceHealthTracker
+}
+
 // loggers
 
 // GetLogger return logger
@@ -21,6 +21,8 @@
 package resource
 
 import (
+	"time"
+
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/uber-go/tally"
@@ -93,6 +95,8 @@ type (
 		ExecutionMgr    *mocks.ExecutionManager
 		PersistenceBean *persistenceClient.MockBean
 
+		PersistenceHealthTracker persistence.HealthTracker
+
 		Logger log.Logger
 	}
 )
@@ -149,6 +153,11 @@ func NewTest(
 	persistenceBean.EXPECT().GetExecutionManager(gomock.Any()).Return(executionMgr, nil).AnyTimes()
 
 	scope := tally.NewTestScope("test", nil)
+	_, persistenceHealthTracker := persistence.NewHealthTrackingMetricsClient(
+		metrics.NewNoopMetricsClient(),
+		time.Minute,
+		clock.NewRealTimeSource(),
+	)
 
 	return &Test{
 		MetricsScope:    scope,
@@ -189,6 +198,8 @@ func NewTest(
 		ExecutionMgr:    executionMgr,
 		PersistenceBean: persistenceBean,
 
+		PersistenceHealthTracker: persistenceHealthTracker,
+
 		// logger
 
 		Logger: logger,
@@ -383,6 +394,11 @@ func (s *Test) GetPersistenceBean() persistenceClient.Bean {
 	return s.PersistenceBean
 }
 
+// GetPersistenceHealthTracker for testing
+func (s *Test) GetPersistenceHealthTracker() persistence.HealthTracker {
+	return s.PersistenceHealthTracker
+}
+
 // loggers
 
 // GetLogger for testing
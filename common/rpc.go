@@ -47,6 +47,13 @@ const (
 	ClientImplHeaderName = "cadence-client-name"
 	// AuthorizationTokenHeaderName refers to the jwt token in the request
 	AuthorizationTokenHeaderName = "cadence-authorization"
+
+	// ReplicationCompressionHeaderName refers to the name of the header
+	// that contains the compression accepted by the cluster fetching replication tasks
+	ReplicationCompressionHeaderName = "cadence-replication-compression"
+	// ShardHandoffHeaderName refers to the name of the header that contains
+	// the identity of the history host handing off a shard to the callee
+	ShardHandoffHeaderName = "cadence-shard-handoff"
 )
 
 type (
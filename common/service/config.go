@@ -33,6 +33,12 @@ type (
 		EnableReadVisibilityFromES dynamicconfig.BoolPropertyFnWithDomainFilter
 		// AdvancedVisibilityWritingMode is the write mode of visibility
 		AdvancedVisibilityWritingMode dynamicconfig.StringPropertyFn
+		// EnableVisibilityMigrationDualWrite is the per domain dual write mode of visibility migration
+		EnableVisibilityMigrationDualWrite dynamicconfig.BoolPropertyFnWithDomainFilter
+		// VisibilityMigrationShadowReadPercentage is the per domain percentage of shadow reads of visibility migration
+		VisibilityMigrationShadowReadPercentage dynamicconfig.IntPropertyFnWithDomainFilter
+		// VisibilityMigrationMaxConcurrentShadowReads is the max number of shadow reads of visibility migration in flight
+		VisibilityMigrationMaxConcurrentShadowReads dynamicconfig.IntPropertyFn
 
 		// configs for db visibility
 		EnableDBVisibilitySampling                  dynamicconfig.BoolPropertyFn                `yaml:"-" json:"-"`
@@ -39,6 +39,12 @@ type (
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
 		ActivityType    string `json:"activityType"`
+		// TaskList is the task list partition which started the activity task and tracks it as
+		// an outstanding task of the poller, empty if outstanding tasks are not limited
+		TaskList string `json:"taskList,omitempty"`
+		// TaskListDomainID is the domain of the task list partition, which is different from DomainID
+		// when the activity is scheduled on a task list of another domain
+		TaskListDomainID string `json:"taskListDomainId,omitempty"`
 	}
 
 	// QueryTaskToken identifies a query task
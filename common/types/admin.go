@@ -47,6 +47,7 @@ type DescribeClusterResponse struct {
 	SupportedClientVersions *SupportedClientVersions    `json:"supportedClientVersions,omitempty"`
 	MembershipInfo          *MembershipInfo             `json:"membershipInfo,omitempty"`
 	PersistenceInfo         map[string]*PersistenceInfo `json:"persistenceInfo,omitempty"`
+	PersistenceHealth       *PersistenceHealth          `json:"persistenceHealth,omitempty"`
 }
 
 // GetSupportedClientVersions is an internal getter (TBD...)
@@ -65,6 +66,45 @@ func (v *DescribeClusterResponse) GetMembershipInfo() (o *MembershipInfo) {
 	return
 }
 
+// GetPersistenceHealth is an internal getter (TBD...)
+func (v *DescribeClusterResponse) GetPersistenceHealth() (o *PersistenceHealth) {
+	if v != nil && v.PersistenceHealth != nil {
+		return v.PersistenceHealth
+	}
+	return
+}
+
+// PersistenceHealth is an internal type (TBD...)
+type PersistenceHealth struct {
+	Requests        int64 `json:"requests,omitempty"`
+	Failures        int64 `json:"failures,omitempty"`
+	WindowInSeconds int32 `json:"windowInSeconds,omitempty"`
+}
+
+// GetRequests is an internal getter (TBD...)
+func (v *PersistenceHealth) GetRequests() (o int64) {
+	if v != nil {
+		return v.Requests
+	}
+	return
+}
+
+// GetFailures is an internal getter (TBD...)
+func (v *PersistenceHealth) GetFailures() (o int64) {
+	if v != nil {
+		return v.Failures
+	}
+	return
+}
+
+// GetWindowInSeconds is an internal getter (TBD...)
+func (v *PersistenceHealth) GetWindowInSeconds() (o int32) {
+	if v != nil {
+		return v.WindowInSeconds
+	}
+	return
+}
+
 // AdminDescribeWorkflowExecutionRequest is an internal type (TBD...)
 type AdminDescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -298,6 +338,148 @@ type PersistenceInfo struct {
 	Features []*PersistenceFeature `json:"features,omitempty"`
 }
 
+// GetFailoverReadinessRequest is an internal type (TBD...)
+type GetFailoverReadinessRequest struct {
+	Domain                          string `json:"domain,omitempty"`
+	MaxReplicationLagInSeconds      *int32 `json:"maxReplicationLagInSeconds,omitempty"`
+	ReplicationErrorWindowInSeconds *int32 `json:"replicationErrorWindowInSeconds,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetFailoverReadinessRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetMaxReplicationLagInSeconds is an internal getter (TBD...)
+func (v *GetFailoverReadinessRequest) GetMaxReplicationLagInSeconds() (o int32) {
+	if v != nil && v.MaxReplicationLagInSeconds != nil {
+		return *v.MaxReplicationLagInSeconds
+	}
+	return
+}
+
+// GetReplicationErrorWindowInSeconds is an internal getter (TBD...)
+func (v *GetFailoverReadinessRequest) GetReplicationErrorWindowInSeconds() (o int32) {
+	if v != nil && v.ReplicationErrorWindowInSeconds != nil {
+		return *v.ReplicationErrorWindowInSeconds
+	}
+	return
+}
+
+// FailoverReadinessCheck is an internal type (TBD...)
+type FailoverReadinessCheck struct {
+	Name    string `json:"name,omitempty"`
+	Passed  bool   `json:"passed,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *FailoverReadinessCheck) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetPassed is an internal getter (TBD...)
+func (v *FailoverReadinessCheck) GetPassed() (o bool) {
+	if v != nil {
+		return v.Passed
+	}
+	return
+}
+
+// GetMessage is an internal getter (TBD...)
+func (v *FailoverReadinessCheck) GetMessage() (o string) {
+	if v != nil {
+		return v.Message
+	}
+	return
+}
+
+// GetFailoverReadinessResponse is an internal type (TBD...)
+type GetFailoverReadinessResponse struct {
+	DomainID      string                    `json:"domainID,omitempty"`
+	Cluster       string                    `json:"cluster,omitempty"`
+	SourceCluster string                    `json:"sourceCluster,omitempty"`
+	Ready         bool                      `json:"ready,omitempty"`
+	Checks        []*FailoverReadinessCheck `json:"checks,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *GetFailoverReadinessResponse) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetCluster is an internal getter (TBD...)
+func (v *GetFailoverReadinessResponse) GetCluster() (o string) {
+	if v != nil {
+		return v.Cluster
+	}
+	return
+}
+
+// GetSourceCluster is an internal getter (TBD...)
+func (v *GetFailoverReadinessResponse) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+// GetReady is an internal getter (TBD...)
+func (v *GetFailoverReadinessResponse) GetReady() (o bool) {
+	if v != nil {
+		return v.Ready
+	}
+	return
+}
+
+// GetChecks is an internal getter (TBD...)
+func (v *GetFailoverReadinessResponse) GetChecks() (o []*FailoverReadinessCheck) {
+	if v != nil && v.Checks != nil {
+		return v.Checks
+	}
+	return
+}
+
+// GetReplicationStatusRequest is an internal type (TBD...)
+type GetReplicationStatusRequest struct {
+	SourceCluster string  `json:"sourceCluster,omitempty"`
+	TargetCluster string  `json:"targetCluster,omitempty"`
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+}
+
+// GetSourceCluster is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+// GetTargetCluster is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
 // ResendReplicationTasksRequest is an internal type (TBD...)
 type ResendReplicationTasksRequest struct {
 	DomainID      string `json:"domainID,omitempty"`
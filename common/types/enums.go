@@ -65,6 +65,10 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionUnpaused,
+		EventTypeActivityTaskOptionsUpdated,
+		EventTypeActivityTaskRetryRequested,
 	}
 }
 
@@ -634,6 +634,120 @@ func (v *ProcessingQueueStates) GetStatesByCluster() (o map[string][]*Processing
 	return
 }
 
+// ReplicationProcessorStates is an internal type (TBD...)
+type ReplicationProcessorStates struct {
+	StatesByCluster map[string]*ReplicationProcessorState `json:"statesByCluster,omitempty"`
+}
+
+// GetStatesByCluster is an internal getter (TBD...)
+func (v *ReplicationProcessorStates) GetStatesByCluster() (o map[string]*ReplicationProcessorState) {
+	if v != nil && v.StatesByCluster != nil {
+		return v.StatesByCluster
+	}
+	return
+}
+
+// ReplicationDLQRetryState is an internal type (TBD...)
+type ReplicationDLQRetryState struct {
+	Cursor     int64                      `json:"cursor,omitempty"`
+	TaskStates []*ReplicationDLQTaskState `json:"taskStates,omitempty"`
+}
+
+// GetCursor is an internal getter (TBD...)
+func (v *ReplicationDLQRetryState) GetCursor() (o int64) {
+	if v != nil {
+		return v.Cursor
+	}
+	return
+}
+
+// GetTaskStates is an internal getter (TBD...)
+func (v *ReplicationDLQRetryState) GetTaskStates() (o []*ReplicationDLQTaskState) {
+	if v != nil && v.TaskStates != nil {
+		return v.TaskStates
+	}
+	return
+}
+
+// ReplicationDLQRetryStates is an internal type (TBD...)
+type ReplicationDLQRetryStates struct {
+	StatesByCluster map[string]*ReplicationDLQRetryState `json:"statesByCluster,omitempty"`
+}
+
+// GetStatesByCluster is an internal getter (TBD...)
+func (v *ReplicationDLQRetryStates) GetStatesByCluster() (o map[string]*ReplicationDLQRetryState) {
+	if v != nil && v.StatesByCluster != nil {
+		return v.StatesByCluster
+	}
+	return
+}
+
+// HistoryGetReplicationReadinessRequest is an internal type (TBD...)
+type HistoryGetReplicationReadinessRequest struct {
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+	SourceCluster string  `json:"sourceCluster,omitempty"`
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *HistoryGetReplicationReadinessRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+// GetSourceCluster is an internal getter (TBD...)
+func (v *HistoryGetReplicationReadinessRequest) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+// HistoryGetReplicationStatusRequest is an internal type (TBD...)
+type HistoryGetReplicationStatusRequest struct {
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+	RemoteCluster string  `json:"remoteCluster,omitempty"`
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *HistoryGetReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+// GetRemoteCluster is an internal getter (TBD...)
+func (v *HistoryGetReplicationStatusRequest) GetRemoteCluster() (o string) {
+	if v != nil {
+		return v.RemoteCluster
+	}
+	return
+}
+
+// HistoryOperatePendingActivityRequest is an internal type (TBD...)
+type HistoryOperatePendingActivityRequest struct {
+	DomainUUID string                         `json:"domainUUID,omitempty"`
+	Request    *OperatePendingActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryOperatePendingActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryOperatePendingActivityRequest) GetRequest() (o *OperatePendingActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // HistoryQueryWorkflowRequest is an internal type (TBD...)
 type HistoryQueryWorkflowRequest struct {
 	DomainUUID string                `json:"domainUUID,omitempty"`
@@ -1785,6 +1899,50 @@ func (v *SyncShardStatusRequest) GetTimestamp() (o int64) {
 	return
 }
 
+// HistoryPauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryPauseWorkflowExecutionRequest struct {
+	DomainUUID   string                         `json:"domainUUID,omitempty"`
+	PauseRequest *PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetPauseRequest() (o *PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUnpauseWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseWorkflowExecutionRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetUnpauseRequest() (o *UnpauseWorkflowExecutionRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
 // HistoryTerminateWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryTerminateWorkflowExecutionRequest struct {
 	DomainUUID                string                             `json:"domainUUID,omitempty"`
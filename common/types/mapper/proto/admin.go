@@ -71,6 +71,7 @@ func FromAdminDescribeClusterResponse(t *types.DescribeClusterResponse) *adminv1
 		SupportedClientVersions: FromSupportedClientVersions(t.SupportedClientVersions),
 		MembershipInfo:          FromMembershipInfo(t.MembershipInfo),
 		PersistenceInfo:         FromPersistenceInfoMap(t.PersistenceInfo),
+		PersistenceHealth:       FromPersistenceHealth(t.PersistenceHealth),
 	}
 }
 
@@ -82,6 +83,29 @@ func ToAdminDescribeClusterResponse(t *adminv1.DescribeClusterResponse) *types.D
 		SupportedClientVersions: ToSupportedClientVersions(t.SupportedClientVersions),
 		MembershipInfo:          ToMembershipInfo(t.MembershipInfo),
 		PersistenceInfo:         ToPersistenceInfoMap(t.PersistenceInfo),
+		PersistenceHealth:       ToPersistenceHealth(t.PersistenceHealth),
+	}
+}
+
+func FromPersistenceHealth(t *types.PersistenceHealth) *adminv1.PersistenceHealth {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PersistenceHealth{
+		Requests:        t.Requests,
+		Failures:        t.Failures,
+		WindowInSeconds: t.WindowInSeconds,
+	}
+}
+
+func ToPersistenceHealth(t *adminv1.PersistenceHealth) *types.PersistenceHealth {
+	if t == nil {
+		return nil
+	}
+	return &types.PersistenceHealth{
+		Requests:        t.Requests,
+		Failures:        t.Failures,
+		WindowInSeconds: t.WindowInSeconds,
 	}
 }
 
@@ -545,6 +569,7 @@ func FromAdminReadDLQMessagesResponse(t *types.ReadDLQMessagesResponse) *adminv1
 		ReplicationTasks:     FromReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: FromReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          FromReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -557,6 +582,7 @@ func ToAdminReadDLQMessagesResponse(t *adminv1.ReadDLQMessagesResponse) *types.R
 		ReplicationTasks:     ToReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: ToReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          ToReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -582,6 +608,49 @@ func ToAdminReapplyEventsRequest(t *adminv1.ReapplyEventsRequest) *types.Reapply
 	}
 }
 
+func FromAdminOperatePendingActivityRequest(t *types.OperatePendingActivityRequest) *adminv1.OperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.OperatePendingActivityRequest{
+		Domain:                 t.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:             t.ActivityID,
+		Operation:              FromPendingActivityOperation(t.Operation),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+		ResetAttempts:          t.ResetAttempts,
+		Failure:                FromFailure(t.Reason, t.Details),
+		Result:                 FromPayload(t.Result),
+		Identity:               t.Identity,
+	}
+}
+
+func ToAdminOperatePendingActivityRequest(t *adminv1.OperatePendingActivityRequest) *types.OperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.OperatePendingActivityRequest{
+		Domain:                        t.Domain,
+		WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.ActivityId,
+		Operation:                     ToPendingActivityOperation(t.Operation),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		ResetAttempts:                 t.ResetAttempts,
+		Reason:                        ToFailureReason(t.Failure),
+		Details:                       ToFailureDetails(t.Failure),
+		Result:                        ToPayload(t.Result),
+		Identity:                      t.Identity,
+	}
+}
+
 func FromAdminRefreshWorkflowTasksRequest(t *types.RefreshWorkflowTasksRequest) *adminv1.RefreshWorkflowTasksRequest {
 	if t == nil {
 		return nil
@@ -723,6 +792,142 @@ func ToAdminGetCrossClusterTasksResponse(t *adminv1.GetCrossClusterTasksResponse
 	}
 }
 
+// FromAdminGetFailoverReadinessRequest converts internal GetFailoverReadinessRequest type to proto
+func FromAdminGetFailoverReadinessRequest(t *types.GetFailoverReadinessRequest) *adminv1.GetFailoverReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetFailoverReadinessRequest{
+		Domain:                 t.Domain,
+		MaxReplicationLag:      secondsToDuration(t.MaxReplicationLagInSeconds),
+		ReplicationErrorWindow: secondsToDuration(t.ReplicationErrorWindowInSeconds),
+	}
+}
+
+// ToAdminGetFailoverReadinessRequest converts proto GetFailoverReadinessRequest type to internal
+func ToAdminGetFailoverReadinessRequest(t *adminv1.GetFailoverReadinessRequest) *types.GetFailoverReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetFailoverReadinessRequest{
+		Domain:                          t.Domain,
+		MaxReplicationLagInSeconds:      durationToSeconds(t.MaxReplicationLag),
+		ReplicationErrorWindowInSeconds: durationToSeconds(t.ReplicationErrorWindow),
+	}
+}
+
+// FromAdminGetFailoverReadinessResponse converts internal GetFailoverReadinessResponse type to proto
+func FromAdminGetFailoverReadinessResponse(t *types.GetFailoverReadinessResponse) *adminv1.GetFailoverReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	var checks []*adminv1.FailoverReadinessCheck
+	if t.Checks != nil {
+		checks = make([]*adminv1.FailoverReadinessCheck, len(t.Checks))
+		for i, check := range t.Checks {
+			checks[i] = FromAdminFailoverReadinessCheck(check)
+		}
+	}
+	return &adminv1.GetFailoverReadinessResponse{
+		DomainId:      t.DomainID,
+		Cluster:       t.Cluster,
+		SourceCluster: t.SourceCluster,
+		Ready:         t.Ready,
+		Checks:        checks,
+	}
+}
+
+// ToAdminGetFailoverReadinessResponse converts proto GetFailoverReadinessResponse type to internal
+func ToAdminGetFailoverReadinessResponse(t *adminv1.GetFailoverReadinessResponse) *types.GetFailoverReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	var checks []*types.FailoverReadinessCheck
+	if t.Checks != nil {
+		checks = make([]*types.FailoverReadinessCheck, len(t.Checks))
+		for i, check := range t.Checks {
+			checks[i] = ToAdminFailoverReadinessCheck(check)
+		}
+	}
+	return &types.GetFailoverReadinessResponse{
+		DomainID:      t.DomainId,
+		Cluster:       t.Cluster,
+		SourceCluster: t.SourceCluster,
+		Ready:         t.Ready,
+		Checks:        checks,
+	}
+}
+
+// FromAdminFailoverReadinessCheck converts internal FailoverReadinessCheck type to proto
+func FromAdminFailoverReadinessCheck(t *types.FailoverReadinessCheck) *adminv1.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.FailoverReadinessCheck{
+		Name:    t.Name,
+		Passed:  t.Passed,
+		Message: t.Message,
+	}
+}
+
+// ToAdminFailoverReadinessCheck converts proto FailoverReadinessCheck type to internal
+func ToAdminFailoverReadinessCheck(t *adminv1.FailoverReadinessCheck) *types.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	return &types.FailoverReadinessCheck{
+		Name:    t.Name,
+		Passed:  t.Passed,
+		Message: t.Message,
+	}
+}
+
+// FromAdminGetReplicationStatusRequest converts internal GetReplicationStatusRequest type to proto
+func FromAdminGetReplicationStatusRequest(t *types.GetReplicationStatusRequest) *adminv1.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetReplicationStatusRequest{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+		ShardIds:      t.ShardIDs,
+	}
+}
+
+// ToAdminGetReplicationStatusRequest converts proto GetReplicationStatusRequest type to internal
+func ToAdminGetReplicationStatusRequest(t *adminv1.GetReplicationStatusRequest) *types.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusRequest{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+		ShardIDs:      t.ShardIds,
+	}
+}
+
+// FromAdminGetReplicationStatusResponse converts internal GetReplicationStatusResponse type to proto
+func FromAdminGetReplicationStatusResponse(t *types.GetReplicationStatusResponse) *adminv1.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetReplicationStatusResponse{
+		StatusByShard:      FromReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: FromGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// ToAdminGetReplicationStatusResponse converts proto GetReplicationStatusResponse type to internal
+func ToAdminGetReplicationStatusResponse(t *adminv1.GetReplicationStatusResponse) *types.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusResponse{
+		StatusByShard:      ToReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
 // FromAdminRespondCrossClusterTasksCompletedRequest converts internal RespondCrossClusterTasksCompletedRequest type to thrift
 func FromAdminRespondCrossClusterTasksCompletedRequest(t *types.RespondCrossClusterTasksCompletedRequest) *adminv1.RespondCrossClusterTasksCompletedRequest {
 	if t == nil {
@@ -769,7 +974,7 @@ func ToAdminRespondCrossClusterTasksCompletedResponse(t *adminv1.RespondCrossClu
 	}
 }
 
-//FromGetDynamicConfigRequest converts internal GetDynamicConfigRequest type to proto
+// FromGetDynamicConfigRequest converts internal GetDynamicConfigRequest type to proto
 func FromGetDynamicConfigRequest(t *types.GetDynamicConfigRequest) *adminv1.GetDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -780,7 +985,7 @@ func FromGetDynamicConfigRequest(t *types.GetDynamicConfigRequest) *adminv1.GetD
 	}
 }
 
-//ToGetDynamicConfigRequest converts proto GetDynamicConfigRequest type to internal
+// ToGetDynamicConfigRequest converts proto GetDynamicConfigRequest type to internal
 func ToGetDynamicConfigRequest(t *adminv1.GetDynamicConfigRequest) *types.GetDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -791,7 +996,7 @@ func ToGetDynamicConfigRequest(t *adminv1.GetDynamicConfigRequest) *types.GetDyn
 	}
 }
 
-//FromGetDynamicConfigResponse converts internal GetDynamicConfigResponse type to proto
+// FromGetDynamicConfigResponse converts internal GetDynamicConfigResponse type to proto
 func FromGetDynamicConfigResponse(t *types.GetDynamicConfigResponse) *adminv1.GetDynamicConfigResponse {
 	if t == nil {
 		return nil
@@ -801,7 +1006,7 @@ func FromGetDynamicConfigResponse(t *types.GetDynamicConfigResponse) *adminv1.Ge
 	}
 }
 
-//ToGetDynamicConfigResponse converts proto GetDynamicConfigResponse type to internal
+// ToGetDynamicConfigResponse converts proto GetDynamicConfigResponse type to internal
 func ToGetDynamicConfigResponse(t *adminv1.GetDynamicConfigResponse) *types.GetDynamicConfigResponse {
 	if t == nil {
 		return nil
@@ -811,7 +1016,7 @@ func ToGetDynamicConfigResponse(t *adminv1.GetDynamicConfigResponse) *types.GetD
 	}
 }
 
-//FromUpdateDynamicConfigRequest converts internal UpdateDynamicConfigRequest type to proto
+// FromUpdateDynamicConfigRequest converts internal UpdateDynamicConfigRequest type to proto
 func FromUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *adminv1.UpdateDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -822,7 +1027,7 @@ func FromUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *adminv
 	}
 }
 
-//ToUpdateDynamicConfigRequest converts proto UpdateDynamicConfigRequest type to internal
+// ToUpdateDynamicConfigRequest converts proto UpdateDynamicConfigRequest type to internal
 func ToUpdateDynamicConfigRequest(t *adminv1.UpdateDynamicConfigRequest) *types.UpdateDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -833,7 +1038,7 @@ func ToUpdateDynamicConfigRequest(t *adminv1.UpdateDynamicConfigRequest) *types.
 	}
 }
 
-//FromRestoreDynamicConfigRequest converts internal RestoreDynamicConfigRequest type to proto
+// FromRestoreDynamicConfigRequest converts internal RestoreDynamicConfigRequest type to proto
 func FromRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *adminv1.RestoreDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -844,7 +1049,7 @@ func FromRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *admi
 	}
 }
 
-//ToRestoreDynamicConfigRequest converts proto RestoreDynamicConfigRequest type to internal
+// ToRestoreDynamicConfigRequest converts proto RestoreDynamicConfigRequest type to internal
 func ToRestoreDynamicConfigRequest(t *adminv1.RestoreDynamicConfigRequest) *types.RestoreDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -855,7 +1060,63 @@ func ToRestoreDynamicConfigRequest(t *adminv1.RestoreDynamicConfigRequest) *type
 	}
 }
 
-//FromListDynamicConfigRequest converts internal ListDynamicConfigRequest type to proto
+// FromMoveTaskListRequest converts internal MoveTaskListRequest type to proto
+func FromMoveTaskListRequest(t *types.MoveTaskListRequest) *adminv1.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MoveTaskListRequest{
+		Domain:         t.Domain,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		Rps:            t.RPS,
+		DryRun:         t.DryRun,
+	}
+}
+
+// ToMoveTaskListRequest converts proto MoveTaskListRequest type to internal
+func ToMoveTaskListRequest(t *adminv1.MoveTaskListRequest) *types.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListRequest{
+		Domain:         t.Domain,
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.BatchSize,
+		RPS:            t.Rps,
+		DryRun:         t.DryRun,
+	}
+}
+
+// FromMoveTaskListResponse converts internal MoveTaskListResponse type to proto
+func FromMoveTaskListResponse(t *types.MoveTaskListResponse) *adminv1.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MoveTaskListResponse{
+		TasksMoved:   t.TasksMoved,
+		TasksExpired: t.TasksExpired,
+		Failure:      t.Failure,
+	}
+}
+
+// ToMoveTaskListResponse converts proto MoveTaskListResponse type to internal
+func ToMoveTaskListResponse(t *adminv1.MoveTaskListResponse) *types.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListResponse{
+		TasksMoved:   t.TasksMoved,
+		TasksExpired: t.TasksExpired,
+		Failure:      t.Failure,
+	}
+}
+
+// FromListDynamicConfigRequest converts internal ListDynamicConfigRequest type to proto
 func FromListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *adminv1.ListDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -865,7 +1126,7 @@ func FromListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *adminv1.Li
 	}
 }
 
-//ToListDynamicConfigRequest converts proto ListDynamicConfigRequest type to internal
+// ToListDynamicConfigRequest converts proto ListDynamicConfigRequest type to internal
 func ToListDynamicConfigRequest(t *adminv1.ListDynamicConfigRequest) *types.ListDynamicConfigRequest {
 	if t == nil {
 		return nil
@@ -875,7 +1136,7 @@ func ToListDynamicConfigRequest(t *adminv1.ListDynamicConfigRequest) *types.List
 	}
 }
 
-//FromListDynamicConfigResponse converts internal ListDynamicConfigResponse type to proto
+// FromListDynamicConfigResponse converts internal ListDynamicConfigResponse type to proto
 func FromListDynamicConfigResponse(t *types.ListDynamicConfigResponse) *adminv1.ListDynamicConfigResponse {
 	if t == nil {
 		return nil
@@ -885,7 +1146,7 @@ func FromListDynamicConfigResponse(t *types.ListDynamicConfigResponse) *adminv1.
 	}
 }
 
-//ToListDynamicConfigResponse converts proto ListDynamicConfigResponse type to internal
+// ToListDynamicConfigResponse converts proto ListDynamicConfigResponse type to internal
 func ToListDynamicConfigResponse(t *adminv1.ListDynamicConfigResponse) *types.ListDynamicConfigResponse {
 	if t == nil {
 		return nil
@@ -895,7 +1156,7 @@ func ToListDynamicConfigResponse(t *adminv1.ListDynamicConfigResponse) *types.Li
 	}
 }
 
-//FromDynamicConfigEntryArray converts internal DynamicConfigEntry array type to proto
+// FromDynamicConfigEntryArray converts internal DynamicConfigEntry array type to proto
 func FromDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*adminv1.DynamicConfigEntry {
 	if t == nil {
 		return nil
@@ -907,7 +1168,7 @@ func FromDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*adminv1.Dynam
 	return v
 }
 
-//ToDynamicConfigEntryArray converts proto DynamicConfigEntry array type to internal
+// ToDynamicConfigEntryArray converts proto DynamicConfigEntry array type to internal
 func ToDynamicConfigEntryArray(t []*adminv1.DynamicConfigEntry) []*types.DynamicConfigEntry {
 	if t == nil {
 		return nil
@@ -919,7 +1180,7 @@ func ToDynamicConfigEntryArray(t []*adminv1.DynamicConfigEntry) []*types.Dynamic
 	return v
 }
 
-//FromDynamicConfigEntry converts internal DynamicConfigEntry type to proto
+// FromDynamicConfigEntry converts internal DynamicConfigEntry type to proto
 func FromDynamicConfigEntry(t *types.DynamicConfigEntry) *adminv1.DynamicConfigEntry {
 	if t == nil {
 		return nil
@@ -930,7 +1191,7 @@ func FromDynamicConfigEntry(t *types.DynamicConfigEntry) *adminv1.DynamicConfigE
 	}
 }
 
-//ToDynamicConfigEntry converts proto DynamicConfigEntry type to internal
+// ToDynamicConfigEntry converts proto DynamicConfigEntry type to internal
 func ToDynamicConfigEntry(t *adminv1.DynamicConfigEntry) *types.DynamicConfigEntry {
 	if t == nil {
 		return nil
@@ -941,7 +1202,7 @@ func ToDynamicConfigEntry(t *adminv1.DynamicConfigEntry) *types.DynamicConfigEnt
 	}
 }
 
-//FromDynamicConfigValueArray converts internal DynamicConfigValue array type to proto
+// FromDynamicConfigValueArray converts internal DynamicConfigValue array type to proto
 func FromDynamicConfigValueArray(t []*types.DynamicConfigValue) []*adminv1.DynamicConfigValue {
 	if t == nil {
 		return nil
@@ -953,7 +1214,7 @@ func FromDynamicConfigValueArray(t []*types.DynamicConfigValue) []*adminv1.Dynam
 	return v
 }
 
-//ToDynamicConfigValueArray converts proto DynamicConfigValue array type to internal
+// ToDynamicConfigValueArray converts proto DynamicConfigValue array type to internal
 func ToDynamicConfigValueArray(t []*adminv1.DynamicConfigValue) []*types.DynamicConfigValue {
 	if t == nil {
 		return nil
@@ -965,7 +1226,7 @@ func ToDynamicConfigValueArray(t []*adminv1.DynamicConfigValue) []*types.Dynamic
 	return v
 }
 
-//FromDynamicConfigValue converts internal DynamicConfigValue type to proto
+// FromDynamicConfigValue converts internal DynamicConfigValue type to proto
 func FromDynamicConfigValue(t *types.DynamicConfigValue) *adminv1.DynamicConfigValue {
 	if t == nil {
 		return nil
@@ -976,7 +1237,7 @@ func FromDynamicConfigValue(t *types.DynamicConfigValue) *adminv1.DynamicConfigV
 	}
 }
 
-//ToDynamicConfigValue converts proto DynamicConfigValue type to internal
+// ToDynamicConfigValue converts proto DynamicConfigValue type to internal
 func ToDynamicConfigValue(t *adminv1.DynamicConfigValue) *types.DynamicConfigValue {
 	if t == nil {
 		return nil
@@ -987,7 +1248,7 @@ func ToDynamicConfigValue(t *adminv1.DynamicConfigValue) *types.DynamicConfigVal
 	}
 }
 
-//FromDynamicConfigFilterArray converts internal DynamicConfigFilter array type to proto
+// FromDynamicConfigFilterArray converts internal DynamicConfigFilter array type to proto
 func FromDynamicConfigFilterArray(t []*types.DynamicConfigFilter) []*adminv1.DynamicConfigFilter {
 	if t == nil {
 		return nil
@@ -999,7 +1260,7 @@ func FromDynamicConfigFilterArray(t []*types.DynamicConfigFilter) []*adminv1.Dyn
 	return v
 }
 
-//ToDynamicConfigFilterArray converts proto DynamicConfigFilter array type to internal
+// ToDynamicConfigFilterArray converts proto DynamicConfigFilter array type to internal
 func ToDynamicConfigFilterArray(t []*adminv1.DynamicConfigFilter) []*types.DynamicConfigFilter {
 	if t == nil {
 		return nil
@@ -1011,7 +1272,7 @@ func ToDynamicConfigFilterArray(t []*adminv1.DynamicConfigFilter) []*types.Dynam
 	return v
 }
 
-//FromDynamicConfigFilter converts internal DynamicConfigFilter type to proto
+// FromDynamicConfigFilter converts internal DynamicConfigFilter type to proto
 func FromDynamicConfigFilter(t *types.DynamicConfigFilter) *adminv1.DynamicConfigFilter {
 	if t == nil {
 		return nil
@@ -1022,7 +1283,7 @@ func FromDynamicConfigFilter(t *types.DynamicConfigFilter) *adminv1.DynamicConfi
 	}
 }
 
-//ToDynamicConfigFilter converts thrift DynamicConfigFilter type to internal
+// ToDynamicConfigFilter converts thrift DynamicConfigFilter type to internal
 func ToDynamicConfigFilter(t *adminv1.DynamicConfigFilter) *types.DynamicConfigFilter {
 	if t == nil {
 		return nil
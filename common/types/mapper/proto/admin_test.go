@@ -152,6 +152,11 @@ func TestAdminReapplyEventsRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminReapplyEventsRequest(FromAdminReapplyEventsRequest(item)))
 	}
 }
+func TestAdminOperatePendingActivityRequest(t *testing.T) {
+	for _, item := range []*types.OperatePendingActivityRequest{nil, {}, &testdata.AdminOperatePendingActivityRequest} {
+		assert.Equal(t, item, ToAdminOperatePendingActivityRequest(FromAdminOperatePendingActivityRequest(item)))
+	}
+}
 func TestAdminRefreshWorkflowTasksRequest(t *testing.T) {
 	for _, item := range []*types.RefreshWorkflowTasksRequest{nil, {}, &testdata.AdminRefreshWorkflowTasksRequest} {
 		assert.Equal(t, item, ToAdminRefreshWorkflowTasksRequest(FromAdminRefreshWorkflowTasksRequest(item)))
@@ -173,6 +178,30 @@ func TestAdminResetQueueRequest(t *testing.T) {
 	}
 }
 
+func TestAdminGetFailoverReadinessRequest(t *testing.T) {
+	for _, item := range []*types.GetFailoverReadinessRequest{nil, {}, &testdata.AdminGetFailoverReadinessRequest} {
+		assert.Equal(t, item, ToAdminGetFailoverReadinessRequest(FromAdminGetFailoverReadinessRequest(item)))
+	}
+}
+
+func TestAdminGetFailoverReadinessResponse(t *testing.T) {
+	for _, item := range []*types.GetFailoverReadinessResponse{nil, {}, &testdata.AdminGetFailoverReadinessResponse} {
+		assert.Equal(t, item, ToAdminGetFailoverReadinessResponse(FromAdminGetFailoverReadinessResponse(item)))
+	}
+}
+
+func TestAdminGetReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusRequest{nil, {}, &testdata.AdminGetReplicationStatusRequest} {
+		assert.Equal(t, item, ToAdminGetReplicationStatusRequest(FromAdminGetReplicationStatusRequest(item)))
+	}
+}
+
+func TestAdminGetReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusResponse{nil, {}, &testdata.AdminGetReplicationStatusResponse} {
+		assert.Equal(t, item, ToAdminGetReplicationStatusResponse(FromAdminGetReplicationStatusResponse(item)))
+	}
+}
+
 func TestAdminGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.AdminGetCrossClusterTasksRequest} {
 		assert.Equal(t, item, ToAdminGetCrossClusterTasksRequest(FromAdminGetCrossClusterTasksRequest(item)))
@@ -196,3 +225,13 @@ func TestAdminRespondCrossClusterTasksCompletedResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminRespondCrossClusterTasksCompletedResponse(FromAdminRespondCrossClusterTasksCompletedResponse(item)))
 	}
 }
+func TestAdminMoveTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MoveTaskListRequest{nil, {}, &testdata.AdminMoveTaskListRequest} {
+		assert.Equal(t, item, ToMoveTaskListRequest(FromMoveTaskListRequest(item)))
+	}
+}
+func TestAdminMoveTaskListResponse(t *testing.T) {
+	for _, item := range []*types.MoveTaskListResponse{nil, {}, &testdata.AdminMoveTaskListResponse} {
+		assert.Equal(t, item, ToMoveTaskListResponse(FromMoveTaskListResponse(item)))
+	}
+}
@@ -147,6 +147,58 @@ func ToActivityTaskFailedEventAttributes(t *apiv1.ActivityTaskFailedEventAttribu
 	}
 }
 
+func FromActivityTaskOptionsUpdatedEventAttributes(t *types.ActivityTaskOptionsUpdatedEventAttributes) *apiv1.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventId:       t.ScheduledEventID,
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+		Identity:               t.Identity,
+	}
+}
+
+func ToActivityTaskOptionsUpdatedEventAttributes(t *apiv1.ActivityTaskOptionsUpdatedEventAttributes) *types.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID:              t.ScheduledEventId,
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Identity:                      t.Identity,
+	}
+}
+
+func FromActivityTaskRetryRequestedEventAttributes(t *types.ActivityTaskRetryRequestedEventAttributes) *apiv1.ActivityTaskRetryRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventId: t.ScheduledEventID,
+		ResetAttempts:    t.ResetAttempts,
+		Identity:         t.Identity,
+	}
+}
+
+func ToActivityTaskRetryRequestedEventAttributes(t *apiv1.ActivityTaskRetryRequestedEventAttributes) *types.ActivityTaskRetryRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventID: t.ScheduledEventId,
+		ResetAttempts:    t.ResetAttempts,
+		Identity:         t.Identity,
+	}
+}
+
 func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
 	if t == nil {
 		return nil
@@ -627,6 +679,83 @@ func ToCompleteWorkflowExecutionDecisionAttributes(t *apiv1.CompleteWorkflowExec
 	}
 }
 
+func FromCompletionCallback(t *types.CompletionCallback) *apiv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompletionCallback{
+		Url:     t.URL,
+		Headers: t.Headers,
+	}
+}
+
+func ToCompletionCallback(t *apiv1.CompletionCallback) *types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallback{
+		URL:     t.Url,
+		Headers: t.Headers,
+	}
+}
+
+func FromCompletionCallbackInfo(t *types.CompletionCallbackInfo) *apiv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompletionCallbackInfo{
+		Url:             t.URL,
+		State:           FromCompletionCallbackState(t.State),
+		Attempt:         t.Attempt,
+		LastAttemptTime: unixNanoToTime(t.LastAttemptTimestamp),
+		NextAttemptTime: unixNanoToTime(t.NextAttemptTimestamp),
+		LastFailure:     t.LastFailure,
+	}
+}
+
+func ToCompletionCallbackInfo(t *apiv1.CompletionCallbackInfo) *types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallbackInfo{
+		URL:                  t.Url,
+		State:                ToCompletionCallbackState(t.State),
+		Attempt:              t.Attempt,
+		LastAttemptTimestamp: timeToUnixNano(t.LastAttemptTime),
+		NextAttemptTimestamp: timeToUnixNano(t.NextAttemptTime),
+		LastFailure:          t.LastFailure,
+	}
+}
+
+func FromCompletionCallbackState(t *types.CompletionCallbackState) apiv1.CompletionCallbackState {
+	if t == nil {
+		return apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID
+	}
+	switch *t {
+	case types.CompletionCallbackStatePending:
+		return apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_PENDING
+	case types.CompletionCallbackStateSucceeded:
+		return apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED
+	case types.CompletionCallbackStateFailed:
+		return apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_FAILED
+	}
+	panic("unexpected enum value")
+}
+
+func ToCompletionCallbackState(t apiv1.CompletionCallbackState) *types.CompletionCallbackState {
+	switch t {
+	case apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID:
+		return nil
+	case apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_PENDING:
+		return types.CompletionCallbackStatePending.Ptr()
+	case apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED:
+		return types.CompletionCallbackStateSucceeded.Ptr()
+	case apiv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_FAILED:
+		return types.CompletionCallbackStateFailed.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromContinueAsNewInitiator(t *types.ContinueAsNewInitiator) apiv1.ContinueAsNewInitiator {
 	if t == nil {
 		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
@@ -706,8 +835,9 @@ func FromCountWorkflowExecutionsRequest(t *types.CountWorkflowExecutionsRequest)
 		return nil
 	}
 	return &apiv1.CountWorkflowExecutionsRequest{
-		Domain: t.Domain,
-		Query:  t.Query,
+		Domain:  t.Domain,
+		Query:   t.Query,
+		GroupBy: t.GroupBy,
 	}
 }
 
@@ -716,8 +846,9 @@ func ToCountWorkflowExecutionsRequest(t *apiv1.CountWorkflowExecutionsRequest) *
 		return nil
 	}
 	return &types.CountWorkflowExecutionsRequest{
-		Domain: t.Domain,
-		Query:  t.Query,
+		Domain:  t.Domain,
+		Query:   t.Query,
+		GroupBy: t.GroupBy,
 	}
 }
 
@@ -726,7 +857,9 @@ func FromCountWorkflowExecutionsResponse(t *types.CountWorkflowExecutionsRespons
 		return nil
 	}
 	return &apiv1.CountWorkflowExecutionsResponse{
-		Count: t.Count,
+		Count:     t.Count,
+		Groups:    FromCountWorkflowExecutionsGroupArray(t.Groups),
+		Truncated: t.Truncated,
 	}
 }
 
@@ -735,10 +868,54 @@ func ToCountWorkflowExecutionsResponse(t *apiv1.CountWorkflowExecutionsResponse)
 		return nil
 	}
 	return &types.CountWorkflowExecutionsResponse{
-		Count: t.Count,
+		Count:     t.Count,
+		Groups:    ToCountWorkflowExecutionsGroupArray(t.Groups),
+		Truncated: t.Truncated,
+	}
+}
+
+func FromCountWorkflowExecutionsGroup(t *types.CountWorkflowExecutionsGroup) *apiv1.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.Count,
+	}
+}
+
+func ToCountWorkflowExecutionsGroup(t *apiv1.CountWorkflowExecutionsGroup) *types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.Count,
 	}
 }
 
+func FromCountWorkflowExecutionsGroupArray(t []*types.CountWorkflowExecutionsGroup) []*apiv1.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = FromCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+func ToCountWorkflowExecutionsGroupArray(t []*apiv1.CountWorkflowExecutionsGroup) []*types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = ToCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
 func FromDataBlob(t *types.DataBlob) *apiv1.DataBlob {
 	if t == nil {
 		return nil
@@ -836,6 +1013,8 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) apiv1.Decisio
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	case types.DecisionTaskFailedCausePendingLimitExceeded:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_PENDING_LIMIT_EXCEEDED
 	}
 	panic("unexpected enum value")
 }
@@ -890,6 +1069,8 @@ func ToDecisionTaskFailedCause(t apiv1.DecisionTaskFailedCause) *types.DecisionT
 		return types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr()
 	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES:
 		return types.DecisionTaskFailedCauseBadSearchAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_PENDING_LIMIT_EXCEEDED:
+		return types.DecisionTaskFailedCausePendingLimitExceeded.Ptr()
 	}
 	panic("unexpected enum value")
 }
@@ -1234,6 +1415,8 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    FromCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -1247,6 +1430,8 @@ func ToDescribeWorkflowExecutionResponse(t *apiv1.DescribeWorkflowExecutionRespo
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -1921,6 +2106,32 @@ func ToParentClosePolicy(t apiv1.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		AllowActivities:   t.AllowActivities,
+	}
+}
+
+func ToPauseWorkflowExecutionRequest(t *apiv1.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		AllowActivities:   t.AllowActivities,
+	}
+}
+
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivityInfo {
 	if t == nil {
 		return nil
@@ -2216,9 +2427,10 @@ func FromPollerInfo(t *types.PollerInfo) *apiv1.PollerInfo {
 		return nil
 	}
 	return &apiv1.PollerInfo{
-		LastAccessTime: unixNanoToTime(t.LastAccessTime),
-		Identity:       t.Identity,
-		RatePerSecond:  t.RatePerSecond,
+		LastAccessTime:   unixNanoToTime(t.LastAccessTime),
+		Identity:         t.Identity,
+		RatePerSecond:    t.RatePerSecond,
+		OutstandingTasks: t.OutstandingTasks,
 	}
 }
 
@@ -2227,9 +2439,10 @@ func ToPollerInfo(t *apiv1.PollerInfo) *types.PollerInfo {
 		return nil
 	}
 	return &types.PollerInfo{
-		LastAccessTime: timeToUnixNano(t.LastAccessTime),
-		Identity:       t.Identity,
-		RatePerSecond:  t.RatePerSecond,
+		LastAccessTime:   timeToUnixNano(t.LastAccessTime),
+		Identity:         t.Identity,
+		RatePerSecond:    t.RatePerSecond,
+		OutstandingTasks: t.OutstandingTasks,
 	}
 }
 
@@ -3318,6 +3531,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 			Header:                       FromHeader(t.Header),
 			DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+			CompletionCallbacks:          FromCompletionCallbackArray(t.CompletionCallbacks),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3349,6 +3563,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		SearchAttributes:                    ToSearchAttributes(t.StartRequest.SearchAttributes),
 		Header:                              ToHeader(t.StartRequest.Header),
 		DelayStartSeconds:                   durationToSeconds(t.StartRequest.DelayStart),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.StartRequest.CompletionCallbacks),
 	}
 }
 
@@ -3587,6 +3802,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		Header:                       FromHeader(t.Header),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+		CompletionCallbacks:          FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -3611,6 +3827,7 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -3796,11 +4013,14 @@ func FromTaskListStatus(t *types.TaskListStatus) *apiv1.TaskListStatus {
 		return nil
 	}
 	return &apiv1.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIdBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:      t.BacklogCountHint,
+		ReadLevel:             t.ReadLevel,
+		AckLevel:              t.AckLevel,
+		RatePerSecond:         t.RatePerSecond,
+		TaskIdBlock:           FromTaskIDBlock(t.TaskIDBlock),
+		BacklogAge:            secondsToDuration(common.Int32Ptr(int32(t.BacklogAgeInSeconds))),
+		DispatchRatePerSecond: t.DispatchRatePerSecond,
+		SyncMatchRatio:        t.SyncMatchRatio,
 	}
 }
 
@@ -3809,11 +4029,14 @@ func ToTaskListStatus(t *apiv1.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIdBlock),
+		BacklogCountHint:      t.BacklogCountHint,
+		ReadLevel:             t.ReadLevel,
+		AckLevel:              t.AckLevel,
+		RatePerSecond:         t.RatePerSecond,
+		TaskIDBlock:           ToTaskIDBlock(t.TaskIdBlock),
+		BacklogAgeInSeconds:   int64(common.Int32Default(durationToSeconds(t.BacklogAge))),
+		DispatchRatePerSecond: t.DispatchRatePerSecond,
+		SyncMatchRatio:        t.SyncMatchRatio,
 	}
 }
 
@@ -4008,6 +4231,30 @@ const (
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
 )
 
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *apiv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToUnpauseWorkflowExecutionRequest(t *apiv1.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
 	if t == nil {
 		return nil
@@ -4645,6 +4892,28 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 	}
 }
 
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:          t.Reason,
+		Identity:        t.Identity,
+		AllowActivities: t.AllowActivities,
+	}
+}
+
+func ToWorkflowExecutionPausedEventAttributes(t *apiv1.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:          t.Reason,
+		Identity:        t.Identity,
+		AllowActivities: t.AllowActivities,
+	}
+}
+
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
 		return nil
@@ -4694,6 +4963,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:          FromResetPoints(t.PrevAutoResetPoints),
 		Header:                       FromHeader(t.Header),
+		CompletionCallbacks:          FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -4728,6 +4998,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -4804,6 +5075,50 @@ func ToWorkflowIDReusePolicy(t apiv1.WorkflowIdReusePolicy) *types.WorkflowIDReu
 	panic("unexpected enum value")
 }
 
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *apiv1.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToWorkflowExecutionUnpausedEventAttributes(t *apiv1.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func FromWorkflowPauseInfo(t *types.WorkflowPauseInfo) *apiv1.WorkflowPauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowPauseInfo{
+		Reason:          t.Reason,
+		Identity:        t.Identity,
+		PausedTime:      unixNanoToTime(t.PausedTimestamp),
+		AllowActivities: t.AllowActivities,
+	}
+}
+
+func ToWorkflowPauseInfo(t *apiv1.WorkflowPauseInfo) *types.WorkflowPauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowPauseInfo{
+		Reason:          t.Reason,
+		Identity:        t.Identity,
+		PausedTimestamp: timeToUnixNano(t.PausedTime),
+		AllowActivities: t.AllowActivities,
+	}
+}
+
 func FromWorkflowQuery(t *types.WorkflowQuery) *apiv1.WorkflowQuery {
 	if t == nil {
 		return nil
@@ -5080,6 +5395,50 @@ func ToResetPointInfoArray(t []*apiv1.ResetPointInfo) []*types.ResetPointInfo {
 	return v
 }
 
+func FromCompletionCallbackArray(t []*types.CompletionCallback) []*apiv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallback(t[i])
+	}
+	return v
+}
+
+func ToCompletionCallbackArray(t []*apiv1.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallback(t[i])
+	}
+	return v
+}
+
+func FromCompletionCallbackInfoArray(t []*types.CompletionCallbackInfo) []*apiv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+func ToCompletionCallbackInfoArray(t []*apiv1.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
 func FromPendingActivityInfoArray(t []*types.PendingActivityInfo) []*apiv1.PendingActivityInfo {
 	if t == nil {
 		return nil
@@ -5482,6 +5841,22 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionPaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes{
+			WorkflowExecutionPausedEventAttributes: FromWorkflowExecutionPausedEventAttributes(e.WorkflowExecutionPausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes{
+			WorkflowExecutionUnpausedEventAttributes: FromWorkflowExecutionUnpausedEventAttributes(e.WorkflowExecutionUnpausedEventAttributes),
+		}
+	case types.EventTypeActivityTaskOptionsUpdated:
+		event.Attributes = &apiv1.HistoryEvent_ActivityTaskOptionsUpdatedEventAttributes{
+			ActivityTaskOptionsUpdatedEventAttributes: FromActivityTaskOptionsUpdatedEventAttributes(e.ActivityTaskOptionsUpdatedEventAttributes),
+		}
+	case types.EventTypeActivityTaskRetryRequested:
+		event.Attributes = &apiv1.HistoryEvent_ActivityTaskRetryRequestedEventAttributes{
+			ActivityTaskRetryRequestedEventAttributes: FromActivityTaskRetryRequestedEventAttributes(e.ActivityTaskRetryRequestedEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5623,6 +5998,18 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		event.WorkflowExecutionPausedEventAttributes = ToWorkflowExecutionPausedEventAttributes(attr.WorkflowExecutionPausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		event.WorkflowExecutionUnpausedEventAttributes = ToWorkflowExecutionUnpausedEventAttributes(attr.WorkflowExecutionUnpausedEventAttributes)
+	case *apiv1.HistoryEvent_ActivityTaskOptionsUpdatedEventAttributes:
+		event.EventType = types.EventTypeActivityTaskOptionsUpdated.Ptr()
+		event.ActivityTaskOptionsUpdatedEventAttributes = ToActivityTaskOptionsUpdatedEventAttributes(attr.ActivityTaskOptionsUpdatedEventAttributes)
+	case *apiv1.HistoryEvent_ActivityTaskRetryRequestedEventAttributes:
+		event.EventType = types.EventTypeActivityTaskRetryRequested.Ptr()
+		event.ActivityTaskRetryRequestedEventAttributes = ToActivityTaskRetryRequestedEventAttributes(attr.ActivityTaskRetryRequestedEventAttributes)
 	}
 	return &event
 }
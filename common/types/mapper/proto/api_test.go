@@ -157,6 +157,12 @@ func TestCountWorkflowExecutionsResponse(t *testing.T) {
 	for _, item := range []*types.CountWorkflowExecutionsResponse{nil, {}, &testdata.CountWorkflowExecutionsResponse} {
 		assert.Equal(t, item, ToCountWorkflowExecutionsResponse(FromCountWorkflowExecutionsResponse(item)))
 	}
+
+	data, err := FromCountWorkflowExecutionsResponse(&testdata.CountWorkflowExecutionsResponse).Marshal()
+	assert.NoError(t, err)
+	var decoded apiv1.CountWorkflowExecutionsResponse
+	assert.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, &testdata.CountWorkflowExecutionsResponse, ToCountWorkflowExecutionsResponse(&decoded))
 }
 func TestDataBlob(t *testing.T) {
 	for _, item := range []*types.DataBlob{nil, {}, &testdata.DataBlob} {
@@ -664,6 +670,22 @@ func TestTaskListStatus(t *testing.T) {
 	for _, item := range []*types.TaskListStatus{nil, {}, &testdata.TaskListStatus} {
 		assert.Equal(t, item, ToTaskListStatus(FromTaskListStatus(item)))
 	}
+
+	data, err := FromTaskListStatus(&testdata.TaskListStatus).Marshal()
+	assert.NoError(t, err)
+	var decoded apiv1.TaskListStatus
+	assert.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, &testdata.TaskListStatus, ToTaskListStatus(&decoded))
+}
+func TestPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.PauseWorkflowExecutionRequest{nil, {}, &testdata.PauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToPauseWorkflowExecutionRequest(FromPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseWorkflowExecutionRequest{nil, {}, &testdata.UnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUnpauseWorkflowExecutionRequest(FromUnpauseWorkflowExecutionRequest(item)))
+	}
 }
 func TestTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.TerminateWorkflowExecutionRequest{nil, {}, &testdata.TerminateWorkflowExecutionRequest} {
@@ -804,6 +826,31 @@ func TestWorkflowExecutionTerminatedEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToWorkflowExecutionTerminatedEventAttributes(FromWorkflowExecutionTerminatedEventAttributes(item)))
 	}
 }
+func TestWorkflowExecutionPausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionPausedEventAttributes{nil, {}, &testdata.WorkflowExecutionPausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionPausedEventAttributes(FromWorkflowExecutionPausedEventAttributes(item)))
+	}
+}
+func TestActivityTaskOptionsUpdatedEventAttributes(t *testing.T) {
+	for _, item := range []*types.ActivityTaskOptionsUpdatedEventAttributes{nil, {}, &testdata.ActivityTaskOptionsUpdatedEventAttributes} {
+		assert.Equal(t, item, ToActivityTaskOptionsUpdatedEventAttributes(FromActivityTaskOptionsUpdatedEventAttributes(item)))
+	}
+}
+func TestActivityTaskRetryRequestedEventAttributes(t *testing.T) {
+	for _, item := range []*types.ActivityTaskRetryRequestedEventAttributes{nil, {}, &testdata.ActivityTaskRetryRequestedEventAttributes} {
+		assert.Equal(t, item, ToActivityTaskRetryRequestedEventAttributes(FromActivityTaskRetryRequestedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUnpausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUnpausedEventAttributes{nil, {}, &testdata.WorkflowExecutionUnpausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUnpausedEventAttributes(FromWorkflowExecutionUnpausedEventAttributes(item)))
+	}
+}
+func TestWorkflowPauseInfo(t *testing.T) {
+	for _, item := range []*types.WorkflowPauseInfo{nil, {}, &testdata.WorkflowPauseInfo} {
+		assert.Equal(t, item, ToWorkflowPauseInfo(FromWorkflowPauseInfo(item)))
+	}
+}
 func TestWorkflowExecutionTimedOutEventAttributes(t *testing.T) {
 	for _, item := range []*types.WorkflowExecutionTimedOutEventAttributes{nil, {}, &testdata.WorkflowExecutionTimedOutEventAttributes} {
 		assert.Equal(t, item, ToWorkflowExecutionTimedOutEventAttributes(FromWorkflowExecutionTimedOutEventAttributes(item)))
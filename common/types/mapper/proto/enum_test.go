@@ -158,6 +158,7 @@ func TestDecisionTaskFailedCause(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCausePendingLimitExceeded.Ptr(),
 	} {
 		assert.Equal(t, item, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(item)))
 	}
@@ -213,6 +214,18 @@ func TestIndexedValueType(t *testing.T) {
 	assert.Panics(t, func() { ToIndexedValueType(apiv1.IndexedValueType(UnknownValue)) })
 	assert.Panics(t, func() { FromIndexedValueType(types.IndexedValueType(UnknownValue)) })
 }
+func TestCompletionCallbackState(t *testing.T) {
+	for _, item := range []*types.CompletionCallbackState{
+		nil,
+		types.CompletionCallbackStatePending.Ptr(),
+		types.CompletionCallbackStateSucceeded.Ptr(),
+		types.CompletionCallbackStateFailed.Ptr(),
+	} {
+		assert.Equal(t, item, ToCompletionCallbackState(FromCompletionCallbackState(item)))
+	}
+	assert.Panics(t, func() { ToCompletionCallbackState(apiv1.CompletionCallbackState(UnknownValue)) })
+	assert.Panics(t, func() { FromCompletionCallbackState(types.CompletionCallbackState(UnknownValue).Ptr()) })
+}
 func TestParentClosePolicy(t *testing.T) {
 	for _, item := range []*types.ParentClosePolicy{
 		nil,
@@ -225,6 +238,19 @@ func TestParentClosePolicy(t *testing.T) {
 	assert.Panics(t, func() { ToParentClosePolicy(apiv1.ParentClosePolicy(UnknownValue)) })
 	assert.Panics(t, func() { FromParentClosePolicy(types.ParentClosePolicy(UnknownValue).Ptr()) })
 }
+func TestPendingActivityOperation(t *testing.T) {
+	for _, item := range []*types.PendingActivityOperation{
+		nil,
+		types.PendingActivityOperationUpdateOptions.Ptr(),
+		types.PendingActivityOperationRetry.Ptr(),
+		types.PendingActivityOperationFail.Ptr(),
+		types.PendingActivityOperationSkip.Ptr(),
+	} {
+		assert.Equal(t, item, ToPendingActivityOperation(FromPendingActivityOperation(item)))
+	}
+	assert.Panics(t, func() { ToPendingActivityOperation(sharedv1.PendingActivityOperation(UnknownValue)) })
+	assert.Panics(t, func() { FromPendingActivityOperation(types.PendingActivityOperation(UnknownValue).Ptr()) })
+}
 func TestPendingActivityState(t *testing.T) {
 	for _, item := range []*types.PendingActivityState{
 		nil,
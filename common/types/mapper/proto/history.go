@@ -197,6 +197,8 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    FromCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -210,6 +212,8 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -647,6 +651,7 @@ func FromHistoryReadDLQMessagesResponse(t *types.ReadDLQMessagesResponse) *histo
 		ReplicationTasks:     FromReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: FromReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          FromReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -659,6 +664,7 @@ func ToHistoryReadDLQMessagesResponse(t *historyv1.ReadDLQMessagesResponse) *typ
 		ReplicationTasks:     ToReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: ToReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          ToReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -882,6 +888,53 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 	}
 }
 
+func FromHistoryOperatePendingActivityRequest(t *types.HistoryOperatePendingActivityRequest) *historyv1.OperatePendingActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.OperatePendingActivityRequest{
+		DomainId:               t.DomainUUID,
+		Domain:                 t.Request.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:             t.Request.ActivityID,
+		Operation:              FromPendingActivityOperation(t.Request.Operation),
+		ScheduleToStartTimeout: secondsToDuration(t.Request.ScheduleToStartTimeoutSeconds),
+		ScheduleToCloseTimeout: secondsToDuration(t.Request.ScheduleToCloseTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.Request.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.Request.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.Request.RetryPolicy),
+		ResetAttempts:          t.Request.ResetAttempts,
+		Failure:                FromFailure(t.Request.Reason, t.Request.Details),
+		Result:                 FromPayload(t.Request.Result),
+		Identity:               t.Request.Identity,
+	}
+}
+
+func ToHistoryOperatePendingActivityRequest(t *historyv1.OperatePendingActivityRequest) *types.HistoryOperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryOperatePendingActivityRequest{
+		DomainUUID: t.DomainId,
+		Request: &types.OperatePendingActivityRequest{
+			Domain:                        t.Domain,
+			WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:                    t.ActivityId,
+			Operation:                     ToPendingActivityOperation(t.Operation),
+			ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+			ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+			StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+			HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+			RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+			ResetAttempts:                 t.ResetAttempts,
+			Reason:                        ToFailureReason(t.Failure),
+			Details:                       ToFailureDetails(t.Failure),
+			Result:                        ToPayload(t.Result),
+			Identity:                      t.Identity,
+		},
+	}
+}
+
 func FromHistoryRefreshWorkflowTasksRequest(t *types.HistoryRefreshWorkflowTasksRequest) *historyv1.RefreshWorkflowTasksRequest {
 	if t == nil || t.Request == nil {
 		return nil
@@ -1411,6 +1464,46 @@ func ToHistorySyncShardStatusRequest(t *historyv1.SyncShardStatusRequest) *types
 	}
 }
 
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		Request:  FromPauseWorkflowExecutionRequest(t.PauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.Request),
+		DomainUUID:   t.DomainId,
+	}
+}
+
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		Request:  FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.Request),
+		DomainUUID:     t.DomainId,
+	}
+}
+
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *historyv1.TerminateWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -1479,6 +1572,94 @@ func ToHistoryGetCrossClusterTasksResponse(t *historyv1.GetCrossClusterTasksResp
 	}
 }
 
+// FromHistoryGetReplicationStatusRequest converts internal HistoryGetReplicationStatusRequest type to proto
+func FromHistoryGetReplicationStatusRequest(t *types.HistoryGetReplicationStatusRequest) *historyv1.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusRequest{
+		ShardIds:      t.ShardIDs,
+		RemoteCluster: t.RemoteCluster,
+	}
+}
+
+// ToHistoryGetReplicationStatusRequest converts proto GetReplicationStatusRequest type to internal
+func ToHistoryGetReplicationStatusRequest(t *historyv1.GetReplicationStatusRequest) *types.HistoryGetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      t.ShardIds,
+		RemoteCluster: t.RemoteCluster,
+	}
+}
+
+// FromHistoryGetReplicationStatusResponse converts internal GetReplicationStatusResponse type to proto
+func FromHistoryGetReplicationStatusResponse(t *types.GetReplicationStatusResponse) *historyv1.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusResponse{
+		StatusByShard:      FromReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: FromGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// ToHistoryGetReplicationStatusResponse converts proto GetReplicationStatusResponse type to internal
+func ToHistoryGetReplicationStatusResponse(t *historyv1.GetReplicationStatusResponse) *types.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusResponse{
+		StatusByShard:      ToReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// FromHistoryGetReplicationReadinessRequest converts internal HistoryGetReplicationReadinessRequest type to proto
+func FromHistoryGetReplicationReadinessRequest(t *types.HistoryGetReplicationReadinessRequest) *historyv1.GetReplicationReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationReadinessRequest{
+		ShardIds:      t.ShardIDs,
+		SourceCluster: t.SourceCluster,
+	}
+}
+
+// ToHistoryGetReplicationReadinessRequest converts proto GetReplicationReadinessRequest type to internal
+func ToHistoryGetReplicationReadinessRequest(t *historyv1.GetReplicationReadinessRequest) *types.HistoryGetReplicationReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      t.ShardIds,
+		SourceCluster: t.SourceCluster,
+	}
+}
+
+// FromHistoryGetReplicationReadinessResponse converts internal GetReplicationReadinessResponse type to proto
+func FromHistoryGetReplicationReadinessResponse(t *types.GetReplicationReadinessResponse) *historyv1.GetReplicationReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationReadinessResponse{
+		ReadinessByShard:   FromReplicationShardReadinessMap(t.ReadinessByShard),
+		FailedCauseByShard: FromGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// ToHistoryGetReplicationReadinessResponse converts proto GetReplicationReadinessResponse type to internal
+func ToHistoryGetReplicationReadinessResponse(t *historyv1.GetReplicationReadinessResponse) *types.GetReplicationReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationReadinessResponse{
+		ReadinessByShard:   ToReplicationShardReadinessMap(t.ReadinessByShard),
+		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
 // FromHistoryRespondCrossClusterTasksCompletedRequest converts internal RespondCrossClusterTasksCompletedRequest type to thrift
 func FromHistoryRespondCrossClusterTasksCompletedRequest(t *types.RespondCrossClusterTasksCompletedRequest) *historyv1.RespondCrossClusterTasksCompletedRequest {
 	if t == nil {
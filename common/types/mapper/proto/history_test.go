@@ -196,6 +196,12 @@ func TestHistoryRecordDecisionTaskStartedResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRecordDecisionTaskStartedResponse(FromHistoryRecordDecisionTaskStartedResponse(item)))
 	}
 }
+func TestHistoryOperatePendingActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryOperatePendingActivityRequest{nil, &testdata.HistoryOperatePendingActivityRequest} {
+		assert.Equal(t, item, ToHistoryOperatePendingActivityRequest(FromHistoryOperatePendingActivityRequest(item)))
+	}
+	assert.Nil(t, FromHistoryOperatePendingActivityRequest(&types.HistoryOperatePendingActivityRequest{}))
+}
 func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	for _, item := range []*types.HistoryRefreshWorkflowTasksRequest{nil, &testdata.HistoryRefreshWorkflowTasksRequest} {
 		assert.Equal(t, item, ToHistoryRefreshWorkflowTasksRequest(FromHistoryRefreshWorkflowTasksRequest(item)))
@@ -312,12 +318,46 @@ func TestHistorySyncShardStatusRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySyncShardStatusRequest(FromHistorySyncShardStatusRequest(item)))
 	}
 }
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
 	}
 }
 
+func TestHistoryGetReplicationReadinessRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetReplicationReadinessRequest{nil, {}, &testdata.HistoryGetReplicationReadinessRequest} {
+		assert.Equal(t, item, ToHistoryGetReplicationReadinessRequest(FromHistoryGetReplicationReadinessRequest(item)))
+	}
+}
+
+func TestHistoryGetReplicationReadinessResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationReadinessResponse{nil, {}, &testdata.HistoryGetReplicationReadinessResponse} {
+		assert.Equal(t, item, ToHistoryGetReplicationReadinessResponse(FromHistoryGetReplicationReadinessResponse(item)))
+	}
+}
+
+func TestHistoryGetReplicationStatusRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetReplicationStatusRequest{nil, {}, &testdata.HistoryGetReplicationStatusRequest} {
+		assert.Equal(t, item, ToHistoryGetReplicationStatusRequest(FromHistoryGetReplicationStatusRequest(item)))
+	}
+}
+
+func TestHistoryGetReplicationStatusResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationStatusResponse{nil, {}, &testdata.HistoryGetReplicationStatusResponse} {
+		assert.Equal(t, item, ToHistoryGetReplicationStatusResponse(FromHistoryGetReplicationStatusResponse(item)))
+	}
+}
+
 func TestHistoryGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.HistoryGetCrossClusterTasksRequest} {
 		assert.Equal(t, item, ToHistoryGetCrossClusterTasksRequest(FromHistoryGetCrossClusterTasksRequest(item)))
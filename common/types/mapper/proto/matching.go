@@ -120,6 +120,30 @@ func ToMatchingCancelOutstandingPollRequest(t *matchingv1.CancelOutstandingPollR
 	}
 }
 
+func FromMatchingReleaseOutstandingTaskRequest(t *types.ReleaseOutstandingTaskRequest) *matchingv1.ReleaseOutstandingTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ReleaseOutstandingTaskRequest{
+		DomainId:          t.DomainUUID,
+		TaskList:          FromTaskList(t.TaskList),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:        t.ScheduleID,
+	}
+}
+
+func ToMatchingReleaseOutstandingTaskRequest(t *matchingv1.ReleaseOutstandingTaskRequest) *types.ReleaseOutstandingTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        t.DomainId,
+		TaskList:          ToTaskList(t.TaskList),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.ScheduleId,
+	}
+}
+
 func FromMatchingDescribeTaskListRequest(t *types.MatchingDescribeTaskListRequest) *matchingv1.DescribeTaskListRequest {
 	if t == nil {
 		return nil
@@ -160,6 +184,66 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	}
 }
 
+func FromMatchingMoveTaskListRequest(t *types.MatchingMoveTaskListRequest) *matchingv1.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	request := t.MoveRequest
+	if request == nil {
+		request = &types.MoveTaskListRequest{}
+	}
+	return &matchingv1.MoveTaskListRequest{
+		DomainId:       t.DomainUUID,
+		Domain:         request.Domain,
+		SourceTaskList: FromTaskList(request.SourceTaskList),
+		TargetTaskList: FromTaskList(request.TargetTaskList),
+		TaskListType:   FromTaskListType(request.TaskListType),
+		BatchSize:      request.BatchSize,
+		Rps:            request.RPS,
+		DryRun:         request.DryRun,
+	}
+}
+
+func ToMatchingMoveTaskListRequest(t *matchingv1.MoveTaskListRequest) *types.MatchingMoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMoveTaskListRequest{
+		DomainUUID: t.DomainId,
+		MoveRequest: &types.MoveTaskListRequest{
+			Domain:         t.Domain,
+			SourceTaskList: ToTaskList(t.SourceTaskList),
+			TargetTaskList: ToTaskList(t.TargetTaskList),
+			TaskListType:   ToTaskListType(t.TaskListType),
+			BatchSize:      t.BatchSize,
+			RPS:            t.Rps,
+			DryRun:         t.DryRun,
+		},
+	}
+}
+
+func FromMatchingMoveTaskListResponse(t *types.MoveTaskListResponse) *matchingv1.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MoveTaskListResponse{
+		TasksMoved:   t.TasksMoved,
+		TasksExpired: t.TasksExpired,
+		Failure:      t.Failure,
+	}
+}
+
+func ToMatchingMoveTaskListResponse(t *matchingv1.MoveTaskListResponse) *types.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListResponse{
+		TasksMoved:   t.TasksMoved,
+		TasksExpired: t.TasksExpired,
+		Failure:      t.Failure,
+	}
+}
+
 func FromMatchingListTaskListPartitionsRequest(t *types.MatchingListTaskListPartitionsRequest) *matchingv1.ListTaskListPartitionsRequest {
 	if t == nil {
 		return nil
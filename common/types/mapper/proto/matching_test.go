@@ -41,6 +41,12 @@ func TestMatchingAddDecisionTaskRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingReleaseOutstandingTaskRequest(t *testing.T) {
+	for _, item := range []*types.ReleaseOutstandingTaskRequest{nil, {}, &testdata.MatchingReleaseOutstandingTaskRequest} {
+		assert.Equal(t, item, ToMatchingReleaseOutstandingTaskRequest(FromMatchingReleaseOutstandingTaskRequest(item)))
+	}
+}
+
 func TestMatchingCancelOutstandingPollRequest(t *testing.T) {
 	for _, item := range []*types.CancelOutstandingPollRequest{nil, {}, &testdata.MatchingCancelOutstandingPollRequest} {
 		assert.Equal(t, item, ToMatchingCancelOutstandingPollRequest(FromMatchingCancelOutstandingPollRequest(item)))
@@ -130,3 +136,9 @@ func TestMatchingGetTaskListsByDomainResponse(t *testing.T) {
 		assert.Equal(t, item, ToMatchingGetTaskListsByDomainResponse(FromMatchingGetTaskListsByDomainResponse(item)))
 	}
 }
+
+func TestMatchingMoveTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingMoveTaskListRequest{nil, &testdata.MatchingMoveTaskListRequest} {
+		assert.Equal(t, item, ToMatchingMoveTaskListRequest(FromMatchingMoveTaskListRequest(item)))
+	}
+}
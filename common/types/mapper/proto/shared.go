@@ -672,6 +672,39 @@ func ToReplicationTaskInfo(t *sharedv1.ReplicationTaskInfo) *types.ReplicationTa
 	}
 }
 
+func FromPendingActivityOperation(t *types.PendingActivityOperation) sharedv1.PendingActivityOperation {
+	if t == nil {
+		return sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_INVALID
+	}
+	switch *t {
+	case types.PendingActivityOperationUpdateOptions:
+		return sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_UPDATE_OPTIONS
+	case types.PendingActivityOperationRetry:
+		return sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_RETRY
+	case types.PendingActivityOperationFail:
+		return sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_FAIL
+	case types.PendingActivityOperationSkip:
+		return sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_SKIP
+	}
+	panic("unexpected enum value")
+}
+
+func ToPendingActivityOperation(t sharedv1.PendingActivityOperation) *types.PendingActivityOperation {
+	switch t {
+	case sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_INVALID:
+		return nil
+	case sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_UPDATE_OPTIONS:
+		return types.PendingActivityOperationUpdateOptions.Ptr()
+	case sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_RETRY:
+		return types.PendingActivityOperationRetry.Ptr()
+	case sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_FAIL:
+		return types.PendingActivityOperationFail.Ptr()
+	case sharedv1.PendingActivityOperation_PENDING_ACTIVITY_OPERATION_SKIP:
+		return types.PendingActivityOperationSkip.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromReplicationTaskType(t *types.ReplicationTaskType) sharedv1.ReplicationTaskType {
 	if t == nil {
 		return sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_INVALID
@@ -691,6 +724,10 @@ func FromReplicationTaskType(t *types.ReplicationTaskType) sharedv1.ReplicationT
 		return sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_HISTORY_V2
 	case types.ReplicationTaskTypeFailoverMarker:
 		return sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_FAILOVER_MARKER
+	case types.ReplicationTaskTypeFrame:
+		return sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_FRAME
+	case types.ReplicationTaskTypeSyncCompletionCallbacks:
+		return sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_COMPLETION_CALLBACKS
 	}
 	panic("unexpected enum value")
 }
@@ -713,6 +750,10 @@ func ToReplicationTaskType(t sharedv1.ReplicationTaskType) *types.ReplicationTas
 		return types.ReplicationTaskTypeHistoryV2.Ptr()
 	case sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_FAILOVER_MARKER:
 		return types.ReplicationTaskTypeFailoverMarker.Ptr()
+	case sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_FRAME:
+		return types.ReplicationTaskTypeFrame.Ptr()
+	case sharedv1.ReplicationTaskType_REPLICATION_TASK_TYPE_SYNC_COMPLETION_CALLBACKS:
+		return types.ReplicationTaskTypeSyncCompletionCallbacks.Ptr()
 	}
 	panic("unexpected enum value")
 }
@@ -783,6 +824,31 @@ func ToSyncActivityTaskAttributes(t *sharedv1.SyncActivityTaskAttributes) *types
 	}
 }
 
+func FromSyncCompletionCallbacksTaskAttributes(t *types.SyncCompletionCallbacksTaskAttributes) *sharedv1.SyncCompletionCallbacksTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.SyncCompletionCallbacksTaskAttributes{
+		DomainId:            t.DomainID,
+		WorkflowExecution:   FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		Version:             t.Version,
+		CompletionCallbacks: FromCompletionCallbackInfoArray(t.CompletionCallbacks),
+	}
+}
+
+func ToSyncCompletionCallbacksTaskAttributes(t *sharedv1.SyncCompletionCallbacksTaskAttributes) *types.SyncCompletionCallbacksTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.SyncCompletionCallbacksTaskAttributes{
+		DomainID:            t.DomainId,
+		WorkflowID:          ToWorkflowID(t.WorkflowExecution),
+		RunID:               ToRunID(t.WorkflowExecution),
+		Version:             t.Version,
+		CompletionCallbacks: ToCompletionCallbackInfoArray(t.CompletionCallbacks),
+	}
+}
+
 func FromSyncShardStatus(t *types.SyncShardStatus) *sharedv1.SyncShardStatus {
 	if t == nil {
 		return nil
@@ -945,6 +1011,16 @@ func FromReplicationTask(t *types.ReplicationTask) *sharedv1.ReplicationTask {
 			FailoverMarkerAttributes: FromFailoverMarkerAttributes(t.FailoverMarkerAttributes),
 		}
 	}
+	if t.FrameAttributes != nil {
+		task.Attributes = &sharedv1.ReplicationTask_FrameAttributes{
+			FrameAttributes: FromReplicationTaskFrameAttributes(t.FrameAttributes),
+		}
+	}
+	if t.SyncCompletionCallbacksTaskAttributes != nil {
+		task.Attributes = &sharedv1.ReplicationTask_SyncCompletionCallbacksTaskAttributes{
+			SyncCompletionCallbacksTaskAttributes: FromSyncCompletionCallbacksTaskAttributes(t.SyncCompletionCallbacksTaskAttributes),
+		}
+	}
 
 	return &task
 }
@@ -970,10 +1046,32 @@ func ToReplicationTask(t *sharedv1.ReplicationTask) *types.ReplicationTask {
 		task.HistoryTaskV2Attributes = ToHistoryTaskV2Attributes(attr.HistoryTaskV2Attributes)
 	case *sharedv1.ReplicationTask_FailoverMarkerAttributes:
 		task.FailoverMarkerAttributes = ToFailoverMarkerAttributes(attr.FailoverMarkerAttributes)
+	case *sharedv1.ReplicationTask_FrameAttributes:
+		task.FrameAttributes = ToReplicationTaskFrameAttributes(attr.FrameAttributes)
+	case *sharedv1.ReplicationTask_SyncCompletionCallbacksTaskAttributes:
+		task.SyncCompletionCallbacksTaskAttributes = ToSyncCompletionCallbacksTaskAttributes(attr.SyncCompletionCallbacksTaskAttributes)
 	}
 	return &task
 }
 
+func FromReplicationTaskFrameAttributes(t *types.ReplicationTaskFrameAttributes) *sharedv1.ReplicationTaskFrameAttributes {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationTaskFrameAttributes{
+		Data: t.Data,
+	}
+}
+
+func ToReplicationTaskFrameAttributes(t *sharedv1.ReplicationTaskFrameAttributes) *types.ReplicationTaskFrameAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationTaskFrameAttributes{
+		Data: t.Data,
+	}
+}
+
 func FromTaskType(t *int32) sharedv1.TaskType {
 	if t == nil {
 		return sharedv1.TaskType_TASK_TYPE_INVALID
@@ -1781,3 +1879,261 @@ func ToCrossClusterTaskResponseArray(t []*sharedv1.CrossClusterTaskResponse) []*
 	}
 	return v
 }
+
+func FromReplicationDomainStatus(t *types.ReplicationDomainStatus) *sharedv1.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationDomainStatus{
+		DomainId:       t.DomainID,
+		Domain:         t.Domain,
+		Backlog:        t.Backlog,
+		OldestTaskTime: unixNanoToTime(t.OldestTaskTimestamp),
+		DlqSize:        t.DLQSize,
+	}
+}
+
+func ToReplicationDomainStatus(t *sharedv1.ReplicationDomainStatus) *types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDomainStatus{
+		DomainID:            t.DomainId,
+		Domain:              t.Domain,
+		Backlog:             t.Backlog,
+		OldestTaskTimestamp: timeToUnixNano(t.OldestTaskTime),
+		DLQSize:             t.DlqSize,
+	}
+}
+
+func FromReplicationDomainStatusArray(t []*types.ReplicationDomainStatus) []*sharedv1.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+func ToReplicationDomainStatusArray(t []*sharedv1.ReplicationDomainStatus) []*types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+func FromReplicationShardStatus(t *types.ReplicationShardStatus) *sharedv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationShardStatus{
+		ShardId:        t.ShardID,
+		AckLevel:       t.AckLevel,
+		LastTaskId:     t.LastTaskID,
+		ReplicatedUpTo: unixNanoToTime(t.ReplicatedUpToTimestamp),
+		Domains:        FromReplicationDomainStatusArray(t.Domains),
+		Truncated:      t.Truncated,
+	}
+}
+
+func ToReplicationShardStatus(t *sharedv1.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:                 t.ShardId,
+		AckLevel:                t.AckLevel,
+		LastTaskID:              t.LastTaskId,
+		ReplicatedUpToTimestamp: timeToUnixNano(t.ReplicatedUpTo),
+		Domains:                 ToReplicationDomainStatusArray(t.Domains),
+		Truncated:               t.Truncated,
+	}
+}
+
+func FromReplicationShardStatusMap(t map[int32]*types.ReplicationShardStatus) map[int32]*sharedv1.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*sharedv1.ReplicationShardStatus, len(t))
+	for key := range t {
+		v[key] = FromReplicationShardStatus(t[key])
+	}
+	return v
+}
+
+func ToReplicationShardStatusMap(t map[int32]*sharedv1.ReplicationShardStatus) map[int32]*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*types.ReplicationShardStatus, len(t))
+	for key := range t {
+		v[key] = ToReplicationShardStatus(t[key])
+	}
+	return v
+}
+
+func FromReplicationTaskFailure(t *types.ReplicationTaskFailure) *sharedv1.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationTaskFailure{
+		Count:     t.Count,
+		LastTime:  unixNanoToTime(&t.LastTimestamp),
+		LastError: t.LastError,
+	}
+}
+
+func ToReplicationTaskFailure(t *sharedv1.ReplicationTaskFailure) *types.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationTaskFailure{
+		Count:         t.Count,
+		LastTimestamp: common.Int64Default(timeToUnixNano(t.LastTime)),
+		LastError:     t.LastError,
+	}
+}
+
+func FromReplicationTaskFailureMap(t map[string]*types.ReplicationTaskFailure) map[string]*sharedv1.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*sharedv1.ReplicationTaskFailure, len(t))
+	for key := range t {
+		v[key] = FromReplicationTaskFailure(t[key])
+	}
+	return v
+}
+
+func ToReplicationTaskFailureMap(t map[string]*sharedv1.ReplicationTaskFailure) map[string]*types.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.ReplicationTaskFailure, len(t))
+	for key := range t {
+		v[key] = ToReplicationTaskFailure(t[key])
+	}
+	return v
+}
+
+func FromReplicationProcessorState(t *types.ReplicationProcessorState) *sharedv1.ReplicationProcessorState {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationProcessorState{
+		ReplicatedUpTo:   unixNanoToTime(t.ReplicatedUpToTimestamp),
+		FailuresByDomain: FromReplicationTaskFailureMap(t.FailuresByDomain),
+	}
+}
+
+func ToReplicationProcessorState(t *sharedv1.ReplicationProcessorState) *types.ReplicationProcessorState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: timeToUnixNano(t.ReplicatedUpTo),
+		FailuresByDomain:        ToReplicationTaskFailureMap(t.FailuresByDomain),
+	}
+}
+
+func FromReplicationDLQTaskState(t *types.ReplicationDLQTaskState) *sharedv1.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationDLQTaskState{
+		TaskId:          t.TaskID,
+		ErrorClass:      t.ErrorClass,
+		Attempts:        t.Attempts,
+		LastError:       t.LastError,
+		LastAttemptTime: unixNanoToTime(&t.LastAttemptTimestamp),
+		NextAttemptTime: unixNanoToTime(&t.NextAttemptTimestamp),
+		Poison:          t.Poison,
+	}
+}
+
+func ToReplicationDLQTaskState(t *sharedv1.ReplicationDLQTaskState) *types.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDLQTaskState{
+		TaskID:               t.TaskId,
+		ErrorClass:           t.ErrorClass,
+		Attempts:             t.Attempts,
+		LastError:            t.LastError,
+		LastAttemptTimestamp: common.Int64Default(timeToUnixNano(t.LastAttemptTime)),
+		NextAttemptTimestamp: common.Int64Default(timeToUnixNano(t.NextAttemptTime)),
+		Poison:               t.Poison,
+	}
+}
+
+func FromReplicationDLQTaskStateArray(t []*types.ReplicationDLQTaskState) []*sharedv1.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.ReplicationDLQTaskState, len(t))
+	for i := range t {
+		v[i] = FromReplicationDLQTaskState(t[i])
+	}
+	return v
+}
+
+func ToReplicationDLQTaskStateArray(t []*sharedv1.ReplicationDLQTaskState) []*types.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDLQTaskState, len(t))
+	for i := range t {
+		v[i] = ToReplicationDLQTaskState(t[i])
+	}
+	return v
+}
+
+func FromReplicationShardReadiness(t *types.ReplicationShardReadiness) *sharedv1.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationShardReadiness{
+		ShardId:         t.ShardID,
+		ProcessorState:  FromReplicationProcessorState(t.ProcessorState),
+		DlqSizeByDomain: t.DLQSizeByDomain,
+	}
+}
+
+func ToReplicationShardReadiness(t *sharedv1.ReplicationShardReadiness) *types.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardReadiness{
+		ShardID:         t.ShardId,
+		ProcessorState:  ToReplicationProcessorState(t.ProcessorState),
+		DLQSizeByDomain: t.DlqSizeByDomain,
+	}
+}
+
+func FromReplicationShardReadinessMap(t map[int32]*types.ReplicationShardReadiness) map[int32]*sharedv1.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*sharedv1.ReplicationShardReadiness, len(t))
+	for key := range t {
+		v[key] = FromReplicationShardReadiness(t[key])
+	}
+	return v
+}
+
+func ToReplicationShardReadinessMap(t map[int32]*sharedv1.ReplicationShardReadiness) map[int32]*types.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*types.ReplicationShardReadiness, len(t))
+	for key := range t {
+		v[key] = ToReplicationShardReadiness(t[key])
+	}
+	return v
+}
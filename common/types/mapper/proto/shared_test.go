@@ -161,8 +161,10 @@ func TestReplicationTask(t *testing.T) {
 		{},
 		&testdata.ReplicationTask_Domain,
 		&testdata.ReplicationTask_Failover,
+		&testdata.ReplicationTask_Frame,
 		&testdata.ReplicationTask_History,
 		&testdata.ReplicationTask_SyncActivity,
+		&testdata.ReplicationTask_SyncCompletionCallbacks,
 		&testdata.ReplicationTask_SyncShard,
 	} {
 		assert.Equal(t, item, ToReplicationTask(FromReplicationTask(item)))
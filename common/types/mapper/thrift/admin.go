@@ -57,6 +57,7 @@ func FromDescribeClusterResponse(t *types.DescribeClusterResponse) *admin.Descri
 		SupportedClientVersions: FromSupportedClientVersions(t.SupportedClientVersions),
 		MembershipInfo:          FromMembershipInfo(t.MembershipInfo),
 		PersistenceInfo:         FromPersistenceInfoMap(t.PersistenceInfo),
+		PersistenceHealth:       FromPersistenceHealth(t.PersistenceHealth),
 	}
 }
 
@@ -69,6 +70,31 @@ func ToDescribeClusterResponse(t *admin.DescribeClusterResponse) *types.Describe
 		SupportedClientVersions: ToSupportedClientVersions(t.SupportedClientVersions),
 		MembershipInfo:          ToMembershipInfo(t.MembershipInfo),
 		PersistenceInfo:         ToPersistenceInfoMap(t.PersistenceInfo),
+		PersistenceHealth:       ToPersistenceHealth(t.PersistenceHealth),
+	}
+}
+
+// FromPersistenceHealth converts internal PersistenceHealth type to thrift
+func FromPersistenceHealth(t *types.PersistenceHealth) *admin.PersistenceHealth {
+	if t == nil {
+		return nil
+	}
+	return &admin.PersistenceHealth{
+		Requests:        &t.Requests,
+		Failures:        &t.Failures,
+		WindowInSeconds: &t.WindowInSeconds,
+	}
+}
+
+// ToPersistenceHealth converts thrift PersistenceHealth type to internal
+func ToPersistenceHealth(t *admin.PersistenceHealth) *types.PersistenceHealth {
+	if t == nil {
+		return nil
+	}
+	return &types.PersistenceHealth{
+		Requests:        t.GetRequests(),
+		Failures:        t.GetFailures(),
+		WindowInSeconds: t.GetWindowInSeconds(),
 	}
 }
 
@@ -362,6 +388,130 @@ func ToPersistenceFeature(t *admin.PersistenceFeature) *types.PersistenceFeature
 	}
 }
 
+// FromGetReplicationStatusRequest converts internal GetReplicationStatusRequest type to thrift
+func FromGetReplicationStatusRequest(t *types.GetReplicationStatusRequest) *admin.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetReplicationStatusRequest{
+		SourceCluster: &t.SourceCluster,
+		TargetCluster: &t.TargetCluster,
+		ShardIDs:      t.ShardIDs,
+	}
+}
+
+// ToGetReplicationStatusRequest converts thrift GetReplicationStatusRequest type to internal
+func ToGetReplicationStatusRequest(t *admin.GetReplicationStatusRequest) *types.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusRequest{
+		SourceCluster: t.GetSourceCluster(),
+		TargetCluster: t.GetTargetCluster(),
+		ShardIDs:      t.ShardIDs,
+	}
+}
+
+// FromGetFailoverReadinessRequest converts internal GetFailoverReadinessRequest type to thrift
+func FromGetFailoverReadinessRequest(t *types.GetFailoverReadinessRequest) *admin.GetFailoverReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetFailoverReadinessRequest{
+		Domain:                          &t.Domain,
+		MaxReplicationLagInSeconds:      t.MaxReplicationLagInSeconds,
+		ReplicationErrorWindowInSeconds: t.ReplicationErrorWindowInSeconds,
+	}
+}
+
+// ToGetFailoverReadinessRequest converts thrift GetFailoverReadinessRequest type to internal
+func ToGetFailoverReadinessRequest(t *admin.GetFailoverReadinessRequest) *types.GetFailoverReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetFailoverReadinessRequest{
+		Domain:                          t.GetDomain(),
+		MaxReplicationLagInSeconds:      t.MaxReplicationLagInSeconds,
+		ReplicationErrorWindowInSeconds: t.ReplicationErrorWindowInSeconds,
+	}
+}
+
+// FromFailoverReadinessCheck converts internal FailoverReadinessCheck type to thrift
+func FromFailoverReadinessCheck(t *types.FailoverReadinessCheck) *admin.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	return &admin.FailoverReadinessCheck{
+		Name:    &t.Name,
+		Passed:  &t.Passed,
+		Message: &t.Message,
+	}
+}
+
+// ToFailoverReadinessCheck converts thrift FailoverReadinessCheck type to internal
+func ToFailoverReadinessCheck(t *admin.FailoverReadinessCheck) *types.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	return &types.FailoverReadinessCheck{
+		Name:    t.GetName(),
+		Passed:  t.GetPassed(),
+		Message: t.GetMessage(),
+	}
+}
+
+// FromFailoverReadinessCheckArray converts internal FailoverReadinessCheck type array to thrift
+func FromFailoverReadinessCheckArray(t []*types.FailoverReadinessCheck) []*admin.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.FailoverReadinessCheck, len(t))
+	for i := range t {
+		v[i] = FromFailoverReadinessCheck(t[i])
+	}
+	return v
+}
+
+// ToFailoverReadinessCheckArray converts thrift FailoverReadinessCheck type array to internal
+func ToFailoverReadinessCheckArray(t []*admin.FailoverReadinessCheck) []*types.FailoverReadinessCheck {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.FailoverReadinessCheck, len(t))
+	for i := range t {
+		v[i] = ToFailoverReadinessCheck(t[i])
+	}
+	return v
+}
+
+// FromGetFailoverReadinessResponse converts internal GetFailoverReadinessResponse type to thrift
+func FromGetFailoverReadinessResponse(t *types.GetFailoverReadinessResponse) *admin.GetFailoverReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetFailoverReadinessResponse{
+		DomainID:      &t.DomainID,
+		Cluster:       &t.Cluster,
+		SourceCluster: &t.SourceCluster,
+		Ready:         &t.Ready,
+		Checks:        FromFailoverReadinessCheckArray(t.Checks),
+	}
+}
+
+// ToGetFailoverReadinessResponse converts thrift GetFailoverReadinessResponse type to internal
+func ToGetFailoverReadinessResponse(t *admin.GetFailoverReadinessResponse) *types.GetFailoverReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetFailoverReadinessResponse{
+		DomainID:      t.GetDomainID(),
+		Cluster:       t.GetCluster(),
+		SourceCluster: t.GetSourceCluster(),
+		Ready:         t.GetReady(),
+		Checks:        ToFailoverReadinessCheckArray(t.Checks),
+	}
+}
+
 // FromResendReplicationTasksRequest converts internal ResendReplicationTasksRequest type to thrift
 func FromResendReplicationTasksRequest(t *types.ResendReplicationTasksRequest) *admin.ResendReplicationTasksRequest {
 	if t == nil {
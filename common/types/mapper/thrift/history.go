@@ -24,6 +24,7 @@ import (
 	"github.com/uber/cadence/common/types"
 
 	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 )
 
 // FromDescribeMutableStateRequest converts internal DescribeMutableStateRequest type to thrift
@@ -654,6 +655,150 @@ func ToRecordDecisionTaskStartedResponse(t *history.RecordDecisionTaskStartedRes
 	}
 }
 
+// FromReplicationProcessorStates converts internal ReplicationProcessorStates type to thrift
+func FromReplicationProcessorStates(t *types.ReplicationProcessorStates) *history.ReplicationProcessorStates {
+	if t == nil {
+		return nil
+	}
+	states := make(map[string]*replicator.ReplicationProcessorState, len(t.StatesByCluster))
+	for cluster, state := range t.StatesByCluster {
+		states[cluster] = FromReplicationProcessorState(state)
+	}
+	return &history.ReplicationProcessorStates{
+		StatesByCluster: states,
+	}
+}
+
+// ToReplicationProcessorStates converts thrift ReplicationProcessorStates type to internal
+func ToReplicationProcessorStates(t *history.ReplicationProcessorStates) *types.ReplicationProcessorStates {
+	if t == nil {
+		return nil
+	}
+	states := make(map[string]*types.ReplicationProcessorState, len(t.StatesByCluster))
+	for cluster, state := range t.StatesByCluster {
+		states[cluster] = ToReplicationProcessorState(state)
+	}
+	return &types.ReplicationProcessorStates{
+		StatesByCluster: states,
+	}
+}
+
+// FromReplicationDLQRetryStates converts internal ReplicationDLQRetryStates type to thrift
+func FromReplicationDLQRetryStates(t *types.ReplicationDLQRetryStates) *history.ReplicationDLQRetryStates {
+	if t == nil {
+		return nil
+	}
+	states := make(map[string]*history.ReplicationDLQRetryState, len(t.StatesByCluster))
+	for cluster, state := range t.StatesByCluster {
+		states[cluster] = FromReplicationDLQRetryState(state)
+	}
+	return &history.ReplicationDLQRetryStates{
+		StatesByCluster: states,
+	}
+}
+
+// ToReplicationDLQRetryStates converts thrift ReplicationDLQRetryStates type to internal
+func ToReplicationDLQRetryStates(t *history.ReplicationDLQRetryStates) *types.ReplicationDLQRetryStates {
+	if t == nil {
+		return nil
+	}
+	states := make(map[string]*types.ReplicationDLQRetryState, len(t.StatesByCluster))
+	for cluster, state := range t.StatesByCluster {
+		states[cluster] = ToReplicationDLQRetryState(state)
+	}
+	return &types.ReplicationDLQRetryStates{
+		StatesByCluster: states,
+	}
+}
+
+// FromReplicationDLQRetryState converts internal ReplicationDLQRetryState type to thrift
+func FromReplicationDLQRetryState(t *types.ReplicationDLQRetryState) *history.ReplicationDLQRetryState {
+	if t == nil {
+		return nil
+	}
+	return &history.ReplicationDLQRetryState{
+		Cursor:     &t.Cursor,
+		TaskStates: FromReplicationDLQTaskStateArray(t.TaskStates),
+	}
+}
+
+// ToReplicationDLQRetryState converts thrift ReplicationDLQRetryState type to internal
+func ToReplicationDLQRetryState(t *history.ReplicationDLQRetryState) *types.ReplicationDLQRetryState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDLQRetryState{
+		Cursor:     t.GetCursor(),
+		TaskStates: ToReplicationDLQTaskStateArray(t.TaskStates),
+	}
+}
+
+// FromHistoryGetReplicationReadinessRequest converts internal HistoryGetReplicationReadinessRequest type to thrift
+func FromHistoryGetReplicationReadinessRequest(t *types.HistoryGetReplicationReadinessRequest) *history.GetReplicationReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetReplicationReadinessRequest{
+		ShardIDs:      t.ShardIDs,
+		SourceCluster: &t.SourceCluster,
+	}
+}
+
+// ToHistoryGetReplicationReadinessRequest converts thrift GetReplicationReadinessRequest type to internal
+func ToHistoryGetReplicationReadinessRequest(t *history.GetReplicationReadinessRequest) *types.HistoryGetReplicationReadinessRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      t.ShardIDs,
+		SourceCluster: t.GetSourceCluster(),
+	}
+}
+
+// FromHistoryGetReplicationStatusRequest converts internal HistoryGetReplicationStatusRequest type to thrift
+func FromHistoryGetReplicationStatusRequest(t *types.HistoryGetReplicationStatusRequest) *history.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetReplicationStatusRequest{
+		ShardIDs:      t.ShardIDs,
+		RemoteCluster: &t.RemoteCluster,
+	}
+}
+
+// ToHistoryGetReplicationStatusRequest converts thrift GetReplicationStatusRequest type to internal
+func ToHistoryGetReplicationStatusRequest(t *history.GetReplicationStatusRequest) *types.HistoryGetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      t.ShardIDs,
+		RemoteCluster: t.GetRemoteCluster(),
+	}
+}
+
+// FromHistoryOperatePendingActivityRequest converts internal HistoryOperatePendingActivityRequest type to thrift
+func FromHistoryOperatePendingActivityRequest(t *types.HistoryOperatePendingActivityRequest) *history.OperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.OperatePendingActivityRequest{
+		DomainUUID: &t.DomainUUID,
+		Request:    FromOperatePendingActivityRequest(t.Request),
+	}
+}
+
+// ToHistoryOperatePendingActivityRequest converts thrift OperatePendingActivityRequest type to internal
+func ToHistoryOperatePendingActivityRequest(t *history.OperatePendingActivityRequest) *types.HistoryOperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryOperatePendingActivityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request:    ToOperatePendingActivityRequest(t.Request),
+	}
+}
+
 // FromHistoryRefreshWorkflowTasksRequest converts internal RefreshWorkflowTasksRequest type to thrift
 func FromHistoryRefreshWorkflowTasksRequest(t *types.HistoryRefreshWorkflowTasksRequest) *history.RefreshWorkflowTasksRequest {
 	if t == nil {
@@ -1152,6 +1297,50 @@ func ToSyncShardStatusRequest(t *history.SyncShardStatusRequest) *types.SyncShar
 	}
 }
 
+// FromHistoryPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *history.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseWorkflowExecutionRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *history.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *history.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *history.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
 // FromHistoryTerminateWorkflowExecutionRequest converts internal TerminateWorkflowExecutionRequest type to thrift
 func FromHistoryTerminateWorkflowExecutionRequest(t *types.HistoryTerminateWorkflowExecutionRequest) *history.TerminateWorkflowExecutionRequest {
 	if t == nil {
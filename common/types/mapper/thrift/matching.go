@@ -118,6 +118,32 @@ func ToCancelOutstandingPollRequest(t *matching.CancelOutstandingPollRequest) *t
 	}
 }
 
+// FromReleaseOutstandingTaskRequest converts internal ReleaseOutstandingTaskRequest type to thrift
+func FromReleaseOutstandingTaskRequest(t *types.ReleaseOutstandingTaskRequest) *matching.ReleaseOutstandingTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.ReleaseOutstandingTaskRequest{
+		DomainUUID:        &t.DomainUUID,
+		TaskList:          FromTaskList(t.TaskList),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:        &t.ScheduleID,
+	}
+}
+
+// ToReleaseOutstandingTaskRequest converts thrift ReleaseOutstandingTaskRequest type to internal
+func ToReleaseOutstandingTaskRequest(t *matching.ReleaseOutstandingTaskRequest) *types.ReleaseOutstandingTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		TaskList:          ToTaskList(t.TaskList),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.GetScheduleId(),
+	}
+}
+
 // FromMatchingDescribeTaskListRequest converts internal DescribeTaskListRequest type to thrift
 func FromMatchingDescribeTaskListRequest(t *types.MatchingDescribeTaskListRequest) *matching.DescribeTaskListRequest {
 	if t == nil {
@@ -140,6 +166,28 @@ func ToMatchingDescribeTaskListRequest(t *matching.DescribeTaskListRequest) *typ
 	}
 }
 
+// FromMatchingMoveTaskListRequest converts internal MoveTaskListRequest type to thrift
+func FromMatchingMoveTaskListRequest(t *types.MatchingMoveTaskListRequest) *matching.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.MoveTaskListRequest{
+		DomainUUID:  &t.DomainUUID,
+		MoveRequest: FromMoveTaskListRequest(t.MoveRequest),
+	}
+}
+
+// ToMatchingMoveTaskListRequest converts thrift MoveTaskListRequest type to internal
+func ToMatchingMoveTaskListRequest(t *matching.MoveTaskListRequest) *types.MatchingMoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMoveTaskListRequest{
+		DomainUUID:  t.GetDomainUUID(),
+		MoveRequest: ToMoveTaskListRequest(t.MoveRequest),
+	}
+}
+
 // FromMatchingListTaskListPartitionsRequest converts internal ListTaskListPartitionsRequest type to thrift
 func FromMatchingListTaskListPartitionsRequest(t *types.MatchingListTaskListPartitionsRequest) *matching.ListTaskListPartitionsRequest {
 	if t == nil {
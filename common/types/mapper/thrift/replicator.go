@@ -442,6 +442,7 @@ func FromReadDLQMessagesResponse(t *types.ReadDLQMessagesResponse) *replicator.R
 		ReplicationTasks:     FromReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: FromReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          FromReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -455,6 +456,7 @@ func ToReadDLQMessagesResponse(t *replicator.ReadDLQMessagesResponse) *types.Rea
 		ReplicationTasks:     ToReplicationTaskArray(t.ReplicationTasks),
 		ReplicationTasksInfo: ToReplicationTaskInfoArray(t.ReplicationTasksInfo),
 		NextPageToken:        t.NextPageToken,
+		RetryStates:          ToReplicationDLQTaskStateArray(t.RetryStates),
 	}
 }
 
@@ -490,14 +492,16 @@ func FromReplicationTask(t *types.ReplicationTask) *replicator.ReplicationTask {
 		return nil
 	}
 	return &replicator.ReplicationTask{
-		TaskType:                      FromReplicationTaskType(t.TaskType),
-		SourceTaskId:                  &t.SourceTaskID,
-		DomainTaskAttributes:          FromDomainTaskAttributes(t.DomainTaskAttributes),
-		SyncShardStatusTaskAttributes: FromSyncShardStatusTaskAttributes(t.SyncShardStatusTaskAttributes),
-		SyncActivityTaskAttributes:    FromSyncActivityTaskAttributes(t.SyncActivityTaskAttributes),
-		HistoryTaskV2Attributes:       FromHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
-		FailoverMarkerAttributes:      FromFailoverMarkerAttributes(t.FailoverMarkerAttributes),
-		CreationTime:                  t.CreationTime,
+		TaskType:                              FromReplicationTaskType(t.TaskType),
+		SourceTaskId:                          &t.SourceTaskID,
+		DomainTaskAttributes:                  FromDomainTaskAttributes(t.DomainTaskAttributes),
+		SyncShardStatusTaskAttributes:         FromSyncShardStatusTaskAttributes(t.SyncShardStatusTaskAttributes),
+		SyncActivityTaskAttributes:            FromSyncActivityTaskAttributes(t.SyncActivityTaskAttributes),
+		HistoryTaskV2Attributes:               FromHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
+		FailoverMarkerAttributes:              FromFailoverMarkerAttributes(t.FailoverMarkerAttributes),
+		CreationTime:                          t.CreationTime,
+		FrameAttributes:                       FromReplicationTaskFrameAttributes(t.FrameAttributes),
+		SyncCompletionCallbacksTaskAttributes: FromSyncCompletionCallbacksTaskAttributes(t.SyncCompletionCallbacksTaskAttributes),
 	}
 }
 
@@ -507,14 +511,64 @@ func ToReplicationTask(t *replicator.ReplicationTask) *types.ReplicationTask {
 		return nil
 	}
 	return &types.ReplicationTask{
-		TaskType:                      ToReplicationTaskType(t.TaskType),
-		SourceTaskID:                  t.GetSourceTaskId(),
-		DomainTaskAttributes:          ToDomainTaskAttributes(t.DomainTaskAttributes),
-		SyncShardStatusTaskAttributes: ToSyncShardStatusTaskAttributes(t.SyncShardStatusTaskAttributes),
-		SyncActivityTaskAttributes:    ToSyncActivityTaskAttributes(t.SyncActivityTaskAttributes),
-		HistoryTaskV2Attributes:       ToHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
-		FailoverMarkerAttributes:      ToFailoverMarkerAttributes(t.FailoverMarkerAttributes),
-		CreationTime:                  t.CreationTime,
+		TaskType:                              ToReplicationTaskType(t.TaskType),
+		SourceTaskID:                          t.GetSourceTaskId(),
+		DomainTaskAttributes:                  ToDomainTaskAttributes(t.DomainTaskAttributes),
+		SyncShardStatusTaskAttributes:         ToSyncShardStatusTaskAttributes(t.SyncShardStatusTaskAttributes),
+		SyncActivityTaskAttributes:            ToSyncActivityTaskAttributes(t.SyncActivityTaskAttributes),
+		HistoryTaskV2Attributes:               ToHistoryTaskV2Attributes(t.HistoryTaskV2Attributes),
+		FailoverMarkerAttributes:              ToFailoverMarkerAttributes(t.FailoverMarkerAttributes),
+		CreationTime:                          t.CreationTime,
+		FrameAttributes:                       ToReplicationTaskFrameAttributes(t.FrameAttributes),
+		SyncCompletionCallbacksTaskAttributes: ToSyncCompletionCallbacksTaskAttributes(t.SyncCompletionCallbacksTaskAttributes),
+	}
+}
+
+// FromReplicationTaskFrameAttributes converts internal ReplicationTaskFrameAttributes type to thrift
+func FromReplicationTaskFrameAttributes(t *types.ReplicationTaskFrameAttributes) *replicator.ReplicationTaskFrameAttributes {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationTaskFrameAttributes{
+		Data: t.Data,
+	}
+}
+
+// ToReplicationTaskFrameAttributes converts thrift ReplicationTaskFrameAttributes type to internal
+func ToReplicationTaskFrameAttributes(t *replicator.ReplicationTaskFrameAttributes) *types.ReplicationTaskFrameAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationTaskFrameAttributes{
+		Data: t.Data,
+	}
+}
+
+// FromSyncCompletionCallbacksTaskAttributes converts internal SyncCompletionCallbacksTaskAttributes type to thrift
+func FromSyncCompletionCallbacksTaskAttributes(t *types.SyncCompletionCallbacksTaskAttributes) *replicator.SyncCompletionCallbacksTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &replicator.SyncCompletionCallbacksTaskAttributes{
+		DomainId:            &t.DomainID,
+		WorkflowId:          &t.WorkflowID,
+		RunId:               &t.RunID,
+		Version:             &t.Version,
+		CompletionCallbacks: FromCompletionCallbackInfoArray(t.CompletionCallbacks),
+	}
+}
+
+// ToSyncCompletionCallbacksTaskAttributes converts thrift SyncCompletionCallbacksTaskAttributes type to internal
+func ToSyncCompletionCallbacksTaskAttributes(t *replicator.SyncCompletionCallbacksTaskAttributes) *types.SyncCompletionCallbacksTaskAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.SyncCompletionCallbacksTaskAttributes{
+		DomainID:            t.GetDomainId(),
+		WorkflowID:          t.GetWorkflowId(),
+		RunID:               t.GetRunId(),
+		Version:             t.GetVersion(),
+		CompletionCallbacks: ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -581,6 +635,12 @@ func FromReplicationTaskType(t *types.ReplicationTaskType) *replicator.Replicati
 	case types.ReplicationTaskTypeFailoverMarker:
 		v := replicator.ReplicationTaskTypeFailoverMarker
 		return &v
+	case types.ReplicationTaskTypeFrame:
+		v := replicator.ReplicationTaskTypeFrame
+		return &v
+	case types.ReplicationTaskTypeSyncCompletionCallbacks:
+		v := replicator.ReplicationTaskTypeSyncCompletionCallbacks
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -612,6 +672,12 @@ func ToReplicationTaskType(t *replicator.ReplicationTaskType) *types.Replication
 	case replicator.ReplicationTaskTypeFailoverMarker:
 		v := types.ReplicationTaskTypeFailoverMarker
 		return &v
+	case replicator.ReplicationTaskTypeFrame:
+		v := types.ReplicationTaskTypeFrame
+		return &v
+	case replicator.ReplicationTaskTypeSyncCompletionCallbacks:
+		v := types.ReplicationTaskTypeSyncCompletionCallbacks
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -851,3 +917,327 @@ func ToReplicationMessagesMap(t map[int32]*replicator.ReplicationMessages) map[i
 	}
 	return v
 }
+
+// FromReplicationDomainStatus converts internal ReplicationDomainStatus type to thrift
+func FromReplicationDomainStatus(t *types.ReplicationDomainStatus) *replicator.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationDomainStatus{
+		DomainID:            &t.DomainID,
+		Domain:              &t.Domain,
+		Backlog:             &t.Backlog,
+		OldestTaskTimestamp: t.OldestTaskTimestamp,
+		DlqSize:             &t.DLQSize,
+	}
+}
+
+// ToReplicationDomainStatus converts thrift ReplicationDomainStatus type to internal
+func ToReplicationDomainStatus(t *replicator.ReplicationDomainStatus) *types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDomainStatus{
+		DomainID:            t.GetDomainID(),
+		Domain:              t.GetDomain(),
+		Backlog:             t.GetBacklog(),
+		OldestTaskTimestamp: t.OldestTaskTimestamp,
+		DLQSize:             t.GetDlqSize(),
+	}
+}
+
+// FromReplicationDomainStatusArray converts internal ReplicationDomainStatus type array to thrift
+func FromReplicationDomainStatusArray(t []*types.ReplicationDomainStatus) []*replicator.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*replicator.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = FromReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+// ToReplicationDomainStatusArray converts thrift ReplicationDomainStatus type array to internal
+func ToReplicationDomainStatusArray(t []*replicator.ReplicationDomainStatus) []*types.ReplicationDomainStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDomainStatus, len(t))
+	for i := range t {
+		v[i] = ToReplicationDomainStatus(t[i])
+	}
+	return v
+}
+
+// FromReplicationShardStatus converts internal ReplicationShardStatus type to thrift
+func FromReplicationShardStatus(t *types.ReplicationShardStatus) *replicator.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationShardStatus{
+		ShardID:                 &t.ShardID,
+		AckLevel:                &t.AckLevel,
+		LastTaskID:              &t.LastTaskID,
+		ReplicatedUpToTimestamp: t.ReplicatedUpToTimestamp,
+		Domains:                 FromReplicationDomainStatusArray(t.Domains),
+		Truncated:               &t.Truncated,
+	}
+}
+
+// ToReplicationShardStatus converts thrift ReplicationShardStatus type to internal
+func ToReplicationShardStatus(t *replicator.ReplicationShardStatus) *types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardStatus{
+		ShardID:                 t.GetShardID(),
+		AckLevel:                t.GetAckLevel(),
+		LastTaskID:              t.GetLastTaskID(),
+		ReplicatedUpToTimestamp: t.ReplicatedUpToTimestamp,
+		Domains:                 ToReplicationDomainStatusArray(t.Domains),
+		Truncated:               t.GetTruncated(),
+	}
+}
+
+// FromReplicationShardStatusMap converts internal ReplicationShardStatus type map to thrift
+func FromReplicationShardStatusMap(t map[int32]*types.ReplicationShardStatus) map[int32]*replicator.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*replicator.ReplicationShardStatus, len(t))
+	for key := range t {
+		v[key] = FromReplicationShardStatus(t[key])
+	}
+	return v
+}
+
+// ToReplicationShardStatusMap converts thrift ReplicationShardStatus type map to internal
+func ToReplicationShardStatusMap(t map[int32]*replicator.ReplicationShardStatus) map[int32]*types.ReplicationShardStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*types.ReplicationShardStatus, len(t))
+	for key := range t {
+		v[key] = ToReplicationShardStatus(t[key])
+	}
+	return v
+}
+
+// FromGetReplicationStatusResponse converts internal GetReplicationStatusResponse type to thrift
+func FromGetReplicationStatusResponse(t *types.GetReplicationStatusResponse) *replicator.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &replicator.GetReplicationStatusResponse{
+		StatusByShard:      FromReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: FromGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// ToGetReplicationStatusResponse converts thrift GetReplicationStatusResponse type to internal
+func ToGetReplicationStatusResponse(t *replicator.GetReplicationStatusResponse) *types.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusResponse{
+		StatusByShard:      ToReplicationShardStatusMap(t.StatusByShard),
+		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// FromReplicationTaskFailure converts internal ReplicationTaskFailure type to thrift
+func FromReplicationTaskFailure(t *types.ReplicationTaskFailure) *replicator.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationTaskFailure{
+		Count:         &t.Count,
+		LastTimestamp: &t.LastTimestamp,
+		LastError:     &t.LastError,
+	}
+}
+
+// ToReplicationTaskFailure converts thrift ReplicationTaskFailure type to internal
+func ToReplicationTaskFailure(t *replicator.ReplicationTaskFailure) *types.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationTaskFailure{
+		Count:         t.GetCount(),
+		LastTimestamp: t.GetLastTimestamp(),
+		LastError:     t.GetLastError(),
+	}
+}
+
+// FromReplicationTaskFailureMap converts internal ReplicationTaskFailure type map to thrift
+func FromReplicationTaskFailureMap(t map[string]*types.ReplicationTaskFailure) map[string]*replicator.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*replicator.ReplicationTaskFailure, len(t))
+	for key := range t {
+		v[key] = FromReplicationTaskFailure(t[key])
+	}
+	return v
+}
+
+// ToReplicationTaskFailureMap converts thrift ReplicationTaskFailure type map to internal
+func ToReplicationTaskFailureMap(t map[string]*replicator.ReplicationTaskFailure) map[string]*types.ReplicationTaskFailure {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.ReplicationTaskFailure, len(t))
+	for key := range t {
+		v[key] = ToReplicationTaskFailure(t[key])
+	}
+	return v
+}
+
+// FromReplicationProcessorState converts internal ReplicationProcessorState type to thrift
+func FromReplicationProcessorState(t *types.ReplicationProcessorState) *replicator.ReplicationProcessorState {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: t.ReplicatedUpToTimestamp,
+		FailuresByDomain:        FromReplicationTaskFailureMap(t.FailuresByDomain),
+	}
+}
+
+// ToReplicationProcessorState converts thrift ReplicationProcessorState type to internal
+func ToReplicationProcessorState(t *replicator.ReplicationProcessorState) *types.ReplicationProcessorState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: t.ReplicatedUpToTimestamp,
+		FailuresByDomain:        ToReplicationTaskFailureMap(t.FailuresByDomain),
+	}
+}
+
+// FromReplicationDLQTaskState converts internal ReplicationDLQTaskState type to thrift
+func FromReplicationDLQTaskState(t *types.ReplicationDLQTaskState) *replicator.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationDLQTaskState{
+		TaskID:               &t.TaskID,
+		ErrorClass:           &t.ErrorClass,
+		Attempts:             &t.Attempts,
+		LastError:            &t.LastError,
+		LastAttemptTimestamp: &t.LastAttemptTimestamp,
+		NextAttemptTimestamp: &t.NextAttemptTimestamp,
+		Poison:               &t.Poison,
+	}
+}
+
+// ToReplicationDLQTaskState converts thrift ReplicationDLQTaskState type to internal
+func ToReplicationDLQTaskState(t *replicator.ReplicationDLQTaskState) *types.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationDLQTaskState{
+		TaskID:               t.GetTaskID(),
+		ErrorClass:           t.GetErrorClass(),
+		Attempts:             t.GetAttempts(),
+		LastError:            t.GetLastError(),
+		LastAttemptTimestamp: t.GetLastAttemptTimestamp(),
+		NextAttemptTimestamp: t.GetNextAttemptTimestamp(),
+		Poison:               t.GetPoison(),
+	}
+}
+
+// FromReplicationDLQTaskStateArray converts internal ReplicationDLQTaskState type array to thrift
+func FromReplicationDLQTaskStateArray(t []*types.ReplicationDLQTaskState) []*replicator.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*replicator.ReplicationDLQTaskState, len(t))
+	for i := range t {
+		v[i] = FromReplicationDLQTaskState(t[i])
+	}
+	return v
+}
+
+// ToReplicationDLQTaskStateArray converts thrift ReplicationDLQTaskState type array to internal
+func ToReplicationDLQTaskStateArray(t []*replicator.ReplicationDLQTaskState) []*types.ReplicationDLQTaskState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationDLQTaskState, len(t))
+	for i := range t {
+		v[i] = ToReplicationDLQTaskState(t[i])
+	}
+	return v
+}
+
+// FromReplicationShardReadiness converts internal ReplicationShardReadiness type to thrift
+func FromReplicationShardReadiness(t *types.ReplicationShardReadiness) *replicator.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	return &replicator.ReplicationShardReadiness{
+		ShardID:         &t.ShardID,
+		ProcessorState:  FromReplicationProcessorState(t.ProcessorState),
+		DlqSizeByDomain: t.DLQSizeByDomain,
+	}
+}
+
+// ToReplicationShardReadiness converts thrift ReplicationShardReadiness type to internal
+func ToReplicationShardReadiness(t *replicator.ReplicationShardReadiness) *types.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationShardReadiness{
+		ShardID:         t.GetShardID(),
+		ProcessorState:  ToReplicationProcessorState(t.ProcessorState),
+		DLQSizeByDomain: t.DlqSizeByDomain,
+	}
+}
+
+// FromReplicationShardReadinessMap converts internal ReplicationShardReadiness type map to thrift
+func FromReplicationShardReadinessMap(t map[int32]*types.ReplicationShardReadiness) map[int32]*replicator.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*replicator.ReplicationShardReadiness, len(t))
+	for key := range t {
+		v[key] = FromReplicationShardReadiness(t[key])
+	}
+	return v
+}
+
+// ToReplicationShardReadinessMap converts thrift ReplicationShardReadiness type map to internal
+func ToReplicationShardReadinessMap(t map[int32]*replicator.ReplicationShardReadiness) map[int32]*types.ReplicationShardReadiness {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*types.ReplicationShardReadiness, len(t))
+	for key := range t {
+		v[key] = ToReplicationShardReadiness(t[key])
+	}
+	return v
+}
+
+// FromGetReplicationReadinessResponse converts internal GetReplicationReadinessResponse type to thrift
+func FromGetReplicationReadinessResponse(t *types.GetReplicationReadinessResponse) *replicator.GetReplicationReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &replicator.GetReplicationReadinessResponse{
+		ReadinessByShard:   FromReplicationShardReadinessMap(t.ReadinessByShard),
+		FailedCauseByShard: FromGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
+
+// ToGetReplicationReadinessResponse converts thrift GetReplicationReadinessResponse type to internal
+func ToGetReplicationReadinessResponse(t *replicator.GetReplicationReadinessResponse) *types.GetReplicationReadinessResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationReadinessResponse{
+		ReadinessByShard:   ToReplicationShardReadinessMap(t.ReadinessByShard),
+		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
+	}
+}
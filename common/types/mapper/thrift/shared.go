@@ -178,6 +178,62 @@ func ToActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttrib
 	}
 }
 
+// FromActivityTaskOptionsUpdatedEventAttributes converts internal ActivityTaskOptionsUpdatedEventAttributes type to thrift
+func FromActivityTaskOptionsUpdatedEventAttributes(t *types.ActivityTaskOptionsUpdatedEventAttributes) *shared.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventId:              &t.ScheduledEventID,
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Identity:                      &t.Identity,
+	}
+}
+
+// ToActivityTaskOptionsUpdatedEventAttributes converts thrift ActivityTaskOptionsUpdatedEventAttributes type to internal
+func ToActivityTaskOptionsUpdatedEventAttributes(t *shared.ActivityTaskOptionsUpdatedEventAttributes) *types.ActivityTaskOptionsUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID:              t.GetScheduledEventId(),
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Identity:                      t.GetIdentity(),
+	}
+}
+
+// FromActivityTaskRetryRequestedEventAttributes converts internal ActivityTaskRetryRequestedEventAttributes type to thrift
+func FromActivityTaskRetryRequestedEventAttributes(t *types.ActivityTaskRetryRequestedEventAttributes) *shared.ActivityTaskRetryRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventId: &t.ScheduledEventID,
+		ResetAttempts:    &t.ResetAttempts,
+		Identity:         &t.Identity,
+	}
+}
+
+// ToActivityTaskRetryRequestedEventAttributes converts thrift ActivityTaskRetryRequestedEventAttributes type to internal
+func ToActivityTaskRetryRequestedEventAttributes(t *shared.ActivityTaskRetryRequestedEventAttributes) *types.ActivityTaskRetryRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventID: t.GetScheduledEventId(),
+		ResetAttempts:    t.GetResetAttempts(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
 // FromActivityTaskScheduledEventAttributes converts internal ActivityTaskScheduledEventAttributes type to thrift
 func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
 	if t == nil {
@@ -836,6 +892,96 @@ func ToCompleteWorkflowExecutionDecisionAttributes(t *shared.CompleteWorkflowExe
 	}
 }
 
+// FromCompletionCallback converts internal CompletionCallback type to thrift
+func FromCompletionCallback(t *types.CompletionCallback) *shared.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompletionCallback{
+		URL:     &t.URL,
+		Headers: t.Headers,
+	}
+}
+
+// ToCompletionCallback converts thrift CompletionCallback type to internal
+func ToCompletionCallback(t *shared.CompletionCallback) *types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallback{
+		URL:     t.GetURL(),
+		Headers: t.Headers,
+	}
+}
+
+// FromCompletionCallbackInfo converts internal CompletionCallbackInfo type to thrift
+func FromCompletionCallbackInfo(t *types.CompletionCallbackInfo) *shared.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompletionCallbackInfo{
+		URL:                  &t.URL,
+		State:                FromCompletionCallbackState(t.State),
+		Attempt:              &t.Attempt,
+		LastAttemptTimestamp: t.LastAttemptTimestamp,
+		NextAttemptTimestamp: t.NextAttemptTimestamp,
+		LastFailure:          &t.LastFailure,
+	}
+}
+
+// ToCompletionCallbackInfo converts thrift CompletionCallbackInfo type to internal
+func ToCompletionCallbackInfo(t *shared.CompletionCallbackInfo) *types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallbackInfo{
+		URL:                  t.GetURL(),
+		State:                ToCompletionCallbackState(t.State),
+		Attempt:              t.GetAttempt(),
+		LastAttemptTimestamp: t.LastAttemptTimestamp,
+		NextAttemptTimestamp: t.NextAttemptTimestamp,
+		LastFailure:          t.GetLastFailure(),
+	}
+}
+
+// FromCompletionCallbackState converts internal CompletionCallbackState type to thrift
+func FromCompletionCallbackState(t *types.CompletionCallbackState) *shared.CompletionCallbackState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.CompletionCallbackStatePending:
+		v := shared.CompletionCallbackStatePending
+		return &v
+	case types.CompletionCallbackStateSucceeded:
+		v := shared.CompletionCallbackStateSucceeded
+		return &v
+	case types.CompletionCallbackStateFailed:
+		v := shared.CompletionCallbackStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToCompletionCallbackState converts thrift CompletionCallbackState type to internal
+func ToCompletionCallbackState(t *shared.CompletionCallbackState) *types.CompletionCallbackState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.CompletionCallbackStatePending:
+		v := types.CompletionCallbackStatePending
+		return &v
+	case shared.CompletionCallbackStateSucceeded:
+		v := types.CompletionCallbackStateSucceeded
+		return &v
+	case shared.CompletionCallbackStateFailed:
+		v := types.CompletionCallbackStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromContinueAsNewInitiator converts internal ContinueAsNewInitiator type to thrift
 func FromContinueAsNewInitiator(t *types.ContinueAsNewInitiator) *shared.ContinueAsNewInitiator {
 	if t == nil {
@@ -928,8 +1074,9 @@ func FromCountWorkflowExecutionsRequest(t *types.CountWorkflowExecutionsRequest)
 		return nil
 	}
 	return &shared.CountWorkflowExecutionsRequest{
-		Domain: &t.Domain,
-		Query:  &t.Query,
+		Domain:  &t.Domain,
+		Query:   &t.Query,
+		GroupBy: t.GroupBy,
 	}
 }
 
@@ -939,8 +1086,9 @@ func ToCountWorkflowExecutionsRequest(t *shared.CountWorkflowExecutionsRequest)
 		return nil
 	}
 	return &types.CountWorkflowExecutionsRequest{
-		Domain: t.GetDomain(),
-		Query:  t.GetQuery(),
+		Domain:  t.GetDomain(),
+		Query:   t.GetQuery(),
+		GroupBy: t.GroupBy,
 	}
 }
 
@@ -950,7 +1098,9 @@ func FromCountWorkflowExecutionsResponse(t *types.CountWorkflowExecutionsRespons
 		return nil
 	}
 	return &shared.CountWorkflowExecutionsResponse{
-		Count: &t.Count,
+		Count:     &t.Count,
+		Groups:    FromCountWorkflowExecutionsGroupArray(t.Groups),
+		Truncated: &t.Truncated,
 	}
 }
 
@@ -960,8 +1110,56 @@ func ToCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse
 		return nil
 	}
 	return &types.CountWorkflowExecutionsResponse{
-		Count: t.GetCount(),
+		Count:     t.GetCount(),
+		Groups:    ToCountWorkflowExecutionsGroupArray(t.Groups),
+		Truncated: t.GetTruncated(),
+	}
+}
+
+// FromCountWorkflowExecutionsGroup converts internal CountWorkflowExecutionsGroup type to thrift
+func FromCountWorkflowExecutionsGroup(t *types.CountWorkflowExecutionsGroup) *shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       &t.Count,
+	}
+}
+
+// ToCountWorkflowExecutionsGroup converts thrift CountWorkflowExecutionsGroup type to internal
+func ToCountWorkflowExecutionsGroup(t *shared.CountWorkflowExecutionsGroup) *types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.CountWorkflowExecutionsGroup{
+		GroupValues: t.GroupValues,
+		Count:       t.GetCount(),
+	}
+}
+
+// FromCountWorkflowExecutionsGroupArray converts internal CountWorkflowExecutionsGroup type array to thrift
+func FromCountWorkflowExecutionsGroupArray(t []*types.CountWorkflowExecutionsGroup) []*shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = FromCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+// ToCountWorkflowExecutionsGroupArray converts thrift CountWorkflowExecutionsGroup type array to internal
+func ToCountWorkflowExecutionsGroupArray(t []*shared.CountWorkflowExecutionsGroup) []*types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = ToCountWorkflowExecutionsGroup(t[i])
 	}
+	return v
 }
 
 // FromCurrentBranchChangedError converts internal CurrentBranchChangedError type to thrift
@@ -1157,6 +1355,9 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCausePendingLimitExceeded:
+		v := shared.DecisionTaskFailedCausePendingLimitExceeded
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1236,6 +1437,9 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCausePendingLimitExceeded:
+		v := types.DecisionTaskFailedCausePendingLimitExceeded
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1775,6 +1979,8 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              FromWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    FromCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -1789,6 +1995,8 @@ func ToDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResp
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		PauseInfo:              ToWorkflowPauseInfo(t.PauseInfo),
+		CompletionCallbacks:    ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -2218,6 +2426,18 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case types.EventTypeActivityTaskOptionsUpdated:
+		v := shared.EventTypeActivityTaskOptionsUpdated
+		return &v
+	case types.EventTypeActivityTaskRetryRequested:
+		v := shared.EventTypeActivityTaskRetryRequested
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2354,6 +2574,18 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
+	case shared.EventTypeActivityTaskOptionsUpdated:
+		v := types.EventTypeActivityTaskOptionsUpdated
+		return &v
+	case shared.EventTypeActivityTaskRetryRequested:
+		v := types.EventTypeActivityTaskRetryRequested
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2649,6 +2881,10 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		ActivityTaskOptionsUpdatedEventAttributes:                      FromActivityTaskOptionsUpdatedEventAttributes(t.ActivityTaskOptionsUpdatedEventAttributes),
+		ActivityTaskRetryRequestedEventAttributes:                      FromActivityTaskRetryRequestedEventAttributes(t.ActivityTaskRetryRequestedEventAttributes),
 	}
 }
 
@@ -2705,6 +2941,10 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
+		ActivityTaskOptionsUpdatedEventAttributes:                      ToActivityTaskOptionsUpdatedEventAttributes(t.ActivityTaskOptionsUpdatedEventAttributes),
+		ActivityTaskRetryRequestedEventAttributes:                      ToActivityTaskRetryRequestedEventAttributes(t.ActivityTaskRetryRequestedEventAttributes),
 	}
 }
 
@@ -3060,6 +3300,62 @@ func ToListTaskListPartitionsRequest(t *shared.ListTaskListPartitionsRequest) *t
 	}
 }
 
+// FromMoveTaskListRequest converts internal MoveTaskListRequest type to thrift
+func FromMoveTaskListRequest(t *types.MoveTaskListRequest) *shared.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.MoveTaskListRequest{
+		Domain:         &t.Domain,
+		SourceTaskList: FromTaskList(t.SourceTaskList),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		BatchSize:      &t.BatchSize,
+		Rps:            &t.RPS,
+		DryRun:         &t.DryRun,
+	}
+}
+
+// ToMoveTaskListRequest converts thrift MoveTaskListRequest type to internal
+func ToMoveTaskListRequest(t *shared.MoveTaskListRequest) *types.MoveTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListRequest{
+		Domain:         t.GetDomain(),
+		SourceTaskList: ToTaskList(t.SourceTaskList),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		BatchSize:      t.GetBatchSize(),
+		RPS:            t.GetRps(),
+		DryRun:         t.GetDryRun(),
+	}
+}
+
+// FromMoveTaskListResponse converts internal MoveTaskListResponse type to thrift
+func FromMoveTaskListResponse(t *types.MoveTaskListResponse) *shared.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.MoveTaskListResponse{
+		TasksMoved:   &t.TasksMoved,
+		TasksExpired: &t.TasksExpired,
+		Failure:      &t.Failure,
+	}
+}
+
+// ToMoveTaskListResponse converts thrift MoveTaskListResponse type to internal
+func ToMoveTaskListResponse(t *shared.MoveTaskListResponse) *types.MoveTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListResponse{
+		TasksMoved:   t.GetTasksMoved(),
+		TasksExpired: t.GetTasksExpired(),
+		Failure:      t.GetFailure(),
+	}
+}
+
 // FromListTaskListPartitionsResponse converts internal ListTaskListPartitionsResponse type to thrift
 func FromListTaskListPartitionsResponse(t *types.ListTaskListPartitionsResponse) *shared.ListTaskListPartitionsResponse {
 	if t == nil {
@@ -3282,6 +3578,34 @@ func ToParentClosePolicy(t *shared.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		AllowActivities:   &t.AllowActivities,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		AllowActivities:   t.GetAllowActivities(),
+	}
+}
+
 // FromPendingActivityInfo converts internal PendingActivityInfo type to thrift
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivityInfo {
 	if t == nil {
@@ -3326,6 +3650,50 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 	}
 }
 
+// FromPendingActivityOperation converts internal PendingActivityOperation type to thrift
+func FromPendingActivityOperation(t *types.PendingActivityOperation) *shared.PendingActivityOperation {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.PendingActivityOperationUpdateOptions:
+		v := shared.PendingActivityOperationUpdateOptions
+		return &v
+	case types.PendingActivityOperationRetry:
+		v := shared.PendingActivityOperationRetry
+		return &v
+	case types.PendingActivityOperationFail:
+		v := shared.PendingActivityOperationFail
+		return &v
+	case types.PendingActivityOperationSkip:
+		v := shared.PendingActivityOperationSkip
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToPendingActivityOperation converts thrift PendingActivityOperation type to internal
+func ToPendingActivityOperation(t *shared.PendingActivityOperation) *types.PendingActivityOperation {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.PendingActivityOperationUpdateOptions:
+		v := types.PendingActivityOperationUpdateOptions
+		return &v
+	case shared.PendingActivityOperationRetry:
+		v := types.PendingActivityOperationRetry
+		return &v
+	case shared.PendingActivityOperationFail:
+		v := types.PendingActivityOperationFail
+		return &v
+	case shared.PendingActivityOperationSkip:
+		v := types.PendingActivityOperationSkip
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromPendingActivityState converts internal PendingActivityState type to thrift
 func FromPendingActivityState(t *types.PendingActivityState) *shared.PendingActivityState {
 	if t == nil {
@@ -3610,9 +3978,10 @@ func FromPollerInfo(t *types.PollerInfo) *shared.PollerInfo {
 		return nil
 	}
 	return &shared.PollerInfo{
-		LastAccessTime: t.LastAccessTime,
-		Identity:       &t.Identity,
-		RatePerSecond:  &t.RatePerSecond,
+		LastAccessTime:   t.LastAccessTime,
+		Identity:         &t.Identity,
+		RatePerSecond:    &t.RatePerSecond,
+		OutstandingTasks: &t.OutstandingTasks,
 	}
 }
 
@@ -3622,9 +3991,10 @@ func ToPollerInfo(t *shared.PollerInfo) *types.PollerInfo {
 		return nil
 	}
 	return &types.PollerInfo{
-		LastAccessTime: t.LastAccessTime,
-		Identity:       t.GetIdentity(),
-		RatePerSecond:  t.GetRatePerSecond(),
+		LastAccessTime:   t.LastAccessTime,
+		Identity:         t.GetIdentity(),
+		RatePerSecond:    t.GetRatePerSecond(),
+		OutstandingTasks: t.GetOutstandingTasks(),
 	}
 }
 
@@ -3968,6 +4338,52 @@ func ToRecordMarkerDecisionAttributes(t *shared.RecordMarkerDecisionAttributes)
 	}
 }
 
+// FromOperatePendingActivityRequest converts internal OperatePendingActivityRequest type to thrift
+func FromOperatePendingActivityRequest(t *types.OperatePendingActivityRequest) *shared.OperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.OperatePendingActivityRequest{
+		Domain:                        &t.Domain,
+		WorkflowExecution:             FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    &t.ActivityID,
+		Operation:                     FromPendingActivityOperation(t.Operation),
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		ResetAttempts:                 &t.ResetAttempts,
+		Reason:                        t.Reason,
+		Details:                       t.Details,
+		Result:                        t.Result,
+		Identity:                      &t.Identity,
+	}
+}
+
+// ToOperatePendingActivityRequest converts thrift OperatePendingActivityRequest type to internal
+func ToOperatePendingActivityRequest(t *shared.OperatePendingActivityRequest) *types.OperatePendingActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.OperatePendingActivityRequest{
+		Domain:                        t.GetDomain(),
+		WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.GetActivityID(),
+		Operation:                     ToPendingActivityOperation(t.Operation),
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		ResetAttempts:                 t.GetResetAttempts(),
+		Reason:                        t.Reason,
+		Details:                       t.Details,
+		Result:                        t.Result,
+		Identity:                      t.GetIdentity(),
+	}
+}
+
 // FromRefreshWorkflowTasksRequest converts internal RefreshWorkflowTasksRequest type to thrift
 func FromRefreshWorkflowTasksRequest(t *types.RefreshWorkflowTasksRequest) *shared.RefreshWorkflowTasksRequest {
 	if t == nil {
@@ -4970,6 +5386,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		CompletionCallbacks:                 FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -4997,6 +5414,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5228,6 +5646,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		CompletionCallbacks:                 FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5253,6 +5672,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5444,11 +5864,14 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint: &t.BacklogCountHint,
-		ReadLevel:        &t.ReadLevel,
-		AckLevel:         &t.AckLevel,
-		RatePerSecond:    &t.RatePerSecond,
-		TaskIDBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:      &t.BacklogCountHint,
+		ReadLevel:             &t.ReadLevel,
+		AckLevel:              &t.AckLevel,
+		RatePerSecond:         &t.RatePerSecond,
+		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
+		BacklogAgeInSeconds:   &t.BacklogAgeInSeconds,
+		DispatchRatePerSecond: &t.DispatchRatePerSecond,
+		SyncMatchRatio:        &t.SyncMatchRatio,
 	}
 }
 
@@ -5458,11 +5881,14 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.GetBacklogCountHint(),
-		ReadLevel:        t.GetReadLevel(),
-		AckLevel:         t.GetAckLevel(),
-		RatePerSecond:    t.GetRatePerSecond(),
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:      t.GetBacklogCountHint(),
+		ReadLevel:             t.GetReadLevel(),
+		AckLevel:              t.GetAckLevel(),
+		RatePerSecond:         t.GetRatePerSecond(),
+		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
+		BacklogAgeInSeconds:   t.GetBacklogAgeInSeconds(),
+		DispatchRatePerSecond: t.GetDispatchRatePerSecond(),
+		SyncMatchRatio:        t.GetSyncMatchRatio(),
 	}
 }
 
@@ -5664,6 +6090,32 @@ func ToTransientDecisionInfo(t *shared.TransientDecisionInfo) *types.TransientDe
 	}
 }
 
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromUpdateDomainRequest converts internal UpdateDomainRequest type to thrift
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainRequest {
 	if t == nil {
@@ -6234,6 +6686,30 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 	}
 }
 
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:          &t.Reason,
+		Identity:        &t.Identity,
+		AllowActivities: &t.AllowActivities,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:          t.GetReason(),
+		Identity:        t.GetIdentity(),
+		AllowActivities: t.GetAllowActivities(),
+	}
+}
+
 // FromWorkflowExecutionSignaledEventAttributes converts internal WorkflowExecutionSignaledEventAttributes type to thrift
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
@@ -6289,6 +6765,7 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		CompletionCallbacks:                 FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -6323,6 +6800,7 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -6414,6 +6892,54 @@ func ToWorkflowIDReusePolicy(t *shared.WorkflowIdReusePolicy) *types.WorkflowIDR
 	panic("unexpected enum value")
 }
 
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   &t.Reason,
+		Identity: &t.Identity,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   t.GetReason(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromWorkflowPauseInfo converts internal WorkflowPauseInfo type to thrift
+func FromWorkflowPauseInfo(t *types.WorkflowPauseInfo) *shared.WorkflowPauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowPauseInfo{
+		Reason:          &t.Reason,
+		Identity:        &t.Identity,
+		PausedTimestamp: t.PausedTimestamp,
+		AllowActivities: &t.AllowActivities,
+	}
+}
+
+// ToWorkflowPauseInfo converts thrift WorkflowPauseInfo type to internal
+func ToWorkflowPauseInfo(t *shared.WorkflowPauseInfo) *types.WorkflowPauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowPauseInfo{
+		Reason:          t.GetReason(),
+		Identity:        t.GetIdentity(),
+		PausedTimestamp: t.PausedTimestamp,
+		AllowActivities: t.GetAllowActivities(),
+	}
+}
+
 // FromWorkflowQuery converts internal WorkflowQuery type to thrift
 func FromWorkflowQuery(t *types.WorkflowQuery) *shared.WorkflowQuery {
 	if t == nil {
@@ -6500,6 +7026,54 @@ func ToWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *types.WorkflowTypeFilte
 	}
 }
 
+// FromCompletionCallbackArray converts internal CompletionCallback type array to thrift
+func FromCompletionCallbackArray(t []*types.CompletionCallback) []*shared.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallback(t[i])
+	}
+	return v
+}
+
+// ToCompletionCallbackArray converts thrift CompletionCallback type array to internal
+func ToCompletionCallbackArray(t []*shared.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallback(t[i])
+	}
+	return v
+}
+
+// FromCompletionCallbackInfoArray converts internal CompletionCallbackInfo type array to thrift
+func FromCompletionCallbackInfoArray(t []*types.CompletionCallbackInfo) []*shared.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+// ToCompletionCallbackInfoArray converts thrift CompletionCallbackInfo type array to internal
+func ToCompletionCallbackInfoArray(t []*shared.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
 // FromPendingActivityInfoArray converts internal PendingActivityInfo type array to thrift
 func FromPendingActivityInfoArray(t []*types.PendingActivityInfo) []*shared.PendingActivityInfo {
 	if t == nil {
@@ -30,6 +30,18 @@ import (
 	"github.com/uber/cadence/common/types/testdata"
 )
 
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistoryPauseWorkflowExecutionRequest(thrift.FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistoryUnpauseWorkflowExecutionRequest(thrift.FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, thrift.ToHistoryTerminateWorkflowExecutionRequest(thrift.FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/common/types/testdata"
@@ -201,3 +202,37 @@ func TestCrossClusterApplyParentClosePolicyResponse(t *testing.T) {
 		),
 	)
 }
+
+func TestTaskListStatus(t *testing.T) {
+	for _, item := range []*types.TaskListStatus{nil, {}, &testdata.TaskListStatus} {
+		assert.Equal(t, item, thrift.ToTaskListStatus(thrift.FromTaskListStatus(item)))
+	}
+
+	wireValue, err := thrift.FromTaskListStatus(&testdata.TaskListStatus).ToWire()
+	assert.NoError(t, err)
+	var decoded shared.TaskListStatus
+	assert.NoError(t, decoded.FromWire(wireValue))
+	assert.Equal(t, &testdata.TaskListStatus, thrift.ToTaskListStatus(&decoded))
+}
+
+func TestCountWorkflowExecutions(t *testing.T) {
+	for _, item := range []*types.CountWorkflowExecutionsRequest{nil, {}, &testdata.CountWorkflowExecutionsRequest} {
+		assert.Equal(t, item, thrift.ToCountWorkflowExecutionsRequest(thrift.FromCountWorkflowExecutionsRequest(item)))
+	}
+	for _, item := range []*types.CountWorkflowExecutionsResponse{nil, {}, &testdata.CountWorkflowExecutionsResponse} {
+		assert.Equal(t, item, thrift.ToCountWorkflowExecutionsResponse(thrift.FromCountWorkflowExecutionsResponse(item)))
+	}
+
+	wireValue, err := thrift.FromCountWorkflowExecutionsResponse(&testdata.CountWorkflowExecutionsResponse).ToWire()
+	assert.NoError(t, err)
+	var decoded shared.CountWorkflowExecutionsResponse
+	assert.NoError(t, decoded.FromWire(wireValue))
+	assert.Equal(t, &testdata.CountWorkflowExecutionsResponse, thrift.ToCountWorkflowExecutionsResponse(&decoded))
+}
+
+func TestDecisionTaskFailedCause_PendingLimitExceeded(t *testing.T) {
+	cause := thrift.FromDecisionTaskFailedCause(types.DecisionTaskFailedCausePendingLimitExceeded.Ptr())
+	assert.Equal(t, shared.DecisionTaskFailedCausePendingLimitExceeded, *cause)
+	assert.Equal(t, "PENDING_LIMIT_EXCEEDED", cause.String())
+	assert.Equal(t, types.DecisionTaskFailedCausePendingLimitExceeded.Ptr(), thrift.ToDecisionTaskFailedCause(cause))
+}
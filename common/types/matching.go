@@ -209,6 +209,46 @@ func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
 	return
 }
 
+// ReleaseOutstandingTaskRequest is an internal type (TBD...)
+type ReleaseOutstandingTaskRequest struct {
+	DomainUUID        string             `json:"domainUUID,omitempty"`
+	TaskList          *TaskList          `json:"taskList,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ScheduleID        int64              `json:"scheduleId,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *ReleaseOutstandingTaskRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ReleaseOutstandingTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ReleaseOutstandingTaskRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *ReleaseOutstandingTaskRequest) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
 // MatchingDescribeTaskListRequest is an internal type (TBD...)
 type MatchingDescribeTaskListRequest struct {
 	DomainUUID  string                   `json:"domainUUID,omitempty"`
@@ -253,6 +293,28 @@ func (v *MatchingListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 	return
 }
 
+// MatchingMoveTaskListRequest is an internal type (TBD...)
+type MatchingMoveTaskListRequest struct {
+	DomainUUID  string               `json:"domainUUID,omitempty"`
+	MoveRequest *MoveTaskListRequest `json:"moveRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetMoveRequest is an internal getter (TBD...)
+func (v *MatchingMoveTaskListRequest) GetMoveRequest() (o *MoveTaskListRequest) {
+	if v != nil && v.MoveRequest != nil {
+		return v.MoveRequest
+	}
+	return
+}
+
 // MatchingGetTaskListsByDomainRequest is an internal type (TBD...)
 type MatchingGetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -591,10 +591,11 @@ func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
 
 // ReadDLQMessagesResponse is an internal type (TBD...)
 type ReadDLQMessagesResponse struct {
-	Type                 *DLQType               `json:"type,omitempty"`
-	ReplicationTasks     []*ReplicationTask     `json:"replicationTasks,omitempty"`
-	ReplicationTasksInfo []*ReplicationTaskInfo `json:"replicationTasksInfo,omitempty"`
-	NextPageToken        []byte                 `json:"nextPageToken,omitempty"`
+	Type                 *DLQType                   `json:"type,omitempty"`
+	ReplicationTasks     []*ReplicationTask         `json:"replicationTasks,omitempty"`
+	ReplicationTasksInfo []*ReplicationTaskInfo     `json:"replicationTasksInfo,omitempty"`
+	NextPageToken        []byte                     `json:"nextPageToken,omitempty"`
+	RetryStates          []*ReplicationDLQTaskState `json:"retryStates,omitempty"`
 }
 
 // GetType is an internal getter (TBD...)
@@ -629,6 +630,14 @@ func (v *ReadDLQMessagesResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
+// GetRetryStates is an internal getter (TBD...)
+func (v *ReadDLQMessagesResponse) GetRetryStates() (o []*ReplicationDLQTaskState) {
+	if v != nil && v.RetryStates != nil {
+		return v.RetryStates
+	}
+	return
+}
+
 // ReplicationMessages is an internal type (TBD...)
 type ReplicationMessages struct {
 	ReplicationTasks       []*ReplicationTask `json:"replicationTasks,omitempty"`
@@ -671,14 +680,16 @@ func (v *ReplicationMessages) GetSyncShardStatus() (o *SyncShardStatus) {
 
 // ReplicationTask is an internal type (TBD...)
 type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	SourceTaskID                  int64                          `json:"sourceTaskId,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActivityTaskAttributes    *SyncActivityTaskAttributes    `json:"syncActivityTaskAttributes,omitempty"`
-	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
-	FailoverMarkerAttributes      *FailoverMarkerAttributes      `json:"failoverMarkerAttributes,omitempty"`
-	CreationTime                  *int64                         `json:"creationTime,omitempty"`
+	TaskType                              *ReplicationTaskType                   `json:"taskType,omitempty"`
+	SourceTaskID                          int64                                  `json:"sourceTaskId,omitempty"`
+	DomainTaskAttributes                  *DomainTaskAttributes                  `json:"domainTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes         *SyncShardStatusTaskAttributes         `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActivityTaskAttributes            *SyncActivityTaskAttributes            `json:"syncActivityTaskAttributes,omitempty"`
+	HistoryTaskV2Attributes               *HistoryTaskV2Attributes               `json:"historyTaskV2Attributes,omitempty"`
+	FailoverMarkerAttributes              *FailoverMarkerAttributes              `json:"failoverMarkerAttributes,omitempty"`
+	CreationTime                          *int64                                 `json:"creationTime,omitempty"`
+	FrameAttributes                       *ReplicationTaskFrameAttributes        `json:"frameAttributes,omitempty"`
+	SyncCompletionCallbacksTaskAttributes *SyncCompletionCallbacksTaskAttributes `json:"syncCompletionCallbacksTaskAttributes,omitempty"`
 }
 
 // GetTaskType is an internal getter (TBD...)
@@ -745,6 +756,36 @@ func (v *ReplicationTask) GetCreationTime() (o int64) {
 	return
 }
 
+// GetFrameAttributes is an internal getter (TBD...)
+func (v *ReplicationTask) GetFrameAttributes() (o *ReplicationTaskFrameAttributes) {
+	if v != nil && v.FrameAttributes != nil {
+		return v.FrameAttributes
+	}
+	return
+}
+
+// GetSyncCompletionCallbacksTaskAttributes is an internal getter (TBD...)
+func (v *ReplicationTask) GetSyncCompletionCallbacksTaskAttributes() (o *SyncCompletionCallbacksTaskAttributes) {
+	if v != nil && v.SyncCompletionCallbacksTaskAttributes != nil {
+		return v.SyncCompletionCallbacksTaskAttributes
+	}
+	return
+}
+
+// ReplicationTaskFrameAttributes carries a batch of replication tasks compressed together
+type ReplicationTaskFrameAttributes struct {
+	// Data is the zstd compressed concatenation of the length prefixed thriftrw encoded replication tasks
+	Data []byte `json:"data,omitempty"`
+}
+
+// GetData is an internal getter (TBD...)
+func (v *ReplicationTaskFrameAttributes) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+	return
+}
+
 // ReplicationTaskInfo is an internal type (TBD...)
 type ReplicationTaskInfo struct {
 	DomainID     string `json:"domainID,omitempty"`
@@ -856,6 +897,10 @@ func (e ReplicationTaskType) String() string {
 		return "HistoryV2"
 	case 6:
 		return "FailoverMarker"
+	case 7:
+		return "Frame"
+	case 8:
+		return "SyncCompletionCallbacks"
 	}
 	return fmt.Sprintf("ReplicationTaskType(%d)", w)
 }
@@ -884,6 +929,12 @@ func (e *ReplicationTaskType) UnmarshalText(value []byte) error {
 	case "FAILOVERMARKER":
 		*e = ReplicationTaskTypeFailoverMarker
 		return nil
+	case "FRAME":
+		*e = ReplicationTaskTypeFrame
+		return nil
+	case "SYNCCOMPLETIONCALLBACKS":
+		*e = ReplicationTaskTypeSyncCompletionCallbacks
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -914,6 +965,10 @@ const (
 	ReplicationTaskTypeHistoryV2
 	// ReplicationTaskTypeFailoverMarker is an option for ReplicationTaskType
 	ReplicationTaskTypeFailoverMarker
+	// ReplicationTaskTypeFrame is an option for ReplicationTaskType
+	ReplicationTaskTypeFrame
+	// ReplicationTaskTypeSyncCompletionCallbacks is an option for ReplicationTaskType
+	ReplicationTaskTypeSyncCompletionCallbacks
 )
 
 // ReplicationToken is an internal type (TBD...)
@@ -947,6 +1002,55 @@ func (v *ReplicationToken) GetLastProcessedMessageID() (o int64) {
 	return
 }
 
+// SyncCompletionCallbacksTaskAttributes carries the delivery state of the completion callbacks of a closed workflow
+type SyncCompletionCallbacksTaskAttributes struct {
+	DomainID            string                    `json:"domainId,omitempty"`
+	WorkflowID          string                    `json:"workflowId,omitempty"`
+	RunID               string                    `json:"runId,omitempty"`
+	Version             int64                     `json:"version,omitempty"`
+	CompletionCallbacks []*CompletionCallbackInfo `json:"completionCallbacks,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *SyncCompletionCallbacksTaskAttributes) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *SyncCompletionCallbacksTaskAttributes) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *SyncCompletionCallbacksTaskAttributes) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *SyncCompletionCallbacksTaskAttributes) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *SyncCompletionCallbacksTaskAttributes) GetCompletionCallbacks() (o []*CompletionCallbackInfo) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // SyncActivityTaskAttributes is an internal type (TBD...)
 type SyncActivityTaskAttributes struct {
 	DomainID           string          `json:"domainId,omitempty"`
@@ -1129,3 +1233,305 @@ func (v *SyncShardStatusTaskAttributes) GetTimestamp() (o int64) {
 	}
 	return
 }
+
+// ReplicationDomainStatus is an internal type (TBD...)
+type ReplicationDomainStatus struct {
+	DomainID            string `json:"domainID,omitempty"`
+	Domain              string `json:"domain,omitempty"`
+	Backlog             int64  `json:"backlog,omitempty"`
+	OldestTaskTimestamp *int64 `json:"oldestTaskTimestamp,omitempty"`
+	DLQSize             int64  `json:"dlqSize,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetBacklog is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetBacklog() (o int64) {
+	if v != nil {
+		return v.Backlog
+	}
+	return
+}
+
+// GetOldestTaskTimestamp is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetOldestTaskTimestamp() (o int64) {
+	if v != nil && v.OldestTaskTimestamp != nil {
+		return *v.OldestTaskTimestamp
+	}
+	return
+}
+
+// GetDLQSize is an internal getter (TBD...)
+func (v *ReplicationDomainStatus) GetDLQSize() (o int64) {
+	if v != nil {
+		return v.DLQSize
+	}
+	return
+}
+
+// ReplicationShardStatus is an internal type (TBD...)
+type ReplicationShardStatus struct {
+	ShardID                 int32                      `json:"shardID,omitempty"`
+	AckLevel                int64                      `json:"ackLevel,omitempty"`
+	LastTaskID              int64                      `json:"lastTaskID,omitempty"`
+	ReplicatedUpToTimestamp *int64                     `json:"replicatedUpToTimestamp,omitempty"`
+	Domains                 []*ReplicationDomainStatus `json:"domains,omitempty"`
+	Truncated               bool                       `json:"truncated,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetAckLevel is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetAckLevel() (o int64) {
+	if v != nil {
+		return v.AckLevel
+	}
+	return
+}
+
+// GetLastTaskID is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetLastTaskID() (o int64) {
+	if v != nil {
+		return v.LastTaskID
+	}
+	return
+}
+
+// GetReplicatedUpToTimestamp is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetReplicatedUpToTimestamp() (o int64) {
+	if v != nil && v.ReplicatedUpToTimestamp != nil {
+		return *v.ReplicatedUpToTimestamp
+	}
+	return
+}
+
+// GetDomains is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetDomains() (o []*ReplicationDomainStatus) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
+	}
+	return
+}
+
+// GetTruncated is an internal getter (TBD...)
+func (v *ReplicationShardStatus) GetTruncated() (o bool) {
+	if v != nil {
+		return v.Truncated
+	}
+	return
+}
+
+// GetReplicationStatusResponse is an internal type (TBD...)
+type GetReplicationStatusResponse struct {
+	StatusByShard      map[int32]*ReplicationShardStatus `json:"statusByShard,omitempty"`
+	FailedCauseByShard map[int32]GetTaskFailedCause      `json:"failedCauseByShard,omitempty"`
+}
+
+// GetStatusByShard is an internal getter (TBD...)
+func (v *GetReplicationStatusResponse) GetStatusByShard() (o map[int32]*ReplicationShardStatus) {
+	if v != nil && v.StatusByShard != nil {
+		return v.StatusByShard
+	}
+	return
+}
+
+// GetFailedCauseByShard is an internal getter (TBD...)
+func (v *GetReplicationStatusResponse) GetFailedCauseByShard() (o map[int32]GetTaskFailedCause) {
+	if v != nil && v.FailedCauseByShard != nil {
+		return v.FailedCauseByShard
+	}
+	return
+}
+
+// ReplicationTaskFailure is an internal type (TBD...)
+type ReplicationTaskFailure struct {
+	Count         int64  `json:"count,omitempty"`
+	LastTimestamp int64  `json:"lastTimestamp,omitempty"`
+	LastError     string `json:"lastError,omitempty"`
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *ReplicationTaskFailure) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
+// GetLastTimestamp is an internal getter (TBD...)
+func (v *ReplicationTaskFailure) GetLastTimestamp() (o int64) {
+	if v != nil {
+		return v.LastTimestamp
+	}
+	return
+}
+
+// GetLastError is an internal getter (TBD...)
+func (v *ReplicationTaskFailure) GetLastError() (o string) {
+	if v != nil {
+		return v.LastError
+	}
+	return
+}
+
+// ReplicationDLQTaskState is an internal type (TBD...)
+type ReplicationDLQTaskState struct {
+	TaskID               int64  `json:"taskID,omitempty"`
+	ErrorClass           string `json:"errorClass,omitempty"`
+	Attempts             int32  `json:"attempts,omitempty"`
+	LastError            string `json:"lastError,omitempty"`
+	LastAttemptTimestamp int64  `json:"lastAttemptTimestamp,omitempty"`
+	NextAttemptTimestamp int64  `json:"nextAttemptTimestamp,omitempty"`
+	Poison               bool   `json:"poison,omitempty"`
+}
+
+// GetTaskID is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+// GetErrorClass is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetErrorClass() (o string) {
+	if v != nil {
+		return v.ErrorClass
+	}
+	return
+}
+
+// GetAttempts is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetAttempts() (o int32) {
+	if v != nil {
+		return v.Attempts
+	}
+	return
+}
+
+// GetLastError is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetLastError() (o string) {
+	if v != nil {
+		return v.LastError
+	}
+	return
+}
+
+// GetLastAttemptTimestamp is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetLastAttemptTimestamp() (o int64) {
+	if v != nil {
+		return v.LastAttemptTimestamp
+	}
+	return
+}
+
+// GetNextAttemptTimestamp is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetNextAttemptTimestamp() (o int64) {
+	if v != nil {
+		return v.NextAttemptTimestamp
+	}
+	return
+}
+
+// GetPoison is an internal getter (TBD...)
+func (v *ReplicationDLQTaskState) GetPoison() (o bool) {
+	if v != nil {
+		return v.Poison
+	}
+	return
+}
+
+// ReplicationProcessorState is an internal type (TBD...)
+type ReplicationProcessorState struct {
+	ReplicatedUpToTimestamp *int64                             `json:"replicatedUpToTimestamp,omitempty"`
+	FailuresByDomain        map[string]*ReplicationTaskFailure `json:"failuresByDomain,omitempty"`
+}
+
+// GetReplicatedUpToTimestamp is an internal getter (TBD...)
+func (v *ReplicationProcessorState) GetReplicatedUpToTimestamp() (o int64) {
+	if v != nil && v.ReplicatedUpToTimestamp != nil {
+		return *v.ReplicatedUpToTimestamp
+	}
+	return
+}
+
+// GetFailuresByDomain is an internal getter (TBD...)
+func (v *ReplicationProcessorState) GetFailuresByDomain() (o map[string]*ReplicationTaskFailure) {
+	if v != nil && v.FailuresByDomain != nil {
+		return v.FailuresByDomain
+	}
+	return
+}
+
+// ReplicationShardReadiness is an internal type (TBD...)
+type ReplicationShardReadiness struct {
+	ShardID         int32                      `json:"shardID,omitempty"`
+	ProcessorState  *ReplicationProcessorState `json:"processorState,omitempty"`
+	DLQSizeByDomain map[string]int64           `json:"dlqSizeByDomain,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ReplicationShardReadiness) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetProcessorState is an internal getter (TBD...)
+func (v *ReplicationShardReadiness) GetProcessorState() (o *ReplicationProcessorState) {
+	if v != nil && v.ProcessorState != nil {
+		return v.ProcessorState
+	}
+	return
+}
+
+// GetDLQSizeByDomain is an internal getter (TBD...)
+func (v *ReplicationShardReadiness) GetDLQSizeByDomain() (o map[string]int64) {
+	if v != nil && v.DLQSizeByDomain != nil {
+		return v.DLQSizeByDomain
+	}
+	return
+}
+
+// GetReplicationReadinessResponse is an internal type (TBD...)
+type GetReplicationReadinessResponse struct {
+	ReadinessByShard   map[int32]*ReplicationShardReadiness `json:"readinessByShard,omitempty"`
+	FailedCauseByShard map[int32]GetTaskFailedCause         `json:"failedCauseByShard,omitempty"`
+}
+
+// GetReadinessByShard is an internal getter (TBD...)
+func (v *GetReplicationReadinessResponse) GetReadinessByShard() (o map[int32]*ReplicationShardReadiness) {
+	if v != nil && v.ReadinessByShard != nil {
+		return v.ReadinessByShard
+	}
+	return
+}
+
+// GetFailedCauseByShard is an internal getter (TBD...)
+func (v *GetReplicationReadinessResponse) GetFailedCauseByShard() (o map[int32]GetTaskFailedCause) {
+	if v != nil && v.FailedCauseByShard != nil {
+		return v.FailedCauseByShard
+	}
+	return
+}
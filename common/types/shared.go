@@ -248,6 +248,104 @@ func (v *ActivityTaskFailedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// ActivityTaskOptionsUpdatedEventAttributes is an internal type (TBD...)
+type ActivityTaskOptionsUpdatedEventAttributes struct {
+	ScheduledEventID              int64        `json:"scheduledEventId,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32       `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32       `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32       `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32       `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy `json:"retryPolicy,omitempty"`
+	Identity                      string       `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+	return
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskOptionsUpdatedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ActivityTaskRetryRequestedEventAttributes is an internal type (TBD...)
+type ActivityTaskRetryRequestedEventAttributes struct {
+	ScheduledEventID int64  `json:"scheduledEventId,omitempty"`
+	ResetAttempts    bool   `json:"resetAttempts,omitempty"`
+	Identity         string `json:"identity,omitempty"`
+}
+
+// GetScheduledEventID is an internal getter (TBD...)
+func (v *ActivityTaskRetryRequestedEventAttributes) GetScheduledEventID() (o int64) {
+	if v != nil {
+		return v.ScheduledEventID
+	}
+	return
+}
+
+// GetResetAttempts is an internal getter (TBD...)
+func (v *ActivityTaskRetryRequestedEventAttributes) GetResetAttempts() (o bool) {
+	if v != nil {
+		return v.ResetAttempts
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ActivityTaskRetryRequestedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // ActivityTaskScheduledEventAttributes is an internal type (TBD...)
 type ActivityTaskScheduledEventAttributes struct {
 	ActivityID                    string        `json:"activityId,omitempty"`
@@ -1199,6 +1297,144 @@ func (v *CompleteWorkflowExecutionDecisionAttributes) GetResult() (o []byte) {
 	return
 }
 
+// CompletionCallback is an internal type (TBD...)
+type CompletionCallback struct {
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallback) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetHeaders is an internal getter (TBD...)
+func (v *CompletionCallback) GetHeaders() (o map[string]string) {
+	if v != nil && v.Headers != nil {
+		return v.Headers
+	}
+	return
+}
+
+// CompletionCallbackInfo is an internal type (TBD...)
+type CompletionCallbackInfo struct {
+	URL                  string                   `json:"url,omitempty"`
+	State                *CompletionCallbackState `json:"state,omitempty"`
+	Attempt              int32                    `json:"attempt,omitempty"`
+	LastAttemptTimestamp *int64                   `json:"lastAttemptTimestamp,omitempty"`
+	NextAttemptTimestamp *int64                   `json:"nextAttemptTimestamp,omitempty"`
+	LastFailure          string                   `json:"lastFailure,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetState() (o CompletionCallbackState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetLastAttemptTimestamp is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastAttemptTimestamp() (o int64) {
+	if v != nil && v.LastAttemptTimestamp != nil {
+		return *v.LastAttemptTimestamp
+	}
+	return
+}
+
+// GetNextAttemptTimestamp is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetNextAttemptTimestamp() (o int64) {
+	if v != nil && v.NextAttemptTimestamp != nil {
+		return *v.NextAttemptTimestamp
+	}
+	return
+}
+
+// GetLastFailure is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastFailure() (o string) {
+	if v != nil {
+		return v.LastFailure
+	}
+	return
+}
+
+// CompletionCallbackState is an internal type (TBD...)
+type CompletionCallbackState int32
+
+// Ptr is a helper function for getting pointer value
+func (e CompletionCallbackState) Ptr() *CompletionCallbackState {
+	return &e
+}
+
+// String returns a readable string representation of CompletionCallbackState.
+func (e CompletionCallbackState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "PENDING"
+	case 1:
+		return "SUCCEEDED"
+	case 2:
+		return "FAILED"
+	}
+	return fmt.Sprintf("CompletionCallbackState(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *CompletionCallbackState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "PENDING":
+		*e = CompletionCallbackStatePending
+		return nil
+	case "SUCCEEDED":
+		*e = CompletionCallbackStateSucceeded
+		return nil
+	case "FAILED":
+		*e = CompletionCallbackStateFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CompletionCallbackState", err)
+		}
+		*e = CompletionCallbackState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes CompletionCallbackState to text.
+func (e CompletionCallbackState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// CompletionCallbackStatePending is an option for CompletionCallbackState
+	CompletionCallbackStatePending CompletionCallbackState = iota
+	// CompletionCallbackStateSucceeded is an option for CompletionCallbackState
+	CompletionCallbackStateSucceeded
+	// CompletionCallbackStateFailed is an option for CompletionCallbackState
+	CompletionCallbackStateFailed
+)
+
 // ContinueAsNewInitiator is an internal type (TBD...)
 type ContinueAsNewInitiator int32
 
@@ -1398,8 +1634,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetSearchAttributes()
 
 // CountWorkflowExecutionsRequest is an internal type (TBD...)
 type CountWorkflowExecutionsRequest struct {
-	Domain string `json:"domain,omitempty"`
-	Query  string `json:"query,omitempty"`
+	Domain  string   `json:"domain,omitempty"`
+	Query   string   `json:"query,omitempty"`
+	GroupBy []string `json:"groupBy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -1418,9 +1655,20 @@ func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
 	return
 }
 
+// GetGroupBy is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsRequest) GetGroupBy() (o []string) {
+	if v != nil && v.GroupBy != nil {
+		return v.GroupBy
+	}
+	return
+}
+
 // CountWorkflowExecutionsResponse is an internal type (TBD...)
 type CountWorkflowExecutionsResponse struct {
-	Count int64 `json:"count,omitempty"`
+	Count  int64                           `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
+	// Truncated is true when there are more groups than returned, only the largest groups are returned
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // GetCount is an internal getter (TBD...)
@@ -1431,6 +1679,46 @@ func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
 	return
 }
 
+// GetGroups is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+	return
+}
+
+// GetTruncated is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsResponse) GetTruncated() (o bool) {
+	if v != nil {
+		return v.Truncated
+	}
+	return
+}
+
+// CountWorkflowExecutionsGroup is an internal type (TBD...)
+type CountWorkflowExecutionsGroup struct {
+	// GroupValues are the JSON encoded values of the group by search attributes, in the order of the request.
+	// A null value stands for workflows without the search attribute.
+	GroupValues []string `json:"groupValues,omitempty"`
+	Count       int64    `json:"count,omitempty"`
+}
+
+// GetGroupValues is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetGroupValues() (o []string) {
+	if v != nil && v.GroupValues != nil {
+		return v.GroupValues
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
 // CurrentBranchChangedError is an internal type (TBD...)
 type CurrentBranchChangedError struct {
 	Message            string `json:"message,required"`
@@ -1712,6 +2000,8 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "PENDING_LIMIT_EXCEEDED"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1788,6 +2078,9 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "PENDING_LIMIT_EXCEEDED":
+		*e = DecisionTaskFailedCausePendingLimitExceeded
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1850,6 +2143,10 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCausePendingLimitExceeded is an option for DecisionTaskFailedCause,
+	// used when a decision would exceed the limit of pending activities, child executions,
+	// timers or external requests of a workflow execution
+	DecisionTaskFailedCausePendingLimitExceeded
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -2608,6 +2905,8 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	PauseInfo              *WorkflowPauseInfo              `json:"pauseInfo,omitempty"`
+	CompletionCallbacks    []*CompletionCallbackInfo       `json:"completionCallbacks,omitempty"`
 }
 
 // GetExecutionConfiguration is an internal getter (TBD...)
@@ -2650,6 +2949,22 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingDecision() (o *PendingDeci
 	return
 }
 
+// GetPauseInfo is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetPauseInfo() (o *WorkflowPauseInfo) {
+	if v != nil && v.PauseInfo != nil {
+		return v.PauseInfo
+	}
+	return
+}
+
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() (o []*CompletionCallbackInfo) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -3121,6 +3436,14 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionPaused"
+	case 43:
+		return "WorkflowExecutionUnpaused"
+	case 44:
+		return "ActivityTaskOptionsUpdated"
+	case 45:
+		return "ActivityTaskRetryRequested"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3254,6 +3577,18 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
+	case "ACTIVITYTASKOPTIONSUPDATED":
+		*e = EventTypeActivityTaskOptionsUpdated
+		return nil
+	case "ACTIVITYTASKRETRYREQUESTED":
+		*e = EventTypeActivityTaskRetryRequested
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3354,6 +3689,14 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
+	// EventTypeActivityTaskOptionsUpdated is an option for EventType
+	EventTypeActivityTaskOptionsUpdated
+	// EventTypeActivityTaskRetryRequested is an option for EventType
+	EventTypeActivityTaskRetryRequested
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -3755,6 +4098,10 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
+	ActivityTaskOptionsUpdatedEventAttributes                      *ActivityTaskOptionsUpdatedEventAttributes                      `json:"activityTaskOptionsUpdatedEventAttributes,omitempty"`
+	ActivityTaskRetryRequestedEventAttributes                      *ActivityTaskRetryRequestedEventAttributes                      `json:"activityTaskRetryRequestedEventAttributes,omitempty"`
 }
 
 // GetEventID is an internal getter (TBD...)
@@ -4133,6 +4480,38 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskOptionsUpdatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskOptionsUpdatedEventAttributes() (o *ActivityTaskOptionsUpdatedEventAttributes) {
+	if v != nil && v.ActivityTaskOptionsUpdatedEventAttributes != nil {
+		return v.ActivityTaskOptionsUpdatedEventAttributes
+	}
+	return
+}
+
+// GetActivityTaskRetryRequestedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetActivityTaskRetryRequestedEventAttributes() (o *ActivityTaskRetryRequestedEventAttributes) {
+	if v != nil && v.ActivityTaskRetryRequestedEventAttributes != nil {
+		return v.ActivityTaskRetryRequestedEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -4599,6 +4978,104 @@ func (v *ListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 	return
 }
 
+// MoveTaskListRequest is an internal type (TBD...)
+type MoveTaskListRequest struct {
+	Domain         string        `json:"domain,omitempty"`
+	SourceTaskList *TaskList     `json:"sourceTaskList,omitempty"`
+	TargetTaskList *TaskList     `json:"targetTaskList,omitempty"`
+	TaskListType   *TaskListType `json:"taskListType,omitempty"`
+	BatchSize      int32         `json:"batchSize,omitempty"`
+	RPS            int32         `json:"rps,omitempty"`
+	DryRun         bool          `json:"dryRun,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetSourceTaskList is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetSourceTaskList() (o *TaskList) {
+	if v != nil && v.SourceTaskList != nil {
+		return v.SourceTaskList
+	}
+	return
+}
+
+// GetTargetTaskList is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetTargetTaskList() (o *TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetBatchSize is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetBatchSize() (o int32) {
+	if v != nil {
+		return v.BatchSize
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+// GetDryRun is an internal getter (TBD...)
+func (v *MoveTaskListRequest) GetDryRun() (o bool) {
+	if v != nil {
+		return v.DryRun
+	}
+	return
+}
+
+// MoveTaskListResponse is an internal type (TBD...)
+type MoveTaskListResponse struct {
+	TasksMoved   int64  `json:"tasksMoved,omitempty"`
+	TasksExpired int64  `json:"tasksExpired,omitempty"`
+	Failure      string `json:"failure,omitempty"`
+}
+
+// GetTasksMoved is an internal getter (TBD...)
+func (v *MoveTaskListResponse) GetTasksMoved() (o int64) {
+	if v != nil {
+		return v.TasksMoved
+	}
+	return
+}
+
+// GetTasksExpired is an internal getter (TBD...)
+func (v *MoveTaskListResponse) GetTasksExpired() (o int64) {
+	if v != nil {
+		return v.TasksExpired
+	}
+	return
+}
+
+// GetFailure is an internal getter (TBD...)
+func (v *MoveTaskListResponse) GetFailure() (o string) {
+	if v != nil {
+		return v.Failure
+	}
+	return
+}
+
 // ListTaskListPartitionsResponse is an internal type (TBD...)
 type ListTaskListPartitionsResponse struct {
 	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
@@ -4718,55 +5195,185 @@ func (v *ListWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
-// MarkerRecordedEventAttributes is an internal type (TBD...)
-type MarkerRecordedEventAttributes struct {
-	MarkerName                   string  `json:"markerName,omitempty"`
-	Details                      []byte  `json:"details,omitempty"`
-	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
-	Header                       *Header `json:"header,omitempty"`
+// MarkerRecordedEventAttributes is an internal type (TBD...)
+type MarkerRecordedEventAttributes struct {
+	MarkerName                   string  `json:"markerName,omitempty"`
+	Details                      []byte  `json:"details,omitempty"`
+	DecisionTaskCompletedEventID int64   `json:"decisionTaskCompletedEventId,omitempty"`
+	Header                       *Header `json:"header,omitempty"`
+}
+
+// GetMarkerName is an internal getter (TBD...)
+func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+	if v != nil {
+		return v.MarkerName
+	}
+	return
+}
+
+// GetDetails is an internal getter (TBD...)
+func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+	return
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetHeader is an internal getter (TBD...)
+func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
+// Memo is an internal type (TBD...)
+type Memo struct {
+	Fields map[string][]byte `json:"fields,omitempty"`
+}
+
+// GetFields is an internal getter (TBD...)
+func (v *Memo) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
+	}
+	return
+}
+
+// OperatePendingActivityRequest is an internal type (TBD...)
+type OperatePendingActivityRequest struct {
+	Domain                        string                    `json:"domain,omitempty"`
+	WorkflowExecution             *WorkflowExecution        `json:"workflowExecution,omitempty"`
+	ActivityID                    string                    `json:"activityID,omitempty"`
+	Operation                     *PendingActivityOperation `json:"operation,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32                    `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32                    `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32                    `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy              `json:"retryPolicy,omitempty"`
+	ResetAttempts                 bool                      `json:"resetAttempts,omitempty"`
+	Reason                        *string                   `json:"reason,omitempty"`
+	Details                       []byte                    `json:"details,omitempty"`
+	Result                        []byte                    `json:"result,omitempty"`
+	Identity                      string                    `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetOperation is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetOperation() (o PendingActivityOperation) {
+	if v != nil && v.Operation != nil {
+		return *v.Operation
+	}
+	return
+}
+
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+	return
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
 }
 
-// GetMarkerName is an internal getter (TBD...)
-func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+// GetResetAttempts is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetResetAttempts() (o bool) {
 	if v != nil {
-		return v.MarkerName
+		return v.ResetAttempts
 	}
 	return
 }
 
-// GetDetails is an internal getter (TBD...)
-func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// GetReason is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 	return
 }
 
-// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
-func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
-	if v != nil {
-		return v.DecisionTaskCompletedEventID
+// GetDetails is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 	return
 }
 
-// GetHeader is an internal getter (TBD...)
-func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+// GetResult is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 	return
 }
 
-// Memo is an internal type (TBD...)
-type Memo struct {
-	Fields map[string][]byte `json:"fields,omitempty"`
-}
-
-// GetFields is an internal getter (TBD...)
-func (v *Memo) GetFields() (o map[string][]byte) {
-	if v != nil && v.Fields != nil {
-		return v.Fields
+// GetIdentity is an internal getter (TBD...)
+func (v *OperatePendingActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
@@ -4829,6 +5436,55 @@ const (
 	ParentClosePolicyTerminate
 )
 
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	AllowActivities   bool               `json:"allowActivities,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetAllowActivities is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetAllowActivities() (o bool) {
+	if v != nil {
+		return v.AllowActivities
+	}
+	return
+}
+
 // PendingActivityInfo is an internal type (TBD...)
 type PendingActivityInfo struct {
 	ActivityID             string                `json:"activityID,omitempty"`
@@ -4950,6 +5606,71 @@ func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
 	return
 }
 
+// PendingActivityOperation is an internal type (TBD...)
+type PendingActivityOperation int32
+
+// Ptr is a helper function for getting pointer value
+func (e PendingActivityOperation) Ptr() *PendingActivityOperation {
+	return &e
+}
+
+// String returns a readable string representation of PendingActivityOperation.
+func (e PendingActivityOperation) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "UPDATE_OPTIONS"
+	case 1:
+		return "RETRY"
+	case 2:
+		return "FAIL"
+	case 3:
+		return "SKIP"
+	}
+	return fmt.Sprintf("PendingActivityOperation(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *PendingActivityOperation) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "UPDATE_OPTIONS":
+		*e = PendingActivityOperationUpdateOptions
+		return nil
+	case "RETRY":
+		*e = PendingActivityOperationRetry
+		return nil
+	case "FAIL":
+		*e = PendingActivityOperationFail
+		return nil
+	case "SKIP":
+		*e = PendingActivityOperationSkip
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityOperation", err)
+		}
+		*e = PendingActivityOperation(val)
+		return nil
+	}
+}
+
+// MarshalText encodes PendingActivityOperation to text.
+func (e PendingActivityOperation) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// PendingActivityOperationUpdateOptions is an option for PendingActivityOperation
+	PendingActivityOperationUpdateOptions PendingActivityOperation = iota
+	// PendingActivityOperationRetry is an option for PendingActivityOperation
+	PendingActivityOperationRetry
+	// PendingActivityOperationFail is an option for PendingActivityOperation
+	PendingActivityOperationFail
+	// PendingActivityOperationSkip is an option for PendingActivityOperation
+	PendingActivityOperationSkip
+)
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -5535,9 +6256,10 @@ func (v *PollForDecisionTaskResponse) GetNextEventID() (o int64) {
 
 // PollerInfo is an internal type (TBD...)
 type PollerInfo struct {
-	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
-	Identity       string  `json:"identity,omitempty"`
-	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+	LastAccessTime   *int64  `json:"lastAccessTime,omitempty"`
+	Identity         string  `json:"identity,omitempty"`
+	RatePerSecond    float64 `json:"ratePerSecond,omitempty"`
+	OutstandingTasks int64   `json:"outstandingTasks,omitempty"`
 }
 
 // GetLastAccessTime is an internal getter (TBD...)
@@ -5564,6 +6286,14 @@ func (v *PollerInfo) GetRatePerSecond() (o float64) {
 	return
 }
 
+// GetOutstandingTasks is an internal getter (TBD...)
+func (v *PollerInfo) GetOutstandingTasks() (o int64) {
+	if v != nil {
+		return v.OutstandingTasks
+	}
+	return
+}
+
 // QueryConsistencyLevel is an internal type (TBD...)
 type QueryConsistencyLevel int32
 
@@ -7693,6 +8423,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback  `json:"completionCallbacks,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7759,6 +8490,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetDelayStartSeconds() (o int3
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // GetIdentity is an internal getter (TBD...)
 func (v *SignalWithStartWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
@@ -8339,6 +9078,7 @@ type StartWorkflowExecutionRequest struct {
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	Header                              *Header                `json:"header,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback  `json:"completionCallbacks,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8405,6 +9145,14 @@ func (v *StartWorkflowExecutionRequest) GetDelayStartSeconds() (o int32) {
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // GetIdentity is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil {
@@ -8658,11 +9406,14 @@ func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint int64        `json:"backlogCountHint,omitempty"`
-	ReadLevel        int64        `json:"readLevel,omitempty"`
-	AckLevel         int64        `json:"ackLevel,omitempty"`
-	RatePerSecond    float64      `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint      int64        `json:"backlogCountHint,omitempty"`
+	ReadLevel             int64        `json:"readLevel,omitempty"`
+	AckLevel              int64        `json:"ackLevel,omitempty"`
+	RatePerSecond         float64      `json:"ratePerSecond,omitempty"`
+	TaskIDBlock           *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogAgeInSeconds   int64        `json:"backlogAgeInSeconds,omitempty"`
+	DispatchRatePerSecond float64      `json:"dispatchRatePerSecond,omitempty"`
+	SyncMatchRatio        float64      `json:"syncMatchRatio,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -8705,6 +9456,30 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetBacklogAgeInSeconds is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogAgeInSeconds() (o int64) {
+	if v != nil {
+		return v.BacklogAgeInSeconds
+	}
+	return
+}
+
+// GetDispatchRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetDispatchRatePerSecond() (o float64) {
+	if v != nil {
+		return v.DispatchRatePerSecond
+	}
+	return
+}
+
+// GetSyncMatchRatio is an internal getter (TBD...)
+func (v *TaskListStatus) GetSyncMatchRatio() (o float64) {
+	if v != nil {
+		return v.SyncMatchRatio
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8985,6 +9760,46 @@ func (v *TransientDecisionInfo) GetStartedEvent() (o *HistoryEvent) {
 	return
 }
 
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // UpdateDomainRequest is an internal type (TBD...)
 type UpdateDomainRequest struct {
 	Name                                   string                             `json:"name,omitempty"`
@@ -9796,6 +10611,37 @@ func (v *WorkflowExecutionInfo) GetIsCron() (o bool) {
 	return
 }
 
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason          string `json:"reason,omitempty"`
+	Identity        string `json:"identity,omitempty"`
+	AllowActivities bool   `json:"allowActivities,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetAllowActivities is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetAllowActivities() (o bool) {
+	if v != nil {
+		return v.AllowActivities
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -9855,6 +10701,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback   `json:"completionCallbacks,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -10058,6 +10905,14 @@ func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   string `json:"reason,omitempty"`
@@ -10167,6 +11022,68 @@ const (
 	WorkflowIDReusePolicyTerminateIfRunning
 )
 
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// WorkflowPauseInfo is an internal type (TBD...)
+type WorkflowPauseInfo struct {
+	Reason          string `json:"reason,omitempty"`
+	Identity        string `json:"identity,omitempty"`
+	PausedTimestamp *int64 `json:"pausedTimestamp,omitempty"`
+	AllowActivities bool   `json:"allowActivities,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowPauseInfo) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowPauseInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetPausedTimestamp is an internal getter (TBD...)
+func (v *WorkflowPauseInfo) GetPausedTimestamp() (o int64) {
+	if v != nil && v.PausedTimestamp != nil {
+		return *v.PausedTimestamp
+	}
+	return
+}
+
+// GetAllowActivities is an internal getter (TBD...)
+func (v *WorkflowPauseInfo) GetAllowActivities() (o bool) {
+	if v != nil {
+		return v.AllowActivities
+	}
+	return
+}
+
 // WorkflowQuery is an internal type (TBD...)
 type WorkflowQuery struct {
 	QueryType string `json:"queryType,omitempty"`
@@ -64,6 +64,8 @@ const (
 	AckLevel          = 1001
 	ReadLevel         = 1002
 	RatePerSecond     = 3.14
+	BacklogAge        = 45
+	SyncMatchRatio    = 0.75
 	TaskID            = 444
 	ShardID           = 12345
 	MessageID1        = 50001
@@ -214,19 +216,23 @@ var (
 		FeatureVersion: FeatureVersion,
 	}
 	PollerInfo = types.PollerInfo{
-		LastAccessTime: &Timestamp1,
-		Identity:       Identity,
-		RatePerSecond:  RatePerSecond,
+		LastAccessTime:   &Timestamp1,
+		Identity:         Identity,
+		RatePerSecond:    RatePerSecond,
+		OutstandingTasks: BacklogCountHint,
 	}
 	PollerInfoArray = []*types.PollerInfo{
 		&PollerInfo,
 	}
 	TaskListStatus = types.TaskListStatus{
-		BacklogCountHint: BacklogCountHint,
-		ReadLevel:        ReadLevel,
-		AckLevel:         AckLevel,
-		RatePerSecond:    RatePerSecond,
-		TaskIDBlock:      &TaskIDBlock,
+		BacklogCountHint:      BacklogCountHint,
+		ReadLevel:             ReadLevel,
+		AckLevel:              AckLevel,
+		RatePerSecond:         RatePerSecond,
+		TaskIDBlock:           &TaskIDBlock,
+		BacklogAgeInSeconds:   BacklogAge,
+		DispatchRatePerSecond: RatePerSecond,
+		SyncMatchRatio:        SyncMatchRatio,
 	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
@@ -396,4 +402,26 @@ var (
 		Attempt:                    Attempt,
 		OriginalScheduledTimestamp: &Timestamp3,
 	}
+	WorkflowPauseInfo = types.WorkflowPauseInfo{
+		Reason:          Reason,
+		Identity:        Identity,
+		PausedTimestamp: &Timestamp1,
+		AllowActivities: true,
+	}
+	CompletionCallbackArray = []*types.CompletionCallback{
+		{
+			URL:     "https://example.com/callback",
+			Headers: map[string]string{"Authorization": "Bearer token"},
+		},
+	}
+	CompletionCallbackInfoArray = []*types.CompletionCallbackInfo{
+		{
+			URL:                  "https://example.com/callback",
+			State:                types.CompletionCallbackStatePending.Ptr(),
+			Attempt:              Attempt,
+			LastAttemptTimestamp: &Timestamp1,
+			NextAttemptTimestamp: &Timestamp2,
+			LastFailure:          Reason,
+		},
+	}
 )
@@ -75,6 +75,10 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_WorkflowExecutionPaused,
+		&HistoryEvent_WorkflowExecutionUnpaused,
+		&HistoryEvent_ActivityTaskOptionsUpdated,
+		&HistoryEvent_ActivityTaskRetryRequested,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -245,6 +249,22 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
+	HistoryEvent_ActivityTaskOptionsUpdated = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskOptionsUpdated.Ptr()
+		e.ActivityTaskOptionsUpdatedEventAttributes = &ActivityTaskOptionsUpdatedEventAttributes
+	})
+	HistoryEvent_ActivityTaskRetryRequested = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeActivityTaskRetryRequested.Ptr()
+		e.ActivityTaskRetryRequestedEventAttributes = &ActivityTaskRetryRequestedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -273,6 +293,7 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		PrevAutoResetPoints:                 &ResetPoints,
 		Header:                              &Header,
+		CompletionCallbacks:                 CompletionCallbackArray,
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -339,6 +360,20 @@ var (
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
 	}
+	ActivityTaskOptionsUpdatedEventAttributes = types.ActivityTaskOptionsUpdatedEventAttributes{
+		ScheduledEventID:              EventID1,
+		ScheduleToStartTimeoutSeconds: &Duration2,
+		ScheduleToCloseTimeoutSeconds: &Duration1,
+		StartToCloseTimeoutSeconds:    &Duration3,
+		HeartbeatTimeoutSeconds:       &Duration4,
+		RetryPolicy:                   &RetryPolicy,
+		Identity:                      Identity,
+	}
+	ActivityTaskRetryRequestedEventAttributes = types.ActivityTaskRetryRequestedEventAttributes{
+		ScheduledEventID: EventID1,
+		ResetAttempts:    true,
+		Identity:         Identity,
+	}
 	ActivityTaskStartedEventAttributes = types.ActivityTaskStartedEventAttributes{
 		ScheduledEventID:   EventID1,
 		Identity:           Identity,
@@ -421,6 +456,15 @@ var (
 		Details:  Payload1,
 		Identity: Identity,
 	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:          Reason,
+		Identity:        Identity,
+		AllowActivities: true,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:   Reason,
+		Identity: Identity,
+	}
 	WorkflowExecutionCancelRequestedEventAttributes = types.WorkflowExecutionCancelRequestedEventAttributes{
 		Cause:                     Cause,
 		ExternalInitiatedEventID:  common.Int64Ptr(EventID1),
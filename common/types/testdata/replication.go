@@ -89,12 +89,26 @@ var (
 		FailoverMarkerAttributes: &FailoverMarkerAttributes,
 		CreationTime:             &Timestamp1,
 	}
+	ReplicationTask_Frame = types.ReplicationTask{
+		TaskType:        types.ReplicationTaskTypeFrame.Ptr(),
+		SourceTaskID:    TaskID,
+		FrameAttributes: &ReplicationTaskFrameAttributes,
+		CreationTime:    &Timestamp1,
+	}
+	ReplicationTask_SyncCompletionCallbacks = types.ReplicationTask{
+		TaskType:                              types.ReplicationTaskTypeSyncCompletionCallbacks.Ptr(),
+		SourceTaskID:                          TaskID,
+		SyncCompletionCallbacksTaskAttributes: &SyncCompletionCallbacksTaskAttributes,
+		CreationTime:                          &Timestamp1,
+	}
 	ReplicationTaskArray = []*types.ReplicationTask{
 		&ReplicationTask_Domain,
 		&ReplicationTask_SyncShard,
 		&ReplicationTask_SyncActivity,
 		&ReplicationTask_History,
 		&ReplicationTask_Failover,
+		&ReplicationTask_Frame,
+		&ReplicationTask_SyncCompletionCallbacks,
 	}
 	DomainTaskAttributes = types.DomainTaskAttributes{
 		DomainOperation:         types.DomainOperationUpdate.Ptr(),
@@ -142,4 +156,56 @@ var (
 		FailoverVersion: FailoverVersion1,
 		CreationTime:    &Timestamp1,
 	}
+	ReplicationTaskFrameAttributes = types.ReplicationTaskFrameAttributes{
+		Data: Payload1,
+	}
+	SyncCompletionCallbacksTaskAttributes = types.SyncCompletionCallbacksTaskAttributes{
+		DomainID:            DomainID,
+		WorkflowID:          WorkflowID,
+		RunID:               RunID,
+		Version:             Version1,
+		CompletionCallbacks: CompletionCallbackInfoArray,
+	}
+	ReplicationDomainStatus = types.ReplicationDomainStatus{
+		DomainID:            DomainID,
+		Domain:              DomainName,
+		Backlog:             2,
+		OldestTaskTimestamp: &Timestamp1,
+		DLQSize:             1,
+	}
+	ReplicationShardStatus = types.ReplicationShardStatus{
+		ShardID:                 ShardID,
+		AckLevel:                TaskID,
+		LastTaskID:              TaskID + 2,
+		ReplicatedUpToTimestamp: &Timestamp2,
+		Domains:                 []*types.ReplicationDomainStatus{&ReplicationDomainStatus},
+		Truncated:               true,
+	}
+	GetReplicationStatusResponse = types.GetReplicationStatusResponse{
+		StatusByShard:      map[int32]*types.ReplicationShardStatus{ShardID: &ReplicationShardStatus},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{ShardID + 1: types.GetTaskFailedCauseShardOwnershipLost},
+	}
+	ReplicationProcessorState = types.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: &Timestamp2,
+		FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+			DomainID: {Count: 2, LastTimestamp: Timestamp1, LastError: ErrorMessage},
+		},
+	}
+	ReplicationDLQTaskState = types.ReplicationDLQTaskState{
+		TaskID:               TaskID,
+		ErrorClass:           "history-gap",
+		Attempts:             2,
+		LastError:            ErrorMessage,
+		LastAttemptTimestamp: Timestamp1,
+		NextAttemptTimestamp: Timestamp2,
+	}
+	ReplicationShardReadiness = types.ReplicationShardReadiness{
+		ShardID:         ShardID,
+		ProcessorState:  &ReplicationProcessorState,
+		DLQSizeByDomain: map[string]int64{DomainID: 1},
+	}
+	GetReplicationReadinessResponse = types.GetReplicationReadinessResponse{
+		ReadinessByShard:   map[int32]*types.ReplicationShardReadiness{ShardID: &ReplicationShardReadiness},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{ShardID + 1: types.GetTaskFailedCauseShardOwnershipLost},
+	}
 )
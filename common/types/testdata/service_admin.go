@@ -37,9 +37,28 @@ var (
 	AdminCloseShardRequest = types.CloseShardRequest{
 		ShardID: ShardID,
 	}
+	AdminMoveTaskListRequest = types.MoveTaskListRequest{
+		Domain:         DomainName,
+		SourceTaskList: &TaskList,
+		TargetTaskList: &TaskList,
+		TaskListType:   TaskListType.Ptr(),
+		BatchSize:      PageSize,
+		RPS:            PageSize,
+		DryRun:         true,
+	}
+	AdminMoveTaskListResponse = types.MoveTaskListResponse{
+		TasksMoved:   BacklogCountHint,
+		TasksExpired: TaskID,
+		Failure:      FailureReason,
+	}
 	AdminDescribeClusterResponse = types.DescribeClusterResponse{
 		SupportedClientVersions: &SupportedClientVersions,
 		MembershipInfo:          &MembershipInfo,
+		PersistenceHealth: &types.PersistenceHealth{
+			Requests:        100,
+			Failures:        2,
+			WindowInSeconds: 60,
+		},
 	}
 	AdminDescribeHistoryHostRequest_ByHost = types.DescribeHistoryHostRequest{
 		HostAddress: common.StringPtr(HostName),
@@ -141,12 +160,29 @@ var (
 		ReplicationTasks:     ReplicationTaskArray,
 		ReplicationTasksInfo: ReplicationTaskInfoArray,
 		NextPageToken:        NextPageToken,
+		RetryStates:          []*types.ReplicationDLQTaskState{&ReplicationDLQTaskState},
 	}
 	AdminReapplyEventsRequest = types.ReapplyEventsRequest{
 		DomainName:        DomainName,
 		WorkflowExecution: &WorkflowExecution,
 		Events:            &DataBlob,
 	}
+	AdminOperatePendingActivityRequest = types.OperatePendingActivityRequest{
+		Domain:                        DomainName,
+		WorkflowExecution:             &WorkflowExecution,
+		ActivityID:                    ActivityID,
+		Operation:                     types.PendingActivityOperationFail.Ptr(),
+		ScheduleToStartTimeoutSeconds: &Duration2,
+		ScheduleToCloseTimeoutSeconds: &Duration1,
+		StartToCloseTimeoutSeconds:    &Duration3,
+		HeartbeatTimeoutSeconds:       &Duration4,
+		RetryPolicy:                   &RetryPolicy,
+		ResetAttempts:                 true,
+		Reason:                        &FailureReason,
+		Details:                       FailureDetails,
+		Result:                        Payload1,
+		Identity:                      Identity,
+	}
 	AdminRefreshWorkflowTasksRequest = types.RefreshWorkflowTasksRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -173,6 +209,27 @@ var (
 		ClusterName: ClusterName1,
 		Type:        common.Int32Ptr(QueueType),
 	}
+	AdminGetFailoverReadinessRequest = types.GetFailoverReadinessRequest{
+		Domain:                          DomainName,
+		MaxReplicationLagInSeconds:      common.Int32Ptr(60),
+		ReplicationErrorWindowInSeconds: common.Int32Ptr(600),
+	}
+	AdminGetFailoverReadinessResponse = types.GetFailoverReadinessResponse{
+		DomainID:      DomainID,
+		Cluster:       ClusterName2,
+		SourceCluster: ClusterName1,
+		Ready:         false,
+		Checks: []*types.FailoverReadinessCheck{
+			{Name: "replication lag", Passed: true, Message: "all shards replicated"},
+			{Name: "replication DLQ", Passed: false, Message: ErrorMessage},
+		},
+	}
+	AdminGetReplicationStatusRequest = types.GetReplicationStatusRequest{
+		SourceCluster: ClusterName1,
+		TargetCluster: ClusterName2,
+		ShardIDs:      []int32{ShardID},
+	}
+	AdminGetReplicationStatusResponse              = GetReplicationStatusResponse
 	AdminGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	AdminRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -155,11 +155,17 @@ var (
 		NextPageToken: NextPageToken,
 	}
 	CountWorkflowExecutionsRequest = types.CountWorkflowExecutionsRequest{
-		Domain: DomainName,
-		Query:  VisibilityQuery,
+		Domain:  DomainName,
+		Query:   VisibilityQuery,
+		GroupBy: []string{"WorkflowType", "CloseStatus"},
 	}
 	CountWorkflowExecutionsResponse = types.CountWorkflowExecutionsResponse{
 		Count: int64(8),
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{GroupValues: []string{`"workflowType"`, "0"}, Count: int64(5)},
+			{GroupValues: []string{`"workflowType"`, "null"}, Count: int64(3)},
+		},
+		Truncated: true,
 	}
 	GetSearchAttributesResponse = types.GetSearchAttributesResponse{
 		Keys: IndexedValueTypeMap,
@@ -319,6 +325,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		CompletionCallbacks:                 CompletionCallbackArray,
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -351,6 +358,7 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		CompletionCallbacks:                 CompletionCallbackArray,
 	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
@@ -370,6 +378,19 @@ var (
 		Details:           Payload1,
 		Identity:          Identity,
 	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		AllowActivities:   true,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -380,6 +401,8 @@ var (
 		PendingActivities:      PendingActivityInfoArray,
 		PendingChildren:        PendingChildExecutionInfoArray,
 		PendingDecision:        &PendingDecisionInfo,
+		PauseInfo:              &WorkflowPauseInfo,
+		CompletionCallbacks:    CompletionCallbackInfoArray,
 	}
 	QueryWorkflowRequest = types.QueryWorkflowRequest{
 		Domain:                DomainName,
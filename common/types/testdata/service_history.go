@@ -170,6 +170,10 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 	}
+	HistoryOperatePendingActivityRequest = types.HistoryOperatePendingActivityRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminOperatePendingActivityRequest,
+	}
 	HistoryRefreshWorkflowTasksRequest = types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
@@ -291,6 +295,24 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryGetReplicationReadinessRequest = types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      []int32{ShardID},
+		SourceCluster: ClusterName1,
+	}
+	HistoryGetReplicationReadinessResponse = GetReplicationReadinessResponse
+	HistoryGetReplicationStatusRequest     = types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      []int32{ShardID},
+		RemoteCluster: ClusterName1,
+	}
+	HistoryGetReplicationStatusResponse              = GetReplicationStatusResponse
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -56,6 +56,12 @@ var (
 		TaskList:     &TaskList,
 		PollerID:     PollerID,
 	}
+	MatchingReleaseOutstandingTaskRequest = types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        DomainID,
+		TaskList:          &TaskList,
+		WorkflowExecution: &WorkflowExecution,
+		ScheduleID:        EventID1,
+	}
 	MatchingDescribeTaskListRequest = types.MatchingDescribeTaskListRequest{
 		DomainUUID:  DomainID,
 		DescRequest: &DescribeTaskListRequest,
@@ -64,6 +70,10 @@ var (
 		Pollers:        PollerInfoArray,
 		TaskListStatus: &TaskListStatus,
 	}
+	MatchingMoveTaskListRequest = types.MatchingMoveTaskListRequest{
+		DomainUUID:  DomainID,
+		MoveRequest: &AdminMoveTaskListRequest,
+	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
@@ -793,6 +793,25 @@ func ConvertIndexedValueTypeToThriftType(fieldType interface{}, logger log.Logge
 	}
 }
 
+// ConvertIndexedValueTypeToInternalType takes fieldType as interface{} and convert to IndexedValueType.
+// It returns false when the type of fieldType is unknown.
+func ConvertIndexedValueTypeToInternalType(fieldType interface{}) (types.IndexedValueType, bool) {
+	switch t := fieldType.(type) {
+	case float64:
+		return types.IndexedValueType(t), true
+	case int:
+		return types.IndexedValueType(t), true
+	case int64:
+		return types.IndexedValueType(t), true
+	case types.IndexedValueType:
+		return t, true
+	case workflow.IndexedValueType:
+		return types.IndexedValueType(t), true
+	default:
+		return 0, false
+	}
+}
+
 // DeserializeSearchAttributeValue takes json encoded search attribute value and it's type as input, then
 // unmarshal the value into a concrete type and return the value
 func DeserializeSearchAttributeValue(value []byte, valueType workflow.IndexedValueType) (interface{}, error) {
@@ -32,6 +32,7 @@ require (
 	github.com/jcmturner/gofork v1.0.0 // indirect
 	github.com/jmoiron/sqlx v1.2.1-0.20200615141059-0794cb1f47ee
 	github.com/jonboulle/clockwork v0.1.0
+	github.com/klauspost/compress v1.13.6
 	github.com/lib/pq v1.2.0
 	github.com/m3db/prometheus_client_golang v0.8.1
 	github.com/m3db/prometheus_client_model v0.1.0 // indirect
@@ -90,3 +91,8 @@ require (
 
 // ringpop-go and tchannel-go depends on older version of thrift, yarpc brings up newer version
 replace github.com/apache/thrift => github.com/apache/thrift v0.0.0-20161221203622-b2a4d4ae21c7
+
+// the IDL is vendored in the idls directory until its changes (task list backlog status and the other
+// API fields added on this branch) land in github.com/uber/cadence-idl, generated code in .gen is built from it.
+// Drop this replace, restore the idls submodule and bump the cadence-idl version once they are published.
+replace github.com/uber/cadence-idl => ./idls
@@ -45,6 +45,7 @@ var (
 		dynamicconfig.ReplicationTaskFetcherErrorRetryWait:          50 * time.Millisecond,
 		dynamicconfig.ReplicationTaskProcessorErrorRetryWait:        time.Millisecond,
 		dynamicconfig.EnableConsistentQueryByDomain:                 true,
+		dynamicconfig.EnableCrossClusterOperations:                  true,
 		dynamicconfig.MinRetentionDays:                              0,
 	}
 )
@@ -0,0 +1,294 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build !race
+// +build !race
+
+// need to run xdc tests with race detector off because of ringpop bug causing data race issue
+
+package xdc
+
+import (
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/host"
+)
+
+func (s *integrationClustersTestSuite) TestCrossClusterSignalExternalWorkflow() {
+	sourceDomain, targetDomain := s.registerCrossClusterDomains("test-cross-cluster-signal-")
+	client1 := s.cluster1.GetFrontendClient() // source domain active
+	client2 := s.cluster2.GetFrontendClient() // target domain active
+
+	signalName := "cross cluster signal"
+	signalInput := []byte("cross cluster signal input")
+
+	targetExecution, targetPoller := s.startCrossClusterTargetWorkflow(
+		client2,
+		targetDomain,
+		"integration-cross-cluster-signal-target",
+		types.EventTypeWorkflowExecutionSignaled,
+		&types.Decision{
+			DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+			CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Signaled."),
+			},
+		},
+	)
+
+	sourceExecution, sourcePoller := s.startCrossClusterSourceWorkflow(
+		client1,
+		sourceDomain,
+		"integration-cross-cluster-signal-source",
+		types.EventTypeExternalWorkflowExecutionSignaled,
+		&types.Decision{
+			DecisionType: types.DecisionTypeSignalExternalWorkflowExecution.Ptr(),
+			SignalExternalWorkflowExecutionDecisionAttributes: &types.SignalExternalWorkflowExecutionDecisionAttributes{
+				Domain:     targetDomain,
+				Execution:  targetExecution,
+				SignalName: signalName,
+				Input:      signalInput,
+			},
+		},
+	)
+
+	// source workflow schedules the signal, which is delivered through the cross cluster queue
+	_, err := sourcePoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask source", tag.Error(err))
+	s.NoError(err)
+
+	// target workflow receives the signal in the other cluster
+	_, err = targetPoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask target", tag.Error(err))
+	s.NoError(err)
+
+	// source workflow is notified once the signal is delivered
+	_, err = sourcePoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask source", tag.Error(err))
+	s.NoError(err)
+
+	signaled := false
+	for _, event := range s.getHistory(client2, targetDomain, targetExecution) {
+		if event.GetEventType() == types.EventTypeWorkflowExecutionSignaled {
+			attributes := event.WorkflowExecutionSignaledEventAttributes
+			s.Equal(signalName, attributes.GetSignalName())
+			s.Equal(signalInput, attributes.Input)
+			signaled = true
+		}
+	}
+	s.True(signaled)
+	s.assertCrossClusterSourceCompleted(client1, sourceDomain, sourceExecution, types.EventTypeExternalWorkflowExecutionSignaled)
+}
+
+func (s *integrationClustersTestSuite) TestCrossClusterCancelExternalWorkflow() {
+	sourceDomain, targetDomain := s.registerCrossClusterDomains("test-cross-cluster-cancel-")
+	client1 := s.cluster1.GetFrontendClient() // source domain active
+	client2 := s.cluster2.GetFrontendClient() // target domain active
+
+	targetExecution, targetPoller := s.startCrossClusterTargetWorkflow(
+		client2,
+		targetDomain,
+		"integration-cross-cluster-cancel-target",
+		types.EventTypeWorkflowExecutionCancelRequested,
+		&types.Decision{
+			DecisionType: types.DecisionTypeCancelWorkflowExecution.Ptr(),
+			CancelWorkflowExecutionDecisionAttributes: &types.CancelWorkflowExecutionDecisionAttributes{
+				Details: []byte("Canceled."),
+			},
+		},
+	)
+
+	sourceExecution, sourcePoller := s.startCrossClusterSourceWorkflow(
+		client1,
+		sourceDomain,
+		"integration-cross-cluster-cancel-source",
+		types.EventTypeExternalWorkflowExecutionCancelRequested,
+		&types.Decision{
+			DecisionType: types.DecisionTypeRequestCancelExternalWorkflowExecution.Ptr(),
+			RequestCancelExternalWorkflowExecutionDecisionAttributes: &types.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+				Domain:     targetDomain,
+				WorkflowID: targetExecution.GetWorkflowID(),
+				RunID:      targetExecution.GetRunID(),
+			},
+		},
+	)
+
+	// source workflow requests the cancellation, which is delivered through the cross cluster queue
+	_, err := sourcePoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask source", tag.Error(err))
+	s.NoError(err)
+
+	// target workflow receives the cancellation request in the other cluster
+	_, err = targetPoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask target", tag.Error(err))
+	s.NoError(err)
+
+	// source workflow is notified once the cancellation request is delivered
+	_, err = sourcePoller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask source", tag.Error(err))
+	s.NoError(err)
+
+	targetEvents := s.getHistory(client2, targetDomain, targetExecution)
+	s.Equal(types.EventTypeWorkflowExecutionCanceled, targetEvents[len(targetEvents)-1].GetEventType())
+	s.assertCrossClusterSourceCompleted(client1, sourceDomain, sourceExecution, types.EventTypeExternalWorkflowExecutionCancelRequested)
+}
+
+// registerCrossClusterDomains registers a source domain active in the first cluster
+// and a target domain active in the second cluster
+func (s *integrationClustersTestSuite) registerCrossClusterDomains(
+	prefix string,
+) (string, string) {
+	client1 := s.cluster1.GetFrontendClient()
+	sourceDomain := prefix + "source-" + common.GenerateRandomString(5)
+	targetDomain := prefix + "target-" + common.GenerateRandomString(5)
+	for domainName, activeCluster := range map[string]string{
+		sourceDomain: clusterName[0],
+		targetDomain: clusterName[1],
+	} {
+		err := client1.RegisterDomain(createContext(), &types.RegisterDomainRequest{
+			Name:                                   domainName,
+			IsGlobalDomain:                         true,
+			Clusters:                               clusterReplicationConfig,
+			ActiveClusterName:                      activeCluster,
+			WorkflowExecutionRetentionPeriodInDays: 1,
+		})
+		s.NoError(err)
+	}
+
+	// Wait for domain cache to pick the change
+	time.Sleep(cacheRefreshInterval)
+	return sourceDomain, targetDomain
+}
+
+// startCrossClusterTargetWorkflow starts a workflow which makes the given decision once the expected event is received,
+// its first decision task is processed before returning
+func (s *integrationClustersTestSuite) startCrossClusterTargetWorkflow(
+	client host.FrontendClient,
+	domainName string,
+	workflowID string,
+	expectedEventType types.EventType,
+	decision *types.Decision,
+) (*types.WorkflowExecution, *host.TaskPoller) {
+	execution, poller := s.startCrossClusterWorkflow(client, domainName, workflowID, func(
+		history *types.History,
+		previousStartedEventID int64,
+	) []*types.Decision {
+		for _, event := range history.Events[previousStartedEventID:] {
+			if event.GetEventType() == expectedEventType {
+				return []*types.Decision{decision}
+			}
+		}
+		return []*types.Decision{}
+	})
+
+	_, err := poller.PollAndProcessDecisionTask(false, false)
+	s.logger.Info("PollAndProcessDecisionTask target", tag.Error(err))
+	s.NoError(err)
+	return execution, poller
+}
+
+// startCrossClusterSourceWorkflow starts a workflow which makes the given cross cluster decision in its first
+// decision task and completes once the expected event is received
+func (s *integrationClustersTestSuite) startCrossClusterSourceWorkflow(
+	client host.FrontendClient,
+	domainName string,
+	workflowID string,
+	expectedEventType types.EventType,
+	decision *types.Decision,
+) (*types.WorkflowExecution, *host.TaskPoller) {
+	decisionMade := false
+	return s.startCrossClusterWorkflow(client, domainName, workflowID, func(
+		history *types.History,
+		previousStartedEventID int64,
+	) []*types.Decision {
+		if !decisionMade {
+			decisionMade = true
+			return []*types.Decision{decision}
+		}
+		for _, event := range history.Events[previousStartedEventID:] {
+			if event.GetEventType() == expectedEventType {
+				return []*types.Decision{{
+					DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+					CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+						Result: []byte("Done."),
+					},
+				}}
+			}
+		}
+		return []*types.Decision{}
+	})
+}
+
+func (s *integrationClustersTestSuite) startCrossClusterWorkflow(
+	client host.FrontendClient,
+	domainName string,
+	workflowID string,
+	decisionFn func(history *types.History, previousStartedEventID int64) []*types.Decision,
+) (*types.WorkflowExecution, *host.TaskPoller) {
+	identity := "worker1"
+	taskList := &types.TaskList{Name: workflowID + "-tasklist"}
+	we, err := client.StartWorkflowExecution(createContext(), &types.StartWorkflowExecutionRequest{
+		RequestID:                           uuid.New(),
+		Domain:                              domainName,
+		WorkflowID:                          workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: workflowID + "-type"},
+		TaskList:                            taskList,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(300),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            identity,
+	})
+	s.NoError(err)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowID(workflowID), tag.WorkflowRunID(we.GetRunID()))
+
+	poller := &host.TaskPoller{
+		Engine:   client,
+		Domain:   domainName,
+		TaskList: taskList,
+		Identity: identity,
+		DecisionHandler: func(execution *types.WorkflowExecution, wt *types.WorkflowType,
+			previousStartedEventID, startedEventID int64, history *types.History) ([]byte, []*types.Decision, error) {
+			return nil, decisionFn(history, previousStartedEventID), nil
+		},
+		Logger: s.logger,
+		T:      s.T(),
+	}
+	return &types.WorkflowExecution{WorkflowID: workflowID, RunID: we.GetRunID()}, poller
+}
+
+func (s *integrationClustersTestSuite) assertCrossClusterSourceCompleted(
+	client host.FrontendClient,
+	domainName string,
+	execution *types.WorkflowExecution,
+	expectedEventType types.EventType,
+) {
+	events := s.getHistory(client, domainName, execution)
+	found := false
+	for _, event := range events {
+		if event.GetEventType() == expectedEventType {
+			found = true
+		}
+	}
+	s.True(found)
+	s.Equal(types.EventTypeWorkflowExecutionCompleted, events[len(events)-1].GetEventType())
+}
@@ -0,0 +1,98 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/common.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosure0ff151d4a308b356 = [][]byte{
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xd8, 0x49, 0x8f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x3c, 0x03, 0x43,
+		0xb3, 0x01, 0x93, 0x10, 0xf7, 0xa6, 0x58, 0x51, 0x0c, 0x4e, 0xec, 0xac, 0x6a, 0xb7, 0xc4, 0x90,
+		0x8d, 0x66, 0xdb, 0xc5, 0x04, 0x5a, 0x3c, 0x72, 0x39, 0x4b, 0xa4, 0x40, 0x51, 0x4e, 0x7c, 0xb7,
+		0x27, 0xd9, 0xc5, 0x5e, 0x69, 0x2f, 0x34, 0x48, 0xa2, 0x63, 0xa7, 0xf3, 0x90, 0x9b, 0x61, 0x77,
+		0xe4, 0xf9, 0x7e, 0xce, 0x47, 0xe1, 0x90, 0x82, 0x76, 0x36, 0x41, 0xe5, 0x04, 0x94, 0xa1, 0x08,
+		0xd0, 0xa1, 0x09, 0x77, 0xe6, 0x27, 0x4e, 0x20, 0xe3, 0x58, 0x0a, 0x3b, 0x51, 0x52, 0x4b, 0xb2,
+		0x9f, 0x33, 0x6c, 0xc3, 0xb0, 0x69, 0xc2, 0xed, 0xf9, 0xc9, 0xd1, 0x67, 0x53, 0x29, 0xa7, 0x11,
+		0x3a, 0x05, 0x65, 0x92, 0x85, 0x0e, 0xcb, 0x14, 0xd5, 0x7c, 0x29, 0xea, 0xbc, 0x81, 0x0f, 0xaf,
+		0xa4, 0x9a, 0x85, 0x91, 0xbc, 0x1e, 0xdc, 0x60, 0x90, 0xe5, 0x10, 0xf9, 0x1c, 0xea, 0xd7, 0xa6,
+		0xe8, 0x73, 0xd6, 0xb2, 0xda, 0xd6, 0xf1, 0x03, 0x0f, 0x96, 0x25, 0x97, 0x91, 0xc7, 0x50, 0x53,
+		0x99, 0xc8, 0xb1, 0xad, 0x02, 0xab, 0xaa, 0x4c, 0xb8, 0xac, 0xd3, 0x81, 0xc6, 0xd2, 0x6c, 0xbc,
+		0x48, 0x90, 0x10, 0xd8, 0x16, 0x34, 0x46, 0x63, 0x50, 0xac, 0x73, 0x4e, 0x2f, 0xd0, 0x7c, 0xce,
+		0xf5, 0xe2, 0x5f, 0x39, 0x9f, 0xc2, 0xce, 0x90, 0x2e, 0x22, 0x49, 0x59, 0x0e, 0x33, 0xaa, 0x69,
+		0x01, 0x37, 0xbc, 0x62, 0xdd, 0x79, 0x01, 0x3b, 0xe7, 0x94, 0x47, 0x99, 0x42, 0x72, 0x00, 0x35,
+		0x85, 0x34, 0x95, 0xc2, 0xe8, 0xcd, 0x8e, 0xb4, 0x60, 0x87, 0xa1, 0xa6, 0x3c, 0x4a, 0x8b, 0x84,
+		0x0d, 0x6f, 0xb9, 0xed, 0xfc, 0x61, 0xc1, 0xf6, 0x8f, 0x18, 0x4b, 0xf2, 0x12, 0x6a, 0x21, 0xc7,
+		0x88, 0xa5, 0x2d, 0xab, 0x5d, 0x39, 0xae, 0x77, 0xbf, 0xb4, 0x37, 0x7c, 0x3f, 0x3b, 0xa7, 0xda,
+		0xe7, 0x05, 0x6f, 0x20, 0xb4, 0x5a, 0x78, 0x46, 0x74, 0x74, 0x05, 0xf5, 0xb5, 0x32, 0x69, 0x42,
+		0x65, 0x86, 0x0b, 0x93, 0x22, 0x5f, 0x92, 0x2e, 0x54, 0xe7, 0x34, 0xca, 0xb0, 0x08, 0x50, 0xef,
+		0x7e, 0xb2, 0xd1, 0xde, 0x1c, 0xd3, 0x2b, 0xa9, 0xdf, 0x6e, 0x3d, 0xb7, 0x3a, 0x7f, 0x5a, 0x50,
+		0x7b, 0x85, 0x94, 0xa1, 0x22, 0xdf, 0xbd, 0x17, 0xf1, 0xe9, 0x46, 0x8f, 0x92, 0xfc, 0xff, 0x86,
+		0xfc, 0xcb, 0x82, 0xe6, 0x08, 0xa9, 0x0a, 0xde, 0xf5, 0xb4, 0x56, 0x7c, 0x92, 0x69, 0x4c, 0x89,
+		0x0f, 0x7b, 0x5c, 0x30, 0xbc, 0x41, 0xe6, 0xdf, 0x89, 0xfd, 0x7c, 0xa3, 0xeb, 0xfb, 0x72, 0xdb,
+		0x2d, 0xb5, 0xeb, 0xe7, 0x78, 0xc8, 0xd7, 0x6b, 0x47, 0xbf, 0x02, 0xf9, 0x27, 0xe9, 0x3f, 0x3c,
+		0x55, 0x08, 0xbb, 0x7d, 0xaa, 0xe9, 0x69, 0x24, 0x27, 0xe4, 0x1c, 0x1e, 0xa2, 0x08, 0x24, 0xe3,
+		0x62, 0xea, 0xeb, 0x45, 0x52, 0x0e, 0xe8, 0x5e, 0xf7, 0x8b, 0x8d, 0x5e, 0x03, 0xc3, 0xcc, 0x27,
+		0xda, 0x6b, 0xe0, 0xda, 0xee, 0x76, 0x80, 0xb7, 0xd6, 0x06, 0x78, 0x58, 0x5e, 0x3a, 0x54, 0x6f,
+		0x51, 0xa5, 0x5c, 0x0a, 0x57, 0x84, 0x32, 0x27, 0xf2, 0x38, 0x89, 0x96, 0x17, 0x21, 0x5f, 0x93,
+		0xa7, 0xf0, 0x28, 0x44, 0xaa, 0x33, 0x85, 0xfe, 0xbc, 0xa4, 0x9a, 0x0b, 0xb7, 0x67, 0xca, 0xc6,
+		0xa0, 0xf3, 0x06, 0x9e, 0x8c, 0xb2, 0x24, 0x91, 0x4a, 0x23, 0x3b, 0x8b, 0x38, 0x0a, 0x6d, 0x90,
+		0x34, 0xbf, 0xab, 0x53, 0xe9, 0xa7, 0x6c, 0x66, 0x9c, 0xab, 0x53, 0x39, 0x62, 0x33, 0x72, 0x08,
+		0xbb, 0xbf, 0xd1, 0x39, 0x2d, 0x80, 0xd2, 0x73, 0x27, 0xdf, 0x8f, 0xd8, 0xac, 0xf3, 0x7b, 0x05,
+		0xea, 0x1e, 0x6a, 0xb5, 0x18, 0xca, 0x88, 0x07, 0x0b, 0xd2, 0x87, 0x26, 0x17, 0x5c, 0x73, 0x1a,
+		0xf9, 0x5c, 0x68, 0x54, 0x73, 0x5a, 0xa6, 0xac, 0x77, 0x0f, 0xed, 0xf2, 0x79, 0xb1, 0x97, 0xcf,
+		0x8b, 0xdd, 0x37, 0xcf, 0x8b, 0xf7, 0xc8, 0x48, 0x5c, 0xa3, 0x20, 0x0e, 0xec, 0x4f, 0x68, 0x30,
+		0x93, 0x61, 0xe8, 0x07, 0x12, 0xc3, 0x90, 0x07, 0x79, 0xcc, 0xa2, 0xb7, 0xe5, 0x11, 0x03, 0x9d,
+		0xad, 0x90, 0xbc, 0x6d, 0x4c, 0x6f, 0x78, 0x9c, 0xc5, 0xab, 0xb6, 0x95, 0x7b, 0xdb, 0x1a, 0xc9,
+		0x6d, 0xdb, 0xaf, 0x56, 0x2e, 0x54, 0x6b, 0x8c, 0x13, 0x9d, 0xb6, 0xb6, 0xdb, 0xd6, 0x71, 0xf5,
+		0x96, 0xda, 0x33, 0x65, 0xf2, 0x12, 0x3e, 0x16, 0x52, 0xf8, 0x2a, 0x3f, 0x3a, 0x9d, 0x44, 0xe8,
+		0xa3, 0x52, 0x52, 0xf9, 0xe5, 0x93, 0x92, 0xb6, 0xaa, 0xed, 0xca, 0xf1, 0x03, 0xaf, 0x25, 0xa4,
+		0xf0, 0x96, 0x8c, 0x41, 0x4e, 0xf0, 0x4a, 0x9c, 0xbc, 0x86, 0x7d, 0xbc, 0x49, 0x78, 0x19, 0x64,
+		0x15, 0xb9, 0x76, 0x5f, 0x64, 0xb2, 0x52, 0x2d, 0x53, 0x7f, 0x7d, 0x0d, 0x8d, 0xf5, 0x99, 0x22,
+		0x87, 0xf0, 0x78, 0x70, 0x71, 0x76, 0xd9, 0x77, 0x2f, 0xbe, 0xf7, 0xc7, 0x3f, 0x0f, 0x07, 0xbe,
+		0x7b, 0xf1, 0xb6, 0xf7, 0x83, 0xdb, 0x6f, 0x7e, 0x40, 0x8e, 0xe0, 0xe0, 0x2e, 0x34, 0x7e, 0xe5,
+		0xb9, 0xe7, 0x63, 0xef, 0xaa, 0x69, 0x91, 0x03, 0x20, 0x77, 0xb1, 0xd7, 0xa3, 0xcb, 0x8b, 0xe6,
+		0x16, 0x69, 0xc1, 0x47, 0x77, 0xeb, 0x43, 0xef, 0x72, 0x7c, 0xf9, 0xac, 0x59, 0x39, 0xfd, 0x09,
+		0x9e, 0x04, 0x32, 0xde, 0x34, 0xe4, 0xa7, 0xbb, 0xbd, 0x84, 0x0f, 0xf3, 0xf4, 0x43, 0xeb, 0x97,
+		0x93, 0x29, 0xd7, 0xef, 0xb2, 0x89, 0x1d, 0xc8, 0xd8, 0x59, 0xff, 0x31, 0x7d, 0xc3, 0x59, 0xe4,
+		0x4c, 0x65, 0xf9, 0xbb, 0x31, 0x7f, 0xa9, 0x17, 0x34, 0xe1, 0xf3, 0x93, 0x49, 0xad, 0xa8, 0x3d,
+		0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x8c, 0x5b, 0xe4, 0xc9, 0x06, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+}
@@ -0,0 +1,62 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/error.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosurec8f91786c9aff272 = [][]byte{
+	// uber/cadence/api/v1/error.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
+		0x10, 0xc5, 0x95, 0x22, 0x22, 0x98, 0x42, 0x5b, 0x4c, 0x28, 0x15, 0x07, 0x4a, 0xcd, 0x9f, 0xe6,
+		0x52, 0x5b, 0x11, 0xc7, 0x9e, 0x92, 0xe0, 0x48, 0x91, 0x50, 0x55, 0x1a, 0x09, 0x10, 0x17, 0x6b,
+		0xb3, 0x9e, 0x98, 0x15, 0xeb, 0xdd, 0x65, 0xff, 0x98, 0xe4, 0xc2, 0xf7, 0xe0, 0xdb, 0x22, 0x7b,
+		0xd7, 0x25, 0x87, 0x20, 0xf5, 0xb8, 0xbf, 0x99, 0x7d, 0x7e, 0xfb, 0x3c, 0x03, 0xa7, 0x6e, 0x89,
+		0x3a, 0xa5, 0xa4, 0x40, 0x41, 0x31, 0x25, 0x8a, 0xa5, 0xf5, 0x28, 0x45, 0xad, 0xa5, 0x4e, 0x94,
+		0x96, 0x56, 0x46, 0x4f, 0x9b, 0x86, 0x24, 0x34, 0x24, 0x44, 0xb1, 0xa4, 0x1e, 0xc5, 0x25, 0xbc,
+		0xf9, 0x22, 0xf5, 0x8f, 0x15, 0x97, 0xbf, 0xb2, 0x35, 0x52, 0x67, 0x99, 0x14, 0x63, 0xae, 0x91,
+		0x14, 0x9b, 0x85, 0x25, 0xda, 0x62, 0x91, 0x35, 0x12, 0xd1, 0x10, 0x8e, 0x4c, 0x73, 0xce, 0x35,
+		0xfe, 0x74, 0x68, 0x6c, 0xce, 0x8a, 0x93, 0xde, 0xab, 0xde, 0xf0, 0xe1, 0xcd, 0x41, 0xcb, 0x6f,
+		0x3c, 0x9e, 0x17, 0xd1, 0x33, 0xe8, 0x6b, 0x27, 0x9a, 0xfa, 0x5e, 0x5b, 0xbf, 0xaf, 0x9d, 0x98,
+		0x17, 0xf1, 0x0a, 0x06, 0x99, 0xb0, 0xcc, 0x6e, 0xae, 0xa4, 0xcd, 0xd6, 0xcc, 0x58, 0xe3, 0x85,
+		0xcf, 0xe1, 0x90, 0x3a, 0xad, 0x51, 0xd8, 0x9c, 0x72, 0x67, 0x2c, 0xea, 0x4e, 0x37, 0xe0, 0xa9,
+		0xa7, 0xd1, 0x5b, 0x38, 0x20, 0xd4, 0xb2, 0x1a, 0x6f, 0xfb, 0xbc, 0xfe, 0x63, 0x4f, 0x43, 0x5b,
+		0x3c, 0x84, 0x77, 0xff, 0x7b, 0xd0, 0x54, 0x56, 0x8a, 0x63, 0xf7, 0xa4, 0xf8, 0x37, 0x0c, 0x3e,
+		0xc8, 0x8a, 0x30, 0x71, 0x25, 0xed, 0xb8, 0xd5, 0xf0, 0x8e, 0x8e, 0xa1, 0x5f, 0xb4, 0x3c, 0x18,
+		0x09, 0xa7, 0x5d, 0x4e, 0xf7, 0xee, 0xe8, 0xf4, 0xde, 0x2e, 0xa7, 0x7f, 0x7a, 0xf0, 0x72, 0xca,
+		0x19, 0x0a, 0xfb, 0x19, 0xb5, 0x61, 0xb2, 0xf1, 0xb1, 0x70, 0x4a, 0xc9, 0x7f, 0xa9, 0x9f, 0xc3,
+		0xe1, 0x0a, 0x89, 0x75, 0x1a, 0xf3, 0xda, 0xf7, 0x74, 0xe1, 0x04, 0x1c, 0x6e, 0x46, 0xa7, 0xb0,
+		0x4f, 0x5b, 0xa9, 0x9c, 0x55, 0x8a, 0x07, 0x5f, 0xe0, 0xd1, 0xbc, 0x52, 0x3c, 0xba, 0x80, 0xc8,
+		0x74, 0xda, 0x9d, 0x96, 0x09, 0xbe, 0x9e, 0xdc, 0x56, 0x82, 0x9c, 0x89, 0x2f, 0xe1, 0x78, 0xe6,
+		0xbf, 0xd0, 0xfc, 0x2e, 0x41, 0x96, 0xbc, 0xb3, 0x74, 0x06, 0x8f, 0x3a, 0x4b, 0x2b, 0x4e, 0xca,
+		0xe0, 0x67, 0x3f, 0xb0, 0x19, 0x27, 0x65, 0xfc, 0x1a, 0xce, 0xa6, 0x44, 0x50, 0xe4, 0x9c, 0x6c,
+		0xa5, 0x1f, 0x26, 0xa4, 0x4b, 0xff, 0x05, 0x9c, 0xf8, 0xf4, 0x43, 0x79, 0x6b, 0x26, 0xe2, 0x01,
+		0x44, 0x1f, 0x59, 0xc5, 0x6c, 0xb6, 0xa6, 0x88, 0x45, 0x77, 0x23, 0x82, 0xa3, 0x4f, 0x0e, 0xf5,
+		0x66, 0x46, 0x18, 0xdf, 0x62, 0x0b, 0xd4, 0x35, 0xa3, 0x38, 0x71, 0x66, 0xd3, 0xb2, 0xc9, 0x57,
+		0x78, 0x4e, 0x65, 0x95, 0xec, 0x98, 0xf6, 0xc9, 0x83, 0xb1, 0x62, 0xd7, 0xcd, 0x32, 0x5c, 0xf7,
+		0xbe, 0x8d, 0x4a, 0x66, 0xbf, 0xbb, 0x65, 0x42, 0x65, 0x95, 0x6e, 0xaf, 0xce, 0x05, 0x2b, 0x78,
+		0x5a, 0xca, 0xb4, 0x5d, 0x9a, 0xb0, 0x47, 0x97, 0x44, 0xb1, 0x7a, 0xb4, 0xec, 0xb7, 0xec, 0xfd,
+		0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x05, 0x69, 0xca, 0x6b, 0x03, 0x00, 0x00,
+	},
+}
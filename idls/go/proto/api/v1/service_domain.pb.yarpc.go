@@ -0,0 +1,634 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_domain.proto
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// DomainAPIYARPCClient is the YARPC client-side interface for the DomainAPI service.
+type DomainAPIYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+}
+
+func newDomainAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) DomainAPIYARPCClient {
+	return &_DomainAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.DomainAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewDomainAPIYARPCClient builds a new YARPC client for the DomainAPI service.
+func NewDomainAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) DomainAPIYARPCClient {
+	return newDomainAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// DomainAPIYARPCServer is the YARPC server-side interface for the DomainAPI service.
+type DomainAPIYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+}
+
+type buildDomainAPIYARPCProceduresParams struct {
+	Server      DomainAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildDomainAPIYARPCProcedures(params buildDomainAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_DomainAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.DomainAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RegisterDomain,
+							NewRequest:  newDomainAPIServiceRegisterDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeDomain,
+							NewRequest:  newDomainAPIServiceDescribeDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDomains,
+							NewRequest:  newDomainAPIServiceListDomainsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomain,
+							NewRequest:  newDomainAPIServiceUpdateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeprecateDomain,
+							NewRequest:  newDomainAPIServiceDeprecateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildDomainAPIYARPCProcedures prepares an implementation of the DomainAPI service for YARPC registration.
+func BuildDomainAPIYARPCProcedures(server DomainAPIYARPCServer) []transport.Procedure {
+	return buildDomainAPIYARPCProcedures(buildDomainAPIYARPCProceduresParams{Server: server})
+}
+
+// FxDomainAPIYARPCClientParams defines the input
+// for NewFxDomainAPIYARPCClient. It provides the
+// paramaters to get a DomainAPIYARPCClient in an
+// Fx application.
+type FxDomainAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxDomainAPIYARPCClientResult defines the output
+// of NewFxDomainAPIYARPCClient. It provides a
+// DomainAPIYARPCClient to an Fx application.
+type FxDomainAPIYARPCClientResult struct {
+	fx.Out
+
+	Client DomainAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxDomainAPIYARPCClient provides a DomainAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//  fx.Provide(
+//    apiv1.NewFxDomainAPIYARPCClient("service-name"),
+//    ...
+//  )
+func NewFxDomainAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxDomainAPIYARPCClientParams) FxDomainAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxDomainAPIYARPCClientResult{
+			Client: newDomainAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxDomainAPIYARPCProceduresParams defines the input
+// for NewFxDomainAPIYARPCProcedures. It provides the
+// paramaters to get DomainAPIYARPCServer procedures in an
+// Fx application.
+type FxDomainAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      DomainAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxDomainAPIYARPCProceduresResult defines the output
+// of NewFxDomainAPIYARPCProcedures. It provides
+// DomainAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxDomainAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxDomainAPIYARPCProcedures provides DomainAPIYARPCServer procedures to an Fx application.
+// It expects a DomainAPIYARPCServer to be present in the container.
+//
+//  fx.Provide(
+//    apiv1.NewFxDomainAPIYARPCProcedures(),
+//    ...
+//  )
+func NewFxDomainAPIYARPCProcedures() interface{} {
+	return func(params FxDomainAPIYARPCProceduresParams) FxDomainAPIYARPCProceduresResult {
+		return FxDomainAPIYARPCProceduresResult{
+			Procedures: buildDomainAPIYARPCProcedures(buildDomainAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.DomainAPI",
+				FileDescriptors: yarpcFileDescriptorClosure2e37d15268893114,
+			},
+		}
+	}
+}
+
+type _DomainAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_DomainAPIYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newDomainAPIServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newDomainAPIServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newDomainAPIServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newDomainAPIServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_DomainAPIYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newDomainAPIServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyDomainAPIServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _DomainAPIYARPCHandler struct {
+	server DomainAPIYARPCServer
+}
+
+func (h *_DomainAPIYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_DomainAPIYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyDomainAPIServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newDomainAPIServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newDomainAPIServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newDomainAPIServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newDomainAPIServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newDomainAPIServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newDomainAPIServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newDomainAPIServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newDomainAPIServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newDomainAPIServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newDomainAPIServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+var (
+	emptyDomainAPIServiceRegisterDomainYARPCRequest   = &RegisterDomainRequest{}
+	emptyDomainAPIServiceRegisterDomainYARPCResponse  = &RegisterDomainResponse{}
+	emptyDomainAPIServiceDescribeDomainYARPCRequest   = &DescribeDomainRequest{}
+	emptyDomainAPIServiceDescribeDomainYARPCResponse  = &DescribeDomainResponse{}
+	emptyDomainAPIServiceListDomainsYARPCRequest      = &ListDomainsRequest{}
+	emptyDomainAPIServiceListDomainsYARPCResponse     = &ListDomainsResponse{}
+	emptyDomainAPIServiceUpdateDomainYARPCRequest     = &UpdateDomainRequest{}
+	emptyDomainAPIServiceUpdateDomainYARPCResponse    = &UpdateDomainResponse{}
+	emptyDomainAPIServiceDeprecateDomainYARPCRequest  = &DeprecateDomainRequest{}
+	emptyDomainAPIServiceDeprecateDomainYARPCResponse = &DeprecateDomainResponse{}
+)
+
+var yarpcFileDescriptorClosure2e37d15268893114 = [][]byte{
+	// uber/cadence/api/v1/service_domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x6d, 0x53, 0xdb, 0x46,
+		0x10, 0x8e, 0x09, 0x10, 0xb3, 0x06, 0x03, 0xc7, 0x9b, 0xe2, 0xcc, 0xb4, 0x1e, 0x67, 0xda, 0xba,
+		0x69, 0x2b, 0x17, 0xa7, 0x6f, 0xd3, 0x7c, 0x02, 0x4c, 0x4a, 0xa7, 0x4d, 0xc6, 0x23, 0xc2, 0x4c,
+		0x5f, 0x3e, 0xa8, 0x27, 0x69, 0x31, 0x37, 0xc8, 0x3a, 0xf5, 0xee, 0x64, 0xe2, 0xfc, 0x90, 0x7e,
+		0xec, 0xef, 0xea, 0xcf, 0xe9, 0xe8, 0x74, 0x22, 0x7e, 0x91, 0xc1, 0x93, 0x90, 0x6f, 0xf2, 0xde,
+		0xb3, 0xcf, 0xee, 0xed, 0xee, 0xb3, 0xb2, 0xa0, 0x99, 0x78, 0x28, 0x5a, 0x3e, 0x0d, 0x30, 0xf2,
+		0xb1, 0x45, 0x63, 0xd6, 0x1a, 0xec, 0xb7, 0x24, 0x8a, 0x01, 0xf3, 0xd1, 0x0d, 0x78, 0x9f, 0xb2,
+		0xc8, 0x8e, 0x05, 0x57, 0x9c, 0x6c, 0xa5, 0x48, 0xdb, 0x20, 0x6d, 0x1a, 0x33, 0x7b, 0xb0, 0x5f,
+		0xfb, 0xa8, 0xc7, 0x79, 0x2f, 0xc4, 0x96, 0x86, 0x78, 0xc9, 0x79, 0x2b, 0x48, 0x04, 0x55, 0x8c,
+		0x1b, 0xa7, 0x5a, 0x7d, 0xf2, 0xfc, 0x9c, 0x61, 0x18, 0xb8, 0x7d, 0x2a, 0x2f, 0x73, 0x44, 0x51,
+		0x02, 0xa3, 0x81, 0x1b, 0xff, 0x2d, 0xc3, 0x8e, 0x83, 0x3d, 0x26, 0x15, 0x8a, 0x8e, 0x3e, 0x70,
+		0xf0, 0xef, 0x04, 0xa5, 0x22, 0x9f, 0x40, 0x55, 0xa2, 0x9f, 0x08, 0xa6, 0x86, 0xae, 0xe2, 0x97,
+		0x18, 0x59, 0xa5, 0x7a, 0xa9, 0xb9, 0xe2, 0xac, 0xe5, 0xd6, 0x57, 0xa9, 0x91, 0x10, 0x58, 0x8c,
+		0x68, 0x1f, 0xad, 0x05, 0x7d, 0xa8, 0x9f, 0x49, 0x1d, 0x2a, 0x01, 0x4a, 0x5f, 0xb0, 0x38, 0xcd,
+		0xd6, 0xba, 0xaf, 0x8f, 0x46, 0x4d, 0xe4, 0x63, 0xa8, 0xf0, 0xab, 0x08, 0x85, 0x8b, 0x7d, 0xca,
+		0x42, 0x6b, 0x51, 0x23, 0x40, 0x9b, 0x8e, 0x53, 0x0b, 0xb9, 0x80, 0xc7, 0x57, 0x5c, 0x5c, 0x9e,
+		0x87, 0xfc, 0xca, 0xc5, 0xd7, 0xe8, 0x27, 0xa9, 0x9b, 0x2b, 0x50, 0x61, 0xa4, 0x9f, 0x62, 0x14,
+		0x8c, 0x07, 0xd6, 0x52, 0xbd, 0xd4, 0xac, 0xb4, 0x1f, 0xda, 0x59, 0x25, 0xec, 0xbc, 0x12, 0x76,
+		0xc7, 0x54, 0xca, 0xa9, 0xe7, 0x2c, 0xc7, 0x39, 0x89, 0x93, 0x73, 0x74, 0x35, 0x05, 0xe9, 0x42,
+		0xd9, 0x0f, 0x93, 0xf4, 0xfe, 0xd2, 0x5a, 0xae, 0xdf, 0x6f, 0x56, 0xda, 0xdf, 0xd8, 0x05, 0xdd,
+		0xb0, 0x8f, 0x32, 0x90, 0x83, 0x71, 0xc8, 0x7c, 0x4d, 0x7e, 0xc4, 0xa3, 0x73, 0xd6, 0xcb, 0x23,
+		0x5d, 0xb3, 0x10, 0x1b, 0xb6, 0xa8, 0xaf, 0xd8, 0x00, 0x5d, 0x63, 0x72, 0x75, 0x85, 0x1e, 0xe8,
+		0x4b, 0x6e, 0x66, 0x47, 0x86, 0xed, 0x65, 0x5a, 0xae, 0x13, 0x58, 0x0c, 0xa8, 0xa2, 0x56, 0xf9,
+		0x86, 0xe8, 0x85, 0x3d, 0xb2, 0x3b, 0x54, 0xd1, 0xe3, 0x48, 0x89, 0xa1, 0xa3, 0x19, 0x48, 0x13,
+		0x36, 0x98, 0x74, 0x7b, 0x21, 0xf7, 0x68, 0x68, 0x06, 0xcc, 0x5a, 0xa9, 0x97, 0x9a, 0x65, 0xa7,
+		0xca, 0xe4, 0x4f, 0xda, 0x9c, 0x11, 0x90, 0x3f, 0x61, 0xef, 0x82, 0x49, 0xc5, 0xc5, 0xd0, 0xa5,
+		0xc2, 0xbf, 0x60, 0x03, 0x1a, 0xba, 0x52, 0x51, 0x95, 0x48, 0x0b, 0xea, 0xa5, 0x66, 0xb5, 0xfd,
+		0xb8, 0x30, 0x8d, 0x03, 0x83, 0x3d, 0xd5, 0x50, 0x67, 0xc7, 0x70, 0x8c, 0x9b, 0xc9, 0xd7, 0xb0,
+		0x3d, 0x45, 0x9e, 0x08, 0x66, 0x55, 0x74, 0x05, 0xc8, 0x84, 0xd3, 0x99, 0x60, 0x84, 0x42, 0x6d,
+		0xc0, 0x24, 0xf3, 0x58, 0x98, 0x8e, 0xdb, 0x64, 0x46, 0xab, 0xf3, 0x67, 0x64, 0xbd, 0xa5, 0x99,
+		0x48, 0xea, 0x3b, 0xd8, 0x2b, 0x0a, 0x91, 0xe6, 0xb5, 0xa6, 0xf3, 0xda, 0x99, 0x76, 0x3d, 0x13,
+		0xac, 0xf6, 0x3d, 0xac, 0x5c, 0x97, 0x99, 0x6c, 0xc0, 0xfd, 0x4b, 0x1c, 0x1a, 0x25, 0xa4, 0x8f,
+		0x64, 0x1b, 0x96, 0x06, 0x34, 0x4c, 0x72, 0x01, 0x64, 0x3f, 0x7e, 0x5c, 0xf8, 0xa1, 0xd4, 0xb0,
+		0x60, 0x77, 0xb2, 0x6b, 0x32, 0xe6, 0x91, 0xc4, 0xc6, 0xbf, 0x65, 0xd8, 0x3a, 0x8b, 0x03, 0xaa,
+		0xf0, 0xce, 0x24, 0xf7, 0x0c, 0x2a, 0x89, 0x66, 0xd4, 0xf2, 0xd7, 0x3d, 0xac, 0xb4, 0x6b, 0x53,
+		0xba, 0x78, 0x9e, 0x6e, 0x88, 0x17, 0x54, 0x5e, 0x3a, 0x90, 0xc1, 0xd3, 0xe7, 0x49, 0xbd, 0x56,
+		0x6e, 0xd5, 0xeb, 0xea, 0x94, 0x5e, 0x9f, 0x9b, 0x19, 0x5e, 0xd3, 0x33, 0xdc, 0x2e, 0x6c, 0x55,
+		0xc1, 0x95, 0xa7, 0x26, 0x78, 0x4e, 0xdd, 0x57, 0xdf, 0x5f, 0xf7, 0x47, 0xb0, 0xea, 0xd1, 0xc0,
+		0xf5, 0x58, 0x44, 0x05, 0x43, 0x69, 0xad, 0x6b, 0xca, 0x7a, 0x61, 0xe6, 0x87, 0x34, 0x38, 0x34,
+		0x38, 0xa7, 0xe2, 0xbd, 0xfd, 0x71, 0x93, 0x8c, 0x36, 0x3e, 0x98, 0x8c, 0x36, 0xdf, 0x51, 0x46,
+		0xe4, 0x03, 0xcb, 0x68, 0xeb, 0x06, 0x19, 0xcd, 0x5a, 0x8a, 0xdb, 0xb3, 0x96, 0xe2, 0xe8, 0x5a,
+		0xde, 0xb9, 0x93, 0xb5, 0xfc, 0x04, 0x36, 0x03, 0x0c, 0x51, 0xa1, 0x7b, 0xdd, 0xf7, 0xa1, 0xb5,
+		0xab, 0xe3, 0xaf, 0x67, 0x07, 0x79, 0x9b, 0x87, 0xa4, 0x03, 0x1b, 0xe7, 0x94, 0x85, 0x7c, 0x80,
+		0xc2, 0x55, 0xac, 0x8f, 0x3c, 0x51, 0xd6, 0xde, 0x6d, 0x33, 0xb7, 0x9e, 0xbb, 0xbc, 0xca, 0x3c,
+		0xde, 0x7d, 0x75, 0xfc, 0x02, 0xdb, 0xe3, 0x62, 0xc9, 0x16, 0x07, 0x79, 0x0a, 0xcb, 0x66, 0xab,
+		0x97, 0x74, 0x32, 0x8f, 0x0a, 0x4b, 0x62, 0x9c, 0x0c, 0xb4, 0x71, 0x0a, 0xbb, 0x1d, 0x8c, 0x05,
+		0xfa, 0x77, 0xb8, 0x6f, 0x1a, 0x0f, 0x61, 0x6f, 0x8a, 0xd4, 0x6c, 0xb7, 0x97, 0xb0, 0xd3, 0xd1,
+		0xab, 0xc3, 0x9b, 0x08, 0xb7, 0x01, 0x0b, 0x2c, 0xc8, 0x42, 0x9c, 0xdc, 0x73, 0x16, 0x58, 0x40,
+		0xb6, 0x47, 0x99, 0x4f, 0xee, 0x65, 0xdc, 0x87, 0x6b, 0xf9, 0x3a, 0xf2, 0xd0, 0xf5, 0x86, 0x8d,
+		0x17, 0x69, 0xfe, 0xe3, 0x7c, 0xef, 0x53, 0x8e, 0xdf, 0x81, 0xfc, 0xca, 0xa4, 0xca, 0xac, 0x32,
+		0xcf, 0xed, 0x11, 0xac, 0xc4, 0xb4, 0x87, 0xae, 0x64, 0x6f, 0x50, 0xb3, 0x2d, 0x39, 0xe5, 0xd4,
+		0x70, 0xca, 0xde, 0x20, 0xf9, 0x14, 0xd6, 0x23, 0x7c, 0xad, 0x5c, 0x8d, 0xc8, 0x0a, 0x95, 0x66,
+		0xbc, 0xea, 0xac, 0xa5, 0xe6, 0x2e, 0xed, 0xa1, 0x2e, 0x54, 0x43, 0xc1, 0xd6, 0x18, 0xb5, 0x49,
+		0xf3, 0x5b, 0x78, 0x90, 0xc5, 0x96, 0x56, 0x49, 0x4f, 0xf2, 0x8d, 0x79, 0xe6, 0xd8, 0x79, 0xa3,
+		0xb6, 0xff, 0x59, 0x84, 0x95, 0xcc, 0xf7, 0xa0, 0xfb, 0x33, 0x61, 0x50, 0x1d, 0x7f, 0xeb, 0x90,
+		0x27, 0xf3, 0xff, 0xa1, 0xa8, 0x7d, 0x31, 0x17, 0xd6, 0xdc, 0x8b, 0x41, 0x75, 0xbc, 0x31, 0x33,
+		0x42, 0x15, 0x4e, 0xc3, 0x8c, 0x50, 0x33, 0x3a, 0xfd, 0x17, 0x54, 0x46, 0x2a, 0x4b, 0x3e, 0x2b,
+		0xf4, 0x9d, 0x6e, 0x6b, 0xad, 0x79, 0x3b, 0xd0, 0x44, 0xf0, 0x61, 0x75, 0x54, 0x72, 0xa4, 0x39,
+		0xef, 0x2b, 0xac, 0xf6, 0xf9, 0x1c, 0x48, 0x13, 0x24, 0x84, 0xf5, 0x09, 0xd5, 0x90, 0x59, 0x65,
+		0x28, 0x12, 0x6c, 0xed, 0xcb, 0xf9, 0xc0, 0x59, 0xb4, 0xc3, 0xdf, 0x60, 0xcf, 0xe7, 0xfd, 0x22,
+		0x97, 0xc3, 0xf2, 0x41, 0xcc, 0xba, 0xe9, 0x02, 0xeb, 0x96, 0xfe, 0xd8, 0xef, 0x31, 0x75, 0x91,
+		0x78, 0xb6, 0xcf, 0xfb, 0xad, 0xd1, 0xef, 0x85, 0xaf, 0x58, 0x10, 0xb6, 0x7a, 0x3c, 0xfb, 0xbc,
+		0x30, 0x1f, 0x0f, 0xcf, 0x68, 0xcc, 0x06, 0xfb, 0xde, 0xb2, 0xb6, 0x3d, 0xfd, 0x3f, 0x00, 0x00,
+		0xff, 0xff, 0x48, 0x3b, 0x4c, 0x2e, 0xe1, 0x0c, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/field_mask.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0xcb, 0x4c, 0xcd,
+		0x49, 0x89, 0xcf, 0x4d, 0x2c, 0xce, 0xd6, 0x03, 0x8b, 0x09, 0xf1, 0x43, 0x54, 0xe8, 0xc1, 0x54,
+		0x28, 0x29, 0x72, 0x71, 0xba, 0x81, 0x14, 0xf9, 0x26, 0x16, 0x67, 0x0b, 0x89, 0x70, 0xb1, 0x16,
+		0x24, 0x96, 0x64, 0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x41, 0x38, 0x4e, 0xad, 0x8c,
+		0x5c, 0xc2, 0xc9, 0xf9, 0xb9, 0x7a, 0x68, 0x5a, 0x9d, 0xf8, 0xe0, 0x1a, 0x03, 0x40, 0x42, 0x01,
+		0x8c, 0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x08,
+		0xa7, 0x94, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x67, 0xe7, 0xe5, 0x97, 0xe7, 0x41, 0x9c, 0x05, 0x72,
+		0x55, 0x41, 0xd2, 0x0f, 0x46, 0xc6, 0x45, 0x4c, 0xcc, 0xee, 0x01, 0x4e, 0xab, 0x98, 0xe4, 0xdc,
+		0x21, 0xba, 0x03, 0xa0, 0x5a, 0xf4, 0xc2, 0x53, 0x73, 0x72, 0xbc, 0x41, 0x1a, 0x42, 0x40, 0x7a,
+		0x93, 0xd8, 0xc0, 0x66, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x94, 0x66, 0x94, 0x9d, 0xe6,
+		0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
+		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
+		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
+		0xc8, 0x6e, 0x30, 0x6c, 0x0f, 0x02, 0x2d, 0xd1, 0x36, 0x51, 0x59, 0x14, 0x28, 0xda, 0x69, 0xde,
+		0x86, 0x7d, 0xc4, 0x3e, 0x66, 0x8f, 0xfb, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb6, 0x90, 0xf4, 0x8d,
+		0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0xb9, 0x18, 0x13, 0xde, 0xf1, 0x71, 0x40,
+		0x22, 0x9f, 0x74, 0x70, 0x4c, 0x3b, 0xcb, 0xd3, 0x4e, 0xc0, 0xe6, 0x98, 0x46, 0x56, 0xcc, 0x99,
+		0x60, 0x68, 0x3f, 0xad, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0x2d, 0x4f, 0x5b, 0x5f, 0x4c, 0x19,
+		0x9b, 0x86, 0xa4, 0x23, 0x4b, 0xc6, 0x8b, 0x49, 0x27, 0x58, 0x70, 0x2c, 0x28, 0x53, 0xa0, 0xd6,
+		0xf1, 0x7a, 0x5e, 0xd0, 0x39, 0x49, 0x04, 0x9e, 0xc7, 0x59, 0xc1, 0xc9, 0x3f, 0x35, 0xd8, 0xb1,
+		0xe5, 0x31, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x9a, 0xa9, 0xb5, 0x77, 0xdd, 0x0a, 0x0d, 0x10, 0x82,
+		0xad, 0x08, 0xcf, 0x89, 0x51, 0x91, 0x11, 0xb9, 0x46, 0xaf, 0x61, 0x27, 0x11, 0x58, 0x2c, 0x12,
+		0xa3, 0x6a, 0x6a, 0xed, 0x46, 0xf7, 0x99, 0x55, 0xa2, 0xca, 0xca, 0x08, 0x87, 0xb2, 0xd0, 0x55,
+		0x00, 0x64, 0x82, 0x1e, 0x90, 0xc4, 0xe7, 0x34, 0x4e, 0xf5, 0x19, 0x5b, 0x92, 0x75, 0x35, 0x84,
+		0x8e, 0x41, 0x67, 0xd7, 0x11, 0xe1, 0x1e, 0x99, 0x63, 0x1a, 0x1a, 0xdb, 0xb2, 0x02, 0x64, 0xc8,
+		0x49, 0x23, 0xe8, 0x35, 0x6c, 0x05, 0x58, 0x60, 0x63, 0xc7, 0xac, 0xb6, 0xf5, 0xee, 0x8b, 0x7b,
+		0xce, 0xb6, 0x6c, 0x2c, 0xb0, 0x13, 0x09, 0x7e, 0xe3, 0x4a, 0x08, 0x9a, 0xc1, 0xf3, 0x6b, 0xc6,
+		0xdf, 0x4f, 0x42, 0x76, 0xed, 0x91, 0x0f, 0xc4, 0x5f, 0xa4, 0x27, 0x7a, 0x9c, 0x08, 0x12, 0xc9,
+		0x55, 0x4c, 0x38, 0x65, 0x81, 0xf1, 0xa9, 0xa9, 0xb5, 0xf5, 0xee, 0x91, 0x95, 0xd9, 0x66, 0xe5,
+		0xb6, 0x59, 0xb6, 0xb2, 0xd5, 0x35, 0x73, 0x16, 0x27, 0x27, 0x71, 0x73, 0x8e, 0x4b, 0x49, 0x81,
+		0xfa, 0x50, 0x1f, 0xe3, 0xc0, 0x1b, 0xd3, 0x08, 0x73, 0x4a, 0x12, 0xa3, 0x26, 0x29, 0xcd, 0x52,
+		0xb1, 0x67, 0x38, 0x38, 0x53, 0x75, 0xae, 0x3e, 0xbe, 0xdd, 0xa0, 0x3f, 0xe1, 0x70, 0x46, 0x13,
+		0xc1, 0xf8, 0x8d, 0x87, 0xb9, 0x3f, 0xa3, 0x4b, 0x1c, 0x7a, 0xca, 0xf8, 0x5d, 0x69, 0xfc, 0xf3,
+		0x52, 0xbe, 0x9e, 0xaa, 0x55, 0xd6, 0x1f, 0x28, 0x8e, 0xbb, 0x61, 0xf4, 0x35, 0x3c, 0xd9, 0x20,
+		0x5f, 0x70, 0x6a, 0x80, 0x34, 0x1c, 0xad, 0x81, 0xde, 0x71, 0x8a, 0x30, 0xb4, 0x96, 0x34, 0xa1,
+		0x63, 0x1a, 0x52, 0xb1, 0xa9, 0x48, 0xff, 0x78, 0x45, 0xc6, 0x2d, 0xcd, 0x9a, 0xa8, 0xef, 0xe0,
+		0xb0, 0xec, 0x88, 0x54, 0x57, 0x5d, 0xea, 0x3a, 0xd8, 0x84, 0xa6, 0xd2, 0x2c, 0xd8, 0xc7, 0xbe,
+		0xa0, 0x4b, 0xe2, 0xf9, 0xe1, 0x22, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71, 0x96,
+		0xea, 0x67, 0x99, 0x8b, 0xb4, 0x83, 0x2f, 0xa1, 0xa6, 0x0a, 0x13, 0xa3, 0x21, 0xfb, 0xe8, 0xdb,
+		0x52, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x7d, 0x16, 0x4d, 0xe8, 0x34, 0x6f,
+		0x84, 0x82, 0x05, 0x7d, 0x09, 0xcd, 0x09, 0xa6, 0x21, 0x5b, 0x12, 0xee, 0x2d, 0x09, 0x4f, 0xd2,
+		0xee, 0x7e, 0x64, 0x6a, 0xed, 0xaa, 0xfb, 0x28, 0x8f, 0x5f, 0x65, 0x61, 0xd4, 0x86, 0x26, 0x4d,
+		0xbc, 0x69, 0xc8, 0xc6, 0x38, 0xf4, 0xb2, 0xe9, 0x36, 0x9a, 0xa6, 0xd6, 0xae, 0xb9, 0x0d, 0x9a,
+		0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x20, 0xa5, 0xd1, 0x84, 0x19, 0x8f, 0x65, 0x1b,
+		0x95, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x88, 0x26, 0xcc, 0xad, 0x4f, 0x56, 0x76, 0xad, 0xef, 0x61,
+		0xb7, 0x18, 0x05, 0xd4, 0x84, 0xea, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5d, 0xa2, 0x27, 0xb0, 0xbd,
+		0xc4, 0xe1, 0x22, 0x1f, 0xf2, 0x6c, 0xf3, 0x43, 0xe5, 0x95, 0x76, 0x62, 0xc3, 0xf1, 0x03, 0x16,
+		0xa0, 0x67, 0x50, 0xbf, 0xe3, 0x79, 0xc6, 0xab, 0xfb, 0xb7, 0x6e, 0x9f, 0xfc, 0xab, 0x81, 0xbe,
+		0xd2, 0xe4, 0xe8, 0x17, 0xa8, 0x15, 0x83, 0xa1, 0x49, 0xf7, 0xad, 0x87, 0x06, 0xc3, 0xca, 0x17,
+		0xd9, 0x38, 0x17, 0xf8, 0x96, 0x07, 0x7b, 0x77, 0x52, 0x25, 0x8f, 0xf7, 0x6a, 0xf5, 0xf1, 0xf4,
+		0xee, 0xc9, 0xbd, 0x67, 0xdd, 0x48, 0xfb, 0x56, 0x2c, 0xf8, 0x5b, 0x83, 0xbd, 0x3b, 0x49, 0xf4,
+		0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x5a, 0x50, 0x63, 0x31, 0xe1, 0x58,
+		0x30, 0xae, 0x9c, 0x2c, 0xf6, 0xe8, 0x27, 0xa8, 0xfb, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbd, 0x7c,
+		0xe5, 0xc5, 0xa9, 0x77, 0x5b, 0x1b, 0x57, 0xcc, 0x28, 0xbf, 0x99, 0x5d, 0x5d, 0xd5, 0xa7, 0x91,
+		0x93, 0xff, 0x2a, 0x50, 0x5f, 0x7d, 0xbf, 0xa5, 0xed, 0xa6, 0x95, 0xb7, 0xdb, 0x08, 0x8c, 0xa2,
+		0x34, 0x11, 0x98, 0x0b, 0xaf, 0xb8, 0xfe, 0x95, 0x23, 0xf7, 0xc9, 0x78, 0x9a, 0x63, 0x87, 0x29,
+		0xb4, 0x88, 0xa3, 0x2b, 0x38, 0x2a, 0x58, 0xc9, 0x87, 0x98, 0x72, 0xb2, 0x42, 0xfb, 0xf0, 0xd3,
+		0x1d, 0xe6, 0x60, 0x47, 0x62, 0x6f, 0x79, 0xbb, 0x70, 0xe0, 0xb3, 0x79, 0x1c, 0x92, 0xd4, 0xaa,
+		0x64, 0x86, 0x79, 0xe0, 0xf9, 0x6c, 0x11, 0x09, 0xf9, 0xa9, 0xd8, 0x76, 0xf7, 0x8b, 0xe4, 0x30,
+		0xcd, 0xf5, 0xd3, 0x14, 0x7a, 0x01, 0x8d, 0x98, 0x44, 0x01, 0x8d, 0xa6, 0x19, 0x22, 0x31, 0xb6,
+		0xcd, 0x6a, 0x7b, 0xdb, 0xdd, 0x53, 0x51, 0x59, 0x9a, 0xbc, 0xfc, 0x4b, 0x83, 0xfa, 0xea, 0x47,
+		0x09, 0x1d, 0xc1, 0x81, 0xfd, 0xdb, 0xaf, 0xbd, 0xc1, 0x85, 0x37, 0x1c, 0xf5, 0x46, 0xef, 0x86,
+		0xde, 0xe0, 0xe2, 0xaa, 0x77, 0x3e, 0xb0, 0x9b, 0x9f, 0xa0, 0xcf, 0xc1, 0xb8, 0x9b, 0x72, 0x9d,
+		0xb7, 0x83, 0xe1, 0xc8, 0x71, 0x1d, 0xbb, 0xa9, 0x6d, 0x66, 0x6d, 0xe7, 0xd2, 0x75, 0xfa, 0xbd,
+		0x91, 0x63, 0x37, 0x2b, 0x9b, 0xb4, 0xb6, 0x73, 0xee, 0xa4, 0xa9, 0xea, 0xcb, 0x19, 0x34, 0xd6,
+		0x6e, 0xbc, 0xcf, 0xe0, 0xb0, 0xe7, 0xf6, 0x7f, 0x1e, 0x5c, 0xf5, 0xce, 0x4b, 0x55, 0xac, 0x27,
+		0xed, 0xc1, 0xb0, 0x77, 0x76, 0x2e, 0x55, 0x94, 0x40, 0x9d, 0x8b, 0x2c, 0x59, 0x39, 0xfb, 0x1d,
+		0x0e, 0x7d, 0x36, 0x2f, 0x6b, 0xf5, 0xb3, 0x5a, 0x2f, 0xa6, 0x97, 0xe9, 0x2b, 0xb9, 0xd4, 0xfe,
+		0x38, 0x9d, 0x52, 0x31, 0x5b, 0x8c, 0x2d, 0x9f, 0xcd, 0x3b, 0xab, 0x3f, 0x1f, 0x5f, 0xd1, 0x20,
+		0xec, 0x4c, 0x59, 0xf6, 0xcb, 0xa0, 0xfe, 0x44, 0x7e, 0xc4, 0x31, 0x5d, 0x9e, 0x8e, 0x77, 0x64,
+		0xec, 0x9b, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) DomainAPIYARPCClient {
+			return NewDomainAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -0,0 +1 @@
+ALTER TABLE executions_visibility ADD search_attributes JSON NULL;
@@ -310,6 +310,22 @@ func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.
 	return a.AdminHandler.RefreshWorkflowTasks(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) OperatePendingActivity(ctx context.Context, request *types.OperatePendingActivityRequest) error {
+	attr := &authorization.Attributes{
+		APIName:    "OperatePendingActivity",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.OperatePendingActivity(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "RemoveTask",
@@ -374,6 +390,38 @@ func (a *AccessControlledWorkflowAdminHandler) GetCrossClusterTasks(ctx context.
 	return a.AdminHandler.GetCrossClusterTasks(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) GetReplicationStatus(ctx context.Context, request *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetReplicationStatus",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetReplicationStatus(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) GetFailoverReadiness(ctx context.Context, request *types.GetFailoverReadinessRequest) (*types.GetFailoverReadinessResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetFailoverReadiness",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetFailoverReadiness(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) GetDynamicConfig(ctx context.Context, request *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error) {
 	attr := &authorization.Attributes{
 		APIName:    "GetDynamicConfig",
@@ -438,6 +486,22 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) MoveTaskList(ctx context.Context, request *types.MoveTaskListRequest) (*types.MoveTaskListResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "MoveTaskList",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.MoveTaskList(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
@@ -721,6 +721,54 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	return a.frontendHandler.TerminateWorkflowExecution(ctx, request)
 }
 
+// PauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "PauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UnpauseWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
 // ListTaskListPartitions API call
 func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	ctx context.Context,
@@ -121,6 +121,11 @@ func (g adminGRPCHandler) RefreshWorkflowTasks(ctx context.Context, request *adm
 	return &adminv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g adminGRPCHandler) OperatePendingActivity(ctx context.Context, request *adminv1.OperatePendingActivityRequest) (*adminv1.OperatePendingActivityResponse, error) {
+	err := g.h.OperatePendingActivity(ctx, proto.ToAdminOperatePendingActivityRequest(request))
+	return &adminv1.OperatePendingActivityResponse{}, proto.FromError(err)
+}
+
 func (g adminGRPCHandler) RemoveTask(ctx context.Context, request *adminv1.RemoveTaskRequest) (*adminv1.RemoveTaskResponse, error) {
 	err := g.h.RemoveTask(ctx, proto.ToAdminRemoveTaskRequest(request))
 	return &adminv1.RemoveTaskResponse{}, proto.FromError(err)
@@ -141,6 +146,16 @@ func (g adminGRPCHandler) GetCrossClusterTasks(ctx context.Context, request *adm
 	return proto.FromAdminGetCrossClusterTasksResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) GetReplicationStatus(ctx context.Context, request *adminv1.GetReplicationStatusRequest) (*adminv1.GetReplicationStatusResponse, error) {
+	response, err := g.h.GetReplicationStatus(ctx, proto.ToAdminGetReplicationStatusRequest(request))
+	return proto.FromAdminGetReplicationStatusResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) GetFailoverReadiness(ctx context.Context, request *adminv1.GetFailoverReadinessRequest) (*adminv1.GetFailoverReadinessResponse, error) {
+	response, err := g.h.GetFailoverReadiness(ctx, proto.ToAdminGetFailoverReadinessRequest(request))
+	return proto.FromAdminGetFailoverReadinessResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) RespondCrossClusterTasksCompleted(ctx context.Context, request *adminv1.RespondCrossClusterTasksCompletedRequest) (*adminv1.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := g.h.RespondCrossClusterTasksCompleted(ctx, proto.ToAdminRespondCrossClusterTasksCompletedRequest(request))
 	return proto.FromAdminRespondCrossClusterTasksCompletedResponse(response), proto.FromError(err)
@@ -165,3 +180,8 @@ func (g adminGRPCHandler) ListDynamicConfig(ctx context.Context, request *adminv
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToListDynamicConfigRequest(request))
 	return proto.FromListDynamicConfigResponse(response), proto.FromError(err)
 }
+
+func (g adminGRPCHandler) MoveTaskList(ctx context.Context, request *adminv1.MoveTaskListRequest) (*adminv1.MoveTaskListResponse, error) {
+	response, err := g.h.MoveTaskList(ctx, proto.ToMoveTaskListRequest(request))
+	return proto.FromMoveTaskListResponse(response), proto.FromError(err)
+}
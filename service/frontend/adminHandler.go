@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -54,11 +55,16 @@ var _ AdminHandler = (*adminHandlerImpl)(nil)
 
 const (
 	endMessageID int64 = 1<<63 - 1
+
+	defaultMaxReplicationLagInSeconds      = 60
+	defaultReplicationErrorWindowInSeconds = 600
 )
 
 var (
 	errMaxMessageIDNotSet = &types.BadRequestError{Message: "Max messageID is not set."}
 	errInvalidFilters     = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errOperationNotSet    = &types.BadRequestError{Message: "Operation is not set on request."}
+	errNegativeTimeout    = &types.BadRequestError{Message: "A valid timeout may not be negative."}
 )
 
 type (
@@ -82,15 +88,19 @@ type (
 		ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+		OperatePendingActivity(context.Context, *types.OperatePendingActivityRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
+		GetReplicationStatus(context.Context, *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error)
+		GetFailoverReadiness(context.Context, *types.GetFailoverReadinessRequest) (*types.GetFailoverReadinessResponse, error)
 		RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 		GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error)
 		UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest) error
 		RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
+		MoveTaskList(context.Context, *types.MoveTaskListRequest) (*types.MoveTaskListResponse, error)
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -101,6 +111,7 @@ type (
 		params                *resource.Params
 		config                *Config
 		domainDLQHandler      domain.DLQMessageHandler
+		clusterSettings       domain.ClusterSettingsHandler
 		domainFailoverWatcher domain.FailoverWatcher
 		eventSerializer       persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
@@ -131,16 +142,35 @@ func NewAdminHandler(
 	config *Config,
 ) AdminHandler {
 
-	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
+	var esIndex string
+	if params.ESConfig != nil {
+		esIndex = params.ESConfig.GetVisibilityIndex()
+	}
+	clusterSettings := domain.NewClusterSettingsHandler(
+		resource.GetClusterMetadata(),
+		params.DynamicConfig,
 		resource.GetDomainManager(),
-		resource.GetTimeSource(),
+		resource.GetDomainReplicationQueue(),
+		params.ESClient,
+		esIndex,
+		config.EnableClusterSettingsReplication,
+		config.ReplicatedDynamicConfigKeys,
 		resource.GetLogger(),
 	)
+	domainReplicationTaskExecutor := domain.NewClusterSettingsReplicationTaskExecutor(
+		domain.NewReplicationTaskExecutor(
+			resource.GetDomainManager(),
+			resource.GetTimeSource(),
+			resource.GetLogger(),
+		),
+		clusterSettings,
+	)
 	return &adminHandlerImpl{
 		Resource:              resource,
 		numberOfHistoryShards: params.PersistenceConfig.NumHistoryShards,
 		params:                params,
 		config:                config,
+		clusterSettings:       clusterSettings,
 		domainDLQHandler: domain.NewDLQMessageHandler(
 			domainReplicationTaskExecutor,
 			resource.GetDomainReplicationQueue(),
@@ -213,7 +243,7 @@ func (adh *adminHandlerImpl) AddSearchAttribute(
 			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", keyName)}, scope)
 		}
 		if currValType, exist := currentValidAttr[keyName]; exist {
-			if currValType != int(valueType) {
+			if currType, ok := common.ConvertIndexedValueTypeToInternalType(currValType); !ok || currType != valueType {
 				return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Key [%s] is already whitelisted as a different type", keyName)}, scope)
 			}
 			adh.GetLogger().Warn("Adding a search attribute that is already existing in dynamicconfig, it's probably a noop if ElasticSearch is already added. Here will re-do it on ElasticSearch.")
@@ -230,7 +260,7 @@ func (adh *adminHandlerImpl) AddSearchAttribute(
 	// update elasticsearch mapping, new added field will not be able to remove or update
 	index := adh.params.ESConfig.GetVisibilityIndex()
 	for k, v := range searchAttr {
-		valueType := convertIndexedValueTypeToESDataType(v)
+		valueType := elasticsearch.ToESDataType(v)
 		if len(valueType) == 0 {
 			return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}, scope)
 		}
@@ -247,6 +277,12 @@ func (adh *adminHandlerImpl) AddSearchAttribute(
 		}
 	}
 
+	if adh.clusterSettings.IsReplicated(dynamicconfig.ValidSearchAttributes) {
+		if err := adh.clusterSettings.Replicate(ctx, dynamicconfig.ValidSearchAttributes, searchAttr); err != nil {
+			return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to replicate search attributes, err: %v", err)}, scope)
+		}
+	}
+
 	return nil
 }
 
@@ -629,6 +665,7 @@ func (adh *adminHandlerImpl) DescribeCluster(
 			"visibilityStore": &visibilityStoreInfo,
 			"historyStore":    &historyStoreInfo,
 		},
+		PersistenceHealth: adh.GetPersistenceHealthTracker().GetPersistenceHealth(),
 	}, nil
 }
 
@@ -976,6 +1013,57 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// OperatePendingActivity updates, retries, fails or skips a pending activity of a workflow execution
+func (adh *adminHandlerImpl) OperatePendingActivity(
+	ctx context.Context,
+	request *types.OperatePendingActivityRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminOperatePendingActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return adh.error(errActivityIDNotSet, scope)
+	}
+	if request.Operation == nil {
+		return adh.error(errOperationNotSet, scope)
+	}
+	if request.GetOperation() == types.PendingActivityOperationUpdateOptions {
+		for _, timeout := range []*int32{
+			request.ScheduleToStartTimeoutSeconds,
+			request.ScheduleToCloseTimeoutSeconds,
+			request.StartToCloseTimeoutSeconds,
+			request.HeartbeatTimeoutSeconds,
+		} {
+			if timeout != nil && *timeout < 0 {
+				return adh.error(errNegativeTimeout, scope)
+			}
+		}
+		if err := common.ValidateRetryPolicy(request.RetryPolicy); err != nil {
+			return adh.error(err, scope)
+		}
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().OperatePendingActivity(ctx, &types.HistoryOperatePendingActivityRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1033,6 +1121,232 @@ func (adh *adminHandlerImpl) GetCrossClusterTasks(
 	return resp, nil
 }
 
+// GetFailoverReadiness checks whether the current cluster is caught up with the active cluster of
+// a global domain, so that failing the domain over to the current cluster does not force conflict
+// resolution. It checks the replication lag of every shard, the replication DLQ of the domain and
+// its recent replication errors.
+func (adh *adminHandlerImpl) GetFailoverReadiness(
+	ctx context.Context,
+	request *types.GetFailoverReadinessRequest,
+) (resp *types.GetFailoverReadinessResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetFailoverReadinessScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf(
+			"Domain %v is not a global domain.", request.GetDomain())}, scope)
+	}
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	sourceCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	if sourceCluster == currentCluster {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf(
+			"Domain %v is already active in cluster %v.", request.GetDomain(), currentCluster)}, scope)
+	}
+	isReplicated := false
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		isReplicated = isReplicated || cluster.ClusterName == currentCluster
+	}
+	if !isReplicated {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf(
+			"Domain %v is not replicated to cluster %v.", request.GetDomain(), currentCluster)}, scope)
+	}
+
+	maxLagInSeconds := int32(defaultMaxReplicationLagInSeconds)
+	if request.MaxReplicationLagInSeconds != nil {
+		maxLagInSeconds = request.GetMaxReplicationLagInSeconds()
+	}
+	errorWindowInSeconds := int32(defaultReplicationErrorWindowInSeconds)
+	if request.ReplicationErrorWindowInSeconds != nil {
+		errorWindowInSeconds = request.GetReplicationErrorWindowInSeconds()
+	}
+
+	shardIDs := make([]int32, adh.config.NumHistoryShards)
+	for i := range shardIDs {
+		shardIDs[i] = int32(i)
+	}
+	shards, err := adh.GetHistoryClient().GetReplicationReadiness(ctx, &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      shardIDs,
+		SourceCluster: sourceCluster,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp = domain.CheckFailoverReadiness(
+		domainEntry.GetInfo().ID,
+		shards,
+		adh.GetTimeSource().Now(),
+		domain.FailoverReadinessOptions{
+			MaxLag:      time.Duration(maxLagInSeconds) * time.Second,
+			ErrorWindow: time.Duration(errorWindowInSeconds) * time.Second,
+		},
+	)
+	resp.Cluster = currentCluster
+	resp.SourceCluster = sourceCluster
+	return resp, nil
+}
+
+// GetReplicationStatus returns the replication status of shards between two clusters.
+// When called on the source cluster, the outbound queue status of the source is combined
+// with the DLQ and processor status read from the target cluster.
+func (adh *adminHandlerImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+) (resp *types.GetReplicationStatusResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetReplicationStatusScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.TargetCluster == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	sourceCluster := request.SourceCluster
+	if sourceCluster == "" {
+		sourceCluster = currentCluster
+	}
+	if sourceCluster == request.TargetCluster {
+		return nil, adh.error(&types.BadRequestError{Message: "Source and target cluster must be different."}, scope)
+	}
+
+	remoteCluster := request.TargetCluster
+	switch currentCluster {
+	case sourceCluster:
+	case request.TargetCluster:
+		remoteCluster = sourceCluster
+	default:
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf(
+			"Current cluster %v is neither the source nor the target cluster.", currentCluster)}, scope)
+	}
+
+	shardIDs := request.ShardIDs
+	if len(shardIDs) == 0 {
+		shardIDs = make([]int32, adh.config.NumHistoryShards)
+		for i := range shardIDs {
+			shardIDs[i] = int32(i)
+		}
+	}
+
+	resp, err = adh.GetHistoryClient().GetReplicationStatus(ctx, &types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      shardIDs,
+		RemoteCluster: remoteCluster,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if currentCluster == request.TargetCluster {
+		// only the inbound side of the replication is known to the target cluster
+		for _, status := range resp.StatusByShard {
+			status.AckLevel = 0
+			status.LastTaskID = 0
+			domains := status.Domains[:0]
+			for _, domainStatus := range status.Domains {
+				if domainStatus.DLQSize > 0 {
+					domainStatus.Backlog = 0
+					domainStatus.OldestTaskTimestamp = nil
+					domains = append(domains, domainStatus)
+				}
+			}
+			status.Domains = domains
+		}
+		return resp, nil
+	}
+
+	remoteResp, err := adh.GetRemoteAdminClient(request.TargetCluster).GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		SourceCluster: sourceCluster,
+		TargetCluster: request.TargetCluster,
+		ShardIDs:      shardIDs,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return mergeReplicationStatus(resp, remoteResp), nil
+}
+
+// mergeReplicationStatus combines the outbound status read from the source cluster
+// with the inbound status read from the target cluster
+func mergeReplicationStatus(
+	source *types.GetReplicationStatusResponse,
+	target *types.GetReplicationStatusResponse,
+) *types.GetReplicationStatusResponse {
+	result := &types.GetReplicationStatusResponse{
+		StatusByShard:      make(map[int32]*types.ReplicationShardStatus),
+		FailedCauseByShard: make(map[int32]types.GetTaskFailedCause),
+	}
+	for shardID, cause := range source.GetFailedCauseByShard() {
+		result.FailedCauseByShard[shardID] = cause
+	}
+	for shardID, cause := range target.GetFailedCauseByShard() {
+		result.FailedCauseByShard[shardID] = cause
+	}
+
+	for shardID, sourceStatus := range source.GetStatusByShard() {
+		if _, ok := result.FailedCauseByShard[shardID]; ok {
+			continue
+		}
+		targetStatus, ok := target.GetStatusByShard()[shardID]
+		if !ok {
+			continue
+		}
+
+		domains := make(map[string]*types.ReplicationDomainStatus)
+		for _, domainStatus := range sourceStatus.Domains {
+			domains[domainStatus.DomainID] = &types.ReplicationDomainStatus{
+				DomainID:            domainStatus.DomainID,
+				Domain:              domainStatus.Domain,
+				Backlog:             domainStatus.Backlog,
+				OldestTaskTimestamp: domainStatus.OldestTaskTimestamp,
+			}
+		}
+		for _, domainStatus := range targetStatus.Domains {
+			merged, ok := domains[domainStatus.DomainID]
+			if !ok {
+				merged = &types.ReplicationDomainStatus{
+					DomainID: domainStatus.DomainID,
+					Domain:   domainStatus.Domain,
+				}
+				domains[domainStatus.DomainID] = merged
+			}
+			merged.DLQSize = domainStatus.DLQSize
+		}
+
+		status := &types.ReplicationShardStatus{
+			ShardID:                 shardID,
+			AckLevel:                sourceStatus.AckLevel,
+			LastTaskID:              sourceStatus.LastTaskID,
+			ReplicatedUpToTimestamp: targetStatus.ReplicatedUpToTimestamp,
+			Domains:                 make([]*types.ReplicationDomainStatus, 0, len(domains)),
+		}
+		for _, domainStatus := range domains {
+			status.Domains = append(status.Domains, domainStatus)
+		}
+		sort.Slice(status.Domains, func(i, j int) bool {
+			return status.Domains[i].DomainID < status.Domains[j].DomainID
+		})
+		result.StatusByShard[shardID] = status
+	}
+	return result
+}
+
 func (adh *adminHandlerImpl) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -1241,25 +1555,6 @@ func (adh *adminHandlerImpl) error(err error, scope metrics.Scope) error {
 	}
 }
 
-func convertIndexedValueTypeToESDataType(valueType types.IndexedValueType) string {
-	switch valueType {
-	case types.IndexedValueTypeString:
-		return "text"
-	case types.IndexedValueTypeKeyword:
-		return "keyword"
-	case types.IndexedValueTypeInt:
-		return "long"
-	case types.IndexedValueTypeDouble:
-		return "double"
-	case types.IndexedValueTypeBool:
-		return "boolean"
-	case types.IndexedValueTypeDatetime:
-		return "date"
-	default:
-		return ""
-	}
-}
-
 func serializeRawHistoryToken(token *getWorkflowRawHistoryV2Token) ([]byte, error) {
 	if token == nil {
 		return nil, nil
@@ -1330,7 +1625,17 @@ func (adh *adminHandlerImpl) UpdateDynamicConfig(ctx context.Context, request *t
 		return adh.error(err, scope)
 	}
 
-	return adh.params.DynamicConfig.UpdateValue(keyVal, request.ConfigValues)
+	if err := adh.params.DynamicConfig.UpdateValue(keyVal, request.ConfigValues); err != nil {
+		return err
+	}
+
+	// the search attribute whitelist is replicated by AddSearchAttribute as the added attributes
+	if keyVal != dc.ValidSearchAttributes && adh.clusterSettings.IsReplicated(keyVal) {
+		if err := adh.clusterSettings.Replicate(ctx, keyVal, request.ConfigValues); err != nil {
+			return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to replicate dynamic config, err: %v", err)}, scope)
+		}
+	}
+	return nil
 }
 
 func (adh *adminHandlerImpl) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest) (retError error) {
@@ -1357,7 +1662,16 @@ func (adh *adminHandlerImpl) RestoreDynamicConfig(ctx context.Context, request *
 			return adh.error(errInvalidFilters, scope)
 		}
 	}
-	return adh.params.DynamicConfig.RestoreValue(keyVal, filters)
+	if err := adh.params.DynamicConfig.RestoreValue(keyVal, filters); err != nil {
+		return err
+	}
+
+	if keyVal != dc.ValidSearchAttributes && adh.clusterSettings.IsReplicated(keyVal) {
+		if err := adh.clusterSettings.ReplicateRestore(ctx, keyVal, filters); err != nil {
+			return adh.error(&types.InternalServiceError{Message: fmt.Sprintf("Failed to replicate dynamic config, err: %v", err)}, scope)
+		}
+	}
+	return nil
 }
 
 func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest) (_ *types.ListDynamicConfigResponse, retError error) {
@@ -1391,6 +1705,43 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	}, nil
 }
 
+// MoveTaskList moves a batch of backlog tasks of all partitions of a task list to another task list.
+// The request is routed to the owner of the root partition, which moves the other partitions through
+// their owners. If the move stops early, the failure is set in the response next to the counts of the moved tasks.
+func (adh *adminHandlerImpl) MoveTaskList(
+	ctx context.Context,
+	request *types.MoveTaskListRequest,
+) (_ *types.MoveTaskListResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminMoveTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetSourceTaskList().GetName() == "" || request.GetTargetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().MoveTaskList(ctx, &types.MatchingMoveTaskListRequest{
+		DomainUUID:  domainID,
+		MoveRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func checkValidKey(keyName string) (dynamicconfig.Key, error) {
 	keyVal, ok := dynamicconfig.KeyNames[keyName]
 	if !ok || keyVal == dynamicconfig.UnknownKey {
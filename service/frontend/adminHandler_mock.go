@@ -317,6 +317,20 @@ func (mr *MockAdminHandlerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminHandler)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// OperatePendingActivity mocks base method
+func (m *MockAdminHandler) OperatePendingActivity(arg0 context.Context, arg1 *types.OperatePendingActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperatePendingActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OperatePendingActivity indicates an expected call of OperatePendingActivity
+func (mr *MockAdminHandlerMockRecorder) OperatePendingActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatePendingActivity", reflect.TypeOf((*MockAdminHandler)(nil).OperatePendingActivity), arg0, arg1)
+}
+
 // RemoveTask mocks base method
 func (m *MockAdminHandler) RemoveTask(arg0 context.Context, arg1 *types.RemoveTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -374,6 +388,36 @@ func (mr *MockAdminHandlerMockRecorder) GetCrossClusterTasks(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockAdminHandler)(nil).GetCrossClusterTasks), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method
+func (m *MockAdminHandler) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus
+func (mr *MockAdminHandlerMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminHandler)(nil).GetReplicationStatus), arg0, arg1)
+}
+
+// GetFailoverReadiness mocks base method
+func (m *MockAdminHandler) GetFailoverReadiness(arg0 context.Context, arg1 *types.GetFailoverReadinessRequest) (*types.GetFailoverReadinessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailoverReadiness", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetFailoverReadinessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailoverReadiness indicates an expected call of GetFailoverReadiness
+func (mr *MockAdminHandlerMockRecorder) GetFailoverReadiness(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailoverReadiness", reflect.TypeOf((*MockAdminHandler)(nil).GetFailoverReadiness), arg0, arg1)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method
 func (m *MockAdminHandler) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -446,3 +490,18 @@ func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
+
+// MoveTaskList mocks base method
+func (m *MockAdminHandler) MoveTaskList(arg0 context.Context, arg1 *types.MoveTaskListRequest) (*types.MoveTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskList indicates an expected call of MoveTaskList
+func (mr *MockAdminHandlerMockRecorder) MoveTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskList", reflect.TypeOf((*MockAdminHandler)(nil).MoveTaskList), arg0, arg1)
+}
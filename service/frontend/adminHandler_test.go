@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -36,8 +37,10 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
 	"github.com/uber/cadence/common/metrics"
@@ -102,46 +105,6 @@ func (s *adminHandlerSuite) TearDownTest() {
 	s.handler.Stop()
 }
 
-func (s *adminHandlerSuite) Test_ConvertIndexedValueTypeToESDataType() {
-	tests := []struct {
-		input    types.IndexedValueType
-		expected string
-	}{
-		{
-			input:    types.IndexedValueTypeString,
-			expected: "text",
-		},
-		{
-			input:    types.IndexedValueTypeKeyword,
-			expected: "keyword",
-		},
-		{
-			input:    types.IndexedValueTypeInt,
-			expected: "long",
-		},
-		{
-			input:    types.IndexedValueTypeDouble,
-			expected: "double",
-		},
-		{
-			input:    types.IndexedValueTypeBool,
-			expected: "boolean",
-		},
-		{
-			input:    types.IndexedValueTypeDatetime,
-			expected: "date",
-		},
-		{
-			input:    types.IndexedValueType(-1),
-			expected: "",
-		},
-	}
-
-	for _, test := range tests {
-		s.Equal(test.expected, convertIndexedValueTypeToESDataType(test.input))
-	}
-}
-
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FailedOnInvalidWorkflowID() {
 
 	ctx := context.Background()
@@ -492,7 +455,7 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 			Name: "key already whitelisted",
 			Request: &types.AddSearchAttributeRequest{
 				SearchAttribute: map[string]types.IndexedValueType{
-					"testkey": 1,
+					"testkey": types.IndexedValueTypeInt,
 				},
 			},
 			Expected: &types.BadRequestError{Message: "Key [testkey] is already whitelisted as a different type"},
@@ -672,3 +635,197 @@ func (s *adminHandlerSuite) Test_GetDynamicConfig_FilterMatch() {
 	s.NoError(err)
 	s.Equal(resp.Value.Data, encTrue)
 }
+
+func (s *adminHandlerSuite) newGlobalDomainEntry(activeCluster string) *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	)
+}
+
+func (s *adminHandlerSuite) Test_GetFailoverReadiness() {
+	ctx := context.Background()
+	s.handler.config.NumHistoryShards = 1
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestAlternativeClusterName).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(s.newGlobalDomainEntry(cluster.TestCurrentClusterName), nil).Times(1)
+	s.mockHistoryClient.EXPECT().GetReplicationReadiness(gomock.Any(), &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      []int32{0},
+		SourceCluster: cluster.TestCurrentClusterName,
+	}).Return(&types.GetReplicationReadinessResponse{
+		ReadinessByShard: map[int32]*types.ReplicationShardReadiness{
+			0: {
+				ShardID: 0,
+				ProcessorState: &types.ReplicationProcessorState{
+					ReplicatedUpToTimestamp: common.Int64Ptr(time.Now().Add(-time.Minute).UnixNano()),
+				},
+				DLQSizeByDomain: map[string]int64{s.domainID: 2},
+			},
+		},
+	}, nil).Times(1)
+
+	resp, err := s.handler.GetFailoverReadiness(ctx, &types.GetFailoverReadinessRequest{
+		Domain:                     s.domainName,
+		MaxReplicationLagInSeconds: common.Int32Ptr(120),
+	})
+	s.NoError(err)
+	s.Equal(s.domainID, resp.DomainID)
+	s.Equal(cluster.TestAlternativeClusterName, resp.Cluster)
+	s.Equal(cluster.TestCurrentClusterName, resp.SourceCluster)
+	s.False(resp.Ready)
+	s.Equal([]*types.FailoverReadinessCheck{
+		{Name: domain.FailoverReadinessCheckLag, Passed: true, Message: "all 1 shards replicated within 2m0s"},
+		{Name: domain.FailoverReadinessCheckDLQ, Passed: false, Message: "2 replication tasks in DLQ"},
+		{Name: domain.FailoverReadinessCheckErrors, Passed: true, Message: "no failure in the last 10m0s"},
+	}, resp.Checks)
+}
+
+func (s *adminHandlerSuite) Test_GetFailoverReadiness_AlreadyActive() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(s.newGlobalDomainEntry(cluster.TestCurrentClusterName), nil).Times(1)
+
+	_, err := s.handler.GetFailoverReadiness(ctx, &types.GetFailoverReadinessRequest{Domain: s.domainName})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.handler.GetFailoverReadiness(ctx, &types.GetFailoverReadinessRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationStatus_Source() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      []int32{0, 1, 2},
+		RemoteCluster: cluster.TestAlternativeClusterName,
+	}).Return(&types.GetReplicationStatusResponse{
+		StatusByShard: map[int32]*types.ReplicationShardStatus{
+			0: {
+				ShardID:    0,
+				AckLevel:   10,
+				LastTaskID: 20,
+				Domains: []*types.ReplicationDomainStatus{
+					{DomainID: "domain-b", Domain: "b", Backlog: 5, OldestTaskTimestamp: common.Int64Ptr(100)},
+				},
+			},
+			1: {ShardID: 1, AckLevel: 30, LastTaskID: 30},
+		},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{
+			2: types.GetTaskFailedCauseShardOwnershipLost,
+		},
+	}, nil).Times(1)
+	s.mockResource.RemoteAdminClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+		SourceCluster: cluster.TestCurrentClusterName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+		ShardIDs:      []int32{0, 1, 2},
+	}).Return(&types.GetReplicationStatusResponse{
+		StatusByShard: map[int32]*types.ReplicationShardStatus{
+			0: {
+				ShardID:                 0,
+				ReplicatedUpToTimestamp: common.Int64Ptr(90),
+				Domains: []*types.ReplicationDomainStatus{
+					{DomainID: "domain-a", Domain: "a", DLQSize: 3},
+					{DomainID: "domain-b", Domain: "b", DLQSize: 1},
+				},
+			},
+			2: {ShardID: 2},
+		},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{
+			1: types.GetTaskFailedCauseServiceBusy,
+		},
+	}, nil).Times(1)
+
+	resp, err := s.handler.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+		ShardIDs:      []int32{0, 1, 2},
+	})
+	s.NoError(err)
+	s.Equal(&types.GetReplicationStatusResponse{
+		StatusByShard: map[int32]*types.ReplicationShardStatus{
+			0: {
+				ShardID:                 0,
+				AckLevel:                10,
+				LastTaskID:              20,
+				ReplicatedUpToTimestamp: common.Int64Ptr(90),
+				Domains: []*types.ReplicationDomainStatus{
+					{DomainID: "domain-a", Domain: "a", DLQSize: 3},
+					{DomainID: "domain-b", Domain: "b", Backlog: 5, OldestTaskTimestamp: common.Int64Ptr(100), DLQSize: 1},
+				},
+			},
+		},
+		FailedCauseByShard: map[int32]types.GetTaskFailedCause{
+			1: types.GetTaskFailedCauseServiceBusy,
+			2: types.GetTaskFailedCauseShardOwnershipLost,
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationStatus_Target() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestAlternativeClusterName).AnyTimes()
+
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.HistoryGetReplicationStatusRequest{
+		ShardIDs:      []int32{0},
+		RemoteCluster: cluster.TestCurrentClusterName,
+	}).Return(&types.GetReplicationStatusResponse{
+		StatusByShard: map[int32]*types.ReplicationShardStatus{
+			0: {
+				ShardID:                 0,
+				AckLevel:                10,
+				LastTaskID:              20,
+				ReplicatedUpToTimestamp: common.Int64Ptr(90),
+				Domains: []*types.ReplicationDomainStatus{
+					{DomainID: "domain-a", Domain: "a", Backlog: 2, DLQSize: 3},
+					{DomainID: "domain-b", Domain: "b", Backlog: 5},
+				},
+			},
+		},
+	}, nil).Times(1)
+
+	resp, err := s.handler.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		SourceCluster: cluster.TestCurrentClusterName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+		ShardIDs:      []int32{0},
+	})
+	s.NoError(err)
+	s.Equal(&types.GetReplicationStatusResponse{
+		StatusByShard: map[int32]*types.ReplicationShardStatus{
+			0: {
+				ShardID:                 0,
+				ReplicatedUpToTimestamp: common.Int64Ptr(90),
+				Domains: []*types.ReplicationDomainStatus{
+					{DomainID: "domain-a", Domain: "a", DLQSize: 3},
+				},
+			},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationStatus_InvalidCluster() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	_, err := s.handler.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{})
+	s.Error(err)
+
+	_, err = s.handler.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+	})
+	s.Error(err)
+
+	_, err = s.handler.GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		SourceCluster: cluster.TestAlternativeClusterName,
+		TargetCluster: "some other cluster",
+	})
+	s.Error(err)
+}
@@ -142,6 +142,12 @@ func (t AdminThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *s
 	return thrift.FromError(err)
 }
 
+// OperatePendingActivity forwards request to the underlying handler
+func (t AdminThriftHandler) OperatePendingActivity(ctx context.Context, request *shared.OperatePendingActivityRequest) error {
+	err := t.h.OperatePendingActivity(ctx, thrift.ToOperatePendingActivityRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveTask forwards request to the underlying handler
 func (t AdminThriftHandler) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest) error {
 	err := t.h.RemoveTask(ctx, thrift.ToRemoveTaskRequest(request))
@@ -166,6 +172,18 @@ func (t AdminThriftHandler) GetCrossClusterTasks(ctx context.Context, request *s
 	return thrift.FromGetCrossClusterTasksResponse(response), thrift.FromError(err)
 }
 
+// GetReplicationStatus returns the replication status of shards between two clusters
+func (t AdminThriftHandler) GetReplicationStatus(ctx context.Context, request *admin.GetReplicationStatusRequest) (*replicator.GetReplicationStatusResponse, error) {
+	response, err := t.h.GetReplicationStatus(ctx, thrift.ToGetReplicationStatusRequest(request))
+	return thrift.FromGetReplicationStatusResponse(response), thrift.FromError(err)
+}
+
+// GetFailoverReadiness checks whether the current cluster is caught up with the active cluster of a domain
+func (t AdminThriftHandler) GetFailoverReadiness(ctx context.Context, request *admin.GetFailoverReadinessRequest) (*admin.GetFailoverReadinessResponse, error) {
+	response, err := t.h.GetFailoverReadiness(ctx, thrift.ToGetFailoverReadinessRequest(request))
+	return thrift.FromGetFailoverReadinessResponse(response), thrift.FromError(err)
+}
+
 // RespondCrossClusterTasksCompleted responds the result of processing cross cluster tasks
 func (t AdminThriftHandler) RespondCrossClusterTasksCompleted(ctx context.Context, request *shared.RespondCrossClusterTasksCompletedRequest) (*shared.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := t.h.RespondCrossClusterTasksCompleted(ctx, thrift.ToRespondCrossClusterTasksCompletedRequest(request))
@@ -190,6 +208,12 @@ func (t AdminThriftHandler) RestoreDynamicConfig(ctx context.Context, request *a
 	return thrift.FromError(err)
 }
 
+// MoveTaskList moves a batch of backlog tasks of a task list to another task list
+func (t AdminThriftHandler) MoveTaskList(ctx context.Context, request *shared.MoveTaskListRequest) (*shared.MoveTaskListResponse, error) {
+	response, err := t.h.MoveTaskList(ctx, thrift.ToMoveTaskListRequest(request))
+	return thrift.FromMoveTaskListResponse(response), thrift.FromError(err)
+}
+
 // ListDynamicConfig fetches all values associated to specified dc parameters or all otherwise
 func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admin.ListDynamicConfigRequest) (*admin.ListDynamicConfigResponse, error) {
 	response, err := t.h.ListDynamicConfig(ctx, thrift.ToListDynamicConfigRequest(request))
@@ -129,6 +129,11 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("OperatePendingActivity", func(t *testing.T) {
+		h.EXPECT().OperatePendingActivity(ctx, &types.OperatePendingActivityRequest{}).Return(internalErr).Times(1)
+		err := th.OperatePendingActivity(ctx, &shared.OperatePendingActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveTask", func(t *testing.T) {
 		h.EXPECT().RemoveTask(ctx, &types.RemoveTaskRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveTask(ctx, &shared.RemoveTaskRequest{})
@@ -150,4 +155,16 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.GetCrossClusterTasksResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetFailoverReadiness", func(t *testing.T) {
+		h.EXPECT().GetFailoverReadiness(ctx, &types.GetFailoverReadinessRequest{}).Return(&types.GetFailoverReadinessResponse{}, internalErr).Times(1)
+		resp, err := th.GetFailoverReadiness(ctx, &admin.GetFailoverReadinessRequest{})
+		assert.Equal(t, admin.GetFailoverReadinessResponse{DomainID: common.StringPtr(""), Cluster: common.StringPtr(""), SourceCluster: common.StringPtr(""), Ready: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("GetReplicationStatus", func(t *testing.T) {
+		h.EXPECT().GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{}).Return(&types.GetReplicationStatusResponse{}, internalErr).Times(1)
+		resp, err := th.GetReplicationStatus(ctx, &admin.GetReplicationStatusRequest{})
+		assert.Equal(t, replicator.GetReplicationStatusResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
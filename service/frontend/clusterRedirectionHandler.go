@@ -517,7 +517,7 @@ func (handler *ClusterRedirectionHandlerImpl) QueryWorkflow(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetExecution().GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -559,7 +559,7 @@ func (handler *ClusterRedirectionHandlerImpl) RecordActivityTaskHeartbeat(
 		return nil, err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -589,7 +589,7 @@ func (handler *ClusterRedirectionHandlerImpl) RecordActivityTaskHeartbeatByID(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -619,7 +619,7 @@ func (handler *ClusterRedirectionHandlerImpl) RequestCancelWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -679,7 +679,7 @@ func (handler *ClusterRedirectionHandlerImpl) ResetWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -714,7 +714,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskCanceled(
 		return err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -744,7 +744,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskCanceledByID(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -779,7 +779,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskCompleted(
 		return err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -809,7 +809,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskCompletedByID(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -844,7 +844,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskFailed(
 		return err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -874,7 +874,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondActivityTaskFailedByID(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -909,7 +909,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondDecisionTaskCompleted(
 		return nil, err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -944,7 +944,7 @@ func (handler *ClusterRedirectionHandlerImpl) RespondDecisionTaskFailed(
 		return err
 	}
 
-	err = handler.redirectionPolicy.WithDomainIDRedirect(ctx, token.DomainID, apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithDomainIDWorkflowRedirect(ctx, token.DomainID, token.WorkflowID, apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -1009,7 +1009,7 @@ func (handler *ClusterRedirectionHandlerImpl) SignalWithStartWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -1039,7 +1039,7 @@ func (handler *ClusterRedirectionHandlerImpl) SignalWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -1068,7 +1068,7 @@ func (handler *ClusterRedirectionHandlerImpl) StartWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -1098,7 +1098,7 @@ func (handler *ClusterRedirectionHandlerImpl) TerminateWorkflowExecution(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
 		case targetDC == handler.currentClusterName:
@@ -1113,6 +1113,66 @@ func (handler *ClusterRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// PauseWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "UnpauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithWorkflowRedirect(ctx, request.GetDomain(), request.GetWorkflowExecution().GetWorkflowID(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // ListTaskListPartitions API call
 func (handler *ClusterRedirectionHandlerImpl) ListTaskListPartitions(
 	ctx context.Context,
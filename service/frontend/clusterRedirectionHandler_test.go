@@ -55,6 +55,7 @@ type (
 
 		domainName             string
 		domainID               string
+		workflowID             string
 		currentClusterName     string
 		alternativeClusterName string
 		config                 *Config
@@ -79,6 +80,7 @@ func (s *clusterRedirectionHandlerSuite) SetupTest() {
 
 	s.domainName = "some random domain name"
 	s.domainID = "some random domain ID"
+	s.workflowID = "some random workflow ID"
 	s.currentClusterName = cluster.TestCurrentClusterName
 	s.alternativeClusterName = cluster.TestAlternativeClusterName
 
@@ -371,11 +373,12 @@ func (s *clusterRedirectionHandlerSuite) TestPollForDecisionTask() {
 func (s *clusterRedirectionHandlerSuite) TestQueryWorkflow() {
 	apiName := "QueryWorkflow"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.QueryWorkflowRequest{
 		Domain:                s.domainName,
+		Execution:             &types.WorkflowExecution{WorkflowID: s.workflowID},
 		QueryConsistencyLevel: types.QueryConsistencyLevelStrong.Ptr(),
 	}
 	resp, err := s.handler.QueryWorkflow(context.Background(), req)
@@ -383,7 +386,7 @@ func (s *clusterRedirectionHandlerSuite) TestQueryWorkflow() {
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().QueryWorkflow(gomock.Any(), req).Return(&types.QueryWorkflowResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -395,11 +398,12 @@ func (s *clusterRedirectionHandlerSuite) TestQueryWorkflow() {
 func (s *clusterRedirectionHandlerSuite) TestRecordActivityTaskHeartbeat() {
 	apiName := "RecordActivityTaskHeartbeat"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RecordActivityTaskHeartbeatRequest{
@@ -410,7 +414,7 @@ func (s *clusterRedirectionHandlerSuite) TestRecordActivityTaskHeartbeat() {
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), req).Return(&types.RecordActivityTaskHeartbeatResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -422,18 +426,19 @@ func (s *clusterRedirectionHandlerSuite) TestRecordActivityTaskHeartbeat() {
 func (s *clusterRedirectionHandlerSuite) TestRecordActivityTaskHeartbeatByID() {
 	apiName := "RecordActivityTaskHeartbeatByID"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.RecordActivityTaskHeartbeatByIDRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	resp, err := s.handler.RecordActivityTaskHeartbeatByID(context.Background(), req)
 	s.Nil(err)
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RecordActivityTaskHeartbeatByID(gomock.Any(), req).Return(&types.RecordActivityTaskHeartbeatResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -445,16 +450,17 @@ func (s *clusterRedirectionHandlerSuite) TestRecordActivityTaskHeartbeatByID() {
 func (s *clusterRedirectionHandlerSuite) TestRequestCancelWorkflowExecution() {
 	apiName := "RequestCancelWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.RequestCancelWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: s.workflowID},
 	}
 	err := s.handler.RequestCancelWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -489,18 +495,19 @@ func (s *clusterRedirectionHandlerSuite) TestResetStickyTaskList() {
 func (s *clusterRedirectionHandlerSuite) TestResetWorkflowExecution() {
 	apiName := "ResetWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.ResetWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: s.workflowID},
 	}
 	resp, err := s.handler.ResetWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().ResetWorkflowExecution(gomock.Any(), req).Return(&types.ResetWorkflowExecutionResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -512,11 +519,12 @@ func (s *clusterRedirectionHandlerSuite) TestResetWorkflowExecution() {
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCanceled() {
 	apiName := "RespondActivityTaskCanceled"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RespondActivityTaskCanceledRequest{
@@ -525,7 +533,7 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCanceled() {
 	err = s.handler.RespondActivityTaskCanceled(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskCanceled(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -537,16 +545,17 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCanceled() {
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCanceledByID() {
 	apiName := "RespondActivityTaskCanceledByID"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.RespondActivityTaskCanceledByIDRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	err := s.handler.RespondActivityTaskCanceledByID(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskCanceledByID(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -558,11 +567,12 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCanceledByID() {
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCompleted() {
 	apiName := "RespondActivityTaskCompleted"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RespondActivityTaskCompletedRequest{
@@ -571,7 +581,7 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCompleted() {
 	err = s.handler.RespondActivityTaskCompleted(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskCompleted(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -583,16 +593,17 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCompleted() {
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCompletedByID() {
 	apiName := "RespondActivityTaskCompletedByID"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.RespondActivityTaskCompletedByIDRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	err := s.handler.RespondActivityTaskCompletedByID(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskCompletedByID(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -604,11 +615,12 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskCompletedByID()
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskFailed() {
 	apiName := "RespondActivityTaskFailed"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RespondActivityTaskFailedRequest{
@@ -617,7 +629,7 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskFailed() {
 	err = s.handler.RespondActivityTaskFailed(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskFailed(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -629,16 +641,17 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskFailed() {
 func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskFailedByID() {
 	apiName := "RespondActivityTaskFailedByID"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.RespondActivityTaskFailedByIDRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	err := s.handler.RespondActivityTaskFailedByID(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondActivityTaskFailedByID(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -650,11 +663,12 @@ func (s *clusterRedirectionHandlerSuite) TestRespondActivityTaskFailedByID() {
 func (s *clusterRedirectionHandlerSuite) TestRespondDecisionTaskCompleted() {
 	apiName := "RespondDecisionTaskCompleted"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RespondDecisionTaskCompletedRequest{
@@ -665,7 +679,7 @@ func (s *clusterRedirectionHandlerSuite) TestRespondDecisionTaskCompleted() {
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), req).Return(&types.RespondDecisionTaskCompletedResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -677,11 +691,12 @@ func (s *clusterRedirectionHandlerSuite) TestRespondDecisionTaskCompleted() {
 func (s *clusterRedirectionHandlerSuite) TestRespondDecisionTaskFailed() {
 	apiName := "RespondDecisionTaskFailed"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainIDRedirect",
-		s.domainID, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithDomainIDWorkflowRedirect",
+		s.domainID, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
-		DomainID: s.domainID,
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
 	})
 	s.Nil(err)
 	req := &types.RespondDecisionTaskFailedRequest{
@@ -690,7 +705,7 @@ func (s *clusterRedirectionHandlerSuite) TestRespondDecisionTaskFailed() {
 	err = s.handler.RespondDecisionTaskFailed(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().RespondDecisionTaskFailed(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -726,18 +741,19 @@ func (s *clusterRedirectionHandlerSuite) TestRespondQueryTaskCompleted() {
 func (s *clusterRedirectionHandlerSuite) TestSignalWithStartWorkflowExecution() {
 	apiName := "SignalWithStartWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.SignalWithStartWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	resp, err := s.handler.SignalWithStartWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), req).Return(&types.StartWorkflowExecutionResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -749,16 +765,17 @@ func (s *clusterRedirectionHandlerSuite) TestSignalWithStartWorkflowExecution()
 func (s *clusterRedirectionHandlerSuite) TestSignalWorkflowExecution() {
 	apiName := "SignalWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.SignalWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: s.workflowID},
 	}
 	err := s.handler.SignalWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().SignalWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -770,18 +787,19 @@ func (s *clusterRedirectionHandlerSuite) TestSignalWorkflowExecution() {
 func (s *clusterRedirectionHandlerSuite) TestStartWorkflowExecution() {
 	apiName := "StartWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.StartWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:     s.domainName,
+		WorkflowID: s.workflowID,
 	}
 	resp, err := s.handler.StartWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 	// the resp is initialized to nil, since inner function is not called
 	s.Nil(resp)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().StartWorkflowExecution(gomock.Any(), req).Return(&types.StartWorkflowExecutionResponse{}, nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -793,16 +811,17 @@ func (s *clusterRedirectionHandlerSuite) TestStartWorkflowExecution() {
 func (s *clusterRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	apiName := "TerminateWorkflowExecution"
 
-	s.mockClusterRedirectionPolicy.On("WithDomainNameRedirect",
-		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+	s.mockClusterRedirectionPolicy.On("WithWorkflowRedirect",
+		s.domainName, s.workflowID, apiName, mock.Anything).Return(nil).Times(1)
 
 	req := &types.TerminateWorkflowExecutionRequest{
-		Domain: s.domainName,
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: s.workflowID},
 	}
 	err := s.handler.TerminateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
 
-	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	callFn := s.mockClusterRedirectionPolicy.Calls[0].Arguments[3].(func(string) error)
 	s.mockFrontendHandler.EXPECT().TerminateWorkflowExecution(gomock.Any(), req).Return(nil).Times(1)
 	err = callFn(s.currentClusterName)
 	s.Nil(err)
@@ -43,6 +43,8 @@ const (
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflow
 	// 7. ResetWorkflow
+	// 8. PauseWorkflowExecution
+	// 9. UnpauseWorkflowExecution
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicyAllDomainAPIsForwarding means forwarding all the worker and non-worker APIs based domain
@@ -54,6 +56,8 @@ type (
 	ClusterRedirectionPolicy interface {
 		WithDomainIDRedirect(ctx context.Context, domainID string, apiName string, call func(string) error) error
 		WithDomainNameRedirect(ctx context.Context, domainName string, apiName string, call func(string) error) error
+		WithWorkflowRedirect(ctx context.Context, domainName string, workflowID string, apiName string, call func(string) error) error
+		WithDomainIDWorkflowRedirect(ctx context.Context, domainID string, workflowID string, apiName string, call func(string) error) error
 	}
 
 	// noopRedirectionPolicy is DC redirection policy which does nothing
@@ -81,6 +85,8 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
 	"ResetWorkflowExecution":           {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -120,6 +126,16 @@ func (policy *noopRedirectionPolicy) WithDomainNameRedirect(ctx context.Context,
 	return call(policy.currentClusterName)
 }
 
+// WithWorkflowRedirect redirect the API call based on domain name and workflow ID
+func (policy *noopRedirectionPolicy) WithWorkflowRedirect(ctx context.Context, domainName string, workflowID string, apiName string, call func(string) error) error {
+	return call(policy.currentClusterName)
+}
+
+// WithDomainIDWorkflowRedirect redirect the API call based on domain ID and workflow ID
+func (policy *noopRedirectionPolicy) WithDomainIDWorkflowRedirect(ctx context.Context, domainID string, workflowID string, apiName string, call func(string) error) error {
+	return call(policy.currentClusterName)
+}
+
 // newSelectedOrAllAPIsForwardingPolicy creates a forwarding policy for selected APIs based on domain
 func newSelectedOrAllAPIsForwardingPolicy(currentClusterName string, config *Config, domainCache cache.DomainCache, allDoaminAPIs bool, targetCluster string) *selectedOrAllAPIsForwardingRedirectionPolicy {
 	return &selectedOrAllAPIsForwardingRedirectionPolicy{
@@ -137,7 +153,7 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainIDRedirect
 	if err != nil {
 		return err
 	}
-	return policy.withRedirect(ctx, domainEntry, apiName, call)
+	return policy.withRedirect(ctx, domainEntry, domainEntry.GetReplicationConfig().ActiveClusterName, apiName, call)
 }
 
 // WithDomainNameRedirect redirect the API call based on domain name
@@ -146,11 +162,29 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainNameRedire
 	if err != nil {
 		return err
 	}
-	return policy.withRedirect(ctx, domainEntry, apiName, call)
+	return policy.withRedirect(ctx, domainEntry, domainEntry.GetReplicationConfig().ActiveClusterName, apiName, call)
+}
+
+// WithWorkflowRedirect redirect the API call based on domain name and workflow ID
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithWorkflowRedirect(ctx context.Context, domainName string, workflowID string, apiName string, call func(string) error) error {
+	domainEntry, err := policy.domainCache.GetDomain(domainName)
+	if err != nil {
+		return err
+	}
+	return policy.withRedirect(ctx, domainEntry, domainEntry.GetActiveClusterForWorkflow(workflowID), apiName, call)
+}
+
+// WithDomainIDWorkflowRedirect redirect the API call based on domain ID and workflow ID
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) WithDomainIDWorkflowRedirect(ctx context.Context, domainID string, workflowID string, apiName string, call func(string) error) error {
+	domainEntry, err := policy.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	return policy.withRedirect(ctx, domainEntry, domainEntry.GetActiveClusterForWorkflow(workflowID), apiName, call)
 }
 
-func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) withRedirect(ctx context.Context, domainEntry *cache.DomainCacheEntry, apiName string, call func(string) error) error {
-	targetDC, enableDomainNotActiveForwarding := policy.getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx, domainEntry, apiName)
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) withRedirect(ctx context.Context, domainEntry *cache.DomainCacheEntry, activeCluster string, apiName string, call func(string) error) error {
+	targetDC, enableDomainNotActiveForwarding := policy.getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx, domainEntry, activeCluster, apiName)
 
 	err := call(targetDC)
 
@@ -170,7 +204,7 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) isDomainNotActiveErr
 }
 
 // return two values: the target cluster name, and whether or not forwarding to the active cluster
-func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx context.Context, domainEntry *cache.DomainCacheEntry, apiName string) (string, bool) {
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx context.Context, domainEntry *cache.DomainCacheEntry, currentActiveCluster string, apiName string) (string, bool) {
 	if !domainEntry.IsGlobalDomain() {
 		// do not do dc redirection if domain is local domain,
 		// for global domains with 1 dc, it's still useful to do auto-forwarding during cluster migration
@@ -182,7 +216,6 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) getTargetClusterAndI
 		return policy.currentClusterName, false
 	}
 
-	currentActiveCluster := domainEntry.GetReplicationConfig().ActiveClusterName
 	if policy.allDomainAPIs {
 		if policy.targetCluster == "" {
 			return currentActiveCluster, true
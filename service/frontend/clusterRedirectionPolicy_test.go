@@ -105,7 +105,13 @@ func (s *noopDCRedirectionPolicySuite) TestWithDomainRedirect() {
 	err = s.policy.WithDomainNameRedirect(context.Background(), domainName, apiName, callFn)
 	s.Nil(err)
 
-	s.Equal(2, callCount)
+	err = s.policy.WithWorkflowRedirect(context.Background(), domainName, "some random workflow ID", apiName, callFn)
+	s.Nil(err)
+
+	err = s.policy.WithDomainIDWorkflowRedirect(context.Background(), domainID, "some random workflow ID", apiName, callFn)
+	s.Nil(err)
+
+	s.Equal(4, callCount)
 }
 
 func TestSelectedAPIsForwardingRedirectionPolicySuite(t *testing.T) {
@@ -346,6 +352,75 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_G
 	s.Equal(2*len(selectedAPIsForwardingRedirectionPolicyAPIAllowlist), alternativeClustercallCount)
 }
 
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_WorkflowActiveCluster() {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   s.domainID,
+			Name: s.domainName,
+			Data: map[string]string{cache.WorkflowActiveClustersKey: s.alternativeClusterName + ":100"},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: s.currentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1234, // not used
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).AnyTimes()
+	s.mockConfig.EnableDomainNotActiveAutoForwarding = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	workflowID := "some random workflow ID"
+	var targetClusters []string
+	callFn := func(targetCluster string) error {
+		targetClusters = append(targetClusters, targetCluster)
+		return nil
+	}
+
+	for apiName := range selectedAPIsForwardingRedirectionPolicyAPIAllowlist {
+		targetClusters = nil
+		err := s.policy.WithWorkflowRedirect(context.Background(), s.domainName, workflowID, apiName, callFn)
+		s.Nil(err)
+		err = s.policy.WithDomainIDWorkflowRedirect(context.Background(), s.domainID, workflowID, apiName, callFn)
+		s.Nil(err)
+		// the workflow is active in the alternative cluster while the domain is active in the current cluster
+		err = s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn)
+		s.Nil(err)
+		s.Equal([]string{s.alternativeClusterName, s.alternativeClusterName, s.currentClusterName}, targetClusters)
+	}
+}
+
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_WorkflowNotActiveError() {
+	s.setupGlobalDomainWithTwoReplicationCluster(true, true)
+
+	workflowID := "some random workflow ID"
+	var targetClusters []string
+	callFn := func(targetCluster string) error {
+		targetClusters = append(targetClusters, targetCluster)
+		if targetCluster == s.currentClusterName {
+			// history found the workflow active in the alternative cluster
+			return &types.DomainNotActiveError{
+				CurrentCluster: s.currentClusterName,
+				ActiveCluster:  s.alternativeClusterName,
+			}
+		}
+		return nil
+	}
+
+	err := s.policy.WithDomainIDWorkflowRedirect(context.Background(), s.domainID, workflowID, "RespondActivityTaskCompleted", callFn)
+	s.IsType(&types.DomainNotActiveError{}, err)
+	s.Equal([]string{s.currentClusterName}, targetClusters)
+
+	targetClusters = nil
+	err = s.policy.WithWorkflowRedirect(context.Background(), s.domainName, workflowID, "SignalWorkflowExecution", callFn)
+	s.Nil(err)
+	s.Equal([]string{s.currentClusterName, s.alternativeClusterName}, targetClusters)
+}
+
 func (s *selectedAPIsForwardingRedirectionPolicySuite) setupLocalDomain() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
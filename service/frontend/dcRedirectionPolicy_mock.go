@@ -58,3 +58,31 @@ func (_m *MockClusterRedirectionPolicy) WithDomainNameRedirect(ctx context.Conte
 
 	return r0
 }
+
+// WithWorkflowRedirect provides a mock function with given fields: domainName, workflowID, apiName, call
+func (_m *MockClusterRedirectionPolicy) WithWorkflowRedirect(ctx context.Context, domainName string, workflowID string, apiName string, call func(string) error) error {
+	ret := _m.Called(domainName, workflowID, apiName, call)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, func(string) error) error); ok {
+		r0 = rf(domainName, workflowID, apiName, call)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithDomainIDWorkflowRedirect provides a mock function with given fields: domainID, workflowID, apiName, call
+func (_m *MockClusterRedirectionPolicy) WithDomainIDWorkflowRedirect(ctx context.Context, domainID string, workflowID string, apiName string, call func(string) error) error {
+	ret := _m.Called(domainID, workflowID, apiName, call)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, func(string) error) error); ok {
+		r0 = rf(domainID, workflowID, apiName, call)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -240,6 +240,16 @@ func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *ap
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToPauseWorkflowExecutionRequest(request))
+	return &apiv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseWorkflowExecution(ctx context.Context, request *apiv1.UnpauseWorkflowExecutionRequest) (*apiv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToUnpauseWorkflowExecutionRequest(request))
+	return &apiv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
 	response, err := g.h.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
 	return proto.FromUpdateDomainResponse(response), proto.FromError(err)
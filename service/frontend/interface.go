@@ -70,6 +70,8 @@ type (
 		SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest) error
 		StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
 	}
 )
@@ -43,6 +43,10 @@ type Config struct {
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
 	PersistenceGlobalMaxQPS         dynamicconfig.IntPropertyFn
 	VisibilityMaxPageSize           dynamicconfig.IntPropertyFnWithDomainFilter
+	CountGroupByMaxGroups           dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadThreshold         dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadOffloadReturnReferences  dynamicconfig.BoolPropertyFnWithDomainFilter
+	CompletionCallbackAllowedHosts  dynamicconfig.StringPropertyFnWithDomainFilter
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
 	VisibilityListMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
@@ -57,6 +61,10 @@ type Config struct {
 	DisallowQuery                   dynamicconfig.BoolPropertyFnWithDomainFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
 
+	// visibility migration
+	VisibilityMigrationShadowReadPercentage     dynamicconfig.IntPropertyFnWithDomainFilter
+	VisibilityMigrationMaxConcurrentShadowReads dynamicconfig.IntPropertyFn
+
 	// id length limits
 	MaxIDLengthWarnLimit  dynamicconfig.IntPropertyFn
 	DomainNameMaxLength   dynamicconfig.IntPropertyFnWithDomainFilter
@@ -93,6 +101,10 @@ type Config struct {
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// EnableClusterSettingsReplication is whether search attributes and selected dynamic config are replicated
+	EnableClusterSettingsReplication dynamicconfig.BoolPropertyFn
+	ReplicatedDynamicConfigKeys      dynamicconfig.StringPropertyFn
+
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
@@ -114,11 +126,17 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		PersistenceMaxQPS:                           dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
 		PersistenceGlobalMaxQPS:                     dc.GetIntProperty(dynamicconfig.FrontendPersistenceGlobalMaxQPS, 0),
 		VisibilityMaxPageSize:                       dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
+		CountGroupByMaxGroups:                       dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendCountGroupByMaxGroups, 1000),
+		PayloadOffloadThreshold:                     dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendPayloadOffloadThreshold, 0),
+		PayloadOffloadReturnReferences:              dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendPayloadOffloadReturnReferences, false),
+		CompletionCallbackAllowedHosts:              dc.GetStringPropertyFilteredByDomain(dynamicconfig.CompletionCallbackAllowedHosts, ""),
 		EnableVisibilitySampling:                    dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:             dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityListMaxQPS:                        dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, defaultVisibilityListMaxQPS()),
 		ESVisibilityListMaxQPS:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESVisibilityListMaxQPS, 30),
 		EnableReadVisibilityFromES:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
+		VisibilityMigrationShadowReadPercentage:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.VisibilityMigrationShadowReadPercentage, 0),
+		VisibilityMigrationMaxConcurrentShadowReads: dc.GetIntProperty(dynamicconfig.VisibilityMigrationMaxConcurrentShadowReads, 100),
 		ESIndexMaxResultWindow:                      dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
 		HistoryMaxPageSize:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                         dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
@@ -148,6 +166,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
+		EnableClusterSettingsReplication:            dc.GetBoolProperty(dynamicconfig.EnableClusterSettingsReplication, false),
+		ReplicatedDynamicConfigKeys:                 dc.GetStringProperty(dynamicconfig.ReplicatedDynamicConfigKeys, ""),
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery, false),
@@ -216,6 +236,10 @@ func NewService(
 			EnableReadVisibilityFromES:    serviceConfig.EnableReadVisibilityFromES,
 			AdvancedVisibilityWritingMode: nil, // frontend service never write
 
+			EnableVisibilityMigrationDualWrite:          nil, // frontend service never write
+			VisibilityMigrationShadowReadPercentage:     serviceConfig.VisibilityMigrationShadowReadPercentage,
+			VisibilityMigrationMaxConcurrentShadowReads: serviceConfig.VisibilityMigrationMaxConcurrentShadowReads,
+
 			EnableDBVisibilitySampling:                  serviceConfig.EnableVisibilitySampling,
 			EnableReadDBVisibilityFromClosedExecutionV2: serviceConfig.EnableReadFromClosedExecutionV2,
 			DBVisibilityListMaxQPS:                      serviceConfig.VisibilityListMaxQPS,
@@ -283,6 +283,18 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *shared.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(ctx, thrift.ToPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *shared.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(ctx, thrift.ToUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // UpdateDomain forwards request to the underlying handler
 func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	response, err := t.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(request))
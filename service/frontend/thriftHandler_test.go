@@ -54,7 +54,7 @@ func TestThriftHandler(t *testing.T) {
 	t.Run("CountWorkflowExecutions", func(t *testing.T) {
 		h.EXPECT().CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{}).Return(&types.CountWorkflowExecutionsResponse{}, internalErr).Times(1)
 		resp, err := th.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{})
-		assert.Equal(t, shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(0), Truncated: common.BoolPtr(false)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("DeprecateDomain", func(t *testing.T) {
@@ -260,6 +260,16 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("UpdateDomain", func(t *testing.T) {
 		h.EXPECT().UpdateDomain(ctx, &types.UpdateDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
 		resp, err := th.UpdateDomain(ctx, &shared.UpdateDomainRequest{})
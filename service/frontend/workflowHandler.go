@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
@@ -85,6 +86,7 @@ type (
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		throttleRetry             *backoff.ThrottleRetry
+		payloadStore              *offload.PayloadStore
 	}
 
 	getHistoryContinuationToken struct {
@@ -132,6 +134,7 @@ var (
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
+	errPayloadIsReference                         = &types.BadRequestError{Message: "Payload must not start with the reserved prefix of offloaded payload references."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
 	// err for archival
@@ -156,6 +159,10 @@ func NewWorkflowHandler(
 	replicationMessageSink messaging.Producer,
 	versionChecker client.VersionChecker,
 ) *WorkflowHandler {
+	var payloadStore *offload.PayloadStore
+	if blobstoreClient := resource.GetBlobstoreClient(); blobstoreClient != nil {
+		payloadStore = offload.NewPayloadStore(blobstoreClient)
+	}
 	return &WorkflowHandler{
 		Resource:        resource,
 		config:          config,
@@ -197,6 +204,7 @@ func NewWorkflowHandler(
 			backoff.WithRetryPolicy(frontendServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		payloadStore: payloadStore,
 	}
 }
 
@@ -722,6 +730,34 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 	return err
 }
 
+func (wh *WorkflowHandler) releaseOutstandingTask(ctx context.Context, taskToken *common.TaskToken) {
+	// The task list is only set in the task token when the task list partition which started the
+	// activity task limits the outstanding tasks of its pollers
+	if taskToken.TaskList == "" {
+		return
+	}
+	// the activity may be scheduled on a task list of another domain, tokens issued before the
+	// task list domain was added to them only come from task lists of the workflow domain
+	taskListDomainID := taskToken.TaskListDomainID
+	if taskListDomainID == "" {
+		taskListDomainID = taskToken.DomainID
+	}
+	err := wh.GetMatchingClient().ReleaseOutstandingTask(ctx, &types.ReleaseOutstandingTaskRequest{
+		DomainUUID: taskListDomainID,
+		TaskList:   &types.TaskList{Name: taskToken.TaskList, Kind: types.TaskListKindNormal.Ptr()},
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: taskToken.WorkflowID,
+			RunID:      taskToken.RunID,
+		},
+		ScheduleID: taskToken.ScheduleID,
+	})
+	// The slot is freed anyway once the start to close timeout of the task elapses.  Just log the error and move on
+	if err != nil {
+		wh.GetLogger().Warn("Failed to release outstanding task.",
+			tag.WorkflowTaskListName(taskToken.TaskList), tag.Error(err))
+	}
+}
+
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
 func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
@@ -1004,6 +1040,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return wh.error(errIdentityTooLong, scope, tags...)
 	}
 
+	if completeRequest.Result, err = wh.offloadPayload(ctx, scope, domainName, taskToken.DomainID, taskToken.WorkflowID, string(completeRequest.TaskToken), completeRequest.Result); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
@@ -1038,10 +1078,12 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 			CompleteRequest: completeRequest,
 		})
 		if err != nil {
+			wh.deleteUnrecordedPayloads(ctx, taskToken.DomainID, taskToken.WorkflowID, err, completeRequest.Result)
 			return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 		}
 	}
 
+	wh.releaseOutstandingTask(ctx, taskToken)
 	return nil
 }
 
@@ -1123,6 +1165,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 		return wh.error(err, scope)
 	}
 
+	if completeRequest.Result, err = wh.offloadPayload(ctx, scope, domainName, domainID, workflowID, string(token), completeRequest.Result); err != nil {
+		return wh.error(err, scope)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 
@@ -1163,6 +1209,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 			CompleteRequest: req,
 		})
 		if err != nil {
+			wh.deleteUnrecordedPayloads(ctx, domainID, workflowID, err, req.Result)
 			return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 		}
 	}
@@ -1264,6 +1311,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 	}
+	wh.releaseOutstandingTask(ctx, taskToken)
 	return nil
 }
 
@@ -1489,6 +1537,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		}
 	}
 
+	wh.releaseOutstandingTask(ctx, taskToken)
 	return nil
 }
 
@@ -1985,6 +2034,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	if err := common.ValidateCompletionCallbacks(startRequest.CompletionCallbacks, wh.config.CompletionCallbackAllowedHosts(domainName)); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	if err := backoff.ValidateSchedule(startRequest.GetCronSchedule()); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
@@ -2051,6 +2104,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	if startRequest.Input, err = wh.offloadPayload(ctx, scope, domainName, domainID, startRequest.GetWorkflowID(), startRequest.GetRequestID(), startRequest.Input); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	actualSize := len(startRequest.Input)
@@ -2077,6 +2134,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 
 	resp, err = wh.GetHistoryClient().StartWorkflowExecution(ctx, historyRequest)
 	if err != nil {
+		wh.deleteUnrecordedPayloads(ctx, domainID, startRequest.GetWorkflowID(), err, startRequest.Input)
 		return nil, wh.error(err, scope, tags...)
 	}
 	return resp, nil
@@ -2439,6 +2497,11 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(err, scope, tags...)
 	}
 
+	workflowID := signalRequest.GetWorkflowExecution().GetWorkflowID()
+	if signalRequest.Input, err = wh.offloadPayload(ctx, scope, domainName, domainID, workflowID, signalRequest.GetRequestID(), signalRequest.Input); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	if err := common.CheckEventBlobSizeLimit(
@@ -2460,6 +2523,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		SignalRequest: signalRequest,
 	})
 	if err != nil {
+		wh.deleteUnrecordedPayloads(ctx, domainID, workflowID, err, signalRequest.Input)
 		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
 	}
 
@@ -2595,6 +2659,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	if err := common.ValidateCompletionCallbacks(signalWithStartRequest.CompletionCallbacks, wh.config.CompletionCallbackAllowedHosts(domainName)); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	if err := backoff.ValidateSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
@@ -2608,6 +2676,16 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	workflowID := signalWithStartRequest.GetWorkflowID()
+	requestID := signalWithStartRequest.GetRequestID()
+	if signalWithStartRequest.SignalInput, err = wh.offloadPayload(ctx, scope, domainName, domainID, workflowID, requestID, signalWithStartRequest.SignalInput); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
+	if signalWithStartRequest.Input, err = wh.offloadPayload(ctx, scope, domainName, domainID, workflowID, requestID, signalWithStartRequest.Input); err != nil {
+		wh.deleteOffloadedPayloads(ctx, domainID, workflowID, signalWithStartRequest.SignalInput)
+		return nil, wh.error(err, scope, tags...)
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	if err := common.CheckEventBlobSizeLimit(
@@ -2643,6 +2721,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		SignalWithStartRequest: signalWithStartRequest,
 	})
 	if err != nil {
+		wh.deleteUnrecordedPayloads(ctx, domainID, workflowID, err, signalWithStartRequest.SignalInput, signalWithStartRequest.Input)
 		return nil, wh.error(err, scope, tags...)
 	}
 
@@ -2704,6 +2783,116 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history. No new decision task is dispatched to the workflow until it is unpaused.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := pauseRequest.GetDomain()
+	wfExecution := pauseRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+// in the history.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	wfExecution := unpauseRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if unpauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -3332,6 +3521,10 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	groupBy, err := wh.visibilityQueryValidator.ValidateGroupBy(countRequest.GetGroupBy())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	domain := countRequest.GetDomain()
 	domainID, err := wh.GetDomainCache().GetDomainID(domain)
@@ -3343,6 +3536,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		DomainUUID: domainID,
 		Domain:     domain,
 		Query:      validatedQuery,
+		GroupBy:    groupBy,
+		MaxGroups:  wh.config.CountGroupByMaxGroups(domain),
 	}
 	persistenceResp, err := wh.GetVisibilityManager().CountWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -3350,7 +3545,9 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	}
 
 	resp = &types.CountWorkflowExecutionsResponse{
-		Count: persistenceResp.Count,
+		Count:     persistenceResp.Count,
+		Groups:    persistenceResp.Groups,
+		Truncated: persistenceResp.Truncated,
 	}
 	return resp, nil
 }
@@ -3716,7 +3913,12 @@ func (wh *WorkflowHandler) getRawHistory(
 	}
 
 	var encoding *types.EncodingType
-	for _, data := range resp.HistoryEventBlobs {
+	for i, data := range resp.HistoryEventBlobs {
+		if i == 0 && firstEventID == common.FirstEventID {
+			if data, err = wh.redactRawCompletionCallbacks(data); err != nil {
+				return nil, nil, err
+			}
+		}
 		switch data.Encoding {
 		case common.EncodingTypeJSON:
 			encoding = types.EncodingTypeJSON.Ptr()
@@ -3803,6 +4005,15 @@ func (wh *WorkflowHandler) getHistory(
 		return nil, nil, err
 	}
 
+	if wh.payloadStore != nil {
+		if err := wh.rehydratePayloads(ctx, scope, domainID, execution.GetWorkflowID(), historyEvents); err != nil {
+			return nil, nil, err
+		}
+	}
+	for i, event := range historyEvents {
+		historyEvents[i] = common.RedactCompletionCallbacks(event)
+	}
+
 	if len(nextPageToken) == 0 && transientDecision != nil {
 		if err := wh.validateTransientDecisionEvents(nextEventID, transientDecision); err != nil {
 			scope.IncCounter(metrics.CadenceErrIncompleteHistoryCounter)
@@ -3821,6 +4032,130 @@ func (wh *WorkflowHandler) getHistory(
 	return executionHistory, nextPageToken, nil
 }
 
+// redactRawCompletionCallbacks removes the headers of completion callbacks from the serialized batch holding the
+// start event, the headers may carry credentials of the callback targets
+func (wh *WorkflowHandler) redactRawCompletionCallbacks(
+	data *persistence.DataBlob,
+) (*persistence.DataBlob, error) {
+	events, err := wh.GetPayloadSerializer().DeserializeBatchEvents(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 || len(events[0].GetWorkflowExecutionStartedEventAttributes().GetCompletionCallbacks()) == 0 {
+		return data, nil
+	}
+	events[0] = common.RedactCompletionCallbacks(events[0])
+	return wh.GetPayloadSerializer().SerializeBatchEvents(events, data.Encoding)
+}
+
+// rehydratePayloads replaces references to offloaded payloads in the events with the payloads, unless the
+// domain is configured to return references
+func (wh *WorkflowHandler) rehydratePayloads(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	workflowID string,
+	events []*types.HistoryEvent,
+) error {
+	hasReference := false
+	for _, event := range events {
+		if offload.HasReference(event) {
+			hasReference = true
+			break
+		}
+	}
+	if !hasReference {
+		return nil
+	}
+	domainName, err := wh.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return err
+	}
+	if wh.config.PayloadOffloadReturnReferences(domainName) {
+		return nil
+	}
+	if err := wh.payloadStore.RehydrateEvents(ctx, domainID, workflowID, events); err != nil {
+		scope.IncCounter(metrics.PayloadRehydrateFailures)
+		wh.GetLogger().Error("Failed to rehydrate offloaded payloads", tag.WorkflowDomainID(domainID), tag.WorkflowID(workflowID), tag.Error(err))
+		return &types.InternalServiceError{Message: fmt.Sprintf("Failed to read offloaded payload: %v", err)}
+	}
+	return nil
+}
+
+// offloadPayload offloads the payload to the blob store if it is larger than the offload threshold of the domain,
+// and returns the reference to the payload in that case. Payloads looking like references are rejected, only
+// references created here may be stored in history. Retries of a request with the same idempotency key offload
+// the payload to the same blob.
+func (wh *WorkflowHandler) offloadPayload(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainName string,
+	domainID string,
+	workflowID string,
+	idempotencyKey string,
+	payload []byte,
+) ([]byte, error) {
+	if offload.IsReference(payload) {
+		return nil, errPayloadIsReference
+	}
+	threshold := wh.config.PayloadOffloadThreshold(domainName)
+	if wh.payloadStore == nil || threshold <= 0 || len(payload) <= threshold {
+		return payload, nil
+	}
+	ref, err := wh.payloadStore.Offload(ctx, domainID, workflowID, idempotencyKey, payload)
+	if err != nil {
+		scope.IncCounter(metrics.PayloadOffloadFailures)
+		wh.GetLogger().Error("Failed to offload payload", tag.WorkflowDomainName(domainName), tag.WorkflowID(workflowID), tag.Error(err))
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("Failed to offload payload: %v", err)}
+	}
+	scope.IncCounter(metrics.PayloadOffloadCounter)
+	return ref, nil
+}
+
+// deleteUnrecordedPayloads deletes the offloaded payloads of a request which history rejected without recording it.
+// The payloads are kept if the request failed otherwise, e.g. timed out, as it may have been recorded anyways; a
+// retry of the request offloads them to the same blobs, which are deleted with the workflow.
+func (wh *WorkflowHandler) deleteUnrecordedPayloads(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	err error,
+	payloads ...[]byte,
+) {
+	switch err.(type) {
+	case *types.BadRequestError, *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyStartedError,
+		*types.WorkflowExecutionAlreadyCompletedError, *types.DomainNotActiveError, *types.ServiceBusyError,
+		*types.LimitExceededError:
+		wh.deleteOffloadedPayloads(ctx, domainID, workflowID, payloads...)
+	}
+}
+
+// deleteOffloadedPayloads deletes the blobs referenced by the given payloads, payloads which are not references
+// are skipped
+func (wh *WorkflowHandler) deleteOffloadedPayloads(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	payloads ...[]byte,
+) {
+	if wh.payloadStore == nil {
+		return
+	}
+
+	var keys []string
+	for _, payload := range payloads {
+		if ref, err := offload.DecodeReference(payload); err == nil {
+			keys = append(keys, ref.Key)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if _, err := wh.payloadStore.Delete(ctx, domainID, workflowID, keys); err != nil {
+		wh.GetLogger().Warn("Failed to delete offloaded payloads of rejected request", tag.WorkflowDomainID(domainID), tag.WorkflowID(workflowID), tag.Error(err))
+	}
+}
+
 func (wh *WorkflowHandler) validateTransientDecisionEvents(
 	expectedNextEventID int64,
 	decision *types.TransientDecisionInfo,
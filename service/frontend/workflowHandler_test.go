@@ -21,9 +21,11 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -38,6 +40,8 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/cluster"
@@ -486,6 +490,33 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	s.Equal(errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_CompletionCallbackHostNotAllowed() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.CompletionCallbackAllowedHosts = func(string) string { return "allowed.example.com" }
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		CompletionCallbacks: []*types.CompletionCallback{
+			{URL: "https://other.example.com/done"},
+		},
+		RequestID: uuid.New(),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Contains(err.Error(), "other.example.com is not allowed")
+}
+
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_MissingDomainDataKey() {
 	dynamicClient := dc.NewInMemoryClient()
 	dynamicClient.UpdateValue(dc.RequiredDomainDataKeys, map[string]interface{}{"Tier": true})
@@ -1314,6 +1345,41 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestCountWorkflowExecutions_GroupBy() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *persistence.CountWorkflowExecutionsRequest) bool {
+		return reflect.DeepEqual([]string{"WorkflowType", "Attr.CustomKeywordField"}, request.GroupBy) && request.MaxGroups == 1000
+	})).Return(&persistence.CountWorkflowExecutionsResponse{
+		Count: 6,
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{GroupValues: []string{`"type1"`, `"value"`}, Count: 3},
+			{GroupValues: []string{`"type2"`, `"value"`}, Count: 2},
+		},
+		Truncated: true,
+	}, nil).Once()
+
+	countRequest := &types.CountWorkflowExecutionsRequest{
+		Domain:  s.testDomain,
+		GroupBy: []string{"WorkflowType", "CustomKeywordField"},
+	}
+	ctx := context.Background()
+
+	resp, err := wh.CountWorkflowExecutions(ctx, countRequest)
+	s.NoError(err)
+	s.Equal(int64(6), resp.GetCount())
+	s.Equal([]*types.CountWorkflowExecutionsGroup{
+		{GroupValues: []string{`"type1"`, `"value"`}, Count: 3},
+		{GroupValues: []string{`"type2"`, `"value"`}, Count: 2},
+	}, resp.GetGroups())
+	s.True(resp.GetTruncated())
+
+	countRequest.GroupBy = []string{"RunID"}
+	_, err = wh.CountWorkflowExecutions(ctx, countRequest)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *workflowHandlerSuite) TestConvertIndexedKeyToThrift() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	m := map[string]interface{}{
@@ -1432,6 +1498,132 @@ func (s *workflowHandlerSuite) TestContextMetricsTags() {
 	s.Fail("counter not found")
 }
 
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_OffloadPayload() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.PayloadOffloadThreshold = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+
+	input := []byte("signal input larger than the threshold")
+	var offloadedKey string
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockResource.BlobstoreClient.On("Put", mock.Anything, mock.MatchedBy(func(request *blobstore.PutRequest) bool {
+		offloadedKey = request.Key
+		return bytes.Equal(input, request.Blob.Body)
+	})).Return(&blobstore.PutResponse{}, nil).Once()
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistorySignalWorkflowExecutionRequest, _ ...interface{}) error {
+			ref, err := offload.DecodeReference(request.SignalRequest.Input)
+			s.NoError(err)
+			s.Equal(offloadedKey, ref.Key)
+			s.Equal(len(input), ref.Size)
+			return nil
+		})
+
+	err := wh.SignalWorkflowExecution(context.Background(), &types.SignalWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		SignalName:        "test_signal",
+		Input:             input,
+	})
+	s.NoError(err)
+	s.mockResource.BlobstoreClient.AssertExpectations(s.T())
+}
+
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_OffloadPayload_SignalRejected() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.PayloadOffloadThreshold = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+
+	input := []byte("signal input larger than the threshold")
+	var offloaded *blobstore.PutRequest
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockResource.BlobstoreClient.On("Put", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		offloaded = args.Get(1).(*blobstore.PutRequest)
+	}).Return(&blobstore.PutResponse{}, nil).Twice()
+	s.mockResource.BlobstoreClient.On("Exists", mock.Anything, mock.Anything).Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	s.mockResource.BlobstoreClient.On("Get", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ *blobstore.GetRequest) *blobstore.GetResponse {
+			return &blobstore.GetResponse{Blob: offloaded.Blob}
+		}, nil).Once()
+	// the payload of a signal rejected by history is deleted, the one of a signal which timed out is kept
+	var deletedKey string
+	s.mockResource.BlobstoreClient.On("Delete", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		deletedKey = args.Get(1).(*blobstore.DeleteRequest).Key
+	}).Return(&blobstore.DeleteResponse{}, nil).Once()
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{}).Times(1)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(context.DeadlineExceeded).Times(1)
+
+	request := &types.SignalWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		SignalName:        "test_signal",
+		Input:             input,
+		RequestID:         uuid.New(),
+	}
+	err := wh.SignalWorkflowExecution(context.Background(), request)
+	s.Error(err)
+	s.Equal(offloaded.Key, deletedKey)
+
+	// the retried signal is offloaded to the same blob
+	request.Input = input
+	err = wh.SignalWorkflowExecution(context.Background(), request)
+	s.Error(err)
+	s.Equal(deletedKey, offloaded.Key)
+	s.mockResource.BlobstoreClient.AssertExpectations(s.T())
+}
+
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_PayloadIsReference() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.PayloadOffloadThreshold = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	err := wh.SignalWorkflowExecution(context.Background(), &types.SignalWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		SignalName:        "test_signal",
+		Input:             []byte(`cadence-payload-ref:{"key":"payload_` + s.testDomainID + `_forged","size":1}`),
+	})
+	s.Equal(errPayloadIsReference, err)
+}
+
+func (s *workflowHandlerSuite) TestRehydratePayloads() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+
+	var offloaded blobstore.Blob
+	s.mockResource.BlobstoreClient.On("Put", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		offloaded = args.Get(1).(*blobstore.PutRequest).Blob
+	}).Return(&blobstore.PutResponse{}, nil).Once()
+	ref, err := offload.NewPayloadStore(s.mockResource.BlobstoreClient).Offload(context.Background(), s.testDomainID, testWorkflowID, "", []byte("input"))
+	s.Require().NoError(err)
+	newEvents := func() []*types.HistoryEvent {
+		return []*types.HistoryEvent{
+			{
+				EventID:                                 1,
+				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: ref},
+			},
+		}
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return(s.testDomain, nil).AnyTimes()
+	s.mockResource.BlobstoreClient.On("Get", mock.Anything, mock.Anything).Return(func(context.Context, *blobstore.GetRequest) *blobstore.GetResponse {
+		return &blobstore.GetResponse{Blob: offloaded}
+	}, nil).Twice()
+
+	events := newEvents()
+	s.NoError(wh.rehydratePayloads(context.Background(), metrics.NoopScope(metrics.Frontend), s.testDomainID, testWorkflowID, events))
+	s.Equal([]byte("input"), events[0].WorkflowExecutionStartedEventAttributes.Input)
+
+	// the reference was offloaded by another workflow
+	events = newEvents()
+	s.Error(wh.rehydratePayloads(context.Background(), metrics.NoopScope(metrics.Frontend), s.testDomainID, "other-workflow-id", events))
+
+	config.PayloadOffloadReturnReferences = dc.GetBoolPropertyFnFilteredByDomain(true)
+	events = newEvents()
+	s.NoError(wh.rehydratePayloads(context.Background(), metrics.NoopScope(metrics.Frontend), s.testDomainID, testWorkflowID, events))
+	s.Equal(ref, events[0].WorkflowExecutionStartedEventAttributes.Input)
+}
+
 func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -1567,3 +1759,38 @@ func listArchivedWorkflowExecutionsTestRequest() *types.ListArchivedWorkflowExec
 		Query:    "some random query string",
 	}
 }
+
+func (s *workflowHandlerSuite) TestReleaseOutstandingTask_TaskListOfAnotherDomain() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	taskListDomainID := "8f2b5e1c-7d3a-4c6e-9b0f-1a2d3e4f5a6b"
+
+	s.mockResource.MatchingClient.EXPECT().ReleaseOutstandingTask(gomock.Any(), &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        taskListDomainID,
+		TaskList:          &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		ScheduleID:        5,
+	}).Return(nil).Times(1)
+	wh.releaseOutstandingTask(context.Background(), &common.TaskToken{
+		DomainID:         s.testDomainID,
+		WorkflowID:       testWorkflowID,
+		RunID:            testRunID,
+		ScheduleID:       5,
+		TaskList:         "tl",
+		TaskListDomainID: taskListDomainID,
+	})
+
+	// tokens without the task list domain fall back to the workflow domain
+	s.mockResource.MatchingClient.EXPECT().ReleaseOutstandingTask(gomock.Any(), &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        s.testDomainID,
+		TaskList:          &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		ScheduleID:        5,
+	}).Return(nil).Times(1)
+	wh.releaseOutstandingTask(context.Background(), &common.TaskToken{
+		DomainID:   s.testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		ScheduleID: 5,
+		TaskList:   "tl",
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// operatePendingActivity records the event of the requested operation on a pending activity and
+// returns the update action for the workflow, a decision is scheduled if the operation closed the activity
+func operatePendingActivity(
+	mutableState execution.MutableState,
+	request *types.OperatePendingActivityRequest,
+	now time.Time,
+) (*workflow.UpdateAction, error) {
+
+	ai, ok := mutableState.GetActivityByActivityID(request.GetActivityID())
+	if !ok {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Pending activity %v not found.", request.GetActivityID())}
+	}
+
+	switch request.GetOperation() {
+	case types.PendingActivityOperationUpdateOptions:
+		if _, err := mutableState.AddActivityTaskOptionsUpdatedEvent(ai.ScheduleID, request); err != nil {
+			return nil, err
+		}
+		return workflow.UpdateWithoutDecision, nil
+
+	case types.PendingActivityOperationRetry:
+		// an activity which is running or already dispatched to matching cannot be retried,
+		// otherwise the same attempt would be dispatched twice
+		if ai.StartedID != common.EmptyEventID || !ai.ScheduledTime.After(now) {
+			return nil, &types.BadRequestError{Message: "Only activities waiting for their next retry can be retried."}
+		}
+		if _, err := mutableState.AddActivityTaskRetryRequestedEvent(ai.ScheduleID, request.GetResetAttempts(), request.GetIdentity()); err != nil {
+			return nil, err
+		}
+		return workflow.UpdateWithoutDecision, nil
+
+	case types.PendingActivityOperationFail, types.PendingActivityOperationSkip:
+		if ai.StartedID == common.EmptyEventID {
+			// activities waiting to be dispatched have no started event, record one to close the activity with
+			if _, err := mutableState.AddActivityTaskStartedEvent(ai, ai.ScheduleID, uuid.New(), request.GetIdentity()); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if request.GetOperation() == types.PendingActivityOperationFail {
+			_, err = mutableState.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID, &types.RespondActivityTaskFailedRequest{
+				Reason:   common.StringPtr(request.GetReason()),
+				Details:  request.Details,
+				Identity: request.GetIdentity(),
+			})
+		} else {
+			_, err = mutableState.AddActivityTaskCompletedEvent(ai.ScheduleID, ai.StartedID, &types.RespondActivityTaskCompletedRequest{
+				Result:   request.Result,
+				Identity: request.GetIdentity(),
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return workflow.UpdateWithNewDecision, nil
+	}
+	return nil, &types.BadRequestError{Message: fmt.Sprintf("Unknown pending activity operation: %v", request.GetOperation())}
+}
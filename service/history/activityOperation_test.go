@@ -0,0 +1,188 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+type (
+	activityOperationSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockMutableState *execution.MockMutableState
+	}
+)
+
+func TestActivityOperationSuite(t *testing.T) {
+	s := new(activityOperationSuite)
+	suite.Run(t, s)
+}
+
+func (s *activityOperationSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = execution.NewMockMutableState(s.controller)
+}
+
+func (s *activityOperationSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_ActivityNotFound() {
+	s.mockMutableState.EXPECT().GetActivityByActivityID("activity").Return(nil, false).Times(1)
+
+	_, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID: "activity",
+		Operation:  types.PendingActivityOperationRetry.Ptr(),
+	}, time.Now())
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_UpdateOptions() {
+	ai := s.newActivityInfo()
+	request := &types.OperatePendingActivityRequest{
+		ActivityID:                 ai.ActivityID,
+		Operation:                  types.PendingActivityOperationUpdateOptions.Ptr(),
+		StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+		Identity:                   "identity",
+	}
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+	s.mockMutableState.EXPECT().AddActivityTaskOptionsUpdatedEvent(ai.ScheduleID, request).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	action, err := operatePendingActivity(s.mockMutableState, request, time.Now())
+	s.NoError(err)
+	s.Equal(workflow.UpdateWithoutDecision, action)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_Retry() {
+	now := time.Now()
+	ai := s.newActivityInfo()
+	ai.ScheduledTime = now.Add(time.Minute)
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+	s.mockMutableState.EXPECT().AddActivityTaskRetryRequestedEvent(ai.ScheduleID, true, "identity").Return(&types.HistoryEvent{}, nil).Times(1)
+
+	action, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID:    ai.ActivityID,
+		Operation:     types.PendingActivityOperationRetry.Ptr(),
+		ResetAttempts: true,
+		Identity:      "identity",
+	}, now)
+	s.NoError(err)
+	s.Equal(workflow.UpdateWithoutDecision, action)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_RetryStartedActivity() {
+	ai := s.newActivityInfo()
+	ai.StartedID = 10
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+
+	_, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID: ai.ActivityID,
+		Operation:  types.PendingActivityOperationRetry.Ptr(),
+	}, time.Now())
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_RetryDispatchedActivity() {
+	now := time.Now()
+	ai := s.newActivityInfo()
+	ai.ScheduledTime = now.Add(-time.Minute)
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+
+	_, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID: ai.ActivityID,
+		Operation:  types.PendingActivityOperationRetry.Ptr(),
+	}, now)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_Fail() {
+	ai := s.newActivityInfo()
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+	s.mockMutableState.EXPECT().AddActivityTaskStartedEvent(ai, ai.ScheduleID, gomock.Any(), "identity").DoAndReturn(
+		func(ai *persistence.ActivityInfo, scheduleID int64, requestID string, identity string) (*types.HistoryEvent, error) {
+			ai.StartedID = 10
+			return &types.HistoryEvent{EventID: 10}, nil
+		},
+	).Times(1)
+	s.mockMutableState.EXPECT().AddActivityTaskFailedEvent(ai.ScheduleID, int64(10), &types.RespondActivityTaskFailedRequest{
+		Reason:   common.StringPtr("reason"),
+		Details:  []byte("details"),
+		Identity: "identity",
+	}).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	action, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID: ai.ActivityID,
+		Operation:  types.PendingActivityOperationFail.Ptr(),
+		Reason:     common.StringPtr("reason"),
+		Details:    []byte("details"),
+		Identity:   "identity",
+	}, time.Now())
+	s.NoError(err)
+	s.Equal(workflow.UpdateWithNewDecision, action)
+}
+
+func (s *activityOperationSuite) TestOperatePendingActivity_Skip() {
+	ai := s.newActivityInfo()
+	ai.StartedID = 10
+	s.mockMutableState.EXPECT().GetActivityByActivityID(ai.ActivityID).Return(ai, true).Times(1)
+	s.mockMutableState.EXPECT().AddActivityTaskCompletedEvent(ai.ScheduleID, int64(10), &types.RespondActivityTaskCompletedRequest{
+		Result:   []byte("result"),
+		Identity: "identity",
+	}).Return(&types.HistoryEvent{}, nil).Times(1)
+
+	action, err := operatePendingActivity(s.mockMutableState, &types.OperatePendingActivityRequest{
+		ActivityID: ai.ActivityID,
+		Operation:  types.PendingActivityOperationSkip.Ptr(),
+		Result:     []byte("result"),
+		Identity:   "identity",
+	}, time.Now())
+	s.NoError(err)
+	s.Equal(workflow.UpdateWithNewDecision, action)
+}
+
+func (s *activityOperationSuite) newActivityInfo() *persistence.ActivityInfo {
+	return &persistence.ActivityInfo{
+		ActivityID:             "activity",
+		ScheduleID:             5,
+		StartedID:              common.EmptyEventID,
+		Attempt:                3,
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 100,
+		StartToCloseTimeout:    10,
+		TimerTaskStatus:        execution.TimerTaskStatusCreatedStartToClose,
+	}
+}
@@ -59,6 +59,9 @@ type Config struct {
 	EnableStickyQuery               dynamicconfig.BoolPropertyFnWithDomainFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
 
+	// Visibility migration settings
+	EnableVisibilityMigrationDualWrite dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	// HistoryCache settings
 	// Change of these configs require shard restart
 	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
@@ -79,6 +82,9 @@ type Config struct {
 	RangeSizeBits           uint
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
 	AcquireShardConcurrency dynamicconfig.IntPropertyFn
+	// EnableGracefulShardHandoff enables handing off shards to their new owner instead of waiting for the shards to be stolen
+	EnableGracefulShardHandoff dynamicconfig.BoolPropertyFn
+	ShardHandoffTimeout        dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
@@ -136,6 +142,7 @@ type Config struct {
 	TimerProcessorMaxTimeShift                        dynamicconfig.DurationPropertyFn
 	TimerProcessorHistoryArchivalSizeLimit            dynamicconfig.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                   dynamicconfig.DurationPropertyFn
+	TimerProcessorEnablePayloadOffloadCleanup         dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// TransferQueueProcessor settings
 	TransferTaskBatchSize                                dynamicconfig.IntPropertyFn
@@ -156,6 +163,14 @@ type Config struct {
 	TransferProcessorValidationInterval                  dynamicconfig.DurationPropertyFn
 	TransferProcessorVisibilityArchivalTimeLimit         dynamicconfig.DurationPropertyFn
 
+	// CompletionCallback settings
+	CompletionCallbackAllowedHosts dynamicconfig.StringPropertyFnWithDomainFilter
+	CompletionCallbackMaxAttempts  dynamicconfig.IntPropertyFnWithDomainFilter
+	CompletionCallbackTimeout      dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// HistoryExport settings
+	HistoryExportMode dynamicconfig.StringPropertyFnWithDomainFilter
+
 	// CrossClusterQueueProcessor settings
 	CrossClusterTaskBatchSize                                     dynamicconfig.IntPropertyFn
 	CrossClusterTaskDeleteBatchSize                               dynamicconfig.IntPropertyFn
@@ -242,6 +257,12 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// Pending count limit related settings, 0 means no limit
+	PendingActivitiesCountLimit       dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingChildExecutionsCountLimit  dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingTimersCountLimit           dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingExternalRequestsCountLimit dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -283,6 +304,15 @@ type Config struct {
 	ReplicationTaskProcessorShardQPS                   dynamicconfig.FloatPropertyFn
 	ReplicationTaskGenerationQPS                       dynamicconfig.FloatPropertyFn
 	EnableReplicationTaskGeneration                    dynamicconfig.BoolPropertyFnWithDomainIDAndWorkflowIDFilter
+	EnableReplicationDLQAutoRetry                      dynamicconfig.BoolPropertyFn
+	ReplicationDLQRetryInterval                        dynamicconfig.DurationPropertyFnWithShardIDFilter
+	ReplicationDLQRetryMaxInterval                     dynamicconfig.DurationPropertyFnWithShardIDFilter
+	ReplicationDLQRetryMaxAttempts                     dynamicconfig.IntPropertyFnWithShardIDFilter
+	ReplicationDLQRetryBatchSize                       dynamicconfig.IntPropertyFn
+	ReplicationDLQMaxTaskStates                        dynamicconfig.IntPropertyFn
+	EnableReplicationTaskCompression                   dynamicconfig.BoolPropertyFn
+	ReplicationTaskFrameMaxSize                        dynamicconfig.IntPropertyFn
+	ReplicationStatusMaxTasks                          dynamicconfig.IntPropertyFn
 
 	// The following are used by consistent query
 	EnableConsistentQuery         dynamicconfig.BoolPropertyFn
@@ -306,6 +336,10 @@ type Config struct {
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicconfig.DurationPropertyFnWithDomainFilter
 
+	// The matching configs used to free the outstanding activity tasks of pollers when the activities are completed by ID or time out
+	MatchingMaxOutstandingTasksPerPoller dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	MatchingNumTasklistReadPartitions    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
 	// Debugging configurations
 	EnableDebugMode             bool // note that this value is initialized once on service start
 	EnableTaskInfoLogByDomainID dynamicconfig.BoolPropertyFnWithDomainIDFilter
@@ -364,6 +398,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		MaxAutoResetPoints:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryMaxAutoResetPoints, DefaultHistoryMaxAutoResetPoints),
 		MaxDecisionStartToCloseSeconds:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseSeconds, 240),
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
+		EnableVisibilityMigrationDualWrite:   dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableVisibilityMigrationDualWrite, false),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
@@ -378,6 +413,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 1),
+		EnableGracefulShardHandoff:           dc.GetBoolProperty(dynamicconfig.EnableGracefulShardHandoff, false),
+		ShardHandoffTimeout:                  dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 5*time.Second),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 15*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 25*time.Minute),
@@ -430,6 +467,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		TimerProcessorMaxTimeShift:                        dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift, 1*time.Second),
 		TimerProcessorHistoryArchivalSizeLimit:            dc.GetIntProperty(dynamicconfig.TimerProcessorHistoryArchivalSizeLimit, 500*1024),
 		TimerProcessorArchivalTimeLimit:                   dc.GetDurationProperty(dynamicconfig.TimerProcessorArchivalTimeLimit, 1*time.Second),
+		TimerProcessorEnablePayloadOffloadCleanup:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.TimerProcessorEnablePayloadOffloadCleanup, false),
 
 		TransferTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TransferTaskBatchSize, 100),
 		TransferTaskDeleteBatchSize:                          dc.GetIntProperty(dynamicconfig.TransferTaskDeleteBatchSize, 4000),
@@ -449,6 +487,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		TransferProcessorValidationInterval:                  dc.GetDurationProperty(dynamicconfig.TransferProcessorValidationInterval, 30*time.Second),
 		TransferProcessorVisibilityArchivalTimeLimit:         dc.GetDurationProperty(dynamicconfig.TransferProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
 
+		CompletionCallbackAllowedHosts: dc.GetStringPropertyFilteredByDomain(dynamicconfig.CompletionCallbackAllowedHosts, ""),
+		CompletionCallbackMaxAttempts:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.CompletionCallbackMaxAttempts, 10),
+		CompletionCallbackTimeout:      dc.GetDurationPropertyFilteredByDomain(dynamicconfig.CompletionCallbackTimeout, 10*time.Second),
+
+		HistoryExportMode: dc.GetStringPropertyFilteredByDomain(dynamicconfig.HistoryExportMode, ""),
+
 		CrossClusterTaskBatchSize:                                     dc.GetIntProperty(dynamicconfig.CrossClusterTaskBatchSize, 100),
 		CrossClusterTaskDeleteBatchSize:                               dc.GetIntProperty(dynamicconfig.CrossClusterTaskDeleteBatchSize, 4000),
 		CrossClusterTaskFetchBatchSize:                                dc.GetIntPropertyFilteredByShardID(dynamicconfig.CrossClusterTaskFetchBatchSize, 100),
@@ -514,6 +558,11 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		PendingActivitiesCountLimit:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingActivitiesCountLimit, 0),
+		PendingChildExecutionsCountLimit:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingChildExecutionsCountLimit, 0),
+		PendingTimersCountLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingTimersCountLimit, 0),
+		PendingExternalRequestsCountLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingExternalRequestsCountLimit, 0),
+
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyQuery, true),
 
@@ -548,6 +597,15 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicationTaskProcessorShardQPS:                   dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 5),
 		ReplicationTaskGenerationQPS:                       dc.GetFloat64Property(dynamicconfig.ReplicationTaskGenerationQPS, 100),
 		EnableReplicationTaskGeneration:                    dc.GetBoolPropertyFilteredByDomainIDAndWorkflowID(dynamicconfig.EnableReplicationTaskGeneration, true),
+		EnableReplicationDLQAutoRetry:                      dc.GetBoolProperty(dynamicconfig.EnableReplicationDLQAutoRetry, false),
+		ReplicationDLQRetryInterval:                        dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRetryInterval, 5*time.Minute),
+		ReplicationDLQRetryMaxInterval:                     dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRetryMaxInterval, 6*time.Hour),
+		ReplicationDLQRetryMaxAttempts:                     dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRetryMaxAttempts, 10),
+		ReplicationDLQRetryBatchSize:                       dc.GetIntProperty(dynamicconfig.ReplicationDLQRetryBatchSize, 100),
+		ReplicationDLQMaxTaskStates:                        dc.GetIntProperty(dynamicconfig.ReplicationDLQMaxTaskStates, 1000),
+		EnableReplicationTaskCompression:                   dc.GetBoolProperty(dynamicconfig.EnableReplicationTaskCompression, false),
+		ReplicationTaskFrameMaxSize:                        dc.GetIntProperty(dynamicconfig.ReplicationTaskFrameMaxSize, 1024*1024),
+		ReplicationStatusMaxTasks:                          dc.GetIntProperty(dynamicconfig.ReplicationStatusMaxTasks, 10000),
 
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery, true),
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain, false),
@@ -565,6 +623,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ActivityMaxScheduleToStartTimeoutForRetry, 30*time.Minute),
 
+		MatchingMaxOutstandingTasksPerPoller: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxOutstandingTasksPerPoller, 0),
+		MatchingNumTasklistReadPartitions:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+
 		EnableDebugMode:             dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
 		EnableTaskInfoLogByDomainID: dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.HistoryEnableTaskInfoLogByDomainID, false),
 	}
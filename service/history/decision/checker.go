@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
@@ -606,6 +607,10 @@ func (v *attrValidator) validateSignalExternalWorkflowExecutionAttributes(
 	if attributes.SignalName == "" {
 		return &types.BadRequestError{Message: "SignalName is not set on decision."}
 	}
+	// offloaded payloads belong to the workflow which offloaded them
+	if offload.IsReference(attributes.Input) {
+		return &types.BadRequestError{Message: "Input of signal must not be a reference to an offloaded payload."}
+	}
 
 	return nil
 }
@@ -680,6 +685,17 @@ func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 		return &types.BadRequestError{Message: "BackoffStartInterval is less than 0."}
 	}
 
+	// the new run takes over offloaded payloads of the run, but must not reference any other payload
+	if offload.IsReference(attributes.Input) {
+		ref, err := offload.DecodeReference(attributes.Input)
+		if err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+		if _, ok := executionInfo.OffloadedPayloads[ref.Key]; !ok {
+			return &types.BadRequestError{Message: "Input of new run references a payload not offloaded by the workflow run."}
+		}
+	}
+
 	domainName, err := v.domainCache.GetDomainName(executionInfo.DomainID)
 	if err != nil {
 		return err
@@ -758,6 +774,11 @@ func (v *attrValidator) validateStartChildExecutionAttributes(
 		return err
 	}
 
+	// offloaded payloads belong to the workflow which offloaded them
+	if offload.IsReference(attributes.Input) {
+		return &types.BadRequestError{Message: "Input of child workflow must not be a reference to an offloaded payload."}
+	}
+
 	// Inherit tasklist from parent workflow execution if not provided on decision
 	taskList, err := v.validatedTaskList(attributes.TaskList, parentInfo.TaskList, metricsScope, attributes.GetDomain())
 	if err != nil {
@@ -154,6 +154,29 @@ func (s *attrValidatorSuite) TestValidateSignalExternalWorkflowExecutionAttribut
 	attributes.Input = []byte("test input")
 	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
 	s.NoError(err)
+
+	attributes.Input = []byte(`cadence-payload-ref:{"key":"payload_key","size":10}`)
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.EqualError(err, "BadRequestError{Message: Input of signal must not be a reference to an offloaded payload.}")
+}
+
+func (s *attrValidatorSuite) TestValidateContinueAsNewWorkflowExecutionAttributes_OffloadedInput() {
+	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return("test domain", nil).AnyTimes()
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:          s.testDomainID,
+		WorkflowTypeName:  "workflow-type",
+		TaskList:          "task-list",
+		OffloadedPayloads: map[string]int64{"payload_key": 1},
+	}
+	attributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		Input: []byte(`cadence-payload-ref:{"key":"payload_key","size":10}`),
+	}
+	err := s.validator.validateContinueAsNewWorkflowExecutionAttributes(attributes, executionInfo, metrics.HistoryRespondDecisionTaskCompletedScope, "test domain")
+	s.NoError(err)
+
+	attributes.Input = []byte(`cadence-payload-ref:{"key":"payload_other_key","size":10}`)
+	err = s.validator.validateContinueAsNewWorkflowExecutionAttributes(attributes, executionInfo, metrics.HistoryRespondDecisionTaskCompletedScope, "test domain")
+	s.EqualError(err, "BadRequestError{Message: Input of new run references a payload not offloaded by the workflow run.}")
 }
 
 func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
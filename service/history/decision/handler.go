@@ -104,7 +104,7 @@ func (handler *handlerImpl) HandleDecisionTaskScheduled(
 	req *types.ScheduleDecisionTaskRequest,
 ) error {
 
-	domainEntry, err := handler.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(handler.shard.GetDomainCache(), req.DomainUUID, req.GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -152,7 +152,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 	req *types.RecordDecisionTaskStartedRequest,
 ) (*types.RecordDecisionTaskStartedResponse, error) {
 
-	domainEntry, err := handler.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(handler.shard.GetDomainCache(), req.DomainUUID, req.GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +204,11 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.EntityNotExistsError{Message: "Decision task not found."}
 			}
 
+			if decision.StartedID == common.EmptyEventID && mutableState.GetExecutionInfo().PauseInfo != nil {
+				// no new decision is dispatched while the workflow is paused
+				return nil, workflow.ErrWorkflowPaused
+			}
+
 			updateAction := &workflow.UpdateAction{}
 
 			if decision.StartedID != common.EmptyEventID {
@@ -247,18 +252,18 @@ func (handler *handlerImpl) HandleDecisionTaskFailed(
 	req *types.HistoryRespondDecisionTaskFailedRequest,
 ) (retError error) {
 
-	domainEntry, err := handler.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return err
-	}
-	domainID := domainEntry.GetInfo().ID
-
 	request := req.FailedRequest
 	token, err := handler.tokenSerializer.Deserialize(request.TaskToken)
 	if err != nil {
 		return workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(handler.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -287,18 +292,18 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 	req *types.HistoryRespondDecisionTaskCompletedRequest,
 ) (resp *types.HistoryRespondDecisionTaskCompletedResponse, retError error) {
 
-	domainEntry, err := handler.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return nil, err
-	}
-	domainID := domainEntry.GetInfo().ID
-
 	request := req.CompleteRequest
 	token, err0 := handler.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
 		return nil, workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(handler.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -487,6 +492,8 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			// activities scheduled by the failed decision are discarded, so none of them can be dispatched
+			decisionResults = nil
 		}
 
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
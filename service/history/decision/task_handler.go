@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -244,6 +245,14 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingActivityInfos()),
+		handler.config.PendingActivitiesCountLimit,
+		"activities",
+	) {
+		return nil, nil
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeScheduleActivityTask.String()),
 		attr.Input,
@@ -257,7 +266,18 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 	event, ai, activityDispatchInfo, err := handler.mutableState.AddActivityTaskScheduledEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
+		if attr.RequestLocalDispatch && activityDispatchInfo == nil {
+			// the activity is not eligible for local dispatch, a transfer task was generated instead
+			handler.metricsClient.IncCounter(
+				metrics.HistoryRespondDecisionTaskCompletedScope,
+				metrics.ActivityLocalDispatchFallbackCounter,
+			)
+		}
 		if activityDispatchInfo != nil {
+			handler.metricsClient.IncCounter(
+				metrics.HistoryRespondDecisionTaskCompletedScope,
+				metrics.ActivityLocalDispatchCounter,
+			)
 			if _, err1 := handler.mutableState.AddActivityTaskStartedEvent(ai, event.GetEventID(), uuid.New(), handler.identity); err1 != nil {
 				return nil, err1
 			}
@@ -370,6 +390,14 @@ func (handler *taskHandlerImpl) handleDecisionStartTimer(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingTimerInfos()),
+		handler.config.PendingTimersCountLimit,
+		"timers",
+	) {
+		return nil
+	}
+
 	_, _, err := handler.mutableState.AddTimerStartedEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
@@ -678,6 +706,14 @@ func (handler *taskHandlerImpl) handleDecisionRequestCancelExternalWorkflow(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		handler.pendingExternalRequestsCount(),
+		handler.config.PendingExternalRequestsCountLimit,
+		"external workflow requests",
+	) {
+		return nil
+	}
+
 	cancelRequestID := uuid.New()
 	_, _, err := handler.mutableState.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 		handler.decisionTaskCompletedID, cancelRequestID, attr,
@@ -841,6 +877,14 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingChildExecutionInfos()),
+		handler.config.PendingChildExecutionsCountLimit,
+		"child workflow executions",
+	) {
+		return nil
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeStartChildWorkflowExecution.String()),
 		attr.Input,
@@ -910,6 +954,14 @@ func (handler *taskHandlerImpl) handleDecisionSignalExternalWorkflow(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		handler.pendingExternalRequestsCount(),
+		handler.config.PendingExternalRequestsCountLimit,
+		"external workflow requests",
+	) {
+		return nil
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		metrics.DecisionTypeTag(types.DecisionTypeSignalExternalWorkflowExecution.String()),
 		attr.Input,
@@ -1044,6 +1096,46 @@ func (handler *taskHandlerImpl) validateDecisionAttr(
 	return nil
 }
 
+// failDecisionIfPendingLimitExceeded fails the decision if the workflow execution already has the maximum
+// number of pending entities allowed for its domain, so that a single workflow cannot grow its mutable
+// state without bound. A limit of 0 means no limit.
+func (handler *taskHandlerImpl) failDecisionIfPendingLimitExceeded(
+	pendingCount int,
+	limitFn dynamicconfig.IntPropertyFnWithDomainFilter,
+	entityName string,
+) bool {
+
+	domainName := handler.domainEntry.GetInfo().Name
+	limit := limitFn(domainName)
+	if limit <= 0 || pendingCount < limit {
+		return false
+	}
+
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domainName),
+	).IncCounter(metrics.PendingLimitExceededDecisionsCounter)
+	handler.logger.Warn("Pending count limit exceeded, failing decision.",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+		tag.Counter(pendingCount),
+	)
+	// handlerFailDecision never returns an error
+	_ = handler.handlerFailDecision(
+		types.DecisionTaskFailedCausePendingLimitExceeded,
+		fmt.Sprintf("Number of pending %v exceeds limit: %v.", entityName, limit),
+	)
+	return true
+}
+
+// pendingExternalRequestsCount returns the number of outstanding signal and cancel requests to external workflows
+func (handler *taskHandlerImpl) pendingExternalRequestsCount() int {
+	return len(handler.mutableState.GetPendingSignalExternalInfos()) +
+		len(handler.mutableState.GetPendingRequestCancelExternalInfos())
+}
+
 func (handler *taskHandlerImpl) handlerFailDecision(
 	failedCause types.DecisionTaskFailedCause,
 	failMessage string,
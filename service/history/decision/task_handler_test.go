@@ -0,0 +1,157 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package decision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+type (
+	taskHandlerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller       *gomock.Controller
+		mockMutableState *execution.MockMutableState
+
+		config *config.Config
+	}
+)
+
+func TestTaskHandlerSuite(t *testing.T) {
+	suite.Run(t, new(taskHandlerSuite))
+}
+
+func (s *taskHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.config = config.NewForTest()
+	s.mockMutableState = execution.NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().HasBufferedEvents().Return(false).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}).AnyTimes()
+}
+
+func (s *taskHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *taskHandlerSuite) newTaskHandler() *taskHandlerImpl {
+	logger := loggerimpl.NewNopLogger()
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	return newDecisionTaskHandler(
+		"identity",
+		common.FirstEventID+2,
+		constants.TestLocalDomainEntry,
+		s.mockMutableState,
+		newAttrValidator(nil, metricsClient, s.config, logger),
+		nil,
+		common.NewJSONTaskTokenSerializer(),
+		logger,
+		nil,
+		metricsClient,
+		s.config,
+	)
+}
+
+func (s *taskHandlerSuite) TestHandleDecisionStartTimer_PendingLimitExceeded() {
+	s.config.PendingTimersCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{
+		"timer-1": {},
+		"timer-2": {},
+	})
+
+	handler := s.newTaskHandler()
+	err := handler.handleDecisionStartTimer(context.Background(), &types.StartTimerDecisionAttributes{
+		TimerID:                   "timer-3",
+		StartToFireTimeoutSeconds: common.Int64Ptr(10),
+	})
+	s.NoError(err)
+	s.True(handler.failDecision)
+	s.True(handler.stopProcessing)
+	s.Equal(types.DecisionTaskFailedCausePendingLimitExceeded, *handler.failDecisionCause)
+	s.Contains(*handler.failMessage, "timers")
+}
+
+func (s *taskHandlerSuite) TestHandleDecisionStartTimer_WithinPendingLimit() {
+	s.config.PendingTimersCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistence.TimerInfo{
+		"timer-1": {},
+	})
+	attr := &types.StartTimerDecisionAttributes{
+		TimerID:                   "timer-2",
+		StartToFireTimeoutSeconds: common.Int64Ptr(10),
+	}
+	s.mockMutableState.EXPECT().AddTimerStartedEvent(common.FirstEventID+2, attr).Return(nil, nil, nil)
+
+	handler := s.newTaskHandler()
+	s.NoError(handler.handleDecisionStartTimer(context.Background(), attr))
+	s.False(handler.failDecision)
+}
+
+func (s *taskHandlerSuite) TestFailDecisionIfPendingLimitExceeded_ExternalRequests() {
+	s.config.PendingExternalRequestsCountLimit = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.mockMutableState.EXPECT().GetPendingSignalExternalInfos().Return(map[int64]*persistence.SignalInfo{
+		5: {},
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(map[int64]*persistence.RequestCancelInfo{
+		6: {},
+	}).AnyTimes()
+
+	handler := s.newTaskHandler()
+	s.Equal(2, handler.pendingExternalRequestsCount())
+	s.True(handler.failDecisionIfPendingLimitExceeded(
+		handler.pendingExternalRequestsCount(),
+		s.config.PendingExternalRequestsCountLimit,
+		"external workflow requests",
+	))
+	s.Equal(types.DecisionTaskFailedCausePendingLimitExceeded, *handler.failDecisionCause)
+}
+
+func (s *taskHandlerSuite) TestFailDecisionIfPendingLimitExceeded_NoLimit() {
+	handler := s.newTaskHandler()
+	s.False(handler.failDecisionIfPendingLimitExceeded(
+		1000,
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		"activities",
+	))
+	s.False(handler.failDecision)
+}
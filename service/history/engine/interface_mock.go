@@ -361,6 +361,48 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockEngine) PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), ctx, request)
+}
+
+// OperatePendingActivity mocks base method
+func (m *MockEngine) OperatePendingActivity(ctx context.Context, request *types.HistoryOperatePendingActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperatePendingActivity", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OperatePendingActivity indicates an expected call of OperatePendingActivity
+func (mr *MockEngineMockRecorder) OperatePendingActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatePendingActivity", reflect.TypeOf((*MockEngine)(nil).OperatePendingActivity), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method
+func (m *MockEngine) UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution
+func (mr *MockEngineMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
 // ResetWorkflowExecution mocks base method
 func (m *MockEngine) ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -446,6 +488,20 @@ func (mr *MockEngineMockRecorder) SyncActivity(ctx, request interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncActivity", reflect.TypeOf((*MockEngine)(nil).SyncActivity), ctx, request)
 }
 
+// SyncCompletionCallbacks mocks base method
+func (m *MockEngine) SyncCompletionCallbacks(ctx context.Context, attributes *types.SyncCompletionCallbacksTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncCompletionCallbacks", ctx, attributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncCompletionCallbacks indicates an expected call of SyncCompletionCallbacks
+func (mr *MockEngineMockRecorder) SyncCompletionCallbacks(ctx, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncCompletionCallbacks", reflect.TypeOf((*MockEngine)(nil).SyncCompletionCallbacks), ctx, attributes)
+}
+
 // GetReplicationMessages mocks base method
 func (m *MockEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +547,36 @@ func (mr *MockEngineMockRecorder) GetCrossClusterTasks(ctx, targetCluster interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockEngine)(nil).GetCrossClusterTasks), ctx, targetCluster)
 }
 
+// GetReplicationStatus mocks base method
+func (m *MockEngine) GetReplicationStatus(ctx context.Context, remoteCluster string) (*types.ReplicationShardStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", ctx, remoteCluster)
+	ret0, _ := ret[0].(*types.ReplicationShardStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus
+func (mr *MockEngineMockRecorder) GetReplicationStatus(ctx, remoteCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), ctx, remoteCluster)
+}
+
+// GetReplicationReadiness mocks base method
+func (m *MockEngine) GetReplicationReadiness(ctx context.Context, sourceCluster string) (*types.ReplicationShardReadiness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationReadiness", ctx, sourceCluster)
+	ret0, _ := ret[0].(*types.ReplicationShardReadiness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationReadiness indicates an expected call of GetReplicationReadiness
+func (mr *MockEngineMockRecorder) GetReplicationReadiness(ctx, sourceCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationReadiness", reflect.TypeOf((*MockEngine)(nil).GetReplicationReadiness), ctx, sourceCluster)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method
 func (m *MockEngine) RespondCrossClusterTasksCompleted(ctx context.Context, targetCluster string, responses []*types.CrossClusterTaskResponse) error {
 	m.ctrl.T.Helper()
@@ -21,11 +21,14 @@
 package execution
 
 import (
+	"errors"
 	"fmt"
 
 	checksumgen "github.com/uber/cadence/.gen/go/checksum"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
@@ -112,3 +115,79 @@ func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateCh
 	payload.PendingReqCancelInitiatedIDs = requestCancelIDs
 	return payload
 }
+
+// GenerateReplicationChecksum generates a checksum of the persisted mutable state of a workflow which only
+// covers the fields replicated between clusters. Fields which are maintained by each cluster on its own
+// (sticky task list, transient decision, branch tokens and non current branches) are excluded, so the
+// checksums generated by two clusters for the same workflow match once replication has caught up.
+func GenerateReplicationChecksum(ms *persistence.WorkflowMutableState) (checksum.Checksum, error) {
+	if ms == nil || ms.ExecutionInfo == nil {
+		return checksum.Checksum{}, errors.New("mutable state has no execution info")
+	}
+	payload, err := newReplicationChecksumPayload(ms)
+	if err != nil {
+		return checksum.Checksum{}, err
+	}
+	return checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV1)
+}
+
+func newReplicationChecksumPayload(ms *persistence.WorkflowMutableState) (*checksumgen.MutableStateChecksumPayload, error) {
+	executionInfo := ms.ExecutionInfo
+	payload := &checksumgen.MutableStateChecksumPayload{
+		CancelRequested:      common.BoolPtr(executionInfo.CancelRequested),
+		State:                common.Int16Ptr(int16(executionInfo.State)),
+		LastFirstEventID:     common.Int64Ptr(executionInfo.LastFirstEventID),
+		NextEventID:          common.Int64Ptr(executionInfo.NextEventID),
+		LastProcessedEventID: common.Int64Ptr(executionInfo.LastProcessedEvent),
+		SignalCount:          common.Int64Ptr(int64(executionInfo.SignalCount)),
+	}
+
+	if ms.VersionHistories != nil {
+		currentVersionHistory, err := ms.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return nil, err
+		}
+		versionHistory := currentVersionHistory.ToInternalType()
+		versionHistory.BranchToken = nil
+		payload.VersionHistories = thrift.FromVersionHistories(&types.VersionHistories{
+			CurrentVersionHistoryIndex: 0,
+			Histories:                  []*types.VersionHistory{versionHistory},
+		})
+	}
+
+	pendingTimerIDs := make([]int64, 0, len(ms.TimerInfos))
+	for _, ti := range ms.TimerInfos {
+		pendingTimerIDs = append(pendingTimerIDs, ti.StartedID)
+	}
+	common.SortInt64Slice(pendingTimerIDs)
+	payload.PendingTimerStartedIDs = pendingTimerIDs
+
+	pendingActivityIDs := make([]int64, 0, len(ms.ActivityInfos))
+	for id := range ms.ActivityInfos {
+		pendingActivityIDs = append(pendingActivityIDs, id)
+	}
+	common.SortInt64Slice(pendingActivityIDs)
+	payload.PendingActivityScheduledIDs = pendingActivityIDs
+
+	pendingChildIDs := make([]int64, 0, len(ms.ChildExecutionInfos))
+	for id := range ms.ChildExecutionInfos {
+		pendingChildIDs = append(pendingChildIDs, id)
+	}
+	common.SortInt64Slice(pendingChildIDs)
+	payload.PendingChildInitiatedIDs = pendingChildIDs
+
+	signalIDs := make([]int64, 0, len(ms.SignalInfos))
+	for id := range ms.SignalInfos {
+		signalIDs = append(signalIDs, id)
+	}
+	common.SortInt64Slice(signalIDs)
+	payload.PendingSignalInitiatedIDs = signalIDs
+
+	requestCancelIDs := make([]int64, 0, len(ms.RequestCancelInfos))
+	for id := range ms.RequestCancelInfos {
+		requestCancelIDs = append(requestCancelIDs, id)
+	}
+	common.SortInt64Slice(requestCancelIDs)
+	payload.PendingReqCancelInitiatedIDs = requestCancelIDs
+	return payload, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGenerateReplicationChecksum(t *testing.T) {
+	newMutableState := func(branchToken string, stickyTaskList string, nextEventID int64) *persistence.WorkflowMutableState {
+		return &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				NextEventID:     nextEventID,
+				StickyTaskList:  stickyTaskList,
+				DecisionAttempt: 2,
+			},
+			VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistoryFromInternalType(&types.VersionHistory{
+				BranchToken: []byte(branchToken),
+				Items:       []*types.VersionHistoryItem{{EventID: nextEventID - 1, Version: 1}},
+			})),
+			ActivityInfos: map[int64]*persistence.ActivityInfo{5: {}, 7: {}},
+			TimerInfos:    map[string]*persistence.TimerInfo{"t1": {StartedID: 6}},
+		}
+	}
+
+	csum, err := GenerateReplicationChecksum(newMutableState("branch-1", "sticky-1", 10))
+	require.NoError(t, err)
+	// branch tokens and sticky task lists are maintained by each cluster on its own
+	remoteCsum, err := GenerateReplicationChecksum(newMutableState("branch-2", "sticky-2", 10))
+	require.NoError(t, err)
+	require.Equal(t, csum, remoteCsum)
+
+	laggingCsum, err := GenerateReplicationChecksum(newMutableState("branch-2", "sticky-2", 8))
+	require.NoError(t, err)
+	require.NotEqual(t, csum.Value, laggingCsum.Value)
+
+	_, err = GenerateReplicationChecksum(&persistence.WorkflowMutableState{})
+	require.Error(t, err)
+}
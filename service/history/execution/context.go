@@ -1155,7 +1155,7 @@ func (c *contextImpl) ReapplyEvents(
 	ctx, cancel := context.WithTimeout(context.Background(), defaultRemoteCallTimeout)
 	defer cancel()
 
-	activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	activeCluster := domainEntry.GetActiveClusterForWorkflow(workflowID)
 	if activeCluster == c.shard.GetClusterMetadata().GetCurrentClusterName() {
 		return c.shard.GetEngine().ReapplyEvents(
 			ctx,
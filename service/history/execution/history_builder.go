@@ -192,6 +192,22 @@ func (b *HistoryBuilder) AddActivityTaskFailedEvent(scheduleEventID, StartedEven
 	return b.addEventToHistory(event)
 }
 
+// AddActivityTaskOptionsUpdatedEvent adds ActivityTaskOptionsUpdated event to history
+func (b *HistoryBuilder) AddActivityTaskOptionsUpdatedEvent(scheduleEventID int64,
+	request *types.OperatePendingActivityRequest) *types.HistoryEvent {
+	event := b.newActivityTaskOptionsUpdatedEvent(scheduleEventID, request)
+
+	return b.addEventToHistory(event)
+}
+
+// AddActivityTaskRetryRequestedEvent adds ActivityTaskRetryRequested event to history
+func (b *HistoryBuilder) AddActivityTaskRetryRequestedEvent(scheduleEventID int64,
+	resetAttempts bool, identity string) *types.HistoryEvent {
+	event := b.newActivityTaskRetryRequestedEvent(scheduleEventID, resetAttempts, identity)
+
+	return b.addEventToHistory(event)
+}
+
 // AddActivityTaskTimedOutEvent adds ActivityTaskTimedOut event to history
 func (b *HistoryBuilder) AddActivityTaskTimedOutEvent(
 	scheduleEventID,
@@ -450,6 +466,22 @@ func (b *HistoryBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(
+	reason string, identity string, allowActivities bool) *types.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(reason, identity, allowActivities)
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string, identity string) *types.HistoryEvent {
+	event := b.newWorkflowExecutionUnpausedEvent(reason, identity)
+
+	return b.addEventToHistory(event)
+}
+
 // AddStartChildWorkflowExecutionInitiatedEvent adds ChildWorkflowExecutionInitiated event to history
 func (b *HistoryBuilder) AddStartChildWorkflowExecutionInitiatedEvent(
 	decisionCompletedEventID int64,
@@ -576,6 +608,7 @@ func (b *HistoryBuilder) newWorkflowExecutionStartedEvent(
 		OriginalExecutionRunID:              originalRunID,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
+		CompletionCallbacks:                 request.CompletionCallbacks,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -755,6 +788,34 @@ func (b *HistoryBuilder) newActivityTaskFailedEvent(scheduleEventID, StartedEven
 	return historyEvent
 }
 
+func (b *HistoryBuilder) newActivityTaskOptionsUpdatedEvent(scheduleEventID int64,
+	request *types.OperatePendingActivityRequest) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskOptionsUpdated)
+	attributes := &types.ActivityTaskOptionsUpdatedEventAttributes{}
+	attributes.ScheduledEventID = scheduleEventID
+	attributes.ScheduleToStartTimeoutSeconds = request.ScheduleToStartTimeoutSeconds
+	attributes.ScheduleToCloseTimeoutSeconds = request.ScheduleToCloseTimeoutSeconds
+	attributes.StartToCloseTimeoutSeconds = request.StartToCloseTimeoutSeconds
+	attributes.HeartbeatTimeoutSeconds = request.HeartbeatTimeoutSeconds
+	attributes.RetryPolicy = request.RetryPolicy
+	attributes.Identity = request.Identity
+	historyEvent.ActivityTaskOptionsUpdatedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *HistoryBuilder) newActivityTaskRetryRequestedEvent(scheduleEventID int64,
+	resetAttempts bool, identity string) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeActivityTaskRetryRequested)
+	attributes := &types.ActivityTaskRetryRequestedEventAttributes{}
+	attributes.ScheduledEventID = scheduleEventID
+	attributes.ResetAttempts = resetAttempts
+	attributes.Identity = identity
+	historyEvent.ActivityTaskRetryRequestedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *HistoryBuilder) newCompleteWorkflowExecutionEvent(decisionTaskCompletedEventID int64,
 	request *types.CompleteWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionCompleted)
@@ -799,6 +860,29 @@ func (b *HistoryBuilder) newWorkflowExecutionSignaledEvent(
 	return historyEvent
 }
 
+func (b *HistoryBuilder) newWorkflowExecutionPausedEvent(
+	reason string, identity string, allowActivities bool) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	attributes := &types.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = reason
+	attributes.Identity = identity
+	attributes.AllowActivities = allowActivities
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *HistoryBuilder) newWorkflowExecutionUnpausedEvent(
+	reason string, identity string) *types.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	attributes := &types.WorkflowExecutionUnpausedEventAttributes{}
+	attributes.Reason = reason
+	attributes.Identity = identity
+	historyEvent.WorkflowExecutionUnpausedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *HistoryBuilder) newWorkflowExecutionTerminatedEvent(
 	reason string, details []byte, identity string) *types.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionTerminated)
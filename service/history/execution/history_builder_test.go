@@ -216,13 +216,13 @@ func (s *historyBuilderSuite) TestHistoryBuilderDynamicSuccess() {
 
 	activity4ID := "activity4"
 	activity4Type := "dynamic-historybuilder-success-activity4-type"
-	activity4Domain := s.targetDomainName
+	activity4Domain := ""
 	activity4Input := []byte("dynamic-historybuilder-success-activity4-input")
 	activity4Result := []byte("dynamic-historybuilder-success-activity4-result")
 	activity4ScheduledEvent, _, activityDispatchInfo := s.addActivityTaskScheduledEvent(4, activity4ID, activity4Type,
-		activity4Domain, activityTaskList, activity4Input, activityTimeout, queueTimeout, hearbeatTimeout, nil, true)
+		activity4Domain, tl, activity4Input, activityTimeout, queueTimeout, hearbeatTimeout, nil, true)
 	s.validateActivityTaskScheduledEvent(activity4ScheduledEvent, 8, 4, activity4ID, activity4Type,
-		activity4Domain, activityTaskList, activity4Input, activityTimeout, queueTimeout, hearbeatTimeout, activityDispatchInfo, true)
+		activity4Domain, tl, activity4Input, activityTimeout, queueTimeout, hearbeatTimeout, activityDispatchInfo, true)
 	s.Equal(int64(9), s.getNextEventID())
 	ai4, activity4Running0 := s.msBuilder.GetActivityInfo(8)
 	s.True(activity4Running0)
@@ -243,8 +243,9 @@ func (s *historyBuilderSuite) TestHistoryBuilderDynamicSuccess() {
 	}
 	activity5ScheduledEvent, _, activityDispatchInfo := s.addActivityTaskScheduledEvent(4, activity5ID, activity5Type,
 		activity5Domain, activityTaskList, activity5Input, activityTimeout, queueTimeout, hearbeatTimeout, activity5RetryPolicy, true)
+	// activity is scheduled in another domain, so it is not dispatched locally even if requested
 	s.validateActivityTaskScheduledEvent(activity5ScheduledEvent, 9, 4, activity5ID, activity5Type,
-		activity5Domain, activityTaskList, activity5Input, activityTimeout, queueTimeout, hearbeatTimeout, activityDispatchInfo, true)
+		activity5Domain, activityTaskList, activity5Input, activityTimeout, queueTimeout, hearbeatTimeout, activityDispatchInfo, false)
 	s.Equal(int64(10), s.getNextEventID())
 	ai5, activity5Running0 := s.msBuilder.GetActivityInfo(9)
 	s.True(activity5Running0)
@@ -1203,7 +1204,7 @@ func (s *historyBuilderSuite) validateActivityTaskScheduledEvent(
 	input []byte, timeout,
 	queueTimeout, hearbeatTimeout int32,
 	activityDispatchInfo *types.ActivityLocalDispatchInfo,
-	requestLocalDispatch bool,
+	expectLocalDispatch bool,
 ) {
 	s.NotNil(event)
 	s.Equal(types.EventTypeActivityTaskScheduled, *event.EventType)
@@ -1223,7 +1224,7 @@ func (s *historyBuilderSuite) validateActivityTaskScheduledEvent(
 	} else {
 		s.Nil(attributes.Domain)
 	}
-	if requestLocalDispatch {
+	if expectLocalDispatch {
 		s.NotNil(activityDispatchInfo)
 	} else {
 		s.Nil(activityDispatchInfo)
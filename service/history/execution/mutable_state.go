@@ -61,6 +61,8 @@ type (
 		AddActivityTaskCanceledEvent(int64, int64, int64, []uint8, string) (*types.HistoryEvent, error)
 		AddActivityTaskCompletedEvent(int64, int64, *types.RespondActivityTaskCompletedRequest) (*types.HistoryEvent, error)
 		AddActivityTaskFailedEvent(int64, int64, *types.RespondActivityTaskFailedRequest) (*types.HistoryEvent, error)
+		AddActivityTaskOptionsUpdatedEvent(int64, *types.OperatePendingActivityRequest) (*types.HistoryEvent, error)
+		AddActivityTaskRetryRequestedEvent(int64, bool, string) (*types.HistoryEvent, error)
 		AddActivityTaskScheduledEvent(int64, *types.ScheduleActivityTaskDecisionAttributes) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, error)
 		AddActivityTaskStartedEvent(*persistence.ActivityInfo, int64, string, string) (*types.HistoryEvent, error)
 		AddActivityTaskTimedOutEvent(int64, int64, types.TimeoutType, []uint8) (*types.HistoryEvent, error)
@@ -101,9 +103,11 @@ type (
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string, allowActivities bool) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -173,6 +177,8 @@ type (
 		ReplicateActivityTaskCanceledEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskCompletedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskFailedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskOptionsUpdatedEvent(*types.HistoryEvent) error
+		ReplicateActivityTaskRetryRequestedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskScheduledEvent(int64, *types.HistoryEvent) (*persistence.ActivityInfo, error)
 		ReplicateActivityTaskStartedEvent(*types.HistoryEvent) error
 		ReplicateActivityTaskTimedOutEvent(*types.HistoryEvent) error
@@ -205,9 +211,11 @@ type (
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent)
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent)
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *types.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *HistoryBuilder)
@@ -215,6 +223,7 @@ type (
 		SetVersionHistories(*persistence.VersionHistories) error
 		UpdateActivity(*persistence.ActivityInfo) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest)
+		UpdateCompletionCallbackInfos([]*types.CompletionCallbackInfo) bool
 		UpdateDecision(*DecisionInfo)
 		UpdateUserTimer(*persistence.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/clock"
@@ -40,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -112,8 +114,9 @@ type (
 		updateBufferedEvents []*types.HistoryEvent // buffered history events that needs to be persisted
 		clearBufferedEvents  bool                  // delete buffered events from persistence
 
-		executionInfo    *persistence.WorkflowExecutionInfo // Workflow mutable state info.
-		versionHistories *persistence.VersionHistories
+		executionInfo           *persistence.WorkflowExecutionInfo // Workflow mutable state info.
+		syncCompletionCallbacks bool                               // Delivery state of completion callbacks to be sync to remote
+		versionHistories        *persistence.VersionHistories
 		// TODO: remove this struct after all 2DC workflows complete
 		replicationState *persistence.ReplicationState
 		hBuilder         *HistoryBuilder
@@ -532,6 +535,18 @@ func (e *mutableStateBuilder) UpdateCurrentVersion(
 	return nil
 }
 
+// updateCurrentVersionForWorkflow sets the version of a new workflow active in another cluster than the domain
+func (e *mutableStateBuilder) updateCurrentVersionForWorkflow(
+	workflowID string,
+) error {
+
+	if !e.domainEntry.IsGlobalDomain() ||
+		e.domainEntry.GetActiveClusterForWorkflow(workflowID) == e.domainEntry.GetReplicationConfig().ActiveClusterName {
+		return nil
+	}
+	return e.UpdateCurrentVersion(e.domainEntry.GetFailoverVersionForWorkflow(workflowID), true)
+}
+
 func (e *mutableStateBuilder) GetCurrentVersion() int64 {
 
 	// TODO: remove this after all 2DC workflows complete
@@ -666,6 +681,7 @@ func (e *mutableStateBuilder) assignEventIDToBufferedEvents() {
 		eventID := e.executionInfo.NextEventID
 		event.EventID = eventID
 		e.executionInfo.IncreaseNextEventID()
+		e.addOffloadedPayloads(event)
 
 		switch event.GetEventType() {
 		case types.EventTypeActivityTaskStarted:
@@ -1254,6 +1270,36 @@ func (e *mutableStateBuilder) UpdateActivityProgress(
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 }
 
+// UpdateCompletionCallbackInfos records the delivery state of the completion callbacks. The state of a callback
+// is only replaced by a newer one, see isCompletionCallbackInfoNewer, so a stale state written by a concurrent
+// delivery attempt or an out of order replication task is dropped. Returns whether the delivery state changed.
+func (e *mutableStateBuilder) UpdateCompletionCallbackInfos(
+	infos []*types.CompletionCallbackInfo,
+) bool {
+
+	current := e.executionInfo.CompletionCallbacks
+	if len(infos) != len(current) {
+		e.logError(
+			fmt.Sprintf("unable to update %v completion callbacks, mutable state has %v", len(infos), len(current)),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return false
+	}
+
+	updated := false
+	for i, info := range infos {
+		if isCompletionCallbackInfoNewer(info, current[i]) {
+			infoCopy := *info
+			current[i] = &infoCopy
+			updated = true
+		}
+	}
+	if updated {
+		e.syncCompletionCallbacks = true
+	}
+	return updated
+}
+
 // ReplicateActivityInfo replicate the necessary activity information
 func (e *mutableStateBuilder) ReplicateActivityInfo(
 	request *types.SyncActivityRequest,
@@ -1595,9 +1641,14 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	execution types.WorkflowExecution,
 	previousExecutionState MutableState,
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
+	completionCallbacks []*types.CompletionCallback,
 	firstRunID string,
 ) (*types.HistoryEvent, error) {
 
+	if err := e.updateCurrentVersionForWorkflow(execution.GetWorkflowID()); err != nil {
+		return nil, err
+	}
+
 	previousExecutionInfo := previousExecutionState.GetExecutionInfo()
 	taskList := previousExecutionInfo.TaskList
 	if attributes.TaskList != nil {
@@ -1632,6 +1683,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		CronSchedule:                        attributes.CronSchedule,
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
+		// completion callbacks are invoked when the last run of the chain closes, so they are carried over to the new run
+		CompletionCallbacks: completionCallbacks,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -1722,6 +1775,9 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 			tag.ErrorTypeInvalidHistoryAction)
 		return nil, e.createInternalServerError(opTag)
 	}
+	if err := e.updateCurrentVersionForWorkflow(execution.GetWorkflowID()); err != nil {
+		return nil, err
+	}
 
 	event := e.hBuilder.AddWorkflowExecutionStartedEvent(startRequest, nil, execution.GetRunID(), execution.GetRunID())
 
@@ -1823,9 +1879,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	if event.Memo != nil {
 		e.executionInfo.Memo = event.Memo.GetFields()
 	}
+	e.executionInfo.CompletionCallbacks = common.NewCompletionCallbackInfos(event.CompletionCallbacks)
 	if event.SearchAttributes != nil {
 		e.executionInfo.SearchAttributes = event.SearchAttributes.GetIndexedFields()
 	}
+	e.addOffloadedPayloads(startEvent)
 
 	e.writeEventToCache(startEvent)
 	return nil
@@ -2129,7 +2187,7 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	if e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) && attributes.RequestLocalDispatch {
+	if attributes.RequestLocalDispatch && e.canDispatchActivityLocally(ai) {
 		return event, ai, &types.ActivityLocalDispatchInfo{ActivityID: ai.ActivityID}, nil
 	}
 	// TODO merge active & passive task generation
@@ -2142,6 +2200,18 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 	return event, ai, nil, err
 }
 
+// canDispatchActivityLocally returns true if the activity can be handed to the worker completing the decision
+// instead of going through the transfer queue and matching. The worker only polls the task list of the workflow
+// in the workflow's domain, so activities scheduled anywhere else always take the regular path.
+func (e *mutableStateBuilder) canDispatchActivityLocally(
+	ai *persistence.ActivityInfo,
+) bool {
+	if !e.config.EnableActivityLocalDispatchByDomain(e.domainEntry.GetInfo().Name) {
+		return false
+	}
+	return ai.DomainID == e.executionInfo.DomainID && ai.TaskList == e.executionInfo.TaskList
+}
+
 func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 	firstEventID int64,
 	event *types.HistoryEvent,
@@ -2318,6 +2388,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskCompletedEvent(
 
 	attributes := event.ActivityTaskCompletedEventAttributes
 	scheduleID := attributes.GetScheduledEventID()
+	e.addOffloadedPayloads(event)
 
 	return e.DeleteActivity(scheduleID)
 }
@@ -2364,6 +2435,143 @@ func (e *mutableStateBuilder) ReplicateActivityTaskFailedEvent(
 	return e.DeleteActivity(scheduleID)
 }
 
+func (e *mutableStateBuilder) AddActivityTaskOptionsUpdatedEvent(
+	scheduleEventID int64,
+	request *types.OperatePendingActivityRequest,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskOptionsUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.GetActivityInfo(scheduleEventID); !ok {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.WorkflowScheduleID(scheduleEventID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskOptionsUpdatedEvent(scheduleEventID, request)
+	if err := e.ReplicateActivityTaskOptionsUpdatedEvent(event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskOptionsUpdatedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskOptionsUpdatedEventAttributes
+	scheduleID := attributes.GetScheduledEventID()
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity event id: %v in mutable state", scheduleID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	if attributes.ScheduleToStartTimeoutSeconds != nil {
+		ai.ScheduleToStartTimeout = attributes.GetScheduleToStartTimeoutSeconds()
+	}
+	if attributes.ScheduleToCloseTimeoutSeconds != nil {
+		ai.ScheduleToCloseTimeout = attributes.GetScheduleToCloseTimeoutSeconds()
+	}
+	if attributes.StartToCloseTimeoutSeconds != nil {
+		ai.StartToCloseTimeout = attributes.GetStartToCloseTimeoutSeconds()
+	}
+	if attributes.HeartbeatTimeoutSeconds != nil {
+		ai.HeartbeatTimeout = attributes.GetHeartbeatTimeoutSeconds()
+	}
+	if policy := attributes.RetryPolicy; policy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = policy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = policy.GetBackoffCoefficient()
+		ai.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = policy.GetMaximumAttempts()
+		ai.NonRetriableErrors = policy.NonRetriableErrorReasons
+		ai.ExpirationTime = time.Time{}
+		if policy.GetExpirationIntervalInSeconds() != 0 {
+			expirationInterval := time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second
+			ai.ExpirationTime = time.Unix(0, event.GetTimestamp()).Add(expirationInterval)
+		}
+	}
+
+	ai.Version = event.GetVersion()
+	// timers are regenerated from the new options when the transaction closes,
+	// timers created with the old options are dropped once they fire
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
+
+func (e *mutableStateBuilder) AddActivityTaskRetryRequestedEvent(
+	scheduleEventID int64,
+	resetAttempts bool,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionActivityTaskRetryRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != common.EmptyEventID {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.WorkflowScheduleID(scheduleEventID))
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddActivityTaskRetryRequestedEvent(scheduleEventID, resetAttempts, identity)
+	if err := e.ReplicateActivityTaskRetryRequestedEvent(event); err != nil {
+		return nil, err
+	}
+
+	// retry timers are only created in the active cluster
+	if err := e.taskGenerator.GenerateActivityRetryTasks(
+		scheduleEventID,
+	); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateActivityTaskRetryRequestedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	attributes := event.ActivityTaskRetryRequestedEventAttributes
+	scheduleID := attributes.GetScheduledEventID()
+	ai, ok := e.GetActivityInfo(scheduleID)
+	if !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity event id: %v in mutable state", scheduleID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	if attributes.GetResetAttempts() {
+		// the retry timer of the previous attempt is dropped as its attempt no longer matches
+		ai.Attempt = 0
+	}
+	ai.Version = event.GetVersion()
+	ai.ScheduledTime = time.Unix(0, event.GetTimestamp())
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	return nil
+}
+
 func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(
 	scheduleEventID int64,
 	startedEventID int64,
@@ -3266,9 +3474,75 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	e.addOffloadedPayloads(event)
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+	allowActivities bool,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity, allowActivities)
+	e.ReplicateWorkflowExecutionPausedEvent(event)
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) {
+
+	attributes := event.WorkflowExecutionPausedEventAttributes
+	e.executionInfo.PauseInfo = &persistence.WorkflowPauseInfo{
+		Reason:          attributes.GetReason(),
+		Identity:        attributes.GetIdentity(),
+		PausedTimestamp: time.Unix(0, event.GetTimestamp()),
+		AllowActivities: attributes.GetAllowActivities(),
+	}
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	e.ReplicateWorkflowExecutionUnpausedEvent(event)
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) {
+
+	e.executionInfo.PauseInfo = nil
+}
+
+// addOffloadedPayloads records the offloaded payloads referenced by the event, so that they are deleted with the
+// workflow. Buffered events are recorded once they are assigned their event ID.
+func (e *mutableStateBuilder) addOffloadedPayloads(event *types.HistoryEvent) {
+	if event.EventID == common.BufferedEventID {
+		return
+	}
+	for _, key := range offload.ReferencedKeys(event) {
+		if e.executionInfo.OffloadedPayloads == nil {
+			e.executionInfo.OffloadedPayloads = make(map[string]int64)
+		}
+		e.executionInfo.OffloadedPayloads[key] = event.EventID
+	}
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	ctx context.Context,
 	firstEventID int64,
@@ -3321,6 +3595,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		newExecution,
 		e,
 		attributes,
+		currentStartEvent.GetWorkflowExecutionStartedEventAttributes().GetCompletionCallbacks(),
 		firstRunID,
 	); err != nil {
 		return nil, nil, &types.InternalServiceError{Message: "Failed to add workflow execution started event."}
@@ -3380,6 +3655,12 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(
 	}
 	e.executionInfo.CompletionEventBatchID = firstEventID // Used when completion event needs to be loaded from database
 	e.ClearStickyness()
+	// completion callbacks are carried over to and invoked by the new run
+	e.executionInfo.CompletionCallbacks = nil
+	// the input may be passed on to the new run (e.g. for cron and retry), which then owns the offloaded payload
+	if ref, err := offload.DecodeReference(continueAsNewEvent.WorkflowExecutionContinuedAsNewEventAttributes.Input); err == nil {
+		delete(e.executionInfo.OffloadedPayloads, ref.Key)
+	}
 	e.writeEventToCache(continueAsNewEvent)
 	return nil
 }
@@ -3947,7 +4228,7 @@ func (e *mutableStateBuilder) StartTransaction(
 ) (bool, error) {
 
 	e.domainEntry = domainEntry
-	if err := e.UpdateCurrentVersion(domainEntry.GetFailoverVersion(), false); err != nil {
+	if err := e.UpdateCurrentVersion(domainEntry.GetFailoverVersionForWorkflow(e.executionInfo.WorkflowID), false); err != nil {
 		return false, err
 	}
 
@@ -4175,6 +4456,7 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateActivityInfos = make(map[int64]*persistence.ActivityInfo)
 	e.deleteActivityInfos = make(map[int64]struct{})
 	e.syncActivityTasks = make(map[int64]struct{})
+	e.syncCompletionCallbacks = false
 
 	e.updateTimerInfos = make(map[string]*persistence.TimerInfo)
 	e.deleteTimerInfos = make(map[string]struct{})
@@ -4253,12 +4535,24 @@ func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 			e.insertReplicationTasks,
 			replicationTasks...,
 		)
+		e.insertTransferTasks = append(
+			e.insertTransferTasks,
+			e.eventsToHistoryExportTask(transactionPolicy, workflowEvents.Events)...,
+		)
 	}
 
 	e.insertReplicationTasks = append(
 		e.insertReplicationTasks,
 		e.syncActivityToReplicationTask(transactionPolicy)...,
 	)
+	syncCompletionCallbacksTasks, err := e.syncCompletionCallbacksToReplicationTask(transactionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	e.insertReplicationTasks = append(
+		e.insertReplicationTasks,
+		syncCompletionCallbacksTasks...,
+	)
 
 	if transactionPolicy == TransactionPolicyPassive && len(e.insertReplicationTasks) > 0 {
 		return nil, &types.InternalServiceError{
@@ -4310,6 +4604,38 @@ func (e *mutableStateBuilder) eventsToReplicationTask(
 	return []persistence.Task{replicationTask}, nil
 }
 
+// eventsToHistoryExportTask generates the task exporting the history batch when history export
+// is enabled for the domain, the batch is only exported by the active cluster. In lifecycle mode
+// the task is only generated for batches with lifecycle events.
+func (e *mutableStateBuilder) eventsToHistoryExportTask(
+	transactionPolicy TransactionPolicy,
+	events []*types.HistoryEvent,
+) []persistence.Task {
+
+	if transactionPolicy == TransactionPolicyPassive || len(events) == 0 {
+		return emptyTasks
+	}
+
+	exportTask := []persistence.Task{&persistence.HistoryExportTask{
+		Version:      events[0].Version,
+		FirstEventID: events[0].EventID,
+	}}
+	switch e.config.HistoryExportMode(e.GetDomainEntry().GetInfo().Name) {
+	case messaging.HistoryExportModeBatch:
+		return exportTask
+	case messaging.HistoryExportModeLifecycle:
+		// only batches with lifecycle events are read and exported
+		for _, event := range events {
+			if messaging.IsHistoryExportLifecycleEvent(event.GetEventType()) {
+				return exportTask
+			}
+		}
+		return emptyTasks
+	default:
+		return emptyTasks
+	}
+}
+
 func (e *mutableStateBuilder) syncActivityToReplicationTask(
 	transactionPolicy TransactionPolicy,
 ) []persistence.Task {
@@ -4325,6 +4651,27 @@ func (e *mutableStateBuilder) syncActivityToReplicationTask(
 	)
 }
 
+// syncCompletionCallbacksToReplicationTask generates the task replicating the delivery state of the completion
+// callbacks, which is not part of history, so the standby clusters know the state after a failover
+func (e *mutableStateBuilder) syncCompletionCallbacksToReplicationTask(
+	transactionPolicy TransactionPolicy,
+) ([]persistence.Task, error) {
+
+	if transactionPolicy == TransactionPolicyPassive ||
+		!e.syncCompletionCallbacks ||
+		!e.canReplicateEvents() {
+		return emptyTasks, nil
+	}
+
+	lastWriteVersion, err := e.GetLastWriteVersion()
+	if err != nil {
+		return nil, err
+	}
+	return []persistence.Task{&persistence.SyncCompletionCallbacksTask{
+		Version: lastWriteVersion,
+	}}, nil
+}
+
 func (e *mutableStateBuilder) updateWithLastWriteEvent(
 	lastEvent *types.HistoryEvent,
 	transactionPolicy TransactionPolicy,
@@ -21,21 +21,27 @@
 package execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -532,6 +538,138 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionPausedAndUnpaused() {
+	s.msBuilder.ReplicateWorkflowExecutionPausedEvent(&types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionPaused.Ptr(),
+		Timestamp: common.Int64Ptr(123),
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{
+			Reason:          "incident",
+			Identity:        "operator",
+			AllowActivities: true,
+		},
+	})
+	s.Equal(&persistence.WorkflowPauseInfo{
+		Reason:          "incident",
+		Identity:        "operator",
+		PausedTimestamp: time.Unix(0, 123),
+		AllowActivities: true,
+	}, s.msBuilder.executionInfo.PauseInfo)
+	s.Nil(s.msBuilder.executionInfo.Memo)
+
+	s.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(&types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		Timestamp: common.Int64Ptr(456),
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{
+			Reason:   "resolved",
+			Identity: "operator",
+		},
+	})
+	s.Nil(s.msBuilder.executionInfo.PauseInfo)
+}
+
+func (s *mutableStateSuite) TestOffloadedPayloads() {
+	blobstoreClient := s.mockShard.Resource.BlobstoreClient
+	blobstoreClient.On("Put", mock.Anything, mock.Anything).Return(&blobstore.PutResponse{}, nil)
+	offloadPayload := func() ([]byte, string) {
+		payload, err := offload.NewPayloadStore(blobstoreClient).Offload(context.Background(), constants.TestDomainID, constants.TestWorkflowID, "", []byte("payload"))
+		s.NoError(err)
+		ref, err := offload.DecodeReference(payload)
+		s.NoError(err)
+		return payload, ref.Key
+	}
+	inputRef, inputKey := offloadPayload()
+	signalRef, signalKey := offloadPayload()
+	bufferedSignalRef, bufferedSignalKey := offloadPayload()
+	s.msBuilder.executionInfo.State = persistence.WorkflowStateRunning
+	s.msBuilder.executionInfo.NextEventID = 10
+
+	s.msBuilder.addOffloadedPayloads(&types.HistoryEvent{
+		EventID:                                 1,
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: inputRef},
+	})
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		EventID:                                  5,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: signalRef},
+	}))
+	bufferedSignal := &types.HistoryEvent{
+		EventID:                                  common.BufferedEventID,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: bufferedSignalRef},
+	}
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(bufferedSignal))
+	s.Equal(map[string]int64{inputKey: 1, signalKey: 5}, s.msBuilder.executionInfo.OffloadedPayloads)
+
+	// buffered events are recorded once they are flushed
+	s.msBuilder.hBuilder.history = []*types.HistoryEvent{bufferedSignal}
+	s.msBuilder.assignEventIDToBufferedEvents()
+	s.Equal(map[string]int64{inputKey: 1, signalKey: 5, bufferedSignalKey: 10}, s.msBuilder.executionInfo.OffloadedPayloads)
+
+	// the input passed on to the new run is owned by the new run
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionContinuedAsNewEvent(11, constants.TestDomainID, &types.HistoryEvent{
+		EventID: 11,
+		WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{Input: inputRef},
+	}))
+	s.Equal(map[string]int64{signalKey: 5, bufferedSignalKey: 10}, s.msBuilder.executionInfo.OffloadedPayloads)
+}
+
+func (s *mutableStateSuite) TestUpdateCompletionCallbackInfos() {
+	s.msBuilder.domainEntry = constants.TestGlobalDomainEntry
+	s.msBuilder.executionInfo.CompletionCallbacks = []*types.CompletionCallbackInfo{
+		{URL: "http://a", State: types.CompletionCallbackStatePending.Ptr(), Attempt: 2},
+		{URL: "http://b", State: types.CompletionCallbackStatePending.Ptr(), Attempt: 1},
+	}
+
+	// a mismatching number of callbacks is rejected
+	s.False(s.msBuilder.UpdateCompletionCallbackInfos(nil))
+
+	// a stale state does not overwrite the current one
+	s.False(s.msBuilder.UpdateCompletionCallbackInfos([]*types.CompletionCallbackInfo{
+		{URL: "http://a", State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 1},
+		{URL: "http://b", State: types.CompletionCallbackStatePending.Ptr(), Attempt: 1},
+	}))
+	s.False(s.msBuilder.syncCompletionCallbacks)
+	tasks, err := s.msBuilder.syncCompletionCallbacksToReplicationTask(TransactionPolicyActive)
+	s.NoError(err)
+	s.Empty(tasks)
+
+	s.True(s.msBuilder.UpdateCompletionCallbackInfos([]*types.CompletionCallbackInfo{
+		{URL: "http://a", State: types.CompletionCallbackStatePending.Ptr(), Attempt: 2},
+		{URL: "http://b", State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 1},
+	}))
+	s.Equal(types.CompletionCallbackStatePending, s.msBuilder.executionInfo.CompletionCallbacks[0].GetState())
+	s.Equal(types.CompletionCallbackStateSucceeded, s.msBuilder.executionInfo.CompletionCallbacks[1].GetState())
+
+	tasks, err = s.msBuilder.syncCompletionCallbacksToReplicationTask(TransactionPolicyPassive)
+	s.NoError(err)
+	s.Empty(tasks)
+	tasks, err = s.msBuilder.syncCompletionCallbacksToReplicationTask(TransactionPolicyActive)
+	s.NoError(err)
+	s.Equal([]persistence.Task{&persistence.SyncCompletionCallbacksTask{Version: common.EmptyVersion}}, tasks)
+}
+
+func (s *mutableStateSuite) TestEventsToHistoryExportTask() {
+	decisionEvents := []*types.HistoryEvent{
+		{EventID: 5, Version: 12, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		{EventID: 6, Version: 12, EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+	}
+	closeEvents := []*types.HistoryEvent{
+		{EventID: 5, Version: 12, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		{EventID: 6, Version: 12, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr()},
+	}
+	exportTask := []persistence.Task{&persistence.HistoryExportTask{Version: 12, FirstEventID: 5}}
+
+	s.mockShard.GetConfig().HistoryExportMode = func(string) string { return messaging.HistoryExportModeDisabled }
+	s.Empty(s.msBuilder.eventsToHistoryExportTask(TransactionPolicyActive, closeEvents))
+
+	s.mockShard.GetConfig().HistoryExportMode = func(string) string { return messaging.HistoryExportModeBatch }
+	s.Equal(exportTask, s.msBuilder.eventsToHistoryExportTask(TransactionPolicyActive, decisionEvents))
+	s.Empty(s.msBuilder.eventsToHistoryExportTask(TransactionPolicyPassive, decisionEvents))
+
+	s.mockShard.GetConfig().HistoryExportMode = func(string) string { return messaging.HistoryExportModeLifecycle }
+	s.Empty(s.msBuilder.eventsToHistoryExportTask(TransactionPolicyActive, decisionEvents))
+	s.Equal(exportTask, s.msBuilder.eventsToHistoryExportTask(TransactionPolicyActive, closeEvents))
+}
+
 func (s *mutableStateSuite) TestTransientDecisionTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -817,6 +955,78 @@ func (s *mutableStateSuite) TestUpdateCurrentVersion_WorkflowClosed() {
 	s.Equal(lastWriteVersion, s.msBuilder.GetCurrentVersion())
 }
 
+func (s *mutableStateSuite) TestStartTransaction_WorkflowActiveCluster() {
+	mutableState := s.buildWorkflowMutableState()
+	s.msBuilder.Load(mutableState)
+
+	// the domain is active in the current cluster while the workflow is active in the alternative cluster
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":100"},
+		},
+		constants.TestGlobalDomainEntry.GetConfig(),
+		constants.TestGlobalDomainEntry.GetReplicationConfig(),
+		cluster.TestCurrentClusterInitialFailoverVersion+100*cluster.TestFailoverVersionIncrement,
+		constants.TestClusterMetadata,
+	)
+	workflowVersion := domainEntry.GetFailoverVersionForWorkflow(mutableState.ExecutionInfo.WorkflowID)
+	s.Equal(cluster.TestAlternativeClusterName, constants.TestClusterMetadata.ClusterNameForFailoverVersion(workflowVersion))
+
+	_, err := s.msBuilder.StartTransaction(domainEntry, mutableState.ExecutionInfo.DecisionVersion)
+	s.NoError(err)
+	s.Equal(workflowVersion, s.msBuilder.GetCurrentVersion())
+
+	// workflows which stay in the domain active cluster use the domain failover version
+	_, err = s.msBuilder.StartTransaction(constants.TestGlobalDomainEntry, mutableState.ExecutionInfo.DecisionVersion)
+	s.NoError(err)
+	s.Equal(constants.TestGlobalDomainEntry.GetFailoverVersion(), s.msBuilder.GetCurrentVersion())
+}
+
+func (s *mutableStateSuite) TestCanDispatchActivityLocally() {
+	executionInfo := s.msBuilder.GetExecutionInfo()
+	executionInfo.DomainID = constants.TestDomainID
+	executionInfo.TaskList = "some random tasklist"
+
+	testCases := []struct {
+		name     string
+		enabled  bool
+		ai       *persistence.ActivityInfo
+		expected bool
+	}{
+		{
+			name:     "same domain and task list",
+			enabled:  true,
+			ai:       &persistence.ActivityInfo{DomainID: constants.TestDomainID, TaskList: executionInfo.TaskList},
+			expected: true,
+		},
+		{
+			name:     "disabled",
+			enabled:  false,
+			ai:       &persistence.ActivityInfo{DomainID: constants.TestDomainID, TaskList: executionInfo.TaskList},
+			expected: false,
+		},
+		{
+			name:     "different task list",
+			enabled:  true,
+			ai:       &persistence.ActivityInfo{DomainID: constants.TestDomainID, TaskList: "other tasklist"},
+			expected: false,
+		},
+		{
+			name:     "different domain",
+			enabled:  true,
+			ai:       &persistence.ActivityInfo{DomainID: constants.TestTargetDomainID, TaskList: executionInfo.TaskList},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.mockShard.GetConfig().EnableActivityLocalDispatchByDomain = func(domain string) bool { return tc.enabled }
+		s.Equal(tc.expected, s.msBuilder.canDispatchActivityLocally(tc.ai), tc.name)
+	}
+}
+
 func (s *mutableStateSuite) newDomainCacheEntry() *cache.DomainCacheEntry {
 	return cache.NewDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: "mutableStateTest"},
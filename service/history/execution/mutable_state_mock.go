@@ -124,6 +124,36 @@ func (mr *MockMutableStateMockRecorder) AddActivityTaskFailedEvent(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskFailedEvent), arg0, arg1, arg2)
 }
 
+// AddActivityTaskOptionsUpdatedEvent mocks base method
+func (m *MockMutableState) AddActivityTaskOptionsUpdatedEvent(arg0 int64, arg1 *types.OperatePendingActivityRequest) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskOptionsUpdatedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskOptionsUpdatedEvent indicates an expected call of AddActivityTaskOptionsUpdatedEvent
+func (mr *MockMutableStateMockRecorder) AddActivityTaskOptionsUpdatedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskOptionsUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskOptionsUpdatedEvent), arg0, arg1)
+}
+
+// AddActivityTaskRetryRequestedEvent mocks base method
+func (m *MockMutableState) AddActivityTaskRetryRequestedEvent(arg0 int64, arg1 bool, arg2 string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityTaskRetryRequestedEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityTaskRetryRequestedEvent indicates an expected call of AddActivityTaskRetryRequestedEvent
+func (mr *MockMutableStateMockRecorder) AddActivityTaskRetryRequestedEvent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskRetryRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityTaskRetryRequestedEvent), arg0, arg1, arg2)
+}
+
 // AddActivityTaskScheduledEvent mocks base method
 func (m *MockMutableState) AddActivityTaskScheduledEvent(arg0 int64, arg1 *types.ScheduleActivityTaskDecisionAttributes) (*types.HistoryEvent, *persistence.ActivityInfo, *types.ActivityLocalDispatchInfo, error) {
 	m.ctrl.T.Helper()
@@ -743,6 +773,36 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSignaled(signalName,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSignaled", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSignaled), signalName, input, identity)
 }
 
+// AddWorkflowExecutionUnpausedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(reason, identity string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", reason, identity)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), reason, identity)
+}
+
+// AddWorkflowExecutionPausedEvent mocks base method
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(reason, identity string, allowActivities bool) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", reason, identity, allowActivities)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(reason, identity, allowActivities interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), reason, identity, allowActivities)
+}
+
 // AddWorkflowExecutionStartedEvent mocks base method
 func (m *MockMutableState) AddWorkflowExecutionStartedEvent(arg0 types.WorkflowExecution, arg1 *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1747,6 +1807,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskFailedEvent(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskFailedEvent), arg0)
 }
 
+// ReplicateActivityTaskOptionsUpdatedEvent mocks base method
+func (m *MockMutableState) ReplicateActivityTaskOptionsUpdatedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskOptionsUpdatedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskOptionsUpdatedEvent indicates an expected call of ReplicateActivityTaskOptionsUpdatedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskOptionsUpdatedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskOptionsUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskOptionsUpdatedEvent), arg0)
+}
+
+// ReplicateActivityTaskRetryRequestedEvent mocks base method
+func (m *MockMutableState) ReplicateActivityTaskRetryRequestedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateActivityTaskRetryRequestedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateActivityTaskRetryRequestedEvent indicates an expected call of ReplicateActivityTaskRetryRequestedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateActivityTaskRetryRequestedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateActivityTaskRetryRequestedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateActivityTaskRetryRequestedEvent), arg0)
+}
+
 // ReplicateActivityTaskScheduledEvent mocks base method
 func (m *MockMutableState) ReplicateActivityTaskScheduledEvent(arg0 int64, arg1 *types.HistoryEvent) (*persistence.ActivityInfo, error) {
 	m.ctrl.T.Helper()
@@ -2131,6 +2219,30 @@ func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowSearchAttributesE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowSearchAttributesEvent), arg0)
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionPausedEvent mocks base method
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent mocks base method
 func (m *MockMutableState) ReplicateWorkflowExecutionCancelRequestedEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2337,6 +2449,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivityProgress(ai, request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityProgress", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityProgress), ai, request)
 }
 
+// UpdateCompletionCallbackInfos mocks base method
+func (m *MockMutableState) UpdateCompletionCallbackInfos(arg0 []*types.CompletionCallbackInfo) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCompletionCallbackInfos", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// UpdateCompletionCallbackInfos indicates an expected call of UpdateCompletionCallbackInfos
+func (mr *MockMutableStateMockRecorder) UpdateCompletionCallbackInfos(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCompletionCallbackInfos", reflect.TypeOf((*MockMutableState)(nil).UpdateCompletionCallbackInfos), arg0)
+}
+
 // UpdateDecision mocks base method
 func (m *MockMutableState) UpdateDecision(arg0 *DecisionInfo) {
 	m.ctrl.T.Helper()
@@ -172,7 +172,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	executionInfo := r.mutableState.GetExecutionInfo()
 	transferTasks := []persistence.Task{}
 	crossClusterTasks := []persistence.Task{}
-	_, isActive, err := getTargetCluster(executionInfo.DomainID, r.domainCache)
+	_, isActive, err := getTargetCluster(executionInfo.DomainID, executionInfo.WorkflowID, r.domainCache)
 	if err != nil {
 		return err
 	}
@@ -230,6 +230,13 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 				},
 			}
 		}
+
+		// 4. invoke completion callbacks, they are only sent by the active cluster
+		if len(executionInfo.CompletionCallbacks) != 0 {
+			transferTasks = append(transferTasks, &persistence.CompletionCallbackTask{
+				Version: closeEvent.GetVersion(),
+			})
+		}
 	}
 
 	r.mutableState.AddTransferTasks(transferTasks...)
@@ -472,7 +479,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateChildWorkflowTasks(
 		}
 	}
 
-	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID)
+	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID, childWorkflowInfo.StartedWorkflowID)
 	if err != nil {
 		return err
 	}
@@ -521,7 +528,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateRequestCancelExternalTasks(
 		return err
 	}
 
-	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID)
+	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID, targetWorkflowID)
 	if err != nil {
 		return err
 	}
@@ -572,7 +579,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateSignalExternalTasks(
 		return err
 	}
 
-	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID)
+	targetCluster, isCrossClusterTask, err := r.isCrossClusterTask(targetDomainID, targetWorkflowID)
 	if err != nil {
 		return err
 	}
@@ -793,8 +800,8 @@ func (r *mutableStateTaskGeneratorImpl) GenerateFromCrossClusterTask(
 	var targetCluster string
 
 	sourceDomainEntry := r.mutableState.GetDomainEntry()
-	if !sourceDomainEntry.IsDomainActive() && !sourceDomainEntry.IsDomainPendingActive() {
-		// domain is passive, generate (passive) transfer task
+	if !sourceDomainEntry.IsWorkflowActive(task.WorkflowID) && !sourceDomainEntry.IsDomainPendingActive() {
+		// workflow is passive, generate (passive) transfer task
 		generateTransferTask = true
 	}
 
@@ -815,7 +822,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateFromCrossClusterTask(
 		if err != nil {
 			return err
 		}
-		targetCluster = targetDomainEntry.GetReplicationConfig().ActiveClusterName
+		targetCluster = targetDomainEntry.GetActiveClusterForWorkflow(task.TargetWorkflowID)
 		if targetCluster == r.clusterMetadata.GetCurrentClusterName() {
 			generateTransferTask = true
 		}
@@ -934,34 +941,38 @@ func (r *mutableStateTaskGeneratorImpl) getTargetDomainID(
 // this is only an best effort check
 // even if the task ended up in the wrong queue, the actual processing logic
 // will detect it and create a new task in the right queue.
+// Both source and target are resolved per workflow, so that workflows of the same
+// domain active in different clusters are routed correctly.
 func (r *mutableStateTaskGeneratorImpl) isCrossClusterTask(
 	targetDomainID string,
+	targetWorkflowID string,
 ) (string, bool, error) {
-	sourceDomainID := r.mutableState.GetExecutionInfo().DomainID
-
-	// case 1: not cross domain task
-	if sourceDomainID == targetDomainID {
-		return "", false, nil
-	}
+	executionInfo := r.mutableState.GetExecutionInfo()
+	sourceDomainID := executionInfo.DomainID
 
 	sourceDomainEntry, err := r.domainCache.GetDomainByID(sourceDomainID)
 	if err != nil {
 		return "", false, err
 	}
 
-	// case 2: source domain is not active in the current cluster
-	if !sourceDomainEntry.IsDomainActive() {
+	// case 1: source workflow is not active in the current cluster
+	if !sourceDomainEntry.IsWorkflowActive(executionInfo.WorkflowID) {
 		return "", false, nil
 	}
 
-	targetDomainEntry, err := r.domainCache.GetDomainByID(targetDomainID)
-	if err != nil {
-		return "", false, err
+	// the target can be in the source domain, when the workflows of the domain are
+	// active in different clusters
+	targetDomainEntry := sourceDomainEntry
+	if targetDomainID != sourceDomainID {
+		targetDomainEntry, err = r.domainCache.GetDomainByID(targetDomainID)
+		if err != nil {
+			return "", false, err
+		}
 	}
-	targetCluster := targetDomainEntry.GetReplicationConfig().ActiveClusterName
+	targetCluster := targetDomainEntry.GetActiveClusterForWorkflow(targetWorkflowID)
 
-	// case 3: target cluster is the same as source domain active cluster
-	// which is current cluster since source domain is active
+	// case 2: target cluster is the same as source workflow active cluster
+	// which is current cluster since source workflow is active
 	if targetCluster == r.clusterMetadata.GetCurrentClusterName() {
 		return "", false, nil
 	}
@@ -971,6 +982,7 @@ func (r *mutableStateTaskGeneratorImpl) isCrossClusterTask(
 
 func getTargetCluster(
 	domainID string,
+	workflowID string,
 	domainCache cache.DomainCache,
 ) (string, bool, error) {
 	domainEntry, err := domainCache.GetDomainByID(domainID)
@@ -978,13 +990,13 @@ func getTargetCluster(
 		return "", false, err
 	}
 
-	isActive := domainEntry.IsDomainActive()
+	isActive := domainEntry.IsWorkflowActive(workflowID)
 	if !isActive {
 		// treat pending active as active
 		isActive = domainEntry.IsDomainPendingActive()
 	}
 
-	activeCluster := domainEntry.GetReplicationConfig().ActiveClusterName
+	activeCluster := domainEntry.GetActiveClusterForWorkflow(workflowID)
 	return activeCluster, isActive, nil
 }
 
@@ -999,7 +1011,7 @@ func getParentCluster(
 		return "", false, nil
 	}
 
-	return getTargetCluster(executionInfo.ParentDomainID, domainCache)
+	return getTargetCluster(executionInfo.ParentDomainID, executionInfo.ParentWorkflowID, domainCache)
 }
 
 func getChildrenClusters(
@@ -1008,33 +1020,15 @@ func getChildrenClusters(
 	domainCache cache.DomainCache,
 ) (map[string]struct{}, map[string]map[string]struct{}, error) {
 
-	if len(childDomainIDs) == 0 {
-		childDomainIDs = make(map[string]struct{})
-		children := mutableState.GetPendingChildExecutionInfos()
-		for _, childInfo := range children {
-			if childInfo.ParentClosePolicy == types.ParentClosePolicyAbandon {
-				continue
-			}
-
-			childDomainID, err := GetChildExecutionDomainID(childInfo, domainCache, mutableState.GetDomainEntry())
-			if err != nil {
-				if common.IsEntityNotExistsError(err) {
-					continue // ignore deleted domain
-				}
-				return nil, nil, err
-			}
-			childDomainIDs[childDomainID] = struct{}{}
-		}
-	}
-
+	// children are routed by the active cluster of their workflow, so that the children of a domain
+	// active in different clusters are sent to each of those clusters
 	sameClusterDomainIDs := make(map[string]struct{})
 	remoteClusterDomainIDs := make(map[string]map[string]struct{})
-	for childDomainID := range childDomainIDs {
-		childCluster, isActive, err := getTargetCluster(childDomainID, domainCache)
+	addChildDomain := func(childDomainID string, childWorkflowID string) error {
+		childCluster, isActive, err := getTargetCluster(childDomainID, childWorkflowID, domainCache)
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
-
 		if isActive {
 			sameClusterDomainIDs[childDomainID] = struct{}{}
 		} else {
@@ -1043,6 +1037,43 @@ func getChildrenClusters(
 			}
 			remoteClusterDomainIDs[childCluster][childDomainID] = struct{}{}
 		}
+		return nil
+	}
+
+	routedDomainIDs := make(map[string]struct{})
+	children := mutableState.GetPendingChildExecutionInfos()
+	for _, childInfo := range children {
+		if childInfo.ParentClosePolicy == types.ParentClosePolicyAbandon {
+			continue
+		}
+
+		childDomainID, err := GetChildExecutionDomainID(childInfo, domainCache, mutableState.GetDomainEntry())
+		if err != nil {
+			if common.IsEntityNotExistsError(err) {
+				continue // ignore deleted domain
+			}
+			return nil, nil, err
+		}
+		if len(childDomainIDs) != 0 {
+			if _, ok := childDomainIDs[childDomainID]; !ok {
+				continue
+			}
+		}
+
+		if err := addChildDomain(childDomainID, childInfo.StartedWorkflowID); err != nil {
+			return nil, nil, err
+		}
+		routedDomainIDs[childDomainID] = struct{}{}
+	}
+
+	// requested domains without a pending child are routed by the domain active cluster
+	for childDomainID := range childDomainIDs {
+		if _, ok := routedDomainIDs[childDomainID]; ok {
+			continue
+		}
+		if err := addChildDomain(childDomainID, ""); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	return sameClusterDomainIDs, remoteClusterDomainIDs, nil
@@ -21,6 +21,7 @@
 package execution
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -84,6 +85,24 @@ func (s *mutableStateTaskGeneratorSuite) TearDownTest() {
 }
 
 func (s *mutableStateTaskGeneratorSuite) TestIsCrossClusterTask() {
+	// all workflows of the split target domain are active in the alternative cluster,
+	// while the domain itself is active in the current cluster
+	splitTargetDomainID := "split-target-domain-id"
+	splitTargetDomainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   splitTargetDomainID,
+			Name: "split-target-domain",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":100",
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		constants.TestGlobalTargetDomainEntry.GetReplicationConfig(),
+		constants.TestVersion,
+		constants.TestClusterMetadata,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(splitTargetDomainID).Return(splitTargetDomainEntry, nil).AnyTimes()
+
 	testCases := []struct {
 		sourceDomainID string
 		targetDomainID string
@@ -115,20 +134,120 @@ func (s *mutableStateTaskGeneratorSuite) TestIsCrossClusterTask() {
 			isCrossCluster: true,
 			targetCluster:  cluster.TestAlternativeClusterName,
 		},
+		{
+			// target workflow is active in a different cluster than the target domain
+			sourceDomainID: constants.TestDomainID,
+			targetDomainID: splitTargetDomainID,
+			isCrossCluster: true,
+			targetCluster:  cluster.TestAlternativeClusterName,
+		},
 	}
 
 	for _, tc := range testCases {
 		s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
-			DomainID: constants.TestDomainID,
+			DomainID:   constants.TestDomainID,
+			WorkflowID: constants.TestWorkflowID,
 		})
 
-		targetCluster, isCrossCluster, err := s.taskGenerator.isCrossClusterTask(tc.targetDomainID)
+		targetCluster, isCrossCluster, err := s.taskGenerator.isCrossClusterTask(tc.targetDomainID, constants.TestWorkflowID)
 		s.NoError(err)
 		s.Equal(tc.isCrossCluster, isCrossCluster)
 		s.Equal(tc.targetCluster, targetCluster)
 	}
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestIsCrossClusterTask_SameDomain() {
+	// half of the workflows of the split domain are active in the alternative cluster
+	splitDomainID := "split-domain-id"
+	splitDomainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   splitDomainID,
+			Name: "split-domain",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":50",
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		constants.TestGlobalTargetDomainEntry.GetReplicationConfig(),
+		constants.TestVersion,
+		constants.TestClusterMetadata,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(splitDomainID).Return(splitDomainEntry, nil).AnyTimes()
+
+	var localWorkflowID, remoteWorkflowID string
+	for i := 0; localWorkflowID == "" || remoteWorkflowID == ""; i++ {
+		workflowID := fmt.Sprintf("workflow-%v", i)
+		if splitDomainEntry.IsWorkflowActive(workflowID) {
+			localWorkflowID = workflowID
+		} else {
+			remoteWorkflowID = workflowID
+		}
+	}
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		DomainID:   splitDomainID,
+		WorkflowID: localWorkflowID,
+	}).Times(2)
+
+	targetCluster, isCrossCluster, err := s.taskGenerator.isCrossClusterTask(splitDomainID, localWorkflowID)
+	s.NoError(err)
+	s.False(isCrossCluster)
+	s.Empty(targetCluster)
+
+	targetCluster, isCrossCluster, err = s.taskGenerator.isCrossClusterTask(splitDomainID, remoteWorkflowID)
+	s.NoError(err)
+	s.True(isCrossCluster)
+	s.Equal(cluster.TestAlternativeClusterName, targetCluster)
+}
+
+func (s *mutableStateTaskGeneratorSuite) TestGetChildrenClusters_SplitDomain() {
+	// half of the workflows of the split domain are active in the alternative cluster
+	splitDomainID := "split-domain-id"
+	splitDomainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   splitDomainID,
+			Name: "split-domain",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":50",
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		constants.TestGlobalTargetDomainEntry.GetReplicationConfig(),
+		constants.TestVersion,
+		constants.TestClusterMetadata,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(splitDomainID).Return(splitDomainEntry, nil).AnyTimes()
+
+	var localWorkflowID, remoteWorkflowID string
+	for i := 0; localWorkflowID == "" || remoteWorkflowID == ""; i++ {
+		workflowID := fmt.Sprintf("workflow-%v", i)
+		if splitDomainEntry.IsWorkflowActive(workflowID) {
+			localWorkflowID = workflowID
+		} else {
+			remoteWorkflowID = workflowID
+		}
+	}
+	s.mockMutableState.EXPECT().GetDomainEntry().Return(constants.TestGlobalDomainEntry).AnyTimes()
+	s.mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{
+		1: {
+			DomainID:          splitDomainID,
+			StartedWorkflowID: localWorkflowID,
+			ParentClosePolicy: types.ParentClosePolicyTerminate,
+		},
+		2: {
+			DomainID:          splitDomainID,
+			StartedWorkflowID: remoteWorkflowID,
+			ParentClosePolicy: types.ParentClosePolicyTerminate,
+		},
+	}).AnyTimes()
+
+	sameClusterDomainIDs, remoteClusterDomainIDs, err := getChildrenClusters(nil, s.mockMutableState, s.mockDomainCache)
+	s.NoError(err)
+	s.Equal(map[string]struct{}{splitDomainID: {}}, sameClusterDomainIDs)
+	s.Equal(map[string]map[string]struct{}{
+		cluster.TestAlternativeClusterName: {splitDomainID: {}},
+	}, remoteClusterDomainIDs)
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 	now := time.Now()
 	version := int64(123)
@@ -166,6 +285,35 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 				},
 			},
 		},
+		{
+			// no parent, no children, with completion callbacks
+			setupFn: func(mockMutableState *MockMutableState) {
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+					CompletionCallbacks: []*types.CompletionCallbackInfo{
+						{URL: "https://example.com/done", State: types.CompletionCallbackStatePending.Ptr()},
+					},
+				}).AnyTimes()
+				mockMutableState.EXPECT().HasParentExecution().Return(false).AnyTimes()
+				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+			},
+			generatedTasks: []persistence.Task{
+				&persistence.CloseExecutionTask{
+					VisibilityTimestamp: now,
+					Version:             version,
+				},
+				&persistence.CompletionCallbackTask{
+					VisibilityTimestamp: now,
+					Version:             version,
+				},
+				&persistence.DeleteHistoryEventTask{
+					VisibilityTimestamp: time.Unix(0, closeEvent.GetTimestamp()).Add(retention),
+					Version:             version,
+				},
+			},
+		},
 		{
 			// parent and children all active in current cluster
 			setupFn: func(mockMutableState *MockMutableState) {
@@ -777,6 +925,8 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateFromCrossClusterTask() {
 			mockMutableState.EXPECT().GetDomainEntry().Return(constants.TestGlobalRemoteTargetDomainEntry).Times(1)
 		}
 
+		mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+
 		var transferTasks []persistence.Task
 		var crossClusterTasks []persistence.Task
 		mockMutableState.EXPECT().AddTransferTasks(gomock.Any()).Do(func(tasks ...persistence.Task) {
@@ -23,6 +23,7 @@ package execution
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
@@ -364,6 +365,9 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		AutoResetPoints:                    sourceInfo.AutoResetPoints,
 		Memo:                               sourceInfo.Memo,
 		SearchAttributes:                   sourceInfo.SearchAttributes,
+		OffloadedPayloads:                  copyOffloadedPayloads(sourceInfo.OffloadedPayloads),
+		PauseInfo:                          copyPauseInfo(sourceInfo.PauseInfo),
+		CompletionCallbacks:                copyCompletionCallbackInfos(sourceInfo.CompletionCallbacks),
 		Attempt:                            sourceInfo.Attempt,
 		HasRetryPolicy:                     sourceInfo.HasRetryPolicy,
 		InitialInterval:                    sourceInfo.InitialInterval,
@@ -377,6 +381,68 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 	}
 }
 
+func copyPauseInfo(source *persistence.WorkflowPauseInfo) *persistence.WorkflowPauseInfo {
+	if source == nil {
+		return nil
+	}
+	result := *source
+	return &result
+}
+
+func copyCompletionCallbackInfos(source []*types.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if source == nil {
+		return nil
+	}
+	result := make([]*types.CompletionCallbackInfo, 0, len(source))
+	for _, info := range source {
+		infoCopy := *info
+		result = append(result, &infoCopy)
+	}
+	return result
+}
+
+// NextCompletionCallbackAttemptTime returns the earliest time a pending completion callback is due
+func NextCompletionCallbackAttemptTime(infos []*types.CompletionCallbackInfo) (time.Time, bool) {
+	var next time.Time
+	found := false
+	for _, info := range infos {
+		if info.GetState() != types.CompletionCallbackStatePending || info.NextAttemptTimestamp == nil {
+			continue
+		}
+		attemptTime := time.Unix(0, info.GetNextAttemptTimestamp())
+		if !found || attemptTime.Before(next) {
+			next = attemptTime
+			found = true
+		}
+	}
+	return next, found
+}
+
+// isCompletionCallbackInfoNewer returns whether the delivery state of a completion callback is newer than the
+// current one, that is the state of a later attempt, or the outcome of the current attempt
+func isCompletionCallbackInfoNewer(
+	info *types.CompletionCallbackInfo,
+	current *types.CompletionCallbackInfo,
+) bool {
+
+	if info.GetAttempt() != current.GetAttempt() {
+		return info.GetAttempt() > current.GetAttempt()
+	}
+	return current.GetState() == types.CompletionCallbackStatePending &&
+		info.GetState() != types.CompletionCallbackStatePending
+}
+
+func copyOffloadedPayloads(source map[string]int64) map[string]int64 {
+	if source == nil {
+		return nil
+	}
+	result := make(map[string]int64, len(source))
+	for key, eventID := range source {
+		result[key] = eventID
+	}
+	return result
+}
+
 // CopyActivityInfo copies ActivityInfo
 func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.ActivityInfo {
 	details := make([]byte, len(sourceInfo.Details))
@@ -148,3 +148,33 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt, pt5)
 }
+
+func TestNextCompletionCallbackAttemptTime(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano())
+	_, ok := NextCompletionCallbackAttemptTime(nil)
+	assert.False(t, ok)
+
+	infos := []*types.CompletionCallbackInfo{
+		{State: types.CompletionCallbackStateSucceeded.Ptr()},
+		{State: types.CompletionCallbackStatePending.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.Add(time.Minute).UnixNano())},
+		{State: types.CompletionCallbackStatePending.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.Add(time.Second).UnixNano())},
+		{State: types.CompletionCallbackStateFailed.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.UnixNano())},
+	}
+	next, ok := NextCompletionCallbackAttemptTime(infos)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Second), next)
+}
+
+func TestIsCompletionCallbackInfoNewer(t *testing.T) {
+	pending := &types.CompletionCallbackInfo{State: types.CompletionCallbackStatePending.Ptr(), Attempt: 2}
+
+	assert.True(t, isCompletionCallbackInfoNewer(&types.CompletionCallbackInfo{State: types.CompletionCallbackStatePending.Ptr(), Attempt: 3}, pending))
+	assert.False(t, isCompletionCallbackInfoNewer(&types.CompletionCallbackInfo{State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 1}, pending))
+	assert.False(t, isCompletionCallbackInfoNewer(pending, pending))
+	assert.True(t, isCompletionCallbackInfoNewer(&types.CompletionCallbackInfo{State: types.CompletionCallbackStateFailed.Ptr(), Attempt: 2}, pending))
+
+	// the outcome of an attempt is final
+	succeeded := &types.CompletionCallbackInfo{State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 2}
+	assert.False(t, isCompletionCallbackInfoNewer(pending, succeeded))
+	assert.False(t, isCompletionCallbackInfoNewer(&types.CompletionCallbackInfo{State: types.CompletionCallbackStateFailed.Ptr(), Attempt: 2}, succeeded))
+}
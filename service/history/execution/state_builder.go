@@ -322,6 +322,24 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeActivityTaskOptionsUpdated:
+			if err := b.mutableState.ReplicateActivityTaskOptionsUpdatedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+			// activity timers are regenerated at the end
+
+		case types.EventTypeActivityTaskRetryRequested:
+			if err := b.mutableState.ReplicateActivityTaskRetryRequestedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+			// retry backoff timers are not created in the passive cluster
+
 		case types.EventTypeActivityTaskCancelRequested:
 			if err := b.mutableState.ReplicateActivityTaskCancelRequestedEvent(
 				event,
@@ -501,6 +519,12 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionPaused:
+			b.mutableState.ReplicateWorkflowExecutionPausedEvent(event)
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(event)
+
 		case types.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.mutableState.ReplicateWorkflowExecutionCancelRequestedEvent(
 				event,
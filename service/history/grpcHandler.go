@@ -78,6 +78,16 @@ func (g grpcHandler) GetCrossClusterTasks(ctx context.Context, request *historyv
 	return proto.FromHistoryGetCrossClusterTasksResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) GetReplicationStatus(ctx context.Context, request *historyv1.GetReplicationStatusRequest) (*historyv1.GetReplicationStatusResponse, error) {
+	response, err := g.h.GetReplicationStatus(ctx, proto.ToHistoryGetReplicationStatusRequest(request))
+	return proto.FromHistoryGetReplicationStatusResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) GetReplicationReadiness(ctx context.Context, request *historyv1.GetReplicationReadinessRequest) (*historyv1.GetReplicationReadinessResponse, error) {
+	response, err := g.h.GetReplicationReadiness(ctx, proto.ToHistoryGetReplicationReadinessRequest(request))
+	return proto.FromHistoryGetReplicationReadinessResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) GetDLQReplicationMessages(ctx context.Context, request *historyv1.GetDLQReplicationMessagesRequest) (*historyv1.GetDLQReplicationMessagesResponse, error) {
 	response, err := g.h.GetDLQReplicationMessages(ctx, proto.ToHistoryGetDLQReplicationMessagesRequest(request))
 	return proto.FromHistoryGetDLQReplicationMessagesResponse(response), proto.FromError(err)
@@ -257,3 +267,18 @@ func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g grpcHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) OperatePendingActivity(ctx context.Context, request *historyv1.OperatePendingActivityRequest) (*historyv1.OperatePendingActivityResponse, error) {
+	err := g.h.OperatePendingActivity(ctx, proto.ToHistoryOperatePendingActivityRequest(request))
+	return &historyv1.OperatePendingActivityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
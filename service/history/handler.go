@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/common"
@@ -38,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/future"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
@@ -53,7 +55,10 @@ import (
 	"github.com/uber/cadence/service/history/task"
 )
 
-const shardOwnershipTransferDelay = 5 * time.Second
+const (
+	gossipPropagationDelay      = 400 * time.Millisecond
+	shardOwnershipTransferDelay = 5 * time.Second
+)
 
 type (
 	// Handler interface for history service
@@ -68,6 +73,8 @@ type (
 		DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
+		GetReplicationStatus(context.Context, *types.HistoryGetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error)
+		GetReplicationReadiness(context.Context, *types.HistoryGetReplicationReadinessRequest) (*types.GetReplicationReadinessResponse, error)
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
 		GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
@@ -103,6 +110,9 @@ type (
 		SyncActivity(context.Context, *types.SyncActivityRequest) error
 		SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 		TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
+		OperatePendingActivity(context.Context, *types.HistoryOperatePendingActivityRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
 		GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	}
 
@@ -121,6 +131,7 @@ type (
 		replicationTaskFetchers  replication.TaskFetchers
 		queueTaskProcessor       task.Processor
 		failoverCoordinator      failover.Coordinator
+		historyExportProducer    messaging.Producer
 	}
 )
 
@@ -134,6 +145,7 @@ var (
 	errWorkflowIDNotSet        = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotValid           = &types.BadRequestError{Message: "RunID is not valid UUID."}
 	errSourceClusterNotSet     = &types.BadRequestError{Message: "Source Cluster not set on request."}
+	errRemoteClusterNotSet     = &types.BadRequestError{Message: "Remote Cluster not set on request."}
 	errTimestampNotSet         = &types.BadRequestError{Message: "Timestamp not set on request."}
 	errInvalidTaskType         = &types.BadRequestError{Message: "Invalid task type"}
 	errHistoryHostThrottle     = &types.ServiceBusyError{Message: "History host rps exceeded"}
@@ -207,6 +219,8 @@ func (h *handlerImpl) Start() {
 	}
 	h.queueTaskProcessor.Start()
 
+	h.historyExportProducer = h.newHistoryExportProducer()
+
 	h.controller = shard.NewShardController(
 		h.Resource,
 		h,
@@ -243,12 +257,22 @@ func (h *handlerImpl) Stop() {
 	h.controller.Stop()
 	h.historyEventNotifier.Stop()
 	h.failoverCoordinator.Stop()
+	if producer, ok := h.historyExportProducer.(messaging.CloseableProducer); ok {
+		if err := producer.Close(); err != nil {
+			h.GetLogger().Warn("Failed to close history export producer.", tag.Error(err))
+		}
+	}
 }
 
 // PrepareToStop starts graceful traffic drain in preparation for shutdown
 func (h *handlerImpl) PrepareToStop(remainingTime time.Duration) time.Duration {
+	h.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	h.GetMembershipResolver().EvictSelf()
+	h.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	remainingTime = common.SleepWithMinDuration(gossipPropagationDelay, remainingTime)
 	h.GetLogger().Info("ShutdownHandler: Initiating shardController shutdown")
-	h.controller.PrepareToStop()
+	// the shards are handed off once the host has left the membership ring, so their new owners are known
+	remainingTime = h.controller.PrepareToStop(remainingTime)
 	h.GetLogger().Info("ShutdownHandler: Waiting for traffic to drain")
 	remainingTime = common.SleepWithMinDuration(shardOwnershipTransferDelay, remainingTime)
 	h.GetLogger().Info("ShutdownHandler: No longer taking rpc requests")
@@ -280,9 +304,25 @@ func (h *handlerImpl) CreateEngine(
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
 		h.failoverCoordinator,
+		h.historyExportProducer,
 	)
 }
 
+// newHistoryExportProducer creates the producer history events are exported to,
+// history export is not available when no topic is configured for it
+func (h *handlerImpl) newHistoryExportProducer() messaging.Producer {
+	messagingClient := h.GetMessagingClient()
+	if messagingClient == nil {
+		return nil
+	}
+	producer, err := messagingClient.NewProducer(common.HistoryExportAppName)
+	if err != nil {
+		h.GetLogger().Info("History export is not available.", tag.Error(err))
+		return nil
+	}
+	return producer
+}
+
 // Health is for health check
 func (h *handlerImpl) Health(ctx context.Context) (*types.HealthStatus, error) {
 	h.startWG.Wait()
@@ -748,6 +788,13 @@ func (h *handlerImpl) DescribeHistoryHost(
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
+	if request.ShardIDForHost != nil && yarpc.CallFromContext(ctx).Header(common.ShardHandoffHeaderName) != "" {
+		// the previous owner of the shard has handed it off to this host
+		if err := h.controller.AcquireShardAfterHandoff(int(request.GetShardIDForHost())); err != nil {
+			return nil, err
+		}
+	}
+
 	numOfItemsInCacheByID, numOfItemsInCacheByName := h.GetDomainCache().GetCacheSize()
 	status := ""
 	switch h.controller.Status() {
@@ -1232,6 +1279,129 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history. No new decision task is dispatched to the workflow until it is unpaused.
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetPauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// OperatePendingActivity updates, retries, fails or skips a pending activity of a workflow execution
+// by recording the corresponding event in the history.
+func (h *handlerImpl) OperatePendingActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryOperatePendingActivityRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryOperatePendingActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.OperatePendingActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionUnpaused event
+// in the history.
+func (h *handlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetUnpauseRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -1594,6 +1764,10 @@ func (h *handlerImpl) GetReplicationMessages(
 		return nil, errShuttingDown
 	}
 
+	// remote clusters supporting compressed frames ask for them with a header, which the frontend
+	// forwards with the other inbound headers, other clusters keep receiving the replication tasks uncompressed
+	compression := yarpc.CallFromContext(ctx).Header(common.ReplicationCompressionHeaderName)
+
 	var wg sync.WaitGroup
 	wg.Add(len(request.Tokens))
 	result := new(sync.Map)
@@ -1616,6 +1790,9 @@ func (h *handlerImpl) GetReplicationMessages(
 				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
 				return
 			}
+			if compression == replication.CompressionZstd {
+				h.compressReplicationMessages(tasks)
+			}
 
 			result.Store(token.GetShardID(), tasks)
 		}(token)
@@ -1636,6 +1813,37 @@ func (h *handlerImpl) GetReplicationMessages(
 	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
 }
 
+func (h *handlerImpl) compressReplicationMessages(
+	messages *types.ReplicationMessages,
+) {
+	if len(messages.ReplicationTasks) == 0 {
+		return
+	}
+
+	frames, uncompressedSize, compressedSize, err := replication.EncodeFrames(
+		messages.ReplicationTasks,
+		h.config.ReplicationTaskFrameMaxSize(),
+	)
+	if err != nil {
+		// the tasks are still valid uncompressed
+		h.GetLogger().Warn("Failed to compress replication tasks", tag.Error(err))
+		return
+	}
+	messages.ReplicationTasks = frames
+
+	metricsClient := h.GetMetricsClient()
+	metricsClient.AddCounter(metrics.HistoryGetReplicationMessagesScope, metrics.ReplicationFrames, int64(len(frames)))
+	metricsClient.AddCounter(metrics.HistoryGetReplicationMessagesScope, metrics.ReplicationFrameUncompressedBytes, int64(uncompressedSize))
+	metricsClient.AddCounter(metrics.HistoryGetReplicationMessagesScope, metrics.ReplicationFrameCompressedBytes, int64(compressedSize))
+	if compressedSize > 0 {
+		metricsClient.UpdateGauge(
+			metrics.HistoryGetReplicationMessagesScope,
+			metrics.ReplicationFrameCompressionRatio,
+			float64(uncompressedSize)/float64(compressedSize),
+		)
+	}
+}
+
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
 func (h *handlerImpl) GetDLQReplicationMessages(
 	ctx context.Context,
@@ -1943,6 +2151,140 @@ func (h *handlerImpl) GetCrossClusterTasks(
 	return response, nil
 }
 
+// GetReplicationStatus returns the replication status of shards with a remote cluster
+func (h *handlerImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.HistoryGetReplicationStatusRequest,
+) (resp *types.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationStatusScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request.GetRemoteCluster() == "" {
+		return nil, h.error(errRemoteClusterNotSet, scope, "", "")
+	}
+
+	ctx, cancel := common.CreateChildContext(ctx, 0.05)
+	defer cancel()
+
+	futureByShardID := make(map[int32]future.Future, len(request.ShardIDs))
+	for _, shardID := range request.ShardIDs {
+		future, settable := future.NewFuture()
+		futureByShardID[shardID] = future
+		go func(shardID int32) {
+			logger := h.GetLogger().WithTags(tag.ShardID(int(shardID)))
+			engine, err := h.controller.GetEngineForShard(int(shardID))
+			if err != nil {
+				logger.Error("History engine not found for shard", tag.Error(err))
+				var owner string
+				if info, err := h.GetMembershipResolver().Lookup(service.History, strconv.Itoa(int(shardID))); err == nil {
+					owner = info.GetAddress()
+				}
+				settable.Set(nil, shard.CreateShardOwnershipLostError(h.GetHostInfo().GetAddress(), owner))
+				return
+			}
+
+			if status, err := engine.GetReplicationStatus(ctx, request.RemoteCluster); err != nil {
+				logger.Error("Failed to get replication status", tag.Error(err))
+				settable.Set(nil, h.convertError(err))
+			} else {
+				settable.Set(status, nil)
+			}
+		}(shardID)
+	}
+
+	response := &types.GetReplicationStatusResponse{
+		StatusByShard:      make(map[int32]*types.ReplicationShardStatus),
+		FailedCauseByShard: make(map[int32]types.GetTaskFailedCause),
+	}
+	for shardID, future := range futureByShardID {
+		var status *types.ReplicationShardStatus
+		if futureErr := future.Get(ctx, &status); futureErr != nil {
+			response.FailedCauseByShard[shardID] = common.ConvertErrToGetTaskFailedCause(futureErr)
+		} else {
+			response.StatusByShard[shardID] = status
+		}
+	}
+	// not using a waitGroup for created goroutines here
+	// as once all futures are unblocked,
+	// those goroutines will eventually be completed
+
+	return response, nil
+}
+
+// GetReplicationReadiness returns the replication readiness of shards for a source cluster
+func (h *handlerImpl) GetReplicationReadiness(
+	ctx context.Context,
+	request *types.HistoryGetReplicationReadinessRequest,
+) (resp *types.GetReplicationReadinessResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationReadinessScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request.GetSourceCluster() == "" {
+		return nil, h.error(errSourceClusterNotSet, scope, "", "")
+	}
+
+	ctx, cancel := common.CreateChildContext(ctx, 0.05)
+	defer cancel()
+
+	futureByShardID := make(map[int32]future.Future, len(request.ShardIDs))
+	for _, shardID := range request.ShardIDs {
+		future, settable := future.NewFuture()
+		futureByShardID[shardID] = future
+		go func(shardID int32) {
+			logger := h.GetLogger().WithTags(tag.ShardID(int(shardID)))
+			engine, err := h.controller.GetEngineForShard(int(shardID))
+			if err != nil {
+				logger.Error("History engine not found for shard", tag.Error(err))
+				var owner string
+				if info, err := h.GetMembershipResolver().Lookup(service.History, strconv.Itoa(int(shardID))); err == nil {
+					owner = info.GetAddress()
+				}
+				settable.Set(nil, shard.CreateShardOwnershipLostError(h.GetHostInfo().GetAddress(), owner))
+				return
+			}
+
+			if readiness, err := engine.GetReplicationReadiness(ctx, request.SourceCluster); err != nil {
+				logger.Error("Failed to get replication readiness", tag.Error(err))
+				settable.Set(nil, h.convertError(err))
+			} else {
+				settable.Set(readiness, nil)
+			}
+		}(shardID)
+	}
+
+	response := &types.GetReplicationReadinessResponse{
+		ReadinessByShard:   make(map[int32]*types.ReplicationShardReadiness),
+		FailedCauseByShard: make(map[int32]types.GetTaskFailedCause),
+	}
+	for shardID, future := range futureByShardID {
+		var readiness *types.ReplicationShardReadiness
+		if futureErr := future.Get(ctx, &readiness); futureErr != nil {
+			response.FailedCauseByShard[shardID] = common.ConvertErrToGetTaskFailedCause(futureErr)
+		} else {
+			response.ReadinessByShard[shardID] = readiness
+		}
+	}
+	// not using a waitGroup for created goroutines here
+	// as once all futures are unblocked,
+	// those goroutines will eventually be completed
+
+	return response, nil
+}
+
 func (h *handlerImpl) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	request *types.RespondCrossClusterTasksCompletedRequest,
@@ -30,13 +30,16 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/yarpctest"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/history/resource"
 	"github.com/uber/cadence/service/history/shard"
 )
@@ -114,6 +117,56 @@ func (s *handlerSuite) TestGetCrossClusterTasks() {
 	}
 }
 
+func (s *handlerSuite) TestGetReplicationMessages_Compression() {
+	var tasks []*types.ReplicationTask
+	for i := int64(0); i < 3; i++ {
+		tasks = append(tasks, &types.ReplicationTask{
+			TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+			SourceTaskID: i,
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+				TaskID:     i,
+				DomainID:   "some random domain ID",
+				WorkflowID: "some random workflow ID",
+				RunID:      "some random run ID",
+				Events: &types.DataBlob{
+					EncodingType: types.EncodingTypeThriftRW.Ptr(),
+					Data:         []byte("some random events"),
+				},
+			},
+		})
+	}
+	s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), cluster.TestAlternativeClusterName, int64(0)).DoAndReturn(
+		func(_ context.Context, _ string, _ int64) (*types.ReplicationMessages, error) {
+			return &types.ReplicationMessages{
+				ReplicationTasks:       append([]*types.ReplicationTask(nil), tasks...),
+				LastRetrievedMessageID: 2,
+			}, nil
+		},
+	).Times(2)
+	request := &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{{ShardID: 1}},
+		ClusterName: cluster.TestAlternativeClusterName,
+	}
+
+	// tasks are sent uncompressed to clusters which don't ask for frames
+	response, err := s.handler.GetReplicationMessages(context.Background(), request)
+	s.NoError(err)
+	s.Equal(tasks, response.MessagesByShard[1].ReplicationTasks)
+
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{common.ReplicationCompressionHeaderName: replication.CompressionZstd},
+	})
+	response, err = s.handler.GetReplicationMessages(ctx, request)
+	s.NoError(err)
+	messages := response.MessagesByShard[1]
+	s.Len(messages.ReplicationTasks, 1)
+	s.True(replication.IsFrame(messages.ReplicationTasks[0]))
+	s.Equal(int64(2), messages.LastRetrievedMessageID)
+	decoded, err := replication.DecodeFrames(messages.ReplicationTasks)
+	s.NoError(err)
+	s.Equal(tasks, decoded)
+}
+
 func (s *handlerSuite) TestRespondCrossClusterTaskCompleted_FetchNewTask() {
 	s.testRespondCrossClusterTaskCompleted(true)
 }
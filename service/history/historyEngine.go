@@ -44,6 +44,7 @@ import (
 	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
@@ -116,6 +117,7 @@ type (
 		rawMatchingClient          matching.Client
 		clientChecker              client.VersionChecker
 		replicationDLQHandler      replication.DLQHandler
+		replicationDLQRetrier      replication.DLQRetrier
 		failoverMarkerNotifier     failover.MarkerNotifier
 	}
 )
@@ -146,6 +148,7 @@ func NewEngineWithShardContext(
 	rawMatchingClient matching.Client,
 	queueTaskProcessor task.Processor,
 	failoverCoordinator failover.Coordinator,
+	historyExportProducer messaging.Producer,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -213,6 +216,7 @@ func NewEngineWithShardContext(
 		executionCache,
 		historyEngImpl.workflowResetter,
 		historyEngImpl.archivalClient,
+		historyExportProducer,
 		openExecutionCheck,
 	)
 
@@ -316,6 +320,7 @@ func NewEngineWithShardContext(
 	historyEngImpl.replicationTaskProcessors = replicationTaskProcessors
 	replicationMessageHandler := replication.NewDLQHandler(shard, replicationTaskExecutors)
 	historyEngImpl.replicationDLQHandler = replicationMessageHandler
+	historyEngImpl.replicationDLQRetrier = replication.NewDLQRetrier(shard, config, replicationTaskExecutors)
 
 	shard.SetEngine(historyEngImpl)
 	return historyEngImpl
@@ -344,6 +349,9 @@ func (e *historyEngineImpl) Start() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Start()
 	}
+	if e.replicationDLQRetrier != nil {
+		e.replicationDLQRetrier.Start()
+	}
 	if e.config.EnableGracefulFailover() {
 		e.failoverMarkerNotifier.Start()
 	}
@@ -363,6 +371,9 @@ func (e *historyEngineImpl) Stop() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Stop()
 	}
+	if e.replicationDLQRetrier != nil {
+		e.replicationDLQRetrier.Stop()
+	}
 
 	if e.queueTaskProcessor != nil {
 		e.queueTaskProcessor.StopShardProcessor(e.shard)
@@ -397,11 +408,10 @@ func (e *historyEngineImpl) registerDomainFailoverCallback() {
 
 	failoverPredicate := func(shardNotificationVersion int64, nextDomain *cache.DomainCacheEntry, action func()) {
 		domainFailoverNotificationVersion := nextDomain.GetFailoverNotificationVersion()
-		domainActiveCluster := nextDomain.GetReplicationConfig().ActiveClusterName
 
 		if nextDomain.IsGlobalDomain() &&
 			domainFailoverNotificationVersion >= shardNotificationVersion &&
-			domainActiveCluster == e.currentClusterName {
+			nextDomain.IsActiveIn(e.currentClusterName) {
 			action()
 		}
 	}
@@ -535,7 +545,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 ) (resp *types.StartWorkflowExecutionResponse, retError error) {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(startRequest.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), startRequest.DomainUUID, startRequest.GetStartRequest().GetWorkflowID())
 	if err != nil {
 		return nil, err
 	}
@@ -924,7 +934,7 @@ func (e *historyEngineImpl) PollMutableState(
 		CurrentBranchToken:  request.CurrentBranchToken})
 
 	if err != nil {
-		return nil, e.updateEntityNotExistsErrorOnPassiveCluster(err, request.GetDomainUUID())
+		return nil, e.updateEntityNotExistsErrorOnPassiveCluster(err, request.GetDomainUUID(), request.GetExecution().GetWorkflowID())
 	}
 
 	return &types.PollMutableStateResponse{
@@ -946,7 +956,7 @@ func (e *historyEngineImpl) PollMutableState(
 	}, nil
 }
 
-func (e *historyEngineImpl) updateEntityNotExistsErrorOnPassiveCluster(err error, domainID string) error {
+func (e *historyEngineImpl) updateEntityNotExistsErrorOnPassiveCluster(err error, domainID string, workflowID string) error {
 	switch err.(type) {
 	case *types.EntityNotExistsError:
 		domainCache, domainCacheErr := e.shard.GetDomainCache().GetDomainByID(domainID)
@@ -954,7 +964,7 @@ func (e *historyEngineImpl) updateEntityNotExistsErrorOnPassiveCluster(err error
 			return err // if could not access domain cache simply return original error
 		}
 
-		if domainNotActiveErr := domainCache.GetDomainNotActiveErr(); domainNotActiveErr != nil {
+		if domainNotActiveErr := domainCache.GetWorkflowNotActiveErr(workflowID); domainNotActiveErr != nil {
 			domainNotActiveErrCasted := domainNotActiveErr.(*types.DomainNotActiveError)
 			return &types.EntityNotExistsError{
 				Message:        "Workflow execution not found in non-active cluster",
@@ -1158,7 +1168,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 	// 2. the workflow is not running, whenever a workflow is not running dispatching query directly is consistent
 	// 3. the client requested eventual consistency, in this case there are no consistency requirements so dispatching directly through matching is safe
 	// 4. if there is no pending or started decision it means no events came before query arrived, so its safe to dispatch directly
-	safeToDispatchDirectly := !de.IsDomainActive() ||
+	safeToDispatchDirectly := !de.IsWorkflowActive(execution.GetWorkflowID()) ||
 		!mutableState.IsWorkflowExecutionRunning() ||
 		req.GetQueryConsistencyLevel() == types.QueryConsistencyLevelEventual ||
 		(!mutableState.HasPendingDecision() && !mutableState.HasInFlightDecision())
@@ -1252,7 +1262,7 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 		len(msResp.GetStickyTaskList().GetName()) != 0 &&
 		supportsStickyQuery &&
 		e.config.EnableStickyQuery(queryRequest.GetDomain()) &&
-		de.IsDomainActive() {
+		de.IsWorkflowActive(queryRequest.GetExecution().GetWorkflowID()) {
 
 		stickyMatchingRequest := &types.MatchingQueryWorkflowRequest{
 			DomainUUID:   domainID,
@@ -1558,6 +1568,15 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(completionEvent.GetTimestamp())
 	}
+	if pauseInfo := executionInfo.PauseInfo; pauseInfo != nil {
+		result.PauseInfo = &types.WorkflowPauseInfo{
+			Reason:          pauseInfo.Reason,
+			Identity:        pauseInfo.Identity,
+			PausedTimestamp: common.Int64Ptr(pauseInfo.PausedTimestamp.UnixNano()),
+			AllowActivities: pauseInfo.AllowActivities,
+		}
+	}
+	result.CompletionCallbacks = executionInfo.CompletionCallbacks
 
 	if len(mutableState.GetPendingActivityInfos()) > 0 {
 		for _, ai := range mutableState.GetPendingActivityInfos() {
@@ -1654,7 +1673,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	request *types.RecordActivityTaskStartedRequest,
 ) (*types.RecordActivityTaskStartedResponse, error) {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), request.DomainUUID, request.GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return nil, err
 	}
@@ -1703,6 +1722,13 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return workflow.ErrActivityTaskNotFound
 			}
 
+			if ai.StartedID == common.EmptyEventID {
+				if pauseInfo := mutableState.GetExecutionInfo().PauseInfo; pauseInfo != nil && !pauseInfo.AllowActivities {
+					// no new activity is dispatched while the workflow is paused, unless activities are allowed to finish
+					return workflow.ErrWorkflowPaused
+				}
+			}
+
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
 			if err != nil {
 				return err
@@ -1785,19 +1811,19 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	req *types.HistoryRespondActivityTaskCompletedRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return err
-	}
-	domainID := domainEntry.GetInfo().ID
-	domainName := domainEntry.GetInfo().Name
-
 	request := req.CompleteRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
 		return workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -1805,6 +1831,8 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 
 	var activityStartedTime time.Time
 	var taskList string
+	var runID string
+	var completedByID *persistence.ActivityInfo
 	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1838,6 +1866,11 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
 				return workflow.ErrActivityTaskNotFound
 			}
+			if token.ScheduleID == common.EmptyEventID {
+				activityInfo := *ai
+				completedByID = &activityInfo
+				runID = mutableState.GetExecutionInfo().RunID
+			}
 
 			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
@@ -1857,6 +1890,10 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 			)
 		scope.RecordTimer(metrics.ActivityE2ELatency, time.Since(activityStartedTime))
 	}
+	if err == nil && completedByID != nil {
+		// frontend only releases the outstanding task of the poller when it is given the task token
+		task.ReleaseOutstandingActivityTask(ctx, e.shard, types.WorkflowExecution{WorkflowID: token.WorkflowID, RunID: runID}, completedByID, e.logger)
+	}
 	return err
 }
 
@@ -1866,19 +1903,19 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	req *types.HistoryRespondActivityTaskFailedRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return err
-	}
-	domainID := domainEntry.GetInfo().ID
-	domainName := domainEntry.GetInfo().Name
-
 	request := req.FailedRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
 		return workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -1886,6 +1923,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 
 	var activityStartedTime time.Time
 	var taskList string
+	var runID string
+	var completedByID *persistence.ActivityInfo
 	err = workflow.UpdateWithActionFunc(
 		ctx,
 		e.executionCache,
@@ -1924,6 +1963,11 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
 				return nil, workflow.ErrActivityTaskNotFound
 			}
+			if token.ScheduleID == common.EmptyEventID {
+				activityInfo := *ai
+				completedByID = &activityInfo
+				runID = mutableState.GetExecutionInfo().RunID
+			}
 
 			postActions := &workflow.UpdateAction{}
 			ok, err := mutableState.RetryActivity(ai, req.FailedRequest.GetReason(), req.FailedRequest.GetDetails())
@@ -1954,6 +1998,10 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			)
 		scope.RecordTimer(metrics.ActivityE2ELatency, time.Since(activityStartedTime))
 	}
+	if err == nil && completedByID != nil {
+		// frontend only releases the outstanding task of the poller when it is given the task token
+		task.ReleaseOutstandingActivityTask(ctx, e.shard, types.WorkflowExecution{WorkflowID: token.WorkflowID, RunID: runID}, completedByID, e.logger)
+	}
 	return err
 }
 
@@ -1963,19 +2011,19 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 	req *types.HistoryRespondActivityTaskCanceledRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return err
-	}
-	domainID := domainEntry.GetInfo().ID
-	domainName := domainEntry.GetInfo().Name
-
 	request := req.CancelRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
 		return workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+	domainName := domainEntry.GetInfo().Name
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -1983,6 +2031,8 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 	var activityStartedTime time.Time
 	var taskList string
+	var runID string
+	var completedByID *persistence.ActivityInfo
 	err = workflow.UpdateWithAction(ctx, e.executionCache, domainID, workflowExecution, true, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -2016,6 +2066,11 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
 				return workflow.ErrActivityTaskNotFound
 			}
+			if token.ScheduleID == common.EmptyEventID {
+				activityInfo := *ai
+				completedByID = &activityInfo
+				runID = mutableState.GetExecutionInfo().RunID
+			}
 
 			if _, err := mutableState.AddActivityTaskCanceledEvent(
 				scheduleID,
@@ -2041,6 +2096,10 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 			)
 		scope.RecordTimer(metrics.ActivityE2ELatency, time.Since(activityStartedTime))
 	}
+	if err == nil && completedByID != nil {
+		// frontend only releases the outstanding task of the poller when it is given the task token
+		task.ReleaseOutstandingActivityTask(ctx, e.shard, types.WorkflowExecution{WorkflowID: token.WorkflowID, RunID: runID}, completedByID, e.logger)
+	}
 	return err
 }
 
@@ -2053,18 +2112,18 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	req *types.HistoryRecordActivityTaskHeartbeatRequest,
 ) (*types.RecordActivityTaskHeartbeatResponse, error) {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
-	if err != nil {
-		return nil, err
-	}
-	domainID := domainEntry.GetInfo().ID
-
 	request := req.HeartbeatRequest
 	token, err0 := e.tokenSerializer.Deserialize(request.TaskToken)
 	if err0 != nil {
 		return nil, workflow.ErrDeserializingToken
 	}
 
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), req.DomainUUID, token.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: token.WorkflowID,
 		RunID:      token.RunID,
@@ -2130,7 +2189,7 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 	req *types.HistoryRequestCancelWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(req.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), req.DomainUUID, req.GetCancelRequest().GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -2184,7 +2243,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	signalRequest *types.HistorySignalWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalRequest.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), signalRequest.DomainUUID, signalRequest.GetSignalRequest().GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -2273,7 +2332,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
 ) (retResp *types.StartWorkflowExecutionResponse, retError error) {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalWithStartRequest.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), signalWithStartRequest.DomainUUID, signalWithStartRequest.GetSignalWithStartRequest().GetWorkflowID())
 	if err != nil {
 		return nil, err
 	}
@@ -2399,7 +2458,7 @@ func (e *historyEngineImpl) RemoveSignalMutableState(
 	request *types.RemoveSignalMutableStateRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(request.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), request.DomainUUID, request.GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -2427,7 +2486,7 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 	terminateRequest *types.HistoryTerminateWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(terminateRequest.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), terminateRequest.DomainUUID, terminateRequest.GetTerminateRequest().GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -2474,13 +2533,147 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution pauses a workflow execution, no new decision or activity task is dispatched until it is unpaused
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), pauseRequest.DomainUUID, pauseRequest.GetPauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.GetExecutionInfo().PauseInfo != nil {
+				// the workflow is already paused
+				return &workflow.UpdateAction{
+					Noop: true,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+				request.GetAllowActivities(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// OperatePendingActivity updates, retries, fails or skips a pending activity of a workflow execution
+func (e *historyEngineImpl) OperatePendingActivity(
+	ctx context.Context,
+	operateRequest *types.HistoryOperatePendingActivityRequest,
+) error {
+
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), operateRequest.DomainUUID, operateRequest.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := operateRequest.Request
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			return operatePendingActivity(mutableState, request, e.timeSource.Now())
+		})
+}
+
+// UnpauseWorkflowExecution unpauses a workflow execution and dispatches the tasks held back while it was paused
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), unpauseRequest.DomainUUID, unpauseRequest.GetUnpauseRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.GetExecutionInfo().PauseInfo == nil {
+				// the workflow is not paused
+				return &workflow.UpdateAction{
+					Noop: true,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+
+			// decision and activity tasks held back while the workflow was paused are dispatched again
+			if err := e.newMutableStateTaskRefresher().RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
 	completionRequest *types.RecordChildExecutionCompletedRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(completionRequest.DomainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), completionRequest.DomainUUID, completionRequest.GetWorkflowExecution().GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -2568,6 +2761,74 @@ func (e *historyEngineImpl) SyncActivity(
 	return e.nDCActivityReplicator.SyncActivity(ctx, request)
 }
 
+// SyncCompletionCallbacks applies the delivery state of completion callbacks replicated from the active cluster.
+// Only the delivery state of callbacks which moved on is updated, and the retry of a pending callback is scheduled
+// so that it is delivered by this cluster after a failover.
+func (e *historyEngineImpl) SyncCompletionCallbacks(
+	ctx context.Context,
+	attributes *types.SyncCompletionCallbacksTaskAttributes,
+) (retError error) {
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: attributes.GetWorkflowID(),
+		RunID:      attributes.GetRunID(),
+	}
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, attributes.GetDomainID(), workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the workflow is long gone
+			return nil
+		}
+		return err
+	}
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() || lastWriteVersion != attributes.GetVersion() {
+		// the workflow was reset, or its history diverged from the one of the source cluster
+		return nil
+	}
+	if !mutableState.UpdateCompletionCallbackInfos(attributes.GetCompletionCallbacks()) {
+		return nil
+	}
+	if nextAttemptTime, ok := execution.NextCompletionCallbackAttemptTime(mutableState.GetExecutionInfo().CompletionCallbacks); ok {
+		mutableState.AddTimerTasks(&persistence.CompletionCallbackRetryTimerTask{
+			VisibilityTimestamp: nextAttemptTime,
+			Version:             lastWriteVersion,
+		})
+	}
+
+	// a new run may have been started after the workflow closed
+	updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+	resp, err := e.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   attributes.GetDomainID(),
+		WorkflowID: attributes.GetWorkflowID(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.RunID != attributes.GetRunID() {
+		updateMode = persistence.UpdateWorkflowModeBypassCurrent
+	}
+
+	return wfContext.UpdateWorkflowExecutionWithNew(
+		ctx,
+		e.timeSource.Now(),
+		updateMode,
+		nil, // no new workflow
+		nil, // no new workflow
+		execution.TransactionPolicyPassive,
+		nil,
+	)
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	resetRequest *types.HistoryResetWorkflowExecutionRequest,
@@ -2813,6 +3074,35 @@ func (e *historyEngineImpl) DescribeCrossClusterQueue(
 	return e.describeQueue(ctx, e.crossClusterProcessor, clusterName)
 }
 
+func (e *historyEngineImpl) GetReplicationStatus(
+	ctx context.Context,
+	remoteCluster string,
+) (*types.ReplicationShardStatus, error) {
+	var remoteProcessor replication.TaskProcessor
+	for _, processor := range e.replicationTaskProcessors {
+		if processor.GetSourceCluster() == remoteCluster {
+			remoteProcessor = processor
+		}
+	}
+	return replication.NewStatusReader(
+		e.shard,
+		e.config.ReplicatorTaskBatchSize(),
+		e.config.ReplicationStatusMaxTasks(),
+	).Read(ctx, remoteCluster, remoteProcessor)
+}
+
+func (e *historyEngineImpl) GetReplicationReadiness(
+	ctx context.Context,
+	sourceCluster string,
+) (*types.ReplicationShardReadiness, error) {
+	for _, processor := range e.replicationTaskProcessors {
+		if processor.GetSourceCluster() == sourceCluster {
+			return replication.ReadShardReadiness(ctx, e.shard, processor, e.config.ReplicatorTaskBatchSize())
+		}
+	}
+	return nil, &types.BadRequestError{Message: fmt.Sprintf("No replication from cluster %v.", sourceCluster)}
+}
+
 func (e *historyEngineImpl) describeQueue(
 	ctx context.Context,
 	queueProcessor queue.Processor,
@@ -2967,6 +3257,7 @@ func getStartRequest(
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
+		CompletionCallbacks:                 request.CompletionCallbacks,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
@@ -3105,7 +3396,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 	reapplyEvents []*types.HistoryEvent,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(domainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), domainUUID, workflowID)
 	if err != nil {
 		switch {
 		case domainEntry != nil && domainEntry.IsDomainPendingActive():
@@ -3256,11 +3547,23 @@ func (e *historyEngineImpl) ReadDLQMessages(
 	if err != nil {
 		return nil, err
 	}
+
+	taskStates := make(map[int64]*types.ReplicationDLQTaskState)
+	for _, taskState := range e.shard.GetReplicationDLQRetryState(request.GetSourceCluster()).GetTaskStates() {
+		taskStates[taskState.GetTaskID()] = taskState
+	}
+	var retryStates []*types.ReplicationDLQTaskState
+	for _, info := range taskInfo {
+		if taskState, ok := taskStates[info.GetTaskID()]; ok {
+			retryStates = append(retryStates, taskState)
+		}
+	}
 	return &types.ReadDLQMessagesResponse{
 		Type:                 request.GetType().Ptr(),
 		ReplicationTasks:     tasks,
 		ReplicationTasksInfo: taskInfo,
 		NextPageToken:        token,
+		RetryStates:          retryStates,
 	}, nil
 }
 
@@ -3301,7 +3604,7 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	domainUUID string,
 	workflowExecution types.WorkflowExecution,
 ) (retError error) {
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(domainUUID)
+	domainEntry, err := cache.GetActiveDomainForWorkflow(e.shard.GetDomainCache(), domainUUID, workflowExecution.GetWorkflowID())
 	if err != nil {
 		return err
 	}
@@ -3318,16 +3621,7 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 		return err
 	}
 
-	mutableStateTaskRefresher := execution.NewMutableStateTaskRefresher(
-		e.shard.GetConfig(),
-		e.shard.GetClusterMetadata(),
-		e.shard.GetDomainCache(),
-		e.shard.GetEventsCache(),
-		e.shard.GetLogger(),
-		e.shard.GetShardID(),
-	)
-
-	err = mutableStateTaskRefresher.RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState)
+	err = e.newMutableStateTaskRefresher().RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState)
 	if err != nil {
 		return err
 	}
@@ -3339,6 +3633,17 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) newMutableStateTaskRefresher() execution.MutableStateTaskRefresher {
+	return execution.NewMutableStateTaskRefresher(
+		e.shard.GetConfig(),
+		e.shard.GetClusterMetadata(),
+		e.shard.GetDomainCache(),
+		e.shard.GetEventsCache(),
+		e.shard.GetLogger(),
+		e.shard.GetShardID(),
+	)
+}
+
 func (e *historyEngineImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	targetCluster string,
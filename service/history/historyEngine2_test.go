@@ -404,6 +404,40 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedIfTaskAlreadyStarted() {
 	s.logger.Error("RecordDecisionTaskStarted failed with", tag.Error(err))
 }
 
+func (s *engine2Suite) TestRecordDecisionTaskStartedIfWorkflowPaused() {
+	domainID := constants.TestDomainID
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.GetExecutionInfo().PauseInfo = &p.WorkflowPauseInfo{PausedTimestamp: time.Now()}
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &types.RecordDecisionTaskStartedRequest{
+		DomainUUID:        domainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        2,
+		TaskID:            100,
+		RequestID:         "reqId",
+		PollRequest: &types.PollForDecisionTaskRequest{
+			TaskList: &types.TaskList{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(response)
+	// the task is dropped by matching, unpausing the workflow schedules it again
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
 func (s *engine2Suite) TestRecordDecisionTaskStartedIfTaskAlreadyCompleted() {
 	domainID := constants.TestDomainID
 	workflowExecution := types.WorkflowExecution{
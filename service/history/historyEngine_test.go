@@ -2881,6 +2881,17 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	// the poller which started the activity does not get a task token back, so history frees its slot
+	s.config.MatchingMaxOutstandingTasksPerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	defer func() {
+		s.config.MatchingMaxOutstandingTasksPerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(0)
+	}()
+	s.mockMatchingClient.EXPECT().ReleaseOutstandingTask(gomock.Any(), &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        constants.TestDomainID,
+		TaskList:          &types.TaskList{Name: tl, Kind: types.TaskListKindNormal.Ptr()},
+		WorkflowExecution: &we,
+		ScheduleID:        activityScheduledEvent.EventID,
+	}).Return(nil).Times(1)
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &types.HistoryRespondActivityTaskCompletedRequest{
 		DomainUUID: constants.TestDomainID,
@@ -4956,6 +4967,122 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Reason:            "incident",
+			Identity:          "testIdentity",
+			AllowActivities:   true,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		pauseInfo := request.UpdateWorkflowMutation.ExecutionInfo.PauseInfo
+		return pauseInfo != nil && pauseInfo.Reason == "incident" && pauseInfo.Identity == "testIdentity" && pauseInfo.AllowActivities
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          "testIdentity",
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.GetExecutionInfo().PauseInfo = &p.WorkflowPauseInfo{PausedTimestamp: time.Now()}
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          "testIdentity",
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.GetExecutionInfo().PauseInfo = &p.WorkflowPauseInfo{PausedTimestamp: time.Now()}
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		// the held back decision task is generated again
+		hasDecisionTask := false
+		for _, task := range request.UpdateWorkflowMutation.TransferTasks {
+			if task.GetType() == p.TransferTaskTypeDecisionTask {
+				hasDecisionTask = true
+			}
+		}
+		return request.UpdateWorkflowMutation.ExecutionInfo.PauseInfo == nil && hasDecisionTask
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Nil(err)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen() {
 	we := types.WorkflowExecution{
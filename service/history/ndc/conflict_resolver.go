@@ -189,6 +189,16 @@ func (r *conflictResolverImpl) rebuild(
 	}
 	// set the update condition from original mutable state
 	rebuildMutableState.SetUpdateCondition(r.mutableState.GetUpdateCondition())
+	// offloaded payloads referenced by the other branches are still deleted with the workflow
+	rebuildExecutionInfo := rebuildMutableState.GetExecutionInfo()
+	for key, eventID := range executionInfo.OffloadedPayloads {
+		if rebuildExecutionInfo.OffloadedPayloads == nil {
+			rebuildExecutionInfo.OffloadedPayloads = make(map[string]int64)
+		}
+		if _, ok := rebuildExecutionInfo.OffloadedPayloads[key]; !ok {
+			rebuildExecutionInfo.OffloadedPayloads[key] = eventID
+		}
+	}
 
 	r.context.Clear()
 	r.context.SetHistorySize(rebuiltHistorySize)
@@ -126,9 +126,10 @@ func (s *conflictResolverSuite) TestRebuild() {
 	s.mockMutableState.EXPECT().GetUpdateCondition().Return(updateCondition).AnyTimes()
 	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
 	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
-		DomainID:   s.domainID,
-		WorkflowID: s.workflowID,
-		RunID:      s.runID,
+		DomainID:          s.domainID,
+		WorkflowID:        s.workflowID,
+		RunID:             s.runID,
+		OffloadedPayloads: map[string]int64{"old-branch-key": 4, "shared-key": 1},
 	}).AnyTimes()
 
 	workflowIdentifier := definition.NewWorkflowIdentifier(
@@ -147,6 +148,10 @@ func (s *conflictResolverSuite) TestRebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	rebuildExecutionInfo := &persistence.WorkflowExecutionInfo{
+		OffloadedPayloads: map[string]int64{"shared-key": 1, "new-branch-key": 2},
+	}
+	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(rebuildExecutionInfo).AnyTimes()
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -166,6 +171,7 @@ func (s *conflictResolverSuite) TestRebuild() {
 	s.NoError(err)
 	s.NotNil(rebuiltMutableState)
 	s.Equal(1, versionHistories.GetCurrentVersionHistoryIndex())
+	s.Equal(map[string]int64{"old-branch-key": 4, "shared-key": 1, "new-branch-key": 2}, rebuildExecutionInfo.OffloadedPayloads)
 }
 
 func (s *conflictResolverSuite) TestPrepareMutableState_NoRebuild() {
@@ -240,6 +246,7 @@ func (s *conflictResolverSuite) TestPrepareMutableState_Rebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -279,7 +279,9 @@ func (r *transactionManagerImpl) backfillWorkflowEventsReapply(
 	}
 	isWorkflowRunning := targetWorkflow.GetMutableState().IsWorkflowExecutionRunning()
 	targetWorkflowActiveCluster := r.clusterMetadata.ClusterNameForFailoverVersion(
-		targetWorkflow.GetMutableState().GetDomainEntry().GetFailoverVersion(),
+		targetWorkflow.GetMutableState().GetDomainEntry().GetFailoverVersionForWorkflow(
+			targetWorkflow.GetMutableState().GetExecutionInfo().WorkflowID,
+		),
 	)
 	currentCluster := r.clusterMetadata.GetCurrentClusterName()
 	isActiveCluster := targetWorkflowActiveCluster == currentCluster
@@ -163,7 +163,7 @@ func (s *transactionManagerSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Op
 	mutableState.EXPECT().IsCurrentWorkflowGuaranteed().Return(true).AnyTimes()
 	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
 	mutableState.EXPECT().GetDomainEntry().Return(s.domainEntry).AnyTimes()
-	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{RunID: runID}).Times(1)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{RunID: runID}).Times(2)
 	context.EXPECT().PersistNonStartWorkflowBatchEvents(gomock.Any(), workflowEvents).Return(int64(0), nil).Times(1)
 	context.EXPECT().UpdateWorkflowExecutionWithNew(
 		gomock.Any(), now, persistence.UpdateWorkflowModeUpdateCurrent, nil, nil, execution.TransactionPolicyActive, (*execution.TransactionPolicy)(nil),
@@ -272,6 +272,58 @@ func (s *transactionManagerSuite) TestBackfillWorkflow_CurrentWorkflow_Passive_O
 	mutableState.EXPECT().IsCurrentWorkflowGuaranteed().Return(true).AnyTimes()
 	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
 	mutableState.EXPECT().GetDomainEntry().Return(s.domainEntry).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	context.EXPECT().ReapplyEvents([]*persistence.WorkflowEvents{workflowEvents}).Times(1)
+	context.EXPECT().PersistNonStartWorkflowBatchEvents(gomock.Any(), workflowEvents).Return(int64(0), nil).Times(1)
+	context.EXPECT().UpdateWorkflowExecutionWithNew(
+		gomock.Any(), now, persistence.UpdateWorkflowModeUpdateCurrent, nil, nil, execution.TransactionPolicyPassive, (*execution.TransactionPolicy)(nil),
+	).Return(nil).Times(1)
+	err := s.transactionManager.backfillWorkflow(ctx, now, workflow, workflowEvents)
+	s.NoError(err)
+	s.True(releaseCalled)
+}
+
+func (s *transactionManagerSuite) TestBackfillWorkflow_CurrentWorkflow_Passive_WorkflowActiveCluster() {
+	ctx := ctx.Background()
+	now := time.Now()
+	workflowID := "some random workflow ID"
+	releaseCalled := false
+
+	// the domain is active in the current cluster while the workflow is active in the alternative cluster
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":100"},
+		},
+		s.domainEntry.GetConfig(),
+		s.domainEntry.GetReplicationConfig(),
+		cluster.TestCurrentClusterInitialFailoverVersion+cluster.TestFailoverVersionIncrement,
+		constants.TestClusterMetadata,
+	)
+	workflowVersion := domainEntry.GetFailoverVersionForWorkflow(workflowID)
+	s.NotEqual(domainEntry.GetFailoverVersion(), workflowVersion)
+
+	workflow := execution.NewMockWorkflow(s.controller)
+	context := execution.NewMockContext(s.controller)
+	mutableState := execution.NewMockMutableState(s.controller)
+	var releaseFn execution.ReleaseFunc = func(error) { releaseCalled = true }
+
+	workflowEvents := &persistence.WorkflowEvents{
+		Events: []*types.HistoryEvent{{EventID: 1}},
+	}
+
+	workflow.EXPECT().GetContext().Return(context).AnyTimes()
+	workflow.EXPECT().GetMutableState().Return(mutableState).AnyTimes()
+	workflow.EXPECT().GetReleaseFn().Return(releaseFn).AnyTimes()
+
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(workflowVersion).Return(cluster.TestAlternativeClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	mutableState.EXPECT().IsCurrentWorkflowGuaranteed().Return(true).AnyTimes()
+	mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+	mutableState.EXPECT().GetDomainEntry().Return(domainEntry).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{WorkflowID: workflowID}).AnyTimes()
 	context.EXPECT().ReapplyEvents([]*persistence.WorkflowEvents{workflowEvents}).Times(1)
 	context.EXPECT().PersistNonStartWorkflowBatchEvents(gomock.Any(), workflowEvents).Return(int64(0), nil).Times(1)
 	context.EXPECT().UpdateWorkflowExecutionWithNew(
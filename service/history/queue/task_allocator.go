@@ -77,7 +77,7 @@ func (t *taskAllocatorImpl) VerifyActiveTask(taskDomainID string, task interface
 		t.logger.Warn("Cannot find domain, default to process task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return true, nil
 	}
-	if domainEntry.IsGlobalDomain() && t.currentClusterName != domainEntry.GetReplicationConfig().ActiveClusterName {
+	if domainEntry.IsGlobalDomain() && t.currentClusterName != domainEntry.GetActiveClusterForWorkflow(getTaskWorkflowID(task)) {
 		// timer task does not belong to cluster name
 		t.logger.Debug("Domain is not active, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return false, nil
@@ -113,6 +113,11 @@ func (t *taskAllocatorImpl) VerifyFailoverActiveTask(targetDomainIDs map[string]
 			t.logger.Warn("Cannot find domain, default to not process task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 			return false, nil
 		}
+		if domainEntry.IsGlobalDomain() && t.currentClusterName != domainEntry.GetActiveClusterForWorkflow(getTaskWorkflowID(task)) {
+			// workflow is still owned by another cluster
+			t.logger.Debug("Workflow is not active, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
+			return false, nil
+		}
 		if err := t.checkDomainPendingActive(
 			domainEntry,
 			taskDomainID,
@@ -148,7 +153,7 @@ func (t *taskAllocatorImpl) VerifyStandbyTask(standbyCluster string, taskDomainI
 		// non global domain, timer task does not belong here
 		t.logger.Debug("Domain is not global, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return false, nil
-	} else if domainEntry.IsGlobalDomain() && domainEntry.GetReplicationConfig().ActiveClusterName != standbyCluster {
+	} else if domainEntry.IsGlobalDomain() && domainEntry.GetActiveClusterForWorkflow(getTaskWorkflowID(task)) != standbyCluster {
 		// timer task does not belong here
 		t.logger.Debug("Domain is not standby, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return false, nil
@@ -177,9 +182,21 @@ func (t *taskAllocatorImpl) checkDomainPendingActive(
 		t.logger.Debug("Domain is not in pending active, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
 		return htask.ErrTaskPendingActive
 	}
+	if domainEntry.IsWorkflowPendingActive(getTaskWorkflowID(task)) {
+		// the workflow is handed off to another cluster, pause on processing this task
+		t.logger.Debug("Workflow is in handoff, skip task.", tag.WorkflowDomainID(taskDomainID), tag.Value(task))
+		return htask.ErrTaskPendingActive
+	}
 	return nil
 }
 
+func getTaskWorkflowID(task interface{}) string {
+	if t, ok := task.(interface{ GetWorkflowID() string }); ok {
+		return t.GetWorkflowID()
+	}
+	return ""
+}
+
 // Lock block all task allocation
 func (t *taskAllocatorImpl) Lock() {
 	t.locker.Lock()
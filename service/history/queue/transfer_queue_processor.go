@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
@@ -92,6 +93,7 @@ func NewTransferQueueProcessor(
 	executionCache *execution.Cache,
 	workflowResetter reset.WorkflowResetter,
 	archivalClient archiver.Client,
+	historyExportProducer messaging.Producer,
 	executionCheck invariant.Invariant,
 ) Processor {
 	logger := shard.GetLogger().WithTags(tag.ComponentTransferQueue)
@@ -102,6 +104,7 @@ func NewTransferQueueProcessor(
 	activeTaskExecutor := task.NewTransferActiveTaskExecutor(
 		shard,
 		archivalClient,
+		historyExportProducer,
 		executionCache,
 		workflowResetter,
 		logger,
@@ -136,6 +139,7 @@ func NewTransferQueueProcessor(
 		standbyTaskExecutor := task.NewTransferStandbyTaskExecutor(
 			shard,
 			archivalClient,
+			historyExportProducer,
 			executionCache,
 			historyResender,
 			logger,
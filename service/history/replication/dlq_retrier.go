@@ -0,0 +1,499 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	// DLQErrorClassMissingDomain is the class of the DLQ tasks whose domain does not exist in the cluster
+	DLQErrorClassMissingDomain = "missing-domain"
+	// DLQErrorClassHistoryGap is the class of the DLQ tasks which cannot be applied until the missing
+	// history of the workflow is replicated
+	DLQErrorClassHistoryGap = "history-gap"
+	// DLQErrorClassWorkflowNotFound is the class of the DLQ tasks whose workflow does not exist in the cluster
+	DLQErrorClassWorkflowNotFound = "workflow-not-found"
+	// DLQErrorClassCorrupted is the class of the DLQ tasks which can never be applied, e.g. they are gone
+	// from the source cluster or cannot be decoded
+	DLQErrorClassCorrupted = "corrupted"
+	// DLQErrorClassTransient is the class of the DLQ tasks which failed with any other error
+	DLQErrorClassTransient = "transient"
+
+	dlqRetryTimerJitterCoefficient = 0.1
+)
+
+var (
+	errDLQTaskNotFound = &types.BadRequestError{Message: "Replication task is not found in the source cluster."}
+)
+
+type (
+	// DLQRetrier periodically retries the replication tasks in the DLQ of a shard. The tasks which are
+	// applied are deleted from the DLQ, the others are retried with backoff until they are found to be
+	// poison, poison tasks are kept in the DLQ for the operators to merge or purge. The retry state and
+	// the read cursor are stored in the shard info, so they survive shard movements. The number of task
+	// states is capped, once the cap is reached the tasks without a state are left in the DLQ untouched.
+	DLQRetrier interface {
+		common.Daemon
+	}
+
+	dlqRetrierImpl struct {
+		status        int32
+		shard         shard.Context
+		config        *config.Config
+		taskExecutors map[string]TaskExecutor
+		metricsClient metrics.Client
+		logger        log.Logger
+
+		done chan struct{}
+	}
+)
+
+var _ DLQRetrier = (*dlqRetrierImpl)(nil)
+
+// NewDLQRetrier creates a new replication DLQ retrier
+func NewDLQRetrier(
+	shard shard.Context,
+	config *config.Config,
+	taskExecutors map[string]TaskExecutor,
+) DLQRetrier {
+
+	return &dlqRetrierImpl{
+		status:        common.DaemonStatusInitialized,
+		shard:         shard,
+		config:        config,
+		taskExecutors: taskExecutors,
+		metricsClient: shard.GetMetricsClient(),
+		logger:        shard.GetLogger(),
+		done:          make(chan struct{}),
+	}
+}
+
+// Start starts the retrier
+func (r *dlqRetrierImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go r.retryLoop()
+	r.logger.Info("Replication DLQ retrier started.")
+}
+
+// Stop stops the retrier
+func (r *dlqRetrierImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(r.done)
+	r.logger.Info("Replication DLQ retrier stopped.")
+}
+
+func (r *dlqRetrierImpl) retryLoop() {
+	shardID := r.shard.GetShardID()
+	timer := time.NewTimer(backoff.JitDuration(
+		r.config.ReplicationDLQRetryInterval(shardID),
+		dlqRetryTimerJitterCoefficient,
+	))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if r.config.EnableReplicationDLQAutoRetry() {
+				r.retryAll()
+			}
+			timer.Reset(backoff.JitDuration(
+				r.config.ReplicationDLQRetryInterval(shardID),
+				dlqRetryTimerJitterCoefficient,
+			))
+		case <-r.done:
+			return
+		}
+	}
+}
+
+func (r *dlqRetrierImpl) retryAll() {
+	sourceClusters := make([]string, 0, len(r.taskExecutors))
+	for sourceCluster := range r.taskExecutors {
+		sourceClusters = append(sourceClusters, sourceCluster)
+	}
+	sort.Strings(sourceClusters)
+
+	for _, sourceCluster := range sourceClusters {
+		if err := r.retry(context.Background(), sourceCluster); err != nil {
+			r.logger.Warn("Failed to retry replication DLQ tasks.", tag.SourceCluster(sourceCluster), tag.Error(err))
+		}
+	}
+}
+
+// retry reads the DLQ from the source cluster starting after the stored cursor and retries the tasks
+// which are due and not poison. A pass ends once a batch of tasks has been retried or the end of the
+// DLQ is reached, in which case the cursor goes back to the beginning of the DLQ. The tasks without a
+// state are skipped when retrying them could grow the states beyond ReplicationDLQMaxTaskStates.
+func (r *dlqRetrierImpl) retry(
+	ctx context.Context,
+	sourceCluster string,
+) (retError error) {
+
+	shardID := r.shard.GetShardID()
+	scope := r.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.TargetClusterTag(sourceCluster),
+		metrics.InstanceTag(strconv.Itoa(shardID)),
+	)
+	retryPolicy := r.newRetryPolicy()
+	batchSize := r.config.ReplicationDLQRetryBatchSize()
+	maxTaskStates := r.config.ReplicationDLQMaxTaskStates()
+
+	cursor, taskStates := r.loadState(sourceCluster)
+	readLevel := cursor
+	skipped := 0
+	defer func() {
+		scope.UpdateGauge(metrics.ReplicationDLQPoisonTasks, float64(countPoisonTasks(taskStates)))
+		if skipped > 0 {
+			scope.AddCounter(metrics.ReplicationDLQRetrySkipped, int64(skipped))
+			r.logger.Warn("Replication DLQ tasks are not retried as the max number of task states is reached, merge or purge the poison tasks.",
+				tag.SourceCluster(sourceCluster),
+				tag.Counter(skipped),
+				tag.Number(int64(maxTaskStates)),
+			)
+		}
+		if err := r.saveState(sourceCluster, cursor, taskStates, maxTaskStates); err != nil && retError == nil {
+			retError = err
+		}
+	}()
+
+	// merged and purged DLQ tasks are deleted, so the states of the tasks which are not read back are dropped
+	seen := make(map[int64]struct{})
+	retried := 0
+	var pageToken []byte
+	for {
+		resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+			ctx,
+			&persistence.GetReplicationTasksFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+					ReadLevel:     readLevel,
+					MaxReadLevel:  common.EndMessageID,
+					BatchSize:     batchSize,
+					NextPageToken: pageToken,
+				},
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		now := r.shard.GetTimeSource().Now().UnixNano()
+		var dueTasks []*persistence.ReplicationTaskInfo
+		lastTaskID := cursor
+		// every due task without a state may fail and take one of the remaining states
+		untracked := 0
+		for _, task := range resp.Tasks {
+			seen[task.GetTaskID()] = struct{}{}
+			if task.GetTaskID() > lastTaskID {
+				lastTaskID = task.GetTaskID()
+			}
+			state, ok := taskStates[task.GetTaskID()]
+			if !ok && len(taskStates)+untracked >= maxTaskStates {
+				skipped++
+				continue
+			}
+			if isDLQTaskDue(state, now) {
+				dueTasks = append(dueTasks, task)
+				if !ok {
+					untracked++
+				}
+			}
+		}
+		if err := r.retryTasks(ctx, sourceCluster, dueTasks, taskStates, retryPolicy, scope); err != nil {
+			return err
+		}
+		retried += len(dueTasks)
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			pruneDLQTaskStates(taskStates, seen, cursor, common.EndMessageID)
+			cursor = defaultBeginningMessageID
+			return nil
+		}
+		pruneDLQTaskStates(taskStates, seen, cursor, lastTaskID)
+		cursor = lastTaskID
+		if retried >= batchSize {
+			return nil
+		}
+	}
+}
+
+func (r *dlqRetrierImpl) loadState(
+	sourceCluster string,
+) (int64, map[int64]*types.ReplicationDLQTaskState) {
+
+	state := r.shard.GetReplicationDLQRetryState(sourceCluster)
+	taskStates := make(map[int64]*types.ReplicationDLQTaskState, len(state.GetTaskStates()))
+	for _, taskState := range state.GetTaskStates() {
+		copied := *taskState
+		taskStates[taskState.GetTaskID()] = &copied
+	}
+	if state == nil {
+		return defaultBeginningMessageID, taskStates
+	}
+	return state.Cursor, taskStates
+}
+
+func (r *dlqRetrierImpl) saveState(
+	sourceCluster string,
+	cursor int64,
+	taskStates map[int64]*types.ReplicationDLQTaskState,
+	maxTaskStates int,
+) error {
+
+	state := &types.ReplicationDLQRetryState{
+		Cursor:     cursor,
+		TaskStates: make([]*types.ReplicationDLQTaskState, 0, len(taskStates)),
+	}
+	for _, taskState := range taskStates {
+		state.TaskStates = append(state.TaskStates, taskState)
+	}
+	sort.Slice(state.TaskStates, func(i, j int) bool {
+		return state.TaskStates[i].TaskID < state.TaskStates[j].TaskID
+	})
+	// the cap may have been lowered since the states were stored
+	if maxTaskStates >= 0 && len(state.TaskStates) > maxTaskStates {
+		state.TaskStates = state.TaskStates[:maxTaskStates]
+	}
+	return r.shard.UpdateReplicationDLQRetryState(sourceCluster, state)
+}
+
+func (r *dlqRetrierImpl) newRetryPolicy() backoff.RetryPolicy {
+	shardID := r.shard.GetShardID()
+	interval := r.config.ReplicationDLQRetryInterval(shardID)
+	maxInterval := r.config.ReplicationDLQRetryMaxInterval(shardID)
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(interval)
+	retryPolicy.SetMaximumInterval(maxInterval)
+	retryPolicy.SetMaximumAttempts(r.config.ReplicationDLQRetryMaxAttempts(shardID))
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	return retryPolicy
+}
+
+func (r *dlqRetrierImpl) retryTasks(
+	ctx context.Context,
+	sourceCluster string,
+	tasks []*persistence.ReplicationTaskInfo,
+	taskStates map[int64]*types.ReplicationDLQTaskState,
+	retryPolicy backoff.RetryPolicy,
+	scope metrics.Scope,
+) error {
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	remoteAdminClient := r.shard.GetService().GetClientBean().GetRemoteAdminClient(sourceCluster)
+	if remoteAdminClient == nil {
+		return errInvalidCluster
+	}
+	taskInfos := make([]*types.ReplicationTaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		taskInfos = append(taskInfos, &types.ReplicationTaskInfo{
+			DomainID:     task.GetDomainID(),
+			WorkflowID:   task.GetWorkflowID(),
+			RunID:        task.GetRunID(),
+			TaskType:     int16(task.GetTaskType()),
+			TaskID:       task.GetTaskID(),
+			Version:      task.GetVersion(),
+			FirstEventID: task.FirstEventID,
+			NextEventID:  task.NextEventID,
+			ScheduledID:  task.ScheduledID,
+		})
+	}
+	resp, err := remoteAdminClient.GetDLQReplicationMessages(
+		ctx,
+		&types.GetDLQReplicationMessagesRequest{
+			TaskInfos: taskInfos,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	replicationTasks := make(map[int64]*types.ReplicationTask, len(resp.GetReplicationTasks()))
+	for _, replicationTask := range resp.GetReplicationTasks() {
+		replicationTasks[replicationTask.GetSourceTaskID()] = replicationTask
+	}
+
+	for _, task := range tasks {
+		scope.IncCounter(metrics.ReplicationDLQRetryCount)
+		errorClass, err := r.retryTask(task, replicationTasks[task.GetTaskID()], sourceCluster)
+		if err == nil {
+			if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+				ctx,
+				&persistence.DeleteReplicationTaskFromDLQRequest{
+					SourceClusterName: sourceCluster,
+					TaskID:            task.GetTaskID(),
+				},
+			); err != nil {
+				return err
+			}
+			delete(taskStates, task.GetTaskID())
+			scope.IncCounter(metrics.ReplicationDLQRetrySuccess)
+			continue
+		}
+
+		state := r.failed(taskStates, task.GetTaskID(), errorClass, err, retryPolicy)
+		scope.Tagged(metrics.ErrorClassTag(errorClass)).IncCounter(metrics.ReplicationDLQRetryFailed)
+		if state.Poison {
+			r.logger.Warn("Replication DLQ task is poison and no longer retried.",
+				tag.SourceCluster(sourceCluster),
+				tag.TaskID(task.GetTaskID()),
+				tag.WorkflowDomainID(task.GetDomainID()),
+				tag.WorkflowID(task.GetWorkflowID()),
+				tag.WorkflowRunID(task.GetRunID()),
+				tag.Value(errorClass),
+				tag.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+func (r *dlqRetrierImpl) retryTask(
+	task *persistence.ReplicationTaskInfo,
+	replicationTask *types.ReplicationTask,
+	sourceCluster string,
+) (string, error) {
+
+	if replicationTask == nil {
+		return DLQErrorClassCorrupted, errDLQTaskNotFound
+	}
+	// the executor does not check the domain when the task is force applied
+	if _, err := r.shard.GetDomainCache().GetDomainByID(task.GetDomainID()); err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return DLQErrorClassMissingDomain, err
+		}
+		return DLQErrorClassTransient, err
+	}
+	_, err := r.taskExecutors[sourceCluster].execute(replicationTask, true)
+	return ClassifyDLQError(err), err
+}
+
+func (r *dlqRetrierImpl) failed(
+	taskStates map[int64]*types.ReplicationDLQTaskState,
+	taskID int64,
+	errorClass string,
+	err error,
+	retryPolicy backoff.RetryPolicy,
+) *types.ReplicationDLQTaskState {
+
+	state, ok := taskStates[taskID]
+	if !ok {
+		state = &types.ReplicationDLQTaskState{TaskID: taskID}
+		taskStates[taskID] = state
+	}
+	now := r.shard.GetTimeSource().Now()
+	state.ErrorClass = errorClass
+	state.Attempts++
+	state.LastError = err.Error()
+	state.LastAttemptTimestamp = now.UnixNano()
+	if delay := retryPolicy.ComputeNextDelay(0, int(state.Attempts)); errorClass == DLQErrorClassCorrupted || delay < 0 {
+		state.Poison = true
+		state.NextAttemptTimestamp = 0
+	} else {
+		state.NextAttemptTimestamp = now.Add(delay).UnixNano()
+	}
+	return state
+}
+
+func isDLQTaskDue(
+	state *types.ReplicationDLQTaskState,
+	now int64,
+) bool {
+
+	return state == nil || (!state.Poison && now >= state.NextAttemptTimestamp)
+}
+
+// pruneDLQTaskStates drops the states of the tasks in (minTaskID, maxTaskID] which are no longer in the DLQ
+func pruneDLQTaskStates(
+	taskStates map[int64]*types.ReplicationDLQTaskState,
+	taskIDs map[int64]struct{},
+	minTaskID int64,
+	maxTaskID int64,
+) {
+
+	for taskID := range taskStates {
+		if taskID <= minTaskID || taskID > maxTaskID {
+			continue
+		}
+		if _, ok := taskIDs[taskID]; !ok {
+			delete(taskStates, taskID)
+		}
+	}
+}
+
+func countPoisonTasks(
+	taskStates map[int64]*types.ReplicationDLQTaskState,
+) int {
+
+	poisonTasks := 0
+	for _, state := range taskStates {
+		if state.Poison {
+			poisonTasks++
+		}
+	}
+	return poisonTasks
+}
+
+// ClassifyDLQError returns the class of the error of applying a replication task from the DLQ
+func ClassifyDLQError(
+	err error,
+) string {
+
+	switch err.(type) {
+	case nil:
+		return ""
+	case *types.RetryTaskV2Error:
+		return DLQErrorClassHistoryGap
+	case *types.EntityNotExistsError:
+		return DLQErrorClassWorkflowNotFound
+	case *types.BadRequestError:
+		return DLQErrorClassCorrupted
+	default:
+		return DLQErrorClassTransient
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	dlqRetrierSuite struct {
+		suite.Suite
+		*require.Assertions
+		controller *gomock.Controller
+
+		mockShard        *shard.TestContext
+		config           *config.Config
+		mockClientBean   *client.MockBean
+		adminClient      *admin.MockClient
+		clusterMetadata  *cluster.MockMetadata
+		domainCache      *cache.MockDomainCache
+		executionManager *mocks.ExecutionManager
+		taskExecutor     *MockTaskExecutor
+		sourceCluster    string
+
+		retrier *dlqRetrierImpl
+	}
+)
+
+func TestDLQRetrierSuite(t *testing.T) {
+	s := new(dlqRetrierSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqRetrierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.config = config.NewForTest()
+	s.config.ReplicationDLQRetryMaxAttempts = dynamicconfig.GetIntPropertyFilteredByShardID(3)
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 0,
+			RangeID: 1,
+		},
+		s.config,
+	)
+
+	s.mockClientBean = s.mockShard.Resource.ClientBean
+	s.adminClient = s.mockShard.Resource.RemoteAdminClient
+	s.clusterMetadata = s.mockShard.Resource.ClusterMetadata
+	s.domainCache = s.mockShard.Resource.DomainCache
+	s.executionManager = s.mockShard.Resource.ExecutionMgr
+
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(s.adminClient).AnyTimes()
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockShard.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.taskExecutor = NewMockTaskExecutor(s.controller)
+	s.sourceCluster = "test"
+
+	s.retrier = NewDLQRetrier(
+		s.mockShard,
+		s.config,
+		map[string]TaskExecutor{s.sourceCluster: s.taskExecutor},
+	).(*dlqRetrierImpl)
+}
+
+func (s *dlqRetrierSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *dlqRetrierSuite) TestRetry_Success() {
+	task := s.newTaskInfo(1)
+	replicationTask := s.expectReadDLQ(defaultBeginningMessageID, task)
+	s.domainCache.EXPECT().GetDomainByID(task.DomainID).Return(cache.NewLocalDomainCacheEntryForTest(nil, nil, "", nil), nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, nil).Times(1)
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            task.TaskID,
+	}).Return(nil).Times(1)
+
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	state := s.mockShard.GetReplicationDLQRetryState(s.sourceCluster)
+	s.Equal(int64(defaultBeginningMessageID), state.GetCursor())
+	s.Empty(state.GetTaskStates())
+}
+
+func (s *dlqRetrierSuite) TestRetry_HistoryGap_BackOff() {
+	task := s.newTaskInfo(1)
+	replicationTask := s.expectReadDLQ(defaultBeginningMessageID, task)
+	s.domainCache.EXPECT().GetDomainByID(task.DomainID).Return(cache.NewLocalDomainCacheEntryForTest(nil, nil, "", nil), nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, &types.RetryTaskV2Error{Message: "missing history"}).Times(1)
+
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	states := s.taskStates()
+	s.Len(states, 1)
+	s.Equal(DLQErrorClassHistoryGap, states[task.TaskID].ErrorClass)
+	s.Equal(int32(1), states[task.TaskID].Attempts)
+	s.False(states[task.TaskID].Poison)
+	s.True(states[task.TaskID].NextAttemptTimestamp > states[task.TaskID].LastAttemptTimestamp)
+
+	// the task is not due, so it is not fetched from the source cluster again
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	s.Equal(int32(1), s.taskStates()[task.TaskID].Attempts)
+}
+
+func (s *dlqRetrierSuite) TestRetry_MissingDomain() {
+	task := s.newTaskInfo(1)
+	s.expectReadDLQ(defaultBeginningMessageID, task)
+	s.domainCache.EXPECT().GetDomainByID(task.DomainID).Return(nil, &types.EntityNotExistsError{Message: "domain not found"}).Times(1)
+
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	state := s.taskStates()[task.TaskID]
+	s.Equal(DLQErrorClassMissingDomain, state.ErrorClass)
+	s.False(state.Poison)
+}
+
+func (s *dlqRetrierSuite) TestRetry_NotFoundInSourceCluster_Poison() {
+	task := s.newTaskInfo(1)
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).
+		Return(&persistence.GetReplicationTasksFromDLQResponse{Tasks: []*persistence.ReplicationTaskInfo{task}}, nil)
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{}, nil).Times(1)
+
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	state := s.taskStates()[task.TaskID]
+	s.Equal(DLQErrorClassCorrupted, state.ErrorClass)
+	s.True(state.Poison)
+}
+
+func (s *dlqRetrierSuite) TestRetry_ResumeFromCursor() {
+	s.config.ReplicationDLQRetryBatchSize = dynamicconfig.GetIntPropertyFn(1)
+
+	task1 := s.newTaskInfo(1)
+	replicationTask1 := s.expectReadDLQPage(defaultBeginningMessageID, []byte("token"), task1)
+	s.domainCache.EXPECT().GetDomainByID(task1.DomainID).Return(cache.NewLocalDomainCacheEntryForTest(nil, nil, "", nil), nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask1, true).Return(0, &types.RetryTaskV2Error{Message: "missing history"}).Times(1)
+
+	// the pass stops after a batch of tasks is retried and the next pass starts after the last task read
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	state := s.mockShard.GetReplicationDLQRetryState(s.sourceCluster)
+	s.Equal(task1.TaskID, state.GetCursor())
+	s.Len(state.GetTaskStates(), 1)
+
+	task2 := s.newTaskInfo(2)
+	replicationTask2 := s.expectReadDLQ(task1.TaskID, task2)
+	s.domainCache.EXPECT().GetDomainByID(task2.DomainID).Return(cache.NewLocalDomainCacheEntryForTest(nil, nil, "", nil), nil).Times(1)
+	s.taskExecutor.EXPECT().execute(replicationTask2, true).Return(0, &types.RetryTaskV2Error{Message: "missing history"}).Times(1)
+
+	// the end of the DLQ is reached, so the cursor goes back to the beginning
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	state = s.mockShard.GetReplicationDLQRetryState(s.sourceCluster)
+	s.Equal(int64(defaultBeginningMessageID), state.GetCursor())
+	s.Len(state.GetTaskStates(), 2)
+}
+
+func (s *dlqRetrierSuite) TestRetry_StateRestoredFromShard() {
+	task := s.newTaskInfo(1)
+	s.NoError(s.mockShard.UpdateReplicationDLQRetryState(s.sourceCluster, &types.ReplicationDLQRetryState{
+		Cursor: defaultBeginningMessageID,
+		TaskStates: []*types.ReplicationDLQTaskState{
+			{TaskID: task.TaskID, ErrorClass: DLQErrorClassCorrupted, Attempts: 1, Poison: true},
+			{TaskID: 5, ErrorClass: DLQErrorClassCorrupted, Attempts: 1, Poison: true},
+		},
+	}))
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).
+		Return(&persistence.GetReplicationTasksFromDLQResponse{Tasks: []*persistence.ReplicationTaskInfo{task}}, nil)
+
+	// the poison task is not retried and the state of the task which is gone from the DLQ is dropped
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	states := s.taskStates()
+	s.Len(states, 1)
+	s.True(states[task.TaskID].Poison)
+	s.Equal(int32(1), states[task.TaskID].Attempts)
+}
+
+func (s *dlqRetrierSuite) TestRetry_MaxTaskStates() {
+	s.config.ReplicationDLQMaxTaskStates = dynamicconfig.GetIntPropertyFn(1)
+
+	task1 := s.newTaskInfo(1)
+	task2 := s.newTaskInfo(2)
+	s.NoError(s.mockShard.UpdateReplicationDLQRetryState(s.sourceCluster, &types.ReplicationDLQRetryState{
+		Cursor: defaultBeginningMessageID,
+		TaskStates: []*types.ReplicationDLQTaskState{
+			{TaskID: task1.TaskID, ErrorClass: DLQErrorClassCorrupted, Attempts: 1, Poison: true},
+		},
+	}))
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).
+		Return(&persistence.GetReplicationTasksFromDLQResponse{Tasks: []*persistence.ReplicationTaskInfo{task1, task2}}, nil)
+
+	// the states are full, so the task without a state is left in the DLQ and not fetched from the source cluster
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	states := s.taskStates()
+	s.Len(states, 1)
+	s.True(states[task1.TaskID].Poison)
+}
+
+func (s *dlqRetrierSuite) TestRetry_MaxTaskStates_Lowered() {
+	s.config.ReplicationDLQMaxTaskStates = dynamicconfig.GetIntPropertyFn(1)
+
+	task1 := s.newTaskInfo(1)
+	task2 := s.newTaskInfo(2)
+	s.NoError(s.mockShard.UpdateReplicationDLQRetryState(s.sourceCluster, &types.ReplicationDLQRetryState{
+		Cursor: defaultBeginningMessageID,
+		TaskStates: []*types.ReplicationDLQTaskState{
+			{TaskID: task1.TaskID, ErrorClass: DLQErrorClassCorrupted, Attempts: 1, Poison: true},
+			{TaskID: task2.TaskID, ErrorClass: DLQErrorClassCorrupted, Attempts: 1, Poison: true},
+		},
+	}))
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).
+		Return(&persistence.GetReplicationTasksFromDLQResponse{Tasks: []*persistence.ReplicationTaskInfo{task1, task2}}, nil)
+
+	s.NoError(s.retrier.retry(context.Background(), s.sourceCluster))
+	states := s.taskStates()
+	s.Len(states, 1)
+	s.Contains(states, task1.TaskID)
+}
+
+func (s *dlqRetrierSuite) TestFailed_MaxAttempts_Poison() {
+	retryPolicy := s.retrier.newRetryPolicy()
+	taskStates := make(map[int64]*types.ReplicationDLQTaskState)
+
+	err := &types.InternalServiceError{Message: "timeout"}
+	for attempt := 1; attempt < 3; attempt++ {
+		state := s.retrier.failed(taskStates, 1, DLQErrorClassTransient, err, retryPolicy)
+		s.Equal(int32(attempt), state.Attempts)
+		s.False(state.Poison)
+	}
+	state := s.retrier.failed(taskStates, 1, DLQErrorClassTransient, err, retryPolicy)
+	s.True(state.Poison)
+	s.False(isDLQTaskDue(state, state.LastAttemptTimestamp+int64(s.config.ReplicationDLQRetryMaxInterval(0))))
+}
+
+func (s *dlqRetrierSuite) TestPruneDLQTaskStates() {
+	taskStates := map[int64]*types.ReplicationDLQTaskState{
+		1: {TaskID: 1, Poison: true},
+		2: {TaskID: 2, Poison: true},
+		3: {TaskID: 3},
+		4: {TaskID: 4},
+	}
+
+	pruneDLQTaskStates(taskStates, map[int64]struct{}{3: {}}, 1, 3)
+	s.Len(taskStates, 3)
+	s.Contains(taskStates, int64(1))
+	s.Contains(taskStates, int64(3))
+	s.Contains(taskStates, int64(4))
+	s.Equal(1, countPoisonTasks(taskStates))
+}
+
+func (s *dlqRetrierSuite) TestClassifyDLQError() {
+	s.Equal("", ClassifyDLQError(nil))
+	s.Equal(DLQErrorClassHistoryGap, ClassifyDLQError(&types.RetryTaskV2Error{}))
+	s.Equal(DLQErrorClassWorkflowNotFound, ClassifyDLQError(&types.EntityNotExistsError{}))
+	s.Equal(DLQErrorClassCorrupted, ClassifyDLQError(ErrUnknownReplicationTask))
+	s.Equal(DLQErrorClassTransient, ClassifyDLQError(&types.InternalServiceError{}))
+}
+
+func (s *dlqRetrierSuite) newTaskInfo(
+	taskID int64,
+) *persistence.ReplicationTaskInfo {
+
+	return &persistence.ReplicationTaskInfo{
+		DomainID:   uuid.New(),
+		WorkflowID: uuid.New(),
+		RunID:      uuid.New(),
+		TaskType:   persistence.ReplicationTaskTypeHistory,
+		TaskID:     taskID,
+	}
+}
+
+func (s *dlqRetrierSuite) taskStates() map[int64]*types.ReplicationDLQTaskState {
+	states := make(map[int64]*types.ReplicationDLQTaskState)
+	for _, state := range s.mockShard.GetReplicationDLQRetryState(s.sourceCluster).GetTaskStates() {
+		states[state.TaskID] = state
+	}
+	return states
+}
+
+func (s *dlqRetrierSuite) expectReadDLQ(
+	readLevel int64,
+	task *persistence.ReplicationTaskInfo,
+) *types.ReplicationTask {
+
+	return s.expectReadDLQPage(readLevel, nil, task)
+}
+
+func (s *dlqRetrierSuite) expectReadDLQPage(
+	readLevel int64,
+	nextPageToken []byte,
+	task *persistence.ReplicationTaskInfo,
+) *types.ReplicationTask {
+
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: common.EndMessageID,
+			BatchSize:    s.config.ReplicationDLQRetryBatchSize(),
+		},
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks:         []*persistence.ReplicationTaskInfo{task},
+		NextPageToken: nextPageToken,
+	}, nil)
+
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: task.TaskID,
+	}
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{replicationTask},
+		}, nil).Times(1)
+	return replicationTask
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+// Replication tasks fetched by a remote cluster can be batched into compressed frames
+// when the remote cluster asks for it with common.ReplicationCompressionHeaderName.
+// A frame is a replication task of type ReplicationTaskTypeFrame, the data of its frame
+// attributes is the compressed concatenation of the length prefixed thriftrw encoded
+// replication tasks of the frame. Clusters which don't ask for frames, including the
+// ones which don't know about the frame task type, receive the replication tasks uncompressed.
+
+const (
+	// CompressionZstd is the header value asking for replication tasks batched into zstd compressed frames
+	CompressionZstd = "zstd"
+
+	// frameMaxDecodedSize bounds the memory used to decompress a frame, frames are about
+	// ReplicationTaskFrameMaxSize bytes unless a single task is larger
+	frameMaxDecodedSize = 64 * 1024 * 1024
+)
+
+var (
+	errInvalidFrame = errors.New("invalid replication task frame")
+
+	frameEncoder, _ = zstd.NewWriter(nil)
+	frameDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(frameMaxDecodedSize))
+	frameCodec      = codec.NewThriftRWEncoder()
+)
+
+// EncodeFrames batches the replication tasks into zstd compressed frames, the tasks of a frame are at most
+// maxFrameSize bytes before compression unless a single task is larger. It also returns the total size of
+// the frames before and after compression.
+func EncodeFrames(
+	tasks []*types.ReplicationTask,
+	maxFrameSize int,
+) ([]*types.ReplicationTask, int, int, error) {
+
+	var frames []*types.ReplicationTask
+	var uncompressedSize, compressedSize int
+	var payload []byte
+	var first, last *types.ReplicationTask
+
+	flush := func() {
+		if len(payload) == 0 {
+			return
+		}
+		compressed := frameEncoder.EncodeAll(payload, nil)
+		uncompressedSize += len(payload)
+		compressedSize += len(compressed)
+		frames = append(frames, newFrame(first, last, compressed))
+		payload = nil
+	}
+
+	for _, task := range tasks {
+		if task == nil {
+			continue
+		}
+		encoded, err := frameCodec.Encode(thrift.FromReplicationTask(task))
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if len(payload) > 0 && len(payload)+binary.MaxVarintLen64+len(encoded) > maxFrameSize {
+			flush()
+		}
+		if len(payload) == 0 {
+			first = task
+		}
+		last = task
+		payload = appendFrameEntry(payload, encoded)
+	}
+	flush()
+
+	return frames, uncompressedSize, compressedSize, nil
+}
+
+// DecodeFrames returns the replication tasks with the frames replaced by the tasks they carry
+func DecodeFrames(
+	tasks []*types.ReplicationTask,
+) ([]*types.ReplicationTask, error) {
+
+	var result []*types.ReplicationTask
+	for _, task := range tasks {
+		if !IsFrame(task) {
+			result = append(result, task)
+			continue
+		}
+
+		payload, err := frameDecoder.DecodeAll(task.FrameAttributes.GetData(), nil)
+		if err != nil {
+			return nil, err
+		}
+		// the decoder only checks the size between blocks, so the last block may go beyond it
+		if len(payload) > frameMaxDecodedSize {
+			return nil, zstd.ErrDecoderSizeExceeded
+		}
+		for len(payload) > 0 {
+			size, n := binary.Uvarint(payload)
+			if n <= 0 || uint64(len(payload)-n) < size {
+				return nil, errInvalidFrame
+			}
+			var thriftTask replicator.ReplicationTask
+			if err := frameCodec.Decode(payload[n:n+int(size)], &thriftTask); err != nil {
+				return nil, err
+			}
+			result = append(result, thrift.ToReplicationTask(&thriftTask))
+			payload = payload[n+int(size):]
+		}
+	}
+	return result, nil
+}
+
+// IsFrame returns whether the replication task is a frame carrying other replication tasks
+func IsFrame(
+	task *types.ReplicationTask,
+) bool {
+
+	return task.GetTaskType() == types.ReplicationTaskTypeFrame
+}
+
+func newFrame(
+	first *types.ReplicationTask,
+	last *types.ReplicationTask,
+	compressed []byte,
+) *types.ReplicationTask {
+
+	return &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeFrame.Ptr(),
+		SourceTaskID: last.SourceTaskID,
+		CreationTime: first.CreationTime,
+		FrameAttributes: &types.ReplicationTaskFrameAttributes{
+			Data: compressed,
+		},
+	}
+}
+
+func appendFrameEntry(
+	payload []byte,
+	entry []byte,
+) []byte {
+
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(entry)))
+	payload = append(payload, size[:n]...)
+	return append(payload, entry...)
+}
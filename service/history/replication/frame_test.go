@@ -0,0 +1,146 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestFrameTasks(count int) []*types.ReplicationTask {
+	var tasks []*types.ReplicationTask
+	for i := 0; i < count; i++ {
+		taskID := int64(100 + i)
+		tasks = append(tasks, &types.ReplicationTask{
+			TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+			SourceTaskID: taskID,
+			CreationTime: common.Int64Ptr(taskID * 1000),
+			HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+				TaskID:     taskID,
+				DomainID:   "some random domain ID",
+				WorkflowID: "some random workflow ID",
+				RunID:      "some random run ID",
+				VersionHistoryItems: []*types.VersionHistoryItem{
+					{EventID: taskID, Version: 1},
+				},
+				Events: &types.DataBlob{
+					EncodingType: types.EncodingTypeThriftRW.Ptr(),
+					Data:         bytes.Repeat([]byte("some random events "), 100),
+				},
+			},
+		})
+	}
+	return tasks
+}
+
+func TestEncodeDecodeFrames(t *testing.T) {
+	tasks := newTestFrameTasks(10)
+
+	frames, uncompressedSize, compressedSize, err := EncodeFrames(tasks, 1024*1024)
+	require.NoError(t, err)
+	require.Len(t, frames, 1)
+	require.True(t, IsFrame(frames[0]))
+	require.Equal(t, tasks[len(tasks)-1].SourceTaskID, frames[0].SourceTaskID)
+	require.Equal(t, tasks[0].CreationTime, frames[0].CreationTime)
+	require.Less(t, compressedSize, uncompressedSize)
+
+	decoded, err := DecodeFrames(frames)
+	require.NoError(t, err)
+	require.Equal(t, tasks, decoded)
+}
+
+func TestEncodeFrames_MaxFrameSize(t *testing.T) {
+	tasks := newTestFrameTasks(10)
+
+	// each task is about 2KB before compression
+	frames, _, _, err := EncodeFrames(tasks, 5*1024)
+	require.NoError(t, err)
+	require.Len(t, frames, 5)
+
+	// a task larger than the max frame size gets a frame of its own
+	frames, _, _, err = EncodeFrames(tasks, 1)
+	require.NoError(t, err)
+	require.Len(t, frames, len(tasks))
+	for i, frame := range frames {
+		require.Equal(t, tasks[i].SourceTaskID, frame.SourceTaskID)
+	}
+
+	decoded, err := DecodeFrames(frames)
+	require.NoError(t, err)
+	require.Equal(t, tasks, decoded)
+}
+
+func TestEncodeFrames_Empty(t *testing.T) {
+	frames, uncompressedSize, compressedSize, err := EncodeFrames(nil, 1024)
+	require.NoError(t, err)
+	require.Empty(t, frames)
+	require.Zero(t, uncompressedSize)
+	require.Zero(t, compressedSize)
+}
+
+func TestDecodeFrames_UncompressedTasks(t *testing.T) {
+	// tasks from clusters which don't support frames are passed through
+	tasks := newTestFrameTasks(3)
+	for _, task := range tasks {
+		require.False(t, IsFrame(task))
+	}
+
+	decoded, err := DecodeFrames(tasks)
+	require.NoError(t, err)
+	require.Equal(t, tasks, decoded)
+}
+
+func TestDecodeFrames_InvalidFrame(t *testing.T) {
+	frame := newFrame(newTestFrameTasks(1)[0], newTestFrameTasks(1)[0], frameEncoder.EncodeAll([]byte{0xff}, nil))
+
+	_, err := DecodeFrames([]*types.ReplicationTask{frame})
+	require.Error(t, err)
+
+	frame.FrameAttributes.Data = []byte("not compressed")
+	_, err = DecodeFrames([]*types.ReplicationTask{frame})
+	require.Error(t, err)
+}
+
+func TestDecodeFrames_MaxDecodedSize(t *testing.T) {
+	payload := make([]byte, frameMaxDecodedSize+1)
+	frame := newFrame(newTestFrameTasks(1)[0], newTestFrameTasks(1)[0], frameEncoder.EncodeAll(payload, nil))
+
+	_, err := DecodeFrames([]*types.ReplicationTask{frame})
+	require.Equal(t, zstd.ErrDecoderSizeExceeded, err)
+
+	// the decoded size is checked as well when the frame does not declare its size
+	var compressed bytes.Buffer
+	encoder, err := zstd.NewWriter(&compressed)
+	require.NoError(t, err)
+	_, err = encoder.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Close())
+	frame.FrameAttributes.Data = compressed.Bytes()
+
+	_, err = DecodeFrames([]*types.ReplicationTask{frame})
+	require.Equal(t, zstd.ErrDecoderSizeExceeded, err)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	// processorStateTracker tracks the replication lag and the failures by domain of the task
+	// processor of a source cluster, the state is stored in the shard info so that it survives
+	// the shard moving to another host
+	processorStateTracker struct {
+		sync.Mutex
+		shard         shard.Context
+		sourceCluster string
+		state         *types.ReplicationProcessorState
+	}
+)
+
+// ReadShardReadiness reads the replication state of a shard from the task processor of the source
+// cluster and the replication DLQ of the shard
+func ReadShardReadiness(
+	ctx context.Context,
+	shard shard.Context,
+	processor TaskProcessor,
+	pageSize int,
+) (*types.ReplicationShardReadiness, error) {
+
+	// the whole DLQ is read, as the failover of a domain is not ready as long as it has tasks in the DLQ
+	dlqSize, _, err := NewStatusReader(shard, pageSize, 0).ReadDLQ(ctx, processor.GetSourceCluster())
+	if err != nil {
+		return nil, err
+	}
+	readiness := &types.ReplicationShardReadiness{
+		ShardID:        int32(shard.GetShardID()),
+		ProcessorState: processor.GetState(),
+	}
+	if len(dlqSize) != 0 {
+		readiness.DLQSizeByDomain = dlqSize
+	}
+	return readiness, nil
+}
+
+func newProcessorStateTracker(
+	shard shard.Context,
+	sourceCluster string,
+) *processorStateTracker {
+
+	return &processorStateTracker{
+		shard:         shard,
+		sourceCluster: sourceCluster,
+		state:         copyProcessorState(shard.GetReplicationProcessorState(sourceCluster)),
+	}
+}
+
+func (t *processorStateTracker) replicatedUpTo(
+	replicatedUpTo time.Time,
+) error {
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.state.ReplicatedUpToTimestamp = common.Int64Ptr(replicatedUpTo.UnixNano())
+	return t.persistLocked()
+}
+
+func (t *processorStateTracker) failed(
+	domainID string,
+	now time.Time,
+	err error,
+) error {
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.state.FailuresByDomain == nil {
+		t.state.FailuresByDomain = make(map[string]*types.ReplicationTaskFailure)
+	}
+	failure, ok := t.state.FailuresByDomain[domainID]
+	if !ok {
+		failure = &types.ReplicationTaskFailure{}
+		t.state.FailuresByDomain[domainID] = failure
+	}
+	failure.Count++
+	failure.LastTimestamp = now.UnixNano()
+	failure.LastError = err.Error()
+	return t.persistLocked()
+}
+
+func (t *processorStateTracker) get() *types.ReplicationProcessorState {
+
+	t.Lock()
+	defer t.Unlock()
+
+	return copyProcessorState(t.state)
+}
+
+func (t *processorStateTracker) persistLocked() error {
+	// the shard info keeps its own copy as it is serialized outside of the tracker lock
+	return t.shard.UpdateReplicationProcessorState(t.sourceCluster, copyProcessorState(t.state))
+}
+
+func copyProcessorState(
+	state *types.ReplicationProcessorState,
+) *types.ReplicationProcessorState {
+
+	copied := &types.ReplicationProcessorState{}
+	if state == nil {
+		return copied
+	}
+	if state.ReplicatedUpToTimestamp != nil {
+		copied.ReplicatedUpToTimestamp = common.Int64Ptr(*state.ReplicatedUpToTimestamp)
+	}
+	if len(state.FailuresByDomain) != 0 {
+		copied.FailuresByDomain = make(map[string]*types.ReplicationTaskFailure, len(state.FailuresByDomain))
+		for domainID, failure := range state.FailuresByDomain {
+			copiedFailure := *failure
+			copied.FailuresByDomain[domainID] = &copiedFailure
+		}
+	}
+	return copied
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+func TestProcessorStateTracker(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistence.ShardInfo{ShardID: 1, RangeID: 1},
+		config.NewForTest(),
+	)
+	defer mockShard.Finish(t)
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(testStatusStandbyCluster).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	mockShard.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+
+	tracker := newProcessorStateTracker(mockShard, testStatusActiveCluster)
+	require.Equal(t, &types.ReplicationProcessorState{}, tracker.get())
+
+	now := time.Now()
+	require.NoError(t, tracker.replicatedUpTo(now))
+	require.NoError(t, tracker.failed("domain-id", now, errors.New("first error")))
+	require.NoError(t, tracker.failed("domain-id", now.Add(time.Second), errors.New("second error")))
+
+	expected := &types.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: common.Int64Ptr(now.UnixNano()),
+		FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+			"domain-id": {Count: 2, LastTimestamp: now.Add(time.Second).UnixNano(), LastError: "second error"},
+		},
+	}
+	state := tracker.get()
+	require.Equal(t, expected, state)
+
+	// the returned state is a copy
+	state.FailuresByDomain["domain-id"].Count = 10
+	require.Equal(t, int64(2), tracker.get().FailuresByDomain["domain-id"].Count)
+
+	// the state is stored in the shard info and restored when the shard is loaded again
+	require.Equal(t, expected, mockShard.GetReplicationProcessorState(testStatusActiveCluster))
+	require.Equal(t, expected, newProcessorStateTracker(mockShard, testStatusActiveCluster).get())
+	require.Equal(t, &types.ReplicationProcessorState{}, newProcessorStateTracker(mockShard, testStatusStandbyCluster).get())
+}
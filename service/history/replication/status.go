@@ -0,0 +1,206 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	// StatusReader reads the replication status of a shard with a remote cluster
+	StatusReader struct {
+		shard    shard.Context
+		pageSize int
+		maxTasks int
+	}
+)
+
+// NewStatusReader creates a new replication status reader which reads at most maxTasks replication
+// tasks from the backlog and from the DLQ of the shard, without limit when maxTasks is not positive
+func NewStatusReader(
+	shard shard.Context,
+	pageSize int,
+	maxTasks int,
+) *StatusReader {
+	return &StatusReader{
+		shard:    shard,
+		pageSize: pageSize,
+		maxTasks: maxTasks,
+	}
+}
+
+// Read reads the replication status of the shard with the remote cluster. The backlog of the replication
+// queue is read from the ack level of the remote cluster, skipping the tasks of domains not replicated to
+// it like the ack manager does. The replication of tasks from the remote cluster is read from the DLQ and
+// the task processor of the remote cluster, which is nil when the shard does not replicate from it.
+// When the backlog or the DLQ has more tasks than the reader reads, the status is truncated: the backlog
+// and DLQ sizes are lower bounds, LastTaskID is the last task read and the domains whose tasks are all
+// beyond the read ones are missing. The oldest task of the domains which are reported is still exact,
+// as the backlog is read in task ID order.
+func (r *StatusReader) Read(
+	ctx context.Context,
+	remoteCluster string,
+	processor TaskProcessor,
+) (*types.ReplicationShardStatus, error) {
+
+	ackLevel := r.shard.GetClusterReplicationLevel(remoteCluster)
+	status := &types.ReplicationShardStatus{
+		ShardID:    int32(r.shard.GetShardID()),
+		AckLevel:   ackLevel,
+		LastTaskID: ackLevel,
+	}
+	domains := make(map[string]*types.ReplicationDomainStatus)
+
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: r.shard.GetTransferMaxReadLevel(),
+	}
+	read := 0
+	for {
+		if request.BatchSize = r.batchSize(read); request.BatchSize == 0 {
+			status.Truncated = true
+			break
+		}
+		response, err := r.shard.GetExecutionManager().GetReplicationTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		read += len(response.Tasks)
+		for _, task := range response.Tasks {
+			status.LastTaskID = task.TaskID
+			domainEntry, err := r.shard.GetDomainCache().GetDomainByID(task.DomainID)
+			if err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					continue
+				}
+				return nil, err
+			}
+			if skipTask(remoteCluster, domainEntry) {
+				continue
+			}
+			domainStatus := r.domainStatus(domains, task.DomainID)
+			domainStatus.Backlog++
+			if domainStatus.OldestTaskTimestamp == nil || task.CreationTime < *domainStatus.OldestTaskTimestamp {
+				domainStatus.OldestTaskTimestamp = common.Int64Ptr(task.CreationTime)
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	dlqSize, dlqTruncated, err := r.ReadDLQ(ctx, remoteCluster)
+	if err != nil {
+		return nil, err
+	}
+	for domainID, size := range dlqSize {
+		r.domainStatus(domains, domainID).DLQSize = size
+	}
+	status.Truncated = status.Truncated || dlqTruncated
+
+	if processor != nil {
+		status.ReplicatedUpToTimestamp = processor.GetState().ReplicatedUpToTimestamp
+	}
+
+	for _, domainStatus := range domains {
+		status.Domains = append(status.Domains, domainStatus)
+	}
+	sort.Slice(status.Domains, func(i, j int) bool {
+		return status.Domains[i].DomainID < status.Domains[j].DomainID
+	})
+	return status, nil
+}
+
+// ReadDLQ reads the number of replication tasks from the source cluster in the DLQ of the shard by domain ID,
+// it also returns whether the DLQ has more tasks than the reader reads
+func (r *StatusReader) ReadDLQ(
+	ctx context.Context,
+	sourceCluster string,
+) (map[string]int64, bool, error) {
+
+	// purged and merged DLQ tasks are deleted, so the whole DLQ is read from the beginning
+	request := &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    0,
+			MaxReadLevel: math.MaxInt64,
+		},
+	}
+	dlqSize := make(map[string]int64)
+	read := 0
+	for {
+		if request.BatchSize = r.batchSize(read); request.BatchSize == 0 {
+			return dlqSize, true, nil
+		}
+		response, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(ctx, request)
+		if err != nil {
+			return nil, false, err
+		}
+		read += len(response.Tasks)
+		for _, task := range response.Tasks {
+			dlqSize[task.DomainID]++
+		}
+		if len(response.NextPageToken) == 0 {
+			return dlqSize, false, nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// batchSize returns the size of the next page to read after reading the given number of tasks,
+// zero once the reader read as many tasks as it reads
+func (r *StatusReader) batchSize(
+	read int,
+) int {
+
+	if r.maxTasks <= 0 || r.maxTasks-read >= r.pageSize {
+		return r.pageSize
+	}
+	if read >= r.maxTasks {
+		return 0
+	}
+	return r.maxTasks - read
+}
+
+func (r *StatusReader) domainStatus(
+	domains map[string]*types.ReplicationDomainStatus,
+	domainID string,
+) *types.ReplicationDomainStatus {
+
+	status, ok := domains[domainID]
+	if !ok {
+		status = &types.ReplicationDomainStatus{DomainID: domainID}
+		// the name is only informational, the status of a deleted domain is still reported
+		if domainName, err := r.shard.GetDomainCache().GetDomainName(domainID); err == nil {
+			status.Domain = domainName
+		}
+		domains[domainID] = status
+	}
+	return status
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	testStatusActiveCluster  = "active"
+	testStatusStandbyCluster = "standby"
+)
+
+func newTestStatusShard(controller *gomock.Controller) *shard.TestContext {
+	mockShard := shard.NewTestContext(
+		controller,
+		&persistence.ShardInfo{
+			ShardID:                 1,
+			RangeID:                 1,
+			ClusterReplicationLevel: map[string]int64{testStatusStandbyCluster: 10},
+		},
+		config.NewForTest(),
+	)
+	newDomain := func(name string, clusters ...string) *cache.DomainCacheEntry {
+		replicationConfig := &persistence.DomainReplicationConfig{ActiveClusterName: testStatusActiveCluster}
+		for _, cluster := range clusters {
+			replicationConfig.Clusters = append(replicationConfig.Clusters, &persistence.ClusterReplicationConfig{ClusterName: cluster})
+		}
+		return cache.NewGlobalDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: name + "-id", Name: name},
+			&persistence.DomainConfig{Retention: 1},
+			replicationConfig,
+			1,
+			nil,
+		)
+	}
+	domainCache := mockShard.Resource.DomainCache
+	domainCache.EXPECT().GetDomainByID("replicated-domain-id").Return(newDomain("replicated-domain", testStatusActiveCluster, testStatusStandbyCluster), nil).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("local-domain-id").Return(newDomain("local-domain", testStatusActiveCluster), nil).AnyTimes()
+	domainCache.EXPECT().GetDomainByID("deleted-domain-id").Return(nil, &types.EntityNotExistsError{}).AnyTimes()
+	domainCache.EXPECT().GetDomainName("replicated-domain-id").Return("replicated-domain", nil).AnyTimes()
+	domainCache.EXPECT().GetDomainName("deleted-domain-id").Return("", &types.EntityNotExistsError{}).AnyTimes()
+	return mockShard
+}
+
+func TestStatusReader_Read(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockShard := newTestStatusShard(controller)
+	defer mockShard.Finish(t)
+	executionManager := mockShard.Resource.ExecutionMgr
+
+	now := time.Now()
+	executionManager.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    2,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 11, DomainID: "replicated-domain-id", CreationTime: now.Add(-time.Minute).UnixNano()},
+			{TaskID: 12, DomainID: "local-domain-id", CreationTime: now.Add(-2 * time.Minute).UnixNano()},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	executionManager.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  mockShard.GetTransferMaxReadLevel(),
+		BatchSize:     2,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 13, DomainID: "replicated-domain-id", CreationTime: now.Add(-2 * time.Minute).UnixNano()},
+			{TaskID: 14, DomainID: "deleted-domain-id", CreationTime: now.Add(-3 * time.Minute).UnixNano()},
+		},
+	}, nil).Once()
+	executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: testStatusStandbyCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    0,
+			MaxReadLevel: math.MaxInt64,
+			BatchSize:    2,
+		},
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 5, DomainID: "replicated-domain-id"},
+			{TaskID: 6, DomainID: "deleted-domain-id"},
+		},
+	}, nil).Once()
+
+	replicatedUpTo := now.Add(-time.Second)
+	processor := NewMockTaskProcessor(controller)
+	processor.EXPECT().GetState().Return(&types.ReplicationProcessorState{ReplicatedUpToTimestamp: common.Int64Ptr(replicatedUpTo.UnixNano())}).Times(1)
+
+	status, err := NewStatusReader(mockShard, 2, 0).Read(context.Background(), testStatusStandbyCluster, processor)
+	require.NoError(t, err)
+	require.Equal(t, &types.ReplicationShardStatus{
+		ShardID:                 1,
+		AckLevel:                10,
+		LastTaskID:              14,
+		ReplicatedUpToTimestamp: common.Int64Ptr(replicatedUpTo.UnixNano()),
+		Domains: []*types.ReplicationDomainStatus{
+			{DomainID: "deleted-domain-id", DLQSize: 1},
+			{
+				DomainID:            "replicated-domain-id",
+				Domain:              "replicated-domain",
+				Backlog:             2,
+				OldestTaskTimestamp: common.Int64Ptr(now.Add(-2 * time.Minute).UnixNano()),
+				DLQSize:             1,
+			},
+		},
+	}, status)
+}
+
+func TestStatusReader_Read_NoProcessor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockShard := newTestStatusShard(controller)
+	defer mockShard.Finish(t)
+	executionManager := mockShard.Resource.ExecutionMgr
+
+	executionManager.On("GetReplicationTasks", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{}, nil).Once()
+	executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{}, nil).Once()
+
+	status, err := NewStatusReader(mockShard, 10, 0).Read(context.Background(), testStatusStandbyCluster, nil)
+	require.NoError(t, err)
+	require.Equal(t, &types.ReplicationShardStatus{
+		ShardID:    1,
+		AckLevel:   10,
+		LastTaskID: 10,
+	}, status)
+}
+
+func TestStatusReader_Read_Truncated(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockShard := newTestStatusShard(controller)
+	defer mockShard.Finish(t)
+	executionManager := mockShard.Resource.ExecutionMgr
+
+	now := time.Now()
+	executionManager.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: mockShard.GetTransferMaxReadLevel(),
+		BatchSize:    2,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 11, DomainID: "replicated-domain-id", CreationTime: now.Add(-2 * time.Minute).UnixNano()},
+			{TaskID: 12, DomainID: "replicated-domain-id", CreationTime: now.Add(-time.Minute).UnixNano()},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	// the last page is cut to the tasks left to read
+	executionManager.On("GetReplicationTasks", mock.Anything, &persistence.GetReplicationTasksRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  mockShard.GetTransferMaxReadLevel(),
+		BatchSize:     1,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 13, DomainID: "replicated-domain-id", CreationTime: now.UnixNano()},
+		},
+		NextPageToken: []byte("token2"),
+	}, nil).Once()
+	executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 5, DomainID: "replicated-domain-id"},
+		},
+	}, nil).Once()
+
+	status, err := NewStatusReader(mockShard, 2, 3).Read(context.Background(), testStatusStandbyCluster, nil)
+	require.NoError(t, err)
+	require.Equal(t, &types.ReplicationShardStatus{
+		ShardID:    1,
+		AckLevel:   10,
+		LastTaskID: 13,
+		Domains: []*types.ReplicationDomainStatus{
+			{
+				DomainID:            "replicated-domain-id",
+				Domain:              "replicated-domain",
+				Backlog:             3,
+				OldestTaskTimestamp: common.Int64Ptr(now.Add(-2 * time.Minute).UnixNano()),
+				DLQSize:             1,
+			},
+		},
+		Truncated: true,
+	}, status)
+}
+
+func TestStatusReader_ReadDLQ_Truncated(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockShard := newTestStatusShard(controller)
+	defer mockShard.Finish(t)
+	executionManager := mockShard.Resource.ExecutionMgr
+
+	executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: testStatusStandbyCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    0,
+			MaxReadLevel: math.MaxInt64,
+			BatchSize:    2,
+		},
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 5, DomainID: "replicated-domain-id"},
+			{TaskID: 6, DomainID: "deleted-domain-id"},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+
+	dlqSize, truncated, err := NewStatusReader(mockShard, 2, 2).ReadDLQ(context.Background(), testStatusStandbyCluster)
+	require.NoError(t, err)
+	require.True(t, truncated)
+	require.Equal(t, map[string]int64{"replicated-domain-id": 1, "deleted-domain-id": 1}, dlqSize)
+}
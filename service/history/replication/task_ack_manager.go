@@ -272,6 +272,12 @@ func (t *taskAckManagerImpl) toReplicationTask(
 			task.SourceTaskID = taskInfo.GetTaskID()
 		}
 		return task, nil
+	case persistence.ReplicationTaskTypeSyncCompletionCallbacks:
+		task, err := t.generateSyncCompletionCallbacksTask(ctx, task)
+		if task != nil {
+			task.SourceTaskID = taskInfo.GetTaskID()
+		}
+		return task, err
 	default:
 		return nil, errUnknownReplicationTask
 	}
@@ -568,6 +574,55 @@ func (t *taskAckManagerImpl) generateSyncActivityTask(
 	)
 }
 
+func (t *taskAckManagerImpl) generateSyncCompletionCallbacksTask(
+	ctx ctx.Context,
+	taskInfo *persistence.ReplicationTaskInfo,
+) (retReplicationTask *types.ReplicationTask, retError error) {
+
+	execution := types.WorkflowExecution{
+		WorkflowID: taskInfo.GetWorkflowID(),
+		RunID:      taskInfo.GetRunID(),
+	}
+
+	context, release, err := t.executionCache.GetOrCreateWorkflowExecution(ctx, taskInfo.GetDomainID(), execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.LoadWorkflowExecution(ctx)
+	switch err.(type) {
+	case nil:
+	case *types.EntityNotExistsError:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	// the latest delivery state is sent rather than the state at the time the task was generated,
+	// the standby side only applies the parts that are newer than what it already has
+	var completionCallbacks []*types.CompletionCallbackInfo
+	for _, info := range msBuilder.GetExecutionInfo().CompletionCallbacks {
+		infoCopy := *info
+		completionCallbacks = append(completionCallbacks, &infoCopy)
+	}
+	if len(completionCallbacks) == 0 {
+		return nil, nil
+	}
+
+	return &types.ReplicationTask{
+		TaskType: types.ReplicationTaskType.Ptr(types.ReplicationTaskTypeSyncCompletionCallbacks),
+		SyncCompletionCallbacksTaskAttributes: &types.SyncCompletionCallbacksTaskAttributes{
+			DomainID:            taskInfo.GetDomainID(),
+			WorkflowID:          taskInfo.GetWorkflowID(),
+			RunID:               taskInfo.GetRunID(),
+			Version:             taskInfo.GetVersion(),
+			CompletionCallbacks: completionCallbacks,
+		},
+		CreationTime: common.Int64Ptr(taskInfo.CreationTime),
+	}, nil
+}
+
 func (t *taskAckManagerImpl) generateHistoryReplicationTask(
 	ctx ctx.Context,
 	task *persistence.ReplicationTaskInfo,
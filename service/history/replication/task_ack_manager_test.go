@@ -673,6 +673,66 @@ func (s *taskAckManagerSuite) TestGenerateSyncActivityTask_Empty() {
 	s.Nil(task)
 }
 
+func (s *taskAckManagerSuite) TestGenerateSyncCompletionCallbacksTask() {
+	domainID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	taskInfo := &persistence.ReplicationTaskInfo{
+		DomainID:     domainID,
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		TaskType:     persistence.ReplicationTaskTypeSyncCompletionCallbacks,
+		Version:      100,
+		CreationTime: 10,
+	}
+	completionCallbacks := []*types.CompletionCallbackInfo{
+		{URL: "http://callback", State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 2},
+	}
+	workflowContext, release, _ := s.ackManager.executionCache.GetOrCreateWorkflowExecutionForBackground(
+		domainID,
+		types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	)
+	workflowContext.SetWorkflowExecution(s.mockMutableState)
+	release(nil)
+
+	s.mockMutableState.EXPECT().StartTransaction(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		CompletionCallbacks: completionCallbacks,
+	}).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "domainName"},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	), nil).AnyTimes()
+
+	task, err := s.ackManager.toReplicationTask(context.Background(), taskInfo)
+	s.NoError(err)
+	s.Equal(&types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeSyncCompletionCallbacks.Ptr(),
+		SourceTaskID: taskInfo.TaskID,
+		SyncCompletionCallbacksTaskAttributes: &types.SyncCompletionCallbacksTaskAttributes{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			Version:             100,
+			CompletionCallbacks: completionCallbacks,
+		},
+		CreationTime: common.Int64Ptr(10),
+	}, task)
+	s.NotSame(completionCallbacks[0], task.SyncCompletionCallbacksTaskAttributes.CompletionCallbacks[0])
+}
+
 func (s *taskAckManagerSuite) TestGenerateHistoryReplicationTask() {
 	domainID := uuid.New()
 	workflowID := uuid.New()
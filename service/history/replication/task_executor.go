@@ -94,6 +94,9 @@ func (e *taskExecutorImpl) execute(
 	case types.ReplicationTaskTypeFailoverMarker:
 		scope = metrics.FailoverMarkerScope
 		err = e.handleFailoverReplicationTask(replicationTask)
+	case types.ReplicationTaskTypeSyncCompletionCallbacks:
+		scope = metrics.SyncCompletionCallbacksTaskScope
+		err = e.handleSyncCompletionCallbacksTask(replicationTask, forceApply)
 	default:
 		e.logger.Error("Unknown task type.")
 		scope = metrics.ReplicatorScope
@@ -274,6 +277,37 @@ func (e *taskExecutorImpl) handleHistoryReplicationTaskV2(
 	return historyReplicationAction()
 }
 
+func (e *taskExecutorImpl) handleSyncCompletionCallbacksTask(
+	task *types.ReplicationTask,
+	forceApply bool,
+) error {
+
+	attr := task.SyncCompletionCallbacksTaskAttributes
+	doContinue, err := e.filterTask(attr.GetDomainID(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	if e.shard.GetShardID() != common.WorkflowIDToHistoryShard(attr.GetWorkflowID(), e.shard.GetConfig().NumberOfShards) {
+		// unlike sync activity there is no history API forwarding the task to the shard owning the workflow,
+		// the callbacks still pending in this cluster are delivered again after a failover
+		e.logger.Warn(
+			"skip replication sync completion callbacks task owned by another shard",
+			tag.WorkflowDomainID(attr.GetDomainID()),
+			tag.WorkflowID(attr.GetWorkflowID()),
+			tag.WorkflowRunID(attr.GetRunID()),
+		)
+		return nil
+	}
+
+	replicationStopWatch := e.metricsClient.StartTimer(metrics.SyncCompletionCallbacksTaskScope, metrics.CadenceLatency)
+	defer replicationStopWatch.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	return e.historyEngine.SyncCompletionCallbacks(ctx, attr)
+}
+
 func (e *taskExecutorImpl) handleFailoverReplicationTask(
 	task *types.ReplicationTask,
 ) error {
@@ -267,3 +267,41 @@ func (s *taskExecutorSuite) TestProcess_SyncActivityReplicationTask_DifferentSha
 	_, err := s.taskHandler.execute(task, true)
 	s.NoError(err)
 }
+
+func (s *taskExecutorSuite) TestProcessTask_SyncCompletionCallbacksReplicationTask_SameShardID() {
+	domainID := uuid.New()
+	workflowID := "6d89f939-e6a4-4c26-a0ed-626ce27bcc9c" // belong to shard 0
+	runID := uuid.New()
+	attributes := &types.SyncCompletionCallbacksTaskAttributes{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	task := &types.ReplicationTask{
+		TaskType:                              types.ReplicationTaskTypeSyncCompletionCallbacks.Ptr(),
+		SyncCompletionCallbacksTaskAttributes: attributes,
+	}
+
+	s.mockEngine.EXPECT().SyncCompletionCallbacks(gomock.Any(), attributes).Return(nil).Times(1)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
+
+func (s *taskExecutorSuite) TestProcess_SyncCompletionCallbacksReplicationTask_DifferentShardID() {
+	domainID := uuid.New()
+	workflowID := "abc" // belong to shard 1
+	runID := uuid.New()
+	attributes := &types.SyncCompletionCallbacksTaskAttributes{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	task := &types.ReplicationTask{
+		TaskType:                              types.ReplicationTaskTypeSyncCompletionCallbacks.Ptr(),
+		SyncCompletionCallbacksTaskAttributes: attributes,
+	}
+
+	s.mockEngine.EXPECT().SyncCompletionCallbacks(gomock.Any(), attributes).Return(nil).Times(0)
+	_, err := s.taskHandler.execute(task, true)
+	s.NoError(err)
+}
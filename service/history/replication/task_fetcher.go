@@ -27,6 +27,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/yarpc"
+
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common"
@@ -288,12 +290,25 @@ func (f *taskFetcherImpl) getMessages(
 		Tokens:      tokens,
 		ClusterName: f.currentCluster,
 	}
-	response, err := f.remotePeer.GetReplicationMessages(ctx, request)
+	var opts []yarpc.CallOption
+	if f.config.EnableReplicationTaskCompression() {
+		opts = append(opts, yarpc.WithHeader(common.ReplicationCompressionHeaderName, CompressionZstd))
+	}
+	response, err := f.remotePeer.GetReplicationMessages(ctx, request, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.GetMessagesByShard(), nil
+	messagesByShard := response.GetMessagesByShard()
+	for _, messages := range messagesByShard {
+		// frames are decoded regardless of the config, in case it was changed during the request
+		tasks, err := DecodeFrames(messages.GetReplicationTasks())
+		if err != nil {
+			return nil, err
+		}
+		messages.ReplicationTasks = tasks
+	}
+	return messagesByShard, nil
 }
 
 // GetSourceCluster returns the source cluster for the fetcher
@@ -21,13 +21,16 @@
 package replication
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
@@ -111,6 +114,43 @@ func (s *taskFetcherSuite) TestGetMessages() {
 	s.Equal(messageByShared, response)
 }
 
+func (s *taskFetcherSuite) TestGetMessages_CompressedFrames() {
+	s.config.EnableReplicationTaskCompression = dynamicconfig.GetBoolPropertyFn(true)
+	requestByShard := make(map[int32]*request)
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastProcessedMessageID: 1,
+		LastRetrievedMessageID: 2,
+	}
+	requestByShard[0] = &request{
+		token: token,
+	}
+	replicationMessageRequest := &types.GetReplicationMessagesRequest{
+		Tokens: []*types.ReplicationToken{
+			token,
+		},
+		ClusterName: "active",
+	}
+	tasks := newTestFrameTasks(3)
+	frames, _, _, err := EncodeFrames(tasks, 1024*1024)
+	s.NoError(err)
+	s.frontendClient.EXPECT().GetReplicationMessages(gomock.Any(), replicationMessageRequest, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *types.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*types.GetReplicationMessagesResponse, error) {
+			// compression header
+			s.Len(opts, 1)
+			return &types.GetReplicationMessagesResponse{
+				MessagesByShard: map[int32]*types.ReplicationMessages{
+					0: {ReplicationTasks: frames, LastRetrievedMessageID: 102},
+				},
+			}, nil
+		})
+	response, err := s.taskFetcher.getMessages(requestByShard)
+	s.NoError(err)
+	s.Equal(map[int32]*types.ReplicationMessages{
+		0: {ReplicationTasks: tasks, LastRetrievedMessageID: 102},
+	}, response)
+}
+
 func (s *taskFetcherSuite) TestFetchAndDistributeTasks() {
 	requestByShard := make(map[int32]*request)
 	token := &types.ReplicationToken{
@@ -68,6 +68,8 @@ type (
 	// TaskProcessor is responsible for processing replication tasks for a shard.
 	TaskProcessor interface {
 		common.Daemon
+		GetSourceCluster() string
+		GetState() *types.ReplicationProcessorState
 	}
 
 	// taskProcessorImpl is responsible for processing replication tasks for a shard.
@@ -91,6 +93,7 @@ type (
 
 		lastProcessedMessageID int64
 		lastRetrievedMessageID int64
+		stateTracker           *processorStateTracker
 
 		requestChan   chan<- *request
 		syncShardChan chan *types.SyncShardStatus
@@ -152,9 +155,20 @@ func NewTaskProcessor(
 		done:                   make(chan struct{}),
 		lastProcessedMessageID: common.EmptyMessageID,
 		lastRetrievedMessageID: common.EmptyMessageID,
+		stateTracker:           newProcessorStateTracker(shard, taskFetcher.GetSourceCluster()),
 	}
 }
 
+// GetSourceCluster returns the cluster the processor replicates from
+func (p *taskProcessorImpl) GetSourceCluster() string {
+	return p.sourceCluster
+}
+
+// GetState returns the replication lag and the recent failures of the processor
+func (p *taskProcessorImpl) GetState() *types.ReplicationProcessorState {
+	return p.stateTracker.get()
+}
+
 // Start starts the processor
 func (p *taskProcessorImpl) Start() {
 	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
@@ -323,6 +337,17 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	// Note here we check replication tasks instead of hasMore. The expectation is that in a steady state
 	// we will receive replication tasks but hasMore is false (meaning that we are always catching up).
 	// So hasMore might not be a good indicator for additional wait.
+	var stateErr error
+	if !response.GetHasMore() && response.GetSyncShardStatus() != nil {
+		stateErr = p.stateTracker.replicatedUpTo(time.Unix(0, response.GetSyncShardStatus().GetTimestamp()))
+	} else if len(response.ReplicationTasks) != 0 {
+		lastTask := response.ReplicationTasks[len(response.ReplicationTasks)-1]
+		stateErr = p.stateTracker.replicatedUpTo(time.Unix(0, lastTask.GetCreationTime()))
+	}
+	if stateErr != nil {
+		p.logger.Warn("Failed to update replication processor state", tag.Error(stateErr))
+	}
+
 	if len(response.ReplicationTasks) == 0 {
 		backoffDuration := p.noTaskRetrier.NextBackOff()
 		time.Sleep(backoffDuration)
@@ -451,6 +476,9 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 
 	if err != nil {
 		p.updateFailureMetric(scope, err)
+		if stateErr := p.stateTracker.failed(getTaskDomainID(replicationTask), ts.Now(), err); stateErr != nil {
+			p.logger.Warn("Failed to update replication processor state", tag.Error(stateErr))
+		}
 	} else {
 		now := ts.Now()
 		mScope := p.metricsClient.Scope(scope, metrics.TargetClusterTag(p.sourceCluster))
@@ -468,6 +496,21 @@ func (p *taskProcessorImpl) processTaskOnce(replicationTask *types.ReplicationTa
 	return err
 }
 
+func getTaskDomainID(replicationTask *types.ReplicationTask) string {
+	switch replicationTask.GetTaskType() {
+	case types.ReplicationTaskTypeSyncActivity:
+		return replicationTask.GetSyncActivityTaskAttributes().GetDomainID()
+	case types.ReplicationTaskTypeHistoryV2:
+		return replicationTask.GetHistoryTaskV2Attributes().GetDomainID()
+	case types.ReplicationTaskTypeFailoverMarker:
+		return replicationTask.GetFailoverMarkerAttributes().GetDomainID()
+	case types.ReplicationTaskTypeSyncCompletionCallbacks:
+		return replicationTask.GetSyncCompletionCallbacksTaskAttributes().GetDomainID()
+	default:
+		return ""
+	}
+}
+
 func (p *taskProcessorImpl) putReplicationTaskToDLQ(replicationTask *types.ReplicationTask) error {
 	request, err := p.generateDLQRequest(replicationTask)
 	if err != nil {
@@ -524,6 +567,20 @@ func (p *taskProcessorImpl) generateDLQRequest(
 			},
 		}, nil
 
+	case types.ReplicationTaskTypeSyncCompletionCallbacks:
+		taskAttributes := replicationTask.GetSyncCompletionCallbacksTaskAttributes()
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistence.ReplicationTaskInfo{
+				DomainID:   taskAttributes.GetDomainID(),
+				WorkflowID: taskAttributes.GetWorkflowID(),
+				RunID:      taskAttributes.GetRunID(),
+				TaskID:     replicationTask.GetSourceTaskID(),
+				TaskType:   persistence.ReplicationTaskTypeSyncCompletionCallbacks,
+				Version:    taskAttributes.GetVersion(),
+			},
+		}, nil
+
 	case types.ReplicationTaskTypeHistoryV2:
 		taskAttributes := replicationTask.GetHistoryTaskV2Attributes()
 		eventsDataBlob := persistence.NewDataBlobFromInternal(taskAttributes.GetEvents())
@@ -30,6 +30,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+
+	types "github.com/uber/cadence/common/types"
 )
 
 // MockTaskProcessor is a mock of TaskProcessor interface
@@ -55,6 +57,34 @@ func (m *MockTaskProcessor) EXPECT() *MockTaskProcessorMockRecorder {
 	return m.recorder
 }
 
+// GetSourceCluster mocks base method
+func (m *MockTaskProcessor) GetSourceCluster() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceCluster")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSourceCluster indicates an expected call of GetSourceCluster
+func (mr *MockTaskProcessorMockRecorder) GetSourceCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceCluster", reflect.TypeOf((*MockTaskProcessor)(nil).GetSourceCluster))
+}
+
+// GetState mocks base method
+func (m *MockTaskProcessor) GetState() *types.ReplicationProcessorState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState")
+	ret0, _ := ret[0].(*types.ReplicationProcessorState)
+	return ret0
+}
+
+// GetState indicates an expected call of GetState
+func (mr *MockTaskProcessorMockRecorder) GetState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockTaskProcessor)(nil).GetState))
+}
+
 // Start mocks base method
 func (m *MockTaskProcessor) Start() {
 	m.ctrl.T.Helper()
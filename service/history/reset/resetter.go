@@ -115,7 +115,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	if err != nil {
 		return err
 	}
-	resetWorkflowVersion := domainEntry.GetFailoverVersion()
+	resetWorkflowVersion := domainEntry.GetFailoverVersionForWorkflow(workflowID)
 
 	currentMutableState := currentWorkflow.GetMutableState()
 	currentWorkflowTerminated := false
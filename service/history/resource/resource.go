@@ -96,6 +96,10 @@ func New(
 			EnableReadVisibilityFromES:    nil, // history service never read,
 			AdvancedVisibilityWritingMode: config.AdvancedVisibilityWritingMode,
 
+			EnableVisibilityMigrationDualWrite:          config.EnableVisibilityMigrationDualWrite,
+			VisibilityMigrationShadowReadPercentage:     nil, // history service never read,
+			VisibilityMigrationMaxConcurrentShadowReads: nil, // history service never read,
+
 			EnableDBVisibilitySampling:                  config.EnableVisibilitySampling,
 			EnableReadDBVisibilityFromClosedExecutionV2: nil, // history service never read,
 			DBVisibilityListMaxQPS:                      nil, // history service never read,
@@ -104,7 +108,7 @@ func New(
 
 			ESVisibilityListMaxQPS: nil, // history service never read,
 			ESIndexMaxResultWindow: nil, // history service never read,
-			ValidSearchAttributes:  nil, // history service never read,
+			ValidSearchAttributes:  config.ValidSearchAttributes,
 		},
 	)
 	if err != nil {
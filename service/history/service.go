@@ -114,26 +114,20 @@ func (s *Service) Stop() {
 	// initiate graceful shutdown :
 	// 1. remove self from the membership ring
 	// 2. wait for other members to discover we are going down
-	// 3. stop acquiring new shards (periodically or based on other membership changes)
+	// 3. stop acquiring new shards (periodically or based on other membership changes) and, with graceful
+	//    shard handoff, hand off the shards owned by the host to their new owners
 	// 4. wait for shard ownership to transfer (and inflight requests to drain) while still accepting new requests
 	// 5. Reject all requests arriving at rpc handler to avoid taking on more work except for RespondXXXCompleted and
 	//    RecordXXStarted APIs - for these APIs, most of the work is already one and rejecting at last stage is
 	//    probably not that desirable. If the shard is closed, these requests will fail anyways.
 	// 6. wait for grace period
 	// 7. force stop the whole world and return
+	// steps 1 to 5 are done by the handler
 
-	const gossipPropagationDelay = 400 * time.Millisecond
-	const shardOwnershipTransferDelay = 5 * time.Second
 	const gracePeriod = 2 * time.Second
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
-	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
-	s.GetMembershipResolver().EvictSelf()
-
-	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
-	remainingTime = common.SleepWithMinDuration(gossipPropagationDelay, remainingTime)
-
 	remainingTime = s.handler.PrepareToStop(remainingTime)
 	remainingTime = common.SleepWithMinDuration(gracePeriod, remainingTime)
 
@@ -61,6 +61,9 @@ type (
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
 		PreviousShardOwnerWasDifferent() bool
+		// FlushAndClose releases the ownership of the shard, persists the shard info, including queue ack levels,
+		// and closes the shard, it is used to hand off the shard to its next owner
+		FlushAndClose() error
 
 		GetEngine() engine.Engine
 		SetEngine(engine.Engine)
@@ -88,6 +91,10 @@ type (
 
 		GetClusterReplicationLevel(cluster string) int64
 		UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error
+		GetReplicationProcessorState(cluster string) *types.ReplicationProcessorState
+		UpdateReplicationProcessorState(cluster string, state *types.ReplicationProcessorState) error
+		GetReplicationDLQRetryState(cluster string) *types.ReplicationDLQRetryState
+		UpdateReplicationDLQRetryState(cluster string, state *types.ReplicationDLQRetryState) error
 
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
@@ -394,6 +401,50 @@ func (s *contextImpl) UpdateClusterReplicationLevel(cluster string, lastTaskID i
 	return s.updateShardInfoLocked()
 }
 
+func (s *contextImpl) GetReplicationProcessorState(cluster string) *types.ReplicationProcessorState {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.shardInfo.ReplicationProcessorStates.GetStatesByCluster()[cluster]
+}
+
+func (s *contextImpl) UpdateReplicationProcessorState(cluster string, state *types.ReplicationProcessorState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ReplicationProcessorStates == nil {
+		s.shardInfo.ReplicationProcessorStates = &types.ReplicationProcessorStates{}
+	}
+	if s.shardInfo.ReplicationProcessorStates.StatesByCluster == nil {
+		s.shardInfo.ReplicationProcessorStates.StatesByCluster = make(map[string]*types.ReplicationProcessorState)
+	}
+	s.shardInfo.ReplicationProcessorStates.StatesByCluster[cluster] = state
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
+func (s *contextImpl) GetReplicationDLQRetryState(cluster string) *types.ReplicationDLQRetryState {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.shardInfo.ReplicationDLQRetryStates.GetStatesByCluster()[cluster]
+}
+
+func (s *contextImpl) UpdateReplicationDLQRetryState(cluster string, state *types.ReplicationDLQRetryState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ReplicationDLQRetryStates == nil {
+		s.shardInfo.ReplicationDLQRetryStates = &types.ReplicationDLQRetryStates{}
+	}
+	if s.shardInfo.ReplicationDLQRetryStates.StatesByCluster == nil {
+		s.shardInfo.ReplicationDLQRetryStates.StatesByCluster = make(map[string]*types.ReplicationDLQRetryState)
+	}
+	s.shardInfo.ReplicationDLQRetryStates.StatesByCluster[cluster] = state
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
 func (s *contextImpl) GetTimerAckLevel() time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -1008,6 +1059,18 @@ func (s *contextImpl) PreviousShardOwnerWasDifferent() bool {
 	return s.previousShardOwnerWasDifferent
 }
 
+func (s *contextImpl) FlushAndClose() error {
+	s.Lock()
+	defer s.Unlock()
+
+	// release the ownership, so that the next owner doesn't wait for a handoff which is already done,
+	// e.g. when it learns about the membership change only after being notified
+	s.shardInfo.Owner = ""
+	err := s.forceUpdateShardInfoLocked()
+	s.closeShard()
+	return err
+}
+
 func (s *contextImpl) GetEventsCache() events.Cache {
 	// the shard needs to be restarted to release the shard cache once global mode is on.
 	if s.config.EventsCacheGlobalEnable() {
@@ -1574,6 +1637,14 @@ func acquireShard(
 		return nil, err
 	}
 
+	if shardInfo.Owner != "" && shardInfo.Owner != shardItem.GetHostInfo().Identity() && shardItem.waitForHandoff(shardInfo.Owner) {
+		// reload the shard info since the previous owner persists it before handing off the shard
+		if err := throttleRetry.Do(context.Background(), getShard); err != nil {
+			shardItem.logger.Error("Fail to acquire shard.", tag.ShardID(shardItem.shardID), tag.Error(err))
+			return nil, err
+		}
+	}
+
 	updatedShardInfo := shardInfo.Copy()
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
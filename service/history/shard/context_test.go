@@ -235,3 +235,45 @@ func (s *contextTestSuite) TestGetAndUpdateProcessingQueueStates() {
 	s.Equal(updatedTransferQueueStates[0].GetAckLevel(), s.context.GetTransferClusterAckLevel(clusterName))
 	s.Equal(time.Unix(0, updatedTimerQueueStates[0].GetAckLevel()), s.context.GetTimerClusterAckLevel(clusterName))
 }
+
+func (s *contextTestSuite) TestGetAndUpdateReplicationProcessorState() {
+	clusterName := cluster.TestAlternativeClusterName
+	s.Nil(s.context.GetReplicationProcessorState(clusterName))
+
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	state := &types.ReplicationProcessorState{
+		ReplicatedUpToTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+		FailuresByDomain: map[string]*types.ReplicationTaskFailure{
+			"testDomainID": {Count: 1, LastTimestamp: time.Now().UnixNano(), LastError: "some error"},
+		},
+	}
+	err := s.context.UpdateReplicationProcessorState(clusterName, state)
+	s.NoError(err)
+
+	s.Equal(state, s.context.GetReplicationProcessorState(clusterName))
+	s.Nil(s.context.GetReplicationProcessorState(cluster.TestCurrentClusterName))
+	s.Equal(state, s.context.shardInfo.ReplicationProcessorStates.StatesByCluster[clusterName])
+}
+
+func (s *contextTestSuite) TestGetAndUpdateReplicationDLQRetryState() {
+	clusterName := cluster.TestAlternativeClusterName
+	s.Nil(s.context.GetReplicationDLQRetryState(clusterName))
+
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	state := &types.ReplicationDLQRetryState{
+		Cursor: 10,
+		TaskStates: []*types.ReplicationDLQTaskState{
+			{TaskID: 5, ErrorClass: "history-gap", Attempts: 2, LastError: "some error", NextAttemptTimestamp: time.Now().UnixNano()},
+		},
+	}
+	err := s.context.UpdateReplicationDLQRetryState(clusterName, state)
+	s.NoError(err)
+
+	s.Equal(state, s.context.GetReplicationDLQRetryState(clusterName))
+	s.Nil(s.context.GetReplicationDLQRetryState(cluster.TestCurrentClusterName))
+	s.Equal(state, s.context.shardInfo.ReplicationDLQRetryStates.StatesByCluster[clusterName])
+}
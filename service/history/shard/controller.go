@@ -23,11 +23,14 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/yarpc"
+
 	"github.com/uber/cadence/common/service"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -45,6 +48,9 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+
+	shardHandoffNotifyTimeout = 10 * time.Second
+	shardHandoffProbeTimeout  = time.Second
 )
 
 var (
@@ -61,12 +67,15 @@ type (
 	Controller interface {
 		common.Daemon
 
-		// PrepareToStop starts the graceful shutdown process for controller
-		PrepareToStop()
+		// PrepareToStop starts the graceful shutdown process for controller, it hands off the shards
+		// owned by the host within the remaining time and returns the time left
+		PrepareToStop(remainingTime time.Duration) time.Duration
 
 		GetEngine(workflowID string) (engine.Engine, error)
 		GetEngineForShard(shardID int) (engine.Engine, error)
 		RemoveEngineForShard(shardID int)
+		// AcquireShardAfterHandoff acquires a shard right after its previous owner has handed it off
+		AcquireShardAfterHandoff(shardID int) error
 
 		// Following methods describes the current status of the controller
 		// TODO: consider converting to a unified describe method
@@ -104,9 +113,13 @@ type (
 		throttledLogger log.Logger
 		engineFactory   EngineFactory
 
+		handoffCh   chan struct{}
+		handoffOnce sync.Once
+
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine engine.Engine
+		status       historyShardsItemStatus
+		engine       engine.Engine
+		shardContext Context
 	}
 )
 
@@ -151,6 +164,7 @@ func newHistoryShardsItem(
 		status:          historyShardsItemStatusInitialized,
 		engineFactory:   factory,
 		config:          config,
+		handoffCh:       make(chan struct{}),
 		logger:          resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 		throttledLogger: resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 	}, nil
@@ -178,7 +192,8 @@ func (c *controller) Stop() {
 		return
 	}
 
-	c.PrepareToStop()
+	// the new owners don't wait for the handoff longer than the handoff timeout
+	c.PrepareToStop(c.config.ShardHandoffTimeout())
 
 	if err := c.GetMembershipResolver().Unsubscribe(service.History, shardControllerMembershipUpdateListenerName); err != nil {
 		c.logger.Error("unsubscribing from membership resolver", tag.Error(err), tag.OperationFailed)
@@ -192,8 +207,17 @@ func (c *controller) Stop() {
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStopped)
 }
 
-func (c *controller) PrepareToStop() {
-	atomic.StoreInt32(&c.shuttingDown, 1)
+func (c *controller) PrepareToStop(remainingTime time.Duration) time.Duration {
+	if !atomic.CompareAndSwapInt32(&c.shuttingDown, 0, 1) {
+		return remainingTime
+	}
+
+	if c.config.EnableGracefulShardHandoff() && atomic.LoadInt32(&c.status) == common.DaemonStatusStarted {
+		startTime := time.Now()
+		c.drainShards(startTime.Add(remainingTime))
+		remainingTime -= time.Since(startTime)
+	}
+	return common.MaxDuration(remainingTime, 0)
 }
 
 func (c *controller) GetEngine(workflowID string) (engine.Engine, error) {
@@ -215,6 +239,16 @@ func (c *controller) RemoveEngineForShard(shardID int) {
 	c.removeEngineForShard(shardID, nil)
 }
 
+func (c *controller) AcquireShardAfterHandoff(shardID int) error {
+	item, err := c.getOrCreateHistoryShardItem(shardID)
+	if err != nil {
+		return err
+	}
+	item.notifyHandoff()
+	_, err = item.getOrCreateEngine(c.shardClosedCallback)
+	return err
+}
+
 func (c *controller) Status() int32 {
 	return atomic.LoadInt32(&c.status)
 }
@@ -404,6 +438,8 @@ func (c *controller) acquireShards() {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else if c.config.EnableGracefulShardHandoff() && c.ownsShard(shardID) {
+						c.handoffShard(context.Background(), shardID)
 					}
 				}
 			}
@@ -423,6 +459,98 @@ func (c *controller) acquireShards() {
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.NumShards()))
 }
 
+// drainShards hands off all shards owned by the host to their next owners until the deadline, it is invoked
+// by PrepareToStop after the host has left the membership ring, so that the next owners are known and notified.
+// The shards which are not handed off by the deadline are left for the next owners to acquire after their
+// handoff timeout.
+func (c *controller) drainShards(deadline time.Time) {
+	shardIDs := c.ShardIDs()
+	c.logger.Info("Draining shards before shutdown", tag.Number(int64(len(shardIDs))))
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	concurrency := common.MaxInt(c.config.AcquireShardConcurrency(), 1)
+	shardIDCh := make(chan int, len(shardIDs))
+	for _, shardID := range shardIDs {
+		shardIDCh <- int(shardID)
+	}
+	close(shardIDCh)
+
+	var skipped int32
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for shardID := range shardIDCh {
+				if ctx.Err() != nil {
+					atomic.AddInt32(&skipped, 1)
+					continue
+				}
+				info, err := c.GetMembershipResolver().Lookup(service.History, string(rune(shardID)))
+				if err != nil || info.Identity() == c.GetHostInfo().Identity() {
+					// the shard is kept until the new owner is known, rather than leaving it unowned
+					c.logger.Warn("New owner of shard unknown during drain", tag.ShardID(shardID), tag.Error(err))
+					atomic.AddInt32(&skipped, 1)
+					continue
+				}
+				c.handoffShard(ctx, shardID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if skipped > 0 {
+		c.logger.Warn("Shards not handed off before shutdown", tag.Number(int64(skipped)))
+	}
+}
+
+// handoffShard releases the shard, then notifies the new owner so that it acquires the shard
+// without waiting for its next acquisition round
+func (c *controller) handoffShard(ctx context.Context, shardID int) {
+	if !c.releaseShard(shardID) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, shardHandoffNotifyTimeout)
+	defer cancel()
+	resp, err := c.GetHistoryClient().DescribeHistoryHost(
+		ctx,
+		&types.DescribeHistoryHostRequest{ShardIDForHost: common.Int32Ptr(int32(shardID))},
+		yarpc.WithHeader(common.ShardHandoffHeaderName, c.GetHostInfo().Identity()),
+	)
+	if err != nil {
+		c.logger.Warn("Failed to notify new owner of shard handoff", tag.ShardID(shardID), tag.Error(err))
+		return
+	}
+	c.logger.Info("Shard handed off", tag.ShardID(shardID), tag.Value(resp.GetAddress()))
+}
+
+// releaseShard stops accepting writes for the shard, persists its shard info with the ownership released
+// and closes it, it returns false if the shard has been removed in the meantime
+func (c *controller) releaseShard(shardID int) bool {
+	item, err := c.removeHistoryShardItem(shardID, nil)
+	if err != nil {
+		return false
+	}
+
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	defer sw.Stop()
+
+	item.handoff()
+	return true
+}
+
+func (c *controller) ownsShard(shardID int) bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	_, ok := c.historyShards[shardID]
+	return ok
+}
+
 func (c *controller) doShutdown() {
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStopping)
 	c.Lock()
@@ -464,6 +592,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.shardContext = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -489,6 +618,33 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine)
 		i.engine.Stop()
 		i.engine = nil
+		i.shardContext = nil
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
+		i.status = historyShardsItemStatusStopped
+	case historyShardsItemStatusStopped:
+		// no op
+	default:
+		panic(i.logInvalidStatus())
+	}
+}
+
+// handoff stops the engine and then persists the shard info before closing the shard,
+// so that the next owner starts processing from the latest ack levels
+func (i *historyShardsItem) handoff() {
+	i.Lock()
+	defer i.Unlock()
+
+	switch i.status {
+	case historyShardsItemStatusInitialized:
+		i.status = historyShardsItemStatusStopped
+	case historyShardsItemStatusStarted:
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine)
+		i.engine.Stop()
+		if err := i.shardContext.FlushAndClose(); err != nil {
+			i.logger.Warn("Failed to persist shard info during handoff", tag.Error(err))
+		}
+		i.engine = nil
+		i.shardContext = nil
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
@@ -498,6 +654,53 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// notifyHandoff unblocks the acquisition of the shard waiting for the handoff from its previous owner
+func (i *historyShardsItem) notifyHandoff() {
+	i.handoffOnce.Do(func() {
+		close(i.handoffCh)
+	})
+}
+
+// waitForHandoff waits until the previous owner has handed off the shard or the handoff timeout fires,
+// it returns false without waiting if graceful handoff is disabled or the previous owner is gone
+func (i *historyShardsItem) waitForHandoff(previousOwner string) bool {
+	if !i.config.EnableGracefulShardHandoff() || !i.isHostAlive(previousOwner) {
+		return false
+	}
+
+	timer := time.NewTimer(i.config.ShardHandoffTimeout())
+	defer timer.Stop()
+
+	select {
+	case <-i.handoffCh:
+	case <-timer.C:
+		i.GetMetricsClient().IncCounter(metrics.HistoryShardControllerScope, metrics.ShardHandoffTimeoutCounter)
+		i.logger.Warn("Timed out waiting for shard handoff from previous owner", tag.Value(previousOwner))
+	}
+	return true
+}
+
+// isHostAlive returns true if the host is in the membership ring, or if it answers though it has left the ring:
+// a host shutting down leaves the ring before handing off its shards
+func (i *historyShardsItem) isHostAlive(identity string) bool {
+	members, err := i.GetMembershipResolver().Members(service.History)
+	if err != nil {
+		// wait for the handoff rather than stealing the shard from a host which may still be alive
+		i.logger.Warn("Failed to get history hosts", tag.Error(err))
+		return true
+	}
+	for _, member := range members {
+		if member.Identity() == identity {
+			return true
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shardHandoffProbeTimeout)
+	defer cancel()
+	_, err = i.GetHistoryClient().DescribeHistoryHost(ctx, &types.DescribeHistoryHostRequest{HostAddress: common.StringPtr(identity)})
+	return err == nil
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
@@ -28,6 +28,7 @@ package shard
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -119,15 +120,17 @@ func (mr *MockControllerMockRecorder) Stop() *gomock.Call {
 }
 
 // PrepareToStop mocks base method
-func (m *MockController) PrepareToStop() {
+func (m *MockController) PrepareToStop(remainingTime time.Duration) time.Duration {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PrepareToStop")
+	ret := m.ctrl.Call(m, "PrepareToStop", remainingTime)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
 }
 
 // PrepareToStop indicates an expected call of PrepareToStop
-func (mr *MockControllerMockRecorder) PrepareToStop() *gomock.Call {
+func (mr *MockControllerMockRecorder) PrepareToStop(remainingTime interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareToStop", reflect.TypeOf((*MockController)(nil).PrepareToStop))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareToStop", reflect.TypeOf((*MockController)(nil).PrepareToStop), remainingTime)
 }
 
 // GetEngine mocks base method
@@ -172,6 +175,20 @@ func (mr *MockControllerMockRecorder) RemoveEngineForShard(shardID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEngineForShard", reflect.TypeOf((*MockController)(nil).RemoveEngineForShard), shardID)
 }
 
+// AcquireShardAfterHandoff mocks base method
+func (m *MockController) AcquireShardAfterHandoff(shardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireShardAfterHandoff", shardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcquireShardAfterHandoff indicates an expected call of AcquireShardAfterHandoff
+func (mr *MockControllerMockRecorder) AcquireShardAfterHandoff(shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireShardAfterHandoff", reflect.TypeOf((*MockController)(nil).AcquireShardAfterHandoff), shardID)
+}
+
 // Status mocks base method
 func (m *MockController) Status() int32 {
 	m.ctrl.T.Helper()
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
@@ -603,6 +604,267 @@ func (s *controllerSuite) TestGetOrCreateHistoryShardItem_InvalidShardID_Error()
 	s.Error(err)
 }
 
+func (s *controllerSuite) TestHandoffShardOnMembershipChange() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.setupMocksForAcquireShard(shardID, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+	s.Equal(1, s.shardController.NumShards())
+
+	newOwner := membership.NewHostInfo("another-host")
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(newOwner, nil).Times(1)
+	s.mockHistoryEngine.EXPECT().Stop().Times(1)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		// the ownership is released
+		return request.ShardInfo.Owner == ""
+	})).Return(nil).Once()
+	s.mockResource.HistoryClient.EXPECT().DescribeHistoryHost(
+		gomock.Any(),
+		&types.DescribeHistoryHostRequest{ShardIDForHost: common.Int32Ptr(int32(shardID))},
+		gomock.Any(),
+	).Return(&types.DescribeHistoryHostResponse{Address: newOwner.GetAddress()}, nil).Times(1)
+	s.shardController.acquireShards()
+
+	s.Equal(0, s.shardController.NumShards())
+}
+
+func (s *controllerSuite) TestPrepareToStop_DrainShards() {
+	numShards := 2
+	s.config.NumberOfShards = numShards
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+	historyEngines := make(map[int]*engine.MockEngine)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := engine.NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockMembershipResolver.EXPECT().Subscribe(service.History, shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.Start()
+	s.Equal(numShards, s.shardController.NumShards())
+
+	// the host has left the membership ring, the shards are handed off to their new owners
+	newOwner := membership.NewHostInfo("another-host")
+	for shardID := 0; shardID < numShards; shardID++ {
+		s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(newOwner, nil).Times(1)
+		historyEngines[shardID].EXPECT().Stop().Times(1)
+		s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+			return request.ShardInfo.Owner == ""
+		})).Return(nil).Once()
+		s.mockResource.HistoryClient.EXPECT().DescribeHistoryHost(
+			gomock.Any(),
+			&types.DescribeHistoryHostRequest{ShardIDForHost: common.Int32Ptr(int32(shardID))},
+			gomock.Any(),
+		).Return(&types.DescribeHistoryHostResponse{Address: newOwner.GetAddress()}, nil).Times(1)
+	}
+	remainingTime := s.shardController.PrepareToStop(time.Minute)
+	s.True(remainingTime > 0 && remainingTime < time.Minute)
+	s.Equal(0, s.shardController.NumShards())
+
+	s.mockMembershipResolver.EXPECT().Unsubscribe(service.History, shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	s.shardController.Stop()
+}
+
+func (s *controllerSuite) TestPrepareToStop_DrainShards_NoTimeLeft() {
+	numShards := 2
+	s.config.NumberOfShards = numShards
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+	historyEngines := make(map[int]*engine.MockEngine)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := engine.NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockMembershipResolver.EXPECT().Subscribe(service.History, shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.Start()
+	s.Equal(numShards, s.shardController.NumShards())
+
+	// no shard is released once the time is up, they are stopped with the controller
+	s.Equal(time.Duration(0), s.shardController.PrepareToStop(0))
+	s.Equal(numShards, s.shardController.NumShards())
+
+	for shardID := 0; shardID < numShards; shardID++ {
+		historyEngines[shardID].EXPECT().Stop().Times(1)
+	}
+	s.mockMembershipResolver.EXPECT().Unsubscribe(service.History, shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	s.shardController.Stop()
+}
+
+func (s *controllerSuite) TestAcquireShardAfterHandoff() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	// shard info is loaded again after the handoff from the previous owner
+	s.mockMembershipResolver.EXPECT().Members(service.History).
+		Return([]*membership.HostInfo{s.hostInfo, membership.NewHostInfo("previous-host")}, nil).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				Owner:                   "previous-host",
+				RangeID:                 5,
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Twice()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	startTime := time.Now()
+	s.NoError(s.shardController.AcquireShardAfterHandoff(shardID))
+	s.True(time.Since(startTime) < time.Minute)
+	s.Equal(1, s.shardController.NumShards())
+}
+
+func (s *controllerSuite) TestAcquireShardAfterHandoff_PreviousOwnerLeftRing() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	// the previous owner is shutting down, it has left the membership ring before handing off its shards
+	s.mockMembershipResolver.EXPECT().Members(service.History).Return([]*membership.HostInfo{s.hostInfo}, nil).Times(1)
+	s.mockResource.HistoryClient.EXPECT().DescribeHistoryHost(
+		gomock.Any(),
+		&types.DescribeHistoryHostRequest{HostAddress: common.StringPtr("previous-host")},
+	).Return(&types.DescribeHistoryHostResponse{}, nil).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				Owner:                   "previous-host",
+				RangeID:                 5,
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Twice()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	startTime := time.Now()
+	s.NoError(s.shardController.AcquireShardAfterHandoff(shardID))
+	s.True(time.Since(startTime) < time.Minute)
+	s.Equal(1, s.shardController.NumShards())
+}
+
+func (s *controllerSuite) TestAcquireShard_HandoffTimeout() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockMembershipResolver.EXPECT().Members(service.History).
+		Return([]*membership.HostInfo{s.hostInfo, membership.NewHostInfo("previous-host")}, nil).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				Owner:                   "previous-host",
+				RangeID:                 5,
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Twice()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	engine, err := s.shardController.GetEngineForShard(shardID)
+	s.NoError(err)
+	s.NotNil(engine)
+}
+
+func (s *controllerSuite) TestAcquireShard_ReleasedByPreviousOwner() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	// the previous owner released the shard when handing it off, there is nothing to wait for
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				RangeID:                 5,
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	startTime := time.Now()
+	engine, err := s.shardController.GetEngineForShard(shardID)
+	s.NoError(err)
+	s.NotNil(engine)
+	s.True(time.Since(startTime) < time.Minute)
+}
+
+func (s *controllerSuite) TestAcquireShard_PreviousOwnerNotInRing() {
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	shardID := 0
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	// the previous owner left the membership ring without releasing the shard and is gone, there is nothing to wait for
+	s.mockMembershipResolver.EXPECT().Members(service.History).Return([]*membership.HostInfo{s.hostInfo}, nil).Times(1)
+	s.mockResource.HistoryClient.EXPECT().DescribeHistoryHost(
+		gomock.Any(),
+		&types.DescribeHistoryHostRequest{HostAddress: common.StringPtr("previous-host")},
+	).Return(nil, errors.New("connection refused")).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				Owner:                   "previous-host",
+				RangeID:                 5,
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil).Once()
+
+	startTime := time.Now()
+	engine, err := s.shardController.GetEngineForShard(shardID)
+	s.NoError(err)
+	s.NotNil(engine)
+	s.True(time.Since(startTime) < time.Minute)
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *engine.MockEngine, currentRangeID,
 	newRangeID int64) {
 
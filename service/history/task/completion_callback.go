@@ -0,0 +1,409 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+const (
+	completionCallbackInitialInterval = time.Second
+	completionCallbackMaxInterval     = 5 * time.Minute
+	// completionCallbackMaxFailureLength limits the size of the failure kept in mutable state
+	completionCallbackMaxFailureLength = 256
+)
+
+type (
+	// completionCallbackError is returned when a completion callback could not be delivered
+	completionCallbackError struct {
+		message   string
+		retryable bool
+	}
+
+	// completionCallbackDeliverer makes delivery attempts of the completion callbacks of closed workflow executions
+	// for both the completion callback transfer task and the completion callback retry timer task
+	completionCallbackDeliverer struct {
+		shard          shard.Context
+		executionCache *execution.Cache
+		httpClient     *http.Client
+		config         *config.Config
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+
+	loadMutableStateFn func(ctx context.Context, wfContext execution.Context) (execution.MutableState, error)
+)
+
+func (e *completionCallbackError) Error() string {
+	return e.message
+}
+
+func newCompletionCallbackDeliverer(
+	shard shard.Context,
+	executionCache *execution.Cache,
+	logger log.Logger,
+	config *config.Config,
+) *completionCallbackDeliverer {
+	return &completionCallbackDeliverer{
+		shard:          shard,
+		executionCache: executionCache,
+		httpClient:     newCompletionCallbackHTTPClient(),
+		config:         config,
+		metricsClient:  shard.GetMetricsClient(),
+		logger:         logger,
+	}
+}
+
+// newCompletionCallbackHTTPClient creates the client posting completion callbacks. Redirects are not followed,
+// as the target of a redirect is not checked against the allowed hosts, and a redirect response is a failure.
+func newCompletionCallbackHTTPClient() *http.Client {
+	return &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// deliver attempts the delivery of the completion callbacks that are due. The delivery state is recorded in
+// mutable state and a retry timer is scheduled for the earliest callback that is still pending, so the task
+// itself always completes.
+func (d *completionCallbackDeliverer) deliver(
+	ctx context.Context,
+	task Info,
+	scopeIdx int,
+	loadMutableState loadMutableStateFn,
+) (retError error) {
+
+	wfContext, release, err := d.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		types.WorkflowExecution{WorkflowID: task.GetWorkflowID(), RunID: task.GetRunID()},
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(d.shard, d.logger, task.GetDomainID(), lastWriteVersion, task.GetVersion(), task)
+	if err != nil || !ok {
+		return err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	now := d.shard.GetTimeSource().Now()
+	if !hasDueCompletionCallbacks(executionInfo.CompletionCallbacks, now) {
+		return nil
+	}
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return err
+	}
+	callbacks := startEvent.WorkflowExecutionStartedEventAttributes.GetCompletionCallbacks()
+	if len(callbacks) != len(executionInfo.CompletionCallbacks) {
+		d.logger.Error("Completion callbacks do not match their delivery state, skip.",
+			tag.WorkflowDomainID(task.GetDomainID()),
+			tag.WorkflowID(task.GetWorkflowID()),
+			tag.WorkflowRunID(task.GetRunID()))
+		return nil
+	}
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return err
+	}
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	request := newCompletionCallbackRequest(domainName, executionInfo, completionEvent)
+	// the cached delivery state must not be modified without holding the lock
+	infos := copyCompletionCallbackInfos(executionInfo.CompletionCallbacks)
+
+	// release the context lock since the rest of logic is making HTTP calls, which takes time.
+	release(nil)
+
+	scope := d.metricsClient.Scope(scopeIdx, metrics.DomainTag(domainName))
+	allowedHosts := d.config.CompletionCallbackAllowedHosts(domainName)
+	maxAttempts := int32(d.config.CompletionCallbackMaxAttempts(domainName))
+	timeout := d.config.CompletionCallbackTimeout(domainName)
+	for i, info := range infos {
+		if !isCompletionCallbackDue(info, now) {
+			continue
+		}
+		if err := common.ValidateCompletionCallbackURL(callbacks[i].GetURL(), allowedHosts); err != nil {
+			// the allow list may have changed since the workflow was started
+			info.State = types.CompletionCallbackStateFailed.Ptr()
+			info.LastFailure = err.Error()
+			info.NextAttemptTimestamp = nil
+			scope.IncCounter(metrics.CompletionCallbackGiveUpCounter)
+			continue
+		}
+
+		attemptTime := d.shard.GetTimeSource().Now()
+		info.Attempt++
+		info.LastAttemptTimestamp = common.Int64Ptr(attemptTime.UnixNano())
+		info.NextAttemptTimestamp = nil
+		callCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		sw := scope.StartTimer(metrics.CompletionCallbackLatency)
+		err := invokeCompletionCallback(callCtx, d.httpClient, callbacks[i], request)
+		sw.Stop()
+		cancel()
+		if err == nil {
+			info.State = types.CompletionCallbackStateSucceeded.Ptr()
+			info.LastFailure = ""
+			scope.IncCounter(metrics.CompletionCallbackSucceededCounter)
+			continue
+		}
+
+		scope.IncCounter(metrics.CompletionCallbackFailedCounter)
+		info.LastFailure = truncateCompletionCallbackFailure(err.Error())
+		if callbackErr, ok := err.(*completionCallbackError); !ok || !callbackErr.retryable || info.Attempt >= maxAttempts {
+			info.State = types.CompletionCallbackStateFailed.Ptr()
+			scope.IncCounter(metrics.CompletionCallbackGiveUpCounter)
+			d.logger.Warn("Give up on completion callback.",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowID(task.GetWorkflowID()),
+				tag.WorkflowRunID(task.GetRunID()),
+				tag.Attempt(info.Attempt),
+				tag.Error(err))
+			continue
+		}
+		info.NextAttemptTimestamp = common.Int64Ptr(attemptTime.Add(completionCallbackBackoff(info.Attempt)).UnixNano())
+	}
+
+	updateCtx, cancel := context.WithTimeout(context.Background(), taskDefaultTimeout)
+	defer cancel()
+	return d.updateCompletionCallbackInfos(updateCtx, task, lastWriteVersion, infos)
+}
+
+// updateCompletionCallbackInfos records the delivery state in mutable state and schedules the next attempt. The
+// lock is not held while the callbacks are invoked, so the workflow is checked again and only the callbacks whose
+// state did not move on in the meantime are updated, see execution.MutableState.UpdateCompletionCallbackInfos.
+func (d *completionCallbackDeliverer) updateCompletionCallbackInfos(
+	ctx context.Context,
+	task Info,
+	version int64,
+	infos []*types.CompletionCallbackInfo,
+) (retError error) {
+
+	wfContext, release, err := d.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		types.WorkflowExecution{WorkflowID: task.GetWorkflowID(), RunID: task.GetRunID()},
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	// the workflow may have been reset while the callbacks were invoked
+	if mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	if lastWriteVersion != version {
+		return nil
+	}
+	if !mutableState.UpdateCompletionCallbackInfos(infos) {
+		// another delivery attempt already recorded a newer state
+		return nil
+	}
+	if nextAttemptTime, ok := execution.NextCompletionCallbackAttemptTime(mutableState.GetExecutionInfo().CompletionCallbacks); ok {
+		mutableState.AddTimerTasks(&persistence.CompletionCallbackRetryTimerTask{
+			VisibilityTimestamp: nextAttemptTime,
+			Version:             version,
+		})
+	}
+
+	// a new run may have been started after the workflow closed
+	updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+	resp, err := d.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   task.GetDomainID(),
+		WorkflowID: task.GetWorkflowID(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.RunID != task.GetRunID() {
+		updateMode = persistence.UpdateWorkflowModeBypassCurrent
+	}
+
+	return wfContext.UpdateWorkflowExecutionWithNew(
+		ctx,
+		d.shard.GetTimeSource().Now(),
+		updateMode,
+		nil, // no new workflow
+		nil, // no new workflow
+		execution.TransactionPolicyActive,
+		nil,
+	)
+}
+
+// newCompletionCallbackRequest creates the body posted to completion callback targets from the completion event
+func newCompletionCallbackRequest(
+	domainName string,
+	executionInfo *persistence.WorkflowExecutionInfo,
+	completionEvent *types.HistoryEvent,
+) *common.CompletionCallbackRequest {
+
+	request := &common.CompletionCallbackRequest{
+		Domain:         domainName,
+		WorkflowID:     executionInfo.WorkflowID,
+		RunID:          executionInfo.RunID,
+		WorkflowType:   executionInfo.WorkflowTypeName,
+		CloseStatus:    persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus).String(),
+		CloseTimestamp: completionEvent.GetTimestamp(),
+	}
+	switch completionEvent.GetEventType() {
+	case types.EventTypeWorkflowExecutionCompleted:
+		request.Result = completionEvent.WorkflowExecutionCompletedEventAttributes.Result
+	case types.EventTypeWorkflowExecutionFailed:
+		request.FailureReason = completionEvent.WorkflowExecutionFailedEventAttributes.GetReason()
+		request.FailureDetails = completionEvent.WorkflowExecutionFailedEventAttributes.Details
+	case types.EventTypeWorkflowExecutionCanceled:
+		request.FailureDetails = completionEvent.WorkflowExecutionCanceledEventAttributes.Details
+	case types.EventTypeWorkflowExecutionTerminated:
+		request.FailureReason = completionEvent.WorkflowExecutionTerminatedEventAttributes.GetReason()
+		request.FailureDetails = completionEvent.WorkflowExecutionTerminatedEventAttributes.Details
+	}
+	return request
+}
+
+// invokeCompletionCallback posts the request to the callback target, the target is expected to respond
+// with a 2xx status code. Client errors other than timeouts and throttling are not retried.
+func invokeCompletionCallback(
+	ctx context.Context,
+	client *http.Client,
+	callback *types.CompletionCallback,
+	request *common.CompletionCallbackRequest,
+) error {
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return &completionCallbackError{message: err.Error()}
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.GetURL(), bytes.NewReader(body))
+	if err != nil {
+		return &completionCallbackError{message: err.Error()}
+	}
+	for key, value := range callback.GetHeaders() {
+		httpRequest.Header.Set(key, value)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	response, err := client.Do(httpRequest)
+	if err != nil {
+		return &completionCallbackError{message: err.Error(), retryable: true}
+	}
+	defer response.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(response.Body, 1024*1024))
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	retryable := response.StatusCode >= 500 ||
+		response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests
+	return &completionCallbackError{
+		message:   fmt.Sprintf("callback target responded with status %v", response.Status),
+		retryable: retryable,
+	}
+}
+
+// completionCallbackBackoff returns the interval between the given attempt of a callback and the next one
+func completionCallbackBackoff(attempt int32) time.Duration {
+	interval := completionCallbackInitialInterval
+	for i := int32(1); i < attempt && interval < completionCallbackMaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > completionCallbackMaxInterval {
+		interval = completionCallbackMaxInterval
+	}
+	return interval
+}
+
+func isCompletionCallbackDue(info *types.CompletionCallbackInfo, now time.Time) bool {
+	return info.GetState() == types.CompletionCallbackStatePending &&
+		!now.Before(time.Unix(0, info.GetNextAttemptTimestamp()))
+}
+
+func hasDueCompletionCallbacks(infos []*types.CompletionCallbackInfo, now time.Time) bool {
+	for _, info := range infos {
+		if isCompletionCallbackDue(info, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func copyCompletionCallbackInfos(infos []*types.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	result := make([]*types.CompletionCallbackInfo, 0, len(infos))
+	for _, info := range infos {
+		infoCopy := *info
+		result = append(result, &infoCopy)
+	}
+	return result
+}
+
+func truncateCompletionCallbackFailure(failure string) string {
+	if len(failure) > completionCallbackMaxFailureLength {
+		return failure[:completionCallbackMaxFailureLength]
+	}
+	return failure
+}
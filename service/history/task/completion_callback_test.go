@@ -0,0 +1,155 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	completionCallbackSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestCompletionCallbackSuite(t *testing.T) {
+	s := new(completionCallbackSuite)
+	suite.Run(t, s)
+}
+
+func (s *completionCallbackSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *completionCallbackSuite) TestInvokeCompletionCallback_Success() {
+	request := &common.CompletionCallbackRequest{
+		Domain:      "some random domain",
+		WorkflowID:  "some random workflow ID",
+		RunID:       "some random run ID",
+		CloseStatus: "COMPLETED",
+		Result:      []byte("some random result"),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		s.Equal("some random token", r.Header.Get("Authorization"))
+		var received common.CompletionCallbackRequest
+		s.NoError(json.NewDecoder(r.Body).Decode(&received))
+		s.Equal(*request, received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := invokeCompletionCallback(context.Background(), server.Client(), &types.CompletionCallback{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "some random token"},
+	}, request)
+	s.NoError(err)
+}
+
+func (s *completionCallbackSuite) TestInvokeCompletionCallback_Failure() {
+	testCases := []struct {
+		statusCode int
+		retryable  bool
+	}{
+		{statusCode: http.StatusBadRequest, retryable: false},
+		{statusCode: http.StatusNotFound, retryable: false},
+		{statusCode: http.StatusTooManyRequests, retryable: true},
+		{statusCode: http.StatusInternalServerError, retryable: true},
+		{statusCode: http.StatusServiceUnavailable, retryable: true},
+	}
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.statusCode)
+		}))
+		err := invokeCompletionCallback(context.Background(), server.Client(), &types.CompletionCallback{
+			URL: server.URL,
+		}, &common.CompletionCallbackRequest{})
+		server.Close()
+
+		s.IsType(&completionCallbackError{}, err)
+		s.Equal(tc.retryable, err.(*completionCallbackError).retryable, "status code %v", tc.statusCode)
+	}
+}
+
+func (s *completionCallbackSuite) TestInvokeCompletionCallback_Redirect() {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	err := invokeCompletionCallback(context.Background(), newCompletionCallbackHTTPClient(), &types.CompletionCallback{
+		URL: server.URL,
+	}, &common.CompletionCallbackRequest{})
+	s.IsType(&completionCallbackError{}, err)
+	s.False(err.(*completionCallbackError).retryable)
+	s.False(redirected)
+}
+
+func (s *completionCallbackSuite) TestInvokeCompletionCallback_Unreachable() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := invokeCompletionCallback(context.Background(), http.DefaultClient, &types.CompletionCallback{
+		URL: url,
+	}, &common.CompletionCallbackRequest{})
+	s.IsType(&completionCallbackError{}, err)
+	s.True(err.(*completionCallbackError).retryable)
+}
+
+func (s *completionCallbackSuite) TestCompletionCallbackBackoff() {
+	s.Equal(time.Second, completionCallbackBackoff(1))
+	s.Equal(4*time.Second, completionCallbackBackoff(3))
+	s.Equal(completionCallbackMaxInterval, completionCallbackBackoff(100))
+}
+
+func (s *completionCallbackSuite) TestHasDueCompletionCallbacks() {
+	now := time.Unix(0, time.Now().UnixNano())
+	s.False(hasDueCompletionCallbacks(nil, now))
+
+	infos := []*types.CompletionCallbackInfo{
+		{State: types.CompletionCallbackStateSucceeded.Ptr()},
+		{State: types.CompletionCallbackStatePending.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.Add(time.Minute).UnixNano())},
+		{State: types.CompletionCallbackStatePending.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.Add(time.Second).UnixNano())},
+		{State: types.CompletionCallbackStateFailed.Ptr(), NextAttemptTimestamp: common.Int64Ptr(now.UnixNano())},
+	}
+	s.False(hasDueCompletionCallbacks(infos, now))
+	s.True(hasDueCompletionCallbacks(infos, now.Add(time.Second)))
+	s.True(isCompletionCallbackDue(&types.CompletionCallbackInfo{State: types.CompletionCallbackStatePending.Ptr()}, now))
+}
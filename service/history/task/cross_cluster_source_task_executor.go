@@ -557,12 +557,12 @@ func (t *crossClusterSourceTaskExecutor) verifyDomainActive(
 		return err
 	}
 
-	if entry.IsDomainPendingActive() {
+	if entry.IsDomainPendingActive() || entry.IsWorkflowPendingActive(task.GetWorkflowID()) {
 		// return error so that the task can be retried
 		return ErrTaskPendingActive
 	}
 
-	if !entry.IsDomainActive() {
+	if !entry.IsWorkflowActive(task.GetWorkflowID()) {
 		// set processing state to invalidated so that a new task can be created
 		t.setTaskState(task, ctask.TaskStatePending, processingStateInvalidated)
 		return nil
@@ -152,7 +152,7 @@ func (t *crossClusterTargetTaskExecutor) executeStartChildExecutionTask(
 		return nil, errMissingTaskRequestAttributes
 	}
 
-	targetDomainName, err := t.verifyDomainActive(attributes.TargetDomainID)
+	targetDomainName, err := t.verifyWorkflowActive(attributes.TargetDomainID, attributes.InitiatedEventAttributes.GetWorkflowID())
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +204,7 @@ func (t *crossClusterTargetTaskExecutor) executeCancelExecutionTask(
 		return nil, errMissingTaskRequestAttributes
 	}
 
-	targetDomainName, err := t.verifyDomainActive(attributes.TargetDomainID)
+	targetDomainName, err := t.verifyWorkflowActive(attributes.TargetDomainID, attributes.TargetWorkflowID)
 	if err != nil {
 		return nil, err
 	}
@@ -261,7 +261,7 @@ func (t *crossClusterTargetTaskExecutor) executeApplyParentClosePolicyTask(
 		var failedCause *types.CrossClusterTaskFailedCause
 		retriable := false
 
-		targetDomainName, err := t.verifyDomainActive(childAttrs.ChildDomainID)
+		targetDomainName, err := t.verifyWorkflowActive(childAttrs.ChildDomainID, childAttrs.ChildWorkflowID)
 		if err == nil {
 			err = applyParentClosePolicy(
 				ctx,
@@ -321,7 +321,7 @@ func (t *crossClusterTargetTaskExecutor) executeRecordChildWorkflowExecutionComp
 		return nil, errMissingTaskRequestAttributes
 	}
 
-	_, err := t.verifyDomainActive(attributes.TargetDomainID)
+	_, err := t.verifyWorkflowActive(attributes.TargetDomainID, attributes.TargetWorkflowID)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +363,7 @@ func (t *crossClusterTargetTaskExecutor) executeSignalExecutionTask(
 		return nil, errMissingTaskRequestAttributes
 	}
 
-	targetDomainName, err := t.verifyDomainActive(attributes.TargetDomainID)
+	targetDomainName, err := t.verifyWorkflowActive(attributes.TargetDomainID, attributes.TargetWorkflowID)
 	if err != nil {
 		return nil, err
 	}
@@ -430,8 +430,9 @@ func (t *crossClusterTargetTaskExecutor) convertErrorToFailureCause(
 	}
 }
 
-func (t *crossClusterTargetTaskExecutor) verifyDomainActive(
+func (t *crossClusterTargetTaskExecutor) verifyWorkflowActive(
 	domainID string,
+	workflowID string,
 ) (string, error) {
 	entry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
 	if err != nil {
@@ -443,11 +444,11 @@ func (t *crossClusterTargetTaskExecutor) verifyDomainActive(
 		return "", err
 	}
 
-	if entry.IsDomainPendingActive() {
+	if entry.IsDomainPendingActive() || entry.IsWorkflowPendingActive(workflowID) {
 		return "", ErrTaskPendingActive
 	}
 
-	if !entry.IsDomainActive() {
+	if !entry.IsWorkflowActive(workflowID) {
 		return "", errTargetDomainNotActive
 	}
 
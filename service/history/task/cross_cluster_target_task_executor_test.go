@@ -23,6 +23,7 @@ package task
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -443,6 +444,61 @@ func (s *crossClusterTargetTaskExecutorSuite) TestSignalExecutionTask_RemoveSign
 	s.NotNil(task.response.SignalExecutionAttributes)
 }
 
+func (s *crossClusterTargetTaskExecutorSuite) TestSignalExecutionTask_TargetWorkflowNotActive() {
+	// target domain is active in the current cluster,
+	// but the target workflow is active in the alternative cluster
+	splitTargetDomainID := "split-target-domain-id"
+	s.mockDomainCache.EXPECT().GetDomainByID(splitTargetDomainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   splitTargetDomainID,
+			Name: "split-target-domain",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey: cluster.TestAlternativeClusterName + ":100",
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		constants.TestGlobalTargetDomainEntry.GetReplicationConfig(),
+		constants.TestVersion,
+		s.mockShard.GetClusterMetadata(),
+	), nil).AnyTimes()
+
+	task := s.getTestSignalExecutionTask(processingStateInitialized)
+	task.request.SignalExecutionAttributes.TargetDomainID = splitTargetDomainID
+
+	err := s.executor.Execute(task, true)
+	s.NoError(err)
+
+	s.Equal(task.GetTaskID(), task.response.GetTaskID())
+	s.Equal(types.CrossClusterTaskTypeSignalExecution, task.response.GetTaskType())
+	s.Equal(types.CrossClusterTaskFailedCauseDomainNotActive, task.response.GetFailedCause())
+}
+
+func (s *crossClusterTargetTaskExecutorSuite) TestSignalExecutionTask_TargetWorkflowPendingActive() {
+	// the target workflow is handed off from the alternative cluster to the current cluster
+	handoffTargetDomainID := "handoff-target-domain-id"
+	s.mockDomainCache.EXPECT().GetDomainByID(handoffTargetDomainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   handoffTargetDomainID,
+			Name: "handoff-target-domain",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey:               cluster.TestCurrentClusterName + ":100",
+				cache.WorkflowActiveClustersPreviousKey:       cluster.TestAlternativeClusterName + ":100",
+				cache.WorkflowActiveClustersHandoffEndTimeKey: strconv.FormatInt(time.Now().Add(time.Hour).UnixNano(), 10),
+			},
+		},
+		&persistence.DomainConfig{Retention: 1},
+		constants.TestGlobalTargetDomainEntry.GetReplicationConfig(),
+		constants.TestVersion,
+		s.mockShard.GetClusterMetadata(),
+	), nil).AnyTimes()
+
+	task := s.getTestSignalExecutionTask(processingStateInitialized)
+	task.request.SignalExecutionAttributes.TargetDomainID = handoffTargetDomainID
+
+	err := s.executor.Execute(task, true)
+	s.Equal(ErrTaskPendingActive, err)
+}
+
 func (s *crossClusterTargetTaskExecutorSuite) TestApplyParentClosePolicyTask_Success() {
 	task := s.getTestApplyParentClosePolicyTask(processingStateInitialized)
 
@@ -315,6 +315,11 @@ func (t *crossClusterSourceTask) Ack() {
 		t.scope.RecordTimer(metrics.TaskAttemptTimerPerDomain, time.Duration(t.attempt))
 		t.scope.RecordTimer(metrics.TaskLatencyPerDomain, time.Since(t.submitTime))
 		t.scope.RecordTimer(metrics.TaskQueueLatencyPerDomain, time.Since(t.GetVisibilityTimestamp()))
+		// end to end latency including the round trip to the target cluster
+		t.scope.Tagged(metrics.TargetClusterTag(t.targetCluster)).RecordTimer(
+			metrics.CrossClusterTaskLatency,
+			time.Since(t.GetVisibilityTimestamp()),
+		)
 
 		if t.eventLogger != nil && t.attempt != 0 {
 			// only dump events when the task has been retried
@@ -547,10 +552,18 @@ func (t *crossClusterSourceTask) getRequestForApplyParentPolicy(
 		// we already filtered the children so that child domainID is in task.TargetDomainIDs
 		// don't check if child domain is active or not here,
 		// we need to send the request even if the child domain is not active in target cluster
-		targetDomainID, err := execution.GetChildExecutionDomainID(childInfo, t.shard.GetDomainCache(), domainEntry)
+		targetDomainEntry, err := execution.GetChildExecutionDomainEntry(childInfo, t.shard.GetDomainCache(), domainEntry)
 		if err != nil {
 			return nil, t.processingState, err
 		}
+		targetDomainID := targetDomainEntry.GetInfo().ID
+		// the exception is a domain whose workflows are active in different clusters, its children
+		// are sent to the clusters where they are active by separate tasks, otherwise each of those
+		// tasks would fail for the children of the others and be regenerated forever
+		if targetDomainEntry.GetInfo().Data[cache.WorkflowActiveClustersKey] != "" &&
+			targetDomainEntry.GetActiveClusterForWorkflow(childInfo.StartedWorkflowID) != t.targetCluster {
+			continue
+		}
 
 		attributes.Children = append(
 			attributes.Children,
@@ -724,20 +737,23 @@ func (t *crossClusterSourceTask) isValidLocked() bool {
 	}
 
 	var targetEntry *cache.DomainCacheEntry
+	var targetWorkflowID string
 	// for apply parent policy, target workflow infomation is not
 	// persisted with the task, so skip this test for target workflow since the check is best effort
 	// TODO: we should check the TargetDomainIDs field
 	if t.GetTaskType() != persistence.CrossClusterTaskTypeApplyParentClosePolicy {
-		targetEntry, err = domainCache.GetDomainByID(t.Info.(*persistence.CrossClusterTaskInfo).TargetDomainID)
+		taskInfo := t.Info.(*persistence.CrossClusterTaskInfo)
+		targetWorkflowID = taskInfo.TargetWorkflowID
+		targetEntry, err = domainCache.GetDomainByID(taskInfo.TargetDomainID)
 		if err != nil {
 			return true
 		}
 	}
 
 	// pending active state is treated as valid
-	sourceInvalid := sourceEntry.GetReplicationConfig().ActiveClusterName !=
+	sourceInvalid := sourceEntry.GetActiveClusterForWorkflow(t.GetWorkflowID()) !=
 		t.shard.GetClusterMetadata().GetCurrentClusterName()
-	targetInvalid := targetEntry != nil && targetEntry.GetReplicationConfig().ActiveClusterName != t.targetCluster
+	targetInvalid := targetEntry != nil && targetEntry.GetActiveClusterForWorkflow(targetWorkflowID) != t.targetCluster
 
 	if sourceInvalid || targetInvalid {
 		t.processingState = processingStateInvalidated
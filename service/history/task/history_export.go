@@ -0,0 +1,224 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+// processHistoryExport publishes the history batch of the task to the history export topic.
+// Publish failures fail the task, so that the batch is exported at least once before the
+// transfer queue ack level moves past the task.
+func (t *transferTaskExecutorBase) processHistoryExport(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+	isActive bool,
+) (retError error) {
+
+	if t.historyExportProducer == nil {
+		t.logger.Warn("History export is not available, skip.",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID))
+		return nil
+	}
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.DomainID,
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, wfContext, task, t.metricsClient, t.logger)
+	if err != nil || mutableState == nil {
+		return err
+	}
+
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	mode := t.config.HistoryExportMode(domainName)
+	if mode != messaging.HistoryExportModeBatch && mode != messaging.HistoryExportModeLifecycle {
+		return nil
+	}
+	branchToken, nextEventID, err := getHistoryExportBranch(mutableState, task)
+	if err != nil {
+		t.logger.Error("History export branch not found, skip.",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowFirstEventID(task.ScheduleID),
+			tag.Error(err))
+		return nil
+	}
+
+	// release the context lock since the rest of logic is reading history and publishing, which takes time.
+	release(nil)
+
+	resp, err := t.shard.GetHistoryManager().ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  task.ScheduleID,
+		MaxEventID:  nextEventID,
+		PageSize:    1,
+		ShardID:     common.IntPtr(t.shard.GetShardID()),
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.History) == 0 || len(resp.History[0].Events) == 0 ||
+		resp.History[0].Events[0].EventID != task.ScheduleID ||
+		resp.History[0].Events[0].Version != task.Version {
+		t.logger.Error("History export batch not found, skip.",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowFirstEventID(task.ScheduleID))
+		return nil
+	}
+
+	message := newHistoryExportMessage(task, domainName, mode, resp.History[0].Events)
+	numEvents := len(message.Events) + len(message.LifecycleEvents)
+	if numEvents == 0 {
+		return nil
+	}
+	if err := t.historyExportProducer.Publish(ctx, message); err != nil {
+		return err
+	}
+
+	scope := metrics.TransferStandbyTaskHistoryExportScope
+	if isActive {
+		scope = metrics.TransferActiveTaskHistoryExportScope
+	}
+	t.metricsClient.Scope(scope, metrics.DomainTag(domainName)).AddCounter(metrics.HistoryExportEventsCounter, int64(numEvents))
+	return nil
+}
+
+// getHistoryExportBranch returns the branch holding the exported batch and the next event ID of the branch.
+// The batch is not necessarily on the current branch, e.g. after conflict resolution switched the current branch.
+func getHistoryExportBranch(
+	mutableState execution.MutableState,
+	task *persistence.TransferTaskInfo,
+) ([]byte, int64, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		branchToken, err := mutableState.GetCurrentBranchToken()
+		return branchToken, mutableState.GetNextEventID(), err
+	}
+	index, err := versionHistories.FindFirstVersionHistoryIndexByItem(
+		persistence.NewVersionHistoryItem(task.ScheduleID, task.Version),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	versionHistory, err := versionHistories.GetVersionHistory(index)
+	if err != nil {
+		return nil, 0, err
+	}
+	lastItem, err := versionHistory.GetLastItem()
+	if err != nil {
+		return nil, 0, err
+	}
+	return versionHistory.GetBranchToken(), lastItem.EventID + 1, nil
+}
+
+// newHistoryExportMessage creates the message exporting the history batch according to the export mode
+func newHistoryExportMessage(
+	task *persistence.TransferTaskInfo,
+	domainName string,
+	mode string,
+	events []*types.HistoryEvent,
+) *messaging.HistoryExportMessage {
+
+	message := &messaging.HistoryExportMessage{
+		DomainID:     task.DomainID,
+		Domain:       domainName,
+		WorkflowID:   task.WorkflowID,
+		RunID:        task.RunID,
+		FirstEventID: events[0].EventID,
+		NextEventID:  events[len(events)-1].EventID + 1,
+	}
+	if mode == messaging.HistoryExportModeBatch {
+		// the headers of the completion callbacks may carry credentials and are not exported
+		message.Events = make([]*types.HistoryEvent, 0, len(events))
+		for _, event := range events {
+			message.Events = append(message.Events, common.RedactCompletionCallbacks(event))
+		}
+		return message
+	}
+	for _, event := range events {
+		if lifecycleEvent := newHistoryExportLifecycleEvent(event); lifecycleEvent != nil {
+			message.LifecycleEvents = append(message.LifecycleEvents, lifecycleEvent)
+		}
+	}
+	return message
+}
+
+// newHistoryExportLifecycleEvent projects workflow started, workflow closed and activity failed events,
+// nil is returned for other events
+func newHistoryExportLifecycleEvent(
+	event *types.HistoryEvent,
+) *messaging.HistoryExportLifecycleEvent {
+
+	if !messaging.IsHistoryExportLifecycleEvent(event.GetEventType()) {
+		return nil
+	}
+	lifecycleEvent := &messaging.HistoryExportLifecycleEvent{
+		EventID:   event.EventID,
+		EventType: event.GetEventType(),
+		Timestamp: event.GetTimestamp(),
+		Version:   event.Version,
+	}
+	switch event.GetEventType() {
+	case types.EventTypeWorkflowExecutionStarted:
+		attributes := event.WorkflowExecutionStartedEventAttributes
+		lifecycleEvent.WorkflowType = attributes.GetWorkflowType().GetName()
+		lifecycleEvent.TaskList = attributes.GetTaskList().GetName()
+	case types.EventTypeWorkflowExecutionFailed:
+		lifecycleEvent.FailureReason = event.WorkflowExecutionFailedEventAttributes.GetReason()
+	case types.EventTypeWorkflowExecutionTerminated:
+		lifecycleEvent.FailureReason = event.WorkflowExecutionTerminatedEventAttributes.GetReason()
+	case types.EventTypeWorkflowExecutionTimedOut:
+		lifecycleEvent.FailureReason = event.WorkflowExecutionTimedOutEventAttributes.GetTimeoutType().String()
+	case types.EventTypeActivityTaskFailed:
+		attributes := event.ActivityTaskFailedEventAttributes
+		lifecycleEvent.ScheduledEventID = attributes.GetScheduledEventID()
+		lifecycleEvent.FailureReason = attributes.GetReason()
+	case types.EventTypeActivityTaskTimedOut:
+		attributes := event.ActivityTaskTimedOutEventAttributes
+		lifecycleEvent.ScheduledEventID = attributes.GetScheduledEventID()
+		lifecycleEvent.FailureReason = attributes.GetTimeoutType().String()
+	}
+	return lifecycleEvent
+}
@@ -142,6 +142,16 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeCompletionCallback:
+		if isActive {
+			return metrics.TransferActiveTaskCompletionCallbackScope
+		}
+		return metrics.TransferStandbyTaskCompletionCallbackScope
+	case persistence.TransferTaskTypeHistoryExport:
+		if isActive {
+			return metrics.TransferActiveTaskHistoryExportScope
+		}
+		return metrics.TransferStandbyTaskHistoryExportScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -191,6 +201,11 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case persistence.TaskTypeCompletionCallbackRetryTimer:
+		if isActive {
+			return metrics.TimerActiveTaskCompletionCallbackRetryTimerScope
+		}
+		return metrics.TimerStandbyTaskCompletionCallbackRetryTimerScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -485,6 +500,49 @@ func getWorkflowExecution(
 	}
 }
 
+// ReleaseOutstandingActivityTask frees the slot held in matching by the poller which started the activity.
+// Frontend releases the slot when a worker responds with the task token, which names the task list
+// partition that started the activity. When the activity is completed by ID or times out that partition
+// is not known, so the release is sent to every read partition of the task list, it is a no-op in the
+// partitions which do not track the activity.
+func ReleaseOutstandingActivityTask(
+	ctx context.Context,
+	shard shard.Context,
+	workflowExecution types.WorkflowExecution,
+	activityInfo *persistence.ActivityInfo,
+	logger log.Logger,
+) {
+	if activityInfo.StartedID == common.EmptyEventID {
+		return
+	}
+	domainName, err := shard.GetDomainCache().GetDomainName(activityInfo.DomainID)
+	if err != nil {
+		return
+	}
+	config := shard.GetConfig()
+	if config.MatchingMaxOutstandingTasksPerPoller(domainName, activityInfo.TaskList, persistence.TaskListTypeActivity) <= 0 {
+		return
+	}
+
+	partitions := config.MatchingNumTasklistReadPartitions(domainName, activityInfo.TaskList, persistence.TaskListTypeActivity)
+	for i := 0; i < common.MaxInt(1, partitions); i++ {
+		taskList := activityInfo.TaskList
+		if i > 0 {
+			taskList = fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, activityInfo.TaskList, i)
+		}
+		err := shard.GetService().GetMatchingClient().ReleaseOutstandingTask(ctx, &types.ReleaseOutstandingTaskRequest{
+			DomainUUID:        activityInfo.DomainID,
+			TaskList:          &types.TaskList{Name: taskList, Kind: types.TaskListKindNormal.Ptr()},
+			WorkflowExecution: &workflowExecution,
+			ScheduleID:        activityInfo.ScheduleID,
+		})
+		// the slot is freed anyway once the start to close timeout of the task elapses
+		if err != nil {
+			logger.Warn("Failed to release outstanding task.", tag.WorkflowTaskListName(taskList), tag.Error(err))
+		}
+	}
+}
+
 // NewMockTaskMatcher creates a gomock matcher for mock Task
 func NewMockTaskMatcher(mockTask *MockTask) gomock.Matcher {
 	return &mockTaskMatcher{
@@ -52,6 +52,8 @@ var (
 type (
 	timerActiveTaskExecutor struct {
 		*timerTaskExecutorBase
+
+		completionCallbackDeliverer *completionCallbackDeliverer
 	}
 )
 
@@ -73,6 +75,7 @@ func NewTimerActiveTaskExecutor(
 			metricsClient,
 			config,
 		),
+		completionCallbackDeliverer: newCompletionCallbackDeliverer(shard, executionCache, logger, config),
 	}
 }
 
@@ -107,6 +110,8 @@ func (t *timerActiveTaskExecutor) Execute(
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
+	case persistence.TaskTypeCompletionCallbackRetryTimer:
+		return t.executeCompletionCallbackRetryTimerTask(ctx, timerTask)
 	default:
 		return errUnknownTimerTask
 	}
@@ -250,6 +255,8 @@ func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 	// is encountered, so that we don't need to scan history multiple times
 	// where there're multiple timers with high delay
 	var resurrectedActivity map[int64]struct{}
+	// started activities which timed out, their pollers no longer hold the tasks
+	var timedOutActivities []*persistence.ActivityInfo
 	scanWorkflowCtx, cancel := context.WithTimeout(context.Background(), scanWorkflowTimeout)
 	defer cancel()
 
@@ -342,6 +349,11 @@ Loop:
 			}
 		}
 
+		if activityInfo.StartedID != common.EmptyEventID {
+			timedOutActivity := *activityInfo
+			timedOutActivities = append(timedOutActivities, &timedOutActivity)
+		}
+
 		if ok, err := mutableState.RetryActivity(
 			activityInfo,
 			execution.TimerTypeToReason(timerSequenceID.TimerType),
@@ -373,7 +385,13 @@ Loop:
 	if !updateMutableState {
 		return nil
 	}
-	return t.updateWorkflowExecution(ctx, wfContext, mutableState, scheduleDecision)
+	if err := t.updateWorkflowExecution(ctx, wfContext, mutableState, scheduleDecision); err != nil {
+		return err
+	}
+	for _, activityInfo := range timedOutActivities {
+		ReleaseOutstandingActivityTask(ctx, t.shard, getWorkflowExecution(task), activityInfo, t.logger)
+	}
+	return nil
 }
 
 func (t *timerActiveTaskExecutor) executeDecisionTimeoutTask(
@@ -513,6 +531,21 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	return t.updateWorkflowExecution(ctx, wfContext, mutableState, true)
 }
 
+func (t *timerActiveTaskExecutor) executeCompletionCallbackRetryTimerTask(
+	ctx context.Context,
+	task *persistence.TimerTaskInfo,
+) error {
+
+	return t.completionCallbackDeliverer.deliver(
+		ctx,
+		task,
+		metrics.TimerActiveTaskCompletionCallbackRetryTimerScope,
+		func(ctx context.Context, wfContext execution.Context) (execution.MutableState, error) {
+			return loadMutableStateForTimerTask(ctx, wfContext, task, t.metricsClient, t.logger)
+		},
+	)
+}
+
 func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
@@ -542,7 +575,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	// generate activity task
 	scheduledID := task.EventID
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
-	if !ok || task.ScheduleAttempt < int64(activityInfo.Attempt) || activityInfo.StartedID != common.EmptyEventID {
+	if !ok || task.ScheduleAttempt != int64(activityInfo.Attempt) || activityInfo.StartedID != common.EmptyEventID {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowID),
@@ -482,6 +482,79 @@ func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_RetryPolicy_Re
 	s.Equal(int32(execution.TimerTaskStatusCreatedScheduleToStart), activityInfo.TimerTaskStatus)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_RetryPolicy_Retry_ReleaseOutstandingTask() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	timerTimeout := 2 * time.Second
+	scheduledEvent, _ := test.AddActivityTaskScheduledEventWithRetry(
+		mutableState,
+		decisionCompletionID,
+		"activity",
+		"activity type",
+		mutableState.GetExecutionInfo().TaskList,
+		[]byte(nil),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		int32(timerTimeout.Seconds()),
+		&types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          1.2,
+			MaximumIntervalInSeconds:    5,
+			MaximumAttempts:             5,
+			NonRetriableErrorReasons:    []string{"（╯' - ')╯ ┻━┻ "},
+			ExpirationIntervalInSeconds: 999,
+		},
+	)
+	startedEvent := test.AddActivityTaskStartedEvent(mutableState, scheduledEvent.GetEventID(), "identity")
+	s.Nil(startedEvent)
+
+	timerSequence := execution.NewTimerSequence(mutableState)
+	mutableState.DeleteTimerTasks()
+	modified, err := timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.GetTimerTasks()[0]
+	timerTask := s.newTimerTaskFromInfo(&persistence.TimerTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeActivityTimeout,
+		TimeoutType:         int(types.TimeoutTypeScheduleToClose),
+		VisibilityTimestamp: task.(*persistence.ActivityTimeoutTask).GetVisibilityTimestamp(),
+		EventID:             scheduledEvent.GetEventID(),
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, scheduledEvent.GetEventID(), scheduledEvent.GetVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockShard.GetConfig().MatchingMaxOutstandingTasksPerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	s.mockShard.GetConfig().MatchingNumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	taskList := mutableState.GetExecutionInfo().TaskList
+	for _, partition := range []string{taskList, "/__cadence_sys/" + taskList + "/1"} {
+		s.mockMatchingClient.EXPECT().ReleaseOutstandingTask(gomock.Any(), &types.ReleaseOutstandingTaskRequest{
+			DomainUUID:        s.domainID,
+			TaskList:          &types.TaskList{Name: partition, Kind: types.TaskListKindNormal.Ptr()},
+			WorkflowExecution: &workflowExecution,
+			ScheduleID:        scheduledEvent.GetEventID(),
+		}).Return(nil).Times(1)
+	}
+
+	s.timeSource.Update(s.now.Add(2 * timerTimeout))
+	err = s.timerActiveTaskExecutor.Execute(timerTask, true)
+	s.NoError(err)
+
+	activityInfo, ok := s.getMutableStateFromCache(s.domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID()).GetActivityInfo(scheduledEvent.GetEventID())
+	s.True(ok)
+	s.Equal(common.EmptyEventID, activityInfo.StartedID)
+}
+
 func (s *timerActiveTaskExecutorSuite) TestProcessActivityTimeout_RetryPolicy_Retry_ScheduleToStart() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
@@ -114,6 +114,8 @@ func (t *timerStandbyTaskExecutor) Execute(
 		return t.executeWorkflowBackoffTimerTask(ctx, timerTask)
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return t.executeDeleteHistoryEventTask(ctx, timerTask)
+	case persistence.TaskTypeCompletionCallbackRetryTimer:
+		return t.executeCompletionCallbackRetryTimerTask(ctx, timerTask)
 	default:
 		return errUnknownTimerTask
 	}
@@ -124,6 +126,7 @@ func (t *timerStandbyTaskExecutor) executeUserTimerTimeoutTask(
 	timerTask *persistence.TimerTaskInfo,
 ) error {
 
+	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
 		timerSequence := execution.NewTimerSequence(mutableState)
@@ -153,6 +156,7 @@ func (t *timerStandbyTaskExecutor) executeUserTimerTimeoutTask(
 
 	return t.processTimer(
 		ctx,
+		processTaskIfClosed,
 		timerTask,
 		actionFn,
 		getStandbyPostActionFn(
@@ -171,6 +175,7 @@ func (t *timerStandbyTaskExecutor) executeActivityTimeoutTask(
 	timerTask *persistence.TimerTaskInfo,
 ) error {
 
+	processTaskIfClosed := false
 	// activity heartbeat timer task is a special snowflake.
 	// normal activity timer task on the passive side will be generated by events related to activity in history replicator,
 	// and the standby timer processor will only need to verify whether the timer task can be safely throw away.
@@ -257,6 +262,7 @@ func (t *timerStandbyTaskExecutor) executeActivityTimeoutTask(
 
 	return t.processTimer(
 		ctx,
+		processTaskIfClosed,
 		timerTask,
 		actionFn,
 		getStandbyPostActionFn(
@@ -275,6 +281,7 @@ func (t *timerStandbyTaskExecutor) executeDecisionTimeoutTask(
 	timerTask *persistence.TimerTaskInfo,
 ) error {
 
+	processTaskIfClosed := false
 	// decision schedule to start timer won't be generate for sticky decision,
 	// since sticky is cleared when applying events on passive.
 	// for normal decision, we don't know if a schedule to start timeout timer
@@ -305,6 +312,7 @@ func (t *timerStandbyTaskExecutor) executeDecisionTimeoutTask(
 
 	return t.processTimer(
 		ctx,
+		processTaskIfClosed,
 		timerTask,
 		actionFn,
 		getStandbyPostActionFn(
@@ -323,6 +331,7 @@ func (t *timerStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 	timerTask *persistence.TimerTaskInfo,
 ) error {
 
+	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
 		if mutableState.HasProcessedOrPendingDecision() {
@@ -346,6 +355,7 @@ func (t *timerStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 
 	return t.processTimer(
 		ctx,
+		processTaskIfClosed,
 		timerTask,
 		actionFn,
 		getStandbyPostActionFn(
@@ -364,6 +374,7 @@ func (t *timerStandbyTaskExecutor) executeWorkflowTimeoutTask(
 	timerTask *persistence.TimerTaskInfo,
 ) error {
 
+	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
 		// we do not need to notify new timer to base, since if there is no new event being replicated
@@ -383,6 +394,7 @@ func (t *timerStandbyTaskExecutor) executeWorkflowTimeoutTask(
 
 	return t.processTimer(
 		ctx,
+		processTaskIfClosed,
 		timerTask,
 		actionFn,
 		getStandbyPostActionFn(
@@ -402,8 +414,58 @@ func (t *timerStandbyTaskExecutor) getStandbyClusterTime() time.Time {
 	return t.shard.GetCurrentTime(t.clusterName).Add(t.shard.GetConfig().StandbyClusterDelay())
 }
 
+// executeCompletionCallbackRetryTimerTask keeps the task pending while a completion callback is due, completion
+// callbacks are only delivered by the active cluster, which replicates their delivery state and so acks the retry
+// here once the callback is delivered. If the domain fails over before the task is discarded, the failover
+// processor hands the task to the active executor, which retries the delivery.
+func (t *timerStandbyTaskExecutor) executeCompletionCallbackRetryTimerTask(
+	ctx context.Context,
+	timerTask *persistence.TimerTaskInfo,
+) error {
+
+	processTaskIfClosed := true
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		if mutableState.IsWorkflowExecutionRunning() {
+			// this can happen if workflow is reset.
+			return nil, nil
+		}
+
+		lastWriteVersion, err := mutableState.GetLastWriteVersion()
+		if err != nil {
+			return nil, err
+		}
+		ok, err := verifyTaskVersion(t.shard, t.logger, timerTask.DomainID, lastWriteVersion, timerTask.Version, timerTask)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		callbacks := mutableState.GetExecutionInfo().CompletionCallbacks
+		if !hasDueCompletionCallbacks(callbacks, t.shard.GetTimeSource().Now()) {
+			return nil, nil
+		}
+		return callbacks, nil
+	}
+
+	return t.processTimer(
+		ctx,
+		processTaskIfClosed,
+		timerTask,
+		actionFn,
+		getStandbyPostActionFn(
+			timerTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			standbyTaskPostActionNoOp,
+			standbyTimerTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *timerStandbyTaskExecutor) processTimer(
 	ctx context.Context,
+	processTaskIfClosed bool,
 	timerTask *persistence.TimerTaskInfo,
 	actionFn standbyActionFn,
 	postActionFn standbyPostActionFn,
@@ -436,7 +498,7 @@ func (t *timerStandbyTaskExecutor) processTimer(
 		return nil
 	}
 
-	if !mutableState.IsWorkflowExecutionRunning() {
+	if !mutableState.IsWorkflowExecutionRunning() && !processTaskIfClosed {
 		// workflow already finished, no need to process the timer
 		return nil
 	}
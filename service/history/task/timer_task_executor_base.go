@@ -23,10 +23,13 @@ package task
 import (
 	"context"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore/offload"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -132,6 +135,12 @@ func (t *timerTaskExecutorBase) deleteWorkflow(
 	msBuilder execution.MutableState,
 ) error {
 
+	// the keys of the offloaded payloads are recorded in the mutable state and the history tree tells which are
+	// shared with other runs, so they are deleted first: if deleting them fails, the retried task still finds both
+	if err := t.deleteOffloadedPayloads(ctx, task, msBuilder); err != nil {
+		return err
+	}
+
 	if err := t.deleteCurrentWorkflowExecution(ctx, task); err != nil {
 		return err
 	}
@@ -271,14 +280,109 @@ func (t *timerTaskExecutorBase) deleteWorkflowHistory(
 	return t.throttleRetry.Do(ctx, op)
 }
 
+// deleteOffloadedPayloads deletes the payloads offloaded to the blob store which are referenced by the workflow run.
+// Runs created by reset share the events before the reset point with their base run, so payloads referenced by
+// shared events are kept until the last run sharing them is deleted. Payloads of archived workflows are kept too,
+// as the archived history references them.
+func (t *timerTaskExecutorBase) deleteOffloadedPayloads(
+	ctx context.Context,
+	task *persistence.TimerTaskInfo,
+	msBuilder execution.MutableState,
+) error {
+
+	blobstoreClient := t.shard.GetService().GetBlobstoreClient()
+	if blobstoreClient == nil {
+		return nil
+	}
+	domainName, err := t.shard.GetDomainCache().GetDomainName(task.DomainID)
+	if err != nil || !t.config.TimerProcessorEnablePayloadOffloadCleanup(domainName) {
+		return nil
+	}
+	payloads := msBuilder.GetExecutionInfo().OffloadedPayloads
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	branchToken, err := msBuilder.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	currentBranch, err := persistence.DecodeHistoryBranchToken(branchToken)
+	if err != nil {
+		return err
+	}
+	tree, err := t.shard.GetHistoryManager().GetHistoryTree(ctx, &persistence.GetHistoryTreeRequest{
+		BranchToken: branchToken,
+		ShardID:     common.IntPtr(t.shard.GetShardID()),
+	})
+	if err != nil {
+		return err
+	}
+	// branches of the run other than the current one are left by conflict resolution and do not belong to other runs
+	runBranchIDs := map[string]bool{currentBranch.GetBranchID(): true}
+	if versionHistories := msBuilder.GetVersionHistories(); versionHistories != nil {
+		for _, versionHistory := range versionHistories.Histories {
+			branch, err := persistence.DecodeHistoryBranchToken(versionHistory.GetBranchToken())
+			if err != nil {
+				return err
+			}
+			runBranchIDs[branch.GetBranchID()] = true
+		}
+	}
+
+	var keys []string
+	for key, eventID := range payloads {
+		shared := false
+		for _, branch := range tree.Branches {
+			if !runBranchIDs[branch.GetBranchID()] && branchOfEvent(branch, eventID) == branchOfEvent(currentBranch, eventID) {
+				shared = true
+				break
+			}
+		}
+		if shared {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	deleted, err := offload.NewPayloadStore(blobstoreClient).Delete(ctx, task.DomainID, task.WorkflowID, keys)
+	if deleted > 0 {
+		t.metricsClient.AddCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeletePayloadCount, int64(deleted))
+	}
+	if len(keys) < len(payloads) {
+		t.logger.Info("Kept offloaded payloads of workflow sharing history with other runs",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.Counter(len(payloads)-len(keys)))
+	}
+	return err
+}
+
+// branchOfEvent returns the ID of the branch storing the event of the given history branch
+func branchOfEvent(branch *workflow.HistoryBranch, eventID int64) string {
+	for _, ancestor := range branch.Ancestors {
+		if eventID >= ancestor.GetBeginNodeID() && eventID < ancestor.GetEndNodeID() {
+			return ancestor.GetBranchID()
+		}
+	}
+	return branch.GetBranchID()
+}
+
 func (t *timerTaskExecutorBase) deleteWorkflowVisibility(
 	ctx context.Context,
 	task *persistence.TimerTaskInfo,
 ) error {
 
+	domainName, err := t.shard.GetDomainCache().GetDomainName(task.DomainID)
+	if err != nil {
+		// domain name is only used as filter of dynamic configs
+		t.logger.Warn("Failed to get domain name for deleting visibility record", tag.WorkflowDomainID(task.DomainID), tag.Error(err))
+	}
 	op := func() error {
 		request := &persistence.VisibilityDeleteWorkflowExecutionRequest{
 			DomainID:   task.DomainID,
+			Domain:     domainName,
 			WorkflowID: task.WorkflowID,
 			RunID:      task.RunID,
 			TaskID:     task.TaskID,
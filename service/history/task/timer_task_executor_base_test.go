@@ -31,13 +31,18 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/worker/archiver"
@@ -97,6 +102,7 @@ func (s *timerQueueTaskExecutorBaseSuite) SetupTest() {
 	s.mockVisibilityManager = s.mockShard.Resource.VisibilityMgr
 	s.mockHistoryV2Manager = s.mockShard.Resource.HistoryMgr
 	s.mockArchivalClient = &archiver.ClientMock{}
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(gomock.Any()).Return(constants.TestDomainName, nil).AnyTimes()
 
 	logger := s.mockShard.GetLogger()
 
@@ -138,6 +144,108 @@ func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_NoErr() {
 	s.NoError(err)
 }
 
+func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_DeleteOffloadedPayloads() {
+	task := &persistence.TimerTaskInfo{
+		DomainID:            constants.TestDomainID,
+		WorkflowID:          constants.TestWorkflowID,
+		TaskID:              12345,
+		VisibilityTimestamp: time.Now(),
+	}
+	executionInfo := types.WorkflowExecution{
+		WorkflowID: task.WorkflowID,
+		RunID:      task.RunID,
+	}
+	wfContext := execution.NewContext(task.DomainID, executionInfo, s.mockShard, s.mockExecutionManager, log.NewNoop())
+	s.timerQueueTaskExecutorBase.config.TimerProcessorEnablePayloadOffloadCleanup = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	// the run was reset after event 4, so payloads referenced by events before are still used by the new run
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID("tree", "base-branch")
+	s.NoError(err)
+	sharedKey := "payload_" + task.DomainID + "_shared"
+	ownKey := "payload_" + task.DomainID + "_own"
+	otherDomainKey := "payload_other-domain_own"
+	// forged reference to a payload offloaded by another workflow of the domain
+	otherWorkflowKey := "payload_" + task.DomainID + "_other-workflow"
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		OffloadedPayloads: map[string]int64{sharedKey: 1, ownKey: 7, otherDomainKey: 8, otherWorkflowKey: 9},
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(nil).AnyTimes()
+
+	blobstoreClient := s.mockShard.Resource.BlobstoreClient
+	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockHistoryV2Manager.On("GetHistoryTree", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{
+			{TreeID: common.StringPtr("tree"), BranchID: common.StringPtr("base-branch")},
+			{
+				TreeID:   common.StringPtr("tree"),
+				BranchID: common.StringPtr("reset-branch"),
+				Ancestors: []*shared.HistoryBranchRange{
+					{BranchID: common.StringPtr("base-branch"), BeginNodeID: common.Int64Ptr(1), EndNodeID: common.Int64Ptr(5)},
+				},
+			},
+		},
+	}, nil).Once()
+	blobstoreClient.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: ownKey}).Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	blobstoreClient.On("Get", mock.Anything, &blobstore.GetRequest{Key: ownKey}).Return(&blobstore.GetResponse{
+		Blob: blobstore.Blob{Tags: map[string]string{"domain_id": task.DomainID, "workflow_id": task.WorkflowID}},
+	}, nil).Once()
+	blobstoreClient.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: otherWorkflowKey}).Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	blobstoreClient.On("Get", mock.Anything, &blobstore.GetRequest{Key: otherWorkflowKey}).Return(&blobstore.GetResponse{
+		Blob: blobstore.Blob{Tags: map[string]string{"domain_id": task.DomainID, "workflow_id": "other-workflow"}},
+	}, nil).Once()
+	blobstoreClient.On("Delete", mock.Anything, &blobstore.DeleteRequest{Key: ownKey}).Return(&blobstore.DeleteResponse{}, nil).Once()
+	s.mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockVisibilityManager.On("DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).Times(2)
+
+	err = s.timerQueueTaskExecutorBase.deleteWorkflow(context.Background(), task, wfContext, s.mockMutableState)
+	s.NoError(err)
+	blobstoreClient.AssertExpectations(s.T())
+}
+
+func (s *timerQueueTaskExecutorBaseSuite) TestDeleteWorkflow_DeleteOffloadedPayloadsFailed() {
+	task := &persistence.TimerTaskInfo{
+		DomainID:            constants.TestDomainID,
+		WorkflowID:          constants.TestWorkflowID,
+		TaskID:              12345,
+		VisibilityTimestamp: time.Now(),
+	}
+	executionInfo := types.WorkflowExecution{
+		WorkflowID: task.WorkflowID,
+		RunID:      task.RunID,
+	}
+	wfContext := execution.NewContext(task.DomainID, executionInfo, s.mockShard, s.mockExecutionManager, log.NewNoop())
+	s.timerQueueTaskExecutorBase.config.TimerProcessorEnablePayloadOffloadCleanup = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID("tree", "base-branch")
+	s.NoError(err)
+	ownKey := "payload_" + task.DomainID + "_own"
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		OffloadedPayloads: map[string]int64{ownKey: 7},
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetVersionHistories().Return(nil).AnyTimes()
+	s.mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil).Times(1)
+
+	blobstoreClient := s.mockShard.Resource.BlobstoreClient
+	s.mockHistoryV2Manager.On("GetHistoryTree", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{{TreeID: common.StringPtr("tree"), BranchID: common.StringPtr("base-branch")}},
+	}, nil).Once()
+	blobstoreClient.On("Exists", mock.Anything, &blobstore.ExistsRequest{Key: ownKey}).Return(&blobstore.ExistsResponse{Exists: true}, nil).Once()
+	blobstoreClient.On("Get", mock.Anything, &blobstore.GetRequest{Key: ownKey}).Return(&blobstore.GetResponse{
+		Blob: blobstore.Blob{Tags: map[string]string{"domain_id": task.DomainID, "workflow_id": task.WorkflowID}},
+	}, nil).Once()
+	deleteErr := errors.New("some random error")
+	blobstoreClient.On("Delete", mock.Anything, &blobstore.DeleteRequest{Key: ownKey}).Return(nil, deleteErr).Once()
+
+	// the mutable state and the history are kept, so that the retried task still finds the payloads
+	err = s.timerQueueTaskExecutorBase.deleteWorkflow(context.Background(), task, wfContext, s.mockMutableState)
+	s.Error(err)
+	blobstoreClient.AssertExpectations(s.T())
+	s.mockExecutionManager.AssertNotCalled(s.T(), "DeleteCurrentWorkflowExecution", mock.Anything, mock.Anything)
+	s.mockExecutionManager.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *timerQueueTaskExecutorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFailed() {
 	s.mockWorkflowExecutionContext.EXPECT().LoadExecutionStats(gomock.Any()).Return(&persistence.ExecutionStats{
 		HistorySize: 1024,
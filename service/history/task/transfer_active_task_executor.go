@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -68,9 +69,10 @@ type (
 	transferActiveTaskExecutor struct {
 		*transferTaskExecutorBase
 
-		historyClient           history.Client
-		parentClosePolicyClient parentclosepolicy.Client
-		workflowResetter        reset.WorkflowResetter
+		historyClient               history.Client
+		parentClosePolicyClient     parentclosepolicy.Client
+		workflowResetter            reset.WorkflowResetter
+		completionCallbackDeliverer *completionCallbackDeliverer
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -80,6 +82,7 @@ type (
 func NewTransferActiveTaskExecutor(
 	shard shard.Context,
 	archiverClient archiver.Client,
+	historyExportProducer messaging.Producer,
 	executionCache *execution.Cache,
 	workflowResetter reset.WorkflowResetter,
 	logger log.Logger,
@@ -90,6 +93,7 @@ func NewTransferActiveTaskExecutor(
 		transferTaskExecutorBase: newTransferTaskExecutorBase(
 			shard,
 			archiverClient,
+			historyExportProducer,
 			executionCache,
 			logger,
 			config,
@@ -101,7 +105,8 @@ func NewTransferActiveTaskExecutor(
 			shard.GetService().GetSDKClient(),
 			config.NumParentClosePolicySystemWorkflows(),
 		),
-		workflowResetter: workflowResetter,
+		workflowResetter:            workflowResetter,
+		completionCallbackDeliverer: newCompletionCallbackDeliverer(shard, executionCache, logger, config),
 	}
 }
 
@@ -147,6 +152,10 @@ func (t *transferActiveTaskExecutor) Execute(
 		return t.processResetWorkflow(ctx, transferTask)
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeCompletionCallback:
+		return t.processCompletionCallback(ctx, transferTask)
+	case persistence.TransferTaskTypeHistoryExport:
+		return t.processHistoryExport(ctx, transferTask, true)
 	default:
 		return errUnknownTransferTask
 	}
@@ -187,6 +196,10 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if pauseInfo := mutableState.GetExecutionInfo().PauseInfo; pauseInfo != nil && !pauseInfo.AllowActivities {
+		// the activity task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
@@ -232,6 +245,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	}
 
 	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.PauseInfo != nil {
+		// the decision task is generated again when the workflow is unpaused
+		return nil
+	}
 	workflowTimeout := executionInfo.WorkflowTimeout
 	decisionTimeout := common.MinInt32(workflowTimeout, common.MaxTaskTimeout)
 
@@ -404,7 +421,7 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 		if err != nil {
 			return err
 		}
-		if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry); isCrossCluster {
+		if targetCluster, isCrossCluster := t.isCrossClusterTask(targetDomainEntry, parentWorkflowID); isCrossCluster {
 			parentInfo := &types.ParentExecutionInfo{
 				DomainUUID: parentDomainID,
 				Domain:     targetDomainEntry.GetInfo().Name,
@@ -555,7 +572,7 @@ func (t *transferActiveTaskExecutor) processCancelExecution(
 		return err
 	}
 
-	if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry); isCrossCluster {
+	if targetCluster, isCrossCluster := t.isCrossClusterTask(targetDomainEntry, task.TargetWorkflowID); isCrossCluster {
 		return t.generateCrossClusterTaskFromTransferTask(ctx, wfContext, task, targetCluster)
 	}
 
@@ -665,7 +682,7 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 		return err
 	}
 
-	if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry); isCrossCluster {
+	if targetCluster, isCrossCluster := t.isCrossClusterTask(targetDomainEntry, task.TargetWorkflowID); isCrossCluster {
 		return t.generateCrossClusterTaskFromTransferTask(ctx, wfContext, task, targetCluster)
 	}
 
@@ -799,7 +816,7 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 		// it is possible that the domain got deleted. Use domainID instead as this is only needed for the history event
 		targetDomainName = task.TargetDomainID
 	} else {
-		if targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry); isCrossCluster {
+		if targetCluster, isCrossCluster := t.isCrossClusterTask(targetDomainEntry, task.TargetWorkflowID); isCrossCluster {
 			return t.generateCrossClusterTaskFromTransferTask(ctx, wfContext, task, targetCluster)
 		}
 
@@ -998,6 +1015,23 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	)
 }
 
+// processCompletionCallback makes the first delivery attempt of the completion callbacks of a closed workflow execution,
+// failed attempts are retried by completion callback retry timer tasks.
+func (t *transferActiveTaskExecutor) processCompletionCallback(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+) error {
+
+	return t.completionCallbackDeliverer.deliver(
+		ctx,
+		task,
+		metrics.TransferActiveTaskCompletionCallbackScope,
+		func(ctx context.Context, wfContext execution.Context) (execution.MutableState, error) {
+			return loadMutableStateForTransferTask(ctx, wfContext, task, t.metricsClient, t.logger)
+		},
+	)
+}
+
 func (t *transferActiveTaskExecutor) processResetWorkflow(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
@@ -1404,15 +1438,14 @@ func signalExternalExecutionFailed(
 	return err
 }
 
+// isCrossClusterTask returns the active cluster of the target workflow when it is not the current cluster.
+// The target workflow can be in the domain of the task, when the workflows of the domain are active in
+// different clusters.
 func (t *transferActiveTaskExecutor) isCrossClusterTask(
-	sourceDomainID string,
 	targetDomainEntry *cache.DomainCacheEntry,
+	targetWorkflowID string,
 ) (string, bool) {
-	if sourceDomainID == targetDomainEntry.GetInfo().ID {
-		return "", false
-	}
-
-	targetCluster := targetDomainEntry.GetReplicationConfig().ActiveClusterName
+	targetCluster := targetDomainEntry.GetActiveClusterForWorkflow(targetWorkflowID)
 	if targetCluster != t.shard.GetClusterMetadata().GetCurrentClusterName() {
 		return targetCluster, true
 	}
@@ -1843,7 +1876,7 @@ func (t *transferActiveTaskExecutor) applyParentClosePolicyDomainActiveCheck(
 			}
 			return nil, nil, false, err
 		}
-		targetCluster, isCrossCluster := t.isCrossClusterTask(task.DomainID, targetDomainEntry)
+		targetCluster, isCrossCluster := t.isCrossClusterTask(targetDomainEntry, childInfo.StartedWorkflowID)
 		if isCrossCluster {
 			if _, ok := remoteClusters[targetCluster]; !ok {
 				remoteClusters[targetCluster] = map[string]struct{}{}
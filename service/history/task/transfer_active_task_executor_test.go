@@ -21,8 +21,12 @@
 package task
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -45,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
@@ -80,6 +85,7 @@ type (
 		mockArchivalMetadata        *archiver.MockArchivalMetadata
 		mockArchiverProvider        *provider.MockArchiverProvider
 		mockParentClosePolicyClient *parentclosepolicy.ClientMock
+		mockHistoryExportProducer   *mocks.KafkaProducer
 
 		logger                     log.Logger
 		domainID                   string
@@ -163,6 +169,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 
 	s.mockParentClosePolicyClient = &parentclosepolicy.ClientMock{}
 	s.mockArchivalClient = &warchiver.ClientMock{}
+	s.mockHistoryExportProducer = &mocks.KafkaProducer{}
 	s.mockMatchingClient = s.mockShard.Resource.MatchingClient
 	s.mockHistoryClient = s.mockShard.Resource.HistoryClient
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
@@ -201,6 +208,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 	s.transferActiveTaskExecutor = NewTransferActiveTaskExecutor(
 		s.mockShard,
 		s.mockArchivalClient,
+		s.mockHistoryExportProducer,
 		execution.NewCache(s.mockShard),
 		nil,
 		s.logger,
@@ -214,6 +222,7 @@ func (s *transferActiveTaskExecutorSuite) TearDownTest() {
 	s.mockShard.Finish(s.T())
 	s.mockArchivalClient.AssertExpectations(s.T())
 	s.mockParentClosePolicyClient.AssertExpectations(s.T())
+	s.mockHistoryExportProducer.AssertExpectations(s.T())
 }
 
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
@@ -1621,6 +1630,267 @@ func (s *transferActiveTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessHistoryExport() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	s.transferActiveTaskExecutor.config.HistoryExportMode = func(string) string {
+		return messaging.HistoryExportModeLifecycle
+	}
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: common.FirstEventID,
+		TaskType:   persistence.TransferTaskTypeHistoryExport,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	decisionScheduledEvent := &types.HistoryEvent{
+		EventID:   startEvent.EventID + 1,
+		EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID && request.PageSize == 1
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*types.History{{Events: []*types.HistoryEvent{startEvent, decisionScheduledEvent}}},
+	}, nil).Once()
+	s.mockHistoryExportProducer.On("Publish", mock.Anything, &messaging.HistoryExportMessage{
+		DomainID:     s.domainID,
+		Domain:       s.domainName,
+		WorkflowID:   workflowExecution.GetWorkflowID(),
+		RunID:        workflowExecution.GetRunID(),
+		FirstEventID: startEvent.EventID,
+		NextEventID:  decisionScheduledEvent.EventID + 1,
+		LifecycleEvents: []*messaging.HistoryExportLifecycleEvent{{
+			EventID:      startEvent.EventID,
+			EventType:    types.EventTypeWorkflowExecutionStarted,
+			Timestamp:    startEvent.GetTimestamp(),
+			Version:      startEvent.Version,
+			WorkflowType: startEvent.WorkflowExecutionStartedEventAttributes.WorkflowType.GetName(),
+			TaskList:     startEvent.WorkflowExecutionStartedEventAttributes.TaskList.GetName(),
+		}},
+	}).Return(nil).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessHistoryExport_NonCurrentBranch() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	s.transferActiveTaskExecutor.config.HistoryExportMode = func(string) string {
+		return messaging.HistoryExportModeBatch
+	}
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: common.FirstEventID + 1,
+		TaskType:   persistence.TransferTaskTypeHistoryExport,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	// the current branch was switched to a branch which does not hold the exported batch
+	exportedBranch, err := persistenceMutableState.VersionHistories.GetCurrentVersionHistory()
+	s.NoError(err)
+	currentBranch := persistence.NewVersionHistory([]byte("some random branch token"), []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(common.FirstEventID, s.version),
+		persistence.NewVersionHistoryItem(decisionCompletionID, s.version+1),
+	})
+	persistenceMutableState.VersionHistories = persistence.NewVersionHistoriesFromInternalType(&types.VersionHistories{
+		CurrentVersionHistoryIndex: 0,
+		Histories:                  []*types.VersionHistory{currentBranch.ToInternalType(), exportedBranch.ToInternalType()},
+	})
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	decisionScheduledEvent := &types.HistoryEvent{
+		EventID:   common.FirstEventID + 1,
+		Version:   s.version,
+		EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return bytes.Equal(request.BranchToken, exportedBranch.GetBranchToken()) && request.MaxEventID == decisionCompletionID+1
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*types.History{{Events: []*types.HistoryEvent{decisionScheduledEvent}}},
+	}, nil).Once()
+	s.mockHistoryExportProducer.On("Publish", mock.Anything, mock.MatchedBy(func(message *messaging.HistoryExportMessage) bool {
+		return len(message.Events) == 1 && message.Events[0] == decisionScheduledEvent
+	})).Return(nil).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessHistoryExport_RedactCompletionCallbacks() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	s.transferActiveTaskExecutor.config.HistoryExportMode = func(string) string {
+		return messaging.HistoryExportModeBatch
+	}
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: common.FirstEventID,
+		TaskType:   persistence.TransferTaskTypeHistoryExport,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	startEventWithCallbacks := *startEvent
+	attributes := *startEvent.WorkflowExecutionStartedEventAttributes
+	attributes.CompletionCallbacks = []*types.CompletionCallback{{
+		URL:     "http://some-random-host/callback",
+		Headers: map[string]string{"Authorization": "some random token"},
+	}}
+	startEventWithCallbacks.WorkflowExecutionStartedEventAttributes = &attributes
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*types.History{{Events: []*types.HistoryEvent{&startEventWithCallbacks}}},
+	}, nil).Once()
+	s.mockHistoryExportProducer.On("Publish", mock.Anything, mock.MatchedBy(func(message *messaging.HistoryExportMessage) bool {
+		if len(message.Events) != 1 {
+			return false
+		}
+		callbacks := message.Events[0].WorkflowExecutionStartedEventAttributes.CompletionCallbacks
+		return len(callbacks) == 1 && callbacks[0].URL == "http://some-random-host/callback" && len(callbacks[0].Headers) == 0
+	})).Return(nil).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+	// the event read from the history is left untouched
+	s.Len(attributes.CompletionCallbacks[0].Headers, 1)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessHistoryExport_PublishFailed() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	s.transferActiveTaskExecutor.config.HistoryExportMode = func(string) string {
+		return messaging.HistoryExportModeBatch
+	}
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		ScheduleID: common.FirstEventID,
+		TaskType:   persistence.TransferTaskTypeHistoryExport,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*types.History{{Events: []*types.HistoryEvent{startEvent}}},
+	}, nil).Once()
+	publishErr := errors.New("some random error")
+	s.mockHistoryExportProducer.On("Publish", mock.Anything, mock.MatchedBy(func(message *messaging.HistoryExportMessage) bool {
+		return len(message.Events) == 1 && message.Events[0] == startEvent
+	})).Return(publishErr).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Equal(publishErr, err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Success() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("some random token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	s.testProcessCompletionCallback(server.URL, func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		infos := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		return len(infos) == 1 &&
+			infos[0].GetState() == types.CompletionCallbackStateSucceeded &&
+			infos[0].Attempt == 1 &&
+			len(request.UpdateWorkflowMutation.TimerTasks) == 0
+	})
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Retry() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s.testProcessCompletionCallback(server.URL, func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		infos := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		if len(infos) != 1 ||
+			infos[0].GetState() != types.CompletionCallbackStatePending ||
+			infos[0].Attempt != 1 ||
+			infos[0].NextAttemptTimestamp == nil ||
+			len(request.UpdateWorkflowMutation.TimerTasks) != 1 {
+			return false
+		}
+		retryTask, ok := request.UpdateWorkflowMutation.TimerTasks[0].(*persistence.CompletionCallbackRetryTimerTask)
+		return ok && retryTask.GetVisibilityTimestamp().UnixNano() == infos[0].GetNextAttemptTimestamp()
+	})
+}
+
+func (s *transferActiveTaskExecutorSuite) testProcessCompletionCallback(
+	url string,
+	matchUpdateRequest func(request *persistence.UpdateWorkflowExecutionRequest) bool,
+) {
+	s.transferActiveTaskExecutor.config.CompletionCallbackAllowedHosts = func(string) string { return "*" }
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+	startEvent.WorkflowExecutionStartedEventAttributes.CompletionCallbacks = []*types.CompletionCallback{
+		{URL: url, Headers: map[string]string{"Authorization": "some random token"}},
+	}
+	mutableState.GetExecutionInfo().CompletionCallbacks = common.NewCompletionCallbackInfos(
+		startEvent.WorkflowExecutionStartedEventAttributes.CompletionCallbacks,
+	)
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskType:   persistence.TransferTaskTypeCompletionCallback,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.GetEventID(), event.GetVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: workflowExecution.GetRunID(),
+	}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.Mode == persistence.UpdateWorkflowModeUpdateCurrent && matchUpdateRequest(request)
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestCopySearchAttributes() {
 	var input map[string][]byte
 	s.Nil(copySearchAttributes(input))
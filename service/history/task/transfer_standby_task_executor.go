@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
@@ -50,6 +51,7 @@ type (
 func NewTransferStandbyTaskExecutor(
 	shard shard.Context,
 	archiverClient archiver.Client,
+	historyExportProducer messaging.Producer,
 	executionCache *execution.Cache,
 	historyResender ndc.HistoryResender,
 	logger log.Logger,
@@ -60,6 +62,7 @@ func NewTransferStandbyTaskExecutor(
 		transferTaskExecutorBase: newTransferTaskExecutorBase(
 			shard,
 			archiverClient,
+			historyExportProducer,
 			executionCache,
 			logger,
 			config,
@@ -102,6 +105,8 @@ func (t *transferStandbyTaskExecutor) Execute(
 		// no action needed for standby
 		// check the comment in t.processCloseExecution()
 		return nil
+	case persistence.TransferTaskTypeCompletionCallback:
+		return t.processCompletionCallback(ctx, transferTask)
 	case persistence.TransferTaskTypeCancelExecution:
 		return t.processCancelExecution(ctx, transferTask)
 	case persistence.TransferTaskTypeSignalExecution:
@@ -116,6 +121,10 @@ func (t *transferStandbyTaskExecutor) Execute(
 		return nil
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeHistoryExport:
+		// the batch was persisted while the domain was active in the current cluster,
+		// export it even though the domain failed over since then
+		return t.processHistoryExport(ctx, transferTask, false)
 	default:
 		return errUnknownTransferTask
 	}
@@ -407,6 +416,55 @@ func (t *transferStandbyTaskExecutor) processStartChildExecution(
 	)
 }
 
+// processCompletionCallback keeps the task pending while the callbacks are not delivered, completion callbacks
+// are only delivered by the active cluster, which replicates their delivery state and so acks the task here once
+// the callbacks are delivered. If the domain fails over before the task is discarded, the failover processor hands
+// the task to the active executor, which delivers the callbacks.
+func (t *transferStandbyTaskExecutor) processCompletionCallback(
+	ctx context.Context,
+	transferTask *persistence.TransferTaskInfo,
+) error {
+
+	processTaskIfClosed := true
+	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
+
+		if mutableState.IsWorkflowExecutionRunning() {
+			// this can happen if workflow is reset.
+			return nil, nil
+		}
+
+		lastWriteVersion, err := mutableState.GetLastWriteVersion()
+		if err != nil {
+			return nil, err
+		}
+		ok, err := verifyTaskVersion(t.shard, t.logger, transferTask.DomainID, lastWriteVersion, transferTask.Version, transferTask)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		callbacks := mutableState.GetExecutionInfo().CompletionCallbacks
+		if !hasDueCompletionCallbacks(callbacks, t.shard.GetTimeSource().Now()) {
+			return nil, nil
+		}
+		return callbacks, nil
+	}
+
+	return t.processTransfer(
+		ctx,
+		processTaskIfClosed,
+		transferTask,
+		actionFn,
+		getStandbyPostActionFn(
+			transferTask,
+			t.getCurrentTime,
+			t.config.StandbyTaskMissingEventsResendDelay(),
+			t.config.StandbyTaskMissingEventsDiscardDelay(),
+			standbyTaskPostActionNoOp,
+			standbyTransferTaskPostActionTaskDiscarded,
+		),
+	)
+}
+
 func (t *transferStandbyTaskExecutor) processRecordWorkflowStarted(
 	ctx context.Context,
 	transferTask *persistence.TransferTaskInfo,
@@ -158,6 +158,7 @@ func (s *transferStandbyTaskExecutorSuite) SetupTest() {
 	s.transferStandbyTaskExecutor = NewTransferStandbyTaskExecutor(
 		s.mockShard,
 		s.mockArchivalClient,
+		nil,
 		execution.NewCache(s.mockShard),
 		s.mockNDCHistoryResender,
 		s.logger,
@@ -446,6 +447,58 @@ func (s *transferStandbyTaskExecutorSuite) testProcessCloseExecution(taskType in
 	s.Nil(err)
 }
 
+func (s *transferStandbyTaskExecutorSuite) TestProcessCompletionCallback_Pending() {
+	transferTask, now := s.setupCompletionCallbackTask(types.CompletionCallbackStatePending)
+
+	s.mockShard.SetCurrentTime(s.clusterName, now)
+	err := s.transferStandbyTaskExecutor.Execute(transferTask, true)
+	s.Equal(ErrTaskRedispatch, err)
+
+	s.mockShard.SetCurrentTime(s.clusterName, now.Add(s.fetchHistoryDuration))
+	err = s.transferStandbyTaskExecutor.Execute(transferTask, true)
+	s.Equal(ErrTaskRedispatch, err)
+
+	s.mockShard.SetCurrentTime(s.clusterName, now.Add(s.discardDuration))
+	err = s.transferStandbyTaskExecutor.Execute(transferTask, true)
+	s.Equal(ErrTaskDiscarded, err)
+}
+
+func (s *transferStandbyTaskExecutorSuite) TestProcessCompletionCallback_Delivered() {
+	transferTask, now := s.setupCompletionCallbackTask(types.CompletionCallbackStateSucceeded)
+
+	s.mockShard.SetCurrentTime(s.clusterName, now)
+	err := s.transferStandbyTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferStandbyTaskExecutorSuite) setupCompletionCallbackTask(state types.CompletionCallbackState) (Task, time.Time) {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+	mutableState.GetExecutionInfo().CompletionCallbacks = []*types.CompletionCallbackInfo{
+		{URL: "http://localhost/callback", State: state.Ptr()},
+	}
+
+	now := time.Now()
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          workflowExecution.GetWorkflowID(),
+		RunID:               workflowExecution.GetRunID(),
+		VisibilityTimestamp: now,
+		TaskID:              int64(59),
+		TaskList:            mutableState.GetExecutionInfo().TaskList,
+		TaskType:            persistence.TransferTaskTypeCompletionCallback,
+		ScheduleID:          event.GetEventID(),
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.GetEventID(), event.GetVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	return transferTask, now
+}
+
 func (s *transferStandbyTaskExecutorSuite) TestProcessCancelExecution_Pending() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
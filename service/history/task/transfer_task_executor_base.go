@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -50,34 +51,37 @@ const (
 
 type (
 	transferTaskExecutorBase struct {
-		shard          shard.Context
-		archiverClient archiver.Client
-		executionCache *execution.Cache
-		logger         log.Logger
-		metricsClient  metrics.Client
-		matchingClient matching.Client
-		visibilityMgr  persistence.VisibilityManager
-		config         *config.Config
-		throttleRetry  *backoff.ThrottleRetry
+		shard                 shard.Context
+		archiverClient        archiver.Client
+		historyExportProducer messaging.Producer
+		executionCache        *execution.Cache
+		logger                log.Logger
+		metricsClient         metrics.Client
+		matchingClient        matching.Client
+		visibilityMgr         persistence.VisibilityManager
+		config                *config.Config
+		throttleRetry         *backoff.ThrottleRetry
 	}
 )
 
 func newTransferTaskExecutorBase(
 	shard shard.Context,
 	archiverClient archiver.Client,
+	historyExportProducer messaging.Producer,
 	executionCache *execution.Cache,
 	logger log.Logger,
 	config *config.Config,
 ) *transferTaskExecutorBase {
 	return &transferTaskExecutorBase{
-		shard:          shard,
-		archiverClient: archiverClient,
-		executionCache: executionCache,
-		logger:         logger,
-		metricsClient:  shard.GetMetricsClient(),
-		matchingClient: shard.GetService().GetMatchingClient(),
-		visibilityMgr:  shard.GetService().GetVisibilityManager(),
-		config:         config,
+		shard:                 shard,
+		archiverClient:        archiverClient,
+		historyExportProducer: historyExportProducer,
+		executionCache:        executionCache,
+		logger:                logger,
+		metricsClient:         shard.GetMetricsClient(),
+		matchingClient:        shard.GetService().GetMatchingClient(),
+		visibilityMgr:         shard.GetService().GetVisibilityManager(),
+		config:                config,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(taskRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
@@ -91,6 +91,18 @@ func (t ThriftHandler) GetCrossClusterTasks(ctx context.Context, request *shared
 	return thrift.FromGetCrossClusterTasksResponse(response), thrift.FromError(err)
 }
 
+// GetReplicationStatus returns the replication status of shards with a remote cluster
+func (t ThriftHandler) GetReplicationStatus(ctx context.Context, request *h.GetReplicationStatusRequest) (*replicator.GetReplicationStatusResponse, error) {
+	response, err := t.h.GetReplicationStatus(ctx, thrift.ToHistoryGetReplicationStatusRequest(request))
+	return thrift.FromGetReplicationStatusResponse(response), thrift.FromError(err)
+}
+
+// GetReplicationReadiness returns the replication readiness of shards for a source cluster
+func (t ThriftHandler) GetReplicationReadiness(ctx context.Context, request *h.GetReplicationReadinessRequest) (*replicator.GetReplicationReadinessResponse, error) {
+	response, err := t.h.GetReplicationReadiness(ctx, thrift.ToHistoryGetReplicationReadinessRequest(request))
+	return thrift.FromGetReplicationReadinessResponse(response), thrift.FromError(err)
+}
+
 // GetDLQReplicationMessages forwards request to the underlying handler
 func (t ThriftHandler) GetDLQReplicationMessages(ctx context.Context, request *replicator.GetDLQReplicationMessagesRequest) (*replicator.GetDLQReplicationMessagesResponse, error) {
 	response, err := t.h.GetDLQReplicationMessages(ctx, thrift.ToGetDLQReplicationMessagesRequest(request))
@@ -301,6 +313,24 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// PauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error {
+	err := t.h.PauseWorkflowExecution(ctx, thrift.ToHistoryPauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
+// OperatePendingActivity forwards request to the underlying handler
+func (t ThriftHandler) OperatePendingActivity(ctx context.Context, request *h.OperatePendingActivityRequest) error {
+	err := t.h.OperatePendingActivity(ctx, thrift.ToHistoryOperatePendingActivityRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpauseWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, request *h.UnpauseWorkflowExecutionRequest) error {
+	err := t.h.UnpauseWorkflowExecution(ctx, thrift.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // GetFailoverInfo forwards request to the underlying handler
 func (t ThriftHandler) GetFailoverInfo(ctx context.Context, request *h.GetFailoverInfoRequest) (*h.GetFailoverInfoResponse, error) {
 	response, err := t.h.GetFailoverInfo(ctx, thrift.ToGetFailoverInfoRequest(request))
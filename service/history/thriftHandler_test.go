@@ -113,6 +113,18 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, replicator.GetReplicationMessagesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetReplicationReadiness", func(t *testing.T) {
+		h.EXPECT().GetReplicationReadiness(ctx, &types.HistoryGetReplicationReadinessRequest{}).Return(&types.GetReplicationReadinessResponse{}, internalErr).Times(1)
+		resp, err := th.GetReplicationReadiness(ctx, &hist.GetReplicationReadinessRequest{})
+		assert.Equal(t, replicator.GetReplicationReadinessResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("GetReplicationStatus", func(t *testing.T) {
+		h.EXPECT().GetReplicationStatus(ctx, &types.HistoryGetReplicationStatusRequest{}).Return(&types.GetReplicationStatusResponse{}, internalErr).Times(1)
+		resp, err := th.GetReplicationStatus(ctx, &hist.GetReplicationStatusRequest{})
+		assert.Equal(t, replicator.GetReplicationStatusResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("MergeDLQMessages", func(t *testing.T) {
 		h.EXPECT().MergeDLQMessages(ctx, &types.MergeDLQMessagesRequest{}).Return(&types.MergeDLQMessagesResponse{}, internalErr).Times(1)
 		resp, err := th.MergeDLQMessages(ctx, &replicator.MergeDLQMessagesRequest{})
@@ -188,6 +200,11 @@ func TestThriftHandler(t *testing.T) {
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("OperatePendingActivity", func(t *testing.T) {
+		h.EXPECT().OperatePendingActivity(ctx, &types.HistoryOperatePendingActivityRequest{}).Return(internalErr).Times(1)
+		err := th.OperatePendingActivity(ctx, &hist.OperatePendingActivityRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RefreshWorkflowTasks", func(t *testing.T) {
 		h.EXPECT().RefreshWorkflowTasks(ctx, &types.HistoryRefreshWorkflowTasksRequest{}).Return(internalErr).Times(1)
 		err := th.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{})
@@ -299,4 +316,14 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.PauseWorkflowExecution(ctx, &hist.PauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpauseWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.UnpauseWorkflowExecution(ctx, &hist.UnpauseWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
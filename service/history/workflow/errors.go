@@ -33,6 +33,9 @@ var (
 	ErrMaxAttemptsExceeded = errors.New("maximum attempts exceeded to update history")
 	// ErrActivityTaskNotFound is the error to indicate activity task could be duplicate and activity already completed
 	ErrActivityTaskNotFound = &types.EntityNotExistsError{Message: "activity task not found"}
+	// ErrWorkflowPaused is the error to indicate the task is not dispatched because the workflow is paused,
+	// matching drops the task and unpausing the workflow generates the task again
+	ErrWorkflowPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
 	// ErrNotExists is the error to indicate workflow doesn't exist
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
@@ -96,6 +96,27 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
+// FenceLease invalidates the range of this host when the task list is handed off, by leasing the
+// task list once more and moving the range ID past it. Writes which are still in flight with the old
+// range ID fail, so the new owner does not race with them. This does not free the task list: the
+// lease is only the range ID, there is no owner or expiry to clear, and the new owner always takes
+// the task list by leasing it again. It is a no-op if another host has already taken the task list.
+func (db *taskListDB) FenceLease() error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.LeaseTaskList(context.Background(), &persistence.LeaseTaskListRequest{
+		DomainID:     db.domainID,
+		TaskList:     db.taskListName,
+		TaskType:     db.taskType,
+		TaskListKind: db.taskListKind,
+		RangeID:      db.rangeID,
+	})
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		return nil
+	}
+	return err
+}
+
 // UpdateState updates the taskList state with the given value
 func (db *taskListDB) UpdateState(ackLevel int64) error {
 	db.Lock()
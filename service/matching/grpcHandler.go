@@ -63,6 +63,11 @@ func (g grpcHandler) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) ReleaseOutstandingTask(ctx context.Context, request *matchingv1.ReleaseOutstandingTaskRequest) (*matchingv1.ReleaseOutstandingTaskResponse, error) {
+	err := g.h.ReleaseOutstandingTask(ctx, proto.ToMatchingReleaseOutstandingTaskRequest(request))
+	return &matchingv1.ReleaseOutstandingTaskResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(ctx, proto.ToMatchingDescribeTaskListRequest(request))
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
@@ -73,6 +78,11 @@ func (g grpcHandler) ListTaskListPartitions(ctx context.Context, request *matchi
 	return proto.FromMatchingListTaskListPartitionsResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) MoveTaskList(ctx context.Context, request *matchingv1.MoveTaskListRequest) (*matchingv1.MoveTaskListResponse, error) {
+	response, err := g.h.MoveTaskList(ctx, proto.ToMatchingMoveTaskListRequest(request))
+	return proto.FromMatchingMoveTaskListResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) GetTaskListsByDomain(ctx context.Context, request *matchingv1.GetTaskListsByDomainRequest) (*matchingv1.GetTaskListsByDomainResponse, error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, proto.ToMatchingGetTaskListsByDomainRequest(request))
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
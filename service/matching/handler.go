@@ -46,8 +46,10 @@ type (
 		AddActivityTask(context.Context, *types.AddActivityTaskRequest) error
 		AddDecisionTask(context.Context, *types.AddDecisionTaskRequest) error
 		CancelOutstandingPoll(context.Context, *types.CancelOutstandingPollRequest) error
+		ReleaseOutstandingTask(context.Context, *types.ReleaseOutstandingTaskRequest) error
 		DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
+		MoveTaskList(context.Context, *types.MatchingMoveTaskListRequest) (*types.MoveTaskListResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
@@ -111,6 +113,7 @@ func NewHandler(
 
 // Start starts the handler
 func (h *handlerImpl) Start() {
+	h.engine.Start()
 	h.startWG.Done()
 }
 
@@ -380,6 +383,31 @@ func (h *handlerImpl) CancelOutstandingPoll(ctx context.Context,
 	return hCtx.handleErr(err)
 }
 
+// ReleaseOutstandingTask is used to release the outstanding task slot of a completed activity task
+func (h *handlerImpl) ReleaseOutstandingTask(ctx context.Context,
+	request *types.ReleaseOutstandingTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingReleaseOutstandingTaskScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow(quotas.Info{
+		Domain: domainName,
+	})
+
+	err := h.engine.ReleaseOutstandingTask(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
 // it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
@@ -439,6 +467,34 @@ func (h *handlerImpl) ListTaskListPartitions(
 	return response, hCtx.handleErr(err)
 }
 
+// MoveTaskList moves a batch of backlog tasks of a task list to another task list
+func (h *handlerImpl) MoveTaskList(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListRequest,
+) (resp *types.MoveTaskListResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetMoveRequest().GetSourceTaskList(),
+		metrics.MatchingMoveTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(quotas.Info{
+		Domain: domainName,
+	}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.MoveTaskList(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 // GetTaskListsByDomain returns information about partitions for a taskList
 func (h *handlerImpl) GetTaskListsByDomain(
 	ctx context.Context,
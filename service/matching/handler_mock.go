@@ -139,6 +139,20 @@ func (mr *MockHandlerMockRecorder) CancelOutstandingPoll(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockHandler)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// ReleaseOutstandingTask mocks base method
+func (m *MockHandler) ReleaseOutstandingTask(arg0 context.Context, arg1 *types.ReleaseOutstandingTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseOutstandingTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseOutstandingTask indicates an expected call of ReleaseOutstandingTask
+func (mr *MockHandlerMockRecorder) ReleaseOutstandingTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutstandingTask", reflect.TypeOf((*MockHandler)(nil).ReleaseOutstandingTask), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +183,21 @@ func (mr *MockHandlerMockRecorder) ListTaskListPartitions(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskListPartitions), arg0, arg1)
 }
 
+// MoveTaskList mocks base method
+func (m *MockHandler) MoveTaskList(arg0 context.Context, arg1 *types.MatchingMoveTaskListRequest) (*types.MoveTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskList indicates an expected call of MoveTaskList
+func (mr *MockHandlerMockRecorder) MoveTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskList", reflect.TypeOf((*MockHandler)(nil).MoveTaskList), arg0, arg1)
+}
+
 // GetTaskListsByDomain mocks base method
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -43,6 +43,9 @@ type TaskMatcher struct {
 	// are interested in queryTasks but not others. Example is when domain is
 	// not active in a cluster
 	queryTaskC chan *InternalTask
+	// synchronous task channel to match backlog tasks with the task mover, which must
+	// not take sync matched or forwarded tasks as they are not persisted in this task list
+	backlogTaskC chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
 
@@ -70,6 +73,7 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 		fwdr:          fwdr,
 		taskC:         make(chan *InternalTask),
 		queryTaskC:    make(chan *InternalTask),
+		backlogTaskC:  make(chan *InternalTask),
 		numPartitions: config.NumReadPartitions,
 	}
 }
@@ -207,7 +211,7 @@ func (tm *TaskMatcher) OfferQuery(ctx context.Context, task *InternalTask) (*typ
 	}
 }
 
-// MustOffer blocks until a consumer is found to handle this task
+// MustOffer blocks until a consumer (a poller or the task mover) is found to handle this backlog task
 // Returns error only when context is canceled or the ratelimit is set to zero (allow nothing)
 // The passed in context MUST NOT have a deadline associated with it
 func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error {
@@ -220,6 +224,8 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 	select {
 	case tm.taskC <- task:
 		return nil
+	case tm.backlogTaskC <- task:
+		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
@@ -230,6 +236,8 @@ forLoop:
 		select {
 		case tm.taskC <- task:
 			return nil
+		case tm.backlogTaskC <- task:
+			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
 			err := tm.fwdr.ForwardTask(childCtx, task)
@@ -243,6 +251,9 @@ forLoop:
 				case tm.taskC <- task:
 					cancel()
 					return nil
+				case tm.backlogTaskC <- task:
+					cancel()
+					return nil
 				case <-childCtx.Done():
 				case <-ctx.Done():
 					cancel()
@@ -277,6 +288,13 @@ func (tm *TaskMatcher) Poll(ctx context.Context) (*InternalTask, error) {
 	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
 }
 
+// PollBacklog blocks until a task read from the backlog of this task list is found or context deadline
+// is exceeded. Unlike Poll, sync matched, forwarded and query tasks are never returned.
+// Returns ErrNoTasks when context deadline is exceeded
+func (tm *TaskMatcher) PollBacklog(ctx context.Context) (*InternalTask, error) {
+	return tm.poll(ctx, tm.backlogTaskC, nil)
+}
+
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error) {
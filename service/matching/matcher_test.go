@@ -317,6 +317,40 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestPollBacklog() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	// sync matched tasks are not taken from the backlog
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		pollCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := t.matcher.PollBacklog(pollCtx)
+		t.Equal(ErrNoTasks, err)
+	})
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.False(syncMatch)
+
+	wait = ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := t.matcher.PollBacklog(ctx)
+		if t.NoError(err) {
+			task.finish(nil)
+		}
+	})
+	task = newInternalTask(t.newTaskInfo(), nil, types.TaskSourceDbBacklog, "", false)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	err = t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 
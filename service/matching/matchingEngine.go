@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/service"
@@ -82,6 +83,9 @@ type (
 		domainCache          cache.DomainCache
 		versionChecker       client.VersionChecker
 		membershipResolver   membership.Resolver
+		membershipUpdateCh   chan *membership.ChangedEvent
+		shutdownCh           chan struct{}
+		stopped              int32
 	}
 )
 
@@ -96,11 +100,21 @@ var (
 	// ErrNoTasks is exported temporarily for integration test
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
+	// errShuttingDown is returned for task lists which are not loaded when the engine is stopping,
+	// it is retried by the caller which eventually reaches the new owner of the task list
+	errShuttingDown = &types.InternalServiceError{Message: "matching engine is shutting down"}
+	// errTaskListNotOwned is returned for task lists which are not loaded and are owned by another host,
+	// it is retried by the caller which eventually reaches the owner once the membership ring converges
+	errTaskListNotOwned = &types.InternalServiceError{Message: "task list is not owned by this host"}
 
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
 )
 
+const (
+	matchingEngineMembershipUpdateListenerName = "MatchingEngine"
+)
+
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
 
 // NewEngine creates an instance of matching engine
@@ -127,20 +141,86 @@ func NewEngine(taskManager persistence.TaskManager,
 		domainCache:          domainCache,
 		versionChecker:       client.NewVersionChecker(),
 		membershipResolver:   resolver,
+		membershipUpdateCh:   make(chan *membership.ChangedEvent, 10),
+		shutdownCh:           make(chan struct{}),
 	}
 }
 
 func (e *matchingEngineImpl) Start() {
-	// As task lists are initialized lazily nothing is done on startup at this point.
+	// As task lists are initialized lazily, the engine only needs to watch for ownership changes on startup
+	if err := e.membershipResolver.Subscribe(service.Matching, matchingEngineMembershipUpdateListenerName, e.membershipUpdateCh); err != nil {
+		e.logger.Error("Failed to subscribe to membership updates", tag.Error(err))
+		return
+	}
+	go e.membershipUpdateLoop()
 }
 
 func (e *matchingEngineImpl) Stop() {
-	// Executes Stop() on each task list outside of lock
+	if !atomic.CompareAndSwapInt32(&e.stopped, 0, 1) {
+		return
+	}
+	close(e.shutdownCh)
+	if err := e.membershipResolver.Unsubscribe(service.Matching, matchingEngineMembershipUpdateListenerName); err != nil {
+		e.logger.Error("Failed to unsubscribe from membership updates", tag.Error(err))
+	}
+
+	// The host is already evicted from the membership ring at this point, so all task lists
+	// are handed off to their new owners. Executes Handoff() on each task list outside of lock
+	taskLists := e.getTaskLists(math.MaxInt32)
+	for _, l := range taskLists {
+		l.Handoff()
+	}
+	e.logger.Info("Handed off all task lists", tag.Counter(len(taskLists)))
+}
+
+func (e *matchingEngineImpl) membershipUpdateLoop() {
+	for {
+		select {
+		case <-e.shutdownCh:
+			return
+		case <-e.membershipUpdateCh:
+			e.handoffTaskListsNotOwned()
+		}
+	}
+}
+
+// handoffTaskListsNotOwned hands off the task lists which are now owned by other hosts, so
+// their new owners can take over before the pollers connected to this host time out
+func (e *matchingEngineImpl) handoffTaskListsNotOwned() {
 	for _, l := range e.getTaskLists(math.MaxInt32) {
-		l.Stop()
+		tlMgr, ok := l.(*taskListManagerImpl)
+		if !ok {
+			continue
+		}
+		if err := e.checkTaskListOwnership(tlMgr.taskListID); err != errTaskListNotOwned {
+			continue
+		}
+		e.logger.Info("Task list is owned by another host, handing off",
+			tag.WorkflowTaskListName(tlMgr.taskListID.name),
+			tag.WorkflowTaskListType(tlMgr.taskListID.taskType),
+			tag.WorkflowDomainID(tlMgr.taskListID.domainID))
+		l.Handoff()
 	}
 }
 
+// checkTaskListOwnership returns errTaskListNotOwned if the membership ring assigns the task list to
+// another host. Task lists are only loaded by their owner, so a task list which was handed off is not
+// taken back by a request which was routed to this host before the ring changed.
+func (e *matchingEngineImpl) checkTaskListOwnership(taskList *taskListID) error {
+	self, err := e.membershipResolver.WhoAmI()
+	if err != nil {
+		return err
+	}
+	owner, err := e.membershipResolver.Lookup(service.Matching, taskList.name)
+	if err != nil {
+		return err
+	}
+	if owner.Identity() != self.Identity() {
+		return errTaskListNotOwned
+	}
+	return nil
+}
+
 func (e *matchingEngineImpl) getTaskLists(maxCount int) (lists []taskListManager) {
 	e.taskListsLock.RLock()
 	defer e.taskListsLock.RUnlock()
@@ -180,12 +260,20 @@ func (e *matchingEngineImpl) getTaskListManager(
 		return result, nil
 	}
 	e.taskListsLock.RUnlock()
+	if err := e.checkTaskListOwnership(taskList); err != nil {
+		return nil, err
+	}
 	// If it gets here, write lock and check again in case a task list is created between the two locks
 	e.taskListsLock.Lock()
 	if result, ok := e.taskLists[*taskList]; ok {
 		e.taskListsLock.Unlock()
 		return result, nil
 	}
+	if atomic.LoadInt32(&e.stopped) == 1 {
+		// do not take the task list back from the host it was handed off to
+		e.taskListsLock.Unlock()
+		return nil, errShuttingDown
+	}
 
 	// common tagged logger
 	logger := e.logger.WithTags(
@@ -502,12 +590,15 @@ pollLoop:
 		e.emitForwardedFromStats(hCtx.scope, task.isForwarded(), req.GetForwardedFrom())
 
 		if task.isStarted() {
-			// tasks received from remote are already started. So, simply forward the response
+			// tasks received from remote are already started. So, simply forward the response.
+			// The outstanding task is tracked by the partition that started it.
+			task.outstandingTasks.cancelReservation(pollerIdentity(request.GetIdentity()))
 			return task.pollForActivityResponse(), nil
 		}
 
 		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task)
 		if err != nil {
+			task.outstandingTasks.cancelReservation(pollerIdentity(request.GetIdentity()))
 			switch err.(type) {
 			case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
 				e.emitInfoOrDebugLog(
@@ -528,6 +619,11 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		task.outstandingTasks.start(
+			pollerIdentity(request.GetIdentity()),
+			outstandingTaskKey{workflowID: task.event.WorkflowID, runID: task.event.RunID, scheduleID: task.event.ScheduleID},
+			time.Duration(resp.GetScheduledEvent().GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeoutSeconds())*time.Second,
+		)
 		return e.createPollForActivityTaskResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -635,6 +731,25 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 	return nil
 }
 
+func (e *matchingEngineImpl) ReleaseOutstandingTask(
+	hCtx *handlerContext,
+	request *types.ReleaseOutstandingTaskRequest,
+) error {
+	taskList, err := newTaskListID(request.GetDomainUUID(), request.GetTaskList().GetName(), persistence.TaskListTypeActivity)
+	if err != nil {
+		return err
+	}
+
+	// the outstanding tasks are not persisted, so there is nothing to release if the task list is not loaded
+	e.taskListsLock.RLock()
+	tlMgr, ok := e.taskLists[*taskList]
+	e.taskListsLock.RUnlock()
+	if ok {
+		tlMgr.ReleaseOutstandingTask(request.GetWorkflowExecution(), request.GetScheduleID())
+	}
+	return nil
+}
+
 func (e *matchingEngineImpl) DescribeTaskList(
 	hCtx *handlerContext,
 	request *types.MatchingDescribeTaskListRequest,
@@ -657,7 +772,156 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	if response.TaskListStatus != nil && taskList.IsRoot() && tlMgr.GetTaskListKind() != types.TaskListKindSticky {
+		e.aggregatePartitionStatus(hCtx, request, taskList, response.TaskListStatus)
+	}
+	return response, nil
+}
+
+// aggregatePartitionStatus merges the status of the non-root partitions of a task list into the
+// status of its root partition, so that the caller gets a single view of the backlog and throughput
+// of the task list. Partitions that fail to respond are skipped and the result is best effort.
+func (e *matchingEngineImpl) aggregatePartitionStatus(
+	hCtx *handlerContext,
+	request *types.MatchingDescribeTaskListRequest,
+	taskList *taskListID,
+	status *types.TaskListStatus,
+) {
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return
+	}
+
+	numPartitions := e.config.NumTasklistReadPartitions(domainName, taskList.name, taskList.taskType)
+	weightedSyncMatchRatio := status.SyncMatchRatio * status.DispatchRatePerSecond
+	for i := 1; i < numPartitions; i++ {
+		partitionName := taskList.mkName(i)
+		resp, err := e.matchingClient.DescribeTaskList(hCtx.Context, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: request.GetDomainUUID(),
+			DescRequest: &types.DescribeTaskListRequest{
+				Domain:                request.DescRequest.GetDomain(),
+				TaskList:              &types.TaskList{Name: partitionName, Kind: request.DescRequest.GetTaskList().Kind},
+				TaskListType:          request.DescRequest.TaskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		if err != nil {
+			hCtx.logger.Warn("Failed to describe task list partition",
+				tag.WorkflowTaskListName(partitionName),
+				tag.Error(err))
+			continue
+		}
+
+		partitionStatus := resp.GetTaskListStatus()
+		status.BacklogCountHint += partitionStatus.GetBacklogCountHint()
+		status.BacklogAgeInSeconds = common.MaxInt64(status.BacklogAgeInSeconds, partitionStatus.GetBacklogAgeInSeconds())
+		status.DispatchRatePerSecond += partitionStatus.GetDispatchRatePerSecond()
+		weightedSyncMatchRatio += partitionStatus.GetSyncMatchRatio() * partitionStatus.GetDispatchRatePerSecond()
+	}
+
+	if status.DispatchRatePerSecond > 0 {
+		status.SyncMatchRatio = weightedSyncMatchRatio / status.DispatchRatePerSecond
+	}
+}
+
+// MoveTaskList moves a batch of tasks of the source task list partition to the target task list. When
+// called on the root partition, the other partitions are moved concurrently by their owners through the
+// matching client, so each partition is always moved by the host holding its lease. The requested rps is
+// a limit for the whole task list and is split evenly between the partitions, each partition moves at
+// least one task per second. When moving a partition fails, the move is reported as successful with the
+// failure set in the response, so that the counts of the tasks that were already moved reach the caller.
+func (e *matchingEngineImpl) MoveTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingMoveTaskListRequest,
+) (*types.MoveTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	moveRequest := request.GetMoveRequest()
+	taskListType := persistence.TaskListTypeDecision
+	if moveRequest.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	if moveRequest.GetSourceTaskList().GetKind() == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Sticky task lists cannot be moved."}
+	}
+
+	taskList, err := newTaskListID(domainID, moveRequest.GetSourceTaskList().GetName(), taskListType)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	targetName, err := newTaskListName(moveRequest.GetTargetTaskList().GetName())
+	if err != nil || !targetName.IsRoot() || targetName.name == "" {
+		return nil, &types.BadRequestError{Message: "Target task list name is invalid."}
+	}
+	if targetName.name == taskList.baseName {
+		return nil, &types.BadRequestError{Message: "Source and target task list must be different."}
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		response = &types.MoveTaskListResponse{}
+	)
+	addResult := func(resp *types.MoveTaskListResponse, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		response.TasksMoved += resp.GetTasksMoved()
+		response.TasksExpired += resp.GetTasksExpired()
+		if response.Failure == "" && err != nil {
+			response.Failure = err.Error()
+		}
+		if response.Failure == "" {
+			response.Failure = resp.GetFailure()
+		}
+	}
+
+	rps := int(moveRequest.GetRPS())
+	if taskList.IsRoot() {
+		domainName, err := e.domainCache.GetDomainName(domainID)
+		if err != nil {
+			return nil, err
+		}
+		numPartitions := e.config.NumTasklistReadPartitions(domainName, taskList.name, taskListType)
+		rps = partitionMoveRPS(rps, numPartitions)
+		for i := 1; i < numPartitions; i++ {
+			partitionRequest := *moveRequest
+			partitionRequest.SourceTaskList = &types.TaskList{
+				Name: taskList.mkName(i),
+				Kind: types.TaskListKindNormal.Ptr(),
+			}
+			partitionRequest.RPS = int32(rps)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				addResult(e.matchingClient.MoveTaskList(hCtx.Context, &types.MatchingMoveTaskListRequest{
+					DomainUUID:  domainID,
+					MoveRequest: &partitionRequest,
+				}))
+			}()
+		}
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		addResult(nil, err)
+	} else {
+		addResult(tlMgr.MoveTasks(hCtx.Context, moveTasksParams{
+			targetTaskList: targetName.name,
+			batchSize:      int(moveRequest.GetBatchSize()),
+			rps:            rps,
+			dryRun:         moveRequest.GetDryRun(),
+		}))
+	}
+	wg.Wait()
+	return response, nil
+}
+
+// partitionMoveRPS splits the rps limit of a task list move between its partitions
+func partitionMoveRPS(rps int, numPartitions int) int {
+	if rps <= 0 || numPartitions <= 1 {
+		return rps
+	}
+	return common.MaxInt(rps/numPartitions, 1)
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -858,6 +1122,10 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 		ActivityID:      attributes.GetActivityID(),
 		ActivityType:    attributes.GetActivityType().GetName(),
 	}
+	if task.outstandingTasks != nil {
+		token.TaskList = task.outstandingTasks.taskListName
+		token.TaskListDomainID = task.outstandingTasks.domainID
+	}
 
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
 	response.Attempt = int32(token.ScheduleAttempt)
@@ -25,6 +25,7 @@ import "github.com/uber/cadence/common/types"
 type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
 	Engine interface {
+		Start()
 		Stop()
 		AddDecisionTask(hCtx *handlerContext, request *types.AddDecisionTaskRequest) (syncMatch bool, err error)
 		AddActivityTask(hCtx *handlerContext, request *types.AddActivityTaskRequest) (syncMatch bool, err error)
@@ -33,8 +34,10 @@ type (
 		QueryWorkflow(hCtx *handlerContext, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(hCtx *handlerContext, request *types.MatchingRespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(hCtx *handlerContext, request *types.CancelOutstandingPollRequest) error
+		ReleaseOutstandingTask(hCtx *handlerContext, request *types.ReleaseOutstandingTaskRequest) error
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		MoveTaskList(hCtx *handlerContext, request *types.MatchingMoveTaskListRequest) (*types.MoveTaskListResponse, error)
 	}
 )
@@ -40,10 +40,12 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 
 	"github.com/davecgh/go-spew/spew"
@@ -59,6 +61,9 @@ type (
 		controller        *gomock.Controller
 		mockHistoryClient *history.MockClient
 		mockDomainCache   *cache.MockDomainCache
+		mockMembership    *membership.MockResolver
+		// notOwnedTaskLists are the task lists which the membership ring assigns to another host
+		notOwnedTaskLists map[string]struct{}
 
 		matchingEngine       *matchingEngineImpl
 		taskManager          *testTaskManager
@@ -110,6 +115,21 @@ func (s *matchingEngineSuite) SetupTest() {
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
 	s.mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry(matchingTestDomainName), nil).AnyTimes()
 	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(matchingTestDomainName, nil).AnyTimes()
+	s.mockMembership = membership.NewMockResolver(s.controller)
+	s.mockMembership.EXPECT().Subscribe(service.Matching, matchingEngineMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockMembership.EXPECT().Unsubscribe(service.Matching, matchingEngineMembershipUpdateListenerName).Return(nil).AnyTimes()
+	self := membership.NewHostInfo("self")
+	s.notOwnedTaskLists = make(map[string]struct{})
+	s.mockMembership.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+	s.mockMembership.EXPECT().Lookup(service.Matching, gomock.Any()).DoAndReturn(
+		func(_ string, key string) (*membership.HostInfo, error) {
+			s.Lock()
+			defer s.Unlock()
+			if _, ok := s.notOwnedTaskLists[key]; ok {
+				return membership.NewHostInfo("other"), nil
+			}
+			return self, nil
+		}).AnyTimes()
 	s.handlerContext = newHandlerContext(
 		context.Background(),
 		matchingTestDomainName,
@@ -132,7 +152,9 @@ func (s *matchingEngineSuite) TearDownTest() {
 func (s *matchingEngineSuite) newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager,
 ) *matchingEngineImpl {
-	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockDomainCache)
+	e := newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockDomainCache)
+	e.membershipResolver = s.mockMembership
+	return e
 }
 
 func newMatchingEngine(
@@ -140,14 +162,16 @@ func newMatchingEngine(
 	logger log.Logger, mockDomainCache cache.DomainCache,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
-		taskManager:     taskMgr,
-		historyService:  mockHistoryClient,
-		taskLists:       make(map[taskListID]taskListManager),
-		logger:          logger,
-		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		config:          config,
-		domainCache:     mockDomainCache,
+		taskManager:        taskMgr,
+		historyService:     mockHistoryClient,
+		taskLists:          make(map[taskListID]taskListManager),
+		logger:             logger,
+		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.Matching),
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		config:             config,
+		domainCache:        mockDomainCache,
+		membershipUpdateCh: make(chan *membership.ChangedEvent, 10),
+		shutdownCh:         make(chan struct{}),
 	}
 }
 
@@ -555,6 +579,85 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getTaskListManager(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestOutstandingTasksPerPoller() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.MaxOutstandingTasksPerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+
+	domainID := "domainId"
+	workflowExecution := types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"}
+	taskList := &types.TaskList{Name: "makeToast"}
+	tlID := newTestTaskListID(domainID, taskList.Name, persistence.TaskListTypeActivity)
+
+	for _, scheduleID := range []int64{3, 6} {
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     &workflowExecution,
+			ScheduleID:                    scheduleID,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		})
+		s.NoError(err)
+	}
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *types.RecordActivityTaskStartedRequest, option ...yarpc.CallOption) (*types.RecordActivityTaskStartedResponse, error) {
+			return &types.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduleID, 0,
+					&types.ScheduleActivityTaskDecisionAttributes{
+						ActivityID:                    "activityId1",
+						TaskList:                      &types.TaskList{Name: taskList.Name},
+						ActivityType:                  &types.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+					}),
+				StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			}, nil
+		}).Times(2)
+
+	poll := func() *types.PollForActivityTaskResponse {
+		result, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+			DomainUUID: domainID,
+			PollRequest: &types.PollForActivityTaskRequest{
+				TaskList: taskList,
+				Identity: "host1",
+			},
+		})
+		s.NoError(err)
+		return result
+	}
+
+	var result *types.PollForActivityTaskResponse
+	for result = poll(); len(result.TaskToken) == 0; result = poll() {
+	}
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
+	s.NoError(err)
+	s.Equal(taskList.Name, token.TaskList)
+	s.Equal(domainID, token.TaskListDomainID)
+
+	// host1 holds as many tasks as it is allowed to, so the second task is not dispatched to it
+	s.Empty(poll().TaskToken)
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, nil)
+	s.NoError(err)
+	pollers := tlMgr.GetAllPollerInfo()
+	s.Equal(1, len(pollers))
+	s.Equal(int64(1), pollers[0].GetOutstandingTasks())
+
+	// the task is released once frontend sees it completed
+	s.NoError(s.matchingEngine.ReleaseOutstandingTask(s.handlerContext, &types.ReleaseOutstandingTaskRequest{
+		DomainUUID:        domainID,
+		TaskList:          &types.TaskList{Name: token.TaskList},
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        token.ScheduleID,
+	}))
+	s.Zero(tlMgr.GetAllPollerInfo()[0].GetOutstandingTasks())
+	for result = poll(); len(result.TaskToken) == 0; result = poll() {
+	}
+	s.Equal(int64(1), tlMgr.GetAllPollerInfo()[0].GetOutstandingTasks())
+}
+
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	// Set a short long poll expiration so we don't have to wait too long for 0 throttling cases
 	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(50 * time.Millisecond)
@@ -1596,6 +1699,60 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 	}
 }
 
+func (s *matchingEngineSuite) TestHandoffTaskListsNotOwned() {
+	domainID := uuid.New()
+	tlKind := types.TaskListKindNormal
+	owned := newTestTaskListID(domainID, "owned", persistence.TaskListTypeActivity)
+	moved := newTestTaskListID(domainID, "moved", persistence.TaskListTypeActivity)
+	_, err := s.matchingEngine.getTaskListManager(owned, &tlKind)
+	s.NoError(err)
+	movedMgr, err := s.matchingEngine.getTaskListManager(moved, &tlKind)
+	s.NoError(err)
+	rangeID := movedMgr.(*taskListManagerImpl).db.RangeID()
+
+	s.Lock()
+	s.notOwnedTaskLists["moved"] = struct{}{}
+	s.Unlock()
+	s.matchingEngine.handoffTaskListsNotOwned()
+
+	taskLists := s.matchingEngine.getTaskLists(10)
+	s.Len(taskLists, 1)
+	ownedMgr := taskLists[0].(*taskListManagerImpl)
+	s.Equal(*owned, *ownedMgr.taskListID)
+	s.Equal(int32(0), atomic.LoadInt32(&ownedMgr.stopped))
+	// the lease of the moved task list is released, so writes still in flight from this host fail
+	s.Equal(rangeID+1, s.taskManager.getTaskListManager(moved).rangeID)
+
+	// requests routed before the ring changed do not load the moved task list again
+	_, err = s.matchingEngine.getTaskListManager(moved, &tlKind)
+	s.Equal(errTaskListNotOwned, err)
+	s.Len(s.matchingEngine.getTaskLists(10), 1)
+
+	// the task list is loaded again once it moves back to this host
+	s.Lock()
+	delete(s.notOwnedTaskLists, "moved")
+	s.Unlock()
+	_, err = s.matchingEngine.getTaskListManager(moved, &tlKind)
+	s.NoError(err)
+	s.Len(s.matchingEngine.getTaskLists(10), 2)
+}
+
+func (s *matchingEngineSuite) TestGetTaskListManagerAfterStop() {
+	domainID := uuid.New()
+	tlKind := types.TaskListKindNormal
+	loaded := newTestTaskListID(domainID, "loaded", persistence.TaskListTypeActivity)
+	mgr, err := s.matchingEngine.getTaskListManager(loaded, &tlKind)
+	s.NoError(err)
+
+	s.matchingEngine.Stop()
+	s.Equal(int32(1), atomic.LoadInt32(&mgr.(*taskListManagerImpl).stopped))
+	s.Empty(s.matchingEngine.getTaskLists(10))
+
+	// task lists are not loaded again once they are handed off
+	_, err = s.matchingEngine.getTaskListManager(loaded, &tlKind)
+	s.Equal(errShuttingDown, err)
+}
+
 func (s *matchingEngineSuite) setupRecordActivityTaskStartedMock(tlName string) {
 	activityTypeName := "activity1"
 	activityID := "activityId1"
@@ -0,0 +1,238 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+type (
+	// outstandingTasks keeps track of the activity tasks started by each poller identity so that a
+	// single worker host cannot take most of the tasks of a task list. A task is outstanding from the
+	// time it is marked as started until frontend releases it after the worker completes, fails or
+	// cancels it, or until its start to close timeout elapses when matching is not told about it.
+	// A poll takes a slot before it starts waiting for a task, so that concurrent polls of the same
+	// identity cannot go over the limit, and gives it back if it does not start a task.
+	outstandingTasks struct {
+		sync.Mutex
+		// domainID and taskListName identify the task list partition, they are put in the task tokens
+		// so that frontend can release the tasks started by this partition
+		domainID     string
+		taskListName string
+		timeSource   clock.TimeSource
+		// tasks maps each poller identity to the expiry time of its outstanding tasks
+		tasks      map[pollerIdentity]map[outstandingTaskKey]time.Time
+		identities map[outstandingTaskKey]pollerIdentity
+		// reserved is the number of slots taken by the polls of each poller identity which have not
+		// started a task yet
+		reserved map[pollerIdentity]int
+		// released is closed and replaced whenever an outstanding task is released
+		released chan struct{}
+	}
+
+	outstandingTaskKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+)
+
+func newOutstandingTasks(domainID string, taskListName string, timeSource clock.TimeSource) *outstandingTasks {
+	return &outstandingTasks{
+		domainID:     domainID,
+		taskListName: taskListName,
+		timeSource:   timeSource,
+		tasks:        make(map[pollerIdentity]map[outstandingTaskKey]time.Time),
+		identities:   make(map[outstandingTaskKey]pollerIdentity),
+		reserved:     make(map[pollerIdentity]int),
+		released:     make(chan struct{}),
+	}
+}
+
+// waitForSlot blocks until the poller identity holds less than limit outstanding tasks and reserved
+// slots, then reserves a slot for the poll. Returns an error if the context is done before that.
+// The reserved slot must be either turned into a task by start or given back by cancelReservation.
+func (o *outstandingTasks) waitForSlot(
+	ctx context.Context,
+	identity pollerIdentity,
+	limit int,
+) error {
+	for {
+		o.Lock()
+		tasks := o.tasksLocked(identity)
+		if len(tasks)+o.reserved[identity] < limit {
+			o.reserved[identity]++
+			o.Unlock()
+			return nil
+		}
+		released := o.released
+		var timer *time.Timer
+		var timerC <-chan time.Time
+		if len(tasks) > 0 {
+			// all the slots may be reserved by other polls, which have no expiry
+			timer = time.NewTimer(o.nextExpiryLocked(tasks).Sub(o.timeSource.Now()))
+			timerC = timer.C
+		}
+		o.Unlock()
+
+		// wait for a slot to be released or for the first started task to time out
+		var err error
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-released:
+		case <-timerC:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// cancelReservation gives back the slot reserved by waitForSlot when the poll does not start a task
+func (o *outstandingTasks) cancelReservation(identity pollerIdentity) {
+	if o == nil {
+		return
+	}
+	o.Lock()
+	defer o.Unlock()
+	if o.unreserveLocked(identity) {
+		o.notifyReleasedLocked()
+	}
+}
+
+// start turns the slot reserved by waitForSlot into a task started by the poller identity, the task
+// is outstanding until it is released or until the start to close timeout elapses
+func (o *outstandingTasks) start(
+	identity pollerIdentity,
+	key outstandingTaskKey,
+	startToCloseTimeout time.Duration,
+) {
+	if o == nil {
+		return
+	}
+	o.Lock()
+	defer o.Unlock()
+	o.unreserveLocked(identity)
+	if startToCloseTimeout <= 0 {
+		o.notifyReleasedLocked()
+		return
+	}
+	o.removeLocked(key)
+	tasks, ok := o.tasks[identity]
+	if !ok {
+		tasks = make(map[outstandingTaskKey]time.Time)
+		o.tasks[identity] = tasks
+	}
+	tasks[key] = o.timeSource.Now().Add(startToCloseTimeout)
+	o.identities[key] = identity
+}
+
+// release frees the outstanding task, it is a no-op if the task is not tracked
+func (o *outstandingTasks) release(key outstandingTaskKey) {
+	o.Lock()
+	defer o.Unlock()
+	if o.removeLocked(key) {
+		o.notifyReleasedLocked()
+	}
+}
+
+// counts returns the number of outstanding tasks of each poller identity
+func (o *outstandingTasks) counts() map[pollerIdentity]int64 {
+	o.Lock()
+	defer o.Unlock()
+
+	result := make(map[pollerIdentity]int64, len(o.tasks))
+	for identity := range o.tasks {
+		if count := len(o.tasksLocked(identity)); count > 0 {
+			result[identity] = int64(count)
+		}
+	}
+	return result
+}
+
+// tasksLocked returns the outstanding tasks of the poller identity after dropping the expired ones
+func (o *outstandingTasks) tasksLocked(identity pollerIdentity) map[outstandingTaskKey]time.Time {
+	tasks := o.tasks[identity]
+	now := o.timeSource.Now()
+	for key, expiry := range tasks {
+		if !expiry.After(now) {
+			delete(tasks, key)
+			delete(o.identities, key)
+		}
+	}
+	if len(tasks) == 0 {
+		// drop poller identities which no longer hold tasks so they do not leak
+		delete(o.tasks, identity)
+	}
+	return tasks
+}
+
+func (o *outstandingTasks) nextExpiryLocked(tasks map[outstandingTaskKey]time.Time) time.Time {
+	var next time.Time
+	for _, expiry := range tasks {
+		if next.IsZero() || expiry.Before(next) {
+			next = expiry
+		}
+	}
+	return next
+}
+
+func (o *outstandingTasks) unreserveLocked(identity pollerIdentity) bool {
+	count, ok := o.reserved[identity]
+	if !ok {
+		return false
+	}
+	if count <= 1 {
+		delete(o.reserved, identity)
+	} else {
+		o.reserved[identity] = count - 1
+	}
+	return true
+}
+
+// notifyReleasedLocked wakes up the polls waiting for a slot
+func (o *outstandingTasks) notifyReleasedLocked() {
+	close(o.released)
+	o.released = make(chan struct{})
+}
+
+func (o *outstandingTasks) removeLocked(key outstandingTaskKey) bool {
+	identity, ok := o.identities[key]
+	if !ok {
+		return false
+	}
+	delete(o.identities, key)
+	if tasks, ok := o.tasks[identity]; ok {
+		delete(tasks, key)
+		if len(tasks) == 0 {
+			delete(o.tasks, identity)
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+func TestOutstandingTasks(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	tasks := newOutstandingTasks("domain", "tl", timeSource)
+	ctx := context.Background()
+	key1 := outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 1}
+	key2 := outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 2}
+
+	// waiting polls reserve their slots, so concurrent polls cannot go over the limit
+	require.NoError(t, tasks.waitForSlot(ctx, "host1", 2))
+	require.NoError(t, tasks.waitForSlot(ctx, "host1", 2))
+	require.Empty(t, tasks.counts())
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, tasks.waitForSlot(timeoutCtx, "host1", 2))
+
+	// a poll which does not start a task gives its slot back
+	waited := make(chan error, 1)
+	go func() {
+		waited <- tasks.waitForSlot(ctx, "host1", 2)
+	}()
+	tasks.cancelReservation("host1")
+	select {
+	case err := <-waited:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "poll was not unblocked after the reservation was canceled")
+	}
+
+	tasks.start("host1", key1, time.Minute)
+	tasks.start("host1", key2, time.Hour)
+	require.NoError(t, tasks.waitForSlot(ctx, "host2", 2))
+	tasks.start("host2", outstandingTaskKey{workflowID: "wid2", runID: "rid2", scheduleID: 1}, time.Minute)
+	require.Equal(t, map[pollerIdentity]int64{"host1": 2, "host2": 1}, tasks.counts())
+	require.Empty(t, tasks.reserved)
+
+	// host1 is at its limit until one of its tasks is released
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, tasks.waitForSlot(timeoutCtx, "host1", 2))
+
+	go func() {
+		waited <- tasks.waitForSlot(ctx, "host1", 2)
+	}()
+	tasks.release(key1)
+	select {
+	case err := <-waited:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "poll was not unblocked after release")
+	}
+	tasks.cancelReservation("host1")
+	require.Equal(t, map[pollerIdentity]int64{"host1": 1, "host2": 1}, tasks.counts())
+
+	// releasing an unknown task is a no-op
+	tasks.release(key1)
+	require.Equal(t, int64(1), tasks.counts()["host1"])
+
+	// tasks without a start to close timeout are not tracked but still free the reserved slot
+	require.NoError(t, tasks.waitForSlot(ctx, "host1", 2))
+	tasks.start("host1", key1, 0)
+	require.Equal(t, int64(1), tasks.counts()["host1"])
+	require.Empty(t, tasks.reserved)
+
+	// tasks which are never released stay outstanding until they time out
+	timeSource.Update(now.Add(time.Minute))
+	require.Equal(t, map[pollerIdentity]int64{"host1": 1}, tasks.counts())
+	timeSource.Update(now.Add(time.Hour))
+	require.Empty(t, tasks.counts())
+	require.Empty(t, tasks.identities)
+
+	// nil trackers are used when there is no limit
+	var noLimit *outstandingTasks
+	noLimit.start("host1", key1, time.Minute)
+	noLimit.cancelReservation("host1")
+}
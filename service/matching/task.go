@@ -54,6 +54,9 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		// outstandingTasks is non-nil when the poller identity that got this task has a limit on outstanding tasks,
+		// the task is recorded in it once it is started
+		outstandingTasks *outstandingTasks
 	}
 )
 
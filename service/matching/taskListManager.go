@@ -31,11 +31,13 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -61,6 +63,9 @@ type (
 	taskListManager interface {
 		Start() error
 		Stop()
+		// Handoff stops the task list manager when the task list is moved to another host,
+		// leaving the task list in a state the new owner can pick up without delays
+		Handoff()
 		// AddTask adds a task to the task list. This method will first attempt a synchronous
 		// match with a poller. When that fails, task will be written to database and later
 		// asynchronously matched with a poller
@@ -76,9 +81,12 @@ type (
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskID string, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		CancelPoller(pollerID string)
+		ReleaseOutstandingTask(execution *types.WorkflowExecution, scheduleID int64)
 		GetAllPollerInfo() []*types.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
+		// MoveTasks moves a batch of tasks of this task list to another task list
+		MoveTasks(ctx context.Context, params moveTasksParams) (*types.MoveTaskListResponse, error)
 		String() string
 		GetTaskListKind() types.TaskListKind
 	}
@@ -95,6 +103,7 @@ type (
 		taskGC           *taskGC
 		taskAckManager   messaging.AckManager // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher         // for matching a task producer with a poller
+		stats            *taskListStats       // tracks backlog age, dispatch rate and sync match ratio
 		domainCache      cache.DomainCache
 		logger           log.Logger
 		metricsClient    metrics.Client
@@ -102,6 +111,8 @@ type (
 		metricScopeValue atomic.Value // domain/tasklist tagged metric scope
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
+		// outstandingTasks tracks started tasks per poller identity to enforce MaxOutstandingTasksPerPoller
+		outstandingTasks *outstandingTasks
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 		// particular tasklist.  PollerID generated by frontend is used as the key and
 		// CancelFunc is the value.  This is used to cancel the context to unblock any
@@ -109,6 +120,11 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// moveLock serializes task moves of the task list, the rate limiter of the moves is kept between
+		// the batches of a move, so that the rps limit holds across them
+		moveLock        sync.Mutex
+		moveRateLimiter *quotas.RateLimiter
+		moveRPS         int
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -157,6 +173,8 @@ func newTaskListManager(
 		taskAckManager:      messaging.NewAckManager(e.logger),
 		taskGC:              newTaskGC(db, taskListConfig),
 		config:              taskListConfig,
+		stats:               newTaskListStats(clock.NewRealTimeSource()),
+		outstandingTasks:    newOutstandingTasks(taskList.domainID, taskList.name, clock.NewRealTimeSource()),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 	}
 
@@ -210,14 +228,52 @@ func (c *taskListManagerImpl) Start() error {
 
 // Stops pump that fills up taskBuffer from persistence.
 func (c *taskListManagerImpl) Stop() {
-	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+	if !c.stop() {
 		return
 	}
+	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
+}
+
+// Handoff stops the task list manager, flushes the ack level and fences the lease, so the new owner
+// of the task list does not dispatch tasks which were already dispatched by this host and does not
+// race with writes of this host. Outstanding polls, including the ones forwarded from child partitions,
+// return as soon as the manager is stopped, so pollers reconnect to the new owner right away instead
+// of waiting for their long poll to expire.
+func (c *taskListManagerImpl) Handoff() {
+	startTime := time.Now()
+	if !c.stop() {
+		return
+	}
+	scope := c.metricScope()
+	if err := c.taskReader.persistAckLevel(); err != nil {
+		// the new owner may have already taken over the task list, it redelivers the tasks
+		// after the persisted ack level and history drops the ones which are already started
+		scope.IncCounter(metrics.HandoffFailurePerTaskListCounter)
+		c.logger.Warn("Failed to persist ack level on task list handoff", tag.Error(err))
+	} else if err := c.db.FenceLease(); err != nil {
+		scope.IncCounter(metrics.HandoffFailurePerTaskListCounter)
+		c.logger.Warn("Failed to fence lease on task list handoff", tag.Error(err))
+	}
+	scope.RecordTimer(metrics.HandoffLatencyPerTaskList, time.Since(startTime))
+	c.logger.Info("Task list manager state changed", tag.LifeCycleHandedOff)
+}
+
+func (c *taskListManagerImpl) stop() bool {
+	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+		return false
+	}
 	close(c.shutdownCh)
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
 	c.engine.removeTaskListManager(c.taskListID)
-	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
+	// return an empty task to outstanding polls right away, e.g. when the task list moves
+	// to another host, so pollers can reconnect to the new owner
+	c.outstandingPollsLock.Lock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+	c.outstandingPollsLock.Unlock()
+	return true
 }
 
 // AddTask adds a task to the task list. This method will first attempt a synchronous
@@ -271,6 +327,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			tag.WorkflowTaskListType(c.taskListID.taskType),
 		)
 	} else {
+		c.stats.recordTaskAdded(syncMatch)
 		c.taskReader.Signal()
 	}
 
@@ -356,12 +413,43 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		return c.matcher.PollForQuery(childCtx)
 	}
 
-	return c.matcher.Poll(childCtx)
+	var outstandingTasks *outstandingTasks
+	if limit := c.config.MaxOutstandingTasksPerPoller(); limit > 0 && identity != "" &&
+		c.taskListID.taskType == persistence.TaskListTypeActivity {
+		// decision tasks are not limited, they are short lived and mostly dispatched through sticky task lists
+		if err := c.outstandingTasks.waitForSlot(childCtx, pollerIdentity(identity), limit); err != nil {
+			c.metricScope().IncCounter(metrics.OutstandingLimitPerTaskListCounter)
+			return nil, ErrNoTasks
+		}
+		outstandingTasks = c.outstandingTasks
+	}
+
+	task, err := c.matcher.Poll(childCtx)
+	if err != nil {
+		outstandingTasks.cancelReservation(pollerIdentity(identity))
+		return nil, err
+	}
+	task.outstandingTasks = outstandingTasks
+	return task, nil
+}
+
+// ReleaseOutstandingTask frees the outstanding task of the poller which started the activity task
+func (c *taskListManagerImpl) ReleaseOutstandingTask(execution *types.WorkflowExecution, scheduleID int64) {
+	c.outstandingTasks.release(outstandingTaskKey{
+		workflowID: execution.GetWorkflowID(),
+		runID:      execution.GetRunID(),
+		scheduleID: scheduleID,
+	})
 }
 
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
 func (c *taskListManagerImpl) GetAllPollerInfo() []*types.PollerInfo {
-	return c.pollerHistory.getAllPollerInfo()
+	pollers := c.pollerHistory.getAllPollerInfo()
+	counts := c.outstandingTasks.counts()
+	for _, poller := range pollers {
+		poller.OutstandingTasks = counts[pollerIdentity(poller.Identity)]
+	}
+	return pollers
 }
 
 func (c *taskListManagerImpl) CancelPoller(pollerID string) {
@@ -377,7 +465,8 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock) along with the backlog age, dispatch
+// rate and sync match ratio observed by this partition.
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse {
 	response := &types.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskListStatus {
@@ -385,6 +474,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	}
 
 	taskIDBlock := c.rangeIDToTaskIDBlock(c.db.RangeID())
+	stats := c.stats.snapshot()
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
@@ -394,6 +484,9 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: taskIDBlock.start,
 			EndID:   taskIDBlock.end,
 		},
+		BacklogAgeInSeconds:   int64(stats.backlogAge.Seconds()),
+		DispatchRatePerSecond: stats.dispatchRatePerSecond,
+		SyncMatchRatio:        stats.syncMatchRatio,
 	}
 
 	return response
@@ -452,6 +545,7 @@ func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error
 		c.taskReader.Signal()
 	}
 	ackLevel := c.taskAckManager.AckItem(task.TaskID)
+	c.stats.recordBacklogTaskCompleted(task.TaskID)
 	c.taskGC.Run(ackLevel)
 }
 
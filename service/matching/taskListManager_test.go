@@ -208,6 +208,15 @@ func TestDescribeTaskList(t *testing.T) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 	require.Equal(t, int64(1), taskIDBlock.GetStartID())
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndID())
+	require.Zero(t, taskListStatus.GetBacklogAgeInSeconds())
+	require.Zero(t, taskListStatus.GetSyncMatchRatio())
+
+	tlm.stats.recordTaskAdded(true)
+	tlm.stats.recordBacklogTaskRead(startTaskID, time.Now().Add(-time.Minute))
+	taskListStatus = tlm.DescribeTaskList(includeTaskStatus).GetTaskListStatus()
+	require.True(t, taskListStatus.GetBacklogAgeInSeconds() >= 60)
+	require.True(t, taskListStatus.GetDispatchRatePerSecond() > 0)
+	require.Equal(t, 1.0, taskListStatus.GetSyncMatchRatio())
 
 	// Add a poller and complete all tasks
 	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
@@ -234,6 +243,66 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
 }
 
+func TestGetTaskOutstandingTasksLimit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.MaxOutstandingTasksPerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+
+	// host1 already holds a started task, so its poll is not matched with any task
+	execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	tlm.outstandingTasks.start("host1", outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 5}, time.Minute)
+
+	ctx := context.WithValue(context.Background(), identityKey, "host1")
+	_, err := tlm.GetTask(ctx, nil)
+	require.Equal(t, ErrNoTasks, err)
+
+	pollers := tlm.DescribeTaskList(false).GetPollers()
+	require.Equal(t, 1, len(pollers))
+	require.Equal(t, "host1", pollers[0].GetIdentity())
+	require.Equal(t, int64(1), pollers[0].GetOutstandingTasks())
+
+	tlm.ReleaseOutstandingTask(execution, 5)
+	require.Zero(t, tlm.DescribeTaskList(false).GetPollers()[0].GetOutstandingTasks())
+}
+
+func TestHandoff(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+
+	// tasks up to 10 were already dispatched by this host
+	ackLevel := int64(10)
+	tlm.taskAckManager.SetAckLevel(ackLevel)
+
+	pollErrC := make(chan error, 1)
+	go func() {
+		ctx := context.WithValue(context.Background(), pollerIDKey, "poller1")
+		_, err := tlm.GetTask(ctx, nil)
+		pollErrC <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	tlm.Handoff()
+	require.Equal(t, int32(1), atomic.LoadInt32(&tlm.stopped))
+	select {
+	case err := <-pollErrC:
+		require.Equal(t, ErrNoTasks, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "outstanding poll was not returned on handoff")
+	}
+
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, ackLevel, tm.getTaskListManager(tlm.taskListID).ackLevel)
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
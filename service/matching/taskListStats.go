@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// taskListStatsWindow is the time window over which dispatch rate and sync match ratio are computed
+	taskListStatsWindow = time.Minute
+	// taskListStatsBucketSize is the granularity of the buckets that make up the window
+	taskListStatsBucketSize = time.Second
+)
+
+type (
+	// taskListStats keeps track of throughput and backlog health of a single task list partition.
+	// Counters are kept in per second buckets over a sliding window so that rates reflect
+	// recent traffic rather than the lifetime of the task list manager.
+	taskListStats struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		buckets    []taskListStatsBucket
+		// createTime of the backlog tasks read from persistence that are not acked yet, keyed by taskID.
+		// Tasks are read in taskID order, so the oldest of them is the oldest task in the backlog.
+		backlogTasks map[int64]time.Time
+	}
+
+	taskListStatsBucket struct {
+		startTime   time.Time
+		added       int64
+		syncMatched int64
+		dispatched  int64
+	}
+
+	// taskListStatsSnapshot is a point in time view of taskListStats
+	taskListStatsSnapshot struct {
+		backlogAge            time.Duration
+		dispatchRatePerSecond float64
+		syncMatchRatio        float64
+	}
+)
+
+func newTaskListStats(timeSource clock.TimeSource) *taskListStats {
+	return &taskListStats{
+		timeSource:   timeSource,
+		buckets:      make([]taskListStatsBucket, int(taskListStatsWindow/taskListStatsBucketSize)),
+		backlogTasks: make(map[int64]time.Time),
+	}
+}
+
+// recordTaskAdded is called when a task is added to the task list, either matched
+// synchronously with a poller (which also counts as a dispatch) or persisted to the backlog
+func (s *taskListStats) recordTaskAdded(syncMatched bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	b := s.currentBucketLocked()
+	b.added++
+	if syncMatched {
+		b.syncMatched++
+		b.dispatched++
+	}
+}
+
+// recordBacklogTaskDispatched is called when a task read from the backlog is handed to a poller
+func (s *taskListStats) recordBacklogTaskDispatched() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.currentBucketLocked().dispatched++
+}
+
+// recordBacklogTaskRead is called when a task is read from the backlog and becomes outstanding in the ack manager
+func (s *taskListStats) recordBacklogTaskRead(taskID int64, createTime time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.backlogTasks[taskID] = createTime
+}
+
+// recordBacklogTaskCompleted is called when a backlog task is acked and leaves the backlog
+func (s *taskListStats) recordBacklogTaskCompleted(taskID int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.backlogTasks, taskID)
+}
+
+func (s *taskListStats) snapshot() taskListStatsSnapshot {
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.timeSource.Now()
+	var added, syncMatched, dispatched int64
+	for _, b := range s.buckets {
+		if now.Sub(b.startTime) >= taskListStatsWindow {
+			continue
+		}
+		added += b.added
+		syncMatched += b.syncMatched
+		dispatched += b.dispatched
+	}
+
+	result := taskListStatsSnapshot{
+		dispatchRatePerSecond: float64(dispatched) / taskListStatsWindow.Seconds(),
+	}
+	if added > 0 {
+		result.syncMatchRatio = float64(syncMatched) / float64(added)
+	}
+	var oldest time.Time
+	for _, createTime := range s.backlogTasks {
+		if oldest.IsZero() || createTime.Before(oldest) {
+			oldest = createTime
+		}
+	}
+	if !oldest.IsZero() && now.After(oldest) {
+		result.backlogAge = now.Sub(oldest)
+	}
+	return result
+}
+
+func (s *taskListStats) currentBucketLocked() *taskListStatsBucket {
+	now := s.timeSource.Now().Truncate(taskListStatsBucketSize)
+	idx := int(now.Unix()) % len(s.buckets)
+	b := &s.buckets[idx]
+	if !b.startTime.Equal(now) {
+		*b = taskListStatsBucket{startTime: now}
+	}
+	return b
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+func TestTaskListStats(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	stats := newTaskListStats(timeSource)
+
+	snapshot := stats.snapshot()
+	require.Zero(t, snapshot.backlogAge)
+	require.Zero(t, snapshot.dispatchRatePerSecond)
+	require.Zero(t, snapshot.syncMatchRatio)
+
+	// 3 sync matched and 1 persisted task
+	for i := 0; i < 3; i++ {
+		stats.recordTaskAdded(true)
+	}
+	stats.recordTaskAdded(false)
+	// two backlog tasks are read, age is driven by the oldest one
+	stats.recordBacklogTaskRead(1, now.Add(-10*time.Second))
+	stats.recordBacklogTaskRead(2, now.Add(-2*time.Second))
+
+	snapshot = stats.snapshot()
+	require.Equal(t, 10*time.Second, snapshot.backlogAge)
+	require.Equal(t, 3/taskListStatsWindow.Seconds(), snapshot.dispatchRatePerSecond)
+	require.Equal(t, 0.75, snapshot.syncMatchRatio)
+
+	// the newer backlog task is dispatched and completed first, the oldest one is still pending
+	timeSource.Update(now.Add(5 * time.Second))
+	stats.recordBacklogTaskDispatched()
+	stats.recordBacklogTaskCompleted(2)
+
+	snapshot = stats.snapshot()
+	require.Equal(t, 15*time.Second, snapshot.backlogAge)
+	require.Equal(t, 4/taskListStatsWindow.Seconds(), snapshot.dispatchRatePerSecond)
+
+	stats.recordBacklogTaskCompleted(1)
+	snapshot = stats.snapshot()
+	require.Zero(t, snapshot.backlogAge)
+	require.Equal(t, 4/taskListStatsWindow.Seconds(), snapshot.dispatchRatePerSecond)
+	require.Equal(t, 0.75, snapshot.syncMatchRatio)
+
+	// everything falls out of the window
+	timeSource.Update(now.Add(taskListStatsWindow + 5*time.Second))
+	snapshot = stats.snapshot()
+	require.Zero(t, snapshot.dispatchRatePerSecond)
+	require.Zero(t, snapshot.syncMatchRatio)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultMoveTasksBatchSize = 100
+	// moveTasksIdleTimeout is how long a move waits for the next task before the batch is considered done
+	moveTasksIdleTimeout = time.Second
+)
+
+type (
+	moveTasksParams struct {
+		targetTaskList string
+		batchSize      int
+		// rps limits the number of tasks moved per second, zero means no limit
+		rps    int
+		dryRun bool
+	}
+)
+
+// MoveTasks moves up to batchSize tasks of this task list partition to the root of the target task list.
+// Tasks are taken from the matcher as they are read from the backlog, sync matched and forwarded tasks are
+// left to the pollers. They are added to the target task list through the matching client, and only then
+// completed. Ownership of the task list never leaves this host,
+// and a failure to add a task writes it back to the backlog, so tasks are never lost. The schedule to start
+// deadline of a moved task is preserved, tasks whose deadline already passed are dropped.
+//
+// Moves of the task list are serialized and share a rate limiter, which is only replaced when the rps changes,
+// so that moving a backlog batch by batch doesn't exceed the rps limit.
+//
+// In dry run mode the backlog is only read from persistence and counted.
+func (c *taskListManagerImpl) MoveTasks(ctx context.Context, params moveTasksParams) (*types.MoveTaskListResponse, error) {
+	if params.dryRun {
+		return c.countBacklogTasks(time.Now())
+	}
+
+	batchSize := params.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultMoveTasksBatchSize
+	}
+	c.moveLock.Lock()
+	defer c.moveLock.Unlock()
+	if params.rps != c.moveRPS {
+		c.moveRPS = params.rps
+		c.moveRateLimiter = nil
+		if params.rps > 0 {
+			c.moveRateLimiter = quotas.NewSimpleRateLimiter(params.rps)
+		}
+	}
+	rateLimiter := c.moveRateLimiter
+
+	response := &types.MoveTaskListResponse{}
+	for response.TasksMoved+response.TasksExpired < int64(batchSize) {
+		if rateLimiter != nil {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				// out of time for this batch, the caller moves the rest with the next one
+				return response, nil
+			}
+		}
+
+		pollCtx, cancel := c.newChildContext(ctx, moveTasksIdleTimeout, returnEmptyTaskTimeBudget)
+		task, err := c.matcher.PollBacklog(pollCtx)
+		cancel()
+		if err == ErrNoTasks {
+			return response, nil
+		}
+		if err != nil {
+			return response, err
+		}
+
+		timeout, ok := remainingScheduleToStartTimeout(task.event.TaskInfo, time.Now())
+		if !ok {
+			task.finish(nil)
+			response.TasksExpired++
+			continue
+		}
+		if err := c.addTaskToTarget(ctx, task, params.targetTaskList, timeout); err != nil {
+			task.finish(err)
+			return response, err
+		}
+		task.finish(nil)
+		response.TasksMoved++
+	}
+	return response, nil
+}
+
+func (c *taskListManagerImpl) addTaskToTarget(
+	ctx context.Context,
+	task *InternalTask,
+	targetTaskList string,
+	scheduleToStartTimeout int32,
+) error {
+	taskList := &types.TaskList{
+		Name: targetTaskList,
+		Kind: types.TaskListKindNormal.Ptr(),
+	}
+	switch c.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return c.engine.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    task.event.DomainID,
+			Execution:                     task.workflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    task.event.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &task.source,
+		})
+	case persistence.TaskListTypeActivity:
+		return c.engine.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.domainID,
+			SourceDomainUUID:              task.event.DomainID,
+			Execution:                     task.workflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    task.event.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &task.source,
+		})
+	default:
+		return errInvalidTaskListType
+	}
+}
+
+// countBacklogTasks reads the backlog between the ack level and the max read level from persistence
+// and counts the tasks that would be moved and the tasks that would be dropped as expired. Tasks that
+// are already dispatched but not yet acked are included in the count.
+func (c *taskListManagerImpl) countBacklogTasks(now time.Time) (*types.MoveTaskListResponse, error) {
+	response := &types.MoveTaskListResponse{}
+	readLevel := c.taskAckManager.GetAckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	for readLevel < maxReadLevel {
+		resp, err := c.db.GetTasks(readLevel, maxReadLevel, c.config.GetTasksBatchSize())
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Tasks) == 0 {
+			break
+		}
+		for _, task := range resp.Tasks {
+			readLevel = task.TaskID
+			if _, ok := remainingScheduleToStartTimeout(task, now); ok {
+				response.TasksMoved++
+			} else {
+				response.TasksExpired++
+			}
+		}
+	}
+	return response, nil
+}
+
+// remainingScheduleToStartTimeout returns the schedule to start timeout that preserves the original
+// deadline of the task, and false if the deadline already passed
+func remainingScheduleToStartTimeout(task *persistence.TaskInfo, now time.Time) (int32, bool) {
+	var deadline time.Time
+	switch {
+	case task.ScheduleToStartTimeout > 0:
+		deadline = task.CreatedTime.Add(time.Duration(task.ScheduleToStartTimeout) * time.Second)
+	case task.Expiry.After(epochStartTime):
+		deadline = task.Expiry
+	default:
+		// task never expires
+		return 0, true
+	}
+	if !deadline.After(now) {
+		return 0, false
+	}
+	return int32(math.Ceil(deadline.Sub(now).Seconds())), true
+}
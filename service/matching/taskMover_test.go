@@ -0,0 +1,343 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestMoveTaskList(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine, tm, mockClient := newTestMoveEngine(controller, defaultTestConfig())
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+	createTestTasks(t, tm, source, []int32{10, 20, 0})
+	// expire one task
+	expiredID := createTestTasks(t, tm, source, []int32{30})[0]
+	task, _ := tm.getTaskListManager(source).tasks.Get(expiredID)
+	task.(*persistence.TaskInfo).Expiry = time.Now().Add(-time.Second)
+
+	request := newTestMoveRequest(source.name, "target", 10)
+	request.MoveRequest.DryRun = true
+	resp, err := engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.Equal(t, &types.MoveTaskListResponse{TasksMoved: 3, TasksExpired: 1}, resp)
+	require.Equal(t, 4, tm.getTaskCount(source))
+
+	var timeouts []int32
+	mockClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.AddActivityTaskRequest, _ ...interface{}) error {
+			require.Equal(t, "target", req.TaskList.GetName())
+			require.Equal(t, "domain", req.GetDomainUUID())
+			timeouts = append(timeouts, req.GetScheduleToStartTimeoutSeconds())
+			return nil
+		},
+	).Times(3)
+
+	// the expired task is skipped by the task reader before it gets to the mover
+	request.MoveRequest.DryRun = false
+	resp, err = engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.Equal(t, &types.MoveTaskListResponse{TasksMoved: 3}, resp)
+	require.Len(t, timeouts, 3)
+	require.True(t, timeouts[0] <= 10 && timeouts[0] > 0)
+	require.True(t, timeouts[1] <= 20 && timeouts[1] > 10)
+	require.Zero(t, timeouts[2])
+
+	// moved tasks are completed by the owner, nothing is left to move
+	resp, err = engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.Equal(t, &types.MoveTaskListResponse{}, resp)
+}
+
+func TestMoveTaskList_BatchSize(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine, tm, mockClient := newTestMoveEngine(controller, defaultTestConfig())
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+	createTestTasks(t, tm, source, []int32{10, 10, 10})
+
+	mockClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+	resp, err := engine.MoveTaskList(newTestMoveHandlerContext(), newTestMoveRequest(source.name, "target", 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.TasksMoved)
+	resp, err = engine.MoveTaskList(newTestMoveHandlerContext(), newTestMoveRequest(source.name, "target", 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.TasksMoved)
+}
+
+func TestMoveTaskList_RateLimiterKeptBetweenBatches(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine, _, _ := newTestMoveEngine(controller, defaultTestConfig())
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+
+	request := newTestMoveRequest(source.name, "target", 10)
+	request.MoveRequest.RPS = 5
+	_, err := engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	tlMgr, err := engine.getTaskListManager(source, types.TaskListKindNormal.Ptr())
+	require.NoError(t, err)
+	rateLimiter := tlMgr.(*taskListManagerImpl).moveRateLimiter
+	require.NotNil(t, rateLimiter)
+
+	// the next batch of the move doesn't get a fresh burst
+	_, err = engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.True(t, rateLimiter == tlMgr.(*taskListManagerImpl).moveRateLimiter)
+
+	request.MoveRequest.RPS = 10
+	_, err = engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.False(t, rateLimiter == tlMgr.(*taskListManagerImpl).moveRateLimiter)
+}
+
+func TestMoveTaskList_AddTaskFailed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine, tm, mockClient := newTestMoveEngine(controller, defaultTestConfig())
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+	createTestTasks(t, tm, source, []int32{10})
+
+	addErr := errors.New("some random error")
+	mockClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(addErr).Times(1)
+	resp, err := engine.MoveTaskList(newTestMoveHandlerContext(), newTestMoveRequest(source.name, "target", 10))
+	require.NoError(t, err)
+	require.Equal(t, addErr.Error(), resp.Failure)
+	// the task is written back to the source task list
+	require.Equal(t, 1, tm.getTaskCount(source))
+}
+
+func TestMoveTaskList_Partitions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := defaultTestConfig()
+	config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	engine, _, mockClient := newTestMoveEngine(controller, config)
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+
+	for _, partition := range []string{source.mkName(1), source.mkName(2)} {
+		partition := partition
+		mockClient.EXPECT().MoveTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *types.MatchingMoveTaskListRequest, _ ...interface{}) (*types.MoveTaskListResponse, error) {
+				require.Equal(t, partition, req.MoveRequest.SourceTaskList.GetName())
+				require.Equal(t, "target", req.MoveRequest.TargetTaskList.GetName())
+				// the rps limit is shared by the partitions
+				require.Equal(t, int32(10), req.MoveRequest.GetRPS())
+				return &types.MoveTaskListResponse{TasksMoved: 2, TasksExpired: 1}, nil
+			},
+		).Times(1)
+	}
+
+	request := newTestMoveRequest(source.name, "target", 10)
+	request.MoveRequest.RPS = 30
+	resp, err := engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.NoError(t, err)
+	require.Equal(t, &types.MoveTaskListResponse{TasksMoved: 4, TasksExpired: 2}, resp)
+}
+
+func TestMoveTaskList_PartitionFailed(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := defaultTestConfig()
+	config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	engine, tm, mockClient := newTestMoveEngine(controller, config)
+	defer engine.Stop()
+	source := newTestTaskListID("domain", "source", persistence.TaskListTypeActivity)
+	createTestTasks(t, tm, source, []int32{10})
+
+	mockClient.EXPECT().MoveTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.MatchingMoveTaskListRequest, _ ...interface{}) (*types.MoveTaskListResponse, error) {
+			if req.MoveRequest.SourceTaskList.GetName() == source.mkName(1) {
+				return &types.MoveTaskListResponse{TasksMoved: 2, Failure: "some random error"}, nil
+			}
+			return &types.MoveTaskListResponse{TasksMoved: 3, TasksExpired: 1}, nil
+		},
+	).Times(2)
+	mockClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	// the failure is reported together with the tasks moved by all partitions
+	resp, err := engine.MoveTaskList(newTestMoveHandlerContext(), newTestMoveRequest(source.name, "target", 10))
+	require.NoError(t, err)
+	require.Equal(t, &types.MoveTaskListResponse{TasksMoved: 6, TasksExpired: 1, Failure: "some random error"}, resp)
+}
+
+func TestPartitionMoveRPS(t *testing.T) {
+	require.Equal(t, 0, partitionMoveRPS(0, 4))
+	require.Equal(t, 100, partitionMoveRPS(100, 1))
+	require.Equal(t, 25, partitionMoveRPS(100, 4))
+	require.Equal(t, 1, partitionMoveRPS(2, 4))
+}
+
+func TestMoveTaskList_InvalidRequest(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	engine, _, _ := newTestMoveEngine(controller, defaultTestConfig())
+	defer engine.Stop()
+
+	for _, request := range []*types.MatchingMoveTaskListRequest{
+		newTestMoveRequest("tl", "tl", 10),
+		newTestMoveRequest("/__cadence_sys/tl/1", "tl", 10),
+		newTestMoveRequest("tl", "/__cadence_sys/target/1", 10),
+		newTestMoveRequest("/__cadence_sys/tl", "target", 10),
+		newTestMoveRequest("tl", "", 10),
+	} {
+		_, err := engine.MoveTaskList(newTestMoveHandlerContext(), request)
+		require.IsType(t, &types.BadRequestError{}, err, request.MoveRequest)
+	}
+
+	request := newTestMoveRequest("tl", "target", 10)
+	request.MoveRequest.SourceTaskList.Kind = types.TaskListKindSticky.Ptr()
+	_, err := engine.MoveTaskList(newTestMoveHandlerContext(), request)
+	require.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestRemainingScheduleToStartTimeout(t *testing.T) {
+	now := time.Now()
+
+	timeout, ok := remainingScheduleToStartTimeout(&persistence.TaskInfo{
+		CreatedTime:            now.Add(-10 * time.Second),
+		ScheduleToStartTimeout: 15,
+	}, now)
+	require.True(t, ok)
+	require.Equal(t, int32(5), timeout)
+
+	timeout, ok = remainingScheduleToStartTimeout(&persistence.TaskInfo{
+		Expiry: now.Add(1500 * time.Millisecond),
+	}, now)
+	require.True(t, ok)
+	require.Equal(t, int32(2), timeout)
+
+	_, ok = remainingScheduleToStartTimeout(&persistence.TaskInfo{
+		CreatedTime:            now.Add(-10 * time.Second),
+		ScheduleToStartTimeout: 5,
+	}, now)
+	require.False(t, ok)
+
+	timeout, ok = remainingScheduleToStartTimeout(&persistence.TaskInfo{}, now)
+	require.True(t, ok)
+	require.Zero(t, timeout)
+}
+
+func newTestMoveEngine(controller *gomock.Controller, config *Config) (*matchingEngineImpl, *testTaskManager, *matching.MockClient) {
+	logger := loggerimpl.NewNopLogger()
+	tm := newTestTaskManager(logger)
+	mockDomainCache := cache.NewMockDomainCache(controller)
+	mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	mockClient := matching.NewMockClient(controller)
+	engine := newMatchingEngine(config, tm, nil, logger, mockDomainCache)
+	engine.matchingClient = mockClient
+	mockMembership := membership.NewMockResolver(controller)
+	mockMembership.EXPECT().Unsubscribe(service.Matching, matchingEngineMembershipUpdateListenerName).Return(nil).AnyTimes()
+	self := membership.NewHostInfo("self")
+	mockMembership.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+	mockMembership.EXPECT().Lookup(service.Matching, gomock.Any()).Return(self, nil).AnyTimes()
+	engine.membershipResolver = mockMembership
+	return engine, tm, mockClient
+}
+
+func newTestMoveRequest(source, target string, batchSize int32) *types.MatchingMoveTaskListRequest {
+	return &types.MatchingMoveTaskListRequest{
+		DomainUUID: "domain",
+		MoveRequest: &types.MoveTaskListRequest{
+			Domain:         "domainName",
+			SourceTaskList: &types.TaskList{Name: source},
+			TargetTaskList: &types.TaskList{Name: target},
+			TaskListType:   types.TaskListTypeActivity.Ptr(),
+			BatchSize:      batchSize,
+		},
+	}
+}
+
+func newTestMoveHandlerContext() *handlerContext {
+	return newHandlerContext(
+		context.Background(),
+		"domainName",
+		&types.TaskList{Name: "source"},
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		metrics.MatchingMoveTaskListScope,
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func createTestTasks(t *testing.T, tm *testTaskManager, id *taskListID, timeouts []int32) []int64 {
+	lease, err := tm.LeaseTaskList(context.Background(), &persistence.LeaseTaskListRequest{
+		DomainID: id.domainID,
+		TaskList: id.name,
+		TaskType: id.taskType,
+	})
+	require.NoError(t, err)
+
+	var taskIDs []int64
+	var tasks []*persistence.CreateTaskInfo
+	for _, timeout := range timeouts {
+		taskID := int64(tm.getTaskListManager(id).tasks.Size() + len(tasks) + 1)
+		taskIDs = append(taskIDs, taskID)
+		tasks = append(tasks, &persistence.CreateTaskInfo{
+			Execution: types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			Data: &persistence.TaskInfo{
+				DomainID:               id.domainID,
+				WorkflowID:             "wid",
+				RunID:                  "rid",
+				ScheduleID:             taskID,
+				ScheduleToStartTimeout: timeout,
+				CreatedTime:            time.Now(),
+			},
+			TaskID: taskID,
+		})
+	}
+	_, err = tm.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
+		TaskListInfo: lease.TaskListInfo,
+		Tasks:        tasks,
+	})
+	require.NoError(t, err)
+	return taskIDs
+}
@@ -97,6 +97,7 @@ dispatchLoop:
 			for {
 				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
 				if err == nil {
+					tr.tlMgr.stats.recordBacklogTaskDispatched()
 					break
 				}
 				if err == context.Canceled {
@@ -263,6 +264,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	if err != nil {
 		tr.logger().Fatal("critical bug when adding item to ackManager")
 	}
+	tr.tlMgr.stats.recordBacklogTaskRead(task.TaskID, task.CreatedTime)
 	for {
 		select {
 		case tr.taskBuffer <- task:
@@ -285,10 +287,19 @@ func (tr *taskReader) persistAckLevel() error {
 	// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 	// especially when task list ownership changes.
 	scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+	tr.emitBacklogStats(scope)
 
 	return tr.tlMgr.db.UpdateState(ackLevel)
 }
 
+func (tr *taskReader) emitBacklogStats(scope metrics.Scope) {
+	stats := tr.tlMgr.stats.snapshot()
+	scope.UpdateGauge(metrics.TaskBacklogPerTaskListGauge, float64(tr.tlMgr.taskAckManager.GetBacklogCount()))
+	scope.UpdateGauge(metrics.TaskBacklogAgePerTaskListGauge, stats.backlogAge.Seconds())
+	scope.UpdateGauge(metrics.TaskDispatchRatePerTaskListGauge, stats.dispatchRatePerSecond)
+	scope.UpdateGauge(metrics.SyncMatchRatioPerTaskListGauge, stats.syncMatchRatio)
+}
+
 func (tr *taskReader) isTaskAddedRecently(lastAddTime time.Time) bool {
 	return time.Now().Sub(lastAddTime) <= tr.tlMgr.config.MaxTasklistIdleTime()
 }
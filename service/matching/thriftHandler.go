@@ -72,6 +72,12 @@ func (t ThriftHandler) CancelOutstandingPoll(ctx context.Context, request *m.Can
 	return thrift.FromError(err)
 }
 
+// ReleaseOutstandingTask forwards request to the underlying handler
+func (t ThriftHandler) ReleaseOutstandingTask(ctx context.Context, request *m.ReleaseOutstandingTaskRequest) error {
+	err := t.h.ReleaseOutstandingTask(ctx, thrift.ToReleaseOutstandingTaskRequest(request))
+	return thrift.FromError(err)
+}
+
 // DescribeTaskList forwards request to the underlying handler
 func (t ThriftHandler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*s.DescribeTaskListResponse, error) {
 	response, err := t.h.DescribeTaskList(ctx, thrift.ToMatchingDescribeTaskListRequest(request))
@@ -84,6 +90,12 @@ func (t ThriftHandler) ListTaskListPartitions(ctx context.Context, request *m.Li
 	return thrift.FromListTaskListPartitionsResponse(response), thrift.FromError(err)
 }
 
+// MoveTaskList forwards request to the underlying handler
+func (t ThriftHandler) MoveTaskList(ctx context.Context, request *m.MoveTaskListRequest) (*s.MoveTaskListResponse, error) {
+	response, err := t.h.MoveTaskList(ctx, thrift.ToMatchingMoveTaskListRequest(request))
+	return thrift.FromMoveTaskListResponse(response), thrift.FromError(err)
+}
+
 // GetTaskListsByDomain forwards request to the underlying handler
 func (t ThriftHandler) GetTaskListsByDomain(ctx context.Context, request *s.GetTaskListsByDomainRequest) (*s.GetTaskListsByDomainResponse, error) {
 	response, err := t.h.GetTaskListsByDomain(ctx, thrift.ToGetTaskListsByDomainRequest(request))
@@ -65,6 +65,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.CancelOutstandingPoll(ctx, &m.CancelOutstandingPollRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReleaseOutstandingTask", func(t *testing.T) {
+		h.EXPECT().ReleaseOutstandingTask(ctx, &types.ReleaseOutstandingTaskRequest{}).Return(internalErr).Times(1)
+		err := th.ReleaseOutstandingTask(ctx, &m.ReleaseOutstandingTaskRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeTaskList", func(t *testing.T) {
 		h.EXPECT().DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{}).Return(&types.DescribeTaskListResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeTaskList(ctx, &m.DescribeTaskListRequest{})
@@ -77,6 +82,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, s.ListTaskListPartitionsResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("MoveTaskList", func(t *testing.T) {
+		h.EXPECT().MoveTaskList(ctx, &types.MatchingMoveTaskListRequest{}).Return(&types.MoveTaskListResponse{}, internalErr).Times(1)
+		resp, err := th.MoveTaskList(ctx, &m.MoveTaskListRequest{})
+		assert.Equal(t, s.MoveTaskListResponse{TasksMoved: common.Int64Ptr(0), TasksExpired: common.Int64Ptr(0), Failure: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("PollForActivityTask", func(t *testing.T) {
 		h.EXPECT().PollForActivityTask(ctx, &types.MatchingPollForActivityTaskRequest{}).Return(&types.PollForActivityTaskResponse{}, internalErr).Times(1)
 		resp, err := th.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{})
@@ -76,10 +76,16 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
+	// BatchTypeActivity is batch type for operating on a pending activity of workflows
+	BatchTypeActivity = "activity"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypePause, BatchTypeUnpause, BatchTypeActivity}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -110,6 +116,18 @@ type (
 		TargetCluster string
 	}
 
+	// PauseParams is the parameters for pausing workflow
+	PauseParams struct {
+		// this indicates whether pending activities are still dispatched while the workflow is paused
+		AllowActivities bool
+	}
+
+	// ActivityParams is the parameters for operating on a pending activity of workflow
+	ActivityParams struct {
+		// Request is applied to every workflow, its Domain and WorkflowExecution are ignored
+		Request types.OperatePendingActivityRequest
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -130,6 +148,10 @@ type (
 		SignalParams SignalParams
 		// ReplicateParams is params only for BatchTypeReplicate
 		ReplicateParams ReplicateParams
+		// PauseParams is params only for BatchTypePause
+		PauseParams PauseParams
+		// ActivityParams is params only for BatchTypeActivity
+		ActivityParams ActivityParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 		RPS int
@@ -221,9 +243,15 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeActivity:
+		if params.ActivityParams.Request.ActivityID == "" {
+			return fmt.Errorf("must provide activityID")
+		}
+		if params.ActivityParams.Request.Operation == nil {
+			return fmt.Errorf("must provide activity operation")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -441,6 +469,45 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Reason:          batchParams.Reason,
+							Identity:        BatchWFTypeName,
+							AllowActivities: batchParams.PauseParams.AllowActivities,
+						})
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+					})
+			case BatchTypeActivity:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						request := batchParams.ActivityParams.Request
+						request.Domain = batchParams.DomainName
+						request.WorkflowExecution = &types.WorkflowExecution{
+							WorkflowID: workflowID,
+							RunID:      runID,
+						}
+						request.Identity = BatchWFTypeName
+						return adminClient.OperatePendingActivity(ctx, &request)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -0,0 +1,612 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// AutoFailoverWorkflowTypeName is the workflow type name of the auto failover controller
+	AutoFailoverWorkflowTypeName = "cadence-sys-auto-failover-workflow"
+	// AutoFailoverWorkflowID is the workflow ID of the auto failover controller
+	AutoFailoverWorkflowID = "cadence-auto-failover-controller"
+	// AutoFailoverOperator is the operator recorded on failover workflows started by the auto failover controller
+	AutoFailoverOperator = "cadence-auto-failover-controller"
+	// AutoFailoverFailoverWorkflowID is the workflow ID of the failover workflows started by the auto failover
+	// controller, which is not the ID of the manual failover workflows
+	AutoFailoverFailoverWorkflowID = "cadence-auto-failover-manager"
+
+	checkClusterHealthActivityName = "cadence-sys-checkClusterHealth-activity"
+	getAutoFailoverDomainsActivity = "cadence-sys-getAutoFailoverDomains-activity"
+
+	// HealthSignalFrontendAvailability is the ratio of probe requests answered by the frontend of a cluster
+	HealthSignalFrontendAvailability = "frontend availability"
+	// HealthSignalPersistenceErrorRate is the ratio of persistence requests failed in the recent window,
+	// as reported by the frontend of a cluster
+	HealthSignalPersistenceErrorRate = "persistence error rate"
+	// HealthSignalReplicationLag is the replication lag in seconds from a cluster to the current cluster
+	HealthSignalReplicationLag = "replication lag"
+
+	// auto failover decisions
+
+	// AutoFailoverActionUnhealthy is recorded when a cluster becomes unhealthy
+	AutoFailoverActionUnhealthy = "unhealthy"
+	// AutoFailoverActionRecovered is recorded when an unhealthy cluster becomes healthy before failover
+	AutoFailoverActionRecovered = "recovered"
+	// AutoFailoverActionSkipped is recorded when a confirmed unhealthy cluster is not failed over
+	AutoFailoverActionSkipped = "skipped"
+	// AutoFailoverActionFailover is recorded when domains of a confirmed unhealthy cluster are failed over
+	AutoFailoverActionFailover = "failover"
+	// AutoFailoverActionPaused is recorded when the controller is paused
+	AutoFailoverActionPaused = "paused"
+	// AutoFailoverActionResumed is recorded when the controller is resumed
+	AutoFailoverActionResumed = "resumed"
+
+	// checks before continue as new, which keeps the history of the controller small
+	autoFailoverChecksPerRun = 500
+	// decisions kept in the state of the controller, older decisions are only in history
+	autoFailoverMaxDecisions    = 200
+	autoFailoverProbeTimeout    = 5 * time.Second
+	autoFailoverDefaultProbes   = 10
+	autoFailoverMaxLagShards    = 64
+	autoFailoverRetryInterval   = time.Minute
+	autoFailoverStartUpDelay    = 10 * time.Second
+	autoFailoverDecisionTimeout = 10 * time.Second
+
+	// max replication lag from an unhealthy cluster when worker.autoFailoverMaxReplicationLag is not set
+	autoFailoverDefaultReadinessLag = time.Minute
+)
+
+var autoFailoverWorkflowOptions = cclient.StartWorkflowOptions{
+	ID:                              AutoFailoverWorkflowID,
+	TaskList:                        TaskListName,
+	ExecutionStartToCloseTimeout:    24 * 365 * time.Hour,
+	DecisionTaskStartToCloseTimeout: autoFailoverDecisionTimeout,
+	WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+}
+
+type (
+	// AutoFailoverParams is the arg for the auto failover controller, it carries the state of the previous run
+	AutoFailoverParams struct {
+		Paused         bool
+		UnhealthySince map[string]time.Time
+		Decisions      []*AutoFailoverDecision
+	}
+
+	// AutoFailoverDecision is an audit record of the auto failover controller
+	AutoFailoverDecision struct {
+		Time           time.Time
+		Cluster        string
+		Action         string
+		Reason         string
+		Signals        []*HealthSignal `json:",omitempty"`
+		SuccessDomains []string        `json:",omitempty"`
+		FailedDomains  []string        `json:",omitempty"`
+	}
+
+	// HealthSignal is the result of one health check of a cluster
+	HealthSignal struct {
+		Name      string
+		Value     float64
+		Threshold float64
+		Healthy   bool
+	}
+
+	// ClusterHealth is the health of a cluster
+	ClusterHealth struct {
+		Cluster string
+		Healthy bool
+		Signals []*HealthSignal
+	}
+
+	// ClusterHealthResult is the result of the check cluster health activity. Only the primary cluster checks
+	// the health of the other clusters and fails over their domains, so that the clusters on both sides of a
+	// network partition don't fail the domains over to themselves. The backup cluster, when one is configured,
+	// only checks the health of the primary cluster and fails over the domains active there
+	ClusterHealthResult struct {
+		Enabled            bool
+		CurrentCluster     string
+		PrimaryCluster     string
+		BackupCluster      string
+		CheckInterval      time.Duration
+		ConfirmationWindow time.Duration
+		// MaxReadinessLag is the max replication lag from an unhealthy cluster, measured when it became unhealthy,
+		// for its domains to be failed over
+		MaxReadinessLag time.Duration
+		Clusters        []*ClusterHealth
+	}
+
+	// GetAutoFailoverDomainsParams params for the get auto failover domains activity
+	GetAutoFailoverDomainsParams struct {
+		SourceCluster string
+		TargetCluster string
+		// UnhealthySince is when the source cluster became unhealthy, the replication from the source cluster
+		// stops when it is down, so the readiness check only looks at the replication lag at that time
+		UnhealthySince time.Time
+		// MaxLag is the max replication lag of the target cluster when the source cluster became unhealthy
+		MaxLag time.Duration
+	}
+
+	// GetAutoFailoverDomainsResult is the result of the get auto failover domains activity
+	GetAutoFailoverDomainsResult struct {
+		// Domains are ready to fail over to the target cluster
+		Domains []string
+		// NotReadyDomains are behind the source cluster, see the GetFailoverReadiness admin API
+		NotReadyDomains []string
+	}
+
+	// AutoFailoverQueryResult is the state of the auto failover controller
+	AutoFailoverQueryResult struct {
+		State string
+		// CurrentCluster, PrimaryCluster and BackupCluster are from the last health check, only the controllers
+		// in the primary and in the backup cluster make decisions
+		CurrentCluster string
+		PrimaryCluster string
+		BackupCluster  string
+		UnhealthySince map[string]time.Time
+		Decisions      []*AutoFailoverDecision
+	}
+)
+
+// AutoFailoverWorkflow is the controller which checks the health of the other clusters, and fails over the
+// domains tagged for auto failover to the current cluster when a cluster stays unhealthy for the confirmation window.
+// The controller runs in every cluster, but only the one in the primary cluster makes decisions for the other
+// clusters. There is no quorum between the clusters: when the primary cluster cannot reach the other clusters,
+// nothing is failed over automatically. The domains active in the primary cluster are only failed over by the
+// controller of the backup cluster, when one is configured, which checks the health of the primary cluster alone.
+// Without a backup cluster they have to be failed over manually when the primary cluster is down.
+func AutoFailoverWorkflow(ctx workflow.Context, params *AutoFailoverParams) error {
+	if params == nil {
+		params = &AutoFailoverParams{}
+	}
+	if params.UnhealthySince == nil {
+		params.UnhealthySince = make(map[string]time.Time)
+	}
+	logger := workflow.GetLogger(ctx)
+
+	record := func(decision *AutoFailoverDecision) {
+		decision.Time = workflow.Now(ctx)
+		params.Decisions = append(params.Decisions, decision)
+		if len(params.Decisions) > autoFailoverMaxDecisions {
+			params.Decisions = params.Decisions[len(params.Decisions)-autoFailoverMaxDecisions:]
+		}
+		logger.Info("auto failover decision",
+			zap.String("cluster", decision.Cluster),
+			zap.String("action", decision.Action),
+			zap.String("reason", decision.Reason),
+			zap.Strings("success-domains", decision.SuccessDomains),
+			zap.Strings("failed-domains", decision.FailedDomains),
+		)
+	}
+
+	var lastHealth ClusterHealthResult
+	err := workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*AutoFailoverQueryResult, error) {
+		state := WorkflowRunning
+		if params.Paused {
+			state = WorkflowPaused
+		}
+		return &AutoFailoverQueryResult{
+			State:          state,
+			CurrentCluster: lastHealth.CurrentCluster,
+			PrimaryCluster: lastHealth.PrimaryCluster,
+			BackupCluster:  lastHealth.BackupCluster,
+			UnhealthySince: params.UnhealthySince,
+			Decisions:      params.Decisions,
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pauseCh := workflow.GetSignalChannel(ctx, PauseSignal)
+	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignal)
+	handleSignals := func() {
+		for pauseCh.ReceiveAsync(nil) {
+			if !params.Paused {
+				params.Paused = true
+				record(&AutoFailoverDecision{Action: AutoFailoverActionPaused, Reason: "pause signal"})
+			}
+		}
+		for resumeCh.ReceiveAsync(nil) {
+			if params.Paused {
+				params.Paused = false
+				record(&AutoFailoverDecision{Action: AutoFailoverActionResumed, Reason: "resume signal"})
+			}
+		}
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getAutoFailoverActivityOptions())
+	for i := 0; i < autoFailoverChecksPerRun; i++ {
+		handleSignals()
+
+		checkInterval := autoFailoverRetryInterval
+		var health ClusterHealthResult
+		if err := workflow.ExecuteActivity(ao, checkClusterHealthActivityName).Get(ctx, &health); err != nil {
+			logger.Error("failed to check cluster health", zap.Error(err))
+		} else {
+			lastHealth = health
+			if health.Enabled && health.makesDecisions() {
+				checkInterval = health.CheckInterval
+				for _, cluster := range health.Clusters {
+					handleClusterHealth(ctx, ao, params, &health, cluster, record)
+				}
+			}
+		}
+
+		// wait for the next check, signals are handled as soon as they arrive
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(workflow.NewTimer(timerCtx, checkInterval), func(workflow.Future) {})
+		selector.AddReceive(pauseCh, func(workflow.Channel, bool) {})
+		selector.AddReceive(resumeCh, func(workflow.Channel, bool) {})
+		selector.Select(ctx)
+		cancelTimer()
+	}
+
+	handleSignals()
+	return workflow.NewContinueAsNewError(ctx, AutoFailoverWorkflowTypeName, params)
+}
+
+func handleClusterHealth(
+	ctx workflow.Context,
+	ao workflow.Context,
+	params *AutoFailoverParams,
+	health *ClusterHealthResult,
+	cluster *ClusterHealth,
+	record func(*AutoFailoverDecision),
+) {
+
+	unhealthySince, unhealthy := params.UnhealthySince[cluster.Cluster]
+	if cluster.Healthy {
+		if unhealthy {
+			delete(params.UnhealthySince, cluster.Cluster)
+			record(&AutoFailoverDecision{
+				Cluster: cluster.Cluster,
+				Action:  AutoFailoverActionRecovered,
+				Reason:  "all health signals passed",
+				Signals: cluster.Signals,
+			})
+		}
+		return
+	}
+
+	now := workflow.Now(ctx)
+	if !unhealthy {
+		params.UnhealthySince[cluster.Cluster] = now
+		record(&AutoFailoverDecision{
+			Cluster: cluster.Cluster,
+			Action:  AutoFailoverActionUnhealthy,
+			Reason:  failedSignals(cluster.Signals),
+			Signals: cluster.Signals,
+		})
+		return
+	}
+	if now.Sub(unhealthySince) < health.ConfirmationWindow {
+		return
+	}
+	if params.Paused {
+		// keep the cluster unhealthy so the failover happens once the controller is resumed
+		return
+	}
+
+	// the next failover of the cluster needs another confirmation window
+	delete(params.UnhealthySince, cluster.Cluster)
+
+	var domains GetAutoFailoverDomainsResult
+	if err := workflow.ExecuteActivity(ao, getAutoFailoverDomainsActivity, &GetAutoFailoverDomainsParams{
+		SourceCluster:  cluster.Cluster,
+		TargetCluster:  health.CurrentCluster,
+		UnhealthySince: unhealthySince,
+		MaxLag:         health.MaxReadinessLag,
+	}).Get(ctx, &domains); err != nil {
+		record(&AutoFailoverDecision{
+			Cluster: cluster.Cluster,
+			Action:  AutoFailoverActionSkipped,
+			Reason:  fmt.Sprintf("failed to get domains: %v", err),
+			Signals: cluster.Signals,
+		})
+		return
+	}
+	if len(domains.Domains) == 0 {
+		reason := "no domains tagged for auto failover are active in the cluster"
+		if len(domains.NotReadyDomains) != 0 {
+			reason = "no domains are ready for failover"
+		}
+		record(&AutoFailoverDecision{
+			Cluster:       cluster.Cluster,
+			Action:        AutoFailoverActionSkipped,
+			Reason:        reason,
+			Signals:       cluster.Signals,
+			FailedDomains: domains.NotReadyDomains,
+		})
+		return
+	}
+
+	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:                   AutoFailoverFailoverWorkflowID,
+		TaskList:                     TaskListName,
+		ExecutionStartToCloseTimeout: 24 * time.Hour,
+		TaskStartToCloseTimeout:      autoFailoverDecisionTimeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		Memo:                         map[string]interface{}{common.MemoKeyForOperator: AutoFailoverOperator},
+	})
+	var result FailoverResult
+	if err := workflow.ExecuteChildWorkflow(childCtx, FailoverWorkflowTypeName, &FailoverParams{
+		TargetCluster: health.CurrentCluster,
+		SourceCluster: cluster.Cluster,
+		Domains:       domains.Domains,
+	}).Get(ctx, &result); err != nil {
+		record(&AutoFailoverDecision{
+			Cluster:       cluster.Cluster,
+			Action:        AutoFailoverActionSkipped,
+			Reason:        fmt.Sprintf("failover workflow failed: %v", err),
+			Signals:       cluster.Signals,
+			FailedDomains: append(domains.Domains, domains.NotReadyDomains...),
+		})
+		return
+	}
+	record(&AutoFailoverDecision{
+		Cluster:        cluster.Cluster,
+		Action:         AutoFailoverActionFailover,
+		Reason:         fmt.Sprintf("unhealthy since %v: %v", unhealthySince.Format(time.RFC3339), failedSignals(cluster.Signals)),
+		Signals:        cluster.Signals,
+		SuccessDomains: result.SuccessDomains,
+		FailedDomains:  append(result.FailedDomains, domains.NotReadyDomains...),
+	})
+}
+
+// makesDecisions returns true if the controller of the current cluster fails over domains
+func (r *ClusterHealthResult) makesDecisions() bool {
+	return r.CurrentCluster == r.PrimaryCluster || (r.BackupCluster != "" && r.CurrentCluster == r.BackupCluster)
+}
+
+func failedSignals(signals []*HealthSignal) string {
+	var failed []string
+	for _, signal := range signals {
+		if !signal.Healthy {
+			failed = append(failed, fmt.Sprintf("%v is %.2f, threshold %.2f", signal.Name, signal.Value, signal.Threshold))
+		}
+	}
+	return strings.Join(failed, ", ")
+}
+
+func getAutoFailoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    5 * time.Minute,
+	}
+}
+
+// CheckClusterHealthActivity checks the health signals of all other enabled clusters in the primary cluster, and
+// of the primary cluster in the backup cluster. The checks are skipped in the other clusters
+func CheckClusterHealthActivity(ctx context.Context) (*ClusterHealthResult, error) {
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	cfg := manager.cfg
+	currentCluster := cfg.ClusterMetadata.GetCurrentClusterName()
+	result := &ClusterHealthResult{
+		Enabled:            cfg.EnableAutoFailover(),
+		CurrentCluster:     currentCluster,
+		PrimaryCluster:     cfg.ClusterMetadata.GetPrimaryClusterName(),
+		BackupCluster:      cfg.AutoFailoverBackupCluster(),
+		CheckInterval:      cfg.AutoFailoverCheckInterval(),
+		ConfirmationWindow: cfg.AutoFailoverConfirmationWindow(),
+		MaxReadinessLag:    cfg.AutoFailoverMaxReplicationLag(),
+	}
+	if result.MaxReadinessLag <= 0 {
+		result.MaxReadinessLag = autoFailoverDefaultReadinessLag
+	}
+	if !result.Enabled || !result.makesDecisions() {
+		return result, nil
+	}
+
+	var clusterNames []string
+	for clusterName, info := range cfg.ClusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || clusterName == currentCluster {
+			continue
+		}
+		// the backup cluster only takes over the domains of the primary cluster
+		if currentCluster == result.PrimaryCluster || clusterName == result.PrimaryCluster {
+			clusterNames = append(clusterNames, clusterName)
+		}
+	}
+	sort.Strings(clusterNames)
+
+	for _, clusterName := range clusterNames {
+		health, err := checkClusterHealth(ctx, manager, clusterName)
+		if err != nil {
+			return nil, err
+		}
+		result.Clusters = append(result.Clusters, health)
+	}
+	return result, nil
+}
+
+func checkClusterHealth(ctx context.Context, manager *FailoverManager, clusterName string) (*ClusterHealth, error) {
+	cfg := manager.cfg
+	health := &ClusterHealth{
+		Cluster: clusterName,
+		Healthy: true,
+	}
+	addSignal := func(name string, value float64, threshold float64, healthy bool) {
+		health.Signals = append(health.Signals, &HealthSignal{
+			Name:      name,
+			Value:     value,
+			Threshold: threshold,
+			Healthy:   healthy,
+		})
+		health.Healthy = health.Healthy && healthy
+	}
+
+	probeCount := cfg.AutoFailoverProbeCount()
+	if probeCount <= 0 {
+		probeCount = autoFailoverDefaultProbes
+	}
+	answered, persistenceHealth := probeCluster(ctx, manager.clientBean.GetRemoteAdminClient(clusterName), probeCount)
+	if minAvailability := cfg.AutoFailoverMinFrontendAvailability(); minAvailability > 0 {
+		availability := float64(answered) / float64(probeCount)
+		addSignal(HealthSignalFrontendAvailability, availability, minAvailability, availability >= minAvailability)
+	}
+	if maxErrorRate := cfg.AutoFailoverMaxPersistenceErrorRate(); maxErrorRate > 0 && persistenceHealth.GetRequests() > 0 {
+		errorRate := float64(persistenceHealth.GetFailures()) / float64(persistenceHealth.GetRequests())
+		addSignal(HealthSignalPersistenceErrorRate, errorRate, maxErrorRate, errorRate <= maxErrorRate)
+	}
+	if maxLag := cfg.AutoFailoverMaxReplicationLag(); maxLag > 0 {
+		lag, ok, err := getReplicationLag(ctx, manager, clusterName, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			addSignal(HealthSignalReplicationLag, lag.Seconds(), maxLag.Seconds(), lag <= maxLag)
+		}
+	}
+	return health, nil
+}
+
+// probeCluster sends describe cluster requests to the frontend of a cluster and returns the number of requests
+// answered, and the persistence requests and failures of the frontend host which answered the last one
+func probeCluster(
+	ctx context.Context,
+	client admin.Client,
+	probeCount int,
+) (answered int, persistenceHealth *types.PersistenceHealth) {
+
+	for i := 0; i < probeCount; i++ {
+		probeCtx, cancel := context.WithTimeout(ctx, autoFailoverProbeTimeout)
+		resp, err := client.DescribeCluster(probeCtx)
+		cancel()
+		switch err.(type) {
+		case nil:
+			answered++
+			persistenceHealth = resp.GetPersistenceHealth()
+		case *types.BadRequestError, *types.ServiceBusyError, *types.AccessDeniedError, *types.InternalServiceError:
+			answered++
+		}
+	}
+	return answered, persistenceHealth
+}
+
+// getReplicationLag returns the max replication lag from a cluster over a sample of the shards
+func getReplicationLag(ctx context.Context, manager *FailoverManager, sourceCluster string, now time.Time) (time.Duration, bool, error) {
+	numShards := manager.cfg.NumHistoryShards
+	step := numShards / autoFailoverMaxLagShards
+	if step < 1 {
+		step = 1
+	}
+	var shardIDs []int32
+	for shardID := 0; shardID < numShards; shardID += step {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+
+	resp, err := manager.clientBean.GetHistoryClient().GetReplicationReadiness(ctx, &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      shardIDs,
+		SourceCluster: sourceCluster,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	var lag time.Duration
+	found := false
+	for _, shard := range resp.GetReadinessByShard() {
+		replicatedUpTo := shard.GetProcessorState().GetReplicatedUpToTimestamp()
+		if replicatedUpTo == 0 {
+			continue
+		}
+		found = true
+		if shardLag := now.Sub(time.Unix(0, replicatedUpTo)); shardLag > lag {
+			lag = shardLag
+		}
+	}
+	return lag, found, nil
+}
+
+// GetAutoFailoverDomainsActivity returns the domains tagged for auto failover which are active in the source cluster
+// and replicated to the target cluster, split by whether the target cluster is ready to take them over
+func GetAutoFailoverDomainsActivity(
+	ctx context.Context,
+	params *GetAutoFailoverDomainsParams,
+) (*GetAutoFailoverDomainsResult, error) {
+
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	domains, err := getAllDomains(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the readiness check measures the lag up to now, while nothing is replicated from the source cluster since it
+	// became unhealthy, so the time since then is added to the lag allowed
+	maxLag := params.MaxLag
+	if !params.UnhealthySince.IsZero() {
+		maxLag += time.Since(params.UnhealthySince)
+	}
+	maxLagInSeconds := int32(maxLag.Seconds())
+
+	res := &GetAutoFailoverDomainsResult{}
+	for _, domain := range domains {
+		if !shouldFailover(domain, params.SourceCluster) ||
+			!isDomainAutoFailover(domain) ||
+			!isDomainReplicatedTo(domain, params.TargetCluster) {
+			continue
+		}
+		domainName := domain.GetDomainInfo().GetName()
+		readiness, err := manager.clientBean.GetRemoteAdminClient(params.TargetCluster).GetFailoverReadiness(
+			ctx,
+			&types.GetFailoverReadinessRequest{
+				Domain:                     domainName,
+				MaxReplicationLagInSeconds: common.Int32Ptr(maxLagInSeconds),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if readiness.GetReady() {
+			res.Domains = append(res.Domains, domainName)
+		} else {
+			res.NotReadyDomains = append(res.NotReadyDomains, domainName)
+		}
+	}
+	return res, nil
+}
+
+func isDomainReplicatedTo(domain *types.DescribeDomainResponse, clusterName string) bool {
+	for _, cluster := range domain.GetReplicationConfiguration().GetClusters() {
+		if cluster.GetClusterName() == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+func isDomainAutoFailover(domain *types.DescribeDomainResponse) bool {
+	domainData := domain.DomainInfo.GetData()
+	return strings.ToLower(strings.TrimSpace(domainData[common.DomainDataKeyForAutoFailover])) == "true"
+}
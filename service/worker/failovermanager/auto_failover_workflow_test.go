@@ -0,0 +1,480 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type autoFailoverWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestAutoFailoverWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(autoFailoverWorkflowTestSuite))
+}
+
+func (s *autoFailoverWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(AutoFailoverWorkflow, workflow.RegisterOptions{Name: AutoFailoverWorkflowTypeName})
+	s.workflowEnv.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(CheckClusterHealthActivity, activity.RegisterOptions{Name: checkClusterHealthActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(GetAutoFailoverDomainsActivity, activity.RegisterOptions{Name: getAutoFailoverDomainsActivity})
+	s.activityEnv.RegisterActivityWithOptions(CheckClusterHealthActivity, activity.RegisterOptions{Name: checkClusterHealthActivityName})
+	s.activityEnv.RegisterActivityWithOptions(GetAutoFailoverDomainsActivity, activity.RegisterOptions{Name: getAutoFailoverDomainsActivity})
+}
+
+func (s *autoFailoverWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_FailoverAfterConfirmationWindow() {
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(s.unhealthyResult(), nil)
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.MatchedBy(func(params *GetAutoFailoverDomainsParams) bool {
+		// the readiness is checked against the replication lag when the cluster became unhealthy
+		return params.SourceCluster == "c1" && params.TargetCluster == "c2" &&
+			!params.UnhealthySince.IsZero() && params.MaxLag == time.Minute
+	})).Return(&GetAutoFailoverDomainsResult{Domains: []string{"d1"}}, nil).Once()
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.OnWorkflow(FailoverWorkflowTypeName, mock.Anything, &FailoverParams{
+		TargetCluster: "c2",
+		SourceCluster: "c1",
+		Domains:       []string{"d1"},
+	}).Return(&FailoverResult{SuccessDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Equal(WorkflowRunning, res.State)
+		s.True(len(res.Decisions) >= 4)
+		s.Equal(AutoFailoverActionUnhealthy, res.Decisions[0].Action)
+		s.Equal("c1", res.Decisions[0].Cluster)
+		s.Equal(AutoFailoverActionFailover, res.Decisions[1].Action)
+		s.Equal([]string{"d1"}, res.Decisions[1].SuccessDomains)
+		s.Equal(AutoFailoverActionUnhealthy, res.Decisions[2].Action)
+		s.Equal(AutoFailoverActionSkipped, res.Decisions[3].Action)
+	}, 10*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	_, ok := s.workflowEnv.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_NotReadyDomains() {
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(s.unhealthyResult(), nil)
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(&GetAutoFailoverDomainsResult{
+		Domains:         []string{"d1"},
+		NotReadyDomains: []string{"d2"},
+	}, nil).Once()
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(&GetAutoFailoverDomainsResult{
+		NotReadyDomains: []string{"d2"},
+	}, nil)
+	s.workflowEnv.OnWorkflow(FailoverWorkflowTypeName, mock.Anything, &FailoverParams{
+		TargetCluster: "c2",
+		SourceCluster: "c1",
+		Domains:       []string{"d1"},
+	}).Return(&FailoverResult{SuccessDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.True(len(res.Decisions) >= 4)
+		s.Equal(AutoFailoverActionFailover, res.Decisions[1].Action)
+		s.Equal([]string{"d1"}, res.Decisions[1].SuccessDomains)
+		s.Equal([]string{"d2"}, res.Decisions[1].FailedDomains)
+		s.Equal(AutoFailoverActionSkipped, res.Decisions[3].Action)
+		s.Equal("no domains are ready for failover", res.Decisions[3].Reason)
+		s.Equal([]string{"d2"}, res.Decisions[3].FailedDomains)
+	}, 10*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_NotPrimaryCluster() {
+	notPrimary := s.unhealthyResult()
+	notPrimary.PrimaryCluster = "c1"
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(notPrimary, nil)
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Empty(res.Decisions)
+		s.Empty(res.UnhealthySince)
+		s.Equal("c2", res.CurrentCluster)
+		s.Equal("c1", res.PrimaryCluster)
+	}, 10*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_BackupCluster() {
+	backup := s.unhealthyResult()
+	backup.PrimaryCluster = "c1"
+	backup.BackupCluster = "c2"
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(backup, nil)
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(&GetAutoFailoverDomainsResult{Domains: []string{"d1"}}, nil).Once()
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.OnWorkflow(FailoverWorkflowTypeName, mock.Anything, &FailoverParams{
+		TargetCluster: "c2",
+		SourceCluster: "c1",
+		Domains:       []string{"d1"},
+	}).Return(&FailoverResult{SuccessDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Equal("c2", res.BackupCluster)
+		s.Equal(AutoFailoverActionFailover, res.Decisions[1].Action)
+		s.Equal("c1", res.Decisions[1].Cluster)
+	}, 10*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_Recovered() {
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(s.unhealthyResult(), nil).Once()
+	healthy := s.unhealthyResult()
+	healthy.Clusters[0].Healthy = true
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(healthy, nil)
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Len(res.Decisions, 2)
+		s.Equal(AutoFailoverActionUnhealthy, res.Decisions[0].Action)
+		s.Equal(AutoFailoverActionRecovered, res.Decisions[1].Action)
+		s.Empty(res.UnhealthySince)
+	}, 10*time.Minute)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestWorkflow_Pause() {
+	s.workflowEnv.OnActivity(checkClusterHealthActivityName, mock.Anything).Return(s.unhealthyResult(), nil)
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(&GetAutoFailoverDomainsResult{Domains: []string{"d1"}}, nil).Once()
+	s.workflowEnv.OnActivity(getAutoFailoverDomainsActivity, mock.Anything, mock.Anything).Return(nil, nil)
+	s.workflowEnv.OnWorkflow(FailoverWorkflowTypeName, mock.Anything, mock.Anything).Return(&FailoverResult{SuccessDomains: []string{"d1"}}, nil).Once()
+
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(PauseSignal, nil)
+	}, 30*time.Second)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Equal(WorkflowPaused, res.State)
+		s.Len(res.Decisions, 2)
+		s.Equal(AutoFailoverActionUnhealthy, res.Decisions[0].Action)
+		s.Equal(AutoFailoverActionPaused, res.Decisions[1].Action)
+		s.Contains(res.UnhealthySince, "c1")
+	}, 10*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(ResumeSignal, nil)
+	}, 11*time.Minute)
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		res := s.queryState()
+		s.Equal(WorkflowRunning, res.State)
+		s.Equal(AutoFailoverActionResumed, res.Decisions[2].Action)
+		s.Equal(AutoFailoverActionFailover, res.Decisions[3].Action)
+	}, 11*time.Minute+30*time.Second)
+
+	s.workflowEnv.ExecuteWorkflow(AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestCheckClusterHealthActivity() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	clusterMetadata := cluster.GetTestClusterMetadata(true, true)
+	s.prepareActivityEnv(mockResource, Config{
+		ClusterMetadata:                     clusterMetadata,
+		NumHistoryShards:                    2,
+		EnableAutoFailover:                  dynamicconfig.GetBoolPropertyFn(true),
+		AutoFailoverCheckInterval:           dynamicconfig.GetDurationPropertyFn(time.Minute),
+		AutoFailoverConfirmationWindow:      dynamicconfig.GetDurationPropertyFn(5 * time.Minute),
+		AutoFailoverProbeCount:              dynamicconfig.GetIntPropertyFn(4),
+		AutoFailoverMinFrontendAvailability: dynamicconfig.GetFloatPropertyFn(0.5),
+		AutoFailoverMaxPersistenceErrorRate: dynamicconfig.GetFloatPropertyFn(0.5),
+		AutoFailoverMaxReplicationLag:       dynamicconfig.GetDurationPropertyFn(time.Minute),
+		AutoFailoverBackupCluster:           dynamicconfig.GetStringPropertyFn(""),
+	})
+
+	// three probes answered, the frontend reports two thirds of its persistence requests failed
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{
+		PersistenceHealth: &types.PersistenceHealth{
+			Requests:        30,
+			Failures:        20,
+			WindowInSeconds: 60,
+		},
+	}, nil).Times(1)
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(nil, &types.InternalServiceError{}).Times(2)
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(nil, context.DeadlineExceeded).Times(1)
+	mockResource.HistoryClient.EXPECT().GetReplicationReadiness(gomock.Any(), &types.HistoryGetReplicationReadinessRequest{
+		ShardIDs:      []int32{0, 1},
+		SourceCluster: cluster.TestAlternativeClusterName,
+	}).Return(&types.GetReplicationReadinessResponse{
+		ReadinessByShard: map[int32]*types.ReplicationShardReadiness{
+			0: {
+				ShardID: 0,
+				ProcessorState: &types.ReplicationProcessorState{
+					ReplicatedUpToTimestamp: common.Int64Ptr(time.Now().Add(-time.Hour).UnixNano()),
+				},
+			},
+			1: {
+				ShardID:        1,
+				ProcessorState: &types.ReplicationProcessorState{},
+			},
+		},
+	}, nil).Times(1)
+
+	actResult, err := s.activityEnv.ExecuteActivity(checkClusterHealthActivityName)
+	s.NoError(err)
+	var result ClusterHealthResult
+	s.NoError(actResult.Get(&result))
+	s.True(result.Enabled)
+	s.Equal(cluster.TestCurrentClusterName, result.CurrentCluster)
+	s.Equal(cluster.TestCurrentClusterName, result.PrimaryCluster)
+	s.Equal(time.Minute, result.CheckInterval)
+	s.Equal(5*time.Minute, result.ConfirmationWindow)
+	s.Len(result.Clusters, 1)
+	health := result.Clusters[0]
+	s.Equal(cluster.TestAlternativeClusterName, health.Cluster)
+	s.False(health.Healthy)
+	s.Len(health.Signals, 3)
+	s.Equal(HealthSignalFrontendAvailability, health.Signals[0].Name)
+	s.Equal(0.75, health.Signals[0].Value)
+	s.True(health.Signals[0].Healthy)
+	s.Equal(HealthSignalPersistenceErrorRate, health.Signals[1].Name)
+	s.InDelta(2.0/3, health.Signals[1].Value, 0.001)
+	s.False(health.Signals[1].Healthy)
+	s.Equal(HealthSignalReplicationLag, health.Signals[2].Name)
+	s.False(health.Signals[2].Healthy)
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestCheckClusterHealthActivity_Disabled() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	s.prepareActivityEnv(mockResource, Config{
+		ClusterMetadata:                cluster.GetTestClusterMetadata(true, true),
+		EnableAutoFailover:             dynamicconfig.GetBoolPropertyFn(false),
+		AutoFailoverCheckInterval:      dynamicconfig.GetDurationPropertyFn(time.Minute),
+		AutoFailoverConfirmationWindow: dynamicconfig.GetDurationPropertyFn(5 * time.Minute),
+		AutoFailoverMaxReplicationLag:  dynamicconfig.GetDurationPropertyFn(0),
+		AutoFailoverBackupCluster:      dynamicconfig.GetStringPropertyFn(""),
+	})
+
+	actResult, err := s.activityEnv.ExecuteActivity(checkClusterHealthActivityName)
+	s.NoError(err)
+	var result ClusterHealthResult
+	s.NoError(actResult.Get(&result))
+	s.False(result.Enabled)
+	s.Empty(result.Clusters)
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestCheckClusterHealthActivity_NotPrimaryCluster() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	s.prepareActivityEnv(mockResource, Config{
+		ClusterMetadata:                cluster.GetTestClusterMetadata(true, false),
+		EnableAutoFailover:             dynamicconfig.GetBoolPropertyFn(true),
+		AutoFailoverCheckInterval:      dynamicconfig.GetDurationPropertyFn(time.Minute),
+		AutoFailoverConfirmationWindow: dynamicconfig.GetDurationPropertyFn(5 * time.Minute),
+		AutoFailoverMaxReplicationLag:  dynamicconfig.GetDurationPropertyFn(0),
+		AutoFailoverBackupCluster:      dynamicconfig.GetStringPropertyFn(""),
+	})
+
+	actResult, err := s.activityEnv.ExecuteActivity(checkClusterHealthActivityName)
+	s.NoError(err)
+	var result ClusterHealthResult
+	s.NoError(actResult.Get(&result))
+	s.True(result.Enabled)
+	s.Equal(cluster.TestCurrentClusterName, result.CurrentCluster)
+	s.Equal(cluster.TestAlternativeClusterName, result.PrimaryCluster)
+	s.Empty(result.Clusters)
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestCheckClusterHealthActivity_BackupCluster() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	s.prepareActivityEnv(mockResource, Config{
+		ClusterMetadata:                     cluster.GetTestClusterMetadata(true, false),
+		EnableAutoFailover:                  dynamicconfig.GetBoolPropertyFn(true),
+		AutoFailoverCheckInterval:           dynamicconfig.GetDurationPropertyFn(time.Minute),
+		AutoFailoverConfirmationWindow:      dynamicconfig.GetDurationPropertyFn(5 * time.Minute),
+		AutoFailoverProbeCount:              dynamicconfig.GetIntPropertyFn(2),
+		AutoFailoverMinFrontendAvailability: dynamicconfig.GetFloatPropertyFn(0.5),
+		AutoFailoverMaxPersistenceErrorRate: dynamicconfig.GetFloatPropertyFn(0.5),
+		AutoFailoverMaxReplicationLag:       dynamicconfig.GetDurationPropertyFn(0),
+		AutoFailoverBackupCluster:           dynamicconfig.GetStringPropertyFn(cluster.TestCurrentClusterName),
+	})
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(nil, context.DeadlineExceeded).Times(2)
+
+	actResult, err := s.activityEnv.ExecuteActivity(checkClusterHealthActivityName)
+	s.NoError(err)
+	var result ClusterHealthResult
+	s.NoError(actResult.Get(&result))
+	s.True(result.makesDecisions())
+	s.Equal(cluster.TestCurrentClusterName, result.BackupCluster)
+	s.Equal(autoFailoverDefaultReadinessLag, result.MaxReadinessLag)
+	// the backup cluster only checks the primary cluster
+	s.Len(result.Clusters, 1)
+	s.Equal(cluster.TestAlternativeClusterName, result.Clusters[0].Cluster)
+	s.False(result.Clusters[0].Healthy)
+}
+
+func (s *autoFailoverWorkflowTestSuite) TestGetAutoFailoverDomainsActivity() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+	s.prepareActivityEnv(mockResource, Config{})
+
+	newDomain := func(name string, data map[string]string, clusters []*types.ClusterReplicationConfiguration) *types.DescribeDomainResponse {
+		return &types.DescribeDomainResponse{
+			DomainInfo: &types.DomainInfo{
+				Name: name,
+				Data: data,
+			},
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{
+				ActiveClusterName: "c1",
+				Clusters:          clusters,
+			},
+			IsGlobalDomain: true,
+		}
+	}
+	domains := &types.ListDomainsResponse{
+		Domains: []*types.DescribeDomainResponse{
+			newDomain("d1", map[string]string{
+				common.DomainDataKeyForManagedFailover: "true",
+				common.DomainDataKeyForAutoFailover:    "true",
+			}, clusters),
+			newDomain("d2", map[string]string{
+				common.DomainDataKeyForManagedFailover: "true",
+			}, clusters),
+			newDomain("d3", map[string]string{
+				common.DomainDataKeyForAutoFailover: "true",
+			}, clusters),
+			newDomain("d4", map[string]string{
+				common.DomainDataKeyForManagedFailover: "true",
+				common.DomainDataKeyForAutoFailover:    "true",
+			}, clusters[:1]),
+			newDomain("d5", map[string]string{
+				common.DomainDataKeyForManagedFailover: "true",
+				common.DomainDataKeyForAutoFailover:    "true",
+			}, clusters),
+		},
+	}
+	mockResource.FrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(domains, nil)
+	// the source cluster has been unhealthy for 10 minutes, replication stopped since then
+	checkRequest := func(domain string) func(context.Context, *types.GetFailoverReadinessRequest, ...interface{}) {
+		return func(_ context.Context, request *types.GetFailoverReadinessRequest, _ ...interface{}) {
+			s.Equal(domain, request.GetDomain())
+			s.InDelta(11*60, request.GetMaxReplicationLagInSeconds(), 1)
+		}
+	}
+	mockResource.RemoteAdminClient.EXPECT().GetFailoverReadiness(gomock.Any(), gomock.Any()).
+		Do(checkRequest("d1")).Return(&types.GetFailoverReadinessResponse{Ready: true}, nil)
+	mockResource.RemoteAdminClient.EXPECT().GetFailoverReadiness(gomock.Any(), gomock.Any()).
+		Do(checkRequest("d5")).Return(&types.GetFailoverReadinessResponse{Ready: false}, nil)
+
+	actResult, err := s.activityEnv.ExecuteActivity(getAutoFailoverDomainsActivity, &GetAutoFailoverDomainsParams{
+		SourceCluster:  "c1",
+		TargetCluster:  "c2",
+		UnhealthySince: time.Now().Add(-10 * time.Minute),
+		MaxLag:         time.Minute,
+	})
+	s.NoError(err)
+	var result GetAutoFailoverDomainsResult
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1"}, result.Domains)
+	s.Equal([]string{"d5"}, result.NotReadyDomains)
+}
+
+func (s *autoFailoverWorkflowTestSuite) unhealthyResult() *ClusterHealthResult {
+	return &ClusterHealthResult{
+		Enabled:            true,
+		CurrentCluster:     "c2",
+		PrimaryCluster:     "c2",
+		CheckInterval:      time.Minute,
+		ConfirmationWindow: 2 * time.Minute,
+		MaxReadinessLag:    time.Minute,
+		Clusters: []*ClusterHealth{
+			{
+				Cluster: "c1",
+				Healthy: false,
+				Signals: []*HealthSignal{
+					{Name: HealthSignalFrontendAvailability, Value: 0, Threshold: 0.5, Healthy: false},
+				},
+			},
+		},
+	}
+}
+
+func (s *autoFailoverWorkflowTestSuite) queryState() *AutoFailoverQueryResult {
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res AutoFailoverQueryResult
+	s.NoError(queryResult.Get(&res))
+	return &res
+}
+
+func (s *autoFailoverWorkflowTestSuite) prepareActivityEnv(mockResource *resource.Test, cfg Config) {
+	ctx := &FailoverManager{
+		cfg:        cfg,
+		svcClient:  mockResource.GetSDKClient(),
+		clientBean: mockResource.ClientBean,
+	}
+	s.activityEnv.SetTestTimeout(time.Second * 5)
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), failoverManagerContextKey, ctx),
+	})
+}
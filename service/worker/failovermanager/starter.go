@@ -26,7 +26,9 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/uber-go/tally"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/cadence/workflow"
 
@@ -37,6 +39,8 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
 )
 
 type (
@@ -46,6 +50,24 @@ type (
 		AdminOperationToken dynamicconfig.StringPropertyFn
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// NumHistoryShards is the number of history shards of this cluster
+		NumHistoryShards int
+		// EnableAutoFailover indicates if the auto failover controller workflow is started
+		EnableAutoFailover dynamicconfig.BoolPropertyFn
+		// AutoFailoverCheckInterval is the interval between two health checks
+		AutoFailoverCheckInterval dynamicconfig.DurationPropertyFn
+		// AutoFailoverConfirmationWindow is how long a cluster stays unhealthy before failover
+		AutoFailoverConfirmationWindow dynamicconfig.DurationPropertyFn
+		// AutoFailoverProbeCount is the number of frontend probe requests of a health check
+		AutoFailoverProbeCount dynamicconfig.IntPropertyFn
+		// AutoFailoverMinFrontendAvailability is the min ratio of answered probe requests
+		AutoFailoverMinFrontendAvailability dynamicconfig.FloatPropertyFn
+		// AutoFailoverMaxPersistenceErrorRate is the max ratio of persistence requests failed in the last minute
+		AutoFailoverMaxPersistenceErrorRate dynamicconfig.FloatPropertyFn
+		// AutoFailoverMaxReplicationLag is the max replication lag from a healthy cluster
+		AutoFailoverMaxReplicationLag dynamicconfig.DurationPropertyFn
+		// AutoFailoverBackupCluster is the cluster which fails over the domains active in the primary cluster
+		AutoFailoverBackupCluster dynamicconfig.StringPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -62,6 +84,8 @@ type (
 		TallyScope tally.Scope
 		// ClientBean is an instance of client.Bean for a collection of clients
 		ClientBean client.Bean
+		// Resource is the resource of the worker service
+		Resource resource.Resource
 	}
 
 	// FailoverManager of cadence worker service
@@ -73,6 +97,7 @@ type (
 		tallyScope    tally.Scope
 		logger        log.Logger
 		worker        worker.Worker
+		resource      resource.Resource
 	}
 )
 
@@ -85,6 +110,7 @@ func New(params *BootstrapParams) *FailoverManager {
 		tallyScope:    params.TallyScope,
 		logger:        params.Logger.WithTags(tag.ComponentBatcher),
 		clientBean:    params.ClientBean,
+		resource:      params.Resource,
 	}
 }
 
@@ -102,8 +128,27 @@ func (s *FailoverManager) Start() error {
 	failoverWorker.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForRebalanceActivity, activity.RegisterOptions{Name: getRebalanceDomainsActivityName})
+	failoverWorker.RegisterWorkflowWithOptions(AutoFailoverWorkflow, workflow.RegisterOptions{Name: AutoFailoverWorkflowTypeName})
+	failoverWorker.RegisterActivityWithOptions(CheckClusterHealthActivity, activity.RegisterOptions{Name: checkClusterHealthActivityName})
+	failoverWorker.RegisterActivityWithOptions(GetAutoFailoverDomainsActivity, activity.RegisterOptions{Name: getAutoFailoverDomainsActivity})
 	s.worker = failoverWorker
-	return failoverWorker.Start()
+	if err := failoverWorker.Start(); err != nil {
+		return err
+	}
+
+	if s.cfg.EnableAutoFailover() {
+		go workercommon.StartWorkflowWithRetry(AutoFailoverWorkflowTypeName, autoFailoverStartUpDelay, s.resource, func(client cclient.Client) error {
+			_, err := client.StartWorkflow(context.Background(), autoFailoverWorkflowOptions, AutoFailoverWorkflowTypeName, &AutoFailoverParams{})
+			switch err.(type) {
+			case nil, *shared.WorkflowExecutionAlreadyStartedError:
+				return nil
+			default:
+				s.logger.Error("Failed to start auto failover workflow", tag.Error(err))
+				return err
+			}
+		})
+	}
+	return nil
 }
 
 // Stop stops the worker
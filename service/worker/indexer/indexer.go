@@ -43,6 +43,8 @@ type (
 		metricsClient       metrics.Client
 		visibilityProcessor *indexProcessor
 		visibilityIndexName string
+		visibilityApp       string
+		processorName       string
 	}
 
 	// Config contains all configs for indexer
@@ -57,7 +59,8 @@ type (
 )
 
 const (
-	visibilityProcessorName = "visibility-processor"
+	visibilityProcessorName                = "visibility-processor"
+	visibilityMigrationTargetProcessorName = "visibility-migration-target-processor"
 )
 
 // NewIndexer create a new Indexer
@@ -78,15 +81,32 @@ func NewIndexer(
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
+		visibilityApp:       common.VisibilityAppName,
+		processorName:       visibilityProcessorName,
 	}
 }
 
+// NewMigrationTargetIndexer create a new Indexer which writes records of the visibility-migration-target
+// kafka application to the target store of advanced visibility migration
+func NewMigrationTargetIndexer(
+	config *Config,
+	client messaging.Client,
+	esClient es.GenericClient,
+	esConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *Indexer {
+	x := NewIndexer(config, client, esClient, esConfig, logger, metricsClient)
+	x.visibilityApp = common.VisibilityMigrationTargetAppName
+	x.processorName = visibilityMigrationTargetProcessorName
+	return x
+}
+
 // Start indexer
 func (x *Indexer) Start() error {
-	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
-	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+	x.visibilityProcessor = newIndexProcessor(x.visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
+		x.processorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
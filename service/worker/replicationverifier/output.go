@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/reconciliation/store"
+)
+
+// NewDivergenceIterator returns an iterator of the divergences written to the given blobstore pages by the verifier,
+// entities returned by the iterator are of type *Divergence
+func NewDivergenceIterator(
+	ctx context.Context,
+	client blobstore.Client,
+	keys store.Keys,
+) pagination.Iterator {
+	return pagination.NewIterator(ctx, keys.MinPage, getDivergenceFetchPageFn(client, keys))
+}
+
+func getDivergenceFetchPageFn(
+	client blobstore.Client,
+	keys store.Keys,
+) pagination.FetchFn {
+	return func(ctx context.Context, token pagination.PageToken) (pagination.Page, error) {
+		index := token.(int)
+		// same key format as the pages written by store.NewBlobstoreWriter
+		key := fmt.Sprintf("%v_%v.%v", keys.UUID, index, keys.Extension)
+		resp, err := client.Get(ctx, &blobstore.GetRequest{Key: key})
+		if err != nil {
+			return pagination.Page{}, err
+		}
+		var divergences []pagination.Entity
+		for _, p := range bytes.Split(resp.Blob.Body, store.SeparatorToken) {
+			if len(p) == 0 {
+				continue
+			}
+			divergence := &Divergence{}
+			if err := json.Unmarshal(p, divergence); err != nil {
+				return pagination.Page{}, err
+			}
+			divergences = append(divergences, divergence)
+		}
+		var nextPageToken pagination.PageToken = index + 1
+		if index+1 > keys.MaxPage {
+			nextPageToken = nil
+		}
+		return pagination.Page{
+			CurrentToken: token,
+			NextToken:    nextPageToken,
+			Entities:     divergences,
+		}, nil
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+const startUpDelay = time.Second * 10
+
+type (
+	// Config defines the configuration for the replication verifier
+	Config struct {
+		// SampleSize is the number of open and the number of closed workflows verified per domain by one run
+		SampleSize dynamicconfig.IntPropertyFnWithDomainFilter
+		// MinAge is the minimum time since a workflow was started before it is sampled
+		MinAge dynamicconfig.DurationPropertyFn
+		// EnableResend decides for which domains replication tasks are resent for fixable divergences
+		EnableResend dynamicconfig.BoolPropertyFnWithDomainFilter
+		// RPS is the rate limit of workflows verified
+		RPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap the replication verifier
+	BootstrapParams struct {
+		// Config contains the configuration for the verifier
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// ClientBean is used to sample workflows from the current cluster and to read them from all clusters
+		ClientBean client.Bean
+		// DomainCache is used to find global domains to verify
+		DomainCache cache.DomainCache
+		// ClusterMetadata is used to find the current cluster and the clusters of a domain
+		ClusterMetadata cluster.Metadata
+		// BlobstoreClient is used to write the divergences found, divergences are only logged if it is nil
+		BlobstoreClient blobstore.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource is used to start the verifier workflow
+		Resource resource.Resource
+	}
+
+	// Verifier periodically compares the history and mutable state of sampled workflows of global domains between
+	// the current cluster and the other clusters of their domain, and reports the divergences found
+	Verifier struct {
+		cfg             Config
+		svcClient       workflowserviceclient.Interface
+		clientBean      client.Bean
+		domainCache     cache.DomainCache
+		clusterMetadata cluster.Metadata
+		blobstoreClient blobstore.Client
+		serializer      persistence.PayloadSerializer
+		rateLimiter     quotas.Limiter
+		metricsClient   metrics.Client
+		tallyScope      tally.Scope
+		logger          log.Logger
+		resource        resource.Resource
+		worker          worker.Worker
+	}
+)
+
+// New returns a new instance of Verifier
+func New(params *BootstrapParams) *Verifier {
+	return &Verifier{
+		cfg:             params.Config,
+		svcClient:       params.ServiceClient,
+		clientBean:      params.ClientBean,
+		domainCache:     params.DomainCache,
+		clusterMetadata: params.ClusterMetadata,
+		blobstoreClient: params.BlobstoreClient,
+		serializer:      persistence.NewPayloadSerializer(),
+		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(params.Config.RPS())
+		}),
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentReplicationVerifier),
+		resource:      params.Resource,
+	}
+}
+
+// Start starts the worker and the verifier workflow
+func (v *Verifier) Start() error {
+	ctx := context.WithValue(context.Background(), verifierContextKey, v)
+	workerOpts := worker.Options{
+		MetricsScope:              v.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	verifierWorker := worker.New(v.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	verifierWorker.RegisterWorkflowWithOptions(VerifierWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	verifierWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	verifierWorker.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+	v.worker = verifierWorker
+	if err := verifierWorker.Start(); err != nil {
+		return err
+	}
+
+	go workercommon.StartWorkflowWithRetry(WorkflowTypeName, startUpDelay, v.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(context.Background(), verifierWorkflowOptions, WorkflowTypeName)
+		switch err.(type) {
+		case nil, *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			v.logger.Error("Failed to start replication verifier workflow", tag.Error(err))
+			return err
+		}
+	})
+	return nil
+}
+
+// Stop stops the worker
+func (v *Verifier) Stop() {
+	v.worker.Stop()
+}
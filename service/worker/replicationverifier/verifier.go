@@ -0,0 +1,284 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	// DivergenceTypeMissing is reported when a workflow does not exist in a cluster of its domain
+	DivergenceTypeMissing DivergenceType = "missing"
+	// DivergenceTypeLagging is reported when the history of a workflow in a cluster is a prefix of its history in the other cluster
+	DivergenceTypeLagging DivergenceType = "lagging"
+	// DivergenceTypeConflict is reported when the histories of a workflow in two clusters branched off from each other
+	DivergenceTypeConflict DivergenceType = "conflict"
+	// DivergenceTypeHistoryGap is reported when the history events of a workflow in a cluster do not match its version history
+	DivergenceTypeHistoryGap DivergenceType = "history_gap"
+	// DivergenceTypeChecksumMismatch is reported when the version histories of a workflow in two clusters are the same,
+	// but the checksums of their mutable states differ
+	DivergenceTypeChecksumMismatch DivergenceType = "checksum_mismatch"
+
+	rawHistoryPageSize = 1000
+)
+
+type (
+	// DivergenceType is the type of difference found between the replicated state of a workflow in two clusters
+	DivergenceType string
+
+	// Divergence is a difference in the replicated state of a workflow between the current cluster and a remote cluster,
+	// it is the entity written to the blobstore output of the verifier
+	Divergence struct {
+		Domain         string
+		DomainID       string
+		WorkflowID     string
+		RunID          string
+		Open           bool
+		Type           DivergenceType
+		CurrentCluster string
+		RemoteCluster  string
+		// LaggingCluster is the cluster replication tasks are resent to, it is only set for divergences which can be fixed by resending
+		LaggingCluster        string
+		CurrentVersionHistory *types.VersionHistory
+		RemoteVersionHistory  *types.VersionHistory
+		Details               string
+		Resent                bool
+		ResendError           string
+	}
+
+	// clusterState is the replicated state of a workflow in a cluster
+	clusterState struct {
+		cluster        string
+		versionHistory *persistence.VersionHistory
+		historyGap     string
+		checksum       checksum.Checksum
+	}
+)
+
+// Fixable returns true if the divergence can be fixed by resending replication tasks to the lagging cluster
+func (d *Divergence) Fixable() bool {
+	return d.LaggingCluster != ""
+}
+
+func (v *Verifier) verifyWorkflow(
+	ctx context.Context,
+	domain string,
+	domainID string,
+	workflow *types.WorkflowExecutionInfo,
+	remoteClusters []string,
+) ([]*Divergence, error) {
+	currentCluster := v.clusterMetadata.GetCurrentClusterName()
+	current, err := v.fetchClusterState(ctx, currentCluster, domain, workflow.Execution)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		// the workflow was deleted after it was sampled
+		return nil, nil
+	}
+
+	var divergences []*Divergence
+	for _, cluster := range remoteClusters {
+		remote, err := v.fetchClusterState(ctx, cluster, domain, workflow.Execution)
+		if err != nil {
+			return nil, err
+		}
+		divergence, err := compareClusterStates(current, remote, cluster)
+		if err != nil {
+			return nil, err
+		}
+		if divergence == nil {
+			continue
+		}
+		divergence.Domain = domain
+		divergence.DomainID = domainID
+		divergence.WorkflowID = workflow.Execution.GetWorkflowID()
+		divergence.RunID = workflow.Execution.GetRunID()
+		divergence.Open = workflow.CloseStatus == nil
+		divergences = append(divergences, divergence)
+	}
+	return divergences, nil
+}
+
+// fetchClusterState reads the history and the mutable state of a workflow from the given cluster,
+// it returns nil if the workflow does not exist in the cluster
+func (v *Verifier) fetchClusterState(
+	ctx context.Context,
+	cluster string,
+	domain string,
+	workflow *types.WorkflowExecution,
+) (*clusterState, error) {
+	adminClient := v.clientBean.GetRemoteAdminClient(cluster)
+	state := &clusterState{cluster: cluster}
+	request := &types.GetWorkflowExecutionRawHistoryV2Request{
+		Domain:          domain,
+		Execution:       workflow,
+		MaximumPageSize: rawHistoryPageSize,
+	}
+	nextEventID := common.FirstEventID
+	for {
+		response, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, request)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if state.versionHistory == nil {
+			state.versionHistory = persistence.NewVersionHistoryFromInternalType(response.VersionHistory)
+		}
+		for _, batch := range response.HistoryBatches {
+			events, err := v.serializer.DeserializeBatchEvents(persistence.NewDataBlobFromInternal(batch))
+			if err != nil {
+				return nil, err
+			}
+			for _, event := range events {
+				if state.historyGap == "" {
+					state.historyGap = checkEvent(state.versionHistory, event, nextEventID)
+				}
+				nextEventID = event.EventID + 1
+			}
+		}
+		request.NextPageToken = response.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			break
+		}
+	}
+	if state.versionHistory == nil || state.versionHistory.IsEmpty() {
+		return nil, fmt.Errorf("workflow %v has no version history in cluster %v", workflow.GetWorkflowID(), cluster)
+	}
+	lastItem, err := state.versionHistory.GetLastItem()
+	if err != nil {
+		return nil, err
+	}
+	if state.historyGap == "" && nextEventID != lastItem.EventID+1 {
+		state.historyGap = fmt.Sprintf("history ends at event %v, version history ends at event %v", nextEventID-1, lastItem.EventID)
+	}
+
+	response, err := adminClient.DescribeWorkflowExecution(ctx, &types.AdminDescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: workflow,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var mutableState persistence.WorkflowMutableState
+	if err := json.Unmarshal([]byte(response.GetMutableStateInDatabase()), &mutableState); err != nil {
+		return nil, err
+	}
+	state.checksum, err = execution.GenerateReplicationChecksum(&mutableState)
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func checkEvent(versionHistory *persistence.VersionHistory, event *types.HistoryEvent, expectedEventID int64) string {
+	if event.EventID != expectedEventID {
+		return fmt.Sprintf("expected event %v, found event %v", expectedEventID, event.EventID)
+	}
+	version, err := versionHistory.GetEventVersion(event.EventID)
+	if err != nil {
+		return fmt.Sprintf("event %v is not in version history: %v", event.EventID, err)
+	}
+	if version != event.Version {
+		return fmt.Sprintf("event %v has version %v, version history has version %v", event.EventID, event.Version, version)
+	}
+	return ""
+}
+
+// compareClusterStates returns the divergence between the state of a workflow in the current cluster and
+// in a remote cluster, or nil if the states are the same
+func compareClusterStates(current *clusterState, remote *clusterState, remoteCluster string) (*Divergence, error) {
+	divergence := &Divergence{
+		CurrentCluster:        current.cluster,
+		RemoteCluster:         remoteCluster,
+		CurrentVersionHistory: current.versionHistory.ToInternalType(),
+	}
+	if remote == nil {
+		divergence.Type = DivergenceTypeMissing
+		divergence.LaggingCluster = remoteCluster
+		return divergence, nil
+	}
+	divergence.RemoteVersionHistory = remote.versionHistory.ToInternalType()
+
+	switch {
+	case current.historyGap != "":
+		divergence.Type = DivergenceTypeHistoryGap
+		divergence.Details = fmt.Sprintf("cluster %v: %v", current.cluster, current.historyGap)
+		return divergence, nil
+	case remote.historyGap != "":
+		divergence.Type = DivergenceTypeHistoryGap
+		divergence.Details = fmt.Sprintf("cluster %v: %v", remote.cluster, remote.historyGap)
+		return divergence, nil
+	}
+
+	currentLastItem, err := current.versionHistory.GetLastItem()
+	if err != nil {
+		return nil, err
+	}
+	remoteLastItem, err := remote.versionHistory.GetLastItem()
+	if err != nil {
+		return nil, err
+	}
+	if currentLastItem.Equals(remoteLastItem) {
+		if !checksumEquals(current.checksum, remote.checksum) {
+			divergence.Type = DivergenceTypeChecksumMismatch
+			return divergence, nil
+		}
+		return nil, nil
+	}
+
+	lcaItem, err := current.versionHistory.FindLCAItem(remote.versionHistory)
+	if err != nil {
+		divergence.Type = DivergenceTypeConflict
+		divergence.Details = err.Error()
+		return divergence, nil
+	}
+	switch {
+	case lcaItem.Equals(remoteLastItem):
+		divergence.Type = DivergenceTypeLagging
+		divergence.LaggingCluster = remoteCluster
+	case lcaItem.Equals(currentLastItem):
+		divergence.Type = DivergenceTypeLagging
+		divergence.LaggingCluster = current.cluster
+	default:
+		divergence.Type = DivergenceTypeConflict
+		divergence.Details = fmt.Sprintf("histories branched off after event %v version %v", lcaItem.EventID, lcaItem.Version)
+	}
+	return divergence, nil
+}
+
+func checksumEquals(a checksum.Checksum, b checksum.Checksum) bool {
+	return a.Version == b.Version && a.Flavor == b.Flavor && bytes.Equal(a.Value, b.Value)
+}
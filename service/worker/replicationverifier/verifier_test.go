@@ -0,0 +1,186 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestClusterState(cluster string, checksumValue byte, items ...*types.VersionHistoryItem) *clusterState {
+	return &clusterState{
+		cluster:        cluster,
+		versionHistory: persistence.NewVersionHistoryFromInternalType(&types.VersionHistory{Items: items}),
+		checksum:       checksum.Checksum{Version: 1, Flavor: checksum.FlavorIEEECRC32OverThriftBinary, Value: []byte{checksumValue}},
+	}
+}
+
+func TestCompareClusterStates(t *testing.T) {
+	current := cluster.TestCurrentClusterName
+	remote := cluster.TestAlternativeClusterName
+	tests := map[string]struct {
+		current        *clusterState
+		remote         *clusterState
+		divergenceType DivergenceType
+		laggingCluster string
+	}{
+		"same state": {
+			current: newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+			remote:  newTestClusterState(remote, 1, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+		},
+		"missing": {
+			current:        newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+			divergenceType: DivergenceTypeMissing,
+			laggingCluster: remote,
+		},
+		"remote lagging": {
+			current:        newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 5, Version: 1}, &types.VersionHistoryItem{EventID: 10, Version: 2}),
+			remote:         newTestClusterState(remote, 2, &types.VersionHistoryItem{EventID: 5, Version: 1}),
+			divergenceType: DivergenceTypeLagging,
+			laggingCluster: remote,
+		},
+		"current lagging": {
+			current:        newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 5, Version: 1}),
+			remote:         newTestClusterState(remote, 2, &types.VersionHistoryItem{EventID: 8, Version: 1}),
+			divergenceType: DivergenceTypeLagging,
+			laggingCluster: current,
+		},
+		"conflict": {
+			current:        newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 5, Version: 1}, &types.VersionHistoryItem{EventID: 10, Version: 2}),
+			remote:         newTestClusterState(remote, 2, &types.VersionHistoryItem{EventID: 5, Version: 1}, &types.VersionHistoryItem{EventID: 8, Version: 12}),
+			divergenceType: DivergenceTypeConflict,
+		},
+		"history gap": {
+			current: newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+			remote: func() *clusterState {
+				state := newTestClusterState(remote, 1, &types.VersionHistoryItem{EventID: 10, Version: 1})
+				state.historyGap = "expected event 5, found event 6"
+				return state
+			}(),
+			divergenceType: DivergenceTypeHistoryGap,
+		},
+		"checksum mismatch": {
+			current:        newTestClusterState(current, 1, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+			remote:         newTestClusterState(remote, 2, &types.VersionHistoryItem{EventID: 10, Version: 1}),
+			divergenceType: DivergenceTypeChecksumMismatch,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			divergence, err := compareClusterStates(test.current, test.remote, remote)
+			require.NoError(t, err)
+			if test.divergenceType == "" {
+				require.Nil(t, divergence)
+				return
+			}
+			require.NotNil(t, divergence)
+			require.Equal(t, test.divergenceType, divergence.Type)
+			require.Equal(t, test.laggingCluster, divergence.LaggingCluster)
+			require.Equal(t, test.laggingCluster != "", divergence.Fixable())
+		})
+	}
+}
+
+func TestFetchClusterState(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	clientBean := client.NewMockBean(controller)
+	adminClient := admin.NewMockClient(controller)
+	clientBean.EXPECT().GetRemoteAdminClient(cluster.TestAlternativeClusterName).Return(adminClient).AnyTimes()
+	verifier := &Verifier{
+		clientBean: clientBean,
+		serializer: persistence.NewPayloadSerializer(),
+	}
+	workflow := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	versionHistory := &types.VersionHistory{
+		BranchToken: []byte("branch"),
+		Items:       []*types.VersionHistoryItem{{EventID: 3, Version: 1}},
+	}
+	serializer := persistence.NewPayloadSerializer()
+	newBatch := func(eventIDs ...int64) *types.DataBlob {
+		var events []*types.HistoryEvent
+		for _, eventID := range eventIDs {
+			events = append(events, &types.HistoryEvent{EventID: eventID, Version: 1})
+		}
+		blob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+		require.NoError(t, err)
+		return blob.ToInternal()
+	}
+	mutableState, err := json.Marshal(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    "domainID",
+			WorkflowID:  workflow.WorkflowID,
+			RunID:       workflow.RunID,
+			NextEventID: 4,
+		},
+		VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistoryFromInternalType(versionHistory)),
+		ActivityInfos:    map[int64]*persistence.ActivityInfo{2: {ScheduleID: 2}},
+	})
+	require.NoError(t, err)
+
+	adminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{newBatch(1, 2)},
+		NextPageToken:  []byte("token"),
+		VersionHistory: versionHistory,
+	}, nil)
+	adminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{newBatch(3)},
+		VersionHistory: versionHistory,
+	}, nil)
+	adminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: string(mutableState),
+	}, nil)
+	state, err := verifier.fetchClusterState(context.Background(), cluster.TestAlternativeClusterName, "domain", workflow)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	require.Empty(t, state.historyGap)
+	require.NotEmpty(t, state.checksum.Value)
+
+	adminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{newBatch(1, 3)},
+		VersionHistory: versionHistory,
+	}, nil)
+	adminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: string(mutableState),
+	}, nil)
+	gapState, err := verifier.fetchClusterState(context.Background(), cluster.TestAlternativeClusterName, "domain", workflow)
+	require.NoError(t, err)
+	require.Equal(t, "expected event 2, found event 3", gapState.historyGap)
+	require.Equal(t, state.checksum, gapState.checksum)
+
+	adminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	missingState, err := verifier.fetchClusterState(context.Background(), cluster.TestAlternativeClusterName, "domain", workflow)
+	require.NoError(t, err)
+	require.Nil(t, missingState)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/common/types"
+)
+
+type contextKey string
+
+const (
+	verifierContextKey contextKey = "replicationVerifierContext"
+
+	// WorkflowID is the workflow ID of the replication verifier cron workflow
+	WorkflowID = "cadence-sys-replication-verifier"
+	// TaskListName is the task list of the replication verifier
+	TaskListName = "cadence-sys-replication-verifier-tasklist"
+	// WorkflowTypeName is the workflow type of the replication verifier
+	WorkflowTypeName       = "cadence-sys-replication-verifier-workflow"
+	getDomainsActivityName = "cadence-sys-replication-verifier-get-domains-activity"
+	verifyActivityName     = "cadence-sys-replication-verifier-verify-activity"
+
+	// DivergenceExtension is the extension of the blobstore pages divergences are written to
+	DivergenceExtension store.Extension = "divergence"
+
+	// divergences found for open workflows are verified again after recheckDelay, so that divergences
+	// caused by replication lag of recent updates are not reported
+	recheckDelay            = 30 * time.Second
+	blobstoreFlushThreshold = 100
+)
+
+var (
+	verifierWorkflowOptions = cclient.StartWorkflowOptions{
+		ID:                           WorkflowID,
+		TaskList:                     TaskListName,
+		ExecutionStartToCloseTimeout: 6 * time.Hour,
+		CronSchedule:                 "0 */6 * * *", // "At minute 0 past every 6th hour"
+	}
+
+	getDomainsActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+
+	verifyActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 2 * time.Hour,
+		},
+	}
+)
+
+type (
+	// VerifierResult is the result of a run of the verifier workflow
+	VerifierResult struct {
+		Domains []*DomainResult
+	}
+
+	// DomainResult is the result of verifying the sampled workflows of a domain
+	DomainResult struct {
+		Domain      string
+		Verified    int
+		Divergences int
+		Resent      int
+		Failures    int
+		// Keys are the blobstore pages the divergences are written to, nil if there is no divergence
+		// or blobstore is not configured
+		Keys *store.Keys
+	}
+
+	// VerifyActivityParams is the input of the verify activity
+	VerifyActivityParams struct {
+		Domain string
+		// OutputID is the ID of the blobstore output of the activity
+		OutputID string
+	}
+)
+
+// VerifierWorkflow verifies the replication of sampled workflows of all global domains of the current cluster
+func VerifierWorkflow(ctx workflow.Context) (*VerifierResult, error) {
+	var domains []string
+	getDomainsCtx := workflow.WithActivityOptions(ctx, getDomainsActivityOptions)
+	if err := workflow.ExecuteActivity(getDomainsCtx, getDomainsActivityName).Get(ctx, &domains); err != nil {
+		return nil, err
+	}
+
+	logger := workflow.GetLogger(ctx)
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	result := &VerifierResult{}
+	verifyCtx := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+	for i, domain := range domains {
+		params := VerifyActivityParams{
+			Domain:   domain,
+			OutputID: fmt.Sprintf("%v-%v", runID, i),
+		}
+		var domainResult DomainResult
+		if err := workflow.ExecuteActivity(verifyCtx, verifyActivityName, params).Get(ctx, &domainResult); err != nil {
+			// continue with other domains, the failed one is verified again by the next run
+			logger.Error("Failed to verify replication of domain", zap.String("domain", domain), zap.Error(err))
+			continue
+		}
+		result.Domains = append(result.Domains, &domainResult)
+	}
+	return result, nil
+}
+
+// GetDomainsActivity returns the names of the global domains replicated to more than one cluster
+func GetDomainsActivity(ctx context.Context) ([]string, error) {
+	verifier := ctx.Value(verifierContextKey).(*Verifier)
+	var domains []string
+	for _, entry := range verifier.domainCache.GetAllDomain() {
+		if !entry.IsGlobalDomain() ||
+			entry.GetInfo().Status != persistence.DomainStatusRegistered ||
+			len(entry.GetReplicationConfig().Clusters) < 2 {
+			continue
+		}
+		domains = append(domains, entry.GetInfo().Name)
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// VerifyActivity samples open and closed workflows of a domain which are active in the current cluster and compares
+// their state with all other clusters of the domain. Divergences found are written to blobstore.
+func VerifyActivity(ctx context.Context, params VerifyActivityParams) (*DomainResult, error) {
+	verifier := ctx.Value(verifierContextKey).(*Verifier)
+	domainEntry, err := verifier.domainCache.GetDomain(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+	scope := verifier.metricsClient.Scope(metrics.ReplicationVerifierScope, metrics.DomainTag(params.Domain))
+	logger := verifier.logger.WithTags(tag.WorkflowDomainName(params.Domain))
+
+	remoteClusters := verifier.getRemoteClusters(domainEntry)
+	if len(remoteClusters) == 0 {
+		return &DomainResult{Domain: params.Domain}, nil
+	}
+	workflows, err := verifier.sampleWorkflows(ctx, params.Domain)
+	if err != nil {
+		scope.IncCounter(metrics.ReplicationVerifierFailures)
+		return nil, err
+	}
+
+	var writer store.ExecutionWriter
+	if verifier.blobstoreClient != nil {
+		writer = store.NewBlobstoreWriter(params.OutputID, DivergenceExtension, verifier.blobstoreClient, blobstoreFlushThreshold)
+	}
+	result := &DomainResult{Domain: params.Domain}
+	var recheck []*types.WorkflowExecutionInfo
+	for _, wf := range workflows {
+		// every workflow is only verified by the cluster it is active in, workflows in handoff are skipped
+		// as their history is still being replicated to the cluster they move to
+		if !domainEntry.IsWorkflowActive(wf.Execution.GetWorkflowID()) {
+			continue
+		}
+		divergences, err := verifier.verifyWorkflowWithRateLimit(ctx, params.Domain, domainEntry.GetInfo().ID, wf, remoteClusters)
+		if err != nil {
+			scope.IncCounter(metrics.ReplicationVerifierFailures)
+			logger.Warn("Failed to verify replication of workflow.", tag.WorkflowID(wf.Execution.GetWorkflowID()), tag.WorkflowRunID(wf.Execution.GetRunID()), tag.Error(err))
+			result.Failures++
+			continue
+		}
+		if len(divergences) != 0 && wf.CloseStatus == nil {
+			recheck = append(recheck, wf)
+			continue
+		}
+		result.Verified++
+		if err := verifier.report(ctx, scope, logger, writer, result, divergences); err != nil {
+			return nil, err
+		}
+		activity.RecordHeartbeat(ctx, result.Verified)
+	}
+
+	if len(recheck) != 0 {
+		if err := waitWithHeartbeat(ctx, recheckDelay, result.Verified); err != nil {
+			return nil, err
+		}
+		for _, wf := range recheck {
+			divergences, err := verifier.verifyWorkflowWithRateLimit(ctx, params.Domain, domainEntry.GetInfo().ID, wf, remoteClusters)
+			if err != nil {
+				scope.IncCounter(metrics.ReplicationVerifierFailures)
+				logger.Warn("Failed to verify replication of workflow.", tag.WorkflowID(wf.Execution.GetWorkflowID()), tag.WorkflowRunID(wf.Execution.GetRunID()), tag.Error(err))
+				result.Failures++
+				continue
+			}
+			result.Verified++
+			if err := verifier.report(ctx, scope, logger, writer, result, divergences); err != nil {
+				return nil, err
+			}
+			activity.RecordHeartbeat(ctx, result.Verified)
+		}
+	}
+
+	if writer != nil {
+		if err := writer.Flush(); err != nil {
+			return nil, err
+		}
+		result.Keys = writer.FlushedKeys()
+	}
+	return result, nil
+}
+
+func (v *Verifier) getRemoteClusters(domainEntry *cache.DomainCacheEntry) []string {
+	currentCluster := v.clusterMetadata.GetCurrentClusterName()
+	clusterInfo := v.clusterMetadata.GetAllClusterInfo()
+	var clusters []string
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if info, ok := clusterInfo[cluster.ClusterName]; !ok || !info.Enabled || cluster.ClusterName == currentCluster {
+			continue
+		}
+		clusters = append(clusters, cluster.ClusterName)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+// sampleWorkflows returns the most recent open and closed workflows of a domain started before the min age
+func (v *Verifier) sampleWorkflows(ctx context.Context, domain string) ([]*types.WorkflowExecutionInfo, error) {
+	sampleSize := int32(v.cfg.SampleSize(domain))
+	if sampleSize <= 0 {
+		return nil, nil
+	}
+	startTimeFilter := &types.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().Add(-v.cfg.MinAge()).UnixNano()),
+	}
+	frontendClient := v.clientBean.GetFrontendClient()
+	openResponse, err := frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          domain,
+		MaximumPageSize: sampleSize,
+		StartTimeFilter: startTimeFilter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	closedResponse, err := frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          domain,
+		MaximumPageSize: sampleSize,
+		StartTimeFilter: startTimeFilter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(openResponse.Executions, closedResponse.Executions...), nil
+}
+
+func (v *Verifier) verifyWorkflowWithRateLimit(
+	ctx context.Context,
+	domain string,
+	domainID string,
+	wf *types.WorkflowExecutionInfo,
+	remoteClusters []string,
+) ([]*Divergence, error) {
+	if err := v.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return v.verifyWorkflow(ctx, domain, domainID, wf, remoteClusters)
+}
+
+// report records the divergences found for a workflow and resends replication tasks for the fixable ones
+func (v *Verifier) report(
+	ctx context.Context,
+	scope metrics.Scope,
+	logger log.Logger,
+	writer store.ExecutionWriter,
+	result *DomainResult,
+	divergences []*Divergence,
+) error {
+	scope.IncCounter(metrics.ReplicationVerifierWorkflowsVerified)
+	for _, divergence := range divergences {
+		result.Divergences++
+		scope.Tagged(metrics.DivergenceTypeTag(string(divergence.Type))).IncCounter(metrics.ReplicationVerifierDivergences)
+		if divergence.Fixable() && v.cfg.EnableResend(divergence.Domain) {
+			if err := v.resend(ctx, divergence); err != nil {
+				divergence.ResendError = err.Error()
+			} else {
+				divergence.Resent = true
+				result.Resent++
+				scope.IncCounter(metrics.ReplicationVerifierResends)
+			}
+		}
+		logger.Warn("Replication divergence found.",
+			tag.WorkflowID(divergence.WorkflowID),
+			tag.WorkflowRunID(divergence.RunID),
+			tag.ClusterName(divergence.RemoteCluster),
+			tag.Value(divergence),
+		)
+		if writer != nil {
+			if err := writer.Add(divergence); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resend resends the replication tasks of a workflow missing from the lagging cluster of a divergence
+func (v *Verifier) resend(ctx context.Context, divergence *Divergence) error {
+	request := &types.ResendReplicationTasksRequest{
+		DomainID:      divergence.DomainID,
+		WorkflowID:    divergence.WorkflowID,
+		RunID:         divergence.RunID,
+		RemoteCluster: divergence.CurrentCluster,
+	}
+	laggingVersionHistory := divergence.RemoteVersionHistory
+	if divergence.LaggingCluster == divergence.CurrentCluster {
+		request.RemoteCluster = divergence.RemoteCluster
+		laggingVersionHistory = divergence.CurrentVersionHistory
+	}
+	if laggingVersionHistory != nil && len(laggingVersionHistory.Items) != 0 {
+		// start is exclusive, resend the events after the last event of the lagging cluster
+		lastItem := laggingVersionHistory.Items[len(laggingVersionHistory.Items)-1]
+		request.StartEventID = common.Int64Ptr(lastItem.EventID)
+		request.StartVersion = common.Int64Ptr(lastItem.Version)
+	}
+	return v.clientBean.GetRemoteAdminClient(divergence.LaggingCluster).ResendReplicationTasks(ctx, request)
+}
+
+func waitWithHeartbeat(ctx context.Context, delay time.Duration, details interface{}) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	ticker := time.NewTicker(delay / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-timer.C:
+			return nil
+		case <-ticker.C:
+			activity.RecordHeartbeat(ctx, details)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
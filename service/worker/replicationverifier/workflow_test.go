@@ -0,0 +1,262 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationverifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	verifierWorkflowTestSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller     *gomock.Controller
+		domainCache    *cache.MockDomainCache
+		clientBean     *client.MockBean
+		frontendClient *frontend.MockClient
+		currentAdmin   *admin.MockClient
+		remoteAdmin    *admin.MockClient
+		verifier       *Verifier
+		activityEnv    *testsuite.TestActivityEnvironment
+		workflowEnv    *testsuite.TestWorkflowEnvironment
+	}
+)
+
+func TestVerifierWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(verifierWorkflowTestSuite))
+}
+
+func (s *verifierWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.domainCache = cache.NewMockDomainCache(s.controller)
+	s.clientBean = client.NewMockBean(s.controller)
+	s.frontendClient = frontend.NewMockClient(s.controller)
+	s.currentAdmin = admin.NewMockClient(s.controller)
+	s.remoteAdmin = admin.NewMockClient(s.controller)
+	s.clientBean.EXPECT().GetFrontendClient().Return(s.frontendClient).AnyTimes()
+	s.clientBean.EXPECT().GetRemoteAdminClient(cluster.TestCurrentClusterName).Return(s.currentAdmin).AnyTimes()
+	s.clientBean.EXPECT().GetRemoteAdminClient(cluster.TestAlternativeClusterName).Return(s.remoteAdmin).AnyTimes()
+
+	blobstoreClient, err := filestore.NewFilestoreClient(&config.FileBlobstore{OutputDirectory: s.T().TempDir()})
+	s.NoError(err)
+
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(VerifierWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+
+	s.verifier = &Verifier{
+		cfg: Config{
+			SampleSize:   dynamicconfig.GetIntPropertyFilteredByDomain(10),
+			MinAge:       dynamicconfig.GetDurationPropertyFn(0),
+			EnableResend: dynamicconfig.GetBoolPropertyFnFilteredByDomain(true),
+			RPS:          dynamicconfig.GetIntPropertyFn(1000),
+		},
+		clientBean:      s.clientBean,
+		domainCache:     s.domainCache,
+		clusterMetadata: cluster.GetTestClusterMetadata(true, true),
+		blobstoreClient: blobstoreClient,
+		serializer:      persistence.NewPayloadSerializer(),
+		rateLimiter:     quotas.NewSimpleRateLimiter(1000),
+		metricsClient:   metrics.NewNoopMetricsClient(),
+		logger:          log.NewNoop(),
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), verifierContextKey, s.verifier),
+	})
+}
+
+func (s *verifierWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *verifierWorkflowTestSuite) newGlobalDomainEntry(id string, name string, clusters ...string) *cache.DomainCacheEntry {
+	replicationConfig := &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName}
+	for _, clusterName := range clusters {
+		replicationConfig.Clusters = append(replicationConfig.Clusters, &persistence.ClusterReplicationConfig{ClusterName: clusterName})
+	}
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: id, Name: name},
+		&persistence.DomainConfig{},
+		replicationConfig,
+		0,
+		s.verifier.clusterMetadata,
+	)
+}
+
+func (s *verifierWorkflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything).Return([]string{"d1", "d2"}, nil)
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.MatchedBy(func(params VerifyActivityParams) bool {
+		return params.Domain == "d1"
+	})).Return(&DomainResult{Domain: "d1", Verified: 10, Divergences: 1}, nil)
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, mock.MatchedBy(func(params VerifyActivityParams) bool {
+		return params.Domain == "d2"
+	})).Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var result VerifierResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Len(result.Domains, 1)
+	s.Equal("d1", result.Domains[0].Domain)
+	s.Equal(1, result.Domains[0].Divergences)
+}
+
+func (s *verifierWorkflowTestSuite) TestGetDomainsActivity() {
+	s.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"id1": s.newGlobalDomainEntry("id1", "replicated", cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName),
+		"id2": s.newGlobalDomainEntry("id2", "single-cluster", cluster.TestCurrentClusterName),
+		"id3": cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id3", Name: "local"}, &persistence.DomainConfig{}, "", nil),
+	})
+
+	result, err := s.activityEnv.ExecuteActivity(getDomainsActivityName)
+	s.NoError(err)
+	var domains []string
+	s.NoError(result.Get(&domains))
+	s.Equal([]string{"replicated"}, domains)
+}
+
+func (s *verifierWorkflowTestSuite) TestVerifyActivity() {
+	domainEntry := s.newGlobalDomainEntry("id1", "replicated", cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName)
+	s.domainCache.EXPECT().GetDomain("replicated").Return(domainEntry, nil)
+	execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	s.frontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{}, nil)
+	s.frontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{{
+			Execution:   execution,
+			CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		}},
+	}, nil)
+
+	versionHistory := &types.VersionHistory{Items: []*types.VersionHistoryItem{{EventID: 1, Version: 1}}}
+	batch, err := s.verifier.serializer.SerializeBatchEvents([]*types.HistoryEvent{{EventID: 1, Version: 1}}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	mutableState, err := json.Marshal(&persistence.WorkflowMutableState{
+		ExecutionInfo:    &persistence.WorkflowExecutionInfo{NextEventID: 2},
+		VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistoryFromInternalType(versionHistory)),
+	})
+	s.NoError(err)
+	s.currentAdmin.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{batch.ToInternal()},
+		VersionHistory: versionHistory,
+	}, nil)
+	s.currentAdmin.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: string(mutableState),
+	}, nil)
+	s.remoteAdmin.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	s.remoteAdmin.EXPECT().ResendReplicationTasks(gomock.Any(), &types.ResendReplicationTasksRequest{
+		DomainID:      "id1",
+		WorkflowID:    execution.WorkflowID,
+		RunID:         execution.RunID,
+		RemoteCluster: cluster.TestCurrentClusterName,
+	}).Return(nil)
+
+	value, err := s.activityEnv.ExecuteActivity(verifyActivityName, VerifyActivityParams{Domain: "replicated", OutputID: "output"})
+	s.NoError(err)
+	var result DomainResult
+	s.NoError(value.Get(&result))
+	s.Equal(1, result.Verified)
+	s.Equal(1, result.Divergences)
+	s.Equal(1, result.Resent)
+	s.NotNil(result.Keys)
+
+	itr := NewDivergenceIterator(context.Background(), s.verifier.blobstoreClient, *result.Keys)
+	s.True(itr.HasNext())
+	entity, err := itr.Next()
+	s.NoError(err)
+	divergence := entity.(*Divergence)
+	s.Equal(DivergenceTypeMissing, divergence.Type)
+	s.Equal(cluster.TestAlternativeClusterName, divergence.LaggingCluster)
+	s.True(divergence.Resent)
+	s.False(itr.HasNext())
+}
+
+func (s *verifierWorkflowTestSuite) TestVerifyActivity_WorkflowInHandoff() {
+	// all workflows of the domain are handed off from the alternative cluster to the current cluster
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			ID:   "id1",
+			Name: "replicated",
+			Data: map[string]string{
+				cache.WorkflowActiveClustersKey:               cluster.TestCurrentClusterName + ":100",
+				cache.WorkflowActiveClustersPreviousKey:       cluster.TestAlternativeClusterName + ":100",
+				cache.WorkflowActiveClustersHandoffEndTimeKey: strconv.FormatInt(time.Now().Add(time.Hour).UnixNano(), 10),
+			},
+		},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		0,
+		s.verifier.clusterMetadata,
+	)
+	s.domainCache.EXPECT().GetDomain("replicated").Return(domainEntry, nil)
+	s.frontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{{
+			Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		}},
+	}, nil)
+	s.frontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
+
+	value, err := s.activityEnv.ExecuteActivity(verifyActivityName, VerifyActivityParams{Domain: "replicated", OutputID: "output"})
+	s.NoError(err)
+	var result DomainResult
+	s.NoError(value.Get(&result))
+	s.Equal(0, result.Verified)
+	s.Equal(0, result.Divergences)
+}
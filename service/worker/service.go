@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/replicationverifier"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
@@ -51,6 +52,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 	"github.com/uber/cadence/service/worker/shadower"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
 )
 
 type (
@@ -75,6 +77,7 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		failoverManagerCfg                  *failovermanager.Config
+		ReplicationVerifierCfg              *replicationverifier.Config
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                   dynamicconfig.IntPropertyFn
@@ -85,6 +88,16 @@ type (
 		EnableWorkflowShadower              dynamicconfig.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
+		EnableReplicationVerifier           dynamicconfig.BoolPropertyFn
+
+		// visibility migration, only set when both db and advanced visibility, or an advanced visibility
+		// migration target store are configured
+		VisibilityMigrationCfg          *visibilitymigration.Config
+		EnableReadVisibilityFromES      dynamicconfig.BoolPropertyFnWithDomainFilter
+		AdvancedVisibilityWritingMode   dynamicconfig.StringPropertyFn
+		EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
+		ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
+		ValidSearchAttributes           dynamicconfig.MapPropertyFn
 	}
 )
 
@@ -102,7 +115,15 @@ func NewService(
 			PersistenceMaxQPS:       serviceConfig.PersistenceMaxQPS,
 			PersistenceGlobalMaxQPS: serviceConfig.PersistenceGlobalMaxQPS,
 			ThrottledLoggerMaxRPS:   serviceConfig.ThrottledLogRPS,
-			// worker service doesn't need visibility config as it never call visibilityManager API
+
+			// worker service only needs visibility config for migrating visibility records between stores
+			EnableReadVisibilityFromES:    serviceConfig.EnableReadVisibilityFromES,
+			AdvancedVisibilityWritingMode: serviceConfig.AdvancedVisibilityWritingMode,
+
+			EnableReadDBVisibilityFromClosedExecutionV2: serviceConfig.EnableReadFromClosedExecutionV2,
+
+			ESIndexMaxResultWindow: serviceConfig.ESIndexMaxResultWindow,
+			ValidSearchAttributes:  serviceConfig.ValidSearchAttributes,
 		},
 	)
 	if err != nil {
@@ -156,8 +177,23 @@ func NewConfig(params *resource.Params) *Config {
 			ClusterMetadata:     params.ClusterMetadata,
 		},
 		failoverManagerCfg: &failovermanager.Config{
-			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
-			ClusterMetadata:     params.ClusterMetadata,
+			AdminOperationToken:                 dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
+			ClusterMetadata:                     params.ClusterMetadata,
+			NumHistoryShards:                    params.PersistenceConfig.NumHistoryShards,
+			EnableAutoFailover:                  dc.GetBoolProperty(dynamicconfig.EnableAutoFailover, false),
+			AutoFailoverCheckInterval:           dc.GetDurationProperty(dynamicconfig.AutoFailoverCheckInterval, time.Minute),
+			AutoFailoverConfirmationWindow:      dc.GetDurationProperty(dynamicconfig.AutoFailoverConfirmationWindow, 5*time.Minute),
+			AutoFailoverProbeCount:              dc.GetIntProperty(dynamicconfig.AutoFailoverProbeCount, 10),
+			AutoFailoverMinFrontendAvailability: dc.GetFloat64Property(dynamicconfig.AutoFailoverMinFrontendAvailability, 0.5),
+			AutoFailoverMaxPersistenceErrorRate: dc.GetFloat64Property(dynamicconfig.AutoFailoverMaxPersistenceErrorRate, 0.5),
+			AutoFailoverMaxReplicationLag:       dc.GetDurationProperty(dynamicconfig.AutoFailoverMaxReplicationLag, 0),
+			AutoFailoverBackupCluster:           dc.GetStringProperty(dynamicconfig.AutoFailoverBackupCluster, ""),
+		},
+		ReplicationVerifierCfg: &replicationverifier.Config{
+			SampleSize:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.ReplicationVerifierSampleSize, 100),
+			MinAge:       dc.GetDurationProperty(dynamicconfig.ReplicationVerifierMinAge, 10*time.Minute),
+			EnableResend: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.ReplicationVerifierEnableResend, false),
+			RPS:          dc.GetIntProperty(dynamicconfig.ReplicationVerifierRPS, 10),
 		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                          dc.GetBoolProperty(dynamicconfig.ESAnalyzerPause, common.DefaultESAnalyzerPause),
@@ -177,6 +213,7 @@ func NewConfig(params *resource.Params) *Config {
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer, false),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
 		EnableWorkflowShadower:              dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
+		EnableReplicationVerifier:           dc.GetBoolProperty(dynamicconfig.EnableReplicationVerifier, false),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS, 500),
@@ -196,6 +233,18 @@ func NewConfig(params *resource.Params) *Config {
 			ValidSearchAttributes:    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		}
 	}
+	if params.PersistenceConfig.AdvancedVisibilityStore != "" &&
+		(params.PersistenceConfig.VisibilityStore != "" || params.PersistenceConfig.AdvancedVisibilityMigrationTargetStore != "") {
+		config.VisibilityMigrationCfg = &visibilitymigration.Config{
+			EnableBackfill: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableVisibilityMigrationBackfill, false),
+			BackfillRPS:    dc.GetIntProperty(dynamicconfig.VisibilityMigrationBackfillRPS, 100),
+		}
+		config.EnableReadVisibilityFromES = dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, true)
+		config.AdvancedVisibilityWritingMode = advancedVisWritingMode
+		config.EnableReadFromClosedExecutionV2 = dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false)
+		config.ESIndexMaxResultWindow = dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000)
+		config.ValidSearchAttributes = dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys())
+	}
 	return config
 }
 
@@ -219,6 +268,9 @@ func (s *Service) Start() {
 
 	if s.GetClusterMetadata().IsGlobalDomainEnabled() {
 		s.startReplicator()
+		if s.config.EnableReplicationVerifier() {
+			s.startReplicationVerifier()
+		}
 	}
 	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
 		s.startArchiver()
@@ -240,6 +292,9 @@ func (s *Service) Start() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
 	}
+	if s.config.VisibilityMigrationCfg != nil {
+		s.startVisibilityMigrationBackfiller()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -330,10 +385,28 @@ func (s *Service) startFixerWorkflowWorker() {
 }
 
 func (s *Service) startReplicator() {
-	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
-		s.Resource.GetDomainManager(),
-		s.Resource.GetTimeSource(),
-		s.Resource.GetLogger(),
+	var esIndex string
+	if s.params.ESConfig != nil {
+		esIndex = s.params.ESConfig.GetVisibilityIndex()
+	}
+	domainReplicationTaskExecutor := domain.NewClusterSettingsReplicationTaskExecutor(
+		domain.NewReplicationTaskExecutor(
+			s.Resource.GetDomainManager(),
+			s.Resource.GetTimeSource(),
+			s.Resource.GetLogger(),
+		),
+		// the worker only applies the cluster settings replicated from the other clusters
+		domain.NewClusterSettingsHandler(
+			s.GetClusterMetadata(),
+			s.params.DynamicConfig,
+			s.GetDomainManager(),
+			s.GetDomainReplicationQueue(),
+			s.params.ESClient,
+			esIndex,
+			dynamicconfig.GetBoolPropertyFn(false),
+			dynamicconfig.GetStringPropertyFn(""),
+			s.GetLogger(),
+		),
 	)
 	msgReplicator := replicator.NewReplicator(
 		s.GetClusterMetadata(),
@@ -365,6 +438,21 @@ func (s *Service) startIndexer() {
 		visibilityIndexer.Stop()
 		s.GetLogger().Fatal("fail to start indexer", tag.Error(err))
 	}
+
+	if s.params.MigrationTargetESClient != nil {
+		targetIndexer := indexer.NewMigrationTargetIndexer(
+			s.config.IndexerCfg,
+			s.GetMessagingClient(),
+			s.params.MigrationTargetESClient,
+			s.params.MigrationTargetESConfig,
+			s.GetLogger(),
+			s.GetMetricsClient(),
+		)
+		if err := targetIndexer.Start(); err != nil {
+			targetIndexer.Stop()
+			s.GetLogger().Fatal("fail to start visibility migration target indexer", tag.Error(err))
+		}
+	}
 }
 
 func (s *Service) startArchiver() {
@@ -392,6 +480,7 @@ func (s *Service) startFailoverManager() {
 		Logger:        s.GetLogger(),
 		TallyScope:    s.params.MetricScope,
 		ClientBean:    s.GetClientBean(),
+		Resource:      s.Resource,
 	}
 	if err := failovermanager.New(params).Start(); err != nil {
 		s.Stop()
@@ -399,6 +488,47 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startReplicationVerifier() {
+	params := &replicationverifier.BootstrapParams{
+		Config:          *s.config.ReplicationVerifierCfg,
+		ServiceClient:   s.params.PublicClient,
+		ClientBean:      s.GetClientBean(),
+		DomainCache:     s.GetDomainCache(),
+		ClusterMetadata: s.GetClusterMetadata(),
+		BlobstoreClient: s.GetBlobstoreClient(),
+		MetricsClient:   s.GetMetricsClient(),
+		Logger:          s.GetLogger(),
+		TallyScope:      s.params.MetricScope,
+		Resource:        s.Resource,
+	}
+	if err := replicationverifier.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting replication verifier", tag.Error(err))
+	}
+}
+
+func (s *Service) startVisibilityMigrationBackfiller() {
+	visibilityManager, ok := s.GetVisibilityManager().(persistence.VisibilityMigrationManager)
+	if !ok {
+		s.GetLogger().Warn("visibility manager does not support migration, visibility migration backfill is not started")
+		return
+	}
+	params := &visibilitymigration.BootstrapParams{
+		Config:            *s.config.VisibilityMigrationCfg,
+		ServiceClient:     s.params.PublicClient,
+		VisibilityManager: visibilityManager,
+		DomainCache:       s.GetDomainCache(),
+		MetricsClient:     s.GetMetricsClient(),
+		Logger:            s.GetLogger(),
+		TallyScope:        s.params.MetricScope,
+		Resource:          s.Resource,
+	}
+	if err := visibilitymigration.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting visibility migration backfiller", tag.Error(err))
+	}
+}
+
 func (s *Service) startWorkflowShadower() {
 	params := &shadower.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+const startUpDelay = time.Second * 10
+
+type (
+	// Config defines the configuration for visibility migration backfill
+	Config struct {
+		// EnableBackfill decides which domains are backfilled
+		EnableBackfill dynamicconfig.BoolPropertyFnWithDomainFilter
+		// BackfillRPS is the rate limit of records written to the target store
+		BackfillRPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility migration backfill
+	BootstrapParams struct {
+		// Config contains the configuration for backfill
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// VisibilityManager is the visibility manager of the worker service, it must be a
+		// persistence.VisibilityMigrationManager which is only the case if both db and
+		// advanced visibility, or an advanced visibility migration target store are configured
+		VisibilityManager persistence.VisibilityMigrationManager
+		// DomainCache is used to find domains to backfill
+		DomainCache cache.DomainCache
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource is used to start the backfill workflow
+		Resource resource.Resource
+	}
+
+	// Backfiller copies visibility records of domains from the store reads are served from to the
+	// other store, so that reads can be switched over once records written before dual write was
+	// enabled are also present in the target store
+	Backfiller struct {
+		cfg               Config
+		svcClient         workflowserviceclient.Interface
+		visibilityManager persistence.VisibilityMigrationManager
+		domainCache       cache.DomainCache
+		rateLimiter       quotas.Limiter
+		metricsClient     metrics.Client
+		tallyScope        tally.Scope
+		logger            log.Logger
+		resource          resource.Resource
+		worker            worker.Worker
+	}
+)
+
+// New returns a new instance of Backfiller
+func New(params *BootstrapParams) *Backfiller {
+	return &Backfiller{
+		cfg:               params.Config,
+		svcClient:         params.ServiceClient,
+		visibilityManager: params.VisibilityManager,
+		domainCache:       params.DomainCache,
+		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(params.Config.BackfillRPS())
+		}),
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentVisibilityMigration),
+		resource:      params.Resource,
+	}
+}
+
+// Start starts the worker and the backfill workflow
+func (b *Backfiller) Start() error {
+	ctx := context.WithValue(context.Background(), backfillerContextKey, b)
+	workerOpts := worker.Options{
+		MetricsScope:              b.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	backfillWorker := worker.New(b.svcClient, common.SystemLocalDomainName, taskListName, workerOpts)
+	backfillWorker.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: backfillWorkflowTypeName})
+	backfillWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	backfillWorker.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	b.worker = backfillWorker
+	if err := backfillWorker.Start(); err != nil {
+		return err
+	}
+
+	go workercommon.StartWorkflowWithRetry(backfillWorkflowTypeName, startUpDelay, b.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(context.Background(), backfillWorkflowOptions, backfillWorkflowTypeName)
+		switch err.(type) {
+		case nil, *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			b.logger.Error("Failed to start visibility migration backfill workflow", tag.Error(err))
+			return err
+		}
+	})
+	return nil
+}
+
+// Stop stops the worker
+func (b *Backfiller) Stop() {
+	b.worker.Stop()
+}
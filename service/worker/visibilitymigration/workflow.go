@@ -0,0 +1,350 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type contextKey string
+
+const (
+	backfillerContextKey contextKey = "visibilityMigrationBackfillerContext"
+
+	backfillWorkflowID       = "cadence-sys-visibility-migration-backfill"
+	taskListName             = "cadence-sys-visibility-migration-tasklist"
+	backfillWorkflowTypeName = "cadence-sys-visibility-migration-backfill-workflow"
+	getDomainsActivityName   = "cadence-sys-visibility-migration-get-domains-activity"
+	backfillActivityName     = "cadence-sys-visibility-migration-backfill-activity"
+
+	// maxBackfillActivitiesPerDomain bounds the work done for a single domain in one run of the
+	// cron workflow, so that the history of a run stays small and every domain makes progress
+	maxBackfillActivitiesPerDomain = 10
+	// backfillActivityTimeBudget is the time after which the backfill activity returns its progress
+	backfillActivityTimeBudget = 5 * time.Minute
+	backfillPageSize           = 100
+	secondsInDay               = int64(24 * time.Hour / time.Second)
+	// visibility records do not carry the timeout of a workflow, which is used as the ttl of open
+	// records by some stores, so open records are backfilled with a timeout long enough to outlive
+	// practically all workflows
+	backfillOpenWorkflowTimeoutInSeconds = int64(365 * 24 * time.Hour / time.Second)
+	// backfilled records are written with the lowest possible task ID, so that records written by
+	// history (through dual write) always take precedence in stores that use it as the record version
+	backfillOpenRecordTaskID   = 0
+	backfillClosedRecordTaskID = 1
+)
+
+var (
+	backfillWorkflowOptions = cclient.StartWorkflowOptions{
+		ID:                           backfillWorkflowID,
+		TaskList:                     taskListName,
+		ExecutionStartToCloseTimeout: 12 * time.Hour,
+		CronSchedule:                 "0 * * * *", // "At minute 0" => every hour
+	}
+
+	getDomainsActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+
+	backfillActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    2 * backfillActivityTimeBudget,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: time.Hour,
+		},
+	}
+
+	errNotMigrationManager = errors.New("visibility manager does not support migration, both db and advanced visibility, or an advanced visibility migration target store must be configured")
+)
+
+type (
+	// BackfillState is the result of a run of the backfill workflow, it is carried over to the next run
+	BackfillState struct {
+		// Domains is keyed by domain name, domains are removed once backfill is disabled for them
+		Domains map[string]*DomainBackfillState
+	}
+
+	// DomainBackfillState is the backfill state of a domain
+	DomainBackfillState struct {
+		// LatestTime is the time backfill started for the domain, records written after it are expected
+		// to be dual written and are not backfilled
+		LatestTime int64
+		Progress   BackfillProgress
+		Done       bool
+	}
+
+	// BackfillProgress is the position of the backfill in the source store. Open records are copied first,
+	// then closed records.
+	BackfillProgress struct {
+		Closed        bool
+		NextPageToken []byte
+	}
+
+	// BackfillActivityParams is the input of the backfill activity
+	BackfillActivityParams struct {
+		Domain     string
+		LatestTime int64
+		Progress   BackfillProgress
+	}
+
+	// BackfillActivityResult is the result of the backfill activity
+	BackfillActivityResult struct {
+		Progress BackfillProgress
+		Done     bool
+	}
+)
+
+// BackfillWorkflow copies visibility records of all domains with backfill enabled to their migration target store
+func BackfillWorkflow(ctx workflow.Context) (*BackfillState, error) {
+	state := &BackfillState{}
+	if workflow.HasLastCompletionResult(ctx) {
+		if err := workflow.GetLastCompletionResult(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+
+	var domains []string
+	getDomainsCtx := workflow.WithActivityOptions(ctx, getDomainsActivityOptions)
+	if err := workflow.ExecuteActivity(getDomainsCtx, getDomainsActivityName).Get(ctx, &domains); err != nil {
+		return nil, err
+	}
+
+	now := workflow.Now(ctx).UnixNano()
+	domainStates := make(map[string]*DomainBackfillState, len(domains))
+	for _, domain := range domains {
+		domainState, ok := state.Domains[domain]
+		if !ok {
+			domainState = &DomainBackfillState{LatestTime: now}
+		}
+		domainStates[domain] = domainState
+	}
+	state.Domains = domainStates
+
+	logger := workflow.GetLogger(ctx)
+	backfillCtx := workflow.WithActivityOptions(ctx, backfillActivityOptions)
+	for _, domain := range domains {
+		domainState := state.Domains[domain]
+		for i := 0; i < maxBackfillActivitiesPerDomain && !domainState.Done; i++ {
+			params := BackfillActivityParams{
+				Domain:     domain,
+				LatestTime: domainState.LatestTime,
+				Progress:   domainState.Progress,
+			}
+			var result BackfillActivityResult
+			if err := workflow.ExecuteActivity(backfillCtx, backfillActivityName, params).Get(ctx, &result); err != nil {
+				// continue with other domains, the failed one is retried by the next run
+				logger.Error("Failed to backfill visibility records", zap.String("domain", domain), zap.Error(err))
+				break
+			}
+			domainState.Progress = result.Progress
+			domainState.Done = result.Done
+		}
+	}
+	return state, nil
+}
+
+// GetDomainsActivity returns the names of the domains which have backfill enabled
+func GetDomainsActivity(ctx context.Context) ([]string, error) {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	var domains []string
+	for _, entry := range backfiller.domainCache.GetAllDomain() {
+		name := entry.GetInfo().Name
+		if backfiller.cfg.EnableBackfill(name) {
+			domains = append(domains, name)
+		}
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// BackfillActivity copies visibility records of a domain from its migration source store to the target store,
+// starting from the given progress. It returns the progress made when the time budget of the activity runs out.
+func BackfillActivity(ctx context.Context, params BackfillActivityParams) (BackfillActivityResult, error) {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	if backfiller.visibilityManager == nil {
+		return BackfillActivityResult{}, errNotMigrationManager
+	}
+	domainEntry, err := backfiller.domainCache.GetDomain(params.Domain)
+	if err != nil {
+		return BackfillActivityResult{}, err
+	}
+	scope := backfiller.metricsClient.Scope(metrics.VisibilityMigrationBackfillScope, metrics.DomainTag(params.Domain))
+	logger := backfiller.logger.WithTags(tag.WorkflowDomainName(params.Domain))
+
+	progress := params.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		// resume from the last page copied by the previous attempt
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Warn("Failed to load visibility migration backfill progress.", tag.Error(err))
+			progress = params.Progress
+		}
+	}
+
+	source, target := backfiller.visibilityManager.GetMigrationStores(params.Domain)
+	deadline := time.Now().Add(backfillActivityTimeBudget)
+	for time.Now().Before(deadline) {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:    domainEntry.GetInfo().ID,
+			Domain:        params.Domain,
+			EarliestTime:  0,
+			LatestTime:    params.LatestTime,
+			PageSize:      backfillPageSize,
+			NextPageToken: progress.NextPageToken,
+		}
+		var response *persistence.ListWorkflowExecutionsResponse
+		if progress.Closed {
+			response, err = source.ListClosedWorkflowExecutions(ctx, request)
+		} else {
+			response, err = source.ListOpenWorkflowExecutions(ctx, request)
+		}
+		if err != nil {
+			scope.IncCounter(metrics.VisibilityMigrationBackfillFailures)
+			return BackfillActivityResult{}, err
+		}
+
+		for _, execution := range response.Executions {
+			if err := backfiller.rateLimiter.Wait(ctx); err != nil {
+				return BackfillActivityResult{}, err
+			}
+			retentionSeconds := int64(domainEntry.GetRetentionDays(execution.GetExecution().GetWorkflowID())) * secondsInDay
+			err := backfillRecord(ctx, target, domainEntry.GetInfo().ID, params.Domain, execution, retentionSeconds)
+			if err == nil && execution.CloseStatus == nil {
+				err = backfillClose(ctx, source, target, domainEntry.GetInfo().ID, params.Domain, execution, retentionSeconds)
+			}
+			if err != nil {
+				scope.IncCounter(metrics.VisibilityMigrationBackfillFailures)
+				return BackfillActivityResult{}, fmt.Errorf("failed to backfill visibility record of workflow %v: %v", execution.GetExecution().GetWorkflowID(), err)
+			}
+			scope.IncCounter(metrics.VisibilityMigrationBackfillRecords)
+		}
+
+		progress.NextPageToken = response.NextPageToken
+		if len(progress.NextPageToken) == 0 {
+			if progress.Closed {
+				logger.Info("Visibility migration backfill completed.")
+				return BackfillActivityResult{Progress: progress, Done: true}, nil
+			}
+			progress.Closed = true
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+	return BackfillActivityResult{Progress: progress}, nil
+}
+
+// backfillClose writes the close record of a workflow backfilled as open, if the workflow was closed in the
+// meantime. Its close record may have been dual written to the target store before the open record was
+// backfilled, which then overwrote it in stores that do not order writes by task ID, so the close is
+// applied again after the open record.
+func backfillClose(
+	ctx context.Context,
+	source persistence.VisibilityManager,
+	target persistence.VisibilityManager,
+	domainID string,
+	domain string,
+	execution *types.WorkflowExecutionInfo,
+	retentionSeconds int64,
+) error {
+	response, err := source.GetClosedWorkflowExecution(ctx, &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Domain:     domain,
+		Execution:  *execution.GetExecution(),
+	})
+	if _, ok := err.(*types.EntityNotExistsError); ok {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return backfillRecord(ctx, target, domainID, domain, response.Execution, retentionSeconds)
+}
+
+func backfillRecord(
+	ctx context.Context,
+	target persistence.VisibilityManager,
+	domainID string,
+	domain string,
+	execution *types.WorkflowExecutionInfo,
+	retentionSeconds int64,
+) error {
+	executionTimestamp := execution.GetExecutionTime()
+	if executionTimestamp == 0 {
+		executionTimestamp = execution.GetStartTime()
+	}
+	if execution.CloseStatus == nil {
+		return target.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         domainID,
+			Domain:             domain,
+			Execution:          *execution.GetExecution(),
+			WorkflowTypeName:   execution.GetType().GetName(),
+			StartTimestamp:     execution.GetStartTime(),
+			ExecutionTimestamp: executionTimestamp,
+			WorkflowTimeout:    backfillOpenWorkflowTimeoutInSeconds,
+			TaskID:             backfillOpenRecordTaskID,
+			Memo:               execution.Memo,
+			TaskList:           execution.TaskList,
+			IsCron:             execution.IsCron,
+			SearchAttributes:   execution.SearchAttributes.GetIndexedFields(),
+		})
+	}
+	return target.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         domainID,
+		Domain:             domain,
+		Execution:          *execution.GetExecution(),
+		WorkflowTypeName:   execution.GetType().GetName(),
+		StartTimestamp:     execution.GetStartTime(),
+		ExecutionTimestamp: executionTimestamp,
+		CloseTimestamp:     execution.GetCloseTime(),
+		Status:             *execution.CloseStatus,
+		HistoryLength:      execution.HistoryLength,
+		RetentionSeconds:   retentionSeconds,
+		TaskID:             backfillClosedRecordTaskID,
+		Memo:               execution.Memo,
+		TaskList:           execution.TaskList,
+		IsCron:             execution.IsCron,
+		SearchAttributes:   execution.SearchAttributes.GetIndexedFields(),
+	})
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	backfillWorkflowTestSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller  *gomock.Controller
+		domainCache *cache.MockDomainCache
+		source      *mocks.VisibilityManager
+		target      *mocks.VisibilityManager
+		activityEnv *testsuite.TestActivityEnvironment
+		workflowEnv *testsuite.TestWorkflowEnvironment
+	}
+
+	testMigrationManager struct {
+		persistence.VisibilityManager
+		source persistence.VisibilityManager
+		target persistence.VisibilityManager
+	}
+)
+
+func (m *testMigrationManager) GetMigrationStores(string) (persistence.VisibilityManager, persistence.VisibilityManager) {
+	return m.source, m.target
+}
+
+func TestBackfillWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillWorkflowTestSuite))
+}
+
+func (s *backfillWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.domainCache = cache.NewMockDomainCache(s.controller)
+	s.source = &mocks.VisibilityManager{}
+	s.target = &mocks.VisibilityManager{}
+
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: backfillWorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+
+	backfiller := &Backfiller{
+		cfg: Config{
+			EnableBackfill: func(domain string) bool { return domain == "enabled" },
+			BackfillRPS:    dynamicconfig.GetIntPropertyFn(1000),
+		},
+		visibilityManager: &testMigrationManager{source: s.source, target: s.target},
+		domainCache:       s.domainCache,
+		rateLimiter:       quotas.NewSimpleRateLimiter(1000),
+		metricsClient:     metrics.NewNoopMetricsClient(),
+		logger:            log.NewNoop(),
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, backfiller),
+	})
+}
+
+func (s *backfillWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.source.AssertExpectations(s.T())
+	s.target.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything).Return([]string{"d1", "d2"}, nil)
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params BackfillActivityParams) bool {
+		return params.Domain == "d1" && !params.Progress.Closed
+	})).Return(BackfillActivityResult{Progress: BackfillProgress{Closed: true}}, nil).Once()
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params BackfillActivityParams) bool {
+		return params.Domain == "d1" && params.Progress.Closed
+	})).Return(BackfillActivityResult{Progress: BackfillProgress{Closed: true}, Done: true}, nil).Once()
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params BackfillActivityParams) bool {
+		return params.Domain == "d2"
+	})).Return(BackfillActivityResult{}, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(backfillWorkflowTypeName)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var state BackfillState
+	s.NoError(s.workflowEnv.GetWorkflowResult(&state))
+	s.Len(state.Domains, 2)
+	s.True(state.Domains["d1"].Done)
+	s.False(state.Domains["d2"].Done)
+}
+
+func (s *backfillWorkflowTestSuite) TestGetDomainsActivity() {
+	s.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"id1": cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id1", Name: "enabled"}, &persistence.DomainConfig{}, "", nil),
+		"id2": cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id2", Name: "disabled"}, &persistence.DomainConfig{}, "", nil),
+	})
+
+	result, err := s.activityEnv.ExecuteActivity(getDomainsActivityName)
+	s.NoError(err)
+	var domains []string
+	s.NoError(result.Get(&domains))
+	s.Equal([]string{"enabled"}, domains)
+}
+
+func (s *backfillWorkflowTestSuite) TestBackfillActivity() {
+	s.domainCache.EXPECT().GetDomain("enabled").Return(
+		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id1", Name: "enabled"}, &persistence.DomainConfig{Retention: 1}, "", nil),
+		nil,
+	)
+	openExecution := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"},
+		Type:      &types.WorkflowType{Name: "type"},
+		StartTime: common.Int64Ptr(1),
+	}
+	closedExecution := &types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"},
+		Type:        &types.WorkflowType{Name: "type"},
+		StartTime:   common.Int64Ptr(1),
+		CloseTime:   common.Int64Ptr(2),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+	s.source.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{openExecution},
+	}, nil).Once()
+	s.source.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{closedExecution},
+	}, nil).Once()
+	s.source.On("GetClosedWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.target.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return request.Execution.WorkflowID == "wid1" && request.TaskID == backfillOpenRecordTaskID
+	})).Return(nil).Once()
+	s.target.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Execution.WorkflowID == "wid2" && request.TaskID == backfillClosedRecordTaskID && request.RetentionSeconds == secondsInDay
+	})).Return(nil).Once()
+
+	result, err := s.activityEnv.ExecuteActivity(backfillActivityName, BackfillActivityParams{Domain: "enabled", LatestTime: 10})
+	s.NoError(err)
+	var backfillResult BackfillActivityResult
+	s.NoError(result.Get(&backfillResult))
+	s.True(backfillResult.Done)
+}
+
+func (s *backfillWorkflowTestSuite) TestBackfillActivity_ClosedWhileBackfilling() {
+	s.domainCache.EXPECT().GetDomain("enabled").Return(
+		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id1", Name: "enabled"}, &persistence.DomainConfig{Retention: 1}, "", nil),
+		nil,
+	)
+	openExecution := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"},
+		Type:      &types.WorkflowType{Name: "type"},
+		StartTime: common.Int64Ptr(1),
+	}
+	closedExecution := &types.WorkflowExecutionInfo{
+		Execution:   openExecution.Execution,
+		Type:        openExecution.Type,
+		StartTime:   common.Int64Ptr(1),
+		CloseTime:   common.Int64Ptr(20),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+	s.source.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{openExecution},
+	}, nil).Once()
+	s.source.On("ListClosedWorkflowExecutions", mock.Anything, mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	s.source.On("GetClosedWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.GetClosedWorkflowExecutionRequest) bool {
+		return request.Execution.WorkflowID == "wid1"
+	})).Return(&persistence.GetClosedWorkflowExecutionResponse{Execution: closedExecution}, nil).Once()
+	var writes []string
+	s.target.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return request.Execution.WorkflowID == "wid1"
+	})).Return(nil).Once().Run(func(mock.Arguments) { writes = append(writes, "started") })
+	s.target.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Execution.WorkflowID == "wid1" && request.CloseTimestamp == 20
+	})).Return(nil).Once().Run(func(mock.Arguments) { writes = append(writes, "closed") })
+
+	result, err := s.activityEnv.ExecuteActivity(backfillActivityName, BackfillActivityParams{Domain: "enabled", LatestTime: 10})
+	s.NoError(err)
+	var backfillResult BackfillActivityResult
+	s.NoError(result.Get(&backfillResult))
+	s.True(backfillResult.Done)
+	s.Equal([]string{"started", "closed"}, writes)
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/tools/common/flag"
 )
@@ -120,6 +121,80 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:        "activity",
+			Aliases:     []string{"act"},
+			Usage:       "Operate on a pending activity of a workflow execution",
+			Subcommands: newAdminActivityCommands(),
+		},
+	}
+}
+
+func newAdminActivityCommands() []cli.Command {
+	flagsForActivity := func(flags ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			cli.StringFlag{
+				Name:  FlagWorkflowIDWithAlias,
+				Usage: "WorkflowID",
+			},
+			cli.StringFlag{
+				Name:  FlagRunIDWithAlias,
+				Usage: "RunID",
+			},
+			cli.StringFlag{
+				Name:  FlagActivityIDWithAlias,
+				Usage: "The activityID to operate on",
+			},
+		}, flags...)
+	}
+	return []cli.Command{
+		{
+			Name:  "update",
+			Usage: "Update timeouts and retry policy of a pending activity",
+			Flags: flagsForActivity(getFlagsForActivityOptions()...),
+			Action: func(c *cli.Context) {
+				AdminActivityOperation(c, types.PendingActivityOperationUpdateOptions)
+			},
+		},
+		{
+			Name:  "retry",
+			Usage: "Dispatch an activity waiting for its next retry immediately",
+			Flags: flagsForActivity(cli.BoolFlag{
+				Name:  FlagResetAttempts,
+				Usage: "Reset the attempt count of the activity",
+			}),
+			Action: func(c *cli.Context) {
+				AdminActivityOperation(c, types.PendingActivityOperationRetry)
+			},
+		},
+		{
+			Name:  "fail",
+			Usage: "Fail a pending activity without retrying it",
+			Flags: flagsForActivity(
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason to fail the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagDetail,
+					Usage: "Detail to fail the activity",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminActivityOperation(c, types.PendingActivityOperationFail)
+			},
+		},
+		{
+			Name:  "skip",
+			Usage: "Complete a pending activity with the given result",
+			Flags: flagsForActivity(cli.StringFlag{
+				Name:  FlagResult,
+				Usage: "Result of the activity",
+			}),
+			Action: func(c *cli.Context) {
+				AdminActivityOperation(c, types.PendingActivityOperationSkip)
+			},
+		},
 	}
 }
 
@@ -259,7 +334,7 @@ func newAdminShardManagementCommands() []cli.Command {
 					Name: FlagTimerType,
 					Usage: "timer types: 0 - DecisionTimeoutTask, 1 - TaskTypeActivityTimeout, " +
 						"2 - TaskTypeUserTimer, 3 - TaskTypeWorkflowTimeout, 4 - TaskTypeDeleteHistoryEvent, " +
-						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer",
+						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer, 7 - TaskTypeCompletionCallbackRetryTimer",
 					Value: &cli.IntSlice{-1},
 				},
 				cli.BoolFlag{
@@ -529,12 +604,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 			Name:    "catIndex",
 			Aliases: []string{"cind"},
 			Usage:   "Cat Indices on ElasticSearch",
-			Flags: []cli.Flag{
-				cli.StringFlag{
-					Name:  FlagURL,
-					Usage: "URL of ElasticSearch cluster",
-				},
-			},
+			Flags:   getESConnectionFlags(),
 			Action: func(c *cli.Context) {
 				AdminCatIndices(c)
 			},
@@ -543,11 +613,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 			Name:    "index",
 			Aliases: []string{"ind"},
 			Usage:   "Index docs on ElasticSearch",
-			Flags: []cli.Flag{
-				cli.StringFlag{
-					Name:  FlagURL,
-					Usage: "URL of ElasticSearch cluster",
-				},
+			Flags: append(getESConnectionFlags(),
 				cli.StringFlag{
 					Name:  FlagIndex,
 					Usage: "ElasticSearch target index",
@@ -561,7 +627,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 					Usage: "Optional batch size of actions for bulk operations",
 					Value: 1000,
 				},
-			},
+			),
 			Action: func(c *cli.Context) {
 				AdminIndex(c)
 			},
@@ -570,11 +636,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 			Name:    "delete",
 			Aliases: []string{"del"},
 			Usage:   "Delete docs on ElasticSearch",
-			Flags: []cli.Flag{
-				cli.StringFlag{
-					Name:  FlagURL,
-					Usage: "URL of ElasticSearch cluster",
-				},
+			Flags: append(getESConnectionFlags(),
 				cli.StringFlag{
 					Name:  FlagIndex,
 					Usage: "ElasticSearch target index",
@@ -594,7 +656,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 					Usage: "Optional batch request rate per second",
 					Value: 30,
 				},
-			},
+			),
 			Action: func(c *cli.Context) {
 				AdminDelete(c)
 			},
@@ -603,11 +665,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 			Name:    "report",
 			Aliases: []string{"rep"},
 			Usage:   "Generate Report by Aggregation functions on ElasticSearch",
-			Flags: []cli.Flag{
-				cli.StringFlag{
-					Name:  FlagURL,
-					Usage: "URL of ElasticSearch cluster",
-				},
+			Flags: append(getESConnectionFlags(),
 				cli.StringFlag{
 					Name:  FlagIndex,
 					Usage: "ElasticSearch target index",
@@ -624,7 +682,7 @@ func newAdminElasticSearchCommands() []cli.Command {
 					Name:  FlagOutputFilename,
 					Usage: "Additional output filename with path",
 				},
-			},
+			),
 			Action: func(c *cli.Context) {
 				GenerateReport(c)
 			},
@@ -632,6 +690,40 @@ func newAdminElasticSearchCommands() []cli.Command {
 	}
 }
 
+func getESConnectionFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagURL,
+			Usage: "URL of ElasticSearch cluster",
+		},
+		cli.StringFlag{
+			Name:  FlagESVersion,
+			Value: "v6",
+			Usage: "Version of ElasticSearch cluster: v6, v7 or os2 (OpenSearch)",
+		},
+		cli.StringFlag{
+			Name:  FlagUsername,
+			Usage: "Optional username of ElasticSearch cluster",
+		},
+		cli.StringFlag{
+			Name:  FlagPassword,
+			Usage: "Optional password of ElasticSearch cluster",
+		},
+		cli.BoolFlag{
+			Name:  FlagEnableTLS,
+			Usage: "enable custom TLS config over https connection to ElasticSearch cluster",
+		},
+		cli.StringFlag{
+			Name:  FlagTLSCaPath,
+			Usage: "ElasticSearch tls ca path (tls must be enabled)",
+		},
+		cli.BoolFlag{
+			Name:  FlagTLSEnableHostVerification,
+			Usage: "ElasticSearch tls verify hostname and server cert (tls must be enabled)",
+		},
+	}
+}
+
 func newAdminTaskListCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -667,6 +759,43 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminListTaskList(c)
 			},
 		},
+		{
+			Name:    "move",
+			Aliases: []string{"mv"},
+			Usage:   "Move backlog tasks of all partitions of a tasklist to another tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Source TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetTaskListWithAlias,
+					Usage: "Target TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSize,
+					Value: 100,
+					Usage: "Number of tasks moved from each partition of the source TaskList per batch",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Max number of tasks moved per second across all partitions of the source TaskList",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the tasks that would be moved",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMoveTaskList(c)
+			},
+		},
 	}
 }
 
@@ -729,6 +858,60 @@ func newAdminClusterCommands() []cli.Command {
 			Usage:       "Rebalance the domains active cluster",
 			Subcommands: newAdminRebalanceCommands(),
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Show the replication backlog, DLQ size and estimated catch up time per domain from a source cluster to a target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Source cluster of the replication, default to the cluster of the address",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Target cluster of the replication, only the DLQ is shown when it is the cluster of the address",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Only show the status of the given shard",
+					Value: -1,
+				},
+				cli.IntFlag{
+					Name:  FlagSampleInterval,
+					Usage: "Seconds between two reads of the status used to estimate the catch up time, 0 disables the estimate. Each read scans the backlog and DLQ of every shard",
+					Value: 0,
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the status of every shard and domain in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReplicationStatus(c)
+			},
+		},
+		{
+			Name:    "replication-divergences",
+			Aliases: []string{"rd"},
+			Usage:   "Print the workflows found diverged between clusters by the replication verifier workflow of the cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Run of the replication verifier workflow to print the divergences of, default is the last completed run",
+				},
+				cli.StringFlag{
+					Name:  FlagBlobstoreDirectory,
+					Usage: "Output directory of the file blobstore of the worker service (see blobstore.filestore.outputDirectory in config)",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file to write to, if not provided output is written to stdout",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReplicationDivergences(c)
+			},
+		},
 	}
 }
 
@@ -1054,7 +1237,7 @@ func getQueueCommandFlags() []cli.Flag {
 		},
 		cli.IntFlag{
 			Name:  FlagQueueType,
-			Usage: "queue type: 2 (transfer queue), 3 (timer queue) or 6 (cross-cluster queue)",
+			Usage: "queue type: 2 (transfer queue), 3 (timer queue), 4 (replication queue) or 6 (cross-cluster queue)",
 		},
 	}
 }
@@ -1172,6 +1355,13 @@ func newAdminFailoverCommands() []cli.Command {
 				AdminFailoverQuery(c)
 			},
 		},
+		{
+			Name:  "auto",
+			Usage: "query the state and decisions of the auto failover controller",
+			Action: func(c *cli.Context) {
+				AdminFailoverAutoQuery(c)
+			},
+		},
 		{
 			Name:    "abort",
 			Aliases: []string{"a"},
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminActivityOperation applies an operation to a pending activity of a workflow execution
+func AdminActivityOperation(c *cli.Context, operation types.PendingActivityOperation) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := buildOperatePendingActivityRequest(c, operation)
+	request.Domain = getRequiredGlobalOption(c, FlagDomain)
+	request.WorkflowExecution = &types.WorkflowExecution{
+		WorkflowID: getRequiredOption(c, FlagWorkflowID),
+		RunID:      c.String(FlagRunID),
+	}
+	request.Identity = getCliIdentity()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if err := adminClient.OperatePendingActivity(ctx, request); err != nil {
+		ErrorAndExit(fmt.Sprintf("Activity operation %v failed.", operation), err)
+	}
+	fmt.Printf("Activity operation %v succeeded.\n", operation)
+}
+
+// parsePendingActivityOperation parses the activity operation given as flag value
+func parsePendingActivityOperation(value string) types.PendingActivityOperation {
+	var operation types.PendingActivityOperation
+	if err := operation.UnmarshalText([]byte(value)); err != nil || operation.String() != strings.ToUpper(value) {
+		ErrorAndExit(fmt.Sprintf("Invalid activity operation %q.", value), err)
+	}
+	return operation
+}
+
+// buildOperatePendingActivityRequest builds the activity operation from the command flags,
+// domain and workflow execution are left to the caller
+func buildOperatePendingActivityRequest(c *cli.Context, operation types.PendingActivityOperation) *types.OperatePendingActivityRequest {
+	request := &types.OperatePendingActivityRequest{
+		ActivityID: getRequiredOption(c, FlagActivityID),
+		Operation:  operation.Ptr(),
+	}
+	switch operation {
+	case types.PendingActivityOperationUpdateOptions:
+		request.ScheduleToStartTimeoutSeconds = getInt32PtrIfSet(c, FlagScheduleToStartTimeout)
+		request.ScheduleToCloseTimeoutSeconds = getInt32PtrIfSet(c, FlagScheduleToCloseTimeout)
+		request.StartToCloseTimeoutSeconds = getInt32PtrIfSet(c, FlagStartToCloseTimeout)
+		request.HeartbeatTimeoutSeconds = getInt32PtrIfSet(c, FlagHeartbeatTimeout)
+		if c.IsSet(FlagRetryInterval) {
+			request.RetryPolicy = &types.RetryPolicy{
+				InitialIntervalInSeconds:    int32(c.Int(FlagRetryInterval)),
+				BackoffCoefficient:          c.Float64(FlagRetryBackoff),
+				MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaxInterval)),
+				MaximumAttempts:             int32(c.Int(FlagRetryAttempts)),
+				ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpiration)),
+			}
+		}
+	case types.PendingActivityOperationRetry:
+		request.ResetAttempts = c.Bool(FlagResetAttempts)
+	case types.PendingActivityOperationFail:
+		request.Reason = common.StringPtr(c.String(FlagReason))
+		request.Details = []byte(c.String(FlagDetail))
+	case types.PendingActivityOperationSkip:
+		request.Result = []byte(c.String(FlagResult))
+	}
+	return request
+}
+
+func getInt32PtrIfSet(c *cli.Context, flagName string) *int32 {
+	if !c.IsSet(flagName) {
+		return nil
+	}
+	return common.Int32Ptr(int32(c.Int(flagName)))
+}
@@ -21,15 +21,25 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	"go.uber.org/cadence/.gen/go/shared"
 	cclient "go.uber.org/cadence/client"
 
+	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/service/worker/failovermanager"
+	"github.com/uber/cadence/service/worker/replicationverifier"
 
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/types"
@@ -116,6 +126,77 @@ func AdminRebalanceList(c *cli.Context) {
 	ListWorkflow(c)
 }
 
+// AdminReplicationDivergences prints the divergences found by a run of the replication verifier workflow, one JSON object per line
+func AdminReplicationDivergences(c *cli.Context) {
+	blobstoreClient, err := filestore.NewFilestoreClient(&config.FileBlobstore{
+		OutputDirectory: getRequiredOption(c, FlagBlobstoreDirectory),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to create blobstore client.", err)
+	}
+	result := getReplicationVerifierResult(c)
+
+	output := getOutputFile(c.String(FlagOutputFilename))
+	defer output.Close()
+	ctx, cancel := newContext(c)
+	defer cancel()
+	for _, domain := range result.Domains {
+		if domain.Keys == nil {
+			continue
+		}
+		itr := replicationverifier.NewDivergenceIterator(ctx, blobstoreClient, *domain.Keys)
+		for itr.HasNext() {
+			divergence, err := itr.Next()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to read divergences of domain %v.", domain.Domain), err)
+			}
+			data, err := json.Marshal(divergence)
+			if err != nil {
+				ErrorAndExit("Failed to encode divergence.", err)
+			}
+			fmt.Fprintln(output, string(data))
+		}
+	}
+}
+
+// getReplicationVerifierResult returns the result of the given run of the replication verifier workflow,
+// or the result of the last completed run if no run is given
+func getReplicationVerifierResult(c *cli.Context) *replicationverifier.VerifierResult {
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	runID := c.String(FlagRunID)
+	history, err := GetHistory(ctx, client, replicationverifier.WorkflowID, runID)
+	if err != nil {
+		ErrorAndExit("Failed to read history of replication verifier workflow.", err)
+	}
+	if len(history.Events) == 0 {
+		ErrorAndExit("Replication verifier workflow has no history.", nil)
+	}
+
+	var payload []byte
+	if runID == "" {
+		// each run of the cron workflow is started with the result of the last completed run
+		payload = history.Events[0].GetWorkflowExecutionStartedEventAttributes().GetLastCompletionResult()
+	} else {
+		lastEvent := history.Events[len(history.Events)-1]
+		switch lastEvent.GetEventType() {
+		case shared.EventTypeWorkflowExecutionCompleted:
+			payload = lastEvent.GetWorkflowExecutionCompletedEventAttributes().GetResult()
+		case shared.EventTypeWorkflowExecutionContinuedAsNew:
+			payload = lastEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetLastCompletionResult()
+		}
+	}
+	if len(payload) == 0 {
+		ErrorAndExit("Replication verifier workflow run has no result.", nil)
+	}
+	var result replicationverifier.VerifierResult
+	if err := json.Unmarshal(payload, &result); err != nil {
+		ErrorAndExit("Failed to decode result of replication verifier workflow.", err)
+	}
+	return &result
+}
+
 func intValTypeToString(valType int) string {
 	switch valType {
 	case 0:
@@ -138,3 +219,256 @@ func intValTypeToString(valType int) string {
 func isValueTypeValid(valType int) bool {
 	return valType >= 0 && valType <= 5
 }
+
+// AdminReplicationStatus shows the replication status between a source and a target cluster
+func AdminReplicationStatus(c *cli.Context) {
+	request := &types.GetReplicationStatusRequest{
+		SourceCluster: c.String(FlagSourceCluster),
+		TargetCluster: getRequiredOption(c, FlagTargetCluster),
+	}
+	if shardID := c.Int(FlagShardID); shardID >= 0 {
+		request.ShardIDs = []int32{int32(shardID)}
+	}
+	sampleInterval := time.Duration(c.Int(FlagSampleInterval)) * time.Second
+
+	adminClient := cFactory.ServerAdminClient(c)
+	first := getReplicationStatus(c, adminClient, request)
+	firstRead := time.Now()
+	last := first
+	if sampleInterval > 0 {
+		time.Sleep(sampleInterval)
+		last = getReplicationStatus(c, adminClient, request)
+	}
+
+	domains := aggregateReplicationStatusByDomain(first, last, time.Since(firstRead), sampleInterval > 0)
+	if domainName := c.GlobalString(FlagDomain); domainName != "" {
+		var filtered []*replicationStatus
+		for _, domain := range domains {
+			if domain.Domain == domainName {
+				filtered = append(filtered, domain)
+			}
+		}
+		domains = filtered
+	}
+	// the catch up time of all domains is only known when it is known for every domain
+	total := &replicationStatus{Domain: "(all)"}
+	catchUpTime, catchUpTimeKnown := time.Duration(0), sampleInterval > 0
+	for _, domain := range domains {
+		total.merge(domain)
+		if domain.CatchUpTime == nil {
+			catchUpTimeKnown = false
+		} else if *domain.CatchUpTime > catchUpTime {
+			catchUpTime = *domain.CatchUpTime
+		}
+	}
+	if catchUpTimeKnown {
+		total.CatchUpTime = &catchUpTime
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(struct {
+			Total   *replicationStatus                  `json:"total"`
+			Domains []*replicationStatus                `json:"domains"`
+			Shards  *types.GetReplicationStatusResponse `json:"shards"`
+		}{total, domains, last})
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Domain", "Backlog", "Replicated Up To", "Lag", "DLQ Size", "Catch Up Time"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	now := time.Now()
+	table.Append(total.row(now))
+	for _, domain := range domains {
+		table.Append(domain.row(now))
+	}
+	table.Render()
+
+	var truncatedShardIDs []int
+	for shardID, shard := range last.GetStatusByShard() {
+		if shard.GetTruncated() {
+			truncatedShardIDs = append(truncatedShardIDs, int(shardID))
+		}
+	}
+	if len(truncatedShardIDs) > 0 {
+		sort.Ints(truncatedShardIDs)
+		fmt.Println(colorRed("The backlog or DLQ of shards has more tasks than were read, their backlog, DLQ size and catch up time are lower bounds:"))
+		fmt.Println(truncatedShardIDs)
+	}
+
+	if len(last.FailedCauseByShard) > 0 {
+		shardIDs := make([]int, 0, len(last.FailedCauseByShard))
+		for shardID := range last.FailedCauseByShard {
+			shardIDs = append(shardIDs, int(shardID))
+		}
+		sort.Ints(shardIDs)
+		fmt.Println(colorRed("Failed to read the status of shards:"))
+		for _, shardID := range shardIDs {
+			fmt.Printf("%v: %v\n", shardID, last.FailedCauseByShard[int32(shardID)])
+		}
+	}
+}
+
+// replicationStatus is the replication status of a domain aggregated over shards
+type replicationStatus struct {
+	DomainID string `json:"domainID,omitempty"`
+	Domain   string `json:"domain"`
+	// Backlog is the number of replication tasks not acknowledged by the target cluster yet
+	Backlog int64 `json:"backlog"`
+	// ReplicatedUpTo is the creation time of the oldest replication task not acknowledged by the
+	// target cluster, all tasks created before it were replicated. It is not set without backlog.
+	ReplicatedUpTo *time.Time `json:"replicatedUpTo,omitempty"`
+	// DLQSize is the number of replication tasks in the DLQ of the target cluster
+	DLQSize int64 `json:"dlqSize"`
+	// CatchUpTime is the estimated time to drain the backlog at the replication rate observed
+	// between two reads of the status, it is not set when the rate is unknown
+	CatchUpTime *time.Duration `json:"catchUpTime,omitempty"`
+}
+
+func getReplicationStatus(
+	c *cli.Context,
+	adminClient admin.Client,
+	request *types.GetReplicationStatusRequest,
+) *types.GetReplicationStatusResponse {
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetReplicationStatus(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to get the replication status.", err)
+	}
+	return response
+}
+
+// aggregateReplicationStatusByDomain aggregates the status of shards by domain, sorted by domain name.
+// When the status was sampled twice, the catch up time is estimated from how far the oldest
+// unreplicated task moved between the two samples.
+func aggregateReplicationStatusByDomain(
+	first *types.GetReplicationStatusResponse,
+	last *types.GetReplicationStatusResponse,
+	interval time.Duration,
+	sampled bool,
+) []*replicationStatus {
+
+	domains := make(map[string]*replicationStatus)
+	previous := make(map[string]*replicationStatus)
+	for _, shard := range last.GetStatusByShard() {
+		for _, domainStatus := range shard.Domains {
+			domain, ok := domains[domainStatus.DomainID]
+			if !ok {
+				domain = &replicationStatus{DomainID: domainStatus.DomainID, Domain: domainStatus.Domain}
+				domains[domainStatus.DomainID] = domain
+			}
+			domain.add(domainStatus)
+		}
+	}
+	for shardID, shard := range first.GetStatusByShard() {
+		if _, ok := last.GetStatusByShard()[shardID]; !ok {
+			continue
+		}
+		for _, domainStatus := range shard.Domains {
+			domain, ok := previous[domainStatus.DomainID]
+			if !ok {
+				domain = &replicationStatus{}
+				previous[domainStatus.DomainID] = domain
+			}
+			domain.add(domainStatus)
+		}
+	}
+
+	result := make([]*replicationStatus, 0, len(domains))
+	for domainID, domain := range domains {
+		if sampled {
+			domain.estimateCatchUpTime(previous[domainID], interval)
+		}
+		result = append(result, domain)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Domain != result[j].Domain {
+			return result[i].Domain < result[j].Domain
+		}
+		return result[i].DomainID < result[j].DomainID
+	})
+	return result
+}
+
+func (s *replicationStatus) add(
+	domainStatus *types.ReplicationDomainStatus,
+) {
+	s.Backlog += domainStatus.Backlog
+	s.DLQSize += domainStatus.DLQSize
+	if domainStatus.OldestTaskTimestamp != nil {
+		s.addReplicatedUpTo(time.Unix(0, *domainStatus.OldestTaskTimestamp))
+	}
+}
+
+func (s *replicationStatus) merge(
+	other *replicationStatus,
+) {
+	s.Backlog += other.Backlog
+	s.DLQSize += other.DLQSize
+	if other.ReplicatedUpTo != nil {
+		s.addReplicatedUpTo(*other.ReplicatedUpTo)
+	}
+}
+
+func (s *replicationStatus) addReplicatedUpTo(
+	replicatedUpTo time.Time,
+) {
+	if s.ReplicatedUpTo == nil || replicatedUpTo.Before(*s.ReplicatedUpTo) {
+		s.ReplicatedUpTo = &replicatedUpTo
+	}
+}
+
+func (s *replicationStatus) estimateCatchUpTime(
+	previous *replicationStatus,
+	interval time.Duration,
+) {
+	if s.Backlog == 0 {
+		catchUpTime := time.Duration(0)
+		s.CatchUpTime = &catchUpTime
+		return
+	}
+	if previous == nil || previous.ReplicatedUpTo == nil || s.ReplicatedUpTo == nil || interval <= 0 {
+		return
+	}
+	// the lag shrinks by the replicated time minus the elapsed time
+	progress := s.ReplicatedUpTo.Sub(*previous.ReplicatedUpTo)
+	if progress <= interval {
+		return
+	}
+	lag := time.Since(*s.ReplicatedUpTo)
+	catchUpTime := time.Duration(float64(lag) * float64(interval) / float64(progress-interval))
+	s.CatchUpTime = &catchUpTime
+}
+
+func (s *replicationStatus) row(
+	now time.Time,
+) []string {
+
+	name := s.Domain
+	if name == "" {
+		name = s.DomainID
+	}
+	replicatedUpTo := "-"
+	lag := time.Duration(0)
+	if s.ReplicatedUpTo != nil {
+		replicatedUpTo = convertTime(s.ReplicatedUpTo.UnixNano(), false)
+		lag = now.Sub(*s.ReplicatedUpTo).Round(time.Second)
+	}
+	catchUpTime := "unknown"
+	if s.CatchUpTime != nil {
+		catchUpTime = s.CatchUpTime.Round(time.Second).String()
+	}
+	return []string{
+		name,
+		strconv.FormatInt(s.Backlog, 10),
+		replicatedUpTo,
+		lag.String(),
+		strconv.FormatInt(s.DLQSize, 10),
+		catchUpTime,
+	}
+}
@@ -23,6 +23,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/urfave/cli"
@@ -52,6 +53,7 @@ func AdminGetDLQMessages(c *cli.Context) {
 
 	showRawTask := c.Bool(FlagDLQRawTask)
 	var rawTasksInfo []*types.ReplicationTaskInfo
+	var retryStates []*types.ReplicationDLQTaskState
 	remainingMessageCount := common.EndMessageID
 	if c.IsSet(FlagMaxMessageCount) {
 		remainingMessageCount = c.Int64(FlagMaxMessageCount)
@@ -80,6 +82,7 @@ func AdminGetDLQMessages(c *cli.Context) {
 		if showRawTask {
 			rawTasksInfo = append(rawTasksInfo, resp.GetReplicationTasksInfo()...)
 		}
+		retryStates = append(retryStates, resp.GetRetryStates()...)
 
 		return paginateItems, resp.GetNextPageToken(), err
 	}
@@ -130,6 +133,31 @@ func AdminGetDLQMessages(c *cli.Context) {
 			}
 		}
 	}
+
+	if len(retryStates) > 0 {
+		printDLQRetryStates(outputFile, retryStates)
+	}
+}
+
+// printDLQRetryStates prints the error class and the retry state of the replication DLQ tasks which
+// were retried automatically by the history service
+func printDLQRetryStates(
+	outputFile *os.File,
+	retryStates []*types.ReplicationDLQTaskState,
+) {
+
+	if _, err := outputFile.WriteString("#### REPLICATION DLQ RETRY STATES ####\n"); err != nil {
+		ErrorAndExit("fail to print dlq retry states.", err)
+	}
+	for _, state := range retryStates {
+		str, err := json.Marshal(state)
+		if err != nil {
+			ErrorAndExit("fail to encode dlq retry states.", err)
+		}
+		if _, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(str))); err != nil {
+			ErrorAndExit("fail to print dlq retry states.", err)
+		}
+	}
 }
 
 // AdminPurgeDLQMessages deletes messages from DLQ
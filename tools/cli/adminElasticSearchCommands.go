@@ -35,7 +35,7 @@ import (
 	"github.com/uber/cadence/common/tokenbucket"
 
 	"github.com/olekukonko/tablewriter"
-	"github.com/olivere/elastic"
+	"github.com/olivere/elastic/v7"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/.gen/go/indexer"
@@ -46,6 +46,7 @@ import (
 const (
 	esDocIDDelimiter = "~"
 	esDocType        = "_doc"
+	esVersionOS2     = "os2"
 
 	versionTypeExternal = "external"
 )
@@ -108,6 +109,7 @@ func AdminIndex(c *cli.Context) {
 	indexName := getRequiredOption(c, FlagIndex)
 	inputFileName := getRequiredOption(c, FlagInputFile)
 	batchSize := c.Int(FlagBatchSize)
+	docType := getESDocType(c)
 
 	messages, err := parseIndexerMessage(inputFileName)
 	if err != nil {
@@ -132,7 +134,7 @@ func AdminIndex(c *cli.Context) {
 			doc := generateESDoc(message)
 			req = elastic.NewBulkIndexRequest().
 				Index(indexName).
-				Type(esDocType).
+				Type(docType).
 				Id(docID).
 				VersionType(versionTypeExternal).
 				Version(message.GetVersion()).
@@ -140,7 +142,7 @@ func AdminIndex(c *cli.Context) {
 		case indexer.MessageTypeDelete:
 			req = elastic.NewBulkDeleteRequest().
 				Index(indexName).
-				Type(esDocType).
+				Type(docType).
 				Id(docID).
 				VersionType(versionTypeExternal).
 				Version(message.GetVersion())
@@ -165,6 +167,7 @@ func AdminDelete(c *cli.Context) {
 	inputFileName := getRequiredOption(c, FlagInputFile)
 	batchSize := c.Int(FlagBatchSize)
 	rps := c.Int(FlagRPS)
+	docType := getESDocType(c)
 	ratelimiter := tokenbucket.New(rps, clock.NewRealTimeSource())
 
 	// This is only executed from the CLI by an admin user
@@ -199,7 +202,7 @@ func AdminDelete(c *cli.Context) {
 		docID := strings.TrimSpace(line[1]) + esDocIDDelimiter + strings.TrimSpace(line[2])
 		req := elastic.NewBulkDeleteRequest().
 			Index(indexName).
-			Type(esDocType).
+			Type(docType).
 			Id(docID).
 			VersionType(versionTypeExternal).
 			Version(math.MaxInt64)
@@ -214,6 +217,14 @@ func AdminDelete(c *cli.Context) {
 	}
 }
 
+// getESDocType returns the mapping type of documents, which is removed in OpenSearch 2.x
+func getESDocType(c *cli.Context) string {
+	if c.String(FlagESVersion) == esVersionOS2 {
+		return ""
+	}
+	return esDocType
+}
+
 func parseIndexerMessage(fileName string) (messages []*indexer.Message, err error) {
 	// Executed from the CLI to parse existing elastiseach files
 	// #nosec
@@ -296,8 +307,7 @@ func toTimeStr(s interface{}) string {
 
 // GenerateReport generate report for an aggregation query to ES
 func GenerateReport(c *cli.Context) {
-	// use url command argument to create client
-	url := getRequiredOption(c, FlagURL)
+	esClient := cFactory.ElasticSearchClient(c)
 	index := getRequiredOption(c, FlagIndex)
 	sql := getRequiredOption(c, FlagListQuery)
 	var reportFormat, reportFilePath string
@@ -309,10 +319,6 @@ func GenerateReport(c *cli.Context) {
 	} else {
 		reportFilePath = "./report." + reportFormat
 	}
-	esClient, err := elastic.NewClient(elastic.SetURL(url))
-	if err != nil {
-		ErrorAndExit("Fail to create elastic client", err)
-	}
 	ctx := context.Background()
 
 	// convert sql to dsl
@@ -335,7 +341,7 @@ func GenerateReport(c *cli.Context) {
 	var headers []string
 	var groupby, bucket map[string]interface{}
 	var buckets []interface{}
-	err = json.Unmarshal(*resp.Aggregations["groupby"], &groupby)
+	err = json.Unmarshal(resp.Aggregations["groupby"], &groupby)
 	if err != nil {
 		ErrorAndExit("Fail to parse groupby", err)
 	}
@@ -107,6 +107,32 @@ func AdminFailoverQuery(c *cli.Context) {
 	prettyPrintJSONObject(result)
 }
 
+// AdminFailoverAutoQuery query the auto failover controller
+func AdminFailoverAutoQuery(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	queryResp, err := client.QueryWorkflow(tcCtx, failovermanager.AutoFailoverWorkflowID, "", failovermanager.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to query auto failover controller", err)
+	}
+	var result failovermanager.AutoFailoverQueryResult
+	if err := queryResp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode auto failover controller state", err)
+	}
+	prettyPrintJSONObject(result)
+
+	if result.BackupCluster == "" {
+		fmt.Printf("NOTE: only the controller in the primary cluster %q makes decisions. There is no backup cluster, "+
+			"the domains active in the primary cluster are not failed over automatically.\n", result.PrimaryCluster)
+	} else {
+		fmt.Printf("NOTE: only the controllers in the primary cluster %q and in the backup cluster %q make decisions. "+
+			"There is no quorum between the clusters, a partition between them makes both active for the domains of "+
+			"the primary cluster.\n", result.PrimaryCluster, result.BackupCluster)
+	}
+}
+
 // AdminFailoverAbort abort a failover workflow
 func AdminFailoverAbort(c *cli.Context) {
 	client := getCadenceClient(c)
@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -102,6 +103,53 @@ func AdminListTaskList(c *cli.Context) {
 	table.Render()
 }
 
+// AdminMoveTaskList moves the backlog tasks of a task list to another task list.
+func AdminMoveTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	sourceTaskList := getRequiredOption(c, FlagTaskList)
+	targetTaskList := getRequiredOption(c, FlagTargetTaskList)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+	dryRun := c.Bool(FlagDryRun)
+	request := &types.MoveTaskListRequest{
+		Domain:         domain,
+		SourceTaskList: &types.TaskList{Name: sourceTaskList},
+		TargetTaskList: &types.TaskList{Name: targetTaskList},
+		TaskListType:   &taskListType,
+		BatchSize:      int32(c.Int(FlagBatchSize)),
+		RPS:            int32(c.Int(FlagRPS)),
+		DryRun:         dryRun,
+	}
+
+	var tasksMoved, tasksExpired int64
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.MoveTaskList(ctx, request)
+		cancel()
+		tasksMoved += resp.GetTasksMoved()
+		tasksExpired += resp.GetTasksExpired()
+		if err == nil && resp.GetFailure() != "" {
+			err = errors.New(resp.GetFailure())
+		}
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to move tasks. Tasks moved: %v, expired tasks dropped: %v.", tasksMoved, tasksExpired), err)
+		}
+		// a dry run counts the whole backlog in one call, a real move only
+		// drains up to a batch per partition so keep going until it is empty
+		if dryRun || (resp.GetTasksMoved() == 0 && resp.GetTasksExpired() == 0) {
+			break
+		}
+	}
+	if dryRun {
+		fmt.Printf("Tasks to move: %v, expired tasks: %v\n", tasksMoved, tasksExpired)
+	} else {
+		fmt.Printf("Tasks moved: %v, expired tasks dropped: %v\n", tasksMoved, tasksExpired)
+	}
+}
+
 func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 
@@ -117,21 +165,31 @@ func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 		strconv.FormatInt(taskIDBlock.GetStartID(), 10),
 		strconv.FormatInt(taskIDBlock.GetEndID(), 10)})
 	table.Render()
+	fmt.Printf("\n")
+	printTaskListBacklogStatus(taskListStatus)
 }
 
 func printPollerInfo(pollers []*types.PollerInfo, taskListType types.TaskListType) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
+	table.SetHeaderLine(false)
 	if taskListType == types.TaskListTypeActivity {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
+		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time", "Outstanding Tasks"})
+		table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+		for _, poller := range pollers {
+			table.Append([]string{
+				poller.GetIdentity(),
+				convertTime(poller.GetLastAccessTime(), false),
+				strconv.FormatInt(poller.GetOutstandingTasks(), 10),
+			})
+		}
 	} else {
 		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
-	}
-	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+		table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+		for _, poller := range pollers {
+			table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+		}
 	}
 	table.Render()
 }
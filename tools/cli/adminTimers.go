@@ -156,6 +156,7 @@ func AdminTimers(c *cli.Context) {
 			persistence.TaskTypeDeleteHistoryEvent,
 			persistence.TaskTypeActivityRetryTimer,
 			persistence.TaskTypeWorkflowBackoffTimer,
+			persistence.TaskTypeCompletionCallbackRetryTimer,
 		}
 	}
 
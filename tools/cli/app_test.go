@@ -21,25 +21,28 @@
 package cli
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/olekukonko/tablewriter"
-	"github.com/olivere/elastic"
+	"github.com/olivere/elastic/v7"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	clientFrontendTest "go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
 	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/failovermanager"
 )
 
 type cliAppSuite struct {
@@ -211,6 +214,55 @@ func (s *cliAppSuite) TestDomainUpdate_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestDomainFailover() {
+	s.expectFailoverReadiness(true)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr("standby"),
+	}).Return(&types.UpdateDomainResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--active_cluster", "standby"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainFailover_DryRun() {
+	s.expectFailoverReadiness(false)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--active_cluster", "standby", "--dry_run"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainFailover_NotReady() {
+	s.expectFailoverReadiness(false)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "failover", "--active_cluster", "standby"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDomainFailover_Force() {
+	s.expectFailoverReadiness(false)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:                     domainName,
+		ActiveClusterName:        common.StringPtr("standby"),
+		FailoverTimeoutInSeconds: common.Int32Ptr(30),
+	}).Return(&types.UpdateDomainResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--active_cluster", "standby", "--ft", "grace", "--fts", "30", "--force"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) expectFailoverReadiness(ready bool) {
+	s.serverAdminClient.EXPECT().GetFailoverReadiness(gomock.Any(), &types.GetFailoverReadinessRequest{
+		Domain:                          domainName,
+		MaxReplicationLagInSeconds:      common.Int32Ptr(defaultMaxReplicationLagInSeconds),
+		ReplicationErrorWindowInSeconds: common.Int32Ptr(defaultReplicationErrorWindowInSeconds),
+	}).Return(&types.GetFailoverReadinessResponse{
+		DomainID:      "domain-id",
+		Cluster:       "standby",
+		SourceCluster: "active",
+		Ready:         ready,
+		Checks: []*types.FailoverReadinessCheck{
+			{Name: "replication lag", Passed: ready, Message: "some message"},
+		},
+	}, nil)
+}
+
 func (s *cliAppSuite) TestDomainDeprecate() {
 	s.clientFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(&shared.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.clientFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(&shared.ListOpenWorkflowExecutionsResponse{}, nil)
@@ -431,6 +483,51 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, request *types.PauseWorkflowExecutionRequest, _ ...yarpc.CallOption) {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+			s.Equal("incident", request.GetReason())
+			s.True(request.GetAllowActivities())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "incident", "--allow_activities"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, request *types.UnpauseWorkflowExecutionRequest, _ ...yarpc.CallOption) {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminActivityRetry() {
+	s.serverAdminClient.EXPECT().OperatePendingActivity(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, request *types.OperatePendingActivityRequest, _ ...yarpc.CallOption) {
+			s.Equal(domainName, request.Domain)
+			s.Equal("wid", request.WorkflowExecution.WorkflowID)
+			s.Equal("aid", request.ActivityID)
+			s.Equal(types.PendingActivityOperationRetry, request.GetOperation())
+			s.True(request.ResetAttempts)
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "activity", "retry", "-w", "wid", "--activity_id", "aid", "--reset_attempts"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminActivityUpdate_Failed() {
+	s.serverAdminClient.EXPECT().OperatePendingActivity(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "workflow", "activity", "update", "-w", "wid", "--activity_id", "aid", "--heartbeat_timeout", "10"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestQueryWorkflow() {
 	resp := &shared.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -571,13 +668,44 @@ func (s *cliAppSuite) TestCountWorkflow() {
 	s.Nil(err)
 }
 
-var describeTaskListResponse = &shared.DescribeTaskListResponse{
-	Pollers: []*shared.PollerInfo{
+func (s *cliAppSuite) TestCountWorkflow_GroupBy() {
+	resp := &types.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{GroupValues: []string{`"type1"`, `1`}, Count: 2},
+			{GroupValues: []string{`"type2"`, `null`}, Count: 1},
+		},
+		Truncated: true,
+	}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain:  domainName,
+		Query:   "WorkflowType = 'type1'",
+		GroupBy: []string{"WorkflowType", "CloseStatus"},
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "WorkflowType = 'type1'", "--group-by", "WorkflowType, CloseStatus"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestFormatGroupValue() {
+	s.Equal("type1", formatGroupValue("WorkflowType", `"type1"`))
+	s.Equal("FAILED", formatGroupValue("CloseStatus", `1`))
+	s.Equal("<missing>", formatGroupValue("Attr.CustomKeywordField", `null`))
+	s.Equal(`["a","b"]`, formatGroupValue("CustomKeywordField", `["a","b"]`))
+}
+
+var describeTaskListResponse = &types.DescribeTaskListResponse{
+	Pollers: []*types.PollerInfo{
 		{
 			LastAccessTime: common.Int64Ptr(time.Now().UnixNano()),
-			Identity:       common.StringPtr("tester"),
+			Identity:       "tester",
 		},
 	},
+	TaskListStatus: &types.TaskListStatus{
+		BacklogCountHint:      10,
+		BacklogAgeInSeconds:   30,
+		DispatchRatePerSecond: 2.5,
+		SyncMatchRatio:        0.5,
+	},
 }
 
 func (s *cliAppSuite) TestAdminDescribeWorkflow() {
@@ -623,16 +751,29 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminFailoverAuto() {
+	resp := &shared.QueryWorkflowResponse{
+		QueryResult: []byte(`{"State":"running","CurrentCluster":"active","PrimaryCluster":"active"}`),
+	}
+	s.clientFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any(), callOptions...).
+		DoAndReturn(func(_ context.Context, request *shared.QueryWorkflowRequest, _ ...yarpc.CallOption) (*shared.QueryWorkflowResponse, error) {
+			s.Equal(failovermanager.AutoFailoverWorkflowID, request.GetExecution().GetWorkflowId())
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "admin", "cl", "fo", "auto"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
-	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	resp := describeTaskListResponse
-	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList", "-tlt", "activity"})
 	s.Nil(err)
 }
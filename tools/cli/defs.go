@@ -67,6 +67,8 @@ const (
 	searchAttrInputSeparator = "|"
 
 	defaultGracefulFailoverTimeoutInSeconds = 60
+	defaultMaxReplicationLagInSeconds       = 60
+	defaultReplicationErrorWindowInSeconds  = 600
 )
 
 var envKeysForUserName = []string{
@@ -65,6 +65,15 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).UpdateDomain(c)
 			},
 		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Fail a global domain over to another cluster after checking the cluster is caught up, the cluster must be the one the CLI is connected to",
+			Flags:   failoverDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, false).FailoverDomain(c)
+			},
+		},
 		{
 			Name:    "deprecate",
 			Aliases: []string{"dep"},
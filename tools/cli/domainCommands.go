@@ -260,6 +260,10 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
 		}
+		if c.IsSet(FlagFailoverTimeout) {
+			// the handoff timeout of workflows moving to other clusters with the workflow active clusters
+			updateRequest.FailoverTimeoutInSeconds = common.Int32Ptr(int32(c.Int(FlagFailoverTimeout)))
+		}
 	}
 
 	securityToken := c.String(FlagSecurityToken)
@@ -276,6 +280,87 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 	}
 }
 
+// FailoverDomain fails a global domain over to the cluster the CLI is connected to, after checking
+// the replication lag, DLQ and recent replication errors of the domain in that cluster
+func (d *domainCLIImpl) FailoverDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagActiveClusterName)
+
+	readiness := checkFailoverReadiness(c, domainName, targetCluster)
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(readiness)
+	} else {
+		printFailoverReadiness(readiness)
+	}
+
+	switch {
+	case c.Bool(FlagDryRun):
+		return
+	case !readiness.Ready && !c.Bool(FlagForce):
+		ErrorAndExit(fmt.Sprintf("Domain %s is not ready for failover to cluster %s, use --%s to fail over anyway.", domainName, targetCluster, FlagForce), nil)
+		return
+	case !readiness.Ready:
+		fmt.Println("Warning: failing over a domain that is not ready, workflows may require conflict resolution.")
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	var failoverTimeout *int32
+	if c.String(FlagFailoverType) == gracefulFailoverType {
+		timeout := int32(c.Int(FlagFailoverTimeout))
+		failoverTimeout = &timeout
+	}
+	if _, err := d.updateDomain(ctx, &types.UpdateDomainRequest{
+		Name:                     domainName,
+		ActiveClusterName:        common.StringPtr(targetCluster),
+		FailoverTimeoutInSeconds: failoverTimeout,
+	}); err != nil {
+		ErrorAndExit("Operation FailoverDomain failed.", err)
+	}
+	fmt.Printf("Domain %s successfully failed over to cluster %s.\n", domainName, targetCluster)
+}
+
+func checkFailoverReadiness(
+	c *cli.Context,
+	domainName string,
+	targetCluster string,
+) *types.GetFailoverReadinessResponse {
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	readiness, err := adminClient.GetFailoverReadiness(ctx, &types.GetFailoverReadinessRequest{
+		Domain:                          domainName,
+		MaxReplicationLagInSeconds:      common.Int32Ptr(int32(c.Int(FlagMaxReplicationLag))),
+		ReplicationErrorWindowInSeconds: common.Int32Ptr(int32(c.Int(FlagReplicationErrorWindow))),
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to check whether domain %s is ready for failover.", domainName), err)
+	}
+	if readiness.Cluster != targetCluster {
+		ErrorAndExit(fmt.Sprintf("The readiness must be checked in cluster %s, the CLI is connected to cluster %s.", targetCluster, readiness.Cluster), nil)
+	}
+	return readiness
+}
+
+func printFailoverReadiness(readiness *types.GetFailoverReadinessResponse) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Check", "Passed", "Details"})
+	for _, check := range readiness.Checks {
+		table.Append([]string{check.Name, strconv.FormatBool(check.Passed), check.Message})
+	}
+	table.Render()
+	if readiness.Ready {
+		fmt.Printf("Cluster %s is ready to become active.\n", readiness.Cluster)
+	} else {
+		fmt.Printf("Cluster %s is not ready to become active.\n", readiness.Cluster)
+	}
+}
+
 func (d *domainCLIImpl) DeprecateDomain(c *cli.Context) {
 	domainName := getRequiredGlobalOption(c, FlagDomain)
 	securityToken := c.String(FlagSecurityToken)
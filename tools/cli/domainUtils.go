@@ -179,6 +179,44 @@ var (
 		},
 	}
 
+	failoverDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagActiveClusterNameWithAlias,
+			Usage: "Required cluster name to fail the domain over to",
+		},
+		cli.StringFlag{
+			Name:  FlagFailoverTypeWithAlias,
+			Usage: "Domain failover type. Default value: force. Options: [force,grace]",
+		},
+		cli.IntFlag{
+			Name:  FlagFailoverTimeoutWithAlias,
+			Value: defaultGracefulFailoverTimeoutInSeconds,
+			Usage: "[Optional] Domain failover timeout in seconds.",
+		},
+		cli.IntFlag{
+			Name:  FlagMaxReplicationLag,
+			Value: defaultMaxReplicationLagInSeconds,
+			Usage: "Maximum replication lag in seconds of the target cluster for the domain to be ready for failover",
+		},
+		cli.IntFlag{
+			Name:  FlagReplicationErrorWindow,
+			Value: defaultReplicationErrorWindowInSeconds,
+			Usage: "Replication errors of the domain in the target cluster within this window in seconds prevent the failover",
+		},
+		cli.BoolFlag{
+			Name:  FlagDryRun,
+			Usage: "Only check whether the domain is ready for failover",
+		},
+		cli.BoolFlag{
+			Name:  FlagForce,
+			Usage: "Fail the domain over even if it is not ready",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintJSONWithAlias,
+			Usage: "Print the readiness check in raw JSON format",
+		},
+	}
+
 	deprecateDomainFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
@@ -22,9 +22,10 @@ package cli
 
 import (
 	"context"
+	"net/http"
 	"time"
 
-	"github.com/olivere/elastic"
+	"github.com/olivere/elastic/v7"
 	"github.com/urfave/cli"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/config"
 )
 
 const (
@@ -99,15 +101,33 @@ func (b *clientFactory) ServerAdminClient(c *cli.Context) admin.Client {
 	return admin.NewThriftClient(serverAdmin.New(b.dispatcher.ClientConfig(cadenceFrontendService)))
 }
 
-// ElasticSearchClient builds an ElasticSearch client
+// ElasticSearchClient builds an ElasticSearch client, which also works with ElasticSearch v6 and OpenSearch clusters
 func (b *clientFactory) ElasticSearchClient(c *cli.Context) *elastic.Client {
 	url := getRequiredOption(c, FlagURL)
 	retrier := elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))
 
-	client, err := elastic.NewClient(
+	options := []elastic.ClientOptionFunc{
 		elastic.SetURL(url),
 		elastic.SetRetrier(retrier),
-	)
+	}
+	if username := c.String(FlagUsername); username != "" {
+		options = append(options, elastic.SetBasicAuth(username, c.String(FlagPassword)))
+	}
+	if c.Bool(FlagEnableTLS) {
+		tlsConfig, err := config.TLS{
+			Enabled:                true,
+			CaFile:                 c.String(FlagTLSCaPath),
+			EnableHostVerification: c.Bool(FlagTLSEnableHostVerification),
+		}.ToTLSConfig()
+		if err != nil {
+			b.logger.Fatal("Unable to load ElasticSearch TLS config", zap.Error(err))
+		}
+		options = append(options, elastic.SetHttpClient(&http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		}))
+	}
+
+	client, err := elastic.NewClient(options...)
 	if err != nil {
 		b.logger.Fatal("Unable to create ElasticSearch client", zap.Error(err))
 	}
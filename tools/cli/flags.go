@@ -57,6 +57,8 @@ const (
 	FlagTargetClusterWithAlias            = FlagTargetCluster + ", tc"
 	FlagSourceCluster                     = "source_cluster"
 	FlagSourceClusterWithAlias            = FlagSourceCluster + ", sc"
+	FlagSampleInterval                    = "sample_interval"
+	FlagBlobstoreDirectory                = "blobstore_directory"
 	FlagMinEventID                        = "min_event_id"
 	FlagMaxEventID                        = "max_event_id"
 	FlagEndEventVersion                   = "end_event_version"
@@ -64,6 +66,8 @@ const (
 	FlagTaskListWithAlias                 = FlagTaskList + ", tl"
 	FlagTaskListType                      = "tasklisttype"
 	FlagTaskListTypeWithAlias             = FlagTaskListType + ", tlt"
+	FlagTargetTaskList                    = "target_tasklist"
+	FlagTargetTaskListWithAlias           = FlagTargetTaskList + ", ttl"
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
@@ -179,6 +183,12 @@ const (
 	FlagEventIDWithAlias                  = FlagEventID + ", eid"
 	FlagActivityID                        = "activity_id"
 	FlagActivityIDWithAlias               = FlagActivityID + ", aid"
+	FlagActivityOperation                 = "activity_operation"
+	FlagScheduleToStartTimeout            = "schedule_to_start_timeout"
+	FlagScheduleToCloseTimeout            = "schedule_to_close_timeout"
+	FlagStartToCloseTimeout               = "start_to_close_timeout"
+	FlagHeartbeatTimeout                  = "heartbeat_timeout"
+	FlagResetAttempts                     = "reset_attempts"
 	FlagMaxFieldLength                    = "max_field_length"
 	FlagMaxFieldLengthWithAlias           = FlagMaxFieldLength + ", maxl"
 	FlagSecurityToken                     = "security_token"
@@ -191,6 +201,7 @@ const (
 	FlagMessageType                       = "message_type"
 	FlagMessageTypeWithAlias              = FlagMessageType + ", mt"
 	FlagURL                               = "url"
+	FlagESVersion                         = "es_version"
 	FlagIndex                             = "index"
 	FlagBatchSize                         = "batch_size"
 	FlagBatchSizeWithAlias                = FlagBatchSize + ", bs"
@@ -208,10 +219,12 @@ const (
 	FlagSkipSignalReapply                 = "skip_signal_reapply"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
+	FlagGroupBy                           = "group-by"
 	FlagBatchType                         = "batch_type"
 	FlagBatchTypeWithAlias                = FlagBatchType + ", bt"
 	FlagSignalName                        = "signal_name"
 	FlagSignalNameWithAlias               = FlagSignalName + ", sig"
+	FlagAllowActivities                   = "allow_activities"
 	FlagTaskID                            = "task_id"
 	FlagTaskType                          = "task_type"
 	FlagTaskVisibilityTimestamp           = "task_timestamp"
@@ -260,6 +273,8 @@ const (
 	FlagFailoverDrill                     = "failover_drill"
 	FlagFailoverDrillWithAlias            = FlagFailoverDrill + ", fd"
 	FlagRetryInterval                     = "retry_interval"
+	FlagMaxReplicationLag                 = "max_replication_lag_seconds"
+	FlagReplicationErrorWindow            = "replication_error_window_seconds"
 	FlagRetryAttempts                     = "retry_attempts"
 	FlagRetryExpiration                   = "retry_expiration"
 	FlagRetryBackoff                      = "retry_backoff"
@@ -525,6 +540,70 @@ func getFlagsForTerminate() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution,
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "The reason you want to pause the workflow",
+		},
+		cli.BoolFlag{
+			Name:  FlagAllowActivities,
+			Usage: "Keep dispatching pending activities while the workflow is paused",
+		},
+	)
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution,
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "The reason you want to unpause the workflow",
+		},
+	)
+}
+
+func getFlagsForActivityOptions() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  FlagScheduleToStartTimeout,
+			Usage: "Optional new schedule to start timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagScheduleToCloseTimeout,
+			Usage: "Optional new schedule to close timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagStartToCloseTimeout,
+			Usage: "Optional new start to close timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagHeartbeatTimeout,
+			Usage: "Optional new heartbeat timeout in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagRetryInterval,
+			Usage: "Optional retry interval in seconds, required to replace the retry policy",
+		},
+		cli.Float64Flag{
+			Name:  FlagRetryBackoff,
+			Value: 1.0,
+			Usage: "Optional retry backoff coefficient. Must be or equal or greater than 1.",
+		},
+		cli.IntFlag{
+			Name:  FlagRetryMaxInterval,
+			Usage: "Optional retry maximum interval in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagRetryAttempts,
+			Usage: "Optional retry attempts",
+		},
+		cli.IntFlag{
+			Name:  FlagRetryExpiration,
+			Usage: "Optional retry expiration in seconds, counted from now",
+		},
+	}
+}
+
 func getCommonFlagsForVisibility() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -652,6 +731,10 @@ func getFlagsForCount() []cli.Flag {
 			Name:  FlagListQueryWithAlias,
 			Usage: "Optional SQL like query. e.g count all open workflows 'CloseTime = missing'; 'WorkflowType=\"wtype\" and CloseTime > 0'",
 		},
+		cli.StringFlag{
+			Name:  FlagGroupBy,
+			Usage: "Optional comma separated search attributes to count workflows by, e.g. 'WorkflowType,CloseStatus'",
+		},
 	}
 }
 
@@ -27,7 +27,7 @@ func newTaskListCommands() []cli.Command {
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
-			Usage:   "Describe pollers info of tasklist",
+			Usage:   "Describe pollers and backlog info of tasklist",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagTaskListWithAlias,
@@ -21,46 +21,64 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/types"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
-	s "go.uber.org/cadence/.gen/go/shared"
 )
 
-// DescribeTaskList show pollers info of a given tasklist
+// DescribeTaskList show pollers info and backlog status of a given tasklist
 func DescribeTaskList(c *cli.Context) {
-	wfClient := getWorkflowClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
-	taskListType := strToTaskListType(c.String(FlagTaskListType)) // default type is decision
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
 
 	ctx, cancel := newContext(c)
 	defer cancel()
-	response, err := wfClient.DescribeTaskList(ctx, taskList, taskListType)
+	request := &types.DescribeTaskListRequest{
+		Domain:                domain,
+		TaskList:              &types.TaskList{Name: taskList},
+		TaskListType:          &taskListType,
+		IncludeTaskListStatus: true,
+	}
+	response, err := frontendClient.DescribeTaskList(ctx, request)
 	if err != nil {
 		ErrorAndExit("Operation DescribeTaskList failed.", err)
 	}
 
+	if taskListStatus := response.GetTaskListStatus(); taskListStatus != nil {
+		printTaskListBacklogStatus(taskListStatus)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
 	}
+	printPollerInfo(pollers, taskListType)
+}
 
+func printTaskListBacklogStatus(taskListStatus *types.TaskListStatus) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	if taskListType == s.TaskListTypeActivity {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
-	} else {
-		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
-	}
+	table.SetHeader([]string{"Backlog", "Backlog Age", "Dispatch Rate", "Sync Match Ratio"})
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
-	}
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{strconv.FormatInt(taskListStatus.GetBacklogCountHint(), 10),
+		(time.Duration(taskListStatus.GetBacklogAgeInSeconds()) * time.Second).String(),
+		strconv.FormatFloat(taskListStatus.GetDispatchRatePerSecond(), 'f', 2, 64),
+		strconv.FormatFloat(taskListStatus.GetSyncMatchRatio(), 'f', 2, 64)})
 	table.Render()
 }
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/batcher"
 )
 
@@ -104,6 +105,23 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:        "pause",
+			Usage:       "pause a workflow execution",
+			Description: "no new decision tasks are dispatched until the workflow is unpaused, timers fire but are handled by the workflow only after unpause",
+			Flags:       getFlagsForPause(),
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: getFlagsForUnpause(),
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -454,7 +472,7 @@ func newBatchCommands() []cli.Command {
 		{
 			Name:  "start",
 			Usage: "Start a batch operation job",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				cli.StringFlag{
 					Name:  FlagListQueryWithAlias,
 					Usage: "Query to get workflows for being executed this batch operation",
@@ -484,6 +502,35 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagTargetClusterWithAlias,
 					Usage: "Required for batch replicate",
 				},
+				cli.BoolFlag{
+					Name:  FlagAllowActivities,
+					Usage: "Optional for batch pause, keep dispatching pending activities of paused workflows",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "Required for batch activity, the activityID to operate on",
+				},
+				cli.StringFlag{
+					Name: FlagActivityOperation,
+					Usage: "Required for batch activity, one of: " + strings.Join([]string{
+						strings.ToLower(types.PendingActivityOperationUpdateOptions.String()),
+						strings.ToLower(types.PendingActivityOperationRetry.String()),
+						strings.ToLower(types.PendingActivityOperationFail.String()),
+						strings.ToLower(types.PendingActivityOperationSkip.String()),
+					}, ","),
+				},
+				cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Optional for batch activity retry, reset the attempt count of the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagResult,
+					Usage: "Optional for batch activity skip, result of the activity",
+				},
+				cli.StringFlag{
+					Name:  FlagDetail,
+					Usage: "Optional for batch activity fail, detail to fail the activity",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -493,7 +540,7 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagYes,
 					Usage: "Optional flag to disable confirmation prompt",
 				},
-			},
+			}, getFlagsForActivityOptions()...),
 			Action: func(c *cli.Context) {
 				StartBatchJob(c)
 			},
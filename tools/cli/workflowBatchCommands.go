@@ -33,6 +33,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/batcher"
 )
 
@@ -152,6 +153,11 @@ func StartBatchJob(c *cli.Context) {
 		sourceCluster = getRequiredOption(c, FlagSourceCluster)
 		targetCluster = getRequiredOption(c, FlagTargetCluster)
 	}
+	var activityRequest types.OperatePendingActivityRequest
+	if batchType == batcher.BatchTypeActivity {
+		operation := parsePendingActivityOperation(getRequiredOption(c, FlagActivityOperation))
+		activityRequest = *buildOperatePendingActivityRequest(c, operation)
+	}
 	rps := c.Int(FlagRPS)
 
 	svcClient := cFactory.ClientFrontendClient(c)
@@ -209,6 +215,12 @@ func StartBatchJob(c *cli.Context) {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		PauseParams: batcher.PauseParams{
+			AllowActivities: c.Bool(FlagAllowActivities),
+		},
+		ActivityParams: batcher.ActivityParams{
+			Request: activityRequest,
+		},
 		RPS: rps,
 	}
 	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
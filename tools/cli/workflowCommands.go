@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/history/execution"
@@ -530,6 +531,59 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:          c.String(FlagReason),
+		Identity:        getCliIdentity(),
+		AllowActivities: c.Bool(FlagAllowActivities),
+	})
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) {
 	serviceClient := cFactory.ClientFrontendClient(c)
@@ -770,6 +824,10 @@ func isQueryOpen(query string) bool {
 
 // CountWorkflow count number of workflows
 func CountWorkflow(c *cli.Context) {
+	if c.IsSet(FlagGroupBy) {
+		countWorkflowByGroups(c)
+		return
+	}
 	wfClient := getWorkflowClient(c)
 
 	query := c.String(FlagListQuery)
@@ -787,6 +845,65 @@ func CountWorkflow(c *cli.Context) {
 	fmt.Println(response.GetCount())
 }
 
+// countWorkflowByGroups counts workflows by the values of the group by search attributes, the client
+// library does not expose group by, so the request is sent with the server frontend client
+func countWorkflowByGroups(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	var groupBy []string
+	for _, name := range strings.Split(c.String(FlagGroupBy), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			groupBy = append(groupBy, name)
+		}
+	}
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	response, err := frontendClient.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
+		Domain:  domain,
+		Query:   c.String(FlagListQuery),
+		GroupBy: groupBy,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to count workflow.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader(append(groupBy, "Count"))
+	table.SetHeaderLine(false)
+	for _, group := range response.GetGroups() {
+		row := make([]string, 0, len(groupBy)+1)
+		for i, value := range group.GetGroupValues() {
+			row = append(row, formatGroupValue(groupBy[i], value))
+		}
+		table.Append(append(row, strconv.FormatInt(group.GetCount(), 10)))
+	}
+	table.SetFooter(append(make([]string, len(groupBy)), strconv.FormatInt(response.GetCount(), 10)))
+	table.Render()
+	if response.GetTruncated() {
+		fmt.Printf("NOTE: only the largest %d groups are shown.\n", len(response.GetGroups()))
+	}
+}
+
+// formatGroupValue converts the JSON encoded value of a group by search attribute for display
+func formatGroupValue(name string, value string) string {
+	if value == "null" {
+		return "<missing>"
+	}
+	if name == definition.CloseStatus {
+		if status, err := strconv.Atoi(value); err == nil {
+			return types.WorkflowExecutionCloseStatus(status).String()
+		}
+	}
+	var str string
+	if err := json.Unmarshal([]byte(value), &str); err == nil {
+		return str
+	}
+	return value
+}
+
 // ListArchivedWorkflow lists archived workflow executions based on filters
 func ListArchivedWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)